@@ -11,9 +11,11 @@ import (
 	"github.com/fatih/color"
 	"github.com/kris-nova/logger"
 	lol "github.com/kris-nova/lolgopher"
+
+	"github.com/weaveworks/eksctl/pkg/progress"
 )
 
-func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsValue bool) {
+func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsValue, quiet bool) {
 	logger.Layout = "2006-01-02 15:04:05"
 
 	var bitwiseLevel int
@@ -31,27 +33,29 @@ func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsV
 	default:
 		bitwiseLevel = logger.LogDeprecated | logger.LogEverything
 	}
+	if quiet {
+		// errors only
+		bitwiseLevel = logger.LogCritical
+	}
 	logger.BitwiseLevel = bitwiseLevel
 
-	if dumpLogsValue {
-		switch colorValue {
-		case "fabulous":
-			logger.Writer = io.MultiWriter(lol.NewLolWriter(), logBuffer)
-		case "true":
-			logger.Writer = io.MultiWriter(color.Output, logBuffer)
-		default:
-			logger.Writer = io.MultiWriter(os.Stdout, logBuffer)
-		}
+	var out io.Writer
+	switch colorValue {
+	case "fabulous":
+		out = lol.NewLolWriter()
+	case "true":
+		out = color.Output
+	default:
+		out = os.Stdout
+	}
+	if !quiet && level > 0 && isTerminal(os.Stdout) {
+		out = progress.EnableBar(out)
+	}
 
+	if dumpLogsValue {
+		logger.Writer = io.MultiWriter(out, logBuffer)
 	} else {
-		switch colorValue {
-		case "fabulous":
-			logger.Writer = lol.NewLolWriter()
-		case "true":
-			logger.Writer = color.Output
-		default:
-			logger.Writer = os.Stdout
-		}
+		logger.Writer = out
 	}
 
 	logger.Line = func(prefix, format string, a ...interface{}) string {
@@ -96,6 +100,12 @@ func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsV
 	}
 }
 
+// isTerminal reports whether f is a terminal, where the progress bar can be redrawn
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
 func dumpLogsToDisk(logBuffer *bytes.Buffer, errorString string) error {
 
 	if _, err := os.Stat("logs/"); os.IsNotExist(err) {
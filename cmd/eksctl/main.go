@@ -2,8 +2,10 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
@@ -11,6 +13,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/register"
 
 	"github.com/weaveworks/eksctl/pkg/actions/anywhere"
+	"github.com/weaveworks/eksctl/pkg/actions/plugin"
 	"github.com/weaveworks/eksctl/pkg/ctl/associate"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/completion"
@@ -26,6 +29,8 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/update"
 	"github.com/weaveworks/eksctl/pkg/ctl/upgrade"
 	"github.com/weaveworks/eksctl/pkg/ctl/utils"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/telemetry"
 )
 
 func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
@@ -50,6 +55,8 @@ func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
 
 	cmdutils.AddResourceCmd(flagGrouping, rootCmd, infoCmd)
 	cmdutils.AddResourceCmd(flagGrouping, rootCmd, versionCmd)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, serveCmd)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, operatorCmd)
 }
 
 func main() {
@@ -84,22 +91,55 @@ func main() {
 	addCommands(rootCmd, flagGrouping)
 	checkCommand(rootCmd)
 
+	pluginToRun, pluginArgs, err := plugin.Find(os.Args[1:], func(name string) bool {
+		return isBuiltinCommand(rootCmd, name)
+	})
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+
+	if pluginToRun != nil {
+		exitCode, err := pluginToRun.Run(pluginArgs)
+		if err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+		os.Exit(exitCode)
+	}
+
 	rootCmd.PersistentFlags().BoolP("help", "h", false, "help for this command")
 
 	loggerLevel := rootCmd.PersistentFlags().IntP("verbose", "v", 3, "set log level, use 0 to silence, 4 for debugging and 5 for debugging with AWS debug logging")
 	colorValue := rootCmd.PersistentFlags().StringP("color", "C", "true", "toggle colorized logs (valid options: true, false, fabulous)")
+	quiet := rootCmd.PersistentFlags().BoolP("quiet", "q", false, "only log errors, and don't show the progress bar of long-running operations in terminals")
 
 	dumpLogsValue := rootCmd.PersistentFlags().BoolP("dumpLogs", "d", false, "dump logs to disk on failure if set to true")
 
+	metricsListen := rootCmd.PersistentFlags().String("metrics-listen", "", "serve Prometheus metrics of the operations at this address for the duration of the command, e.g. :9090")
+
 	logBuffer := new(bytes.Buffer)
+	stopMetrics := func() {}
 
 	cobra.OnInitialize(func() {
-		initLogger(*loggerLevel, *colorValue, logBuffer, *dumpLogsValue)
+		initLogger(*loggerLevel, *colorValue, logBuffer, *dumpLogsValue, *quiet)
+		if *metricsListen != "" {
+			stop, err := metrics.Serve(*metricsListen)
+			if err != nil {
+				logger.Warning("not serving metrics: %v", err)
+				return
+			}
+			stopMetrics = stop
+		}
 	})
 
 	rootCmd.SetUsageFunc(flagGrouping.Usage)
 
-	if err := rootCmd.Execute(); err != nil {
+	ctx, endTrace := telemetry.Setup(context.Background(), commandPath(rootCmd))
+	err = rootCmd.ExecuteContext(ctx)
+	endTrace()
+	stopMetrics()
+	if err != nil {
 
 		if *dumpLogsValue {
 			if dumpErr := dumpLogsToDisk(logBuffer, err.Error()); dumpErr != nil {
@@ -111,6 +151,15 @@ func main() {
 	}
 }
 
+// commandPath returns the path of the command that will be executed, e.g. `eksctl create cluster`
+func commandPath(rootCmd *cobra.Command) string {
+	cmd, _, err := rootCmd.Find(os.Args[1:])
+	if err != nil || cmd == nil {
+		return rootCmd.Name()
+	}
+	return cmd.CommandPath()
+}
+
 func checkCommand(rootCmd *cobra.Command) {
 	for _, cmd := range rootCmd.Commands() {
 		// just a precaution as the verb command didn't have runE
@@ -133,3 +182,17 @@ func checkCommand(rootCmd *cobra.Command) {
 		}
 	}
 }
+
+// isBuiltinCommand reports whether name is an eksctl command, including the ones
+// cobra adds when it runs, so that plugins can't shadow them
+func isBuiltinCommand(rootCmd *cobra.Command, name string) bool {
+	if name == "help" || strings.HasPrefix(name, "__") {
+		return true
+	}
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
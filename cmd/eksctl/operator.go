@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/weaveworks/eksctl/pkg/client"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/operator"
+)
+
+func operatorCmd(cmd *cmdutils.Cmd) {
+	var (
+		kubeconfigPath string
+		namespace      string
+		installCRD     bool
+		awsOptions     client.AWSOptions
+	)
+
+	cmd.SetDescription("operator", "Reconcile EKS clusters with ClusterConfig objects in a Kubernetes cluster",
+		"Watches ClusterConfig objects and creates their EKS clusters and nodegroups; deleting a ClusterConfig object deletes its EKS cluster")
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&kubeconfigPath, "kubeconfig", "", "path to the kubeconfig of the management cluster (defaults to the in-cluster config or the default kubeconfig)")
+		fs.StringVar(&namespace, "namespace", "", "namespace to watch (defaults to all namespaces)")
+		fs.BoolVar(&installCRD, "install-crd", true, "create the ClusterConfig CustomResourceDefinition if it doesn't exist")
+		fs.StringVarP(&awsOptions.Profile, "profile", "p", "", "AWS credentials profile to use (defaults to value of the AWS_PROFILE environment variable)")
+		cmdutils.AddTimeoutFlag(fs, &awsOptions.Timeout)
+	})
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+		loadingRules.ExplicitPath = kubeconfigPath
+		restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+		if err != nil {
+			return err
+		}
+
+		if installCRD {
+			if err := operator.InstallCRD(ctx, restConfig); err != nil {
+				return err
+			}
+		}
+
+		controller, err := operator.NewController(restConfig, namespace, operator.NewActions(awsOptions))
+		if err != nil {
+			return err
+		}
+		return controller.Run(ctx)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/server"
+)
+
+func serveCmd(cmd *cmdutils.Cmd) {
+	var listenAddress string
+
+	cmd.SetDescription("serve", "Serve cluster, nodegroup and addon operations over a local HTTP API",
+		"Runs operations submitted to POST /v1/jobs one at a time; their status and logs can be polled with GET /v1/jobs/<id>")
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&listenAddress, "listen-address", "localhost:8665", "address to listen on; the API is not authenticated, so it should not be reachable from other hosts")
+	})
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			return err
+		}
+
+		s := server.New()
+		go s.Run(ctx)
+
+		httpServer := &http.Server{
+			Handler:           s,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := httpServer.Shutdown(shutdownCtx); err != nil {
+				logger.Warning("error shutting down server: %v", err)
+			}
+		}()
+
+		logger.Info("serving on http://%s", listener.Addr())
+		if err := httpServer.Serve(listener); err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+	}
+}
@@ -1,17 +1,18 @@
 package matchers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
-	"strings"
 
 	"github.com/onsi/gomega/types"
 
+	"github.com/aws/aws-sdk-go-v2/config"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
-
-	awseks "github.com/aws/aws-sdk-go/service/eks"
 )
 
 // HaveExistingStack returns a GoMega matcher that will check for the existence of an cloudformation stack
@@ -51,13 +52,13 @@ func (m *existingStack) NegatedFailureMessage(_ interface{}) (message string) {
 }
 
 // HaveExistingCluster returns a GoMega matcher that will check for the existence of an EKS cluster
-func HaveExistingCluster(expectedName string, expectedStatus string, expectedVersion string) types.GomegaMatcher {
+func HaveExistingCluster(expectedName string, expectedStatus ekstypes.ClusterStatus, expectedVersion string) types.GomegaMatcher {
 	return &existingCluster{expectedName: expectedName, expectedStatus: expectedStatus, expectedVersion: expectedVersion}
 }
 
 type existingCluster struct {
 	expectedName    string
-	expectedStatus  string
+	expectedStatus  ekstypes.ClusterStatus
 	expectedVersion string
 
 	clusterNotFound bool
@@ -65,7 +66,7 @@ type existingCluster struct {
 	statusMismatch  bool
 
 	actualVersion string
-	actualStatus  string
+	actualStatus  ekstypes.ClusterStatus
 }
 
 func (m *existingCluster) Match(actual interface{}) (success bool, err error) {
@@ -77,16 +78,21 @@ func (m *existingCluster) Match(actual interface{}) (success bool, err error) {
 		return false, errors.New("not a AWS session")
 	}
 
-	eks := awseks.New(actual.(*session.Session))
+	ctx := context.Background()
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(aws.StringValue(actual.(*session.Session).Config.Region)))
+	if err != nil {
+		return false, err
+	}
+	eks := awseks.NewFromConfig(cfg)
 
 	input := &awseks.DescribeClusterInput{
 		Name: aws.String(m.expectedName),
 	}
-	output, err := eks.DescribeCluster(input)
+	output, err := eks.DescribeCluster(ctx, input)
 
 	if err != nil {
-		// Check if its a not found error: ResourceNotFoundException
-		if !strings.Contains(err.Error(), awseks.ErrCodeResourceNotFoundException) {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if !errors.As(err, &notFoundErr) {
 			return false, err
 		}
 
@@ -94,7 +100,7 @@ func (m *existingCluster) Match(actual interface{}) (success bool, err error) {
 		return false, nil
 	}
 
-	m.actualStatus = *output.Cluster.Status
+	m.actualStatus = output.Cluster.Status
 	if m.actualStatus != m.expectedStatus {
 		m.statusMismatch = true
 		return false, nil
@@ -8,7 +8,7 @@ import (
 	"fmt"
 	"testing"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -52,7 +52,7 @@ var _ = Describe("(Integration) Create & Delete before Active", func() {
 		cmd.Start()
 		awsSession := NewSession(params.Region)
 		Eventually(awsSession, timeOutSeconds, pollInterval).Should(
-			HaveExistingCluster(params.ClusterName, awseks.ClusterStatusCreating, params.Version))
+			HaveExistingCluster(params.ClusterName, ekstypes.ClusterStatusCreating, params.Version))
 	})
 
 	Context("when deleting the cluster in process of being created", func() {
@@ -69,7 +69,7 @@ var _ = Describe("(Integration) Create & Delete before Active", func() {
 		It("should eventually delete the EKS cluster and both CloudFormation stacks", func() {
 			awsSession := NewSession(params.Region)
 			Eventually(awsSession, timeOutSeconds, pollInterval).ShouldNot(
-				HaveExistingCluster(params.ClusterName, awseks.ClusterStatusActive, params.Version))
+				HaveExistingCluster(params.ClusterName, ekstypes.ClusterStatusActive, params.Version))
 			Eventually(awsSession, timeOutSeconds, pollInterval).ShouldNot(
 				HaveExistingStack(fmt.Sprintf("eksctl-%s-cluster", params.ClusterName)))
 			Eventually(awsSession, timeOutSeconds, pollInterval).ShouldNot(
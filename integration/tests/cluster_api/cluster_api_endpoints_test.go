@@ -17,7 +17,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/testutils"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -110,7 +110,7 @@ var _ = Describe("(Integration) Create and Update Cluster with Endpoint Configs"
 				Expect(cmd).Should(RunSuccessfully())
 				awsSession := NewSession(params.Region)
 				Eventually(awsSession, timeOutSeconds, pollInterval).Should(
-					HaveExistingCluster(clName, awseks.ClusterStatusActive, params.Version))
+					HaveExistingCluster(clName, ekstypes.ClusterStatusActive, params.Version))
 			} else if e.Type == updateCluster {
 				utilsCmd := params.EksctlUtilsCmd.
 					WithTimeout(timeOutSeconds*time.Second).
@@ -154,7 +154,7 @@ var _ = Describe("(Integration) Create and Update Cluster with Endpoint Configs"
 				Expect(deleteCmd).Should(RunSuccessfully())
 				awsSession := NewSession(params.Region)
 				Eventually(awsSession, timeOutSeconds, pollInterval).
-					ShouldNot(HaveExistingCluster(clName, awseks.ClusterStatusActive, params.Version))
+					ShouldNot(HaveExistingCluster(clName, ekstypes.ClusterStatusActive, params.Version))
 			}
 		},
 		Entry("Create cluster1, Private=false, Public=true, should succeed", endpointAccessCase{
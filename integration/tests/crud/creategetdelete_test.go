@@ -18,9 +18,9 @@ import (
 
 	"k8s.io/client-go/kubernetes"
 
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
 	harness "github.com/dlespiau/kube-test-harness"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -132,7 +132,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 		It("should have created an EKS cluster and two CloudFormation stacks", func() {
 			awsSession := NewSession(params.Region)
 
-			Expect(awsSession).To(HaveExistingCluster(params.ClusterName, awseks.ClusterStatusActive, params.Version))
+			Expect(awsSession).To(HaveExistingCluster(params.ClusterName, ekstypes.ClusterStatusActive, params.Version))
 
 			Expect(awsSession).To(HaveExistingStack(fmt.Sprintf("eksctl-%s-cluster", params.ClusterName)))
 			Expect(awsSession).To(HaveExistingStack(fmt.Sprintf("eksctl-%s-nodegroup-%s", params.ClusterName, mngNG1)))
@@ -191,7 +191,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 				}
 				ctl, err := eks.New(context.TODO(), &api.ProviderConfig{Region: params.Region}, cfg)
 				Expect(err).NotTo(HaveOccurred())
-				err = ctl.RefreshClusterStatus(cfg)
+				err = ctl.RefreshClusterStatus(context.TODO(), cfg)
 				Expect(err).ShouldNot(HaveOccurred())
 				clientSet, err = ctl.NewStdClientSet(cfg)
 				Expect(err).ShouldNot(HaveOccurred())
@@ -326,7 +326,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 				awsSession := NewSession(params.Region)
 				ec2 := awsec2.New(awsSession)
 				existingSubnets, err := ec2.DescribeSubnets(&awsec2.DescribeSubnetsInput{
-					SubnetIds: cl.ResourcesVpcConfig.SubnetIds,
+					SubnetIds: aws.StringSlice(cl.ResourcesVpcConfig.SubnetIds),
 				})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(len(existingSubnets.Subnets) > 0).To(BeTrue())
@@ -590,7 +590,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 				})
 
 				It("should have all types disabled by default", func() {
-					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(enabled.List()).To(HaveLen(0))
 					Expect(disable.List()).To(HaveLen(5))
@@ -604,7 +604,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 					)
 					Expect(cmd).To(RunSuccessfully())
 
-					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(enabled.List()).To(HaveLen(0))
 					Expect(disable.List()).To(HaveLen(5))
@@ -619,7 +619,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 					)
 					Expect(cmd).To(RunSuccessfully())
 
-					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(enabled.List()).To(HaveLen(2))
 					Expect(disable.List()).To(HaveLen(3))
@@ -636,7 +636,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 					)
 					Expect(cmd).To(RunSuccessfully())
 
-					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(enabled.List()).To(HaveLen(5))
 					Expect(disable.List()).To(HaveLen(0))
@@ -652,7 +652,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 					)
 					Expect(cmd).To(RunSuccessfully())
 
-					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(enabled.List()).To(HaveLen(4))
 					Expect(disable.List()).To(HaveLen(1))
@@ -670,7 +670,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 					)
 					Expect(cmd).To(RunSuccessfully())
 
-					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(disable.List()).To(HaveLen(4))
 					Expect(enabled.List()).To(HaveLen(1))
@@ -687,7 +687,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 					)
 					Expect(cmd).To(RunSuccessfully())
 
-					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+					enabled, disable, err := ctl.GetCurrentClusterConfigForLogging(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(enabled.List()).To(HaveLen(0))
 					Expect(disable.List()).To(HaveLen(5))
@@ -712,9 +712,9 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 					}
 					ctl, err = eks.New(context.TODO(), &api.ProviderConfig{Region: params.Region}, cfg)
 					Expect(err).NotTo(HaveOccurred())
-					err = ctl.RefreshClusterStatus(cfg)
+					err = ctl.RefreshClusterStatus(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
-					oidc, err = ctl.NewOpenIDConnectManager(cfg)
+					oidc, err = ctl.NewOpenIDConnectManager(context.TODO(), cfg)
 					Expect(err).ShouldNot(HaveOccurred())
 				})
 
@@ -6,14 +6,15 @@ package eks_connector_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -107,11 +108,11 @@ var _ = Describe("(Integration) [EKS Connector test]", func() {
 			describeClusterInput := &awseks.DescribeClusterInput{
 				Name: aws.String(connectedClusterName),
 			}
-			Eventually(func() string {
-				connectedCluster, err := provider.Provider.EKS().DescribeCluster(describeClusterInput)
+			Eventually(func() ekstypes.ClusterStatus {
+				connectedCluster, err := provider.Provider.EKS().DescribeCluster(context.TODO(), describeClusterInput)
 				Expect(err).NotTo(HaveOccurred())
-				return *connectedCluster.Cluster.Status
-			}, "5m", "8s").Should(Equal("ACTIVE"))
+				return connectedCluster.Cluster.Status
+			}, "5m", "8s").Should(Equal(ekstypes.ClusterStatusActive))
 
 			cmd = params.EksctlGetCmd.WithArgs("clusters", "-n", connectedClusterName)
 			Expect(cmd).To(RunSuccessfullyWithOutputString(ContainSubstring("OTHER")))
@@ -134,10 +135,10 @@ var _ = Describe("(Integration) [EKS Connector test]", func() {
 				WithArgs("--name", connectedClusterName)
 			Expect(cmd).To(RunSuccessfully())
 
-			_, err = provider.Provider.EKS().DescribeCluster(describeClusterInput)
+			_, err = provider.Provider.EKS().DescribeCluster(context.TODO(), describeClusterInput)
 			Expect(err).To(HaveOccurred())
-			awsErr, ok := err.(awserr.Error)
-			Expect(ok && awsErr.Code() == awseks.ErrCodeResourceNotFoundException).To(BeTrue())
+			var notFoundErr *ekstypes.ResourceNotFoundException
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
 		})
 	})
 })
@@ -176,7 +177,7 @@ func getRawClient(clusterName, region string) *kubewrapper.RawClient {
 	ctl, err := eks.New(context.TODO(), &api.ProviderConfig{Region: region}, cfg)
 	Expect(err).NotTo(HaveOccurred())
 
-	err = ctl.RefreshClusterStatus(cfg)
+	err = ctl.RefreshClusterStatus(context.TODO(), cfg)
 	Expect(err).ShouldNot(HaveOccurred())
 	rawClient, err := ctl.NewRawClient(cfg)
 	Expect(err).NotTo(HaveOccurred())
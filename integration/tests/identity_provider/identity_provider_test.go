@@ -12,11 +12,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
-	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -37,6 +37,7 @@ import (
 
 	"github.com/weaveworks/eksctl/integration/tests"
 	"github.com/weaveworks/eksctl/integration/utilities/kube"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/testutils"
 )
 
@@ -125,7 +126,9 @@ var _ = Describe("(Integration) [Identity Provider]", func() {
 		test.CreateClusterRoleBindingFromFile("testdata/cluster-role-binding.yaml")
 
 		By("creating an OIDC Clientset")
-		clientset, err := createOIDCClientset(eks.New(NewSession(params.Region)), oidcConfig, params.ClusterName)
+		awsConfig, err := config.LoadDefaultConfig(context.TODO(), config.WithRegion(params.Region))
+		Expect(err).NotTo(HaveOccurred())
+		clientset, err := createOIDCClientset(eks.NewFromConfig(awsConfig), oidcConfig, params.ClusterName)
 		Expect(err).NotTo(HaveOccurred())
 
 		By("reading Kubernetes resources")
@@ -176,10 +179,10 @@ var _ = AfterSuite(func() {
 	}
 })
 
-func createOIDCClientset(eksAPI eksiface.EKSAPI, o *OIDCConfig, clusterName string) (kubernetes.Interface, error) {
+func createOIDCClientset(eksAPI awsapi.EKS, o *OIDCConfig, clusterName string) (kubernetes.Interface, error) {
 	contextName := fmt.Sprintf("%s@%s", "test", clusterName)
 
-	cluster, err := eksAPI.DescribeCluster(&eks.DescribeClusterInput{
+	cluster, err := eksAPI.DescribeCluster(context.TODO(), &eks.DescribeClusterInput{
 		Name: aws.String(clusterName),
 	})
 	if err != nil {
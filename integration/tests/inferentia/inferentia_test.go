@@ -177,7 +177,7 @@ func newClientSet(name string) *kubernetes.Clientset {
 	ctl, err := eks.New(context.TODO(), &api.ProviderConfig{Region: params.Region}, cfg)
 	Expect(err).NotTo(HaveOccurred())
 
-	err = ctl.RefreshClusterStatus(cfg)
+	err = ctl.RefreshClusterStatus(context.TODO(), cfg)
 	Expect(err).ShouldNot(HaveOccurred())
 
 	clientSet, err := ctl.NewStdClientSet(cfg)
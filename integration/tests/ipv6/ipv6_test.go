@@ -161,7 +161,7 @@ var _ = Describe("(Integration) [EKS IPv6 test]", func() {
 			var clientSet *kubernetes.Clientset
 			ctl, err := eks.New(context.TODO(), &api.ProviderConfig{Region: params.Region}, clusterConfig)
 			Expect(err).NotTo(HaveOccurred())
-			err = ctl.RefreshClusterStatus(clusterConfig)
+			err = ctl.RefreshClusterStatus(context.TODO(), clusterConfig)
 			Expect(err).ShouldNot(HaveOccurred())
 			clientSet, err = ctl.NewStdClientSet(clusterConfig)
 			Expect(err).ShouldNot(HaveOccurred())
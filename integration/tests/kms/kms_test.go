@@ -11,8 +11,8 @@ import (
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 
 	. "github.com/weaveworks/eksctl/integration/runner"
 	"github.com/weaveworks/eksctl/integration/tests"
@@ -68,8 +68,7 @@ var _ = Describe("(Integration) [EKS kms test]", func() {
 			Expect(err).NotTo(HaveOccurred())
 			ctl = clusterProvider.Provider
 
-			kmsClient := kms.New(ctl.ConfigProvider())
-			output, err := kmsClient.CreateKey(&kms.CreateKeyInput{
+			output, err := ctl.KMS().CreateKey(context.Background(), &kms.CreateKeyInput{
 				Description: aws.String(fmt.Sprintf("Key to test KMS encryption on EKS cluster %s", clusterName)),
 			})
 			Expect(err).NotTo(HaveOccurred())
@@ -83,10 +82,9 @@ var _ = Describe("(Integration) [EKS kms test]", func() {
 			)
 			Expect(cmd).To(RunSuccessfully())
 
-			kmsClient := kms.New(ctl.ConfigProvider())
-			_, err := kmsClient.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
+			_, err := ctl.KMS().ScheduleKeyDeletion(context.Background(), &kms.ScheduleKeyDeletionInput{
 				KeyId:               kmsKeyARN,
-				PendingWindowInDays: aws.Int64(7),
+				PendingWindowInDays: aws.Int32(7),
 			})
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -10,8 +10,9 @@ import (
 	"testing"
 	"time"
 
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
 	harness "github.com/dlespiau/kube-test-harness"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -229,7 +230,7 @@ var _ = Describe("(Integration) Create Managed Nodegroups", func() {
 		It("should have created an EKS cluster and 4 CloudFormation stacks", func() {
 			awsSession := NewSession(params.Region)
 
-			Expect(awsSession).To(HaveExistingCluster(params.ClusterName, awseks.ClusterStatusActive, params.Version))
+			Expect(awsSession).To(HaveExistingCluster(params.ClusterName, ekstypes.ClusterStatusActive, params.Version))
 
 			Expect(awsSession).To(HaveExistingStack(fmt.Sprintf("eksctl-%s-cluster", params.ClusterName)))
 			Expect(awsSession).To(HaveExistingStack(fmt.Sprintf("eksctl-%s-nodegroup-%s", params.ClusterName, initialAl2Nodegroup)))
@@ -531,12 +532,12 @@ var _ = Describe("(Integration) Create Managed Nodegroups", func() {
 				clusterProvider, err := eks.New(context.TODO(), &api.ProviderConfig{Region: params.Region}, clusterConfig)
 				Expect(err).NotTo(HaveOccurred())
 				ctl := clusterProvider.Provider
-				out, err := ctl.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
+				out, err := ctl.EKS().DescribeNodegroup(context.TODO(), &awseks.DescribeNodegroupInput{
 					ClusterName:   &params.ClusterName,
 					NodegroupName: aws.String("update-config-ng"),
 				})
 				Expect(err).NotTo(HaveOccurred())
-				Eventually(out.Nodegroup.UpdateConfig.MaxUnavailable).Should(Equal(aws.Int64(2)))
+				Eventually(out.Nodegroup.UpdateConfig.MaxUnavailable).Should(Equal(aws.Int32(2)))
 
 				By("and updating the nodegroup's UpdateConfig")
 				clusterConfig.ManagedNodeGroups[0].Spot = true
@@ -557,12 +558,12 @@ var _ = Describe("(Integration) Create Managed Nodegroups", func() {
 					ContainElement(ContainSubstring("unchanged fields for nodegroup update-config-ng: the following fields remain unchanged; they are not supported by `eksctl update nodegroup`: Spot")),
 				))
 
-				out, err = ctl.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
+				out, err = ctl.EKS().DescribeNodegroup(context.TODO(), &awseks.DescribeNodegroupInput{
 					ClusterName:   &params.ClusterName,
 					NodegroupName: aws.String("update-config-ng"),
 				})
 				Expect(err).NotTo(HaveOccurred())
-				Eventually(out.Nodegroup.UpdateConfig.MaxUnavailable).Should(Equal(aws.Int64(1)))
+				Eventually(out.Nodegroup.UpdateConfig.MaxUnavailable).Should(Equal(aws.Int32(1)))
 			})
 		})
 
@@ -17,8 +17,9 @@ import (
 
 	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -358,22 +359,22 @@ func createClusterWithNodeGroup(clusterName, stackName, ng1, version string, ctl
 	timeoutDuration := time.Minute * 30
 	publicSubnets, privateSubnets, clusterRoleArn, nodeRoleArn, vpcID, securityGroup := createVPCAndRole(stackName, ctl)
 
-	_, err := ctl.EKS().CreateCluster(&awseks.CreateClusterInput{
+	_, err := ctl.EKS().CreateCluster(context.TODO(), &awseks.CreateClusterInput{
 		Name: &clusterName,
-		ResourcesVpcConfig: &awseks.VpcConfigRequest{
-			SubnetIds: aws.StringSlice(append(publicSubnets, privateSubnets...)),
+		ResourcesVpcConfig: &ekstypes.VpcConfigRequest{
+			SubnetIds: append(publicSubnets, privateSubnets...),
 		},
 		RoleArn: &clusterRoleArn,
 		Version: aws.String(version),
 	})
 	Expect(err).NotTo(HaveOccurred())
-	Eventually(func() string {
-		out, err := ctl.EKS().DescribeCluster(&awseks.DescribeClusterInput{
+	Eventually(func() ekstypes.ClusterStatus {
+		out, err := ctl.EKS().DescribeCluster(context.TODO(), &awseks.DescribeClusterInput{
 			Name: &clusterName,
 		})
 		Expect(err).NotTo(HaveOccurred())
-		return *out.Cluster.Status
-	}, timeoutDuration, time.Second*30).Should(Equal("ACTIVE"))
+		return out.Cluster.Status
+	}, timeoutDuration, time.Second*30).Should(Equal(ekstypes.ClusterStatusActive))
 
 	newVPC := api.NewClusterVPC(false)
 	newVPC.ID = vpcID
@@ -403,26 +404,26 @@ func createClusterWithNodeGroup(clusterName, stackName, ng1, version string, ctl
 		},
 	}
 
-	_, err = ctl.EKS().CreateNodegroup(&awseks.CreateNodegroupInput{
+	_, err = ctl.EKS().CreateNodegroup(context.TODO(), &awseks.CreateNodegroupInput{
 		NodegroupName: &ng1,
 		ClusterName:   &clusterName,
 		NodeRole:      &nodeRoleArn,
-		Subnets:       aws.StringSlice(publicSubnets),
-		ScalingConfig: &awseks.NodegroupScalingConfig{
-			MaxSize:     aws.Int64(1),
-			DesiredSize: aws.Int64(1),
-			MinSize:     aws.Int64(1),
+		Subnets:       publicSubnets,
+		ScalingConfig: &ekstypes.NodegroupScalingConfig{
+			MaxSize:     aws.Int32(1),
+			DesiredSize: aws.Int32(1),
+			MinSize:     aws.Int32(1),
 		},
 	})
 	Expect(err).NotTo(HaveOccurred())
-	Eventually(func() string {
-		out, err := ctl.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
+	Eventually(func() ekstypes.NodegroupStatus {
+		out, err := ctl.EKS().DescribeNodegroup(context.TODO(), &awseks.DescribeNodegroupInput{
 			ClusterName:   &clusterName,
 			NodegroupName: &ng1,
 		})
 		Expect(err).NotTo(HaveOccurred())
-		return *out.Nodegroup.Status
-	}, timeoutDuration, time.Second*30).Should(Equal("ACTIVE"))
+		return out.Nodegroup.Status
+	}, timeoutDuration, time.Second*30).Should(Equal(ekstypes.NodegroupStatusActive))
 
 	return newVPC
 }
@@ -0,0 +1,88 @@
+// Package accesspolicy lists the access policies managed by EKS, which grant Kubernetes permissions to the IAM
+// principals of access entries
+package accesspolicy
+
+import (
+	"sort"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Access scope types an access policy can be associated with
+const (
+	// ScopeCluster grants the permissions of the policy in all namespaces
+	ScopeCluster = "cluster"
+	// ScopeNamespace grants the permissions of the policy in the listed namespaces only
+	ScopeNamespace = "namespace"
+)
+
+// Policy is an access policy managed by EKS
+type Policy struct {
+	Name string `json:"name"`
+	ARN  string `json:"arn"`
+	// Scopes are the access scope types the policy can be associated with
+	Scopes []string `json:"scopes"`
+	// Description summarises the permissions the policy grants
+	Description string `json:"description"`
+}
+
+type policy struct {
+	name        string
+	scopes      []string
+	description string
+}
+
+var managedPolicies = []policy{
+	{
+		name:        "AmazonEKSClusterAdminPolicy",
+		scopes:      []string{ScopeCluster},
+		description: "full access to all resources, equivalent to the cluster-admin ClusterRole",
+	},
+	{
+		name:        "AmazonEKSAdminPolicy",
+		scopes:      []string{ScopeCluster, ScopeNamespace},
+		description: "read and write access to most resources, including roles and role bindings in namespaces, equivalent to the admin ClusterRole",
+	},
+	{
+		name:        "AmazonEKSEditPolicy",
+		scopes:      []string{ScopeCluster, ScopeNamespace},
+		description: "read and write access to most resources in namespaces, excluding roles and role bindings, equivalent to the edit ClusterRole",
+	},
+	{
+		name:        "AmazonEKSViewPolicy",
+		scopes:      []string{ScopeCluster, ScopeNamespace},
+		description: "read-only access to most resources in namespaces, excluding secrets, equivalent to the view ClusterRole",
+	},
+	{
+		name:        "AmazonEKSAdminViewPolicy",
+		scopes:      []string{ScopeCluster},
+		description: "read-only access to all resources, including secrets",
+	},
+}
+
+// List returns the access policies managed by EKS in a partition, sorted by name
+func List(partition string) []Policy {
+	var policies []Policy
+	for _, p := range managedPolicies {
+		policies = append(policies, Policy{
+			Name:        p.name,
+			ARN:         api.EKSAccessPolicyARN(partition, p.name),
+			Scopes:      append([]string(nil), p.scopes...),
+			Description: p.description,
+		})
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+	return policies
+}
+
+// Find returns the access policy managed by EKS with name, or false if there is none
+func Find(partition, name string) (Policy, bool) {
+	for _, p := range List(partition) {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Policy{}, false
+}
@@ -0,0 +1,11 @@
+package accesspolicy_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestAccessPolicy(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
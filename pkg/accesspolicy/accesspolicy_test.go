@@ -0,0 +1,38 @@
+package accesspolicy_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/accesspolicy"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Access policies", func() {
+	It("lists the policies managed by EKS sorted by name", func() {
+		policies := accesspolicy.List(api.PartitionAWS)
+		var names []string
+		for _, p := range policies {
+			names = append(names, p.Name)
+		}
+		Expect(names).To(Equal([]string{
+			"AmazonEKSAdminPolicy",
+			"AmazonEKSAdminViewPolicy",
+			"AmazonEKSClusterAdminPolicy",
+			"AmazonEKSEditPolicy",
+			"AmazonEKSViewPolicy",
+		}))
+	})
+
+	It("returns the ARNs of the partition", func() {
+		policy, ok := accesspolicy.Find(api.PartitionChina, "AmazonEKSClusterAdminPolicy")
+		Expect(ok).To(BeTrue())
+		Expect(policy.ARN).To(Equal("arn:aws-cn:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"))
+		Expect(policy.Scopes).To(Equal([]string{accesspolicy.ScopeCluster}))
+	})
+
+	It("doesn't find unknown policies", func() {
+		_, ok := accesspolicy.Find(api.PartitionAWS, "AmazonEKSUnknownPolicy")
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,11 @@
+package accessentries_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestAccessEntries(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,85 @@
+package accessentries
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// clusterAdminPolicyName is the name of the access policy that grants admin permissions on the whole cluster,
+// its ARN depends on the partition
+const clusterAdminPolicyName = "cluster-access-policy/AmazonEKSClusterAdminPolicy"
+
+// IsClusterAdmin returns true if the access entry is associated with AmazonEKSClusterAdminPolicy for the whole cluster
+func (s Summary) IsClusterAdmin() bool {
+	for _, p := range s.AccessPolicies {
+		if strings.HasSuffix(p.PolicyARN, ":"+clusterAdminPolicyName) && p.AccessScope.Type == string(ekstypes.AccessScopeTypeCluster) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClusterAdmins returns the principals whose access entries grant admin permissions on the whole cluster
+func (g *Getter) ClusterAdmins(ctx context.Context) ([]string, error) {
+	summaries, err := g.Get(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var admins []string
+	for _, s := range summaries {
+		if s.IsClusterAdmin() {
+			admins = append(admins, s.PrincipalARN)
+		}
+	}
+	return admins, nil
+}
+
+// ClusterAdminPolicyARN returns the ARN of AmazonEKSClusterAdminPolicy in partition
+func ClusterAdminPolicyARN(partition string) string {
+	return fmt.Sprintf("arn:%s:eks::aws:%s", partition, clusterAdminPolicyName)
+}
+
+// ClusterAdminCreator creates access entries that grant admin permissions on the whole cluster
+type ClusterAdminCreator struct {
+	clusterName string
+	partition   string
+	api         awsapi.EKS
+}
+
+// NewClusterAdminCreator creates a new ClusterAdminCreator
+func NewClusterAdminCreator(clusterName, partition string, api awsapi.EKS) *ClusterAdminCreator {
+	return &ClusterAdminCreator{
+		clusterName: clusterName,
+		partition:   partition,
+		api:         api,
+	}
+}
+
+// Create creates an access entry for principalARN and associates it with AmazonEKSClusterAdminPolicy for the whole cluster
+func (c *ClusterAdminCreator) Create(ctx context.Context, principalARN string) error {
+	if _, err := c.api.CreateAccessEntry(ctx, &eks.CreateAccessEntryInput{
+		ClusterName:  aws.String(c.clusterName),
+		PrincipalArn: aws.String(principalARN),
+		Type:         aws.String("STANDARD"),
+	}); err != nil {
+		return fmt.Errorf("creating access entry for %q: %w", principalARN, err)
+	}
+	if _, err := c.api.AssociateAccessPolicy(ctx, &eks.AssociateAccessPolicyInput{
+		ClusterName:  aws.String(c.clusterName),
+		PrincipalArn: aws.String(principalARN),
+		PolicyArn:    aws.String(ClusterAdminPolicyARN(c.partition)),
+		AccessScope:  &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeCluster},
+	}); err != nil {
+		return fmt.Errorf("associating %s with access entry for %q: %w", clusterAdminPolicyName, principalARN, err)
+	}
+	logger.Info("created access entry for %q with admin permissions on cluster %q", principalARN, c.clusterName)
+	return nil
+}
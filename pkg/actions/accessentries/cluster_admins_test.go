@@ -0,0 +1,52 @@
+package accessentries_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/accessentries"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Create cluster admin access entries", func() {
+	const adminARN = "arn:aws-cn:iam::123456789012:role/admin"
+
+	var p *mockprovider.MockProvider
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+	})
+
+	It("creates an access entry associated with AmazonEKSClusterAdminPolicy for the whole cluster", func() {
+		p.MockEKS().On("CreateAccessEntry", mock.Anything, &eks.CreateAccessEntryInput{
+			ClusterName:  aws.String("cluster"),
+			PrincipalArn: aws.String(adminARN),
+			Type:         aws.String("STANDARD"),
+		}).Return(&eks.CreateAccessEntryOutput{}, nil).Once()
+		p.MockEKS().On("AssociateAccessPolicy", mock.Anything, &eks.AssociateAccessPolicyInput{
+			ClusterName:  aws.String("cluster"),
+			PrincipalArn: aws.String(adminARN),
+			PolicyArn:    aws.String("arn:aws-cn:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"),
+			AccessScope:  &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeCluster},
+		}).Return(&eks.AssociateAccessPolicyOutput{}, nil).Once()
+
+		err := accessentries.NewClusterAdminCreator("cluster", "aws-cn", p.MockEKS()).Create(context.Background(), adminARN)
+		Expect(err).NotTo(HaveOccurred())
+		p.MockEKS().AssertExpectations(GinkgoT())
+	})
+
+	It("doesn't associate the policy if the access entry can't be created", func() {
+		p.MockEKS().On("CreateAccessEntry", mock.Anything, mock.Anything).Return(nil, errors.New("ResourceInUseException")).Once()
+
+		err := accessentries.NewClusterAdminCreator("cluster", "aws", p.MockEKS()).Create(context.Background(), adminARN)
+		Expect(err).To(MatchError(ContainSubstring(`creating access entry for "arn:aws-cn:iam::123456789012:role/admin": ResourceInUseException`)))
+		p.MockEKS().AssertNotCalled(GinkgoT(), "AssociateAccessPolicy", mock.Anything, mock.Anything)
+	})
+})
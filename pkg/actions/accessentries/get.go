@@ -0,0 +1,132 @@
+// Package accessentries reads and creates the access entries of a cluster, which grant IAM principals access to it
+package accessentries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Summary holds the known info about an access entry and the access policies associated with it
+type Summary struct {
+	PrincipalARN     string         `json:"principalARN"`
+	Type             string         `json:"type"`
+	Username         string         `json:"username,omitempty"`
+	KubernetesGroups []string       `json:"kubernetesGroups,omitempty"`
+	AccessPolicies   []AccessPolicy `json:"accessPolicies,omitempty"`
+	CreatedAt        *time.Time     `json:"createdAt,omitempty"`
+}
+
+// AccessPolicy is an access policy associated with an access entry
+type AccessPolicy struct {
+	PolicyARN   string             `json:"policyARN"`
+	AccessScope AccessScopeSummary `json:"accessScope"`
+}
+
+// AccessScopeSummary is the scope an access policy is associated with
+type AccessScopeSummary struct {
+	Type       string   `json:"type"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// Getter reads the access entries of a cluster
+type Getter struct {
+	clusterName string
+	api         awsapi.EKS
+}
+
+// NewGetter creates a new Getter
+func NewGetter(clusterName string, api awsapi.EKS) *Getter {
+	return &Getter{
+		clusterName: clusterName,
+		api:         api,
+	}
+}
+
+// Get returns the access entries of the cluster with their associated access policies, or only the access entry of
+// principalARN if it is set
+func (g *Getter) Get(ctx context.Context, principalARN string) ([]Summary, error) {
+	principalARNs := []string{principalARN}
+	if principalARN == "" {
+		var err error
+		if principalARNs, err = g.listPrincipalARNs(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	summaries := []Summary{}
+	for _, arn := range principalARNs {
+		summary, err := g.getAccessEntry(ctx, arn)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+func (g *Getter) listPrincipalARNs(ctx context.Context) ([]string, error) {
+	var principalARNs []string
+	input := &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(g.clusterName),
+	}
+	for {
+		output, err := g.api.ListAccessEntries(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing access entries of cluster %q: %w", g.clusterName, err)
+		}
+		principalARNs = append(principalARNs, output.AccessEntries...)
+		if output.NextToken == nil {
+			return principalARNs, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func (g *Getter) getAccessEntry(ctx context.Context, principalARN string) (Summary, error) {
+	output, err := g.api.DescribeAccessEntry(ctx, &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(g.clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+	if err != nil {
+		return Summary{}, fmt.Errorf("describing access entry of %q: %w", principalARN, err)
+	}
+	entry := output.AccessEntry
+	summary := Summary{
+		PrincipalARN:     aws.ToString(entry.PrincipalArn),
+		Type:             aws.ToString(entry.Type),
+		Username:         aws.ToString(entry.Username),
+		KubernetesGroups: entry.KubernetesGroups,
+		CreatedAt:        entry.CreatedAt,
+	}
+
+	input := &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(g.clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+	for {
+		output, err := g.api.ListAssociatedAccessPolicies(ctx, input)
+		if err != nil {
+			return Summary{}, fmt.Errorf("listing access policies associated with %q: %w", principalARN, err)
+		}
+		for _, p := range output.AssociatedAccessPolicies {
+			policy := AccessPolicy{PolicyARN: aws.ToString(p.PolicyArn)}
+			if p.AccessScope != nil {
+				policy.AccessScope = AccessScopeSummary{
+					Type:       string(p.AccessScope.Type),
+					Namespaces: p.AccessScope.Namespaces,
+				}
+			}
+			summary.AccessPolicies = append(summary.AccessPolicies, policy)
+		}
+		if output.NextToken == nil {
+			return summary, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
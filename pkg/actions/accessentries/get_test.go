@@ -0,0 +1,137 @@
+package accessentries_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/accessentries"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Get access entries", func() {
+	const (
+		adminARN = "arn:aws:iam::123456789012:role/admin"
+		devARN   = "arn:aws:iam::123456789012:role/dev"
+	)
+
+	var getter *accessentries.Getter
+
+	BeforeEach(func() {
+		entries := map[string]*ekstypes.AccessEntry{
+			adminARN: {
+				PrincipalArn: aws.String(adminARN),
+				Type:         aws.String("STANDARD"),
+				Username:     aws.String(adminARN),
+			},
+			devARN: {
+				PrincipalArn:     aws.String(devARN),
+				Type:             aws.String("STANDARD"),
+				Username:         aws.String("dev"),
+				KubernetesGroups: []string{"developers"},
+			},
+		}
+		policies := map[string][]ekstypes.AssociatedAccessPolicy{
+			adminARN: {
+				{
+					PolicyArn:   aws.String("arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"),
+					AccessScope: &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeCluster},
+				},
+			},
+			devARN: {
+				{
+					PolicyArn: aws.String("arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy"),
+					AccessScope: &ekstypes.AccessScope{
+						Type:       ekstypes.AccessScopeTypeNamespace,
+						Namespaces: []string{"dev"},
+					},
+				},
+			},
+		}
+
+		p := mockprovider.NewMockProvider()
+		p.MockEKS().On("ListAccessEntries", mock.Anything, mock.MatchedBy(func(input *eks.ListAccessEntriesInput) bool {
+			return input.NextToken == nil
+		})).Return(&eks.ListAccessEntriesOutput{
+			AccessEntries: []string{adminARN},
+			NextToken:     aws.String("next"),
+		}, nil)
+		p.MockEKS().On("ListAccessEntries", mock.Anything, mock.MatchedBy(func(input *eks.ListAccessEntriesInput) bool {
+			return aws.ToString(input.NextToken) == "next"
+		})).Return(&eks.ListAccessEntriesOutput{
+			AccessEntries: []string{devARN},
+		}, nil)
+		p.MockEKS().On("DescribeAccessEntry", mock.Anything, mock.Anything).Return(func(_ context.Context, input *eks.DescribeAccessEntryInput, _ ...func(*eks.Options)) *eks.DescribeAccessEntryOutput {
+			entry, ok := entries[aws.ToString(input.PrincipalArn)]
+			if !ok {
+				return nil
+			}
+			return &eks.DescribeAccessEntryOutput{AccessEntry: entry}
+		}, func(_ context.Context, input *eks.DescribeAccessEntryInput, _ ...func(*eks.Options)) error {
+			if _, ok := entries[aws.ToString(input.PrincipalArn)]; !ok {
+				return errors.New("ResourceNotFoundException")
+			}
+			return nil
+		})
+		p.MockEKS().On("ListAssociatedAccessPolicies", mock.Anything, mock.Anything).Return(func(_ context.Context, input *eks.ListAssociatedAccessPoliciesInput, _ ...func(*eks.Options)) *eks.ListAssociatedAccessPoliciesOutput {
+			return &eks.ListAssociatedAccessPoliciesOutput{
+				AssociatedAccessPolicies: policies[aws.ToString(input.PrincipalArn)],
+			}
+		}, nil)
+		getter = accessentries.NewGetter("my-cluster", p.MockEKS())
+	})
+
+	It("gets all access entries with their access policies", func() {
+		summaries, err := getter.Get(context.Background(), "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summaries).To(Equal([]accessentries.Summary{
+			{
+				PrincipalARN: adminARN,
+				Type:         "STANDARD",
+				Username:     adminARN,
+				AccessPolicies: []accessentries.AccessPolicy{
+					{
+						PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy",
+						AccessScope: accessentries.AccessScopeSummary{Type: "cluster"},
+					},
+				},
+			},
+			{
+				PrincipalARN:     devARN,
+				Type:             "STANDARD",
+				Username:         "dev",
+				KubernetesGroups: []string{"developers"},
+				AccessPolicies: []accessentries.AccessPolicy{
+					{
+						PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy",
+						AccessScope: accessentries.AccessScopeSummary{Type: "namespace", Namespaces: []string{"dev"}},
+					},
+				},
+			},
+		}))
+	})
+
+	It("gets the access entry of a principal", func() {
+		summaries, err := getter.Get(context.Background(), devARN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summaries).To(HaveLen(1))
+		Expect(summaries[0].PrincipalARN).To(Equal(devARN))
+	})
+
+	It("fails for principals without an access entry", func() {
+		_, err := getter.Get(context.Background(), "arn:aws:iam::123456789012:role/unknown")
+		Expect(err).To(MatchError(ContainSubstring(`describing access entry of "arn:aws:iam::123456789012:role/unknown"`)))
+	})
+
+	It("gets the principals with admin permissions on the whole cluster", func() {
+		admins, err := getter.ClusterAdmins(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(admins).To(Equal([]string{adminARN}))
+	})
+})
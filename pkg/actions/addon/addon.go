@@ -7,12 +7,13 @@ import (
 	"strings"
 	"time"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/go-version"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
 	kubeclient "k8s.io/client-go/kubernetes"
 
@@ -25,7 +26,7 @@ import (
 
 type Manager struct {
 	clusterConfig *api.ClusterConfig
-	eksAPI        eksiface.EKSAPI
+	eksAPI        awsapi.EKS
 	withOIDC      bool
 	oidcManager   *iamoidc.OpenIDConnectManager
 	stackManager  manager.StackManager
@@ -33,7 +34,7 @@ type Manager struct {
 	timeout       time.Duration
 }
 
-func New(clusterConfig *api.ClusterConfig, eksAPI eksiface.EKSAPI, stackManager manager.StackManager, withOIDC bool, oidcManager *iamoidc.OpenIDConnectManager, clientSet kubeclient.Interface, timeout time.Duration) (*Manager, error) {
+func New(clusterConfig *api.ClusterConfig, eksAPI awsapi.EKS, stackManager manager.StackManager, withOIDC bool, oidcManager *iamoidc.OpenIDConnectManager, clientSet kubeclient.Interface, timeout time.Duration) (*Manager, error) {
 	return &Manager{
 		clusterConfig: clusterConfig,
 		eksAPI:        eksAPI,
@@ -45,18 +46,18 @@ func New(clusterConfig *api.ClusterConfig, eksAPI eksiface.EKSAPI, stackManager
 	}, nil
 }
 
-func (a *Manager) waitForAddonToBeActive(addon *api.Addon) error {
+func (a *Manager) waitForAddonToBeActive(ctx context.Context, addon *api.Addon) error {
 	var out *awseks.DescribeAddonOutput
 	operation := func() (bool, error) {
 		var err error
-		out, err = a.eksAPI.DescribeAddon(&awseks.DescribeAddonInput{
+		out, err = a.eksAPI.DescribeAddon(ctx, &awseks.DescribeAddonInput{
 			ClusterName: &a.clusterConfig.Metadata.Name,
 			AddonName:   &addon.Name,
 		})
 		if err != nil {
 			return false, err
 		}
-		if *out.Addon.Status == awseks.AddonStatusActive {
+		if out.Addon.Status == ekstypes.AddonStatusActive {
 			return true, nil
 		}
 		return false, nil
@@ -72,7 +73,7 @@ func (a *Manager) waitForAddonToBeActive(addon *api.Addon) error {
 	err := w.WaitWithTimeout(a.timeout)
 	if err != nil {
 		if err == context.DeadlineExceeded {
-			return errors.Errorf("timed out waiting for addon %q to become active, status: %q", addon.Name, *out.Addon.Status)
+			return errors.Errorf("timed out waiting for addon %q to become active, status: %q", addon.Name, out.Addon.Status)
 		}
 		return err
 	}
@@ -80,8 +81,8 @@ func (a *Manager) waitForAddonToBeActive(addon *api.Addon) error {
 	return nil
 }
 
-func (a *Manager) getLatestMatchingVersion(addon *api.Addon) (string, error) {
-	addonInfos, err := a.describeVersions(addon)
+func (a *Manager) getLatestMatchingVersion(ctx context.Context, addon *api.Addon) (string, error) {
+	addonInfos, err := a.describeVersions(ctx, addon)
 	if err != nil {
 		return "", err
 	}
@@ -10,9 +10,8 @@ import (
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/aws/aws-sdk-go/aws"
-
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -31,7 +30,7 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, wait bool) error
 	version := addon.Version
 	if version != "" {
 		var err error
-		version, err = a.getLatestMatchingVersion(addon)
+		version, err = a.getLatestMatchingVersion(ctx, addon)
 		if err != nil {
 			return fmt.Errorf("failed to fetch version %s for addon %s: %w", version, addon.Name, err)
 		}
@@ -44,7 +43,7 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, wait bool) error
 	}
 
 	if addon.Force {
-		createAddonInput.ResolveConflicts = aws.String("overwrite")
+		createAddonInput.ResolveConflicts = ekstypes.ResolveConflictsOverwrite
 		logger.Debug("setting resolve conflicts to overwrite")
 	} else {
 		addonName := strings.ToLower(addon.Name)
@@ -57,7 +56,10 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, wait bool) error
 	namespace, serviceAccount := a.getKnownServiceAccountLocation(addon)
 
 	if len(addon.Tags) > 0 {
-		createAddonInput.Tags = aws.StringMap(addon.Tags)
+		createAddonInput.Tags = addon.Tags
+	}
+	if addon.ConfigurationValues != "" {
+		createAddonInput.ConfigurationValues = &addon.ConfigurationValues
 	}
 	if a.withOIDC {
 		if addon.ServiceAccountRoleARN != "" {
@@ -103,7 +105,7 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, wait bool) error
 		}
 	}
 
-	if addon.CanonicalName() == vpcCNIName {
+	if addon.CanonicalName() == vpcCNIName && a.clientSet != nil {
 		logger.Debug("patching AWS node")
 		err := a.patchAWSNodeSA()
 		if err != nil {
@@ -117,17 +119,17 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, wait bool) error
 	}
 
 	logger.Info("creating addon")
-	output, err := a.eksAPI.CreateAddon(createAddonInput)
+	output, err := a.eksAPI.CreateAddon(ctx, createAddonInput)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create addon %q", addon.Name)
 	}
 
 	if output != nil {
-		logger.Debug("EKS Create Addon output: %s", output.String())
+		logger.Debug("EKS Create Addon output: %+v", output.Addon)
 	}
 
 	if wait {
-		return a.waitForAddonToBeActive(addon)
+		return a.waitForAddonToBeActive(ctx, addon)
 	}
 	logger.Info("successfully created addon")
 	return nil
@@ -192,13 +194,14 @@ func (a *Manager) patchAWSNodeDaemonSet() error {
 }
 
 func (a *Manager) getRecommendedPolicies(addon *api.Addon) (api.InlineDocument, []string, *api.WellKnownPolicies) {
+	partition := api.Partition(a.clusterConfig.Metadata.Region)
 	// API isn't case sensitive
 	switch addon.CanonicalName() {
 	case vpcCNIName:
 		if a.clusterConfig.IPv6Enabled() {
-			return makeIPv6VPCCNIPolicyDocument(), nil, nil
+			return makeIPv6VPCCNIPolicyDocument(partition), nil, nil
 		}
-		return nil, []string{fmt.Sprintf("arn:%s:iam::aws:policy/%s", api.Partition(a.clusterConfig.Metadata.Region), api.IAMPolicyAmazonEKSCNIPolicy)}, nil
+		return nil, []string{api.AWSManagedPolicyARN(partition, api.IAMPolicyAmazonEKSCNIPolicy)}, nil
 	case ebsCSIDriverName:
 		return nil, nil, &api.WellKnownPolicies{
 			EBSCSIController: true,
@@ -267,7 +270,7 @@ func (a *Manager) createStack(ctx context.Context, resourceSet builder.ResourceS
 	return <-errChan
 }
 
-func makeIPv6VPCCNIPolicyDocument() map[string]interface{} {
+func makeIPv6VPCCNIPolicyDocument(partition string) map[string]interface{} {
 	return map[string]interface{}{
 		"Version": "2012-10-17",
 		"Statement": []map[string]interface{}{
@@ -287,7 +290,7 @@ func makeIPv6VPCCNIPolicyDocument() map[string]interface{} {
 				"Action": []string{
 					"ec2:CreateTags",
 				},
-				"Resource": "arn:aws:ec2:*:*:network-interface/*",
+				"Resource": api.ARN(partition, "ec2", "*", "*", "network-interface/*"),
 			},
 		},
 	}
@@ -11,8 +11,9 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -82,25 +83,25 @@ var _ = Describe("Create", func() {
 		Expect(err).NotTo(HaveOccurred())
 		oidc.ProviderARN = "arn:aws:iam::456123987123:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E"
 
-		mockProvider.MockEKS().On("CreateAddon", mock.Anything).Run(func(args mock.Arguments) {
-			Expect(args).To(HaveLen(1))
-			Expect(args[0]).To(BeAssignableToTypeOf(&awseks.CreateAddonInput{}))
-			createAddonInput = args[0].(*awseks.CreateAddonInput)
+		mockProvider.MockEKS().On("CreateAddon", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			Expect(args).To(HaveLen(2))
+			Expect(args[1]).To(BeAssignableToTypeOf(&awseks.CreateAddonInput{}))
+			createAddonInput = args[1].(*awseks.CreateAddonInput)
 		}).Return(nil, returnedErr)
 
 		manager, err = addon.New(clusterConfig, mockProvider.EKS(), fakeStackManager, withOIDC, oidc, rawClient.ClientSet(), 5*time.Minute)
 		Expect(err).NotTo(HaveOccurred())
 		manager.SetTimeout(time.Second)
 
-		mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-			Expect(args).To(HaveLen(1))
-			Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+		mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			Expect(args).To(HaveLen(2))
+			Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
 		}).Return(&awseks.DescribeAddonVersionsOutput{
-			Addons: []*awseks.AddonInfo{
+			Addons: []ekstypes.AddonInfo{
 				{
 					AddonName: aws.String("my-addon"),
 					Type:      aws.String("type"),
-					AddonVersions: []*awseks.AddonVersionInfo{
+					AddonVersions: []ekstypes.AddonVersionInfo{
 						{
 							AddonVersion: aws.String("v1.0.0-eksbuild.1"),
 						},
@@ -231,15 +232,15 @@ var _ = Describe("Create", func() {
 			BeforeEach(func() {
 				withOIDC = false
 
-				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
 				}).Return(&awseks.DescribeAddonVersionsOutput{
-					Addons: []*awseks.AddonInfo{
+					Addons: []ekstypes.AddonInfo{
 						{
 							AddonName: aws.String("my-addon"),
 							Type:      aws.String("type"),
-							AddonVersions: []*awseks.AddonVersionInfo{
+							AddonVersions: []ekstypes.AddonVersionInfo{
 								{
 									AddonVersion: aws.String("v1.7.5-eksbuild.1"),
 								},
@@ -270,15 +271,15 @@ var _ = Describe("Create", func() {
 			BeforeEach(func() {
 				withOIDC = false
 
-				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
 				}).Return(&awseks.DescribeAddonVersionsOutput{
-					Addons: []*awseks.AddonInfo{
+					Addons: []ekstypes.AddonInfo{
 						{
 							AddonName:     aws.String("my-addon"),
 							Type:          aws.String("type"),
-							AddonVersions: []*awseks.AddonVersionInfo{},
+							AddonVersions: []ekstypes.AddonVersionInfo{},
 						},
 					},
 				}, nil)
@@ -313,7 +314,7 @@ var _ = Describe("Create", func() {
 			Expect(*createAddonInput.ClusterName).To(Equal("my-cluster"))
 			Expect(*createAddonInput.AddonName).To(Equal("my-addon"))
 			Expect(*createAddonInput.AddonVersion).To(Equal("v1.0.0-eksbuild.1"))
-			Expect(*createAddonInput.ResolveConflicts).To(Equal("overwrite"))
+			Expect(createAddonInput.ResolveConflicts).To(Equal(ekstypes.ResolveConflictsOverwrite))
 			Expect(createAddonInput.ServiceAccountRoleArn).To(BeNil())
 		})
 	})
@@ -322,11 +323,11 @@ var _ = Describe("Create", func() {
 		When("the addon creation succeeds", func() {
 			BeforeEach(func() {
 				withOIDC = false
-				mockProvider.MockEKS().On("DescribeAddon", mock.Anything).
+				mockProvider.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).
 					Return(&awseks.DescribeAddonOutput{
-						Addon: &awseks.Addon{
+						Addon: &ekstypes.Addon{
 							AddonName: aws.String("my-addon"),
-							Status:    aws.String("ACTIVE"),
+							Status:    ekstypes.AddonStatusActive,
 						},
 					}, nil)
 			})
@@ -348,11 +349,11 @@ var _ = Describe("Create", func() {
 		When("the addon creation fails", func() {
 			BeforeEach(func() {
 				withOIDC = false
-				mockProvider.MockEKS().On("DescribeAddon", mock.Anything).
+				mockProvider.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).
 					Return(&awseks.DescribeAddonOutput{
-						Addon: &awseks.Addon{
+						Addon: &ekstypes.Addon{
 							AddonName: aws.String("my-addon"),
-							Status:    aws.String("DEGRADED"),
+							Status:    ekstypes.AddonStatusDegraded,
 						},
 					}, nil)
 			})
@@ -448,6 +449,7 @@ var _ = Describe("Create", func() {
 						output, err := resourceSet.RenderJSON()
 						Expect(err).NotTo(HaveOccurred())
 						Expect(string(output)).To(ContainSubstring("AssignIpv6Addresses"))
+						Expect(string(output)).To(ContainSubstring("arn:aws:ec2:*:*:network-interface/*"))
 						Expect(*createAddonInput.ClusterName).To(Equal("my-cluster"))
 						Expect(*createAddonInput.AddonName).To(Equal("vpc-cni"))
 						Expect(*createAddonInput.AddonVersion).To(Equal("v1.0.0-eksbuild.1"))
@@ -581,8 +583,20 @@ var _ = Describe("Create", func() {
 			Expect(*createAddonInput.ClusterName).To(Equal("my-cluster"))
 			Expect(*createAddonInput.AddonName).To(Equal("my-addon"))
 			Expect(*createAddonInput.AddonVersion).To(Equal("v1.0.0-eksbuild.1"))
-			Expect(*createAddonInput.Tags["foo"]).To(Equal("bar"))
-			Expect(*createAddonInput.Tags["fox"]).To(Equal("brown"))
+			Expect(createAddonInput.Tags["foo"]).To(Equal("bar"))
+			Expect(createAddonInput.Tags["fox"]).To(Equal("brown"))
+		})
+	})
+
+	When("configuration values are set", func() {
+		It("passes the configuration values to the addon", func() {
+			err := manager.Create(context.TODO(), &api.Addon{
+				Name:                "my-addon",
+				Version:             "v1.0.0-eksbuild.1",
+				ConfigurationValues: `{"env":{"ENABLE_PREFIX_DELEGATION":"true"}}`,
+			}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*createAddonInput.ConfigurationValues).To(Equal(`{"env":{"ENABLE_PREFIX_DELEGATION":"true"}}`))
 		})
 	})
 })
@@ -5,25 +5,26 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 )
 
-func (a *Manager) DeleteWithPreserve(addon *api.Addon) error {
+func (a *Manager) DeleteWithPreserve(ctx context.Context, addon *api.Addon) error {
 	logger.Info("deleting addon %q and preserving its resources", addon.Name)
-	_, err := a.eksAPI.DeleteAddon(&eks.DeleteAddonInput{
+	_, err := a.eksAPI.DeleteAddon(ctx, &eks.DeleteAddonInput{
 		AddonName:   &addon.Name,
 		ClusterName: &a.clusterConfig.Metadata.Name,
-		Preserve:    aws.Bool(true),
+		Preserve:    true,
 	})
 
 	if err != nil {
-		if awsError, ok := err.(awserr.Error); ok && awsError.Code() == eks.ErrCodeResourceNotFoundException {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
 			logger.Info("addon %q does not exist", addon.Name)
 		} else {
 			return fmt.Errorf("failed to delete addon %q: %v", addon.Name, err)
@@ -36,13 +37,14 @@ func (a *Manager) Delete(ctx context.Context, addon *api.Addon) error {
 	addonExists := true
 	logger.Debug("addon: %v", addon)
 	logger.Info("deleting addon: %s", addon.Name)
-	_, err := a.eksAPI.DeleteAddon(&eks.DeleteAddonInput{
+	_, err := a.eksAPI.DeleteAddon(ctx, &eks.DeleteAddonInput{
 		AddonName:   &addon.Name,
 		ClusterName: &a.clusterConfig.Metadata.Name,
 	})
 
 	if err != nil {
-		if awsError, ok := err.(awserr.Error); ok && awsError.Code() == eks.ErrCodeResourceNotFoundException {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
 			logger.Info("addon %q does not exist", addon.Name)
 			addonExists = false
 		} else {
@@ -5,14 +5,15 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/smithy-go"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -43,7 +44,7 @@ var _ = Describe("Delete", func() {
 		})
 
 		It("deletes all associated stacks and addons", func() {
-			mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+			mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 				AddonName:   aws.String("my-addon"),
 				ClusterName: aws.String("my-cluster"),
 			}).Return(&awseks.DeleteAddonOutput{}, nil)
@@ -62,7 +63,7 @@ var _ = Describe("Delete", func() {
 
 		When("delete addon fails", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					AddonName:   aws.String("my-addon"),
 					ClusterName: aws.String("my-cluster"),
 				}).Return(&awseks.DeleteAddonOutput{}, fmt.Errorf("foo"))
@@ -77,7 +78,7 @@ var _ = Describe("Delete", func() {
 
 		When("list stacks fails", func() {
 			It("only deletes the addon", func() {
-				mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					AddonName:   aws.String("my-addon"),
 					ClusterName: aws.String("my-cluster"),
 				}).Return(&awseks.DeleteAddonOutput{}, nil)
@@ -94,7 +95,7 @@ var _ = Describe("Delete", func() {
 
 		When("delete stack fails", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					AddonName:   aws.String("my-addon"),
 					ClusterName: aws.String("my-cluster"),
 				}).Return(&awseks.DeleteAddonOutput{}, nil)
@@ -117,7 +118,7 @@ var _ = Describe("Delete", func() {
 
 		When("no stack exists", func() {
 			It("only deletes the addon", func() {
-				mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					AddonName:   aws.String("my-addon"),
 					ClusterName: aws.String("my-cluster"),
 				}).Return(&awseks.DeleteAddonOutput{}, nil)
@@ -138,10 +139,10 @@ var _ = Describe("Delete", func() {
 
 		When("when no addon exists, but the stack does", func() {
 			It("only deletes the stack", func() {
-				mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					AddonName:   aws.String("my-addon"),
 					ClusterName: aws.String("my-cluster"),
-				}).Return(&awseks.DeleteAddonOutput{}, awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil))
+				}).Return(&awseks.DeleteAddonOutput{}, &ekstypes.ResourceNotFoundException{})
 
 				fakeStackManager.DescribeStackReturns(&types.Stack{StackName: aws.String("eksctl-my-cluster-addon-my-addon")}, nil)
 
@@ -159,10 +160,10 @@ var _ = Describe("Delete", func() {
 
 		When("when no addon exists or stack exists", func() {
 			It("errors", func() {
-				mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					AddonName:   aws.String("my-addon"),
 					ClusterName: aws.String("my-cluster"),
-				}).Return(&awseks.DeleteAddonOutput{}, awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil))
+				}).Return(&awseks.DeleteAddonOutput{}, &ekstypes.ResourceNotFoundException{})
 
 				fakeStackManager.DescribeStackReturns(nil, errors.Wrap(&smithy.OperationError{
 					Err: fmt.Errorf("ValidationError"),
@@ -192,13 +193,13 @@ var _ = Describe("Delete", func() {
 		})
 
 		It("deletes the addon but preserves the resources", func() {
-			mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+			mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 				AddonName:   aws.String("my-addon"),
 				ClusterName: aws.String("my-cluster"),
-				Preserve:    aws.Bool(true),
+				Preserve:    true,
 			}).Return(&awseks.DeleteAddonOutput{}, nil)
 
-			err := manager.DeleteWithPreserve(&api.Addon{
+			err := manager.DeleteWithPreserve(context.TODO(), &api.Addon{
 				Name: "my-addon",
 			})
 			Expect(err).NotTo(HaveOccurred())
@@ -206,13 +207,13 @@ var _ = Describe("Delete", func() {
 
 		When("delete addon fails", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				mockProvider.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					AddonName:   aws.String("my-addon"),
 					ClusterName: aws.String("my-cluster"),
-					Preserve:    aws.Bool(true),
+					Preserve:    true,
 				}).Return(&awseks.DeleteAddonOutput{}, fmt.Errorf("foo"))
 
-				err := manager.DeleteWithPreserve(&api.Addon{
+				err := manager.DeleteWithPreserve(context.TODO(), &api.Addon{
 					Name: "my-addon",
 				})
 
@@ -1,34 +1,37 @@
 package addon
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/kris-nova/logger"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 )
 
-func (a *Manager) DescribeVersions(addon *api.Addon) (string, error) {
+func (a *Manager) DescribeVersions(ctx context.Context, addon *api.Addon) (string, error) {
 	logger.Debug("addon: %v", addon)
 	logger.Info("describing addon versions for addon: %s", addon.Name)
-	versions, err := a.describeVersions(addon)
+	versions, err := a.describeVersions(ctx, addon)
 	if err != nil {
 		return "", err
 	}
-	return versions.String(), nil
+	return summariseVersions(versions)
 }
 
-func (a *Manager) DescribeAllVersions() (string, error) {
+func (a *Manager) DescribeAllVersions(ctx context.Context) (string, error) {
 	logger.Info("describing all addon versions")
-	versions, err := a.describeVersions(&api.Addon{})
+	versions, err := a.describeVersions(ctx, &api.Addon{})
 	if err != nil {
 		return "", err
 	}
-	return versions.String(), nil
+	return summariseVersions(versions)
 }
 
-func (a *Manager) describeVersions(addon *api.Addon) (*eks.DescribeAddonVersionsOutput, error) {
+func (a *Manager) describeVersions(ctx context.Context, addon *api.Addon) (*eks.DescribeAddonVersionsOutput, error) {
 	input := &eks.DescribeAddonVersionsInput{
 		KubernetesVersion: &a.clusterConfig.Metadata.Version,
 	}
@@ -37,10 +40,23 @@ func (a *Manager) describeVersions(addon *api.Addon) (*eks.DescribeAddonVersions
 		input.AddonName = &addon.Name
 	}
 
-	output, err := a.eksAPI.DescribeAddonVersions(input)
+	output, err := a.eksAPI.DescribeAddonVersions(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("failed to describe addon versions: %v", err)
 	}
 
 	return output, nil
 }
+
+// summariseVersions renders the addon versions as JSON, leaving out the response metadata
+func summariseVersions(versions *eks.DescribeAddonVersionsOutput) (string, error) {
+	summary, err := json.MarshalIndent(struct {
+		Addons []ekstypes.AddonInfo
+	}{
+		Addons: versions.Addons,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal addon versions: %w", err)
+	}
+	return string(summary), nil
+}
@@ -1,11 +1,14 @@
 package addon_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
@@ -32,16 +35,16 @@ var _ = Describe("DescribeVersions", func() {
 
 	Describe("DescribeVersions", func() {
 		It("returns an addon", func() {
-			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
-				describeAddonVersonsInput = args[0].(*awseks.DescribeAddonVersionsInput)
+			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+				describeAddonVersonsInput = args[1].(*awseks.DescribeAddonVersionsInput)
 			}).Return(&awseks.DescribeAddonVersionsOutput{
-				Addons: []*awseks.AddonInfo{
+				Addons: []ekstypes.AddonInfo{
 					{
 						AddonName: aws.String("my-addon"),
 						Type:      aws.String("type"),
-						AddonVersions: []*awseks.AddonVersionInfo{
+						AddonVersions: []ekstypes.AddonVersionInfo{
 							{
 								AddonVersion: aws.String("1.0"),
 							},
@@ -53,26 +56,26 @@ var _ = Describe("DescribeVersions", func() {
 				},
 			}, nil)
 
-			summary, err := manager.DescribeVersions(&api.Addon{
+			summary, err := manager.DescribeVersions(context.TODO(), &api.Addon{
 				Name: "my-addon",
 			})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(summary).To(Equal(awseks.DescribeAddonVersionsOutput{
-				Addons: []*awseks.AddonInfo{
-					{
-						AddonName: aws.String("my-addon"),
-						Type:      aws.String("type"),
-						AddonVersions: []*awseks.AddonVersionInfo{
-							{
-								AddonVersion: aws.String("1.0"),
-							},
-							{
-								AddonVersion: aws.String("1.1"),
-							},
+			var versions struct{ Addons []ekstypes.AddonInfo }
+			Expect(json.Unmarshal([]byte(summary), &versions)).To(Succeed())
+			Expect(versions.Addons).To(Equal([]ekstypes.AddonInfo{
+				{
+					AddonName: aws.String("my-addon"),
+					Type:      aws.String("type"),
+					AddonVersions: []ekstypes.AddonVersionInfo{
+						{
+							AddonVersion: aws.String("1.0"),
+						},
+						{
+							AddonVersion: aws.String("1.1"),
 						},
 					},
 				},
-			}.String()))
+			}))
 
 			Expect(*describeAddonVersonsInput.KubernetesVersion).To(Equal("1.18"))
 			Expect(*describeAddonVersonsInput.AddonName).To(Equal("my-addon"))
@@ -80,13 +83,13 @@ var _ = Describe("DescribeVersions", func() {
 
 		When("it fails to describe addon versions", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
-					describeAddonVersonsInput = args[0].(*awseks.DescribeAddonVersionsInput)
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+					describeAddonVersonsInput = args[1].(*awseks.DescribeAddonVersionsInput)
 				}).Return(&awseks.DescribeAddonVersionsOutput{}, fmt.Errorf("foo"))
 
-				_, err := manager.DescribeVersions(&api.Addon{
+				_, err := manager.DescribeVersions(context.TODO(), &api.Addon{
 					Name: "my-addon",
 				})
 				Expect(err).To(MatchError(`failed to describe addon versions: foo`))
@@ -98,16 +101,16 @@ var _ = Describe("DescribeVersions", func() {
 
 	Describe("DescribeAllVersions", func() {
 		It("returns an addon", func() {
-			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
-				describeAddonVersonsInput = args[0].(*awseks.DescribeAddonVersionsInput)
+			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+				describeAddonVersonsInput = args[1].(*awseks.DescribeAddonVersionsInput)
 			}).Return(&awseks.DescribeAddonVersionsOutput{
-				Addons: []*awseks.AddonInfo{
+				Addons: []ekstypes.AddonInfo{
 					{
 						AddonName: aws.String("my-addon"),
 						Type:      aws.String("type"),
-						AddonVersions: []*awseks.AddonVersionInfo{
+						AddonVersions: []ekstypes.AddonVersionInfo{
 							{
 								AddonVersion: aws.String("1.0"),
 							},
@@ -119,24 +122,24 @@ var _ = Describe("DescribeVersions", func() {
 				},
 			}, nil)
 
-			summary, err := manager.DescribeAllVersions()
+			summary, err := manager.DescribeAllVersions(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(summary).To(Equal(awseks.DescribeAddonVersionsOutput{
-				Addons: []*awseks.AddonInfo{
-					{
-						AddonName: aws.String("my-addon"),
-						Type:      aws.String("type"),
-						AddonVersions: []*awseks.AddonVersionInfo{
-							{
-								AddonVersion: aws.String("1.0"),
-							},
-							{
-								AddonVersion: aws.String("1.1"),
-							},
+			var versions struct{ Addons []ekstypes.AddonInfo }
+			Expect(json.Unmarshal([]byte(summary), &versions)).To(Succeed())
+			Expect(versions.Addons).To(Equal([]ekstypes.AddonInfo{
+				{
+					AddonName: aws.String("my-addon"),
+					Type:      aws.String("type"),
+					AddonVersions: []ekstypes.AddonVersionInfo{
+						{
+							AddonVersion: aws.String("1.0"),
+						},
+						{
+							AddonVersion: aws.String("1.1"),
 						},
 					},
 				},
-			}.String()))
+			}))
 
 			Expect(*describeAddonVersonsInput.KubernetesVersion).To(Equal("1.18"))
 			Expect(describeAddonVersonsInput.AddonName).To(BeNil())
@@ -144,13 +147,13 @@ var _ = Describe("DescribeVersions", func() {
 
 		When("it fails to describe addon versions", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
-					describeAddonVersonsInput = args[0].(*awseks.DescribeAddonVersionsInput)
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+					describeAddonVersonsInput = args[1].(*awseks.DescribeAddonVersionsInput)
 				}).Return(&awseks.DescribeAddonVersionsOutput{}, fmt.Errorf("foo"))
 
-				_, err := manager.DescribeAllVersions()
+				_, err := manager.DescribeAllVersions(context.TODO())
 				Expect(err).To(MatchError(`failed to describe addon versions: foo`))
 				Expect(*describeAddonVersonsInput.KubernetesVersion).To(Equal("1.18"))
 				Expect(describeAddonVersonsInput.AddonName).To(BeNil())
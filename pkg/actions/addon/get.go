@@ -1,6 +1,8 @@
 package addon
 
 import (
+	"context"
+	"crypto/sha256"
 	"fmt"
 	"strings"
 
@@ -9,7 +11,8 @@ import (
 	"github.com/kris-nova/logger"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
 )
 
 type Summary struct {
@@ -18,12 +21,32 @@ type Summary struct {
 	NewerVersion string
 	IAMRole      string
 	Status       string
-	Issues       []string
+	Issues       []Issue
+	// PodIdentityAssociations holds the ARNs of the pod identity associations of the addon
+	PodIdentityAssociations []string
+	// ConfigurationValuesHash is a short hash of the addon's configuration values, to tell whether
+	// the addons of different clusters are configured alike without printing the values
+	ConfigurationValuesHash string
 }
 
-func (a *Manager) Get(addon *api.Addon) (Summary, error) {
+// Issue is a health issue reported by EKS for an addon
+type Issue struct {
+	Code        string
+	Message     string
+	ResourceIDs []string
+}
+
+func (i Issue) String() string {
+	issue := fmt.Sprintf("%s: %s", i.Code, i.Message)
+	if len(i.ResourceIDs) > 0 {
+		issue = fmt.Sprintf("%s (resources: %s)", issue, strings.Join(i.ResourceIDs, ", "))
+	}
+	return issue
+}
+
+func (a *Manager) Get(ctx context.Context, addon *api.Addon) (Summary, error) {
 	logger.Debug("addon: %v", addon)
-	output, err := a.eksAPI.DescribeAddon(&eks.DescribeAddonInput{
+	output, err := a.eksAPI.DescribeAddon(ctx, &eks.DescribeAddonInput{
 		ClusterName: &a.clusterConfig.Metadata.Name,
 		AddonName:   &addon.Name,
 	})
@@ -32,11 +55,15 @@ func (a *Manager) Get(addon *api.Addon) (Summary, error) {
 		return Summary{}, fmt.Errorf("failed to get addon %q: %v", addon.Name, err)
 	}
 
-	var issues []string
+	var issues []Issue
 
 	if output.Addon.Health != nil && output.Addon.Health.Issues != nil {
 		for _, issue := range output.Addon.Health.Issues {
-			issues = append(issues, issue.String())
+			issues = append(issues, Issue{
+				Code:        string(issue.Code),
+				Message:     aws.ToString(issue.Message),
+				ResourceIDs: issue.ResourceIds,
+			})
 		}
 	}
 	serviceAccountRoleARN := ""
@@ -44,28 +71,35 @@ func (a *Manager) Get(addon *api.Addon) (Summary, error) {
 		serviceAccountRoleARN = *output.Addon.ServiceAccountRoleArn
 	}
 
+	configurationValuesHash := ""
+	if configurationValues := aws.ToString(output.Addon.ConfigurationValues); configurationValues != "" {
+		configurationValuesHash = fmt.Sprintf("%x", sha256.Sum256([]byte(configurationValues)))[:12]
+	}
+
 	if addon.Version == "" {
 		addon.Version = *output.Addon.AddonVersion
 	}
 
-	newerVersion, err := a.findNewerVersions(addon)
+	newerVersion, err := a.findNewerVersions(ctx, addon)
 	if err != nil {
 		return Summary{}, err
 	}
 
 	return Summary{
-		Name:         *output.Addon.AddonName,
-		Version:      *output.Addon.AddonVersion,
-		IAMRole:      serviceAccountRoleARN,
-		Status:       *output.Addon.Status,
-		NewerVersion: newerVersion,
-		Issues:       issues,
+		Name:                    *output.Addon.AddonName,
+		Version:                 *output.Addon.AddonVersion,
+		IAMRole:                 serviceAccountRoleARN,
+		Status:                  string(output.Addon.Status),
+		NewerVersion:            newerVersion,
+		Issues:                  issues,
+		PodIdentityAssociations: output.Addon.PodIdentityAssociations,
+		ConfigurationValuesHash: configurationValuesHash,
 	}, nil
 }
 
-func (a *Manager) GetAll() ([]Summary, error) {
+func (a *Manager) GetAll(ctx context.Context) ([]Summary, error) {
 	logger.Info("getting all addons")
-	output, err := a.eksAPI.ListAddons(&eks.ListAddonsInput{
+	output, err := a.eksAPI.ListAddons(ctx, &eks.ListAddonsInput{
 		ClusterName: &a.clusterConfig.Metadata.Name,
 	})
 	if err != nil {
@@ -74,7 +108,7 @@ func (a *Manager) GetAll() ([]Summary, error) {
 
 	var summaries []Summary
 	for _, addon := range output.Addons {
-		summary, err := a.Get(&api.Addon{Name: *addon})
+		summary, err := a.Get(ctx, &api.Addon{Name: addon})
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +117,7 @@ func (a *Manager) GetAll() ([]Summary, error) {
 	return summaries, nil
 }
 
-func (a *Manager) findNewerVersions(addon *api.Addon) (string, error) {
+func (a *Manager) findNewerVersions(ctx context.Context, addon *api.Addon) (string, error) {
 	var newerVersions []string
 	currentVersion, err := semver.Parse(strings.TrimPrefix(addon.Version, "v"))
 	if err != nil {
@@ -94,7 +128,7 @@ func (a *Manager) findNewerVersions(addon *api.Addon) (string, error) {
 	currentVersion.Build = []string{}
 	currentVersion.Pre = []semver.PRVersion{}
 
-	versions, err := a.describeVersions(addon)
+	versions, err := a.describeVersions(ctx, addon)
 	if err != nil {
 		return "", err
 	}
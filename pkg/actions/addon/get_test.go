@@ -1,11 +1,13 @@
 package addon_test
 
 import (
+	"context"
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
@@ -32,15 +34,15 @@ var _ = Describe("Get", func() {
 
 	Describe("Get", func() {
 		It("returns an addon", func() {
-			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
 			}).Return(&awseks.DescribeAddonVersionsOutput{
-				Addons: []*awseks.AddonInfo{
+				Addons: []ekstypes.AddonInfo{
 					{
 						AddonName: aws.String("my-addon"),
 						Type:      aws.String("type"),
-						AddonVersions: []*awseks.AddonVersionInfo{
+						AddonVersions: []ekstypes.AddonVersionInfo{
 							{
 								AddonVersion: aws.String("1.0.0"),
 							},
@@ -56,29 +58,31 @@ var _ = Describe("Get", func() {
 				},
 			}, nil)
 
-			mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
-				describeAddonInput = args[0].(*awseks.DescribeAddonInput)
+			mockProvider.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
+				describeAddonInput = args[1].(*awseks.DescribeAddonInput)
 			}).Return(&awseks.DescribeAddonOutput{
-				Addon: &awseks.Addon{
-					AddonName:             aws.String("my-addon"),
-					AddonVersion:          aws.String("v1.0.0"),
-					ServiceAccountRoleArn: aws.String("foo"),
-					Status:                aws.String("created"),
-					Health: &awseks.AddonHealth{
-						Issues: []*awseks.AddonIssue{
+				Addon: &ekstypes.Addon{
+					AddonName:               aws.String("my-addon"),
+					AddonVersion:            aws.String("v1.0.0"),
+					ServiceAccountRoleArn:   aws.String("foo"),
+					Status:                  ekstypes.AddonStatusCreating,
+					PodIdentityAssociations: []string{"arn:aws:eks:us-west-2:123456789012:podidentityassociation/my-cluster/a-1"},
+					ConfigurationValues:     aws.String(`{"replicaCount":3}`),
+					Health: &ekstypes.AddonHealth{
+						Issues: []ekstypes.AddonIssue{
 							{
-								Code:        aws.String("1"),
+								Code:        ekstypes.AddonIssueCodeInsufficientNumberOfReplicas,
 								Message:     aws.String("foo"),
-								ResourceIds: aws.StringSlice([]string{"id-1"}),
+								ResourceIds: []string{"id-1"},
 							},
 						},
 					},
 				},
 			}, nil)
 
-			summary, err := manager.Get(&api.Addon{
+			summary, err := manager.Get(context.TODO(), &api.Addon{
 				Name: "my-addon",
 			})
 			Expect(err).NotTo(HaveOccurred())
@@ -87,8 +91,16 @@ var _ = Describe("Get", func() {
 				Version:      "v1.0.0",
 				NewerVersion: "v1.1.0,1.2.0",
 				IAMRole:      "foo",
-				Status:       "created",
-				Issues:       []string{"{\n  Code: \"1\",\n  Message: \"foo\",\n  ResourceIds: [\"id-1\"]\n}"},
+				Status:       "CREATING",
+				Issues: []addon.Issue{
+					{
+						Code:        "InsufficientNumberOfReplicas",
+						Message:     "foo",
+						ResourceIDs: []string{"id-1"},
+					},
+				},
+				PodIdentityAssociations: []string{"arn:aws:eks:us-west-2:123456789012:podidentityassociation/my-cluster/a-1"},
+				ConfigurationValuesHash: "a9d53c1874e6",
 			}))
 
 			Expect(*describeAddonInput.ClusterName).To(Equal("my-cluster"))
@@ -97,13 +109,13 @@ var _ = Describe("Get", func() {
 
 		When("it fails to get the addon", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
-					describeAddonInput = args[0].(*awseks.DescribeAddonInput)
+				mockProvider.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
+					describeAddonInput = args[1].(*awseks.DescribeAddonInput)
 				}).Return(nil, fmt.Errorf("foo"))
 
-				_, err := manager.Get(&api.Addon{
+				_, err := manager.Get(context.TODO(), &api.Addon{
 					Name: "my-addon",
 				})
 				Expect(err).To(MatchError(`failed to get addon "my-addon": foo`))
@@ -116,15 +128,15 @@ var _ = Describe("Get", func() {
 	Describe("GetAll", func() {
 		var listAddonsInput *awseks.ListAddonsInput
 		It("returns an addon", func() {
-			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
 			}).Return(&awseks.DescribeAddonVersionsOutput{
-				Addons: []*awseks.AddonInfo{
+				Addons: []ekstypes.AddonInfo{
 					{
 						AddonName: aws.String("my-addon"),
 						Type:      aws.String("type"),
-						AddonVersions: []*awseks.AddonVersionInfo{
+						AddonVersions: []ekstypes.AddonVersionInfo{
 							{
 								AddonVersion: aws.String("1.0.0"),
 							},
@@ -140,28 +152,28 @@ var _ = Describe("Get", func() {
 				},
 			}, nil)
 
-			mockProvider.MockEKS().On("ListAddons", mock.Anything).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
-				listAddonsInput = args[0].(*awseks.ListAddonsInput)
+			mockProvider.MockEKS().On("ListAddons", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
+				listAddonsInput = args[1].(*awseks.ListAddonsInput)
 			}).Return(&awseks.ListAddonsOutput{
-				Addons: aws.StringSlice([]string{"my-addon"}),
+				Addons: []string{"my-addon"},
 			}, nil)
 
-			mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
-				describeAddonInput = args[0].(*awseks.DescribeAddonInput)
+			mockProvider.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
+				describeAddonInput = args[1].(*awseks.DescribeAddonInput)
 			}).Return(&awseks.DescribeAddonOutput{
-				Addon: &awseks.Addon{
+				Addon: &ekstypes.Addon{
 					AddonName:             aws.String("my-addon"),
 					AddonVersion:          aws.String("1.0.0"),
 					ServiceAccountRoleArn: aws.String("foo"),
-					Status:                aws.String("created"),
+					Status:                ekstypes.AddonStatusCreating,
 				},
 			}, nil)
 
-			summary, err := manager.GetAll()
+			summary, err := manager.GetAll(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(summary).To(Equal([]addon.Summary{
 				{
@@ -169,7 +181,7 @@ var _ = Describe("Get", func() {
 					Version:      "1.0.0",
 					NewerVersion: "v1.1.0,1.2.0",
 					IAMRole:      "foo",
-					Status:       "created",
+					Status:       "CREATING",
 				},
 			}))
 
@@ -180,21 +192,21 @@ var _ = Describe("Get", func() {
 
 		When("it fails to get the addon", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("ListAddons", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
-					listAddonsInput = args[0].(*awseks.ListAddonsInput)
+				mockProvider.MockEKS().On("ListAddons", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
+					listAddonsInput = args[1].(*awseks.ListAddonsInput)
 				}).Return(&awseks.ListAddonsOutput{
-					Addons: aws.StringSlice([]string{"my-addon"}),
+					Addons: []string{"my-addon"},
 				}, nil)
 
-				mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
-					describeAddonInput = args[0].(*awseks.DescribeAddonInput)
+				mockProvider.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
+					describeAddonInput = args[1].(*awseks.DescribeAddonInput)
 				}).Return(nil, fmt.Errorf("foo"))
 
-				_, err := manager.GetAll()
+				_, err := manager.GetAll(context.TODO())
 				Expect(err).To(MatchError(`failed to get addon "my-addon": foo`))
 				Expect(*describeAddonInput.ClusterName).To(Equal("my-cluster"))
 				Expect(*describeAddonInput.AddonName).To(Equal("my-addon"))
@@ -205,18 +217,105 @@ var _ = Describe("Get", func() {
 
 		When("it fails to list addons", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("ListAddons", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
-					listAddonsInput = args[0].(*awseks.ListAddonsInput)
+				mockProvider.MockEKS().On("ListAddons", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
+					listAddonsInput = args[1].(*awseks.ListAddonsInput)
 				}).Return(&awseks.ListAddonsOutput{
-					Addons: aws.StringSlice([]string{"my-addon"}),
+					Addons: []string{"my-addon"},
 				}, fmt.Errorf("foo"))
 
-				_, err := manager.GetAll()
+				_, err := manager.GetAll(context.TODO())
 				Expect(err).To(MatchError(`failed to list addons: foo`))
 				Expect(*listAddonsInput.ClusterName).To(Equal("my-cluster"))
 			})
 		})
 	})
+
+	Describe("GetRecommended", func() {
+		compatibleWith := func(clusterVersion string, defaultVersion bool) []ekstypes.Compatibility {
+			return []ekstypes.Compatibility{{ClusterVersion: aws.String(clusterVersion), DefaultVersion: defaultVersion}}
+		}
+
+		It("returns the addons with a default version that aren't installed", func() {
+			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
+				KubernetesVersion: aws.String("1.18"),
+			}).Return(&awseks.DescribeAddonVersionsOutput{
+				Addons: []ekstypes.AddonInfo{
+					{
+						AddonName: aws.String("vpc-cni"),
+						Type:      aws.String("networking"),
+						AddonVersions: []ekstypes.AddonVersionInfo{
+							{AddonVersion: aws.String("v1.10.0-eksbuild.1"), Compatibilities: compatibleWith("1.18", false)},
+							{AddonVersion: aws.String("v1.9.0-eksbuild.1"), Compatibilities: compatibleWith("1.18", true)},
+							{AddonVersion: aws.String("v1.11.0-eksbuild.1"), Compatibilities: compatibleWith("1.19", false)},
+						},
+					},
+					{
+						AddonName: aws.String("kube-proxy"),
+						Type:      aws.String("networking"),
+						AddonVersions: []ekstypes.AddonVersionInfo{
+							{AddonVersion: aws.String("v1.18.8-eksbuild.1"), Compatibilities: compatibleWith("1.18", true)},
+						},
+					},
+					{
+						AddonName: aws.String("my-addon"),
+						Type:      aws.String("type"),
+						AddonVersions: []ekstypes.AddonVersionInfo{
+							{AddonVersion: aws.String("v1.0.0"), Compatibilities: compatibleWith("1.18", false)},
+						},
+					},
+					{
+						AddonName: aws.String("coredns"),
+						Type:      aws.String("networking"),
+						AddonVersions: []ekstypes.AddonVersionInfo{
+							{AddonVersion: aws.String("v1.8.0-eksbuild.1"), Compatibilities: compatibleWith("1.18", true)},
+						},
+					},
+				},
+			}, nil)
+			mockProvider.MockEKS().On("ListAddons", mock.Anything, &awseks.ListAddonsInput{
+				ClusterName: aws.String("my-cluster"),
+			}).Return(&awseks.ListAddonsOutput{
+				Addons: []string{"coredns"},
+			}, nil)
+
+			recommendations, err := manager.GetRecommended(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recommendations).To(Equal([]addon.Recommendation{
+				{
+					Name:           "kube-proxy",
+					Type:           "networking",
+					DefaultVersion: "v1.18.8-eksbuild.1",
+					LatestVersion:  "v1.18.8-eksbuild.1",
+				},
+				{
+					Name:            "vpc-cni",
+					Type:            "networking",
+					DefaultVersion:  "v1.9.0-eksbuild.1",
+					LatestVersion:   "v1.10.0-eksbuild.1",
+					IAMRoleRequired: true,
+				},
+			}))
+		})
+
+		When("it fails to list addons", func() {
+			It("returns an error", func() {
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Return(&awseks.DescribeAddonVersionsOutput{}, nil)
+				mockProvider.MockEKS().On("ListAddons", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("foo"))
+
+				_, err := manager.GetRecommended(context.TODO())
+				Expect(err).To(MatchError(`failed to list addons: foo`))
+			})
+		})
+	})
+
+	Describe("Issue", func() {
+		It("formats the code, message and affected resources", func() {
+			Expect(addon.Issue{Code: "InsufficientNumberOfReplicas", Message: "not enough replicas"}.String()).
+				To(Equal("InsufficientNumberOfReplicas: not enough replicas"))
+			Expect(addon.Issue{Code: "AccessDenied", Message: "denied", ResourceIDs: []string{"id-1", "id-2"}}.String()).
+				To(Equal("AccessDenied: denied (resources: id-1, id-2)"))
+		})
+	})
 })
@@ -0,0 +1,103 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/go-version"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Recommendation is an addon that AWS provides a default version of for the cluster's Kubernetes version
+// but that isn't installed on the cluster
+type Recommendation struct {
+	Name            string
+	Type            string
+	DefaultVersion  string
+	LatestVersion   string
+	IAMRoleRequired bool
+}
+
+// GetRecommended returns the addons with a default version for the cluster's Kubernetes version that
+// aren't installed on the cluster
+func (a *Manager) GetRecommended(ctx context.Context) ([]Recommendation, error) {
+	logger.Info("getting recommended addons for Kubernetes version %q", a.clusterConfig.Metadata.Version)
+	versions, err := a.describeVersions(ctx, &api.Addon{})
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := a.eksAPI.ListAddons(ctx, &eks.ListAddonsInput{
+		ClusterName: &a.clusterConfig.Metadata.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list addons: %v", err)
+	}
+	installed := map[string]bool{}
+	for _, name := range output.Addons {
+		installed[strings.ToLower(name)] = true
+	}
+
+	var recommendations []Recommendation
+	for _, addonInfo := range versions.Addons {
+		name := aws.ToString(addonInfo.AddonName)
+		if installed[strings.ToLower(name)] {
+			continue
+		}
+		defaultVersion, latestVersion := a.findCompatibleVersions(addonInfo)
+		if defaultVersion == "" {
+			continue
+		}
+		policyDocument, policyARNs, wellKnownPolicies := a.getRecommendedPolicies(&api.Addon{Name: name})
+		recommendations = append(recommendations, Recommendation{
+			Name:            name,
+			Type:            aws.ToString(addonInfo.Type),
+			DefaultVersion:  defaultVersion,
+			LatestVersion:   latestVersion,
+			IAMRoleRequired: policyDocument != nil || len(policyARNs) > 0 || wellKnownPolicies != nil,
+		})
+	}
+
+	sort.Slice(recommendations, func(i, j int) bool {
+		return recommendations[i].Name < recommendations[j].Name
+	})
+	return recommendations, nil
+}
+
+// findCompatibleVersions returns the default and the latest versions of the addon that are compatible with
+// the cluster's Kubernetes version
+func (a *Manager) findCompatibleVersions(addonInfo ekstypes.AddonInfo) (string, string) {
+	var (
+		defaultVersion string
+		latestVersion  *version.Version
+	)
+	for _, versionInfo := range addonInfo.AddonVersions {
+		for _, compatibility := range versionInfo.Compatibilities {
+			if aws.ToString(compatibility.ClusterVersion) != a.clusterConfig.Metadata.Version {
+				continue
+			}
+			if compatibility.DefaultVersion {
+				defaultVersion = aws.ToString(versionInfo.AddonVersion)
+			}
+			v, err := a.parseVersion(aws.ToString(versionInfo.AddonVersion))
+			if err != nil {
+				logger.Debug("skipping version comparison: %v", err)
+				continue
+			}
+			if latestVersion == nil || latestVersion.LessThan(v) {
+				latestVersion = v
+			}
+		}
+	}
+	if defaultVersion == "" || latestVersion == nil {
+		return defaultVersion, defaultVersion
+	}
+	return defaultVersion, latestVersion.Original()
+}
@@ -2,9 +2,10 @@ package addon
 
 import (
 	"context"
-	"strings"
 	"time"
 
+	kubeclient "k8s.io/client-go/kubernetes"
+
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
@@ -16,7 +17,7 @@ func CreateAddonTasks(ctx context.Context, cfg *api.ClusterConfig, clusterProvid
 	var preAddons []*api.Addon
 	var postAddons []*api.Addon
 	for _, addon := range cfg.Addons {
-		if strings.ToLower(addon.Name) == "vpc-cni" {
+		if addon.IsBeforeNodes() {
 			preAddons = append(preAddons, addon)
 		} else {
 			postAddons = append(postAddons, addon)
@@ -32,7 +33,8 @@ func CreateAddonTasks(ctx context.Context, cfg *api.ClusterConfig, clusterProvid
 			clusterProvider: clusterProvider,
 			forceAll:        forceAll,
 			timeout:         timeout,
-			wait:            false,
+			// wait for the vpc-cni addon to apply the VPC CNI configuration before the first nodes launch
+			wait: cfg.VPCCNI != nil,
 		},
 	)
 
@@ -66,7 +68,7 @@ type createAddonTask struct {
 func (t *createAddonTask) Describe() string { return t.info }
 
 func (t *createAddonTask) Do(errorCh chan error) error {
-	oidc, err := t.clusterProvider.NewOpenIDConnectManager(t.cfg)
+	oidc, err := t.clusterProvider.NewOpenIDConnectManager(t.ctx, t.cfg)
 	if err != nil {
 		return err
 	}
@@ -78,9 +80,13 @@ func (t *createAddonTask) Do(errorCh chan error) error {
 
 	stackManager := t.clusterProvider.NewStackManager(t.cfg)
 
-	clientSet, err := t.clusterProvider.NewStdClientSet(t.cfg)
-	if err != nil {
-		return err
+	// without access to the Kubernetes API there's no eksctl-managed aws-node to patch,
+	// as eksctl hasn't touched the cluster
+	var clientSet kubeclient.Interface
+	if t.clusterProvider.HasKubeAccess() {
+		if clientSet, err = t.clusterProvider.NewStdClientSet(t.cfg); err != nil {
+			return err
+		}
 	}
 	addonManager, err := New(t.cfg, t.clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, clientSet, t.timeout)
 	if err != nil {
@@ -91,7 +97,7 @@ func (t *createAddonTask) Do(errorCh chan error) error {
 		if t.forceAll {
 			a.Force = true
 		}
-		err := addonManager.Create(context.TODO(), a, t.wait)
+		err := addonManager.Create(t.ctx, a, t.wait)
 		if err != nil {
 			go func() {
 				errorCh <- err
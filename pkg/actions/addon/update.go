@@ -4,8 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/google/uuid"
 	"github.com/kris-nova/logger"
 
@@ -23,12 +24,12 @@ func (a *Manager) Update(ctx context.Context, addon *api.Addon, wait bool) error
 	}
 
 	if addon.Force {
-		updateAddonInput.ResolveConflicts = aws.String("overwrite")
+		updateAddonInput.ResolveConflicts = ekstypes.ResolveConflictsOverwrite
 		logger.Debug("setting resolve conflicts to overwrite")
 
 	}
 
-	summary, err := a.Get(addon)
+	summary, err := a.Get(ctx, addon)
 	if err != nil {
 		return err
 	}
@@ -40,7 +41,7 @@ func (a *Manager) Update(ctx context.Context, addon *api.Addon, wait bool) error
 
 		updateAddonInput.AddonVersion = &summary.Version
 	} else {
-		version, err := a.getLatestMatchingVersion(addon)
+		version, err := a.getLatestMatchingVersion(ctx, addon)
 		if err != nil {
 			return fmt.Errorf("failed to fetch addon version: %w", err)
 		}
@@ -68,18 +69,22 @@ func (a *Manager) Update(ctx context.Context, addon *api.Addon, wait bool) error
 		}
 	}
 
+	if addon.ConfigurationValues != "" {
+		updateAddonInput.ConfigurationValues = &addon.ConfigurationValues
+	}
+
 	logger.Info("updating addon")
-	logger.Debug(updateAddonInput.String())
+	logger.Debug("%+v", updateAddonInput)
 
-	output, err := a.eksAPI.UpdateAddon(updateAddonInput)
+	output, err := a.eksAPI.UpdateAddon(ctx, updateAddonInput)
 	if err != nil {
 		return fmt.Errorf("failed to update addon %q: %v", addon.Name, err)
 	}
 	if output != nil {
-		logger.Debug(output.String())
+		logger.Debug("%+v", output.Update)
 	}
 	if wait {
-		return a.waitForAddonToBeActive(addon)
+		return a.waitForAddonToBeActive(ctx, addon)
 	}
 	return nil
 }
@@ -3,10 +3,9 @@ package anywhere
 import (
 	"errors"
 	"fmt"
-	"os"
 	"os/exec"
 
-	"github.com/weaveworks/eksctl/pkg/version"
+	"github.com/weaveworks/eksctl/pkg/actions/plugin"
 )
 
 const (
@@ -37,22 +36,12 @@ func IsAnywhereCommand(args []string) (bool, error) {
 
 // RunAnywhereCommand executes the anywhere binary.
 func RunAnywhereCommand(args []string) (int, error) {
-	if _, err := exec.LookPath(BinaryFileName); errors.Is(err, exec.ErrNotFound) {
+	path, err := exec.LookPath(BinaryFileName)
+	if errors.Is(err, exec.ErrNotFound) {
 		return 1, fmt.Errorf(fmt.Sprintf("%q plugin was not found on your path", BinaryFileName))
 	} else if err != nil {
 		return 1, fmt.Errorf("failed to lookup anywhere plugin: %w", err)
 	}
 
-	cmd := exec.Command(BinaryFileName, args[1:]...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Stdin = os.Stdin
-
-	cmd.Env = append(os.Environ(), fmt.Sprintf("EKSCTL_VERSION=%s", version.GetVersion()))
-
-	err := cmd.Run()
-	if exiterr, ok := err.(*exec.ExitError); ok {
-		return exiterr.ExitCode(), nil
-	}
-	return 0, err
+	return plugin.Plugin{Name: "anywhere", Path: path}.Run(args[1:])
 }
@@ -0,0 +1,205 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/helm"
+	"github.com/weaveworks/eksctl/pkg/provenance"
+	"github.com/weaveworks/eksctl/pkg/utils/retry"
+)
+
+const defaultRetryInterval = 10 * time.Second
+
+// ManifestApplier creates or replaces the objects of a manifest in a cluster
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate -o fakes/fake_manifest_applier.go . ManifestApplier
+type ManifestApplier interface {
+	CreateOrReplace(manifest []byte, plan bool) error
+}
+
+// Applier applies the manifests and installs the Helm charts declared in bootstrap and charts
+type Applier struct {
+	ClusterConfig   *api.ClusterConfig
+	ManifestApplier ManifestApplier
+	// NewHelmInstaller returns a ChartInstaller that stores releases in namespace
+	NewHelmInstaller func(namespace string) (helm.ChartInstaller, error)
+	RetryInterval    time.Duration
+}
+
+// NewApplier creates a new Applier
+func NewApplier(cfg *api.ClusterConfig, manifestApplier ManifestApplier, newHelmInstaller func(namespace string) (helm.ChartInstaller, error)) *Applier {
+	return &Applier{
+		ClusterConfig:    cfg,
+		ManifestApplier:  manifestApplier,
+		NewHelmInstaller: newHelmInstaller,
+		RetryInterval:    defaultRetryInterval,
+	}
+}
+
+// Apply applies the bootstrap manifests, then installs the bootstrap Helm charts, then installs charts, each in the
+// order they are declared in. Each of them is retried up to bootstrap.retries times before giving up
+func (a *Applier) Apply(ctx context.Context) error {
+	if a.ClusterConfig.HasBootstrap() {
+		if err := a.applyBootstrap(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, chart := range a.ClusterConfig.Charts {
+		values, err := a.renderValuesTemplate(chart.ReleaseName, chart.ValuesTemplate)
+		if err != nil {
+			return errors.Wrapf(err, "rendering valuesTemplate of chart %q", chart.ReleaseName)
+		}
+		if err := a.installChart(ctx, chart.Repo, chart.Name, chart.Version, chart.ReleaseName, chart.Namespace, values, chart.Verification); err != nil {
+			return err
+		}
+	}
+	if a.ClusterConfig.HasCharts() {
+		logger.Success("installed %d Helm chart(s)", len(a.ClusterConfig.Charts))
+	}
+	return nil
+}
+
+func (a *Applier) applyBootstrap(ctx context.Context) error {
+	bootstrap := a.ClusterConfig.Bootstrap
+	for _, m := range bootstrap.Manifests {
+		manifest, err := render(a.ClusterConfig, m.Name, m.Document)
+		if err != nil {
+			return errors.Wrapf(err, "rendering manifest %q", m.Name)
+		}
+		logger.Info("applying bootstrap manifest %q", m.Name)
+		if err := a.withRetries(ctx, fmt.Sprintf("applying manifest %q", m.Name), func() error {
+			return a.ManifestApplier.CreateOrReplace([]byte(manifest), false)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, chart := range bootstrap.HelmCharts {
+		values, err := a.renderValues(chart.Name, chart.Values)
+		if err != nil {
+			return errors.Wrapf(err, "rendering values of chart %q", chart.Name)
+		}
+		if err := a.installChart(ctx, chart.Repo, chart.Chart, chart.Version, chart.Name, chart.Namespace, values, chart.Verification); err != nil {
+			return err
+		}
+	}
+
+	logger.Success("applied %d bootstrap manifest(s) and installed %d bootstrap Helm chart(s)", len(bootstrap.Manifests), len(bootstrap.HelmCharts))
+	return nil
+}
+
+func (a *Applier) installChart(ctx context.Context, repo, chart, version, releaseName, namespace string, values map[string]interface{}, verification *api.ArtifactVerification) error {
+	installer, err := a.NewHelmInstaller(namespace)
+	if err != nil {
+		return errors.Wrapf(err, "creating Helm installer for chart %q", releaseName)
+	}
+	logger.Info("installing Helm chart %q", releaseName)
+	return a.withRetries(ctx, fmt.Sprintf("installing chart %q", releaseName), func() error {
+		if err := installer.AddRepo(repo, releaseName); err != nil {
+			return err
+		}
+		return installer.InstallChart(ctx, helm.InstallChartOpts{
+			ChartName:       fmt.Sprintf("%s/%s", releaseName, chart),
+			CreateNamespace: true,
+			Namespace:       namespace,
+			ReleaseName:     releaseName,
+			Values:          values,
+			Version:         version,
+			Verify:          provenance.NewVerifier(a.ClusterConfig.Cosign).VerifyFunc(fmt.Sprintf("chart %q", releaseName), verification),
+		})
+	})
+}
+
+func (a *Applier) retries() int {
+	if a.ClusterConfig.Bootstrap != nil && a.ClusterConfig.Bootstrap.Retries != nil {
+		return *a.ClusterConfig.Bootstrap.Retries
+	}
+	return api.DefaultBootstrapRetries
+}
+
+func (a *Applier) withRetries(ctx context.Context, description string, fn func() error) error {
+	policy := &retry.ConstantBackoff{
+		MaxRetries: a.retries(),
+		Time:       1,
+		TimeUnit:   a.RetryInterval,
+	}
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		if policy.Done() {
+			return errors.Wrapf(err, "%s", description)
+		}
+		wait := policy.Duration()
+		logger.Warning("%s failed, retrying in %s: %v", description, wait, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (a *Applier) renderValuesTemplate(name, valuesTemplate string) (map[string]interface{}, error) {
+	if valuesTemplate == "" {
+		return nil, nil
+	}
+	rendered, err := render(a.ClusterConfig, name, valuesTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]interface{}
+	if err := yaml.Unmarshal([]byte(rendered), &values); err != nil {
+		return nil, errors.Wrap(err, "parsing rendered values")
+	}
+	return values, nil
+}
+
+func (a *Applier) renderValues(name string, values api.InlineDocument) (map[string]interface{}, error) {
+	if values == nil {
+		return nil, nil
+	}
+	rendered, err := a.renderValue(name, map[string]interface{}(values))
+	if err != nil {
+		return nil, err
+	}
+	return rendered.(map[string]interface{}), nil
+}
+
+func (a *Applier) renderValue(name string, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return render(a.ClusterConfig, name, v)
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			rendered, err := a.renderValue(name, val)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = rendered
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			rendered, err := a.renderValue(name, val)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = rendered
+		}
+		return out, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,223 @@
+package bootstrap_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap"
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap/fakes"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/helm"
+	helmfakes "github.com/weaveworks/eksctl/pkg/helm/fakes"
+)
+
+var _ = Describe("Bootstrap", func() {
+	var (
+		cfg             *api.ClusterConfig
+		manifestApplier *fakes.FakeManifestApplier
+		helmInstaller   *helmfakes.FakeChartInstaller
+		namespaces      []string
+		applier         *bootstrap.Applier
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{
+			{
+				ClusterIAMMeta: api.ClusterIAMMeta{Name: "aws-load-balancer-controller", Namespace: "kube-system"},
+				Status: &api.ClusterIAMServiceAccountStatus{
+					RoleARN: aws.String("arn:aws:iam::123456789012:role/lb-controller"),
+				},
+			},
+		}
+		cfg.Bootstrap = &api.Bootstrap{
+			Manifests: []*api.BootstrapManifest{
+				{
+					Name:     "namespace",
+					Document: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: {{ .ClusterName }}-system\n",
+				},
+			},
+			HelmCharts: []*api.BootstrapHelmChart{
+				{
+					Name:      "aws-load-balancer-controller",
+					Repo:      "https://aws.github.io/eks-charts",
+					Chart:     "aws-load-balancer-controller",
+					Version:   "1.4.1",
+					Namespace: "kube-system",
+					Values: api.InlineDocument{
+						"clusterName":  "{{ .ClusterName }}",
+						"replicaCount": 2,
+						"serviceAccount": map[string]interface{}{
+							"annotations": map[string]interface{}{
+								"eks.amazonaws.com/role-arn": `{{ serviceAccountRoleARN "kube-system/aws-load-balancer-controller" }}`,
+							},
+						},
+					},
+				},
+			},
+			Retries: aws.Int(2),
+		}
+
+		manifestApplier = &fakes.FakeManifestApplier{}
+		helmInstaller = &helmfakes.FakeChartInstaller{}
+		namespaces = nil
+		applier = bootstrap.NewApplier(cfg, manifestApplier, func(namespace string) (helm.ChartInstaller, error) {
+			namespaces = append(namespaces, namespace)
+			return helmInstaller, nil
+		})
+		applier.RetryInterval = 0
+	})
+
+	It("applies the rendered manifests and installs the charts with rendered values", func() {
+		Expect(applier.Apply(context.Background())).To(Succeed())
+
+		Expect(manifestApplier.CreateOrReplaceCallCount()).To(Equal(1))
+		manifest, plan := manifestApplier.CreateOrReplaceArgsForCall(0)
+		Expect(string(manifest)).To(ContainSubstring("name: test-cluster-system"))
+		Expect(plan).To(BeFalse())
+
+		Expect(namespaces).To(Equal([]string{"kube-system"}))
+		Expect(helmInstaller.AddRepoCallCount()).To(Equal(1))
+		repoURL, repoName := helmInstaller.AddRepoArgsForCall(0)
+		Expect(repoURL).To(Equal("https://aws.github.io/eks-charts"))
+		Expect(repoName).To(Equal("aws-load-balancer-controller"))
+
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(1))
+		_, opts := helmInstaller.InstallChartArgsForCall(0)
+		Expect(opts).To(Equal(helm.InstallChartOpts{
+			ChartName:       "aws-load-balancer-controller/aws-load-balancer-controller",
+			CreateNamespace: true,
+			Namespace:       "kube-system",
+			ReleaseName:     "aws-load-balancer-controller",
+			Version:         "1.4.1",
+			Values: map[string]interface{}{
+				"clusterName":  "test-cluster",
+				"replicaCount": 2,
+				"serviceAccount": map[string]interface{}{
+					"annotations": map[string]interface{}{
+						"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/lb-controller",
+					},
+				},
+			},
+		}))
+	})
+
+	It("retries failed manifests", func() {
+		manifestApplier.CreateOrReplaceReturnsOnCall(0, errors.New("no matches for kind"))
+		manifestApplier.CreateOrReplaceReturnsOnCall(1, errors.New("no matches for kind"))
+
+		Expect(applier.Apply(context.Background())).To(Succeed())
+		Expect(manifestApplier.CreateOrReplaceCallCount()).To(Equal(3))
+	})
+
+	It("gives up after the configured number of retries", func() {
+		helmInstaller.InstallChartReturns(errors.New("timed out waiting for the condition"))
+
+		err := applier.Apply(context.Background())
+		Expect(err).To(MatchError(`installing chart "aws-load-balancer-controller": timed out waiting for the condition`))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(3))
+	})
+
+	It("does not install charts when a manifest fails", func() {
+		manifestApplier.CreateOrReplaceReturns(errors.New("forbidden"))
+
+		Expect(applier.Apply(context.Background())).To(MatchError(`applying manifest "namespace": forbidden`))
+		Expect(helmInstaller.InstallChartCallCount()).To(BeZero())
+	})
+
+	It("fails when a manifest references an unknown iamserviceaccount", func() {
+		cfg.Bootstrap.Manifests[0].Document = `{{ serviceAccountRoleARN "default/missing" }}`
+
+		Expect(applier.Apply(context.Background())).To(MatchError(ContainSubstring(`iamserviceaccount "default/missing" is not defined in iam.serviceAccounts`)))
+		Expect(manifestApplier.CreateOrReplaceCallCount()).To(BeZero())
+	})
+
+	Context("charts", func() {
+		BeforeEach(func() {
+			cfg.Bootstrap = nil
+			cfg.VPC.ID = "vpc-123"
+			cfg.VPC.Subnets = &api.ClusterSubnets{
+				Private: api.AZSubnetMapping{
+					"us-west-2b": api.AZSubnetSpec{ID: "subnet-2"},
+					"us-west-2a": api.AZSubnetSpec{ID: "subnet-1"},
+				},
+			}
+			ng := api.NewNodeGroup()
+			ng.Name = "workers"
+			ng.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/workers"
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+			cfg.Charts = []*api.HelmChart{
+				{
+					Repo:        "https://example.com/charts",
+					Name:        "controller",
+					Version:     "0.1.0",
+					ReleaseName: "my-controller",
+					Namespace:   "controllers",
+					ValuesTemplate: `vpcID: {{ .VPCID }}
+subnets: {{ join .PrivateSubnetIDs "," }}
+nodeRoleARN: {{ nodeGroupInstanceRoleARN "workers" }}
+`,
+				},
+			}
+		})
+
+		It("installs the charts with the rendered valuesTemplate", func() {
+			Expect(applier.Apply(context.Background())).To(Succeed())
+
+			Expect(manifestApplier.CreateOrReplaceCallCount()).To(BeZero())
+			Expect(namespaces).To(Equal([]string{"controllers"}))
+			repoURL, repoName := helmInstaller.AddRepoArgsForCall(0)
+			Expect(repoURL).To(Equal("https://example.com/charts"))
+			Expect(repoName).To(Equal("my-controller"))
+
+			Expect(helmInstaller.InstallChartCallCount()).To(Equal(1))
+			_, opts := helmInstaller.InstallChartArgsForCall(0)
+			Expect(opts).To(Equal(helm.InstallChartOpts{
+				ChartName:       "my-controller/controller",
+				CreateNamespace: true,
+				Namespace:       "controllers",
+				ReleaseName:     "my-controller",
+				Version:         "0.1.0",
+				Values: map[string]interface{}{
+					"vpcID":       "vpc-123",
+					"subnets":     "subnet-1,subnet-2",
+					"nodeRoleARN": "arn:aws:iam::123456789012:role/workers",
+				},
+			}))
+		})
+
+		It("installs charts after bootstrap", func() {
+			cfg.Bootstrap = &api.Bootstrap{
+				HelmCharts: []*api.BootstrapHelmChart{
+					{Name: "first", Repo: "https://example.com/charts", Chart: "first", Version: "1.0.0", Namespace: "default"},
+				},
+			}
+
+			Expect(applier.Apply(context.Background())).To(Succeed())
+			Expect(helmInstaller.InstallChartCallCount()).To(Equal(2))
+			_, opts := helmInstaller.InstallChartArgsForCall(0)
+			Expect(opts.ReleaseName).To(Equal("first"))
+			_, opts = helmInstaller.InstallChartArgsForCall(1)
+			Expect(opts.ReleaseName).To(Equal("my-controller"))
+		})
+
+		It("fails when the valuesTemplate references a nodegroup that does not exist", func() {
+			cfg.Charts[0].ValuesTemplate = `role: {{ nodeGroupInstanceRoleARN "missing" }}`
+
+			Expect(applier.Apply(context.Background())).To(MatchError(ContainSubstring(`nodegroup "missing" is not defined`)))
+			Expect(helmInstaller.InstallChartCallCount()).To(BeZero())
+		})
+
+		It("fails when the rendered valuesTemplate is not valid YAML", func() {
+			cfg.Charts[0].ValuesTemplate = "key: [unclosed"
+
+			Expect(applier.Apply(context.Background())).To(MatchError(ContainSubstring(`rendering valuesTemplate of chart "my-controller": parsing rendered values`)))
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// templateData holds the resources created by eksctl that manifests and chart values can reference
+type templateData struct {
+	ClusterName               string
+	Region                    string
+	ClusterARN                string
+	ClusterEndpoint           string
+	VPCID                     string
+	ControlPlaneSecurityGroup string
+	SharedNodeSecurityGroup   string
+	PrivateSubnetIDs          []string
+	PublicSubnetIDs           []string
+}
+
+func newTemplateData(cfg *api.ClusterConfig) templateData {
+	data := templateData{
+		ClusterName: cfg.Metadata.Name,
+		Region:      cfg.Metadata.Region,
+	}
+	if cfg.Status != nil {
+		data.ClusterARN = cfg.Status.ARN
+		data.ClusterEndpoint = cfg.Status.Endpoint
+	}
+	if cfg.VPC != nil {
+		data.VPCID = cfg.VPC.ID
+		data.ControlPlaneSecurityGroup = cfg.VPC.SecurityGroup
+		data.SharedNodeSecurityGroup = cfg.VPC.SharedNodeSecurityGroup
+		if cfg.VPC.Subnets != nil {
+			data.PrivateSubnetIDs = subnetIDs(cfg.VPC.Subnets.Private)
+			data.PublicSubnetIDs = subnetIDs(cfg.VPC.Subnets.Public)
+		}
+	}
+	return data
+}
+
+func subnetIDs(subnets api.AZSubnetMapping) []string {
+	var ids []string
+	for _, subnet := range subnets {
+		if subnet.ID != "" {
+			ids = append(ids, subnet.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// render substitutes the resources created by eksctl in text
+func render(cfg *api.ClusterConfig, name, text string) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(template.FuncMap{
+		"serviceAccountRoleARN":    serviceAccountRoleARNFunc(cfg),
+		"nodeGroupInstanceRoleARN": nodeGroupInstanceRoleARNFunc(cfg),
+		"join":                     strings.Join,
+	}).Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, newTemplateData(cfg)); err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
+
+func serviceAccountRoleARNFunc(cfg *api.ClusterConfig) func(string) (string, error) {
+	return func(name string) (string, error) {
+		if cfg.IAM != nil {
+			for _, sa := range cfg.IAM.ServiceAccounts {
+				if sa.NameString() != name {
+					continue
+				}
+				if sa.Status == nil || sa.Status.RoleARN == nil {
+					return "", fmt.Errorf("IAM role of iamserviceaccount %q has not been created", name)
+				}
+				return *sa.Status.RoleARN, nil
+			}
+		}
+		return "", fmt.Errorf("iamserviceaccount %q is not defined in iam.serviceAccounts", name)
+	}
+}
+
+func nodeGroupInstanceRoleARNFunc(cfg *api.ClusterConfig) func(string) (string, error) {
+	return func(name string) (string, error) {
+		for _, ng := range cfg.AllNodeGroups() {
+			if ng.Name != name {
+				continue
+			}
+			if ng.IAM == nil || ng.IAM.InstanceRoleARN == "" {
+				return "", fmt.Errorf("instance role of nodegroup %q is not known", name)
+			}
+			return ng.IAM.InstanceRoleARN, nil
+		}
+		return "", fmt.Errorf("nodegroup %q is not defined", name)
+	}
+}
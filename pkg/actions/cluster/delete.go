@@ -6,9 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/pkg/errors"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
@@ -16,9 +15,12 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/fargate"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -30,10 +32,43 @@ import (
 	"github.com/kris-nova/logger"
 )
 
+// KeepResources are the resources of a cluster that are kept when it's deleted, so that clusters created
+// into shared infrastructure can be deleted without deleting the resources other clusters use
+type KeepResources struct {
+	// VPC keeps the VPC, subnets, gateways and route tables eksctl created for the cluster
+	VPC bool
+	// OIDCProvider keeps the IAM OIDC provider of the cluster
+	OIDCProvider bool
+	// IAMRoles keeps the IAM roles, policies and instance profiles eksctl created for the cluster
+	IAMRoles bool
+}
+
+var (
+	vpcResourceTypes = []string{
+		"AWS::EC2::VPC",
+		"AWS::EC2::VPCCidrBlock",
+		"AWS::EC2::Subnet",
+		"AWS::EC2::InternetGateway",
+		"AWS::EC2::EgressOnlyInternetGateway",
+		"AWS::EC2::VPCGatewayAttachment",
+		"AWS::EC2::NatGateway",
+		"AWS::EC2::EIP",
+		"AWS::EC2::RouteTable",
+		"AWS::EC2::Route",
+		"AWS::EC2::SubnetRouteTableAssociation",
+	}
+	iamResourceTypes = []string{
+		"AWS::IAM::Role",
+		"AWS::IAM::Policy",
+		"AWS::IAM::ManagedPolicy",
+		"AWS::IAM::InstanceProfile",
+	}
+)
+
 type NodeGroupDrainer interface {
 	Drain(input *nodegroup.DrainInput) error
 }
-type vpcCniDeleter func(clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface)
+type vpcCniDeleter func(ctx context.Context, clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface)
 
 func deleteSharedResources(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clusterOperable bool, clientSet kubernetes.Interface) error {
 	if clusterOperable {
@@ -68,6 +103,67 @@ func deleteSharedResources(ctx context.Context, cfg *api.ClusterConfig, ctl *eks
 	return nil
 }
 
+// retainResources sets the resources of the stacks of a cluster that are kept up to be retained when the stacks
+// are deleted
+func retainResources(ctx context.Context, stackManager manager.StackManager, keep KeepResources) ([]manager.RetainedResource, error) {
+	var resourceTypes []string
+	if keep.VPC {
+		resourceTypes = append(resourceTypes, vpcResourceTypes...)
+	}
+	if keep.IAMRoles {
+		resourceTypes = append(resourceTypes, iamResourceTypes...)
+	}
+	if len(resourceTypes) == 0 {
+		return nil, nil
+	}
+
+	stacks, err := stackManager.DescribeStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var retained []manager.RetainedResource
+	for _, s := range stacks {
+		if !stackManager.StackStatusIsNotTransitional(s) {
+			return nil, fmt.Errorf("cannot keep the resources of stack %q with status %q", *s.StackName, s.StackStatus)
+		}
+		stackRetained, err := stackManager.RetainResources(ctx, s, resourceTypes)
+		if err != nil {
+			return nil, err
+		}
+		retained = append(retained, stackRetained...)
+	}
+	return retained, nil
+}
+
+func logKeptResources(clusterName string, keep KeepResources, retained []manager.RetainedResource) {
+	if keep.OIDCProvider {
+		logger.Info("kept the IAM OIDC provider of cluster %q", clusterName)
+	}
+	if len(retained) == 0 {
+		if keep.VPC || keep.IAMRoles {
+			logger.Info("no VPC or IAM resources created by eksctl were found to keep")
+		}
+		return
+	}
+	logger.Info("kept the following resources, they need to be deleted manually once they're no longer used:")
+	for _, r := range retained {
+		logger.Info("%s %s (%s in stack %q)", r.Type, r.PhysicalID, r.LogicalID, r.StackName)
+	}
+}
+
+// newTasksToDeleteIAMServiceAccountsAndOIDCProvider returns the tasks to delete the IAM service accounts of a
+// cluster and, unless it's kept, its IAM OIDC provider
+func newTasksToDeleteIAMServiceAccountsAndOIDCProvider(ctx context.Context, stackManager manager.StackManager, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, keepOIDCProvider bool) (*tasks.TaskTree, error) {
+	if !keepOIDCProvider {
+		return stackManager.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx, oidc, clientSetGetter)
+	}
+	serviceAccounts, err := stackManager.ListIAMServiceAccountStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stackManager.NewTasksToDeleteIAMServiceAccounts(ctx, serviceAccounts, clientSetGetter, true)
+}
+
 func handleErrors(errs []error, subject string) error {
 	logger.Info("%d error(s) occurred while deleting %s", len(errs), subject)
 	for _, err := range errs {
@@ -82,7 +178,7 @@ func deleteFargateProfiles(ctx context.Context, clusterMeta *api.ClusterMeta, ct
 		ctl.Provider,
 		stackManager,
 	)
-	profileNames, err := manager.ListProfiles()
+	profileNames, err := manager.ListProfiles(ctx)
 	if err != nil {
 		if fargate.IsUnauthorizedError(err) {
 			logger.Debug("Fargate: unauthorized error: %v", err)
@@ -100,14 +196,14 @@ func deleteFargateProfiles(ctx context.Context, clusterMeta *api.ClusterMeta, ct
 	//   status DELETING
 
 	for _, profileName := range profileNames {
-		logger.Info("deleting Fargate profile %q", *profileName)
+		logger.Info("deleting Fargate profile %q", profileName)
 		// All Fargate profiles must be completely deleted by waiting for the deletion to complete, before deleting
 		// the cluster itself, otherwise it can result in this error:
 		//   Cannot delete because cluster <cluster> currently has Fargate profile <profile> in status DELETING
-		if err := manager.DeleteProfile(ctx, *profileName, true); err != nil {
+		if err := manager.DeleteProfile(ctx, profileName, true); err != nil {
 			return err
 		}
-		logger.Info("deleted Fargate profile %q", *profileName)
+		logger.Info("deleted Fargate profile %q", profileName)
 	}
 	logger.Info("deleted %v Fargate profile(s)", len(profileNames))
 
@@ -141,6 +237,19 @@ func deleteDeprecatedStacks(ctx context.Context, stackManager manager.StackManag
 	return false, nil
 }
 
+// deleteAutoAMIUpdatesStackIfExists deletes the stack that updates the AMIs of managed nodegroups on a schedule
+func deleteAutoAMIUpdatesStackIfExists(ctx context.Context, stackManager manager.StackManager) error {
+	stack, err := stackManager.GetAutoAMIUpdatesStack(ctx)
+	if err != nil {
+		return err
+	}
+	if stack != nil {
+		logger.Info("deleting automatic AMI updates stack")
+		return stackManager.DeleteStackSync(ctx, stack)
+	}
+	return nil
+}
+
 func checkForUndeletedStacks(ctx context.Context, stackManager manager.StackManager) error {
 	stacks, err := stackManager.DescribeStacks(ctx)
 	if err != nil {
@@ -165,7 +274,7 @@ func checkForUndeletedStacks(ctx context.Context, stackManager manager.StackMana
 	return nil
 }
 
-func drainAllNodeGroups(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.Interface, allStacks []manager.NodeGroupStack,
+func drainAllNodeGroups(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.Interface, allStacks []manager.NodeGroupStack,
 	disableEviction bool, parallel int, nodeGroupDrainer NodeGroupDrainer, vpcCniDeleter vpcCniDeleter, podEvictionWaitPeriod time.Duration) error {
 	if len(allStacks) == 0 {
 		return nil
@@ -191,22 +300,23 @@ func drainAllNodeGroups(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, client
 		return err
 	}
 
-	vpcCniDeleter(cfg.Metadata.Name, ctl, clientSet)
+	vpcCniDeleter(ctx, cfg.Metadata.Name, ctl, clientSet)
 	return nil
 }
 
 // Attempts to delete the vpc-cni, and fails silently if an error occurs. This is an attempt
 // to prevent a race condition in the vpc-cni #1849
-func attemptVpcCniDeletion(clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) {
+func attemptVpcCniDeletion(ctx context.Context, clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) {
 	vpcCNI := "vpc-cni"
 	logger.Debug("deleting EKS addon %q if it exists", vpcCNI)
-	_, err := ctl.Provider.EKS().DeleteAddon(&awseks.DeleteAddonInput{
+	_, err := ctl.Provider.EKS().DeleteAddon(ctx, &awseks.DeleteAddonInput{
 		ClusterName: &clusterName,
 		AddonName:   aws.String(vpcCNI),
 	})
 
 	if err != nil {
-		if awsError, ok := err.(awserr.Error); ok && awsError.Code() == awseks.ErrCodeResourceNotFoundException {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
 			logger.Debug("EKS addon %q does not exist", vpcCNI)
 		} else {
 			logger.Debug("failed to delete addon %q: %v", vpcCNI, err)
@@ -214,7 +324,7 @@ func attemptVpcCniDeletion(clusterName string, ctl *eks.ClusterProvider, clientS
 	}
 
 	logger.Debug("deleting kube-system/aws-node DaemonSet")
-	err = clientSet.AppsV1().DaemonSets("kube-system").Delete(context.TODO(), "aws-node", metav1.DeleteOptions{})
+	err = clientSet.AppsV1().DaemonSets("kube-system").Delete(ctx, "aws-node", metav1.DeleteOptions{})
 	if err != nil {
 		logger.Debug("failed to delete kube-system/aws-node DaemonSet: %w", err)
 	}
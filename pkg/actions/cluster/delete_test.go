@@ -1,6 +1,7 @@
 package cluster_test
 
 import (
+	"context"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
@@ -66,11 +67,11 @@ var _ = Describe("DrainAllNodeGroups", func() {
 				mockedDrainer := &drainerMock{}
 				mockedDrainer.On("Drain", mockedDrainInput).Return(nil)
 				vpcCniDeleterCalled := 0
-				vpcCniDeleter := func(clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) {
+				vpcCniDeleter := func(_ context.Context, clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) {
 					vpcCniDeleterCalled++
 				}
 
-				err := cluster.DrainAllNodeGroups(cfg, ctl, fakeClientSet, nodeGroupStacks, false, 1, mockedDrainer, vpcCniDeleter, time.Second*0)
+				err := cluster.DrainAllNodeGroups(context.TODO(), cfg, ctl, fakeClientSet, nodeGroupStacks, false, 1, mockedDrainer, vpcCniDeleter, time.Second*0)
 				Expect(err).NotTo(HaveOccurred())
 				mockedDrainer.AssertNumberOfCalls(GinkgoT(), "Drain", 1)
 				Expect(vpcCniDeleterCalled).To(Equal(1))
@@ -95,11 +96,11 @@ var _ = Describe("DrainAllNodeGroups", func() {
 				mockedDrainer := &drainerMock{}
 				mockedDrainer.On("Drain", mockedDrainInput).Return(nil)
 				vpcCniDeleterCalled := 0
-				vpcCniDeleter := func(clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) {
+				vpcCniDeleter := func(_ context.Context, clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) {
 					vpcCniDeleterCalled++
 				}
 
-				err := cluster.DrainAllNodeGroups(cfg, ctl, fakeClientSet, nodeGroupStacks, true, 1, mockedDrainer, vpcCniDeleter, time.Second*0)
+				err := cluster.DrainAllNodeGroups(context.TODO(), cfg, ctl, fakeClientSet, nodeGroupStacks, true, 1, mockedDrainer, vpcCniDeleter, time.Second*0)
 				Expect(err).NotTo(HaveOccurred())
 				mockedDrainer.AssertNumberOfCalls(GinkgoT(), "Drain", 1)
 				Expect(vpcCniDeleterCalled).To(Equal(1))
@@ -123,11 +124,11 @@ var _ = Describe("DrainAllNodeGroups", func() {
 				mockedDrainer := &drainerMock{}
 				mockedDrainer.On("Drain", mockedDrainInput).Return(nil)
 				vpcCniDeleterCalled := 0
-				vpcCniDeleter := func(clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) {
+				vpcCniDeleter := func(_ context.Context, clusterName string, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) {
 					vpcCniDeleterCalled++
 				}
 
-				err := cluster.DrainAllNodeGroups(cfg, ctl, fakeClientSet, nodeGroupStacks, false, 1, mockedDrainer, vpcCniDeleter, time.Second*0)
+				err := cluster.DrainAllNodeGroups(context.TODO(), cfg, ctl, fakeClientSet, nodeGroupStacks, false, 1, mockedDrainer, vpcCniDeleter, time.Second*0)
 				Expect(err).NotTo(HaveOccurred())
 				mockedDrainer.AssertNotCalled(GinkgoT(), "Drain")
 				Expect(vpcCniDeleterCalled).To(Equal(0))
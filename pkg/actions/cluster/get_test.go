@@ -7,8 +7,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
@@ -46,11 +46,11 @@ var _ = Describe("Get", func() {
 		When("it succeeds", func() {
 			BeforeEach(func() {
 
-				intialProvider.MockEKS().On("ListClusters", &awseks.ListClustersInput{
-					MaxResults: aws.Int64(100),
-					Include:    aws.StringSlice([]string{"all"}),
+				intialProvider.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
+					MaxResults: aws.Int32(100),
+					Include:    []string{"all"},
 				}).Return(&awseks.ListClustersOutput{
-					Clusters: aws.StringSlice([]string{"cluster1", "cluster2", "cluster3"}),
+					Clusters: []string{"cluster1", "cluster2", "cluster3"},
 				}, nil)
 
 				stackManager.ListClusterStackNamesReturns(nil, nil)
@@ -107,9 +107,9 @@ var _ = Describe("Get", func() {
 
 		When("ListClusters errors", func() {
 			BeforeEach(func() {
-				intialProvider.MockEKS().On("ListClusters", &awseks.ListClustersInput{
-					MaxResults: aws.Int64(100),
-					Include:    aws.StringSlice([]string{"all"}),
+				intialProvider.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
+					MaxResults: aws.Int32(100),
+					Include:    []string{"all"},
 				}).Return(nil, fmt.Errorf("foo"))
 			})
 
@@ -155,18 +155,18 @@ var _ = Describe("Get", func() {
 					},
 				}, nil)
 
-				providerRegion1.MockEKS().On("ListClusters", &awseks.ListClustersInput{
-					MaxResults: aws.Int64(100),
-					Include:    aws.StringSlice([]string{"all"}),
+				providerRegion1.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
+					MaxResults: aws.Int32(100),
+					Include:    []string{"all"},
 				}).Return(&awseks.ListClustersOutput{
-					Clusters: aws.StringSlice([]string{"cluster1"}),
+					Clusters: []string{"cluster1"},
 				}, nil)
 
-				providerRegion2.MockEKS().On("ListClusters", &awseks.ListClustersInput{
-					MaxResults: aws.Int64(100),
-					Include:    aws.StringSlice([]string{"all"}),
+				providerRegion2.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
+					MaxResults: aws.Int32(100),
+					Include:    []string{"all"},
 				}).Return(&awseks.ListClustersOutput{
-					Clusters: aws.StringSlice([]string{"cluster2"}),
+					Clusters: []string{"cluster2"},
 				}, nil)
 
 				stackManagerRegion1.ListClusterStackNamesReturns(nil, nil)
@@ -239,11 +239,11 @@ var _ = Describe("Get", func() {
 					},
 				}, nil)
 
-				providerRegion1.MockEKS().On("ListClusters", &awseks.ListClustersInput{
-					MaxResults: aws.Int64(100),
-					Include:    aws.StringSlice([]string{"all"}),
+				providerRegion1.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
+					MaxResults: aws.Int32(100),
+					Include:    []string{"all"},
 				}).Return(&awseks.ListClustersOutput{
-					Clusters: aws.StringSlice([]string{"cluster1"}),
+					Clusters: []string{"cluster1"},
 				}, nil)
 
 				stackManagerRegion1.ListClusterStackNamesReturns(nil, nil)
@@ -46,7 +46,7 @@ func (c *OwnedCluster) Upgrade(ctx context.Context, dryRun bool) error {
 		return errors.Wrapf(err, "getting VPC configuration for cluster %q", c.cfg.Metadata.Name)
 	}
 
-	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, dryRun)
+	versionUpdateRequired, err := upgrade(ctx, c.cfg, c.ctl, dryRun)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func (c *OwnedCluster) Upgrade(ctx context.Context, dryRun bool) error {
 	return nil
 }
 
-func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction bool, parallel int) error {
+func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction bool, parallel int, keep KeepResources) error {
 	var (
 		clientSet kubernetes.Interface
 		oidc      *iamoidc.OpenIDConnectManager
@@ -94,7 +94,7 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 			}
 		}
 
-		oidc, err = c.ctl.NewOpenIDConnectManager(c.cfg)
+		oidc, err = c.ctl.NewOpenIDConnectManager(ctx, c.cfg)
 		if err != nil {
 			if _, ok := err.(*eks.UnsupportedOIDCError); !ok {
 				if force {
@@ -107,7 +107,7 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 		}
 
 		nodeGroupManager := c.newNodeGroupManager(c.cfg, c.ctl, clientSet)
-		if err := drainAllNodeGroups(c.cfg, c.ctl, clientSet, allStacks, disableNodegroupEviction, parallel, nodeGroupManager, attemptVpcCniDeletion, podEvictionWaitPeriod); err != nil {
+		if err := drainAllNodeGroups(ctx, c.cfg, c.ctl, clientSet, allStacks, disableNodegroupEviction, parallel, nodeGroupManager, attemptVpcCniDeletion, podEvictionWaitPeriod); err != nil {
 			if !force {
 				return err
 			}
@@ -116,6 +116,11 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 		}
 	}
 
+	retained, err := retainResources(ctx, c.stackManager, keep)
+	if err != nil {
+		return errors.Wrap(err, "keeping cluster resources")
+	}
+
 	if err := deleteSharedResources(ctx, c.cfg, c.ctl, c.stackManager, clusterOperable, clientSet); err != nil {
 		if err != nil {
 			if force {
@@ -127,6 +132,20 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 	}
 
 	deleteOIDCProvider := clusterOperable && oidcSupported
+	if deleteOIDCProvider && keep.OIDCProvider {
+		// the IAM service accounts are deleted along with the OIDC provider, so they are deleted on their own here
+		serviceAccountTasks, err := newTasksToDeleteIAMServiceAccountsAndOIDCProvider(ctx, c.stackManager, oidc, kubernetes.NewCachedClientSet(clientSet), true)
+		if err != nil {
+			return err
+		}
+		if serviceAccountTasks.Len() > 0 {
+			logger.Info(serviceAccountTasks.Describe())
+			if errs := serviceAccountTasks.DoAllSync(); len(errs) > 0 {
+				return handleErrors(errs, "IAM service accounts")
+			}
+		}
+		deleteOIDCProvider = false
+	}
 	tasks, err := c.stackManager.NewTasksToDeleteClusterWithNodeGroups(ctx, c.clusterStack, allStacks, deleteOIDCProvider, oidc, kubernetes.NewCachedClientSet(clientSet), wait, func(errs chan error, _ string) error {
 		logger.Info("trying to cleanup dangling network interfaces")
 		if err := c.ctl.LoadClusterVPC(ctx, c.cfg, c.stackManager); err != nil {
@@ -158,10 +177,23 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 		return err
 	}
 
+	if err := deleteAutoAMIUpdatesStackIfExists(ctx, c.stackManager); err != nil {
+		return err
+	}
+
+	if err := deleteScheduledDeletionStackIfExists(ctx, c.stackManager); err != nil {
+		return err
+	}
+
 	if err := checkForUndeletedStacks(ctx, c.stackManager); err != nil {
 		return err
 	}
 
+	if keep != (KeepResources{}) {
+		logger.Success("all cluster resources were deleted, except for the ones kept")
+		logKeptResources(c.cfg.Metadata.Name, keep, retained)
+		return nil
+	}
 	logger.Success("all cluster resources were deleted")
 
 	return nil
@@ -6,8 +6,10 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
@@ -21,6 +23,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/testutils"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
@@ -63,23 +66,18 @@ var _ = Describe("Delete", func() {
 	Context("when the cluster is operable", func() {
 		It("deletes the cluster", func() {
 			//mocks are in order of being called
-			p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
-				Expect(*input.Name).To(Equal(clusterName))
-				return true
-			})).Return(&awseks.DescribeClusterOutput{
-				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
-			}, nil)
+			p.ExpectDescribeCluster(testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive))
 
-			p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+			p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 				ClusterName: strings.Pointer(clusterName),
-			}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"fargate-1"})}, nil)
+			}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: []string{"fargate-1"}}, nil)
 
-			p.MockEKS().On("DeleteFargateProfile", &awseks.DeleteFargateProfileInput{
+			p.MockEKS().On("DeleteFargateProfile", mock.Anything, &awseks.DeleteFargateProfileInput{
 				ClusterName:        aws.String(clusterName),
 				FargateProfileName: aws.String("fargate-1"),
 			}).Once().Return(&awseks.DeleteFargateProfileOutput{}, nil)
 
-			p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+			p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 				ClusterName: strings.Pointer(clusterName),
 			}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
 
@@ -92,7 +90,7 @@ var _ = Describe("Delete", func() {
 
 			p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
 
-			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
 			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
 				Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
@@ -106,6 +104,9 @@ var _ = Describe("Delete", func() {
 			}
 
 			fakeStackManager.GetKarpenterStackReturns(karpenterStack, nil)
+			fakeStackManager.GetAutoAMIUpdatesStackReturns(&manager.Stack{
+				StackName: aws.String("eksctl-my-cluster-auto-ami-updates"),
+			}, nil)
 
 			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager)
 			fakeClientSet = fake.NewSimpleClientset()
@@ -114,45 +115,42 @@ var _ = Describe("Delete", func() {
 				return fakeClientSet, nil
 			})
 
-			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1, cluster.KeepResources{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(ranDeleteDeprecatedTasks).To(BeTrue())
 			Expect(fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCallCount()).To(Equal(1))
 			Expect(ranDeleteClusterTasks).To(BeTrue())
-			Expect(fakeStackManager.DeleteStackSyncCallCount()).To(Equal(1))
+			Expect(fakeStackManager.DeleteStackSyncCallCount()).To(Equal(2))
 			_, stack := fakeStackManager.DeleteStackSyncArgsForCall(0)
 			Expect(*stack.StackName).To(Equal("karpenter"))
+			_, stack = fakeStackManager.DeleteStackSyncArgsForCall(1)
+			Expect(*stack.StackName).To(Equal("eksctl-my-cluster-auto-ami-updates"))
 		})
 
 		When("force flag is set to true", func() {
 			It("ignoring nodes draining error", func() {
 				ctl.Status = &eks.ProviderStatus{
 					ClusterInfo: &eks.ClusterInfo{
-						Cluster: &awseks.Cluster{
-							Status:  aws.String(awseks.ClusterStatusActive),
+						Cluster: &ekstypes.Cluster{
+							Status:  ekstypes.ClusterStatusActive,
 							Version: aws.String("1.21"),
 						},
 					},
 				}
 				//mocks are in order of being called
-				p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
-					Expect(*input.Name).To(Equal(clusterName))
-					return true
-				})).Return(&awseks.DescribeClusterOutput{
-					Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
-				}, nil)
+				p.ExpectDescribeCluster(testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive))
 
-				p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+				p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 					ClusterName: strings.Pointer(clusterName),
-				}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{})}, nil)
+				}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: []string{}}, nil)
 
-				p.MockEKS().On("DeleteFargateProfile", &awseks.DeleteFargateProfileInput{
+				p.MockEKS().On("DeleteFargateProfile", mock.Anything, &awseks.DeleteFargateProfileInput{
 					ClusterName:        aws.String(clusterName),
 					FargateProfileName: aws.String("fargate-1"),
 				}).Once().Return(&awseks.DeleteFargateProfileOutput{}, nil)
 
-				p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+				p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 					ClusterName: strings.Pointer(clusterName),
 				}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
 
@@ -164,7 +162,7 @@ var _ = Describe("Delete", func() {
 
 				p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
 
-				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
 				fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
 					Tasks: []tasks.Task{},
@@ -189,7 +187,7 @@ var _ = Describe("Delete", func() {
 					return mockedDrainer
 				})
 
-				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, true, false, 1)
+				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, true, false, 1, cluster.KeepResources{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 				Expect(ranDeleteDeprecatedTasks).To(BeFalse())
@@ -202,23 +200,18 @@ var _ = Describe("Delete", func() {
 		When("force flag is set to false", func() {
 			It("nodes draining error thrown", func() {
 				//mocks are in order of being called
-				p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
-					Expect(*input.Name).To(Equal(clusterName))
-					return true
-				})).Return(&awseks.DescribeClusterOutput{
-					Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
-				}, nil)
+				p.ExpectDescribeCluster(testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive))
 
-				p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+				p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 					ClusterName: strings.Pointer(clusterName),
-				}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{})}, nil)
+				}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: []string{}}, nil)
 
-				p.MockEKS().On("DeleteFargateProfile", &awseks.DeleteFargateProfileInput{
+				p.MockEKS().On("DeleteFargateProfile", mock.Anything, &awseks.DeleteFargateProfileInput{
 					ClusterName:        aws.String(clusterName),
 					FargateProfileName: aws.String("fargate-1"),
 				}).Once().Return(&awseks.DeleteFargateProfileOutput{}, nil)
 
-				p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+				p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 					ClusterName: strings.Pointer(clusterName),
 				}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
 
@@ -229,7 +222,7 @@ var _ = Describe("Delete", func() {
 
 				p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
 
-				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
 				fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
 					Tasks: []tasks.Task{},
@@ -249,8 +242,8 @@ var _ = Describe("Delete", func() {
 				}
 				ctl.Status = &eks.ProviderStatus{
 					ClusterInfo: &eks.ClusterInfo{
-						Cluster: &awseks.Cluster{
-							Status:  aws.String(awseks.ClusterStatusActive),
+						Cluster: &ekstypes.Cluster{
+							Status:  ekstypes.ClusterStatusActive,
 							Version: aws.String("1.21"),
 						},
 					},
@@ -262,7 +255,7 @@ var _ = Describe("Delete", func() {
 					return mockedDrainer
 				})
 
-				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1, cluster.KeepResources{})
 				Expect(err).To(MatchError(errorMessage))
 				Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(0))
 				Expect(ranDeleteDeprecatedTasks).To(BeFalse())
@@ -276,12 +269,7 @@ var _ = Describe("Delete", func() {
 	Context("when the cluster is inoperable", func() {
 		It("deletes the cluster without trying to query kubernetes", func() {
 			//mocks are in order of being called
-			p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
-				Expect(*input.Name).To(Equal(clusterName))
-				return true
-			})).Return(&awseks.DescribeClusterOutput{
-				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusFailed),
-			}, nil)
+			p.ExpectDescribeCluster(testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusFailed))
 
 			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{
 				Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
@@ -292,7 +280,7 @@ var _ = Describe("Delete", func() {
 
 			p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
 
-			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
 			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
 				Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
@@ -303,12 +291,63 @@ var _ = Describe("Delete", func() {
 
 			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager)
 
-			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1, cluster.KeepResources{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(ranDeleteDeprecatedTasks).To(BeTrue())
 			Expect(fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCallCount()).To(Equal(1))
 			Expect(ranDeleteClusterTasks).To(BeTrue())
 		})
+
+		It("retains the resources that are kept before deleting the stacks", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&awseks.DescribeClusterOutput{
+				Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusFailed),
+			}, nil)
+			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{}, nil)
+			p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
+			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+
+			clusterStack := &manager.Stack{StackName: aws.String("eksctl-my-cluster-cluster")}
+			fakeStackManager.DescribeStacksReturnsOnCall(0, []*manager.Stack{clusterStack}, nil)
+			fakeStackManager.StackStatusIsNotTransitionalReturns(true)
+			fakeStackManager.RetainResourcesReturns([]manager.RetainedResource{
+				{StackName: "eksctl-my-cluster-cluster", LogicalID: "VPC", PhysicalID: "vpc-123", Type: "AWS::EC2::VPC"},
+			}, nil)
+			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCalls(func(context.Context, *manager.Stack, []manager.NodeGroupStack, bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) (*tasks.TaskTree, error) {
+				Expect(fakeStackManager.RetainResourcesCallCount()).To(Equal(1))
+				return &tasks.TaskTree{
+					Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
+						ranDeleteClusterTasks = true
+						return nil
+					}}},
+				}, nil
+			})
+
+			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager)
+
+			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1, cluster.KeepResources{VPC: true, IAMRoles: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ranDeleteClusterTasks).To(BeTrue())
+			_, stack, resourceTypes := fakeStackManager.RetainResourcesArgsForCall(0)
+			Expect(stack).To(Equal(clusterStack))
+			Expect(resourceTypes).To(ContainElements("AWS::EC2::VPC", "AWS::EC2::Subnet", "AWS::IAM::Role", "AWS::IAM::InstanceProfile"))
+		})
+
+		It("doesn't delete anything if the resources that are kept can't be retained", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&awseks.DescribeClusterOutput{
+				Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusFailed),
+			}, nil)
+			fakeStackManager.DescribeStacksReturns([]*manager.Stack{{
+				StackName:   aws.String("eksctl-my-cluster-cluster"),
+				StackStatus: cfntypes.StackStatusDeleteFailed,
+			}}, nil)
+
+			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager)
+
+			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, true, false, 1, cluster.KeepResources{VPC: true})
+			Expect(err).To(MatchError(`keeping cluster resources: cannot keep the resources of stack "eksctl-my-cluster-cluster" with status "DELETE_FAILED"`))
+			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(0))
+			Expect(fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCallCount()).To(Equal(0))
+		})
 	})
 })
@@ -0,0 +1,147 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/lock"
+)
+
+// ExpiredCluster is a cluster created by eksctl whose TTL expired, see api.ClusterMeta.TTL
+type ExpiredCluster struct {
+	Name      string
+	ExpiresAt time.Time
+	// SkipReason is why the cluster is not deleted, it's empty if the cluster can be deleted
+	SkipReason string
+}
+
+// FindExpiredClusters returns the clusters created by eksctl in the region of provider that expired at now, the
+// clusters that can't be deleted safely are returned with the reason they are skipped
+func FindExpiredClusters(ctx context.Context, provider api.ClusterProvider, now time.Time) ([]ExpiredCluster, error) {
+	spec := &api.ClusterConfig{Metadata: &api.ClusterMeta{Name: ""}}
+	stackManager := newStackCollection(provider, spec)
+	stackNames, err := stackManager.ListClusterStackNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster stacks in region %q: %w", provider.Region(), err)
+	}
+
+	var expired []ExpiredCluster
+	for _, stackName := range stackNames {
+		stack, err := stackManager.DescribeStack(ctx, &manager.Stack{StackName: aws.String(stackName)})
+		if err != nil {
+			return nil, err
+		}
+		tags := map[string]string{}
+		for _, tag := range stack.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		expiresAt, ok, err := api.ExpiresAt(tags)
+		if err != nil {
+			logger.Warning("ignoring stack %q: %v", stackName, err)
+			continue
+		}
+		if !ok || expiresAt.After(now) {
+			continue
+		}
+
+		cluster := ExpiredCluster{
+			Name:      tags[api.ClusterNameTag],
+			ExpiresAt: expiresAt,
+		}
+		switch {
+		case cluster.Name == "":
+			logger.Warning("ignoring stack %q as it doesn't have the %s tag", stackName, api.ClusterNameTag)
+			continue
+		case aws.ToBool(stack.EnableTerminationProtection):
+			cluster.SkipReason = "termination protection is enabled on the cluster stack"
+		case !stackManager.StackStatusIsNotTransitional(stack):
+			cluster.SkipReason = fmt.Sprintf("the cluster stack is %s", stack.StackStatus)
+		}
+		expired = append(expired, cluster)
+	}
+	return expired, nil
+}
+
+// LockClusterFunc acquires the lock of a cluster, it returns a nil lock when the cluster can't be locked but can be
+// changed anyway
+type LockClusterFunc func(ctl *eks.ClusterProvider, clusterName string) (*lock.Lock, error)
+
+// DeleteExpiredClusters deletes the expired clusters that aren't skipped, one at a time, holding the lock of each
+// cluster while it's deleted. Clusters whose lock is held by another eksctl invocation are skipped, and it carries on
+// after failing to delete a cluster
+func DeleteExpiredClusters(ctx context.Context, providerConfig *api.ProviderConfig, clusters []ExpiredCluster, wait bool, lockCluster LockClusterFunc) error {
+	var attempted, failed int
+	for _, c := range clusters {
+		if c.SkipReason != "" {
+			continue
+		}
+		attempted++
+		if err := deleteExpiredCluster(ctx, providerConfig, c, wait, lockCluster); err != nil {
+			logger.Critical("failed to delete expired cluster %q: %v", c.Name, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d expired clusters", failed, attempted)
+	}
+	return nil
+}
+
+func deleteExpiredCluster(ctx context.Context, providerConfig *api.ProviderConfig, expired ExpiredCluster, wait bool, lockCluster LockClusterFunc) (err error) {
+	cfg := api.NewClusterConfig()
+	cfg.Metadata.Name = expired.Name
+
+	// each cluster needs its own provider, as the provider caches the status of the cluster
+	ctl, err := newClusterProvider(ctx, providerConfig, cfg)
+	if err != nil {
+		return err
+	}
+
+	l, err := lockCluster(ctl, expired.Name)
+	if err != nil {
+		var heldErr *lock.HeldError
+		if errors.As(err, &heldErr) {
+			logger.Warning("skipping expired cluster %q as it's being changed by %s", expired.Name, heldErr.Holder)
+			return nil
+		}
+		return err
+	}
+	if l != nil {
+		defer func() {
+			switch releaseErr := l.Release(context.TODO()); {
+			case errors.Is(releaseErr, lock.ErrLost) && err == nil:
+				err = releaseErr
+			case releaseErr != nil:
+				logger.Warning("failed to release the lock of cluster %q: %v", expired.Name, releaseErr)
+			}
+		}()
+	}
+	if l != nil {
+		// stop deleting the cluster if its lock is lost
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-l.Lost():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	logger.Info("deleting cluster %q, which expired at %s", expired.Name, formatDeletionTime(expired.ExpiresAt))
+	c, err := New(ctx, cfg, ctl)
+	if err != nil {
+		return err
+	}
+	return c.Delete(ctx, 20*time.Second, 10*time.Second, wait, false, false, 1, KeepResources{})
+}
@@ -0,0 +1,119 @@
+package cluster_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/cluster/fakes"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	mgrfakes "github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/lock"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("FindExpiredClusters", func() {
+	var (
+		stackManager *mgrfakes.FakeStackManager
+		stacks       map[string]*manager.Stack
+		now          time.Time
+	)
+
+	clusterStack := func(clusterName, expiresAt string) *manager.Stack {
+		stack := &manager.Stack{
+			StackName:   aws.String("eksctl-" + clusterName + "-cluster"),
+			StackStatus: cfntypes.StackStatusCreateComplete,
+			Tags: []cfntypes.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)},
+			},
+		}
+		if expiresAt != "" {
+			stack.Tags = append(stack.Tags, cfntypes.Tag{Key: aws.String(api.ExpiresAtTag), Value: aws.String(expiresAt)})
+		}
+		return stack
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2022, 3, 4, 12, 0, 0, 0, time.UTC)
+		stackManager = new(mgrfakes.FakeStackManager)
+		stackCollectionProvider := new(fakes.FakeStackManagerConstructor)
+		stackCollectionProvider.Returns(stackManager)
+		cluster.SetStackManagerConstructor(stackCollectionProvider.Spy)
+
+		stacks = map[string]*manager.Stack{}
+		stackManager.ListClusterStackNamesStub = func(context.Context) ([]string, error) {
+			var names []string
+			for name := range stacks {
+				names = append(names, name)
+			}
+			return names, nil
+		}
+		stackManager.DescribeStackStub = func(_ context.Context, s *manager.Stack) (*manager.Stack, error) {
+			return stacks[*s.StackName], nil
+		}
+		stackManager.StackStatusIsNotTransitionalStub = func(s *manager.Stack) bool {
+			return s.StackStatus == cfntypes.StackStatusCreateComplete || s.StackStatus == cfntypes.StackStatusUpdateComplete
+		}
+	})
+
+	addStack := func(stack *manager.Stack) {
+		stacks[*stack.StackName] = stack
+	}
+
+	It("returns the clusters that expired", func() {
+		addStack(clusterStack("expired", "2022-03-04T11:00:00Z"))
+		addStack(clusterStack("not-expired", "2022-03-04T13:00:00Z"))
+		addStack(clusterStack("no-ttl", ""))
+		addStack(clusterStack("invalid-ttl", "tomorrow"))
+
+		expired, err := cluster.FindExpiredClusters(context.Background(), mockprovider.NewMockProvider(), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(ConsistOf(cluster.ExpiredCluster{
+			Name:      "expired",
+			ExpiresAt: time.Date(2022, 3, 4, 11, 0, 0, 0, time.UTC),
+		}))
+	})
+
+	It("skips the clusters that can't be deleted safely", func() {
+		protected := clusterStack("protected", "2022-03-04T11:00:00Z")
+		protected.EnableTerminationProtection = aws.Bool(true)
+		addStack(protected)
+		updating := clusterStack("updating", "2022-03-04T11:00:00Z")
+		updating.StackStatus = cfntypes.StackStatusUpdateInProgress
+		addStack(updating)
+		untagged := clusterStack("untagged", "2022-03-04T11:00:00Z")
+		untagged.Tags = untagged.Tags[1:]
+		addStack(untagged)
+
+		expired, err := cluster.FindExpiredClusters(context.Background(), mockprovider.NewMockProvider(), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(ConsistOf(
+			cluster.ExpiredCluster{
+				Name:       "protected",
+				ExpiresAt:  time.Date(2022, 3, 4, 11, 0, 0, 0, time.UTC),
+				SkipReason: "termination protection is enabled on the cluster stack",
+			},
+			cluster.ExpiredCluster{
+				Name:       "updating",
+				ExpiresAt:  time.Date(2022, 3, 4, 11, 0, 0, 0, time.UTC),
+				SkipReason: "the cluster stack is UPDATE_IN_PROGRESS",
+			},
+		))
+	})
+
+	It("doesn't delete skipped clusters", func() {
+		Expect(cluster.DeleteExpiredClusters(context.Background(), &api.ProviderConfig{}, []cluster.ExpiredCluster{
+			{Name: "protected", SkipReason: "termination protection is enabled on the cluster stack"},
+		}, false, func(*eks.ClusterProvider, string) (*lock.Lock, error) {
+			Fail("skipped clusters must not be locked")
+			return nil, nil
+		})).To(Succeed())
+	})
+})
@@ -0,0 +1,129 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// MinScheduledDeletionDelay is how long after now a deletion can be scheduled at the earliest, so that the stack
+// that deletes the cluster is created before then
+const MinScheduledDeletionDelay = 5 * time.Minute
+
+// DeletionSchedule schedules the deletion of a cluster at a later time instead of deleting it right away
+type DeletionSchedule struct {
+	// At is the time of the deletion
+	At time.Time
+	// Cancel cancels the deletion that is scheduled
+	Cancel bool
+}
+
+// IsSet returns true if the deletion is scheduled or cancelled rather than done right away
+func (s DeletionSchedule) IsSet() bool {
+	return !s.At.IsZero() || s.Cancel
+}
+
+// ScheduleDeletion creates or updates the stack that deletes the cluster and all its stacks at deleteAt
+func (c *OwnedCluster) ScheduleDeletion(ctx context.Context, deleteAt time.Time) error {
+	clusterName := c.cfg.Metadata.Name
+	if deleteAt.Before(time.Now().Add(MinScheduledDeletionDelay)) {
+		return fmt.Errorf("the deletion must be scheduled at least %s from now, got %s", MinScheduledDeletionDelay, formatDeletionTime(deleteAt))
+	}
+
+	resourceSet := builder.NewScheduledDeletionResourceSet(c.cfg, *c.clusterStack.StackName, c.ctl.Provider.CloudFormationRoleARN(), deleteAt)
+	if err := resourceSet.AddAllResources(); err != nil {
+		return err
+	}
+
+	stackName := manager.MakeScheduledDeletionStackName(clusterName)
+	stack, err := c.stackManager.GetScheduledDeletionStack(ctx)
+	if err != nil {
+		return fmt.Errorf("describing scheduled deletion stack: %w", err)
+	}
+
+	if stack == nil {
+		errs := make(chan error)
+		if err := c.stackManager.CreateStack(ctx, stackName, resourceSet, nil, nil, errs); err != nil {
+			return err
+		}
+		if err := <-errs; err != nil {
+			return err
+		}
+	} else {
+		templateBody, err := resourceSet.RenderJSON()
+		if err != nil {
+			return err
+		}
+		if err := c.stackManager.UpdateStack(ctx, manager.UpdateStackOptions{
+			Stack:         stack,
+			ChangeSetName: c.stackManager.MakeChangeSetName("update-scheduled-deletion"),
+			Description:   fmt.Sprintf("updating stack %q", stackName),
+			TemplateData:  manager.TemplateBody(templateBody),
+			Wait:          true,
+		}); err != nil {
+			return err
+		}
+	}
+	logger.Success("cluster %q will be deleted at %s, run 'eksctl delete cluster --name=%s --cancel-schedule' to cancel the deletion", clusterName, formatDeletionTime(deleteAt), clusterName)
+	return nil
+}
+
+// CancelScheduledDeletion deletes the stack that deletes the cluster at a later time
+func (c *OwnedCluster) CancelScheduledDeletion(ctx context.Context, wait bool) error {
+	clusterName := c.cfg.Metadata.Name
+	stack, err := c.stackManager.GetScheduledDeletionStack(ctx)
+	if err != nil {
+		return fmt.Errorf("describing scheduled deletion stack: %w", err)
+	}
+	if stack == nil {
+		logger.Info("no deletion of cluster %q is scheduled", clusterName)
+		return nil
+	}
+
+	if wait {
+		err = c.stackManager.DeleteStackSync(ctx, stack)
+	} else {
+		_, err = c.stackManager.DeleteStackBySpec(ctx, stack)
+	}
+	if err != nil {
+		return fmt.Errorf("deleting stack %q: %w", *stack.StackName, err)
+	}
+	logger.Success("cancelled the scheduled deletion of cluster %q", clusterName)
+	return nil
+}
+
+// ScheduleDeletion returns an error, as only clusters created by eksctl can be deleted by the stacks eksctl creates
+func (c *UnownedCluster) ScheduleDeletion(_ context.Context, _ time.Time) error {
+	return errScheduledDeletionUnsupported(c.cfg.Metadata.Name)
+}
+
+// CancelScheduledDeletion returns an error, see ScheduleDeletion
+func (c *UnownedCluster) CancelScheduledDeletion(_ context.Context, _ bool) error {
+	return errScheduledDeletionUnsupported(c.cfg.Metadata.Name)
+}
+
+func errScheduledDeletionUnsupported(clusterName string) error {
+	return fmt.Errorf("cluster %q was not created by eksctl, only the deletion of clusters created by eksctl can be scheduled", clusterName)
+}
+
+// deleteScheduledDeletionStackIfExists deletes the stack that deletes the cluster at a later time
+func deleteScheduledDeletionStackIfExists(ctx context.Context, stackManager manager.StackManager) error {
+	stack, err := stackManager.GetScheduledDeletionStack(ctx)
+	if err != nil {
+		return err
+	}
+	if stack != nil {
+		logger.Info("deleting scheduled deletion stack")
+		return stackManager.DeleteStackSync(ctx, stack)
+	}
+	return nil
+}
+
+func formatDeletionTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,95 @@
+package cluster_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Scheduled deletion", func() {
+	const stackName = "eksctl-my-cluster-scheduled-deletion"
+
+	var (
+		cfg              *api.ClusterConfig
+		ctl              *eks.ClusterProvider
+		fakeStackManager *fakes.FakeStackManager
+		c                *cluster.OwnedCluster
+		deleteAt         time.Time
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		ctl = &eks.ClusterProvider{Provider: mockprovider.NewMockProvider(), Status: &eks.ProviderStatus{}}
+		fakeStackManager = new(fakes.FakeStackManager)
+		c = cluster.NewOwnedCluster(cfg, ctl, &manager.Stack{StackName: aws.String("eksctl-my-cluster-cluster")}, fakeStackManager)
+		deleteAt = time.Now().Add(72 * time.Hour)
+	})
+
+	It("creates the stack when no deletion is scheduled", func() {
+		fakeStackManager.GetScheduledDeletionStackReturns(nil, nil)
+		fakeStackManager.CreateStackStub = func(_ context.Context, _ string, _ builder.ResourceSetReader, _, _ map[string]string, errs chan error) error {
+			go func() { errs <- nil }()
+			return nil
+		}
+
+		Expect(c.ScheduleDeletion(context.Background(), deleteAt)).To(Succeed())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+		_, name, resourceSet, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+		Expect(name).To(Equal(stackName))
+		template, err := resourceSet.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(template)).To(ContainSubstring(builder.ScheduledDeletionExpression(deleteAt)))
+		Expect(string(template)).To(ContainSubstring(`"clusterStackName": "eksctl-my-cluster-cluster"`))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+	})
+
+	It("updates the stack to change the time of a scheduled deletion", func() {
+		fakeStackManager.GetScheduledDeletionStackReturns(&manager.Stack{StackName: aws.String(stackName)}, nil)
+
+		Expect(c.ScheduleDeletion(context.Background(), deleteAt)).To(Succeed())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+		_, updateOptions := fakeStackManager.UpdateStackArgsForCall(0)
+		Expect(*updateOptions.Stack.StackName).To(Equal(stackName))
+		Expect(updateOptions.TemplateData).To(BeAssignableToTypeOf(manager.TemplateBody{}))
+	})
+
+	It("fails to schedule a deletion too soon", func() {
+		err := c.ScheduleDeletion(context.Background(), time.Now().Add(time.Minute))
+		Expect(err).To(MatchError(ContainSubstring("the deletion must be scheduled at least 5m0s from now")))
+		Expect(fakeStackManager.GetScheduledDeletionStackCallCount()).To(Equal(0))
+	})
+
+	It("deletes the stack to cancel a scheduled deletion", func() {
+		fakeStackManager.GetScheduledDeletionStackReturns(&manager.Stack{StackName: aws.String(stackName)}, nil)
+
+		Expect(c.CancelScheduledDeletion(context.Background(), true)).To(Succeed())
+		Expect(fakeStackManager.DeleteStackSyncCallCount()).To(Equal(1))
+	})
+
+	It("does nothing to cancel a deletion that is not scheduled", func() {
+		fakeStackManager.GetScheduledDeletionStackReturns(nil, nil)
+
+		Expect(c.CancelScheduledDeletion(context.Background(), false)).To(Succeed())
+		Expect(fakeStackManager.DeleteStackSyncCallCount()).To(Equal(0))
+		Expect(fakeStackManager.DeleteStackBySpecCallCount()).To(Equal(0))
+	})
+
+	It("fails to schedule the deletion of clusters not created by eksctl", func() {
+		err := cluster.NewUnownedCluster(cfg, ctl, fakeStackManager).ScheduleDeletion(context.Background(), deleteAt)
+		Expect(err).To(MatchError(`cluster "my-cluster" was not created by eksctl, only the deletion of clusters created by eksctl can be scheduled`))
+	})
+})
@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/request"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
@@ -44,8 +43,8 @@ func NewUnownedCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackMa
 	}
 }
 
-func (c *UnownedCluster) Upgrade(_ context.Context, dryRun bool) error {
-	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, dryRun)
+func (c *UnownedCluster) Upgrade(ctx context.Context, dryRun bool) error {
+	versionUpdateRequired, err := upgrade(ctx, c.cfg, c.ctl, dryRun)
 	if err != nil {
 		return err
 	}
@@ -55,10 +54,10 @@ func (c *UnownedCluster) Upgrade(_ context.Context, dryRun bool) error {
 	return nil
 }
 
-func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction bool, parallel int) error {
+func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction bool, parallel int, keep KeepResources) error {
 	clusterName := c.cfg.Metadata.Name
 
-	if err := c.checkClusterExists(clusterName); err != nil {
+	if err := c.checkClusterExists(ctx, clusterName); err != nil {
 		return err
 	}
 
@@ -80,7 +79,7 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 		}
 
 		nodeGroupManager := c.newNodeGroupManager(c.cfg, c.ctl, clientSet)
-		if err := drainAllNodeGroups(c.cfg, c.ctl, clientSet, allStacks, disableNodegroupEviction, parallel, nodeGroupManager, attemptVpcCniDeletion, podEvictionWaitPeriod); err != nil {
+		if err := drainAllNodeGroups(ctx, c.cfg, c.ctl, clientSet, allStacks, disableNodegroupEviction, parallel, nodeGroupManager, attemptVpcCniDeletion, podEvictionWaitPeriod); err != nil {
 			if !force {
 				return err
 			}
@@ -89,6 +88,14 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 		}
 	}
 
+	if keep.VPC {
+		logger.Info("the VPC of cluster %q wasn't created by eksctl and is never deleted, --keep-vpc has no effect", clusterName)
+	}
+	retained, err := retainResources(ctx, c.stackManager, keep)
+	if err != nil {
+		return errors.Wrap(err, "keeping cluster resources")
+	}
+
 	if err := deleteSharedResources(ctx, c.cfg, c.ctl, c.stackManager, clusterOperable, clientSet); err != nil {
 		if err != nil {
 			if force {
@@ -105,11 +112,11 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 
 	// we have to wait for nodegroups to delete before deleting the cluster
 	// so the `wait` value is ignored here
-	if err := c.deleteAndWaitForNodegroupsDeletion(waitInterval, allStacks); err != nil {
+	if err := c.deleteAndWaitForNodegroupsDeletion(ctx, waitInterval, allStacks); err != nil {
 		return err
 	}
 
-	if err := c.deleteIAMAndOIDC(ctx, wait, clusterOperable, clientSet); err != nil {
+	if err := c.deleteIAMAndOIDC(ctx, wait, clusterOperable, clientSet, keep.OIDCProvider); err != nil {
 		if err != nil {
 			if force {
 				logger.Warning("error occurred during deletion: %v", err)
@@ -119,7 +126,11 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 		}
 	}
 
-	if err := c.deleteCluster(wait); err != nil {
+	if err := c.deleteCluster(ctx, wait); err != nil {
+		return err
+	}
+
+	if err := deleteAutoAMIUpdatesStackIfExists(ctx, c.stackManager); err != nil {
 		return err
 	}
 
@@ -127,6 +138,11 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 		return err
 	}
 
+	if keep != (KeepResources{}) {
+		logger.Success("all cluster resources were deleted, except for the ones kept")
+		logKeptResources(clusterName, keep, retained)
+		return nil
+	}
 	logger.Success("all cluster resources were deleted")
 	return nil
 }
@@ -147,8 +163,8 @@ func (c *UnownedCluster) deleteFargateRoleIfExists(ctx context.Context) error {
 	return nil
 }
 
-func (c *UnownedCluster) checkClusterExists(clusterName string) error {
-	_, err := c.ctl.Provider.EKS().DescribeCluster(&awseks.DescribeClusterInput{
+func (c *UnownedCluster) checkClusterExists(ctx context.Context, clusterName string) error {
+	_, err := c.ctl.Provider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
 		Name: &c.cfg.Metadata.Name,
 	})
 	if err != nil {
@@ -160,13 +176,13 @@ func (c *UnownedCluster) checkClusterExists(clusterName string) error {
 	return nil
 }
 
-func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, clusterOperable bool, clientSet kubernetes.Interface) error {
+func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, clusterOperable bool, clientSet kubernetes.Interface, keepOIDCProvider bool) error {
 	var oidc *iamoidc.OpenIDConnectManager
 	oidcSupported := true
 
 	if clusterOperable {
 		var err error
-		oidc, err = c.ctl.NewOpenIDConnectManager(c.cfg)
+		oidc, err = c.ctl.NewOpenIDConnectManager(ctx, c.cfg)
 		if err != nil {
 			if _, ok := err.(*eks.UnsupportedOIDCError); !ok {
 				return err
@@ -179,7 +195,7 @@ func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, cluste
 
 	if clusterOperable && oidcSupported {
 		clientSetGetter := kubernetes.NewCachedClientSet(clientSet)
-		serviceAccountAndOIDCTasks, err := c.stackManager.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx, oidc, clientSetGetter)
+		serviceAccountAndOIDCTasks, err := newTasksToDeleteIAMServiceAccountsAndOIDCProvider(ctx, c.stackManager, oidc, clientSetGetter, keepOIDCProvider)
 		if err != nil {
 			return err
 		}
@@ -214,10 +230,10 @@ func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, cluste
 	return nil
 }
 
-func (c *UnownedCluster) deleteCluster(wait bool) error {
+func (c *UnownedCluster) deleteCluster(ctx context.Context, wait bool) error {
 	clusterName := c.cfg.Metadata.Name
 
-	out, err := c.ctl.Provider.EKS().DeleteCluster(&awseks.DeleteClusterInput{
+	out, err := c.ctl.Provider.EKS().DeleteCluster(ctx, &awseks.DeleteClusterInput{
 		Name: &clusterName,
 	})
 
@@ -227,34 +243,35 @@ func (c *UnownedCluster) deleteCluster(wait bool) error {
 
 	logger.Info("initiated deletion of cluster %q", clusterName)
 	if out != nil {
-		logger.Debug("delete cluster response: %s", out.String())
+		logger.Debug("delete cluster response: %+v", out.Cluster)
 	}
 
 	if !wait {
 		logger.Info("to see the status of the deletion run `eksctl get cluster --name %s --region %s`", clusterName, c.cfg.Metadata.Region)
 		return nil
 	}
-	newRequest := func() *request.Request {
-		input := &awseks.DescribeClusterInput{
-			Name: &clusterName,
-		}
-		req, _ := c.ctl.Provider.EKS().DescribeClusterRequest(input)
-		return req
-	}
-
-	acceptors := waiters.MakeErrorCodeAcceptors(awseks.ErrCodeResourceNotFoundException)
-
 	msg := fmt.Sprintf("waiting for cluster %q to be deleted", clusterName)
 
-	return waiters.Wait(clusterName, msg, acceptors, newRequest, c.ctl.Provider.WaitTimeout(), nil)
+	return waiters.Wait(ctx, msg, func(ctx context.Context) (bool, error) {
+		_, err := c.ctl.Provider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
+			Name: &clusterName,
+		})
+		if err != nil {
+			if isNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	}, c.ctl.Provider.WaitTimeout())
 }
 
-func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Duration, allStacks []manager.NodeGroupStack) error {
+func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(ctx context.Context, waitInterval time.Duration, allStacks []manager.NodeGroupStack) error {
 	clusterName := c.cfg.Metadata.Name
 	eksAPI := c.ctl.Provider.EKS()
 
 	// get all managed nodegroups for this cluster
-	nodeGroups, err := eksAPI.ListNodegroups(&awseks.ListNodegroupsInput{
+	nodeGroups, err := eksAPI.ListNodegroups(ctx, &awseks.ListNodegroupsInput{
 		ClusterName: &clusterName,
 	})
 	if err != nil {
@@ -275,7 +292,7 @@ func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Du
 	for _, n := range nodeGroups.Nodegroups {
 		isUnowned := func() bool {
 			for _, stack := range allStacks {
-				if stack.NodeGroupName == *n {
+				if stack.NodeGroupName == n {
 					return false
 				}
 			}
@@ -284,7 +301,7 @@ func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Du
 
 		if isUnowned() {
 			// if a managed ng does not have a stack, we queue if for deletion via api
-			tasks.Append(c.stackManager.NewTaskToDeleteUnownedNodeGroup(clusterName, *n, eksAPI, c.waitForUnownedNgsDeletion(waitInterval)))
+			tasks.Append(c.stackManager.NewTaskToDeleteUnownedNodeGroup(ctx, clusterName, n, eksAPI, c.waitForUnownedNgsDeletion(ctx, waitInterval)))
 		}
 	}
 
@@ -298,13 +315,13 @@ func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Du
 }
 
 func isNotFound(err error) bool {
-	awsError, ok := err.(awserr.Error)
-	return ok && awsError.Code() == awseks.ErrCodeResourceNotFoundException
+	var notFoundErr *ekstypes.ResourceNotFoundException
+	return errors.As(err, &notFoundErr)
 }
 
-func (c *UnownedCluster) waitForUnownedNgsDeletion(interval time.Duration) *manager.DeleteWaitCondition {
+func (c *UnownedCluster) waitForUnownedNgsDeletion(ctx context.Context, interval time.Duration) *manager.DeleteWaitCondition {
 	condition := func() (bool, error) {
-		nodeGroups, err := c.ctl.Provider.EKS().ListNodegroups(&awseks.ListNodegroupsInput{
+		nodeGroups, err := c.ctl.Provider.EKS().ListNodegroups(ctx, &awseks.ListNodegroupsInput{
 			ClusterName: &c.cfg.Metadata.Name,
 		})
 		if err != nil {
@@ -4,11 +4,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
@@ -61,27 +62,27 @@ var _ = Describe("Delete", func() {
 	Context("when the cluster is operable", func() {
 		It("deletes the cluster", func() {
 			//mocks are in order of being called
-			p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
 				return *input.Name == clusterName
 			})).Return(&awseks.DescribeClusterOutput{
-				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
+				Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive),
 			}, nil)
 
-			p.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+			p.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 				ClusterName: strings.Pointer(clusterName),
 				AddonName:   strings.Pointer("vpc-cni"),
 			}).Return(&awseks.DeleteAddonOutput{}, nil)
 
-			p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+			p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 				ClusterName: strings.Pointer(clusterName),
-			}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"fargate-1"})}, nil)
+			}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: []string{"fargate-1"}}, nil)
 
-			p.MockEKS().On("DeleteFargateProfile", &awseks.DeleteFargateProfileInput{
+			p.MockEKS().On("DeleteFargateProfile", mock.Anything, &awseks.DeleteFargateProfileInput{
 				ClusterName:        aws.String(clusterName),
 				FargateProfileName: aws.String("fargate-1"),
 			}).Once().Return(&awseks.DeleteFargateProfileOutput{}, nil)
 
-			p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+			p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 				ClusterName: strings.Pointer(clusterName),
 			}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
 
@@ -114,13 +115,13 @@ var _ = Describe("Delete", func() {
 
 			p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
 
-			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
 			fakeStackManager.GetFargateStackReturns(&types.Stack{StackName: aws.String("fargate-role")}, nil)
 			fakeStackManager.DeleteStackBySpecReturns(nil, nil)
 
-			p.MockEKS().On("ListNodegroups", mock.Anything).Return(&awseks.ListNodegroupsOutput{
-				Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"}),
+			p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything).Return(&awseks.ListNodegroupsOutput{
+				Nodegroups: []string{"ng-1", "ng-2"},
 			}, nil)
 
 			fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{{NodeGroupName: "ng-1"}}, nil)
@@ -141,10 +142,10 @@ var _ = Describe("Delete", func() {
 				}}},
 			})
 
-			p.MockEKS().On("DeleteNodegroup", &awseks.DeleteNodegroupInput{ClusterName: &clusterName, NodegroupName: aws.String("ng-1")}).Return(&awseks.DeleteNodegroupOutput{}, nil)
-			p.MockEKS().On("DeleteNodegroup", &awseks.DeleteNodegroupInput{ClusterName: &clusterName, NodegroupName: aws.String("ng-2")}).Return(&awseks.DeleteNodegroupOutput{}, nil)
+			p.MockEKS().On("DeleteNodegroup", mock.Anything, &awseks.DeleteNodegroupInput{ClusterName: &clusterName, NodegroupName: aws.String("ng-1")}).Return(&awseks.DeleteNodegroupOutput{}, nil)
+			p.MockEKS().On("DeleteNodegroup", mock.Anything, &awseks.DeleteNodegroupInput{ClusterName: &clusterName, NodegroupName: aws.String("ng-2")}).Return(&awseks.DeleteNodegroupOutput{}, nil)
 
-			p.MockEKS().On("DeleteCluster", mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
+			p.MockEKS().On("DeleteCluster", mock.Anything, mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
 			c := cluster.NewUnownedCluster(cfg, ctl, fakeStackManager)
 			fakeClientSet := fake.NewSimpleClientset()
 
@@ -152,7 +153,7 @@ var _ = Describe("Delete", func() {
 				return fakeClientSet, nil
 			})
 
-			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1, cluster.KeepResources{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(deleteCallCount).To(Equal(1))
 			Expect(unownedDeleteCallCount).To(Equal(1))
@@ -166,27 +167,27 @@ var _ = Describe("Delete", func() {
 		When("force flag is set to true", func() {
 			It("ignoring nodes draining error", func() {
 				//mocks are in order of being called
-				p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+				p.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
 					return *input.Name == clusterName
 				})).Return(&awseks.DescribeClusterOutput{
-					Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
+					Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive),
 				}, nil)
 
-				p.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				p.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					ClusterName: strings.Pointer(clusterName),
 					AddonName:   strings.Pointer("vpc-cni"),
 				}).Return(&awseks.DeleteAddonOutput{}, nil)
 
-				p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+				p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 					ClusterName: strings.Pointer(clusterName),
 				}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
 
-				p.MockEKS().On("DeleteFargateProfile", &awseks.DeleteFargateProfileInput{
+				p.MockEKS().On("DeleteFargateProfile", mock.Anything, &awseks.DeleteFargateProfileInput{
 					ClusterName:        aws.String(clusterName),
 					FargateProfileName: aws.String("fargate-1"),
 				}).Once().Return(&awseks.DeleteFargateProfileOutput{}, nil)
 
-				p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+				p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 					ClusterName: strings.Pointer(clusterName),
 				}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
 
@@ -203,13 +204,13 @@ var _ = Describe("Delete", func() {
 
 				p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
 
-				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
 				fakeStackManager.GetFargateStackReturns(nil, nil)
 				fakeStackManager.DeleteStackBySpecReturns(nil, nil)
 
-				p.MockEKS().On("ListNodegroups", mock.Anything).Return(&awseks.ListNodegroupsOutput{
-					Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"}),
+				p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything).Return(&awseks.ListNodegroupsOutput{
+					Nodegroups: []string{"ng-1", "ng-2"},
 				}, nil)
 
 				fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{{NodeGroupName: "ng-1"}}, nil)
@@ -224,14 +225,14 @@ var _ = Describe("Delete", func() {
 					Tasks: []tasks.Task{},
 				})
 
-				p.MockEKS().On("DeleteNodegroup", nil).Return(&awseks.DeleteNodegroupOutput{}, nil)
-				p.MockEKS().On("DeleteNodegroup", nil).Return(&awseks.DeleteNodegroupOutput{}, nil)
+				p.MockEKS().On("DeleteNodegroup", mock.Anything, nil).Return(&awseks.DeleteNodegroupOutput{}, nil)
+				p.MockEKS().On("DeleteNodegroup", mock.Anything, nil).Return(&awseks.DeleteNodegroupOutput{}, nil)
 
-				p.MockEKS().On("DeleteCluster", mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
+				p.MockEKS().On("DeleteCluster", mock.Anything, mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
 				ctl.Status = &eks.ProviderStatus{
 					ClusterInfo: &eks.ClusterInfo{
-						Cluster: &awseks.Cluster{
-							Status:  aws.String(awseks.ClusterStatusActive),
+						Cluster: &ekstypes.Cluster{
+							Status:  ekstypes.ClusterStatusActive,
 							Version: aws.String("1.21"),
 						},
 					},
@@ -255,7 +256,7 @@ var _ = Describe("Delete", func() {
 					return mockedDrainer
 				})
 
-				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, true, false, 1)
+				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, true, false, 1, cluster.KeepResources{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(deleteCallCount).To(Equal(0))
 				Expect(unownedDeleteCallCount).To(Equal(0))
@@ -269,27 +270,27 @@ var _ = Describe("Delete", func() {
 		When("force flag is set to false", func() {
 			It("nodes draining error thrown", func() {
 				//mocks are in order of being called
-				p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+				p.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
 					return *input.Name == clusterName
 				})).Return(&awseks.DescribeClusterOutput{
-					Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
+					Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive),
 				}, nil)
 
-				p.MockEKS().On("DeleteAddon", &awseks.DeleteAddonInput{
+				p.MockEKS().On("DeleteAddon", mock.Anything, &awseks.DeleteAddonInput{
 					ClusterName: strings.Pointer(clusterName),
 					AddonName:   strings.Pointer("vpc-cni"),
 				}).Return(&awseks.DeleteAddonOutput{}, nil)
 
-				p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+				p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 					ClusterName: strings.Pointer(clusterName),
 				}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
 
-				p.MockEKS().On("DeleteFargateProfile", &awseks.DeleteFargateProfileInput{
+				p.MockEKS().On("DeleteFargateProfile", mock.Anything, &awseks.DeleteFargateProfileInput{
 					ClusterName:        aws.String(clusterName),
 					FargateProfileName: aws.String("fargate-1"),
 				}).Once().Return(&awseks.DeleteFargateProfileOutput{}, nil)
 
-				p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+				p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
 					ClusterName: strings.Pointer(clusterName),
 				}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
 
@@ -306,13 +307,13 @@ var _ = Describe("Delete", func() {
 
 				p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
 
-				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
 				fakeStackManager.GetFargateStackReturns(nil, nil)
 				fakeStackManager.DeleteStackBySpecReturns(nil, nil)
 
-				p.MockEKS().On("ListNodegroups", mock.Anything).Return(&awseks.ListNodegroupsOutput{
-					Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"}),
+				p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything).Return(&awseks.ListNodegroupsOutput{
+					Nodegroups: []string{"ng-1", "ng-2"},
 				}, nil)
 
 				fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{{NodeGroupName: "ng-1"}}, nil)
@@ -327,10 +328,10 @@ var _ = Describe("Delete", func() {
 					Tasks: []tasks.Task{},
 				})
 
-				p.MockEKS().On("DeleteNodegroup", nil).Return(&awseks.DeleteNodegroupOutput{}, nil)
-				p.MockEKS().On("DeleteNodegroup", nil).Return(&awseks.DeleteNodegroupOutput{}, nil)
+				p.MockEKS().On("DeleteNodegroup", mock.Anything, nil).Return(&awseks.DeleteNodegroupOutput{}, nil)
+				p.MockEKS().On("DeleteNodegroup", mock.Anything, nil).Return(&awseks.DeleteNodegroupOutput{}, nil)
 
-				p.MockEKS().On("DeleteCluster", mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
+				p.MockEKS().On("DeleteCluster", mock.Anything, mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
 				c := cluster.NewUnownedCluster(cfg, ctl, fakeStackManager)
 				fakeClientSet := fake.NewSimpleClientset()
 
@@ -339,8 +340,8 @@ var _ = Describe("Delete", func() {
 				})
 				ctl.Status = &eks.ProviderStatus{
 					ClusterInfo: &eks.ClusterInfo{
-						Cluster: &awseks.Cluster{
-							Status:  aws.String(awseks.ClusterStatusActive),
+						Cluster: &ekstypes.Cluster{
+							Status:  ekstypes.ClusterStatusActive,
 							Version: aws.String("1.21"),
 						},
 					},
@@ -358,7 +359,7 @@ var _ = Describe("Delete", func() {
 					return mockedDrainer
 				})
 
-				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1, cluster.KeepResources{})
 				Expect(err).To(MatchError(errorMessage))
 				Expect(deleteCallCount).To(Equal(0))
 				Expect(unownedDeleteCallCount).To(Equal(0))
@@ -373,11 +374,11 @@ var _ = Describe("Delete", func() {
 	Context("when the cluster is inoperable", func() {
 		It("deletes the cluster without trying to query kubernetes", func() {
 			//mocks are in order of being called
-			p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
 				Expect(*input.Name).To(Equal(clusterName))
 				return true
 			})).Return(&awseks.DescribeClusterOutput{
-				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusFailed),
+				Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusFailed),
 			}, nil)
 
 			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{
@@ -389,10 +390,10 @@ var _ = Describe("Delete", func() {
 
 			p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
 
-			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
-			p.MockEKS().On("ListNodegroups", mock.Anything).Return(&awseks.ListNodegroupsOutput{
-				Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"}),
+			p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything).Return(&awseks.ListNodegroupsOutput{
+				Nodegroups: []string{"ng-1", "ng-2"},
 			}, nil)
 
 			fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{{NodeGroupName: "ng-1"}}, nil)
@@ -413,16 +414,16 @@ var _ = Describe("Delete", func() {
 				}}},
 			})
 
-			p.MockEKS().On("DeleteNodegroup", mock.MatchedBy(func(input *awseks.DeleteNodegroupInput) bool {
+			p.MockEKS().On("DeleteNodegroup", mock.Anything, mock.MatchedBy(func(input *awseks.DeleteNodegroupInput) bool {
 				Expect(*input.ClusterName).To(Equal(clusterName))
 				Expect(*input.NodegroupName).To(Equal("ng-1"))
 				return true
 			})).Return(&awseks.DeleteNodegroupOutput{}, nil)
 
-			p.MockEKS().On("DeleteCluster", mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
+			p.MockEKS().On("DeleteCluster", mock.Anything, mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
 
 			c := cluster.NewUnownedCluster(cfg, ctl, fakeStackManager)
-			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1, cluster.KeepResources{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(deleteCallCount).To(Equal(1))
@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/weaveworks/eksctl/pkg/printers"
@@ -13,7 +14,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
-func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (bool, error) {
+func upgrade(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (bool, error) {
 	currentVersion := ctl.ControlPlaneVersion()
 	versionUpdateRequired, err := requiresVersionUpgrade(cfg.Metadata, currentVersion)
 	if err != nil {
@@ -29,7 +30,7 @@ func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (boo
 		msgNodeGroupsAndAddons := "you will need to follow the upgrade procedure for all of nodegroups and add-ons"
 		cmdutils.LogIntendedAction(dryRun, "upgrade cluster %q control plane from current version %q to %q", cfg.Metadata.Name, currentVersion, cfg.Metadata.Version)
 		if !dryRun {
-			if err := ctl.UpdateClusterVersionBlocking(cfg); err != nil {
+			if err := ctl.UpdateClusterVersionBlocking(ctx, cfg); err != nil {
 				return false, err
 			}
 			logger.Success("cluster %q control plane has been upgraded to version %q", cfg.Metadata.Name, cfg.Metadata.Version)
@@ -41,6 +42,17 @@ func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (boo
 	return versionUpdateRequired, nil
 }
 
+// TargetVersion returns the version the control plane of a cluster running currentVersion is upgraded to,
+// and whether it requires an upgrade at all
+func TargetVersion(clusterMeta *api.ClusterMeta, currentVersion string) (string, bool, error) {
+	meta := *clusterMeta
+	upgradeRequired, err := requiresVersionUpgrade(&meta, currentVersion)
+	if err != nil {
+		return "", false, err
+	}
+	return meta.Version, upgradeRequired, nil
+}
+
 func requiresVersionUpgrade(clusterMeta *api.ClusterMeta, currentEKSVersion string) (bool, error) {
 	nextVersion, err := getNextVersion(currentEKSVersion)
 	if err != nil {
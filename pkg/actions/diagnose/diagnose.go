@@ -0,0 +1,262 @@
+// Package diagnose gathers the state of a cluster that is useful to troubleshoot it into a bundle
+// that can be attached to support escalations.
+package diagnose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// maxCloudTrailEvents bounds the number of CloudTrail events looked up, as a busy account
+// can have many events for a cluster
+const maxCloudTrailEvents = 1000
+
+// Collector gathers the diagnostics of a cluster
+type Collector struct {
+	ClusterName  string
+	Provider     api.ClusterProvider
+	StackManager manager.StackManager
+	// ClientSet is used to read the aws-auth ConfigMap, it's nil when the cluster can't be reached
+	ClientSet kubernetes.Interface
+	// Since is how far back CloudTrail errors are looked up
+	Since time.Duration
+}
+
+// file is a file of the bundle
+type file struct {
+	name string
+	data []byte
+}
+
+// WriteBundle writes the diagnostics of the cluster to w as a gzipped tarball; the sections that
+// can't be gathered are listed in errors.txt rather than failing the whole bundle
+func (c *Collector) WriteBundle(ctx context.Context, w io.Writer) error {
+	var (
+		files    []file
+		failures []string
+	)
+	for _, section := range []struct {
+		name    string
+		collect func(context.Context) (interface{}, error)
+	}{
+		{"cluster.yaml", c.controlPlane},
+		{"addons.yaml", c.addons},
+		{"nodegroups.yaml", c.nodegroups},
+		{"failed-stack-events.yaml", c.failedStackEvents},
+		{"aws-auth.yaml", c.awsAuth},
+		{"cloudtrail-errors.yaml", c.cloudTrailErrors},
+	} {
+		logger.Info("gathering %s", strings.TrimSuffix(section.name, ".yaml"))
+		obj, err := section.collect(ctx)
+		if err != nil {
+			logger.Warning("failed to gather %s: %v", section.name, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", section.name, err))
+			continue
+		}
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		files = append(files, file{name: section.name, data: Redact(data)})
+	}
+	if len(failures) > 0 {
+		files = append(files, file{name: "errors.txt", data: Redact([]byte(strings.Join(failures, "\n") + "\n"))})
+	}
+	return writeTarball(w, c.ClusterName, files)
+}
+
+func writeTarball(w io.Writer, clusterName string, files []file) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	now := time.Now()
+	for _, f := range files {
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Name:    fmt.Sprintf("eksctl-diagnose-%s/%s", clusterName, f.name),
+			Mode:    0600,
+			Size:    int64(len(f.data)),
+			ModTime: now,
+		}); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(f.data); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+var (
+	accountIDPattern = regexp.MustCompile(`\b\d{12}\b`)
+	accessKeyPattern = regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`)
+)
+
+// Redact replaces AWS account IDs and access key IDs in data
+func Redact(data []byte) []byte {
+	data = accountIDPattern.ReplaceAll(data, []byte("REDACTED-ACCOUNT-ID"))
+	return accessKeyPattern.ReplaceAll(data, []byte("REDACTED-ACCESS-KEY-ID"))
+}
+
+func (c *Collector) controlPlane(ctx context.Context) (interface{}, error) {
+	output, err := c.Provider.EKS().DescribeCluster(ctx, &eks.DescribeClusterInput{
+		Name: aws.String(c.ClusterName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	cluster := output.Cluster
+	// the certificate isn't useful to troubleshoot the cluster
+	cluster.CertificateAuthority = nil
+	return cluster, nil
+}
+
+func (c *Collector) addons(ctx context.Context) (interface{}, error) {
+	var addons []interface{}
+	paginator := eks.NewListAddonsPaginator(c.Provider.EKS(), &eks.ListAddonsInput{
+		ClusterName: aws.String(c.ClusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range output.Addons {
+			addon, err := c.Provider.EKS().DescribeAddon(ctx, &eks.DescribeAddonInput{
+				ClusterName: aws.String(c.ClusterName),
+				AddonName:   aws.String(name),
+			})
+			if err != nil {
+				return nil, err
+			}
+			addons = append(addons, addon.Addon)
+		}
+	}
+	return addons, nil
+}
+
+func (c *Collector) nodegroups(ctx context.Context) (interface{}, error) {
+	var nodegroups []interface{}
+	paginator := eks.NewListNodegroupsPaginator(c.Provider.EKS(), &eks.ListNodegroupsInput{
+		ClusterName: aws.String(c.ClusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range output.Nodegroups {
+			nodegroup, err := c.Provider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String(c.ClusterName),
+				NodegroupName: aws.String(name),
+			})
+			if err != nil {
+				return nil, err
+			}
+			nodegroups = append(nodegroups, nodegroup.Nodegroup)
+		}
+	}
+	return nodegroups, nil
+}
+
+func (c *Collector) failedStackEvents(ctx context.Context) (interface{}, error) {
+	stacks, err := c.StackManager.DescribeStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	failedEvents := map[string]interface{}{}
+	for _, s := range stacks {
+		events, err := c.StackManager.DescribeStackEvents(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		var failed []interface{}
+		for _, e := range events {
+			if strings.HasSuffix(string(e.ResourceStatus), "_FAILED") {
+				failed = append(failed, e)
+			}
+		}
+		if len(failed) > 0 {
+			failedEvents[*s.StackName] = failed
+		}
+	}
+	return failedEvents, nil
+}
+
+func (c *Collector) awsAuth(ctx context.Context) (interface{}, error) {
+	if c.ClientSet == nil {
+		return nil, fmt.Errorf("the cluster can't be reached")
+	}
+	cm, err := c.ClientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace).Get(ctx, authconfigmap.ObjectName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return cm.Data, nil
+}
+
+// cloudTrailError is a CloudTrail event of a failed API call
+type cloudTrailError struct {
+	Time         time.Time `json:"time"`
+	EventSource  string    `json:"eventSource"`
+	EventName    string    `json:"eventName"`
+	ErrorCode    string    `json:"errorCode"`
+	ErrorMessage string    `json:"errorMessage,omitempty"`
+}
+
+func (c *Collector) cloudTrailErrors(ctx context.Context) (interface{}, error) {
+	found := []cloudTrailError{}
+	paginator := cloudtrail.NewLookupEventsPaginator(c.Provider.CloudTrail(), &cloudtrail.LookupEventsInput{
+		LookupAttributes: []cttypes.LookupAttribute{{
+			AttributeKey:   cttypes.LookupAttributeKeyResourceName,
+			AttributeValue: aws.String(c.ClusterName),
+		}},
+		StartTime: aws.Time(time.Now().Add(-c.Since)),
+	})
+	var looked int
+	for paginator.HasMorePages() && looked < maxCloudTrailEvents {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		looked += len(output.Events)
+		for _, e := range output.Events {
+			var event struct {
+				EventSource  string `json:"eventSource"`
+				ErrorCode    string `json:"errorCode"`
+				ErrorMessage string `json:"errorMessage"`
+			}
+			if err := json.Unmarshal([]byte(aws.ToString(e.CloudTrailEvent)), &event); err != nil || event.ErrorCode == "" {
+				continue
+			}
+			found = append(found, cloudTrailError{
+				Time:         aws.ToTime(e.EventTime),
+				EventSource:  event.EventSource,
+				EventName:    aws.ToString(e.EventName),
+				ErrorCode:    event.ErrorCode,
+				ErrorMessage: event.ErrorMessage,
+			})
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,11 @@
+package diagnose_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestDiagnose(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -26,12 +26,12 @@ type Installer struct {
 	fluxClient InstallerClient
 }
 
-func New(k8sClientSet kubeclient.Interface, opts *api.GitOps) (*Installer, error) {
+func New(k8sClientSet kubeclient.Interface, opts *api.GitOps, cosign *api.Cosign) (*Installer, error) {
 	if opts.Flux == nil {
 		return nil, errors.New("expected gitops.flux in cluster configuration but found nil")
 	}
 
-	fluxClient, err := flux.NewClient(opts.Flux)
+	fluxClient, err := flux.NewClient(opts.Flux, cosign)
 	if err != nil {
 		return nil, err
 	}
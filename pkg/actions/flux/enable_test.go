@@ -30,7 +30,7 @@ var _ = Describe("Gitops", func() {
 
 	JustBeforeEach(func() {
 		var err error
-		installer, err = flux.New(fakeClientSet, opts)
+		installer, err = flux.New(fakeClientSet, opts, nil)
 		Expect(err).NotTo(HaveOccurred())
 		installer.SetFluxClient(fakeFluxClient)
 	})
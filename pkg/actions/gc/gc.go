@@ -0,0 +1,401 @@
+// Package gc finds and deletes the AWS resources of a cluster that were left behind when the
+// stacks that managed them, or the cluster itself, were deleted.
+package gc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Kinds of resources collected, in the order they are deleted
+const (
+	KindNetworkInterface = "network-interface"
+	KindSecurityGroup    = "security-group"
+	KindIAMRole          = "iam-role"
+	KindOIDCProvider     = "oidc-provider"
+	KindLogGroup         = "log-group"
+)
+
+const (
+	// cniClusterNameTag and cniCreatedAtTag are set by the VPC CNI on the ENIs it creates
+	cniClusterNameTag = "cluster.k8s.amazonaws.com/name"
+	cniCreatedAtTag   = "node.k8s.amazonaws.com/createdAt"
+	eksClusterNameTag = "aws:eks:cluster-name"
+
+	// unattachedENIGracePeriod is how long an ENI of a running cluster can stay unattached,
+	// as the VPC CNI creates ENIs before attaching them
+	unattachedENIGracePeriod = time.Hour
+)
+
+// Resource is a leaked resource
+type Resource struct {
+	Kind   string
+	ID     string
+	Reason string
+}
+
+func (r Resource) String() string {
+	return fmt.Sprintf("%s %s (%s)", r.Kind, r.ID, r.Reason)
+}
+
+// Collector finds and deletes the leaked resources of a cluster
+type Collector struct {
+	clusterName string
+	provider    api.ClusterProvider
+}
+
+// New returns a Collector for the resources of clusterName
+func New(clusterName string, provider api.ClusterProvider) *Collector {
+	return &Collector{
+		clusterName: clusterName,
+		provider:    provider,
+	}
+}
+
+// Find returns the resources bearing the ownership tags of the cluster that aren't managed by any
+// of its stacks; OIDC providers and log groups are only returned once the cluster is deleted
+func (c *Collector) Find(ctx context.Context) ([]Resource, error) {
+	clusterExists, err := c.clusterExists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	managed, err := c.stackResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, find := range []func(context.Context, bool, map[string]bool) ([]Resource, error){
+		c.findNetworkInterfaces,
+		c.findSecurityGroups,
+		c.findIAMRoles,
+		c.findOIDCProviders,
+		c.findLogGroups,
+	} {
+		found, err := find(ctx, clusterExists, managed)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, found...)
+	}
+	return resources, nil
+}
+
+// Delete deletes resources, continuing after failures
+func (c *Collector) Delete(ctx context.Context, resources []Resource) error {
+	var failed int
+	for _, r := range resources {
+		logger.Info("deleting %s", r)
+		if err := c.delete(ctx, r); err != nil {
+			logger.Critical("failed to delete %s %s: %v", r.Kind, r.ID, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d resources", failed, len(resources))
+	}
+	return nil
+}
+
+func (c *Collector) delete(ctx context.Context, r Resource) error {
+	switch r.Kind {
+	case KindNetworkInterface:
+		_, err := c.provider.EC2().DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: aws.String(r.ID),
+		})
+		return err
+	case KindSecurityGroup:
+		_, err := c.provider.EC2().DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{
+			GroupId: aws.String(r.ID),
+		})
+		return err
+	case KindIAMRole:
+		return c.deleteRole(ctx, r.ID)
+	case KindOIDCProvider:
+		_, err := c.provider.IAM().DeleteOpenIDConnectProvider(ctx, &iam.DeleteOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(r.ID),
+		})
+		return err
+	case KindLogGroup:
+		_, err := c.provider.CloudWatchLogs().DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{
+			LogGroupName: aws.String(r.ID),
+		})
+		return err
+	}
+	return fmt.Errorf("unknown kind of resource %q", r.Kind)
+}
+
+func (c *Collector) clusterExists(ctx context.Context) (bool, error) {
+	_, err := c.provider.EKS().DescribeCluster(ctx, &eks.DescribeClusterInput{
+		Name: aws.String(c.clusterName),
+	})
+	if err != nil {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("describing cluster %q: %w", c.clusterName, err)
+	}
+	return true, nil
+}
+
+// stackResources returns the physical IDs of the resources managed by the stacks of the cluster
+func (c *Collector) stackResources(ctx context.Context) (map[string]bool, error) {
+	stackName := regexp.MustCompile(fmt.Sprintf("^(eksctl|EKS)-%s-", regexp.QuoteMeta(c.clusterName)))
+	managed := map[string]bool{}
+
+	stacks := cloudformation.NewListStacksPaginator(c.provider.CloudFormation(), &cloudformation.ListStacksInput{})
+	for stacks.HasMorePages() {
+		output, err := stacks.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing stacks: %w", err)
+		}
+		for _, s := range output.StackSummaries {
+			if s.StackStatus == cfntypes.StackStatusDeleteComplete || !stackName.MatchString(*s.StackName) {
+				continue
+			}
+			resources := cloudformation.NewListStackResourcesPaginator(c.provider.CloudFormation(), &cloudformation.ListStackResourcesInput{
+				StackName: s.StackId,
+			})
+			for resources.HasMorePages() {
+				output, err := resources.NextPage(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("listing resources of stack %q: %w", *s.StackName, err)
+				}
+				for _, r := range output.StackResourceSummaries {
+					if r.PhysicalResourceId != nil {
+						managed[*r.PhysicalResourceId] = true
+					}
+				}
+			}
+		}
+	}
+	return managed, nil
+}
+
+func (c *Collector) findNetworkInterfaces(ctx context.Context, clusterExists bool, _ map[string]bool) ([]Resource, error) {
+	var resources []Resource
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(c.provider.EC2(), &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("tag:" + cniClusterNameTag), Values: []string{c.clusterName}},
+			{Name: aws.String("status"), Values: []string{string(ec2types.NetworkInterfaceStatusAvailable)}},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing network interfaces: %w", err)
+		}
+		for _, eni := range output.NetworkInterfaces {
+			if clusterExists && !unattachedForLong(eni.TagSet) {
+				continue
+			}
+			resources = append(resources, Resource{
+				Kind:   KindNetworkInterface,
+				ID:     *eni.NetworkInterfaceId,
+				Reason: "created by the VPC CNI and not attached to any instance",
+			})
+		}
+	}
+	return resources, nil
+}
+
+func unattachedForLong(tags []ec2types.Tag) bool {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == cniCreatedAtTag {
+			createdAt, err := time.Parse(time.RFC3339, aws.ToString(tag.Value))
+			return err == nil && time.Since(createdAt) > unattachedENIGracePeriod
+		}
+	}
+	return false
+}
+
+func (c *Collector) findSecurityGroups(ctx context.Context, clusterExists bool, managed map[string]bool) ([]Resource, error) {
+	var resources []Resource
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(c.provider.EC2(), &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("tag:" + api.ClusterNameTag), Values: []string{c.clusterName}},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing security groups: %w", err)
+		}
+		for _, sg := range output.SecurityGroups {
+			if managed[*sg.GroupId] {
+				continue
+			}
+			// the cluster security group is created by EKS rather than by a stack
+			if clusterExists && hasEC2Tag(sg.Tags, eksClusterNameTag) {
+				continue
+			}
+			resources = append(resources, Resource{
+				Kind:   KindSecurityGroup,
+				ID:     *sg.GroupId,
+				Reason: fmt.Sprintf("%s is not managed by any stack of the cluster", aws.ToString(sg.GroupName)),
+			})
+		}
+	}
+	return resources, nil
+}
+
+func hasEC2Tag(tags []ec2types.Tag, key string) bool {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Collector) findIAMRoles(ctx context.Context, _ bool, managed map[string]bool) ([]Resource, error) {
+	var resources []Resource
+	paginator := iam.NewListRolesPaginator(c.provider.IAM(), &iam.ListRolesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing IAM roles: %w", err)
+		}
+		for _, role := range output.Roles {
+			// roles created by stacks are named after them
+			if !strings.HasPrefix(*role.RoleName, "eksctl-") || managed[*role.RoleName] {
+				continue
+			}
+			tags, err := c.provider.IAM().ListRoleTags(ctx, &iam.ListRoleTagsInput{
+				RoleName: role.RoleName,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("listing tags of IAM role %q: %w", *role.RoleName, err)
+			}
+			for _, tag := range tags.Tags {
+				if aws.ToString(tag.Key) == api.ClusterNameTag && aws.ToString(tag.Value) == c.clusterName {
+					resources = append(resources, Resource{
+						Kind:   KindIAMRole,
+						ID:     *role.RoleName,
+						Reason: "not managed by any stack of the cluster",
+					})
+					break
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
+func (c *Collector) deleteRole(ctx context.Context, roleName string) error {
+	iamAPI := c.provider.IAM()
+
+	attached := iam.NewListAttachedRolePoliciesPaginator(iamAPI, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)})
+	for attached.HasMorePages() {
+		output, err := attached.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, p := range output.AttachedPolicies {
+			if _, err := iamAPI.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{RoleName: aws.String(roleName), PolicyArn: p.PolicyArn}); err != nil {
+				return err
+			}
+		}
+	}
+
+	inline := iam.NewListRolePoliciesPaginator(iamAPI, &iam.ListRolePoliciesInput{RoleName: aws.String(roleName)})
+	for inline.HasMorePages() {
+		output, err := inline.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, policyName := range output.PolicyNames {
+			if _, err := iamAPI.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{RoleName: aws.String(roleName), PolicyName: aws.String(policyName)}); err != nil {
+				return err
+			}
+		}
+	}
+
+	profiles := iam.NewListInstanceProfilesForRolePaginator(iamAPI, &iam.ListInstanceProfilesForRoleInput{RoleName: aws.String(roleName)})
+	for profiles.HasMorePages() {
+		output, err := profiles.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, p := range output.InstanceProfiles {
+			if _, err := iamAPI.RemoveRoleFromInstanceProfile(ctx, &iam.RemoveRoleFromInstanceProfileInput{RoleName: aws.String(roleName), InstanceProfileName: p.InstanceProfileName}); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := iamAPI.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String(roleName)})
+	return err
+}
+
+func (c *Collector) findOIDCProviders(ctx context.Context, clusterExists bool, _ map[string]bool) ([]Resource, error) {
+	if clusterExists {
+		return nil, nil
+	}
+	output, err := c.provider.IAM().ListOpenIDConnectProviders(ctx, &iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("listing OIDC providers: %w", err)
+	}
+	var resources []Resource
+	for _, p := range output.OpenIDConnectProviderList {
+		tags, err := c.provider.IAM().ListOpenIDConnectProviderTags(ctx, &iam.ListOpenIDConnectProviderTagsInput{
+			OpenIDConnectProviderArn: p.Arn,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tags of OIDC provider %q: %w", *p.Arn, err)
+		}
+		for _, tag := range tags.Tags {
+			if aws.ToString(tag.Key) == api.ClusterNameTag && aws.ToString(tag.Value) == c.clusterName {
+				resources = append(resources, Resource{
+					Kind:   KindOIDCProvider,
+					ID:     *p.Arn,
+					Reason: "the cluster doesn't exist",
+				})
+				break
+			}
+		}
+	}
+	return resources, nil
+}
+
+func (c *Collector) findLogGroups(ctx context.Context, clusterExists bool, _ map[string]bool) ([]Resource, error) {
+	if clusterExists {
+		return nil, nil
+	}
+	logGroupName := fmt.Sprintf("/aws/eks/%s/cluster", c.clusterName)
+	output, err := c.provider.CloudWatchLogs().DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(logGroupName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing log groups: %w", err)
+	}
+	var resources []Resource
+	for _, g := range output.LogGroups {
+		if aws.ToString(g.LogGroupName) == logGroupName {
+			resources = append(resources, Resource{
+				Kind:   KindLogGroup,
+				ID:     logGroupName,
+				Reason: "the cluster doesn't exist",
+			})
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,11 @@
+package gc_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestGC(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,173 @@
+package gc_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/gc"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("GC", func() {
+	const clusterName = "test"
+
+	var (
+		p         *mockprovider.MockProvider
+		collector *gc.Collector
+	)
+
+	clusterTag := func() iamtypes.Tag {
+		return iamtypes.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		collector = gc.New(clusterName, p)
+
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStacksOutput{
+			StackSummaries: []cfntypes.StackSummary{
+				{StackName: aws.String("eksctl-test-cluster"), StackId: aws.String("cluster-id"), StackStatus: cfntypes.StackStatusCreateComplete},
+				{StackName: aws.String("eksctl-test-nodegroup-ng-1"), StackId: aws.String("ng-1-id"), StackStatus: cfntypes.StackStatusDeleteComplete},
+				{StackName: aws.String("eksctl-other-cluster"), StackId: aws.String("other-id"), StackStatus: cfntypes.StackStatusCreateComplete},
+			},
+		}, nil)
+		p.MockCloudFormation().On("ListStackResources", mock.Anything, &cloudformation.ListStackResourcesInput{StackName: aws.String("cluster-id")}, mock.Anything).
+			Return(&cloudformation.ListStackResourcesOutput{
+				StackResourceSummaries: []cfntypes.StackResourceSummary{
+					{PhysicalResourceId: aws.String("sg-managed")},
+					{PhysicalResourceId: aws.String("eksctl-test-cluster-ServiceRole-1")},
+				},
+			}, nil)
+
+		p.MockEC2().On("DescribeNetworkInterfaces", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeNetworkInterfacesOutput{
+			NetworkInterfaces: []ec2types.NetworkInterface{
+				{NetworkInterfaceId: aws.String("eni-old"), TagSet: []ec2types.Tag{{Key: aws.String("node.k8s.amazonaws.com/createdAt"), Value: aws.String("2020-01-01T00:00:00Z")}}},
+				{NetworkInterfaceId: aws.String("eni-untagged")},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []ec2types.SecurityGroup{
+				{GroupId: aws.String("sg-managed"), GroupName: aws.String("managed")},
+				{GroupId: aws.String("sg-leaked"), GroupName: aws.String("leaked")},
+				{GroupId: aws.String("sg-eks"), GroupName: aws.String("eks-cluster-sg"), Tags: []ec2types.Tag{{Key: aws.String("aws:eks:cluster-name"), Value: aws.String(clusterName)}}},
+			},
+		}, nil)
+
+		p.MockIAM().On("ListRoles", mock.Anything, mock.Anything, mock.Anything).Return(&iam.ListRolesOutput{
+			Roles: []iamtypes.Role{
+				{RoleName: aws.String("eksctl-test-cluster-ServiceRole-1")},
+				{RoleName: aws.String("eksctl-test-addon-iamserviceaccount-Role1-2")},
+				{RoleName: aws.String("eksctl-other-addon-iamserviceaccount-Role1-3")},
+				{RoleName: aws.String("unrelated")},
+			},
+		}, nil)
+		p.MockIAM().On("ListRoleTags", mock.Anything, &iam.ListRoleTagsInput{RoleName: aws.String("eksctl-test-addon-iamserviceaccount-Role1-2")}).
+			Return(&iam.ListRoleTagsOutput{Tags: []iamtypes.Tag{clusterTag()}}, nil)
+		p.MockIAM().On("ListRoleTags", mock.Anything, &iam.ListRoleTagsInput{RoleName: aws.String("eksctl-other-addon-iamserviceaccount-Role1-3")}).
+			Return(&iam.ListRoleTagsOutput{Tags: []iamtypes.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("other")}}}, nil)
+	})
+
+	When("the cluster exists", func() {
+		BeforeEach(func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+		})
+
+		It("finds the resources that aren't managed by any stack of the cluster", func() {
+			resources, err := collector.Find(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(ConsistOf(
+				MatchResource(gc.KindNetworkInterface, "eni-old"),
+				MatchResource(gc.KindSecurityGroup, "sg-leaked"),
+				MatchResource(gc.KindIAMRole, "eksctl-test-addon-iamserviceaccount-Role1-2"),
+			))
+			p.MockIAM().AssertNotCalled(GinkgoT(), "ListOpenIDConnectProviders", mock.Anything, mock.Anything)
+			p.MockCloudWatchLogs().AssertNotCalled(GinkgoT(), "DescribeLogGroups", mock.Anything, mock.Anything)
+		})
+	})
+
+	When("the cluster doesn't exist", func() {
+		BeforeEach(func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(nil, &ekstypes.ResourceNotFoundException{})
+			p.MockIAM().On("ListOpenIDConnectProviders", mock.Anything, mock.Anything).Return(&iam.ListOpenIDConnectProvidersOutput{
+				OpenIDConnectProviderList: []iamtypes.OpenIDConnectProviderListEntry{{Arn: aws.String("arn:oidc-test")}, {Arn: aws.String("arn:oidc-other")}},
+			}, nil)
+			p.MockIAM().On("ListOpenIDConnectProviderTags", mock.Anything, &iam.ListOpenIDConnectProviderTagsInput{OpenIDConnectProviderArn: aws.String("arn:oidc-test")}).
+				Return(&iam.ListOpenIDConnectProviderTagsOutput{Tags: []iamtypes.Tag{clusterTag()}}, nil)
+			p.MockIAM().On("ListOpenIDConnectProviderTags", mock.Anything, &iam.ListOpenIDConnectProviderTagsInput{OpenIDConnectProviderArn: aws.String("arn:oidc-other")}).
+				Return(&iam.ListOpenIDConnectProviderTagsOutput{}, nil)
+			p.MockCloudWatchLogs().On("DescribeLogGroups", mock.Anything, mock.Anything).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+				LogGroups: []cwltypes.LogGroup{{LogGroupName: aws.String("/aws/eks/test/cluster")}},
+			}, nil)
+		})
+
+		It("also finds the cluster's OIDC providers, log group and unattached network interfaces", func() {
+			resources, err := collector.Find(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(ConsistOf(
+				MatchResource(gc.KindNetworkInterface, "eni-old"),
+				MatchResource(gc.KindNetworkInterface, "eni-untagged"),
+				MatchResource(gc.KindSecurityGroup, "sg-leaked"),
+				MatchResource(gc.KindSecurityGroup, "sg-eks"),
+				MatchResource(gc.KindIAMRole, "eksctl-test-addon-iamserviceaccount-Role1-2"),
+				MatchResource(gc.KindOIDCProvider, "arn:oidc-test"),
+				MatchResource(gc.KindLogGroup, "/aws/eks/test/cluster"),
+			))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("detaches the policies of IAM roles before deleting them", func() {
+			roleName := aws.String("eksctl-test-addon-iamserviceaccount-Role1-2")
+			p.MockIAM().On("ListAttachedRolePolicies", mock.Anything, mock.Anything, mock.Anything).Return(&iam.ListAttachedRolePoliciesOutput{
+				AttachedPolicies: []iamtypes.AttachedPolicy{{PolicyArn: aws.String("arn:policy")}},
+			}, nil)
+			p.MockIAM().On("DetachRolePolicy", mock.Anything, &iam.DetachRolePolicyInput{RoleName: roleName, PolicyArn: aws.String("arn:policy")}).Return(&iam.DetachRolePolicyOutput{}, nil)
+			p.MockIAM().On("ListRolePolicies", mock.Anything, mock.Anything, mock.Anything).Return(&iam.ListRolePoliciesOutput{PolicyNames: []string{"inline"}}, nil)
+			p.MockIAM().On("DeleteRolePolicy", mock.Anything, &iam.DeleteRolePolicyInput{RoleName: roleName, PolicyName: aws.String("inline")}).Return(&iam.DeleteRolePolicyOutput{}, nil)
+			p.MockIAM().On("ListInstanceProfilesForRole", mock.Anything, mock.Anything, mock.Anything).Return(&iam.ListInstanceProfilesForRoleOutput{}, nil)
+			p.MockIAM().On("DeleteRole", mock.Anything, &iam.DeleteRoleInput{RoleName: roleName}).Return(&iam.DeleteRoleOutput{}, nil)
+
+			Expect(collector.Delete(context.Background(), []gc.Resource{{Kind: gc.KindIAMRole, ID: *roleName}})).To(Succeed())
+			p.MockIAM().AssertCalled(GinkgoT(), "DetachRolePolicy", mock.Anything, &iam.DetachRolePolicyInput{RoleName: roleName, PolicyArn: aws.String("arn:policy")})
+			p.MockIAM().AssertCalled(GinkgoT(), "DeleteRolePolicy", mock.Anything, &iam.DeleteRolePolicyInput{RoleName: roleName, PolicyName: aws.String("inline")})
+			p.MockIAM().AssertCalled(GinkgoT(), "DeleteRole", mock.Anything, &iam.DeleteRoleInput{RoleName: roleName})
+		})
+
+		It("deletes the other resources and reports failures", func() {
+			p.MockEC2().On("DeleteSecurityGroup", mock.Anything, &ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-leaked")}).
+				Return(nil, &smithy.GenericAPIError{Code: "DependencyViolation"})
+			p.MockCloudWatchLogs().On("DeleteLogGroup", mock.Anything, &cloudwatchlogs.DeleteLogGroupInput{LogGroupName: aws.String("/aws/eks/test/cluster")}).
+				Return(&cloudwatchlogs.DeleteLogGroupOutput{}, nil)
+
+			err := collector.Delete(context.Background(), []gc.Resource{
+				{Kind: gc.KindSecurityGroup, ID: "sg-leaked"},
+				{Kind: gc.KindLogGroup, ID: "/aws/eks/test/cluster"},
+			})
+			Expect(err).To(MatchError("failed to delete 1 of 2 resources"))
+			p.MockCloudWatchLogs().AssertExpectations(GinkgoT())
+		})
+	})
+})
+
+func MatchResource(kind, id string) OmegaMatcher {
+	return And(
+		WithTransform(func(r gc.Resource) string { return r.Kind }, Equal(kind)),
+		WithTransform(func(r gc.Resource) string { return r.ID }, Equal(id)),
+	)
+}
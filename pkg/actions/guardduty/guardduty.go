@@ -0,0 +1,273 @@
+package guardduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	guarddutytypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// AgentAddonName is the name of the EKS addon of the GuardDuty security agent
+const AgentAddonName = "aws-guardduty-agent"
+
+// AddonCreator creates EKS addons
+type AddonCreator interface {
+	Create(ctx context.Context, addon *api.Addon, wait bool) error
+}
+
+// Enabler enables GuardDuty EKS Audit Log Monitoring and Runtime Monitoring, and deploys the GuardDuty agent to a cluster
+type Enabler struct {
+	cfg          *api.ClusterConfig
+	cluster      *ekstypes.Cluster
+	guardDutyAPI awsapi.GuardDuty
+	ec2API       awsapi.EC2
+	eksAPI       awsapi.EKS
+	addonCreator AddonCreator
+}
+
+// New creates a new Enabler
+func New(cfg *api.ClusterConfig, cluster *ekstypes.Cluster, guardDutyAPI awsapi.GuardDuty, ec2API awsapi.EC2, eksAPI awsapi.EKS, addonCreator AddonCreator) *Enabler {
+	return &Enabler{
+		cfg:          cfg,
+		cluster:      cluster,
+		guardDutyAPI: guardDutyAPI,
+		ec2API:       ec2API,
+		eksAPI:       eksAPI,
+		addonCreator: addonCreator,
+	}
+}
+
+// Enable turns on EKS protection in the GuardDuty detector of the account and region, creating the detector
+// if there isn't one, then creates the VPC endpoint the agent reports to and deploys the agent addon.
+// In plan mode it only logs the changes.
+func (e *Enabler) Enable(ctx context.Context, plan bool) error {
+	if err := e.enableDetectorFeatures(ctx, plan); err != nil {
+		return err
+	}
+	if err := e.ensureVPCEndpoint(ctx, plan); err != nil {
+		return err
+	}
+	return e.ensureAgentAddon(ctx, plan)
+}
+
+func (e *Enabler) enableDetectorFeatures(ctx context.Context, plan bool) error {
+	output, err := e.guardDutyAPI.ListDetectors(ctx, &guardduty.ListDetectorsInput{})
+	if err != nil {
+		return fmt.Errorf("listing GuardDuty detectors: %w", err)
+	}
+	var detectorID string
+	if len(output.DetectorIds) > 0 {
+		detectorID = output.DetectorIds[0]
+	}
+
+	if plan {
+		if detectorID == "" {
+			logger.Info("(plan) would create a GuardDuty detector in %s", e.cfg.Metadata.Region)
+		}
+		logger.Info("(plan) would enable EKS Audit Log Monitoring and EKS Runtime Monitoring in GuardDuty")
+		return nil
+	}
+
+	if detectorID == "" {
+		output, err := e.guardDutyAPI.CreateDetector(ctx, &guardduty.CreateDetectorInput{
+			Enable: aws.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("creating GuardDuty detector: %w", err)
+		}
+		detectorID = aws.ToString(output.DetectorId)
+		logger.Info("created GuardDuty detector %q", detectorID)
+	}
+
+	var features []guarddutytypes.DetectorFeatureConfiguration
+	for _, name := range []guarddutytypes.DetectorFeature{guarddutytypes.DetectorFeatureEksAuditLogs, guarddutytypes.DetectorFeatureEksRuntimeMonitoring} {
+		features = append(features, guarddutytypes.DetectorFeatureConfiguration{
+			Name:   name,
+			Status: guarddutytypes.FeatureStatusEnabled,
+		})
+	}
+	if _, err := e.guardDutyAPI.UpdateDetector(ctx, &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Enable:     aws.Bool(true),
+		Features:   features,
+	}); err != nil {
+		return fmt.Errorf("enabling EKS protection in GuardDuty detector %q: %w", detectorID, err)
+	}
+	logger.Info("enabled EKS Audit Log Monitoring and EKS Runtime Monitoring in GuardDuty detector %q", detectorID)
+	return nil
+}
+
+// ensureVPCEndpoint creates the interface endpoint of the GuardDuty data plane, which the agent sends
+// runtime events to, in the VPC of the cluster
+func (e *Enabler) ensureVPCEndpoint(ctx context.Context, plan bool) error {
+	vpcID := aws.ToString(e.cluster.ResourcesVpcConfig.VpcId)
+	serviceName := fmt.Sprintf("com.amazonaws.%s.guardduty-data", e.cfg.Metadata.Region)
+
+	endpoints, err := e.ec2API.DescribeVpcEndpoints(ctx, &ec2.DescribeVpcEndpointsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("service-name"), Values: []string{serviceName}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("describing VPC endpoints of %s: %w", vpcID, err)
+	}
+	for _, endpoint := range endpoints.VpcEndpoints {
+		switch endpoint.State {
+		case ec2types.StateDeleting, ec2types.StateDeleted, ec2types.StateFailed, ec2types.StateRejected:
+			continue
+		}
+		logger.Info("VPC endpoint %q for %s already exists", aws.ToString(endpoint.VpcEndpointId), serviceName)
+		return nil
+	}
+
+	if plan {
+		logger.Info("(plan) would create a VPC endpoint for %s in %s", serviceName, vpcID)
+		return nil
+	}
+
+	subnetIDs, err := e.subnetPerAZ(ctx)
+	if err != nil {
+		return err
+	}
+	securityGroupID, err := e.ensureEndpointSecurityGroup(ctx, vpcID)
+	if err != nil {
+		return err
+	}
+	output, err := e.ec2API.CreateVpcEndpoint(ctx, &ec2.CreateVpcEndpointInput{
+		VpcId:             aws.String(vpcID),
+		ServiceName:       aws.String(serviceName),
+		VpcEndpointType:   ec2types.VpcEndpointTypeInterface,
+		PrivateDnsEnabled: aws.Bool(true),
+		SubnetIds:         subnetIDs,
+		SecurityGroupIds:  []string{securityGroupID},
+		TagSpecifications: e.tagSpecifications(ec2types.ResourceTypeVpcEndpoint, e.cfg.Metadata.Name+"-guardduty-data"),
+	})
+	if err != nil {
+		return fmt.Errorf("creating VPC endpoint for %s: %w", serviceName, err)
+	}
+	logger.Info("created VPC endpoint %q for %s", aws.ToString(output.VpcEndpoint.VpcEndpointId), serviceName)
+	return nil
+}
+
+// subnetPerAZ returns a subnet of the cluster in each of its availability zones, as an interface endpoint
+// can only have one subnet per availability zone
+func (e *Enabler) subnetPerAZ(ctx context.Context) ([]string, error) {
+	output, err := e.ec2API.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: e.cluster.ResourcesVpcConfig.SubnetIds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing subnets of the cluster: %w", err)
+	}
+	zones := map[string]bool{}
+	var subnetIDs []string
+	for _, subnet := range output.Subnets {
+		zone := aws.ToString(subnet.AvailabilityZone)
+		if zones[zone] {
+			continue
+		}
+		zones[zone] = true
+		subnetIDs = append(subnetIDs, aws.ToString(subnet.SubnetId))
+	}
+	return subnetIDs, nil
+}
+
+func (e *Enabler) ensureEndpointSecurityGroup(ctx context.Context, vpcID string) (string, error) {
+	groupName := fmt.Sprintf("eksctl-%s-guardduty-data", e.cfg.Metadata.Name)
+	groups, err := e.ec2API.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("group-name"), Values: []string{groupName}},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("describing security groups of %s: %w", vpcID, err)
+	}
+	if len(groups.SecurityGroups) > 0 {
+		return aws.ToString(groups.SecurityGroups[0].GroupId), nil
+	}
+
+	vpcs, err := e.ec2API.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+		VpcIds: []string{vpcID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("describing %s: %w", vpcID, err)
+	}
+	if len(vpcs.Vpcs) == 0 {
+		return "", fmt.Errorf("VPC %s of the cluster not found", vpcID)
+	}
+
+	output, err := e.ec2API.CreateSecurityGroup(ctx, &ec2.CreateSecurityGroupInput{
+		GroupName:         aws.String(groupName),
+		Description:       aws.String("Allows the GuardDuty agent to reach the GuardDuty VPC endpoint"),
+		VpcId:             aws.String(vpcID),
+		TagSpecifications: e.tagSpecifications(ec2types.ResourceTypeSecurityGroup, groupName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating security group %q: %w", groupName, err)
+	}
+	groupID := aws.ToString(output.GroupId)
+	var ipRanges []ec2types.IpRange
+	for _, association := range vpcs.Vpcs[0].CidrBlockAssociationSet {
+		ipRanges = append(ipRanges, ec2types.IpRange{CidrIp: association.CidrBlock})
+	}
+	if _, err := e.ec2API.AuthorizeSecurityGroupIngress(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: aws.String(groupID),
+		IpPermissions: []ec2types.IpPermission{
+			{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int32(443),
+				ToPort:     aws.Int32(443),
+				IpRanges:   ipRanges,
+			},
+		},
+	}); err != nil {
+		return "", fmt.Errorf("allowing HTTPS from %s in security group %q: %w", vpcID, groupName, err)
+	}
+	logger.Info("created security group %q allowing HTTPS from %s", groupID, vpcID)
+	return groupID, nil
+}
+
+func (e *Enabler) tagSpecifications(resourceType ec2types.ResourceType, name string) []ec2types.TagSpecification {
+	return []ec2types.TagSpecification{
+		{
+			ResourceType: resourceType,
+			Tags: []ec2types.Tag{
+				{Key: aws.String("Name"), Value: aws.String(name)},
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String(e.cfg.Metadata.Name)},
+			},
+		},
+	}
+}
+
+func (e *Enabler) ensureAgentAddon(ctx context.Context, plan bool) error {
+	_, err := e.eksAPI.DescribeAddon(ctx, &awseks.DescribeAddonInput{
+		ClusterName: aws.String(e.cfg.Metadata.Name),
+		AddonName:   aws.String(AgentAddonName),
+	})
+	if err == nil {
+		logger.Info("addon %q already exists", AgentAddonName)
+		return nil
+	}
+	var notFoundErr *ekstypes.ResourceNotFoundException
+	if !errors.As(err, &notFoundErr) {
+		return fmt.Errorf("describing addon %q: %w", AgentAddonName, err)
+	}
+
+	if plan {
+		logger.Info("(plan) would create addon %q", AgentAddonName)
+		return nil
+	}
+	return e.addonCreator.Create(ctx, &api.Addon{Name: AgentAddonName}, true)
+}
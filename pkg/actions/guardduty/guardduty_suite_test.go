@@ -0,0 +1,11 @@
+package guardduty_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestGuardDuty(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
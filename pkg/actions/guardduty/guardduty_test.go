@@ -0,0 +1,134 @@
+package guardduty_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awsguardduty "github.com/aws/aws-sdk-go-v2/service/guardduty"
+	guarddutytypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/guardduty"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type fakeAddonCreator struct {
+	created []*api.Addon
+}
+
+func (f *fakeAddonCreator) Create(_ context.Context, addon *api.Addon, _ bool) error {
+	f.created = append(f.created, addon)
+	return nil
+}
+
+var _ = Describe("Enable GuardDuty EKS protection", func() {
+	var (
+		cfg          *api.ClusterConfig
+		p            *mockprovider.MockProvider
+		detectorIDs  []string
+		addonCreator *fakeAddonCreator
+		enabler      *guardduty.Enabler
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		p = mockprovider.NewMockProvider()
+		detectorIDs = nil
+		p.MockGuardDuty().On("ListDetectors", mock.Anything, mock.Anything).Return(func(_ context.Context, _ *awsguardduty.ListDetectorsInput, _ ...func(*awsguardduty.Options)) *awsguardduty.ListDetectorsOutput {
+			return &awsguardduty.ListDetectorsOutput{DetectorIds: detectorIDs}
+		}, nil)
+		p.MockGuardDuty().On("CreateDetector", mock.Anything, mock.Anything).Return(&awsguardduty.CreateDetectorOutput{DetectorId: aws.String("new-detector")}, nil)
+		p.MockGuardDuty().On("UpdateDetector", mock.Anything, mock.Anything).Return(&awsguardduty.UpdateDetectorOutput{}, nil)
+		addonCreator = &fakeAddonCreator{}
+		cluster := &ekstypes.Cluster{
+			ResourcesVpcConfig: &ekstypes.VpcConfigResponse{
+				VpcId:     aws.String("vpc-1"),
+				SubnetIds: []string{"subnet-a1", "subnet-a2", "subnet-b1"},
+			},
+		}
+		enabler = guardduty.New(cfg, cluster, p.GuardDuty(), p.EC2(), p.EKS(), addonCreator)
+	})
+
+	mockMissingResources := func() {
+		p.MockEC2().On("DescribeVpcEndpoints", mock.Anything, mock.Anything).Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+		p.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Return(nil, &ekstypes.ResourceNotFoundException{})
+	}
+
+	It("enables EKS protection and deploys the agent with its VPC endpoint", func() {
+		mockMissingResources()
+		p.MockEC2().On("DescribeSubnets", mock.Anything, mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []ec2types.Subnet{
+				{SubnetId: aws.String("subnet-a1"), AvailabilityZone: aws.String("us-west-2a")},
+				{SubnetId: aws.String("subnet-a2"), AvailabilityZone: aws.String("us-west-2a")},
+				{SubnetId: aws.String("subnet-b1"), AvailabilityZone: aws.String("us-west-2b")},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+		p.MockEC2().On("DescribeVpcs", mock.Anything, mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []ec2types.Vpc{{CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{{CidrBlock: aws.String("192.168.0.0/16")}}}},
+		}, nil)
+		p.MockEC2().On("CreateSecurityGroup", mock.Anything, mock.Anything).Return(&ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-1")}, nil)
+		p.MockEC2().On("AuthorizeSecurityGroupIngress", mock.Anything, mock.MatchedBy(func(input *ec2.AuthorizeSecurityGroupIngressInput) bool {
+			return aws.ToString(input.GroupId) == "sg-1" && aws.ToString(input.IpPermissions[0].IpRanges[0].CidrIp) == "192.168.0.0/16"
+		})).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+		p.MockEC2().On("CreateVpcEndpoint", mock.Anything, mock.MatchedBy(func(input *ec2.CreateVpcEndpointInput) bool {
+			Expect(aws.ToString(input.ServiceName)).To(Equal("com.amazonaws.us-west-2.guardduty-data"))
+			Expect(input.SubnetIds).To(ConsistOf("subnet-a1", "subnet-b1"))
+			Expect(input.SecurityGroupIds).To(ConsistOf("sg-1"))
+			Expect(aws.ToBool(input.PrivateDnsEnabled)).To(BeTrue())
+			return true
+		})).Return(&ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2types.VpcEndpoint{VpcEndpointId: aws.String("vpce-1")}}, nil)
+
+		Expect(enabler.Enable(context.Background(), false)).To(Succeed())
+
+		p.MockGuardDuty().AssertCalled(GinkgoT(), "CreateDetector", mock.Anything, mock.Anything)
+		p.MockGuardDuty().AssertCalled(GinkgoT(), "UpdateDetector", mock.Anything, &awsguardduty.UpdateDetectorInput{
+			DetectorId: aws.String("new-detector"),
+			Enable:     aws.Bool(true),
+			Features: []guarddutytypes.DetectorFeatureConfiguration{
+				{Name: guarddutytypes.DetectorFeatureEksAuditLogs, Status: guarddutytypes.FeatureStatusEnabled},
+				{Name: guarddutytypes.DetectorFeatureEksRuntimeMonitoring, Status: guarddutytypes.FeatureStatusEnabled},
+			},
+		})
+		Expect(addonCreator.created).To(HaveLen(1))
+		Expect(addonCreator.created[0].Name).To(Equal(guardduty.AgentAddonName))
+		p.MockEC2().AssertExpectations(GinkgoT())
+	})
+
+	It("reuses the detector, VPC endpoint and addon", func() {
+		detectorIDs = []string{"existing-detector"}
+		p.MockEC2().On("DescribeVpcEndpoints", mock.Anything, mock.Anything).Return(&ec2.DescribeVpcEndpointsOutput{
+			VpcEndpoints: []ec2types.VpcEndpoint{{VpcEndpointId: aws.String("vpce-1"), State: ec2types.StateAvailable}},
+		}, nil)
+		p.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Return(&awseks.DescribeAddonOutput{}, nil)
+
+		Expect(enabler.Enable(context.Background(), false)).To(Succeed())
+
+		p.MockGuardDuty().AssertNotCalled(GinkgoT(), "CreateDetector", mock.Anything, mock.Anything)
+		p.MockGuardDuty().AssertCalled(GinkgoT(), "UpdateDetector", mock.Anything, mock.MatchedBy(func(input *awsguardduty.UpdateDetectorInput) bool {
+			return aws.ToString(input.DetectorId) == "existing-detector"
+		}))
+		Expect(addonCreator.created).To(BeEmpty())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "CreateVpcEndpoint", mock.Anything, mock.Anything)
+	})
+
+	It("doesn't change anything in plan mode", func() {
+		mockMissingResources()
+
+		Expect(enabler.Enable(context.Background(), true)).To(Succeed())
+
+		p.MockGuardDuty().AssertNotCalled(GinkgoT(), "CreateDetector", mock.Anything, mock.Anything)
+		p.MockGuardDuty().AssertNotCalled(GinkgoT(), "UpdateDetector", mock.Anything, mock.Anything)
+		Expect(addonCreator.created).To(BeEmpty())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "CreateVpcEndpoint", mock.Anything, mock.Anything)
+	})
+})
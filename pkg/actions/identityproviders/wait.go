@@ -1,43 +1,44 @@
 package identityproviders
 
 import (
+	"context"
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
 func (m *Manager) waitForUpdate(
-	update eks.Update, timeout time.Duration,
+	ctx context.Context, update ekstypes.Update, timeout time.Duration,
 ) error {
 	clusterName := m.metadata.Name
-	newRequest := func() *request.Request {
-		input := &eks.DescribeUpdateInput{
-			Name:     aws.String(clusterName),
-			UpdateId: update.Id,
-		}
-		req, _ := m.eksAPI.DescribeUpdateRequest(input)
-		return req
+	input := &eks.DescribeUpdateInput{
+		Name:     aws.String(clusterName),
+		UpdateId: update.Id,
 	}
 
-	acceptors := waiters.MakeAcceptors(
-		"Update.Status",
-		eks.UpdateStatusSuccessful,
-		[]string{
-			eks.UpdateStatusCancelled,
-			eks.UpdateStatusFailed,
-		},
-	)
-
 	msg := fmt.Sprintf(
 		"waiting for update %q in cluster %q to succeed",
-		*update.Type,
+		update.Type,
 		clusterName,
 	)
 
-	return waiters.Wait(clusterName, msg, acceptors, newRequest, timeout, nil)
+	return waiters.Wait(ctx, msg, func(ctx context.Context) (bool, error) {
+		output, err := m.eksAPI.DescribeUpdate(ctx, input)
+		if err != nil {
+			return false, err
+		}
+		switch status := output.Update.Status; status {
+		case ekstypes.UpdateStatusSuccessful:
+			return true, nil
+		case ekstypes.UpdateStatusCancelled, ekstypes.UpdateStatusFailed:
+			return false, fmt.Errorf("update finished with status %q", status)
+		default:
+			return false, nil
+		}
+	}, timeout)
 }
@@ -0,0 +1,132 @@
+// Package insights reads the insights EKS reports for a cluster, such as the use of APIs removed in the next
+// Kubernetes version
+package insights
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Summary holds the known info about an insight
+type Summary struct {
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	Category          string     `json:"category"`
+	KubernetesVersion string     `json:"kubernetesVersion,omitempty"`
+	Severity          string     `json:"severity"`
+	Reason            string     `json:"reason,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	Recommendation    string     `json:"recommendation,omitempty"`
+	AffectedResources []Resource `json:"affectedResources,omitempty"`
+	LastRefreshTime   *time.Time `json:"lastRefreshTime,omitempty"`
+}
+
+// Resource is a resource an insight found an issue with
+type Resource struct {
+	KubernetesResourceURI string `json:"kubernetesResourceURI,omitempty"`
+	ARN                   string `json:"arn,omitempty"`
+	Severity              string `json:"severity,omitempty"`
+	Reason                string `json:"reason,omitempty"`
+}
+
+// Name returns the Kubernetes resource URI of the resource, or its ARN if it isn't a Kubernetes resource
+func (r Resource) Name() string {
+	if r.KubernetesResourceURI != "" {
+		return r.KubernetesResourceURI
+	}
+	return r.ARN
+}
+
+// Getter reads the insights of a cluster
+type Getter struct {
+	clusterName string
+	api         awsapi.EKS
+}
+
+// NewGetter creates a new Getter
+func NewGetter(clusterName string, api awsapi.EKS) *Getter {
+	return &Getter{
+		clusterName: clusterName,
+		api:         api,
+	}
+}
+
+// Get returns the insights of the cluster with the resources they affect and the recommended actions
+func (g *Getter) Get(ctx context.Context) ([]Summary, error) {
+	ids, err := g.listInsightIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := []Summary{}
+	for _, id := range ids {
+		summary, err := g.getInsight(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+func (g *Getter) listInsightIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(g.clusterName),
+	}
+	for {
+		output, err := g.api.ListInsights(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing insights of cluster %q: %w", g.clusterName, err)
+		}
+		for _, insight := range output.Insights {
+			ids = append(ids, aws.ToString(insight.Id))
+		}
+		if output.NextToken == nil {
+			return ids, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func (g *Getter) getInsight(ctx context.Context, id string) (Summary, error) {
+	output, err := g.api.DescribeInsight(ctx, &eks.DescribeInsightInput{
+		ClusterName: aws.String(g.clusterName),
+		Id:          aws.String(id),
+	})
+	if err != nil {
+		return Summary{}, fmt.Errorf("describing insight %q: %w", id, err)
+	}
+	insight := output.Insight
+	summary := Summary{
+		ID:                aws.ToString(insight.Id),
+		Name:              aws.ToString(insight.Name),
+		Category:          string(insight.Category),
+		KubernetesVersion: aws.ToString(insight.KubernetesVersion),
+		Description:       aws.ToString(insight.Description),
+		Recommendation:    aws.ToString(insight.Recommendation),
+		LastRefreshTime:   insight.LastRefreshTime,
+	}
+	if insight.InsightStatus != nil {
+		summary.Severity = string(insight.InsightStatus.Status)
+		summary.Reason = aws.ToString(insight.InsightStatus.Reason)
+	}
+	for _, r := range insight.Resources {
+		resource := Resource{
+			KubernetesResourceURI: aws.ToString(r.KubernetesResourceUri),
+			ARN:                   aws.ToString(r.Arn),
+		}
+		if r.InsightStatus != nil {
+			resource.Severity = string(r.InsightStatus.Status)
+			resource.Reason = aws.ToString(r.InsightStatus.Reason)
+		}
+		summary.AffectedResources = append(summary.AffectedResources, resource)
+	}
+	return summary, nil
+}
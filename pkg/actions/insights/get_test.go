@@ -0,0 +1,105 @@
+package insights_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/insights"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Get insights", func() {
+	var p *mockprovider.MockProvider
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		p.MockEKS().On("ListInsights", mock.Anything, mock.MatchedBy(func(input *eks.ListInsightsInput) bool {
+			return input.NextToken == nil
+		})).Return(&eks.ListInsightsOutput{
+			Insights:  []ekstypes.InsightSummary{{Id: aws.String("deprecated-apis")}},
+			NextToken: aws.String("next"),
+		}, nil)
+		p.MockEKS().On("ListInsights", mock.Anything, mock.MatchedBy(func(input *eks.ListInsightsInput) bool {
+			return aws.ToString(input.NextToken) == "next"
+		})).Return(&eks.ListInsightsOutput{
+			Insights: []ekstypes.InsightSummary{{Id: aws.String("kubelet-skew")}},
+		}, nil)
+	})
+
+	It("gets the insights with their affected resources and recommendations", func() {
+		p.MockEKS().On("DescribeInsight", mock.Anything, &eks.DescribeInsightInput{
+			ClusterName: aws.String("my-cluster"),
+			Id:          aws.String("deprecated-apis"),
+		}).Return(&eks.DescribeInsightOutput{
+			Insight: &ekstypes.Insight{
+				Id:                aws.String("deprecated-apis"),
+				Name:              aws.String("Deprecated APIs removed in Kubernetes v1.29"),
+				Category:          ekstypes.CategoryUpgradeReadiness,
+				KubernetesVersion: aws.String("1.29"),
+				Recommendation:    aws.String("Update manifests and API clients to use newer Kubernetes APIs"),
+				InsightStatus: &ekstypes.InsightStatus{
+					Status: ekstypes.InsightStatusValueError,
+					Reason: aws.String("Deprecated API usage detected within last 30 days"),
+				},
+				Resources: []ekstypes.InsightResourceDetail{
+					{
+						KubernetesResourceUri: aws.String("/apis/flowcontrol.apiserver.k8s.io/v1beta2/flowschemas"),
+						InsightStatus:         &ekstypes.InsightStatus{Status: ekstypes.InsightStatusValueError},
+					},
+				},
+			},
+		}, nil)
+		p.MockEKS().On("DescribeInsight", mock.Anything, &eks.DescribeInsightInput{
+			ClusterName: aws.String("my-cluster"),
+			Id:          aws.String("kubelet-skew"),
+		}).Return(&eks.DescribeInsightOutput{
+			Insight: &ekstypes.Insight{
+				Id:            aws.String("kubelet-skew"),
+				Name:          aws.String("Kubelet version skew"),
+				Category:      ekstypes.CategoryUpgradeReadiness,
+				InsightStatus: &ekstypes.InsightStatus{Status: ekstypes.InsightStatusValuePassing},
+			},
+		}, nil)
+
+		summaries, err := insights.NewGetter("my-cluster", p.MockEKS()).Get(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summaries).To(Equal([]insights.Summary{
+			{
+				ID:                "deprecated-apis",
+				Name:              "Deprecated APIs removed in Kubernetes v1.29",
+				Category:          "UPGRADE_READINESS",
+				KubernetesVersion: "1.29",
+				Severity:          "ERROR",
+				Reason:            "Deprecated API usage detected within last 30 days",
+				Recommendation:    "Update manifests and API clients to use newer Kubernetes APIs",
+				AffectedResources: []insights.Resource{
+					{
+						KubernetesResourceURI: "/apis/flowcontrol.apiserver.k8s.io/v1beta2/flowschemas",
+						Severity:              "ERROR",
+					},
+				},
+			},
+			{
+				ID:       "kubelet-skew",
+				Name:     "Kubelet version skew",
+				Category: "UPGRADE_READINESS",
+				Severity: "PASSING",
+			},
+		}))
+		Expect(summaries[0].AffectedResources[0].Name()).To(Equal("/apis/flowcontrol.apiserver.k8s.io/v1beta2/flowschemas"))
+	})
+
+	It("fails if an insight can't be described", func() {
+		p.MockEKS().On("DescribeInsight", mock.Anything, mock.Anything).Return(nil, errors.New("ResourceNotFoundException"))
+
+		_, err := insights.NewGetter("my-cluster", p.MockEKS()).Get(context.Background())
+		Expect(err).To(MatchError(`describing insight "deprecated-apis": ResourceNotFoundException`))
+	})
+})
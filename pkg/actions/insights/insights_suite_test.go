@@ -0,0 +1,11 @@
+package insights_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestInsights(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
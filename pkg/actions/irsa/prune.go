@@ -0,0 +1,81 @@
+package irsa
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// Prune deletes the IAM role stacks and Kubernetes service accounts of iamserviceaccounts that exist
+// in the cluster, in any namespace, but are not present in serviceAccounts. Only iamserviceaccounts
+// for which shouldPrune returns true are considered, so that include and exclude rules are respected
+func (m *Manager) Prune(ctx context.Context, serviceAccounts []*api.ClusterIAMServiceAccount, shouldPrune func(name string) bool, plan bool) error {
+	remote, err := m.stackManager.ListIAMServiceAccountStacks(ctx)
+	if err != nil {
+		return err
+	}
+
+	local := sets.NewString()
+	for _, sa := range serviceAccounts {
+		local.Insert(sa.NameString())
+	}
+
+	var toDelete []string
+	for _, name := range remote {
+		if !local.Has(name) && shouldPrune(name) {
+			toDelete = append(toDelete, name)
+		}
+	}
+
+	if len(toDelete) == 0 {
+		logger.Info("no iamserviceaccounts to prune")
+		return nil
+	}
+
+	sort.Strings(toDelete)
+	logger.Info("%d iamserviceaccount(s) present in the cluster but missing from the given config will be pruned: %s", len(toDelete), strings.Join(toDelete, ", "))
+	return m.Delete(ctx, toDelete, plan, false)
+}
+
+// PruneMissingFromCluster deletes the IAM role stacks of iamserviceaccounts whose Kubernetes service account
+// no longer exists in the cluster, e.g. because it was deleted with kubectl. Only iamserviceaccounts for which
+// shouldPrune returns true are considered, so that include and exclude rules are respected
+func (m *Manager) PruneMissingFromCluster(ctx context.Context, shouldPrune func(name string) bool, plan, wait bool) error {
+	remote, err := m.stackManager.ListIAMServiceAccountStacks(ctx)
+	if err != nil {
+		return err
+	}
+
+	var toDelete []string
+	for _, name := range remote {
+		if !shouldPrune(name) {
+			continue
+		}
+		meta, err := api.ClusterIAMServiceAccountNameStringToClusterIAMMeta(name)
+		if err != nil {
+			return err
+		}
+		exists, err := kubernetes.CheckServiceAccountExists(m.clientSet, meta.AsObjectMeta())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			toDelete = append(toDelete, name)
+		}
+	}
+
+	if len(toDelete) == 0 {
+		logger.Info("no iamserviceaccounts are missing their Kubernetes service account")
+		return nil
+	}
+
+	sort.Strings(toDelete)
+	logger.Info("%d iamserviceaccount(s) whose Kubernetes service account no longer exists in the cluster will be deleted: %s", len(toDelete), strings.Join(toDelete, ", "))
+	return m.Delete(ctx, toDelete, plan, wait)
+}
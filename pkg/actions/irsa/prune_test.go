@@ -0,0 +1,148 @@
+package irsa_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("Prune", func() {
+
+	var (
+		irsaManager      *irsa.Manager
+		fakeStackManager *fakes.FakeStackManager
+		serviceAccounts  []*api.ClusterIAMServiceAccount
+		matchAll         = func(string) bool { return true }
+	)
+
+	BeforeEach(func() {
+		serviceAccounts = []*api.ClusterIAMServiceAccount{
+			{
+				ClusterIAMMeta: api.ClusterIAMMeta{
+					Name:      "test-sa",
+					Namespace: "default",
+				},
+			},
+		}
+
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.NewTasksToDeleteIAMServiceAccountsReturns(&tasks.TaskTree{}, nil)
+		irsaManager = irsa.New("my-cluster", fakeStackManager, nil, nil)
+	})
+
+	When("iamserviceaccounts in other namespaces are missing from the config", func() {
+		It("deletes them", func() {
+			fakeStackManager.ListIAMServiceAccountStacksReturns([]string{"kube-system/other-sa", "default/test-sa", "apps/another-sa"}, nil)
+
+			err := irsaManager.Prune(context.TODO(), serviceAccounts, matchAll, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeStackManager.NewTasksToDeleteIAMServiceAccountsCallCount()).To(Equal(1))
+			_, names, _, wait := fakeStackManager.NewTasksToDeleteIAMServiceAccountsArgsForCall(0)
+			Expect(names).To(Equal([]string{"apps/another-sa", "kube-system/other-sa"}))
+			Expect(wait).To(BeFalse())
+		})
+	})
+
+	When("the filter excludes an iamserviceaccount", func() {
+		It("does not delete it", func() {
+			fakeStackManager.ListIAMServiceAccountStacksReturns([]string{"kube-system/other-sa", "apps/another-sa"}, nil)
+
+			err := irsaManager.Prune(context.TODO(), serviceAccounts, func(name string) bool {
+				return !strings.HasPrefix(name, "kube-system/")
+			}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, names, _, _ := fakeStackManager.NewTasksToDeleteIAMServiceAccountsArgsForCall(0)
+			Expect(names).To(Equal([]string{"apps/another-sa"}))
+		})
+	})
+
+	When("every iamserviceaccount in the cluster is in the config", func() {
+		It("does not delete anything", func() {
+			fakeStackManager.ListIAMServiceAccountStacksReturns([]string{"default/test-sa"}, nil)
+
+			err := irsaManager.Prune(context.TODO(), serviceAccounts, matchAll, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeStackManager.NewTasksToDeleteIAMServiceAccountsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("listing the iamserviceaccount stacks fails", func() {
+		It("returns an error", func() {
+			fakeStackManager.ListIAMServiceAccountStacksReturns(nil, errors.New("boom"))
+
+			err := irsaManager.Prune(context.TODO(), serviceAccounts, matchAll, false)
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+})
+
+var _ = Describe("PruneMissingFromCluster", func() {
+
+	var (
+		irsaManager      *irsa.Manager
+		fakeStackManager *fakes.FakeStackManager
+		clientSet        *fake.Clientset
+		matchAll         = func(string) bool { return true }
+	)
+
+	BeforeEach(func() {
+		clientSet = fake.NewSimpleClientset(&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-sa",
+				Namespace: "default",
+			},
+		})
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.NewTasksToDeleteIAMServiceAccountsReturns(&tasks.TaskTree{}, nil)
+		irsaManager = irsa.New("my-cluster", fakeStackManager, nil, clientSet)
+	})
+
+	When("the Kubernetes service accounts of iamserviceaccounts were deleted", func() {
+		It("deletes their stacks", func() {
+			fakeStackManager.ListIAMServiceAccountStacksReturns([]string{"kube-system/other-sa", "default/test-sa", "apps/another-sa"}, nil)
+
+			err := irsaManager.PruneMissingFromCluster(context.TODO(), matchAll, false, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeStackManager.NewTasksToDeleteIAMServiceAccountsCallCount()).To(Equal(1))
+			_, names, _, wait := fakeStackManager.NewTasksToDeleteIAMServiceAccountsArgsForCall(0)
+			Expect(names).To(Equal([]string{"apps/another-sa", "kube-system/other-sa"}))
+			Expect(wait).To(BeTrue())
+		})
+
+		It("respects the filter", func() {
+			fakeStackManager.ListIAMServiceAccountStacksReturns([]string{"kube-system/other-sa", "apps/another-sa"}, nil)
+
+			err := irsaManager.PruneMissingFromCluster(context.TODO(), func(name string) bool {
+				return !strings.HasPrefix(name, "kube-system/")
+			}, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, names, _, _ := fakeStackManager.NewTasksToDeleteIAMServiceAccountsArgsForCall(0)
+			Expect(names).To(Equal([]string{"apps/another-sa"}))
+		})
+	})
+
+	When("every iamserviceaccount has its Kubernetes service account", func() {
+		It("does not delete anything", func() {
+			fakeStackManager.ListIAMServiceAccountStacksReturns([]string{"default/test-sa"}, nil)
+
+			err := irsaManager.PruneMissingFromCluster(context.TODO(), matchAll, false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeStackManager.NewTasksToDeleteIAMServiceAccountsCallCount()).To(Equal(0))
+		})
+	})
+})
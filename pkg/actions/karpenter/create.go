@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/kris-nova/logger"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
@@ -31,7 +31,7 @@ func (i *Installer) Create(ctx context.Context) error {
 	}
 	instanceProfileName := fmt.Sprintf("eksctl-%s-%s", builder.KarpenterNodeInstanceProfile, i.Config.Metadata.Name)
 	if i.Config.Karpenter.DefaultInstanceProfile != nil {
-		instanceProfileName = aws.StringValue(i.Config.Karpenter.DefaultInstanceProfile)
+		instanceProfileName = aws.ToString(i.Config.Karpenter.DefaultInstanceProfile)
 	}
 
 	// Create IAM roles
@@ -44,8 +44,8 @@ func (i *Installer) Create(ctx context.Context) error {
 	// Because we prefix with eksctl and to avoid having to get the name again,
 	// we always pass in the name and overwrite with the service account label.
 	roleName := fmt.Sprintf("eksctl-%s-iamservice-role", i.Config.Metadata.Name)
-	roleARN := fmt.Sprintf("arn:aws:iam::%s:role/%s", parsedARN.AccountID, roleName)
-	policyArn := fmt.Sprintf("arn:aws:iam::%s:policy/eksctl-%s-%s", parsedARN.AccountID, builder.KarpenterManagedPolicy, i.Config.Metadata.Name)
+	roleARN := api.IAMRoleARN(parsedARN.Partition, parsedARN.AccountID, roleName)
+	policyArn := api.IAMPolicyARN(parsedARN.Partition, parsedARN.AccountID, fmt.Sprintf("eksctl-%s-%s", builder.KarpenterManagedPolicy, i.Config.Metadata.Name))
 	iamServiceAccount := &api.ClusterIAMServiceAccount{
 		ClusterIAMMeta: api.ClusterIAMMeta{
 			Name:      karpenter.DefaultServiceAccountName,
@@ -69,7 +69,7 @@ func (i *Installer) Create(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to create client for auth config: %w", err)
 	}
-	identityArn := fmt.Sprintf("arn:aws:iam::%s:role/eksctl-%s-%s", parsedARN.AccountID, builder.KarpenterNodeRoleName, i.Config.Metadata.Name)
+	identityArn := api.IAMRoleARN(parsedARN.Partition, parsedARN.AccountID, fmt.Sprintf("eksctl-%s-%s", builder.KarpenterNodeRoleName, i.Config.Metadata.Name))
 	id, err := iam.NewIdentity(identityArn, authconfigmap.RoleNodeGroupUsername, authconfigmap.RoleNodeGroupGroups)
 	if err != nil {
 		return fmt.Errorf("failed to create new identity: %w", err)
@@ -9,9 +9,9 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 
 	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
 
 	"github.com/kris-nova/logger"
 	. "github.com/onsi/ginkgo"
@@ -77,7 +77,7 @@ var _ = Describe("Create", func() {
 				Provider: p,
 				Status: &eks.ProviderStatus{
 					ClusterInfo: &eks.ClusterInfo{
-						Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
+						Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive),
 					},
 				},
 			}
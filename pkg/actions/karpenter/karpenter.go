@@ -3,21 +3,18 @@ package karpenter
 import (
 	"context"
 	"fmt"
-	"time"
 
-	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/kris-nova/logger"
 	kubeclient "k8s.io/client-go/kubernetes"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/helm"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/karpenter"
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers/helm"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
-	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
 // Installer contains all necessary dependencies for the Karpenter Install tasks and others.
@@ -25,14 +22,11 @@ type Installer struct {
 	StackManager       manager.StackManager
 	CTL                *eks.ClusterProvider
 	Config             *api.ClusterConfig
-	Wait               WaitFunc
 	KarpenterInstaller karpenter.ChartInstaller
 	ClientSet          kubernetes.Interface
 	OIDC               *iamoidc.OpenIDConnectManager
 }
 
-type WaitFunc func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error
-
 // NewInstaller creates a new Karpenter installer.
 func NewInstaller(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clientSet kubeclient.Interface, restClientGetter *kubernetes.SimpleRESTClientGetter) (*Installer, error) {
 	helmInstaller, err := helm.NewInstaller(helm.Options{
@@ -47,7 +41,7 @@ func NewInstaller(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterP
 		Namespace:     karpenter.DefaultNamespace,
 		ClusterConfig: cfg,
 	})
-	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +59,6 @@ func NewInstaller(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterP
 		StackManager:       stackManager,
 		CTL:                ctl,
 		Config:             cfg,
-		Wait:               waiters.Wait,
 		KarpenterInstaller: karpenterInstaller,
 		ClientSet:          clientSet,
 		OIDC:               oidc,
@@ -4,8 +4,9 @@ import (
 	"context"
 	"errors"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/smithy-go"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -68,10 +69,10 @@ var _ = Describe("Labels", func() {
 		})
 
 		When("the nodegroup is not owned by eksctl", func() {
-			var returnedLabels map[string]*string
+			var returnedLabels map[string]string
 
 			BeforeEach(func() {
-				returnedLabels = map[string]*string{"k1": aws.String("v1")}
+				returnedLabels = map[string]string{"k1": "v1"}
 				err := &smithy.OperationError{
 					Err: errors.New("ValidationError"),
 				}
@@ -79,10 +80,10 @@ var _ = Describe("Labels", func() {
 			})
 
 			It("returns the labels from the EKS api", func() {
-				mockProvider.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				mockProvider.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 					ClusterName:   aws.String(clusterName),
 					NodegroupName: aws.String(nodegroupName),
-				}).Return(&awseks.DescribeNodegroupOutput{Nodegroup: &awseks.Nodegroup{Labels: returnedLabels}}, nil)
+				}).Return(&awseks.DescribeNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{Labels: returnedLabels}}, nil)
 
 				summary, err := manager.Get(context.TODO(), nodegroupName)
 				Expect(err).NotTo(HaveOccurred())
@@ -91,7 +92,7 @@ var _ = Describe("Labels", func() {
 
 			When("the EKS api returns an error", func() {
 				It("fails", func() {
-					mockProvider.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&awseks.DescribeNodegroupOutput{}, errors.New("oh-noes"))
+					mockProvider.MockEKS().On("DescribeNodegroup", mock.Anything, mock.Anything).Return(&awseks.DescribeNodegroupOutput{}, errors.New("oh-noes"))
 
 					summary, err := manager.Get(context.TODO(), nodegroupName)
 					Expect(err).To(HaveOccurred())
@@ -130,10 +131,10 @@ var _ = Describe("Labels", func() {
 		})
 
 		When("the nodegroup is not owned by eksctl", func() {
-			var eksLabels map[string]*string
+			var eksLabels map[string]string
 
 			BeforeEach(func() {
-				eksLabels = map[string]*string{"k1": aws.String("v1")}
+				eksLabels = map[string]string{"k1": "v1"}
 				err := &smithy.OperationError{
 					Err: errors.New("ValidationError"),
 				}
@@ -141,10 +142,10 @@ var _ = Describe("Labels", func() {
 			})
 
 			It("updates the labels through the EKS api", func() {
-				mockProvider.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
+				mockProvider.MockEKS().On("UpdateNodegroupConfig", mock.Anything, &awseks.UpdateNodegroupConfigInput{
 					ClusterName:   aws.String(clusterName),
 					NodegroupName: aws.String(nodegroupName),
-					Labels: &awseks.UpdateLabelsPayload{
+					Labels: &ekstypes.UpdateLabelsPayload{
 						AddOrUpdateLabels: eksLabels,
 					},
 				}).Return(&awseks.UpdateNodegroupConfigOutput{}, nil)
@@ -154,7 +155,7 @@ var _ = Describe("Labels", func() {
 
 			When("the EKS api returns an error", func() {
 				It("fails", func() {
-					mockProvider.MockEKS().On("UpdateNodegroupConfig", mock.Anything).Return(&awseks.UpdateNodegroupConfigOutput{}, errors.New("oh-noes"))
+					mockProvider.MockEKS().On("UpdateNodegroupConfig", mock.Anything, mock.Anything).Return(&awseks.UpdateNodegroupConfigOutput{}, errors.New("oh-noes"))
 
 					err := manager.Set(context.TODO(), nodegroupName, labels)
 					Expect(err).To(HaveOccurred())
@@ -192,10 +193,10 @@ var _ = Describe("Labels", func() {
 		})
 
 		When("the nodegroup is not owned by eksctl", func() {
-			var eksLabels []*string
+			var eksLabels []string
 
 			BeforeEach(func() {
-				eksLabels = []*string{aws.String("k1")}
+				eksLabels = []string{"k1"}
 				err := &smithy.OperationError{
 					Err: errors.New("ValidationError"),
 				}
@@ -203,10 +204,10 @@ var _ = Describe("Labels", func() {
 			})
 
 			It("removes the labels through the EKS api", func() {
-				mockProvider.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
+				mockProvider.MockEKS().On("UpdateNodegroupConfig", mock.Anything, &awseks.UpdateNodegroupConfigInput{
 					ClusterName:   aws.String(clusterName),
 					NodegroupName: aws.String(nodegroupName),
-					Labels: &awseks.UpdateLabelsPayload{
+					Labels: &ekstypes.UpdateLabelsPayload{
 						RemoveLabels: eksLabels,
 					},
 				}).Return(&awseks.UpdateNodegroupConfigOutput{}, nil)
@@ -216,7 +217,7 @@ var _ = Describe("Labels", func() {
 
 			When("the EKS api returns an error", func() {
 				It("fails", func() {
-					mockProvider.MockEKS().On("UpdateNodegroupConfig", mock.Anything).Return(&awseks.UpdateNodegroupConfigOutput{}, errors.New("oh-noes"))
+					mockProvider.MockEKS().On("UpdateNodegroupConfig", mock.Anything, mock.Anything).Return(&awseks.UpdateNodegroupConfigOutput{}, errors.New("oh-noes"))
 
 					err := manager.Unset(context.TODO(), nodegroupName, labels)
 					Expect(err).To(HaveOccurred())
@@ -3,8 +3,9 @@ package label
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 )
@@ -12,17 +13,16 @@ import (
 func (m *Manager) Set(ctx context.Context, nodeGroupName string, labels map[string]string) error {
 	err := m.service.UpdateLabels(ctx, nodeGroupName, labels, nil)
 	if manager.IsStackDoesNotExistError(err) {
-		return m.setLabelsOnUnownedNodeGroup(nodeGroupName, labels)
+		return m.setLabelsOnUnownedNodeGroup(ctx, nodeGroupName, labels)
 	}
 	return err
 }
 
-func (m *Manager) setLabelsOnUnownedNodeGroup(nodeGroupName string, labels map[string]string) error {
-	pointyLabels := aws.StringMap(labels)
-	_, err := m.eksAPI.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+func (m *Manager) setLabelsOnUnownedNodeGroup(ctx context.Context, nodeGroupName string, labels map[string]string) error {
+	_, err := m.eksAPI.UpdateNodegroupConfig(ctx, &eks.UpdateNodegroupConfigInput{
 		ClusterName:   aws.String(m.clusterName),
 		NodegroupName: aws.String(nodeGroupName),
-		Labels:        &eks.UpdateLabelsPayload{AddOrUpdateLabels: pointyLabels},
+		Labels:        &ekstypes.UpdateLabelsPayload{AddOrUpdateLabels: labels},
 	})
 	if err != nil {
 		return err
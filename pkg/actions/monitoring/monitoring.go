@@ -0,0 +1,277 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amp"
+	amptypes "github.com/aws/aws-sdk-go-v2/service/amp/types"
+	"github.com/aws/aws-sdk-go-v2/service/grafana"
+	grafanatypes "github.com/aws/aws-sdk-go-v2/service/grafana/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/helm"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+const (
+	// DefaultNamespace is the namespace Prometheus is installed in
+	DefaultNamespace = "prometheus"
+	// ServiceAccountName is the name of the service account Prometheus writes to the workspace with
+	ServiceAccountName = "amp-iamproxy-ingest-service-account"
+
+	releaseName       = "prometheus"
+	chartRepo         = "https://prometheus-community.github.io/helm-charts"
+	chartName         = "prometheus-community/prometheus"
+	chartVersion      = "15.10.1"
+	remoteWritePolicy = "AmazonPrometheusRemoteWriteAccess"
+
+	workspaceActiveTimeout = 5 * time.Minute
+)
+
+// Enabler contains all necessary dependencies to set up the monitoring of a cluster.
+type Enabler struct {
+	StackManager   manager.StackManager
+	Config         *api.ClusterConfig
+	Prometheus     awsapi.AMP
+	Grafana        awsapi.Grafana
+	ChartInstaller helm.ChartInstaller
+	ClientSet      kubernetes.Interface
+	OIDC           *iamoidc.OpenIDConnectManager
+}
+
+// NewEnabler creates a new Enabler.
+func NewEnabler(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clientSet kubernetes.Interface, restClientGetter *kubernetes.SimpleRESTClientGetter) (*Enabler, error) {
+	chartInstaller, err := helm.NewInstaller(helm.Options{
+		Namespace:        DefaultNamespace,
+		RESTClientGetter: restClientGetter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	oidcProviderExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !oidcProviderExists {
+		return nil, fmt.Errorf("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", cfg.Metadata.Region, cfg.Metadata.Name)
+	}
+
+	return &Enabler{
+		StackManager:   stackManager,
+		Config:         cfg,
+		Prometheus:     ctl.Provider.AMP(),
+		Grafana:        ctl.Provider.Grafana(),
+		ChartInstaller: chartInstaller,
+		ClientSet:      clientSet,
+		OIDC:           oidc,
+	}, nil
+}
+
+// Enable writes the metrics of the cluster to a Prometheus workspace, using a Prometheus server that authenticates
+// with an IAM role for its service account, and gives the Grafana workspace access to the Prometheus workspace.
+func (e *Enabler) Enable(ctx context.Context) error {
+	workspace, err := e.ensurePrometheusWorkspace(ctx)
+	if err != nil {
+		return err
+	}
+	workspaceID := aws.ToString(workspace.WorkspaceId)
+	endpoint := aws.ToString(workspace.PrometheusEndpoint)
+	logger.Info("using Prometheus workspace %q with endpoint %s", workspaceID, endpoint)
+
+	if err := e.createServiceAccount(ctx); err != nil {
+		return err
+	}
+
+	if err := e.ChartInstaller.AddRepo(chartRepo, releaseName); err != nil {
+		return fmt.Errorf("adding repo for Prometheus chart: %w", err)
+	}
+	if err := e.ChartInstaller.InstallChart(ctx, helm.InstallChartOpts{
+		ChartName:       chartName,
+		CreateNamespace: true,
+		Namespace:       DefaultNamespace,
+		ReleaseName:     releaseName,
+		Values:          prometheusValues(endpoint, e.Config.Metadata.Region),
+		Version:         chartVersion,
+	}); err != nil {
+		return fmt.Errorf("installing Prometheus chart: %w", err)
+	}
+	logger.Success("Prometheus in namespace %q writes the metrics of cluster %q to workspace %q", DefaultNamespace, e.Config.Metadata.Name, workspaceID)
+
+	if e.Config.Observability.Grafana == nil {
+		return nil
+	}
+	return e.linkGrafana(ctx, workspaceID, endpoint)
+}
+
+func (e *Enabler) ensurePrometheusWorkspace(ctx context.Context) (*amptypes.WorkspaceDescription, error) {
+	workspaceID := e.Config.Observability.Prometheus.WorkspaceID
+	if workspaceID == "" {
+		alias := e.Config.PrometheusWorkspaceAlias()
+		var err error
+		if workspaceID, err = e.findWorkspace(ctx, alias); err != nil {
+			return nil, err
+		}
+		if workspaceID == "" {
+			tags := map[string]string{
+				api.ClusterNameTag: e.Config.Metadata.Name,
+			}
+			for k, v := range e.Config.Metadata.Tags {
+				tags[k] = v
+			}
+			output, err := e.Prometheus.CreateWorkspace(ctx, &amp.CreateWorkspaceInput{
+				Alias: aws.String(alias),
+				Tags:  tags,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("creating Prometheus workspace %q: %w", alias, err)
+			}
+			workspaceID = aws.ToString(output.WorkspaceId)
+			logger.Info("created Prometheus workspace %q with alias %q", workspaceID, alias)
+		}
+	}
+
+	output, err := amp.NewWorkspaceActiveWaiter(e.Prometheus).WaitForOutput(ctx, &amp.DescribeWorkspaceInput{
+		WorkspaceId: aws.String(workspaceID),
+	}, workspaceActiveTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Prometheus workspace %q to become active: %w", workspaceID, err)
+	}
+	return output.Workspace, nil
+}
+
+// findWorkspace returns the ID of the workspace with the alias, workspaces are filtered by prefix
+// so the alias has to be compared
+func (e *Enabler) findWorkspace(ctx context.Context, alias string) (string, error) {
+	paginator := amp.NewListWorkspacesPaginator(e.Prometheus, &amp.ListWorkspacesInput{
+		Alias: aws.String(alias),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("listing Prometheus workspaces: %w", err)
+		}
+		for _, w := range output.Workspaces {
+			if aws.ToString(w.Alias) == alias && w.Status != nil && w.Status.StatusCode != amptypes.WorkspaceStatusCodeDeleting {
+				return aws.ToString(w.WorkspaceId), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func (e *Enabler) createServiceAccount(ctx context.Context) error {
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      ServiceAccountName,
+			Namespace: DefaultNamespace,
+		},
+		AttachPolicyARNs: []string{api.AWSManagedPolicyARN(api.Partition(e.Config.Metadata.Region), remoteWritePolicy)},
+	}
+	existing, err := e.StackManager.ListIAMServiceAccountStacks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range existing {
+		if name == serviceAccount.NameString() {
+			logger.Info("iamserviceaccount %q already exists", name)
+			return nil
+		}
+	}
+
+	taskTree := e.StackManager.NewTasksToCreateIAMServiceAccounts([]*api.ClusterIAMServiceAccount{serviceAccount}, e.OIDC, kubernetes.NewCachedClientSet(e.ClientSet))
+	return doTasks(taskTree)
+}
+
+func (e *Enabler) linkGrafana(ctx context.Context, prometheusWorkspaceID, prometheusEndpoint string) error {
+	grafanaWorkspaceID := e.Config.Observability.Grafana.WorkspaceID
+	output, err := e.Grafana.DescribeWorkspace(ctx, &grafana.DescribeWorkspaceInput{
+		WorkspaceId: aws.String(grafanaWorkspaceID),
+	})
+	if err != nil {
+		return fmt.Errorf("describing Grafana workspace %q: %w", grafanaWorkspaceID, err)
+	}
+	workspace := output.Workspace
+
+	if workspace.PermissionType != grafanatypes.PermissionTypeServiceManaged {
+		logger.Warning("Grafana workspace %q uses customer managed permissions, make sure its IAM role can query Prometheus workspace %q", grafanaWorkspaceID, prometheusWorkspaceID)
+	} else if !hasDataSource(workspace, grafanatypes.DataSourceTypePrometheus) {
+		if _, err := e.Grafana.UpdateWorkspace(ctx, &grafana.UpdateWorkspaceInput{
+			WorkspaceId:          aws.String(grafanaWorkspaceID),
+			WorkspaceDataSources: append(workspace.DataSources, grafanatypes.DataSourceTypePrometheus),
+		}); err != nil {
+			return fmt.Errorf("giving Grafana workspace %q access to Prometheus: %w", grafanaWorkspaceID, err)
+		}
+		logger.Info("gave Grafana workspace %q access to Amazon Managed Service for Prometheus", grafanaWorkspaceID)
+	}
+
+	logger.Info("to query the metrics, add a Prometheus data source with URL %s and SigV4 auth in region %s to Grafana at https://%s",
+		strings.TrimSuffix(prometheusEndpoint, "/"), e.Config.Metadata.Region, aws.ToString(workspace.Endpoint))
+	return nil
+}
+
+func hasDataSource(workspace *grafanatypes.WorkspaceDescription, dataSource grafanatypes.DataSourceType) bool {
+	for _, ds := range workspace.DataSources {
+		if ds == dataSource {
+			return true
+		}
+	}
+	return false
+}
+
+func prometheusValues(endpoint, region string) map[string]interface{} {
+	return map[string]interface{}{
+		"serviceAccounts": map[string]interface{}{
+			"server": map[string]interface{}{
+				"create": false,
+				"name":   ServiceAccountName,
+			},
+		},
+		"alertmanager": map[string]interface{}{
+			"enabled": false,
+		},
+		"pushgateway": map[string]interface{}{
+			"enabled": false,
+		},
+		"server": map[string]interface{}{
+			"remoteWrite": []interface{}{
+				map[string]interface{}{
+					"url": strings.TrimSuffix(endpoint, "/") + "/api/v1/remote_write",
+					"sigv4": map[string]interface{}{
+						"region": region,
+					},
+					"queue_config": map[string]interface{}{
+						"max_samples_per_send": 1000,
+						"max_shards":           200,
+						"capacity":             2500,
+					},
+				},
+			},
+		},
+	}
+}
+
+func doTasks(taskTree *tasks.TaskTree) error {
+	logger.Info(taskTree.Describe())
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while creating the IAM role of Prometheus, you may wish to check CloudFormation console", len(errs))
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to create iamserviceaccount for Prometheus")
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package monitoring_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestMonitoring(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
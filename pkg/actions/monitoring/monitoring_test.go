@@ -0,0 +1,136 @@
+package monitoring_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amp"
+	amptypes "github.com/aws/aws-sdk-go-v2/service/amp/types"
+	"github.com/aws/aws-sdk-go-v2/service/grafana"
+	grafanatypes "github.com/aws/aws-sdk-go-v2/service/grafana/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/monitoring"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	managerfakes "github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	helmfakes "github.com/weaveworks/eksctl/pkg/helm/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("Enable monitoring", func() {
+	var (
+		cfg                *api.ClusterConfig
+		p                  *mockprovider.MockProvider
+		workspaces         []amptypes.WorkspaceSummary
+		grafanaWorkspace   *grafanatypes.WorkspaceDescription
+		fakeStackManager   *managerfakes.FakeStackManager
+		fakeChartInstaller *helmfakes.FakeChartInstaller
+		enabler            *monitoring.Enabler
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Observability = &api.Observability{
+			Prometheus: &api.ObservabilityPrometheus{},
+		}
+		p = mockprovider.NewMockProvider()
+		workspaces = nil
+		p.MockAMP().On("ListWorkspaces", mock.Anything, mock.Anything, mock.Anything).Return(func(_ context.Context, _ *amp.ListWorkspacesInput, _ ...func(*amp.Options)) *amp.ListWorkspacesOutput {
+			return &amp.ListWorkspacesOutput{Workspaces: workspaces}
+		}, nil)
+		p.MockAMP().On("CreateWorkspace", mock.Anything, mock.Anything).Return(&amp.CreateWorkspaceOutput{WorkspaceId: aws.String("ws-new")}, nil)
+		p.MockAMP().On("DescribeWorkspace", mock.Anything, mock.Anything, mock.Anything).Return(func(_ context.Context, input *amp.DescribeWorkspaceInput, _ ...func(*amp.Options)) *amp.DescribeWorkspaceOutput {
+			return &amp.DescribeWorkspaceOutput{
+				Workspace: &amptypes.WorkspaceDescription{
+					WorkspaceId:        input.WorkspaceId,
+					PrometheusEndpoint: aws.String("https://aps-workspaces.us-west-2.amazonaws.com/workspaces/" + aws.ToString(input.WorkspaceId) + "/"),
+					Status:             &amptypes.WorkspaceStatus{StatusCode: amptypes.WorkspaceStatusCodeActive},
+				},
+			}
+		}, nil)
+		grafanaWorkspace = &grafanatypes.WorkspaceDescription{
+			Endpoint:       aws.String("g-0123456789.grafana-workspace.us-west-2.amazonaws.com"),
+			PermissionType: grafanatypes.PermissionTypeServiceManaged,
+			DataSources:    []grafanatypes.DataSourceType{grafanatypes.DataSourceTypeCloudwatch},
+		}
+		p.MockGrafana().On("DescribeWorkspace", mock.Anything, mock.Anything).Return(func(_ context.Context, _ *grafana.DescribeWorkspaceInput, _ ...func(*grafana.Options)) *grafana.DescribeWorkspaceOutput {
+			return &grafana.DescribeWorkspaceOutput{Workspace: grafanaWorkspace}
+		}, nil)
+		p.MockGrafana().On("UpdateWorkspace", mock.Anything, mock.Anything).Return(&grafana.UpdateWorkspaceOutput{}, nil)
+		fakeStackManager = &managerfakes.FakeStackManager{}
+		fakeStackManager.NewTasksToCreateIAMServiceAccountsReturns(&tasks.TaskTree{})
+		fakeChartInstaller = &helmfakes.FakeChartInstaller{}
+		enabler = &monitoring.Enabler{
+			StackManager:   fakeStackManager,
+			Config:         cfg,
+			Prometheus:     p.AMP(),
+			Grafana:        p.Grafana(),
+			ChartInstaller: fakeChartInstaller,
+		}
+	})
+
+	It("creates a workspace and installs Prometheus writing to it", func() {
+		Expect(enabler.Enable(context.Background())).To(Succeed())
+
+		p.MockAMP().AssertCalled(GinkgoT(), "CreateWorkspace", mock.Anything, mock.MatchedBy(func(input *amp.CreateWorkspaceInput) bool {
+			return aws.ToString(input.Alias) == "eksctl-my-cluster" && input.Tags[api.ClusterNameTag] == "my-cluster"
+		}))
+
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(1))
+		serviceAccounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+		Expect(serviceAccounts).To(HaveLen(1))
+		Expect(serviceAccounts[0].NameString()).To(Equal("prometheus/amp-iamproxy-ingest-service-account"))
+		Expect(serviceAccounts[0].AttachPolicyARNs).To(ConsistOf("arn:aws:iam::aws:policy/AmazonPrometheusRemoteWriteAccess"))
+
+		Expect(fakeChartInstaller.InstallChartCallCount()).To(Equal(1))
+		_, opts := fakeChartInstaller.InstallChartArgsForCall(0)
+		Expect(opts.ReleaseName).To(Equal("prometheus"))
+		Expect(opts.Namespace).To(Equal(monitoring.DefaultNamespace))
+		remoteWrite := opts.Values["server"].(map[string]interface{})["remoteWrite"].([]interface{})
+		Expect(remoteWrite[0]).To(HaveKeyWithValue("url", "https://aps-workspaces.us-west-2.amazonaws.com/workspaces/ws-new/api/v1/remote_write"))
+
+		p.MockGrafana().AssertNotCalled(GinkgoT(), "UpdateWorkspace", mock.Anything, mock.Anything)
+	})
+
+	It("reuses the workspace with the cluster's alias", func() {
+		workspaces = []amptypes.WorkspaceSummary{
+			{Alias: aws.String("eksctl-my-cluster-2"), WorkspaceId: aws.String("ws-other"), Status: &amptypes.WorkspaceStatus{StatusCode: amptypes.WorkspaceStatusCodeActive}},
+			{Alias: aws.String("eksctl-my-cluster"), WorkspaceId: aws.String("ws-existing"), Status: &amptypes.WorkspaceStatus{StatusCode: amptypes.WorkspaceStatusCodeActive}},
+		}
+		Expect(enabler.Enable(context.Background())).To(Succeed())
+
+		p.MockAMP().AssertNotCalled(GinkgoT(), "CreateWorkspace", mock.Anything, mock.Anything)
+		_, opts := fakeChartInstaller.InstallChartArgsForCall(0)
+		remoteWrite := opts.Values["server"].(map[string]interface{})["remoteWrite"].([]interface{})
+		Expect(remoteWrite[0]).To(HaveKeyWithValue("url", "https://aps-workspaces.us-west-2.amazonaws.com/workspaces/ws-existing/api/v1/remote_write"))
+	})
+
+	It("doesn't recreate an existing iamserviceaccount", func() {
+		fakeStackManager.ListIAMServiceAccountStacksReturns([]string{"prometheus/amp-iamproxy-ingest-service-account"}, nil)
+		Expect(enabler.Enable(context.Background())).To(Succeed())
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(BeZero())
+		Expect(fakeChartInstaller.InstallChartCallCount()).To(Equal(1))
+	})
+
+	It("gives the Grafana workspace access to Prometheus", func() {
+		cfg.Observability.Grafana = &api.ObservabilityGrafana{WorkspaceID: "g-0123456789"}
+		Expect(enabler.Enable(context.Background())).To(Succeed())
+
+		p.MockGrafana().AssertCalled(GinkgoT(), "UpdateWorkspace", mock.Anything, &grafana.UpdateWorkspaceInput{
+			WorkspaceId:          aws.String("g-0123456789"),
+			WorkspaceDataSources: []grafanatypes.DataSourceType{grafanatypes.DataSourceTypeCloudwatch, grafanatypes.DataSourceTypePrometheus},
+		})
+	})
+
+	It("doesn't update Grafana workspaces with customer managed permissions", func() {
+		cfg.Observability.Grafana = &api.ObservabilityGrafana{WorkspaceID: "g-0123456789"}
+		grafanaWorkspace.PermissionType = grafanatypes.PermissionTypeCustomerManaged
+		Expect(enabler.Enable(context.Background())).To(Succeed())
+		p.MockGrafana().AssertNotCalled(GinkgoT(), "UpdateWorkspace", mock.Anything, mock.Anything)
+	})
+})
@@ -0,0 +1,239 @@
+package networkpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/blang/semver"
+	"github.com/kris-nova/logger"
+	appsv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+const (
+	// NodeAgentContainer is the container of the aws-node DaemonSet that runs the network policy agent
+	NodeAgentContainer = "aws-eks-nodeagent"
+	// DefaultDenyPolicyName is the name of the NetworkPolicy denying all the traffic of the pods of a namespace
+	DefaultDenyPolicyName = "default-deny-all"
+
+	awsNodeName      = "aws-node"
+	awsNodeNamespace = metav1.NamespaceSystem
+)
+
+// minimumVPCCNIVersion is the first version of the vpc-cni addon that ships the network policy agent
+var minimumVPCCNIVersion = semver.MustParse("1.14.0")
+
+// AddonUpdater updates EKS addons
+type AddonUpdater interface {
+	Update(ctx context.Context, addon *api.Addon, wait bool) error
+}
+
+// Enabler enables the network policy agent of the VPC CNI in a cluster
+type Enabler struct {
+	cfg          *api.ClusterConfig
+	eksAPI       awsapi.EKS
+	addonUpdater AddonUpdater
+	clientSet    kubernetes.Interface
+	timeout      time.Duration
+
+	// PollInterval is how often the rollout of the aws-node DaemonSet is checked
+	PollInterval time.Duration
+}
+
+// New creates a new Enabler, timeout is how long it waits for the aws-node DaemonSet to roll out
+func New(cfg *api.ClusterConfig, eksAPI awsapi.EKS, addonUpdater AddonUpdater, clientSet kubernetes.Interface, timeout time.Duration) *Enabler {
+	return &Enabler{
+		cfg:          cfg,
+		eksAPI:       eksAPI,
+		addonUpdater: addonUpdater,
+		clientSet:    clientSet,
+		timeout:      timeout,
+		PollInterval: 10 * time.Second,
+	}
+}
+
+// Enable sets enableNetworkPolicy in the configuration values of the vpc-cni addon, waits for the aws-node
+// DaemonSet to roll out the network policy agent, then applies a NetworkPolicy denying all the traffic of
+// the pods of each of defaultDenyNamespaces. In plan mode it only logs the changes.
+func (e *Enabler) Enable(ctx context.Context, defaultDenyNamespaces []string, plan bool) error {
+	if err := e.updateAddon(ctx, plan); err != nil {
+		return err
+	}
+	if plan {
+		for _, namespace := range defaultDenyNamespaces {
+			logger.Info("(plan) would apply NetworkPolicy %q denying all ingress and egress traffic in namespace %q", DefaultDenyPolicyName, namespace)
+		}
+		return nil
+	}
+	if err := e.waitForRollout(ctx); err != nil {
+		return err
+	}
+	for _, namespace := range defaultDenyNamespaces {
+		if err := e.applyDefaultDeny(ctx, namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Enabler) updateAddon(ctx context.Context, plan bool) error {
+	output, err := e.eksAPI.DescribeAddon(ctx, &awseks.DescribeAddonInput{
+		ClusterName: aws.String(e.cfg.Metadata.Name),
+		AddonName:   aws.String(api.VPCCNIAddon),
+	})
+	if err != nil {
+		var notFound *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return fmt.Errorf("the network policy agent is only available with the %[1]s addon, create it with `eksctl create addon --name %[1]s --force` first", api.VPCCNIAddon)
+		}
+		return fmt.Errorf("describing the %s addon: %w", api.VPCCNIAddon, err)
+	}
+
+	addonVersion := aws.ToString(output.Addon.AddonVersion)
+	version, err := semver.ParseTolerant(addonVersion)
+	if err != nil {
+		return fmt.Errorf("parsing version %q of the %s addon: %w", addonVersion, api.VPCCNIAddon, err)
+	}
+	version.Pre, version.Build = nil, nil
+	if version.LT(minimumVPCCNIVersion) {
+		return fmt.Errorf("the network policy agent requires version %s or later of the %s addon, found %s, update it first", minimumVPCCNIVersion, api.VPCCNIAddon, addonVersion)
+	}
+
+	configurationValues, updated, err := enableNetworkPolicy(aws.ToString(output.Addon.ConfigurationValues))
+	if err != nil {
+		return err
+	}
+	if !updated {
+		logger.Info("enableNetworkPolicy is already set in the configuration values of the %s addon", api.VPCCNIAddon)
+		return nil
+	}
+	if plan {
+		logger.Info("(plan) would set enableNetworkPolicy in the configuration values of the %s addon", api.VPCCNIAddon)
+		return nil
+	}
+
+	if err := e.addonUpdater.Update(ctx, &api.Addon{
+		Name:                api.VPCCNIAddon,
+		Version:             addonVersion,
+		ConfigurationValues: configurationValues,
+	}, true); err != nil {
+		return err
+	}
+	logger.Info("set enableNetworkPolicy in the configuration values of the %s addon", api.VPCCNIAddon)
+	return nil
+}
+
+// enableNetworkPolicy sets enableNetworkPolicy in the configuration values of the vpc-cni addon, keeping the
+// other values. It returns false if it was already set
+func enableNetworkPolicy(configurationValues string) (string, bool, error) {
+	values := map[string]interface{}{}
+	if strings.TrimSpace(configurationValues) != "" {
+		// configuration values are JSON or YAML
+		if err := yaml.Unmarshal([]byte(configurationValues), &values); err != nil {
+			return "", false, fmt.Errorf("parsing the configuration values of the %s addon: %w", api.VPCCNIAddon, err)
+		}
+	}
+	if values["enableNetworkPolicy"] == "true" {
+		return configurationValues, false, nil
+	}
+	values["enableNetworkPolicy"] = "true"
+	updated, err := json.Marshal(values)
+	if err != nil {
+		return "", false, fmt.Errorf("marshalling the configuration values of the %s addon: %w", api.VPCCNIAddon, err)
+	}
+	return string(updated), true, nil
+}
+
+// waitForRollout waits for the pods of the aws-node DaemonSet to be updated with the network policy agent
+func (e *Enabler) waitForRollout(ctx context.Context) error {
+	logger.Info("waiting for the %s DaemonSet to roll out the %s container", awsNodeName, NodeAgentContainer)
+	var status string
+	err := wait.PollImmediate(e.PollInterval, e.timeout, func() (bool, error) {
+		daemonSet, err := e.clientSet.AppsV1().DaemonSets(awsNodeNamespace).Get(ctx, awsNodeName, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("getting the %s DaemonSet: %w", awsNodeName, err)
+		}
+		if !hasContainer(daemonSet, NodeAgentContainer) {
+			status = fmt.Sprintf("the %s container hasn't been added yet", NodeAgentContainer)
+			return false, nil
+		}
+		done, rolloutStatus := rolledOut(daemonSet)
+		status = rolloutStatus
+		return done, nil
+	})
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return fmt.Errorf("timed out waiting for the %s DaemonSet to roll out the %s container: %s", awsNodeName, NodeAgentContainer, status)
+	}
+	if err != nil {
+		return err
+	}
+	logger.Info("the %s container is running on all the nodes", NodeAgentContainer)
+	return nil
+}
+
+func hasContainer(daemonSet *appsv1.DaemonSet, name string) bool {
+	for _, container := range daemonSet.Spec.Template.Spec.Containers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// rolledOut returns true when all the pods of daemonSet run its latest spec, as `kubectl rollout status` does
+func rolledOut(daemonSet *appsv1.DaemonSet) (bool, string) {
+	s := daemonSet.Status
+	switch {
+	case s.ObservedGeneration < daemonSet.Generation:
+		return false, "the update hasn't been observed yet"
+	case s.UpdatedNumberScheduled < s.DesiredNumberScheduled:
+		return false, fmt.Sprintf("%d of %d pods updated", s.UpdatedNumberScheduled, s.DesiredNumberScheduled)
+	case s.NumberAvailable < s.DesiredNumberScheduled:
+		return false, fmt.Sprintf("%d of %d updated pods available", s.NumberAvailable, s.DesiredNumberScheduled)
+	}
+	return true, ""
+}
+
+// applyDefaultDeny creates or replaces the NetworkPolicy denying all ingress and egress traffic of the pods of namespace
+func (e *Enabler) applyDefaultDeny(ctx context.Context, namespace string) error {
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultDenyPolicyName,
+			Namespace: namespace,
+			Labels:    map[string]string{api.ClusterNameLabel: e.cfg.Metadata.Name},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		},
+	}
+	policies := e.clientSet.NetworkingV1().NetworkPolicies(namespace)
+	_, err := policies.Create(ctx, policy, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		var existing *networkingv1.NetworkPolicy
+		if existing, err = policies.Get(ctx, DefaultDenyPolicyName, metav1.GetOptions{}); err == nil {
+			policy.ResourceVersion = existing.ResourceVersion
+			_, err = policies.Update(ctx, policy, metav1.UpdateOptions{})
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("applying NetworkPolicy %q in namespace %q: %w", DefaultDenyPolicyName, namespace, err)
+	}
+	logger.Info("applied NetworkPolicy %q denying all ingress and egress traffic in namespace %q", DefaultDenyPolicyName, namespace)
+	return nil
+}
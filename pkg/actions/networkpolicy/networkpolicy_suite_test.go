@@ -0,0 +1,11 @@
+package networkpolicy_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestNetworkPolicy(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
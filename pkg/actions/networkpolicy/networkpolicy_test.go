@@ -0,0 +1,180 @@
+package networkpolicy_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/networkpolicy"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type fakeAddonUpdater struct {
+	updated []*api.Addon
+}
+
+func (f *fakeAddonUpdater) Update(_ context.Context, addon *api.Addon, _ bool) error {
+	f.updated = append(f.updated, addon)
+	return nil
+}
+
+var _ = Describe("Enable network policy", func() {
+	var (
+		cfg          *api.ClusterConfig
+		p            *mockprovider.MockProvider
+		addonUpdater *fakeAddonUpdater
+		clientSet    *fake.Clientset
+		enabler      *networkpolicy.Enabler
+	)
+
+	mockVPCCNIAddon := func(version, configurationValues string) {
+		p.MockEKS().On("DescribeAddon", mock.Anything, &awseks.DescribeAddonInput{
+			ClusterName: aws.String("my-cluster"),
+			AddonName:   aws.String(api.VPCCNIAddon),
+		}).Return(&awseks.DescribeAddonOutput{
+			Addon: &ekstypes.Addon{
+				AddonName:           aws.String(api.VPCCNIAddon),
+				AddonVersion:        aws.String(version),
+				ConfigurationValues: aws.String(configurationValues),
+			},
+		}, nil)
+	}
+
+	awsNode := func(containers []string, desired, updated, available int32) *appsv1.DaemonSet {
+		daemonSet := &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "aws-node",
+				Namespace:  metav1.NamespaceSystem,
+				Generation: 2,
+			},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration:     2,
+				DesiredNumberScheduled: desired,
+				UpdatedNumberScheduled: updated,
+				NumberAvailable:        available,
+			},
+		}
+		for _, name := range containers {
+			daemonSet.Spec.Template.Spec.Containers = append(daemonSet.Spec.Template.Spec.Containers, corev1.Container{Name: name})
+		}
+		return daemonSet
+	}
+
+	newEnabler := func(daemonSet *appsv1.DaemonSet) {
+		clientSet = fake.NewSimpleClientset(daemonSet)
+		enabler = networkpolicy.New(cfg, p.EKS(), addonUpdater, clientSet, 100*time.Millisecond)
+		enabler.PollInterval = 10 * time.Millisecond
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		p = mockprovider.NewMockProvider()
+		addonUpdater = &fakeAddonUpdater{}
+		newEnabler(awsNode([]string{"aws-node", networkpolicy.NodeAgentContainer}, 3, 3, 3))
+	})
+
+	It("sets enableNetworkPolicy in the vpc-cni configuration values and applies the default deny policies", func() {
+		mockVPCCNIAddon("v1.14.1-eksbuild.1", `{"env":{"ENABLE_PREFIX_DELEGATION":"true"}}`)
+
+		Expect(enabler.Enable(context.Background(), []string{"default", "apps"}, false)).To(Succeed())
+
+		Expect(addonUpdater.updated).To(HaveLen(1))
+		Expect(addonUpdater.updated[0].Name).To(Equal(api.VPCCNIAddon))
+		Expect(addonUpdater.updated[0].Version).To(Equal("v1.14.1-eksbuild.1"))
+		Expect(addonUpdater.updated[0].ConfigurationValues).To(MatchJSON(`{"env":{"ENABLE_PREFIX_DELEGATION":"true"},"enableNetworkPolicy":"true"}`))
+
+		for _, namespace := range []string{"default", "apps"} {
+			policy, err := clientSet.NetworkingV1().NetworkPolicies(namespace).Get(context.Background(), networkpolicy.DefaultDenyPolicyName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Spec.PodSelector).To(Equal(metav1.LabelSelector{}))
+			Expect(policy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+			Expect(policy.Spec.Ingress).To(BeEmpty())
+			Expect(policy.Spec.Egress).To(BeEmpty())
+		}
+	})
+
+	It("reads YAML configuration values", func() {
+		mockVPCCNIAddon("v1.15.0-eksbuild.2", "env:\n  WARM_IP_TARGET: \"2\"\n")
+
+		Expect(enabler.Enable(context.Background(), nil, false)).To(Succeed())
+		Expect(addonUpdater.updated[0].ConfigurationValues).To(MatchJSON(`{"env":{"WARM_IP_TARGET":"2"},"enableNetworkPolicy":"true"}`))
+	})
+
+	It("doesn't update the addon when the network policy agent is already enabled", func() {
+		mockVPCCNIAddon("v1.14.1-eksbuild.1", `{"enableNetworkPolicy":"true"}`)
+
+		Expect(enabler.Enable(context.Background(), nil, false)).To(Succeed())
+		Expect(addonUpdater.updated).To(BeEmpty())
+	})
+
+	It("replaces an existing default deny policy", func() {
+		mockVPCCNIAddon("v1.14.1-eksbuild.1", "")
+		_, err := clientSet.NetworkingV1().NetworkPolicies("default").Create(context.Background(), &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: networkpolicy.DefaultDenyPolicyName, Namespace: "default"},
+			Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(enabler.Enable(context.Background(), []string{"default"}, false)).To(Succeed())
+		policy, err := clientSet.NetworkingV1().NetworkPolicies("default").Get(context.Background(), networkpolicy.DefaultDenyPolicyName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+	})
+
+	It("fails when the vpc-cni addon isn't installed", func() {
+		p.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Return(nil, &ekstypes.ResourceNotFoundException{})
+
+		err := enabler.Enable(context.Background(), nil, false)
+		Expect(err).To(MatchError(ContainSubstring("the network policy agent is only available with the vpc-cni addon")))
+		Expect(addonUpdater.updated).To(BeEmpty())
+	})
+
+	It("fails when the vpc-cni addon doesn't ship the network policy agent", func() {
+		mockVPCCNIAddon("v1.12.6-eksbuild.2", "")
+
+		err := enabler.Enable(context.Background(), []string{"default"}, false)
+		Expect(err).To(MatchError(ContainSubstring("requires version 1.14.0 or later of the vpc-cni addon, found v1.12.6-eksbuild.2")))
+		Expect(addonUpdater.updated).To(BeEmpty())
+	})
+
+	It("fails when the network policy agent doesn't roll out", func() {
+		mockVPCCNIAddon("v1.14.1-eksbuild.1", "")
+		newEnabler(awsNode([]string{"aws-node", networkpolicy.NodeAgentContainer}, 3, 1, 3))
+
+		err := enabler.Enable(context.Background(), []string{"default"}, false)
+		Expect(err).To(MatchError(ContainSubstring("timed out waiting for the aws-node DaemonSet to roll out the aws-eks-nodeagent container: 1 of 3 pods updated")))
+		_, err = clientSet.NetworkingV1().NetworkPolicies("default").Get(context.Background(), networkpolicy.DefaultDenyPolicyName, metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the network policy agent container isn't added", func() {
+		mockVPCCNIAddon("v1.14.1-eksbuild.1", "")
+		newEnabler(awsNode([]string{"aws-node"}, 3, 3, 3))
+
+		err := enabler.Enable(context.Background(), nil, false)
+		Expect(err).To(MatchError(ContainSubstring("the aws-eks-nodeagent container hasn't been added yet")))
+	})
+
+	It("doesn't change anything in plan mode", func() {
+		mockVPCCNIAddon("v1.14.1-eksbuild.1", "")
+
+		Expect(enabler.Enable(context.Background(), []string{"default"}, true)).To(Succeed())
+		Expect(addonUpdater.updated).To(BeEmpty())
+		policies, err := clientSet.NetworkingV1().NetworkPolicies("default").List(context.Background(), metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policies.Items).To(BeEmpty())
+	})
+})
@@ -0,0 +1,105 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/managed"
+)
+
+// EnableAutoAMIUpdates creates or updates the stack that updates the AMIs of managed nodegroups on a schedule
+func (m *Manager) EnableAutoAMIUpdates(ctx context.Context, options builder.AutoAMIUpdatesOptions, plan bool) error {
+	if err := options.Validate(); err != nil {
+		return err
+	}
+	for _, name := range options.NodeGroups {
+		if _, err := m.ctl.Provider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+			ClusterName:   &m.cfg.Metadata.Name,
+			NodegroupName: aws.String(name),
+		}); err != nil {
+			if managed.IsNotFound(err) {
+				return fmt.Errorf("could not find managed nodegroup with name %q", name)
+			}
+			return err
+		}
+	}
+
+	resourceSet := builder.NewAutoAMIUpdatesResourceSet(m.cfg, options)
+	if err := resourceSet.AddAllResources(); err != nil {
+		return err
+	}
+
+	stackName := manager.MakeAutoAMIUpdatesStackName(m.cfg.Metadata.Name)
+	stack, err := m.stackManager.GetAutoAMIUpdatesStack(ctx)
+	if err != nil {
+		return fmt.Errorf("describing automatic AMI updates stack: %w", err)
+	}
+
+	target := "all managed nodegroups"
+	if len(options.NodeGroups) > 0 {
+		target = fmt.Sprintf("managed nodegroup(s) %v", options.NodeGroups)
+	}
+	if plan {
+		logger.Info("(plan) would update the AMIs of %s of cluster %q at %q, within a %s maintenance window", target, m.cfg.Metadata.Name, options.Schedule, options.MaintenanceWindow)
+		return nil
+	}
+
+	if stack == nil {
+		errs := make(chan error)
+		if err := m.stackManager.CreateStack(ctx, stackName, resourceSet, nil, nil, errs); err != nil {
+			return err
+		}
+		if err := <-errs; err != nil {
+			return err
+		}
+	} else {
+		templateBody, err := resourceSet.RenderJSON()
+		if err != nil {
+			return err
+		}
+		if err := m.stackManager.UpdateStack(ctx, manager.UpdateStackOptions{
+			Stack:         stack,
+			ChangeSetName: m.stackManager.MakeChangeSetName("update-auto-ami-updates"),
+			Description:   fmt.Sprintf("updating stack %q", stackName),
+			TemplateData:  manager.TemplateBody(templateBody),
+			Wait:          true,
+		}); err != nil {
+			return err
+		}
+	}
+	logger.Success("the AMIs of %s of cluster %q will be updated at %q", target, m.cfg.Metadata.Name, options.Schedule)
+	return nil
+}
+
+// DisableAutoAMIUpdates deletes the stack that updates the AMIs of managed nodegroups on a schedule
+func (m *Manager) DisableAutoAMIUpdates(ctx context.Context, plan, wait bool) error {
+	stack, err := m.stackManager.GetAutoAMIUpdatesStack(ctx)
+	if err != nil {
+		return fmt.Errorf("describing automatic AMI updates stack: %w", err)
+	}
+	if stack == nil {
+		logger.Info("automatic AMI updates are not enabled for cluster %q", m.cfg.Metadata.Name)
+		return nil
+	}
+	if plan {
+		logger.Info("(plan) would delete stack %q", *stack.StackName)
+		return nil
+	}
+
+	if wait {
+		err = m.stackManager.DeleteStackSync(ctx, stack)
+	} else {
+		_, err = m.stackManager.DeleteStackBySpec(ctx, stack)
+	}
+	if err != nil {
+		return fmt.Errorf("deleting stack %q: %w", *stack.StackName, err)
+	}
+	logger.Success("disabled automatic AMI updates for cluster %q", m.cfg.Metadata.Name)
+	return nil
+}
@@ -0,0 +1,111 @@
+package nodegroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Auto AMI updates", func() {
+	const stackName = "eksctl-my-cluster-auto-ami-updates"
+
+	var (
+		p                *mockprovider.MockProvider
+		fakeStackManager *fakes.FakeStackManager
+		m                *Manager
+		options          builder.AutoAMIUpdatesOptions
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := configbuilder.New("my-cluster", "us-west-2").Build()
+		fakeStackManager = new(fakes.FakeStackManager)
+		m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+		m.SetStackManager(fakeStackManager)
+		options = builder.AutoAMIUpdatesOptions{
+			Schedule:          "cron(0 3 ? * SUN *)",
+			MaintenanceWindow: time.Hour,
+			NodeGroups:        []string{"ng-1"},
+		}
+	})
+
+	mockNodegroup := func() {
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String("my-cluster"),
+			NodegroupName: aws.String("ng-1"),
+		}).Return(&awseks.DescribeNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{}}, nil)
+	}
+
+	It("fails for nodegroups that are not managed nodegroups", func() {
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, mock.Anything).
+			Return(nil, &ekstypes.ResourceNotFoundException{Message: aws.String("not found")})
+
+		err := m.EnableAutoAMIUpdates(context.Background(), options, false)
+		Expect(err).To(MatchError(`could not find managed nodegroup with name "ng-1"`))
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+	})
+
+	It("creates the stack when automatic AMI updates are not enabled", func() {
+		mockNodegroup()
+		fakeStackManager.GetAutoAMIUpdatesStackReturns(nil, nil)
+		fakeStackManager.CreateStackStub = func(_ context.Context, _ string, _ builder.ResourceSetReader, _, _ map[string]string, errs chan error) error {
+			go func() { errs <- nil }()
+			return nil
+		}
+
+		Expect(m.EnableAutoAMIUpdates(context.Background(), options, false)).To(Succeed())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+		_, name, _, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+		Expect(name).To(Equal(stackName))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+	})
+
+	It("updates the stack when automatic AMI updates are already enabled", func() {
+		mockNodegroup()
+		fakeStackManager.GetAutoAMIUpdatesStackReturns(&manager.Stack{StackName: aws.String(stackName)}, nil)
+
+		Expect(m.EnableAutoAMIUpdates(context.Background(), options, false)).To(Succeed())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+		_, updateOptions := fakeStackManager.UpdateStackArgsForCall(0)
+		Expect(*updateOptions.Stack.StackName).To(Equal(stackName))
+		Expect(updateOptions.TemplateData).To(BeAssignableToTypeOf(manager.TemplateBody{}))
+	})
+
+	It("does not change anything in plan mode", func() {
+		mockNodegroup()
+		fakeStackManager.GetAutoAMIUpdatesStackReturns(nil, nil)
+
+		Expect(m.EnableAutoAMIUpdates(context.Background(), options, true)).To(Succeed())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+	})
+
+	It("deletes the stack to disable automatic AMI updates", func() {
+		fakeStackManager.GetAutoAMIUpdatesStackReturns(&manager.Stack{StackName: aws.String(stackName)}, nil)
+
+		Expect(m.DisableAutoAMIUpdates(context.Background(), false, true)).To(Succeed())
+		Expect(fakeStackManager.DeleteStackSyncCallCount()).To(Equal(1))
+	})
+
+	It("does nothing to disable automatic AMI updates that are not enabled", func() {
+		fakeStackManager.GetAutoAMIUpdatesStackReturns(nil, nil)
+
+		Expect(m.DisableAutoAMIUpdates(context.Background(), false, true)).To(Succeed())
+		Expect(fakeStackManager.DeleteStackSyncCallCount()).To(Equal(0))
+		Expect(fakeStackManager.DeleteStackBySpecCallCount()).To(Equal(0))
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
@@ -44,6 +45,10 @@ func (m *Manager) Create(ctx context.Context, options CreateOpts, nodegroupFilte
 		return err
 	}
 
+	if err := validateNodeGroupVersions(cfg, ctl.ControlPlaneVersion()); err != nil {
+		return err
+	}
+
 	if err := m.checkARMSupport(ctl, m.clientSet, cfg, options.SkipOutdatedAddonsCheck); err != nil {
 		return err
 	}
@@ -74,6 +79,9 @@ func (m *Manager) Create(ctx context.Context, options CreateOpts, nodegroupFilte
 		if err := m.init.Normalize(ctx, nodePools, cfg.Metadata); err != nil {
 			return err
 		}
+		if err := m.init.ApplyVPCCNIConfig(ctx, nodePools, cfg); err != nil {
+			return err
+		}
 	}
 
 	printer := printers.NewJSONPrinter()
@@ -194,15 +202,27 @@ func (m *Manager) postNodeCreationTasks(clientSet kubernetes.Interface, options
 		return fmt.Errorf("failed to create nodegroups for cluster %q", m.cfg.Metadata.Name)
 	}
 
+	var authNodeGroups, skippedAuthNodeGroups []*api.NodeGroup
+	for _, ng := range m.cfg.NodeGroups {
+		if m.cfg.SkipsAWSAuthConfigMap(ng) {
+			skippedAuthNodeGroups = append(skippedAuthNodeGroups, ng)
+		} else {
+			authNodeGroups = append(authNodeGroups, ng)
+		}
+	}
+	nodesReadyTimeout := m.cfg.Timeouts.NodesReadyTimeout(m.ctl.Provider.WaitTimeout())
 	if options.UpdateAuthConfigMap {
-		if err := m.kubeProvider.UpdateAuthConfigMap(m.cfg.NodeGroups, clientSet); err != nil {
+		if err := m.kubeProvider.UpdateAuthConfigMap(authNodeGroups, clientSet, nodesReadyTimeout); err != nil {
 			return err
 		}
 	}
+	if err := authconfigmap.LogNodeGroupsMapRoles(skippedAuthNodeGroups); err != nil {
+		return err
+	}
 	logger.Success("created %d nodegroup(s) in cluster %q", len(m.cfg.NodeGroups), m.cfg.Metadata.Name)
 
 	for _, ng := range m.cfg.ManagedNodeGroups {
-		if err := m.kubeProvider.WaitForNodes(clientSet, ng); err != nil {
+		if err := m.kubeProvider.WaitForNodes(clientSet, ng, nodesReadyTimeout); err != nil {
 			if m.cfg.PrivateCluster.Enabled {
 				logger.Info("error waiting for nodes to join the cluster; this command was likely run from outside the cluster's VPC as the API server is not reachable, nodegroup(s) should still be able to join the cluster, underlying error is: %v", err)
 				break
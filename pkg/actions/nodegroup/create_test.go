@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
@@ -17,6 +18,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/eks/fakes"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
 
@@ -267,32 +269,40 @@ var _ = DescribeTable("Create", func(t ngEntry) {
 	}),
 )
 
+var _ = Describe("Create with iam.skipNodeGroupsAWSAuthConfigMap", func() {
+	It("doesn't add the nodegroups to the auth ConfigMap", func() {
+		cfg := newClusterConfig()
+		cfg.Metadata.Version = ""
+		cfg.IAM.SkipNodeGroupsAWSAuthConfigMap = api.Enabled()
+		cfg.NodeGroups[0].IAM = &api.NodeGroupIAM{InstanceRoleARN: "arn:aws:iam::123456789012:role/my-ng-role"}
+
+		ctl := &eks.ClusterProvider{
+			Provider: mockprovider.NewMockProvider(),
+			Status: &eks.ProviderStatus{
+				ClusterInfo: &eks.ClusterInfo{
+					Cluster: testutils.NewFakeCluster("my-cluster", ""),
+				},
+			},
+		}
+		m := nodegroup.New(cfg, ctl, nil)
+		k := &fakes.FakeKubeProvider{}
+		m.MockKubeProvider(k)
+		m.MockNodeGroupService(&fakes.FakeNodeGroupInitialiser{})
+
+		ngFilter := &utilFakes.FakeNodegroupFilter{}
+		ngFilter.MatchReturns(true)
+
+		Expect(m.Create(context.Background(), nodegroup.CreateOpts{UpdateAuthConfigMap: true}, ngFilter)).To(Succeed())
+		Expect(k.UpdateAuthConfigMapCallCount()).To(Equal(1))
+		nodeGroups, _, _ := k.UpdateAuthConfigMapArgsForCall(0)
+		Expect(nodeGroups).To(BeEmpty())
+	})
+})
+
 func newClusterConfig() *api.ClusterConfig {
-	return &api.ClusterConfig{
-		TypeMeta: api.ClusterConfigTypeMeta(),
-		Metadata: &api.ClusterMeta{
-			Name:    "my-cluster",
-			Version: api.DefaultVersion,
-		},
-		Status: &api.ClusterStatus{
-			Endpoint:                 "https://localhost/",
-			CertificateAuthorityData: []byte("dGVzdAo="),
-		},
-		IAM: api.NewClusterIAM(),
-		VPC: api.NewClusterVPC(false),
-		CloudWatch: &api.ClusterCloudWatch{
-			ClusterLogging: &api.ClusterCloudWatchLogging{},
-		},
-		PrivateCluster: &api.PrivateCluster{},
-		NodeGroups: []*api.NodeGroup{{
-			NodeGroupBase: &api.NodeGroupBase{
-				Name: "my-ng",
-			}},
-		},
-		ManagedNodeGroups: []*api.ManagedNodeGroup{{
-			NodeGroupBase: &api.NodeGroupBase{
-				Name: "my-ng",
-			}},
-		},
-	}
+	return configbuilder.New("my-cluster", "").
+		WithEndpoint("https://localhost/", []byte("dGVzdAo=")).
+		WithNodegroup("my-ng").
+		WithManagedNodegroup("my-ng").
+		Build()
 }
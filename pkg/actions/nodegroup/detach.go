@@ -0,0 +1,223 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// maxDetachInstances is the maximum number of instances a DetachInstances call accepts
+const maxDetachInstances = 20
+
+// detachTarget holds the resources of a nodegroup its instances are detached from
+type detachTarget struct {
+	asgNames []string
+	// securityGroupID is the ID of the security group owned by the nodegroup stack, if any. It is removed from the
+	// detached instances, as the stack can't be deleted while it is still in use
+	securityGroupID string
+}
+
+// DetachInstances detaches the instances of nodeGroups from their Auto Scaling groups, so that they keep running after
+// the nodegroups are deleted, and tags them with the name of the nodegroup they were detached from
+func (m *Manager) DetachInstances(ctx context.Context, nodeGroups []eks.KubeNodeGroup, plan bool) error {
+	stackInfos, err := m.stackManager.DescribeNodeGroupStacksAndResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, ng := range nodeGroups {
+		name := ng.NameString()
+		target, err := m.getDetachTarget(ctx, name, stackInfos)
+		if err != nil {
+			return fmt.Errorf("getting the Auto Scaling groups of nodegroup %q: %w", name, err)
+		}
+		instances, err := m.getDetachableInstances(ctx, target.asgNames)
+		if err != nil {
+			return fmt.Errorf("getting the instances of nodegroup %q: %w", name, err)
+		}
+
+		var instanceIDs []string
+		for _, ids := range instances {
+			instanceIDs = append(instanceIDs, ids...)
+		}
+		if len(instanceIDs) == 0 {
+			logger.Info("nodegroup %q has no instances to detach", name)
+			continue
+		}
+		if plan {
+			logger.Info("(plan) would detach %d instance(s) from nodegroup %q: %s", len(instanceIDs), name, strings.Join(instanceIDs, ", "))
+			continue
+		}
+
+		if _, err := m.ctl.Provider.EC2().CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: instanceIDs,
+			Tags: []ec2types.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String(m.cfg.Metadata.Name)},
+				{Key: aws.String(api.DetachedFromNodeGroupTag), Value: aws.String(name)},
+			},
+		}); err != nil {
+			return fmt.Errorf("tagging the instances of nodegroup %q: %w", name, err)
+		}
+
+		for asgName, ids := range instances {
+			if err := m.detachFromAutoScalingGroup(ctx, asgName, ids); err != nil {
+				return fmt.Errorf("detaching the instances of nodegroup %q: %w", name, err)
+			}
+		}
+
+		if target.securityGroupID != "" {
+			if err := m.removeSecurityGroup(ctx, instanceIDs, target.securityGroupID); err != nil {
+				return fmt.Errorf("removing the security group of nodegroup %q from its instances: %w", name, err)
+			}
+		}
+
+		logger.Info("detached %d instance(s) from nodegroup %q, they are tagged with %s=%s: %s", len(instanceIDs), name, api.DetachedFromNodeGroupTag, name, strings.Join(instanceIDs, ", "))
+	}
+	return nil
+}
+
+func (m *Manager) getDetachTarget(ctx context.Context, name string, stackInfos map[string]manager.StackInfo) (detachTarget, error) {
+	if stackInfo, ok := stackInfos[name]; ok {
+		nodeGroupType, err := manager.GetNodeGroupType(stackInfo.Stack.Tags)
+		if err != nil {
+			return detachTarget{}, err
+		}
+		if nodeGroupType != api.NodeGroupTypeManaged {
+			var target detachTarget
+			for _, resource := range stackInfo.Resources {
+				switch aws.ToString(resource.LogicalResourceId) {
+				case "NodeGroup":
+					target.asgNames = append(target.asgNames, aws.ToString(resource.PhysicalResourceId))
+				case "SG":
+					target.securityGroupID = aws.ToString(resource.PhysicalResourceId)
+				}
+			}
+			if len(target.asgNames) == 0 {
+				return detachTarget{}, fmt.Errorf("failed to find NodeGroup auto scaling group")
+			}
+			return target, nil
+		}
+	}
+
+	output, err := m.ctl.Provider.EKS().DescribeNodegroup(ctx, &awseks.DescribeNodegroupInput{
+		ClusterName:   &m.cfg.Metadata.Name,
+		NodegroupName: &name,
+	})
+	if err != nil {
+		return detachTarget{}, err
+	}
+	var target detachTarget
+	if output.Nodegroup.Resources != nil {
+		for _, asg := range output.Nodegroup.Resources.AutoScalingGroups {
+			target.asgNames = append(target.asgNames, aws.ToString(asg.Name))
+		}
+	}
+	return target, nil
+}
+
+// getDetachableInstances returns the IDs of the instances that can be detached, by Auto Scaling group name
+func (m *Manager) getDetachableInstances(ctx context.Context, asgNames []string) (map[string][]string, error) {
+	instances := map[string][]string{}
+	if len(asgNames) == 0 {
+		return instances, nil
+	}
+
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(m.ctl.Provider.ASG(), &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: asgNames,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing Auto Scaling groups: %w", err)
+		}
+		for _, asg := range output.AutoScalingGroups {
+			for _, instance := range asg.Instances {
+				switch instance.LifecycleState {
+				case asgtypes.LifecycleStateInService, asgtypes.LifecycleStateStandby:
+					asgName := aws.ToString(asg.AutoScalingGroupName)
+					instances[asgName] = append(instances[asgName], aws.ToString(instance.InstanceId))
+				}
+			}
+		}
+	}
+	return instances, nil
+}
+
+func (m *Manager) detachFromAutoScalingGroup(ctx context.Context, asgName string, instanceIDs []string) error {
+	// the desired capacity is decremented as instances are detached, which fails if it drops below the minimum size
+	if _, err := m.ctl.Provider.ASG().UpdateAutoScalingGroup(ctx, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: &asgName,
+		MinSize:              aws.Int32(0),
+	}); err != nil {
+		return fmt.Errorf("setting the minimum size of Auto Scaling group %q to 0: %w", asgName, err)
+	}
+
+	for start := 0; start < len(instanceIDs); start += maxDetachInstances {
+		end := start + maxDetachInstances
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+		if _, err := m.ctl.Provider.ASG().DetachInstances(ctx, &autoscaling.DetachInstancesInput{
+			AutoScalingGroupName:           &asgName,
+			InstanceIds:                    instanceIDs[start:end],
+			ShouldDecrementDesiredCapacity: aws.Bool(true),
+		}); err != nil {
+			return fmt.Errorf("detaching instances from Auto Scaling group %q: %w", asgName, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) removeSecurityGroup(ctx context.Context, instanceIDs []string, securityGroupID string) error {
+	paginator := ec2.NewDescribeInstancesPaginator(m.ctl.Provider.EC2(), &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("describing instances: %w", err)
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				for _, networkInterface := range instance.NetworkInterfaces {
+					var groups []string
+					found := false
+					for _, group := range networkInterface.Groups {
+						if aws.ToString(group.GroupId) == securityGroupID {
+							found = true
+							continue
+						}
+						groups = append(groups, aws.ToString(group.GroupId))
+					}
+					if !found {
+						continue
+					}
+					if len(groups) == 0 {
+						logger.Warning("network interface %q of instance %q only belongs to security group %q, the deletion of the nodegroup stack will fail until the security group is removed from it",
+							aws.ToString(networkInterface.NetworkInterfaceId), aws.ToString(instance.InstanceId), securityGroupID)
+						continue
+					}
+					if _, err := m.ctl.Provider.EC2().ModifyNetworkInterfaceAttribute(ctx, &ec2.ModifyNetworkInterfaceAttributeInput{
+						NetworkInterfaceId: networkInterface.NetworkInterfaceId,
+						Groups:             groups,
+					}); err != nil {
+						return fmt.Errorf("updating the security groups of network interface %q: %w", aws.ToString(networkInterface.NetworkInterfaceId), err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
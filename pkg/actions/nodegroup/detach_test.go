@@ -0,0 +1,189 @@
+package nodegroup_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("DetachInstances", func() {
+	var (
+		p                *mockprovider.MockProvider
+		cfg              *api.ClusterConfig
+		m                *nodegroup.Manager
+		fakeStackManager *fakes.FakeStackManager
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = configbuilder.New("my-cluster", "").Build()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+		fakeStackManager = new(fakes.FakeStackManager)
+		m.SetStackManager(fakeStackManager)
+	})
+
+	mockASG := func(asgName string, instanceIDs ...string) {
+		var instances []asgtypes.Instance
+		for _, id := range instanceIDs {
+			instances = append(instances, asgtypes.Instance{
+				InstanceId:     aws.String(id),
+				LifecycleState: asgtypes.LifecycleStateInService,
+			})
+		}
+		instances = append(instances, asgtypes.Instance{
+			InstanceId:     aws.String("i-terminating"),
+			LifecycleState: asgtypes.LifecycleStateTerminating,
+		})
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{asgName},
+		}, mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{
+				{
+					AutoScalingGroupName: aws.String(asgName),
+					Instances:            instances,
+				},
+			},
+		}, nil)
+		p.MockASG().On("UpdateAutoScalingGroup", mock.Anything, &autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(asgName),
+			MinSize:              aws.Int32(0),
+		}).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
+		p.MockASG().On("DetachInstances", mock.Anything, mock.Anything).Return(&autoscaling.DetachInstancesOutput{}, nil)
+		p.MockEC2().On("CreateTags", mock.Anything, mock.Anything).Return(&ec2.CreateTagsOutput{}, nil)
+	}
+
+	Context("unmanaged nodegroups", func() {
+		BeforeEach(func() {
+			fakeStackManager.DescribeNodeGroupStacksAndResourcesReturns(map[string]manager.StackInfo{
+				"ng-1": {
+					Stack: &manager.Stack{
+						Tags: []cfntypes.Tag{
+							{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+							{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+						},
+					},
+					Resources: []cfntypes.StackResource{
+						{LogicalResourceId: aws.String("NodeGroup"), PhysicalResourceId: aws.String("asg-1")},
+						{LogicalResourceId: aws.String("SG"), PhysicalResourceId: aws.String("sg-local")},
+					},
+				},
+			}, nil)
+			mockASG("asg-1", "i-1", "i-2")
+			p.MockEC2().On("DescribeInstances", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstancesOutput{
+				Reservations: []ec2types.Reservation{
+					{
+						Instances: []ec2types.Instance{
+							{
+								InstanceId: aws.String("i-1"),
+								NetworkInterfaces: []ec2types.InstanceNetworkInterface{
+									{
+										NetworkInterfaceId: aws.String("eni-1"),
+										Groups: []ec2types.GroupIdentifier{
+											{GroupId: aws.String("sg-shared")},
+											{GroupId: aws.String("sg-local")},
+										},
+									},
+								},
+							},
+							{
+								InstanceId: aws.String("i-2"),
+								NetworkInterfaces: []ec2types.InstanceNetworkInterface{
+									{
+										NetworkInterfaceId: aws.String("eni-2"),
+										Groups: []ec2types.GroupIdentifier{
+											{GroupId: aws.String("sg-local")},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+			p.MockEC2().On("ModifyNetworkInterfaceAttribute", mock.Anything, mock.Anything).Return(&ec2.ModifyNetworkInterfaceAttributeOutput{}, nil)
+		})
+
+		It("tags and detaches the running instances and removes the nodegroup security group", func() {
+			err := m.DetachInstances(context.Background(), []eks.KubeNodeGroup{&api.NodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "ng-1"}}}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			p.MockEC2().AssertCalled(GinkgoT(), "CreateTags", mock.Anything, &ec2.CreateTagsInput{
+				Resources: []string{"i-1", "i-2"},
+				Tags: []ec2types.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("my-cluster")},
+					{Key: aws.String(api.DetachedFromNodeGroupTag), Value: aws.String("ng-1")},
+				},
+			})
+			p.MockASG().AssertCalled(GinkgoT(), "DetachInstances", mock.Anything, &autoscaling.DetachInstancesInput{
+				AutoScalingGroupName:           aws.String("asg-1"),
+				InstanceIds:                    []string{"i-1", "i-2"},
+				ShouldDecrementDesiredCapacity: aws.Bool(true),
+			})
+			p.MockEC2().AssertCalled(GinkgoT(), "ModifyNetworkInterfaceAttribute", mock.Anything, &ec2.ModifyNetworkInterfaceAttributeInput{
+				NetworkInterfaceId: aws.String("eni-1"),
+				Groups:             []string{"sg-shared"},
+			})
+			p.MockEC2().AssertNumberOfCalls(GinkgoT(), "ModifyNetworkInterfaceAttribute", 1)
+		})
+
+		It("doesn't change anything in plan mode", func() {
+			err := m.DetachInstances(context.Background(), []eks.KubeNodeGroup{&api.NodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "ng-1"}}}, true)
+			Expect(err).NotTo(HaveOccurred())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "CreateTags", mock.Anything, mock.Anything)
+			p.MockASG().AssertNotCalled(GinkgoT(), "DetachInstances", mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("managed nodegroups", func() {
+		BeforeEach(func() {
+			fakeStackManager.DescribeNodeGroupStacksAndResourcesReturns(map[string]manager.StackInfo{}, nil)
+		})
+
+		It("detaches the instances of the Auto Scaling groups of the nodegroup in batches", func() {
+			var instanceIDs []string
+			for i := 0; i < 25; i++ {
+				instanceIDs = append(instanceIDs, fmt.Sprintf("i-%d", i))
+			}
+			mockASG("eks-asg", instanceIDs...)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+				ClusterName:   aws.String("my-cluster"),
+				NodegroupName: aws.String("mng-1"),
+			}).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &ekstypes.Nodegroup{
+					Resources: &ekstypes.NodegroupResources{
+						AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String("eks-asg")}},
+					},
+				},
+			}, nil)
+
+			err := m.DetachInstances(context.Background(), []eks.KubeNodeGroup{&api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "mng-1"}}}, false)
+			Expect(err).NotTo(HaveOccurred())
+			p.MockASG().AssertNumberOfCalls(GinkgoT(), "DetachInstances", 2)
+			p.MockASG().AssertCalled(GinkgoT(), "DetachInstances", mock.Anything, &autoscaling.DetachInstancesInput{
+				AutoScalingGroupName:           aws.String("eks-asg"),
+				InstanceIds:                    instanceIDs[20:],
+				ShouldDecrementDesiredCapacity: aws.Bool(true),
+			})
+			p.MockEC2().AssertNotCalled(GinkgoT(), "ModifyNetworkInterfaceAttribute", mock.Anything, mock.Anything)
+		})
+	})
+})
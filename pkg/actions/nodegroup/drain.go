@@ -1,8 +1,16 @@
 package nodegroup
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
+
 	"github.com/weaveworks/eksctl/pkg/eks"
 
 	"github.com/weaveworks/eksctl/pkg/drain"
@@ -17,16 +25,76 @@ type DrainInput struct {
 	Undo                  bool
 	DisableEviction       bool
 	Parallel              int
+	// DrainTimeout is the maximum time to wait for each nodegroup to be drained,
+	// defaults to the provider wait timeout
+	DrainTimeout time.Duration
+	// NodeGroupParallel is the number of nodegroups to drain at the same time, defaults to 1
+	NodeGroupParallel int
+	// ContinueOnPDBBlock treats nodegroups whose drain timed out because of a PodDisruptionBudget as drained
+	ContinueOnPDBBlock bool
 }
 
 func (m *Manager) Drain(input *DrainInput) error {
-	if !input.Plan {
-		for _, n := range input.NodeGroups {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, n, m.ctl.Provider.WaitTimeout(), input.MaxGracePeriod, input.NodeDrainWaitPeriod, input.PodEvictionWaitPeriod, input.Undo, input.DisableEviction, input.Parallel)
-			if err := nodeGroupDrainer.Drain(); err != nil {
-				return err
-			}
+	if input.Plan {
+		return nil
+	}
+
+	waitTimeout := input.DrainTimeout
+	if waitTimeout == 0 {
+		waitTimeout = m.ctl.Provider.WaitTimeout()
+	}
+	nodeGroupParallel := input.NodeGroupParallel
+	if nodeGroupParallel < 1 {
+		nodeGroupParallel = 1
+	}
+
+	var (
+		wg      sync.WaitGroup
+		sem     = semaphore.NewWeighted(int64(nodeGroupParallel))
+		results = make([]error, len(input.NodeGroups))
+	)
+	if len(input.NodeGroups) > 1 {
+		logger.Info("draining %d nodegroup(s), max in-flight of %d", len(input.NodeGroups), nodeGroupParallel)
+	}
+	for i, n := range input.NodeGroups {
+		if err := sem.Acquire(context.TODO(), 1); err != nil {
+			return errors.Wrap(err, "failed to acquire semaphore")
 		}
+		wg.Add(1)
+		go func(i int, n eks.KubeNodeGroup) {
+			defer wg.Done()
+			defer sem.Release(1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, n, waitTimeout, input.MaxGracePeriod, input.NodeDrainWaitPeriod, input.PodEvictionWaitPeriod, input.Undo, input.DisableEviction, input.Parallel)
+			results[i] = nodeGroupDrainer.Drain()
+		}(i, n)
+	}
+	wg.Wait()
+
+	return reportDrainOutcomes(input.NodeGroups, results, input.ContinueOnPDBBlock)
+}
+
+// reportDrainOutcomes logs the drain outcome of each nodegroup and returns an error
+// listing the nodegroups that failed to drain
+func reportDrainOutcomes(nodeGroups []eks.KubeNodeGroup, results []error, continueOnPDBBlock bool) error {
+	var failures []string
+	for i, n := range nodeGroups {
+		err := results[i]
+		switch {
+		case err == nil:
+			logger.Info("nodegroup %q: drained", n.NameString())
+		case continueOnPDBBlock && errors.Is(err, drain.ErrDrainBlockedByPDB):
+			logger.Warning("nodegroup %q: drain blocked by PodDisruptionBudget, continuing: %v", n.NameString(), err)
+		default:
+			logger.Critical("nodegroup %q: drain failed: %v", n.NameString(), err)
+			failures = append(failures, fmt.Sprintf("%s: %v", n.NameString(), err))
+		}
+	}
+
+	if len(failures) == 1 && len(nodeGroups) == 1 {
+		return results[0]
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to drain %d of %d nodegroup(s): %s", len(failures), len(nodeGroups), strings.Join(failures, "; "))
 	}
 	return nil
 }
@@ -0,0 +1,65 @@
+package nodegroup_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+)
+
+type drainOutcomesEntry struct {
+	results            []error
+	continueOnPDBBlock bool
+
+	expectedErr string
+}
+
+var _ = Describe("Drain", func() {
+	newNodeGroups := func(n int) []eks.KubeNodeGroup {
+		var nodeGroups []eks.KubeNodeGroup
+		for i := 0; i < n; i++ {
+			ng := &mocks.KubeNodeGroup{}
+			ng.On("NameString").Return(fmt.Sprintf("ng-%d", i))
+			nodeGroups = append(nodeGroups, ng)
+		}
+		return nodeGroups
+	}
+
+	pdbErr := fmt.Errorf("timed out (after 1m0s) waiting for nodegroup \"ng-1\" to be drained: %w", drain.ErrDrainBlockedByPDB)
+
+	DescribeTable("reporting drain outcomes", func(e drainOutcomesEntry) {
+		err := nodegroup.ReportDrainOutcomes(newNodeGroups(len(e.results)), e.results, e.continueOnPDBBlock)
+		if e.expectedErr == "" {
+			Expect(err).NotTo(HaveOccurred())
+			return
+		}
+		Expect(err).To(MatchError(e.expectedErr))
+	},
+		Entry("all nodegroups drained", drainOutcomesEntry{
+			results: []error{nil, nil},
+		}),
+		Entry("a single nodegroup failed", drainOutcomesEntry{
+			results:     []error{errors.New("boom")},
+			expectedErr: "boom",
+		}),
+		Entry("some nodegroups failed", drainOutcomesEntry{
+			results:     []error{nil, errors.New("boom"), errors.New("bang")},
+			expectedErr: "failed to drain 2 of 3 nodegroup(s): ng-1: boom; ng-2: bang",
+		}),
+		Entry("a nodegroup was blocked by a PodDisruptionBudget", drainOutcomesEntry{
+			results:     []error{nil, pdbErr},
+			expectedErr: `failed to drain 1 of 2 nodegroup(s): ng-1: timed out (after 1m0s) waiting for nodegroup "ng-1" to be drained: pod evictions blocked by PodDisruptionBudget`,
+		}),
+		Entry("a nodegroup was blocked by a PodDisruptionBudget with continueOnPDBBlock", drainOutcomesEntry{
+			results:            []error{nil, pdbErr},
+			continueOnPDBBlock: true,
+		}),
+	)
+})
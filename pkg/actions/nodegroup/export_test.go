@@ -5,10 +5,6 @@ import (
 	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
-func (m *Manager) SetWaiter(wait WaitFunc) {
-	m.wait = wait
-}
-
 func (m *Manager) SetStackManager(stackManager manager.StackManager) {
 	m.stackManager = stackManager
 }
@@ -22,3 +18,5 @@ func (m *Manager) MockKubeProvider(k eks.KubeProvider) {
 func (m *Manager) MockNodeGroupService(ngSvc eks.NodeGroupInitialiser) {
 	m.init = ngSvc
 }
+
+var ReportDrainOutcomes = reportDrainOutcomes
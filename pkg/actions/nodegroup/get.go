@@ -7,11 +7,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 	"github.com/tidwall/gjson"
 
@@ -42,6 +42,8 @@ type Summary struct {
 	AutoScalingGroupName string
 	Version              string
 	NodeGroupType        api.NodeGroupType `json:"Type"`
+	// Instances is only set when requested, see AddInstancesSummaries
+	Instances *InstancesSummary `json:",omitempty"`
 }
 
 func (m *Manager) GetAll(ctx context.Context) ([]*Summary, error) {
@@ -73,7 +75,7 @@ func (m *Manager) Get(ctx context.Context, name string) (*Summary, error) {
 
 func (m *Manager) getManagedSummaries(ctx context.Context) ([]*Summary, error) {
 	var summaries []*Summary
-	managedNodeGroups, err := m.ctl.Provider.EKS().ListNodegroups(&eks.ListNodegroupsInput{
+	managedNodeGroups, err := m.ctl.Provider.EKS().ListNodegroups(ctx, &eks.ListNodegroupsInput{
 		ClusterName: aws.String(m.cfg.Metadata.Name),
 	})
 	if err != nil {
@@ -82,16 +84,16 @@ func (m *Manager) getManagedSummaries(ctx context.Context) ([]*Summary, error) {
 
 	for _, ngName := range managedNodeGroups.Nodegroups {
 		var stack *types.Stack
-		stack, err = m.stackManager.DescribeNodeGroupStack(ctx, *ngName)
+		stack, err = m.stackManager.DescribeNodeGroupStack(ctx, ngName)
 		if err != nil {
 			stack = &types.Stack{}
 		}
 
-		summary, err := m.getManagedSummary(ctx, *ngName)
+		summary, err := m.getManagedSummary(ctx, ngName)
 		if err != nil {
 			return nil, err
 		}
-		summary.StackName = aws.StringValue(stack.StackName)
+		summary.StackName = aws.ToString(stack.StackName)
 		summaries = append(summaries, summary)
 	}
 
@@ -275,7 +277,7 @@ func getClusterNameTag(s *manager.Stack) string {
 }
 
 func (m *Manager) getManagedSummary(ctx context.Context, nodeGroupName string) (*Summary, error) {
-	describeOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+	describeOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
 		ClusterName:   aws.String(m.cfg.Metadata.Name),
 		NodegroupName: aws.String(nodeGroupName),
 	})
@@ -289,22 +291,22 @@ func (m *Manager) getManagedSummary(ctx context.Context, nodeGroupName string) (
 	var asgs []string
 	if ng.Resources != nil {
 		for _, asg := range ng.Resources.AutoScalingGroups {
-			asgs = append(asgs, aws.StringValue(asg.Name))
+			asgs = append(asgs, aws.ToString(asg.Name))
 		}
 	}
 
 	var imageID string
-	if *ng.AmiType == awseks.AMITypesCustom {
+	if ng.AmiType == ekstypes.AMITypesCustom {
 		// ReleaseVersion contains the AMI ID for custom AMIs.
 		imageID = *ng.ReleaseVersion
 	} else {
-		imageID = *ng.AmiType
+		imageID = string(ng.AmiType)
 	}
 
 	return &Summary{
 		Name:                 *ng.NodegroupName,
 		Cluster:              *ng.ClusterName,
-		Status:               *ng.Status,
+		Status:               string(ng.Status),
 		MaxSize:              int(*ng.ScalingConfig.MaxSize),
 		MinSize:              int(*ng.ScalingConfig.MinSize),
 		DesiredCapacity:      int(*ng.ScalingConfig.DesiredSize),
@@ -318,9 +320,9 @@ func (m *Manager) getManagedSummary(ctx context.Context, nodeGroupName string) (
 	}, nil
 }
 
-func (m *Manager) getInstanceTypes(ctx context.Context, ng *awseks.Nodegroup) string {
+func (m *Manager) getInstanceTypes(ctx context.Context, ng *ekstypes.Nodegroup) string {
 	if len(ng.InstanceTypes) > 0 {
-		return strings.Join(aws.StringValueSlice(ng.InstanceTypes), ",")
+		return strings.Join(ng.InstanceTypes, ",")
 	}
 
 	if ng.LaunchTemplate == nil {
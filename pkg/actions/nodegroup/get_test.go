@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	cftypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
@@ -22,6 +23,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
 
@@ -39,8 +41,7 @@ var _ = Describe("Get", func() {
 	)
 
 	BeforeEach(func() {
-		cfg = api.NewClusterConfig()
-		cfg.Metadata.Name = clusterName
+		cfg = configbuilder.New(clusterName, "").Build()
 		p = mockprovider.NewMockProvider()
 		fakeClientSet = fake.NewSimpleClientset()
 		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, fakeClientSet)
@@ -50,16 +51,16 @@ var _ = Describe("Get", func() {
 
 	Describe("GetAll", func() {
 		BeforeEach(func() {
-			p.MockEKS().On("ListNodegroups", &awseks.ListNodegroupsInput{
+			p.MockEKS().On("ListNodegroups", mock.Anything, &awseks.ListNodegroupsInput{
 				ClusterName: aws.String(clusterName),
 			}).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.ListNodegroupsInput{
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.ListNodegroupsInput{
 					ClusterName: aws.String(clusterName),
 				}))
 			}).Return(&awseks.ListNodegroupsOutput{
-				Nodegroups: []*string{
-					aws.String(ngName),
+				Nodegroups: []string{
+					ngName,
 				},
 			}, nil)
 		})
@@ -67,31 +68,31 @@ var _ = Describe("Get", func() {
 		Context("when getting managed nodegroups", func() {
 			When("a nodegroup is associated to a CF Stack", func() {
 				BeforeEach(func() {
-					p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+					p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 						ClusterName:   aws.String(clusterName),
 						NodegroupName: aws.String(ngName),
 					}).Run(func(args mock.Arguments) {
-						Expect(args).To(HaveLen(1))
-						Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
+						Expect(args).To(HaveLen(2))
+						Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
 							ClusterName:   aws.String(clusterName),
 							NodegroupName: aws.String(ngName),
 						}))
 					}).Return(&awseks.DescribeNodegroupOutput{
-						Nodegroup: &awseks.Nodegroup{
+						Nodegroup: &ekstypes.Nodegroup{
 							NodegroupName: aws.String(ngName),
 							ClusterName:   aws.String(clusterName),
-							Status:        aws.String("my-status"),
-							ScalingConfig: &awseks.NodegroupScalingConfig{
-								DesiredSize: aws.Int64(2),
-								MaxSize:     aws.Int64(4),
-								MinSize:     aws.Int64(0),
+							Status:        ekstypes.NodegroupStatus("my-status"),
+							ScalingConfig: &ekstypes.NodegroupScalingConfig{
+								DesiredSize: aws.Int32(2),
+								MaxSize:     aws.Int32(4),
+								MinSize:     aws.Int32(0),
 							},
-							InstanceTypes: []*string{},
-							AmiType:       aws.String("ami-type"),
+							InstanceTypes: []string{},
+							AmiType:       ekstypes.AMITypes("ami-type"),
 							CreatedAt:     &t,
 							NodeRole:      aws.String("node-role"),
-							Resources: &awseks.NodegroupResources{
-								AutoScalingGroups: []*awseks.AutoScalingGroup{
+							Resources: &ekstypes.NodegroupResources{
+								AutoScalingGroups: []ekstypes.AutoScalingGroup{
 									{
 										Name: aws.String("asg-name"),
 									},
@@ -133,31 +134,31 @@ var _ = Describe("Get", func() {
 
 			When("a nodegroup is not associated to a CF Stack", func() {
 				BeforeEach(func() {
-					p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+					p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 						ClusterName:   aws.String(clusterName),
 						NodegroupName: aws.String(ngName),
 					}).Run(func(args mock.Arguments) {
-						Expect(args).To(HaveLen(1))
-						Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
+						Expect(args).To(HaveLen(2))
+						Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
 							ClusterName:   aws.String(clusterName),
 							NodegroupName: aws.String(ngName),
 						}))
 					}).Return(&awseks.DescribeNodegroupOutput{
-						Nodegroup: &awseks.Nodegroup{
+						Nodegroup: &ekstypes.Nodegroup{
 							NodegroupName: aws.String(ngName),
 							ClusterName:   aws.String(clusterName),
-							Status:        aws.String("my-status"),
-							ScalingConfig: &awseks.NodegroupScalingConfig{
-								DesiredSize: aws.Int64(2),
-								MaxSize:     aws.Int64(4),
-								MinSize:     aws.Int64(0),
+							Status:        ekstypes.NodegroupStatus("my-status"),
+							ScalingConfig: &ekstypes.NodegroupScalingConfig{
+								DesiredSize: aws.Int32(2),
+								MaxSize:     aws.Int32(4),
+								MinSize:     aws.Int32(0),
 							},
-							InstanceTypes: []*string{},
-							AmiType:       aws.String("ami-type"),
+							InstanceTypes: []string{},
+							AmiType:       ekstypes.AMITypes("ami-type"),
 							CreatedAt:     &t,
 							NodeRole:      aws.String("node-role"),
-							Resources: &awseks.NodegroupResources{
-								AutoScalingGroups: []*awseks.AutoScalingGroup{
+							Resources: &ekstypes.NodegroupResources{
+								AutoScalingGroups: []ekstypes.AutoScalingGroup{
 									{
 										Name: aws.String("asg-name"),
 									},
@@ -197,38 +198,38 @@ var _ = Describe("Get", func() {
 
 			When("a nodegroup is associated with a launch template", func() {
 				BeforeEach(func() {
-					p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+					p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 						ClusterName:   aws.String(clusterName),
 						NodegroupName: aws.String(ngName),
 					}).Run(func(args mock.Arguments) {
-						Expect(args).To(HaveLen(1))
-						Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
+						Expect(args).To(HaveLen(2))
+						Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
 							ClusterName:   aws.String(clusterName),
 							NodegroupName: aws.String(ngName),
 						}))
 					}).Return(&awseks.DescribeNodegroupOutput{
-						Nodegroup: &awseks.Nodegroup{
+						Nodegroup: &ekstypes.Nodegroup{
 							NodegroupName: aws.String(ngName),
 							ClusterName:   aws.String(clusterName),
-							Status:        aws.String("my-status"),
-							ScalingConfig: &awseks.NodegroupScalingConfig{
-								DesiredSize: aws.Int64(2),
-								MaxSize:     aws.Int64(4),
-								MinSize:     aws.Int64(0),
+							Status:        ekstypes.NodegroupStatus("my-status"),
+							ScalingConfig: &ekstypes.NodegroupScalingConfig{
+								DesiredSize: aws.Int32(2),
+								MaxSize:     aws.Int32(4),
+								MinSize:     aws.Int32(0),
 							},
-							InstanceTypes: []*string{},
-							AmiType:       aws.String("ami-type"),
+							InstanceTypes: []string{},
+							AmiType:       ekstypes.AMITypes("ami-type"),
 							CreatedAt:     &t,
 							NodeRole:      aws.String("node-role"),
-							Resources: &awseks.NodegroupResources{
-								AutoScalingGroups: []*awseks.AutoScalingGroup{
+							Resources: &ekstypes.NodegroupResources{
+								AutoScalingGroups: []ekstypes.AutoScalingGroup{
 									{
 										Name: aws.String("asg-name"),
 									},
 								},
 							},
 							Version: aws.String("1.18"),
-							LaunchTemplate: &awseks.LaunchTemplateSpecification{
+							LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
 								Id:      aws.String("4"),
 								Version: aws.String("5"),
 							},
@@ -276,32 +277,32 @@ var _ = Describe("Get", func() {
 
 			When("a nodegroup has a custom AMI", func() {
 				BeforeEach(func() {
-					p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+					p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 						ClusterName:   aws.String(clusterName),
 						NodegroupName: aws.String(ngName),
 					}).Run(func(args mock.Arguments) {
-						Expect(args).To(HaveLen(1))
-						Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
+						Expect(args).To(HaveLen(2))
+						Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
 							ClusterName:   aws.String(clusterName),
 							NodegroupName: aws.String(ngName),
 						}))
 					}).Return(&awseks.DescribeNodegroupOutput{
-						Nodegroup: &awseks.Nodegroup{
+						Nodegroup: &ekstypes.Nodegroup{
 							NodegroupName: aws.String(ngName),
 							ClusterName:   aws.String(clusterName),
-							Status:        aws.String("my-status"),
-							ScalingConfig: &awseks.NodegroupScalingConfig{
-								DesiredSize: aws.Int64(2),
-								MaxSize:     aws.Int64(4),
-								MinSize:     aws.Int64(0),
+							Status:        ekstypes.NodegroupStatus("my-status"),
+							ScalingConfig: &ekstypes.NodegroupScalingConfig{
+								DesiredSize: aws.Int32(2),
+								MaxSize:     aws.Int32(4),
+								MinSize:     aws.Int32(0),
 							},
-							InstanceTypes:  aws.StringSlice([]string{"m5.xlarge"}),
-							AmiType:        aws.String("CUSTOM"),
+							InstanceTypes:  []string{"m5.xlarge"},
+							AmiType:        ekstypes.AMITypesCustom,
 							CreatedAt:      &t,
 							NodeRole:       aws.String("node-role"),
 							ReleaseVersion: aws.String("ami-custom"),
-							Resources: &awseks.NodegroupResources{
-								AutoScalingGroups: []*awseks.AutoScalingGroup{
+							Resources: &ekstypes.NodegroupResources{
+								AutoScalingGroups: []ekstypes.AutoScalingGroup{
 									{
 										Name: aws.String("asg-1"),
 									},
@@ -409,31 +410,31 @@ var _ = Describe("Get", func() {
 				fakeStackManager.GetNodeGroupNameReturns(unmanagedNodegroupName)
 
 				//managed nodegroup
-				p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 					ClusterName:   aws.String(clusterName),
 					NodegroupName: aws.String(ngName),
 				}).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
-					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
+					Expect(args).To(HaveLen(2))
+					Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
 						ClusterName:   aws.String(clusterName),
 						NodegroupName: aws.String(ngName),
 					}))
 				}).Return(&awseks.DescribeNodegroupOutput{
-					Nodegroup: &awseks.Nodegroup{
+					Nodegroup: &ekstypes.Nodegroup{
 						NodegroupName: aws.String(ngName),
 						ClusterName:   aws.String(clusterName),
-						Status:        aws.String("my-status"),
-						ScalingConfig: &awseks.NodegroupScalingConfig{
-							DesiredSize: aws.Int64(2),
-							MaxSize:     aws.Int64(4),
-							MinSize:     aws.Int64(0),
+						Status:        ekstypes.NodegroupStatus("my-status"),
+						ScalingConfig: &ekstypes.NodegroupScalingConfig{
+							DesiredSize: aws.Int32(2),
+							MaxSize:     aws.Int32(4),
+							MinSize:     aws.Int32(0),
 						},
-						InstanceTypes: []*string{},
-						AmiType:       aws.String("ami-type"),
+						InstanceTypes: []string{},
+						AmiType:       ekstypes.AMITypes("ami-type"),
 						CreatedAt:     &t,
 						NodeRole:      aws.String("node-role"),
-						Resources: &awseks.NodegroupResources{
-							AutoScalingGroups: []*awseks.AutoScalingGroup{
+						Resources: &ekstypes.NodegroupResources{
+							AutoScalingGroups: []ekstypes.AutoScalingGroup{
 								{
 									Name: aws.String("asg-name"),
 								},
@@ -508,31 +509,31 @@ var _ = Describe("Get", func() {
 				},
 			}, nil)
 
-			p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+			p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 				ClusterName:   aws.String(clusterName),
 				NodegroupName: aws.String(ngName),
 			}).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeNodegroupInput{
 					ClusterName:   aws.String(clusterName),
 					NodegroupName: aws.String(ngName),
 				}))
 			}).Return(&awseks.DescribeNodegroupOutput{
-				Nodegroup: &awseks.Nodegroup{
+				Nodegroup: &ekstypes.Nodegroup{
 					NodegroupName: aws.String(ngName),
 					ClusterName:   aws.String(clusterName),
-					Status:        aws.String("my-status"),
-					ScalingConfig: &awseks.NodegroupScalingConfig{
-						DesiredSize: aws.Int64(2),
-						MaxSize:     aws.Int64(4),
-						MinSize:     aws.Int64(0),
+					Status:        ekstypes.NodegroupStatus("my-status"),
+					ScalingConfig: &ekstypes.NodegroupScalingConfig{
+						DesiredSize: aws.Int32(2),
+						MaxSize:     aws.Int32(4),
+						MinSize:     aws.Int32(0),
 					},
-					InstanceTypes: aws.StringSlice([]string{"m5.xlarge"}),
-					AmiType:       aws.String("ami-type"),
+					InstanceTypes: []string{"m5.xlarge"},
+					AmiType:       ekstypes.AMITypes("ami-type"),
 					CreatedAt:     &t,
 					NodeRole:      aws.String("node-role"),
-					Resources: &awseks.NodegroupResources{
-						AutoScalingGroups: []*awseks.AutoScalingGroup{
+					Resources: &ekstypes.NodegroupResources{
+						AutoScalingGroups: []ekstypes.AutoScalingGroup{
 							{
 								Name: aws.String("asg-1"),
 							},
@@ -0,0 +1,107 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// InstancesSummary summarises the instances in service in the Auto Scaling groups of a nodegroup
+type InstancesSummary struct {
+	Spot              int
+	OnDemand          int
+	InstanceTypes     []string
+	AvailabilityZones map[string]int
+}
+
+// AddInstancesSummaries sets the Instances of summaries from their Auto Scaling groups
+func (m *Manager) AddInstancesSummaries(ctx context.Context, summaries []*Summary) error {
+	for _, s := range summaries {
+		instances, err := m.getInstancesSummary(ctx, s.AutoScalingGroupName)
+		if err != nil {
+			return fmt.Errorf("getting instances of nodegroup %q: %w", s.Name, err)
+		}
+		s.Instances = instances
+	}
+	return nil
+}
+
+func (m *Manager) getInstancesSummary(ctx context.Context, asgNames string) (*InstancesSummary, error) {
+	summary := &InstancesSummary{
+		AvailabilityZones: map[string]int{},
+	}
+	if asgNames == "" {
+		return summary, nil
+	}
+
+	inService, err := m.getInServiceInstances(ctx, asgNames)
+	if err != nil {
+		return nil, err
+	}
+	if len(inService) == 0 {
+		return summary, nil
+	}
+
+	instanceTypes := map[string]struct{}{}
+	var instanceIDs []string
+	for _, instance := range inService {
+		instanceTypes[aws.ToString(instance.InstanceType)] = struct{}{}
+		summary.AvailabilityZones[aws.ToString(instance.AvailabilityZone)]++
+		instanceIDs = append(instanceIDs, aws.ToString(instance.InstanceId))
+	}
+	for instanceType := range instanceTypes {
+		summary.InstanceTypes = append(summary.InstanceTypes, instanceType)
+	}
+	sort.Strings(summary.InstanceTypes)
+
+	// the lifecycle of instances is only reported by EC2
+	instancesPaginator := ec2.NewDescribeInstancesPaginator(m.ctl.Provider.EC2(), &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	for instancesPaginator.HasMorePages() {
+		output, err := instancesPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing instances: %w", err)
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot {
+					summary.Spot++
+				} else {
+					summary.OnDemand++
+				}
+			}
+		}
+	}
+
+	return summary, nil
+}
+
+// getInServiceInstances returns the instances in service in the comma-separated Auto Scaling groups asgNames
+func (m *Manager) getInServiceInstances(ctx context.Context, asgNames string) ([]asgtypes.Instance, error) {
+	var inService []asgtypes.Instance
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(m.ctl.Provider.ASG(), &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: strings.Split(asgNames, ","),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing Auto Scaling groups: %w", err)
+		}
+		for _, asg := range output.AutoScalingGroups {
+			for _, instance := range asg.Instances {
+				if instance.LifecycleState == asgtypes.LifecycleStateInService {
+					inService = append(inService, instance)
+				}
+			}
+		}
+	}
+	return inService, nil
+}
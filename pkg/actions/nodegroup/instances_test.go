@@ -0,0 +1,139 @@
+package nodegroup_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("AddInstancesSummaries", func() {
+	var (
+		p *mockprovider.MockProvider
+		m *nodegroup.Manager
+	)
+
+	BeforeEach(func() {
+		cfg := configbuilder.New("my-cluster", "").Build()
+		p = mockprovider.NewMockProvider()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, fake.NewSimpleClientset())
+	})
+
+	instance := func(id, instanceType, zone string, state asgtypes.LifecycleState) asgtypes.Instance {
+		return asgtypes.Instance{
+			InstanceId:       aws.String(id),
+			InstanceType:     aws.String(instanceType),
+			AvailabilityZone: aws.String(zone),
+			LifecycleState:   state,
+		}
+	}
+
+	It("summarises the instances in service in the Auto Scaling groups of the nodegroups", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{"asg-1", "asg-2"},
+		}, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{
+				{
+					Instances: []asgtypes.Instance{
+						instance("i-1", "m5.large", "us-west-2a", asgtypes.LifecycleStateInService),
+						instance("i-2", "m5a.large", "us-west-2b", asgtypes.LifecycleStateInService),
+					},
+				},
+				{
+					Instances: []asgtypes.Instance{
+						instance("i-3", "m5.large", "us-west-2a", asgtypes.LifecycleStateInService),
+						instance("i-4", "c5.large", "us-west-2c", asgtypes.LifecycleStatePending),
+					},
+				},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeInstances", mock.Anything, &ec2.DescribeInstancesInput{
+			InstanceIds: []string{"i-1", "i-2", "i-3"},
+		}, mock.Anything).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []ec2types.Reservation{
+				{
+					Instances: []ec2types.Instance{
+						{InstanceId: aws.String("i-1"), InstanceLifecycle: ec2types.InstanceLifecycleTypeSpot},
+						{InstanceId: aws.String("i-2"), InstanceLifecycle: ec2types.InstanceLifecycleTypeSpot},
+					},
+				},
+				{
+					Instances: []ec2types.Instance{
+						{InstanceId: aws.String("i-3")},
+					},
+				},
+			},
+		}, nil)
+
+		summaries := []*nodegroup.Summary{
+			{
+				Name:                 "ng-1",
+				AutoScalingGroupName: "asg-1,asg-2",
+			},
+		}
+		Expect(m.AddInstancesSummaries(context.Background(), summaries)).To(Succeed())
+		Expect(summaries[0].Instances).To(Equal(&nodegroup.InstancesSummary{
+			Spot:          2,
+			OnDemand:      1,
+			InstanceTypes: []string{"m5.large", "m5a.large"},
+			AvailabilityZones: map[string]int{
+				"us-west-2a": 2,
+				"us-west-2b": 1,
+			},
+		}))
+	})
+
+	It("returns an empty summary when a nodegroup has no instances in service", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{
+				{
+					Instances: []asgtypes.Instance{
+						instance("i-1", "m5.large", "us-west-2a", asgtypes.LifecycleStateTerminating),
+					},
+				},
+			},
+		}, nil)
+
+		summaries := []*nodegroup.Summary{
+			{
+				Name:                 "ng-1",
+				AutoScalingGroupName: "asg-1",
+			},
+			{
+				Name: "ng-2",
+			},
+		}
+		Expect(m.AddInstancesSummaries(context.Background(), summaries)).To(Succeed())
+		for _, s := range summaries {
+			Expect(s.Instances).To(Equal(&nodegroup.InstancesSummary{
+				AvailabilityZones: map[string]int{},
+			}))
+		}
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeInstances", mock.Anything, mock.Anything)
+	})
+
+	It("returns an error when the Auto Scaling groups cannot be described", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("access denied"))
+
+		err := m.AddInstancesSummaries(context.Background(), []*nodegroup.Summary{
+			{
+				Name:                 "ng-1",
+				AutoScalingGroupName: "asg-1",
+			},
+		})
+		Expect(err).To(MatchError(`getting instances of nodegroup "ng-1": describing Auto Scaling groups: access denied`))
+	})
+})
@@ -0,0 +1,298 @@
+package nodegroup
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/utils/waiters"
+)
+
+// maxUserDataDiffLines bounds the size of the user data that is diffed line by line
+const maxUserDataDiffLines = 1000
+
+// LaunchTemplateVersion is a version of the launch template of a managed nodegroup
+type LaunchTemplateVersion struct {
+	Version     int64
+	Description string `json:",omitempty"`
+	CreateTime  time.Time
+	ImageID     string `json:",omitempty"`
+	Default     bool
+	// Current is whether the nodegroup uses this version
+	Current bool
+}
+
+// ListLaunchTemplateVersions lists the versions of the launch template of a managed nodegroup, newest first
+func (m *Manager) ListLaunchTemplateVersions(ctx context.Context, nodegroupName string) ([]LaunchTemplateVersion, error) {
+	output, err := m.ctl.Provider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(m.cfg.Metadata.Name),
+		NodegroupName: aws.String(nodegroupName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	lt := output.Nodegroup.LaunchTemplate
+	if lt == nil || (lt.Id == nil && lt.Name == nil) {
+		return nil, fmt.Errorf("nodegroup %q is not configured to use a launch template", nodegroupName)
+	}
+
+	var versions []LaunchTemplateVersion
+	paginator := ec2.NewDescribeLaunchTemplateVersionsPaginator(m.ctl.Provider.EC2(), launchTemplateVersionsInput(lt))
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "describing launch template versions")
+		}
+		for _, v := range page.LaunchTemplateVersions {
+			version := LaunchTemplateVersion{
+				Version:     aws.ToInt64(v.VersionNumber),
+				Description: aws.ToString(v.VersionDescription),
+				CreateTime:  aws.ToTime(v.CreateTime),
+				Default:     aws.ToBool(v.DefaultVersion),
+				Current:     strconv.FormatInt(aws.ToInt64(v.VersionNumber), 10) == aws.ToString(lt.Version),
+			}
+			if v.LaunchTemplateData != nil {
+				version.ImageID = aws.ToString(v.LaunchTemplateData.ImageId)
+			}
+			versions = append(versions, version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version > versions[j].Version
+	})
+	return versions, nil
+}
+
+// resolveLaunchTemplateVersion checks that the launch template version the nodegroup is upgraded to exists,
+// logs how it differs from the current version and returns its number, as $Latest and $Default aren't
+// accepted by EKS
+func (m *Manager) resolveLaunchTemplateVersion(ctx context.Context, version string, nodegroup *ekstypes.Nodegroup) (string, error) {
+	lt := nodegroup.LaunchTemplate
+	if lt == nil || (lt.Id == nil && lt.Name == nil) {
+		return "", errors.New("cannot update launch template version because the nodegroup is not configured to use one")
+	}
+
+	target, err := m.describeLaunchTemplateVersion(ctx, lt, version)
+	if err != nil {
+		return "", err
+	}
+	targetVersion := strconv.FormatInt(aws.ToInt64(target.VersionNumber), 10)
+	currentVersion := aws.ToString(lt.Version)
+	if targetVersion == currentVersion {
+		logger.Info("nodegroup %q already uses launch template version %s", *nodegroup.NodegroupName, currentVersion)
+		return targetVersion, nil
+	}
+
+	current, err := m.describeLaunchTemplateVersion(ctx, lt, currentVersion)
+	if err != nil {
+		return "", err
+	}
+	if diff := DiffLaunchTemplateVersions(current, target); diff != "" {
+		logger.Info("changes from launch template version %s to %s:\n%s", currentVersion, targetVersion, diff)
+	} else {
+		logger.Info("launch template versions %s and %s use the same AMI, instance type and user data", currentVersion, targetVersion)
+	}
+	return targetVersion, nil
+}
+
+func (m *Manager) describeLaunchTemplateVersion(ctx context.Context, lt *ekstypes.LaunchTemplateSpecification, version string) (*ec2types.LaunchTemplateVersion, error) {
+	output, err := m.ctl.Provider.EC2().DescribeLaunchTemplateVersions(ctx, launchTemplateVersionsInput(lt, version))
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing launch template version %q", version)
+	}
+	if len(output.LaunchTemplateVersions) != 1 {
+		return nil, fmt.Errorf("launch template version %q not found", version)
+	}
+	return &output.LaunchTemplateVersions[0], nil
+}
+
+func launchTemplateVersionsInput(lt *ekstypes.LaunchTemplateSpecification, versions ...string) *ec2.DescribeLaunchTemplateVersionsInput {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		Versions: versions,
+	}
+	if lt.Id != nil {
+		input.LaunchTemplateId = lt.Id
+	} else {
+		input.LaunchTemplateName = lt.Name
+	}
+	return input
+}
+
+// DiffLaunchTemplateVersions describes the changes to the AMI, instance type and user data between two
+// launch template versions, it's empty if there are none
+func DiffLaunchTemplateVersions(from, to *ec2types.LaunchTemplateVersion) string {
+	fromData, toData := from.LaunchTemplateData, to.LaunchTemplateData
+	if fromData == nil {
+		fromData = &ec2types.ResponseLaunchTemplateData{}
+	}
+	if toData == nil {
+		toData = &ec2types.ResponseLaunchTemplateData{}
+	}
+
+	var b strings.Builder
+	if from, to := aws.ToString(fromData.ImageId), aws.ToString(toData.ImageId); from != to {
+		fmt.Fprintf(&b, "image ID: %s -> %s\n", valueOrNone(from), valueOrNone(to))
+	}
+	if from, to := string(fromData.InstanceType), string(toData.InstanceType); from != to {
+		fmt.Fprintf(&b, "instance type: %s -> %s\n", valueOrNone(from), valueOrNone(to))
+	}
+	if from, to := decodeUserData(fromData.UserData), decodeUserData(toData.UserData); from != to {
+		b.WriteString("user data:\n")
+		b.WriteString(diffLines(from, to))
+	}
+	return b.String()
+}
+
+func valueOrNone(v string) string {
+	if v == "" {
+		return "<none>"
+	}
+	return v
+}
+
+func decodeUserData(userData *string) string {
+	data, err := base64.StdEncoding.DecodeString(aws.ToString(userData))
+	if err != nil {
+		// not base64-encoded
+		return aws.ToString(userData)
+	}
+	return string(data)
+}
+
+// diffLines returns the lines of from and to prefixed with "-" when removed, "+" when added and " " when unchanged
+func diffLines(from, to string) string {
+	a, b := splitLines(from), splitLines(to)
+	var out strings.Builder
+	if len(a) > maxUserDataDiffLines || len(b) > maxUserDataDiffLines {
+		for _, line := range a {
+			fmt.Fprintf(&out, "-%s\n", line)
+		}
+		for _, line := range b {
+			fmt.Fprintf(&out, "+%s\n", line)
+		}
+		return out.String()
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			fmt.Fprintf(&out, " %s\n", a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			fmt.Fprintf(&out, "-%s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&out, "+%s\n", b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		fmt.Fprintf(&out, "-%s\n", a[i])
+	}
+	for ; j < len(b); j++ {
+		fmt.Fprintf(&out, "+%s\n", b[j])
+	}
+	return out.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// rollbackLaunchTemplateVersion reverts a nodegroup whose upgrade failed to the launch template version it used
+// before the upgrade
+func (m *Manager) rollbackLaunchTemplateVersion(ctx context.Context, options UpgradeOptions, nodegroup *ekstypes.Nodegroup, upgradeErr error) error {
+	lt := nodegroup.LaunchTemplate
+	previousVersion := aws.ToString(lt.Version)
+	logger.Warning("upgrade of nodegroup %q failed, rolling back to launch template version %s", options.NodegroupName, previousVersion)
+
+	input := &eks.UpdateNodegroupVersionInput{
+		ClusterName:   aws.String(m.cfg.Metadata.Name),
+		NodegroupName: aws.String(options.NodegroupName),
+		Force:         options.ForceUpgrade,
+		LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+			Version: aws.String(previousVersion),
+		},
+	}
+	if lt.Id != nil {
+		input.LaunchTemplate.Id = lt.Id
+	} else {
+		input.LaunchTemplate.Name = lt.Name
+	}
+	output, err := m.ctl.Provider.EKS().UpdateNodegroupVersion(ctx, input)
+	if err == nil {
+		err = m.waitForNodegroupUpdate(ctx, options.NodegroupName, output.Update)
+	}
+	if err != nil {
+		return fmt.Errorf("%v; rolling back to launch template version %s also failed: %w", upgradeErr, previousVersion, err)
+	}
+	return errors.Wrapf(upgradeErr, "nodegroup %q was rolled back to launch template version %s", options.NodegroupName, previousVersion)
+}
+
+// waitForNodegroupUpdate waits for an update of a managed nodegroup to succeed
+func (m *Manager) waitForNodegroupUpdate(ctx context.Context, nodegroupName string, update *ekstypes.Update) error {
+	if update == nil {
+		return nil
+	}
+	input := &eks.DescribeUpdateInput{
+		Name:          aws.String(m.cfg.Metadata.Name),
+		NodegroupName: aws.String(nodegroupName),
+		UpdateId:      update.Id,
+	}
+	msg := fmt.Sprintf("waiting for update of nodegroup %q to succeed", nodegroupName)
+	return waiters.Wait(ctx, msg, func(ctx context.Context) (bool, error) {
+		output, err := m.ctl.Provider.EKS().DescribeUpdate(ctx, input)
+		if err != nil {
+			return false, err
+		}
+		switch status := output.Update.Status; status {
+		case ekstypes.UpdateStatusSuccessful:
+			return true, nil
+		case ekstypes.UpdateStatusCancelled, ekstypes.UpdateStatusFailed:
+			var reasons []string
+			for _, e := range output.Update.Errors {
+				reasons = append(reasons, aws.ToString(e.ErrorMessage))
+			}
+			if len(reasons) > 0 {
+				return false, fmt.Errorf("update finished with status %q: %s", status, strings.Join(reasons, "; "))
+			}
+			return false, fmt.Errorf("update finished with status %q", status)
+		default:
+			return false, nil
+		}
+	}, m.ctl.Provider.WaitTimeout())
+}
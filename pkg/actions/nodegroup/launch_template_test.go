@@ -0,0 +1,147 @@
+package nodegroup_test
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Launch template versions", func() {
+	var (
+		p *mockprovider.MockProvider
+		m *nodegroup.Manager
+	)
+
+	BeforeEach(func() {
+		cfg := configbuilder.New("my-cluster", "").Build()
+		p = mockprovider.NewMockProvider()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, fake.NewSimpleClientset())
+		m.SetStackManager(new(fakes.FakeStackManager))
+
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String("my-cluster"),
+			NodegroupName: aws.String("ng"),
+		}).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &ekstypes.Nodegroup{
+				NodegroupName: aws.String("ng"),
+				ClusterName:   aws.String("my-cluster"),
+				Version:       aws.String("1.21"),
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+					Id:      aws.String("lt-123"),
+					Version: aws.String("1"),
+				},
+			},
+		}, nil)
+	})
+
+	It("lists the versions of the launch template, newest first", func() {
+		createTime := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+		p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String("lt-123"),
+		}, mock.Anything).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+				{
+					VersionNumber:  aws.Int64(1),
+					DefaultVersion: aws.Bool(true),
+					CreateTime:     aws.Time(createTime),
+					LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{
+						ImageId: aws.String("ami-1"),
+					},
+				},
+				{
+					VersionNumber:      aws.Int64(2),
+					VersionDescription: aws.String("new AMI"),
+					DefaultVersion:     aws.Bool(false),
+					CreateTime:         aws.Time(createTime),
+					LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{
+						ImageId: aws.String("ami-2"),
+					},
+				},
+			},
+		}, nil)
+
+		versions, err := m.ListLaunchTemplateVersions(context.Background(), "ng")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(versions).To(Equal([]nodegroup.LaunchTemplateVersion{
+			{Version: 2, Description: "new AMI", CreateTime: createTime, ImageID: "ami-2"},
+			{Version: 1, CreateTime: createTime, ImageID: "ami-1", Default: true, Current: true},
+		}))
+	})
+
+	It("describes the changes between two versions", func() {
+		userData := func(s string) *string {
+			return aws.String(base64.StdEncoding.EncodeToString([]byte(s)))
+		}
+		diff := nodegroup.DiffLaunchTemplateVersions(&ec2types.LaunchTemplateVersion{
+			LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{
+				ImageId:  aws.String("ami-1"),
+				UserData: userData("#!/bin/bash\nset -e\n/etc/eks/bootstrap.sh my-cluster\n"),
+			},
+		}, &ec2types.LaunchTemplateVersion{
+			LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{
+				ImageId:      aws.String("ami-2"),
+				InstanceType: ec2types.InstanceTypeM5Large,
+				UserData:     userData("#!/bin/bash\nset -ex\n/etc/eks/bootstrap.sh my-cluster\n"),
+			},
+		})
+		Expect(diff).To(Equal(`image ID: ami-1 -> ami-2
+instance type: <none> -> m5.large
+user data:
+ #!/bin/bash
+-set -e
++set -ex
+ /etc/eks/bootstrap.sh my-cluster
+`))
+	})
+
+	It("rolls back to the previous launch template version when the upgrade fails", func() {
+		mockLaunchTemplateVersions(p)
+		for version, updateID := range map[string]string{"2": "upgrade", "1": "rollback"} {
+			version := version
+			p.MockEKS().On("UpdateNodegroupVersion", mock.Anything, mock.MatchedBy(func(input *awseks.UpdateNodegroupVersionInput) bool {
+				return *input.LaunchTemplate.Version == version
+			})).Return(&awseks.UpdateNodegroupVersionOutput{
+				Update: &ekstypes.Update{Id: aws.String(updateID)},
+			}, nil)
+		}
+		p.MockEKS().On("DescribeUpdate", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
+			return *input.UpdateId == "upgrade"
+		})).Return(&awseks.DescribeUpdateOutput{
+			Update: &ekstypes.Update{
+				Status: ekstypes.UpdateStatusFailed,
+				Errors: []ekstypes.ErrorDetail{{ErrorMessage: aws.String("instances failed to join the cluster")}},
+			},
+		}, nil)
+		p.MockEKS().On("DescribeUpdate", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
+			return *input.UpdateId == "rollback"
+		})).Return(&awseks.DescribeUpdateOutput{
+			Update: &ekstypes.Update{Status: ekstypes.UpdateStatusSuccessful},
+		}, nil)
+
+		err := m.Upgrade(context.Background(), nodegroup.UpgradeOptions{
+			NodegroupName:         "ng",
+			LaunchTemplateVersion: "$Latest",
+			Wait:                  true,
+			RollbackOnFailure:     true,
+		})
+		Expect(err).To(MatchError(ContainSubstring(`nodegroup "ng" was rolled back to launch template version 1`)))
+		Expect(err).To(MatchError(ContainSubstring("instances failed to join the cluster")))
+		Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "UpdateNodegroupVersion", 2)).To(BeTrue())
+	})
+})
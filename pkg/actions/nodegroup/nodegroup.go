@@ -1,9 +1,11 @@
 package nodegroup
 
 import (
-	"time"
+	"context"
+	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws/request"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"k8s.io/client-go/kubernetes"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -18,14 +20,11 @@ type Manager struct {
 	ctl                   *eks.ClusterProvider
 	cfg                   *api.ClusterConfig
 	clientSet             kubernetes.Interface
-	wait                  WaitFunc
 	init                  eks.NodeGroupInitialiser
 	kubeProvider          eks.KubeProvider
 	launchTemplateFetcher *builder.LaunchTemplateFetcher
 }
 
-type WaitFunc func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error
-
 // New creates a new manager.
 func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) *Manager {
 	return &Manager{
@@ -33,7 +32,6 @@ func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.
 		ctl:          ctl,
 		cfg:          cfg,
 		clientSet:    clientSet,
-		wait:         waiters.Wait,
 		init: &eks.NodeGroupService{
 			Provider: ctl.Provider,
 		},
@@ -50,3 +48,24 @@ func (m *Manager) hasStacks(stacks []manager.NodeGroupStack, name string) *manag
 	}
 	return nil
 }
+
+// waitForActiveNodegroup waits for the managed nodegroup to become active, a degraded nodegroup is reported as an error
+func (m *Manager) waitForActiveNodegroup(ctx context.Context, nodegroupName, msg string) error {
+	return waiters.Wait(ctx, msg, func(ctx context.Context) (bool, error) {
+		output, err := m.ctl.Provider.EKS().DescribeNodegroup(ctx, &awseks.DescribeNodegroupInput{
+			ClusterName:   &m.cfg.Metadata.Name,
+			NodegroupName: &nodegroupName,
+		})
+		if err != nil {
+			return false, err
+		}
+		switch output.Nodegroup.Status {
+		case ekstypes.NodegroupStatusActive:
+			return true, nil
+		case ekstypes.NodegroupStatusDegraded:
+			return false, fmt.Errorf("nodegroup %q is in status %q", nodegroupName, output.Nodegroup.Status)
+		default:
+			return false, nil
+		}
+	}, m.ctl.Provider.WaitTimeout())
+}
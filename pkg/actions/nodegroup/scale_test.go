@@ -3,15 +3,14 @@ package nodegroup_test
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/request"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
@@ -19,6 +18,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
 
@@ -35,8 +35,7 @@ var _ = Describe("Scale", func() {
 		clusterName = "my-cluster"
 		ngName = "my-ng"
 		p = mockprovider.NewMockProvider()
-		cfg = api.NewClusterConfig()
-		cfg.Metadata.Name = clusterName
+		cfg = configbuilder.New(clusterName, "").Build()
 
 		ng = &api.NodeGroupBase{
 			Name: ngName,
@@ -72,37 +71,35 @@ var _ = Describe("Scale", func() {
 		})
 
 		It("scales the nodegroup using the values provided", func() {
-			p.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
-				ScalingConfig: &awseks.NodegroupScalingConfig{
-					MinSize:     aws.Int64(1),
-					DesiredSize: aws.Int64(3),
+			p.MockEKS().On("UpdateNodegroupConfig", mock.Anything, &awseks.UpdateNodegroupConfigInput{
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{
+					MinSize:     aws.Int32(1),
+					DesiredSize: aws.Int32(3),
 				},
 				ClusterName:   &clusterName,
 				NodegroupName: &ngName,
 			}).Return(nil, nil)
 
-			p.MockEKS().On("DescribeNodegroupRequest", &awseks.DescribeNodegroupInput{
+			p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 				ClusterName:   &clusterName,
 				NodegroupName: &ngName,
-			}).Return(&request.Request{}, nil)
-
-			waitCallCount := 0
-			m.SetWaiter(func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error {
-				waitCallCount++
-				return nil
-			})
+			}).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &ekstypes.Nodegroup{
+					Status: ekstypes.NodegroupStatusActive,
+				},
+			}, nil)
 
 			err := m.Scale(context.Background(), ng)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(waitCallCount).To(Equal(1))
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeNodegroup", 1)
 		})
 
 		When("update fails", func() {
 			It("returns an error", func() {
-				p.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
-					ScalingConfig: &awseks.NodegroupScalingConfig{
-						MinSize:     aws.Int64(1),
-						DesiredSize: aws.Int64(3),
+				p.MockEKS().On("UpdateNodegroupConfig", mock.Anything, &awseks.UpdateNodegroupConfigInput{
+					ScalingConfig: &ekstypes.NodegroupScalingConfig{
+						MinSize:     aws.Int32(1),
+						DesiredSize: aws.Int32(3),
 					},
 					ClusterName:   &clusterName,
 					NodegroupName: &ngName,
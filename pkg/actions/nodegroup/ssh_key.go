@@ -0,0 +1,95 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/ssh"
+	sshclient "github.com/weaveworks/eksctl/pkg/ssh/client"
+)
+
+const (
+	managedLaunchTemplateResourceName   = "LaunchTemplate"
+	unmanagedLaunchTemplateResourceName = "NodeGroupLaunchTemplate"
+)
+
+// RotateSSHKey replaces the EC2 key pair of a nodegroup with the key in sshConfig. The key pair is changed in
+// the launch template created by eksctl, which replaces the nodes of the nodegroup
+func (m *Manager) RotateSSHKey(ctx context.Context, nodeGroupName string, sshConfig *api.NodeGroupSSH, plan, wait bool) error {
+	stack, err := m.stackManager.DescribeNodeGroupStack(ctx, nodeGroupName)
+	if err != nil {
+		return errors.Wrapf(err, "finding stack of nodegroup %q", nodeGroupName)
+	}
+	template, err := m.stackManager.GetStackTemplate(ctx, *stack.StackName)
+	if err != nil {
+		return errors.Wrapf(err, "fetching template of nodegroup %q", nodeGroupName)
+	}
+
+	launchTemplateName := unmanagedLaunchTemplateResourceName
+	managed := gjson.Get(template, resourcePath(builder.ManagedNodeGroupResourceName)).Exists()
+	if managed {
+		launchTemplateName = managedLaunchTemplateResourceName
+	}
+	if !gjson.Get(template, resourcePath(launchTemplateName)).Exists() {
+		return fmt.Errorf("nodegroup %q uses a launch template that wasn't created by eksctl, create a version of it with the new key pair and run `eksctl upgrade nodegroup --launch-template-version` instead", nodeGroupName)
+	}
+
+	keyName, err := ssh.LoadKey(ctx, sshConfig, m.cfg.Metadata.Name, nodeGroupName, m.ctl.Provider.EC2(), sshclient.KeyStores{
+		SSM:            m.ctl.Provider.SSM(),
+		SecretsManager: m.ctl.Provider.SecretsManager(),
+	})
+	if err != nil {
+		return err
+	}
+	if keyName == "" {
+		return errors.New("an SSH public key must be specified")
+	}
+
+	keyNamePath := resourcePath(launchTemplateName) + ".Properties.LaunchTemplateData.KeyName"
+	currentKeyName := gjson.Get(template, keyNamePath).String()
+	if currentKeyName == keyName {
+		logger.Info("nodegroup %q already uses key pair %q", nodeGroupName, keyName)
+		return nil
+	}
+	if currentKeyName == "" {
+		logger.Warning("nodegroup %q doesn't use a key pair, its security group may not allow SSH access", nodeGroupName)
+	}
+
+	if template, err = sjson.Set(template, keyNamePath, keyName); err != nil {
+		return errors.Wrap(err, "setting the key pair of the launch template")
+	}
+	if managed {
+		// the nodegroup uses the default version of its launch template unless the version is set, which makes
+		// EKS replace the nodes with the new version
+		versionPath := resourcePath(builder.ManagedNodeGroupResourceName) + ".Properties.LaunchTemplate.Version"
+		if template, err = sjson.Set(template, versionPath, gfnt.MakeFnGetAttString(launchTemplateName, "LatestVersionNumber")); err != nil {
+			return errors.Wrap(err, "setting the launch template version of the nodegroup")
+		}
+	}
+
+	if plan {
+		logger.Info("(plan) would replace key pair %q of nodegroup %q with %q and replace its nodes", currentKeyName, nodeGroupName, keyName)
+		return nil
+	}
+
+	logger.Info("replacing key pair %q of nodegroup %q with %q, its nodes will be replaced", currentKeyName, nodeGroupName, keyName)
+	if err := m.stackManager.UpdateNodeGroupStack(ctx, nodeGroupName, template, wait); err != nil {
+		return errors.Wrap(err, "error updating nodegroup stack")
+	}
+	if wait {
+		logger.Info("nodes of nodegroup %q use key pair %q", nodeGroupName, keyName)
+	}
+	return nil
+}
+
+func resourcePath(name string) string {
+	return "Resources." + name
+}
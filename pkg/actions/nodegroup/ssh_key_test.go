@@ -0,0 +1,107 @@
+package nodegroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("RotateSSHKey", func() {
+	const (
+		managedTemplate = `{
+  "Resources": {
+    "LaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {"LaunchTemplateData": {"KeyName": "old-key"}}},
+    "ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "Properties": {"LaunchTemplate": {"Id": {"Ref": "LaunchTemplate"}}}}
+  }
+}`
+		unmanagedTemplate = `{
+  "Resources": {
+    "NodeGroupLaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {"LaunchTemplateData": {"KeyName": "old-key"}}},
+    "NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup", "Properties": {}}
+  }
+}`
+	)
+
+	var (
+		p                *mockprovider.MockProvider
+		fakeStackManager *fakes.FakeStackManager
+		m                *Manager
+		sshConfig        *api.NodeGroupSSH
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := configbuilder.New("my-cluster", "").Build()
+		fakeStackManager = new(fakes.FakeStackManager)
+		m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+		m.SetStackManager(fakeStackManager)
+
+		fakeStackManager.DescribeNodeGroupStackReturns(&manager.Stack{StackName: aws.String("eksctl-my-cluster-nodegroup-ng-1")}, nil)
+		p.MockEC2().On("DescribeKeyPairs", mock.Anything, &ec2.DescribeKeyPairsInput{KeyNames: []string{"new-key"}}).
+			Return(&ec2.DescribeKeyPairsOutput{KeyPairs: []ec2types.KeyPairInfo{{KeyName: aws.String("new-key")}}}, nil)
+		sshConfig = &api.NodeGroupSSH{
+			Allow:         api.Enabled(),
+			PublicKeyName: aws.String("new-key"),
+		}
+	})
+
+	It("replaces the key pair of a managed nodegroup and its launch template version", func() {
+		fakeStackManager.GetStackTemplateReturns(managedTemplate, nil)
+
+		Expect(m.RotateSSHKey(context.Background(), "ng-1", sshConfig, false, true)).To(Succeed())
+		Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(Equal(1))
+		_, name, template, wait := fakeStackManager.UpdateNodeGroupStackArgsForCall(0)
+		Expect(name).To(Equal("ng-1"))
+		Expect(wait).To(BeTrue())
+		Expect(gjson.Get(template, "Resources.LaunchTemplate.Properties.LaunchTemplateData.KeyName").String()).To(Equal("new-key"))
+		Expect(gjson.Get(template, "Resources.ManagedNodeGroup.Properties.LaunchTemplate.Version").Raw).
+			To(MatchJSON(`{"Fn::GetAtt": ["LaunchTemplate", "LatestVersionNumber"]}`))
+	})
+
+	It("replaces the key pair of an unmanaged nodegroup", func() {
+		fakeStackManager.GetStackTemplateReturns(unmanagedTemplate, nil)
+
+		Expect(m.RotateSSHKey(context.Background(), "ng-1", sshConfig, false, false)).To(Succeed())
+		Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(Equal(1))
+		_, _, template, _ := fakeStackManager.UpdateNodeGroupStackArgsForCall(0)
+		Expect(gjson.Get(template, "Resources.NodeGroupLaunchTemplate.Properties.LaunchTemplateData.KeyName").String()).To(Equal("new-key"))
+	})
+
+	It("doesn't update the stack when the nodegroup already uses the key pair", func() {
+		fakeStackManager.GetStackTemplateReturns(managedTemplate, nil)
+		sshConfig.PublicKeyName = aws.String("old-key")
+		p.MockEC2().On("DescribeKeyPairs", mock.Anything, &ec2.DescribeKeyPairsInput{KeyNames: []string{"old-key"}}).
+			Return(&ec2.DescribeKeyPairsOutput{KeyPairs: []ec2types.KeyPairInfo{{KeyName: aws.String("old-key")}}}, nil)
+
+		Expect(m.RotateSSHKey(context.Background(), "ng-1", sshConfig, false, true)).To(Succeed())
+		Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(Equal(0))
+	})
+
+	It("doesn't update the stack in plan mode", func() {
+		fakeStackManager.GetStackTemplateReturns(managedTemplate, nil)
+
+		Expect(m.RotateSSHKey(context.Background(), "ng-1", sshConfig, true, true)).To(Succeed())
+		Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(Equal(0))
+	})
+
+	It("fails for nodegroups using a launch template not created by eksctl", func() {
+		fakeStackManager.GetStackTemplateReturns(`{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup"}}}`, nil)
+
+		err := m.RotateSSHKey(context.Background(), "ng-1", sshConfig, false, true)
+		Expect(err).To(MatchError(ContainSubstring("uses a launch template that wasn't created by eksctl")))
+		Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(Equal(0))
+	})
+})
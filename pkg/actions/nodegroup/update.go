@@ -1,29 +1,31 @@
 package nodegroup
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/managed"
 )
 
-func (m *Manager) Update() error {
+func (m *Manager) Update(ctx context.Context) error {
 	for _, ng := range m.cfg.ManagedNodeGroups {
-		if err := m.updateNodegroup(ng); err != nil {
+		if err := m.updateNodegroup(ctx, ng); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (m *Manager) updateNodegroup(ng *api.ManagedNodeGroup) error {
+func (m *Manager) updateNodegroup(ctx context.Context, ng *api.ManagedNodeGroup) error {
 	logger.Info("checking that nodegroup %s is a managed nodegroup", ng.Name)
 
-	_, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+	_, err := m.ctl.Provider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
 		ClusterName:   &m.cfg.Metadata.Name,
 		NodegroupName: &ng.Name,
 	})
@@ -44,7 +46,7 @@ func (m *Manager) updateNodegroup(ng *api.ManagedNodeGroup) error {
 		return err
 	}
 
-	_, err = m.ctl.Provider.EKS().UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+	_, err = m.ctl.Provider.EKS().UpdateNodegroupConfig(ctx, &eks.UpdateNodegroupConfigInput{
 		UpdateConfig:  updateConfig,
 		ClusterName:   &m.cfg.Metadata.Name,
 		NodegroupName: &ng.Name,
@@ -57,16 +59,77 @@ func (m *Manager) updateNodegroup(ng *api.ManagedNodeGroup) error {
 	return nil
 }
 
-func updateUpdateConfig(ng *api.ManagedNodeGroup) (*eks.NodegroupUpdateConfig, error) {
+// SetUpdateConfig changes how many nodes of a managed nodegroup can be unavailable while it's updated
+func (m *Manager) SetUpdateConfig(ctx context.Context, nodeGroupName string, updateConfig *api.NodeGroupUpdateConfig, plan, wait bool) error {
+	output, err := m.ctl.Provider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   &m.cfg.Metadata.Name,
+		NodegroupName: &nodeGroupName,
+	})
+	if err != nil {
+		if managed.IsNotFound(err) {
+			return fmt.Errorf("could not find managed nodegroup with name %q", nodeGroupName)
+		}
+		return err
+	}
+
+	current := formatUpdateConfig(output.Nodegroup.UpdateConfig)
+	desired := &ekstypes.NodegroupUpdateConfig{}
+	if updateConfig.MaxUnavailable != nil {
+		desired.MaxUnavailable = aws.Int32(int32(*updateConfig.MaxUnavailable))
+	}
+	if updateConfig.MaxUnavailablePercentage != nil {
+		desired.MaxUnavailablePercentage = aws.Int32(int32(*updateConfig.MaxUnavailablePercentage))
+	}
+	if formatUpdateConfig(desired) == current {
+		logger.Info("nodegroup %q already uses update config %s", nodeGroupName, current)
+		return nil
+	}
+	if plan {
+		logger.Info("(plan) would change the update config of nodegroup %q from %s to %s", nodeGroupName, current, formatUpdateConfig(desired))
+		return nil
+	}
+
+	logger.Info("changing the update config of nodegroup %q from %s to %s", nodeGroupName, current, formatUpdateConfig(desired))
+	update, err := m.ctl.Provider.EKS().UpdateNodegroupConfig(ctx, &eks.UpdateNodegroupConfigInput{
+		UpdateConfig:  desired,
+		ClusterName:   &m.cfg.Metadata.Name,
+		NodegroupName: &nodeGroupName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update nodegroup %s: %w", nodeGroupName, err)
+	}
+	if wait {
+		if err := m.waitForNodegroupUpdate(ctx, nodeGroupName, update.Update); err != nil {
+			return err
+		}
+	}
+	logger.Info("nodegroup %s successfully updated", nodeGroupName)
+	return nil
+}
+
+func formatUpdateConfig(updateConfig *ekstypes.NodegroupUpdateConfig) string {
+	switch {
+	case updateConfig == nil:
+		return "<none>"
+	case updateConfig.MaxUnavailablePercentage != nil:
+		return fmt.Sprintf("maxUnavailablePercentage=%d", *updateConfig.MaxUnavailablePercentage)
+	case updateConfig.MaxUnavailable != nil:
+		return fmt.Sprintf("maxUnavailable=%d", *updateConfig.MaxUnavailable)
+	default:
+		return "<none>"
+	}
+}
+
+func updateUpdateConfig(ng *api.ManagedNodeGroup) (*ekstypes.NodegroupUpdateConfig, error) {
 	logger.Info("updating nodegroup %s's UpdateConfig", ng.Name)
-	updateConfig := &eks.NodegroupUpdateConfig{}
+	updateConfig := &ekstypes.NodegroupUpdateConfig{}
 
 	if ng.UpdateConfig.MaxUnavailable != nil {
-		updateConfig.MaxUnavailable = aws.Int64(int64(*ng.UpdateConfig.MaxUnavailable))
+		updateConfig.MaxUnavailable = aws.Int32(int32(*ng.UpdateConfig.MaxUnavailable))
 	}
 
 	if ng.UpdateConfig.MaxUnavailablePercentage != nil {
-		updateConfig.MaxUnavailablePercentage = aws.Int64(int64(*ng.UpdateConfig.MaxUnavailablePercentage))
+		updateConfig.MaxUnavailablePercentage = aws.Int32(int32(*ng.UpdateConfig.MaxUnavailablePercentage))
 	}
 
 	return updateConfig, nil
@@ -1,16 +1,18 @@
 package nodegroup
 
 import (
-	"errors"
+	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
 
@@ -26,44 +28,36 @@ var _ = Describe("Update", func() {
 		clusterName = "my-cluster"
 		ngName = "my-ng"
 		p = mockprovider.NewMockProvider()
-		cfg = api.NewClusterConfig()
-		cfg.Metadata.Name = clusterName
-		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{
-			{
-				NodeGroupBase: &api.NodeGroupBase{
-					Name: ngName,
-				},
-			},
-		}
+		cfg = configbuilder.New(clusterName, "").WithManagedNodegroup(ngName).Build()
 	})
 
 	It("fails for unmanaged nodegroups", func() {
-		p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 			ClusterName:   &clusterName,
 			NodegroupName: &ngName,
-		}).Return(nil, awserr.New(awseks.ErrCodeResourceNotFoundException, "test-err", errors.New("err")))
+		}).Return(nil, &ekstypes.ResourceNotFoundException{Message: aws.String("test-err")})
 
 		m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
-		err := m.Update()
+		err := m.Update(context.Background())
 		Expect(err).To(HaveOccurred())
 		Expect(err).To(MatchError(ContainSubstring("could not find managed nodegroup with name \"my-ng\"")))
 	})
 
 	It("[happy path] successfully updates a nodegroup with updateConfig and maxUnavailable", func() {
-		p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 			ClusterName:   &clusterName,
 			NodegroupName: &ngName,
 		}).Return(&awseks.DescribeNodegroupOutput{
-			Nodegroup: &awseks.Nodegroup{
-				UpdateConfig: &awseks.NodegroupUpdateConfig{
-					MaxUnavailable: aws.Int64(4),
+			Nodegroup: &ekstypes.Nodegroup{
+				UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+					MaxUnavailable: aws.Int32(4),
 				},
 			},
 		}, nil)
 
-		p.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
-			UpdateConfig: &awseks.NodegroupUpdateConfig{
-				MaxUnavailable: aws.Int64(6),
+		p.MockEKS().On("UpdateNodegroupConfig", mock.Anything, &awseks.UpdateNodegroupConfigInput{
+			UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+				MaxUnavailable: aws.Int32(6),
 			},
 			ClusterName:   &clusterName,
 			NodegroupName: &ngName,
@@ -74,44 +68,44 @@ var _ = Describe("Update", func() {
 		}
 
 		m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
-		err := m.Update()
+		err := m.Update(context.Background())
 		Expect(err).NotTo(HaveOccurred())
 	})
 
 	It("[happy path] successfully updates multiple nodegroups with updateConfig and maxUnavailable", func() {
-		p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 			ClusterName:   &clusterName,
 			NodegroupName: &ngName,
 		}).Return(&awseks.DescribeNodegroupOutput{
-			Nodegroup: &awseks.Nodegroup{
-				UpdateConfig: &awseks.NodegroupUpdateConfig{
-					MaxUnavailable: aws.Int64(4),
+			Nodegroup: &ekstypes.Nodegroup{
+				UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+					MaxUnavailable: aws.Int32(4),
 				},
 			},
 		}, nil)
 
-		p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 			ClusterName:   &clusterName,
 			NodegroupName: aws.String("ng-2"),
 		}).Return(&awseks.DescribeNodegroupOutput{
-			Nodegroup: &awseks.Nodegroup{
-				UpdateConfig: &awseks.NodegroupUpdateConfig{
-					MaxUnavailable: aws.Int64(4),
+			Nodegroup: &ekstypes.Nodegroup{
+				UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+					MaxUnavailable: aws.Int32(4),
 				},
 			},
 		}, nil)
 
-		p.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
-			UpdateConfig: &awseks.NodegroupUpdateConfig{
-				MaxUnavailable: aws.Int64(6),
+		p.MockEKS().On("UpdateNodegroupConfig", mock.Anything, &awseks.UpdateNodegroupConfigInput{
+			UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+				MaxUnavailable: aws.Int32(6),
 			},
 			ClusterName:   &clusterName,
 			NodegroupName: &ngName,
 		}).Return(nil, nil)
 
-		p.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
-			UpdateConfig: &awseks.NodegroupUpdateConfig{
-				MaxUnavailable: aws.Int64(6),
+		p.MockEKS().On("UpdateNodegroupConfig", mock.Anything, &awseks.UpdateNodegroupConfigInput{
+			UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+				MaxUnavailable: aws.Int32(6),
 			},
 			ClusterName:   &clusterName,
 			NodegroupName: aws.String("ng-2"),
@@ -133,7 +127,66 @@ var _ = Describe("Update", func() {
 		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, newNg)
 
 		m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
-		err := m.Update()
+		err := m.Update(context.Background())
 		Expect(err).NotTo(HaveOccurred())
 	})
+
+	Describe("SetUpdateConfig", func() {
+		mockNodegroup := func(updateConfig *ekstypes.NodegroupUpdateConfig) {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &ekstypes.Nodegroup{UpdateConfig: updateConfig},
+			}, nil)
+		}
+
+		It("changes the update config and waits for the update", func() {
+			mockNodegroup(&ekstypes.NodegroupUpdateConfig{MaxUnavailable: aws.Int32(1)})
+			p.MockEKS().On("UpdateNodegroupConfig", mock.Anything, &awseks.UpdateNodegroupConfigInput{
+				UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+					MaxUnavailablePercentage: aws.Int32(33),
+				},
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Return(&awseks.UpdateNodegroupConfigOutput{Update: &ekstypes.Update{Id: aws.String("update-1")}}, nil)
+			p.MockEKS().On("DescribeUpdate", mock.Anything, &awseks.DescribeUpdateInput{
+				Name:          &clusterName,
+				NodegroupName: &ngName,
+				UpdateId:      aws.String("update-1"),
+			}).Return(&awseks.DescribeUpdateOutput{Update: &ekstypes.Update{Status: ekstypes.UpdateStatusSuccessful}}, nil)
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			err := m.SetUpdateConfig(context.Background(), ngName, &api.NodeGroupUpdateConfig{MaxUnavailablePercentage: aws.Int(33)}, false, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeUpdate", 1)).To(BeTrue())
+		})
+
+		It("does nothing when the nodegroup already uses the update config", func() {
+			mockNodegroup(&ekstypes.NodegroupUpdateConfig{MaxUnavailable: aws.Int32(2)})
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			err := m.SetUpdateConfig(context.Background(), ngName, &api.NodeGroupUpdateConfig{MaxUnavailable: aws.Int(2)}, false, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("does not change the update config in plan mode", func() {
+			mockNodegroup(&ekstypes.NodegroupUpdateConfig{MaxUnavailable: aws.Int32(1)})
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			err := m.SetUpdateConfig(context.Background(), ngName, &api.NodeGroupUpdateConfig{MaxUnavailable: aws.Int(2)}, true, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("fails for unmanaged nodegroups", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything, mock.Anything).
+				Return(nil, &ekstypes.ResourceNotFoundException{Message: aws.String("test-err")})
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			err := m.SetUpdateConfig(context.Background(), ngName, &api.NodeGroupUpdateConfig{MaxUnavailable: aws.Int(2)}, false, true)
+			Expect(err).To(MatchError(`could not find managed nodegroup with name "my-ng"`))
+		})
+	})
 })
@@ -8,9 +8,9 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/blang/semver"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
@@ -26,7 +26,6 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/managed"
-	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -45,6 +44,8 @@ type UpgradeOptions struct {
 	ReleaseVersion string
 	// Wait for the upgrade to finish
 	Wait bool
+	// RollbackOnFailure reverts the launch template version if the upgrade fails, valid only with Wait
+	RollbackOnFailure bool
 	// Stack to upgrade
 	Stack *manager.NodeGroupStack
 }
@@ -62,7 +63,11 @@ func (m *Manager) Upgrade(ctx context.Context, options UpgradeOptions) error {
 		}
 	}
 
-	nodegroupOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+	if err := m.validateUpgradeVersionSkew(options); err != nil {
+		return err
+	}
+
+	nodegroupOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
 		ClusterName:   &m.cfg.Metadata.Name,
 		NodegroupName: &options.NodegroupName,
 	})
@@ -74,33 +79,59 @@ func (m *Manager) Upgrade(ctx context.Context, options UpgradeOptions) error {
 		return err
 	}
 
+	if options.LaunchTemplateVersion != "" {
+		options.LaunchTemplateVersion, err = m.resolveLaunchTemplateVersion(ctx, options.LaunchTemplateVersion, nodegroupOutput.Nodegroup)
+		if err != nil {
+			return err
+		}
+	}
+
 	if hasStack != nil {
 		options.Stack = hasStack
 		return m.upgradeUsingStack(ctx, options, nodegroupOutput.Nodegroup)
 	}
 
-	return m.upgradeUsingAPI(options, nodegroupOutput.Nodegroup)
+	return m.upgradeUsingAPI(ctx, options, nodegroupOutput.Nodegroup)
+}
+
+// validateUpgradeVersionSkew checks that the version the nodegroup is upgraded to is within the supported
+// skew of the control plane
+func (m *Manager) validateUpgradeVersionSkew(options UpgradeOptions) error {
+	controlPlaneVersion := m.ctl.ControlPlaneVersion()
+	if controlPlaneVersion == "" {
+		return nil
+	}
+	if options.KubernetesVersion != "" {
+		if err := ValidateVersionSkew(options.KubernetesVersion, controlPlaneVersion); err != nil {
+			return errors.Wrap(err, "invalid Kubernetes version")
+		}
+	}
+	if options.ReleaseVersion != "" {
+		releaseVersion, err := ParseReleaseVersion(options.ReleaseVersion)
+		if err != nil {
+			return errors.Wrap(err, "invalid release version")
+		}
+		if err := ValidateVersionSkew(releaseVersion.Version.String(), controlPlaneVersion); err != nil {
+			return errors.Wrap(err, "invalid release version")
+		}
+	}
+	return nil
 }
 
-func (m *Manager) upgradeUsingAPI(options UpgradeOptions, nodegroup *eks.Nodegroup) error {
+func (m *Manager) upgradeUsingAPI(ctx context.Context, options UpgradeOptions, nodegroup *ekstypes.Nodegroup) error {
 	input := &eks.UpdateNodegroupVersionInput{
 		ClusterName:   &m.cfg.Metadata.Name,
-		Force:         &options.ForceUpgrade,
+		Force:         options.ForceUpgrade,
 		NodegroupName: &options.NodegroupName,
 		Version:       &options.KubernetesVersion,
 	}
 
 	if options.LaunchTemplateVersion != "" {
-		lt := nodegroup.LaunchTemplate
-		if lt == nil || (lt.Id == nil && lt.Name == nil) {
-			return errors.New("cannot update launch template version because the nodegroup is not configured to use one")
-		}
-
-		input.LaunchTemplate = &eks.LaunchTemplateSpecification{
+		input.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{
 			Version: &options.LaunchTemplateVersion,
 		}
 
-		if lt.Id != nil {
+		if nodegroup.LaunchTemplate.Id != nil {
 			input.LaunchTemplate.Id = nodegroup.LaunchTemplate.Id
 		} else {
 			input.LaunchTemplate.Name = nodegroup.LaunchTemplate.Name
@@ -117,48 +148,33 @@ func (m *Manager) upgradeUsingAPI(options UpgradeOptions, nodegroup *eks.Nodegro
 		input.Version = aws.String(fmt.Sprintf("%v.%v", version.Major, version.Minor))
 	}
 
-	upgradeResponse, err := m.ctl.Provider.EKS().UpdateNodegroupVersion(input)
+	upgradeResponse, err := m.ctl.Provider.EKS().UpdateNodegroupVersion(ctx, input)
 
 	if err != nil {
 		return err
 	}
 
 	if upgradeResponse != nil {
-		logger.Debug("upgrade response for %q: %s", options.NodegroupName, upgradeResponse.String())
+		logger.Debug("upgrade response for %q: %+v", options.NodegroupName, upgradeResponse.Update)
 	}
 
 	logger.Info("upgrade of nodegroup %q in progress", options.NodegroupName)
 
 	if options.Wait {
-		return m.waitForUpgrade(options)
+		if options.LaunchTemplateVersion != "" && options.LaunchTemplateVersion != aws.ToString(nodegroup.LaunchTemplate.Version) && options.RollbackOnFailure {
+			if err := m.waitForNodegroupUpdate(ctx, options.NodegroupName, upgradeResponse.Update); err != nil {
+				return m.rollbackLaunchTemplateVersion(ctx, options, nodegroup, err)
+			}
+		}
+		return m.waitForUpgrade(ctx, options)
 	}
 
 	return nil
 }
 
-func (m *Manager) waitForUpgrade(options UpgradeOptions) error {
-
-	newRequest := func() *request.Request {
-		input := &eks.DescribeNodegroupInput{
-			ClusterName:   &m.cfg.Metadata.Name,
-			NodegroupName: &options.NodegroupName,
-		}
-		req, _ := m.ctl.Provider.EKS().DescribeNodegroupRequest(input)
-		return req
-	}
-
+func (m *Manager) waitForUpgrade(ctx context.Context, options UpgradeOptions) error {
 	msg := fmt.Sprintf("waiting for upgrade of nodegroup %q to complete", options.NodegroupName)
-
-	acceptors := waiters.MakeAcceptors(
-		"Nodegroup.Status",
-		eks.NodegroupStatusActive,
-		[]string{
-			eks.NodegroupStatusDegraded,
-		},
-	)
-
-	err := m.wait(options.NodegroupName, msg, acceptors, newRequest, m.ctl.Provider.WaitTimeout(), nil)
-	if err != nil {
+	if err := m.waitForActiveNodegroup(ctx, options.NodegroupName, msg); err != nil {
 		return err
 	}
 	logger.Info("nodegroup successfully upgraded")
@@ -168,7 +184,7 @@ func (m *Manager) waitForUpgrade(options UpgradeOptions) error {
 // upgradeUsingStack upgrades nodegroup to the latest AMI release for the specified Kubernetes version, or
 // the current Kubernetes version if the version isn't specified
 // If options.LaunchTemplateVersion is set, it also upgrades the nodegroup to the specified launch template version
-func (m *Manager) upgradeUsingStack(ctx context.Context, options UpgradeOptions, nodegroup *eks.Nodegroup) error {
+func (m *Manager) upgradeUsingStack(ctx context.Context, options UpgradeOptions, nodegroup *ekstypes.Nodegroup) error {
 	if options.KubernetesVersion != "" && options.ReleaseVersion != "" {
 		return errors.New("only one of kubernetes-version or release-version can be specified")
 	}
@@ -227,7 +243,6 @@ func (m *Manager) upgradeUsingStack(ctx context.Context, options UpgradeOptions,
 	ltResources := stack.GetAllEC2LaunchTemplateResources()
 
 	if options.LaunchTemplateVersion != "" {
-		// TODO validate launch template version
 		if len(ltResources) == 1 {
 			return errors.New("launch-template-version is only valid if a nodegroup is using an explicit launch template")
 		}
@@ -285,7 +300,7 @@ func (m *Manager) upgradeUsingStack(ctx context.Context, options UpgradeOptions,
 	return nil
 }
 
-func (m *Manager) updateReleaseVersion(latestReleaseVersion, launchTemplateVersion string, nodegroup *eks.Nodegroup, ngResource *gfneks.Nodegroup) error {
+func (m *Manager) updateReleaseVersion(latestReleaseVersion, launchTemplateVersion string, nodegroup *ekstypes.Nodegroup, ngResource *gfneks.Nodegroup) error {
 	latest, err := ParseReleaseVersion(latestReleaseVersion)
 	if err != nil {
 		return err
@@ -326,8 +341,8 @@ func (m *Manager) requiresStackUpdate(ctx context.Context, nodeGroupName string)
 	return !ver.EQ(curVer), nil
 }
 
-func (m *Manager) getLatestReleaseVersion(ctx context.Context, kubernetesVersion string, nodeGroup *eks.Nodegroup) (string, error) {
-	ssmParameterName, err := ami.MakeManagedSSMParameterName(kubernetesVersion, *nodeGroup.AmiType)
+func (m *Manager) getLatestReleaseVersion(ctx context.Context, kubernetesVersion string, nodeGroup *ekstypes.Nodegroup) (string, error) {
+	ssmParameterName, err := ami.MakeManagedSSMParameterName(kubernetesVersion, nodeGroup.AmiType)
 	if err != nil {
 		return "", err
 	}
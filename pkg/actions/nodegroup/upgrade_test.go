@@ -2,13 +2,18 @@ package nodegroup_test
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
@@ -19,6 +24,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
@@ -37,8 +43,7 @@ var _ = Describe("Upgrade", func() {
 	BeforeEach(func() {
 		ngName = "my-nodegroup"
 		clusterName = "my-cluster"
-		cfg = api.NewClusterConfig()
-		cfg.Metadata.Name = clusterName
+		cfg = configbuilder.New(clusterName, "").Build()
 		p = mockprovider.NewMockProvider()
 		fakeClientSet = fake.NewSimpleClientset()
 		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, fakeClientSet)
@@ -56,77 +61,111 @@ var _ = Describe("Upgrade", func() {
 	When("the nodegroup does not have a stack", func() {
 		When("launchTemplate Id is set", func() {
 			BeforeEach(func() {
-				p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 					ClusterName:   aws.String(clusterName),
 					NodegroupName: aws.String(ngName),
 				}).Return(&awseks.DescribeNodegroupOutput{
-					Nodegroup: &awseks.Nodegroup{
+					Nodegroup: &ekstypes.Nodegroup{
 						NodegroupName: aws.String(ngName),
 						ClusterName:   aws.String(clusterName),
-						Status:        aws.String("my-status"),
-						AmiType:       aws.String("ami-type"),
+						Status:        ekstypes.NodegroupStatus("my-status"),
+						AmiType:       ekstypes.AMITypes("ami-type"),
 						Version:       aws.String("1.20"),
-						LaunchTemplate: &awseks.LaunchTemplateSpecification{
-							Id: aws.String("id-123"),
+						LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+							Id:      aws.String("id-123"),
+							Version: aws.String("1"),
 						},
 					},
 				}, nil)
 
-				p.MockEKS().On("UpdateNodegroupVersion", &awseks.UpdateNodegroupVersionInput{
+				p.MockEKS().On("UpdateNodegroupVersion", mock.Anything, &awseks.UpdateNodegroupVersionInput{
 					NodegroupName: aws.String(ngName),
 					ClusterName:   aws.String(clusterName),
-					Force:         aws.Bool(false),
+					Force:         false,
 					Version:       aws.String("1.21"),
-					LaunchTemplate: &awseks.LaunchTemplateSpecification{
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
 						Id:      aws.String("id-123"),
-						Version: aws.String("v2"),
+						Version: aws.String("2"),
 					},
 				}).Return(&awseks.UpdateNodegroupVersionOutput{}, nil)
+
+				mockLaunchTemplateVersions(p)
 			})
 
 			It("upgrades the nodegroup version and lt by calling the API", func() {
-				options.LaunchTemplateVersion = "v2"
+				options.LaunchTemplateVersion = "2"
 				Expect(m.Upgrade(context.Background(), options)).To(Succeed())
 			})
 		})
 
 		When("launchTemplate Name is set", func() {
 			BeforeEach(func() {
-				p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 					ClusterName:   aws.String(clusterName),
 					NodegroupName: aws.String(ngName),
 				}).Return(&awseks.DescribeNodegroupOutput{
-					Nodegroup: &awseks.Nodegroup{
+					Nodegroup: &ekstypes.Nodegroup{
 						NodegroupName: aws.String(ngName),
 						ClusterName:   aws.String(clusterName),
-						Status:        aws.String("my-status"),
-						AmiType:       aws.String("AL2_x86_64"),
+						Status:        ekstypes.NodegroupStatus("my-status"),
+						AmiType:       ekstypes.AMITypesAl2X8664,
 						Version:       aws.String("1.20"),
-						LaunchTemplate: &awseks.LaunchTemplateSpecification{
-							Name: aws.String("lt"),
+						LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+							Name:    aws.String("lt"),
+							Version: aws.String("1"),
 						},
 					},
 				}, nil)
 
-				p.MockEKS().On("UpdateNodegroupVersion", &awseks.UpdateNodegroupVersionInput{
+				p.MockEKS().On("UpdateNodegroupVersion", mock.Anything, &awseks.UpdateNodegroupVersionInput{
 					NodegroupName: aws.String(ngName),
 					ClusterName:   aws.String(clusterName),
-					Force:         aws.Bool(false),
+					Force:         false,
 					Version:       aws.String("1.21"),
-					LaunchTemplate: &awseks.LaunchTemplateSpecification{
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
 						Name:    aws.String("lt"),
-						Version: aws.String("v2"),
+						Version: aws.String("2"),
 					},
 				}).Return(&awseks.UpdateNodegroupVersionOutput{}, nil)
+
+				mockLaunchTemplateVersions(p)
 			})
 
 			It("upgrades the nodegroup version and lt by calling the API", func() {
-				options.LaunchTemplateVersion = "v2"
+				options.LaunchTemplateVersion = "2"
 				Expect(m.Upgrade(context.Background(), options)).To(Succeed())
 			})
 		})
 	})
 
+	When("the version is not within the supported skew of the control plane", func() {
+		BeforeEach(func() {
+			m = nodegroup.New(cfg, &eks.ClusterProvider{
+				Provider: p,
+				Status: &eks.ProviderStatus{
+					ClusterInfo: &eks.ClusterInfo{
+						Cluster: &ekstypes.Cluster{Version: aws.String("1.21")},
+					},
+				},
+			}, fakeClientSet)
+			m.SetStackManager(fakeStackManager)
+		})
+
+		It("fails when the Kubernetes version is newer than the control plane", func() {
+			options.KubernetesVersion = "1.22"
+			err := m.Upgrade(context.Background(), options)
+			Expect(err).To(MatchError(ContainSubstring("nodegroup version 1.22 is newer than the control plane version 1.21")))
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "DescribeNodegroup", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("fails when the release version is more than two minor versions behind", func() {
+			options.KubernetesVersion = ""
+			options.ReleaseVersion = "1.18.9-20220123"
+			err := m.Upgrade(context.Background(), options)
+			Expect(err).To(MatchError(ContainSubstring("invalid release version: nodegroup version 1.18.9 is 3 minor versions behind the control plane version 1.21")))
+		})
+	})
+
 	When("the nodegroup does have a stack", func() {
 		When("ForceUpdateEnabled isn't set", func() {
 			When("it uses amazonlinux2", func() {
@@ -146,15 +185,15 @@ var _ = Describe("Upgrade", func() {
 
 					fakeStackManager.UpdateNodeGroupStackReturns(nil)
 
-					p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+					p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 						ClusterName:   aws.String(clusterName),
 						NodegroupName: aws.String(ngName),
 					}).Return(&awseks.DescribeNodegroupOutput{
-						Nodegroup: &awseks.Nodegroup{
+						Nodegroup: &ekstypes.Nodegroup{
 							NodegroupName:  aws.String(ngName),
 							ClusterName:    aws.String(clusterName),
-							Status:         aws.String("my-status"),
-							AmiType:        aws.String("AL2_x86_64"),
+							Status:         ekstypes.NodegroupStatus("my-status"),
+							AmiType:        ekstypes.AMITypesAl2X8664,
 							Version:        aws.String("1.20"),
 							ReleaseVersion: aws.String("1.20-20201212"),
 						},
@@ -208,15 +247,15 @@ var _ = Describe("Upgrade", func() {
 
 					fakeStackManager.UpdateNodeGroupStackReturns(nil)
 
-					p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+					p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 						ClusterName:   aws.String(clusterName),
 						NodegroupName: aws.String(ngName),
 					}).Return(&awseks.DescribeNodegroupOutput{
-						Nodegroup: &awseks.Nodegroup{
+						Nodegroup: &ekstypes.Nodegroup{
 							NodegroupName:  aws.String(ngName),
 							ClusterName:    aws.String(clusterName),
-							Status:         aws.String("my-status"),
-							AmiType:        aws.String("AL2_x86_64_GPU"),
+							Status:         ekstypes.NodegroupStatus("my-status"),
+							AmiType:        ekstypes.AMITypesAl2X8664Gpu,
 							Version:        aws.String("1.20"),
 							ReleaseVersion: aws.String("1.20-20201212"),
 						},
@@ -264,15 +303,15 @@ var _ = Describe("Upgrade", func() {
 
 					fakeStackManager.UpdateNodeGroupStackReturns(nil)
 
-					p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+					p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 						ClusterName:   aws.String(clusterName),
 						NodegroupName: aws.String(ngName),
 					}).Return(&awseks.DescribeNodegroupOutput{
-						Nodegroup: &awseks.Nodegroup{
+						Nodegroup: &ekstypes.Nodegroup{
 							NodegroupName:  aws.String(ngName),
 							ClusterName:    aws.String(clusterName),
-							Status:         aws.String("my-status"),
-							AmiType:        aws.String("BOTTLEROCKET_x86_64"),
+							Status:         ekstypes.NodegroupStatus("my-status"),
+							AmiType:        ekstypes.AMITypesBottlerocketX8664,
 							Version:        aws.String("1.20"),
 							ReleaseVersion: aws.String("1.20-20201212"),
 						},
@@ -310,3 +349,23 @@ var _ = Describe("Upgrade", func() {
 		})
 	})
 })
+
+func mockLaunchTemplateVersions(p *mockprovider.MockProvider) {
+	p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything, mock.Anything).Return(func(_ context.Context, input *ec2.DescribeLaunchTemplateVersionsInput, _ ...func(*ec2.Options)) *ec2.DescribeLaunchTemplateVersionsOutput {
+		// version 2 is the latest
+		version := int64(2)
+		if input.Versions[0] != "$Latest" {
+			var err error
+			version, err = strconv.ParseInt(input.Versions[0], 10, 64)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		return &ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{{
+				VersionNumber: aws.Int64(version),
+				LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{
+					ImageId: aws.String(fmt.Sprintf("ami-%d", version)),
+				},
+			}},
+		}
+	}, nil)
+}
@@ -0,0 +1,377 @@
+package nodegroup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricingtypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+const (
+	// TargetUtilization is the peak CPU and memory utilization, in percent, an instance type is sized for
+	TargetUtilization = 70.0
+
+	// DefaultUtilizationPeriod is the period the utilization of nodegroups is measured over by default
+	DefaultUtilizationPeriod = 14 * 24 * time.Hour
+
+	// containerInsightsNamespace is the namespace of the node metrics of Container Insights, which are
+	// published by the CloudWatch agent
+	containerInsightsNamespace = "ContainerInsights"
+
+	hoursPerMonth = 730
+
+	// maxMetricDataQueries is the maximum number of queries of a GetMetricData request
+	maxMetricDataQueries = 500
+)
+
+// Utilization is the peak CPU and memory utilization and the cost of a nodegroup, along with a cheaper instance type of
+// the same family if the nodegroup is over-provisioned
+type Utilization struct {
+	Name         string
+	InstanceType string
+	Nodes        int
+	// CPUUtilization and MemoryUtilization are the peak hourly average across the nodes, in percent,
+	// they are nil if the nodes don't publish Container Insights metrics
+	CPUUtilization    *float64 `json:",omitempty"`
+	MemoryUtilization *float64 `json:",omitempty"`
+	// HourlyPrice is the on-demand price of an instance
+	HourlyPrice *float64 `json:",omitempty"`
+	// SuggestedInstanceType is the smallest instance type of the family that keeps the peak utilization under
+	// TargetUtilization, it's only set if it's cheaper
+	SuggestedInstanceType string   `json:",omitempty"`
+	SuggestedHourlyPrice  *float64 `json:",omitempty"`
+	MonthlySavings        *float64 `json:",omitempty"`
+}
+
+// OverProvisioned reports whether a cheaper instance type would do
+func (u Utilization) OverProvisioned() bool {
+	return u.SuggestedInstanceType != ""
+}
+
+// GetUtilization measures the utilization of the nodes of the nodegroups over period from the Container Insights
+// metrics and joins it with the on-demand price of their instance type to suggest cheaper instance types
+func (m *Manager) GetUtilization(ctx context.Context, summaries []*Summary, period time.Duration) ([]Utilization, error) {
+	r := &rightsizer{
+		manager:    m,
+		metricsAPI: m.ctl.Provider.CloudWatch(),
+		pricingAPI: m.ctl.Provider.Pricing(),
+		prices:     map[string]*float64{},
+		end:        time.Now().Truncate(time.Hour),
+	}
+	r.start = r.end.Add(-period)
+
+	var utilizations []Utilization
+	missingMetrics := false
+	for _, s := range summaries {
+		u, err := r.getUtilization(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		if u.Nodes > 0 && (u.CPUUtilization == nil || u.MemoryUtilization == nil) {
+			missingMetrics = true
+		}
+		utilizations = append(utilizations, u)
+	}
+	if missingMetrics {
+		logger.Warning("the utilization of some nodes is unknown, it is only available for clusters with Container Insights enabled")
+	}
+	return utilizations, nil
+}
+
+type rightsizer struct {
+	manager    *Manager
+	metricsAPI awsapi.CloudWatch
+	pricingAPI awsapi.Pricing
+	// prices caches the price of instance types, nil if there is none
+	prices     map[string]*float64
+	start, end time.Time
+}
+
+func (r *rightsizer) getUtilization(ctx context.Context, s *Summary) (Utilization, error) {
+	u := Utilization{
+		Name:         s.Name,
+		InstanceType: s.InstanceType,
+	}
+	if s.AutoScalingGroupName == "" {
+		return u, nil
+	}
+	instances, err := r.manager.getInServiceInstances(ctx, s.AutoScalingGroupName)
+	if err != nil {
+		return Utilization{}, fmt.Errorf("getting instances of nodegroup %q: %w", s.Name, err)
+	}
+	u.Nodes = len(instances)
+	if u.Nodes == 0 {
+		return u, nil
+	}
+
+	instanceTypes := map[string]bool{}
+	var instanceIDs []string
+	for _, instance := range instances {
+		instanceTypes[aws.ToString(instance.InstanceType)] = true
+		instanceIDs = append(instanceIDs, aws.ToString(instance.InstanceId))
+	}
+	if len(instanceTypes) > 1 {
+		// there is no single instance type to size or price
+		u.InstanceType = "mixed"
+	} else {
+		u.InstanceType = aws.ToString(instances[0].InstanceType)
+	}
+
+	if u.CPUUtilization, u.MemoryUtilization, err = r.getPeakUtilization(ctx, instanceIDs); err != nil {
+		return Utilization{}, fmt.Errorf("getting utilization of nodegroup %q: %w", s.Name, err)
+	}
+	if len(instanceTypes) > 1 {
+		return u, nil
+	}
+
+	if u.HourlyPrice, err = r.getPrice(ctx, u.InstanceType); err != nil {
+		return Utilization{}, err
+	}
+	if u.CPUUtilization == nil || u.MemoryUtilization == nil || u.HourlyPrice == nil {
+		return u, nil
+	}
+
+	suggested, err := r.suggestInstanceType(ctx, u.InstanceType, *u.CPUUtilization, *u.MemoryUtilization)
+	if err != nil || suggested == "" {
+		return u, err
+	}
+	suggestedPrice, err := r.getPrice(ctx, suggested)
+	if err != nil || suggestedPrice == nil || *suggestedPrice >= *u.HourlyPrice {
+		return u, err
+	}
+	savings := (*u.HourlyPrice - *suggestedPrice) * float64(u.Nodes) * hoursPerMonth
+	u.SuggestedInstanceType = suggested
+	u.SuggestedHourlyPrice = suggestedPrice
+	u.MonthlySavings = &savings
+	return u, nil
+}
+
+// getPeakUtilization returns the peak hourly average CPU and memory utilization across the instances
+func (r *rightsizer) getPeakUtilization(ctx context.Context, instanceIDs []string) (*float64, *float64, error) {
+	nodeNames, err := r.getNodeNames(ctx, instanceIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metrics := []string{"node_cpu_utilization", "node_memory_utilization"}
+	var queries []cloudwatchtypes.MetricDataQuery
+	for i, instanceID := range instanceIDs {
+		for j, metricName := range metrics {
+			queries = append(queries, cloudwatchtypes.MetricDataQuery{
+				Id: aws.String(fmt.Sprintf("m%d_%d", j, i)),
+				MetricStat: &cloudwatchtypes.MetricStat{
+					Metric: &cloudwatchtypes.Metric{
+						Namespace:  aws.String(containerInsightsNamespace),
+						MetricName: aws.String(metricName),
+						Dimensions: []cloudwatchtypes.Dimension{
+							{Name: aws.String("ClusterName"), Value: aws.String(r.manager.cfg.Metadata.Name)},
+							{Name: aws.String("InstanceId"), Value: aws.String(instanceID)},
+							{Name: aws.String("NodeName"), Value: aws.String(nodeNames[instanceID])},
+						},
+					},
+					Period: aws.Int32(int32(time.Hour.Seconds())),
+					Stat:   aws.String(string(cloudwatchtypes.StatisticAverage)),
+				},
+			})
+		}
+	}
+
+	// the hourly values of each metric summed across the instances, and the number of instances they were reported by
+	sums := make([]map[time.Time]float64, len(metrics))
+	counts := make([]map[time.Time]int, len(metrics))
+	for j := range metrics {
+		sums[j] = map[time.Time]float64{}
+		counts[j] = map[time.Time]int{}
+	}
+	for start := 0; start < len(queries); start += maxMetricDataQueries {
+		end := start + maxMetricDataQueries
+		if end > len(queries) {
+			end = len(queries)
+		}
+		input := &cloudwatch.GetMetricDataInput{
+			MetricDataQueries: queries[start:end],
+			StartTime:         aws.Time(r.start),
+			EndTime:           aws.Time(r.end),
+		}
+		paginator := cloudwatch.NewGetMetricDataPaginator(r.metricsAPI, input)
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("getting Container Insights metrics: %w", err)
+			}
+			for _, result := range output.MetricDataResults {
+				var j int
+				if _, err := fmt.Sscanf(aws.ToString(result.Id), "m%d_", &j); err != nil || j >= len(metrics) {
+					continue
+				}
+				for k, timestamp := range result.Timestamps {
+					if k < len(result.Values) {
+						sums[j][timestamp] += result.Values[k]
+						counts[j][timestamp]++
+					}
+				}
+			}
+		}
+	}
+
+	peaks := make([]*float64, len(metrics))
+	for j := range metrics {
+		for timestamp, sum := range sums[j] {
+			average := sum / float64(counts[j][timestamp])
+			if peaks[j] == nil || average > *peaks[j] {
+				peak := average
+				peaks[j] = &peak
+			}
+		}
+	}
+	return peaks[0], peaks[1], nil
+}
+
+// getNodeNames returns the names of the nodes of instances, which are their private DNS names
+func (r *rightsizer) getNodeNames(ctx context.Context, instanceIDs []string) (map[string]string, error) {
+	nodeNames := map[string]string{}
+	paginator := ec2.NewDescribeInstancesPaginator(r.manager.ctl.Provider.EC2(), &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing instances: %w", err)
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				nodeNames[aws.ToString(instance.InstanceId)] = aws.ToString(instance.PrivateDnsName)
+			}
+		}
+	}
+	return nodeNames, nil
+}
+
+// suggestInstanceType returns the smallest instance type of the family of instanceType that keeps the peak
+// utilization under TargetUtilization, or an empty string if it's not smaller than instanceType
+func (r *rightsizer) suggestInstanceType(ctx context.Context, instanceType string, cpuUtilization, memoryUtilization float64) (string, error) {
+	family := strings.SplitN(instanceType, ".", 2)[0]
+	var instanceTypes []ec2types.InstanceTypeInfo
+	paginator := ec2.NewDescribeInstanceTypesPaginator(r.manager.ctl.Provider.EC2(), &ec2.DescribeInstanceTypesInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("instance-type"), Values: []string{family + ".*"}},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("describing instance types of family %q: %w", family, err)
+		}
+		instanceTypes = append(instanceTypes, output.InstanceTypes...)
+	}
+
+	var current *ec2types.InstanceTypeInfo
+	for i, it := range instanceTypes {
+		if string(it.InstanceType) == instanceType {
+			current = &instanceTypes[i]
+		}
+	}
+	if current == nil || current.VCpuInfo == nil || current.MemoryInfo == nil {
+		return "", nil
+	}
+	requiredVCPUs := float64(aws.ToInt32(current.VCpuInfo.DefaultVCpus)) * cpuUtilization / TargetUtilization
+	requiredMemory := float64(aws.ToInt64(current.MemoryInfo.SizeInMiB)) * memoryUtilization / TargetUtilization
+
+	var candidates []ec2types.InstanceTypeInfo
+	for _, it := range instanceTypes {
+		if it.VCpuInfo == nil || it.MemoryInfo == nil || it.BareMetal == nil || *it.BareMetal {
+			continue
+		}
+		vCPUs, memory := aws.ToInt32(it.VCpuInfo.DefaultVCpus), aws.ToInt64(it.MemoryInfo.SizeInMiB)
+		if float64(vCPUs) >= requiredVCPUs && float64(memory) >= requiredMemory {
+			candidates = append(candidates, it)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		vCPUsI, vCPUsJ := aws.ToInt32(candidates[i].VCpuInfo.DefaultVCpus), aws.ToInt32(candidates[j].VCpuInfo.DefaultVCpus)
+		if vCPUsI != vCPUsJ {
+			return vCPUsI < vCPUsJ
+		}
+		return aws.ToInt64(candidates[i].MemoryInfo.SizeInMiB) < aws.ToInt64(candidates[j].MemoryInfo.SizeInMiB)
+	})
+	if len(candidates) == 0 || string(candidates[0].InstanceType) == instanceType {
+		return "", nil
+	}
+	return string(candidates[0].InstanceType), nil
+}
+
+// getPrice returns the on-demand hourly price of a Linux instance of instanceType in USD, or nil if it has none
+func (r *rightsizer) getPrice(ctx context.Context, instanceType string) (*float64, error) {
+	if price, ok := r.prices[instanceType]; ok {
+		return price, nil
+	}
+
+	filter := func(field, value string) pricingtypes.Filter {
+		return pricingtypes.Filter{
+			Type:  pricingtypes.FilterTypeTermMatch,
+			Field: aws.String(field),
+			Value: aws.String(value),
+		}
+	}
+	output, err := r.pricingAPI.GetProducts(ctx, &pricing.GetProductsInput{
+		ServiceCode: aws.String("AmazonEC2"),
+		Filters: []pricingtypes.Filter{
+			filter("instanceType", instanceType),
+			filter("regionCode", r.manager.cfg.Metadata.Region),
+			filter("operatingSystem", "Linux"),
+			filter("tenancy", "Shared"),
+			filter("preInstalledSw", "NA"),
+			filter("capacitystatus", "Used"),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting the price of instance type %q: %w", instanceType, err)
+	}
+
+	var price *float64
+	for _, product := range output.PriceList {
+		if price = onDemandPrice(product); price != nil {
+			break
+		}
+	}
+	r.prices[instanceType] = price
+	return price, nil
+}
+
+// onDemandPrice returns the price in USD of the on-demand term of a product of the Price List API, which is
+// a JSON document
+func onDemandPrice(productJSON string) *float64 {
+	var product map[string]interface{}
+	if err := json.Unmarshal([]byte(productJSON), &product); err != nil {
+		return nil
+	}
+	terms, _ := product["terms"].(map[string]interface{})
+	onDemand, _ := terms["OnDemand"].(map[string]interface{})
+	for _, term := range onDemand {
+		term, _ := term.(map[string]interface{})
+		dimensions, _ := term["priceDimensions"].(map[string]interface{})
+		for _, dimension := range dimensions {
+			dimension, _ := dimension.(map[string]interface{})
+			pricePerUnit, _ := dimension["pricePerUnit"].(map[string]interface{})
+			usd, _ := pricePerUnit["USD"].(string)
+			if price, err := strconv.ParseFloat(usd, 64); err == nil && price > 0 {
+				return &price
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package nodegroup_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+// mockMetrics makes GetMetricData return the hourly values of each metric, by instance, and records the inputs
+func mockMetrics(p *mockprovider.MockProvider, values map[string]map[string][]float64) *[]*cloudwatch.GetMetricDataInput {
+	var inputs []*cloudwatch.GetMetricDataInput
+	p.MockCloudWatch().On("GetMetricData", mock.Anything, mock.Anything, mock.Anything).Return(func(_ context.Context, input *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) *cloudwatch.GetMetricDataOutput {
+		inputs = append(inputs, input)
+		output := &cloudwatch.GetMetricDataOutput{}
+		start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+		for _, query := range input.MetricDataQueries {
+			var instanceID string
+			for _, d := range query.MetricStat.Metric.Dimensions {
+				if *d.Name == "InstanceId" {
+					instanceID = *d.Value
+				}
+			}
+			result := cloudwatchtypes.MetricDataResult{Id: query.Id}
+			for i, v := range values[*query.MetricStat.Metric.MetricName][instanceID] {
+				result.Timestamps = append(result.Timestamps, start.Add(time.Duration(i)*time.Hour))
+				result.Values = append(result.Values, v)
+			}
+			output.MetricDataResults = append(output.MetricDataResults, result)
+		}
+		return output
+	}, nil)
+	return &inputs
+}
+
+// mockPrices makes GetProducts return an on-demand term with the price of each instance type
+func mockPrices(p *mockprovider.MockProvider, prices map[string]string) {
+	p.MockPricing().On("GetProducts", mock.Anything, mock.Anything).Return(func(_ context.Context, input *pricing.GetProductsInput, _ ...func(*pricing.Options)) *pricing.GetProductsOutput {
+		var instanceType string
+		for _, filter := range input.Filters {
+			if *filter.Field == "instanceType" {
+				instanceType = *filter.Value
+			}
+		}
+		price, ok := prices[instanceType]
+		if !ok {
+			return &pricing.GetProductsOutput{}
+		}
+		return &pricing.GetProductsOutput{
+			PriceList: []string{
+				fmt.Sprintf(`{"terms": {"OnDemand": {"term": {"priceDimensions": {"dimension": {"pricePerUnit": {"USD": %q}}}}}}}`, price),
+			},
+		}
+	}, nil)
+}
+
+var _ = Describe("GetUtilization", func() {
+	var (
+		p         *mockprovider.MockProvider
+		m         *nodegroup.Manager
+		summaries []*nodegroup.Summary
+		m5Type    = func(name string, vCPUs int32, memory int64) ec2types.InstanceTypeInfo {
+			return ec2types.InstanceTypeInfo{
+				InstanceType: ec2types.InstanceType(name),
+				BareMetal:    aws.Bool(false),
+				VCpuInfo:     &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(vCPUs)},
+				MemoryInfo:   &ec2types.MemoryInfo{SizeInMiB: aws.Int64(memory)},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		cfg := configbuilder.New("my-cluster", "us-west-2").Build()
+		p = mockprovider.NewMockProvider()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, fake.NewSimpleClientset())
+		mockPrices(p, map[string]string{
+			"m5.large":   "0.0960000000",
+			"m5.xlarge":  "0.1920000000",
+			"m5.2xlarge": "0.3840000000",
+		})
+		summaries = []*nodegroup.Summary{
+			{Name: "ng-1", InstanceType: "m5.2xlarge", AutoScalingGroupName: "asg-1"},
+		}
+
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{"asg-1"},
+		}, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{
+				{
+					Instances: []asgtypes.Instance{
+						{InstanceId: aws.String("i-1"), InstanceType: aws.String("m5.2xlarge"), LifecycleState: asgtypes.LifecycleStateInService},
+						{InstanceId: aws.String("i-2"), InstanceType: aws.String("m5.2xlarge"), LifecycleState: asgtypes.LifecycleStateInService},
+					},
+				},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeInstances", mock.Anything, &ec2.DescribeInstancesInput{
+			InstanceIds: []string{"i-1", "i-2"},
+		}, mock.Anything).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []ec2types.Reservation{
+				{
+					Instances: []ec2types.Instance{
+						{InstanceId: aws.String("i-1"), PrivateDnsName: aws.String("ip-192-168-1-1.us-west-2.compute.internal")},
+						{InstanceId: aws.String("i-2"), PrivateDnsName: aws.String("ip-192-168-1-2.us-west-2.compute.internal")},
+					},
+				},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeInstanceTypes", mock.Anything, &ec2.DescribeInstanceTypesInput{
+			Filters: []ec2types.Filter{{Name: aws.String("instance-type"), Values: []string{"m5.*"}}},
+		}, mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []ec2types.InstanceTypeInfo{
+				m5Type("m5.2xlarge", 8, 32768),
+				m5Type("m5.large", 2, 8192),
+				m5Type("m5.xlarge", 4, 16384),
+			},
+		}, nil)
+	})
+
+	It("suggests the smallest instance type of the family that fits the peak utilization", func() {
+		inputs := mockMetrics(p, map[string]map[string][]float64{
+			// the peak of the average across the nodes is 30% CPU, 20% memory
+			"node_cpu_utilization": {
+				"i-1": {10, 40},
+				"i-2": {20, 20},
+			},
+			"node_memory_utilization": {
+				"i-1": {20, 10},
+				"i-2": {20, 10},
+			},
+		})
+
+		utilizations, err := m.GetUtilization(context.Background(), summaries, 24*time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(utilizations).To(HaveLen(1))
+		u := utilizations[0]
+		Expect(u.Nodes).To(Equal(2))
+		Expect(*u.CPUUtilization).To(BeNumerically("~", 30))
+		Expect(*u.MemoryUtilization).To(BeNumerically("~", 20))
+		Expect(*u.HourlyPrice).To(BeNumerically("~", 0.384))
+		// 8 vCPUs at 30% need 3.4 vCPUs at 70%
+		Expect(u.SuggestedInstanceType).To(Equal("m5.xlarge"))
+		Expect(u.OverProvisioned()).To(BeTrue())
+		Expect(*u.SuggestedHourlyPrice).To(BeNumerically("~", 0.192))
+		Expect(*u.MonthlySavings).To(BeNumerically("~", 0.192*2*730))
+
+		Expect(*inputs).To(HaveLen(1))
+		Expect((*inputs)[0].MetricDataQueries).To(HaveLen(4))
+		metric := (*inputs)[0].MetricDataQueries[0].MetricStat.Metric
+		Expect(*metric.Namespace).To(Equal("ContainerInsights"))
+		Expect(metric.Dimensions).To(ConsistOf(
+			cloudwatchtypes.Dimension{Name: aws.String("ClusterName"), Value: aws.String("my-cluster")},
+			cloudwatchtypes.Dimension{Name: aws.String("InstanceId"), Value: aws.String("i-1")},
+			cloudwatchtypes.Dimension{Name: aws.String("NodeName"), Value: aws.String("ip-192-168-1-1.us-west-2.compute.internal")},
+		))
+	})
+
+	It("suggests nothing when the nodegroup is busy", func() {
+		mockMetrics(p, map[string]map[string][]float64{
+			"node_cpu_utilization":    {"i-1": {80}, "i-2": {60}},
+			"node_memory_utilization": {"i-1": {20}, "i-2": {20}},
+		})
+
+		utilizations, err := m.GetUtilization(context.Background(), summaries, 24*time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(utilizations[0].OverProvisioned()).To(BeFalse())
+		Expect(utilizations[0].MonthlySavings).To(BeNil())
+	})
+
+	It("reports no utilization when the nodes don't publish Container Insights metrics", func() {
+		mockMetrics(p, nil)
+
+		utilizations, err := m.GetUtilization(context.Background(), summaries, 24*time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(utilizations[0].CPUUtilization).To(BeNil())
+		Expect(utilizations[0].MemoryUtilization).To(BeNil())
+		Expect(*utilizations[0].HourlyPrice).To(BeNumerically("~", 0.384))
+		Expect(utilizations[0].OverProvisioned()).To(BeFalse())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeInstanceTypes", mock.Anything, mock.Anything)
+	})
+})
@@ -0,0 +1,107 @@
+package nodegroup
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// MaxMinorVersionSkew is the number of minor versions nodes can be behind the control plane,
+// see https://kubernetes.io/releases/version-skew-policy/#kubelet
+const MaxMinorVersionSkew = 2
+
+// Values for VersionSkew.Status
+const (
+	VersionSkewSupported   = "supported"
+	VersionSkewUnsupported = "unsupported"
+	VersionSkewUnknown     = "unknown"
+)
+
+// VersionSkew describes how far behind the control plane a nodegroup is
+type VersionSkew struct {
+	Name                string
+	Version             string
+	ControlPlaneVersion string
+	MinorVersionsBehind int
+	Status              string
+}
+
+// MinorVersionsBehind returns the number of minor versions nodegroupVersion is behind controlPlaneVersion;
+// it's negative when the nodegroup is newer than the control plane
+func MinorVersionsBehind(nodegroupVersion, controlPlaneVersion string) (int, error) {
+	ngVersion, err := semver.ParseTolerant(nodegroupVersion)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid nodegroup version %q", nodegroupVersion)
+	}
+	cpVersion, err := semver.ParseTolerant(controlPlaneVersion)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid control plane version %q", controlPlaneVersion)
+	}
+	if ngVersion.Major != cpVersion.Major {
+		return 0, fmt.Errorf("nodegroup version %s and control plane version %s have different major versions", nodegroupVersion, controlPlaneVersion)
+	}
+	return int(cpVersion.Minor) - int(ngVersion.Minor), nil
+}
+
+// ValidateVersionSkew checks that nodegroupVersion is within the supported skew of controlPlaneVersion
+func ValidateVersionSkew(nodegroupVersion, controlPlaneVersion string) error {
+	behind, err := MinorVersionsBehind(nodegroupVersion, controlPlaneVersion)
+	if err != nil {
+		return err
+	}
+	switch {
+	case behind < 0:
+		return fmt.Errorf("nodegroup version %s is newer than the control plane version %s", nodegroupVersion, controlPlaneVersion)
+	case behind > MaxMinorVersionSkew:
+		return fmt.Errorf("nodegroup version %s is %d minor versions behind the control plane version %s, at most %d are supported", nodegroupVersion, behind, controlPlaneVersion, MaxMinorVersionSkew)
+	}
+	return nil
+}
+
+// validateNodeGroupVersions checks that the versions of the nodegroups in cfg are within the supported skew
+// of the control plane; managed nodegroups use the Kubernetes version of their release version, if set
+func validateNodeGroupVersions(cfg *api.ClusterConfig, controlPlaneVersion string) error {
+	if err := ValidateVersionSkew(cfg.Metadata.Version, controlPlaneVersion); err != nil {
+		return err
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		if ng.ReleaseVersion == "" {
+			continue
+		}
+		releaseVersion, err := ParseReleaseVersion(ng.ReleaseVersion)
+		if err != nil {
+			return errors.Wrapf(err, "invalid release version for managed nodegroup %q", ng.Name)
+		}
+		if err := ValidateVersionSkew(releaseVersion.Version.String(), controlPlaneVersion); err != nil {
+			return errors.Wrapf(err, "invalid release version for managed nodegroup %q", ng.Name)
+		}
+	}
+	return nil
+}
+
+// GetVersionSkew returns the version skew of each nodegroup in summaries relative to controlPlaneVersion
+func GetVersionSkew(summaries []*Summary, controlPlaneVersion string) []VersionSkew {
+	skews := make([]VersionSkew, 0, len(summaries))
+	for _, s := range summaries {
+		skew := VersionSkew{
+			Name:                s.Name,
+			Version:             s.Version,
+			ControlPlaneVersion: controlPlaneVersion,
+			Status:              VersionSkewUnknown,
+		}
+		// unmanaged nodegroups without nodes have no version
+		if behind, err := MinorVersionsBehind(s.Version, controlPlaneVersion); err == nil {
+			skew.MinorVersionsBehind = behind
+			if behind < 0 || behind > MaxMinorVersionSkew {
+				skew.Status = VersionSkewUnsupported
+			} else {
+				skew.Status = VersionSkewSupported
+			}
+		}
+		skews = append(skews, skew)
+	}
+	return skews
+}
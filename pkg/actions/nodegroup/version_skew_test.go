@@ -0,0 +1,63 @@
+package nodegroup_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+)
+
+var _ = Describe("Version skew", func() {
+	type skewCase struct {
+		nodegroupVersion    string
+		controlPlaneVersion string
+		errMsg              string
+	}
+
+	DescribeTable("ValidateVersionSkew", func(c skewCase) {
+		err := nodegroup.ValidateVersionSkew(c.nodegroupVersion, c.controlPlaneVersion)
+		if c.errMsg != "" {
+			Expect(err).To(MatchError(ContainSubstring(c.errMsg)))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+	},
+		Entry("same version", skewCase{
+			nodegroupVersion:    "1.21",
+			controlPlaneVersion: "1.21",
+		}),
+		Entry("two minor versions behind", skewCase{
+			nodegroupVersion:    "1.19.15",
+			controlPlaneVersion: "1.21",
+		}),
+		Entry("three minor versions behind", skewCase{
+			nodegroupVersion:    "1.18",
+			controlPlaneVersion: "1.21",
+			errMsg:              "nodegroup version 1.18 is 3 minor versions behind the control plane version 1.21, at most 2 are supported",
+		}),
+		Entry("newer than the control plane", skewCase{
+			nodegroupVersion:    "1.22",
+			controlPlaneVersion: "1.21",
+			errMsg:              "nodegroup version 1.22 is newer than the control plane version 1.21",
+		}),
+		Entry("invalid version", skewCase{
+			nodegroupVersion:    "latest",
+			controlPlaneVersion: "1.21",
+			errMsg:              `invalid nodegroup version "latest"`,
+		}),
+	)
+
+	It("reports the skew of each nodegroup", func() {
+		skews := nodegroup.GetVersionSkew([]*nodegroup.Summary{
+			{Name: "ng-1", Version: "1.21.2"},
+			{Name: "ng-2", Version: "1.18.9"},
+			{Name: "ng-3"},
+		}, "1.21")
+		Expect(skews).To(Equal([]nodegroup.VersionSkew{
+			{Name: "ng-1", Version: "1.21.2", ControlPlaneVersion: "1.21", Status: nodegroup.VersionSkewSupported},
+			{Name: "ng-2", Version: "1.18.9", ControlPlaneVersion: "1.21", MinorVersionsBehind: 3, Status: nodegroup.VersionSkewUnsupported},
+			{Name: "ng-3", ControlPlaneVersion: "1.21", Status: nodegroup.VersionSkewUnknown},
+		}))
+	})
+})
@@ -0,0 +1,134 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+const (
+	// BinaryPrefix is the prefix of plugin binaries, `eksctl foo` runs `eksctl-foo`
+	BinaryPrefix = "eksctl-"
+
+	// VersionEnvName is set to the version of eksctl that runs the plugin
+	VersionEnvName = "EKSCTL_VERSION"
+	// BinaryEnvName is set to the path of the eksctl binary that runs the plugin
+	BinaryEnvName = "EKSCTL_BINARY"
+)
+
+// Plugin is an executable on PATH that adds a command to eksctl
+type Plugin struct {
+	// Name is the command the plugin adds, e.g. `foo-bar` for `eksctl-foo-bar`
+	Name string
+	// Path is where the executable is
+	Path string
+}
+
+// Find returns the plugin that handles args, and the args to pass to it.
+// Like kubectl, the longest match wins: `eksctl foo bar baz` runs `eksctl-foo-bar baz`
+// when it exists, and `eksctl-foo bar baz` otherwise. It returns nil when args
+// don't start with a plain word, when isBuiltin reports the word as an eksctl
+// command, or when no plugin is found.
+func Find(args []string, isBuiltin func(string) bool) (*Plugin, []string, error) {
+	if len(args) == 0 || !isValidName(args[0]) || isBuiltin(args[0]) {
+		return nil, nil, nil
+	}
+
+	words := []string{}
+	for _, arg := range args {
+		if !isValidName(arg) {
+			break
+		}
+		words = append(words, arg)
+	}
+
+	for i := len(words); i > 0; i-- {
+		name := strings.Join(words[:i], "-")
+		path, err := exec.LookPath(BinaryPrefix + name)
+		if errors.Is(err, exec.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("failed to lookup plugin %q: %w", name, err)
+		}
+		return &Plugin{Name: name, Path: path}, args[i:], nil
+	}
+	return nil, nil, nil
+}
+
+// List returns the plugins on PATH sorted by name. When several directories
+// have a plugin with the same name, only the first one is returned, as that's
+// the one eksctl runs.
+func List() ([]Plugin, error) {
+	seen := map[string]bool{}
+	plugins := []Plugin{}
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			// PATH often has directories that don't exist
+			continue
+		}
+		for _, entry := range entries {
+			name := strings.TrimSuffix(entry.Name(), ".exe")
+			if entry.IsDir() || !strings.HasPrefix(name, BinaryPrefix) {
+				continue
+			}
+			name = strings.TrimPrefix(name, BinaryPrefix)
+			if seen[name] || !isExecutable(filepath.Join(dir, entry.Name())) {
+				continue
+			}
+			seen[name] = true
+			plugins = append(plugins, Plugin{Name: name, Path: filepath.Join(dir, entry.Name())})
+		}
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+	return plugins, nil
+}
+
+// Run executes the plugin with args, sharing the standard streams of eksctl,
+// and returns its exit code
+func (p Plugin) Run(args []string) (int, error) {
+	cmd := exec.Command(p.Path, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", VersionEnvName, version.GetVersion()))
+	if binary, err := os.Executable(); err == nil {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", BinaryEnvName, binary))
+	}
+
+	err := cmd.Run()
+	if exiterr, ok := err.(*exec.ExitError); ok {
+		return exiterr.ExitCode(), nil
+	}
+	if err != nil {
+		return 1, fmt.Errorf("failed to run plugin %q: %w", p.Name, err)
+	}
+	return 0, nil
+}
+
+func isValidName(arg string) bool {
+	return arg != "" && !strings.HasPrefix(arg, "-") && !strings.ContainsAny(arg, `/\=`)
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if filepath.Ext(path) == ".exe" {
+		return true
+	}
+	return info.Mode()&0111 != 0
+}
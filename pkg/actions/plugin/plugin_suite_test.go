@@ -0,0 +1,11 @@
+package plugin_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestPlugin(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
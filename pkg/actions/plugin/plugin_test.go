@@ -0,0 +1,149 @@
+package plugin_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/plugin"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		tmpDir       string
+		originalPath string
+	)
+
+	writePlugin := func(dir, name, script string) string {
+		path := filepath.Join(dir, plugin.BinaryPrefix+name)
+		Expect(os.WriteFile(path, []byte("#!/usr/bin/env sh\n"+script), 0777)).To(Succeed())
+		return path
+	}
+
+	isBuiltin := func(name string) bool {
+		return name == "create" || name == "get"
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "plugin")
+		Expect(err).NotTo(HaveOccurred())
+
+		originalPath = os.Getenv("PATH")
+		Expect(os.Setenv("PATH", tmpDir)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+		Expect(os.Setenv("PATH", originalPath)).To(Succeed())
+	})
+
+	Context("Find", func() {
+		BeforeEach(func() {
+			writePlugin(tmpDir, "foo", "exit 0")
+			writePlugin(tmpDir, "foo-bar", "exit 0")
+			writePlugin(tmpDir, "create", "exit 0")
+		})
+
+		It("prefers the plugin with the longest name", func() {
+			p, args, err := plugin.Find([]string{"foo", "bar", "baz", "--flag"}, isBuiltin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(Equal(&plugin.Plugin{Name: "foo-bar", Path: filepath.Join(tmpDir, "eksctl-foo-bar")}))
+			Expect(args).To(Equal([]string{"baz", "--flag"}))
+		})
+
+		It("passes the remaining args to a shorter match", func() {
+			p, args, err := plugin.Find([]string{"foo", "--bar", "baz"}, isBuiltin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.Name).To(Equal("foo"))
+			Expect(args).To(Equal([]string{"--bar", "baz"}))
+		})
+
+		It("doesn't shadow builtin commands", func() {
+			p, _, err := plugin.Find([]string{"create", "cluster"}, isBuiltin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(BeNil())
+		})
+
+		It("ignores args that start with a flag", func() {
+			p, _, err := plugin.Find([]string{"--foo"}, isBuiltin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(BeNil())
+		})
+
+		It("returns nil when no plugin is found", func() {
+			p, _, err := plugin.Find([]string{"baz"}, isBuiltin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(BeNil())
+		})
+	})
+
+	Context("List", func() {
+		It("returns the first executable plugin of each name", func() {
+			otherDir, err := os.MkdirTemp("", "plugin")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(otherDir)
+			Expect(os.Setenv("PATH", strings.Join([]string{tmpDir, filepath.Join(tmpDir, "missing"), otherDir}, string(os.PathListSeparator)))).To(Succeed())
+
+			writePlugin(tmpDir, "foo", "exit 0")
+			writePlugin(otherDir, "foo", "exit 0")
+			writePlugin(otherDir, "bar", "exit 0")
+			Expect(os.WriteFile(filepath.Join(tmpDir, "eksctl-not-executable"), []byte{}, 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(tmpDir, "kubectl-foo"), []byte{}, 0777)).To(Succeed())
+
+			plugins, err := plugin.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plugins).To(Equal([]plugin.Plugin{
+				{Name: "bar", Path: filepath.Join(otherDir, "eksctl-bar")},
+				{Name: "foo", Path: filepath.Join(tmpDir, "eksctl-foo")},
+			}))
+		})
+	})
+
+	Context("Run", func() {
+		BeforeEach(func() {
+			// the plugins need sh from the original PATH
+			Expect(os.Setenv("PATH", strings.Join([]string{tmpDir, originalPath}, string(os.PathListSeparator)))).To(Succeed())
+		})
+
+		It("runs the plugin with the args and the eksctl environment", func() {
+			path := writePlugin(tmpDir, "foo", `echo $@
+echo "EKSCTL_VERSION=$EKSCTL_VERSION"
+[ -n "$EKSCTL_BINARY" ] && echo "EKSCTL_BINARY is set"
+exit 0`)
+
+			newStdoutReader, newStdoutWriter, _ := os.Pipe()
+			originalStdout := os.Stdout
+			defer func() {
+				os.Stdout = originalStdout
+			}()
+			os.Stdout = newStdoutWriter
+
+			exitCode, err := plugin.Plugin{Name: "foo", Path: path}.Run([]string{"--do", "something"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCode).To(BeZero())
+			newStdoutWriter.Close()
+
+			stdout, _ := io.ReadAll(newStdoutReader)
+			Expect(strings.Split(strings.TrimSuffix(string(stdout), "\n"), "\n")).To(Equal([]string{
+				"--do something",
+				fmt.Sprintf("EKSCTL_VERSION=%s", version.GetVersion()),
+				"EKSCTL_BINARY is set",
+			}))
+		})
+
+		It("returns the exit code of the plugin", func() {
+			path := writePlugin(tmpDir, "foo", "exit 33")
+
+			exitCode, err := plugin.Plugin{Name: "foo", Path: path}.Run(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCode).To(Equal(33))
+		})
+	})
+})
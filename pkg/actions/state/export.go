@@ -0,0 +1,114 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// Exporter exports the eksctl-owned state of a cluster
+type Exporter struct {
+	clusterName  string
+	region       string
+	stackManager manager.StackManager
+	cfnAPI       awsapi.CloudFormation
+}
+
+// NewExporter creates a new Exporter
+func NewExporter(clusterName, region string, stackManager manager.StackManager, cfnAPI awsapi.CloudFormation) *Exporter {
+	return &Exporter{
+		clusterName:  clusterName,
+		region:       region,
+		stackManager: stackManager,
+		cfnAPI:       cfnAPI,
+	}
+}
+
+// Export captures the templates, parameters, tags and resources of the stacks of the cluster
+func (e *Exporter) Export(ctx context.Context) (*State, error) {
+	stacks, err := e.stackManager.DescribeStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(stacks) == 0 {
+		return nil, fmt.Errorf("no eksctl-managed CloudFormation stacks found for %q", e.clusterName)
+	}
+
+	clusterStackName := e.stackManager.MakeClusterStackName()
+	// the cluster stack goes first as other stacks import its outputs
+	sort.SliceStable(stacks, func(i, j int) bool {
+		nameI, nameJ := aws.ToString(stacks[i].StackName), aws.ToString(stacks[j].StackName)
+		if nameI == clusterStackName || nameJ == clusterStackName {
+			return nameI == clusterStackName
+		}
+		return nameI < nameJ
+	})
+
+	s := &State{
+		Version:     Version,
+		ClusterName: e.clusterName,
+		Region:      e.region,
+		ExportedAt:  time.Now().UTC(),
+	}
+	for _, stack := range stacks {
+		exported, err := e.exportStack(ctx, stack)
+		if err != nil {
+			return nil, err
+		}
+		s.Stacks = append(s.Stacks, exported)
+	}
+	return s, nil
+}
+
+func (e *Exporter) exportStack(ctx context.Context, stack *manager.Stack) (Stack, error) {
+	stackName := aws.ToString(stack.StackName)
+	template, err := e.stackManager.GetStackTemplate(ctx, stackName)
+	if err != nil {
+		return Stack{}, fmt.Errorf("getting template of stack %q: %w", stackName, err)
+	}
+
+	exported := Stack{
+		Name:       stackName,
+		Template:   template,
+		Parameters: map[string]string{},
+		Tags:       map[string]string{},
+		Outputs:    map[string]string{},
+	}
+	for _, p := range stack.Parameters {
+		exported.Parameters[aws.ToString(p.ParameterKey)] = aws.ToString(p.ParameterValue)
+	}
+	for _, t := range stack.Tags {
+		exported.Tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	for _, c := range stack.Capabilities {
+		exported.Capabilities = append(exported.Capabilities, string(c))
+	}
+	for _, o := range stack.Outputs {
+		exported.Outputs[aws.ToString(o.OutputKey)] = aws.ToString(o.OutputValue)
+	}
+
+	paginator := cloudformation.NewListStackResourcesPaginator(e.cfnAPI, &cloudformation.ListStackResourcesInput{
+		StackName: stack.StackName,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return Stack{}, fmt.Errorf("listing resources of stack %q: %w", stackName, err)
+		}
+		for _, r := range output.StackResourceSummaries {
+			exported.Resources = append(exported.Resources, Resource{
+				LogicalID:  aws.ToString(r.LogicalResourceId),
+				PhysicalID: aws.ToString(r.PhysicalResourceId),
+				Type:       aws.ToString(r.ResourceType),
+			})
+		}
+	}
+	return exported, nil
+}
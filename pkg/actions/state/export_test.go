@@ -0,0 +1,3 @@
+package state
+
+var MakeImportTemplate = makeImportTemplate
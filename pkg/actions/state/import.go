@@ -0,0 +1,212 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// Importer recreates or re-adopts the stacks of an exported state
+type Importer struct {
+	stackManager manager.StackManager
+	cfnAPI       awsapi.CloudFormation
+	roleARN      string
+	timeout      time.Duration
+}
+
+// NewImporter creates a new Importer, roleARN is the service role CloudFormation uses, if any
+func NewImporter(stackManager manager.StackManager, cfnAPI awsapi.CloudFormation, roleARN string, timeout time.Duration) *Importer {
+	return &Importer{
+		stackManager: stackManager,
+		cfnAPI:       cfnAPI,
+		roleARN:      roleARN,
+		timeout:      timeout,
+	}
+}
+
+// Import brings back the stacks of the state that no longer exist, in the order of the state. By default a stack is
+// recreated from its template, which creates new resources. With adopt, the resources of the stack that still exist
+// are imported into a new stack instead, and the resources that can't be imported are then recreated.
+// In plan mode it only logs the changes.
+func (i *Importer) Import(ctx context.Context, s *State, adopt, plan bool) error {
+	for _, stack := range s.Stacks {
+		exists, err := i.stackExists(ctx, stack.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			logger.Info("stack %q already exists", stack.Name)
+			continue
+		}
+
+		if plan {
+			if adopt {
+				logger.Info("(plan) would re-adopt the resources of stack %q", stack.Name)
+			} else {
+				logger.Info("(plan) would recreate stack %q", stack.Name)
+			}
+			continue
+		}
+
+		if adopt {
+			err = i.adoptStack(ctx, stack)
+		} else {
+			err = i.recreateStack(ctx, stack)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *Importer) stackExists(ctx context.Context, name string) (bool, error) {
+	_, err := i.stackManager.DescribeStack(ctx, &manager.Stack{StackName: aws.String(name)})
+	if err == nil {
+		return true, nil
+	}
+	if manager.IsStackDoesNotExistError(err) {
+		return false, nil
+	}
+	return false, fmt.Errorf("describing stack %q: %w", name, err)
+}
+
+func (i *Importer) recreateStack(ctx context.Context, stack Stack) error {
+	logger.Info("recreating stack %q", stack.Name)
+	output, err := i.cfnAPI.CreateStack(ctx, &cloudformation.CreateStackInput{
+		StackName:    aws.String(stack.Name),
+		TemplateBody: aws.String(stack.Template),
+		Parameters:   makeParameters(stack.Parameters),
+		Tags:         makeTags(stack.Tags),
+		Capabilities: makeCapabilities(stack.Capabilities),
+		RoleARN:      i.roleARNOrNil(),
+	})
+	if err != nil {
+		return fmt.Errorf("creating stack %q: %w", stack.Name, err)
+	}
+	if err := i.stackManager.DoWaitUntilStackIsCreated(ctx, &manager.Stack{
+		StackName: aws.String(stack.Name),
+		StackId:   output.StackId,
+	}); err != nil {
+		return err
+	}
+	logger.Success("recreated stack %q", stack.Name)
+	return nil
+}
+
+func (i *Importer) adoptStack(ctx context.Context, stack Stack) error {
+	summary, err := i.cfnAPI.GetTemplateSummary(ctx, &cloudformation.GetTemplateSummaryInput{
+		TemplateBody: aws.String(stack.Template),
+	})
+	if err != nil {
+		return fmt.Errorf("getting template summary of stack %q: %w", stack.Name, err)
+	}
+	identifiers := map[string][]string{}
+	for _, s := range summary.ResourceIdentifierSummaries {
+		identifiers[aws.ToString(s.ResourceType)] = s.ResourceIdentifiers
+	}
+
+	importTemplate, resourcesToImport, skipped, err := makeImportTemplate(stack, identifiers)
+	if err != nil {
+		return err
+	}
+	if len(resourcesToImport) == 0 {
+		return fmt.Errorf("none of the resources of stack %q can be re-adopted, import the state without adopting to recreate the stack", stack.Name)
+	}
+	for _, logicalID := range skipped {
+		logger.Info("resource %q of stack %q can't be imported and will be recreated", logicalID, stack.Name)
+	}
+
+	changeSetName := i.stackManager.MakeChangeSetName("import")
+	logger.Info("importing %d resources into stack %q", len(resourcesToImport), stack.Name)
+	if _, err := i.cfnAPI.CreateChangeSet(ctx, &cloudformation.CreateChangeSetInput{
+		StackName:         aws.String(stack.Name),
+		ChangeSetName:     aws.String(changeSetName),
+		ChangeSetType:     cfntypes.ChangeSetTypeImport,
+		TemplateBody:      aws.String(importTemplate),
+		Parameters:        makeParameters(stack.Parameters),
+		Tags:              makeTags(stack.Tags),
+		Capabilities:      makeCapabilities(stack.Capabilities),
+		RoleARN:           i.roleARNOrNil(),
+		ResourcesToImport: resourcesToImport,
+	}); err != nil {
+		return fmt.Errorf("creating import change set for stack %q: %w", stack.Name, err)
+	}
+	if err := cloudformation.NewChangeSetCreateCompleteWaiter(i.cfnAPI).Wait(ctx, &cloudformation.DescribeChangeSetInput{
+		StackName:     aws.String(stack.Name),
+		ChangeSetName: aws.String(changeSetName),
+	}, i.timeout); err != nil {
+		return fmt.Errorf("waiting for import change set of stack %q: %w", stack.Name, err)
+	}
+	if _, err := i.cfnAPI.ExecuteChangeSet(ctx, &cloudformation.ExecuteChangeSetInput{
+		StackName:     aws.String(stack.Name),
+		ChangeSetName: aws.String(changeSetName),
+	}); err != nil {
+		return fmt.Errorf("executing import change set of stack %q: %w", stack.Name, err)
+	}
+	if err := cloudformation.NewStackImportCompleteWaiter(i.cfnAPI).Wait(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stack.Name),
+	}, i.timeout); err != nil {
+		return fmt.Errorf("waiting for resources to be imported into stack %q: %w", stack.Name, err)
+	}
+
+	// updating the stack to its original template restores the deletion policies and outputs,
+	// and creates the resources that weren't imported
+	if err := i.stackManager.UpdateStack(ctx, manager.UpdateStackOptions{
+		StackName:     stack.Name,
+		ChangeSetName: i.stackManager.MakeChangeSetName("restore"),
+		Description:   fmt.Sprintf("restoring the template of stack %q", stack.Name),
+		TemplateData:  manager.TemplateBody(stack.Template),
+		Parameters:    stack.Parameters,
+		Wait:          true,
+	}); err != nil {
+		return fmt.Errorf("restoring the template of stack %q after importing its resources: %w", stack.Name, err)
+	}
+	logger.Success("re-adopted the resources of stack %q", stack.Name)
+	return nil
+}
+
+func (i *Importer) roleARNOrNil() *string {
+	if i.roleARN == "" {
+		return nil
+	}
+	return aws.String(i.roleARN)
+}
+
+func makeParameters(parameters map[string]string) []cfntypes.Parameter {
+	var cfnParameters []cfntypes.Parameter
+	for k, v := range parameters {
+		cfnParameters = append(cfnParameters, cfntypes.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(v),
+		})
+	}
+	return cfnParameters
+}
+
+func makeTags(tags map[string]string) []cfntypes.Tag {
+	var cfnTags []cfntypes.Tag
+	for k, v := range tags {
+		cfnTags = append(cfnTags, cfntypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	return cfnTags
+}
+
+func makeCapabilities(capabilities []string) []cfntypes.Capability {
+	var cfnCapabilities []cfntypes.Capability
+	for _, c := range capabilities {
+		cfnCapabilities = append(cfnCapabilities, cfntypes.Capability(c))
+	}
+	return cfnCapabilities
+}
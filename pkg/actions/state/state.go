@@ -0,0 +1,60 @@
+// Package state exports the CloudFormation stacks eksctl owns for a cluster, so that they can be recreated or
+// re-adopted after they are deleted by accident
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Version is the version of the state format
+const Version = 1
+
+// State is the eksctl-owned state of a cluster
+type State struct {
+	Version     int       `json:"version"`
+	ClusterName string    `json:"clusterName"`
+	Region      string    `json:"region"`
+	ExportedAt  time.Time `json:"exportedAt"`
+	// Stacks are ordered so that stacks are listed after the stacks they import outputs from
+	Stacks []Stack `json:"stacks"`
+}
+
+// Stack is a CloudFormation stack and the resources it manages
+type Stack struct {
+	Name         string            `json:"name"`
+	Template     string            `json:"template"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Capabilities []string          `json:"capabilities,omitempty"`
+	Outputs      map[string]string `json:"outputs,omitempty"`
+	Resources    []Resource        `json:"resources"`
+}
+
+// Resource is a resource of a stack
+type Resource struct {
+	LogicalID  string `json:"logicalID"`
+	PhysicalID string `json:"physicalID"`
+	Type       string `json:"type"`
+}
+
+// Write writes the state as indented JSON
+func Write(w io.Writer, s *State) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// Read reads a state written by Write
+func Read(r io.Reader) (*State, error) {
+	var s State
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("parsing state: %w", err)
+	}
+	if s.Version != Version {
+		return nil, fmt.Errorf("unsupported state version %d, expected %d", s.Version, Version)
+	}
+	return &s, nil
+}
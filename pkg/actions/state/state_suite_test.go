@@ -0,0 +1,11 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestState(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
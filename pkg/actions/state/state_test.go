@@ -0,0 +1,197 @@
+package state_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/state"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	managerfakes "github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("State", func() {
+	var (
+		fakeStackManager *managerfakes.FakeStackManager
+		p                *mockprovider.MockProvider
+	)
+
+	BeforeEach(func() {
+		fakeStackManager = &managerfakes.FakeStackManager{}
+		p = mockprovider.NewMockProvider()
+	})
+
+	Describe("Export", func() {
+		It("exports the stacks with the cluster stack first", func() {
+			fakeStackManager.MakeClusterStackNameReturns("eksctl-my-cluster-cluster")
+			fakeStackManager.DescribeStacksReturns([]*manager.Stack{
+				{
+					StackName:    aws.String("eksctl-my-cluster-nodegroup-ng-1"),
+					Capabilities: []cfntypes.Capability{cfntypes.CapabilityCapabilityIam},
+					Tags:         []cfntypes.Tag{{Key: aws.String("alpha.eksctl.io/cluster-name"), Value: aws.String("my-cluster")}},
+				},
+				{
+					StackName:  aws.String("eksctl-my-cluster-cluster"),
+					Parameters: []cfntypes.Parameter{{ParameterKey: aws.String("Key"), ParameterValue: aws.String("value")}},
+					Outputs:    []cfntypes.Output{{OutputKey: aws.String("VPC"), OutputValue: aws.String("vpc-1")}},
+				},
+			}, nil)
+			fakeStackManager.GetStackTemplateReturns(`{"Resources":{}}`, nil)
+			p.MockCloudFormation().On("ListStackResources", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStackResourcesOutput{
+				StackResourceSummaries: []cfntypes.StackResourceSummary{
+					{LogicalResourceId: aws.String("VPC"), PhysicalResourceId: aws.String("vpc-1"), ResourceType: aws.String("AWS::EC2::VPC")},
+				},
+			}, nil)
+
+			s, err := state.NewExporter("my-cluster", "us-west-2", fakeStackManager, p.MockCloudFormation()).Export(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.ClusterName).To(Equal("my-cluster"))
+			Expect(s.Region).To(Equal("us-west-2"))
+			Expect(s.Stacks).To(HaveLen(2))
+
+			Expect(s.Stacks[0].Name).To(Equal("eksctl-my-cluster-cluster"))
+			Expect(s.Stacks[0].Template).To(Equal(`{"Resources":{}}`))
+			Expect(s.Stacks[0].Parameters).To(Equal(map[string]string{"Key": "value"}))
+			Expect(s.Stacks[0].Outputs).To(Equal(map[string]string{"VPC": "vpc-1"}))
+			Expect(s.Stacks[0].Resources).To(Equal([]state.Resource{{LogicalID: "VPC", PhysicalID: "vpc-1", Type: "AWS::EC2::VPC"}}))
+
+			Expect(s.Stacks[1].Name).To(Equal("eksctl-my-cluster-nodegroup-ng-1"))
+			Expect(s.Stacks[1].Capabilities).To(ConsistOf("CAPABILITY_IAM"))
+			Expect(s.Stacks[1].Tags).To(HaveKeyWithValue("alpha.eksctl.io/cluster-name", "my-cluster"))
+		})
+
+		It("fails if the cluster has no stacks", func() {
+			_, err := state.NewExporter("my-cluster", "us-west-2", fakeStackManager, p.MockCloudFormation()).Export(context.Background())
+			Expect(err).To(MatchError(`no eksctl-managed CloudFormation stacks found for "my-cluster"`))
+		})
+	})
+
+	It("reads the state it writes", func() {
+		s := &state.State{
+			Version:     state.Version,
+			ClusterName: "my-cluster",
+			Region:      "us-west-2",
+			ExportedAt:  time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+			Stacks:      []state.Stack{{Name: "eksctl-my-cluster-cluster", Template: "{}"}},
+		}
+		var buf bytes.Buffer
+		Expect(state.Write(&buf, s)).To(Succeed())
+		read, err := state.Read(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(read).To(Equal(s))
+
+		_, err = state.Read(bytes.NewBufferString(`{"version": 2}`))
+		Expect(err).To(MatchError("unsupported state version 2, expected 1"))
+	})
+
+	Describe("Import", func() {
+		var s *state.State
+
+		BeforeEach(func() {
+			s = &state.State{
+				Version:     state.Version,
+				ClusterName: "my-cluster",
+				Region:      "us-west-2",
+				Stacks: []state.Stack{
+					{Name: "eksctl-my-cluster-cluster", Template: "{}"},
+					{
+						Name:         "eksctl-my-cluster-nodegroup-ng-1",
+						Template:     `{"Resources":{}}`,
+						Tags:         map[string]string{"alpha.eksctl.io/cluster-name": "my-cluster"},
+						Capabilities: []string{"CAPABILITY_IAM"},
+					},
+				},
+			}
+			fakeStackManager.DescribeStackStub = func(_ context.Context, stack *manager.Stack) (*manager.Stack, error) {
+				if aws.StringValue(stack.StackName) == "eksctl-my-cluster-cluster" {
+					return stack, nil
+				}
+				return nil, pkgerrors.Wrapf(&smithy.OperationError{Err: errors.New("ValidationError: Stack does not exist")}, "describing CloudFormation stack")
+			}
+		})
+
+		It("recreates the stacks that don't exist", func() {
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Return(&cloudformation.CreateStackOutput{StackId: aws.String("id")}, nil)
+
+			Expect(state.NewImporter(fakeStackManager, p.MockCloudFormation(), "", time.Minute).Import(context.Background(), s, false, false)).To(Succeed())
+
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "CreateStack", 1)
+			input := p.MockCloudFormation().Calls[0].Arguments[1].(*cloudformation.CreateStackInput)
+			Expect(aws.StringValue(input.StackName)).To(Equal("eksctl-my-cluster-nodegroup-ng-1"))
+			Expect(aws.StringValue(input.TemplateBody)).To(Equal(`{"Resources":{}}`))
+			Expect(input.Capabilities).To(ConsistOf(cfntypes.CapabilityCapabilityIam))
+			Expect(input.Tags).To(ConsistOf(cfntypes.Tag{Key: aws.String("alpha.eksctl.io/cluster-name"), Value: aws.String("my-cluster")}))
+			Expect(input.RoleARN).To(BeNil())
+			Expect(fakeStackManager.DoWaitUntilStackIsCreatedCallCount()).To(Equal(1))
+		})
+
+		It("doesn't change anything in plan mode", func() {
+			Expect(state.NewImporter(fakeStackManager, p.MockCloudFormation(), "", time.Minute).Import(context.Background(), s, true, true)).To(Succeed())
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+		})
+	})
+
+	Describe("import template", func() {
+		It("imports the resources identified by their physical ID and leaves out the rest", func() {
+			stack := state.Stack{
+				Name: "eksctl-my-cluster-cluster",
+				Template: `{
+					"Resources": {
+						"VPC": {"Type": "AWS::EC2::VPC", "Properties": {"CidrBlock": "192.168.0.0/16"}},
+						"SubnetA": {"Type": "AWS::EC2::Subnet", "Properties": {"VpcId": {"Ref": "VPC"}}},
+						"RouteTable": {"Type": "AWS::EC2::RouteTable", "Properties": {"VpcId": {"Ref": "VPC"}}},
+						"Route": {"Type": "AWS::EC2::Route", "Properties": {"RouteTableId": {"Ref": "RouteTable"}}},
+						"Role": {"Type": "AWS::IAM::Role", "DependsOn": "Route", "Properties": {}},
+						"Deleted": {"Type": "AWS::EC2::InternetGateway"}
+					},
+					"Outputs": {"VPC": {"Value": {"Ref": "VPC"}}}
+				}`,
+				Resources: []state.Resource{
+					{LogicalID: "VPC", PhysicalID: "vpc-1", Type: "AWS::EC2::VPC"},
+					{LogicalID: "SubnetA", PhysicalID: "subnet-1", Type: "AWS::EC2::Subnet"},
+					{LogicalID: "RouteTable", PhysicalID: "rtb-1", Type: "AWS::EC2::RouteTable"},
+					{LogicalID: "Route", PhysicalID: "rtb-1|0.0.0.0/0", Type: "AWS::EC2::Route"},
+					{LogicalID: "Role", PhysicalID: "role-1", Type: "AWS::IAM::Role"},
+				},
+			}
+			identifiers := map[string][]string{
+				"AWS::EC2::VPC":             {"VpcId"},
+				"AWS::EC2::Subnet":          {"SubnetId"},
+				"AWS::EC2::RouteTable":      {"RouteTableId"},
+				"AWS::EC2::InternetGateway": {"InternetGatewayId"},
+				"AWS::IAM::Role":            {"RoleName"},
+			}
+
+			template, resourcesToImport, skipped, err := state.MakeImportTemplate(stack, identifiers)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(skipped).To(Equal([]string{"Deleted", "Role", "Route"}))
+			Expect(resourcesToImport).To(Equal([]cfntypes.ResourceToImport{
+				{LogicalResourceId: aws.String("RouteTable"), ResourceType: aws.String("AWS::EC2::RouteTable"), ResourceIdentifier: map[string]string{"RouteTableId": "rtb-1"}},
+				{LogicalResourceId: aws.String("SubnetA"), ResourceType: aws.String("AWS::EC2::Subnet"), ResourceIdentifier: map[string]string{"SubnetId": "subnet-1"}},
+				{LogicalResourceId: aws.String("VPC"), ResourceType: aws.String("AWS::EC2::VPC"), ResourceIdentifier: map[string]string{"VpcId": "vpc-1"}},
+			}))
+
+			var parsed struct {
+				Resources map[string]struct{ DeletionPolicy string }
+				Outputs   map[string]interface{}
+			}
+			Expect(json.Unmarshal([]byte(template), &parsed)).To(Succeed())
+			Expect(parsed.Resources).To(HaveLen(3))
+			Expect(parsed.Resources["VPC"].DeletionPolicy).To(Equal("Retain"))
+			Expect(parsed.Outputs).To(BeNil())
+		})
+	})
+})
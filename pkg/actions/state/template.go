@@ -0,0 +1,147 @@
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// subReference matches the resources and parameters referenced in a Fn::Sub string, e.g. ${VPC} or ${Role.Arn}
+var subReference = regexp.MustCompile(`\$\{([^!}.][^}.]*)`)
+
+// makeImportTemplate returns the template of an import change set for the stack, along with the resources to import
+// and the logical IDs of the resources left out. A resource is imported if it was exported with a physical ID and its
+// type is identified by a single property, the physical ID, in identifiers. Resources referencing a resource that is
+// left out are left out too, as are the outputs. Imported resources must have a DeletionPolicy, Retain is used.
+func makeImportTemplate(stack Stack, identifiers map[string][]string) (string, []cfntypes.ResourceToImport, []string, error) {
+	var template map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(stack.Template)))
+	// numbers are kept as they are instead of being converted to float64
+	decoder.UseNumber()
+	if err := decoder.Decode(&template); err != nil {
+		return "", nil, nil, fmt.Errorf("parsing template of stack %q: %w", stack.Name, err)
+	}
+
+	physicalIDs := map[string]string{}
+	for _, r := range stack.Resources {
+		physicalIDs[r.LogicalID] = r.PhysicalID
+	}
+
+	resources, _ := template["Resources"].(map[string]interface{})
+	skipped := map[string]bool{}
+	for logicalID, r := range resources {
+		resource, _ := r.(map[string]interface{})
+		resourceType, _ := resource["Type"].(string)
+		if physicalIDs[logicalID] == "" || len(identifiers[resourceType]) != 1 {
+			skipped[logicalID] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for logicalID, r := range resources {
+			if skipped[logicalID] {
+				continue
+			}
+			refs := map[string]bool{}
+			collectReferences(r, refs)
+			for ref := range refs {
+				if skipped[ref] {
+					skipped[logicalID] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	var logicalIDs []string
+	for logicalID := range resources {
+		logicalIDs = append(logicalIDs, logicalID)
+	}
+	sort.Strings(logicalIDs)
+
+	var resourcesToImport []cfntypes.ResourceToImport
+	var skippedIDs []string
+	for _, logicalID := range logicalIDs {
+		if skipped[logicalID] {
+			skippedIDs = append(skippedIDs, logicalID)
+			delete(resources, logicalID)
+			continue
+		}
+		resource := resources[logicalID].(map[string]interface{})
+		resourceType := resource["Type"].(string)
+		resource["DeletionPolicy"] = "Retain"
+		resourcesToImport = append(resourcesToImport, cfntypes.ResourceToImport{
+			LogicalResourceId: aws.String(logicalID),
+			ResourceType:      aws.String(resourceType),
+			ResourceIdentifier: map[string]string{
+				identifiers[resourceType][0]: physicalIDs[logicalID],
+			},
+		})
+	}
+	delete(template, "Outputs")
+
+	importTemplate, err := json.Marshal(template)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("serialising import template of stack %q: %w", stack.Name, err)
+	}
+	return string(importTemplate), resourcesToImport, skippedIDs, nil
+}
+
+// collectReferences adds the logical IDs referenced by Ref, Fn::GetAtt, Fn::Sub and DependsOn in v to refs
+func collectReferences(v interface{}, refs map[string]bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			switch key {
+			case "Ref":
+				if ref, ok := value.(string); ok {
+					refs[ref] = true
+				}
+			case "Fn::GetAtt":
+				switch getAtt := value.(type) {
+				case []interface{}:
+					if len(getAtt) > 0 {
+						if ref, ok := getAtt[0].(string); ok {
+							refs[ref] = true
+						}
+					}
+				case string:
+					refs[strings.SplitN(getAtt, ".", 2)[0]] = true
+				}
+			case "Fn::Sub":
+				sub := value
+				if args, ok := value.([]interface{}); ok && len(args) > 0 {
+					sub = args[0]
+				}
+				if s, ok := sub.(string); ok {
+					for _, match := range subReference.FindAllStringSubmatch(s, -1) {
+						refs[match[1]] = true
+					}
+				}
+			case "DependsOn":
+				switch dependsOn := value.(type) {
+				case string:
+					refs[dependsOn] = true
+				case []interface{}:
+					for _, d := range dependsOn {
+						if ref, ok := d.(string); ok {
+							refs[ref] = true
+						}
+					}
+				}
+			}
+			collectReferences(value, refs)
+		}
+	case []interface{}:
+		for _, value := range v {
+			collectReferences(value, refs)
+		}
+	}
+}
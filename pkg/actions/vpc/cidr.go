@@ -0,0 +1,249 @@
+package vpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+)
+
+const (
+	// privateSubnetPrefix and privateRouteTablePrefix prefix the names of the private subnets and route tables of
+	// IPv4 VPCs, which end with the availability zone
+	privateSubnetPrefix     = "SubnetPrivate"
+	privateRouteTablePrefix = builder.PrivateRouteTableKey
+)
+
+// CIDRAssociator associates secondary CIDR blocks with the VPC of a cluster through the cluster stack, so that the
+// VPC doesn't drift from the stack
+type CIDRAssociator struct {
+	stackManager manager.StackManager
+	ec2API       awsapi.EC2
+}
+
+// NewCIDRAssociator creates a CIDRAssociator
+func NewCIDRAssociator(stackManager manager.StackManager, ec2API awsapi.EC2) *CIDRAssociator {
+	return &CIDRAssociator{
+		stackManager: stackManager,
+		ec2API:       ec2API,
+	}
+}
+
+// Associate adds cidr to the VPC created by eksctl. With createSubnets, the CIDR is split into a private subnet per
+// availability zone of the cluster, routed through the private route table of the zone
+func (a *CIDRAssociator) Associate(ctx context.Context, cidr *net.IPNet, createSubnets, plan bool) error {
+	if cidr.IP.To4() == nil {
+		return fmt.Errorf("%s is not an IPv4 CIDR block", cidr)
+	}
+
+	stack, err := a.stackManager.DescribeClusterStack(ctx)
+	if err != nil {
+		return errors.Wrap(err, "describing cluster stack")
+	}
+	stackName := aws.ToString(stack.StackName)
+	template, err := a.stackManager.GetStackTemplate(ctx, stackName)
+	if err != nil {
+		return errors.Wrapf(err, "fetching template of stack %q", stackName)
+	}
+	if !gjson.Get(template, resourcePath(builder.VPCResourceKey)).Exists() {
+		return errors.New("the VPC of the cluster wasn't created by eksctl, associate the CIDR block with the VPC directly instead")
+	}
+
+	cidrResourceName := "VPCCIDR" + formatCIDR(cidr)
+	if gjson.Get(template, resourcePath(cidrResourceName)).Exists() {
+		logger.Info("CIDR block %s is already associated with the VPC by stack %q", cidr, stackName)
+		return nil
+	}
+
+	vpcID := ""
+	for _, o := range stack.Outputs {
+		if aws.ToString(o.OutputKey) == outputs.ClusterVPC {
+			vpcID = aws.ToString(o.OutputValue)
+		}
+	}
+	if vpcID == "" {
+		return fmt.Errorf("no VPC output in stack %q", stackName)
+	}
+	if err := a.checkOverlap(ctx, vpcID, cidr); err != nil {
+		return err
+	}
+
+	if template, err = sjson.Set(template, resourcePath(cidrResourceName), map[string]interface{}{
+		"Type": "AWS::EC2::VPCCidrBlock",
+		"Properties": map[string]interface{}{
+			"VpcId":     map[string]string{"Ref": builder.VPCResourceKey},
+			"CidrBlock": cidr.String(),
+		},
+	}); err != nil {
+		return errors.Wrap(err, "adding the CIDR block to the cluster stack")
+	}
+
+	var subnets []string
+	if createSubnets {
+		if template, subnets, err = addSubnets(template, cidr, cidrResourceName); err != nil {
+			return err
+		}
+	}
+
+	describeUpdate := fmt.Sprintf("associating CIDR block %s with VPC %q", cidr, vpcID)
+	if len(subnets) > 0 {
+		describeUpdate += fmt.Sprintf(" and creating subnets %s", strings.Join(subnets, ", "))
+	}
+	if plan {
+		logger.Info("(plan) would update stack %q, %s", stackName, describeUpdate)
+		return nil
+	}
+	logger.Info("updating stack %q, %s", stackName, describeUpdate)
+	if err := a.stackManager.UpdateStack(ctx, manager.UpdateStackOptions{
+		StackName:     stackName,
+		ChangeSetName: a.stackManager.MakeChangeSetName("associate-vpc-cidr"),
+		Description:   describeUpdate,
+		TemplateData:  manager.TemplateBody(template),
+		Wait:          true,
+	}); err != nil {
+		return errors.Wrapf(err, "updating stack %q", stackName)
+	}
+	logger.Success("associated CIDR block %s with VPC %q", cidr, vpcID)
+	return nil
+}
+
+// checkOverlap checks that cidr doesn't overlap the CIDR blocks of the VPC, EC2 only reports it once the stack is updated
+func (a *CIDRAssociator) checkOverlap(ctx context.Context, vpcID string, cidr *net.IPNet) error {
+	output, err := a.ec2API.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+		VpcIds: []string{vpcID},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing VPC %q", vpcID)
+	}
+	if len(output.Vpcs) == 0 {
+		return fmt.Errorf("VPC %q not found", vpcID)
+	}
+	for _, association := range output.Vpcs[0].CidrBlockAssociationSet {
+		if association.CidrBlockState == nil {
+			continue
+		}
+		switch association.CidrBlockState.State {
+		case ec2types.VpcCidrBlockStateCodeAssociated, ec2types.VpcCidrBlockStateCodeAssociating:
+		default:
+			continue
+		}
+		_, existing, err := net.ParseCIDR(aws.ToString(association.CidrBlock))
+		if err != nil {
+			continue
+		}
+		if existing.Contains(cidr.IP) || cidr.Contains(existing.IP) {
+			return fmt.Errorf("CIDR block %s overlaps CIDR block %s of VPC %q", cidr, existing, vpcID)
+		}
+	}
+	return nil
+}
+
+// addSubnets adds a private subnet from cidr for each availability zone that has a private subnet in the template
+func addSubnets(template string, cidr *net.IPNet, cidrResourceName string) (string, []string, error) {
+	// the private subnets of the cluster are named after their availability zone, e.g. SubnetPrivateUSWEST2A
+	zones := map[string]string{}
+	gjson.Get(template, "Resources").ForEach(func(key, value gjson.Result) bool {
+		name := key.String()
+		if strings.HasPrefix(name, privateSubnetPrefix) && value.Get("Type").String() == "AWS::EC2::Subnet" {
+			zones[strings.TrimPrefix(name, privateSubnetPrefix)] = value.Get("Properties.AvailabilityZone").String()
+		}
+		return true
+	})
+	if len(zones) == 0 {
+		return "", nil, errors.New("the cluster stack has no private subnets to create subnets alongside")
+	}
+	var aliases []string
+	for alias := range zones {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	cidrs, err := splitCIDR(cidr, len(aliases))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var subnets []string
+	for i, alias := range aliases {
+		routeTable := privateRouteTablePrefix + alias
+		if !gjson.Get(template, resourcePath(routeTable)).Exists() {
+			return "", nil, fmt.Errorf("no private route table %q in the cluster stack", routeTable)
+		}
+		subnetName := "Subnet" + cidrResourceName + alias
+		if template, err = sjson.Set(template, resourcePath(subnetName), map[string]interface{}{
+			"Type": "AWS::EC2::Subnet",
+			// the subnet can only be created once the CIDR block is associated
+			"DependsOn": []string{cidrResourceName},
+			"Properties": map[string]interface{}{
+				"AvailabilityZone": zones[alias],
+				"CidrBlock":        cidrs[i].String(),
+				"VpcId":            map[string]string{"Ref": builder.VPCResourceKey},
+				"Tags": []map[string]interface{}{
+					{"Key": "Name", "Value": map[string]string{"Fn::Sub": "${AWS::StackName}/" + subnetName}},
+				},
+			},
+		}); err != nil {
+			return "", nil, errors.Wrapf(err, "adding subnet %q to the cluster stack", subnetName)
+		}
+		if template, err = sjson.Set(template, resourcePath("RouteTableAssociation"+cidrResourceName+alias), map[string]interface{}{
+			"Type": "AWS::EC2::SubnetRouteTableAssociation",
+			"Properties": map[string]interface{}{
+				"SubnetId":     map[string]string{"Ref": subnetName},
+				"RouteTableId": map[string]string{"Ref": routeTable},
+			},
+		}); err != nil {
+			return "", nil, errors.Wrapf(err, "adding the route table association of subnet %q to the cluster stack", subnetName)
+		}
+		subnets = append(subnets, fmt.Sprintf("%s (%s)", cidrs[i], zones[alias]))
+	}
+	return template, subnets, nil
+}
+
+// splitCIDR splits parent into the smallest power of two of equal blocks that is at least count
+func splitCIDR(parent *net.IPNet, count int) ([]*net.IPNet, error) {
+	bits := 0
+	for 1<<bits < count {
+		bits++
+	}
+	ones, _ := parent.Mask.Size()
+	prefix := ones + bits
+	// subnets can't be smaller than /28
+	if prefix > 28 {
+		return nil, fmt.Errorf("CIDR block %s is too small to be split into %d subnets", parent, count)
+	}
+	start := binary.BigEndian.Uint32(parent.IP.To4())
+	var blocks []*net.IPNet
+	for i := 0; i < count; i++ {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, start+uint32(i)<<uint(32-prefix))
+		blocks = append(blocks, &net.IPNet{
+			IP:   ip,
+			Mask: net.CIDRMask(prefix, 32),
+		})
+	}
+	return blocks, nil
+}
+
+// formatCIDR formats cidr for resource names, e.g. 100.64.0.0/16 becomes 100640016
+func formatCIDR(cidr *net.IPNet) string {
+	return strings.NewReplacer(".", "", "/", "").Replace(cidr.String())
+}
+
+func resourcePath(name string) string {
+	return "Resources." + name
+}
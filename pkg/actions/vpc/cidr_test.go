@@ -0,0 +1,116 @@
+package vpc_test
+
+import (
+	"context"
+	"net"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
+
+	"github.com/weaveworks/eksctl/pkg/actions/vpc"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	managerfakes "github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+const clusterTemplate = `{
+	"Resources": {
+		"VPC": {"Type": "AWS::EC2::VPC", "Properties": {"CidrBlock": "192.168.0.0/16"}},
+		"SubnetPrivateUSWEST2A": {"Type": "AWS::EC2::Subnet", "Properties": {"AvailabilityZone": "us-west-2a"}},
+		"SubnetPrivateUSWEST2B": {"Type": "AWS::EC2::Subnet", "Properties": {"AvailabilityZone": "us-west-2b"}},
+		"SubnetPublicUSWEST2A": {"Type": "AWS::EC2::Subnet", "Properties": {"AvailabilityZone": "us-west-2a"}},
+		"PrivateRouteTableUSWEST2A": {"Type": "AWS::EC2::RouteTable"},
+		"PrivateRouteTableUSWEST2B": {"Type": "AWS::EC2::RouteTable"}
+	},
+	"Outputs": {}
+}`
+
+var _ = Describe("CIDRAssociator", func() {
+	var (
+		fakeStackManager *managerfakes.FakeStackManager
+		p                *mockprovider.MockProvider
+		associator       *vpc.CIDRAssociator
+		cidr             *net.IPNet
+	)
+
+	BeforeEach(func() {
+		fakeStackManager = &managerfakes.FakeStackManager{}
+		fakeStackManager.DescribeClusterStackReturns(&manager.Stack{
+			StackName: aws.String("eksctl-my-cluster-cluster"),
+			Outputs:   []cfntypes.Output{{OutputKey: aws.String("VPC"), OutputValue: aws.String("vpc-1")}},
+		}, nil)
+		fakeStackManager.GetStackTemplateReturns(clusterTemplate, nil)
+		p = mockprovider.NewMockProvider()
+		p.MockEC2().On("DescribeVpcs", mock.Anything, &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-1"}}).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []ec2types.Vpc{
+				{
+					CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
+						{
+							CidrBlock:      aws.String("192.168.0.0/16"),
+							CidrBlockState: &ec2types.VpcCidrBlockState{State: ec2types.VpcCidrBlockStateCodeAssociated},
+						},
+					},
+				},
+			},
+		}, nil)
+		associator = vpc.NewCIDRAssociator(fakeStackManager, p.MockEC2())
+		_, cidr, _ = net.ParseCIDR("100.64.0.0/16")
+	})
+
+	It("adds the CIDR block to the cluster stack", func() {
+		Expect(associator.Associate(context.Background(), cidr, false, false)).To(Succeed())
+
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+		_, options := fakeStackManager.UpdateStackArgsForCall(0)
+		Expect(options.StackName).To(Equal("eksctl-my-cluster-cluster"))
+		template := string(options.TemplateData.(manager.TemplateBody))
+		Expect(gjson.Get(template, "Resources.VPCCIDR100640016.Type").String()).To(Equal("AWS::EC2::VPCCidrBlock"))
+		Expect(gjson.Get(template, "Resources.VPCCIDR100640016.Properties.CidrBlock").String()).To(Equal("100.64.0.0/16"))
+		Expect(gjson.Get(template, "Resources.VPCCIDR100640016.Properties.VpcId.Ref").String()).To(Equal("VPC"))
+		Expect(gjson.Get(template, "Resources.SubnetVPCCIDR100640016USWEST2A").Exists()).To(BeFalse())
+	})
+
+	It("creates a private subnet per availability zone from the CIDR block", func() {
+		Expect(associator.Associate(context.Background(), cidr, true, false)).To(Succeed())
+
+		_, options := fakeStackManager.UpdateStackArgsForCall(0)
+		template := string(options.TemplateData.(manager.TemplateBody))
+		for zone, subnetCIDR := range map[string]string{"USWEST2A": "100.64.0.0/17", "USWEST2B": "100.64.128.0/17"} {
+			subnet := gjson.Get(template, "Resources.SubnetVPCCIDR100640016"+zone)
+			Expect(subnet.Get("Properties.CidrBlock").String()).To(Equal(subnetCIDR))
+			Expect(subnet.Get("DependsOn.0").String()).To(Equal("VPCCIDR100640016"))
+			association := gjson.Get(template, "Resources.RouteTableAssociationVPCCIDR100640016"+zone)
+			Expect(association.Get("Properties.RouteTableId.Ref").String()).To(Equal("PrivateRouteTable" + zone))
+			Expect(association.Get("Properties.SubnetId.Ref").String()).To(Equal("SubnetVPCCIDR100640016" + zone))
+		}
+		Expect(gjson.Get(template, "Resources.SubnetVPCCIDR100640016USWEST2A.Properties.AvailabilityZone").String()).To(Equal("us-west-2a"))
+	})
+
+	It("doesn't update the stack in plan mode", func() {
+		Expect(associator.Associate(context.Background(), cidr, true, true)).To(Succeed())
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+	})
+
+	It("rejects CIDR blocks overlapping those of the VPC", func() {
+		_, cidr, _ = net.ParseCIDR("192.168.64.0/18")
+		Expect(associator.Associate(context.Background(), cidr, false, false)).To(MatchError(`CIDR block 192.168.64.0/18 overlaps CIDR block 192.168.0.0/16 of VPC "vpc-1"`))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+	})
+
+	It("doesn't change anything when the CIDR block is already associated by the stack", func() {
+		fakeStackManager.GetStackTemplateReturns(`{"Resources": {"VPC": {}, "VPCCIDR100640016": {}}}`, nil)
+		Expect(associator.Associate(context.Background(), cidr, false, false)).To(Succeed())
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+	})
+
+	It("fails when the VPC wasn't created by eksctl", func() {
+		fakeStackManager.GetStackTemplateReturns(`{"Resources": {"ControlPlane": {}}}`, nil)
+		Expect(associator.Associate(context.Background(), cidr, false, false)).To(MatchError(ContainSubstring("the VPC of the cluster wasn't created by eksctl")))
+	})
+})
@@ -0,0 +1,11 @@
+package vpc_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestVPC(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -1,24 +1,27 @@
 package defaultaddons
 
 import (
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/pkg/errors"
-	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
 )
 
 type AddonInput struct {
 	RawClient           kubernetes.RawClientInterface
-	EKSAPI              eksiface.EKSAPI
+	EKSAPI              awsapi.EKS
 	ControlPlaneVersion string
 	Region              string
+	// ImageRepositoryOverride replaces the registry of the images, see api.ClusterConfig.ImageRepositoryOverride
+	ImageRepositoryOverride string
 }
 
 // DoAddonsSupportMultiArch checks if the coredns/kubeproxy/awsnode support multi arch nodegroups
 // We know that AWS node requires 1.6.3+ to work, so we check for that
 // Kubeproxy/coredns we don't know what version adds support, so we just ensure its up-to-date before proceeding.
 // TODO: we should know what versions of kubeproxy/coredns added support, rather than always erroring if they are out of date
-func DoAddonsSupportMultiArch(eksAPI eksiface.EKSAPI, rawClient kubernetes.RawClientInterface, controlPlaneVersion string, region string) (bool, error) {
+func DoAddonsSupportMultiArch(eksAPI awsapi.EKS, rawClient kubernetes.RawClientInterface, controlPlaneVersion string, region string) (bool, error) {
 	input := AddonInput{
 		RawClient:           rawClient,
 		ControlPlaneVersion: controlPlaneVersion,
@@ -110,6 +110,7 @@ func UpdateAWSNode(input AddonInput, plan bool) (bool, error) {
 			if err := addons.UseRegionalImage(&daemonSet.Spec.Template, input.Region); err != nil {
 				return false, err
 			}
+			addons.OverrideImageRepository(&daemonSet.Spec.Template, input.ImageRepositoryOverride)
 
 			containerTagMismatch, err := addons.ImageTagsDiffer(
 				container.Image,
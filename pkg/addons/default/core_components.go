@@ -0,0 +1,194 @@
+package defaultaddons
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// CoreComponentVersion holds the version of a core component running in the cluster, along with the version
+// recommended for the Kubernetes version of the cluster
+type CoreComponentVersion struct {
+	Name string
+	// CurrentVersion is empty if the component is not installed
+	CurrentVersion     string
+	RecommendedVersion string
+	// EKSAddon is the name of the EKS addon that manages the component, if it is installed as one
+	EKSAddon string
+}
+
+// UpToDate returns true if the component runs the recommended version
+func (c CoreComponentVersion) UpToDate() bool {
+	return c.CurrentVersion == c.RecommendedVersion
+}
+
+// Installed returns true if the component is running in the cluster
+func (c CoreComponentVersion) Installed() bool {
+	return c.CurrentVersion != ""
+}
+
+// coreComponentAddons maps the core components to the EKS addons that can manage them
+var coreComponentAddons = map[string]string{
+	AWSNode:   api.VPCCNIAddon,
+	CoreDNS:   api.CoreDNSAddon,
+	KubeProxy: api.KubeProxyAddon,
+}
+
+// ReportCoreComponents returns the running and recommended versions of aws-node, coredns and kube-proxy.
+// eksAddons holds the names of the EKS addons installed in the cluster
+func ReportCoreComponents(input AddonInput, eksAddons []string) ([]CoreComponentVersion, error) {
+	installedAddons := map[string]bool{}
+	for _, name := range eksAddons {
+		installedAddons[name] = true
+	}
+
+	type versionGetter func(AddonInput) (string, error)
+	components := []struct {
+		name        string
+		current     versionGetter
+		recommended versionGetter
+	}{
+		{name: AWSNode, current: currentAWSNodeVersion, recommended: recommendedAWSNodeVersion},
+		{name: CoreDNS, current: currentCoreDNSVersion, recommended: recommendedCoreDNSVersion},
+		{name: KubeProxy, current: currentKubeProxyVersion, recommended: getLatestKubeProxyImage},
+	}
+
+	var versions []CoreComponentVersion
+	for _, c := range components {
+		current, err := c.current(input)
+		if err != nil {
+			return nil, err
+		}
+		recommended, err := c.recommended(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting the recommended version of %q", c.name)
+		}
+		version := CoreComponentVersion{
+			Name:               c.name,
+			CurrentVersion:     current,
+			RecommendedVersion: recommended,
+		}
+		if addonName := coreComponentAddons[c.name]; installedAddons[addonName] {
+			version.EKSAddon = addonName
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// UpdateCoreComponents patches the self-managed manifests of the components that are installed but not up-to-date,
+// and returns true if an update is available in plan mode. Components managed by EKS addons are left for
+// `eksctl update addon` to update
+func UpdateCoreComponents(input AddonInput, versions []CoreComponentVersion, plan bool) (bool, error) {
+	updaters := map[string]func(AddonInput, bool) (bool, error){
+		AWSNode:   UpdateAWSNode,
+		CoreDNS:   UpdateCoreDNS,
+		KubeProxy: UpdateKubeProxy,
+	}
+
+	updateRequired := false
+	for _, v := range versions {
+		switch {
+		case !v.Installed():
+			logger.Info("%q is not installed, skipping", v.Name)
+		case v.EKSAddon != "":
+			logger.Info("%q is managed by the %q EKS addon, use `eksctl update addon` to update it", v.Name, v.EKSAddon)
+		case v.UpToDate():
+			logger.Info("%q is already up-to-date", v.Name)
+		default:
+			update, ok := updaters[v.Name]
+			if !ok {
+				return false, fmt.Errorf("unknown core component %q", v.Name)
+			}
+			required, err := update(input, plan)
+			if err != nil {
+				return false, errors.Wrapf(err, "updating %q", v.Name)
+			}
+			updateRequired = updateRequired || required
+		}
+	}
+	return updateRequired, nil
+}
+
+func currentAWSNodeVersion(input AddonInput) (string, error) {
+	daemonSet, err := input.RawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), AWSNode, metav1.GetOptions{})
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "getting %q", AWSNode)
+	}
+	return firstContainerImageTag(AWSNode, daemonSet.Spec.Template.Spec.Containers)
+}
+
+func currentCoreDNSVersion(input AddonInput) (string, error) {
+	deployment, err := input.RawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), CoreDNS, metav1.GetOptions{})
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "getting %q", CoreDNS)
+	}
+	return firstContainerImageTag(CoreDNS, deployment.Spec.Template.Spec.Containers)
+}
+
+func currentKubeProxyVersion(input AddonInput) (string, error) {
+	daemonSet, err := input.RawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), KubeProxy, metav1.GetOptions{})
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "getting %q", KubeProxy)
+	}
+	return firstContainerImageTag(KubeProxy, daemonSet.Spec.Template.Spec.Containers)
+}
+
+func recommendedAWSNodeVersion(input AddonInput) (string, error) {
+	list, err := newList(latestAWSNodeYaml)
+	if err != nil {
+		return "", err
+	}
+	for _, rawObj := range list.Items {
+		resource, err := input.RawClient.NewRawResource(rawObj.Object)
+		if err != nil {
+			return "", err
+		}
+		if daemonSet, ok := resource.Info.Object.(*appsv1.DaemonSet); ok {
+			return firstContainerImageTag(AWSNode, daemonSet.Spec.Template.Spec.Containers)
+		}
+	}
+	return "", fmt.Errorf("no DaemonSet found in the %q manifest", AWSNode)
+}
+
+func recommendedCoreDNSVersion(input AddonInput) (string, error) {
+	list, err := loadAssetCoreDNS(input.ControlPlaneVersion)
+	if err != nil {
+		return "", err
+	}
+	for _, rawObj := range list.Items {
+		resource, err := input.RawClient.NewRawResource(rawObj.Object)
+		if err != nil {
+			return "", err
+		}
+		if deployment, ok := resource.Info.Object.(*appsv1.Deployment); ok && resource.Info.Name == CoreDNS {
+			return firstContainerImageTag(CoreDNS, deployment.Spec.Template.Spec.Containers)
+		}
+	}
+	return "", fmt.Errorf("no Deployment found in the %q manifest", CoreDNS)
+}
+
+func firstContainerImageTag(name string, containers []corev1.Container) (string, error) {
+	if len(containers) == 0 {
+		return "", fmt.Errorf("%s has no containers", name)
+	}
+	return addons.ImageTag(containers[0].Image)
+}
@@ -0,0 +1,117 @@
+package defaultaddons_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	da "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("core components", func() {
+	var (
+		rawClient *testutils.FakeRawClient
+		input     da.AddonInput
+	)
+
+	BeforeEach(func() {
+		mockProvider := mockprovider.NewMockProvider()
+		mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
+			AddonName:         aws.String("kube-proxy"),
+			KubernetesVersion: aws.String("1.19"),
+		}).Return(&awseks.DescribeAddonVersionsOutput{
+			Addons: []ekstypes.AddonInfo{
+				{
+					AddonName: aws.String("kube-proxy"),
+					AddonVersions: []ekstypes.AddonVersionInfo{
+						{
+							AddonVersion: aws.String("v1.18.1-eksbuild.2"),
+						},
+					},
+				},
+			},
+		}, nil)
+
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.UseUnionTracker = true
+		for _, item := range testutils.LoadSamples("testdata/sample-1.19.json") {
+			resource, err := rawClient.NewRawResource(item)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = resource.CreateOrReplace(false)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		input = da.AddonInput{
+			RawClient:           rawClient,
+			EKSAPI:              mockProvider.EKS(),
+			ControlPlaneVersion: "1.19.1",
+			Region:              "eu-west-1",
+		}
+	})
+
+	Context("ReportCoreComponents", func() {
+		It("reports the running and recommended versions", func() {
+			versions, err := da.ReportCoreComponents(input, []string{"coredns"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(Equal([]da.CoreComponentVersion{
+				{
+					Name:               "aws-node",
+					CurrentVersion:     "v1.7.6",
+					RecommendedVersion: "v1.9.3",
+				},
+				{
+					Name:               "coredns",
+					CurrentVersion:     "v1.6.6",
+					RecommendedVersion: "v1.8.0-eksbuild.1",
+					EKSAddon:           "coredns",
+				},
+				{
+					Name:               "kube-proxy",
+					CurrentVersion:     "v1.19.1-eksbuild.1",
+					RecommendedVersion: "v1.19.1-eksbuild.1",
+				},
+			}))
+			Expect(versions[0].UpToDate()).To(BeFalse())
+			Expect(versions[2].UpToDate()).To(BeTrue())
+		})
+
+		It("reports components that are not installed", func() {
+			input.RawClient = testutils.NewFakeRawClient()
+
+			versions, err := da.ReportCoreComponents(input, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(HaveLen(3))
+			for _, v := range versions {
+				Expect(v.Installed()).To(BeFalse())
+				Expect(v.RecommendedVersion).NotTo(BeEmpty())
+			}
+		})
+	})
+
+	Context("UpdateCoreComponents", func() {
+		It("only updates the components that are out of date and not managed by EKS addons", func() {
+			versions, err := da.ReportCoreComponents(input, []string{"coredns"})
+			Expect(err).NotTo(HaveOccurred())
+
+			rawClient.ClearUpdated()
+			_, err = da.UpdateCoreComponents(input, versions, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rawClient.Collection.Updated()).To(HaveKey("PUT [/namespaces/kube-system/daemonsets/aws-node] (aws-node)"))
+			Expect(rawClient.Collection.Updated()).NotTo(HaveKey("PUT [/namespaces/kube-system/deployments/coredns] (coredns)"))
+		})
+
+		It("reports that an update is required in plan mode", func() {
+			versions, err := da.ReportCoreComponents(input, []string{"coredns"})
+			Expect(err).NotTo(HaveOccurred())
+
+			updateRequired, err := da.UpdateCoreComponents(input, versions, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updateRequired).To(BeTrue())
+		})
+	})
+})
@@ -65,6 +65,7 @@ func IsCoreDNSUpToDate(input AddonInput) (bool, error) {
 		if err := addons.UseRegionalImage(&deployment.Spec.Template, input.Region); err != nil {
 			return false, err
 		}
+		addons.OverrideImageRepository(&deployment.Spec.Template, input.ImageRepositoryOverride)
 		if computeType, ok := kubeDNSDeployment.Spec.Template.Annotations[coredns.ComputeTypeAnnotationKey]; ok {
 			deployment.Spec.Template.Annotations[coredns.ComputeTypeAnnotationKey] = computeType
 		}
@@ -126,6 +127,7 @@ func UpdateCoreDNS(input AddonInput, plan bool) (bool, error) {
 			if err := addons.UseRegionalImage(template, input.Region); err != nil {
 				return false, err
 			}
+			addons.OverrideImageRepository(template, input.ImageRepositoryOverride)
 			if computeType, ok := kubeDNSDeployment.Spec.Template.Annotations[coredns.ComputeTypeAnnotationKey]; ok {
 				if template.Annotations == nil {
 					template.Annotations = make(map[string]string)
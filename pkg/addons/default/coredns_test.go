@@ -69,6 +69,14 @@ var _ = Describe("default addons - coredns", func() {
 				Equal("602401143452.dkr.ecr." + region + ".amazonaws.com/eks/coredns:" + expectedImageTag),
 			)
 		})
+
+		It("uses the overridden image repository", func() {
+			input.ImageRepositoryOverride = "mycorp.jfrog.io/eks"
+			_, err := da.UpdateCoreDNS(input, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(coreDNSImage(rawClient)).To(Equal("mycorp.jfrog.io/eks/eks/coredns:" + expectedImageTag))
+		})
 	})
 
 	Context("IsCoreDNSUpToDate", func() {
@@ -6,9 +6,8 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/hashicorp/go-version"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
@@ -17,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/weaveworks/eksctl/pkg/addons"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
@@ -88,7 +88,9 @@ func UpdateKubeProxy(input AddonInput, plan bool) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	if imageParts[1] == desiredTag && hasArm64NodeSelector {
+	// the registry of the image is only changed when it's overridden, as it's otherwise regional
+	repositoryUpToDate := *image == addons.ImageWithRepository(*image, input.ImageRepositoryOverride)
+	if imageParts[1] == desiredTag && hasArm64NodeSelector && repositoryUpToDate {
 		logger.Debug("imageParts = %v, desiredTag = %s", imageParts, desiredTag)
 		logger.Info("%q is already up-to-date", KubeProxy)
 		return false, nil
@@ -100,7 +102,7 @@ func UpdateKubeProxy(input AddonInput, plan bool) (bool, error) {
 	}
 
 	imageParts[1] = desiredTag
-	*image = strings.Join(imageParts, ":")
+	*image = addons.ImageWithRepository(strings.Join(imageParts, ":"), input.ImageRepositoryOverride)
 
 	if err := printer.LogObj(logger.Debug, KubeProxy+" [updated] = \\\n%s\n", d); err != nil {
 		return false, err
@@ -157,7 +159,7 @@ func addArm64NodeSelector(daemonSet *v1.DaemonSet) error {
 
 func getLatestKubeProxyImage(input AddonInput) (string, error) {
 	defaultClusterVersion := generateImageVersionFromClusterVersion(input.ControlPlaneVersion)
-	latestEKSReportedVersion, err := getLatestImageVersionFromEKS(input.EKSAPI, input.ControlPlaneVersion)
+	latestEKSReportedVersion, err := getLatestImageVersionFromEKS(context.TODO(), input.EKSAPI, input.ControlPlaneVersion)
 	if err != nil {
 		return "", err
 	}
@@ -191,7 +193,7 @@ func generateImageVersionFromClusterVersion(controlPlaneVersion string) string {
 	return fmt.Sprintf("v%s-eksbuild.1", controlPlaneVersion)
 }
 
-func getLatestImageVersionFromEKS(eksAPI eksiface.EKSAPI, controlPlaneVersion string) (string, error) {
+func getLatestImageVersionFromEKS(ctx context.Context, eksAPI awsapi.EKS, controlPlaneVersion string) (string, error) {
 	controlPlaneMajorMinor, err := versionWithOnlyMajorAndMinor(controlPlaneVersion)
 	if err != nil {
 		return "", err
@@ -201,7 +203,7 @@ func getLatestImageVersionFromEKS(eksAPI eksiface.EKSAPI, controlPlaneVersion st
 		AddonName:         aws.String(KubeProxy),
 	}
 
-	addonInfos, err := eksAPI.DescribeAddonVersions(input)
+	addonInfos, err := eksAPI.DescribeAddonVersions(ctx, input)
 	if err != nil {
 		return "", fmt.Errorf("failed to describe addon versions: %v", err)
 	}
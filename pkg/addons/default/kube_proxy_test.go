@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	da "github.com/weaveworks/eksctl/pkg/addons/default"
@@ -32,14 +34,14 @@ var _ = Describe("KubeProxy", func() {
 				ControlPlaneVersion: "1.19.1",
 			}
 
-			mockProvider.MockEKS().On("DescribeAddonVersions", &awseks.DescribeAddonVersionsInput{
+			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
 				AddonName:         aws.String("kube-proxy"),
 				KubernetesVersion: aws.String("1.19"),
 			}).Return(&awseks.DescribeAddonVersionsOutput{
-				Addons: []*awseks.AddonInfo{
+				Addons: []ekstypes.AddonInfo{
 					{
 						AddonName: aws.String("kube-proxy"),
-						AddonVersions: []*awseks.AddonVersionInfo{
+						AddonVersions: []ekstypes.AddonVersionInfo{
 							{
 								AddonVersion: aws.String("v1.17.0-eksbuild.1"),
 							},
@@ -128,14 +130,14 @@ var _ = Describe("KubeProxy", func() {
 		When("nodeaffinity is not set on kube-proxy", func() {
 			BeforeEach(func() {
 				input.RawClient = testutils.NewFakeRawClientWithSamples("testdata/sample-old-kube-proxy-amd.json")
-				mockProvider.MockEKS().On("DescribeAddonVersions", &awseks.DescribeAddonVersionsInput{
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
 					AddonName:         aws.String("kube-proxy"),
 					KubernetesVersion: aws.String("1.19"),
 				}).Return(&awseks.DescribeAddonVersionsOutput{
-					Addons: []*awseks.AddonInfo{
+					Addons: []ekstypes.AddonInfo{
 						{
 							AddonName: aws.String("kube-proxy"),
-							AddonVersions: []*awseks.AddonVersionInfo{
+							AddonVersions: []ekstypes.AddonVersionInfo{
 								{
 									AddonVersion: aws.String("v1.17.0-eksbuild.1"),
 								},
@@ -159,14 +161,14 @@ var _ = Describe("KubeProxy", func() {
 
 		When("the version reported by EKS API is more up-to-date than the default cluster version", func() {
 			BeforeEach(func() {
-				mockProvider.MockEKS().On("DescribeAddonVersions", &awseks.DescribeAddonVersionsInput{
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
 					AddonName:         aws.String("kube-proxy"),
 					KubernetesVersion: aws.String("1.19"),
 				}).Return(&awseks.DescribeAddonVersionsOutput{
-					Addons: []*awseks.AddonInfo{
+					Addons: []ekstypes.AddonInfo{
 						{
 							AddonName: aws.String("kube-proxy"),
-							AddonVersions: []*awseks.AddonVersionInfo{
+							AddonVersions: []ekstypes.AddonVersionInfo{
 								{
 									AddonVersion: aws.String("v1.17.0-eksbuild.1"),
 								},
@@ -189,18 +191,30 @@ var _ = Describe("KubeProxy", func() {
 				Expect(kubeProxyImage(clientSet)).To(Equal("602401143452.dkr.ecr.eu-west-1.amazonaws.com/eks/kube-proxy:v1.19.1-eksbuild.2"))
 				Expect(kubeProxyNodeSelectorValues(clientSet)).To(ConsistOf("amd64", "arm64"))
 			})
+
+			It("uses the overridden image repository", func() {
+				input.ImageRepositoryOverride = "mycorp.jfrog.io/eks"
+				_, err := da.UpdateKubeProxy(input, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeProxyImage(clientSet)).To(Equal("mycorp.jfrog.io/eks/eks/kube-proxy:v1.19.1-eksbuild.2"))
+
+				By("not updating it again")
+				needsUpdate, err := da.UpdateKubeProxy(input, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(needsUpdate).To(BeFalse())
+			})
 		})
 
 		When("the version reported by EKS API is behind the default cluster version", func() {
 			BeforeEach(func() {
-				mockProvider.MockEKS().On("DescribeAddonVersions", &awseks.DescribeAddonVersionsInput{
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
 					AddonName:         aws.String("kube-proxy"),
 					KubernetesVersion: aws.String("1.19"),
 				}).Return(&awseks.DescribeAddonVersionsOutput{
-					Addons: []*awseks.AddonInfo{
+					Addons: []ekstypes.AddonInfo{
 						{
 							AddonName: aws.String("kube-proxy"),
-							AddonVersions: []*awseks.AddonVersionInfo{
+							AddonVersions: []ekstypes.AddonVersionInfo{
 								{
 									AddonVersion: aws.String("v1.17.0-eksbuild.1"),
 								},
@@ -227,14 +241,14 @@ var _ = Describe("KubeProxy", func() {
 
 		When("there are no versions returned", func() {
 			BeforeEach(func() {
-				mockProvider.MockEKS().On("DescribeAddonVersions", &awseks.DescribeAddonVersionsInput{
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
 					AddonName:         aws.String("kube-proxy"),
 					KubernetesVersion: aws.String("1.19"),
 				}).Return(&awseks.DescribeAddonVersionsOutput{
-					Addons: []*awseks.AddonInfo{
+					Addons: []ekstypes.AddonInfo{
 						{
 							AddonName:     aws.String("kube-proxy"),
-							AddonVersions: []*awseks.AddonVersionInfo{},
+							AddonVersions: []ekstypes.AddonVersionInfo{},
 						},
 					},
 				}, nil)
@@ -248,14 +262,14 @@ var _ = Describe("KubeProxy", func() {
 
 		When("there are no valid versions returned", func() {
 			BeforeEach(func() {
-				mockProvider.MockEKS().On("DescribeAddonVersions", &awseks.DescribeAddonVersionsInput{
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
 					AddonName:         aws.String("kube-proxy"),
 					KubernetesVersion: aws.String("1.19"),
 				}).Return(&awseks.DescribeAddonVersionsOutput{
-					Addons: []*awseks.AddonInfo{
+					Addons: []ekstypes.AddonInfo{
 						{
 							AddonName: aws.String("kube-proxy"),
-							AddonVersions: []*awseks.AddonVersionInfo{
+							AddonVersions: []ekstypes.AddonVersionInfo{
 								{
 									AddonVersion: aws.String("not-a.1valid-version!?"),
 								},
@@ -273,7 +287,7 @@ var _ = Describe("KubeProxy", func() {
 
 		When("describing the addon errors", func() {
 			BeforeEach(func() {
-				mockProvider.MockEKS().On("DescribeAddonVersions", &awseks.DescribeAddonVersionsInput{
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, &awseks.DescribeAddonVersionsInput{
 					AddonName:         aws.String("kube-proxy"),
 					KubernetesVersion: aws.String("1.19"),
 				}).Return(&awseks.DescribeAddonVersionsOutput{}, fmt.Errorf("foo"))
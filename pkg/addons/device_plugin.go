@@ -31,11 +31,7 @@ var nvidiaDevicePluginYaml []byte
 
 func useRegionalImage(spec *v1.PodTemplateSpec, region string, account string) error {
 	imageFormat := spec.Spec.Containers[0].Image
-	dnsSuffix, err := awsDNSSuffixForRegion(region)
-	if err != nil {
-		return err
-	}
-	regionalImage := fmt.Sprintf(imageFormat, account, region, dnsSuffix)
+	regionalImage := fmt.Sprintf(imageFormat, account, region, awsDNSSuffixForRegion(region))
 	spec.Spec.Containers[0].Image = regionalImage
 	return nil
 }
@@ -72,7 +68,7 @@ func watchDaemonSetReady(dsClientSet clientappsv1.DaemonSetInterface, dsName str
 	}
 }
 
-type MkDevicePlugin func(rawClient kubernetes.RawClientInterface, region string, planMode bool) DevicePlugin
+type MkDevicePlugin func(rawClient kubernetes.RawClientInterface, region string, planMode bool, imageRepositoryOverride string) DevicePlugin
 
 type DevicePlugin interface {
 	RawClient() kubernetes.RawClientInterface
@@ -124,19 +120,21 @@ func applyDevicePlugin(dp DevicePlugin) error {
 }
 
 // NewNeuronDevicePlugin creates a new NeuronDevicePlugin
-func NewNeuronDevicePlugin(rawClient kubernetes.RawClientInterface, region string, planMode bool) DevicePlugin {
+func NewNeuronDevicePlugin(rawClient kubernetes.RawClientInterface, region string, planMode bool, imageRepositoryOverride string) DevicePlugin {
 	return &NeuronDevicePlugin{
 		rawClient,
 		region,
 		planMode,
+		imageRepositoryOverride,
 	}
 }
 
 // A NeuronDevicePlugin deploys the Neuron Device Plugin to a cluster
 type NeuronDevicePlugin struct {
-	rawClient kubernetes.RawClientInterface
-	region    string
-	planMode  bool
+	rawClient               kubernetes.RawClientInterface
+	region                  string
+	planMode                bool
+	imageRepositoryOverride string
 }
 
 func (n *NeuronDevicePlugin) RawClient() kubernetes.RawClientInterface {
@@ -152,6 +150,7 @@ func (n *NeuronDevicePlugin) Manifest() []byte {
 }
 
 func (n *NeuronDevicePlugin) SetImage(t *v1.PodTemplateSpec) error {
+	OverrideImageRepository(t, n.imageRepositoryOverride)
 	return nil
 }
 
@@ -161,19 +160,21 @@ func (n *NeuronDevicePlugin) Deploy() error {
 }
 
 // NewNvidiaDevicePlugin creates a new NvidiaDevicePlugin
-func NewNvidiaDevicePlugin(rawClient kubernetes.RawClientInterface, region string, planMode bool) DevicePlugin {
+func NewNvidiaDevicePlugin(rawClient kubernetes.RawClientInterface, region string, planMode bool, imageRepositoryOverride string) DevicePlugin {
 	return &NvidiaDevicePlugin{
 		rawClient,
 		region,
 		planMode,
+		imageRepositoryOverride,
 	}
 }
 
 // A NvidiaDevicePlugin deploys the Nvidia Device Plugin to a cluster
 type NvidiaDevicePlugin struct {
-	rawClient kubernetes.RawClientInterface
-	region    string
-	planMode  bool
+	rawClient               kubernetes.RawClientInterface
+	region                  string
+	planMode                bool
+	imageRepositoryOverride string
 }
 
 func (n *NvidiaDevicePlugin) RawClient() kubernetes.RawClientInterface {
@@ -185,6 +186,7 @@ func (n *NvidiaDevicePlugin) PlanMode() bool {
 }
 
 func (n *NvidiaDevicePlugin) SetImage(t *v1.PodTemplateSpec) error {
+	OverrideImageRepository(t, n.imageRepositoryOverride)
 	return nil
 }
 
@@ -199,9 +201,10 @@ func (n *NvidiaDevicePlugin) Deploy() error {
 
 // A EFADevicePlugin deploys the EFA Device Plugin to a cluster
 type EFADevicePlugin struct {
-	rawClient kubernetes.RawClientInterface
-	region    string
-	planMode  bool
+	rawClient               kubernetes.RawClientInterface
+	region                  string
+	planMode                bool
+	imageRepositoryOverride string
 }
 
 func (n *EFADevicePlugin) RawClient() kubernetes.RawClientInterface {
@@ -218,15 +221,20 @@ func (n *EFADevicePlugin) Manifest() []byte {
 
 func (n *EFADevicePlugin) SetImage(t *v1.PodTemplateSpec) error {
 	account := api.EKSResourceAccountID(n.region)
-	return useRegionalImage(t, n.region, account)
+	if err := useRegionalImage(t, n.region, account); err != nil {
+		return err
+	}
+	OverrideImageRepository(t, n.imageRepositoryOverride)
+	return nil
 }
 
 // NewEFADevicePlugin creates a new EFADevicePlugin
-func NewEFADevicePlugin(rawClient kubernetes.RawClientInterface, region string, planMode bool) DevicePlugin {
+func NewEFADevicePlugin(rawClient kubernetes.RawClientInterface, region string, planMode bool, imageRepositoryOverride string) DevicePlugin {
 	return &EFADevicePlugin{
 		rawClient,
 		region,
 		planMode,
+		imageRepositoryOverride,
 	}
 }
 
@@ -4,29 +4,20 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws/endpoints"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	corev1 "k8s.io/api/core/v1"
 )
 
 // awsDNSSuffixForRegion returns the AWS DNS suffix (amazonaws.com or amazonaws.com.cn) for the specified region
-func awsDNSSuffixForRegion(region string) (string, error) {
-	for _, p := range endpoints.DefaultPartitions() {
-		if _, ok := p.Regions()[region]; ok {
-			return p.DNSSuffix(), nil
-		}
-	}
-	return "", fmt.Errorf("failed to find DNS suffix for region %s", region)
+func awsDNSSuffixForRegion(region string) string {
+	return api.DNSSuffix(api.Partition(region))
 }
 
 // UseRegionalImage sets the region and AWS DNS suffix for a container image
 // in format '%s.dkr.ecr.%s.%s/image:tag'
 func UseRegionalImage(spec *corev1.PodTemplateSpec, region string) error {
 	imageFormat := spec.Spec.Containers[0].Image
-	dnsSuffix, err := awsDNSSuffixForRegion(region)
-	if err != nil {
-		return err
-	}
+	dnsSuffix := awsDNSSuffixForRegion(region)
 	regionalImage := fmt.Sprintf(imageFormat, api.EKSResourceAccountID(region), region, dnsSuffix)
 	spec.Spec.Containers[0].Image = regionalImage
 
@@ -61,3 +52,32 @@ func ImageTagsDiffer(image1, image2 string) (bool, error) {
 	}
 	return tag1 != tag2, nil
 }
+
+// ImageWithRepository replaces the registry of image with repository, keeping the image path and tag,
+// e.g. 602401143452.dkr.ecr.us-west-2.amazonaws.com/eks/coredns:v1.8.4 becomes mycorp.jfrog.io/eks/eks/coredns:v1.8.4
+// for repository mycorp.jfrog.io/eks. The image is returned as is when repository is empty or already its registry
+func ImageWithRepository(image, repository string) string {
+	if repository == "" || strings.HasPrefix(image, repository+"/") {
+		return image
+	}
+	parts := strings.SplitN(image, "/", 2)
+	// the first component is only a registry if it's a host, images of Docker Hub don't have one
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return repository + "/" + parts[1]
+	}
+	return repository + "/" + image
+}
+
+// OverrideImageRepository sets the registry of the images of all containers and init containers to repository,
+// see ImageWithRepository
+func OverrideImageRepository(spec *corev1.PodTemplateSpec, repository string) {
+	if repository == "" {
+		return
+	}
+	for i := range spec.Spec.InitContainers {
+		spec.Spec.InitContainers[i].Image = ImageWithRepository(spec.Spec.InitContainers[i].Image, repository)
+	}
+	for i := range spec.Spec.Containers {
+		spec.Spec.Containers[i].Image = ImageWithRepository(spec.Spec.Containers[i].Image, repository)
+	}
+}
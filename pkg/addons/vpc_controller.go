@@ -53,23 +53,25 @@ const (
 )
 
 // NewVPCController creates a new VPCController
-func NewVPCController(rawClient kubernetes.RawClientInterface, irsa IRSAHelper, clusterStatus *api.ClusterStatus, region string, planMode bool) *VPCController {
+func NewVPCController(rawClient kubernetes.RawClientInterface, irsa IRSAHelper, clusterStatus *api.ClusterStatus, region, imageRepositoryOverride string, planMode bool) *VPCController {
 	return &VPCController{
-		rawClient:     rawClient,
-		irsa:          irsa,
-		clusterStatus: clusterStatus,
-		region:        region,
-		planMode:      planMode,
+		rawClient:               rawClient,
+		irsa:                    irsa,
+		clusterStatus:           clusterStatus,
+		region:                  region,
+		imageRepositoryOverride: imageRepositoryOverride,
+		planMode:                planMode,
 	}
 }
 
 // A VPCController deploys Windows VPC controller to a cluster
 type VPCController struct {
-	rawClient     kubernetes.RawClientInterface
-	irsa          IRSAHelper
-	clusterStatus *api.ClusterStatus
-	region        string
-	planMode      bool
+	rawClient               kubernetes.RawClientInterface
+	irsa                    IRSAHelper
+	clusterStatus           *api.ClusterStatus
+	region                  string
+	imageRepositoryOverride string
+	planMode                bool
 }
 
 // Deploy deploys VPC controller to the specified cluster
@@ -361,6 +363,7 @@ func (v *VPCController) applyDeployment(manifests []byte) error {
 	if err := UseRegionalImage(&deployment.Spec.Template, v.region); err != nil {
 		return err
 	}
+	OverrideImageRepository(&deployment.Spec.Template, v.imageRepositoryOverride)
 	return v.applyRawResource(rawExtension.Object)
 }
 
@@ -0,0 +1,113 @@
+package ami
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	imagebuildertypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
+)
+
+// ImageBuilderImage is an AMI built by an EC2 Image Builder pipeline
+type ImageBuilderImage struct {
+	// ID of the AMI
+	ID string
+	// BuildVersionARN is the ARN of the image build version that produced the AMI
+	BuildVersionARN string
+}
+
+// ImageBuilderResolver resolves the AMI to the latest image built by an EC2 Image Builder pipeline
+type ImageBuilderResolver struct {
+	imageBuilder awsapi.ImageBuilder
+	ec2API       awsapi.EC2
+}
+
+// NewImageBuilderResolver creates a new ImageBuilderResolver
+func NewImageBuilderResolver(imageBuilder awsapi.ImageBuilder, ec2API awsapi.EC2) *ImageBuilderResolver {
+	return &ImageBuilderResolver{
+		imageBuilder: imageBuilder,
+		ec2API:       ec2API,
+	}
+}
+
+// Resolve returns the latest available image built by the pipeline that has an AMI in the region, and checks
+// that the image matches the architecture of the instance type and the platform of the image family
+func (r *ImageBuilderResolver) Resolve(ctx context.Context, region, pipelineARN, instanceType, imageFamily string) (*ImageBuilderImage, error) {
+	logger.Debug("resolving AMI using Image Builder pipeline %s for region %s, instanceType %s and imageFamily %s", pipelineARN, region, instanceType, imageFamily)
+
+	var (
+		latest   *imagebuildertypes.ImageSummary
+		latestID string
+	)
+	paginator := imagebuilder.NewListImagePipelineImagesPaginator(r.imageBuilder, &imagebuilder.ListImagePipelineImagesInput{
+		ImagePipelineArn: aws.String(pipelineARN),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing images of Image Builder pipeline %q: %w", pipelineARN, err)
+		}
+		for i, image := range output.ImageSummaryList {
+			if image.State == nil || image.State.Status != imagebuildertypes.ImageStatusAvailable {
+				continue
+			}
+			id := findRegionalAMI(image, region)
+			// creation dates are ISO 8601 timestamps in UTC, so they sort lexically
+			if id != "" && (latest == nil || aws.ToString(image.DateCreated) > aws.ToString(latest.DateCreated)) {
+				latest, latestID = &output.ImageSummaryList[i], id
+			}
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no available image with an AMI in %s was built by Image Builder pipeline %q", region, pipelineARN)
+	}
+
+	isWindowsImage := latest.Platform == imagebuildertypes.PlatformWindows
+	if isWindowsImage != api.IsWindowsImage(imageFamily) {
+		return nil, fmt.Errorf("image %q built by Image Builder pipeline %q is a %s image and cannot be used with image family %s",
+			aws.ToString(latest.Arn), pipelineARN, latest.Platform, imageFamily)
+	}
+
+	output, err := r.ec2API.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		ImageIds: []string{latestID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to find image %q: %w", latestID, err)
+	}
+	if len(output.Images) < 1 {
+		return nil, NewErrNotFound(latestID)
+	}
+	expectedArchitecture := ec2types.ArchitectureValuesX8664
+	if instanceutils.IsARMInstanceType(instanceType) {
+		expectedArchitecture = ec2types.ArchitectureValuesArm64
+	}
+	if architecture := output.Images[0].Architecture; architecture != expectedArchitecture {
+		return nil, fmt.Errorf("AMI %q built by Image Builder pipeline %q has architecture %s, but instance type %s requires %s",
+			latestID, pipelineARN, architecture, instanceType, expectedArchitecture)
+	}
+
+	return &ImageBuilderImage{
+		ID:              latestID,
+		BuildVersionARN: aws.ToString(latest.Arn),
+	}, nil
+}
+
+func findRegionalAMI(image imagebuildertypes.ImageSummary, region string) string {
+	if image.OutputResources == nil {
+		return ""
+	}
+	for _, ami := range image.OutputResources.Amis {
+		if aws.ToString(ami.Region) == region {
+			return aws.ToString(ami.Image)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,123 @@
+package ami_test
+
+import (
+	"context"
+
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	imagebuildertypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+const pipelineARN = "arn:aws:imagebuilder:us-west-2:123456789012:image-pipeline/eks-nodes"
+
+func imageSummary(version string, status imagebuildertypes.ImageStatus, dateCreated, amiID string) imagebuildertypes.ImageSummary {
+	return imagebuildertypes.ImageSummary{
+		Arn:         aws.String("arn:aws:imagebuilder:us-west-2:123456789012:image/eks-nodes/" + version),
+		DateCreated: aws.String(dateCreated),
+		Platform:    imagebuildertypes.PlatformLinux,
+		State:       &imagebuildertypes.ImageState{Status: status},
+		OutputResources: &imagebuildertypes.OutputResources{
+			Amis: []imagebuildertypes.Ami{
+				{Region: aws.String("eu-west-1"), Image: aws.String(amiID + "-eu")},
+				{Region: aws.String("us-west-2"), Image: aws.String(amiID)},
+			},
+		},
+	}
+}
+
+var _ = Describe("Image Builder AMI resolution", func() {
+	var p *mockprovider.MockProvider
+
+	// mockPages makes the pipeline list its images in pages
+	mockPages := func(pages ...[]imagebuildertypes.ImageSummary) {
+		for i, page := range pages {
+			var token, nextToken *string
+			if i > 0 {
+				token = aws.String(fmt.Sprintf("page-%d", i))
+			}
+			if i < len(pages)-1 {
+				nextToken = aws.String(fmt.Sprintf("page-%d", i+1))
+			}
+			p.MockImageBuilder().On("ListImagePipelineImages", mock.Anything, &imagebuilder.ListImagePipelineImagesInput{
+				ImagePipelineArn: aws.String(pipelineARN),
+				NextToken:        token,
+			}, mock.Anything).Return(&imagebuilder.ListImagePipelineImagesOutput{
+				ImageSummaryList: page,
+				NextToken:        nextToken,
+			}, nil)
+		}
+	}
+
+	// mockImages makes the pipeline list a failed image and two available ones, the latest of them on the second page
+	mockImages := func() {
+		mockPages(
+			[]imagebuildertypes.ImageSummary{
+				imageSummary("1.0.0/1", imagebuildertypes.ImageStatusAvailable, "2022-05-01T10:00:00.000Z", "ami-1"),
+				imageSummary("1.0.0/3", imagebuildertypes.ImageStatusFailed, "2022-05-03T10:00:00.000Z", "ami-3"),
+			},
+			[]imagebuildertypes.ImageSummary{
+				imageSummary("1.0.0/2", imagebuildertypes.ImageStatusAvailable, "2022-05-02T10:00:00.000Z", "ami-2"),
+			},
+		)
+	}
+
+	mockArchitecture := func(amiID string, architecture ec2types.ArchitectureValues) {
+		p.MockEC2().On("DescribeImages", mock.Anything, &ec2.DescribeImagesInput{ImageIds: []string{amiID}}).
+			Return(&ec2.DescribeImagesOutput{Images: []ec2types.Image{{ImageId: aws.String(amiID), Architecture: architecture}}}, nil)
+	}
+
+	resolve := func(instanceType, imageFamily string) (*ImageBuilderImage, error) {
+		return NewImageBuilderResolver(p.MockImageBuilder(), p.MockEC2()).Resolve(context.Background(), "us-west-2", pipelineARN, instanceType, imageFamily)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+	})
+
+	It("resolves the latest available image in the region", func() {
+		mockImages()
+		mockArchitecture("ami-2", ec2types.ArchitectureValuesX8664)
+
+		image, err := resolve("m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(image).To(Equal(&ImageBuilderImage{
+			ID:              "ami-2",
+			BuildVersionARN: "arn:aws:imagebuilder:us-west-2:123456789012:image/eks-nodes/1.0.0/2",
+		}))
+	})
+
+	It("fails when the AMI architecture does not match the instance type", func() {
+		mockImages()
+		mockArchitecture("ami-2", ec2types.ArchitectureValuesX8664)
+
+		_, err := resolve("m6g.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).To(MatchError(ContainSubstring(`AMI "ami-2" built by Image Builder pipeline "` + pipelineARN + `" has architecture x86_64, but instance type m6g.large requires arm64`)))
+	})
+
+	It("fails when the image platform does not match the AMI family", func() {
+		mockImages()
+
+		_, err := resolve("m5.large", api.NodeImageFamilyWindowsServer2019CoreContainer)
+		Expect(err).To(MatchError(ContainSubstring("is a Linux image and cannot be used with image family WindowsServer2019CoreContainer")))
+	})
+
+	It("fails when the pipeline has no available image in the region", func() {
+		mockPages([]imagebuildertypes.ImageSummary{
+			imageSummary("1.0.0/3", imagebuildertypes.ImageStatusFailed, "2022-05-03T10:00:00.000Z", "ami-3"),
+		})
+
+		_, err := resolve("m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).To(MatchError(`no available image with an AMI in us-west-2 was built by Image Builder pipeline "` + pipelineARN + `"`))
+	})
+})
@@ -0,0 +1,104 @@
+package v1alpha5
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// Values for `AuthenticationMode`
+const (
+	// AuthenticationModeConfigMap only authenticates IAM principals mapped in the aws-auth ConfigMap
+	AuthenticationModeConfigMap = "CONFIG_MAP"
+	// AuthenticationModeAPIAndConfigMap authenticates IAM principals with access entries or mapped in the aws-auth ConfigMap
+	AuthenticationModeAPIAndConfigMap = "API_AND_CONFIG_MAP"
+	// AuthenticationModeAPI only authenticates IAM principals with access entries
+	AuthenticationModeAPI = "API"
+)
+
+// AuthenticationModes returns the authentication modes in the only order EKS allows switching between them
+func AuthenticationModes() []string {
+	return []string{AuthenticationModeConfigMap, AuthenticationModeAPIAndConfigMap, AuthenticationModeAPI}
+}
+
+// AccessConfig holds the configuration of how IAM principals are granted access to the cluster
+type AccessConfig struct {
+	// AuthenticationMode selects the sources of the IAM principals the cluster authenticates,
+	// use `eksctl utils update-authentication-mode` to change it for an existing cluster.
+	// Valid variants are `AuthenticationMode` constants
+	// +optional
+	AuthenticationMode string `json:"authenticationMode,omitempty"`
+	// BootstrapClusterCreatorAdminPermissions grants the IAM identity that creates the cluster admin permissions
+	// through an access entry. It can only be set when the cluster is created, set it to `false` so that only
+	// `clusterAdminARNs` have admin permissions
+	// +optional
+	BootstrapClusterCreatorAdminPermissions *bool `json:"bootstrapClusterCreatorAdminPermissions,omitempty"`
+	// ClusterAdminARNs are the ARNs of the IAM principals that get an access entry associated with
+	// AmazonEKSClusterAdminPolicy for the whole cluster when it's created
+	// +optional
+	ClusterAdminARNs []string `json:"clusterAdminARNs,omitempty"`
+}
+
+// BootstrapsClusterCreatorAdmin returns false if the IAM identity that creates the cluster mustn't get admin permissions
+func (c *ClusterConfig) BootstrapsClusterCreatorAdmin() bool {
+	return c.AccessConfig == nil || !IsDisabled(c.AccessConfig.BootstrapClusterCreatorAdminPermissions)
+}
+
+// AuthenticationModeIndex returns the position of mode in AuthenticationModes, or -1 if it isn't a valid mode
+func AuthenticationModeIndex(mode string) int {
+	for i, m := range AuthenticationModes() {
+		if m == mode {
+			return i
+		}
+	}
+	return -1
+}
+
+// ValidateAuthenticationModeUpdate returns an error unless the authentication mode of a cluster can safely be switched
+// from current to mode. EKS only allows switching in the order of AuthenticationModes, and eksctl requires going
+// through API_AND_CONFIG_MAP so that access entries can be created before the aws-auth ConfigMap is disabled
+func ValidateAuthenticationModeUpdate(current, mode string) error {
+	from, to := AuthenticationModeIndex(current), AuthenticationModeIndex(mode)
+	switch {
+	case to < 0:
+		return fmt.Errorf("authentication mode must be one of %v, got %q", AuthenticationModes(), mode)
+	case to < from:
+		return fmt.Errorf("cannot switch the authentication mode from %s back to %s", current, mode)
+	case to-from > 1:
+		return fmt.Errorf("cannot switch the authentication mode from %s to %s directly, switch to %s and create access entries for the cluster admins first",
+			current, mode, AuthenticationModeAPIAndConfigMap)
+	}
+	return nil
+}
+
+func (c *ClusterConfig) validateAccessConfig() error {
+	if c.AccessConfig == nil {
+		return nil
+	}
+	mode := c.AccessConfig.AuthenticationMode
+	if mode != "" && AuthenticationModeIndex(mode) < 0 {
+		return fmt.Errorf("accessConfig.authenticationMode must be one of %v, got %q", AuthenticationModes(), mode)
+	}
+	if !c.BootstrapsClusterCreatorAdmin() && len(c.AccessConfig.ClusterAdminARNs) == 0 {
+		return fmt.Errorf("accessConfig.clusterAdminARNs must be set when accessConfig.bootstrapClusterCreatorAdminPermissions is disabled, " +
+			"or the cluster would have no admin")
+	}
+	if (!c.BootstrapsClusterCreatorAdmin() || len(c.AccessConfig.ClusterAdminARNs) > 0) && (mode == "" || mode == AuthenticationModeConfigMap) {
+		return fmt.Errorf("accessConfig.authenticationMode must be %s or %s to use access entries for the cluster admins",
+			AuthenticationModeAPI, AuthenticationModeAPIAndConfigMap)
+	}
+	for i, principalARN := range c.AccessConfig.ClusterAdminARNs {
+		if parsed, err := arn.Parse(principalARN); err != nil || (parsed.Service != "iam" && parsed.Service != "sts") {
+			return fmt.Errorf("accessConfig.clusterAdminARNs[%d]: %q is not the ARN of an IAM principal", i, principalARN)
+		}
+	}
+	if mode == AuthenticationModeAPI {
+		for _, ng := range c.NodeGroups {
+			if !c.SkipsAWSAuthConfigMap(ng) {
+				return fmt.Errorf("accessConfig.authenticationMode %s disables the aws-auth ConfigMap that the nodes of nodegroup %q need to join the cluster, use %s instead",
+					AuthenticationModeAPI, ng.Name, AuthenticationModeAPIAndConfigMap)
+			}
+		}
+	}
+	return nil
+}
@@ -28,6 +28,15 @@ type Addon struct {
 	// Each tag consists of a key and an optional value, both of which you define.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+	// ConfigurationValues is a JSON or YAML document of settings supported by the addon's configuration schema,
+	// see `aws eks describe-addon-configuration`
+	// +optional
+	ConfigurationValues string `json:"configurationValues,omitempty"`
+	// BeforeNodes creates the addon before any nodegroups are created during cluster creation, so that
+	// configuration changes (e.g. vpc-cni prefix delegation) apply to the first nodes that launch.
+	// vpc-cni is always created before nodegroups.
+	// +optional
+	BeforeNodes bool `json:"beforeNodes,omitempty"`
 	// Force applies the add-on to overwrite an existing add-on
 	Force bool `json:"-"`
 }
@@ -36,6 +45,11 @@ func (a Addon) CanonicalName() string {
 	return strings.ToLower(a.Name)
 }
 
+// IsBeforeNodes returns whether the addon is created before nodegroups during cluster creation
+func (a Addon) IsBeforeNodes() bool {
+	return a.BeforeNodes || a.CanonicalName() == "vpc-cni"
+}
+
 func (a Addon) Validate() error {
 	if a.Name == "" {
 		return fmt.Errorf("name required")
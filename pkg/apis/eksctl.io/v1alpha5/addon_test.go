@@ -59,4 +59,12 @@ var _ = Describe("Addon", func() {
 			})
 		})
 	})
+
+	Describe("IsBeforeNodes", func() {
+		It("is true for vpc-cni and addons with beforeNodes set", func() {
+			Expect(v1alpha5.Addon{Name: "vpc-cni"}.IsBeforeNodes()).To(BeTrue())
+			Expect(v1alpha5.Addon{Name: "kube-proxy", BeforeNodes: true}.IsBeforeNodes()).To(BeTrue())
+			Expect(v1alpha5.Addon{Name: "coredns"}.IsBeforeNodes()).To(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,84 @@
+package v1alpha5
+
+// DefaultBootstrapRetries is the default number of times applying a bootstrap manifest or chart is retried
+const DefaultBootstrapRetries = 3
+
+// Bootstrap holds Kubernetes resources applied once the cluster and its nodegroups are ready,
+// so that baseline controllers are installed in the same invocation that creates the cluster.
+//
+// Manifests and the string values of charts are rendered as Go templates that can reference the resources created
+// by eksctl, such as `{{ .ClusterName }}` or `{{ serviceAccountRoleARN "<namespace>/<name>" }}`,
+// see [Helm charts](/usage/helm-charts/)
+type Bootstrap struct {
+	// Manifests are applied in the order they are listed, before HelmCharts
+	// +optional
+	Manifests []*BootstrapManifest `json:"manifests,omitempty"`
+
+	// HelmCharts are installed in the order they are listed, after Manifests
+	// +optional
+	HelmCharts []*BootstrapHelmChart `json:"helmCharts,omitempty"`
+
+	// Retries is the number of times applying a manifest or installing a chart is retried before giving up
+	// Defaults to `3`
+	// +optional
+	Retries *int `json:"retries,omitempty"`
+}
+
+// BootstrapManifest is a Kubernetes manifest to apply, exactly one of `file`, `url` or `content` must be set
+type BootstrapManifest struct {
+	// Name identifies the manifest in logs and errors
+	// +required
+	Name string `json:"name"`
+
+	// File is the path of the manifest, relative paths are resolved against the directory of the config file
+	// +optional
+	File string `json:"file,omitempty"`
+
+	// URL is an HTTP(S) URL of the manifest
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Content holds the manifest inline
+	// +optional
+	Content string `json:"content,omitempty"`
+
+	// Document holds the manifest loaded from File, URL or Content
+	Document string `json:"-"`
+}
+
+// BootstrapHelmChart is a Helm chart to install
+type BootstrapHelmChart struct {
+	// Name of the release
+	// +required
+	Name string `json:"name"`
+
+	// Repo is the URL of the chart repository
+	// +required
+	Repo string `json:"repo"`
+
+	// Chart is the name of the chart in the repository
+	// +required
+	Chart string `json:"chart"`
+
+	// Version of the chart
+	// +required
+	Version string `json:"version"`
+
+	// Namespace to install the release in, it is created if it doesn't exist
+	// Defaults to `default`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Values to install the chart with
+	// +optional
+	Values InlineDocument `json:"values,omitempty"`
+
+	// Verification pins the digest of the chart archive and verifies its signature
+	// +optional
+	Verification *ArtifactVerification `json:"verification,omitempty"`
+}
+
+// HasBootstrap returns true if the config declares resources to apply after the cluster is created
+func (c *ClusterConfig) HasBootstrap() bool {
+	return c.Bootstrap != nil && (len(c.Bootstrap.Manifests) > 0 || len(c.Bootstrap.HelmCharts) > 0)
+}
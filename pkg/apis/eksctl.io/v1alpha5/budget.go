@@ -0,0 +1,129 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Values for `BudgetTimeUnit`
+const (
+	BudgetTimeUnitDaily     = "DAILY"
+	BudgetTimeUnitMonthly   = "MONTHLY"
+	BudgetTimeUnitQuarterly = "QUARTERLY"
+	BudgetTimeUnitAnnually  = "ANNUALLY"
+)
+
+const (
+	// DefaultBudgetUnit is the currency of budgets
+	DefaultBudgetUnit = "USD"
+
+	// the limits of AWS Budgets on the notifications of a budget and the email subscribers of a notification
+	maxBudgetThresholds = 5
+	maxBudgetEmails     = 10
+)
+
+// DefaultBudgetThresholds are the percentages of the budgeted amount at which subscribers are notified
+var DefaultBudgetThresholds = []float64{80, 100}
+
+// ClusterBudget is an AWS Budgets cost budget for the resources of the cluster, created with the cluster. Its
+// subscribers are notified by email or through an SNS topic when the costs of the resources tagged with the
+// cost-allocation tag of the cluster exceed thresholds
+type ClusterBudget struct {
+	// Amount is the cost budgeted for each period, in `unit`
+	// +required
+	Amount float64 `json:"amount"`
+	// Unit is the currency of the amount
+	// Defaults to `"USD"`
+	// +optional
+	Unit string `json:"unit,omitempty"`
+	// TimeUnit is the period the budget covers, valid variants are `BudgetTimeUnit` constants
+	// Defaults to `"MONTHLY"`
+	// +optional
+	TimeUnit string `json:"timeUnit,omitempty"`
+	// Thresholds are the percentages of the amount at which subscribers are notified, at most 5
+	// Defaults to `[80, 100]`
+	// +optional
+	Thresholds []float64 `json:"thresholds,omitempty"`
+	// Forecasted notifies subscribers when the forecasted costs of the period exceed a threshold, rather than
+	// the actual costs
+	// +optional
+	Forecasted bool `json:"forecasted,omitempty"`
+	// Emails are the email addresses notified, at most 10
+	// +optional
+	Emails []string `json:"emails,omitempty"`
+	// SNSTopicARN is the ARN of the SNS topic notified, its policy must allow `budgets.amazonaws.com` to publish to it
+	// +optional
+	SNSTopicARN string `json:"snsTopicARN,omitempty"`
+	// CostAllocationTag is the key of the tag the costs are filtered by. The value is the value of the tag in
+	// `metadata.tags`, or the name of the cluster for the default tag. The tag must be activated as a cost allocation
+	// tag in the Billing console for costs to be counted
+	// Defaults to `"alpha.eksctl.io/cluster-name"`
+	// +optional
+	CostAllocationTag string `json:"costAllocationTag,omitempty"`
+}
+
+// CostAllocationTagValue returns the value of the cost-allocation tag of the cluster
+func (b *ClusterBudget) CostAllocationTagValue(meta *ClusterMeta) string {
+	if b.CostAllocationTag == ClusterNameTag {
+		return meta.Name
+	}
+	return meta.Tags[b.CostAllocationTag]
+}
+
+func (c *ClusterConfig) setBudgetDefaults() {
+	budget := c.Budget
+	if budget.Unit == "" {
+		budget.Unit = DefaultBudgetUnit
+	}
+	if budget.TimeUnit == "" {
+		budget.TimeUnit = BudgetTimeUnitMonthly
+	}
+	if len(budget.Thresholds) == 0 {
+		budget.Thresholds = append([]float64(nil), DefaultBudgetThresholds...)
+	}
+	if budget.CostAllocationTag == "" {
+		budget.CostAllocationTag = ClusterNameTag
+	}
+}
+
+func (c *ClusterConfig) validateBudget() error {
+	budget := c.Budget
+	if budget == nil {
+		return nil
+	}
+	if budget.Amount <= 0 {
+		return fmt.Errorf("budget.amount must be greater than 0")
+	}
+	switch budget.TimeUnit {
+	case "", BudgetTimeUnitDaily, BudgetTimeUnitMonthly, BudgetTimeUnitQuarterly, BudgetTimeUnitAnnually:
+	default:
+		return fmt.Errorf("invalid budget.timeUnit %q, valid values are %s, %s, %s and %s", budget.TimeUnit,
+			BudgetTimeUnitDaily, BudgetTimeUnitMonthly, BudgetTimeUnitQuarterly, BudgetTimeUnitAnnually)
+	}
+	if len(budget.Thresholds) > maxBudgetThresholds {
+		return fmt.Errorf("budget.thresholds: at most %d thresholds are supported, got %d", maxBudgetThresholds, len(budget.Thresholds))
+	}
+	for i, threshold := range budget.Thresholds {
+		if threshold <= 0 {
+			return fmt.Errorf("budget.thresholds[%d] must be greater than 0", i)
+		}
+	}
+	if len(budget.Emails) == 0 && budget.SNSTopicARN == "" {
+		return fmt.Errorf("budget: at least one of emails and snsTopicARN must be set")
+	}
+	if len(budget.Emails) > maxBudgetEmails {
+		return fmt.Errorf("budget.emails: at most %d email addresses are supported, got %d", maxBudgetEmails, len(budget.Emails))
+	}
+	for i, email := range budget.Emails {
+		if !strings.Contains(email, "@") {
+			return fmt.Errorf("budget.emails[%d]: %q is not an email address", i, email)
+		}
+	}
+	if budget.SNSTopicARN != "" && !strings.HasPrefix(budget.SNSTopicARN, "arn:") {
+		return fmt.Errorf("budget.snsTopicARN: %q is not an ARN", budget.SNSTopicARN)
+	}
+	if tag := budget.CostAllocationTag; tag != "" && tag != ClusterNameTag && c.Metadata.Tags[tag] == "" {
+		return fmt.Errorf("budget.costAllocationTag: tag %q must be set in metadata.tags", tag)
+	}
+	return nil
+}
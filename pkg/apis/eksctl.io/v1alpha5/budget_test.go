@@ -0,0 +1,56 @@
+package v1alpha5
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClusterBudget", func() {
+	var cfg *ClusterConfig
+
+	BeforeEach(func() {
+		cfg = NewClusterConfig()
+		cfg.Metadata.Name = "sandbox"
+		cfg.Metadata.Tags = map[string]string{"team": "platform"}
+	})
+
+	It("sets defaults", func() {
+		cfg.Budget = &ClusterBudget{Amount: 100, Emails: []string{"team@example.com"}}
+		SetClusterConfigDefaults(cfg)
+		Expect(cfg.Budget.Unit).To(Equal("USD"))
+		Expect(cfg.Budget.TimeUnit).To(Equal(BudgetTimeUnitMonthly))
+		Expect(cfg.Budget.Thresholds).To(Equal([]float64{80, 100}))
+		Expect(cfg.Budget.CostAllocationTag).To(Equal(ClusterNameTag))
+		Expect(cfg.Budget.CostAllocationTagValue(cfg.Metadata)).To(Equal("sandbox"))
+	})
+
+	It("filters costs by the value of a custom tag", func() {
+		cfg.Budget = &ClusterBudget{CostAllocationTag: "team"}
+		Expect(cfg.Budget.CostAllocationTagValue(cfg.Metadata)).To(Equal("platform"))
+	})
+
+	DescribeTable("validation", func(budget ClusterBudget, expectedErr string) {
+		cfg.Budget = &budget
+		err := cfg.validateBudget()
+		if expectedErr == "" {
+			Expect(err).NotTo(HaveOccurred())
+			return
+		}
+		Expect(err).To(MatchError(expectedErr))
+	},
+		Entry("valid budget", ClusterBudget{Amount: 100, TimeUnit: BudgetTimeUnitDaily, Thresholds: []float64{50, 120}, Emails: []string{"team@example.com"}}, ""),
+		Entry("SNS topic", ClusterBudget{Amount: 100, SNSTopicARN: "arn:aws:sns:us-west-2:123456789012:budgets", CostAllocationTag: "team"}, ""),
+		Entry("no amount", ClusterBudget{Emails: []string{"team@example.com"}}, "budget.amount must be greater than 0"),
+		Entry("invalid time unit", ClusterBudget{Amount: 100, TimeUnit: "WEEKLY", Emails: []string{"team@example.com"}},
+			`invalid budget.timeUnit "WEEKLY", valid values are DAILY, MONTHLY, QUARTERLY and ANNUALLY`),
+		Entry("too many thresholds", ClusterBudget{Amount: 100, Thresholds: []float64{10, 20, 30, 40, 50, 60}, Emails: []string{"team@example.com"}},
+			"budget.thresholds: at most 5 thresholds are supported, got 6"),
+		Entry("negative threshold", ClusterBudget{Amount: 100, Thresholds: []float64{-10}, Emails: []string{"team@example.com"}}, "budget.thresholds[0] must be greater than 0"),
+		Entry("no subscribers", ClusterBudget{Amount: 100}, "budget: at least one of emails and snsTopicARN must be set"),
+		Entry("invalid email", ClusterBudget{Amount: 100, Emails: []string{"team"}}, `budget.emails[0]: "team" is not an email address`),
+		Entry("invalid SNS topic", ClusterBudget{Amount: 100, SNSTopicARN: "budgets"}, `budget.snsTopicARN: "budgets" is not an ARN`),
+		Entry("untagged cost allocation tag", ClusterBudget{Amount: 100, Emails: []string{"team@example.com"}, CostAllocationTag: "cost-center"},
+			`budget.costAllocationTag: tag "cost-center" must be set in metadata.tags`),
+	)
+})
@@ -1,5 +1,7 @@
 package v1alpha5
 
+import "fmt"
+
 // ClusterCloudWatch contains config parameters related to CloudWatch
 type ClusterCloudWatch struct {
 	//+optional
@@ -31,6 +33,16 @@ type ClusterCloudWatchLogging struct {
 	// 1827, and 3653.
 	//+optional
 	LogRetentionInDays int `json:"logRetentionInDays,omitempty"`
+	// LogGroupKMSKeyARN is the ARN of the KMS key to encrypt the `/aws/eks/<cluster>/cluster` log group with.
+	// The key policy is updated to allow CloudWatch Logs to use the key for the log group
+	//+optional
+	LogGroupKMSKeyARN string `json:"logGroupKMSKeyARN,omitempty"`
+}
+
+// ClusterLogGroupName returns the name of the log group EKS sends the control plane logs to,
+// see https://docs.aws.amazon.com/eks/latest/userguide/control-plane-logs.html
+func (c *ClusterConfig) ClusterLogGroupName() string {
+	return fmt.Sprintf("/aws/eks/%s/cluster", c.Metadata.Name)
 }
 
 // SupportedCloudWatchClusterLogTypes returns all supported logging facilities
@@ -1,12 +1,11 @@
 package v1alpha5
 
 import (
-	"fmt"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
 const (
@@ -59,9 +58,43 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		cfg.VPC.ManageSharedNodeSecurityGroupRules = Enabled()
 	}
 
+	if cfg.VPC != nil && cfg.VPC.FlowLogs != nil {
+		if cfg.VPC.FlowLogs.Destination == "" {
+			cfg.VPC.FlowLogs.Destination = FlowLogsDestinationCloudWatch
+		}
+		if cfg.VPC.FlowLogs.TrafficType == "" {
+			cfg.VPC.FlowLogs.TrafficType = FlowLogsTrafficTypeAll
+		}
+	}
+
+	if cfg.Budget != nil {
+		cfg.setBudgetDefaults()
+	}
+
 	if cfg.Karpenter != nil && cfg.Karpenter.CreateServiceAccount == nil {
 		cfg.Karpenter.CreateServiceAccount = Disabled()
 	}
+
+	if cfg.Bootstrap != nil {
+		if cfg.Bootstrap.Retries == nil {
+			retries := DefaultBootstrapRetries
+			cfg.Bootstrap.Retries = &retries
+		}
+		for _, chart := range cfg.Bootstrap.HelmCharts {
+			if chart.Namespace == "" {
+				chart.Namespace = metav1.NamespaceDefault
+			}
+		}
+	}
+
+	for _, chart := range cfg.Charts {
+		if chart.ReleaseName == "" {
+			chart.ReleaseName = chart.Name
+		}
+		if chart.Namespace == "" {
+			chart.Namespace = metav1.NamespaceDefault
+		}
+	}
 }
 
 // IAMServiceAccountsWithImplicitServiceAccounts adds implicitly created
@@ -77,7 +110,7 @@ func IAMServiceAccountsWithImplicitServiceAccounts(cfg *ClusterConfig) []*Cluste
 			awsNode := ClusterIAMServiceAccount{
 				ClusterIAMMeta: AWSNodeMeta,
 				AttachPolicyARNs: []string{
-					fmt.Sprintf("arn:%s:iam::aws:policy/%s", Partition(cfg.Metadata.Region), IAMPolicyAmazonEKSCNIPolicy),
+					AWSManagedPolicyARN(Partition(cfg.Metadata.Region), IAMPolicyAmazonEKSCNIPolicy),
 				},
 			}
 			serviceAccounts = append(serviceAccounts, &awsNode)
@@ -121,6 +154,28 @@ func SetNodeGroupDefaults(ng *NodeGroup, meta *ClusterMeta) {
 	}
 
 	setContainerRuntimeDefault(ng)
+	setAlarmsDefaults(ng.Alarms)
+}
+
+func setAlarmsDefaults(alarms *NodeGroupAlarms) {
+	if alarms == nil {
+		return
+	}
+	if alarms.InServiceCapacity == nil {
+		alarms.InServiceCapacity = Enabled()
+	}
+	if alarms.StatusCheckFailed == nil {
+		alarms.StatusCheckFailed = Enabled()
+	}
+	if alarms.StatusCheckFailedThreshold == nil {
+		alarms.StatusCheckFailedThreshold = aws.Int(1)
+	}
+	if alarms.SpotInterruptions == nil {
+		alarms.SpotInterruptions = Disabled()
+	}
+	if alarms.SpotInterruptionsThreshold == nil {
+		alarms.SpotInterruptionsThreshold = aws.Int(5)
+	}
 }
 
 // SetManagedNodeGroupDefaults sets default values for a ManagedNodeGroup
@@ -279,7 +334,7 @@ func setSSHDefaults(sshConfig *NodeGroupSSH) {
 		sshConfig.PublicKeyPath,
 		sshConfig.PublicKey)
 
-	if numSSHFlagsEnabled == 0 {
+	if numSSHFlagsEnabled == 0 && len(sshConfig.AuthorizedKeys) == 0 {
 		if IsEnabled(sshConfig.Allow) {
 			sshConfig.PublicKeyPath = &DefaultNodeSSHPublicKeyPath
 		} else {
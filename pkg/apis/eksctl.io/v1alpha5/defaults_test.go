@@ -109,6 +109,22 @@ var _ = Describe("ClusterConfig validation", func() {
 			Expect(*testNodeGroup.SSH.PublicKeyPath).To(BeIdenticalTo("~/.ssh/id_rsa.pub"))
 		})
 
+		It("Providing authorized keys enables SSH without the default key", func() {
+			testNodeGroup := NodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					VolumeSize: &DefaultNodeVolumeSize,
+					SSH: &NodeGroupSSH{
+						AuthorizedKeys: []string{"ssh-ed25519 AAAA user@example.com"},
+					},
+				},
+			}
+
+			SetNodeGroupDefaults(&testNodeGroup, &ClusterMeta{})
+
+			Expect(*testNodeGroup.SSH.Allow).To(BeTrue())
+			Expect(testNodeGroup.SSH.PublicKeyPath).To(BeNil())
+		})
+
 		It("Providing an SSH key and explicitly disabling SSH keeps SSH disabled", func() {
 			testKeyPath := "some/path/to/file.pub"
 
@@ -0,0 +1,40 @@
+package v1alpha5
+
+// HelmChart is a Helm chart installed once the cluster and its nodegroups are ready, after `bootstrap`
+type HelmChart struct {
+	// Repo is the URL of the chart repository
+	// +required
+	Repo string `json:"repo"`
+
+	// Name of the chart in the repository
+	// +required
+	Name string `json:"name"`
+
+	// Version of the chart
+	// +required
+	Version string `json:"version"`
+
+	// ReleaseName is the name of the release
+	// Defaults to the name of the chart
+	// +optional
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// Namespace to install the release in, it is created if it doesn't exist
+	// Defaults to `default`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ValuesTemplate is a YAML document holding the values of the release. It is rendered as a Go template
+	// that can reference the resources created by eksctl, see [Helm charts](/usage/helm-charts/)
+	// +optional
+	ValuesTemplate string `json:"valuesTemplate,omitempty"`
+
+	// Verification pins the digest of the chart archive and verifies its signature
+	// +optional
+	Verification *ArtifactVerification `json:"verification,omitempty"`
+}
+
+// HasCharts returns true if the config declares Helm charts to install after the cluster is created
+func (c *ClusterConfig) HasCharts() bool {
+	return len(c.Charts) > 0
+}
@@ -44,6 +44,11 @@ type ClusterIAM struct {
 	// necessary to run the VPC controller in the control plane
 	// Defaults to `true`
 	VPCResourceControllerPolicy *bool `json:"vpcResourceControllerPolicy,omitempty"`
+
+	// stops eksctl from adding the instance roles of nodegroups to the aws-auth ConfigMap,
+	// it's the default of `nodeGroups[].iam.skipAWSAuthConfigMap`
+	// +optional
+	SkipNodeGroupsAWSAuthConfigMap *bool `json:"skipNodeGroupsAWSAuthConfigMap,omitempty"`
 }
 
 // ClusterIAMMeta holds information we can use to create ObjectMeta for service
@@ -86,6 +91,20 @@ type ClusterIAMServiceAccount struct {
 	// +optional
 	AttachPolicy InlineDocument `json:"attachPolicy,omitempty"`
 
+	// AttachPolicyFiles holds paths to JSON or YAML policy documents to attach to this service account.
+	// Relative paths are resolved against the directory of the config file.
+	// `{{ .ClusterName }}` and `{{ .Region }}` are substituted in the documents
+	// +optional
+	AttachPolicyFiles []string `json:"attachPolicyFiles,omitempty"`
+
+	// AttachPolicyURLs holds HTTP(S) URLs of JSON or YAML policy documents to attach to this service account.
+	// `{{ .ClusterName }}` and `{{ .Region }}` are substituted in the documents
+	// +optional
+	AttachPolicyURLs []string `json:"attachPolicyURLs,omitempty"`
+
+	// AttachPolicyDocuments holds the policy documents loaded from AttachPolicyFiles and AttachPolicyURLs
+	AttachPolicyDocuments []InlineDocument `json:"-"`
+
 	// ARN of the role to attach to the service account
 	AttachRoleARN string `json:"attachRoleARN,omitempty"`
 
@@ -0,0 +1,157 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const maintenanceWindowTimeLayout = "15:04"
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// MaintenanceWindow is a recurring period during which upgrades are applied, e.g. `Sat-Sun 02:00-06:00`;
+// upgrade commands wait for the window to open before changing anything
+type MaintenanceWindow struct {
+	// Days the window opens on, as day names or ranges of day names, e.g. `[Mon-Fri]` or `[Sat, Sun]`;
+	// the window opens every day if empty
+	// +optional
+	Days []string `json:"days,omitempty"`
+	// Start is the time the window opens, in `HH:MM` format
+	// +required
+	Start string `json:"start"`
+	// End is the time the window closes, in `HH:MM` format; a window that ends before it starts
+	// closes on the next day
+	// +required
+	End string `json:"end"`
+	// TimeZone of the window, e.g. `Europe/London`
+	// Defaults to `"UTC"`
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ParseMaintenanceWindow parses a maintenance window in the `[DAYS ]HH:MM-HH:MM[ TIMEZONE]` format of the
+// `--maintenance-window` flag, e.g. `Sat,Sun 02:00-06:00 Europe/London`
+func ParseMaintenanceWindow(value string) (*MaintenanceWindow, error) {
+	fields := strings.Fields(value)
+	window := &MaintenanceWindow{}
+	for i, field := range fields {
+		if start, end, ok := strings.Cut(field, "-"); ok && strings.Contains(start, ":") {
+			if window.Start != "" {
+				return nil, fmt.Errorf("invalid maintenance window %q, more than one time range", value)
+			}
+			window.Start, window.End = start, end
+			continue
+		}
+		switch {
+		case window.Start == "" && window.Days == nil:
+			window.Days = strings.Split(field, ",")
+		case window.Start != "" && i == len(fields)-1:
+			window.TimeZone = field
+		default:
+			return nil, fmt.Errorf("invalid maintenance window %q, expected [DAYS ]HH:MM-HH:MM[ TIMEZONE]", value)
+		}
+	}
+	if err := window.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid maintenance window %q: %w", value, err)
+	}
+	return window, nil
+}
+
+// Validate validates the maintenance window
+func (w *MaintenanceWindow) Validate() error {
+	if w.Start == "" || w.End == "" {
+		return fmt.Errorf("start and end must be set")
+	}
+	_, _, _, _, err := w.parse()
+	return err
+}
+
+// parse returns the days, start, length and location of a maintenance window
+func (w *MaintenanceWindow) parse() (map[time.Weekday]bool, time.Duration, time.Duration, *time.Location, error) {
+	days := map[time.Weekday]bool{}
+	for _, day := range w.Days {
+		from, to, isRange := strings.Cut(day, "-")
+		if !isRange {
+			to = from
+		}
+		first, ok := weekdays[strings.ToLower(from)]
+		last, ok2 := weekdays[strings.ToLower(to)]
+		if !ok || !ok2 {
+			return nil, 0, 0, nil, fmt.Errorf("invalid day %q, expected a day name such as Mon or a range such as Mon-Fri", day)
+		}
+		for d := first; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == last {
+				break
+			}
+		}
+	}
+
+	start, err := time.Parse(maintenanceWindowTimeLayout, w.Start)
+	if err != nil {
+		return nil, 0, 0, nil, fmt.Errorf("invalid start %q, expected HH:MM", w.Start)
+	}
+	end, err := time.Parse(maintenanceWindowTimeLayout, w.End)
+	if err != nil {
+		return nil, 0, 0, nil, fmt.Errorf("invalid end %q, expected HH:MM", w.End)
+	}
+	length := end.Sub(start)
+	if length <= 0 {
+		length += 24 * time.Hour
+	}
+
+	location := time.UTC
+	if w.TimeZone != "" {
+		if location, err = time.LoadLocation(w.TimeZone); err != nil {
+			return nil, 0, 0, nil, fmt.Errorf("invalid time zone %q", w.TimeZone)
+		}
+	}
+	return days, start.Sub(start.Truncate(24 * time.Hour)), length, location, nil
+}
+
+// Next returns when the maintenance window is next open at or after now, which is now if the window is open
+func (w *MaintenanceWindow) Next(now time.Time) (opens, closes time.Time, err error) {
+	days, start, length, location, err := w.parse()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	local := now.In(location)
+	// a window that opened on the previous day can still be open
+	for offset := -1; offset <= 7; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, location)
+		if len(days) > 0 && !days[day.Weekday()] {
+			continue
+		}
+		opens = time.Date(day.Year(), day.Month(), day.Day(), int(start.Hours()), int(start.Minutes())%60, 0, 0, location)
+		closes = opens.Add(length)
+		if closes.After(now) {
+			if opens.Before(now) {
+				opens = now
+			}
+			return opens, closes, nil
+		}
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("maintenance window never opens")
+}
+
+// String returns the maintenance window in the format of the `--maintenance-window` flag
+func (w *MaintenanceWindow) String() string {
+	parts := []string{}
+	if len(w.Days) > 0 {
+		parts = append(parts, strings.Join(w.Days, ","))
+	}
+	parts = append(parts, w.Start+"-"+w.End)
+	if w.TimeZone != "" {
+		parts = append(parts, w.TimeZone)
+	}
+	return strings.Join(parts, " ")
+}
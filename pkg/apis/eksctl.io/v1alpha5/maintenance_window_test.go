@@ -0,0 +1,67 @@
+package v1alpha5_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("MaintenanceWindow", func() {
+	DescribeTable("ParseMaintenanceWindow",
+		func(value string, expected *api.MaintenanceWindow, expectedErr string) {
+			window, err := api.ParseMaintenanceWindow(value)
+			if expectedErr != "" {
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window).To(Equal(expected))
+			Expect(window.String()).To(Equal(value))
+		},
+		Entry("time range only", "02:00-06:00", &api.MaintenanceWindow{Start: "02:00", End: "06:00"}, ""),
+		Entry("days, time range and time zone", "Sat,Sun 02:00-06:00 Europe/London", &api.MaintenanceWindow{
+			Days:     []string{"Sat", "Sun"},
+			Start:    "02:00",
+			End:      "06:00",
+			TimeZone: "Europe/London",
+		}, ""),
+		Entry("day range", "Mon-Fri 22:00-02:00", &api.MaintenanceWindow{Days: []string{"Mon-Fri"}, Start: "22:00", End: "02:00"}, ""),
+		Entry("missing time range", "Sat", nil, "start and end must be set"),
+		Entry("invalid day", "Someday 02:00-06:00", nil, `invalid day "Someday"`),
+		Entry("invalid time", "02:00-25:00", nil, `invalid end "25:00"`),
+		Entry("invalid time zone", "02:00-06:00 Nowhere/Land", nil, `invalid time zone "Nowhere/Land"`),
+		Entry("two time ranges", "02:00-03:00 04:00-05:00", nil, "more than one time range"),
+	)
+
+	Describe("Next", func() {
+		// Wednesday
+		now := time.Date(2022, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+		DescribeTable("returns when the window next opens and closes",
+			func(window api.MaintenanceWindow, expectedOpens, expectedCloses time.Time) {
+				opens, closes, err := window.Next(now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(opens).To(BeTemporally("==", expectedOpens))
+				Expect(closes).To(BeTemporally("==", expectedCloses))
+			},
+			Entry("the window is open", api.MaintenanceWindow{Start: "11:00", End: "13:00"},
+				now, time.Date(2022, time.June, 15, 13, 0, 0, 0, time.UTC)),
+			Entry("the window opens later today", api.MaintenanceWindow{Start: "22:00", End: "23:00"},
+				time.Date(2022, time.June, 15, 22, 0, 0, 0, time.UTC), time.Date(2022, time.June, 15, 23, 0, 0, 0, time.UTC)),
+			Entry("the window has closed today", api.MaintenanceWindow{Start: "02:00", End: "06:00"},
+				time.Date(2022, time.June, 16, 2, 0, 0, 0, time.UTC), time.Date(2022, time.June, 16, 6, 0, 0, 0, time.UTC)),
+			Entry("the window opened on the previous day", api.MaintenanceWindow{Days: []string{"Tue"}, Start: "20:00", End: "14:00"},
+				now, time.Date(2022, time.June, 15, 14, 0, 0, 0, time.UTC)),
+			Entry("the window opens on the weekend", api.MaintenanceWindow{Days: []string{"Sat", "Sun"}, Start: "02:00", End: "06:00"},
+				time.Date(2022, time.June, 18, 2, 0, 0, 0, time.UTC), time.Date(2022, time.June, 18, 6, 0, 0, 0, time.UTC)),
+			Entry("the window wraps around the week", api.MaintenanceWindow{Days: []string{"Sun-Tue"}, Start: "02:00", End: "06:00"},
+				time.Date(2022, time.June, 19, 2, 0, 0, 0, time.UTC), time.Date(2022, time.June, 19, 6, 0, 0, 0, time.UTC)),
+			Entry("the window is in another time zone", api.MaintenanceWindow{Start: "02:00", End: "06:00", TimeZone: "America/New_York"},
+				time.Date(2022, time.June, 16, 6, 0, 0, 0, time.UTC), time.Date(2022, time.June, 16, 10, 0, 0, 0, time.UTC)),
+		)
+	})
+})
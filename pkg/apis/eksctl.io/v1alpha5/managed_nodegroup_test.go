@@ -58,6 +58,23 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 				},
 			},
 		}),
+		Entry("Image Builder AMI with overrideBootstrapCommand", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					AMI:                      "imagebuilder:arn:aws:imagebuilder:us-west-2:123456789012:image-pipeline/eks-nodes",
+					OverrideBootstrapCommand: aws.String(`bootstrap.sh`),
+				},
+			},
+		}),
+		Entry("Image Builder AMI without a pipeline ARN", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					AMI:                      "imagebuilder:arn:aws:imagebuilder:us-west-2:123456789012:image/eks-nodes/1.0.0/1",
+					OverrideBootstrapCommand: aws.String(`bootstrap.sh`),
+				},
+			},
+			errMsg: `invalid Image Builder pipeline ARN "arn:aws:imagebuilder:us-west-2:123456789012:image/eks-nodes/1.0.0/1" in managedNodeGroups[0].ami`,
+		}),
 		Entry("launchTemplate with no ID", &nodeGroupCase{
 			ng: &ManagedNodeGroup{
 				NodeGroupBase:  &NodeGroupBase{},
@@ -137,7 +154,7 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 		err := ValidateManagedNodeGroup(0, mng)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("cannot set instanceType, ami, ssh.allow, ssh.enableSSM, ssh.sourceSecurityGroupIds, securityGroups, " +
-			"volumeSize, instanceName, instancePrefix, maxPodsPerNode, disableIMDSv1, disablePodIMDS, preBootstrapCommands, overrideBootstrapCommand, placement in managedNodeGroup when a launch template is supplied"))
+			"volumeSize, instanceName, instancePrefix, maxPodsPerNode, disableIMDSv1, disablePodIMDS, preBootstrapCommands, overrideBootstrapCommand, placement, proxy, extraCACerts, cpuOptions, tenancy in managedNodeGroup when a launch template is supplied"))
 	},
 		Entry("instanceType", &NodeGroupBase{
 			InstanceType: "m5.xlarge",
@@ -164,6 +181,17 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 				AttachIDs: []string{"sg-custom"},
 			},
 		}),
+		Entry("securityGroups.attachSharedClusterSG", &NodeGroupBase{
+			SecurityGroups: &NodeGroupSGs{
+				AttachSharedClusterSG: Enabled(),
+			},
+		}),
+		Entry("cpuOptions", &NodeGroupBase{
+			CPUOptions: &CPUOptions{
+				CoreCount:      2,
+				ThreadsPerCore: 1,
+			},
+		}),
 	)
 
 	type updateConfigEntry struct {
@@ -215,5 +243,13 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 		Entry("returns an error if both maxUnavailable and maxUnavailablePercentage are not set", updateConfigEntry{
 			valid: false,
 		}),
+		Entry("returns an error if max unavailable is zero", updateConfigEntry{
+			unavailable: aws.Int(0),
+			valid:       false,
+		}),
+		Entry("returns an error if max unavailable percentage is over 100", updateConfigEntry{
+			unavailablePercentage: aws.Int(101),
+			valid:                 false,
+		}),
 	)
 })
@@ -104,3 +104,12 @@ func (c *ClusterConfig) HasWindowsNodeGroup() bool {
 	}
 	return false
 }
+
+// SkipsAWSAuthConfigMap returns true if the instance role of ng mustn't be added to the aws-auth ConfigMap,
+// as set by the nodegroup or else by the cluster.
+func (c *ClusterConfig) SkipsAWSAuthConfigMap(ng *NodeGroup) bool {
+	if ng.IAM != nil && ng.IAM.SkipAWSAuthConfigMap != nil {
+		return *ng.IAM.SkipAWSAuthConfigMap
+	}
+	return c.IAM != nil && IsEnabled(c.IAM.SkipNodeGroupsAWSAuthConfigMap)
+}
@@ -0,0 +1,34 @@
+package v1alpha5
+
+// Observability configures the Amazon Managed Service for Prometheus and Amazon Managed Grafana
+// workspaces set up by `eksctl enable monitoring`, see [monitoring](/usage/monitoring/)
+type Observability struct {
+	// Prometheus configures the Amazon Managed Service for Prometheus workspace the metrics
+	// of the cluster are written to
+	// +optional
+	Prometheus *ObservabilityPrometheus `json:"prometheus,omitempty"`
+
+	// Grafana configures the Amazon Managed Grafana workspace that queries the Prometheus workspace
+	// +optional
+	Grafana *ObservabilityGrafana `json:"grafana,omitempty"`
+}
+
+// ObservabilityPrometheus configures the Amazon Managed Service for Prometheus workspace of a cluster
+type ObservabilityPrometheus struct {
+	// WorkspaceID is the ID of an existing workspace. When unset, the workspace with the alias
+	// `eksctl-<cluster-name>` is used and created if it doesn't exist
+	// +optional
+	WorkspaceID string `json:"workspaceID,omitempty"`
+}
+
+// ObservabilityGrafana configures the Amazon Managed Grafana workspace of a cluster
+type ObservabilityGrafana struct {
+	// WorkspaceID is the ID of the Grafana workspace to give access to the Prometheus workspace
+	// +required
+	WorkspaceID string `json:"workspaceID"`
+}
+
+// PrometheusWorkspaceAlias returns the alias of the Prometheus workspace eksctl creates for the cluster
+func (c *ClusterConfig) PrometheusWorkspaceAlias() string {
+	return "eksctl-" + c.Metadata.Name
+}
@@ -0,0 +1,153 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// Features whose availability depends on the partition
+const (
+	FeatureFargate      = "Fargate"
+	FeatureEKSConnector = "EKS Connector"
+)
+
+// unsupportedFeatures are the features that aren't available in each partition
+var unsupportedFeatures = map[string][]string{
+	PartitionChina: {FeatureFargate, FeatureEKSConnector},
+	PartitionUSGov: {FeatureFargate, FeatureEKSConnector},
+}
+
+// ValidateFeatureInRegion returns an error if a feature isn't available in the partition of a region
+func ValidateFeatureInRegion(feature, region string) error {
+	partition := Partition(region)
+	for _, f := range unsupportedFeatures[partition] {
+		if f == feature {
+			return fmt.Errorf("%s is not available in region %q, as it isn't supported in the %q partition", feature, region, partition)
+		}
+	}
+	return nil
+}
+
+// ValidatePartitionFeatures returns an error if the cluster config uses features that aren't available in the
+// partition of its region
+func ValidatePartitionFeatures(cfg *ClusterConfig) error {
+	if len(cfg.FargateProfiles) > 0 {
+		if err := ValidateFeatureInRegion(FeatureFargate, cfg.Metadata.Region); err != nil {
+			return fmt.Errorf("fargateProfiles: %w", err)
+		}
+	}
+	return nil
+}
+
+// Service names of the service principals used by eksctl, see ServicePrincipal for their principal in a partition
+const (
+	ServicePrincipalEC2            = "ec2"
+	ServicePrincipalEKS            = "eks"
+	ServicePrincipalEKSFargatePods = "eks-fargate-pods"
+	ServicePrincipalLambda         = "lambda"
+	ServicePrincipalScheduler      = "scheduler"
+	ServicePrincipalSSM            = "ssm"
+)
+
+// ARN returns the ARN of a resource in a partition, region and accountID are empty for resources of global services
+// such as IAM, or can be "*" to match any
+func ARN(partition, service, region, accountID, resource string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resource,
+	}.String()
+}
+
+// IAMRoleARN returns the ARN of an IAM role in a partition
+func IAMRoleARN(partition, accountID, roleName string) string {
+	return ARN(partition, "iam", "", accountID, "role/"+roleName)
+}
+
+// IAMPolicyARN returns the ARN of a customer managed IAM policy in a partition
+func IAMPolicyARN(partition, accountID, policyName string) string {
+	return ARN(partition, "iam", "", accountID, "policy/"+policyName)
+}
+
+// AWSManagedPolicyARN returns the ARN of an AWS managed IAM policy in a partition
+func AWSManagedPolicyARN(partition, policyName string) string {
+	return ARN(partition, "iam", "", "aws", "policy/"+policyName)
+}
+
+// EKSAccessPolicyARN returns the ARN of an access policy managed by EKS in a partition
+func EKSAccessPolicyARN(partition, policyName string) string {
+	return ARN(partition, "eks", "", "aws", "cluster-access-policy/"+policyName)
+}
+
+// OIDCProviderARN returns the ARN of an IAM OIDC provider in a partition, hostAndPath is the issuer URL without
+// its scheme
+func OIDCProviderARN(partition, accountID, hostAndPath string) string {
+	return ARN(partition, "iam", "", accountID, "oidc-provider/"+hostAndPath)
+}
+
+// ServicePrincipal returns the principal a service assumes roles with in a partition
+func ServicePrincipal(partition, service string) string {
+	if partition == PartitionChina && service == ServicePrincipalEC2 {
+		return service + ".amazonaws.com.cn"
+	}
+	return service + ".amazonaws.com"
+}
+
+// DNSSuffix returns the DNS suffix of the endpoints of AWS services in a partition
+func DNSSuffix(partition string) string {
+	if partition == PartitionChina {
+		return "amazonaws.com.cn"
+	}
+	return "amazonaws.com"
+}
+
+// fipsRegions are the regions with FIPS endpoints for each of the services eksctl calls
+var fipsRegions = func() map[string][]string {
+	us := []string{RegionUSEast1, RegionUSEast2, RegionUSWest1, RegionUSWest2, RegionUSGovWest1, RegionUSGovEast1}
+	usAndCanada := append([]string{RegionCACentral1}, us...)
+	return map[string][]string{
+		"autoscaling":    usAndCanada,
+		"cloudformation": us,
+		"cloudtrail":     usAndCanada,
+		"ec2":            usAndCanada,
+		"eks":            us,
+		"elb":            usAndCanada,
+		"iam":            us,
+		"logs":           usAndCanada,
+		"ssm":            usAndCanada,
+		"sts":            us,
+	}
+}()
+
+// ValidateEndpointsMode returns an error if the endpoints mode isn't valid, or if any of the services eksctl calls
+// doesn't have endpoints of that mode in a region
+func ValidateEndpointsMode(mode, region string) error {
+	switch mode {
+	case "", EndpointsModeDefault, EndpointsModeDualStack:
+		return nil
+	case EndpointsModeFIPS:
+	default:
+		return fmt.Errorf("invalid endpoints mode %q, valid values are %q, %q and %q", mode, EndpointsModeDefault, EndpointsModeFIPS, EndpointsModeDualStack)
+	}
+
+	var unsupported []string
+	for service, regions := range fipsRegions {
+		supported := false
+		for _, r := range regions {
+			supported = supported || r == region
+		}
+		if !supported {
+			unsupported = append(unsupported, service)
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return fmt.Errorf("FIPS endpoints are not available in region %q for services: %s", region, strings.Join(unsupported, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package v1alpha5_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Partition features", func() {
+	DescribeTable("ValidateFeatureInRegion", func(feature, region, errMsg string) {
+		err := api.ValidateFeatureInRegion(feature, region)
+		if errMsg != "" {
+			Expect(err).To(MatchError(errMsg))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+	},
+		Entry("Fargate in the aws partition", api.FeatureFargate, api.RegionUSWest2, ""),
+		Entry("Fargate in the aws-cn partition", api.FeatureFargate, api.RegionCNNorth1, `Fargate is not available in region "cn-north-1", as it isn't supported in the "aws-cn" partition`),
+		Entry("EKS Connector in the aws-us-gov partition", api.FeatureEKSConnector, api.RegionUSGovWest1, `EKS Connector is not available in region "us-gov-west-1", as it isn't supported in the "aws-us-gov" partition`),
+	)
+
+	It("validates the features a cluster config uses", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Region = api.RegionUSGovEast1
+		Expect(api.ValidatePartitionFeatures(cfg)).To(Succeed())
+
+		cfg.FargateProfiles = []*api.FargateProfile{{Name: "fp-default"}}
+		Expect(api.ValidatePartitionFeatures(cfg)).To(MatchError(ContainSubstring("fargateProfiles: Fargate is not available")))
+	})
+
+	It("knows which regions are opt-in", func() {
+		Expect(api.IsOptInRegion(api.RegionAFSouth1)).To(BeTrue())
+		Expect(api.IsOptInRegion(api.RegionEUWest1)).To(BeFalse())
+	})
+})
+
+var _ = Describe("Partition ARNs", func() {
+	DescribeTable("builds ARNs in the partition", func(partition string, expectedRoleARN, expectedManagedPolicyARN, expectedEC2Principal string) {
+		Expect(api.IAMRoleARN(partition, "123456789012", "role-1")).To(Equal(expectedRoleARN))
+		Expect(api.AWSManagedPolicyARN(partition, api.IAMPolicyAmazonEKSCNIPolicy)).To(Equal(expectedManagedPolicyARN))
+		Expect(api.ServicePrincipal(partition, api.ServicePrincipalEC2)).To(Equal(expectedEC2Principal))
+		Expect(api.ServicePrincipal(partition, api.ServicePrincipalEKS)).To(Equal("eks.amazonaws.com"))
+	},
+		Entry("aws", api.PartitionAWS, "arn:aws:iam::123456789012:role/role-1", "arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy", "ec2.amazonaws.com"),
+		Entry("aws-us-gov", api.PartitionUSGov, "arn:aws-us-gov:iam::123456789012:role/role-1", "arn:aws-us-gov:iam::aws:policy/AmazonEKS_CNI_Policy", "ec2.amazonaws.com"),
+		Entry("aws-cn", api.PartitionChina, "arn:aws-cn:iam::123456789012:role/role-1", "arn:aws-cn:iam::aws:policy/AmazonEKS_CNI_Policy", "ec2.amazonaws.com.cn"),
+	)
+
+	It("builds ARNs of regional resources", func() {
+		Expect(api.ARN(api.PartitionChina, "ec2", "*", "*", "network-interface/*")).To(Equal("arn:aws-cn:ec2:*:*:network-interface/*"))
+		Expect(api.OIDCProviderARN(api.PartitionUSGov, "123456789012", "oidc.eks.us-gov-west-1.amazonaws.com/id/ABC")).
+			To(Equal("arn:aws-us-gov:iam::123456789012:oidc-provider/oidc.eks.us-gov-west-1.amazonaws.com/id/ABC"))
+	})
+
+	It("knows the DNS suffix of the endpoints in each partition", func() {
+		Expect(api.DNSSuffix(api.PartitionAWS)).To(Equal("amazonaws.com"))
+		Expect(api.DNSSuffix(api.PartitionUSGov)).To(Equal("amazonaws.com"))
+		Expect(api.DNSSuffix(api.PartitionChina)).To(Equal("amazonaws.com.cn"))
+	})
+})
+
+var _ = Describe("Endpoints mode", func() {
+	DescribeTable("ValidateEndpointsMode", func(mode, region, errMsg string) {
+		err := api.ValidateEndpointsMode(mode, region)
+		if errMsg != "" {
+			Expect(err).To(MatchError(errMsg))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+	},
+		Entry("default endpoints", "", api.RegionEUWest1, ""),
+		Entry("FIPS endpoints in a US region", api.EndpointsModeFIPS, api.RegionUSEast1, ""),
+		Entry("FIPS endpoints in GovCloud", api.EndpointsModeFIPS, api.RegionUSGovWest1, ""),
+		Entry("FIPS endpoints in a region where only some services have them", api.EndpointsModeFIPS, api.RegionCACentral1, `FIPS endpoints are not available in region "ca-central-1" for services: cloudformation, eks, iam, sts`),
+		Entry("FIPS endpoints in a region without them", api.EndpointsModeFIPS, api.RegionEUWest1, `FIPS endpoints are not available in region "eu-west-1" for services: autoscaling, cloudformation, cloudtrail, ec2, eks, elb, iam, logs, ssm, sts`),
+		Entry("dual-stack endpoints", api.EndpointsModeDualStack, api.RegionEUWest1, ""),
+		Entry("an invalid mode", "ipv6", api.RegionUSEast1, `invalid endpoints mode "ipv6", valid values are "default", "fips" and "dualstack"`),
+	)
+})
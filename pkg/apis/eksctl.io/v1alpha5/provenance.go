@@ -0,0 +1,77 @@
+package v1alpha5
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Cosign configures how the cosign CLI verifies the signatures of the components eksctl deploys,
+// see [Verifying components](/usage/verifying-components/)
+type Cosign struct {
+	// Key is the public key signatures are verified with, a path, URL or KMS URI, e.g. `awskms:///alias/cosign`
+	// +required
+	Key string `json:"key"`
+}
+
+// ArtifactVerification pins the digest of an artifact eksctl deploys, a Helm chart archive or the flux binary,
+// and verifies its signature. The artifact is rejected before it's deployed if either check fails
+type ArtifactVerification struct {
+	// Digest is the SHA-256 digest of the artifact, e.g. `sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae`
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// Signature is the path or URL of the cosign signature of the artifact, verified with `cosign.key`
+	// +optional
+	Signature string `json:"signature,omitempty"`
+}
+
+// artifactDigest matches the SHA-256 digests of artifacts
+var artifactDigest = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// validateProvenance validates cosign and the verifications of the charts, Karpenter and Flux
+func validateProvenance(cfg *ClusterConfig) error {
+	if cfg.Cosign != nil && cfg.Cosign.Key == "" {
+		return errors.New("cosign.key must be set")
+	}
+
+	validate := func(v *ArtifactVerification, path string) error {
+		if v == nil {
+			return nil
+		}
+		if v.Digest == "" && v.Signature == "" {
+			return fmt.Errorf("at least one of %[1]s.digest and %[1]s.signature must be set", path)
+		}
+		if v.Digest != "" && !artifactDigest.MatchString(v.Digest) {
+			return fmt.Errorf("%s.digest %q must be a SHA-256 digest in the format sha256:<hex>", path, v.Digest)
+		}
+		if v.Signature != "" && cfg.Cosign == nil {
+			return fmt.Errorf("%s.signature requires cosign.key to be set", path)
+		}
+		return nil
+	}
+
+	if cfg.Bootstrap != nil {
+		for i, chart := range cfg.Bootstrap.HelmCharts {
+			if err := validate(chart.Verification, fmt.Sprintf("bootstrap.helmCharts[%d].verification", i)); err != nil {
+				return err
+			}
+		}
+	}
+	for i, chart := range cfg.Charts {
+		if err := validate(chart.Verification, fmt.Sprintf("charts[%d].verification", i)); err != nil {
+			return err
+		}
+	}
+	if cfg.Karpenter != nil {
+		if err := validate(cfg.Karpenter.ChartVerification, "karpenter.chartVerification"); err != nil {
+			return err
+		}
+	}
+	if cfg.HasGitOpsFluxConfigured() {
+		if err := validate(cfg.GitOps.Flux.BinaryVerification, "gitops.flux.binaryVerification"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
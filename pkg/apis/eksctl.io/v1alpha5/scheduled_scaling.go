@@ -0,0 +1,134 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var cronFieldRegexp = regexp.MustCompile(`^[0-9A-Za-z*/,-]+$`)
+
+// cronFields returns the minute, hour, day-of-month, month and day-of-week fields of a Unix cron schedule
+func cronFields(cron string) ([]string, error) {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%q must have 5 fields (minute, hour, day of month, month, day of week)", cron)
+	}
+	for _, field := range fields {
+		if !cronFieldRegexp.MatchString(field) {
+			return nil, fmt.Errorf("%q has an invalid field %q", cron, field)
+		}
+	}
+	return fields, nil
+}
+
+// ScheduleExpression returns the schedule in the cron format of EventBridge, which requires
+// either the day of month or the day of week to be `?` and numbers days of week from 1 (Sunday)
+func (a ScheduledScalingAction) ScheduleExpression() (string, error) {
+	fields, err := cronFields(a.Cron)
+	if err != nil {
+		return "", err
+	}
+	dayOfMonth, dayOfWeek := fields[2], fields[4]
+	switch {
+	case dayOfWeek == "*":
+		dayOfWeek = "?"
+	case dayOfMonth == "*":
+		dayOfMonth = "?"
+		if dayOfWeek, err = eventBridgeDayOfWeek(dayOfWeek); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("%q cannot restrict both the day of month and the day of week", a.Cron)
+	}
+	return fmt.Sprintf("cron(%s %s %s %s %s *)", fields[0], fields[1], dayOfMonth, fields[3], dayOfWeek), nil
+}
+
+// eventBridgeDayOfWeek renumbers the days of week from Unix cron, 0 or 7 for Sunday, to EventBridge, 1 for Sunday
+func eventBridgeDayOfWeek(field string) (string, error) {
+	renumber := func(days string) (string, error) {
+		var renumbered []string
+		for _, day := range strings.Split(days, "-") {
+			n, err := strconv.Atoi(day)
+			if err != nil {
+				// day names are the same in both formats
+				renumbered = append(renumbered, day)
+				continue
+			}
+			if n < 0 || n > 7 {
+				return "", fmt.Errorf("invalid day of week %d", n)
+			}
+			renumbered = append(renumbered, strconv.Itoa(n%7+1))
+		}
+		return strings.Join(renumbered, "-"), nil
+	}
+
+	var parts []string
+	for _, part := range strings.Split(field, ",") {
+		// only the days before a step are renumbered, e.g. `1-5/2`
+		days, step, hasStep := strings.Cut(part, "/")
+		renumbered, err := renumber(days)
+		if err != nil {
+			return "", err
+		}
+		if hasStep {
+			renumbered += "/" + step
+		}
+		parts = append(parts, renumbered)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+func validateScheduledScaling(np NodePool, path string) error {
+	_, isManaged := np.(*ManagedNodeGroup)
+	for i, action := range np.BaseNodeGroup().ScheduledScaling {
+		actionPath := fmt.Sprintf("%s.scheduledScaling[%d]", path, i)
+		if action.Cron == "" {
+			return fmt.Errorf("%s.cron must be set", actionPath)
+		}
+		if _, err := cronFields(action.Cron); err != nil {
+			return fmt.Errorf("%s.cron %s", actionPath, err)
+		}
+		if isManaged {
+			// managed nodegroups are scaled by EventBridge Scheduler
+			if _, err := action.ScheduleExpression(); err != nil {
+				return fmt.Errorf("%s.cron %s", actionPath, err)
+			}
+		}
+
+		if action.MinSize == nil && action.MaxSize == nil && action.DesiredCapacity == nil {
+			return fmt.Errorf("at least one of %[1]s.minSize, %[1]s.maxSize or %[1]s.desiredCapacity must be set", actionPath)
+		}
+		for _, size := range []struct {
+			field string
+			value *int
+		}{{"minSize", action.MinSize}, {"maxSize", action.MaxSize}, {"desiredCapacity", action.DesiredCapacity}} {
+			if size.value != nil && *size.value < 0 {
+				return fmt.Errorf("%s.%s cannot be negative", actionPath, size.field)
+			}
+		}
+		if isManaged && action.MaxSize != nil && *action.MaxSize < 1 {
+			return fmt.Errorf("%s.maxSize must be at least 1 for managed nodegroups", actionPath)
+		}
+		if action.MinSize != nil && action.MaxSize != nil && *action.MinSize > *action.MaxSize {
+			return fmt.Errorf("%[1]s.minSize cannot be greater than %[1]s.maxSize", actionPath)
+		}
+		if action.DesiredCapacity != nil {
+			if action.MinSize != nil && *action.DesiredCapacity < *action.MinSize {
+				return fmt.Errorf("%[1]s.desiredCapacity cannot be less than %[1]s.minSize", actionPath)
+			}
+			if action.MaxSize != nil && *action.DesiredCapacity > *action.MaxSize {
+				return fmt.Errorf("%[1]s.desiredCapacity cannot be greater than %[1]s.maxSize", actionPath)
+			}
+		}
+
+		if action.TimeZone != "" {
+			if _, err := time.LoadLocation(action.TimeZone); err != nil {
+				return fmt.Errorf("%s.timezone %q is not a valid IANA time zone", actionPath, action.TimeZone)
+			}
+		}
+	}
+	return nil
+}
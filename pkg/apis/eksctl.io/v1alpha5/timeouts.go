@@ -0,0 +1,90 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"time"
+)
+
+// Timeouts overrides the timeout set with `--timeout` for individual phases of cluster and nodegroup creation,
+// durations are specified as e.g. `40m` or `1h30m`
+type Timeouts struct {
+	// StackCreation is the maximum time to wait for each CloudFormation stack to be created
+	// +optional
+	StackCreation string `json:"stackCreation,omitempty"`
+	// ControlPlaneReady is the maximum time to wait for the Kubernetes API of a new cluster to become reachable
+	// +optional
+	ControlPlaneReady string `json:"controlPlaneReady,omitempty"`
+	// NodesReady is the maximum time to wait for the nodes of each nodegroup to join the cluster and become ready
+	// +optional
+	NodesReady string `json:"nodesReady,omitempty"`
+	// AddonActive is the maximum time to wait for each addon to become active
+	// +optional
+	AddonActive string `json:"addonActive,omitempty"`
+}
+
+// StackCreationTimeout returns the stack creation timeout, or defaultTimeout if it is not set
+func (t *Timeouts) StackCreationTimeout(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+	return durationOrDefault(t.StackCreation, defaultTimeout)
+}
+
+// ControlPlaneReadyTimeout returns the control plane readiness timeout, or defaultTimeout if it is not set
+func (t *Timeouts) ControlPlaneReadyTimeout(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+	return durationOrDefault(t.ControlPlaneReady, defaultTimeout)
+}
+
+// NodesReadyTimeout returns the nodes readiness timeout, or defaultTimeout if it is not set
+func (t *Timeouts) NodesReadyTimeout(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+	return durationOrDefault(t.NodesReady, defaultTimeout)
+}
+
+// AddonActiveTimeout returns the addon activation timeout, or defaultTimeout if it is not set
+func (t *Timeouts) AddonActiveTimeout(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+	return durationOrDefault(t.AddonActive, defaultTimeout)
+}
+
+// durationOrDefault parses a duration that has already been validated
+func durationOrDefault(duration string, defaultDuration time.Duration) time.Duration {
+	if duration == "" {
+		return defaultDuration
+	}
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return defaultDuration
+	}
+	return d
+}
+
+func (t *Timeouts) validate() error {
+	if t == nil {
+		return nil
+	}
+	for _, timeout := range []struct {
+		name     string
+		duration string
+	}{
+		{"stackCreation", t.StackCreation},
+		{"controlPlaneReady", t.ControlPlaneReady},
+		{"nodesReady", t.NodesReady},
+		{"addonActive", t.AddonActive},
+	} {
+		if timeout.duration == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(timeout.duration); err != nil || d <= 0 {
+			return fmt.Errorf("timeouts.%s: %q is not a valid positive duration", timeout.name, timeout.duration)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package v1alpha5
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timeouts", func() {
+	It("falls back to the default timeout for phases that are not set", func() {
+		var unset *Timeouts
+		Expect(unset.StackCreationTimeout(25 * time.Minute)).To(Equal(25 * time.Minute))
+
+		timeouts := &Timeouts{
+			StackCreation: "1h30m",
+			NodesReady:    "10m",
+		}
+		Expect(timeouts.StackCreationTimeout(25 * time.Minute)).To(Equal(90 * time.Minute))
+		Expect(timeouts.ControlPlaneReadyTimeout(25 * time.Minute)).To(Equal(25 * time.Minute))
+		Expect(timeouts.NodesReadyTimeout(25 * time.Minute)).To(Equal(10 * time.Minute))
+		Expect(timeouts.AddonActiveTimeout(25 * time.Minute)).To(Equal(25 * time.Minute))
+	})
+
+	It("validates durations", func() {
+		Expect((&Timeouts{ControlPlaneReady: "5m"}).validate()).To(Succeed())
+		Expect((&Timeouts{AddonActive: "ten minutes"}).validate()).To(MatchError(`timeouts.addonActive: "ten minutes" is not a valid positive duration`))
+		Expect((&Timeouts{NodesReady: "-1m"}).validate()).To(MatchError(`timeouts.nodesReady: "-1m" is not a valid positive duration`))
+	})
+})
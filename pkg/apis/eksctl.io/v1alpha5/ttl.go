@@ -0,0 +1,45 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"time"
+)
+
+// SetExpiresAtTag tags the resources of a cluster with a TTL with the time it expires at, counting from now. The tag
+// isn't changed if it's already set
+func (c *ClusterMeta) SetExpiresAtTag(now time.Time) {
+	if c.TTL == "" {
+		return
+	}
+	if c.Tags == nil {
+		c.Tags = map[string]string{}
+	}
+	if _, ok := c.Tags[ExpiresAtTag]; ok {
+		return
+	}
+	c.Tags[ExpiresAtTag] = now.Add(durationOrDefault(c.TTL, 0)).UTC().Format(time.RFC3339)
+}
+
+// ExpiresAt returns the time a cluster expires at from the tags of its resources, it returns false if the cluster
+// doesn't have a TTL
+func ExpiresAt(tags map[string]string) (time.Time, bool, error) {
+	value, ok := tags[ExpiresAtTag]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s tag %q: %w", ExpiresAtTag, value, err)
+	}
+	return expiresAt, true, nil
+}
+
+func (c *ClusterMeta) validateTTL() error {
+	if c.TTL == "" {
+		return nil
+	}
+	if d, err := time.ParseDuration(c.TTL); err != nil || d <= 0 {
+		return fmt.Errorf("metadata.ttl: %q is not a valid positive duration", c.TTL)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package v1alpha5
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TTL", func() {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	It("tags the resources with the time the cluster expires at", func() {
+		meta := &ClusterMeta{Name: "sandbox", TTL: "72h"}
+		meta.SetExpiresAtTag(now)
+		Expect(meta.Tags).To(Equal(map[string]string{ExpiresAtTag: "2022-03-04T11:00:00Z"}))
+
+		expiresAt, ok, err := ExpiresAt(meta.Tags)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(expiresAt.Equal(now.Add(72 * time.Hour))).To(BeTrue())
+	})
+
+	It("doesn't change the tag if it's already set", func() {
+		meta := &ClusterMeta{Name: "sandbox", TTL: "72h", Tags: map[string]string{ExpiresAtTag: "2022-03-02T00:00:00Z"}}
+		meta.SetExpiresAtTag(now)
+		Expect(meta.Tags[ExpiresAtTag]).To(Equal("2022-03-02T00:00:00Z"))
+	})
+
+	It("doesn't tag clusters without a TTL", func() {
+		meta := &ClusterMeta{Name: "sandbox"}
+		meta.SetExpiresAtTag(now)
+		Expect(meta.Tags).To(BeNil())
+
+		_, ok, err := ExpiresAt(map[string]string{ClusterNameTag: "sandbox"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+
+	It("rejects invalid tags", func() {
+		_, _, err := ExpiresAt(map[string]string{ExpiresAtTag: "tomorrow"})
+		Expect(err).To(MatchError(ContainSubstring(`invalid alpha.eksctl.io/expires-at tag "tomorrow"`)))
+	})
+
+	It("validates the TTL", func() {
+		Expect((&ClusterMeta{TTL: "1h30m"}).validateTTL()).To(Succeed())
+		Expect((&ClusterMeta{TTL: "3d"}).validateTTL()).To(MatchError(`metadata.ttl: "3d" is not a valid positive duration`))
+		Expect((&ClusterMeta{TTL: "-1h"}).validateTTL()).To(MatchError(`metadata.ttl: "-1h" is not a valid positive duration`))
+	})
+})
@@ -9,12 +9,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
-	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -192,6 +191,13 @@ const (
 	ContainerRuntimeDockerForWindows = "docker"
 )
 
+// Values for `Tenancy`.
+const (
+	TenancyDefault   = "default"
+	TenancyDedicated = "dedicated"
+	TenancyHost      = "host"
+)
+
 const (
 	// DefaultNodeType is the default instance type to use for nodes
 	DefaultNodeType = "m5.large"
@@ -204,6 +210,9 @@ const (
 	// NodeImageResolverAutoSSM is used to indicate that the latest EKS AMIs should be used for the nodes. The AMI is selected
 	// using an SSM GetParameter query
 	NodeImageResolverAutoSSM = "auto-ssm"
+	// NodeImageResolverImageBuilderPrefix is the prefix of an EC2 Image Builder pipeline ARN in the AMI field, the latest
+	// image built by the pipeline is used for the nodes
+	NodeImageResolverImageBuilderPrefix = "imagebuilder:"
 
 	// EksctlVersionTag defines the version of eksctl which is used to provision or update EKS cluster
 	EksctlVersionTag = "alpha.eksctl.io/eksctl-version"
@@ -226,6 +235,9 @@ const (
 	// OldNodeGroupIDTag defines the old version of tag of the nodegroup name
 	OldNodeGroupIDTag = "eksctl.cluster.k8s.io/v1alpha1/nodegroup-id"
 
+	// DetachedFromNodeGroupTag defines the tag of instances detached from a nodegroup before its deletion
+	DetachedFromNodeGroupTag = "alpha.eksctl.io/detached-from-nodegroup"
+
 	// IAMServiceAccountNameTag defines the tag of the IAM service account name
 	IAMServiceAccountNameTag = "alpha.eksctl.io/iamserviceaccount-name"
 
@@ -238,12 +250,21 @@ const (
 	// NodeGroupNameLabel defines the label of the nodegroup name
 	NodeGroupNameLabel = "alpha.eksctl.io/nodegroup-name"
 
+	// SecurityGroupsForPodsLabel defines the label of nodegroups that support security groups for pods
+	SecurityGroupsForPodsLabel = "alpha.eksctl.io/security-groups-for-pods"
+
 	// KarpenterNameTag defines the tag of the Karpenter stack name
 	KarpenterNameTag = "alpha.eksctl.io/karpenter-name"
 
 	// KarpenterVersionTag defines the tag for Karpenter's version
 	KarpenterVersionTag = "alpha.eksctl.io/karpenter-version"
 
+	// ImageBuildVersionTag defines the tag of the EC2 Image Builder image build version the nodegroup AMI was resolved to
+	ImageBuildVersionTag = "alpha.eksctl.io/image-build-version"
+
+	// ExpiresAtTag defines the tag of the time a cluster with a TTL expires at, in RFC 3339 format
+	ExpiresAtTag = "alpha.eksctl.io/expires-at"
+
 	EKSNodeGroupNameLabel = "eks.amazonaws.com/nodegroup"
 
 	// SpotAllocationStrategyLowestPrice defines the ASG spot allocation strategy of lowest-price
@@ -438,6 +459,16 @@ func Partition(region string) string {
 	}
 }
 
+// IsOptInRegion returns whether a region has to be enabled for an account before it can be used
+func IsOptInRegion(region string) bool {
+	switch region {
+	case RegionAFSouth1, RegionAPEast1, RegionEUSouth1, RegionMESouth1:
+		return true
+	default:
+		return false
+	}
+}
+
 // DeprecatedVersions are the versions of Kubernetes that EKS used to support
 // but no longer does. See also:
 // https://docs.aws.amazon.com/eks/latest/userguide/kubernetes-versions.html
@@ -562,12 +593,24 @@ type ClusterMeta struct {
 	// the AWS region hosting this cluster
 	// +required
 	Region string `json:"region"`
+	// EndpointsMode selects the AWS API endpoints eksctl calls, overriding the `--fips` and `--dual-stack` flags.
+	// Valid variants are `EndpointsMode` constants
+	// +optional
+	EndpointsMode string `json:"endpointsMode,omitempty"`
+	// ServiceEndpoints overrides the endpoint URL of AWS services, keyed by service, e.g. `ec2` or `cloudformation`.
+	// They take precedence over the `AWS_<SERVICE>_ENDPOINT` environment variables
+	// +optional
+	ServiceEndpoints map[string]string `json:"serviceEndpoints,omitempty"`
 	// Valid variants are `KubernetesVersion` constants
 	// +optional
 	Version string `json:"version,omitempty"`
 	// Tags are used to tag AWS resources created by eksctl
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+	// TTL is how long the cluster is kept after it's created, e.g. `72h`. The resources created with the cluster are
+	// tagged with the time it expires at, and `eksctl utils reap-expired` deletes the clusters that expired
+	// +optional
+	TTL string `json:"ttl,omitempty"`
 	// Annotations are arbitrary metadata ignored by `eksctl`.
 	// +optional
 	Annotations map[string]string `json:"annotations,omitempty"`
@@ -580,6 +623,8 @@ type KubernetesNetworkConfig struct {
 	IPFamily string `json:"ipFamily,omitempty"`
 	// ServiceIPv4CIDR is the CIDR range from where `ClusterIP`s are assigned
 	ServiceIPv4CIDR string `json:"serviceIPv4CIDR,omitempty"`
+	// ServiceIPv6CIDR is the CIDR range assigned by EKS to IPv6 clusters, this is a read-only field
+	ServiceIPv6CIDR string `json:"-"`
 }
 
 func (k *KubernetesNetworkConfig) IPv6Enabled() bool {
@@ -650,8 +695,9 @@ type ClusterProvider interface {
 	CloudFormation() awsapi.CloudFormation
 	CloudFormationRoleARN() string
 	CloudFormationDisableRollback() bool
+	CloudFormationExplainFailures() bool
 	ASG() awsapi.ASG
-	EKS() eksiface.EKSAPI
+	EKS() awsapi.EKS
 	SSM() awsapi.SSM
 	CloudTrail() awsapi.CloudTrail
 	CloudWatchLogs() awsapi.CloudWatchLogs
@@ -659,7 +705,10 @@ type ClusterProvider interface {
 	Region() string
 	Profile() string
 	WaitTimeout() time.Duration
-	ConfigProvider() client.ConfigProvider
+	// WaiterMaxDelay is the maximum delay between the attempts of waiters, zero uses the default of each waiter
+	WaiterMaxDelay() time.Duration
+	EnsureCredentials(ctx context.Context, lifetime time.Duration) error
+	// Session is the AWS SDK v1 session, only used by the instance selector
 	Session() *session.Session
 
 	ELB() awsapi.ELB
@@ -667,10 +716,20 @@ type ClusterProvider interface {
 	STS() awsapi.STS
 	STSPresigner() STSPresigner
 	EC2() awsapi.EC2
+	ServiceQuotas() awsapi.ServiceQuotas
+	ImageBuilder() awsapi.ImageBuilder
+	AMP() awsapi.AMP
+	Grafana() awsapi.Grafana
+	GuardDuty() awsapi.GuardDuty
+	KMS() awsapi.KMS
+	Pricing() awsapi.Pricing
+	CloudWatch() awsapi.CloudWatch
+	SecretsManager() awsapi.SecretsManager
 }
 
 // STSPresigner defines the method to pre-sign GetCallerIdentity requests to add a proper header required by EKS for
 // authentication from the outside.
+//
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 //counterfeiter:generate -o fakes/fake_sts_presigner.go . STSPresigner
 type STSPresigner interface {
@@ -681,10 +740,84 @@ type STSPresigner interface {
 type ProviderConfig struct {
 	CloudFormationRoleARN         string
 	CloudFormationDisableRollback bool
+	// CloudFormationExplainFailures looks up the IAM actions that were denied to stacks that failed in CloudTrail
+	CloudFormationExplainFailures bool
 
 	Region      string
 	Profile     string
 	WaitTimeout time.Duration
+
+	Retry RetryConfig
+
+	// FailOnCredentialsExpiry makes long-running operations fail before they start
+	// when the credentials expire before the operation is expected to complete
+	FailOnCredentialsExpiry bool
+
+	// AuditFile is the JSON Lines file mutating AWS API calls are recorded in
+	AuditFile string
+	// AuditLogGroup is the CloudWatch Logs log group mutating AWS API calls are also sent to
+	AuditLogGroup string
+
+	// EndpointsMode is one of the EndpointsMode constants
+	EndpointsMode string
+	// ServiceEndpoints are the endpoint URLs of AWS services, keyed by service
+	ServiceEndpoints map[string]string
+
+	// DryProvider is a local emulator of AWS APIs that's called instead of AWS, only DryProviderLocalStack
+	// is supported
+	DryProvider string
+
+	// MetadataBundle is the file exported by `eksctl utils export-metadata` that AMIs, instance types and addon
+	// versions are looked up in instead of AWS, for regions where these lookups fail
+	MetadataBundle string
+
+	// AssumeRoleARN is a role assumed with the credentials of the profile or the environment, e.g. the web identity
+	// of a CI runner, to call AWS with
+	AssumeRoleARN string
+	// RoleSessionName is the session name of AssumeRoleARN
+	RoleSessionName string
+	// SessionTags are the session tags of the roles eksctl assumes, AssumeRoleARN and the roles of profiles
+	// that set role_arn
+	SessionTags map[string]string
+	// SourceIdentity is the source identity of the roles eksctl assumes, e.g. the name of the operator, which
+	// CloudTrail records for every call made with the role
+	SourceIdentity string
+	// STSRegion is the region of the STS endpoint credentials are obtained from, it defaults to Region
+	STSRegion string
+}
+
+// DryProviderLocalStack calls LocalStack instead of AWS
+const DryProviderLocalStack = "localstack"
+
+// Values for `EndpointsMode`
+const (
+	// EndpointsModeDefault uses the standard endpoints of AWS services (default)
+	EndpointsModeDefault = "default"
+	// EndpointsModeFIPS uses the FIPS 140-2 validated endpoints of AWS services
+	EndpointsModeFIPS = "fips"
+	// EndpointsModeDualStack uses the dual-stack endpoints of AWS services, which are reachable from IPv6-only clients
+	EndpointsModeDualStack = "dualstack"
+)
+
+// Values for RetryConfig.Mode
+const (
+	// RetryModeStandard retries API calls with exponential backoff
+	RetryModeStandard = "standard"
+	// RetryModeAdaptive additionally rate limits API calls on the client side when they are being throttled
+	RetryModeAdaptive = "adaptive"
+)
+
+// RetryConfig holds the retry settings of the AWS API clients, zero values use the defaults
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts for an API call
+	MaxAttempts int
+	// Mode is either RetryModeStandard or RetryModeAdaptive
+	Mode string
+
+	// Per-service overrides of MaxAttempts
+	EC2MaxAttempts            int
+	CloudFormationMaxAttempts int
+	EKSMaxAttempts            int
 }
 
 // +genclient
@@ -712,12 +845,38 @@ type ClusterConfig struct {
 	// +optional
 	Addons []*Addon `json:"addons,omitempty"`
 
+	// VPCCNI configures the VPC CNI plugin
+	// +optional
+	VPCCNI *VPCCNI `json:"vpcCNI,omitempty"`
+
+	// AccessConfig configures how IAM principals are granted access to the cluster
+	// +optional
+	AccessConfig *AccessConfig `json:"accessConfig,omitempty"`
+
+	// Timeouts overrides the `--timeout` flag for individual phases of cluster and nodegroup creation
+	// +optional
+	Timeouts *Timeouts `json:"timeouts,omitempty"`
+
+	// MaintenanceWindow is when `eksctl upgrade cluster` applies upgrades, it waits for the window to open
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// Budget creates an AWS Budgets cost budget for the cluster when it's created
+	// +optional
+	Budget *ClusterBudget `json:"budget,omitempty"`
+
 	// PrivateCluster allows configuring a fully-private cluster
 	// in which no node has outbound internet access, and private access
 	// to AWS services is enabled via VPC endpoints
 	// +optional
 	PrivateCluster *PrivateCluster `json:"privateCluster,omitempty"`
 
+	// ImageRepositoryOverride is a registry, optionally followed by a path, e.g. `mycorp.jfrog.io/eks`,
+	// that replaces the registry of the images eksctl deploys (aws-node, coredns, kube-proxy, device plugins,
+	// the VPC controller and Karpenter), for clusters that can't pull from public registries
+	// +optional
+	ImageRepositoryOverride string `json:"imageRepositoryOverride,omitempty"`
+
 	// NodeGroups For information and examples see [nodegroups](/usage/managing-nodegroups)
 	// +optional
 	NodeGroups []*NodeGroup `json:"nodeGroups,omitempty"`
@@ -727,6 +886,10 @@ type ClusterConfig struct {
 	// +optional
 	ManagedNodeGroups []*ManagedNodeGroup `json:"managedNodeGroups,omitempty"`
 
+	// NodeGroupDefaults holds settings applied to all nodegroups, both managed and self-managed
+	// +optional
+	NodeGroupDefaults *NodeGroupDefaults `json:"nodeGroupDefaults,omitempty"`
+
 	// +optional
 	FargateProfiles []*FargateProfile `json:"fargateProfiles,omitempty"`
 
@@ -749,6 +912,25 @@ type ClusterConfig struct {
 	// Karpenter specific configuration options.
 	// +optional
 	Karpenter *Karpenter `json:"karpenter,omitempty"`
+
+	// Observability configures Amazon Managed Service for Prometheus and Amazon Managed Grafana.
+	// See [monitoring](/usage/monitoring/)
+	// +optional
+	Observability *Observability `json:"observability,omitempty"`
+
+	// Bootstrap holds Kubernetes manifests and Helm charts to apply once the cluster and its nodegroups are ready.
+	// See [bootstrapping clusters](/usage/bootstrap/)
+	// +optional
+	Bootstrap *Bootstrap `json:"bootstrap,omitempty"`
+
+	// Charts are Helm charts installed once the cluster, its nodegroups and `bootstrap` are ready.
+	// See [Helm charts](/usage/helm-charts/)
+	// +optional
+	Charts []*HelmChart `json:"charts,omitempty"`
+
+	// Cosign configures how the signatures of Helm charts and the flux binary are verified
+	// +optional
+	Cosign *Cosign `json:"cosign,omitempty"`
 }
 
 // Karpenter provides configuration opti
@@ -762,6 +944,36 @@ type Karpenter struct {
 	// DefaultInstanceProfile override the default IAM instance profile
 	// +optional
 	DefaultInstanceProfile *string `json:"defaultInstanceProfile,omitempty"`
+	// ChartVerification pins the digest of the Karpenter chart archive and verifies its signature
+	// +optional
+	ChartVerification *ArtifactVerification `json:"chartVerification,omitempty"`
+}
+
+// NodeGroupDefaults holds settings that apply to all nodegroups in the cluster
+type NodeGroupDefaults struct {
+	// AutoScaler adds the tags used by cluster-autoscaler for auto-discovery to the
+	// ASGs of all nodegroups, along with node-template tags for labels and taints
+	// so that nodegroups can be scaled from zero
+	// +optional
+	AutoScaler *bool `json:"autoScaler,omitempty"`
+
+	// BootstrapCommandTemplates renders the `preBootstrapCommands` and
+	// `overrideBootstrapCommand` of all nodegroups as Go templates, like
+	// `preBootstrapCommandSnippets`. Literal `{{` must be written as `{{"{{"}}`
+	// +optional
+	BootstrapCommandTemplates *bool `json:"bootstrapCommandTemplates,omitempty"`
+
+	// PreBootstrapCommandSnippets are named lists of commands that nodegroups can
+	// reference in `preBootstrapCommandRefs`. Commands are Go templates rendered with
+	// the cluster metadata as `.Cluster` and the nodegroup as `.NodeGroup`, and the
+	// functions `clusterName`, `region`, `b64` and `tojson`
+	// +optional
+	PreBootstrapCommandSnippets map[string][]string `json:"preBootstrapCommandSnippets,omitempty"`
+
+	// PreBootstrapCommandSnippetsFile is the path to a YAML file of additional snippets,
+	// relative to the config file
+	// +optional
+	PreBootstrapCommandSnippetsFile string `json:"preBootstrapCommandSnippetsFile,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -845,11 +1057,18 @@ func (c *ClusterConfig) IPv6Enabled() bool {
 	return c.KubernetesNetworkConfig != nil && c.KubernetesNetworkConfig.IPv6Enabled()
 }
 
-// SetClusterStatus populates ClusterStatus using *eks.Cluster.
-func (c *ClusterConfig) SetClusterStatus(cluster *eks.Cluster) error {
-	if networkConfig := cluster.KubernetesNetworkConfig; networkConfig != nil && networkConfig.ServiceIpv4Cidr != nil {
+// AutoScalerTagsEnabled reports whether cluster-autoscaler tags should be added to all nodegroups
+func (c *ClusterConfig) AutoScalerTagsEnabled() bool {
+	return c.NodeGroupDefaults != nil && IsEnabled(c.NodeGroupDefaults.AutoScaler)
+}
+
+// SetClusterStatus populates ClusterStatus using *ekstypes.Cluster.
+func (c *ClusterConfig) SetClusterStatus(cluster *ekstypes.Cluster) error {
+	if networkConfig := cluster.KubernetesNetworkConfig; networkConfig != nil && (networkConfig.ServiceIpv4Cidr != nil || networkConfig.ServiceIpv6Cidr != nil) {
 		c.Status.KubernetesNetworkConfig = &KubernetesNetworkConfig{
-			ServiceIPv4CIDR: *networkConfig.ServiceIpv4Cidr,
+			IPFamily:        string(networkConfig.IpFamily),
+			ServiceIPv4CIDR: aws.ToString(networkConfig.ServiceIpv4Cidr),
+			ServiceIPv6CIDR: aws.ToString(networkConfig.ServiceIpv6Cidr),
 		}
 	}
 	data, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
@@ -960,9 +1179,6 @@ type NodeGroup struct {
 	//+optional
 	InstancesDistribution *NodeGroupInstancesDistribution `json:"instancesDistribution,omitempty"`
 
-	// +optional
-	ASGMetricsCollection []MetricsCollection `json:"asgMetricsCollection,omitempty"`
-
 	// CPUCredits configures [T3 Unlimited](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/burstable-performance-instances-unlimited-mode.html), valid only for T-type instances
 	// +optional
 	CPUCredits *string `json:"cpuCredits,omitempty"`
@@ -1005,6 +1221,94 @@ type NodeGroup struct {
 	// MaxInstanceLifetime defines the maximum amount of time in seconds an instance stays alive.
 	// +optional
 	MaxInstanceLifetime *int `json:"maxInstanceLifetime,omitempty"`
+
+	// Alarms creates CloudWatch alarms for the nodegroup in its stack
+	// +optional
+	Alarms *NodeGroupAlarms `json:"alarms,omitempty"`
+
+	// CapacityBlock launches the instances of a GPU nodegroup in an [EC2 Capacity Block for
+	// ML](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-capacity-blocks.html). The nodegroup is
+	// scaled to zero outside the reservation period of the block.
+	// +optional
+	CapacityBlock *CapacityBlock `json:"capacityBlock,omitempty"`
+
+	// ElasticIPs associates an Elastic IP with each node when it boots, giving nodes in
+	// public subnets a stable public IPv4 address for egress without a NAT gateway
+	// +optional
+	ElasticIPs *NodeGroupElasticIPs `json:"elasticIPs,omitempty"`
+}
+
+// CPUOptions holds the [CPU options](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-optimize-cpu.html)
+// of the instances of a nodegroup
+type CPUOptions struct {
+	// CoreCount is the number of CPU cores of the instances
+	// +required
+	CoreCount int `json:"coreCount"`
+
+	// ThreadsPerCore is the number of threads per CPU core, `1` disables hyperthreading
+	// +required
+	ThreadsPerCore int `json:"threadsPerCore"`
+}
+
+// CapacityBlock holds the configuration of the Capacity Block for ML a nodegroup launches its instances in
+type CapacityBlock struct {
+	// ReservationID is the ID of the Capacity Reservation of the Capacity Block, e.g. `cr-0123456789abcdef0`
+	// +required
+	ReservationID string `json:"reservationID"`
+}
+
+// NodeGroupElasticIPs holds the Elastic IPs associated with the nodes of a nodegroup.
+// Exactly one of `allocate` or `allocationIDs` must be set
+type NodeGroupElasticIPs struct {
+	// Allocate creates an Elastic IP for each node, up to `maxSize`, in the
+	// nodegroup stack. They are released when the nodegroup is deleted
+	// +optional
+	Allocate *bool `json:"allocate,omitempty"`
+
+	// PublicIPv4Pool is the ID of the address pool, e.g. a BYOIP pool like
+	// `ipv4pool-ec2-0123456789abcdef0`, that allocated Elastic IPs come from
+	// +optional
+	PublicIPv4Pool string `json:"publicIPv4Pool,omitempty"`
+
+	// AllocationIDs of existing Elastic IPs to associate with the nodes, e.g.
+	// `eipalloc-0123456789abcdef0`. There must be at least `maxSize` of them
+	// +optional
+	AllocationIDs []string `json:"allocationIDs,omitempty"`
+}
+
+// NodeGroupAlarms holds the configuration of the CloudWatch alarms created for a nodegroup
+type NodeGroupAlarms struct {
+	// SNSTopicARN is the ARN of the SNS topic notified when an alarm changes state
+	// +required
+	SNSTopicARN string `json:"snsTopicARN"`
+
+	// InServiceCapacity alarms when the number of in-service instances stays below the desired capacity
+	// for 15 minutes. Enables the collection of the required ASG metrics.
+	// Defaults to `true`
+	// +optional
+	InServiceCapacity *bool `json:"inServiceCapacity,omitempty"`
+
+	// StatusCheckFailed alarms when instances of the nodegroup fail EC2 status checks.
+	// Defaults to `true`
+	// +optional
+	StatusCheckFailed *bool `json:"statusCheckFailed,omitempty"`
+
+	// StatusCheckFailedThreshold is the number of failed status checks within 5 minutes that triggers the alarm.
+	// Defaults to `1`
+	// +optional
+	StatusCheckFailedThreshold *int `json:"statusCheckFailedThreshold,omitempty"`
+
+	// SpotInterruptions alarms when the rate of Spot interruption warnings is high. Spot interruption
+	// events do not identify the Auto Scaling group, so warnings for any Spot instance in the account
+	// and region are counted. Requires `instancesDistribution`.
+	// Defaults to `false`
+	// +optional
+	SpotInterruptions *bool `json:"spotInterruptions,omitempty"`
+
+	// SpotInterruptionsThreshold is the number of Spot interruption warnings within 15 minutes that triggers the alarm.
+	// Defaults to `5`
+	// +optional
+	SpotInterruptionsThreshold *int `json:"spotInterruptionsThreshold,omitempty"`
 }
 
 // GetContainerRuntime returns the container runtime.
@@ -1056,6 +1360,9 @@ type Flux struct {
 	// Flags is an arbitrary map of string to string to pass any flags to Flux bootstrap
 	// via eksctl see https://fluxcd.io/docs/ for information on all flags
 	Flags FluxFlags `json:"flags,omitempty"`
+
+	// BinaryVerification pins the digest of the flux binary eksctl runs and verifies its signature
+	BinaryVerification *ArtifactVerification `json:"binaryVerification,omitempty"`
 }
 
 // FluxFlags is a map of string for passing arbitrary flags to Flux bootstrap
@@ -1085,6 +1392,14 @@ type (
 		// Defaults to `true`
 		// +optional
 		WithLocal *bool `json:"withLocal"`
+		// AttachSharedClusterSG attaches the cluster security group
+		// created by EKS, shared by the control plane and all managed
+		// nodegroups. When disabled, nodes only get `attachIDs`, which
+		// must allow the traffic between nodes and the control plane
+		// Only supported for managed nodegroups
+		// Defaults to `true`
+		// +optional
+		AttachSharedClusterSG *bool `json:"attachSharedClusterSG,omitempty"`
 	}
 	// NodeGroupIAM holds all IAM attributes of a NodeGroup
 	NodeGroupIAM struct {
@@ -1104,6 +1419,11 @@ type (
 		InstanceRolePermissionsBoundary string `json:"instanceRolePermissionsBoundary,omitempty"`
 		// +optional
 		WithAddonPolicies NodeGroupIAMAddonPolicies `json:"withAddonPolicies,omitempty"`
+		// SkipAWSAuthConfigMap stops eksctl from adding the instance role to the aws-auth ConfigMap, for when
+		// the ConfigMap is managed outside of eksctl, e.g. with GitOps; the mapRoles entry to add is logged instead.
+		// Defaults to `iam.skipNodeGroupsAWSAuthConfigMap`, not supported by managed nodegroups
+		// +optional
+		SkipAWSAuthConfigMap *bool `json:"skipAWSAuthConfigMap,omitempty"`
 	}
 	// NodeGroupIAMAddonPolicies holds all IAM addon policies
 	NodeGroupIAMAddonPolicies struct {
@@ -1155,8 +1475,13 @@ type (
 		// +optional Public key to be added to the nodes SSH keychain. If Allow is false this value is ignored.
 		PublicKey *string `json:"publicKey,omitempty"`
 		// +optional Public key name in EC2 to be added to the nodes SSH keychain. If Allow is false this value
-		// is ignored.
+		// is ignored. Prefixing the value with `ssm:` or `secretsmanager:` reads the public key from the named
+		// SSM parameter or Secrets Manager secret and imports it in EC2.
 		PublicKeyName *string `json:"publicKeyName,omitempty"`
+		// +optional Public keys appended to the `authorized_keys` of the nodes' default user via user data, in
+		// addition to the key pair. Setting them enables SSH unless Allow is false. Not supported for Bottlerocket
+		// and Windows nodes
+		AuthorizedKeys []string `json:"authorizedKeys,omitempty"`
 		// +optional
 		SourceSecurityGroupIDs []string `json:"sourceSecurityGroupIds,omitempty"`
 		// Enables the ability to [SSH onto nodes using SSM](/introduction#ssh-access)
@@ -1203,6 +1528,21 @@ type (
 		Settings *InlineDocument `json:"settings,omitempty"`
 	}
 
+	// NodeGroupProxy holds the HTTP proxy configuration for nodes
+	NodeGroupProxy struct {
+		// HTTPProxy is the proxy URL used for HTTP requests
+		// +optional
+		HTTPProxy string `json:"httpProxy,omitempty"`
+		// HTTPSProxy is the proxy URL used for HTTPS requests
+		// +optional
+		HTTPSProxy string `json:"httpsProxy,omitempty"`
+		// NoProxy lists hosts, domains and CIDRs that bypass the proxy.
+		// Addresses required for bootstrapping (IMDS, localhost, the VPC and service CIDRs)
+		// are always added
+		// +optional
+		NoProxy []string `json:"noProxy,omitempty"`
+	}
+
 	// NodeGroupUpdateConfig contains the configuration for updating NodeGroups.
 	NodeGroupUpdateConfig struct {
 		// MaxUnavailable sets the max number of nodes that can become unavailable
@@ -1221,8 +1561,11 @@ type (
 // see [cloudformation
 // docs](https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-as-metricscollection.html)
 type MetricsCollection struct {
+	// Granularity of the metrics, the only valid value is `1Minute`
 	// +required
 	Granularity string `json:"granularity"`
+	// Metrics to collect, e.g. `GroupDesiredCapacity` and `GroupInServiceInstances`,
+	// all group metrics are collected when omitted
 	// +optional
 	Metrics []string `json:"metrics,omitempty"`
 }
@@ -1288,6 +1631,11 @@ type NodeGroupBase struct {
 	// Limit nodes to specific subnets
 	// +optional
 	Subnets []string `json:"subnets,omitempty"`
+	// SubnetSelector limits nodes to the subnets of the cluster VPC with all of
+	// these tags, resolved when the nodegroup is created. Values may contain `*`
+	// wildcards, e.g. `{Name: "prod-private-*"}` selects subnets by name
+	// +optional
+	SubnetSelector map[string]string `json:"subnetSelector,omitempty"`
 
 	// +optional
 	InstancePrefix string `json:"instancePrefix,omitempty"`
@@ -1318,7 +1666,7 @@ type NodeGroupBase struct {
 	// +optional
 	IAM *NodeGroupIAM `json:"iam,omitempty"`
 
-	// Specify [custom AMIs](/usage/custom-ami-support/), `auto-ssm`, `auto`, or `static`
+	// Specify [custom AMIs](/usage/custom-ami-support/), `imagebuilder:<pipeline-arn>`, `auto-ssm`, `auto`, or `static`
 	// +optional
 	AMI string `json:"ami,omitempty"`
 
@@ -1333,6 +1681,11 @@ type NodeGroupBase struct {
 	// +optional
 	ASGSuspendProcesses []string `json:"asgSuspendProcesses,omitempty"`
 
+	// ASGMetricsCollection enables the collection of group metrics of the Auto Scaling group,
+	// for managed nodegroups it's enabled on the Auto Scaling group created by EKS
+	// +optional
+	ASGMetricsCollection []MetricsCollection `json:"asgMetricsCollection,omitempty"`
+
 	// EBSOptimized enables [EBS
 	// optimization](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ebs-optimized.html)
 	// +optional
@@ -1361,6 +1714,12 @@ type NodeGroupBase struct {
 	// +optional
 	PreBootstrapCommands []string `json:"preBootstrapCommands,omitempty"`
 
+	// PreBootstrapCommandRefs are the names of snippets defined in
+	// `nodeGroupDefaults.preBootstrapCommandSnippets` whose commands are executed
+	// before `preBootstrapCommands`
+	// +optional
+	PreBootstrapCommandRefs []string `json:"preBootstrapCommandRefs,omitempty"`
+
 	// Override `eksctl`'s bootstrapping script
 	// +optional
 	OverrideBootstrapCommand *string `json:"overrideBootstrapCommand,omitempty"`
@@ -1384,6 +1743,18 @@ type NodeGroupBase struct {
 	// +optional
 	Placement *Placement `json:"placement,omitempty"`
 
+	// Tenancy of the nodes, valid variants are `"default"`, `"dedicated"` and `"host"`.
+	// Defaults to `"default"`
+	// +optional
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// HostResourceGroupARN is the ARN of the host resource group in which
+	// nodes with `host` tenancy are launched, it is required by unmanaged
+	// nodegroups as Auto Scaling groups only launch instances on Dedicated Hosts
+	// through a host resource group
+	// +optional
+	HostResourceGroupARN string `json:"hostResourceGroupARN,omitempty"`
+
 	// EFAEnabled creates the maximum allowed number of EFA-enabled network
 	// cards on nodes in this group.
 	// +optional
@@ -1403,6 +1774,49 @@ type NodeGroupBase struct {
 	// Enable EC2 detailed monitoring
 	// +optional
 	EnableDetailedMonitoring *bool `json:"enableDetailedMonitoring,omitempty"`
+
+	// CPUOptions sets the number of CPU cores and threads per core of the instances, e.g. to disable
+	// hyperthreading. Requires a single instance type.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// Proxy configures the HTTP proxy used by the container runtime and kubelet on nodes
+	// +optional
+	Proxy *NodeGroupProxy `json:"proxy,omitempty"`
+
+	// ExtraCACerts holds PEM-encoded CA certificates that are added to the
+	// trust store of nodes, e.g. for TLS-intercepting proxies
+	// +optional
+	ExtraCACerts []string `json:"extraCACerts,omitempty"`
+
+	// ScheduledScaling changes the size of the nodegroup on a recurring schedule,
+	// e.g. to scale it down outside office hours
+	// +optional
+	ScheduledScaling []ScheduledScalingAction `json:"scheduledScaling,omitempty"`
+}
+
+// ScheduledScalingAction sets the size of a nodegroup on a recurring schedule
+type ScheduledScalingAction struct {
+	// Cron is the schedule in Unix cron format, e.g. `0 8 * * MON-FRI`
+	// +required
+	Cron string `json:"cron"`
+
+	// MinSize is the minimum size of the nodegroup from the scheduled time
+	// +optional
+	MinSize *int `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum size of the nodegroup from the scheduled time
+	// +optional
+	MaxSize *int `json:"maxSize,omitempty"`
+
+	// DesiredCapacity is the desired size of the nodegroup from the scheduled time
+	// +optional
+	DesiredCapacity *int `json:"desiredCapacity,omitempty"`
+
+	// TimeZone is the IANA time zone of the schedule, e.g. `Europe/London`.
+	// Defaults to `UTC`
+	// +optional
+	TimeZone string `json:"timezone,omitempty"`
 }
 
 // Placement specifies placement group information
@@ -1546,6 +1960,11 @@ func IsAMI(amiFlag string) bool {
 	return strings.HasPrefix(amiFlag, "ami-")
 }
 
+// IsImageBuilderAMI returns true if the argument refers to an EC2 Image Builder pipeline
+func IsImageBuilderAMI(amiFlag string) bool {
+	return strings.HasPrefix(amiFlag, NodeImageResolverImageBuilderPrefix)
+}
+
 // FargateProfile defines the settings used to schedule workload onto Fargate.
 type FargateProfile struct {
 
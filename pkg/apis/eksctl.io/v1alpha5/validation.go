@@ -1,23 +1,28 @@
 package v1alpha5
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 
 	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/go-version"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/utils/shell"
 	"github.com/weaveworks/eksctl/pkg/utils/taints"
 
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -79,8 +84,13 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		if ok, err := saNames.checkUnique("<namespace>/<name> of "+path, sa.NameString()); !ok {
 			return err
 		}
-		if !sa.WellKnownPolicies.HasPolicy() && len(sa.AttachPolicyARNs) == 0 && sa.AttachPolicy == nil && sa.AttachRoleARN == "" {
-			return fmt.Errorf("%[1]s.wellKnownPolicies, %[1]s.attachPolicyARNs,%[1]s.attachRoleARN  or %[1]s.attachPolicy must be set", path)
+		if !sa.WellKnownPolicies.HasPolicy() && len(sa.AttachPolicyARNs) == 0 && sa.AttachPolicy == nil && len(sa.AttachPolicyFiles) == 0 && len(sa.AttachPolicyURLs) == 0 && sa.AttachRoleARN == "" {
+			return fmt.Errorf("%[1]s.wellKnownPolicies, %[1]s.attachPolicyARNs,%[1]s.attachRoleARN, %[1]s.attachPolicyFiles, %[1]s.attachPolicyURLs  or %[1]s.attachPolicy must be set", path)
+		}
+		for j, u := range sa.AttachPolicyURLs {
+			if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+				return fmt.Errorf("%s.attachPolicyURLs[%d]: %q is not a valid HTTP(S) URL", path, j, u)
+			}
 		}
 	}
 
@@ -88,6 +98,36 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return err
 	}
 
+	if err := cfg.validateVPCCNI(); err != nil {
+		return err
+	}
+
+	if err := cfg.validateAccessConfig(); err != nil {
+		return err
+	}
+
+	if err := cfg.Timeouts.validate(); err != nil {
+		return err
+	}
+
+	if err := cfg.Metadata.validateTTL(); err != nil {
+		return err
+	}
+
+	if err := cfg.validateBudget(); err != nil {
+		return err
+	}
+
+	if cfg.MaintenanceWindow != nil {
+		if err := cfg.MaintenanceWindow.Validate(); err != nil {
+			return fmt.Errorf("maintenanceWindow: %w", err)
+		}
+	}
+
+	if cfg.ImageRepositoryOverride != "" && !imageRepository.MatchString(cfg.ImageRepositoryOverride) {
+		return fmt.Errorf("imageRepositoryOverride %q must be a registry host optionally followed by a path, e.g. mycorp.jfrog.io/eks, without a scheme, port or tag", cfg.ImageRepositoryOverride)
+	}
+
 	// names must be unique across both managed and unmanaged nodegroups
 	ngNames := nameSet{}
 	validateNg := func(ng *NodeGroupBase, path string) error {
@@ -103,6 +143,19 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return nil
 	}
 
+	// cluster-autoscaler node-template tags are keyed by label and taint keys, which must not overlap
+	validateAutoScalerTags := func(labels map[string]string, taints []NodeGroupTaint, path string) error {
+		if !cfg.AutoScalerTagsEnabled() {
+			return nil
+		}
+		for _, taint := range taints {
+			if _, ok := labels[taint.Key]; ok {
+				return fmt.Errorf("%s: taint key %q must not match a label key when nodeGroupDefaults.autoScaler is enabled", path, taint.Key)
+			}
+		}
+		return nil
+	}
+
 	if err := validateIdentityProviders(cfg.IdentityProviders); err != nil {
 		return err
 	}
@@ -112,6 +165,12 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		if err := validateNg(ng.NodeGroupBase, path); err != nil {
 			return err
 		}
+		if cfg.IPv6Enabled() && !isSupportedIPv6AMIFamily(ng.AMIFamily) {
+			return fmt.Errorf("%s.amiFamily %q is not supported for unmanaged nodegroups in IPv6 clusters, supported families are %s and %s", path, ng.AMIFamily, NodeImageFamilyAmazonLinux2, NodeImageFamilyBottlerocket)
+		}
+		if err := validateAutoScalerTags(ng.Labels, ng.Taints, path); err != nil {
+			return err
+		}
 		if ng.DisableASGTagPropagation != nil {
 			logger.Warning("field DisableASGTagPropagation for nodegroup has been deprecated and has no effect. Please use PropagateASGTags instead for nodegroup %s!", ng.Name)
 		}
@@ -122,9 +181,12 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		if err := validateNg(ng.NodeGroupBase, path); err != nil {
 			return err
 		}
+		if err := validateAutoScalerTags(ng.Labels, ng.Taints, path); err != nil {
+			return err
+		}
 	}
 
-	if err := validateCloudWatchLogging(cfg); err != nil {
+	if err := ValidateCloudWatchLogging(cfg); err != nil {
 		return err
 	}
 
@@ -144,6 +206,118 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return fmt.Errorf("failed to validate karpenter config: %w", err)
 	}
 
+	if err := ValidateObservability(cfg); err != nil {
+		return err
+	}
+
+	if err := validateBootstrap(cfg.Bootstrap); err != nil {
+		return err
+	}
+
+	if err := validateCharts(cfg); err != nil {
+		return err
+	}
+
+	if err := validateProvenance(cfg); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateCharts(cfg *ClusterConfig) error {
+	releases := map[string]bool{}
+	if cfg.Bootstrap != nil {
+		for _, chart := range cfg.Bootstrap.HelmCharts {
+			releases[chart.Namespace+"/"+chart.Name] = true
+		}
+	}
+	for i, chart := range cfg.Charts {
+		path := fmt.Sprintf("charts[%d]", i)
+		for _, field := range []struct{ name, value string }{
+			{"repo", chart.Repo},
+			{"name", chart.Name},
+			{"version", chart.Version},
+		} {
+			if field.value == "" {
+				return fmt.Errorf("%s.%s must be set", path, field.name)
+			}
+		}
+		release := chart.Namespace + "/" + chart.ReleaseName
+		if releases[release] {
+			return fmt.Errorf("%s: release %q is declared more than once", path, release)
+		}
+		releases[release] = true
+	}
+	return nil
+}
+
+func validateBootstrap(bootstrap *Bootstrap) error {
+	if bootstrap == nil {
+		return nil
+	}
+	if bootstrap.Retries != nil && *bootstrap.Retries < 0 {
+		return errors.New("bootstrap.retries cannot be negative")
+	}
+
+	manifestNames := map[string]bool{}
+	for i, m := range bootstrap.Manifests {
+		path := fmt.Sprintf("bootstrap.manifests[%d]", i)
+		if m.Name == "" {
+			return fmt.Errorf("%s.name must be set", path)
+		}
+		if manifestNames[m.Name] {
+			return fmt.Errorf("%s.name %q is not unique", path, m.Name)
+		}
+		manifestNames[m.Name] = true
+
+		sources := 0
+		for _, source := range []string{m.File, m.URL, m.Content} {
+			if source != "" {
+				sources++
+			}
+		}
+		if sources != 1 {
+			return fmt.Errorf("exactly one of %[1]s.file, %[1]s.url and %[1]s.content must be set", path)
+		}
+	}
+
+	releases := map[string]bool{}
+	for i, chart := range bootstrap.HelmCharts {
+		path := fmt.Sprintf("bootstrap.helmCharts[%d]", i)
+		for _, field := range []struct{ name, value string }{
+			{"name", chart.Name},
+			{"repo", chart.Repo},
+			{"chart", chart.Chart},
+			{"version", chart.Version},
+		} {
+			if field.value == "" {
+				return fmt.Errorf("%s.%s must be set", path, field.name)
+			}
+		}
+		release := chart.Namespace + "/" + chart.Name
+		if releases[release] {
+			return fmt.Errorf("%s: release %q is declared more than once", path, release)
+		}
+		releases[release] = true
+	}
+	return nil
+}
+
+// ValidateObservability validates the observability section
+func ValidateObservability(cfg *ClusterConfig) error {
+	o := cfg.Observability
+	if o == nil {
+		return nil
+	}
+	if o.Grafana != nil {
+		if o.Grafana.WorkspaceID == "" {
+			return errors.New("observability.grafana.workspaceID must be set")
+		}
+		if o.Prometheus == nil {
+			return errors.New("observability.grafana requires observability.prometheus to be set")
+		}
+	}
 	return nil
 }
 
@@ -170,12 +344,16 @@ func validateKarpenterConfig(cfg *ClusterConfig) error {
 	return nil
 }
 
-func validateCloudWatchLogging(clusterConfig *ClusterConfig) error {
+// ValidateCloudWatchLogging validates cloudWatch.clusterLogging
+func ValidateCloudWatchLogging(clusterConfig *ClusterConfig) error {
 	if !clusterConfig.HasClusterCloudWatchLogging() {
-		if clusterConfig.CloudWatch != nil &&
-			clusterConfig.CloudWatch.ClusterLogging != nil &&
-			clusterConfig.CloudWatch.ClusterLogging.LogRetentionInDays != 0 {
-			return errors.New("cannot set cloudWatch.clusterLogging.logRetentionInDays without enabling log types")
+		if clusterConfig.CloudWatch != nil && clusterConfig.CloudWatch.ClusterLogging != nil {
+			if clusterConfig.CloudWatch.ClusterLogging.LogRetentionInDays != 0 {
+				return errors.New("cannot set cloudWatch.clusterLogging.logRetentionInDays without enabling log types")
+			}
+			if clusterConfig.CloudWatch.ClusterLogging.LogGroupKMSKeyARN != "" {
+				return errors.New("cannot set cloudWatch.clusterLogging.logGroupKMSKeyARN without enabling log types")
+			}
 		}
 		return nil
 	}
@@ -191,6 +369,11 @@ func validateCloudWatchLogging(clusterConfig *ClusterConfig) error {
 			return errors.Errorf("log type %q (cloudWatch.clusterLogging.enableTypes[%d]) is unknown", logType, i)
 		}
 	}
+	if keyARN := clusterConfig.CloudWatch.ClusterLogging.LogGroupKMSKeyARN; keyARN != "" {
+		if parsed, err := arn.Parse(keyARN); err != nil || parsed.Service != "kms" || !strings.HasPrefix(parsed.Resource, "key/") {
+			return errors.Errorf("invalid value %q for cloudWatch.clusterLogging.logGroupKMSKeyARN; must be the ARN of a KMS key", keyARN)
+		}
+	}
 	if logRetentionDays := clusterConfig.CloudWatch.ClusterLogging.LogRetentionInDays; logRetentionDays != 0 {
 		for _, v := range LogRetentionInDaysValues {
 			if v == logRetentionDays {
@@ -225,7 +408,7 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 		c.VPC.ExtraCIDRs = cidrs
 	}
 	if len(c.VPC.PublicAccessCIDRs) > 0 {
-		cidrs, err := validateCIDRs(c.VPC.PublicAccessCIDRs)
+		cidrs, err := ValidatePublicAccessCIDRs(c.VPC.PublicAccessCIDRs, c.IPv6Enabled())
 		if err != nil {
 			return err
 		}
@@ -263,6 +446,120 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 		return errors.New("vpc.manageSharedNodeSecurityGroupRules must be enabled when using ekstcl-managed security groups")
 	}
 
+	if err := c.validateOpenWebhookPorts(); err != nil {
+		return err
+	}
+
+	if err := c.validateVPCDNS(); err != nil {
+		return err
+	}
+
+	if err := c.validateVPCFlowLogs(); err != nil {
+		return err
+	}
+
+	if c.VPC.HasInstanceConnectEndpoint() && IsDisabled(c.VPC.ManageSharedNodeSecurityGroupRules) {
+		return errors.New("vpc.instanceConnectEndpoint cannot be enabled when vpc.manageSharedNodeSecurityGroupRules is disabled")
+	}
+
+	return nil
+}
+
+func (c *ClusterConfig) validateVPCFlowLogs() error {
+	flowLogs := c.VPC.FlowLogs
+	if flowLogs == nil {
+		return nil
+	}
+	if c.VPC.ID != "" {
+		return errors.New("vpc.flowLogs cannot be set when using an existing VPC")
+	}
+	switch flowLogs.Destination {
+	case "", FlowLogsDestinationCloudWatch:
+		if flowLogs.S3BucketARN != "" {
+			return errors.New("vpc.flowLogs.s3BucketARN can only be set when vpc.flowLogs.destination is s3")
+		}
+		if days := flowLogs.LogRetentionInDays; days != 0 {
+			valid := false
+			for _, v := range LogRetentionInDaysValues {
+				valid = valid || v == days
+			}
+			if !valid {
+				return fmt.Errorf("invalid value %d for vpc.flowLogs.logRetentionInDays; supported values are %v", days, LogRetentionInDaysValues)
+			}
+		}
+	case FlowLogsDestinationS3:
+		if flowLogs.S3BucketARN == "" {
+			return errors.New("vpc.flowLogs.s3BucketARN must be set when vpc.flowLogs.destination is s3")
+		}
+		if parsed, err := arn.Parse(flowLogs.S3BucketARN); err != nil || parsed.Service != "s3" {
+			return fmt.Errorf("invalid vpc.flowLogs.s3BucketARN %q, must be the ARN of an S3 bucket", flowLogs.S3BucketARN)
+		}
+		if flowLogs.LogRetentionInDays != 0 {
+			return errors.New("vpc.flowLogs.logRetentionInDays can only be set when vpc.flowLogs.destination is cloudwatch")
+		}
+	default:
+		return fmt.Errorf("invalid vpc.flowLogs.destination %q, valid values are %s and %s", flowLogs.Destination, FlowLogsDestinationCloudWatch, FlowLogsDestinationS3)
+	}
+	switch flowLogs.TrafficType {
+	case "", FlowLogsTrafficTypeAll, FlowLogsTrafficTypeAccept, FlowLogsTrafficTypeReject:
+	default:
+		return fmt.Errorf("invalid vpc.flowLogs.trafficType %q, valid values are %s, %s and %s", flowLogs.TrafficType, FlowLogsTrafficTypeAll, FlowLogsTrafficTypeAccept, FlowLogsTrafficTypeReject)
+	}
+	return nil
+}
+
+// maxDomainNameServers is the maximum number of domain name servers of DHCP options
+const maxDomainNameServers = 4
+
+func (c *ClusterConfig) validateVPCDNS() error {
+	dns := c.VPC.DNS
+	if dns == nil {
+		return nil
+	}
+	if c.VPC.ID != "" {
+		return errors.New("vpc.dns cannot be set when using an existing VPC, its DHCP options and DNS attributes are managed outside of eksctl")
+	}
+	// EKS requires both attributes, nodes can't register with the cluster without them
+	if IsDisabled(dns.EnableDNSHostnames) {
+		return errors.New("vpc.dns.enableDnsHostnames cannot be disabled, EKS nodes register with their private DNS hostnames")
+	}
+	if IsDisabled(dns.EnableDNSSupport) {
+		return errors.New("vpc.dns.enableDnsSupport cannot be disabled, EKS requires DNS resolution in the VPC")
+	}
+	if len(dns.DomainNameServers) > maxDomainNameServers {
+		return fmt.Errorf("vpc.dns.domainNameServers can hold at most %d servers, got %d", maxDomainNameServers, len(dns.DomainNameServers))
+	}
+	for _, server := range dns.DomainNameServers {
+		if server == AmazonProvidedDNS {
+			continue
+		}
+		if ip := net.ParseIP(server); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("invalid domain name server %q in vpc.dns.domainNameServers, must be an IPv4 address or %s", server, AmazonProvidedDNS)
+		}
+	}
+	if strings.ContainsAny(dns.DomainName, ", ") {
+		return fmt.Errorf("invalid vpc.dns.domainName %q, must be a single domain name", dns.DomainName)
+	}
+	return nil
+}
+
+func (c *ClusterConfig) validateOpenWebhookPorts() error {
+	if len(c.VPC.OpenWebhookPorts) == 0 {
+		return nil
+	}
+	if IsDisabled(c.VPC.ManageSharedNodeSecurityGroupRules) {
+		return errors.New("vpc.openWebhookPorts cannot be set when vpc.manageSharedNodeSecurityGroupRules is disabled")
+	}
+	seen := map[int]bool{}
+	for _, port := range c.VPC.OpenWebhookPorts {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("invalid port %d in vpc.openWebhookPorts, must be between 1 and 65535", port)
+		}
+		if seen[port] {
+			return fmt.Errorf("port %d is specified more than once in vpc.openWebhookPorts", port)
+		}
+		seen[port] = true
+	}
 	return nil
 }
 
@@ -345,6 +642,14 @@ func (c *ClusterConfig) ValidateClusterEndpointConfig() error {
 		if noAccess(endpts) {
 			return ErrClusterEndpointNoAccess
 		}
+		if dns := endpts.DNS; dns != nil {
+			if dns.HostedZoneID == "" {
+				return errors.New("vpc.clusterEndpoints.dns.hostedZoneID must be set")
+			}
+			if dns.Name == "" {
+				return errors.New("vpc.clusterEndpoints.dns.name must be set")
+			}
+		}
 	}
 	return nil
 }
@@ -432,6 +737,15 @@ func PrivateOnly(ces *ClusterEndpoints) bool {
 	return !*ces.PublicAccess && *ces.PrivateAccess
 }
 
+func validateImageBuilderAMI(ami, path string) error {
+	pipelineARN := strings.TrimPrefix(ami, NodeImageResolverImageBuilderPrefix)
+	parsed, err := arn.Parse(pipelineARN)
+	if err != nil || parsed.Service != "imagebuilder" || !strings.HasPrefix(parsed.Resource, "image-pipeline/") {
+		return fmt.Errorf("invalid Image Builder pipeline ARN %q in %s.ami, expected imagebuilder:arn:<partition>:imagebuilder:<region>:<account>:image-pipeline/<name>", pipelineARN, path)
+	}
+	return nil
+}
+
 func validateNodeGroupBase(np NodePool, path string) error {
 	ng := np.BaseNodeGroup()
 	if ng.VolumeSize == nil {
@@ -462,6 +776,12 @@ func validateNodeGroupBase(np NodePool, path string) error {
 		return fmt.Errorf("%s.maxPodsPerNode cannot be negative", path)
 	}
 
+	if IsImageBuilderAMI(ng.AMI) {
+		if err := validateImageBuilderAMI(ng.AMI, path); err != nil {
+			return err
+		}
+	}
+
 	if IsEnabled(ng.DisablePodIMDS) && ng.IAM != nil {
 		fmtFieldConflictErr := func(_ string) error {
 			return fmt.Errorf("%s.disablePodIMDS and %s.iam.withAddonPolicies cannot be set at the same time", path, path)
@@ -475,6 +795,17 @@ func validateNodeGroupBase(np NodePool, path string) error {
 		return fmt.Errorf("only one of %[1]s.subnets or %[1]s.availabilityZones should be set", path)
 	}
 
+	if len(ng.SubnetSelector) > 0 {
+		if len(ng.AvailabilityZones) > 0 || len(ng.Subnets) > 0 {
+			return fmt.Errorf("%[1]s.subnetSelector cannot be set with %[1]s.subnets or %[1]s.availabilityZones", path)
+		}
+		for key := range ng.SubnetSelector {
+			if key == "" {
+				return fmt.Errorf("%s.subnetSelector cannot contain an empty tag key", path)
+			}
+		}
+	}
+
 	if ng.Placement != nil {
 		if ng.Placement.GroupName == "" {
 			return fmt.Errorf("%s.placement.groupName must be set and non-empty", path)
@@ -498,6 +829,33 @@ func validateNodeGroupBase(np NodePool, path string) error {
 			}
 			logger.Warning("SSM is now enabled by default; `ssh.enableSSM` is deprecated and will be removed in a future release")
 		}
+		if err := validateSSHAuthorizedKeys(ng, path); err != nil {
+			return err
+		}
+	}
+
+	if err := validateNodeGroupProxy(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateBootstrapCommands(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateScheduledScaling(np, path); err != nil {
+		return err
+	}
+
+	if err := validateCPUOptions(np, path); err != nil {
+		return err
+	}
+
+	if err := validateTenancy(np, path); err != nil {
+		return err
+	}
+
+	if err := validateASGMetricsCollection(ng, path); err != nil {
+		return err
 	}
 
 	// Only AmazonLinux2 and Bottlerocket support NVIDIA GPUs
@@ -514,6 +872,142 @@ func validateNodeGroupBase(np NodePool, path string) error {
 	return nil
 }
 
+func validateCPUOptions(np NodePool, path string) error {
+	ng := np.BaseNodeGroup()
+	if ng.CPUOptions == nil {
+		return nil
+	}
+	if ng.CPUOptions.CoreCount < 1 {
+		return fmt.Errorf("%s.cpuOptions.coreCount must be at least 1", path)
+	}
+	if ng.CPUOptions.ThreadsPerCore != 1 && ng.CPUOptions.ThreadsPerCore != 2 {
+		return fmt.Errorf("%s.cpuOptions.threadsPerCore must be 1 or 2", path)
+	}
+
+	var instanceTypes []string
+	switch ng := np.(type) {
+	case *NodeGroup:
+		instanceTypes = ng.InstanceTypeList()
+	case *ManagedNodeGroup:
+		if ng.LaunchTemplate != nil {
+			// rejected with the other fields that cannot be set with a launch template
+			return nil
+		}
+		instanceTypes = ng.InstanceTypeList()
+	}
+	hasInstanceSelector := ng.InstanceSelector != nil && !ng.InstanceSelector.IsZero()
+	if len(instanceTypes) != 1 || instanceTypes[0] == "" || hasInstanceSelector {
+		return fmt.Errorf("%s.cpuOptions requires a single instanceType, the valid CPU options depend on the instance type", path)
+	}
+	return nil
+}
+
+func validateTenancy(np NodePool, path string) error {
+	ng := np.BaseNodeGroup()
+	switch ng.Tenancy {
+	case "", TenancyDefault, TenancyDedicated, TenancyHost:
+	default:
+		return fmt.Errorf("invalid value %q for %s.tenancy, must be one of %s, %s, %s", ng.Tenancy, path, TenancyDefault, TenancyDedicated, TenancyHost)
+	}
+
+	if ng.HostResourceGroupARN != "" {
+		if ng.Tenancy != TenancyHost {
+			return fmt.Errorf("%s.hostResourceGroupARN can only be set when %s.tenancy is %s", path, path, TenancyHost)
+		}
+		if parsed, err := arn.Parse(ng.HostResourceGroupARN); err != nil || parsed.Service != "resource-groups" {
+			return fmt.Errorf("invalid host resource group ARN %q in %s.hostResourceGroupARN", ng.HostResourceGroupARN, path)
+		}
+	}
+
+	if ng.Tenancy == TenancyHost {
+		if _, ok := np.(*ManagedNodeGroup); ok {
+			return fmt.Errorf("%s.tenancy %s is not supported for managed nodegroups", path, TenancyHost)
+		}
+		if ng.HostResourceGroupARN == "" {
+			return fmt.Errorf("%s.hostResourceGroupARN must be set when %s.tenancy is %s, nodes can only be launched on Dedicated Hosts through a host resource group", path, path, TenancyHost)
+		}
+	}
+	return nil
+}
+
+func validateNodeGroupProxy(ng *NodeGroupBase, path string) error {
+	if ng.Proxy != nil {
+		if ng.Proxy.HTTPProxy == "" && ng.Proxy.HTTPSProxy == "" {
+			return fmt.Errorf("at least one of %[1]s.proxy.httpProxy or %[1]s.proxy.httpsProxy must be set", path)
+		}
+		for field, proxyURL := range map[string]string{
+			"httpProxy":  ng.Proxy.HTTPProxy,
+			"httpsProxy": ng.Proxy.HTTPSProxy,
+		} {
+			if proxyURL == "" {
+				continue
+			}
+			u, err := url.Parse(proxyURL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("%s.proxy.%s must be a valid http:// or https:// URL, got %q", path, field, proxyURL)
+			}
+		}
+		for _, entry := range ng.Proxy.NoProxy {
+			if entry == "" || strings.ContainsAny(entry, ", ") {
+				return fmt.Errorf("invalid entry %q in %s.proxy.noProxy", entry, path)
+			}
+		}
+	}
+
+	for i, cert := range ng.ExtraCACerts {
+		if err := validatePEMCertificates(cert); err != nil {
+			return errors.Wrapf(err, "invalid certificate in %s.extraCACerts[%d]", path, i)
+		}
+	}
+	return nil
+}
+
+// validateBootstrapCommands lints the shell scripts of Linux nodegroups, so that syntax errors fail before
+// nodes are launched rather than in their cloud-init logs
+func validateBootstrapCommands(ng *NodeGroupBase, path string) error {
+	if IsWindowsImage(ng.AMIFamily) {
+		// Windows nodes run PowerShell
+		return nil
+	}
+	for i, command := range ng.PreBootstrapCommands {
+		if err := shell.Lint(command); err != nil {
+			return fmt.Errorf("%s.preBootstrapCommands[%d] is not a valid shell script: %w", path, i, err)
+		}
+	}
+	if ng.OverrideBootstrapCommand != nil {
+		if err := shell.Lint(*ng.OverrideBootstrapCommand); err != nil {
+			return fmt.Errorf("%s.overrideBootstrapCommand is not a valid shell script: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func validatePEMCertificates(data string) error {
+	rest := []byte(data)
+	found := false
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected PEM block of type %q", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return err
+		}
+		found = true
+	}
+	if !found {
+		return errors.New("no PEM-encoded certificate found")
+	}
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return errors.New("unexpected trailing data after PEM-encoded certificates")
+	}
+	return nil
+}
+
 func validateVolumeOpts(ng *NodeGroupBase, path string) error {
 	if ng.VolumeType != nil {
 		if ng.VolumeIOPS != nil && !(*ng.VolumeType == NodeVolumeTypeIO1 || *ng.VolumeType == NodeVolumeTypeGP3) {
@@ -697,6 +1191,26 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
+	if err := validateAlarms(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateCapacityBlock(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateElasticIPs(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateLoadBalancerAttachments(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateNodeGroupSecurityGroups(ng, path); err != nil {
+		return err
+	}
+
 	if ng.ContainerRuntime != nil {
 		if *ng.ContainerRuntime == ContainerRuntimeContainerD {
 			if ng.AMIFamily != NodeImageFamilyAmazonLinux2 && !IsWindowsImage(ng.AMIFamily) {
@@ -881,6 +1395,9 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 		if ng.IAM.InstanceProfileARN != "" {
 			return errNotSupported("instanceProfileARN")
 		}
+		if ng.IAM.SkipAWSAuthConfigMap != nil {
+			return errNotSupported("skipAWSAuthConfigMap")
+		}
 	}
 
 	// TODO fix error messages to not use CLI flags
@@ -913,13 +1430,10 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 	}
 
 	if ng.UpdateConfig != nil {
-		if ng.UpdateConfig.MaxUnavailable == nil && ng.UpdateConfig.MaxUnavailablePercentage == nil {
-			return fmt.Errorf("invalid UpdateConfig: maxUnavailable or maxUnavailablePercentage must be defined")
-		}
-		if ng.UpdateConfig.MaxUnavailable != nil && ng.UpdateConfig.MaxUnavailablePercentage != nil {
-			return fmt.Errorf("cannot use maxUnavailable=%d and maxUnavailablePercentage=%d at the same time", *ng.UpdateConfig.MaxUnavailable, *ng.UpdateConfig.MaxUnavailablePercentage)
+		if err := ng.UpdateConfig.Validate(); err != nil {
+			return err
 		}
-		if aws.IntValue(ng.UpdateConfig.MaxUnavailable) > aws.IntValue(ng.MaxSize) {
+		if aws.ToInt(ng.UpdateConfig.MaxUnavailable) > aws.ToInt(ng.MaxSize) {
 			return fmt.Errorf("maxUnavailable=%d cannot be greater than maxSize=%d", *ng.UpdateConfig.MaxUnavailable, *ng.MaxSize)
 		}
 	}
@@ -928,6 +1442,10 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 		return errors.Errorf("securityGroups.withLocal and securityGroups.withShared are not supported for managed nodegroups (%s.securityGroups)", path)
 	}
 
+	if IsDisabled(ng.SecurityGroups.AttachSharedClusterSG) && len(ng.SecurityGroups.AttachIDs) == 0 {
+		return errors.Errorf("%[1]s.securityGroups.attachIDs must be set when %[1]s.securityGroups.attachSharedClusterSG is disabled", path)
+	}
+
 	if ng.InstanceType != "" {
 		if len(ng.InstanceTypes) > 0 {
 			return errors.Errorf("only one of instanceType or instanceTypes can be specified (%s)", path)
@@ -977,18 +1495,21 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 		if ng.InstanceType != "" || ng.AMI != "" || IsEnabled(ng.SSH.Allow) || IsEnabled(ng.SSH.EnableSSM) || len(ng.SSH.SourceSecurityGroupIDs) > 0 ||
 			ng.VolumeSize != nil || len(ng.PreBootstrapCommands) > 0 || ng.OverrideBootstrapCommand != nil ||
 			len(ng.SecurityGroups.AttachIDs) > 0 || ng.InstanceName != "" || ng.InstancePrefix != "" || ng.MaxPodsPerNode != 0 ||
-			IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) || ng.Placement != nil {
+			IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) || ng.Placement != nil ||
+			ng.Proxy != nil || len(ng.ExtraCACerts) > 0 || ng.CPUOptions != nil || ng.Tenancy != "" ||
+			ng.SecurityGroups.AttachSharedClusterSG != nil {
 
 			incompatibleFields := []string{
 				"instanceType", "ami", "ssh.allow", "ssh.enableSSM", "ssh.sourceSecurityGroupIds", "securityGroups",
 				"volumeSize", "instanceName", "instancePrefix", "maxPodsPerNode", "disableIMDSv1",
 				"disablePodIMDS", "preBootstrapCommands", "overrideBootstrapCommand", "placement",
+				"proxy", "extraCACerts", "cpuOptions", "tenancy",
 			}
 			return errors.Errorf("cannot set %s in managedNodeGroup when a launch template is supplied", strings.Join(incompatibleFields, ", "))
 		}
 
 	case ng.AMI != "":
-		if !IsAMI(ng.AMI) {
+		if !IsAMI(ng.AMI) && !IsImageBuilderAMI(ng.AMI) {
 			return errors.Errorf("invalid AMI %q (%s.%s)", ng.AMI, path, "ami")
 		}
 		if ng.AMIFamily != NodeImageFamilyAmazonLinux2 {
@@ -1120,6 +1641,120 @@ func validateCPUCredits(ng *NodeGroup) error {
 	return nil
 }
 
+func validateAlarms(ng *NodeGroup, path string) error {
+	alarms := ng.Alarms
+	if alarms == nil {
+		return nil
+	}
+	if alarms.SNSTopicARN == "" {
+		return fmt.Errorf("%s.alarms.snsTopicARN must be set", path)
+	}
+	if parsed, err := arn.Parse(alarms.SNSTopicARN); err != nil || parsed.Service != "sns" {
+		return fmt.Errorf("%s.alarms.snsTopicARN %q is not a valid SNS topic ARN", path, alarms.SNSTopicARN)
+	}
+	if alarms.StatusCheckFailedThreshold != nil && *alarms.StatusCheckFailedThreshold < 1 {
+		return fmt.Errorf("%s.alarms.statusCheckFailedThreshold must be at least 1", path)
+	}
+	if alarms.SpotInterruptionsThreshold != nil && *alarms.SpotInterruptionsThreshold < 1 {
+		return fmt.Errorf("%s.alarms.spotInterruptionsThreshold must be at least 1", path)
+	}
+	if IsEnabled(alarms.SpotInterruptions) && ng.InstancesDistribution == nil {
+		return fmt.Errorf("%s.alarms.spotInterruptions requires %s.instancesDistribution to be set", path, path)
+	}
+	return nil
+}
+
+func validateCapacityBlock(ng *NodeGroup, path string) error {
+	if ng.CapacityBlock == nil {
+		return nil
+	}
+	if !strings.HasPrefix(ng.CapacityBlock.ReservationID, "cr-") {
+		return fmt.Errorf("%s.capacityBlock.reservationID %q is not a valid Capacity Reservation ID", path, ng.CapacityBlock.ReservationID)
+	}
+	if ng.InstancesDistribution != nil || ng.InstanceSelector != nil && !ng.InstanceSelector.IsZero() {
+		return fmt.Errorf("%s.capacityBlock requires a single instanceType, instancesDistribution and instanceSelector cannot be set", path)
+	}
+	if !instanceutils.IsGPUInstanceType(ng.InstanceType) {
+		return fmt.Errorf("%s.capacityBlock requires a GPU instance type, got %q", path, ng.InstanceType)
+	}
+	if len(ng.ScheduledScaling) > 0 {
+		return fmt.Errorf("%s.capacityBlock and %s.scheduledScaling cannot be set at the same time, the nodegroup is scaled on the reservation period of the Capacity Block", path, path)
+	}
+	return nil
+}
+
+func validateNodeGroupSecurityGroups(ng *NodeGroup, path string) error {
+	if ng.SecurityGroups == nil {
+		return nil
+	}
+	if ng.SecurityGroups.AttachSharedClusterSG != nil {
+		return fmt.Errorf("%[1]s.securityGroups.attachSharedClusterSG is only supported for managed nodegroups, use %[1]s.securityGroups.withShared and %[1]s.securityGroups.withLocal instead", path)
+	}
+	if IsDisabled(ng.SecurityGroups.WithShared) && IsDisabled(ng.SecurityGroups.WithLocal) && len(ng.SecurityGroups.AttachIDs) == 0 {
+		return fmt.Errorf("%[1]s.securityGroups.attachIDs must be set when %[1]s.securityGroups.withShared and %[1]s.securityGroups.withLocal are disabled", path)
+	}
+	return nil
+}
+
+func validateElasticIPs(ng *NodeGroup, path string) error {
+	if ng.ElasticIPs == nil {
+		return nil
+	}
+	allocate := IsEnabled(ng.ElasticIPs.Allocate)
+	if allocate == (len(ng.ElasticIPs.AllocationIDs) > 0) {
+		return fmt.Errorf("exactly one of %[1]s.elasticIPs.allocate or %[1]s.elasticIPs.allocationIDs must be set", path)
+	}
+	if ng.ElasticIPs.PublicIPv4Pool != "" && !allocate {
+		return fmt.Errorf("%[1]s.elasticIPs.publicIPv4Pool can only be set when %[1]s.elasticIPs.allocate is enabled", path)
+	}
+	for _, id := range ng.ElasticIPs.AllocationIDs {
+		if !strings.HasPrefix(id, "eipalloc-") {
+			return fmt.Errorf("%s.elasticIPs.allocationIDs contains %q, which is not a valid Elastic IP allocation ID", path, id)
+		}
+	}
+	if !allocate && ng.MaxSize != nil && len(ng.ElasticIPs.AllocationIDs) < *ng.MaxSize {
+		return fmt.Errorf("%s.elasticIPs.allocationIDs must hold at least maxSize (%d) Elastic IPs, got %d", path, *ng.MaxSize, len(ng.ElasticIPs.AllocationIDs))
+	}
+	if ng.PrivateNetworking {
+		return fmt.Errorf("%s.elasticIPs cannot be set when %s.privateNetworking is enabled, Elastic IPs are only reachable by nodes in public subnets", path, path)
+	}
+	switch ng.AMIFamily {
+	case "", NodeImageFamilyAmazonLinux2, NodeImageFamilyUbuntu2004, NodeImageFamilyUbuntu1804:
+	default:
+		return fmt.Errorf("%s.elasticIPs is not supported for %s, Elastic IPs are associated by a shell script in the user data of AmazonLinux2 and Ubuntu nodes", path, ng.AMIFamily)
+	}
+	return nil
+}
+
+// imageRepository matches a registry host followed by optional path components, as image tags are split on the
+// first colon a port isn't supported
+var imageRepository = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+
+// classicLoadBalancerName matches the names of Classic Load Balancers
+var classicLoadBalancerName = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?$`)
+
+// validateLoadBalancerAttachments checks the load balancers and target groups the Auto Scaling group registers its
+// instances with, as CloudFormation only rejects them once it gets to creating the nodegroup
+func validateLoadBalancerAttachments(ng *NodeGroup, path string) error {
+	for _, name := range ng.ClassicLoadBalancerNames {
+		if !classicLoadBalancerName.MatchString(name) {
+			return fmt.Errorf("%s.classicLoadBalancerNames contains %q, which is not a valid Classic Load Balancer name", path, name)
+		}
+	}
+	seen := map[string]bool{}
+	for _, targetGroupARN := range ng.TargetGroupARNs {
+		parsed, err := arn.Parse(targetGroupARN)
+		if err != nil || parsed.Service != "elasticloadbalancing" || !strings.HasPrefix(parsed.Resource, "targetgroup/") {
+			return fmt.Errorf("%s.targetGroupARNs contains %q, which is not a valid target group ARN", path, targetGroupARN)
+		}
+		if seen[targetGroupARN] {
+			return fmt.Errorf("%s.targetGroupARNs contains %q more than once", path, targetGroupARN)
+		}
+		seen[targetGroupARN] = true
+	}
+	return nil
+}
+
 func validateASGSuspendProcesses(ng *NodeGroup) error {
 	// Processes list taken from here: https://docs.aws.amazon.com/autoscaling/ec2/APIReference/API_SuspendProcesses.html
 	for _, proc := range ng.ASGSuspendProcesses {
@@ -1142,6 +1777,16 @@ func validateASGSuspendProcesses(ng *NodeGroup) error {
 	return nil
 }
 
+func validateASGMetricsCollection(ng *NodeGroupBase, path string) error {
+	for i, m := range ng.ASGMetricsCollection {
+		// 1Minute is the only granularity supported by EnableMetricsCollection
+		if m.Granularity != "1Minute" {
+			return fmt.Errorf("%s.asgMetricsCollection[%d].granularity must be %q", path, i, "1Minute")
+		}
+	}
+	return nil
+}
+
 func validateNodeGroupSSH(SSH *NodeGroupSSH) error {
 	numSSHFlagsEnabled := countEnabledFields(
 		SSH.PublicKeyPath,
@@ -1154,6 +1799,43 @@ func validateNodeGroupSSH(SSH *NodeGroupSSH) error {
 	return nil
 }
 
+// Validate validates the update config of a managed nodegroup
+func (c *NodeGroupUpdateConfig) Validate() error {
+	if c.MaxUnavailable == nil && c.MaxUnavailablePercentage == nil {
+		return fmt.Errorf("invalid UpdateConfig: maxUnavailable or maxUnavailablePercentage must be defined")
+	}
+	if c.MaxUnavailable != nil && c.MaxUnavailablePercentage != nil {
+		return fmt.Errorf("cannot use maxUnavailable=%d and maxUnavailablePercentage=%d at the same time", *c.MaxUnavailable, *c.MaxUnavailablePercentage)
+	}
+	// the ranges accepted by EKS
+	if c.MaxUnavailable != nil && (*c.MaxUnavailable < 1 || *c.MaxUnavailable > 100) {
+		return fmt.Errorf("maxUnavailable must be between 1 and 100, got %d", *c.MaxUnavailable)
+	}
+	if c.MaxUnavailablePercentage != nil && (*c.MaxUnavailablePercentage < 1 || *c.MaxUnavailablePercentage > 100) {
+		return fmt.Errorf("maxUnavailablePercentage must be between 1 and 100, got %d", *c.MaxUnavailablePercentage)
+	}
+	return nil
+}
+
+func validateSSHAuthorizedKeys(ng *NodeGroupBase, path string) error {
+	if len(ng.SSH.AuthorizedKeys) == 0 {
+		return nil
+	}
+	if IsWindowsImage(ng.AMIFamily) || ng.AMIFamily == NodeImageFamilyBottlerocket {
+		return &unsupportedFieldError{
+			ng:    ng,
+			path:  path,
+			field: "ssh.authorizedKeys",
+		}
+	}
+	for i, key := range ng.SSH.AuthorizedKeys {
+		if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key)); err != nil {
+			return fmt.Errorf("%s.ssh.authorizedKeys[%d] is not a valid SSH public key: %v", path, i, err)
+		}
+	}
+	return nil
+}
+
 func countEnabledFields(fields ...*string) int {
 	count := 0
 	for _, flag := range fields {
@@ -1196,6 +1878,17 @@ func isSupportedAMIFamily(imageFamily string) bool {
 	return false
 }
 
+// isSupportedIPv6AMIFamily reports whether unmanaged nodes of the AMI family can join an IPv6 cluster,
+// an empty AMI family is accepted as it defaults to AmazonLinux2
+func isSupportedIPv6AMIFamily(imageFamily string) bool {
+	switch imageFamily {
+	case "", NodeImageFamilyAmazonLinux2, NodeImageFamilyBottlerocket:
+		return true
+	default:
+		return false
+	}
+}
+
 // IsWindowsImage reports whether the AMI family is for Windows
 func IsWindowsImage(imageFamily string) bool {
 	switch imageFamily {
@@ -1222,6 +1915,23 @@ func validateCIDRs(cidrs []string) ([]string, error) {
 	return validCIDRs, nil
 }
 
+// ValidatePublicAccessCIDRs validates the CIDRs allowed to reach the public endpoint of the API server and returns
+// them in canonical form. IPv6 CIDRs can only be used with IPv6 clusters, whose endpoint is dual-stack
+func ValidatePublicAccessCIDRs(cidrs []string, ipv6Cluster bool) ([]string, error) {
+	validCIDRs, err := validateCIDRs(cidrs)
+	if err != nil {
+		return nil, err
+	}
+	if !ipv6Cluster {
+		for _, cidr := range validCIDRs {
+			if ip, _, _ := net.ParseCIDR(cidr); ip.To4() == nil {
+				return nil, fmt.Errorf("IPv6 CIDR %q in vpc.publicAccessCIDRs can only be used with an IPv6 cluster, as the API server endpoint of IPv4 clusters is only reachable over IPv4", cidr)
+			}
+		}
+	}
+	return validCIDRs, nil
+}
+
 func validateTaints(ngTaints []NodeGroupTaint) error {
 	for _, t := range ngTaints {
 		if err := taints.Validate(corev1.Taint{
@@ -148,6 +148,649 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("nodeGroupDefaults.autoScaler validation", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.NodeGroupDefaults = &api.NodeGroupDefaults{AutoScaler: api.Enabled()}
+		})
+
+		It("accepts nodegroups whose labels and taints have distinct keys", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.Labels = map[string]string{"role": "worker"}
+			ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("rejects nodegroups with a taint key matching a label key", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.Labels = map[string]string{"dedicated": "gpu"}
+			ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`nodeGroups[0]: taint key "dedicated" must not match a label key when nodeGroupDefaults.autoScaler is enabled`))
+		})
+
+		It("rejects managed nodegroups with a taint key matching a label key", func() {
+			mng := api.NewManagedNodeGroup()
+			mng.Name = "mng"
+			mng.Labels = map[string]string{"dedicated": "gpu"}
+			mng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}}
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`managedNodeGroups[0]: taint key "dedicated" must not match a label key when nodeGroupDefaults.autoScaler is enabled`))
+		})
+
+		It("allows overlapping keys when the option is disabled", func() {
+			cfg.NodeGroupDefaults = nil
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.Labels = map[string]string{"dedicated": "gpu"}
+			ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+	})
+
+	Describe("nodeGroups[*].proxy and extraCACerts validation", func() {
+		const testCACert = `-----BEGIN CERTIFICATE-----
+MIIBiTCCAS+gAwIBAgIUcPVyzky5vl3p0al7XJqNlz5/+CkwCgYIKoZIzj0EAwIw
+GTEXMBUGA1UEAwwOZWtzY3RsLXRlc3QtY2EwIBcNMjYxMDE3MDQzNzE4WhgPMjEy
+NjA5MjMwNDM3MThaMBkxFzAVBgNVBAMMDmVrc2N0bC10ZXN0LWNhMFkwEwYHKoZI
+zj0CAQYIKoZIzj0DAQcDQgAEbQk2/Qrnxqs75nxhIdInG6SnLQ1L0PrKANS0+zGZ
+sNGNeqzqohWwgngtlnbYnec9P2t+F1zc90MSx8vxcLqJCKNTMFEwHQYDVR0OBBYE
+FMMeefTMCelrTK8WMqd+U2VZKnKVMB8GA1UdIwQYMBaAFMMeefTMCelrTK8WMqd+
+U2VZKnKVMA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwIDSAAwRQIhAMvJWdcf
+IHETYRVbXSM379g8ZplraoihEaX+Ljnkk/8OAiARDOwZw1YXbBU9Kg/Z4/rwu15q
+XYsGbljEbqWHGnzhBA==
+-----END CERTIFICATE-----`
+
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+		})
+
+		It("accepts valid proxy URLs and certificates", func() {
+			ng.Proxy = &api.NodeGroupProxy{
+				HTTPProxy:  "http://proxy.corp:3128",
+				HTTPSProxy: "https://proxy.corp:3129",
+				NoProxy:    []string{".corp", "10.0.0.0/8"},
+			}
+			ng.ExtraCACerts = []string{testCACert}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("requires a proxy URL", func() {
+			ng.Proxy = &api.NodeGroupProxy{NoProxy: []string{".corp"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("at least one of nodeGroups[0].proxy.httpProxy or nodeGroups[0].proxy.httpsProxy must be set"))
+		})
+
+		It("rejects proxy URLs without a scheme", func() {
+			ng.Proxy = &api.NodeGroupProxy{HTTPProxy: "proxy.corp:3128"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].proxy.httpProxy must be a valid http:// or https:// URL")))
+		})
+
+		It("rejects comma-separated noProxy entries", func() {
+			ng.Proxy = &api.NodeGroupProxy{HTTPProxy: "http://proxy.corp:3128", NoProxy: []string{"a.corp,b.corp"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("invalid entry \"a.corp,b.corp\" in nodeGroups[0].proxy.noProxy")))
+		})
+
+		It("rejects invalid certificates", func() {
+			ng.ExtraCACerts = []string{"not a certificate"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("invalid certificate in nodeGroups[0].extraCACerts[0]")))
+		})
+
+		It("rejects proxy settings on managed nodegroups with a launch template", func() {
+			mng := api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			mng.LaunchTemplate = &api.LaunchTemplate{ID: "lt-123"}
+			mng.Proxy = &api.NodeGroupProxy{HTTPProxy: "http://proxy.corp:3128"}
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(MatchError(ContainSubstring("proxy, extraCACerts")))
+		})
+	})
+
+	Describe("nodeGroups[*] bootstrap commands validation", func() {
+		It("accepts valid shell scripts", func() {
+			ng := api.NewClusterConfig().NewNodeGroup()
+			ng.PreBootstrapCommands = []string{"if [ -f /etc/motd ]; then cat /etc/motd; fi"}
+			ng.OverrideBootstrapCommand = aws.String("#!/bin/bash\n/etc/eks/bootstrap.sh cluster-1\n")
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects invalid preBootstrapCommands with the line of the error", func() {
+			ng := api.NewClusterConfig().NewNodeGroup()
+			ng.PreBootstrapCommands = []string{"echo ok", "for i in 1 2; do\n  echo \"$i\n"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].preBootstrapCommands[1] is not a valid shell script: line 1, column 1: for is never closed with done; line 2, column 8: unterminated double-quoted string`))
+		})
+
+		It("rejects an invalid overrideBootstrapCommand of managed nodegroups", func() {
+			mng := api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			mng.AMI = "ami-123"
+			mng.OverrideBootstrapCommand = aws.String("#!/bin/bash\nif [-f /etc/eks/bootstrap.sh ]; then /etc/eks/bootstrap.sh cluster; fi")
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(MatchError(`managedNodeGroups[0].overrideBootstrapCommand is not a valid shell script: line 2, column 4: missing space after [, e.g. [ -f file ]`))
+		})
+
+		It("doesn't lint the PowerShell scripts of Windows nodegroups", func() {
+			ng := api.NewClusterConfig().NewNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+			ng.PreBootstrapCommands = []string{`Write-Output "it's $($env:COMPUTERNAME)`}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+	})
+
+	Describe("nodeGroups[*].alarms validation", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			ng.Alarms = &api.NodeGroupAlarms{SNSTopicARN: "arn:aws:sns:us-west-2:123456789012:alarms"}
+		})
+
+		It("accepts an SNS topic ARN", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("requires an SNS topic ARN", func() {
+			ng.Alarms.SNSTopicARN = ""
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].alarms.snsTopicARN must be set"))
+		})
+
+		It("rejects ARNs of other services", func() {
+			ng.Alarms.SNSTopicARN = "arn:aws:sqs:us-west-2:123456789012:alarms"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("is not a valid SNS topic ARN")))
+		})
+
+		It("requires instancesDistribution for spot interruption alarms", func() {
+			ng.Alarms.SpotInterruptions = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].alarms.spotInterruptions requires nodeGroups[0].instancesDistribution to be set"))
+		})
+	})
+
+	Describe("scheduledScaling validation", func() {
+		var (
+			ng  *api.NodeGroup
+			mng *api.ManagedNodeGroup
+		)
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			mng = api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+		})
+
+		It("accepts scheduled scaling actions", func() {
+			ng.ScheduledScaling = []api.ScheduledScalingAction{
+				{Cron: "0 8 * * 1-5", MinSize: aws.Int(3), MaxSize: aws.Int(10), DesiredCapacity: aws.Int(3), TimeZone: "Europe/London"},
+				{Cron: "0 20 * * 1-5", MinSize: aws.Int(0), DesiredCapacity: aws.Int(0)},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			mng.ScheduledScaling = ng.ScheduledScaling
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(Succeed())
+		})
+
+		It("requires a cron schedule with 5 fields", func() {
+			ng.ScheduledScaling = []api.ScheduledScalingAction{{Cron: "0 8 * *", MinSize: aws.Int(1)}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].scheduledScaling[0].cron \"0 8 * *\" must have 5 fields")))
+		})
+
+		It("requires a size", func() {
+			ng.ScheduledScaling = []api.ScheduledScalingAction{{Cron: "0 8 * * *"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("at least one of nodeGroups[0].scheduledScaling[0].minSize")))
+		})
+
+		It("rejects a desired capacity above the max size", func() {
+			ng.ScheduledScaling = []api.ScheduledScalingAction{{Cron: "0 8 * * *", MaxSize: aws.Int(2), DesiredCapacity: aws.Int(3)}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].scheduledScaling[0].desiredCapacity cannot be greater than nodeGroups[0].scheduledScaling[0].maxSize"))
+		})
+
+		It("rejects unknown time zones", func() {
+			ng.ScheduledScaling = []api.ScheduledScalingAction{{Cron: "0 8 * * *", MinSize: aws.Int(1), TimeZone: "Mars/Olympus_Mons"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("is not a valid IANA time zone")))
+		})
+
+		It("rejects schedules restricting both days for managed nodegroups", func() {
+			mng.ScheduledScaling = []api.ScheduledScalingAction{{Cron: "0 8 1 * MON", MinSize: aws.Int(1)}}
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(MatchError(ContainSubstring("cannot restrict both the day of month and the day of week")))
+
+			ng.ScheduledScaling = mng.ScheduledScaling
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+	})
+
+	Describe("cpuOptions validation", func() {
+		var (
+			ng  *api.NodeGroup
+			mng *api.ManagedNodeGroup
+		)
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			ng.InstanceType = "c5.18xlarge"
+			ng.CPUOptions = &api.CPUOptions{CoreCount: 36, ThreadsPerCore: 1}
+			mng = api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			mng.InstanceType = "c5.18xlarge"
+			mng.CPUOptions = ng.CPUOptions
+		})
+
+		It("accepts CPU options for a single instance type", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(Succeed())
+		})
+
+		It("requires at least one core", func() {
+			ng.CPUOptions.CoreCount = 0
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].cpuOptions.coreCount must be at least 1"))
+		})
+
+		It("requires 1 or 2 threads per core", func() {
+			ng.CPUOptions.ThreadsPerCore = 4
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].cpuOptions.threadsPerCore must be 1 or 2"))
+		})
+
+		It("rejects multiple instance types", func() {
+			mng.InstanceType = ""
+			mng.InstanceTypes = []string{"c5.18xlarge", "c5n.18xlarge"}
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(MatchError(ContainSubstring("managedNodeGroups[0].cpuOptions requires a single instanceType")))
+		})
+	})
+
+	Describe("subnetSelector validation", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			ng.SubnetSelector = map[string]string{"tier": "private", "env": "prod"}
+		})
+
+		It("accepts a subnet selector", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects a subnet selector with subnets or availability zones", func() {
+			ng.Subnets = []string{"subnet-1"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].subnetSelector cannot be set with nodeGroups[0].subnets or nodeGroups[0].availabilityZones"))
+			ng.Subnets = nil
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].subnetSelector cannot be set with nodeGroups[0].subnets or nodeGroups[0].availabilityZones"))
+		})
+
+		It("rejects an empty tag key", func() {
+			ng.SubnetSelector[""] = "prod"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].subnetSelector cannot contain an empty tag key"))
+		})
+	})
+
+	Describe("tenancy validation", func() {
+		var (
+			ng  *api.NodeGroup
+			mng *api.ManagedNodeGroup
+		)
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			ng.Tenancy = api.TenancyHost
+			ng.HostResourceGroupARN = "arn:aws:resource-groups:us-west-2:123456789012:group/byol-hosts"
+			mng = api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+		})
+
+		It("accepts host tenancy with a host resource group", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("accepts dedicated tenancy", func() {
+			ng.Tenancy = api.TenancyDedicated
+			ng.HostResourceGroupARN = ""
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			mng.Tenancy = api.TenancyDedicated
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(Succeed())
+		})
+
+		It("rejects an unknown tenancy", func() {
+			ng.Tenancy = "shared"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`invalid value "shared" for nodeGroups[0].tenancy, must be one of default, dedicated, host`))
+		})
+
+		It("requires a host resource group for host tenancy", func() {
+			ng.HostResourceGroupARN = ""
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].hostResourceGroupARN must be set when nodeGroups[0].tenancy is host")))
+		})
+
+		It("rejects a host resource group without host tenancy", func() {
+			ng.Tenancy = api.TenancyDedicated
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].hostResourceGroupARN can only be set when nodeGroups[0].tenancy is host"))
+		})
+
+		It("rejects an ARN that is not a resource group", func() {
+			ng.HostResourceGroupARN = "arn:aws:ec2:us-west-2:123456789012:dedicated-host/h-0123456789abcdef0"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("invalid host resource group ARN")))
+		})
+
+		It("rejects host tenancy for managed nodegroups", func() {
+			mng.Tenancy = api.TenancyHost
+			mng.HostResourceGroupARN = ng.HostResourceGroupARN
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(MatchError("managedNodeGroups[0].tenancy host is not supported for managed nodegroups"))
+		})
+	})
+
+	Describe("elasticIPs validation", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			ng.MaxSize = aws.Int(2)
+			ng.ElasticIPs = &api.NodeGroupElasticIPs{Allocate: aws.Bool(true)}
+		})
+
+		It("accepts allocated Elastic IPs", func() {
+			ng.ElasticIPs.PublicIPv4Pool = "ipv4pool-ec2-0123456789abcdef0"
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("accepts enough existing Elastic IPs", func() {
+			ng.ElasticIPs = &api.NodeGroupElasticIPs{AllocationIDs: []string{"eipalloc-1", "eipalloc-2"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("requires exactly one of allocate or allocationIDs", func() {
+			ng.ElasticIPs.AllocationIDs = []string{"eipalloc-1", "eipalloc-2"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("exactly one of nodeGroups[0].elasticIPs.allocate or nodeGroups[0].elasticIPs.allocationIDs must be set"))
+			ng.ElasticIPs = &api.NodeGroupElasticIPs{}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("exactly one of nodeGroups[0].elasticIPs.allocate or nodeGroups[0].elasticIPs.allocationIDs must be set"))
+		})
+
+		It("rejects a public IPv4 pool for existing Elastic IPs", func() {
+			ng.ElasticIPs = &api.NodeGroupElasticIPs{
+				AllocationIDs:  []string{"eipalloc-1", "eipalloc-2"},
+				PublicIPv4Pool: "ipv4pool-ec2-0123456789abcdef0",
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].elasticIPs.publicIPv4Pool can only be set when nodeGroups[0].elasticIPs.allocate is enabled"))
+		})
+
+		It("rejects invalid allocation IDs", func() {
+			ng.ElasticIPs = &api.NodeGroupElasticIPs{AllocationIDs: []string{"eipalloc-1", "203.0.113.10"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring(`contains "203.0.113.10", which is not a valid Elastic IP allocation ID`)))
+		})
+
+		It("requires an Elastic IP for each node", func() {
+			ng.ElasticIPs = &api.NodeGroupElasticIPs{AllocationIDs: []string{"eipalloc-1"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].elasticIPs.allocationIDs must hold at least maxSize (2) Elastic IPs, got 1"))
+		})
+
+		It("rejects nodes in private subnets", func() {
+			ng.PrivateNetworking = true
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].elasticIPs cannot be set when nodeGroups[0].privateNetworking is enabled")))
+		})
+
+		It("rejects AMI families without shell scripts in their user data", func() {
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].elasticIPs is not supported for Bottlerocket")))
+		})
+	})
+
+	Describe("load balancer attachments validation", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+		})
+
+		It("accepts Classic Load Balancer names and target group ARNs", func() {
+			ng.ClassicLoadBalancerNames = []string{"dev-clb-1"}
+			ng.TargetGroupARNs = []string{"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/dev-tg-1/abcdef0123456789"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects invalid Classic Load Balancer names", func() {
+			ng.ClassicLoadBalancerNames = []string{"dev_clb"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].classicLoadBalancerNames contains "dev_clb", which is not a valid Classic Load Balancer name`))
+		})
+
+		It("rejects ARNs that are not of target groups", func() {
+			ng.TargetGroupARNs = []string{"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/dev-alb/abcdef0123456789"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("which is not a valid target group ARN")))
+			ng.TargetGroupARNs = []string{"dev-tg-1"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("which is not a valid target group ARN")))
+		})
+
+		It("rejects duplicate target group ARNs", func() {
+			targetGroupARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/dev-tg-1/abcdef0123456789"
+			ng.TargetGroupARNs = []string{targetGroupARN, targetGroupARN}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("more than once")))
+		})
+	})
+
+	Describe("securityGroups validation", func() {
+		var (
+			ng  *api.NodeGroup
+			mng *api.ManagedNodeGroup
+		)
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			mng = api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+		})
+
+		It("accepts managed nodegroups with only attached security groups", func() {
+			mng.SecurityGroups.AttachSharedClusterSG = api.Disabled()
+			mng.SecurityGroups.AttachIDs = []string{"sg-regulated"}
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(Succeed())
+		})
+
+		It("requires attachIDs when the shared cluster security group is not attached", func() {
+			mng.SecurityGroups.AttachSharedClusterSG = api.Disabled()
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(MatchError("managedNodeGroups[0].securityGroups.attachIDs must be set when managedNodeGroups[0].securityGroups.attachSharedClusterSG is disabled"))
+		})
+
+		It("rejects attachSharedClusterSG for unmanaged nodegroups", func() {
+			ng.SecurityGroups.AttachSharedClusterSG = api.Disabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].securityGroups.attachSharedClusterSG is only supported for managed nodegroups")))
+		})
+
+		It("requires attachIDs when unmanaged nodegroups get no security groups from eksctl", func() {
+			ng.SecurityGroups.WithShared = api.Disabled()
+			ng.SecurityGroups.WithLocal = api.Disabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].securityGroups.attachIDs must be set when nodeGroups[0].securityGroups.withShared and nodeGroups[0].securityGroups.withLocal are disabled"))
+			ng.SecurityGroups.AttachIDs = []string{"sg-regulated"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+	})
+
+	Describe("bootstrap validation", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Bootstrap = &api.Bootstrap{
+				Manifests: []*api.BootstrapManifest{
+					{Name: "namespaces", File: "manifests/namespaces.yaml"},
+				},
+				HelmCharts: []*api.BootstrapHelmChart{
+					{Name: "metrics-server", Repo: "https://kubernetes-sigs.github.io/metrics-server", Chart: "metrics-server", Version: "3.8.2"},
+				},
+			}
+			api.SetClusterConfigDefaults(cfg)
+		})
+
+		It("accepts valid manifests and charts", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(*cfg.Bootstrap.Retries).To(Equal(api.DefaultBootstrapRetries))
+			Expect(cfg.Bootstrap.HelmCharts[0].Namespace).To(Equal("default"))
+		})
+
+		It("requires exactly one source per manifest", func() {
+			cfg.Bootstrap.Manifests[0].URL = "https://example.com/namespaces.yaml"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("exactly one of bootstrap.manifests[0].file, bootstrap.manifests[0].url and bootstrap.manifests[0].content must be set"))
+		})
+
+		It("requires unique manifest names", func() {
+			cfg.Bootstrap.Manifests = append(cfg.Bootstrap.Manifests, &api.BootstrapManifest{Name: "namespaces", Content: "{}"})
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`bootstrap.manifests[1].name "namespaces" is not unique`))
+		})
+
+		It("requires the chart version", func() {
+			cfg.Bootstrap.HelmCharts[0].Version = ""
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("bootstrap.helmCharts[0].version must be set"))
+		})
+
+		It("rejects releases declared more than once", func() {
+			chart := *cfg.Bootstrap.HelmCharts[0]
+			cfg.Bootstrap.HelmCharts = append(cfg.Bootstrap.HelmCharts, &chart)
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`bootstrap.helmCharts[1]: release "default/metrics-server" is declared more than once`))
+		})
+	})
+
+	Describe("charts validation", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Charts = []*api.HelmChart{
+				{Repo: "https://kubernetes-sigs.github.io/metrics-server", Name: "metrics-server", Version: "3.8.2"},
+			}
+			api.SetClusterConfigDefaults(cfg)
+		})
+
+		It("defaults the release name and namespace", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.Charts[0].ReleaseName).To(Equal("metrics-server"))
+			Expect(cfg.Charts[0].Namespace).To(Equal("default"))
+		})
+
+		It("requires the chart repo", func() {
+			cfg.Charts[0].Repo = ""
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("charts[0].repo must be set"))
+		})
+
+		It("rejects releases also declared in bootstrap.helmCharts", func() {
+			cfg.Bootstrap = &api.Bootstrap{
+				HelmCharts: []*api.BootstrapHelmChart{
+					{Name: "metrics-server", Repo: "https://kubernetes-sigs.github.io/metrics-server", Chart: "metrics-server", Version: "3.8.2"},
+				},
+			}
+			api.SetClusterConfigDefaults(cfg)
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`charts[0]: release "default/metrics-server" is declared more than once`))
+		})
+	})
+
+	Describe("observability validation", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Observability = &api.Observability{
+				Prometheus: &api.ObservabilityPrometheus{},
+				Grafana:    &api.ObservabilityGrafana{WorkspaceID: "g-0123456789"},
+			}
+		})
+
+		It("accepts a Prometheus and a Grafana workspace", func() {
+			Expect(api.ValidateObservability(cfg)).To(Succeed())
+		})
+
+		It("requires the Grafana workspace ID", func() {
+			cfg.Observability.Grafana.WorkspaceID = ""
+			Expect(api.ValidateObservability(cfg)).To(MatchError("observability.grafana.workspaceID must be set"))
+		})
+
+		It("requires Prometheus with Grafana", func() {
+			cfg.Observability.Prometheus = nil
+			Expect(api.ValidateObservability(cfg)).To(MatchError("observability.grafana requires observability.prometheus to be set"))
+		})
+	})
+
+	Describe("capacityBlock validation", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			ng.InstanceType = "p5.48xlarge"
+			ng.CapacityBlock = &api.CapacityBlock{ReservationID: "cr-0123456789abcdef0"}
+		})
+
+		It("accepts a capacity block for a GPU instance type", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("requires a valid reservation ID", func() {
+			ng.CapacityBlock.ReservationID = "0123456789abcdef0"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].capacityBlock.reservationID "0123456789abcdef0" is not a valid Capacity Reservation ID`))
+		})
+
+		It("requires a GPU instance type", func() {
+			ng.InstanceType = "m5.large"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].capacityBlock requires a GPU instance type, got "m5.large"`))
+		})
+
+		It("rejects mixed instances", func() {
+			ng.InstanceType = ""
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+				InstanceTypes: []string{"p5.48xlarge", "p4d.24xlarge"},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].capacityBlock requires a single instanceType")))
+		})
+
+		It("rejects scheduled scaling", func() {
+			ng.ScheduledScaling = []api.ScheduledScalingAction{{Cron: "0 8 * * *", MinSize: aws.Int(1)}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].capacityBlock and nodeGroups[0].scheduledScaling cannot be set at the same time")))
+		})
+	})
+
+	DescribeTable("ScheduledScalingAction.ScheduleExpression", func(cron, expected string) {
+		Expect(api.ScheduledScalingAction{Cron: cron}.ScheduleExpression()).To(Equal(expected))
+	},
+		Entry("every day", "0 8 * * *", "cron(0 8 * * ? *)"),
+		Entry("on the first day of the month", "30 6 1 * *", "cron(30 6 1 * ? *)"),
+		Entry("on weekdays", "0 8 * * 1-5", "cron(0 8 ? * 2-6 *)"),
+		Entry("on Sundays numbered 0 and 7", "0 8 * * 0,7", "cron(0 8 ? * 1,1 *)"),
+		Entry("every other day of the week", "0 8 * * */2", "cron(0 8 ? * */2 *)"),
+		Entry("with day names", "0 8 * * MON-FRI", "cron(0 8 ? * MON-FRI *)"),
+	)
+
+	Describe("asgMetricsCollection validation", func() {
+		It("accepts a granularity of 1Minute", func() {
+			ng := api.NewClusterConfig().NewNodeGroup()
+			ng.ASGMetricsCollection = []api.MetricsCollection{{Granularity: "1Minute", Metrics: []string{"GroupDesiredCapacity"}}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+
+			mng := api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			mng.ASGMetricsCollection = ng.ASGMetricsCollection
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(Succeed())
+		})
+
+		It("rejects other granularities", func() {
+			mng := api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			mng.ASGMetricsCollection = []api.MetricsCollection{{Granularity: "5Minutes"}}
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(MatchError(`managedNodeGroups[0].asgMetricsCollection[0].granularity must be "1Minute"`))
+		})
+	})
+
+	Describe("iam.skipAWSAuthConfigMap", func() {
+		It("is rejected for managed nodegroups", func() {
+			mng := api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			mng.IAM.SkipAWSAuthConfigMap = api.Enabled()
+			Expect(api.ValidateManagedNodeGroup(0, mng)).To(MatchError(ContainSubstring("skipAWSAuthConfigMap is not supported for Managed Nodes")))
+		})
+
+		It("defaults to iam.skipNodeGroupsAWSAuthConfigMap", func() {
+			cfg := api.NewClusterConfig()
+			ng := cfg.NewNodeGroup()
+			Expect(cfg.SkipsAWSAuthConfigMap(ng)).To(BeFalse())
+
+			cfg.IAM.SkipNodeGroupsAWSAuthConfigMap = api.Enabled()
+			Expect(cfg.SkipsAWSAuthConfigMap(ng)).To(BeTrue())
+
+			ng.IAM.SkipAWSAuthConfigMap = api.Disabled()
+			Expect(cfg.SkipsAWSAuthConfigMap(ng)).To(BeFalse())
+		})
+	})
+
 	Describe("nodeGroups[*].maxInstanceLifetime validation", func() {
 		It("should reject if value is below a day", func() {
 			cfg := api.NewClusterConfig()
@@ -527,6 +1170,31 @@ var _ = Describe("ClusterConfig validation", func() {
 			))
 		})
 
+		It("should pass when iam.serviceAccounts[0] only has policy files or URLs", func() {
+			cfg.IAM.WithOIDC = api.Enabled()
+
+			cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{}, {}}
+			cfg.IAM.ServiceAccounts[0].Name = "sa-1"
+			cfg.IAM.ServiceAccounts[0].AttachPolicyFiles = []string{"./policies/s3.json"}
+
+			cfg.IAM.ServiceAccounts[1].Name = "sa-2"
+			cfg.IAM.ServiceAccounts[1].AttachPolicyURLs = []string{"https://example.com/policies/s3.json"}
+
+			err = api.ValidateClusterConfig(cfg)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail when iam.serviceAccounts[0] has an invalid policy URL", func() {
+			cfg.IAM.WithOIDC = api.Enabled()
+
+			cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{}}
+			cfg.IAM.ServiceAccounts[0].Name = "sa-1"
+			cfg.IAM.ServiceAccounts[0].AttachPolicyURLs = []string{"ftp://example.com/s3.json"}
+
+			err = api.ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`iam.serviceAccounts[0].attachPolicyURLs[0]: "ftp://example.com/s3.json" is not a valid HTTP(S) URL`))
+		})
+
 		It("should fail when non-uniquely named iam.serviceAccounts are given", func() {
 			cfg.IAM.WithOIDC = api.Enabled()
 
@@ -623,6 +1291,29 @@ var _ = Describe("ClusterConfig validation", func() {
 			},
 			expectedErr: "cannot set cloudWatch.clusterLogging.logRetentionInDays without enabling log types",
 		}),
+
+		Entry("log group KMS key", logRetentionEntry{
+			logging: &api.ClusterCloudWatchLogging{
+				LogRetentionInDays: 545,
+				LogGroupKMSKeyARN:  "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+				EnableTypes:        []string{"api"},
+			},
+		}),
+
+		Entry("log group KMS key alias", logRetentionEntry{
+			logging: &api.ClusterCloudWatchLogging{
+				LogGroupKMSKeyARN: "arn:aws:kms:us-west-2:123456789012:alias/logs",
+				EnableTypes:       []string{"api"},
+			},
+			expectedErr: `invalid value "arn:aws:kms:us-west-2:123456789012:alias/logs" for cloudWatch.clusterLogging.logGroupKMSKeyARN`,
+		}),
+
+		Entry("log group KMS key without enableTypes", logRetentionEntry{
+			logging: &api.ClusterCloudWatchLogging{
+				LogGroupKMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			},
+			expectedErr: "cannot set cloudWatch.clusterLogging.logGroupKMSKeyARN without enabling log types",
+		}),
 	)
 
 	Describe("Cluster Endpoint access", func() {
@@ -678,6 +1369,26 @@ var _ = Describe("ClusterConfig validation", func() {
 					err := api.ValidateClusterConfig(cfg)
 					Expect(err).To(MatchError(api.ErrClusterEndpointNoAccess))
 				})
+
+				It("should error on a DNS record without a hosted zone", func() {
+					cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+						PrivateAccess: api.Disabled(),
+						PublicAccess:  api.Enabled(),
+						DNS:           &api.ClusterEndpointDNS{Name: "api.cluster.example.com"},
+					}
+					err := api.ValidateClusterConfig(cfg)
+					Expect(err).To(MatchError("vpc.clusterEndpoints.dns.hostedZoneID must be set"))
+				})
+
+				It("should error on a DNS record without a name", func() {
+					cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+						PrivateAccess: api.Disabled(),
+						PublicAccess:  api.Enabled(),
+						DNS:           &api.ClusterEndpointDNS{HostedZoneID: "Z0123456789"},
+					}
+					err := api.ValidateClusterConfig(cfg)
+					Expect(err).To(MatchError("vpc.clusterEndpoints.dns.name must be set"))
+				})
 			})
 		})
 	})
@@ -1021,6 +1732,39 @@ var _ = Describe("ClusterConfig validation", func() {
 						Expect(err).To(MatchError(ContainSubstring("auto allocate ipv6 is not supported with IPv6")))
 					})
 				})
+
+				When("ipFamily is set to IPv6 and unmanaged nodegroups are defined", func() {
+					JustBeforeEach(func() {
+						cfg.Metadata.Version = api.Version1_22
+						cfg.IAM = &api.ClusterIAM{
+							WithOIDC: api.Enabled(),
+						}
+						cfg.Addons = append(cfg.Addons,
+							&api.Addon{Name: api.KubeProxyAddon},
+							&api.Addon{Name: api.CoreDNSAddon},
+							&api.Addon{Name: api.VPCCNIAddon},
+						)
+						cfg.VPC.NAT = nil
+					})
+
+					It("accepts AmazonLinux2 and Bottlerocket nodegroups", func() {
+						ng0 := cfg.NewNodeGroup()
+						ng0.Name = "al2"
+						ng0.AMIFamily = api.NodeImageFamilyAmazonLinux2
+						ng1 := cfg.NewNodeGroup()
+						ng1.Name = "bottlerocket"
+						ng1.AMIFamily = api.NodeImageFamilyBottlerocket
+						Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+					})
+
+					It("returns an error for other AMI families", func() {
+						ng := cfg.NewNodeGroup()
+						ng.Name = "ubuntu"
+						ng.AMIFamily = api.NodeImageFamilyUbuntu2004
+						err = api.ValidateClusterConfig(cfg)
+						Expect(err).To(MatchError(`nodeGroups[0].amiFamily "Ubuntu2004" is not supported for unmanaged nodegroups in IPv6 clusters, supported families are AmazonLinux2 and Bottlerocket`))
+					})
+				})
 			})
 		})
 
@@ -1047,6 +1791,146 @@ var _ = Describe("ClusterConfig validation", func() {
 					Expect(err).To(HaveOccurred())
 				})
 			})
+
+			When("public access cidrs has an IPv6 cidr", func() {
+				It("returns an error for IPv4 clusters", func() {
+					cfg.VPC.PublicAccessCIDRs = []string{"3.48.58.68/24", "2001:db8::/32"}
+					err = cfg.ValidateVPCConfig()
+					Expect(err).To(MatchError(`IPv6 CIDR "2001:db8::/32" in vpc.publicAccessCIDRs can only be used with an IPv6 cluster, as the API server endpoint of IPv4 clusters is only reachable over IPv4`))
+				})
+
+				It("accepts and normalises it for IPv6 clusters", func() {
+					cidrs, err := api.ValidatePublicAccessCIDRs([]string{"3.48.58.68/24", "2001:DB8::1/32"}, true)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(cidrs).To(Equal([]string{"3.48.58.0/24", "2001:db8::/32"}))
+				})
+			})
+		})
+
+		Context("openWebhookPorts", func() {
+			It("accepts valid ports", func() {
+				cfg.VPC.OpenWebhookPorts = []int{8443, 9443, 15017}
+				Expect(cfg.ValidateVPCConfig()).To(Succeed())
+			})
+
+			It("rejects ports out of range", func() {
+				cfg.VPC.OpenWebhookPorts = []int{8443, 70000}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError("invalid port 70000 in vpc.openWebhookPorts, must be between 1 and 65535"))
+			})
+
+			It("rejects duplicate ports", func() {
+				cfg.VPC.OpenWebhookPorts = []int{9443, 9443}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError("port 9443 is specified more than once in vpc.openWebhookPorts"))
+			})
+
+			When("manageSharedNodeSecurityGroupRules is disabled", func() {
+				It("returns an error", func() {
+					cfg.VPC.SharedNodeSecurityGroup = "sg-shared"
+					cfg.VPC.ManageSharedNodeSecurityGroupRules = api.Disabled()
+					cfg.VPC.OpenWebhookPorts = []int{8443}
+					Expect(cfg.ValidateVPCConfig()).To(MatchError("vpc.openWebhookPorts cannot be set when vpc.manageSharedNodeSecurityGroupRules is disabled"))
+				})
+			})
+		})
+
+		Context("dns", func() {
+			It("accepts custom DHCP options", func() {
+				cfg.VPC.DNS = &api.ClusterVPCDNS{
+					DomainName:         "corp.example.com",
+					DomainNameServers:  []string{"10.0.0.2", api.AmazonProvidedDNS},
+					EnableDNSHostnames: api.Enabled(),
+				}
+				Expect(cfg.ValidateVPCConfig()).To(Succeed())
+			})
+
+			It("rejects disabling the DNS attributes EKS requires", func() {
+				cfg.VPC.DNS = &api.ClusterVPCDNS{EnableDNSHostnames: api.Disabled()}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring("vpc.dns.enableDnsHostnames cannot be disabled")))
+				cfg.VPC.DNS = &api.ClusterVPCDNS{EnableDNSSupport: api.Disabled()}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring("vpc.dns.enableDnsSupport cannot be disabled")))
+			})
+
+			It("rejects invalid domain name servers", func() {
+				cfg.VPC.DNS = &api.ClusterVPCDNS{DomainNameServers: []string{"ns1.example.com"}}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(`invalid domain name server "ns1.example.com" in vpc.dns.domainNameServers, must be an IPv4 address or AmazonProvidedDNS`))
+				cfg.VPC.DNS = &api.ClusterVPCDNS{DomainNameServers: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5"}}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError("vpc.dns.domainNameServers can hold at most 4 servers, got 5"))
+			})
+
+			It("rejects DNS settings for an existing VPC", func() {
+				cfg.VPC.ID = "vpc-1"
+				cfg.VPC.DNS = &api.ClusterVPCDNS{DomainName: "corp.example.com"}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring("vpc.dns cannot be set when using an existing VPC")))
+			})
+		})
+
+		Context("flowLogs", func() {
+			It("accepts flow logs delivered to CloudWatch", func() {
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{
+					Destination:        api.FlowLogsDestinationCloudWatch,
+					TrafficType:        api.FlowLogsTrafficTypeReject,
+					LogRetentionInDays: 30,
+				}
+				Expect(cfg.ValidateVPCConfig()).To(Succeed())
+			})
+
+			It("accepts flow logs delivered to S3", func() {
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{
+					Destination: api.FlowLogsDestinationS3,
+					S3BucketARN: "arn:aws:s3:::flow-logs/cluster",
+				}
+				Expect(cfg.ValidateVPCConfig()).To(Succeed())
+			})
+
+			It("rejects invalid destinations and traffic types", func() {
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{Destination: "kinesis"}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring(`invalid vpc.flowLogs.destination "kinesis"`)))
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{TrafficType: "DROPPED"}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring(`invalid vpc.flowLogs.trafficType "DROPPED"`)))
+			})
+
+			It("rejects an S3 destination without a valid bucket ARN", func() {
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{Destination: api.FlowLogsDestinationS3}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError("vpc.flowLogs.s3BucketARN must be set when vpc.flowLogs.destination is s3"))
+				cfg.VPC.FlowLogs.S3BucketARN = "arn:aws:logs:us-west-2:123456789012:log-group:flow-logs"
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring("must be the ARN of an S3 bucket")))
+			})
+
+			It("rejects settings of the other destination", func() {
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{S3BucketARN: "arn:aws:s3:::flow-logs"}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError("vpc.flowLogs.s3BucketARN can only be set when vpc.flowLogs.destination is s3"))
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{
+					Destination:        api.FlowLogsDestinationS3,
+					S3BucketARN:        "arn:aws:s3:::flow-logs",
+					LogRetentionInDays: 30,
+				}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError("vpc.flowLogs.logRetentionInDays can only be set when vpc.flowLogs.destination is cloudwatch"))
+			})
+
+			It("rejects an unsupported log retention", func() {
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{LogRetentionInDays: 2}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring("invalid value 2 for vpc.flowLogs.logRetentionInDays")))
+			})
+
+			It("rejects flow logs for an existing VPC", func() {
+				cfg.VPC.ID = "vpc-1"
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring("vpc.flowLogs cannot be set when using an existing VPC")))
+			})
+		})
+
+		Context("instanceConnectEndpoint", func() {
+			It("accepts an endpoint", func() {
+				cfg.VPC.InstanceConnectEndpoint = &api.InstanceConnectEndpoint{Enabled: true}
+				Expect(cfg.ValidateVPCConfig()).To(Succeed())
+			})
+
+			It("rejects an endpoint when the shared node security group rules aren't managed", func() {
+				cfg.VPC.SharedNodeSecurityGroup = "sg-shared"
+				cfg.VPC.ManageSharedNodeSecurityGroupRules = api.Disabled()
+				cfg.VPC.InstanceConnectEndpoint = &api.InstanceConnectEndpoint{Enabled: true}
+				Expect(cfg.ValidateVPCConfig()).To(MatchError("vpc.instanceConnectEndpoint cannot be enabled when vpc.manageSharedNodeSecurityGroupRules is disabled"))
+			})
 		})
 
 		Context("ipv6 CIDRs", func() {
@@ -1211,6 +2095,214 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("vpcCNI", func() {
+		var cfg *api.ClusterConfig
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPCCNI = &api.VPCCNI{}
+		})
+
+		It("accepts prefix delegation settings", func() {
+			warmPrefixTarget, minimumIPTarget := 1, 10
+			cfg.VPCCNI.PrefixDelegation = true
+			cfg.VPCCNI.WarmPrefixTarget = &warmPrefixTarget
+			cfg.VPCCNI.MinimumIPTarget = &minimumIPTarget
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("errors if warmPrefixTarget is set without prefixDelegation", func() {
+			warmPrefixTarget := 1
+			cfg.VPCCNI.WarmPrefixTarget = &warmPrefixTarget
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpcCNI.warmPrefixTarget requires vpcCNI.prefixDelegation to be enabled"))
+		})
+
+		It("errors if minimumIPTarget is negative", func() {
+			minimumIPTarget := -1
+			cfg.VPCCNI.MinimumIPTarget = &minimumIPTarget
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpcCNI.minimumIPTarget must be a non-negative integer"))
+		})
+
+		It("errors if podENI is enabled without the VPC resource controller policy", func() {
+			cfg.VPCCNI.PodENI = true
+			cfg.IAM.VPCResourceControllerPolicy = api.Disabled()
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpcCNI.podENI requires iam.vpcResourceControllerPolicy to be enabled"))
+		})
+
+		It("errors if the vpc-cni addon also sets configurationValues", func() {
+			cfg.VPCCNI.PrefixDelegation = true
+			cfg.Addons = []*api.Addon{{Name: api.VPCCNIAddon, ConfigurationValues: `{"env":{"WARM_IP_TARGET":"2"}}`}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("configurationValues cannot be set on the vpc-cni addon when vpcCNI is set"))
+		})
+
+		It("adds the vpc-cni addon with the corresponding configuration values", func() {
+			warmPrefixTarget := 1
+			cfg.VPCCNI.PrefixDelegation = true
+			cfg.VPCCNI.WarmPrefixTarget = &warmPrefixTarget
+			cfg.VPCCNI.PodENI = true
+			cfg.VPCCNI.EnableNetworkPolicy = true
+			Expect(cfg.SetVPCCNIAddonConfiguration()).To(Succeed())
+			Expect(cfg.Addons).To(HaveLen(1))
+			Expect(cfg.Addons[0].Name).To(Equal(api.VPCCNIAddon))
+			Expect(cfg.Addons[0].ConfigurationValues).To(MatchJSON(`{
+				"env": {"ENABLE_PREFIX_DELEGATION": "true", "WARM_PREFIX_TARGET": "1", "ENABLE_POD_ENI": "true"},
+				"init": {"env": {"DISABLE_TCP_EARLY_DEMUX": "true"}},
+				"enableNetworkPolicy": "true"
+			}`))
+		})
+
+		It("sets the configuration values of an existing vpc-cni addon", func() {
+			cfg.VPCCNI.EnableNetworkPolicy = true
+			cfg.Addons = []*api.Addon{{Name: api.VPCCNIAddon, Version: "latest"}}
+			Expect(cfg.SetVPCCNIAddonConfiguration()).To(Succeed())
+			Expect(cfg.Addons).To(HaveLen(1))
+			Expect(cfg.Addons[0].Version).To(Equal("latest"))
+			Expect(cfg.Addons[0].ConfigurationValues).To(MatchJSON(`{"enableNetworkPolicy": "true"}`))
+		})
+	})
+
+	Describe("accessConfig", func() {
+		var cfg *api.ClusterConfig
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.AccessConfig = &api.AccessConfig{}
+		})
+
+		It("accepts the authentication modes supported by EKS", func() {
+			for _, mode := range api.AuthenticationModes() {
+				cfg.AccessConfig.AuthenticationMode = mode
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			}
+		})
+
+		It("errors on an unknown authentication mode", func() {
+			cfg.AccessConfig.AuthenticationMode = "IAM"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`accessConfig.authenticationMode must be one of [CONFIG_MAP API_AND_CONFIG_MAP API], got "IAM"`))
+		})
+
+		It("errors if the API mode disables the aws-auth ConfigMap that nodegroups need", func() {
+			cfg.AccessConfig.AuthenticationMode = api.AuthenticationModeAPI
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`the nodes of nodegroup "ng" need to join the cluster, use API_AND_CONFIG_MAP instead`)))
+
+			ng.IAM.SkipAWSAuthConfigMap = api.Enabled()
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("accepts cluster admins instead of the cluster creator", func() {
+			cfg.AccessConfig.AuthenticationMode = api.AuthenticationModeAPIAndConfigMap
+			cfg.AccessConfig.BootstrapClusterCreatorAdminPermissions = api.Disabled()
+			cfg.AccessConfig.ClusterAdminARNs = []string{"arn:aws:iam::123456789012:role/admin", "arn:aws:iam::123456789012:user/ops"}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("errors if the cluster would have no admin", func() {
+			cfg.AccessConfig.AuthenticationMode = api.AuthenticationModeAPI
+			cfg.AccessConfig.BootstrapClusterCreatorAdminPermissions = api.Disabled()
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("accessConfig.clusterAdminARNs must be set")))
+		})
+
+		It("errors if cluster admins are set without access entries", func() {
+			cfg.AccessConfig.ClusterAdminARNs = []string{"arn:aws:iam::123456789012:role/admin"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("accessConfig.authenticationMode must be API or API_AND_CONFIG_MAP to use access entries for the cluster admins"))
+
+			cfg.AccessConfig.AuthenticationMode = api.AuthenticationModeConfigMap
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("accessConfig.authenticationMode must be API or API_AND_CONFIG_MAP")))
+		})
+
+		It("errors if a cluster admin isn't an IAM principal", func() {
+			cfg.AccessConfig.AuthenticationMode = api.AuthenticationModeAPI
+			cfg.AccessConfig.ClusterAdminARNs = []string{"arn:aws:s3:::bucket"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`accessConfig.clusterAdminARNs[0]: "arn:aws:s3:::bucket" is not the ARN of an IAM principal`))
+		})
+	})
+
+	DescribeTable("authentication mode updates",
+		func(current, mode, expectedErr string) {
+			err := api.ValidateAuthenticationModeUpdate(current, mode)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			}
+		},
+		Entry("CONFIG_MAP to API_AND_CONFIG_MAP", api.AuthenticationModeConfigMap, api.AuthenticationModeAPIAndConfigMap, ""),
+		Entry("API_AND_CONFIG_MAP to API", api.AuthenticationModeAPIAndConfigMap, api.AuthenticationModeAPI, ""),
+		Entry("CONFIG_MAP to API", api.AuthenticationModeConfigMap, api.AuthenticationModeAPI, "switch to API_AND_CONFIG_MAP and create access entries for the cluster admins first"),
+		Entry("API to API_AND_CONFIG_MAP", api.AuthenticationModeAPI, api.AuthenticationModeAPIAndConfigMap, "cannot switch the authentication mode from API back to API_AND_CONFIG_MAP"),
+		Entry("unknown mode", api.AuthenticationModeConfigMap, "IAM", "authentication mode must be one of"),
+	)
+
+	Describe("cosign and artifact verification", func() {
+		const digest = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+		var cfg *api.ClusterConfig
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Charts = []*api.HelmChart{{Repo: "https://charts.example.com", Name: "app", Version: "1.0.0", ReleaseName: "app"}}
+		})
+
+		It("accepts a pinned digest without cosign", func() {
+			cfg.Charts[0].Verification = &api.ArtifactVerification{Digest: digest}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("accepts signatures with a cosign key", func() {
+			cfg.Cosign = &api.Cosign{Key: "cosign.pub"}
+			cfg.GitOps = &api.GitOps{Flux: &api.Flux{BinaryVerification: &api.ArtifactVerification{Signature: "flux.sig"}}}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("errors if the cosign key isn't set", func() {
+			cfg.Cosign = &api.Cosign{}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("cosign.key must be set"))
+		})
+
+		It("errors if a signature is set without cosign", func() {
+			cfg.Charts[0].Verification = &api.ArtifactVerification{Signature: "app.tgz.sig"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("charts[0].verification.signature requires cosign.key to be set"))
+		})
+
+		It("errors if neither a digest nor a signature is set", func() {
+			cfg.Karpenter = &api.Karpenter{Version: "0.6.0", ChartVerification: &api.ArtifactVerification{}}
+			cfg.IAM.WithOIDC = api.Enabled()
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("at least one of karpenter.chartVerification.digest and karpenter.chartVerification.signature must be set"))
+		})
+
+		It("errors if the digest isn't a SHA-256 digest", func() {
+			cfg.Bootstrap = &api.Bootstrap{HelmCharts: []*api.BootstrapHelmChart{{
+				Name: "base", Repo: "https://charts.example.com", Chart: "base", Version: "1.0.0",
+				Verification: &api.ArtifactVerification{Digest: "md5:acbd18db4cc2f85cedef654fccc4a4d8"},
+			}}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`bootstrap.helmCharts[0].verification.digest "md5:acbd18db4cc2f85cedef654fccc4a4d8" must be a SHA-256 digest in the format sha256:<hex>`))
+		})
+	})
+
+	Describe("imageRepositoryOverride", func() {
+		var cfg *api.ClusterConfig
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		DescribeTable("valid repositories", func(repository string) {
+			cfg.ImageRepositoryOverride = repository
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		},
+			Entry("registry", "mycorp.jfrog.io"),
+			Entry("registry with a path", "mycorp.jfrog.io/eks"),
+			Entry("registry with a nested path", "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/eks-images"),
+		)
+
+		DescribeTable("invalid repositories", func(repository string) {
+			cfg.ImageRepositoryOverride = repository
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("imageRepositoryOverride %q must be a registry host", repository)))
+		},
+			Entry("scheme", "https://mycorp.jfrog.io/eks"),
+			Entry("trailing slash", "mycorp.jfrog.io/eks/"),
+			Entry("port", "mycorp.jfrog.io:5000/eks"),
+			Entry("tag", "mycorp.jfrog.io/eks:latest"),
+		)
+	})
+
 	Describe("cpuCredits", func() {
 		var ng *api.NodeGroup
 		BeforeEach(func() {
@@ -1297,6 +2389,34 @@ var _ = Describe("ClusterConfig validation", func() {
 			Expect(err).To(MatchError("only one of publicKeyName, publicKeyPath or publicKey can be specified for SSH per node-group"))
 		})
 
+		It("accepts authorized keys along with a key pair", func() {
+			ng.SSH = &api.NodeGroupSSH{
+				Allow:          api.Enabled(),
+				PublicKeyName:  &testKeyName,
+				AuthorizedKeys: []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEOp3SRPeF/s2A6QbuUCzSQCp7mAvWDP2Ue79pU50PV6 user@example.com"},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("fails when an authorized key is not an SSH public key", func() {
+			ng.SSH = &api.NodeGroupSSH{
+				Allow:          api.Enabled(),
+				AuthorizedKeys: []string{testKey},
+			}
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring("nodeGroups[0].ssh.authorizedKeys[0] is not a valid SSH public key")))
+		})
+
+		It("fails when authorized keys are set for Bottlerocket nodes", func() {
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			ng.SSH = &api.NodeGroupSSH{
+				Allow:          api.Enabled(),
+				AuthorizedKeys: []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEOp3SRPeF/s2A6QbuUCzSQCp7mAvWDP2Ue79pU50PV6 user@example.com"},
+			}
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError("ssh.authorizedKeys is not supported for Bottlerocket nodegroups (path=nodeGroups[0].ssh.authorizedKeys)"))
+		})
+
 		Context("Instances distribution", func() {
 			var ng *api.NodeGroup
 			BeforeEach(func() {
@@ -6,7 +6,7 @@ import (
 	"net"
 	"reflect"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
 
 	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
@@ -153,6 +153,11 @@ type (
 		// Defaults to `true`
 		// +optional
 		ManageSharedNodeSecurityGroupRules *bool `json:"manageSharedNodeSecurityGroupRules,omitempty"`
+		// OpenWebhookPorts lists TCP ports on which the control plane can
+		// reach nodes through the shared node security group, e.g. for
+		// admission webhooks listening on ports such as 8443, 9443 or 15017
+		// +optional
+		OpenWebhookPorts []int `json:"openWebhookPorts,omitempty"`
 		// AutoAllocateIPV6 requests an IPv6 CIDR block with /56 prefix for the VPC
 		// +optional
 		AutoAllocateIPv6 *bool `json:"autoAllocateIPv6,omitempty"`
@@ -165,6 +170,17 @@ type (
 		// k8s API endpoint
 		// +optional
 		PublicAccessCIDRs []string `json:"publicAccessCIDRs,omitempty"`
+		// DNS configures the DHCP options and DNS attributes of the VPC
+		// created by eksctl
+		// +optional
+		DNS *ClusterVPCDNS `json:"dns,omitempty"`
+		// FlowLogs captures the IP traffic of the VPC created by eksctl
+		// +optional
+		FlowLogs *ClusterVPCFlowLogs `json:"flowLogs,omitempty"`
+		// InstanceConnectEndpoint creates an EC2 Instance Connect Endpoint in a private subnet,
+		// through which nodes can be reached over SSH without a bastion
+		// +optional
+		InstanceConnectEndpoint *InstanceConnectEndpoint `json:"instanceConnectEndpoint,omitempty"`
 	}
 	// ClusterSubnets holds private and public subnets
 	ClusterSubnets struct {
@@ -193,6 +209,58 @@ type (
 		// +optional
 		IPv6Pool string `json:"ipv6Pool,omitempty"`
 	}
+	// ClusterVPCDNS holds the DNS settings of a VPC created by eksctl. Setting
+	// `domainName` or `domainNameServers` associates custom DHCP options with the VPC
+	ClusterVPCDNS struct {
+		// DomainName is the domain name instances resolve unqualified names in,
+		// e.g. `corp.example.com`
+		// +optional
+		DomainName string `json:"domainName,omitempty"`
+		// DomainNameServers are the IPv4 addresses of up to four DNS servers, or
+		// `AmazonProvidedDNS`. Defaults to `AmazonProvidedDNS`
+		// +optional
+		DomainNameServers []string `json:"domainNameServers,omitempty"`
+		// EnableDNSHostnames must stay enabled, as nodes register with their private DNS names.
+		// Defaults to `true`
+		// +optional
+		EnableDNSHostnames *bool `json:"enableDnsHostnames,omitempty"`
+		// EnableDNSSupport must stay enabled, as nodes resolve the cluster endpoint with the Amazon DNS server.
+		// Defaults to `true`
+		// +optional
+		EnableDNSSupport *bool `json:"enableDnsSupport,omitempty"`
+	}
+
+	// ClusterVPCFlowLogs holds the configuration of the flow log of a VPC created by eksctl
+	ClusterVPCFlowLogs struct {
+		// Destination of the flow log records, valid variants are `FlowLogsDestination` constants.
+		// Defaults to `cloudwatch`, which creates a log group and a role for delivering the records to it
+		// +optional
+		Destination string `json:"destination,omitempty"`
+		// S3BucketARN is the ARN of the S3 bucket, or of a folder in it, the records are delivered to,
+		// it's required when the destination is `s3`
+		// +optional
+		S3BucketARN string `json:"s3BucketARN,omitempty"`
+		// TrafficType is the type of traffic to capture, `ACCEPT`, `REJECT` or `ALL`.
+		// Defaults to `ALL`
+		// +optional
+		TrafficType string `json:"trafficType,omitempty"`
+		// LogFormat is the format of the records, e.g. `${srcaddr} ${dstaddr} ${action}`.
+		// Defaults to the AWS default format
+		// +optional
+		LogFormat string `json:"logFormat,omitempty"`
+		// LogRetentionInDays is the retention of the log group of the `cloudwatch` destination,
+		// records are kept forever by default
+		// +optional
+		LogRetentionInDays int `json:"logRetentionInDays,omitempty"`
+	}
+
+	// InstanceConnectEndpoint holds the configuration of the EC2 Instance Connect Endpoint of the cluster
+	InstanceConnectEndpoint struct {
+		// Enabled creates the endpoint, along with a security group allowing it to reach
+		// nodes on port 22 through the shared node security group
+		Enabled bool `json:"enabled"`
+	}
+
 	// ClusterNAT NAT config
 	ClusterNAT struct {
 		// Valid variants are `ClusterNAT` constants
@@ -203,6 +271,21 @@ type (
 	ClusterEndpoints struct {
 		PrivateAccess *bool `json:"privateAccess,omitempty"`
 		PublicAccess  *bool `json:"publicAccess,omitempty"`
+		// DNS creates a record pointing to the API server endpoint, so that
+		// kubeconfigs and tooling can use a stable name
+		// +optional
+		DNS *ClusterEndpointDNS `json:"dns,omitempty"`
+	}
+
+	// ClusterEndpointDNS is a CNAME record in a Route 53 hosted zone pointing to the API server endpoint,
+	// it is part of the cluster stack so it follows the endpoint when the cluster is recreated
+	ClusterEndpointDNS struct {
+		// HostedZoneID is the ID of the Route 53 hosted zone the record is created in
+		// +required
+		HostedZoneID string `json:"hostedZoneID"`
+		// Name of the record, e.g. `api.my-cluster.example.com`
+		// +required
+		Name string `json:"name"`
 	}
 )
 
@@ -221,6 +304,34 @@ const (
 	SubnetTopologyPublic SubnetTopology = "Public"
 )
 
+// Values for `FlowLogsDestination`
+const (
+	// FlowLogsDestinationCloudWatch delivers flow log records to a CloudWatch log group
+	FlowLogsDestinationCloudWatch = "cloudwatch"
+	// FlowLogsDestinationS3 delivers flow log records to an S3 bucket
+	FlowLogsDestinationS3 = "s3"
+)
+
+// Values for the `TrafficType` of flow logs
+const (
+	FlowLogsTrafficTypeAll    = "ALL"
+	FlowLogsTrafficTypeAccept = "ACCEPT"
+	FlowLogsTrafficTypeReject = "REJECT"
+)
+
+// AmazonProvidedDNS is the domain name server of DHCP options that stands for the Amazon DNS server
+const AmazonProvidedDNS = "AmazonProvidedDNS"
+
+// HasCustomDHCPOptions reports whether custom DHCP options are to be associated with the VPC
+func (d *ClusterVPCDNS) HasCustomDHCPOptions() bool {
+	return d != nil && (d.DomainName != "" || len(d.DomainNameServers) > 0)
+}
+
+// HasInstanceConnectEndpoint reports whether an EC2 Instance Connect Endpoint is to be created
+func (c *ClusterVPC) HasInstanceConnectEndpoint() bool {
+	return c != nil && c.InstanceConnectEndpoint != nil && c.InstanceConnectEndpoint.Enabled
+}
+
 // SubnetTopologies returns a list of topologies
 func SubnetTopologies() []SubnetTopology {
 	return []SubnetTopology{
@@ -267,8 +378,10 @@ func (c *ClusterConfig) ImportSubnet(topology SubnetTopology, az, subnetID, cidr
 // Note that the user must use
 // EITHER AZs as keys
 // OR names as keys and specify
-//    the ID (optionally with AZ and CIDR)
-//    OR AZ, optionally with CIDR
+//
+//	the ID (optionally with AZ and CIDR)
+//	OR AZ, optionally with CIDR
+//
 // If a user specifies a subnet by AZ without CIDR and ID but multiple subnets
 // exist in this VPC, one will be arbitrarily chosen
 func doImportSubnet(subnets AZSubnetMapping, az, subnetID, cidr string) error {
@@ -381,19 +494,19 @@ func (c *ClusterConfig) HasSufficientSubnets() error {
 	return nil
 }
 
-//DefaultEndpointsMsg returns a message that the EndpointAccess is the same as the default
+// DefaultEndpointsMsg returns a message that the EndpointAccess is the same as the default
 func (c *ClusterConfig) DefaultEndpointsMsg() string {
 	return fmt.Sprintf(
 		"Kubernetes API endpoint access will use default of {publicAccess=true, privateAccess=false} for cluster %q in %q", c.Metadata.Name, c.Metadata.Region)
 }
 
-//CustomEndpointsMsg returns a message indicating the EndpointAccess given by the user
+// CustomEndpointsMsg returns a message indicating the EndpointAccess given by the user
 func (c *ClusterConfig) CustomEndpointsMsg() string {
 	return fmt.Sprintf(
 		"Kubernetes API endpoint access will use provided values {publicAccess=%v, privateAccess=%v} for cluster %q in %q", *c.VPC.ClusterEndpoints.PublicAccess, *c.VPC.ClusterEndpoints.PrivateAccess, c.Metadata.Name, c.Metadata.Region)
 }
 
-//UpdateEndpointsMsg gives message indicating that they need to use eksctl utils to make this config
+// UpdateEndpointsMsg gives message indicating that they need to use eksctl utils to make this config
 func (c *ClusterConfig) UpdateEndpointsMsg() string {
 	return fmt.Sprintf(
 		"you can update Kubernetes API endpoint access with `eksctl utils update-cluster-endpoints --region=%s --name=%s --private-access=bool --public-access=bool`", c.Metadata.Region, c.Metadata.Name)
@@ -404,11 +517,11 @@ func EndpointsEqual(a, b ClusterEndpoints) bool {
 	return reflect.DeepEqual(a, b)
 }
 
-//HasClusterEndpointAccess determines if endpoint access was configured in config file or not
+// HasClusterEndpointAccess determines if endpoint access was configured in config file or not
 func (c *ClusterConfig) HasClusterEndpointAccess() bool {
 	if c.VPC != nil && c.VPC.ClusterEndpoints != nil {
-		hasPublicAccess := aws.BoolValue(c.VPC.ClusterEndpoints.PublicAccess)
-		hasPrivateAccess := aws.BoolValue(c.VPC.ClusterEndpoints.PrivateAccess)
+		hasPublicAccess := aws.ToBool(c.VPC.ClusterEndpoints.PublicAccess)
+		hasPrivateAccess := aws.ToBool(c.VPC.ClusterEndpoints.PrivateAccess)
 		return hasPublicAccess || hasPrivateAccess
 	}
 	return true
@@ -0,0 +1,132 @@
+package v1alpha5
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// VPCCNI holds the configuration of the VPC CNI plugin, it is written to the configuration values of the
+// vpc-cni addon, which is created before any nodegroups when the cluster is created
+type VPCCNI struct {
+	// PrefixDelegation assigns IPv4 prefixes instead of individual IP addresses to the nodes' network interfaces,
+	// increasing the number of pods that can run on a node. The maximum number of pods of nodegroups
+	// that do not set `maxPodsPerNode` is calculated accordingly
+	// +optional
+	PrefixDelegation bool `json:"prefixDelegation,omitempty"`
+	// WarmPrefixTarget is the number of prefixes to keep allocated to a node in addition to the ones in use,
+	// it requires `prefixDelegation`
+	// +optional
+	WarmPrefixTarget *int `json:"warmPrefixTarget,omitempty"`
+	// MinimumIPTarget is the minimum number of IP addresses to keep allocated to a node
+	// +optional
+	MinimumIPTarget *int `json:"minimumIPTarget,omitempty"`
+	// PodENI enables security groups for pods, assigning branch network interfaces with their own security groups
+	// to pods selected by a SecurityGroupPolicy. Nodegroups whose instance types support it are labelled with
+	// `alpha.eksctl.io/security-groups-for-pods: "true"`
+	// +optional
+	PodENI bool `json:"podENI,omitempty"`
+	// EnableNetworkPolicy enables the network policy agent of the VPC CNI, which enforces
+	// Kubernetes NetworkPolicy resources
+	// +optional
+	EnableNetworkPolicy bool `json:"enableNetworkPolicy,omitempty"`
+}
+
+// vpcCNIConfigurationValues holds the subset of the vpc-cni addon configuration schema set by VPCCNI
+type vpcCNIConfigurationValues struct {
+	Env                 map[string]string `json:"env,omitempty"`
+	Init                *vpcCNIInitValues `json:"init,omitempty"`
+	EnableNetworkPolicy string            `json:"enableNetworkPolicy,omitempty"`
+}
+
+type vpcCNIInitValues struct {
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// SetVPCCNIAddonConfiguration adds the vpc-cni addon to the cluster config if needed and sets its
+// configuration values according to the VPC CNI configuration
+func (c *ClusterConfig) SetVPCCNIAddonConfiguration() error {
+	if c.VPCCNI == nil {
+		return nil
+	}
+
+	values := vpcCNIConfigurationValues{Env: map[string]string{}}
+	if c.VPCCNI.PrefixDelegation {
+		values.Env["ENABLE_PREFIX_DELEGATION"] = "true"
+	}
+	if c.VPCCNI.WarmPrefixTarget != nil {
+		values.Env["WARM_PREFIX_TARGET"] = strconv.Itoa(*c.VPCCNI.WarmPrefixTarget)
+	}
+	if c.VPCCNI.MinimumIPTarget != nil {
+		values.Env["MINIMUM_IP_TARGET"] = strconv.Itoa(*c.VPCCNI.MinimumIPTarget)
+	}
+	if c.VPCCNI.PodENI {
+		values.Env["ENABLE_POD_ENI"] = "true"
+		// allows kubelet to reach pods with security groups for liveness and readiness probes
+		values.Init = &vpcCNIInitValues{Env: map[string]string{"DISABLE_TCP_EARLY_DEMUX": "true"}}
+	}
+	if c.VPCCNI.EnableNetworkPolicy {
+		values.EnableNetworkPolicy = "true"
+	}
+	if len(values.Env) == 0 && values.EnableNetworkPolicy == "" {
+		return nil
+	}
+
+	configurationValues, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("marshalling vpc-cni configuration values: %w", err)
+	}
+
+	for _, addon := range c.Addons {
+		if addon.CanonicalName() == VPCCNIAddon {
+			addon.ConfigurationValues = string(configurationValues)
+			return nil
+		}
+	}
+	c.Addons = append(c.Addons, &Addon{
+		Name:                VPCCNIAddon,
+		ConfigurationValues: string(configurationValues),
+		// the addon replaces the aws-node DaemonSet installed with the cluster, which has no settings worth keeping
+		Force: true,
+	})
+	return nil
+}
+
+// HasPrefixDelegation returns true if the VPC CNI is configured to assign prefixes to the nodes
+func (c *ClusterConfig) HasPrefixDelegation() bool {
+	return c.VPCCNI != nil && c.VPCCNI.PrefixDelegation
+}
+
+// HasPodENI returns true if security groups for pods are enabled
+func (c *ClusterConfig) HasPodENI() bool {
+	return c.VPCCNI != nil && c.VPCCNI.PodENI
+}
+
+func (c *ClusterConfig) validateVPCCNI() error {
+	if c.VPCCNI == nil {
+		return nil
+	}
+	if c.IPv6Enabled() {
+		return fmt.Errorf("vpcCNI is not supported with IPv6, the VPC CNI always assigns prefixes in IPv6 clusters")
+	}
+	if c.VPCCNI.WarmPrefixTarget != nil {
+		if !c.VPCCNI.PrefixDelegation {
+			return fmt.Errorf("vpcCNI.warmPrefixTarget requires vpcCNI.prefixDelegation to be enabled")
+		}
+		if *c.VPCCNI.WarmPrefixTarget < 0 {
+			return fmt.Errorf("vpcCNI.warmPrefixTarget must be a non-negative integer")
+		}
+	}
+	if c.VPCCNI.MinimumIPTarget != nil && *c.VPCCNI.MinimumIPTarget < 0 {
+		return fmt.Errorf("vpcCNI.minimumIPTarget must be a non-negative integer")
+	}
+	if c.VPCCNI.PodENI && c.IAM != nil && IsDisabled(c.IAM.VPCResourceControllerPolicy) {
+		return fmt.Errorf("vpcCNI.podENI requires iam.vpcResourceControllerPolicy to be enabled")
+	}
+	for _, addon := range c.Addons {
+		if addon.CanonicalName() == VPCCNIAddon && addon.ConfigurationValues != "" {
+			return fmt.Errorf("configurationValues cannot be set on the %s addon when vpcCNI is set", VPCCNIAddon)
+		}
+	}
+	return nil
+}
@@ -67,6 +67,32 @@ func (in *AZSubnetSpec) DeepCopy() *AZSubnetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessConfig) DeepCopyInto(out *AccessConfig) {
+	*out = *in
+	if in.BootstrapClusterCreatorAdminPermissions != nil {
+		in, out := &in.BootstrapClusterCreatorAdminPermissions, &out.BootstrapClusterCreatorAdminPermissions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClusterAdminARNs != nil {
+		in, out := &in.ClusterAdminARNs, &out.ClusterAdminARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessConfig.
+func (in *AccessConfig) DeepCopy() *AccessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addon) DeepCopyInto(out *Addon) {
 	*out = *in
@@ -97,6 +123,161 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactVerification) DeepCopyInto(out *ArtifactVerification) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactVerification.
+func (in *ArtifactVerification) DeepCopy() *ArtifactVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bootstrap) DeepCopyInto(out *Bootstrap) {
+	*out = *in
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]*BootstrapManifest, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BootstrapManifest)
+				**out = **in
+			}
+		}
+	}
+	if in.HelmCharts != nil {
+		in, out := &in.HelmCharts, &out.HelmCharts
+		*out = make([]*BootstrapHelmChart, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BootstrapHelmChart)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bootstrap.
+func (in *Bootstrap) DeepCopy() *Bootstrap {
+	if in == nil {
+		return nil
+	}
+	out := new(Bootstrap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapHelmChart) DeepCopyInto(out *BootstrapHelmChart) {
+	*out = *in
+	in.Values.DeepCopyInto(&out.Values)
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArtifactVerification)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapHelmChart.
+func (in *BootstrapHelmChart) DeepCopy() *BootstrapHelmChart {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapHelmChart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapManifest) DeepCopyInto(out *BootstrapManifest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapManifest.
+func (in *BootstrapManifest) DeepCopy() *BootstrapManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUOptions) DeepCopyInto(out *CPUOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUOptions.
+func (in *CPUOptions) DeepCopy() *CPUOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityBlock) DeepCopyInto(out *CapacityBlock) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityBlock.
+func (in *CapacityBlock) DeepCopy() *CapacityBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBudget) DeepCopyInto(out *ClusterBudget) {
+	*out = *in
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]float64, len(*in))
+		copy(*out, *in)
+	}
+	if in.Emails != nil {
+		in, out := &in.Emails, &out.Emails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBudget.
+func (in *ClusterBudget) DeepCopy() *ClusterBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterCloudWatch) DeepCopyInto(out *ClusterCloudWatch) {
 	*out = *in
@@ -181,6 +362,31 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 			}
 		}
 	}
+	if in.VPCCNI != nil {
+		in, out := &in.VPCCNI, &out.VPCCNI
+		*out = new(VPCCNI)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessConfig != nil {
+		in, out := &in.AccessConfig, &out.AccessConfig
+		*out = new(AccessConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(Timeouts)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(ClusterBudget)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PrivateCluster != nil {
 		in, out := &in.PrivateCluster, &out.PrivateCluster
 		*out = new(PrivateCluster)
@@ -208,6 +414,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 			}
 		}
 	}
+	if in.NodeGroupDefaults != nil {
+		in, out := &in.NodeGroupDefaults, &out.NodeGroupDefaults
+		*out = new(NodeGroupDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FargateProfiles != nil {
 		in, out := &in.FargateProfiles, &out.FargateProfiles
 		*out = make([]*FargateProfile, len(*in))
@@ -249,6 +460,32 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(Karpenter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(Observability)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(Bootstrap)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = make([]*HelmChart, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(HelmChart)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(Cosign)
+		**out = **in
+	}
 	return
 }
 
@@ -303,6 +540,22 @@ func (in *ClusterConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEndpointDNS) DeepCopyInto(out *ClusterEndpointDNS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEndpointDNS.
+func (in *ClusterEndpointDNS) DeepCopy() *ClusterEndpointDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEndpointDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterEndpoints) DeepCopyInto(out *ClusterEndpoints) {
 	*out = *in
@@ -316,6 +569,11 @@ func (in *ClusterEndpoints) DeepCopyInto(out *ClusterEndpoints) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(ClusterEndpointDNS)
+		**out = **in
+	}
 	return
 }
 
@@ -373,6 +631,11 @@ func (in *ClusterIAM) DeepCopyInto(out *ClusterIAM) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SkipNodeGroupsAWSAuthConfigMap != nil {
+		in, out := &in.SkipNodeGroupsAWSAuthConfigMap, &out.SkipNodeGroupsAWSAuthConfigMap
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -427,6 +690,23 @@ func (in *ClusterIAMServiceAccount) DeepCopyInto(out *ClusterIAMServiceAccount)
 	}
 	out.WellKnownPolicies = in.WellKnownPolicies
 	in.AttachPolicy.DeepCopyInto(&out.AttachPolicy)
+	if in.AttachPolicyFiles != nil {
+		in, out := &in.AttachPolicyFiles, &out.AttachPolicyFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AttachPolicyURLs != nil {
+		in, out := &in.AttachPolicyURLs, &out.AttachPolicyURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AttachPolicyDocuments != nil {
+		in, out := &in.AttachPolicyDocuments, &out.AttachPolicyDocuments
+		*out = make([]InlineDocument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(ClusterIAMServiceAccountStatus)
@@ -481,6 +761,13 @@ func (in *ClusterIAMServiceAccountStatus) DeepCopy() *ClusterIAMServiceAccountSt
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterMeta) DeepCopyInto(out *ClusterMeta) {
 	*out = *in
+	if in.ServiceEndpoints != nil {
+		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -609,6 +896,11 @@ func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.OpenWebhookPorts != nil {
+		in, out := &in.OpenWebhookPorts, &out.OpenWebhookPorts
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
 	if in.AutoAllocateIPv6 != nil {
 		in, out := &in.AutoAllocateIPv6, &out.AutoAllocateIPv6
 		*out = new(bool)
@@ -629,6 +921,21 @@ func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(ClusterVPCDNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(ClusterVPCFlowLogs)
+		**out = **in
+	}
+	if in.InstanceConnectEndpoint != nil {
+		in, out := &in.InstanceConnectEndpoint, &out.InstanceConnectEndpoint
+		*out = new(InstanceConnectEndpoint)
+		**out = **in
+	}
 	return
 }
 
@@ -642,6 +949,69 @@ func (in *ClusterVPC) DeepCopy() *ClusterVPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVPCDNS) DeepCopyInto(out *ClusterVPCDNS) {
+	*out = *in
+	if in.DomainNameServers != nil {
+		in, out := &in.DomainNameServers, &out.DomainNameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableDNSHostnames != nil {
+		in, out := &in.EnableDNSHostnames, &out.EnableDNSHostnames
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableDNSSupport != nil {
+		in, out := &in.EnableDNSSupport, &out.EnableDNSSupport
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVPCDNS.
+func (in *ClusterVPCDNS) DeepCopy() *ClusterVPCDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVPCDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVPCFlowLogs) DeepCopyInto(out *ClusterVPCFlowLogs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVPCFlowLogs.
+func (in *ClusterVPCFlowLogs) DeepCopy() *ClusterVPCFlowLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVPCFlowLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cosign) DeepCopyInto(out *Cosign) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cosign.
+func (in *Cosign) DeepCopy() *Cosign {
+	if in == nil {
+		return nil
+	}
+	out := new(Cosign)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FargateProfile) DeepCopyInto(out *FargateProfile) {
 	*out = *in
@@ -710,6 +1080,11 @@ func (in *Flux) DeepCopyInto(out *Flux) {
 			(*out)[key] = val
 		}
 	}
+	if in.BinaryVerification != nil {
+		in, out := &in.BinaryVerification, &out.BinaryVerification
+		*out = new(ArtifactVerification)
+		**out = **in
+	}
 	return
 }
 
@@ -766,6 +1141,27 @@ func (in *GitOps) DeepCopy() *GitOps {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChart) DeepCopyInto(out *HelmChart) {
+	*out = *in
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArtifactVerification)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChart.
+func (in *HelmChart) DeepCopy() *HelmChart {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChart)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityProvider) DeepCopyInto(out *IdentityProvider) {
 	*out = *in
@@ -795,6 +1191,22 @@ func (in InlineDocument) DeepCopyInto(out *InlineDocument) {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceConnectEndpoint) DeepCopyInto(out *InstanceConnectEndpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceConnectEndpoint.
+func (in *InstanceConnectEndpoint) DeepCopy() *InstanceConnectEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceConnectEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceSelector) DeepCopyInto(out *InstanceSelector) {
 	*out = *in
@@ -829,6 +1241,11 @@ func (in *Karpenter) DeepCopyInto(out *Karpenter) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ChartVerification != nil {
+		in, out := &in.ChartVerification, &out.ChartVerification
+		*out = new(ArtifactVerification)
+		**out = **in
+	}
 	return
 }
 
@@ -869,12 +1286,33 @@ func (in *LaunchTemplate) DeepCopyInto(out *LaunchTemplate) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplate.
-func (in *LaunchTemplate) DeepCopy() *LaunchTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplate.
+func (in *LaunchTemplate) DeepCopy() *LaunchTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(LaunchTemplate)
+	out := new(MaintenanceWindow)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -974,13 +1412,6 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		*out = new(NodeGroupInstancesDistribution)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ASGMetricsCollection != nil {
-		in, out := &in.ASGMetricsCollection, &out.ASGMetricsCollection
-		*out = make([]MetricsCollection, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.CPUCredits != nil {
 		in, out := &in.CPUCredits, &out.CPUCredits
 		*out = new(string)
@@ -1025,6 +1456,21 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Alarms != nil {
+		in, out := &in.Alarms, &out.Alarms
+		*out = new(NodeGroupAlarms)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CapacityBlock != nil {
+		in, out := &in.CapacityBlock, &out.CapacityBlock
+		*out = new(CapacityBlock)
+		**out = **in
+	}
+	if in.ElasticIPs != nil {
+		in, out := &in.ElasticIPs, &out.ElasticIPs
+		*out = new(NodeGroupElasticIPs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1038,6 +1484,47 @@ func (in *NodeGroup) DeepCopy() *NodeGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupAlarms) DeepCopyInto(out *NodeGroupAlarms) {
+	*out = *in
+	if in.InServiceCapacity != nil {
+		in, out := &in.InServiceCapacity, &out.InServiceCapacity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StatusCheckFailed != nil {
+		in, out := &in.StatusCheckFailed, &out.StatusCheckFailed
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StatusCheckFailedThreshold != nil {
+		in, out := &in.StatusCheckFailedThreshold, &out.StatusCheckFailedThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.SpotInterruptions != nil {
+		in, out := &in.SpotInterruptions, &out.SpotInterruptions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SpotInterruptionsThreshold != nil {
+		in, out := &in.SpotInterruptionsThreshold, &out.SpotInterruptionsThreshold
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupAlarms.
+func (in *NodeGroupAlarms) DeepCopy() *NodeGroupAlarms {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupAlarms)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 	*out = *in
@@ -1051,6 +1538,13 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SubnetSelector != nil {
+		in, out := &in.SubnetSelector, &out.SubnetSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.ScalingConfig != nil {
 		in, out := &in.ScalingConfig, &out.ScalingConfig
 		*out = new(ScalingConfig)
@@ -1095,6 +1589,13 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ASGMetricsCollection != nil {
+		in, out := &in.ASGMetricsCollection, &out.ASGMetricsCollection
+		*out = make([]MetricsCollection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.EBSOptimized != nil {
 		in, out := &in.EBSOptimized, &out.EBSOptimized
 		*out = new(bool)
@@ -1146,6 +1647,11 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PreBootstrapCommandRefs != nil {
+		in, out := &in.PreBootstrapCommandRefs, &out.PreBootstrapCommandRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.OverrideBootstrapCommand != nil {
 		in, out := &in.OverrideBootstrapCommand, &out.OverrideBootstrapCommand
 		*out = new(string)
@@ -1191,6 +1697,28 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		**out = **in
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(NodeGroupProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtraCACerts != nil {
+		in, out := &in.ExtraCACerts, &out.ExtraCACerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScheduledScaling != nil {
+		in, out := &in.ScheduledScaling, &out.ScheduledScaling
+		*out = make([]ScheduledScalingAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1229,6 +1757,73 @@ func (in *NodeGroupBottlerocket) DeepCopy() *NodeGroupBottlerocket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupDefaults) DeepCopyInto(out *NodeGroupDefaults) {
+	*out = *in
+	if in.AutoScaler != nil {
+		in, out := &in.AutoScaler, &out.AutoScaler
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BootstrapCommandTemplates != nil {
+		in, out := &in.BootstrapCommandTemplates, &out.BootstrapCommandTemplates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreBootstrapCommandSnippets != nil {
+		in, out := &in.PreBootstrapCommandSnippets, &out.PreBootstrapCommandSnippets
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupDefaults.
+func (in *NodeGroupDefaults) DeepCopy() *NodeGroupDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupElasticIPs) DeepCopyInto(out *NodeGroupElasticIPs) {
+	*out = *in
+	if in.Allocate != nil {
+		in, out := &in.Allocate, &out.Allocate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllocationIDs != nil {
+		in, out := &in.AllocationIDs, &out.AllocationIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupElasticIPs.
+func (in *NodeGroupElasticIPs) DeepCopy() *NodeGroupElasticIPs {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupElasticIPs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroupIAM) DeepCopyInto(out *NodeGroupIAM) {
 	*out = *in
@@ -1239,6 +1834,11 @@ func (in *NodeGroupIAM) DeepCopyInto(out *NodeGroupIAM) {
 		copy(*out, *in)
 	}
 	in.WithAddonPolicies.DeepCopyInto(&out.WithAddonPolicies)
+	if in.SkipAWSAuthConfigMap != nil {
+		in, out := &in.SkipAWSAuthConfigMap, &out.SkipAWSAuthConfigMap
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1379,6 +1979,27 @@ func (in *NodeGroupInstancesDistribution) DeepCopy() *NodeGroupInstancesDistribu
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupProxy) DeepCopyInto(out *NodeGroupProxy) {
+	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupProxy.
+func (in *NodeGroupProxy) DeepCopy() *NodeGroupProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroupSGs) DeepCopyInto(out *NodeGroupSGs) {
 	*out = *in
@@ -1397,6 +2018,11 @@ func (in *NodeGroupSGs) DeepCopyInto(out *NodeGroupSGs) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AttachSharedClusterSG != nil {
+		in, out := &in.AttachSharedClusterSG, &out.AttachSharedClusterSG
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1433,6 +2059,11 @@ func (in *NodeGroupSSH) DeepCopyInto(out *NodeGroupSSH) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AuthorizedKeys != nil {
+		in, out := &in.AuthorizedKeys, &out.AuthorizedKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.SourceSecurityGroupIDs != nil {
 		in, out := &in.SourceSecurityGroupIDs, &out.SourceSecurityGroupIDs
 		*out = make([]string, len(*in))
@@ -1528,6 +2159,64 @@ func (in *OIDCIdentityProvider) DeepCopy() *OIDCIdentityProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observability) DeepCopyInto(out *Observability) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(ObservabilityPrometheus)
+		**out = **in
+	}
+	if in.Grafana != nil {
+		in, out := &in.Grafana, &out.Grafana
+		*out = new(ObservabilityGrafana)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observability.
+func (in *Observability) DeepCopy() *Observability {
+	if in == nil {
+		return nil
+	}
+	out := new(Observability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityGrafana) DeepCopyInto(out *ObservabilityGrafana) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityGrafana.
+func (in *ObservabilityGrafana) DeepCopy() *ObservabilityGrafana {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityGrafana)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPrometheus) DeepCopyInto(out *ObservabilityPrometheus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPrometheus.
+func (in *ObservabilityPrometheus) DeepCopy() *ObservabilityPrometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPrometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Placement) DeepCopyInto(out *Placement) {
 	*out = *in
@@ -1568,6 +2257,21 @@ func (in *PrivateCluster) DeepCopy() *PrivateCluster {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
+	out.Retry = in.Retry
+	if in.ServiceEndpoints != nil {
+		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SessionTags != nil {
+		in, out := &in.SessionTags, &out.SessionTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1581,6 +2285,22 @@ func (in *ProviderConfig) DeepCopy() *ProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryConfig) DeepCopyInto(out *RetryConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryConfig.
+func (in *RetryConfig) DeepCopy() *RetryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingConfig) DeepCopyInto(out *ScalingConfig) {
 	*out = *in
@@ -1612,6 +2332,37 @@ func (in *ScalingConfig) DeepCopy() *ScalingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledScalingAction) DeepCopyInto(out *ScheduledScalingAction) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.DesiredCapacity != nil {
+		in, out := &in.DesiredCapacity, &out.DesiredCapacity
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledScalingAction.
+func (in *ScheduledScalingAction) DeepCopy() *ScheduledScalingAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledScalingAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretsEncryption) DeepCopyInto(out *SecretsEncryption) {
 	*out = *in
@@ -1628,6 +2379,48 @@ func (in *SecretsEncryption) DeepCopy() *SecretsEncryption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Timeouts) DeepCopyInto(out *Timeouts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Timeouts.
+func (in *Timeouts) DeepCopy() *Timeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(Timeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCNI) DeepCopyInto(out *VPCCNI) {
+	*out = *in
+	if in.WarmPrefixTarget != nil {
+		in, out := &in.WarmPrefixTarget, &out.WarmPrefixTarget
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinimumIPTarget != nil {
+		in, out := &in.MinimumIPTarget, &out.MinimumIPTarget
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCNI.
+func (in *VPCCNI) DeepCopy() *VPCCNI {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCCNI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMapping) DeepCopyInto(out *VolumeMapping) {
 	*out = *in
@@ -0,0 +1,125 @@
+package v1alpha6
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// ConvertToV1alpha5 converts a v1alpha6 ClusterConfig to the v1alpha5 ClusterConfig eksctl acts on
+func ConvertToV1alpha5(in *ClusterConfig) *v1alpha5.ClusterConfig {
+	return &v1alpha5.ClusterConfig{
+		TypeMeta:                v1alpha5.ClusterConfigTypeMeta(),
+		Metadata:                in.Metadata,
+		KubernetesNetworkConfig: in.KubernetesNetworkConfig,
+		IAM:                     in.IAM,
+		IdentityProviders:       in.IdentityProviders,
+		VPC:                     in.VPC,
+		Addons:                  in.Addons,
+		VPCCNI:                  in.VPCCNI,
+		AccessConfig:            in.AccessConfig,
+		Timeouts:                convertTimeoutsToV1alpha5(in.Timeouts),
+		MaintenanceWindow:       in.MaintenanceWindow,
+		Budget:                  in.Budget,
+		PrivateCluster:          in.PrivateCluster,
+		ImageRepositoryOverride: in.ImageRepositoryOverride,
+		NodeGroups:              in.SelfManagedNodeGroups,
+		ManagedNodeGroups:       in.ManagedNodeGroups,
+		NodeGroupDefaults:       in.NodeGroupDefaults,
+		FargateProfiles:         in.FargateProfiles,
+		AvailabilityZones:       in.AvailabilityZones,
+		CloudWatch:              in.CloudWatch,
+		SecretsEncryption:       in.SecretsEncryption,
+		GitOps:                  in.GitOps,
+		Karpenter:               in.Karpenter,
+		Observability:           in.Observability,
+		Bootstrap:               in.Bootstrap,
+		Charts:                  in.Charts,
+		Cosign:                  in.Cosign,
+	}
+}
+
+// ConvertFromV1alpha5 converts a v1alpha5 ClusterConfig to v1alpha6, it fails if a timeout isn't a valid duration
+func ConvertFromV1alpha5(in *v1alpha5.ClusterConfig) (*ClusterConfig, error) {
+	timeouts, err := convertTimeoutsFromV1alpha5(in.Timeouts)
+	if err != nil {
+		return nil, err
+	}
+	return &ClusterConfig{
+		TypeMeta:                ClusterConfigTypeMeta(),
+		Metadata:                in.Metadata,
+		KubernetesNetworkConfig: in.KubernetesNetworkConfig,
+		IAM:                     in.IAM,
+		IdentityProviders:       in.IdentityProviders,
+		VPC:                     in.VPC,
+		Addons:                  in.Addons,
+		VPCCNI:                  in.VPCCNI,
+		AccessConfig:            in.AccessConfig,
+		Timeouts:                timeouts,
+		MaintenanceWindow:       in.MaintenanceWindow,
+		Budget:                  in.Budget,
+		PrivateCluster:          in.PrivateCluster,
+		ImageRepositoryOverride: in.ImageRepositoryOverride,
+		SelfManagedNodeGroups:   in.NodeGroups,
+		ManagedNodeGroups:       in.ManagedNodeGroups,
+		NodeGroupDefaults:       in.NodeGroupDefaults,
+		FargateProfiles:         in.FargateProfiles,
+		AvailabilityZones:       in.AvailabilityZones,
+		CloudWatch:              in.CloudWatch,
+		SecretsEncryption:       in.SecretsEncryption,
+		GitOps:                  in.GitOps,
+		Karpenter:               in.Karpenter,
+		Observability:           in.Observability,
+		Bootstrap:               in.Bootstrap,
+		Charts:                  in.Charts,
+		Cosign:                  in.Cosign,
+	}, nil
+}
+
+func convertTimeoutsToV1alpha5(in *Timeouts) *v1alpha5.Timeouts {
+	if in == nil {
+		return nil
+	}
+	durationString := func(d *metav1.Duration) string {
+		if d == nil {
+			return ""
+		}
+		return d.Duration.String()
+	}
+	return &v1alpha5.Timeouts{
+		StackCreation:     durationString(in.StackCreation),
+		ControlPlaneReady: durationString(in.ControlPlaneReady),
+		NodesReady:        durationString(in.NodesReady),
+		AddonActive:       durationString(in.AddonActive),
+	}
+}
+
+func convertTimeoutsFromV1alpha5(in *v1alpha5.Timeouts) (*Timeouts, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &Timeouts{}
+	for _, timeout := range []struct {
+		name     string
+		duration string
+		out      **metav1.Duration
+	}{
+		{"stackCreation", in.StackCreation, &out.StackCreation},
+		{"controlPlaneReady", in.ControlPlaneReady, &out.ControlPlaneReady},
+		{"nodesReady", in.NodesReady, &out.NodesReady},
+		{"addonActive", in.AddonActive, &out.AddonActive},
+	} {
+		if timeout.duration == "" {
+			continue
+		}
+		d, err := time.ParseDuration(timeout.duration)
+		if err != nil {
+			return nil, fmt.Errorf("timeouts.%s: %q is not a valid duration", timeout.name, timeout.duration)
+		}
+		*timeout.out = &metav1.Duration{Duration: d}
+	}
+	return out, nil
+}
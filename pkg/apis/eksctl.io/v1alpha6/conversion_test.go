@@ -0,0 +1,50 @@
+package v1alpha6_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
+)
+
+var _ = Describe("conversion", func() {
+	It("converts v1alpha5 configs to v1alpha6 and back", func() {
+		cfg := v1alpha5.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.NodeGroups = []*v1alpha5.NodeGroup{{NodeGroupBase: &v1alpha5.NodeGroupBase{Name: "ng-1"}}}
+		cfg.ManagedNodeGroups = []*v1alpha5.ManagedNodeGroup{{NodeGroupBase: &v1alpha5.NodeGroupBase{Name: "mng-1"}}}
+		cfg.Timeouts = &v1alpha5.Timeouts{
+			StackCreation: "40m",
+			NodesReady:    "1h30m",
+		}
+
+		converted, err := v1alpha6.ConvertFromV1alpha5(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(converted.TypeMeta).To(Equal(v1alpha6.ClusterConfigTypeMeta()))
+		Expect(converted.Metadata.Name).To(Equal("cluster-1"))
+		Expect(converted.SelfManagedNodeGroups).To(Equal(cfg.NodeGroups))
+		Expect(converted.ManagedNodeGroups).To(Equal(cfg.ManagedNodeGroups))
+		Expect(converted.Timeouts.StackCreation).To(Equal(&metav1.Duration{Duration: 40 * time.Minute}))
+		Expect(converted.Timeouts.NodesReady).To(Equal(&metav1.Duration{Duration: 90 * time.Minute}))
+		Expect(converted.Timeouts.ControlPlaneReady).To(BeNil())
+
+		back := v1alpha6.ConvertToV1alpha5(converted)
+		Expect(back.TypeMeta).To(Equal(v1alpha5.ClusterConfigTypeMeta()))
+		Expect(back.NodeGroups).To(Equal(cfg.NodeGroups))
+		Expect(back.Timeouts).To(Equal(&v1alpha5.Timeouts{
+			StackCreation: "40m0s",
+			NodesReady:    "1h30m0s",
+		}))
+	})
+
+	It("rejects invalid v1alpha5 timeouts", func() {
+		cfg := v1alpha5.NewClusterConfig()
+		cfg.Timeouts = &v1alpha5.Timeouts{AddonActive: "soon"}
+		_, err := v1alpha6.ConvertFromV1alpha5(cfg)
+		Expect(err).To(MatchError(`timeouts.addonActive: "soon" is not a valid duration`))
+	})
+})
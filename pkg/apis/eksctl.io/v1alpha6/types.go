@@ -0,0 +1,120 @@
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterConfig is a simple config, to be replaced with Cluster API
+type ClusterConfig struct {
+	metav1.TypeMeta
+
+	// +required
+	Metadata *v1alpha5.ClusterMeta `json:"metadata"`
+
+	// +optional
+	KubernetesNetworkConfig *v1alpha5.KubernetesNetworkConfig `json:"kubernetesNetworkConfig,omitempty"`
+
+	// +optional
+	IAM *v1alpha5.ClusterIAM `json:"iam,omitempty"`
+
+	// +optional
+	IdentityProviders []v1alpha5.IdentityProvider `json:"identityProviders,omitempty"`
+
+	// +optional
+	VPC *v1alpha5.ClusterVPC `json:"vpc,omitempty"`
+
+	// +optional
+	Addons []*v1alpha5.Addon `json:"addons,omitempty"`
+
+	// +optional
+	VPCCNI *v1alpha5.VPCCNI `json:"vpcCNI,omitempty"`
+
+	// +optional
+	AccessConfig *v1alpha5.AccessConfig `json:"accessConfig,omitempty"`
+
+	// Timeouts overrides the `--timeout` flag for individual phases of cluster and nodegroup creation
+	// +optional
+	Timeouts *Timeouts `json:"timeouts,omitempty"`
+
+	// MaintenanceWindow is when `eksctl upgrade cluster` applies upgrades, it waits for the window to open
+	// +optional
+	MaintenanceWindow *v1alpha5.MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// +optional
+	Budget *v1alpha5.ClusterBudget `json:"budget,omitempty"`
+
+	// +optional
+	PrivateCluster *v1alpha5.PrivateCluster `json:"privateCluster,omitempty"`
+
+	// +optional
+	ImageRepositoryOverride string `json:"imageRepositoryOverride,omitempty"`
+
+	// SelfManagedNodeGroups are the nodegroups eksctl manages, called `nodeGroups` in v1alpha5
+	// +optional
+	SelfManagedNodeGroups []*v1alpha5.NodeGroup `json:"selfManagedNodeGroups,omitempty"`
+
+	// +optional
+	ManagedNodeGroups []*v1alpha5.ManagedNodeGroup `json:"managedNodeGroups,omitempty"`
+
+	// +optional
+	NodeGroupDefaults *v1alpha5.NodeGroupDefaults `json:"nodeGroupDefaults,omitempty"`
+
+	// +optional
+	FargateProfiles []*v1alpha5.FargateProfile `json:"fargateProfiles,omitempty"`
+
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// +optional
+	CloudWatch *v1alpha5.ClusterCloudWatch `json:"cloudWatch,omitempty"`
+
+	// +optional
+	SecretsEncryption *v1alpha5.SecretsEncryption `json:"secretsEncryption,omitempty"`
+
+	// +optional
+	GitOps *v1alpha5.GitOps `json:"gitops,omitempty"`
+
+	// +optional
+	Karpenter *v1alpha5.Karpenter `json:"karpenter,omitempty"`
+
+	// +optional
+	Observability *v1alpha5.Observability `json:"observability,omitempty"`
+
+	// +optional
+	Bootstrap *v1alpha5.Bootstrap `json:"bootstrap,omitempty"`
+
+	// +optional
+	Charts []*v1alpha5.HelmChart `json:"charts,omitempty"`
+
+	// +optional
+	Cosign *v1alpha5.Cosign `json:"cosign,omitempty"`
+}
+
+// ClusterConfigTypeMeta constructs TypeMeta for ClusterConfig
+func ClusterConfigTypeMeta() metav1.TypeMeta {
+	return metav1.TypeMeta{
+		Kind:       ClusterConfigKind,
+		APIVersion: SchemeGroupVersion.String(),
+	}
+}
+
+// Timeouts overrides the timeout set with `--timeout` for individual phases of cluster and nodegroup creation,
+// durations are specified as e.g. `40m` or `1h30m`
+type Timeouts struct {
+	// StackCreation is the maximum time to wait for each CloudFormation stack to be created
+	// +optional
+	StackCreation *metav1.Duration `json:"stackCreation,omitempty"`
+	// ControlPlaneReady is the maximum time to wait for the Kubernetes API of a new cluster to become reachable
+	// +optional
+	ControlPlaneReady *metav1.Duration `json:"controlPlaneReady,omitempty"`
+	// NodesReady is the maximum time to wait for the nodes of each nodegroup to join the cluster and become ready
+	// +optional
+	NodesReady *metav1.Duration `json:"nodesReady,omitempty"`
+	// AddonActive is the maximum time to wait for each addon to become active
+	// +optional
+	AddonActive *metav1.Duration `json:"addonActive,omitempty"`
+}
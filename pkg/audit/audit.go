@@ -0,0 +1,247 @@
+// Package audit records the AWS API calls eksctl makes that change resources, so that they can
+// be attached as evidence to change-management tickets.
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/uuid"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Result values of a Record
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Record is a single AWS API call
+type Record struct {
+	Time         time.Time `json:"time"`
+	InvocationID string    `json:"invocationId"`
+	Command      string    `json:"command"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region"`
+	// ParametersHash is the SHA-256 of the JSON encoded parameters of the call,
+	// which allows matching calls without storing their parameters
+	ParametersHash string `json:"parametersHash"`
+	Result         string `json:"result"`
+	ErrorCode      string `json:"errorCode,omitempty"`
+	ErrorMessage   string `json:"errorMessage,omitempty"`
+	RequestID      string `json:"requestId,omitempty"`
+}
+
+// readOnlyPrefixes are the prefixes of the AWS operations that don't change resources
+var readOnlyPrefixes = []string{"Describe", "Get", "List", "Lookup", "Search", "Estimate", "Validate", "Preview", "AssumeRole", "DecodeAuthorizationMessage"}
+
+// IsMutating returns whether the AWS operation may change resources
+func IsMutating(operation string) bool {
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// Logger writes a Record for every mutating AWS API call to a JSON Lines file
+// and, optionally, to a CloudWatch Logs log group
+type Logger struct {
+	file         *os.File
+	invocationID string
+	command      string
+
+	cloudWatchLogs   awsapi.CloudWatchLogs
+	logGroup         string
+	logStream        string
+	logStreamCreated bool
+	sequenceToken    *string
+
+	mu sync.Mutex
+}
+
+var (
+	loggersMu sync.Mutex
+	loggers   = map[string]*Logger{}
+)
+
+// Open returns the Logger writing to path, opening the file if necessary; the same Logger
+// is shared by all the AWS clients of an invocation
+func Open(path string) (*Logger, error) {
+	loggersMu.Lock()
+	defer loggersMu.Unlock()
+
+	if l, ok := loggers[path]; ok {
+		return l, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit file: %w", err)
+	}
+	invocationID := uuid.NewString()
+	l := &Logger{
+		file:         file,
+		invocationID: invocationID,
+		command:      strings.Join(os.Args, " "),
+		logStream:    fmt.Sprintf("eksctl-%s-%s", time.Now().UTC().Format("2006-01-02T15-04-05Z"), invocationID),
+	}
+	loggers[path] = l
+	return l, nil
+}
+
+// Close closes the file of the Logger, a later Open of the same path opens it again
+func (l *Logger) Close() error {
+	loggersMu.Lock()
+	defer loggersMu.Unlock()
+
+	for path, logger := range loggers {
+		if logger == l {
+			delete(loggers, path)
+		}
+	}
+	return l.file.Close()
+}
+
+// SendToCloudWatchLogs makes the Logger also send the records to a log stream of logGroup,
+// the client must not be configured with the Logger's middleware
+func (l *Logger) SendToCloudWatchLogs(client awsapi.CloudWatchLogs, logGroup string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cloudWatchLogs == nil {
+		l.cloudWatchLogs = client
+		l.logGroup = logGroup
+	}
+}
+
+// AddV1Handlers records the mutating calls made with the AWS SDK v1 handlers
+func (l *Logger) AddV1Handlers(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "eksctlAudit",
+		Fn: func(r *request.Request) {
+			if !IsMutating(r.Operation.Name) {
+				return
+			}
+			var errorCode string
+			if awsErr, ok := r.Error.(awserr.Error); ok {
+				errorCode = awsErr.Code()
+			}
+			l.record(r.ClientInfo.ServiceID, r.Operation.Name, aws.ToString(r.Config.Region), r.Params, r.RequestID, errorCode, r.Error)
+		},
+	})
+}
+
+// AddMiddleware records the mutating calls made with AWS SDK v2 clients, it's meant to be added to aws.Config.APIOptions
+func (l *Logger) AddMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("eksctlAudit", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		operation := awsmiddleware.GetOperationName(ctx)
+		if IsMutating(operation) {
+			requestID, _ := awsmiddleware.GetRequestIDMetadata(metadata)
+			var errorCode string
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) {
+				errorCode = apiErr.ErrorCode()
+			}
+			l.record(awsmiddleware.GetServiceID(ctx), operation, awsmiddleware.GetRegion(ctx), in.Parameters, requestID, errorCode, err)
+		}
+		return out, metadata, err
+	}), middleware.After)
+}
+
+func (l *Logger) record(service, operation, region string, params interface{}, requestID, errorCode string, err error) {
+	record := Record{
+		Time:           time.Now().UTC(),
+		InvocationID:   l.invocationID,
+		Command:        l.command,
+		Service:        service,
+		Operation:      operation,
+		Region:         region,
+		ParametersHash: hashParameters(params),
+		Result:         ResultSuccess,
+		RequestID:      requestID,
+	}
+	if err != nil {
+		record.Result = ResultFailure
+		record.ErrorCode = errorCode
+		record.ErrorMessage = err.Error()
+	}
+
+	data, jsonErr := json.Marshal(record)
+	if jsonErr != nil {
+		logger.Warning("failed to encode audit record: %v", jsonErr)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.file.Write(append(data, '\n')); err != nil {
+		logger.Warning("failed to write audit record: %v", err)
+	}
+	if l.cloudWatchLogs != nil {
+		l.sendToCloudWatchLogs(record.Time, string(data))
+	}
+}
+
+func (l *Logger) sendToCloudWatchLogs(t time.Time, message string) {
+	// the records of calls made with a cancelled context must still be sent
+	ctx := context.TODO()
+	if !l.logStreamCreated {
+		_, err := l.cloudWatchLogs.CreateLogStream(ctx, &cloudwatchlogs.CreateLogStreamInput{
+			LogGroupName:  aws.String(l.logGroup),
+			LogStreamName: aws.String(l.logStream),
+		})
+		var alreadyExists *cwltypes.ResourceAlreadyExistsException
+		if err != nil && !errors.As(err, &alreadyExists) {
+			logger.Warning("failed to create audit log stream %q in log group %q, audit records will only be written to the audit file: %v", l.logStream, l.logGroup, err)
+			l.cloudWatchLogs = nil
+			return
+		}
+		l.logStreamCreated = true
+	}
+
+	output, err := l.cloudWatchLogs.PutLogEvents(ctx, &cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(l.logGroup),
+		LogStreamName: aws.String(l.logStream),
+		SequenceToken: l.sequenceToken,
+		LogEvents: []cwltypes.InputLogEvent{{
+			Message:   aws.String(message),
+			Timestamp: aws.Int64(t.UnixMilli()),
+		}},
+	})
+	if err != nil {
+		logger.Warning("failed to send audit record to log group %q: %v", l.logGroup, err)
+		return
+	}
+	l.sequenceToken = output.NextSequenceToken
+}
+
+func hashParameters(params interface{}) string {
+	data, err := json.Marshal(params)
+	if err != nil {
+		// some parameters, e.g. streams, can't be encoded
+		data = []byte(fmt.Sprintf("%#v", params))
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,170 @@
+package audit_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/smithy-go/middleware"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/audit"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+var _ = Describe("Audit", func() {
+	var (
+		auditFile   string
+		auditLogger *audit.Logger
+		httpClient  *http.Client
+		statusCode  int
+	)
+
+	BeforeEach(func() {
+		dir, err := os.MkdirTemp("", "audit")
+		Expect(err).NotTo(HaveOccurred())
+		auditFile = filepath.Join(dir, "audit.jsonl")
+		auditLogger, err = audit.Open(auditFile)
+		Expect(err).NotTo(HaveOccurred())
+
+		statusCode = http.StatusOK
+		httpClient = &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			body := `{}`
+			if statusCode != http.StatusOK {
+				body = `{"message": "cluster not found"}`
+			}
+			return &http.Response{
+				StatusCode: statusCode,
+				Header: http.Header{
+					"X-Amzn-Requestid": []string{"request-1"},
+					"X-Amzn-Errortype": []string{"ResourceNotFoundException"},
+				},
+				Body:    io.NopCloser(strings.NewReader(body)),
+				Request: r,
+			}, nil
+		})}
+	})
+
+	AfterEach(func() {
+		Expect(auditLogger.Close()).To(Succeed())
+		Expect(os.RemoveAll(filepath.Dir(auditFile))).To(Succeed())
+	})
+
+	readRecords := func() []audit.Record {
+		file, err := os.Open(auditFile)
+		Expect(err).NotTo(HaveOccurred())
+		defer file.Close()
+		var records []audit.Record
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var record audit.Record
+			Expect(json.Unmarshal(scanner.Bytes(), &record)).To(Succeed())
+			records = append(records, record)
+		}
+		return records
+	}
+
+	newV2Client := func() *eks.Client {
+		return eks.NewFromConfig(aws.Config{
+			Region:           "us-west-2",
+			Credentials:      aws.AnonymousCredentials{},
+			HTTPClient:       httpClient,
+			RetryMaxAttempts: 1,
+			APIOptions:       []func(*middleware.Stack) error{auditLogger.AddMiddleware},
+		})
+	}
+
+	It("records mutating calls made with SDK v2 clients", func() {
+		client := newV2Client()
+		_, err := client.DescribeCluster(context.Background(), &eks.DescribeClusterInput{Name: aws.String("dev")})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.DeleteCluster(context.Background(), &eks.DeleteClusterInput{Name: aws.String("dev")})
+		Expect(err).NotTo(HaveOccurred())
+
+		records := readRecords()
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Service).To(Equal("EKS"))
+		Expect(records[0].Operation).To(Equal("DeleteCluster"))
+		Expect(records[0].Region).To(Equal("us-west-2"))
+		Expect(records[0].Result).To(Equal(audit.ResultSuccess))
+		Expect(records[0].RequestID).To(Equal("request-1"))
+		Expect(records[0].ParametersHash).To(HavePrefix("sha256:"))
+		Expect(records[0].InvocationID).NotTo(BeEmpty())
+	})
+
+	It("records failed calls", func() {
+		statusCode = http.StatusNotFound
+		_, err := newV2Client().DeleteCluster(context.Background(), &eks.DeleteClusterInput{Name: aws.String("dev")})
+		Expect(err).To(HaveOccurred())
+
+		records := readRecords()
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Result).To(Equal(audit.ResultFailure))
+		Expect(records[0].ErrorCode).To(Equal("ResourceNotFoundException"))
+		Expect(records[0].ErrorMessage).To(ContainSubstring("cluster not found"))
+	})
+
+	It("records mutating calls made with SDK v1 clients", func() {
+		sess := session.Must(session.NewSession(&awsv1.Config{
+			Region:      awsv1.String("us-west-2"),
+			Credentials: credentialsv1.AnonymousCredentials,
+		}))
+		auditLogger.AddV1Handlers(&sess.Handlers)
+		client := eksv1.New(sess, &awsv1.Config{HTTPClient: httpClient})
+
+		_, err := client.ListClusters(&eksv1.ListClustersInput{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.DeleteCluster(&eksv1.DeleteClusterInput{Name: awsv1.String("dev")})
+		Expect(err).NotTo(HaveOccurred())
+
+		records := readRecords()
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Service).To(Equal("EKS"))
+		Expect(records[0].Operation).To(Equal("DeleteCluster"))
+		Expect(records[0].RequestID).To(Equal("request-1"))
+	})
+
+	It("hashes the parameters", func() {
+		client := newV2Client()
+		for _, name := range []string{"dev", "dev", "prod"} {
+			_, err := client.DeleteCluster(context.Background(), &eks.DeleteClusterInput{Name: aws.String(name)})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		records := readRecords()
+		Expect(records[0].ParametersHash).To(Equal(records[1].ParametersHash))
+		Expect(records[0].ParametersHash).NotTo(Equal(records[2].ParametersHash))
+	})
+
+	It("shares the logger of a file", func() {
+		other, err := audit.Open(auditFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other).To(BeIdenticalTo(auditLogger))
+	})
+
+	table.DescribeTable("IsMutating", func(operation string, expected bool) {
+		Expect(audit.IsMutating(operation)).To(Equal(expected))
+	},
+		table.Entry("create", "CreateStack", true),
+		table.Entry("tag", "TagResource", true),
+		table.Entry("describe", "DescribeStacks", false),
+		table.Entry("list", "ListNodegroups", false),
+		table.Entry("get", "GetCallerIdentity", false),
+	)
+})
@@ -9,8 +9,8 @@ package authconfigmap
 import (
 	"context"
 	"fmt"
+	"strings"
 
-	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -83,7 +83,7 @@ func NewFromClientSet(clientSet kubernetes.Interface) (*AuthConfigMap, error) {
 	if err != nil && !apierrors.IsNotFound(err) {
 		return nil, errors.Wrapf(err, "getting auth ConfigMap")
 	}
-	logger.Debug("aws-auth = %s", awsutil.Prettify(cm))
+	logger.Debug("aws-auth = %v", cm)
 	return New(client, cm), nil
 }
 
@@ -281,6 +281,14 @@ func ObjectMeta() metav1.ObjectMeta {
 	}
 }
 
+// NodeGroupGroups returns the groups the instance role of ng is mapped to, for its nodes to join the cluster
+func NodeGroupGroups(ng *api.NodeGroup) []string {
+	if api.IsWindowsImage(ng.AMIFamily) {
+		return append([]string{roleNodeGroupWindows}, RoleNodeGroupGroups...)
+	}
+	return RoleNodeGroupGroups
+}
+
 // AddNodeGroup creates or adds a nodegroup IAM role in the auth
 // ConfigMap for the given nodegroup.
 func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
@@ -289,12 +297,7 @@ func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
 		return err
 	}
 
-	nodeGroupRoles := RoleNodeGroupGroups
-	if api.IsWindowsImage(ng.AMIFamily) {
-		nodeGroupRoles = append([]string{roleNodeGroupWindows}, nodeGroupRoles...)
-	}
-
-	identity, err := iam.NewIdentity(ng.IAM.InstanceRoleARN, RoleNodeGroupUsername, nodeGroupRoles)
+	identity, err := nodeGroupIdentity(ng)
 	if err != nil {
 		return err
 	}
@@ -309,6 +312,37 @@ func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
 	return nil
 }
 
+// LogNodeGroupsMapRoles logs the mapRoles entries that let the nodes of nodeGroups join the cluster,
+// as AddNodeGroup would add them, for when the auth ConfigMap is managed outside of eksctl.
+func LogNodeGroupsMapRoles(nodeGroups []*api.NodeGroup) error {
+	if len(nodeGroups) == 0 {
+		return nil
+	}
+	var (
+		names []string
+		roles []iam.Identity
+	)
+	for _, ng := range nodeGroups {
+		identity, err := nodeGroupIdentity(ng)
+		if err != nil {
+			return errors.Wrapf(err, "building mapRoles entry of nodegroup %q", ng.Name)
+		}
+		names = append(names, ng.Name)
+		roles = append(roles, identity)
+	}
+	mapRoles, err := yaml.Marshal(roles)
+	if err != nil {
+		return errors.Wrapf(err, "marshalling %q", rolesData)
+	}
+	logger.Info("auth ConfigMap not updated for nodegroup(s) %s, add the following to %q of ConfigMap %s/%s for their nodes to join the cluster:\n%s",
+		strings.Join(names, ", "), rolesData, ObjectNamespace, ObjectName, mapRoles)
+	return nil
+}
+
+func nodeGroupIdentity(ng *api.NodeGroup) (iam.Identity, error) {
+	return iam.NewIdentity(ng.IAM.InstanceRoleARN, RoleNodeGroupUsername, NodeGroupGroups(ng))
+}
+
 // RemoveNodeGroup removes a nodegroup from the ConfigMap and
 // does a client update.
 func RemoveNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
@@ -1,13 +1,12 @@
 package authconfigmap
 
 import (
-	"fmt"
-
 	// go go:embed to work
 	_ "embed"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/assetutil"
 	"github.com/weaveworks/eksctl/pkg/iam"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
@@ -79,7 +78,7 @@ func (s *ServiceAccess) Grant(serviceName, namespace string, partition string) e
 	}
 
 	role := &iam.RoleIdentity{
-		RoleARN: fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, s.accountID, serviceDetails.IAMRoleName),
+		RoleARN: api.IAMRoleARN(partition, s.accountID, serviceDetails.IAMRoleName),
 		KubernetesIdentity: iam.KubernetesIdentity{
 			KubernetesUsername: string(serviceDetails.User),
 		},
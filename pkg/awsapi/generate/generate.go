@@ -5,7 +5,17 @@ package generate
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh cloudwatchlogs CloudWatchLogs
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh cloudformation CloudFormation
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh cloudtrail CloudTrail
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh eks EKS
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh elasticloadbalancing ELB
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh elasticloadbalancingv2 ELBV2
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh ssm SSM
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh iam IAM
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh servicequotas ServiceQuotas
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh imagebuilder ImageBuilder
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh amp AMP
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh grafana Grafana
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh guardduty GuardDuty
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh kms KMS
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh pricing Pricing
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh cloudwatch CloudWatch
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh secretsmanager SecretsManager
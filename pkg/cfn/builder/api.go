@@ -16,6 +16,10 @@ const (
 	clusterTemplateDescription   = "EKS cluster"
 	nodeGroupTemplateDescription = "EKS nodes"
 	templateDescriptionSuffix    = "[created and managed by eksctl]"
+
+	// pythonRuntime is the runtime of the Python functions and scripts eksctl creates, it must be supported by
+	// Lambda and by the aws:executeScript action of SSM Automation
+	pythonRuntime = "python3.12"
 )
 
 type awsCloudFormationResource struct {
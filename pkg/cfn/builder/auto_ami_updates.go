@@ -0,0 +1,253 @@
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	gfn "github.com/weaveworks/goformation/v4/cloudformation"
+	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
+	gfnlambda "github.com/weaveworks/goformation/v4/cloudformation/lambda"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	autoAMIUpdatesFunctionName      = "AutoAMIUpdatesFunction"
+	autoAMIUpdatesFunctionRoleName  = "AutoAMIUpdatesFunctionRole"
+	autoAMIUpdatesSchedulerRoleName = "AutoAMIUpdatesSchedulerRole"
+	autoAMIUpdatesScheduleName      = "AutoAMIUpdatesSchedule"
+
+	// DefaultAutoAMIUpdatesMaintenanceWindow is how long after the scheduled time AMI updates can be started
+	DefaultAutoAMIUpdatesMaintenanceWindow = 4 * time.Hour
+	// maxAutoAMIUpdatesMaintenanceWindow is the longest an EventBridge Scheduler event can be retried for
+	maxAutoAMIUpdatesMaintenanceWindow = 24 * time.Hour
+)
+
+// autoAMIUpdatesFunctionCode starts a version update of the managed nodegroups given in the event of a schedule whose
+// AMI release is older than the latest one published for their AMI type; nodegroups with a custom AMI or an update in
+// progress are skipped, as are invocations delivered after the end of the maintenance window
+const autoAMIUpdatesFunctionCode = `import datetime
+
+import boto3
+
+RELEASE_VERSION_PARAMETERS = {
+    "AL2_x86_64": "/aws/service/eks/optimized-ami/{version}/amazon-linux-2/recommended/release_version",
+    "AL2_x86_64_GPU": "/aws/service/eks/optimized-ami/{version}/amazon-linux-2-gpu/recommended/release_version",
+    "AL2_ARM_64": "/aws/service/eks/optimized-ami/{version}/amazon-linux-2-arm64/recommended/release_version",
+    "AL2023_x86_64_STANDARD": "/aws/service/eks/optimized-ami/{version}/amazon-linux-2023/x86_64/standard/recommended/release_version",
+    "AL2023_ARM_64_STANDARD": "/aws/service/eks/optimized-ami/{version}/amazon-linux-2023/arm64/standard/recommended/release_version",
+    "BOTTLEROCKET_x86_64": "/aws/service/bottlerocket/aws-k8s-{version}/x86_64/latest/image_version",
+    "BOTTLEROCKET_ARM_64": "/aws/service/bottlerocket/aws-k8s-{version}/arm64/latest/image_version",
+}
+
+eks = boto3.client("eks")
+ssm = boto3.client("ssm")
+
+
+def handler(event, context):
+    scheduled_time = datetime.datetime.fromisoformat(event["scheduledTime"].replace("Z", "+00:00"))
+    window_end = scheduled_time + datetime.timedelta(seconds=event["maintenanceWindowSeconds"])
+    if datetime.datetime.now(datetime.timezone.utc) > window_end:
+        print(f"maintenance window ended at {window_end.isoformat()}, not starting any update")
+        return
+
+    cluster = event["clusterName"]
+    names = event["nodegroupNames"]
+    if not names:
+        names = []
+        for page in eks.get_paginator("list_nodegroups").paginate(clusterName=cluster):
+            names.extend(page["nodegroups"])
+
+    for name in names:
+        nodegroup = eks.describe_nodegroup(clusterName=cluster, nodegroupName=name)["nodegroup"]
+        parameter = RELEASE_VERSION_PARAMETERS.get(nodegroup.get("amiType"))
+        if parameter is None:
+            print(f"skipping nodegroup {name}, AMI type {nodegroup.get('amiType')} cannot be updated automatically")
+            continue
+        if nodegroup["status"] != "ACTIVE":
+            print(f"skipping nodegroup {name} in status {nodegroup['status']}")
+            continue
+        latest = ssm.get_parameter(Name=parameter.format(version=nodegroup["version"]))["Parameter"]["Value"]
+        if nodegroup.get("releaseVersion") == latest:
+            continue
+        print(f"updating nodegroup {name} from release {nodegroup.get('releaseVersion')} to {latest}")
+        eks.update_nodegroup_version(clusterName=cluster, nodegroupName=name, releaseVersion=latest)
+`
+
+// AutoAMIUpdatesOptions configures when the AMIs of managed nodegroups are updated
+type AutoAMIUpdatesOptions struct {
+	// Schedule is an EventBridge Scheduler expression, e.g. cron(0 3 ? * SUN *), that starts the maintenance window
+	Schedule string
+	// TimeZone is the time zone of Schedule, UTC by default
+	TimeZone string
+	// MaintenanceWindow is how long after the scheduled time updates can still be started
+	MaintenanceWindow time.Duration
+	// NodeGroups are the managed nodegroups to update, all managed nodegroups of the cluster if empty
+	NodeGroups []string
+}
+
+// Validate validates the options
+func (o AutoAMIUpdatesOptions) Validate() error {
+	if o.Schedule == "" {
+		return fmt.Errorf("a schedule must be set")
+	}
+	if !(strings.HasPrefix(o.Schedule, "cron(") || strings.HasPrefix(o.Schedule, "rate(")) || !strings.HasSuffix(o.Schedule, ")") {
+		return fmt.Errorf("invalid schedule %q, expected cron(...) or rate(...)", o.Schedule)
+	}
+	if o.MaintenanceWindow < time.Minute || o.MaintenanceWindow > maxAutoAMIUpdatesMaintenanceWindow {
+		return fmt.Errorf("the maintenance window must be between 1m and %s, got %s", maxAutoAMIUpdatesMaintenanceWindow, o.MaintenanceWindow)
+	}
+	return nil
+}
+
+// AutoAMIUpdatesResourceSet stores the resources that update the AMIs of managed nodegroups on a schedule
+type AutoAMIUpdatesResourceSet struct {
+	rs            *resourceSet
+	clusterConfig *api.ClusterConfig
+	options       AutoAMIUpdatesOptions
+}
+
+// NewAutoAMIUpdatesResourceSet returns a resource set for the automatic AMI updates of the managed nodegroups of a cluster
+func NewAutoAMIUpdatesResourceSet(clusterConfig *api.ClusterConfig, options AutoAMIUpdatesOptions) *AutoAMIUpdatesResourceSet {
+	return &AutoAMIUpdatesResourceSet{
+		rs:            newResourceSet(),
+		clusterConfig: clusterConfig,
+		options:       options,
+	}
+}
+
+// AddAllResources adds all the resources for the automatic AMI updates to the resource set
+func (a *AutoAMIUpdatesResourceSet) AddAllResources() error {
+	if err := a.options.Validate(); err != nil {
+		return err
+	}
+	a.rs.template.Description = fmt.Sprintf("Automatic nodegroup AMI updates %s", templateDescriptionSuffix)
+
+	clusterName := a.clusterConfig.Metadata.Name
+	partition := api.Partition(a.clusterConfig.Metadata.Region)
+	a.rs.withIAM = true
+	a.newResource(autoAMIUpdatesFunctionRoleName, &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+			gfnt.NewString(api.ServicePrincipal(partition, api.ServicePrincipalLambda)),
+		),
+		ManagedPolicyArns: gfnt.NewSlice(makePolicyARNs("service-role/AWSLambdaBasicExecutionRole")...),
+		Policies: []gfniam.Role_Policy{{
+			PolicyName: makeName("UpdateNodegroupVersion"),
+			PolicyDocument: cft.MakePolicyDocument(
+				cft.MapOfInterfaces{
+					"Effect":   "Allow",
+					"Action":   []string{"eks:ListNodegroups"},
+					"Resource": gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:eks:${AWS::Region}:${AWS::AccountId}:cluster/%s", clusterName)),
+				},
+				cft.MapOfInterfaces{
+					"Effect":   "Allow",
+					"Action":   []string{"eks:DescribeNodegroup", "eks:UpdateNodegroupVersion"},
+					"Resource": gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:eks:${AWS::Region}:${AWS::AccountId}:nodegroup/%s/*", clusterName)),
+				},
+				cft.MapOfInterfaces{
+					"Effect":   "Allow",
+					"Action":   []string{"ssm:GetParameter"},
+					"Resource": gfnt.MakeFnSubString("arn:${AWS::Partition}:ssm:${AWS::Region}::parameter/aws/service/*"),
+				},
+			),
+		}},
+	})
+	a.newResource(autoAMIUpdatesFunctionName, &gfnlambda.Function{
+		Description: gfnt.NewString(fmt.Sprintf("Automatic AMI updates of the managed nodegroups of cluster %q", clusterName)),
+		Handler:     gfnt.NewString("index.handler"),
+		Runtime:     gfnt.NewString(pythonRuntime),
+		Timeout:     gfnt.NewInteger(300),
+		Role:        gfnt.MakeFnGetAttString(autoAMIUpdatesFunctionRoleName, "Arn"),
+		Code: &gfnlambda.Function_Code{
+			ZipFile: gfnt.NewString(autoAMIUpdatesFunctionCode),
+		},
+	})
+	a.newResource(autoAMIUpdatesSchedulerRoleName, &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+			gfnt.NewString(api.ServicePrincipal(partition, api.ServicePrincipalScheduler)),
+		),
+		Policies: []gfniam.Role_Policy{{
+			PolicyName: makeName("InvokeAutoAMIUpdatesFunction"),
+			PolicyDocument: cft.MakePolicyDocument(cft.MapOfInterfaces{
+				"Effect":   "Allow",
+				"Action":   []string{"lambda:InvokeFunction"},
+				"Resource": gfnt.MakeFnGetAttString(autoAMIUpdatesFunctionName, "Arn"),
+			}),
+		}},
+	})
+
+	nodeGroups := a.options.NodeGroups
+	if nodeGroups == nil {
+		nodeGroups = []string{}
+	}
+	maintenanceWindowSeconds := int(a.options.MaintenanceWindow.Seconds())
+	input, err := json.Marshal(map[string]interface{}{
+		"clusterName":    clusterName,
+		"nodegroupNames": nodeGroups,
+		// the scheduler replaces this context attribute with the time the invocation was scheduled for
+		"scheduledTime":            "<aws.scheduler.scheduled-time>",
+		"maintenanceWindowSeconds": maintenanceWindowSeconds,
+	})
+	if err != nil {
+		return err
+	}
+
+	properties := map[string]interface{}{
+		"Description":        fmt.Sprintf("Automatic AMI updates of the managed nodegroups of cluster %q at %q", clusterName, a.options.Schedule),
+		"ScheduleExpression": a.options.Schedule,
+		"FlexibleTimeWindow": map[string]string{
+			"Mode": "OFF",
+		},
+		"Target": map[string]interface{}{
+			"Arn":     gfnt.MakeFnGetAttString(autoAMIUpdatesFunctionName, "Arn"),
+			"RoleArn": gfnt.MakeFnGetAttString(autoAMIUpdatesSchedulerRoleName, "Arn"),
+			"Input":   string(input),
+			// invocations that can't be delivered before the end of the maintenance window are dropped
+			"RetryPolicy": map[string]int{
+				"MaximumEventAgeInSeconds": maintenanceWindowSeconds,
+			},
+		},
+	}
+	if a.options.TimeZone != "" {
+		properties["ScheduleExpressionTimezone"] = a.options.TimeZone
+	}
+	a.newResource(autoAMIUpdatesScheduleName, &awsCloudFormationResource{
+		Type:       "AWS::Scheduler::Schedule",
+		Properties: properties,
+	})
+	return nil
+}
+
+// RenderJSON returns the rendered JSON
+func (a *AutoAMIUpdatesResourceSet) RenderJSON() ([]byte, error) {
+	return a.rs.renderJSON()
+}
+
+// Template returns the CloudFormation template
+func (a *AutoAMIUpdatesResourceSet) Template() gfn.Template {
+	return *a.rs.template
+}
+
+func (a *AutoAMIUpdatesResourceSet) newResource(name string, resource gfn.Resource) *gfnt.Value {
+	return a.rs.newResource(name, resource)
+}
+
+// WithIAM implements the ResourceSet interface
+func (a *AutoAMIUpdatesResourceSet) WithIAM() bool {
+	return a.rs.withIAM
+}
+
+// WithNamedIAM implements the ResourceSet interface
+func (a *AutoAMIUpdatesResourceSet) WithNamedIAM() bool {
+	return false
+}
+
+// GetAllOutputs collects all outputs of the resource set
+func (a *AutoAMIUpdatesResourceSet) GetAllOutputs(stack types.Stack) error {
+	return a.rs.GetAllOutputs(stack)
+}
@@ -0,0 +1,109 @@
+package builder
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+func TestAutoAMIUpdates(t *testing.T) {
+	require := require.New(t)
+	clusterConfig := api.NewClusterConfig()
+	clusterConfig.Metadata.Name = "cluster"
+	clusterConfig.Metadata.Region = "us-west-2"
+
+	stack := NewAutoAMIUpdatesResourceSet(clusterConfig, AutoAMIUpdatesOptions{
+		Schedule:          "cron(0 3 ? * SUN *)",
+		TimeZone:          "Europe/London",
+		MaintenanceWindow: 2 * time.Hour,
+		NodeGroups:        []string{"ng-1", "ng-2"},
+	})
+	require.NoError(stack.AddAllResources())
+	require.True(stack.WithIAM())
+
+	bytes, err := stack.RenderJSON()
+	require.NoError(err)
+	var template struct {
+		Resources map[string]struct {
+			Type       string
+			Properties struct {
+				Policies []struct {
+					PolicyDocument struct {
+						Statement []struct {
+							Action   []string
+							Resource interface{}
+						}
+					}
+				}
+				ScheduleExpression         string
+				ScheduleExpressionTimezone string
+				Target                     struct {
+					Arn, RoleArn interface{}
+					Input        string
+					RetryPolicy  struct {
+						MaximumEventAgeInSeconds int
+					}
+				}
+			}
+		}
+	}
+	require.NoError(json.Unmarshal(bytes, &template))
+
+	require.Equal("AWS::Lambda::Function", template.Resources[autoAMIUpdatesFunctionName].Type)
+	functionRole := template.Resources[autoAMIUpdatesFunctionRoleName]
+	require.Equal("AWS::IAM::Role", functionRole.Type)
+	statements := functionRole.Properties.Policies[0].PolicyDocument.Statement
+	require.Len(statements, 3)
+	require.Equal([]string{"eks:DescribeNodegroup", "eks:UpdateNodegroupVersion"}, statements[1].Action)
+	require.Equal(map[string]interface{}{
+		"Fn::Sub": "arn:${AWS::Partition}:eks:${AWS::Region}:${AWS::AccountId}:nodegroup/cluster/*",
+	}, statements[1].Resource)
+
+	schedule := template.Resources[autoAMIUpdatesScheduleName]
+	require.Equal("AWS::Scheduler::Schedule", schedule.Type)
+	require.Equal("cron(0 3 ? * SUN *)", schedule.Properties.ScheduleExpression)
+	require.Equal("Europe/London", schedule.Properties.ScheduleExpressionTimezone)
+	require.Equal(map[string]interface{}{"Fn::GetAtt": []interface{}{autoAMIUpdatesFunctionName, "Arn"}}, schedule.Properties.Target.Arn)
+	require.Equal(map[string]interface{}{"Fn::GetAtt": []interface{}{autoAMIUpdatesSchedulerRoleName, "Arn"}}, schedule.Properties.Target.RoleArn)
+	require.Equal(7200, schedule.Properties.Target.RetryPolicy.MaximumEventAgeInSeconds)
+	require.JSONEq(`{
+		"clusterName": "cluster",
+		"nodegroupNames": ["ng-1", "ng-2"],
+		"scheduledTime": "<aws.scheduler.scheduled-time>",
+		"maintenanceWindowSeconds": 7200
+	}`, schedule.Properties.Target.Input)
+}
+
+func TestAutoAMIUpdatesOptionsValidate(t *testing.T) {
+	for _, tt := range []struct {
+		options     AutoAMIUpdatesOptions
+		expectedErr string
+	}{
+		{
+			options: AutoAMIUpdatesOptions{Schedule: "rate(7 days)", MaintenanceWindow: time.Hour},
+		},
+		{
+			options:     AutoAMIUpdatesOptions{MaintenanceWindow: time.Hour},
+			expectedErr: "a schedule must be set",
+		},
+		{
+			options:     AutoAMIUpdatesOptions{Schedule: "0 3 * * 0", MaintenanceWindow: time.Hour},
+			expectedErr: `invalid schedule "0 3 * * 0", expected cron(...) or rate(...)`,
+		},
+		{
+			options:     AutoAMIUpdatesOptions{Schedule: "cron(0 3 ? * SUN *)", MaintenanceWindow: 25 * time.Hour},
+			expectedErr: "the maintenance window must be between 1m and 24h0m0s, got 25h0m0s",
+		},
+	} {
+		err := tt.options.Validate()
+		if tt.expectedErr == "" {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, tt.expectedErr)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	gfnautoscaling "github.com/weaveworks/goformation/v4/cloudformation/autoscaling"
+	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	capacityBlockMarketType = "capacity-block"
+
+	// capacityBlockTerminationNotice is how long before the end of a Capacity Block EC2 starts terminating
+	// its instances, the nodegroup is scaled to zero at that time so that nodes are drained gracefully
+	capacityBlockTerminationNotice = 30 * time.Minute
+
+	// scheduledActionTimeFormat is the format of the start time of scheduled actions, which must be in UTC
+	scheduledActionTimeFormat = "2006-01-02T15:04:05Z"
+)
+
+// describeCapacityBlock returns the Capacity Reservation of the Capacity Block of the nodegroup, after checking that
+// it can be used by the nodegroup and that its reservation period hasn't ended
+func (n *NodeGroupResourceSet) describeCapacityBlock(ctx context.Context) (*ec2types.CapacityReservation, error) {
+	reservationID := n.spec.CapacityBlock.ReservationID
+	output, err := n.ec2API.DescribeCapacityReservations(ctx, &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: []string{reservationID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing capacity block %q: %w", reservationID, err)
+	}
+	if len(output.CapacityReservations) != 1 {
+		return nil, fmt.Errorf("capacity block %q not found", reservationID)
+	}
+
+	reservation := output.CapacityReservations[0]
+	switch reservation.State {
+	case ec2types.CapacityReservationStateExpired, ec2types.CapacityReservationStateCancelled, ec2types.CapacityReservationStateFailed:
+		return nil, fmt.Errorf("capacity block %q is %s", reservationID, reservation.State)
+	}
+	if instanceType := aws.ToString(reservation.InstanceType); instanceType != n.spec.InstanceType {
+		return nil, fmt.Errorf("capacity block %q reserves instance type %q but nodegroup %q uses %q", reservationID, instanceType, n.spec.Name, n.spec.InstanceType)
+	}
+	if reservation.EndDate == nil {
+		return nil, fmt.Errorf("capacity reservation %q is not a capacity block, it has no end date", reservationID)
+	}
+	if scaleDown := reservation.EndDate.Add(-capacityBlockTerminationNotice); !time.Now().Before(scaleDown) {
+		return nil, fmt.Errorf("capacity block %q ends at %s, its instances are already being terminated", reservationID, reservation.EndDate.UTC().Format(time.RFC3339))
+	}
+	if totalInstanceCount := aws.ToInt32(reservation.TotalInstanceCount); *n.spec.MaxSize > int(totalInstanceCount) {
+		return nil, fmt.Errorf("maxSize of nodegroup %q (%d) cannot be greater than the number of instances reserved by capacity block %q (%d)", n.spec.Name, *n.spec.MaxSize, reservationID, totalInstanceCount)
+	}
+	if len(n.spec.AvailabilityZones) > 0 && !hasAvailabilityZone(n.spec.AvailabilityZones, aws.ToString(reservation.AvailabilityZone)) {
+		return nil, fmt.Errorf("availabilityZones of nodegroup %q must include %s, the availability zone of capacity block %q", n.spec.Name, aws.ToString(reservation.AvailabilityZone), reservationID)
+	}
+	return &reservation, nil
+}
+
+func hasAvailabilityZone(zones []string, zone string) bool {
+	for _, z := range zones {
+		if z == zone {
+			return true
+		}
+	}
+	return false
+}
+
+// addCapacityBlockLaunchTemplateData makes instances launch in the Capacity Block of the nodegroup
+func (n *NodeGroupResourceSet) addCapacityBlockLaunchTemplateData(launchTemplateData *gfnec2.LaunchTemplate_LaunchTemplateData) {
+	launchTemplateData.InstanceMarketOptions = &gfnec2.LaunchTemplate_InstanceMarketOptions{
+		MarketType: gfnt.NewString(capacityBlockMarketType),
+	}
+	launchTemplateData.CapacityReservationSpecification = &gfnec2.LaunchTemplate_CapacityReservationSpecification{
+		CapacityReservationTarget: &gfnec2.LaunchTemplate_CapacityReservationTarget{
+			CapacityReservationId: gfnt.NewString(n.spec.CapacityBlock.ReservationID),
+		},
+	}
+}
+
+// capacityBlockSubnetsSpec returns the nodegroup spec to assign subnets from, restricted to the availability zone
+// of the Capacity Block unless the nodegroup selects its own subnets
+func capacityBlockSubnetsSpec(ng *api.NodeGroupBase, reservation *ec2types.CapacityReservation) *api.NodeGroupBase {
+	if len(ng.AvailabilityZones) > 0 || len(ng.Subnets) > 0 || len(ng.SubnetSelector) > 0 {
+		return ng
+	}
+	spec := *ng
+	spec.AvailabilityZones = []string{aws.ToString(reservation.AvailabilityZone)}
+	return &spec
+}
+
+// addResourcesForCapacityBlock keeps the nodegroup scaled to zero outside the reservation period of its Capacity Block,
+// asg is scaled to zero until the start of the block if it hasn't started yet
+func (n *NodeGroupResourceSet) addResourcesForCapacityBlock(asg *awsCloudFormationResource, reservation *ec2types.CapacityReservation) {
+	if reservation.StartDate != nil && time.Now().Before(*reservation.StartDate) {
+		asg.Properties["MinSize"] = "0"
+		asg.Properties["DesiredCapacity"] = "0"
+
+		scaleUp := &gfnautoscaling.ScheduledAction{
+			AutoScalingGroupName: gfnt.MakeRef("NodeGroup"),
+			StartTime:            gfnt.NewString(reservation.StartDate.UTC().Format(scheduledActionTimeFormat)),
+			MinSize:              gfnt.NewString(fmt.Sprintf("%d", *n.spec.MinSize)),
+			MaxSize:              gfnt.NewString(fmt.Sprintf("%d", *n.spec.MaxSize)),
+		}
+		if n.spec.DesiredCapacity != nil {
+			scaleUp.DesiredCapacity = gfnt.NewString(fmt.Sprintf("%d", *n.spec.DesiredCapacity))
+		}
+		n.newResource("CapacityBlockScaleUpAction", scaleUp)
+	}
+
+	n.newResource("CapacityBlockScaleDownAction", &gfnautoscaling.ScheduledAction{
+		AutoScalingGroupName: gfnt.MakeRef("NodeGroup"),
+		StartTime:            gfnt.NewString(reservation.EndDate.Add(-capacityBlockTerminationNotice).UTC().Format(scheduledActionTimeFormat)),
+		MinSize:              gfnt.NewString("0"),
+		DesiredCapacity:      gfnt.NewString("0"),
+	})
+}
@@ -3,6 +3,7 @@ package builder
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -13,6 +14,7 @@ import (
 	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
 	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
 	gfneks "github.com/weaveworks/goformation/v4/cloudformation/eks"
+	gfnroute53 "github.com/weaveworks/goformation/v4/cloudformation/route53"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -73,13 +75,26 @@ func (c *ClusterResourceSet) AddAllResources(ctx context.Context) error {
 		}
 	}
 
+	if c.spec.VPC.HasInstanceConnectEndpoint() {
+		if err := c.addResourcesForInstanceConnectEndpoint(vpcID, subnetDetails, clusterSG.ClusterSharedNode); err != nil {
+			return err
+		}
+	}
+
 	c.addResourcesForIAM()
+	if c.spec.VPC.ID == "" && c.spec.VPC.FlowLogs != nil {
+		c.addResourcesForFlowLogs(vpcID)
+	}
 	c.addResourcesForControlPlane(subnetDetails)
 
 	if len(c.spec.FargateProfiles) > 0 {
 		c.addResourcesForFargate()
 	}
 
+	if c.spec.Budget != nil {
+		c.addResourcesForBudget()
+	}
+
 	c.rs.defineOutput(outputs.ClusterStackName, gfnt.RefStackName, false, func(v string) error {
 		if c.spec.Status == nil {
 			c.spec.Status = &api.ClusterStatus{}
@@ -179,6 +194,17 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups(vpcID *gfnt.Value) *c
 		})
 	}
 
+	for _, port := range c.spec.VPC.OpenWebhookPorts {
+		c.newResource(fmt.Sprintf("IngressControlPlaneWebhookPort%d", port), &gfnec2.SecurityGroupIngress{
+			GroupId:               refClusterSharedNodeSG,
+			SourceSecurityGroupId: refControlPlaneSG,
+			Description:           gfnt.NewString(fmt.Sprintf("Allow control plane to reach admission webhooks on nodes (port %d)", port)),
+			IpProtocol:            gfnt.NewString("tcp"),
+			FromPort:              gfnt.NewInteger(port),
+			ToPort:                gfnt.NewInteger(port),
+		})
+	}
+
 	if c.spec.VPC == nil {
 		c.spec.VPC = &api.ClusterVPC{}
 	}
@@ -235,6 +261,18 @@ func (c *ClusterResourceSet) newResource(name string, resource gfn.Resource) *gf
 	return c.rs.newResource(name, resource)
 }
 
+// addResourcesForEndpointDNS adds a CNAME record pointing to the hostname of the API server endpoint
+func (c *ClusterResourceSet) addResourcesForEndpointDNS(dns *api.ClusterEndpointDNS) {
+	endpointHostname := gfnt.MakeFnSelect(gfnt.NewInteger(1), gfnt.MakeFnSplit("//", gfnt.MakeFnGetAttString("ControlPlane", "Endpoint")))
+	c.newResource("ClusterEndpointDNSRecord", &gfnroute53.RecordSet{
+		HostedZoneId:    gfnt.NewString(dns.HostedZoneID),
+		Name:            gfnt.NewString(dns.Name),
+		Type:            gfnt.NewString("CNAME"),
+		TTL:             gfnt.NewString("60"),
+		ResourceRecords: gfnt.NewSlice(endpointHostname),
+	})
+}
+
 func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *SubnetDetails) {
 	clusterVPC := &gfneks.Cluster_ResourcesVpcConfig{
 		EndpointPublicAccess:  gfnt.NewBoolean(*c.spec.VPC.ClusterEndpoints.PublicAccess),
@@ -286,7 +324,14 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *SubnetDe
 	}
 	cluster.KubernetesNetworkConfig = kubernetesNetworkConfig
 
-	c.newResource("ControlPlane", &cluster)
+	c.newResource("ControlPlane", &controlPlaneResource{
+		Cluster:      &cluster,
+		AccessConfig: makeAccessConfig(c.spec.AccessConfig),
+	})
+
+	if dns := c.spec.VPC.ClusterEndpoints.DNS; dns != nil {
+		c.addResourcesForEndpointDNS(dns)
+	}
 
 	if c.spec.Status == nil {
 		c.spec.Status = &api.ClusterStatus{}
@@ -333,3 +378,48 @@ func makeCFNTags(clusterConfig *api.ClusterConfig) []gfncfn.Tag {
 func (c *ClusterResourceSet) addResourcesForFargate() {
 	_ = addResourcesForFargate(c.rs, c.spec)
 }
+
+// controlPlaneResource is an AWS::EKS::Cluster resource with the properties goformation doesn't support yet
+type controlPlaneResource struct {
+	*gfneks.Cluster
+	AccessConfig *clusterAccessConfig
+}
+
+type clusterAccessConfig struct {
+	AuthenticationMode                      string `json:",omitempty"`
+	BootstrapClusterCreatorAdminPermissions *bool  `json:",omitempty"`
+}
+
+// clusterProperties has the fields of gfneks.Cluster without its MarshalJSON method
+type clusterProperties gfneks.Cluster
+
+type controlPlaneProperties struct {
+	*clusterProperties
+	AccessConfig *clusterAccessConfig `json:",omitempty"`
+}
+
+// MarshalJSON renders the resource with the properties of the embedded gfneks.Cluster
+func (r *controlPlaneResource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type       string
+		Properties controlPlaneProperties
+		DependsOn  []string `json:",omitempty"`
+	}{
+		Type: r.AWSCloudFormationType(),
+		Properties: controlPlaneProperties{
+			clusterProperties: (*clusterProperties)(r.Cluster),
+			AccessConfig:      r.AccessConfig,
+		},
+		DependsOn: r.AWSCloudFormationDependsOn,
+	})
+}
+
+func makeAccessConfig(accessConfig *api.AccessConfig) *clusterAccessConfig {
+	if accessConfig == nil || (accessConfig.AuthenticationMode == "" && accessConfig.BootstrapClusterCreatorAdminPermissions == nil) {
+		return nil
+	}
+	return &clusterAccessConfig{
+		AuthenticationMode:                      accessConfig.AuthenticationMode,
+		BootstrapClusterCreatorAdminPermissions: accessConfig.BootstrapClusterCreatorAdminPermissions,
+	}
+}
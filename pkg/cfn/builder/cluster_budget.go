@@ -0,0 +1,66 @@
+package builder
+
+import (
+	"fmt"
+
+	gfnbudgets "github.com/weaveworks/goformation/v4/cloudformation/budgets"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+)
+
+// BudgetKey is the name of the cost budget of the cluster
+const BudgetKey = "Budget"
+
+// addResourcesForBudget adds a cost budget for the resources tagged with the cost-allocation tag of the cluster,
+// with a notification for each threshold
+func (c *ClusterResourceSet) addResourcesForBudget() {
+	budget := c.spec.Budget
+	costFilter := fmt.Sprintf("user:%s$%s", budget.CostAllocationTag, budget.CostAllocationTagValue(c.spec.Metadata))
+
+	var subscribers []gfnbudgets.Budget_Subscriber
+	for _, email := range budget.Emails {
+		subscribers = append(subscribers, gfnbudgets.Budget_Subscriber{
+			SubscriptionType: gfnt.NewString("EMAIL"),
+			Address:          gfnt.NewString(email),
+		})
+	}
+	if budget.SNSTopicARN != "" {
+		subscribers = append(subscribers, gfnbudgets.Budget_Subscriber{
+			SubscriptionType: gfnt.NewString("SNS"),
+			Address:          gfnt.NewString(budget.SNSTopicARN),
+		})
+	}
+
+	notificationType := "ACTUAL"
+	if budget.Forecasted {
+		notificationType = "FORECASTED"
+	}
+	var notifications []gfnbudgets.Budget_NotificationWithSubscribers
+	for _, threshold := range budget.Thresholds {
+		notifications = append(notifications, gfnbudgets.Budget_NotificationWithSubscribers{
+			Notification: &gfnbudgets.Budget_Notification{
+				ComparisonOperator: gfnt.NewString("GREATER_THAN"),
+				NotificationType:   gfnt.NewString(notificationType),
+				Threshold:          gfnt.NewDouble(threshold),
+				ThresholdType:      gfnt.NewString("PERCENTAGE"),
+			},
+			Subscribers: subscribers,
+		})
+	}
+
+	c.newResource(BudgetKey, &gfnbudgets.Budget{
+		Budget: &gfnbudgets.Budget_BudgetData{
+			// budgets are global, the region tells apart clusters of the same name
+			BudgetName: gfnt.MakeFnSubString(fmt.Sprintf("eksctl-%s-${%s}", c.spec.Metadata.Name, gfnt.Region)),
+			BudgetType: gfnt.NewString("COST"),
+			TimeUnit:   gfnt.NewString(budget.TimeUnit),
+			BudgetLimit: &gfnbudgets.Budget_Spend{
+				Amount: gfnt.NewDouble(budget.Amount),
+				Unit:   gfnt.NewString(budget.Unit),
+			},
+			CostFilters: map[string][]string{
+				"TagKeyValue": {costFilter},
+			},
+		},
+		NotificationsWithSubscribers: notifications,
+	})
+}
@@ -96,6 +96,70 @@ var _ = Describe("Cluster Template Builder", func() {
 			Expect(clusterTemplate.Resources).To(HaveKey(privateSubnetRef1))
 		})
 
+		It("should not add an endpoint DNS record", func() {
+			Expect(clusterTemplate.Resources).NotTo(HaveKey("ClusterEndpointDNSRecord"))
+		})
+
+		Context("when an endpoint DNS record is configured", func() {
+			BeforeEach(func() {
+				cfg.VPC.ClusterEndpoints.DNS = &api.ClusterEndpointDNS{
+					HostedZoneID: "Z0123456789",
+					Name:         "api.cluster.example.com",
+				}
+			})
+
+			It("should add a CNAME record pointing to the endpoint hostname", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("ClusterEndpointDNSRecord"))
+				record := clusterTemplate.Resources["ClusterEndpointDNSRecord"].Properties
+				Expect(record.HostedZoneID).To(Equal("Z0123456789"))
+				Expect(record.Name).To(Equal("api.cluster.example.com"))
+				Expect(record.Type).To(Equal("CNAME"))
+				Expect(record.TTL).To(Equal("60"))
+				Expect(record.ResourceRecords).To(Equal([]interface{}{
+					map[string]interface{}{
+						"Fn::Select": []interface{}{1.0, map[string]interface{}{
+							"Fn::Split": []interface{}{"//", map[string]interface{}{"Fn::GetAtt": []interface{}{"ControlPlane", "Endpoint"}}},
+						}},
+					},
+				}))
+			})
+		})
+
+		It("should not set the access config by default", func() {
+			Expect(clusterTemplate.Resources["ControlPlane"].Properties.AccessConfig).To(BeNil())
+		})
+
+		Context("when an authentication mode is configured", func() {
+			BeforeEach(func() {
+				cfg.AccessConfig = &api.AccessConfig{AuthenticationMode: api.AuthenticationModeAPIAndConfigMap}
+			})
+
+			It("should set the authentication mode of the control plane", func() {
+				controlPlane := clusterTemplate.Resources["ControlPlane"].Properties
+				Expect(controlPlane.AccessConfig).NotTo(BeNil())
+				Expect(controlPlane.AccessConfig.AuthenticationMode).To(Equal("API_AND_CONFIG_MAP"))
+				Expect(controlPlane.AccessConfig.BootstrapClusterCreatorAdminPermissions).To(BeNil())
+				Expect(controlPlane.Name).To(Equal(cfg.Metadata.Name))
+			})
+		})
+
+		Context("when the cluster creator mustn't get admin permissions", func() {
+			BeforeEach(func() {
+				cfg.AccessConfig = &api.AccessConfig{
+					AuthenticationMode:                      api.AuthenticationModeAPI,
+					BootstrapClusterCreatorAdminPermissions: api.Disabled(),
+					ClusterAdminARNs:                        []string{"arn:aws:iam::123456789012:role/admin"},
+				}
+			})
+
+			It("should disable bootstrapping the cluster creator admin permissions", func() {
+				controlPlane := clusterTemplate.Resources["ControlPlane"].Properties
+				Expect(controlPlane.AccessConfig).NotTo(BeNil())
+				Expect(controlPlane.AccessConfig.AuthenticationMode).To(Equal("API"))
+				Expect(controlPlane.AccessConfig.BootstrapClusterCreatorAdminPermissions).To(Equal(api.Disabled()))
+			})
+		})
+
 		Context("when ipFamily is set to IPv6", func() {
 			BeforeEach(func() {
 				cfg.KubernetesNetworkConfig.IPFamily = api.IPV6Family
@@ -245,6 +309,69 @@ var _ = Describe("Cluster Template Builder", func() {
 			})
 		})
 
+		Context("when openWebhookPorts are defined", func() {
+			BeforeEach(func() {
+				cfg.VPC.OpenWebhookPorts = []int{9443, 15017}
+			})
+
+			It("should add ingress rules from the control plane to the shared node security group", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("IngressControlPlaneWebhookPort9443"))
+				Expect(clusterTemplate.Resources["IngressControlPlaneWebhookPort9443"].Properties).To(Equal(fakes.Properties{
+					IPProtocol: "tcp",
+					FromPort:   9443,
+					ToPort:     9443,
+					GroupID: map[string]interface{}{
+						"Ref": "ClusterSharedNodeSecurityGroup"},
+					SourceSecurityGroupID: map[string]interface{}{
+						"Ref": "ControlPlaneSecurityGroup"},
+					Description: "Allow control plane to reach admission webhooks on nodes (port 9443)",
+				}))
+				Expect(clusterTemplate.Resources).To(HaveKey("IngressControlPlaneWebhookPort15017"))
+			})
+		})
+
+		It("should not add an instance connect endpoint", func() {
+			Expect(clusterTemplate.Resources).NotTo(HaveKey("InstanceConnectEndpoint"))
+		})
+
+		Context("when an instance connect endpoint is enabled", func() {
+			BeforeEach(func() {
+				cfg.VPC.InstanceConnectEndpoint = &api.InstanceConnectEndpoint{Enabled: true}
+			})
+
+			It("should add the endpoint to a private subnet", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("InstanceConnectEndpoint"))
+				endpoint := clusterTemplate.Resources["InstanceConnectEndpoint"]
+				Expect(endpoint.Type).To(Equal("AWS::EC2::InstanceConnectEndpoint"))
+				Expect(endpoint.Properties.SubnetID).To(Equal(map[string]interface{}{"Ref": "SubnetPrivateUSWEST2A"}))
+				Expect(endpoint.Properties.SecurityGroupIds).To(ConsistOf(map[string]interface{}{"Ref": "InstanceConnectEndpointSecurityGroup"}))
+				Expect(*endpoint.Properties.PreserveClientIP).To(BeFalse())
+				Expect(clusterTemplate.Outputs).To(HaveKey("InstanceConnectEndpoint"))
+			})
+
+			It("should only allow SSH between the endpoint and the nodes", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("InstanceConnectEndpointSecurityGroup"))
+				Expect(clusterTemplate.Resources["InstanceConnectEndpointSecurityGroup"].Properties.SecurityGroupEgress).To(Equal([]fakes.SGEgress{
+					{
+						DestinationSecurityGroupID: map[string]interface{}{"Ref": "ClusterSharedNodeSecurityGroup"},
+						FromPort:                   22,
+						ToPort:                     22,
+						Description:                "Allow the endpoint to reach nodes over SSH",
+						IPProtocol:                 "tcp",
+					},
+				}))
+				Expect(clusterTemplate.Resources).To(HaveKey("IngressInstanceConnectEndpointSSH"))
+				Expect(clusterTemplate.Resources["IngressInstanceConnectEndpointSSH"].Properties).To(Equal(fakes.Properties{
+					IPProtocol:            "tcp",
+					FromPort:              22,
+					ToPort:                22,
+					GroupID:               map[string]interface{}{"Ref": "ClusterSharedNodeSecurityGroup"},
+					SourceSecurityGroupID: map[string]interface{}{"Ref": "InstanceConnectEndpointSecurityGroup"},
+					Description:           "Allow SSH from the EC2 Instance Connect Endpoint to nodes",
+				}))
+			})
+		})
+
 		Context("if SharedNodeSecurityGroup is set", func() {
 			BeforeEach(func() {
 				cfg.VPC.SharedNodeSecurityGroup = "foo"
@@ -304,6 +431,113 @@ var _ = Describe("Cluster Template Builder", func() {
 			})
 		})
 
+		It("should not add flow log resources", func() {
+			Expect(clusterTemplate.Resources).NotTo(HaveKey("FlowLog"))
+			Expect(clusterTemplate.Resources).NotTo(HaveKey("FlowLogsRole"))
+		})
+
+		Context("when flow logs are delivered to CloudWatch", func() {
+			BeforeEach(func() {
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{
+					Destination:        api.FlowLogsDestinationCloudWatch,
+					TrafficType:        api.FlowLogsTrafficTypeReject,
+					LogFormat:          "${srcaddr} ${dstaddr} ${action}",
+					LogRetentionInDays: 30,
+				}
+				role := "foo"
+				cfg.IAM.ServiceRoleARN = &role
+			})
+
+			It("should add a log group, a delivery role and a flow log for the VPC", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("FlowLogsLogGroup"))
+				Expect(clusterTemplate.Resources["FlowLogsLogGroup"].Properties.RetentionInDays).To(Equal(30))
+				Expect(clusterTemplate.Resources).To(HaveKey("FlowLogsRole"))
+				Expect(clusterTemplate.Resources).To(HaveKey("PolicyFlowLogsDelivery"))
+
+				Expect(clusterTemplate.Resources).To(HaveKey("FlowLog"))
+				flowLog := clusterTemplate.Resources["FlowLog"].Properties
+				Expect(flowLog.ResourceId).To(Equal(map[string]interface{}{"Ref": vpcResourceKey}))
+				Expect(flowLog.ResourceType).To(Equal("VPC"))
+				Expect(flowLog.TrafficType).To(Equal("REJECT"))
+				Expect(flowLog.LogFormat).To(Equal("${srcaddr} ${dstaddr} ${action}"))
+				Expect(flowLog.LogDestinationType).To(Equal("cloud-watch-logs"))
+				Expect(flowLog.LogDestination).To(Equal(map[string]interface{}{"Fn::GetAtt": []interface{}{"FlowLogsLogGroup", "Arn"}}))
+				Expect(flowLog.DeliverLogsPermissionArn).To(Equal(map[string]interface{}{"Fn::GetAtt": []interface{}{"FlowLogsRole", "Arn"}}))
+			})
+
+			It("should require IAM capabilities", func() {
+				Expect(crs.WithIAM()).To(BeTrue())
+			})
+		})
+
+		Context("when flow logs are delivered to S3", func() {
+			BeforeEach(func() {
+				cfg.VPC.FlowLogs = &api.ClusterVPCFlowLogs{
+					Destination: api.FlowLogsDestinationS3,
+					S3BucketARN: "arn:aws:s3:::flow-logs",
+					TrafficType: api.FlowLogsTrafficTypeAll,
+				}
+			})
+
+			It("should only add a flow log for the VPC", func() {
+				Expect(clusterTemplate.Resources).NotTo(HaveKey("FlowLogsLogGroup"))
+				Expect(clusterTemplate.Resources).NotTo(HaveKey("FlowLogsRole"))
+				Expect(clusterTemplate.Resources).To(HaveKey("FlowLog"))
+				flowLog := clusterTemplate.Resources["FlowLog"].Properties
+				Expect(flowLog.LogDestinationType).To(Equal("s3"))
+				Expect(flowLog.LogDestination).To(Equal("arn:aws:s3:::flow-logs"))
+				Expect(flowLog.DeliverLogsPermissionArn).To(BeNil())
+			})
+		})
+
+		It("should not add a budget", func() {
+			Expect(clusterTemplate.Resources).NotTo(HaveKey("Budget"))
+		})
+
+		Context("when a budget is set", func() {
+			BeforeEach(func() {
+				cfg.Metadata.Name = "test-cluster"
+				cfg.Budget = &api.ClusterBudget{
+					Amount:            500,
+					Unit:              "USD",
+					TimeUnit:          api.BudgetTimeUnitMonthly,
+					Thresholds:        []float64{80, 100},
+					Forecasted:        true,
+					Emails:            []string{"team@example.com"},
+					SNSTopicARN:       "arn:aws:sns:us-west-2:123456789012:budgets",
+					CostAllocationTag: api.ClusterNameTag,
+				}
+			})
+
+			It("should add a cost budget for the resources tagged with the cluster name", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("Budget"))
+				budget := clusterTemplate.Resources["Budget"].Properties
+				Expect(budget.Budget).To(Equal(map[string]interface{}{
+					"BudgetName":  map[string]interface{}{"Fn::Sub": "eksctl-test-cluster-${AWS::Region}"},
+					"BudgetType":  "COST",
+					"TimeUnit":    "MONTHLY",
+					"BudgetLimit": map[string]interface{}{"Amount": 500.0, "Unit": "USD"},
+					"CostFilters": map[string]interface{}{
+						"TagKeyValue": []interface{}{"user:alpha.eksctl.io/cluster-name$test-cluster"},
+					},
+				}))
+
+				Expect(budget.NotificationsWithSubscribers).To(HaveLen(2))
+				Expect(budget.NotificationsWithSubscribers[1]).To(Equal(map[string]interface{}{
+					"Notification": map[string]interface{}{
+						"ComparisonOperator": "GREATER_THAN",
+						"NotificationType":   "FORECASTED",
+						"Threshold":          100.0,
+						"ThresholdType":      "PERCENTAGE",
+					},
+					"Subscribers": []interface{}{
+						map[string]interface{}{"SubscriptionType": "EMAIL", "Address": "team@example.com"},
+						map[string]interface{}{"SubscriptionType": "SNS", "Address": "arn:aws:sns:us-west-2:123456789012:budgets"},
+					},
+				}))
+			})
+		})
+
 		Context("when ServiceRolePermissionsBoundary is set", func() {
 			BeforeEach(func() {
 				pb := "foo"
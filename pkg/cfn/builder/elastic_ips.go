@@ -0,0 +1,38 @@
+package builder
+
+import (
+	"fmt"
+
+	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
+	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// addResourcesForElasticIPs allocates an Elastic IP for each node the nodegroup can scale to. Nodes find them
+// by the cluster and nodegroup name tags and associate one when they boot
+func (n *NodeGroupResourceSet) addResourcesForElasticIPs() {
+	if n.spec.ElasticIPs == nil || !api.IsEnabled(n.spec.ElasticIPs.Allocate) {
+		return
+	}
+	for i := 0; i < *n.spec.MaxSize; i++ {
+		eip := &gfnec2.EIP{
+			Domain: gfnt.NewString("vpc"),
+			Tags: []gfncfn.Tag{
+				{
+					Key:   gfnt.NewString(api.ClusterNameTag),
+					Value: gfnt.NewString(n.clusterSpec.Metadata.Name),
+				},
+				{
+					Key:   gfnt.NewString(api.NodeGroupNameTag),
+					Value: gfnt.NewString(n.spec.Name),
+				},
+			},
+		}
+		if n.spec.ElasticIPs.PublicIPv4Pool != "" {
+			eip.PublicIpv4Pool = gfnt.NewString(n.spec.ElasticIPs.PublicIPv4Pool)
+		}
+		n.newResource(fmt.Sprintf("NodeElasticIP%d", i), eip)
+	}
+}
@@ -25,6 +25,9 @@ type Properties struct {
 	Description                          string
 	Tags                                 []Tag
 	SecurityGroupIngress                 []SGIngress
+	SecurityGroupEgress                  []SGEgress
+	SecurityGroupIds                     []interface{}
+	PreserveClientIP                     *bool
 	GroupID                              interface{}
 	SourceSecurityGroupID                interface{}
 	DestinationSecurityGroupID           interface{}
@@ -58,6 +61,26 @@ type Properties struct {
 	DesiredCapacity, MinSize, MaxSize string
 	MaxInstanceLifetime               int
 
+	AutoScalingGroupName            interface{}
+	Recurrence, TimeZone, StartTime string
+
+	ScheduleExpression, ScheduleExpressionTimezone string
+	Target                                         struct {
+		Arn, RoleArn interface{}
+		Input        string
+	}
+
+	HostedZoneID, Type, TTL string
+	ResourceRecords         []interface{}
+
+	AlarmActions, OKActions []string
+	MetricName, Namespace   string
+	Threshold               float64
+	Metrics                 []map[string]interface{}
+
+	Budget                       map[string]interface{}
+	NotificationsWithSubscribers []map[string]interface{}
+
 	CidrIP, CidrIPv6, IPProtocol string
 	FromPort, ToPort             int
 
@@ -70,12 +93,23 @@ type Properties struct {
 
 	Ipv6CidrBlock           interface{}
 	Ipv6Pool                string
+	PublicIpv4Pool          string
 	CidrBlock               interface{}
 	KubernetesNetworkConfig KubernetesNetworkConfig
 
 	AmazonProvidedIpv6CidrBlock bool
 	AvailabilityZone, Domain    string
 
+	DomainName        string
+	DomainNameServers []string
+	DhcpOptionsID     interface{}
+
+	ResourceId, ResourceType                 interface{}
+	TrafficType, LogDestinationType          string
+	LogFormat, LogGroupName                  string
+	LogDestination, DeliverLogsPermissionArn interface{}
+	RetentionInDays                          int
+
 	Name, Version      string
 	RoleArn            interface{}
 	ResourcesVpcConfig struct {
@@ -85,6 +119,10 @@ type Properties struct {
 		EndpointPrivateAccess bool
 		PublicAccessCidrs     []string
 	}
+	AccessConfig *struct {
+		AuthenticationMode                      string
+		BootstrapClusterCreatorAdminPermissions *bool
+	}
 	EncryptionConfig []struct {
 		Provider struct {
 			KeyARN interface{}
@@ -137,12 +175,21 @@ type ClusterLoggingType struct {
 
 type SGIngress struct {
 	SourceSecurityGroupID interface{}
+	CidrIpv6              string
 	FromPort              float64
 	ToPort                float64
 	Description           string
 	IPProtocol            string
 }
 
+type SGEgress struct {
+	DestinationSecurityGroupID interface{}
+	FromPort                   float64
+	ToPort                     float64
+	Description                string
+	IPProtocol                 string
+}
+
 type LaunchTemplateData struct {
 	IamInstanceProfile              struct{ Arn interface{} }
 	UserData, InstanceType, ImageID string
@@ -160,6 +207,15 @@ type LaunchTemplateData struct {
 	CreditSpecification *struct {
 		CPUCredits string
 	}
+	CPUOptions *struct {
+		CoreCount      int
+		ThreadsPerCore int
+	} `json:"CpuOptions"`
+	CapacityReservationSpecification *struct {
+		CapacityReservationTarget struct {
+			CapacityReservationID string `json:"CapacityReservationId"`
+		}
+	}
 	MetadataOptions   MetadataOptions
 	TagSpecifications []TagSpecification
 	Placement         Placement
@@ -167,7 +223,9 @@ type LaunchTemplateData struct {
 }
 
 type Placement struct {
-	GroupName interface{}
+	GroupName            interface{}
+	Tenancy              string
+	HostResourceGroupArn string
 }
 
 type BlockDeviceMappings struct {
@@ -178,6 +236,7 @@ type BlockDeviceMappings struct {
 type MetadataOptions struct {
 	HTTPPutResponseHopLimit float64
 	HTTPTokens              string
+	HTTPProtocolIpv6        string
 }
 
 type TagSpecification struct {
@@ -190,6 +249,8 @@ type NetworkInterface struct {
 	AssociatePublicIPAddress bool
 	NetworkCardIndex         int
 	InterfaceType            string
+	Ipv6AddressCount         int
+	Groups                   []interface{}
 }
 
 type Monitoring struct {
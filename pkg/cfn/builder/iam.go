@@ -170,6 +170,10 @@ func (n *NodeGroupResourceSet) addResourcesForIAM(ctx context.Context) error {
 	if err := createRole(n.rs, n.clusterSpec.IAM, n.spec.IAM, false, n.forceAddCNIPolicy); err != nil {
 		return err
 	}
+	if n.spec.ElasticIPs != nil {
+		// nodes associate an Elastic IP with themselves when they boot
+		n.rs.attachAllowPolicy("PolicyElasticIPs", gfnt.MakeRef(cfnIAMInstanceRoleName), elasticIPsStatements())
+	}
 
 	n.newResource(cfnIAMInstanceProfileName, &gfniam.InstanceProfile{
 		Path:  gfnt.NewString("/"),
@@ -190,14 +194,15 @@ func (n *NodeGroupResourceSet) addResourcesForIAM(ctx context.Context) error {
 
 func NewIAMRoleResourceSetForServiceAccount(spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) *IAMRoleResourceSet {
 	return &IAMRoleResourceSet{
-		template:            cft.NewTemplate(),
-		attachPolicy:        spec.AttachPolicy,
-		attachPolicyARNs:    spec.AttachPolicyARNs,
-		serviceAccount:      spec.Name,
-		namespace:           spec.Namespace,
-		wellKnownPolicies:   spec.WellKnownPolicies,
-		roleName:            spec.RoleName,
-		permissionsBoundary: spec.PermissionsBoundary,
+		template:              cft.NewTemplate(),
+		attachPolicy:          spec.AttachPolicy,
+		attachPolicyDocuments: spec.AttachPolicyDocuments,
+		attachPolicyARNs:      spec.AttachPolicyARNs,
+		serviceAccount:        spec.Name,
+		namespace:             spec.Namespace,
+		wellKnownPolicies:     spec.WellKnownPolicies,
+		roleName:              spec.RoleName,
+		permissionsBoundary:   spec.PermissionsBoundary,
 		description: fmt.Sprintf(
 			"IAM role for serviceaccount %q %s",
 			spec.NameString(),
@@ -215,19 +220,20 @@ func NewIAMRoleResourceSetForServiceAccount(spec *api.ClusterIAMServiceAccount,
 
 // IAMRoleResourceSet holds IAM Role stack build-time information
 type IAMRoleResourceSet struct {
-	template            *cft.Template
-	oidc                *iamoidc.OpenIDConnectManager
-	outputs             *outputs.CollectorSet
-	roleName            string
-	wellKnownPolicies   api.WellKnownPolicies
-	attachPolicyARNs    []string
-	attachPolicy        api.InlineDocument
-	roleNameCollector   func(string) error
-	OutputRole          string
-	serviceAccount      string
-	namespace           string
-	permissionsBoundary string
-	description         string
+	template              *cft.Template
+	oidc                  *iamoidc.OpenIDConnectManager
+	outputs               *outputs.CollectorSet
+	roleName              string
+	wellKnownPolicies     api.WellKnownPolicies
+	attachPolicyARNs      []string
+	attachPolicy          api.InlineDocument
+	attachPolicyDocuments []api.InlineDocument
+	roleNameCollector     func(string) error
+	OutputRole            string
+	serviceAccount        string
+	namespace             string
+	permissionsBoundary   string
+	description           string
 }
 
 // NewIAMRoleResourceSetWithAttachPolicyARNs builds IAM Role stack from the give spec
@@ -322,6 +328,10 @@ func (rs *IAMRoleResourceSet) AddAllResources() error {
 		rs.template.AttachPolicy("Policy1", roleRef, rs.attachPolicy)
 	}
 
+	for i, doc := range rs.attachPolicyDocuments {
+		rs.template.AttachPolicy(fmt.Sprintf("Policy%d", i+2), roleRef, doc)
+	}
+
 	return nil
 }
 
@@ -217,6 +217,57 @@ var _ = Describe("template builder for IAM", func() {
 			Expect(t).To(HaveOutputWithValue(outputs.IAMServiceAccountRoleName, `{ "Fn::GetAtt": "Role1.Arn" }`))
 		})
 
+		It("can construct an iamserviceaccount addon template with policies loaded from files or URLs", func() {
+			serviceAccount := &api.ClusterIAMServiceAccount{}
+
+			serviceAccount.Name = "sa-1"
+
+			serviceAccount.AttachPolicy = cft.MakePolicyDocument(
+				cft.MapOfInterfaces{
+					"Effect":   "Allow",
+					"Action":   []string{"s3:Get*"},
+					"Resource": "*",
+				},
+			)
+			serviceAccount.AttachPolicyDocuments = []api.InlineDocument{
+				cft.MakePolicyDocument(
+					cft.MapOfInterfaces{
+						"Effect":   "Allow",
+						"Action":   []string{"sqs:SendMessage"},
+						"Resource": "*",
+					},
+				),
+			}
+
+			appendServiceAccountToClusterConfig(cfg, serviceAccount)
+
+			rs := builder.NewIAMRoleResourceSetForServiceAccount(serviceAccount, oidc)
+
+			templateBody := []byte{}
+
+			Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+			t := cft.NewTemplate()
+
+			Expect(t).To(LoadBytesWithoutErrors(templateBody))
+
+			Expect(t.Resources).To(HaveLen(3))
+			Expect(t).To(HaveResource("Policy1", "AWS::IAM::Policy"))
+			Expect(t).To(HaveResource("Policy2", "AWS::IAM::Policy"))
+			Expect(t).To(HaveResourceWithPropertyValue("Policy2", "PolicyDocument", `{
+            "Version": "2012-10-17",
+            "Statement": [
+                {
+                    "Effect": "Allow",
+                    "Action": [
+                        "sqs:SendMessage"
+                    ],
+                    "Resource": "*"
+                }
+            ]
+        }`))
+		})
+
 		It("can construct an iamserviceaccount addon template with one managed policy and a permissions boundary", func() {
 			serviceAccount := &api.ClusterIAMServiceAccount{}
 
@@ -0,0 +1,69 @@
+package builder
+
+import (
+	"errors"
+
+	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
+	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+)
+
+const (
+	instanceConnectEndpointKey   = "InstanceConnectEndpoint"
+	instanceConnectEndpointSGKey = "InstanceConnectEndpointSecurityGroup"
+)
+
+// addResourcesForInstanceConnectEndpoint adds an EC2 Instance Connect Endpoint to a private subnet, allowed to
+// reach nodes on port 22 through the shared node security group
+func (c *ClusterResourceSet) addResourcesForInstanceConnectEndpoint(vpcID *gfnt.Value, subnetDetails *SubnetDetails, sharedNodeSG *gfnt.Value) error {
+	if len(subnetDetails.Private) == 0 {
+		return errors.New("vpc.instanceConnectEndpoint requires a private subnet")
+	}
+
+	// the subnets are listed in no particular order, pick the same one each time the template is rendered
+	subnet := subnetDetails.Private[0]
+	for _, s := range subnetDetails.Private[1:] {
+		if s.AvailabilityZone < subnet.AvailabilityZone {
+			subnet = s
+		}
+	}
+
+	refEndpointSG := c.newResource(instanceConnectEndpointSGKey, &gfnec2.SecurityGroup{
+		GroupDescription: gfnt.NewString("EC2 Instance Connect Endpoint of the cluster"),
+		VpcId:            vpcID,
+		// replaces the default rule allowing all outbound traffic
+		SecurityGroupEgress: []gfnec2.SecurityGroup_Egress{
+			{
+				DestinationSecurityGroupId: sharedNodeSG,
+				Description:                gfnt.NewString("Allow the endpoint to reach nodes over SSH"),
+				IpProtocol:                 gfnt.NewString("tcp"),
+				FromPort:                   sgPortSSH,
+				ToPort:                     sgPortSSH,
+			},
+		},
+	})
+	c.newResource("IngressInstanceConnectEndpointSSH", &gfnec2.SecurityGroupIngress{
+		GroupId:               sharedNodeSG,
+		SourceSecurityGroupId: refEndpointSG,
+		Description:           gfnt.NewString("Allow SSH from the EC2 Instance Connect Endpoint to nodes"),
+		IpProtocol:            gfnt.NewString("tcp"),
+		FromPort:              sgPortSSH,
+		ToPort:                sgPortSSH,
+	})
+
+	// goformation doesn't know AWS::EC2::InstanceConnectEndpoint yet
+	refEndpoint := c.newResource(instanceConnectEndpointKey, &awsCloudFormationResource{
+		Type: "AWS::EC2::InstanceConnectEndpoint",
+		Properties: map[string]interface{}{
+			"SubnetId":         subnet.Subnet,
+			"SecurityGroupIds": []*gfnt.Value{refEndpointSG},
+			// the rules of the shared node security group only match the endpoint when it's the source of the traffic
+			"PreserveClientIp": false,
+			"Tags":             []gfncfn.Tag{makeAutoNameTag(instanceConnectEndpointKey)},
+		},
+	})
+	c.rs.defineOutputWithoutCollector(outputs.ClusterInstanceConnectEndpoint, refEndpoint, false)
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
 )
 
 func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData(ctx context.Context) (*gfnec2.LaunchTemplate_LaunchTemplateData, error) {
@@ -23,11 +24,22 @@ func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
+	if err := nodebootstrap.ValidateUserDataSize(mng.Name, userData); err != nil {
+		return nil, err
+	}
 	if userData != "" {
 		launchTemplateData.UserData = gfnt.NewString(userData)
 	}
 
-	securityGroupIDs := m.vpcImporter.SecurityGroups()
+	var securityGroupIDs gfnt.Slice
+	if api.IsDisabled(mng.SecurityGroups.AttachSharedClusterSG) {
+		// EKS only attaches the cluster security group when the launch template has none
+		if err := checkControlPlaneConnectivity(ctx, m.ec2API, mng.SecurityGroups.AttachIDs); err != nil {
+			return nil, err
+		}
+	} else {
+		securityGroupIDs = m.vpcImporter.SecurityGroups()
+	}
 	for _, sgID := range mng.SecurityGroups.AttachIDs {
 		securityGroupIDs = append(securityGroupIDs, gfnt.NewString(sgID))
 	}
@@ -88,6 +100,10 @@ func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData(ctx context.Context
 		}
 	}
 
+	launchTemplateData.CpuOptions = makeCPUOptions(mng.NodeGroupBase)
+
+	addTenancy(launchTemplateData, mng.NodeGroupBase)
+
 	launchTemplateData.BlockDeviceMappings = makeBlockDeviceMappings(mng.NodeGroupBase)
 
 	return launchTemplateData, nil
@@ -234,6 +234,78 @@ API_SERVER_URL=https://test.com
 			resourcesFilename: "placement.json",
 		}),
 
+		Entry("With CPU options", &mngCase{
+			ng: &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:         "standard",
+					InstanceType: "c5.18xlarge",
+					CPUOptions: &api.CPUOptions{
+						CoreCount:      36,
+						ThreadsPerCore: 1,
+					},
+				},
+			},
+			resourcesFilename: "cpu_options.json",
+		}),
+
+		Entry("Without the shared cluster security group", &mngCase{
+			ng: &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:         "regulated",
+					InstanceType: "m5.xlarge",
+					SecurityGroups: &api.NodeGroupSGs{
+						AttachSharedClusterSG: api.Disabled(),
+						AttachIDs:             []string{"sg-regulated"},
+					},
+				},
+			},
+			mockFetcherFn: mockSecurityGroup("sg-regulated", ec2types.SecurityGroup{
+				IpPermissions: []ec2types.IpPermission{
+					{
+						IpProtocol: aws.String("tcp"),
+						FromPort:   aws.Int32(1025),
+						ToPort:     aws.Int32(65535),
+					},
+				},
+				IpPermissionsEgress: []ec2types.IpPermission{
+					{
+						IpProtocol: aws.String("-1"),
+					},
+				},
+			}),
+			resourcesFilename: "attach_ids_only.json",
+		}),
+
+		Entry("Without the shared cluster security group and no access to the Kubernetes API", &mngCase{
+			ng: &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:         "regulated",
+					InstanceType: "m5.xlarge",
+					SecurityGroups: &api.NodeGroupSGs{
+						AttachSharedClusterSG: api.Disabled(),
+						AttachIDs:             []string{"sg-regulated"},
+					},
+				},
+			},
+			mockFetcherFn: mockSecurityGroup("sg-regulated", ec2types.SecurityGroup{
+				IpPermissions: []ec2types.IpPermission{
+					{
+						IpProtocol: aws.String("tcp"),
+						FromPort:   aws.Int32(10250),
+						ToPort:     aws.Int32(10250),
+					},
+				},
+				IpPermissionsEgress: []ec2types.IpPermission{
+					{
+						IpProtocol: aws.String("udp"),
+						FromPort:   aws.Int32(53),
+						ToPort:     aws.Int32(53),
+					},
+				},
+			}),
+			errMsg: "must allow outbound TCP traffic on port 443",
+		}),
+
 		Entry("With Spot instances", &mngCase{
 			ng: &api.ManagedNodeGroup{
 				NodeGroupBase: &api.NodeGroupBase{
@@ -345,3 +417,14 @@ func mockLaunchTemplate(matcher func(*ec2.DescribeLaunchTemplateVersionsInput) b
 			}, nil)
 	}
 }
+
+func mockSecurityGroup(groupID string, sg ec2types.SecurityGroup) func(provider *mockprovider.MockProvider) {
+	return func(provider *mockprovider.MockProvider) {
+		sg.GroupId = aws.String(groupID)
+		provider.MockEC2().On("DescribeSecurityGroups", mock.Anything, &ec2.DescribeSecurityGroupsInput{
+			GroupIds: []string{groupID},
+		}).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []ec2types.SecurityGroup{sg},
+		}, nil)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/require"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
@@ -223,6 +224,63 @@ func TestManagedNodeRole(t *testing.T) {
 	}
 }
 
+func TestManagedNodeGroupScheduledScaling(t *testing.T) {
+	require := require.New(t)
+	clusterConfig := api.NewClusterConfig()
+	clusterConfig.Metadata.Name = "cluster"
+	clusterConfig.Metadata.Region = "us-west-2"
+
+	ng := api.NewManagedNodeGroup()
+	ng.Name = "office-hours"
+	api.SetManagedNodeGroupDefaults(ng, clusterConfig.Metadata)
+	ng.ScheduledScaling = []api.ScheduledScalingAction{
+		{Cron: "0 8 * * 1-5", MinSize: aws.Int(3), DesiredCapacity: aws.Int(3), TimeZone: "Europe/London"},
+		{Cron: "0 20 * * 1-5", MinSize: aws.Int(0), DesiredCapacity: aws.Int(0)},
+	}
+
+	p := mockprovider.NewMockProvider()
+	bootstrapper := nodebootstrap.NewManagedBootstrapper(clusterConfig, ng)
+	stack := NewManagedNodeGroup(p.EC2(), clusterConfig, ng, nil, bootstrapper, false, new(vpcfakes.FakeImporter))
+	require.NoError(stack.AddAllResources(context.Background()))
+
+	bytes, err := stack.RenderJSON()
+	require.NoError(err)
+	var template struct {
+		Resources map[string]struct {
+			Type       string
+			Properties struct {
+				ManagedPolicyArns          interface{}
+				ScheduleExpression         string
+				ScheduleExpressionTimezone string
+				Target                     struct {
+					Arn, RoleArn interface{}
+					Input        string
+				}
+			}
+		}
+	}
+	require.NoError(json.Unmarshal(bytes, &template))
+
+	require.Equal("AWS::Lambda::Function", template.Resources[scheduledScalingFunctionName].Type)
+	functionRole := template.Resources[scheduledScalingFunctionRoleName]
+	require.Equal("AWS::IAM::Role", functionRole.Type)
+	require.Equal([]interface{}{map[string]interface{}{
+		"Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole",
+	}}, functionRole.Properties.ManagedPolicyArns)
+
+	morning := template.Resources["ScheduledScalingSchedule0"]
+	require.Equal("AWS::Scheduler::Schedule", morning.Type)
+	require.Equal("cron(0 8 ? * 2-6 *)", morning.Properties.ScheduleExpression)
+	require.Equal("Europe/London", morning.Properties.ScheduleExpressionTimezone)
+	require.Equal(map[string]interface{}{"Fn::GetAtt": []interface{}{scheduledScalingFunctionName, "Arn"}}, morning.Properties.Target.Arn)
+	require.Equal(map[string]interface{}{"Fn::GetAtt": []interface{}{scheduledScalingSchedulerRoleName, "Arn"}}, morning.Properties.Target.RoleArn)
+	require.JSONEq(`{"clusterName":"cluster","nodegroupName":"office-hours","scalingConfig":{"minSize":3,"desiredSize":3}}`, morning.Properties.Target.Input)
+
+	evening := template.Resources["ScheduledScalingSchedule1"]
+	require.Equal("cron(0 20 ? * 2-6 *)", evening.Properties.ScheduleExpression)
+	require.Empty(evening.Properties.ScheduleExpressionTimezone)
+}
+
 func makePartitionedPolicies(policies ...string) []*gfnt.Value {
 	var partitionedPolicies []*gfnt.Value
 	for _, policy := range policies {
@@ -0,0 +1,58 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+const (
+	kubeletPort = 10250
+	httpsPort   = 443
+)
+
+// checkControlPlaneConnectivity returns an error if securityGroupIDs, the only security groups of nodes that get none
+// from eksctl or EKS, don't allow the minimal traffic between nodes and the control plane: requests from the control
+// plane to the kubelet and requests from nodes to the Kubernetes API. The sources and destinations of the rules
+// aren't checked
+func checkControlPlaneConnectivity(ctx context.Context, ec2API awsapi.EC2, securityGroupIDs []string) error {
+	output, err := ec2API.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: securityGroupIDs,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing security groups %v", securityGroupIDs)
+	}
+
+	var ingress, egress []ec2types.IpPermission
+	for _, sg := range output.SecurityGroups {
+		ingress = append(ingress, sg.IpPermissions...)
+		egress = append(egress, sg.IpPermissionsEgress...)
+	}
+	if !allowsTCPPort(ingress, kubeletPort) {
+		return fmt.Errorf("security groups %v must allow inbound TCP traffic on port %d, the control plane cannot reach the kubelet otherwise", securityGroupIDs, kubeletPort)
+	}
+	if !allowsTCPPort(egress, httpsPort) {
+		return fmt.Errorf("security groups %v must allow outbound TCP traffic on port %d, nodes cannot reach the Kubernetes API otherwise", securityGroupIDs, httpsPort)
+	}
+	return nil
+}
+
+func allowsTCPPort(permissions []ec2types.IpPermission, port int32) bool {
+	for _, p := range permissions {
+		switch aws.ToString(p.IpProtocol) {
+		case "-1":
+			return true
+		case "tcp", "6":
+			if aws.ToInt32(p.FromPort) <= port && port <= aws.ToInt32(p.ToPort) {
+				return true
+			}
+		}
+	}
+	return false
+}
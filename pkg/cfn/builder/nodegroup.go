@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/pkg/errors"
 	gfn "github.com/weaveworks/goformation/v4/cloudformation"
 	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
@@ -58,10 +61,6 @@ func NewNodeGroupResourceSet(ec2API awsapi.EC2, iamAPI awsapi.IAM, spec *api.Clu
 // AddAllResources adds all the information about the nodegroup to the resource set
 func (n *NodeGroupResourceSet) AddAllResources(ctx context.Context) error {
 
-	if n.clusterSpec.IPv6Enabled() {
-		return errors.New("unmanaged nodegroups are not supported with IPv6 clusters")
-	}
-
 	n.rs.template.Description = fmt.Sprintf(
 		"%s (AMI family: %s, SSH access: %v, private networking: %v) %s",
 		nodeGroupTemplateDescription,
@@ -117,6 +116,11 @@ func (n *NodeGroupResourceSet) AddAllResources(ctx context.Context) error {
 	if err := n.addResourcesForIAM(ctx); err != nil {
 		return err
 	}
+	if api.IsDisabled(n.spec.SecurityGroups.WithShared) && api.IsDisabled(n.spec.SecurityGroups.WithLocal) {
+		if err := checkControlPlaneConnectivity(ctx, n.ec2API, n.spec.SecurityGroups.AttachIDs); err != nil {
+			return err
+		}
+	}
 	n.addResourcesForSecurityGroups()
 
 	return n.addResourcesForNodeGroup(ctx)
@@ -139,6 +143,11 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 	vpcID := n.vpcImporter.VPC()
 	refControlPlaneSG := n.vpcImporter.ControlPlaneSecurityGroup()
 
+	ingressRules := makeNodeIngressRules(n.spec.NodeGroupBase, refControlPlaneSG, n.clusterSpec.VPC.CIDR.String(), desc)
+	if n.clusterSpec.IPv6Enabled() {
+		ingressRules = append(ingressRules, makeNodeIPv6IngressRules(n.spec.NodeGroupBase, n.clusterSpec.VPC.IPv6Cidr, desc)...)
+	}
+
 	refNodeGroupLocalSG := n.newResource("SG", &gfnec2.SecurityGroup{
 		VpcId:            vpcID,
 		GroupDescription: gfnt.NewString("Communication between the control plane and " + desc),
@@ -146,7 +155,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 			Key:   gfnt.NewString("kubernetes.io/cluster/" + n.clusterSpec.Metadata.Name),
 			Value: gfnt.NewString("owned"),
 		}},
-		SecurityGroupIngress: makeNodeIngressRules(n.spec.NodeGroupBase, refControlPlaneSG, n.clusterSpec.VPC.CIDR.String(), desc),
+		SecurityGroupIngress: ingressRules,
 	})
 
 	n.securityGroups = append(n.securityGroups, refNodeGroupLocalSG)
@@ -203,6 +212,32 @@ func makeNodeIngressRules(ng *api.NodeGroupBase, controlPlaneSG *gfnt.Value, vpc
 	return append(ingressRules, makeSSHIngressRules(ng, vpcCIDR, description)...)
 }
 
+// makeNodeIPv6IngressRules returns the additional rules required by nodes in IPv6 clusters
+func makeNodeIPv6IngressRules(ng *api.NodeGroupBase, vpcIPv6CIDR, description string) []gfnec2.SecurityGroup_Ingress {
+	ingressRules := []gfnec2.SecurityGroup_Ingress{
+		{
+			// unlike IPv4, IPv6 routers never fragment packets so ICMPv6 is required for path MTU discovery
+			CidrIpv6:    sgSourceAnywhereIPv6,
+			Description: gfnt.NewString(fmt.Sprintf("Allow ICMPv6 to %s (path MTU discovery)", description)),
+			IpProtocol:  sgProtoICMPv6,
+			FromPort:    sgPortAll,
+			ToPort:      sgPortAll,
+		},
+	}
+
+	if api.IsEnabled(ng.SSH.Allow) && len(ng.SSH.SourceSecurityGroupIDs) == 0 && ng.PrivateNetworking && vpcIPv6CIDR != "" {
+		ingressRules = append(ingressRules, gfnec2.SecurityGroup_Ingress{
+			CidrIpv6:    gfnt.NewString(vpcIPv6CIDR),
+			Description: gfnt.NewString(fmt.Sprintf("Allow SSH access to %s (private, only inside VPC)", description)),
+			IpProtocol:  sgProtoTCP,
+			FromPort:    sgPortSSH,
+			ToPort:      sgPortSSH,
+		})
+	}
+
+	return ingressRules
+}
+
 // RenderJSON returns the rendered JSON
 func (n *NodeGroupResourceSet) RenderJSON() ([]byte, error) {
 	return n.rs.renderJSON()
@@ -230,12 +265,22 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup(ctx context.Context) err
 
 	launchTemplateData.BlockDeviceMappings = makeBlockDeviceMappings(n.spec.NodeGroupBase)
 
+	subnetsSpec := n.spec.NodeGroupBase
+	var capacityBlock *ec2types.CapacityReservation
+	if n.spec.CapacityBlock != nil {
+		if capacityBlock, err = n.describeCapacityBlock(ctx); err != nil {
+			return err
+		}
+		n.addCapacityBlockLaunchTemplateData(launchTemplateData)
+		subnetsSpec = capacityBlockSubnetsSpec(subnetsSpec, capacityBlock)
+	}
+
 	n.newResource("NodeGroupLaunchTemplate", &gfnec2.LaunchTemplate{
 		LaunchTemplateName: launchTemplateName,
 		LaunchTemplateData: launchTemplateData,
 	})
 
-	vpcZoneIdentifier, err := AssignSubnets(ctx, n.spec.NodeGroupBase, n.vpcImporter, n.clusterSpec, n.ec2API)
+	vpcZoneIdentifier, err := AssignSubnets(ctx, subnetsSpec, n.vpcImporter, n.clusterSpec, n.ec2API)
 	if err != nil {
 		return err
 	}
@@ -252,7 +297,8 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup(ctx context.Context) err
 			"PropagateAtLaunch": "true",
 		},
 	}
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) {
+	autoScalerTagsEnabled := n.clusterSpec.AutoScalerTagsEnabled()
+	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) || autoScalerTagsEnabled {
 		tags = append(tags,
 			map[string]interface{}{
 				"Key":               "k8s.io/cluster-autoscaler/enabled",
@@ -267,7 +313,7 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup(ctx context.Context) err
 		)
 	}
 
-	if api.IsEnabled(n.spec.PropagateASGTags) {
+	if api.IsEnabled(n.spec.PropagateASGTags) || autoScalerTagsEnabled {
 		clusterTags, err := generateClusterAutoscalerTags(n.spec)
 		if err != nil {
 			return err
@@ -280,37 +326,66 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup(ctx context.Context) err
 
 	asg := nodeGroupResource(launchTemplateName, vpcZoneIdentifier, tags, n.spec)
 	n.newResource("NodeGroup", asg)
+	n.addResourcesForAlarms()
+	n.addResourcesForScheduledScaling()
+	n.addResourcesForElasticIPs()
+	if capacityBlock != nil {
+		n.addResourcesForCapacityBlock(asg, capacityBlock)
+	}
 
 	return nil
 }
 
 func generateClusterAutoscalerTags(spec *api.NodeGroup) ([]map[string]interface{}, error) {
 	result := make([]map[string]interface{}, 0)
+	err := forEachNodeTemplateTag(spec.Labels, spec.Taints, func(key, value string) {
+		result = append(result, map[string]interface{}{
+			"Key":               key,
+			"Value":             value,
+			"PropagateAtLaunch": "true",
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ManagedNodeGroupClusterAutoscalerTags returns the tags cluster-autoscaler uses to discover the ASGs
+// of a managed nodegroup and to learn its labels and taints when scaling from zero
+func ManagedNodeGroupClusterAutoscalerTags(clusterName string, ng *api.ManagedNodeGroup) (map[string]string, error) {
+	tags := map[string]string{
+		"k8s.io/cluster-autoscaler/enabled":        "true",
+		"k8s.io/cluster-autoscaler/" + clusterName: "owned",
+	}
+	err := forEachNodeTemplateTag(ng.Labels, ng.Taints, func(key, value string) {
+		tags[key] = value
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// forEachNodeTemplateTag calls addTag with each cluster-autoscaler node-template tag for the labels and taints
+func forEachNodeTemplateTag(labels map[string]string, taints []api.NodeGroupTaint, addTag func(key, value string)) error {
 	duplicates := make(map[string]string)
 
 	// labels
-	for k, v := range spec.Labels {
+	for k, v := range labels {
 		duplicates[k] = v
-		result = append(result, map[string]interface{}{
-			"Key":               "k8s.io/cluster-autoscaler/node-template/label/" + k,
-			"Value":             v,
-			"PropagateAtLaunch": "true",
-		})
+		addTag("k8s.io/cluster-autoscaler/node-template/label/"+k, v)
 	}
 
 	// taints
-	for _, taint := range spec.Taints {
+	for _, taint := range taints {
 		if _, ok := duplicates[taint.Key]; ok {
-			return nil, fmt.Errorf("duplicate key found for taints and labels with taint key=value: %s=%s, and label: %s=%s", taint.Key, taint.Value, taint.Key, duplicates[taint.Key])
+			return fmt.Errorf("duplicate key found for taints and labels with taint key=value: %s=%s, and label: %s=%s", taint.Key, taint.Value, taint.Key, duplicates[taint.Key])
 		}
 		duplicates[taint.Key] = taint.Value
-		result = append(result, map[string]interface{}{
-			"Key":               "k8s.io/cluster-autoscaler/node-template/taints/" + taint.Key,
-			"Value":             taint.Value,
-			"PropagateAtLaunch": "true",
-		})
+		addTag("k8s.io/cluster-autoscaler/node-template/taints/"+taint.Key, taint.Value)
 	}
-	return result, nil
+	return nil
 }
 
 // generateNodeName formulates the name based on the configuration in input
@@ -333,14 +408,22 @@ func AssignSubnets(ctx context.Context, spec *api.NodeGroupBase, vpcImporter vpc
 	// Currently, goformation type system doesn't allow specifying `VPCZoneIdentifier: { "Fn::ImportValue": ... }`,
 	// and tags don't have `PropagateAtLaunch` field, so we have a custom method here until this gets resolved
 
-	if len(spec.AvailabilityZones) > 0 || len(spec.Subnets) > 0 || api.IsEnabled(spec.EFAEnabled) {
+	if len(spec.AvailabilityZones) > 0 || len(spec.Subnets) > 0 || len(spec.SubnetSelector) > 0 || api.IsEnabled(spec.EFAEnabled) {
 		subnets := clusterSpec.VPC.Subnets.Public
 		typ := "public"
 		if spec.PrivateNetworking {
 			subnets = clusterSpec.VPC.Subnets.Private
 			typ = "private"
 		}
-		subnetIDs, err := vpc.SelectNodeGroupSubnets(ctx, spec.AvailabilityZones, spec.Subnets, subnets, ec2API, clusterSpec.VPC.ID)
+		var (
+			subnetIDs []string
+			err       error
+		)
+		if len(spec.SubnetSelector) > 0 {
+			subnetIDs, err = vpc.SelectNodeGroupSubnetsByTags(ctx, spec.SubnetSelector, ec2API, clusterSpec.VPC.ID)
+		} else {
+			subnetIDs, err = vpc.SelectNodeGroupSubnets(ctx, spec.AvailabilityZones, spec.Subnets, subnets, ec2API, clusterSpec.VPC.ID)
+		}
 		if api.IsEnabled(spec.EFAEnabled) && len(subnetIDs) > 1 {
 			subnetIDs = []string{subnetIDs[0]}
 			logger.Info("EFA requires all nodes be in a single subnet, arbitrarily choosing one: %s", subnetIDs)
@@ -368,6 +451,9 @@ func newLaunchTemplateData(ctx context.Context, n *NodeGroupResourceSet) (*gfnec
 	if err != nil {
 		return nil, err
 	}
+	if err := nodebootstrap.ValidateUserDataSize(n.spec.Name, userData); err != nil {
+		return nil, err
+	}
 
 	launchTemplateData := &gfnec2.LaunchTemplate_LaunchTemplateData{
 		IamInstanceProfile: &gfnec2.LaunchTemplate_IamInstanceProfile{
@@ -383,6 +469,13 @@ func newLaunchTemplateData(ctx context.Context, n *NodeGroupResourceSet) (*gfnec
 		return nil, errors.Wrap(err, "couldn't build network interfaces for launch template data")
 	}
 
+	if n.clusterSpec.IPv6Enabled() {
+		// nodes in IPv6-only subnets need an IPv6 address on the primary interface
+		// and access to the IPv6 IMDS endpoint
+		launchTemplateData.NetworkInterfaces[0].Ipv6AddressCount = gfnt.NewInteger(1)
+		launchTemplateData.MetadataOptions.HttpProtocolIpv6 = gfnt.NewString("enabled")
+	}
+
 	if api.IsEnabled(n.spec.EFAEnabled) && n.spec.Placement == nil {
 		groupName := n.newResource("NodeGroupPlacementGroup", &gfnec2.PlacementGroup{
 			Strategy: gfnt.NewString("cluster"),
@@ -419,6 +512,15 @@ func newLaunchTemplateData(ctx context.Context, n *NodeGroupResourceSet) (*gfnec
 		}
 	}
 
+	launchTemplateData.CpuOptions = makeCPUOptions(n.spec.NodeGroupBase)
+
+	if n.spec.Tenancy == api.TenancyHost {
+		if err := checkDedicatedHostsSupported(ctx, n.ec2API, n.spec.InstanceTypeList()); err != nil {
+			return nil, err
+		}
+	}
+	addTenancy(launchTemplateData, n.spec.NodeGroupBase)
+
 	return launchTemplateData, nil
 }
 
@@ -437,6 +539,50 @@ func makeMetadataOptions(ng *api.NodeGroupBase) *gfnec2.LaunchTemplate_MetadataO
 	}
 }
 
+func makeCPUOptions(ng *api.NodeGroupBase) *gfnec2.LaunchTemplate_CpuOptions {
+	if ng.CPUOptions == nil {
+		return nil
+	}
+	return &gfnec2.LaunchTemplate_CpuOptions{
+		CoreCount:      gfnt.NewInteger(ng.CPUOptions.CoreCount),
+		ThreadsPerCore: gfnt.NewInteger(ng.CPUOptions.ThreadsPerCore),
+	}
+}
+
+// addTenancy sets the tenancy of the nodegroup in the placement of launchTemplateData, keeping its placement group
+func addTenancy(launchTemplateData *gfnec2.LaunchTemplate_LaunchTemplateData, ng *api.NodeGroupBase) {
+	if ng.Tenancy == "" {
+		return
+	}
+	if launchTemplateData.Placement == nil {
+		launchTemplateData.Placement = &gfnec2.LaunchTemplate_Placement{}
+	}
+	launchTemplateData.Placement.Tenancy = gfnt.NewString(ng.Tenancy)
+	if ng.HostResourceGroupARN != "" {
+		launchTemplateData.Placement.HostResourceGroupArn = gfnt.NewString(ng.HostResourceGroupARN)
+	}
+}
+
+// checkDedicatedHostsSupported returns an error if any of instanceTypes cannot run on Dedicated Hosts
+func checkDedicatedHostsSupported(ctx context.Context, ec2API awsapi.EC2, instanceTypes []string) error {
+	var instanceTypeList []ec2types.InstanceType
+	for _, it := range instanceTypes {
+		instanceTypeList = append(instanceTypeList, ec2types.InstanceType(it))
+	}
+	info, err := ec2API.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: instanceTypeList,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "couldn't retrieve instance type description for %v", instanceTypes)
+	}
+	for _, it := range info.InstanceTypes {
+		if !aws.ToBool(it.DedicatedHostsSupported) {
+			return fmt.Errorf("instance type %s does not support Dedicated Hosts, which are required by tenancy %q", it.InstanceType, api.TenancyHost)
+		}
+	}
+	return nil
+}
+
 func nodeGroupResource(launchTemplateName *gfnt.Value, vpcZoneIdentifier interface{}, tags []map[string]interface{}, ng *api.NodeGroup) *awsCloudFormationResource {
 	ngProps := map[string]interface{}{
 		"VPCZoneIdentifier": vpcZoneIdentifier,
@@ -456,8 +602,8 @@ func nodeGroupResource(launchTemplateName *gfnt.Value, vpcZoneIdentifier interfa
 	if ng.MaxSize != nil {
 		ngProps["MaxSize"] = fmt.Sprintf("%d", *ng.MaxSize)
 	}
-	if len(ng.ASGMetricsCollection) > 0 {
-		ngProps["MetricsCollection"] = metricsCollectionResource(ng.ASGMetricsCollection)
+	if metricsCollection := withAlarmMetricsCollection(ng.ASGMetricsCollection, ng.Alarms); len(metricsCollection) > 0 {
+		ngProps["MetricsCollection"] = metricsCollectionResource(metricsCollection)
 	}
 	if len(ng.ClassicLoadBalancerNames) > 0 {
 		ngProps["LoadBalancerNames"] = ng.ClassicLoadBalancerNames
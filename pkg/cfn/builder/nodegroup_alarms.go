@@ -0,0 +1,146 @@
+package builder
+
+import (
+	"fmt"
+
+	gfncloudwatch "github.com/weaveworks/goformation/v4/cloudformation/cloudwatch"
+	gfnevents "github.com/weaveworks/goformation/v4/cloudformation/events"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// inServiceCapacityMetrics are the ASG metrics the in-service capacity alarm is computed from
+var inServiceCapacityMetrics = []string{"GroupDesiredCapacity", "GroupInServiceInstances"}
+
+// addResourcesForAlarms adds the CloudWatch alarms configured for the nodegroup
+func (n *NodeGroupResourceSet) addResourcesForAlarms() {
+	alarms := n.spec.Alarms
+	if alarms == nil {
+		return
+	}
+
+	asgName := gfnt.MakeRef("NodeGroup")
+	alarmActions := gfnt.NewStringSlice(alarms.SNSTopicARN)
+	asgDimensions := func() []gfncloudwatch.Alarm_Dimension {
+		return []gfncloudwatch.Alarm_Dimension{{
+			Name:  gfnt.NewString("AutoScalingGroupName"),
+			Value: asgName,
+		}}
+	}
+	asgMetric := func(id, metricName string) gfncloudwatch.Alarm_MetricDataQuery {
+		return gfncloudwatch.Alarm_MetricDataQuery{
+			Id: gfnt.NewString(id),
+			MetricStat: &gfncloudwatch.Alarm_MetricStat{
+				Metric: &gfncloudwatch.Alarm_Metric{
+					Namespace:  gfnt.NewString("AWS/AutoScaling"),
+					MetricName: gfnt.NewString(metricName),
+					Dimensions: asgDimensions(),
+				},
+				Period: gfnt.NewInteger(300),
+				Stat:   gfnt.NewString("Average"),
+			},
+			ReturnData: gfnt.False(),
+		}
+	}
+
+	if api.IsEnabled(alarms.InServiceCapacity) {
+		n.newResource("InServiceCapacityAlarm", &gfncloudwatch.Alarm{
+			AlarmDescription: gfnt.NewString(fmt.Sprintf("In-service instances of nodegroup %q are below its desired capacity", n.spec.Name)),
+			AlarmActions:     alarmActions,
+			OKActions:        alarmActions,
+			Metrics: []gfncloudwatch.Alarm_MetricDataQuery{
+				asgMetric("desired", "GroupDesiredCapacity"),
+				asgMetric("inService", "GroupInServiceInstances"),
+				{
+					Id:         gfnt.NewString("missing"),
+					Expression: gfnt.NewString("desired - inService"),
+					Label:      gfnt.NewString("Instances missing from desired capacity"),
+					ReturnData: gfnt.True(),
+				},
+			},
+			ComparisonOperator: gfnt.NewString("GreaterThanThreshold"),
+			Threshold:          gfnt.NewDouble(0),
+			EvaluationPeriods:  gfnt.NewInteger(3),
+			TreatMissingData:   gfnt.NewString("missing"),
+		})
+	}
+
+	if api.IsEnabled(alarms.StatusCheckFailed) {
+		n.newResource("StatusCheckFailedAlarm", &gfncloudwatch.Alarm{
+			AlarmDescription:   gfnt.NewString(fmt.Sprintf("Instances of nodegroup %q are failing EC2 status checks", n.spec.Name)),
+			AlarmActions:       alarmActions,
+			OKActions:          alarmActions,
+			Namespace:          gfnt.NewString("AWS/EC2"),
+			MetricName:         gfnt.NewString("StatusCheckFailed"),
+			Dimensions:         asgDimensions(),
+			Statistic:          gfnt.NewString("Sum"),
+			Period:             gfnt.NewInteger(300),
+			EvaluationPeriods:  gfnt.NewInteger(1),
+			ComparisonOperator: gfnt.NewString("GreaterThanOrEqualToThreshold"),
+			Threshold:          gfnt.NewDouble(float64(*alarms.StatusCheckFailedThreshold)),
+			TreatMissingData:   gfnt.NewString("notBreaching"),
+		})
+	}
+
+	if api.IsEnabled(alarms.SpotInterruptions) {
+		rule := n.newResource("SpotInterruptionRule", &gfnevents.Rule{
+			Description: gfnt.NewString(fmt.Sprintf("Spot interruption warnings counted by the alarm of nodegroup %q", n.spec.Name)),
+			EventPattern: map[string][]string{
+				"source":      {"aws.ec2"},
+				"detail-type": {"EC2 Spot Instance Interruption Warning"},
+			},
+			State: gfnt.NewString("ENABLED"),
+		})
+		n.newResource("SpotInterruptionAlarm", &gfncloudwatch.Alarm{
+			AlarmDescription: gfnt.NewString(fmt.Sprintf("Spot interruption rate is high for nodegroup %q", n.spec.Name)),
+			AlarmActions:     alarmActions,
+			OKActions:        alarmActions,
+			Namespace:        gfnt.NewString("AWS/Events"),
+			MetricName:       gfnt.NewString("TriggeredRules"),
+			Dimensions: []gfncloudwatch.Alarm_Dimension{{
+				Name:  gfnt.NewString("RuleName"),
+				Value: rule,
+			}},
+			Statistic:          gfnt.NewString("Sum"),
+			Period:             gfnt.NewInteger(900),
+			EvaluationPeriods:  gfnt.NewInteger(1),
+			ComparisonOperator: gfnt.NewString("GreaterThanOrEqualToThreshold"),
+			Threshold:          gfnt.NewDouble(float64(*alarms.SpotInterruptionsThreshold)),
+			TreatMissingData:   gfnt.NewString("notBreaching"),
+		})
+	}
+}
+
+// withAlarmMetricsCollection adds the ASG metrics required by the nodegroup alarms to metricsCollection
+func withAlarmMetricsCollection(metricsCollection []api.MetricsCollection, alarms *api.NodeGroupAlarms) []api.MetricsCollection {
+	if alarms == nil || !api.IsEnabled(alarms.InServiceCapacity) {
+		return metricsCollection
+	}
+
+	collected := map[string]bool{}
+	for _, m := range metricsCollection {
+		if len(m.Metrics) == 0 {
+			// all metrics are already collected
+			return metricsCollection
+		}
+		for _, metric := range m.Metrics {
+			collected[metric] = true
+		}
+	}
+
+	var missing []string
+	for _, metric := range inServiceCapacityMetrics {
+		if !collected[metric] {
+			missing = append(missing, metric)
+		}
+	}
+	if len(missing) == 0 {
+		return metricsCollection
+	}
+
+	return append(append([]api.MetricsCollection{}, metricsCollection...), api.MetricsCollection{
+		Granularity: "1Minute",
+		Metrics:     missing,
+	})
+}
@@ -2,9 +2,11 @@ package builder_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -89,9 +91,42 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				cfg.KubernetesNetworkConfig.IPFamily = api.IPV4Family
 			})
 
-			When("an unmanaged nodegroup is created", func() {
-				It("returns an error", func() {
-					Expect(addErr).To(MatchError(ContainSubstring("unmanaged nodegroups are not supported with IPv6 clusters")))
+			It("should not error", func() {
+				Expect(addErr).NotTo(HaveOccurred())
+			})
+
+			It("assigns an IPv6 address to the primary network interface", func() {
+				properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+				Expect(properties.LaunchTemplateData.NetworkInterfaces).To(HaveLen(1))
+				Expect(properties.LaunchTemplateData.NetworkInterfaces[0].Ipv6AddressCount).To(Equal(1))
+			})
+
+			It("enables the IPv6 IMDS endpoint", func() {
+				properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+				Expect(properties.LaunchTemplateData.MetadataOptions.HTTPProtocolIpv6).To(Equal("enabled"))
+			})
+
+			It("allows ICMPv6 to the nodes", func() {
+				properties := ngTemplate.Resources["SG"].Properties
+				Expect(properties.SecurityGroupIngress).To(HaveLen(3))
+				Expect(properties.SecurityGroupIngress[2].IPProtocol).To(Equal("58"))
+				Expect(properties.SecurityGroupIngress[2].CidrIpv6).To(Equal("::/0"))
+				Expect(properties.SecurityGroupIngress[2].FromPort).To(Equal(float64(-1)))
+				Expect(properties.SecurityGroupIngress[2].ToPort).To(Equal(float64(-1)))
+			})
+
+			When("SSH is allowed from inside the VPC", func() {
+				BeforeEach(func() {
+					ng.SSH.Allow = api.Enabled()
+					ng.PrivateNetworking = true
+					cfg.VPC.IPv6Cidr = "2002::1234:abcd:ffff:c0a8:101/56"
+				})
+
+				It("allows SSH from the VPC IPv6 CIDR", func() {
+					properties := ngTemplate.Resources["SG"].Properties
+					Expect(properties.SecurityGroupIngress).To(HaveLen(5))
+					Expect(properties.SecurityGroupIngress[4].CidrIpv6).To(Equal("2002::1234:abcd:ffff:c0a8:101/56"))
+					Expect(properties.SecurityGroupIngress[4].FromPort).To(Equal(float64(22)))
 				})
 			})
 		})
@@ -384,6 +419,25 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("ng.ElasticIPs is set", func() {
+				BeforeEach(func() {
+					ng.ElasticIPs = &api.NodeGroupElasticIPs{AllocationIDs: []string{"eipalloc-1", "eipalloc-2"}}
+				})
+
+				It("allows nodes to associate Elastic IPs with themselves", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("PolicyElasticIPs"))
+					Expect(isRefTo(ngTemplate.Resources["PolicyElasticIPs"].Properties.Roles[0], "NodeInstanceRole")).To(BeTrue())
+					Expect(ngTemplate.Resources["PolicyElasticIPs"].Properties.PolicyDocument.Statement[0].Action).To(Equal([]string{
+						"ec2:DescribeAddresses",
+						"ec2:AssociateAddress",
+					}))
+				})
+
+				It("does not allocate Elastic IPs", func() {
+					Expect(ngTemplate.Resources).NotTo(HaveKey("NodeElasticIP0"))
+				})
+			})
+
 			Context("ng.WithAddonPolicies.CertManager is set", func() {
 				BeforeEach(func() {
 					ng.IAM.WithAddonPolicies.CertManager = aws.Bool(true)
@@ -538,6 +592,59 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 			})
 		})
 
+		Context("ng.SecurityGroups.WithShared and ng.SecurityGroups.WithLocal are disabled", func() {
+			mockSecurityGroup := func(groupID string, egressPort int32) {
+				ng.SecurityGroups.WithShared = aws.Bool(false)
+				ng.SecurityGroups.WithLocal = aws.Bool(false)
+				ng.SecurityGroups.AttachIDs = []string{groupID}
+				mockEC2.On("DescribeSecurityGroups", mock.Anything, &ec2.DescribeSecurityGroupsInput{
+					GroupIds: []string{groupID},
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []ec2types.SecurityGroup{
+						{
+							GroupId: aws.String(groupID),
+							IpPermissions: []ec2types.IpPermission{
+								{
+									IpProtocol: aws.String("tcp"),
+									FromPort:   aws.Int32(10250),
+									ToPort:     aws.Int32(10250),
+								},
+							},
+							IpPermissionsEgress: []ec2types.IpPermission{
+								{
+									IpProtocol: aws.String("tcp"),
+									FromPort:   aws.Int32(egressPort),
+									ToPort:     aws.Int32(egressPort),
+								},
+							},
+						},
+					},
+				}, nil)
+			}
+
+			Context("the attached security groups allow traffic to and from the control plane", func() {
+				BeforeEach(func() {
+					mockSecurityGroup("sg-regulated", 443)
+				})
+
+				It("only attaches the supplied security groups", func() {
+					Expect(addErr).NotTo(HaveOccurred())
+					Expect(ngTemplate.Resources).NotTo(HaveKey("SG"))
+					Expect(ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.NetworkInterfaces[0].Groups).To(Equal([]interface{}{"sg-regulated"}))
+				})
+			})
+
+			Context("the attached security groups do not allow nodes to reach the Kubernetes API", func() {
+				BeforeEach(func() {
+					mockSecurityGroup("sg-isolated", 8443)
+				})
+
+				It("returns an error", func() {
+					Expect(addErr).To(MatchError(ContainSubstring("security groups [sg-isolated] must allow outbound TCP traffic on port 443")))
+				})
+			})
+		})
+
 		Context("adding security group resources", func() {
 			var (
 				vpcID = "some-vpc"
@@ -685,6 +792,16 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("userdata exceeds the size accepted by EC2", func() {
+				BeforeEach(func() {
+					fakeBootstrapper.UserDataReturns(base64.StdEncoding.EncodeToString(make([]byte, 16*1024+1)), nil)
+				})
+
+				It("returns an error", func() {
+					Expect(addErr).To(MatchError(ContainSubstring(`user data of nodegroup "ng-abcd1234" is 16385 bytes, which exceeds the maximum of 16384 bytes accepted by EC2`)))
+				})
+			})
+
 			Context("ng.DisableIMDSv1 is enabled", func() {
 				BeforeEach(func() {
 					ng.DisableIMDSv1 = aws.Bool(true)
@@ -765,6 +882,89 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
 					Expect(properties.LaunchTemplateData.Placement.GroupName).To(Equal("one-direction"))
 				})
+
+				Context("ng.Tenancy is dedicated", func() {
+					BeforeEach(func() {
+						ng.Tenancy = api.TenancyDedicated
+					})
+
+					It("sets the tenancy alongside the placement group", func() {
+						properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+						Expect(properties.LaunchTemplateData.Placement.GroupName).To(Equal("one-direction"))
+						Expect(properties.LaunchTemplateData.Placement.Tenancy).To(Equal("dedicated"))
+						Expect(properties.LaunchTemplateData.Placement.HostResourceGroupArn).To(BeEmpty())
+					})
+				})
+			})
+
+			Context("ng.ElasticIPs.Allocate is enabled", func() {
+				BeforeEach(func() {
+					ng.MaxSize = aws.Int(2)
+					ng.ElasticIPs = &api.NodeGroupElasticIPs{
+						Allocate:       aws.Bool(true),
+						PublicIPv4Pool: "ipv4pool-ec2-0123456789abcdef0",
+					}
+				})
+
+				It("allocates an Elastic IP for each node the nodegroup can scale to", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("NodeElasticIP0"))
+					Expect(ngTemplate.Resources).To(HaveKey("NodeElasticIP1"))
+					Expect(ngTemplate.Resources).NotTo(HaveKey("NodeElasticIP2"))
+
+					eip := ngTemplate.Resources["NodeElasticIP1"]
+					Expect(eip.Type).To(Equal("AWS::EC2::EIP"))
+					Expect(eip.Properties.Domain).To(Equal("vpc"))
+					Expect(eip.Properties.PublicIpv4Pool).To(Equal("ipv4pool-ec2-0123456789abcdef0"))
+					Expect(eip.Properties.Tags).To(ContainElements(
+						fakes.Tag{Key: "alpha.eksctl.io/cluster-name", Value: "bonsai"},
+						fakes.Tag{Key: "alpha.eksctl.io/nodegroup-name", Value: "ng-abcd1234"},
+					))
+				})
+			})
+
+			Context("ng.Tenancy is host", func() {
+				BeforeEach(func() {
+					ng.Tenancy = api.TenancyHost
+					ng.HostResourceGroupARN = "arn:aws:resource-groups:us-west-2:123456789012:group/byol-hosts"
+				})
+
+				// the EC2 mock is shared by all specs, so each case uses its own instance type
+				mockDedicatedHostsSupported := func(instanceType ec2types.InstanceType, supported bool) {
+					ng.InstanceType = string(instanceType)
+					mockEC2.On("DescribeInstanceTypes", mock.Anything, &ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []ec2types.InstanceType{instanceType},
+					}).Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []ec2types.InstanceTypeInfo{
+							{
+								InstanceType:            instanceType,
+								DedicatedHostsSupported: aws.Bool(supported),
+							},
+						},
+					}, nil)
+				}
+
+				Context("the instance type supports Dedicated Hosts", func() {
+					BeforeEach(func() {
+						mockDedicatedHostsSupported(ec2types.InstanceTypeM5Xlarge, true)
+					})
+
+					It("launches instances in the host resource group", func() {
+						Expect(addErr).NotTo(HaveOccurred())
+						properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+						Expect(properties.LaunchTemplateData.Placement.Tenancy).To(Equal("host"))
+						Expect(properties.LaunchTemplateData.Placement.HostResourceGroupArn).To(Equal("arn:aws:resource-groups:us-west-2:123456789012:group/byol-hosts"))
+					})
+				})
+
+				Context("the instance type does not support Dedicated Hosts", func() {
+					BeforeEach(func() {
+						mockDedicatedHostsSupported(ec2types.InstanceTypeT3Micro, false)
+					})
+
+					It("returns an error", func() {
+						Expect(addErr).To(MatchError(ContainSubstring(`instance type t3.micro does not support Dedicated Hosts, which are required by tenancy "host"`)))
+					})
+				})
 			})
 
 			It("creates new NodeGroup resource", func() {
@@ -961,6 +1161,209 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("ng.Alarms is set", func() {
+				const topicARN = "arn:aws:sns:us-west-2:123456789012:nodegroup-alarms"
+
+				BeforeEach(func() {
+					ng.Alarms = &api.NodeGroupAlarms{SNSTopicARN: topicARN}
+					api.SetNodeGroupDefaults(ng, cfg.Metadata)
+				})
+
+				It("adds the in-service capacity and status check alarms", func() {
+					inService := ngTemplate.Resources["InServiceCapacityAlarm"]
+					Expect(inService.Type).To(Equal("AWS::CloudWatch::Alarm"))
+					Expect(inService.Properties.AlarmActions).To(Equal([]string{topicARN}))
+					Expect(inService.Properties.Metrics).To(HaveLen(3))
+					Expect(inService.Properties.Metrics[2]["Expression"]).To(Equal("desired - inService"))
+
+					statusCheck := ngTemplate.Resources["StatusCheckFailedAlarm"]
+					Expect(statusCheck.Type).To(Equal("AWS::CloudWatch::Alarm"))
+					Expect(statusCheck.Properties.MetricName).To(Equal("StatusCheckFailed"))
+					Expect(statusCheck.Properties.Threshold).To(Equal(float64(1)))
+
+					Expect(ngTemplate.Resources).NotTo(HaveKey("SpotInterruptionAlarm"))
+				})
+
+				It("collects the ASG metrics required by the in-service capacity alarm", func() {
+					Expect(ngTemplate.Resources["NodeGroup"].Properties.MetricsCollection).To(Equal([]map[string]interface{}{{
+						"Granularity": "1Minute",
+						"Metrics":     []interface{}{"GroupDesiredCapacity", "GroupInServiceInstances"},
+					}}))
+				})
+
+				Context("all ASG metrics are already collected", func() {
+					BeforeEach(func() {
+						ng.ASGMetricsCollection = []api.MetricsCollection{{Granularity: "1Minute"}}
+					})
+
+					It("does not add another metrics collection", func() {
+						Expect(ngTemplate.Resources["NodeGroup"].Properties.MetricsCollection).To(HaveLen(1))
+						Expect(ngTemplate.Resources["NodeGroup"].Properties.MetricsCollection[0]).NotTo(HaveKey("Metrics"))
+					})
+				})
+
+				Context("spot interruption alarms are enabled", func() {
+					BeforeEach(func() {
+						ng.Alarms.SpotInterruptions = api.Enabled()
+						ng.Alarms.SpotInterruptionsThreshold = aws.Int(3)
+					})
+
+					It("adds the spot interruption rule and alarm", func() {
+						Expect(ngTemplate.Resources["SpotInterruptionRule"].Type).To(Equal("AWS::Events::Rule"))
+						spot := ngTemplate.Resources["SpotInterruptionAlarm"]
+						Expect(spot.Properties.Namespace).To(Equal("AWS/Events"))
+						Expect(spot.Properties.Threshold).To(Equal(float64(3)))
+					})
+				})
+			})
+
+			Context("ng.ScheduledScaling is set", func() {
+				BeforeEach(func() {
+					ng.ScheduledScaling = []api.ScheduledScalingAction{
+						{Cron: "0 8 * * MON-FRI", MinSize: aws.Int(3), DesiredCapacity: aws.Int(3), TimeZone: "Europe/London"},
+						{Cron: "0 20 * * MON-FRI", MinSize: aws.Int(0), MaxSize: aws.Int(2), DesiredCapacity: aws.Int(0)},
+					}
+				})
+
+				It("adds a scheduled action to the ASG for each action", func() {
+					morning := ngTemplate.Resources["ScheduledScalingAction0"]
+					Expect(morning.Type).To(Equal("AWS::AutoScaling::ScheduledAction"))
+					Expect(morning.Properties.AutoScalingGroupName).To(Equal(map[string]interface{}{"Ref": "NodeGroup"}))
+					Expect(morning.Properties.Recurrence).To(Equal("0 8 * * MON-FRI"))
+					Expect(morning.Properties.TimeZone).To(Equal("Europe/London"))
+					Expect(morning.Properties.MinSize).To(Equal("3"))
+					Expect(morning.Properties.DesiredCapacity).To(Equal("3"))
+					Expect(morning.Properties.MaxSize).To(BeEmpty())
+
+					evening := ngTemplate.Resources["ScheduledScalingAction1"]
+					Expect(evening.Properties.Recurrence).To(Equal("0 20 * * MON-FRI"))
+					Expect(evening.Properties.TimeZone).To(BeEmpty())
+					Expect(evening.Properties.MaxSize).To(Equal("2"))
+				})
+			})
+
+			Context("ng.CapacityBlock is set", func() {
+				var (
+					reservation ec2types.CapacityReservation
+					start, end  time.Time
+				)
+
+				BeforeEach(func() {
+					start = time.Now().Add(2 * time.Hour)
+					end = start.Add(24 * time.Hour)
+					ng.InstanceType = "p5.48xlarge"
+					ng.MinSize = aws.Int(1)
+					ng.MaxSize = aws.Int(2)
+					ng.DesiredCapacity = aws.Int(2)
+					reservation = ec2types.CapacityReservation{
+						InstanceType:       aws.String("p5.48xlarge"),
+						AvailabilityZone:   aws.String(azA),
+						TotalInstanceCount: aws.Int32(2),
+						State:              "scheduled",
+					}
+				})
+
+				mockCapacityBlock := func(reservationID string) {
+					ng.CapacityBlock = &api.CapacityBlock{ReservationID: reservationID}
+					reservation.CapacityReservationId = aws.String(reservationID)
+					reservation.StartDate = aws.Time(start)
+					reservation.EndDate = aws.Time(end)
+					mockEC2.On("DescribeCapacityReservations", mock.Anything, &ec2.DescribeCapacityReservationsInput{
+						CapacityReservationIds: []string{reservationID},
+					}).Return(&ec2.DescribeCapacityReservationsOutput{
+						CapacityReservations: []ec2types.CapacityReservation{reservation},
+					}, nil)
+				}
+
+				Context("the capacity block has not started", func() {
+					BeforeEach(func() {
+						mockCapacityBlock("cr-00000000000000001")
+					})
+
+					It("launches instances in the capacity block", func() {
+						Expect(addErr).NotTo(HaveOccurred())
+						launchTemplateData := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData
+						Expect(launchTemplateData.InstanceMarketOptions.MarketType).To(Equal("capacity-block"))
+						Expect(launchTemplateData.CapacityReservationSpecification.CapacityReservationTarget.CapacityReservationID).To(Equal("cr-00000000000000001"))
+					})
+
+					It("restricts the nodegroup to the availability zone of the capacity block", func() {
+						Expect(ngTemplate.Resources["NodeGroup"].Properties.VPCZoneIdentifier).To(Equal([]interface{}{publicSubnet1}))
+					})
+
+					It("scales the nodegroup to zero outside the reservation period", func() {
+						asg := ngTemplate.Resources["NodeGroup"].Properties
+						Expect(asg.MinSize).To(Equal("0"))
+						Expect(asg.DesiredCapacity).To(Equal("0"))
+						Expect(asg.MaxSize).To(Equal("2"))
+
+						scaleUp := ngTemplate.Resources["CapacityBlockScaleUpAction"]
+						Expect(scaleUp.Type).To(Equal("AWS::AutoScaling::ScheduledAction"))
+						Expect(scaleUp.Properties.AutoScalingGroupName).To(Equal(map[string]interface{}{"Ref": "NodeGroup"}))
+						Expect(scaleUp.Properties.StartTime).To(Equal(start.UTC().Format("2006-01-02T15:04:05Z")))
+						Expect(scaleUp.Properties.MinSize).To(Equal("1"))
+						Expect(scaleUp.Properties.MaxSize).To(Equal("2"))
+						Expect(scaleUp.Properties.DesiredCapacity).To(Equal("2"))
+
+						scaleDown := ngTemplate.Resources["CapacityBlockScaleDownAction"]
+						Expect(scaleDown.Properties.StartTime).To(Equal(end.Add(-30 * time.Minute).UTC().Format("2006-01-02T15:04:05Z")))
+						Expect(scaleDown.Properties.MinSize).To(Equal("0"))
+						Expect(scaleDown.Properties.DesiredCapacity).To(Equal("0"))
+						Expect(scaleDown.Properties.MaxSize).To(BeEmpty())
+					})
+				})
+
+				Context("the capacity block is active", func() {
+					BeforeEach(func() {
+						start = time.Now().Add(-time.Hour)
+						reservation.State = ec2types.CapacityReservationStateActive
+						mockCapacityBlock("cr-00000000000000002")
+					})
+
+					It("only scales the nodegroup to zero at the end of the reservation period", func() {
+						Expect(addErr).NotTo(HaveOccurred())
+						Expect(ngTemplate.Resources["NodeGroup"].Properties.MinSize).To(Equal("1"))
+						Expect(ngTemplate.Resources["NodeGroup"].Properties.DesiredCapacity).To(Equal("2"))
+						Expect(ngTemplate.Resources).NotTo(HaveKey("CapacityBlockScaleUpAction"))
+						Expect(ngTemplate.Resources).To(HaveKey("CapacityBlockScaleDownAction"))
+					})
+				})
+
+				Context("the capacity block is about to end", func() {
+					BeforeEach(func() {
+						start = time.Now().Add(-24 * time.Hour)
+						end = time.Now().Add(10 * time.Minute)
+						mockCapacityBlock("cr-00000000000000003")
+					})
+
+					It("fails", func() {
+						Expect(addErr).To(MatchError(ContainSubstring(`capacity block "cr-00000000000000003" ends at`)))
+					})
+				})
+
+				Context("the capacity block reserves another instance type", func() {
+					BeforeEach(func() {
+						reservation.InstanceType = aws.String("p4d.24xlarge")
+						mockCapacityBlock("cr-00000000000000004")
+					})
+
+					It("fails", func() {
+						Expect(addErr).To(MatchError(`capacity block "cr-00000000000000004" reserves instance type "p4d.24xlarge" but nodegroup "ng-abcd1234" uses "p5.48xlarge"`))
+					})
+				})
+
+				Context("the nodegroup is larger than the capacity block", func() {
+					BeforeEach(func() {
+						ng.MaxSize = aws.Int(3)
+						mockCapacityBlock("cr-00000000000000005")
+					})
+
+					It("fails", func() {
+						Expect(addErr).To(MatchError(`maxSize of nodegroup "ng-abcd1234" (3) cannot be greater than the number of instances reserved by capacity block "cr-00000000000000005" (2)`))
+					})
+				})
+			})
+
 			Context("ng.ClassicLoadBalancerNames are set", func() {
 				BeforeEach(func() {
 					ng.ClassicLoadBalancerNames = []string{"what-a-classic"}
@@ -1081,6 +1484,53 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("nodeGroupDefaults.autoScaler is enabled", func() {
+				BeforeEach(func() {
+					cfg.NodeGroupDefaults = &api.NodeGroupDefaults{AutoScaler: api.Enabled()}
+					ng.Labels = map[string]string{
+						"test": "label",
+					}
+					ng.Taints = []api.NodeGroupTaint{
+						{
+							Key:   "taint-key",
+							Value: "taint-value",
+						},
+					}
+				})
+
+				It("appends the discovery and node-template tags to the ASG", func() {
+					tags := ngTemplate.Resources["NodeGroup"].Properties.Tags
+					Expect(tags).To(HaveLen(6))
+					Expect(tags).To(ContainElements(fakes.Tag{
+						Key:               "k8s.io/cluster-autoscaler/enabled",
+						Value:             "true",
+						PropagateAtLaunch: "true",
+					}, fakes.Tag{
+						Key:               "k8s.io/cluster-autoscaler/bonsai",
+						Value:             "owned",
+						PropagateAtLaunch: "true",
+					}, fakes.Tag{
+						Key:               "k8s.io/cluster-autoscaler/node-template/label/test",
+						Value:             "label",
+						PropagateAtLaunch: "true",
+					}, fakes.Tag{
+						Key:               "k8s.io/cluster-autoscaler/node-template/taints/taint-key",
+						Value:             "taint-value",
+						PropagateAtLaunch: "true",
+					}))
+				})
+
+				When("ng.IAM.WithAddonPolicies.AutoScaler is also enabled", func() {
+					BeforeEach(func() {
+						ng.IAM.WithAddonPolicies.AutoScaler = aws.Bool(true)
+					})
+
+					It("does not duplicate the discovery tags", func() {
+						Expect(ngTemplate.Resources["NodeGroup"].Properties.Tags).To(HaveLen(6))
+					})
+				})
+			})
+
 			Context("ng.SSH.PublicKeyName", func() {
 				BeforeEach(func() {
 					ng.SSH = &api.NodeGroupSSH{
@@ -1252,6 +1702,25 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 					Expect(properties.LaunchTemplateData.Monitoring.Enabled).To(Equal(true))
 				})
 			})
+
+			Context("ng.CPUOptions is set", func() {
+				BeforeEach(func() {
+					ng.CPUOptions = &api.CPUOptions{CoreCount: 24, ThreadsPerCore: 1}
+				})
+
+				It("sets the CPU options on the launch template", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.CPUOptions.CoreCount).To(Equal(24))
+					Expect(properties.LaunchTemplateData.CPUOptions.ThreadsPerCore).To(Equal(1))
+				})
+			})
+
+			Context("ng.CPUOptions is not set", func() {
+				It("leaves the CPU options of the instance type", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.CPUOptions).To(BeNil())
+				})
+			})
 		})
 	})
 
@@ -1316,6 +1785,36 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 			Expect(err).To(MatchError(ContainSubstring("nope")))
 		})
 
+		It("returns the subnets of the cluster's VPC matching the subnet selector", func() {
+			mockEC2 = &mocksv2.EC2{}
+			mockEC2.On("DescribeSubnets", mock.Anything, &ec2.DescribeSubnetsInput{
+				Filters: []ec2types.Filter{
+					{Name: aws.String("vpc-id"), Values: []string{cfg.VPC.ID}},
+					{Name: aws.String("tag:Name"), Values: []string{"prod-private-*"}},
+					{Name: aws.String("tag:tier"), Values: []string{"private"}},
+				},
+			}, mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+				Subnets: []ec2types.Subnet{
+					{SubnetId: aws.String("subnet-b")},
+					{SubnetId: aws.String("subnet-a")},
+				},
+			}, nil)
+			ngBase := ngBase.DeepCopy()
+			ngBase.SubnetSelector = map[string]string{"tier": "private", "Name": "prod-private-*"}
+			subnets, err := builder.AssignSubnets(context.Background(), ngBase, fakeVPCImporter, cfg, mockEC2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets).To(Equal(gfnt.NewStringSlice("subnet-a", "subnet-b")))
+		})
+
+		It("returns an error if no subnet matches the subnet selector", func() {
+			mockEC2 = &mocksv2.EC2{}
+			mockEC2.On("DescribeSubnets", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSubnetsOutput{}, nil)
+			ngBase := ngBase.DeepCopy()
+			ngBase.SubnetSelector = map[string]string{"tier": "private"}
+			_, err := builder.AssignSubnets(context.Background(), ngBase, fakeVPCImporter, cfg, mockEC2)
+			Expect(err).To(MatchError(ContainSubstring(`no subnets in vpc "" match subnetSelector map[tier:private]`)))
+		})
+
 		Context("when private networking is enabled", func() {
 			BeforeEach(func() {
 				fakeVPCImporter.SubnetsPrivateReturns(gfnt.NewString("subnet-2"))
@@ -1385,6 +1884,33 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 	})
 })
 
+var _ = Describe("ManagedNodeGroupClusterAutoscalerTags", func() {
+	var mng *api.ManagedNodeGroup
+
+	BeforeEach(func() {
+		mng = api.NewManagedNodeGroup()
+		mng.Labels = map[string]string{"role": "worker"}
+		mng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}}
+	})
+
+	It("returns the discovery and node-template tags", func() {
+		tags, err := builder.ManagedNodeGroupClusterAutoscalerTags("bonsai", mng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(Equal(map[string]string{
+			"k8s.io/cluster-autoscaler/enabled":                        "true",
+			"k8s.io/cluster-autoscaler/bonsai":                         "owned",
+			"k8s.io/cluster-autoscaler/node-template/label/role":       "worker",
+			"k8s.io/cluster-autoscaler/node-template/taints/dedicated": "gpu",
+		}))
+	})
+
+	It("errors when a taint and a label share a key", func() {
+		mng.Labels = map[string]string{"dedicated": "gpu"}
+		_, err := builder.ManagedNodeGroupClusterAutoscalerTags("bonsai", mng)
+		Expect(err).To(MatchError(ContainSubstring("duplicate key found for taints and labels")))
+	})
+})
+
 func newClusterAndNodeGroup() (*api.ClusterConfig, *api.NodeGroup) {
 	cfg := api.NewClusterConfig()
 	cfg.Metadata.Name = "bonsai"
@@ -4,24 +4,22 @@ import (
 	"fmt"
 
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
-var servicePrincipalPartitionMappings = map[string]map[string]string{
-	"aws": {
-		"EC2":            "ec2.amazonaws.com",
-		"EKS":            "eks.amazonaws.com",
-		"EKSFargatePods": "eks-fargate-pods.amazonaws.com",
-	},
-	"aws-us-gov": {
-		"EC2":            "ec2.amazonaws.com",
-		"EKS":            "eks.amazonaws.com",
-		"EKSFargatePods": "eks-fargate-pods.amazonaws.com",
-	},
-	"aws-cn": {
-		"EC2":            "ec2.amazonaws.com.cn",
-		"EKS":            "eks.amazonaws.com",
-		"EKSFargatePods": "eks-fargate-pods.amazonaws.com",
-	},
+var servicePrincipalPartitionMappings = makeServicePrincipalPartitionMappings(api.PartitionAWS, api.PartitionUSGov, api.PartitionChina)
+
+func makeServicePrincipalPartitionMappings(partitions ...string) map[string]map[string]string {
+	mappings := map[string]map[string]string{}
+	for _, partition := range partitions {
+		mappings[partition] = map[string]string{
+			"EC2":            api.ServicePrincipal(partition, api.ServicePrincipalEC2),
+			"EKS":            api.ServicePrincipal(partition, api.ServicePrincipalEKS),
+			"EKSFargatePods": api.ServicePrincipal(partition, api.ServicePrincipalEKSFargatePods),
+		}
+	}
+	return mappings
 }
 
 const servicePrincipalPartitionMapName = "ServicePrincipalPartitionMap"
@@ -0,0 +1,485 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	gfn "github.com/weaveworks/goformation/v4/cloudformation"
+	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
+	gfnssm "github.com/weaveworks/goformation/v4/cloudformation/ssm"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	scheduledDeletionDocumentName       = "ScheduledDeletionDocument"
+	scheduledDeletionAutomationRoleName = "ScheduledDeletionAutomationRole"
+	scheduledDeletionSchedulerRoleName  = "ScheduledDeletionSchedulerRole"
+	scheduledDeletionScheduleName       = "ScheduledDeletionSchedule"
+
+	// scheduledDeletionTimeFormat is the format of the time of at() schedule expressions
+	scheduledDeletionTimeFormat = "2006-01-02T15:04:05"
+)
+
+// scheduledDeletionScript deletes the stacks of a cluster in the order eksctl deletes them: the Fargate profiles
+// and all stacks but the cluster stack first, then the IAM OIDC provider and the cluster stack. Like eksctl, it
+// deletes the stacks with the CloudFormation service role if one is set.
+// Steps of automations time out after 600 seconds, so each handler stops waiting before then by raising an error,
+// and the step is attempted again to keep waiting
+const scheduledDeletionScript = `import time
+
+import boto3
+
+CLUSTER_NAME_TAG = "alpha.eksctl.io/cluster-name"
+WAIT_SECONDS = 540
+
+cfn = boto3.client("cloudformation")
+eks = boto3.client("eks")
+iam = boto3.client("iam")
+
+
+def check_deadline(deadline, what):
+    if time.time() > deadline:
+        raise TimeoutError(f"still waiting for the deletion of {what}")
+
+
+def cluster_stacks(cluster):
+    prefix = f"eksctl-{cluster}-"
+    for page in cfn.get_paginator("describe_stacks").paginate():
+        for stack in page["Stacks"]:
+            tags = {tag["Key"]: tag["Value"] for tag in stack.get("Tags", [])}
+            if stack["StackName"].startswith(prefix) and tags.get(CLUSTER_NAME_TAG) == cluster:
+                yield stack
+
+
+def delete_stacks(cluster, selected, deadline, role_arn):
+    requested = set()
+    while True:
+        stacks = [stack for stack in cluster_stacks(cluster) if selected(stack["StackName"])]
+        if not stacks:
+            return
+        for stack in stacks:
+            name, status = stack["StackName"], stack["StackStatus"]
+            if status == "DELETE_IN_PROGRESS":
+                continue
+            if name in requested:
+                raise RuntimeError(f"deleting stack {name} failed: {stack.get('StackStatusReason')}")
+            print(f"deleting stack {name}")
+            if role_arn:
+                cfn.delete_stack(StackName=name, RoleARN=role_arn)
+            else:
+                cfn.delete_stack(StackName=name)
+            requested.add(name)
+        check_deadline(deadline, ", ".join(stack["StackName"] for stack in stacks))
+        time.sleep(15)
+
+
+def delete_fargate_profiles(cluster, deadline):
+    # only one Fargate profile of a cluster can be deleted at a time
+    while True:
+        try:
+            names = []
+            for page in eks.get_paginator("list_fargate_profiles").paginate(clusterName=cluster):
+                names.extend(page["fargateProfileNames"])
+        except eks.exceptions.ResourceNotFoundException:
+            return
+        if not names:
+            return
+        statuses = [eks.describe_fargate_profile(clusterName=cluster, fargateProfileName=name)["fargateProfile"]["status"] for name in names]
+        if "DELETING" not in statuses:
+            print(f"deleting Fargate profile {names[0]}")
+            eks.delete_fargate_profile(clusterName=cluster, fargateProfileName=names[0])
+        check_deadline(deadline, "Fargate profiles")
+        time.sleep(15)
+
+
+def delete_oidc_provider(cluster):
+    try:
+        issuer = eks.describe_cluster(name=cluster)["cluster"].get("identity", {}).get("oidc", {}).get("issuer")
+    except eks.exceptions.ResourceNotFoundException:
+        return
+    if not issuer:
+        return
+    suffix = "oidc-provider/" + issuer.removeprefix("https://")
+    for provider in iam.list_open_id_connect_providers()["OpenIDConnectProviderList"]:
+        if provider["Arn"].endswith(suffix):
+            print(f"deleting IAM OIDC provider {provider['Arn']}")
+            iam.delete_open_id_connect_provider(OpenIDConnectProviderArn=provider["Arn"])
+
+
+def delete_dependents(events, context):
+    deadline = time.time() + WAIT_SECONDS
+    cluster = events["clusterName"]
+    kept = {events["clusterStackName"], events["stackName"]}
+    delete_fargate_profiles(cluster, deadline)
+    delete_stacks(cluster, lambda name: name not in kept, deadline, events["cfnRoleARN"])
+
+
+def delete_cluster(events, context):
+    deadline = time.time() + WAIT_SECONDS
+    cluster = events["clusterName"]
+    delete_oidc_provider(cluster)
+    delete_stacks(cluster, lambda name: name == events["clusterStackName"], deadline, events["cfnRoleARN"])
+`
+
+// scheduledDeletionReadActions are the read-only actions needed to delete the resources of the stacks eksctl creates
+var scheduledDeletionReadActions = []string{
+	"autoscaling:Describe*",
+	"budgets:ViewBudget",
+	"cloudwatch:DescribeAlarms",
+	"ec2:Describe*",
+	"eks:Describe*",
+	"eks:List*",
+	"events:DescribeRule",
+	"iam:Get*",
+	"iam:List*",
+	"lambda:GetFunction",
+	"logs:DescribeLogGroups",
+	"route53:Get*",
+	"route53:List*",
+	"scheduler:GetSchedule",
+	"ssm:DescribeDocument",
+}
+
+// scheduledDeletionTaggedActions are the actions needed to delete the resources of the stacks eksctl creates that
+// have the tags of their stack, they are only allowed on the resources tagged with the name of the cluster
+var scheduledDeletionTaggedActions = []string{
+	"autoscaling:DeleteAutoScalingGroup",
+	"autoscaling:DeleteLifecycleHook",
+	"autoscaling:DeleteScheduledAction",
+	"autoscaling:DeleteWarmPool",
+	"autoscaling:UpdateAutoScalingGroup",
+	"ec2:Delete*",
+	"ec2:Detach*",
+	"ec2:Disassociate*",
+	"ec2:ReleaseAddress",
+	"ec2:Revoke*",
+	"iam:DeleteRole",
+	"iam:DeleteRolePolicy",
+	"iam:DetachRolePolicy",
+}
+
+// scheduledDeletionNamedActions are the actions needed to delete the resources of the stacks eksctl creates that
+// are named after their stack, they are only allowed on the resources whose name starts with the prefix of the
+// stacks of the cluster
+var scheduledDeletionNamedActions = []string{
+	"budgets:ModifyBudget",
+	"cloudwatch:DeleteAlarms",
+	"events:DeleteRule",
+	"events:RemoveTargets",
+	"iam:DeleteInstanceProfile",
+	"iam:DeletePolicy",
+	"iam:DeletePolicyVersion",
+	"iam:DeleteRole",
+	"iam:DeleteRolePolicy",
+	"iam:DetachRolePolicy",
+	"iam:RemoveRoleFromInstanceProfile",
+	"lambda:DeleteFunction",
+	"lambda:RemovePermission",
+	"scheduler:DeleteSchedule",
+	"ssm:DeleteDocument",
+}
+
+// ScheduledDeletionResourceSet stores the resources that delete a cluster at a later time
+type ScheduledDeletionResourceSet struct {
+	rs               *resourceSet
+	clusterConfig    *api.ClusterConfig
+	clusterStackName string
+	cfnRoleARN       string
+	deleteAt         time.Time
+}
+
+// NewScheduledDeletionResourceSet returns a resource set that deletes the cluster whose stack is clusterStackName,
+// along with all its other stacks, at deleteAt. If cfnRoleARN is set, the stacks are deleted with this
+// CloudFormation service role, and the automation itself is only allowed to delete the stacks
+func NewScheduledDeletionResourceSet(clusterConfig *api.ClusterConfig, clusterStackName, cfnRoleARN string, deleteAt time.Time) *ScheduledDeletionResourceSet {
+	return &ScheduledDeletionResourceSet{
+		rs:               newResourceSet(),
+		clusterConfig:    clusterConfig,
+		clusterStackName: clusterStackName,
+		cfnRoleARN:       cfnRoleARN,
+		deleteAt:         deleteAt,
+	}
+}
+
+// AddAllResources adds all the resources for the scheduled deletion to the resource set: an EventBridge schedule
+// that starts, at the time of the deletion, an SSM automation deleting the stacks of the cluster and lastly the
+// stack of the resource set itself
+func (s *ScheduledDeletionResourceSet) AddAllResources() error {
+	if s.deleteAt.IsZero() {
+		return errors.New("the time of the deletion must be set")
+	}
+	if s.clusterStackName == "" {
+		return errors.New("the name of the cluster stack must be set")
+	}
+	s.rs.template.Description = fmt.Sprintf("Scheduled cluster deletion %s", templateDescriptionSuffix)
+
+	clusterName := s.clusterConfig.Metadata.Name
+	partition := api.Partition(s.clusterConfig.Metadata.Region)
+	s.rs.withIAM = true
+	s.newResource(scheduledDeletionAutomationRoleName, &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+			gfnt.NewString(api.ServicePrincipal(partition, api.ServicePrincipalSSM)),
+		),
+		Policies: []gfniam.Role_Policy{{
+			PolicyName:     makeName("DeleteCluster"),
+			PolicyDocument: cft.MakePolicyDocument(s.automationStatements()...),
+		}},
+	})
+
+	inputPayload := map[string]interface{}{
+		"clusterName":      clusterName,
+		"clusterStackName": s.clusterStackName,
+		"stackName":        gfnt.MakeRef("AWS::StackName"),
+		"cfnRoleARN":       s.cfnRoleARN,
+	}
+	deleteStackInputs := map[string]interface{}{
+		"Service":   "cloudformation",
+		"Api":       "DeleteStack",
+		"StackName": gfnt.MakeRef("AWS::StackName"),
+	}
+	if s.cfnRoleARN != "" {
+		deleteStackInputs["RoleARN"] = s.cfnRoleARN
+	}
+	s.newResource(scheduledDeletionDocumentName, &gfnssm.Document{
+		DocumentType: gfnt.NewString("Automation"),
+		Content: map[string]interface{}{
+			"schemaVersion": "0.3",
+			"description":   fmt.Sprintf("Deletes cluster %q and its stacks", clusterName),
+			"assumeRole":    gfnt.MakeFnGetAttString(scheduledDeletionAutomationRoleName, "Arn"),
+			"mainSteps": []map[string]interface{}{
+				{
+					"name":           "DeleteDependentStacks",
+					"action":         "aws:executeScript",
+					"timeoutSeconds": 600,
+					// nodegroups can take longer than a step to delete
+					"maxAttempts": 6,
+					"inputs": map[string]interface{}{
+						"Runtime":      pythonRuntime,
+						"Handler":      "delete_dependents",
+						"Script":       scheduledDeletionScript,
+						"InputPayload": inputPayload,
+					},
+				},
+				{
+					"name":           "DeleteClusterStack",
+					"action":         "aws:executeScript",
+					"timeoutSeconds": 600,
+					"maxAttempts":    4,
+					"inputs": map[string]interface{}{
+						"Runtime":      pythonRuntime,
+						"Handler":      "delete_cluster",
+						"Script":       scheduledDeletionScript,
+						"InputPayload": inputPayload,
+					},
+				},
+				{
+					"name":   "DeleteScheduledDeletionStack",
+					"action": "aws:executeAwsApi",
+					"isEnd":  true,
+					"inputs": deleteStackInputs,
+				},
+			},
+		},
+	})
+	s.newResource(scheduledDeletionSchedulerRoleName, &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+			gfnt.NewString(api.ServicePrincipal(partition, api.ServicePrincipalScheduler)),
+		),
+		Policies: []gfniam.Role_Policy{{
+			PolicyName: makeName("StartScheduledDeletion"),
+			PolicyDocument: cft.MakePolicyDocument(
+				cft.MapOfInterfaces{
+					"Effect":   "Allow",
+					"Action":   []string{"ssm:StartAutomationExecution"},
+					"Resource": gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:ssm:${AWS::Region}:${AWS::AccountId}:automation-definition/${%s}:*", scheduledDeletionDocumentName)),
+				},
+				cft.MapOfInterfaces{
+					"Effect":   "Allow",
+					"Action":   []string{"iam:PassRole"},
+					"Resource": gfnt.MakeFnGetAttString(scheduledDeletionAutomationRoleName, "Arn"),
+				},
+			),
+		}},
+	})
+
+	s.newResource(scheduledDeletionScheduleName, &awsCloudFormationResource{
+		Type: "AWS::Scheduler::Schedule",
+		Properties: map[string]interface{}{
+			"Description":                fmt.Sprintf("Deletion of cluster %q", clusterName),
+			"ScheduleExpression":         ScheduledDeletionExpression(s.deleteAt),
+			"ScheduleExpressionTimezone": "UTC",
+			"FlexibleTimeWindow": map[string]string{
+				"Mode": "OFF",
+			},
+			"Target": map[string]interface{}{
+				// a universal target, which calls the StartAutomationExecution API
+				"Arn":     gfnt.MakeFnSubString("arn:${AWS::Partition}:scheduler:::aws-sdk:ssm:startAutomationExecution"),
+				"RoleArn": gfnt.MakeFnGetAttString(scheduledDeletionSchedulerRoleName, "Arn"),
+				"Input":   gfnt.MakeFnSubString(fmt.Sprintf(`{"DocumentName":"${%s}"}`, scheduledDeletionDocumentName)),
+			},
+		},
+	})
+	return nil
+}
+
+// automationStatements returns the policy statements of the role of the automation. The automation deletes the
+// Fargate profiles, the IAM OIDC provider and the stacks of the cluster. Unless the stacks are deleted with a
+// CloudFormation service role, CloudFormation deletes their resources with the permissions of the automation,
+// which are limited to the resources of the cluster by their tags, names or ARNs
+func (s *ScheduledDeletionResourceSet) automationStatements() []cft.MapOfInterfaces {
+	clusterName := s.clusterConfig.Metadata.Name
+	stackPrefix := fmt.Sprintf("eksctl-%s-", clusterName)
+	taggedWithCluster := map[string]interface{}{
+		"StringEquals": map[string]string{
+			"aws:ResourceTag/" + api.ClusterNameTag: clusterName,
+		},
+	}
+	statements := []cft.MapOfInterfaces{
+		{
+			"Effect":   "Allow",
+			"Action":   []string{"cloudformation:DescribeStacks"},
+			"Resource": "*",
+		},
+		{
+			"Effect":    "Allow",
+			"Action":    []string{"cloudformation:DeleteStack"},
+			"Resource":  gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:cloudformation:${AWS::Region}:${AWS::AccountId}:stack/%s*/*", stackPrefix)),
+			"Condition": taggedWithCluster,
+		},
+		{
+			"Effect": "Allow",
+			"Action": []string{
+				"eks:DescribeCluster",
+				"eks:ListFargateProfiles",
+			},
+			"Resource": gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:eks:${AWS::Region}:${AWS::AccountId}:cluster/%s", clusterName)),
+		},
+		{
+			"Effect": "Allow",
+			"Action": []string{
+				"eks:DescribeFargateProfile",
+				"eks:DeleteFargateProfile",
+			},
+			"Resource": gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:eks:${AWS::Region}:${AWS::AccountId}:fargateprofile/%s/*", clusterName)),
+		},
+		{
+			"Effect":   "Allow",
+			"Action":   []string{"iam:ListOpenIDConnectProviders"},
+			"Resource": "*",
+		},
+		{
+			"Effect":    "Allow",
+			"Action":    []string{"iam:DeleteOpenIDConnectProvider"},
+			"Resource":  gfnt.MakeFnSubString("arn:${AWS::Partition}:iam::${AWS::AccountId}:oidc-provider/*"),
+			"Condition": taggedWithCluster,
+		},
+	}
+	if s.cfnRoleARN != "" {
+		return append(statements, cft.MapOfInterfaces{
+			"Effect":   "Allow",
+			"Action":   []string{"iam:PassRole"},
+			"Resource": s.cfnRoleARN,
+			"Condition": map[string]interface{}{
+				"StringEquals": map[string]string{
+					"iam:PassedToService": "cloudformation.amazonaws.com",
+				},
+			},
+		})
+	}
+
+	return append(statements,
+		cft.MapOfInterfaces{
+			"Effect":   "Allow",
+			"Action":   scheduledDeletionReadActions,
+			"Resource": "*",
+		},
+		cft.MapOfInterfaces{
+			"Effect":    "Allow",
+			"Action":    scheduledDeletionTaggedActions,
+			"Resource":  "*",
+			"Condition": taggedWithCluster,
+		},
+		cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": scheduledDeletionNamedActions,
+			"Resource": []*gfnt.Value{
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:budgets::${AWS::AccountId}:budget/%s*", stackPrefix)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:cloudwatch:${AWS::Region}:${AWS::AccountId}:alarm:%s*", stackPrefix)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:events:${AWS::Region}:${AWS::AccountId}:rule/%s*", stackPrefix)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:iam::${AWS::AccountId}:instance-profile/%s*", stackPrefix)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:iam::${AWS::AccountId}:policy/%s*", stackPrefix)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:iam::${AWS::AccountId}:role/%s*", stackPrefix)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:lambda:${AWS::Region}:${AWS::AccountId}:function:%s*", stackPrefix)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:scheduler:${AWS::Region}:${AWS::AccountId}:schedule/*/%s*", stackPrefix)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:ssm:${AWS::Region}:${AWS::AccountId}:document/%s*", stackPrefix)),
+			},
+		},
+		cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": []string{
+				"eks:DeleteCluster",
+				"eks:DeleteNodegroup",
+			},
+			"Resource": []*gfnt.Value{
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:eks:${AWS::Region}:${AWS::AccountId}:cluster/%s", clusterName)),
+				gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:eks:${AWS::Region}:${AWS::AccountId}:nodegroup/%s/*", clusterName)),
+			},
+		},
+		cft.MapOfInterfaces{
+			"Effect":   "Allow",
+			"Action":   []string{"logs:DeleteLogGroup"},
+			"Resource": gfnt.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:logs:${AWS::Region}:${AWS::AccountId}:log-group:/aws/eks/%s/*", clusterName)),
+		},
+		// the CNAME record of the endpoint DNS name, in a hosted zone that isn't managed by eksctl
+		cft.MapOfInterfaces{
+			"Effect":   "Allow",
+			"Action":   []string{"route53:ChangeResourceRecordSets"},
+			"Resource": gfnt.MakeFnSubString("arn:${AWS::Partition}:route53:::hostedzone/*"),
+			"Condition": map[string]interface{}{
+				"ForAllValues:StringEquals": map[string][]string{
+					"route53:ChangeResourceRecordSetsActions":     {"DELETE"},
+					"route53:ChangeResourceRecordSetsRecordTypes": {"CNAME"},
+				},
+			},
+		},
+	)
+}
+
+// ScheduledDeletionExpression returns the one-time EventBridge Scheduler expression of a deletion at deleteAt
+func ScheduledDeletionExpression(deleteAt time.Time) string {
+	return fmt.Sprintf("at(%s)", deleteAt.UTC().Format(scheduledDeletionTimeFormat))
+}
+
+// RenderJSON returns the rendered JSON
+func (s *ScheduledDeletionResourceSet) RenderJSON() ([]byte, error) {
+	return s.rs.renderJSON()
+}
+
+// Template returns the CloudFormation template
+func (s *ScheduledDeletionResourceSet) Template() gfn.Template {
+	return *s.rs.template
+}
+
+func (s *ScheduledDeletionResourceSet) newResource(name string, resource gfn.Resource) *gfnt.Value {
+	return s.rs.newResource(name, resource)
+}
+
+// WithIAM implements the ResourceSet interface
+func (s *ScheduledDeletionResourceSet) WithIAM() bool {
+	return s.rs.withIAM
+}
+
+// WithNamedIAM implements the ResourceSet interface
+func (s *ScheduledDeletionResourceSet) WithNamedIAM() bool {
+	return false
+}
+
+// GetAllOutputs collects all outputs of the resource set
+func (s *ScheduledDeletionResourceSet) GetAllOutputs(stack types.Stack) error {
+	return s.rs.GetAllOutputs(stack)
+}
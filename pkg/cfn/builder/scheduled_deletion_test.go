@@ -0,0 +1,151 @@
+package builder
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+func TestScheduledDeletion(t *testing.T) {
+	require := require.New(t)
+	clusterConfig := api.NewClusterConfig()
+	clusterConfig.Metadata.Name = "cluster"
+	clusterConfig.Metadata.Region = "us-west-2"
+
+	deleteAt := time.Date(2022, 5, 2, 9, 30, 0, 0, time.FixedZone("", 2*60*60))
+	stack := NewScheduledDeletionResourceSet(clusterConfig, "eksctl-cluster-cluster", "", deleteAt)
+	require.NoError(stack.AddAllResources())
+	require.True(stack.WithIAM())
+
+	template := renderScheduledDeletion(t, stack)
+
+	automationRole := template.Resources[scheduledDeletionAutomationRoleName]
+	require.Equal("AWS::IAM::Role", automationRole.Type)
+	statements := automationRole.Properties.Policies[0].PolicyDocument.Statement
+	require.Equal([]string{"cloudformation:DeleteStack"}, statements[1].Action)
+	require.Equal(map[string]interface{}{
+		"Fn::Sub": "arn:${AWS::Partition}:cloudformation:${AWS::Region}:${AWS::AccountId}:stack/eksctl-cluster-*/*",
+	}, statements[1].Resource)
+	require.Equal(map[string]interface{}{
+		"StringEquals": map[string]interface{}{"aws:ResourceTag/alpha.eksctl.io/cluster-name": "cluster"},
+	}, statements[1].Condition)
+	for _, statement := range statements {
+		if statement.Resource != "*" || statement.Condition != nil {
+			continue
+		}
+		// only reading is allowed on all resources
+		for _, action := range statement.Action {
+			require.Regexp(`:(Describe|Get|List|View)`, action)
+		}
+	}
+
+	document := template.Resources[scheduledDeletionDocumentName]
+	require.Equal("AWS::SSM::Document", document.Type)
+	require.Equal("Automation", document.Properties.DocumentType)
+	require.Equal(map[string]interface{}{"Fn::GetAtt": []interface{}{scheduledDeletionAutomationRoleName, "Arn"}}, document.Properties.Content.AssumeRole)
+	steps := document.Properties.Content.MainSteps
+	require.Len(steps, 3)
+	require.Equal("delete_dependents", steps[0].Inputs.Handler)
+	require.Equal(map[string]interface{}{
+		"clusterName":      "cluster",
+		"clusterStackName": "eksctl-cluster-cluster",
+		"stackName":        map[string]interface{}{"Ref": "AWS::StackName"},
+		"cfnRoleARN":       "",
+	}, steps[0].Inputs.InputPayload)
+	require.Equal("delete_cluster", steps[1].Inputs.Handler)
+	require.Equal("aws:executeAwsApi", steps[2].Action)
+	require.Equal(map[string]interface{}{"Ref": "AWS::StackName"}, steps[2].Inputs.StackName)
+	require.Empty(steps[2].Inputs.RoleARN)
+
+	schedule := template.Resources[scheduledDeletionScheduleName]
+	require.Equal("AWS::Scheduler::Schedule", schedule.Type)
+	require.Equal("at(2022-05-02T07:30:00)", schedule.Properties.ScheduleExpression)
+	require.Equal("UTC", schedule.Properties.ScheduleExpressionTimezone)
+	require.Equal(map[string]interface{}{"Fn::Sub": "arn:${AWS::Partition}:scheduler:::aws-sdk:ssm:startAutomationExecution"}, schedule.Properties.Target.Arn)
+	require.Equal(map[string]interface{}{"Fn::GetAtt": []interface{}{scheduledDeletionSchedulerRoleName, "Arn"}}, schedule.Properties.Target.RoleArn)
+	require.Equal(map[string]interface{}{"Fn::Sub": `{"DocumentName":"${ScheduledDeletionDocument}"}`}, schedule.Properties.Target.Input)
+}
+
+func TestScheduledDeletionWithCloudFormationRole(t *testing.T) {
+	require := require.New(t)
+	clusterConfig := api.NewClusterConfig()
+	clusterConfig.Metadata.Name = "cluster"
+	clusterConfig.Metadata.Region = "us-west-2"
+
+	const cfnRoleARN = "arn:aws:iam::123456789012:role/cfn-service-role"
+	stack := NewScheduledDeletionResourceSet(clusterConfig, "eksctl-cluster-cluster", cfnRoleARN, time.Now().Add(time.Hour))
+	require.NoError(stack.AddAllResources())
+	template := renderScheduledDeletion(t, stack)
+
+	// CloudFormation deletes the resources of the stacks with its service role
+	var actions []string
+	for _, statement := range template.Resources[scheduledDeletionAutomationRoleName].Properties.Policies[0].PolicyDocument.Statement {
+		actions = append(actions, statement.Action...)
+		if statement.Action[0] == "iam:PassRole" {
+			require.Equal(cfnRoleARN, statement.Resource)
+		}
+	}
+	require.Contains(actions, "iam:PassRole")
+	require.NotContains(actions, "ec2:Delete*")
+	require.NotContains(actions, "eks:DeleteCluster")
+
+	steps := template.Resources[scheduledDeletionDocumentName].Properties.Content.MainSteps
+	require.Equal(cfnRoleARN, steps[0].Inputs.InputPayload["cfnRoleARN"])
+	require.Equal(cfnRoleARN, steps[2].Inputs.RoleARN)
+}
+
+type scheduledDeletionTemplate struct {
+	Resources map[string]struct {
+		Type       string
+		Properties struct {
+			Policies []struct {
+				PolicyDocument struct {
+					Statement []struct {
+						Action    []string
+						Resource  interface{}
+						Condition map[string]interface{}
+					}
+				}
+			}
+			DocumentType string
+			Content      struct {
+				AssumeRole interface{}
+				MainSteps  []struct {
+					Name        string
+					Action      string
+					MaxAttempts int
+					Inputs      struct {
+						Handler      string
+						InputPayload map[string]interface{}
+						StackName    interface{}
+						RoleARN      string
+					}
+				}
+			}
+			ScheduleExpression         string
+			ScheduleExpressionTimezone string
+			Target                     struct {
+				Arn, RoleArn, Input interface{}
+			}
+		}
+	}
+}
+
+func renderScheduledDeletion(t *testing.T, stack *ScheduledDeletionResourceSet) scheduledDeletionTemplate {
+	bytes, err := stack.RenderJSON()
+	require.NoError(t, err)
+	var template scheduledDeletionTemplate
+	require.NoError(t, json.Unmarshal(bytes, &template))
+	return template
+}
+
+func TestScheduledDeletionRequiresTime(t *testing.T) {
+	clusterConfig := api.NewClusterConfig()
+	clusterConfig.Metadata.Name = "cluster"
+	stack := NewScheduledDeletionResourceSet(clusterConfig, "eksctl-cluster-cluster", "", time.Time{})
+	require.EqualError(t, stack.AddAllResources(), "the time of the deletion must be set")
+}
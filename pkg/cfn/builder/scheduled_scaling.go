@@ -0,0 +1,150 @@
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gfnautoscaling "github.com/weaveworks/goformation/v4/cloudformation/autoscaling"
+	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
+	gfnlambda "github.com/weaveworks/goformation/v4/cloudformation/lambda"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	scheduledScalingFunctionName      = "ScheduledScalingFunction"
+	scheduledScalingFunctionRoleName  = "ScheduledScalingFunctionRole"
+	scheduledScalingSchedulerRoleName = "ScheduledScalingSchedulerRole"
+)
+
+// scheduledScalingFunctionCode updates the scaling config of the managed nodegroup given in the event of a schedule
+const scheduledScalingFunctionCode = `import boto3
+
+
+def handler(event, context):
+    boto3.client("eks").update_nodegroup_config(
+        clusterName=event["clusterName"],
+        nodegroupName=event["nodegroupName"],
+        scalingConfig=event["scalingConfig"],
+    )
+`
+
+// addResourcesForScheduledScaling adds a scheduled action to the ASG of the nodegroup for each scheduled scaling action
+func (n *NodeGroupResourceSet) addResourcesForScheduledScaling() {
+	for i, action := range n.spec.ScheduledScaling {
+		scheduledAction := &gfnautoscaling.ScheduledAction{
+			AutoScalingGroupName: gfnt.MakeRef("NodeGroup"),
+			Recurrence:           gfnt.NewString(action.Cron),
+		}
+		if action.MinSize != nil {
+			scheduledAction.MinSize = gfnt.NewString(fmt.Sprintf("%d", *action.MinSize))
+		}
+		if action.MaxSize != nil {
+			scheduledAction.MaxSize = gfnt.NewString(fmt.Sprintf("%d", *action.MaxSize))
+		}
+		if action.DesiredCapacity != nil {
+			scheduledAction.DesiredCapacity = gfnt.NewString(fmt.Sprintf("%d", *action.DesiredCapacity))
+		}
+		if action.TimeZone != "" {
+			scheduledAction.TimeZone = gfnt.NewString(action.TimeZone)
+		}
+		n.newResource(fmt.Sprintf("ScheduledScalingAction%d", i), scheduledAction)
+	}
+}
+
+// addResourcesForScheduledScaling adds an EventBridge Scheduler schedule for each scheduled scaling action,
+// which invokes a Lambda function that updates the scaling config of the managed nodegroup
+func (m *ManagedNodeGroupResourceSet) addResourcesForScheduledScaling() error {
+	actions := m.nodeGroup.ScheduledScaling
+	if len(actions) == 0 {
+		return nil
+	}
+
+	partition := api.Partition(m.clusterConfig.Metadata.Region)
+	m.newResource(scheduledScalingFunctionRoleName, &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+			gfnt.NewString(api.ServicePrincipal(partition, api.ServicePrincipalLambda)),
+		),
+		ManagedPolicyArns: gfnt.NewSlice(makePolicyARNs("service-role/AWSLambdaBasicExecutionRole")...),
+		Policies: []gfniam.Role_Policy{{
+			PolicyName: makeName("UpdateNodegroupConfig"),
+			PolicyDocument: cft.MakePolicyDocument(cft.MapOfInterfaces{
+				"Effect":   "Allow",
+				"Action":   []string{"eks:UpdateNodegroupConfig"},
+				"Resource": gfnt.MakeFnGetAttString(ManagedNodeGroupResourceName, "Arn"),
+			}),
+		}},
+	})
+	m.newResource(scheduledScalingFunctionName, &gfnlambda.Function{
+		Description: gfnt.NewString(fmt.Sprintf("Scheduled scaling of managed nodegroup %q", m.nodeGroup.Name)),
+		Handler:     gfnt.NewString("index.handler"),
+		Runtime:     gfnt.NewString(pythonRuntime),
+		Timeout:     gfnt.NewInteger(30),
+		Role:        gfnt.MakeFnGetAttString(scheduledScalingFunctionRoleName, "Arn"),
+		Code: &gfnlambda.Function_Code{
+			ZipFile: gfnt.NewString(scheduledScalingFunctionCode),
+		},
+	})
+	m.newResource(scheduledScalingSchedulerRoleName, &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+			gfnt.NewString(api.ServicePrincipal(partition, api.ServicePrincipalScheduler)),
+		),
+		Policies: []gfniam.Role_Policy{{
+			PolicyName: makeName("InvokeScheduledScalingFunction"),
+			PolicyDocument: cft.MakePolicyDocument(cft.MapOfInterfaces{
+				"Effect":   "Allow",
+				"Action":   []string{"lambda:InvokeFunction"},
+				"Resource": gfnt.MakeFnGetAttString(scheduledScalingFunctionName, "Arn"),
+			}),
+		}},
+	})
+
+	for i, action := range actions {
+		scheduleExpression, err := action.ScheduleExpression()
+		if err != nil {
+			return err
+		}
+
+		scalingConfig := map[string]int{}
+		if action.MinSize != nil {
+			scalingConfig["minSize"] = *action.MinSize
+		}
+		if action.MaxSize != nil {
+			scalingConfig["maxSize"] = *action.MaxSize
+		}
+		if action.DesiredCapacity != nil {
+			scalingConfig["desiredSize"] = *action.DesiredCapacity
+		}
+		input, err := json.Marshal(map[string]interface{}{
+			"clusterName":   m.clusterConfig.Metadata.Name,
+			"nodegroupName": m.nodeGroup.Name,
+			"scalingConfig": scalingConfig,
+		})
+		if err != nil {
+			return err
+		}
+
+		properties := map[string]interface{}{
+			"Description":        fmt.Sprintf("Scheduled scaling of managed nodegroup %q at %q", m.nodeGroup.Name, action.Cron),
+			"ScheduleExpression": scheduleExpression,
+			"FlexibleTimeWindow": map[string]string{
+				"Mode": "OFF",
+			},
+			"Target": map[string]interface{}{
+				"Arn":     gfnt.MakeFnGetAttString(scheduledScalingFunctionName, "Arn"),
+				"RoleArn": gfnt.MakeFnGetAttString(scheduledScalingSchedulerRoleName, "Arn"),
+				"Input":   string(input),
+			},
+		}
+		if action.TimeZone != "" {
+			properties["ScheduleExpressionTimezone"] = action.TimeZone
+		}
+		m.newResource(fmt.Sprintf("ScheduledScalingSchedule%d", i), &awsCloudFormationResource{
+			Type:       "AWS::Scheduler::Schedule",
+			Properties: properties,
+		})
+	}
+	return nil
+}
@@ -550,3 +550,16 @@ func efsCSIControllerStatements() []cft.MapOfInterfaces {
 		},
 	}
 }
+
+func elasticIPsStatements() []cft.MapOfInterfaces {
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"ec2:DescribeAddresses",
+				"ec2:AssociateAddress",
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 )
 
@@ -22,6 +23,10 @@ const (
 	NATGatewayKey                = "NATGateway"
 	ElasticIPKey                 = "EIP"
 
+	// DNS
+	DHCPOptionsKey            = "DHCPOptions"
+	DHCPOptionsAssociationKey = "VPCDHCPOptionsAssociation"
+
 	// CIDRs
 	IPv6CIDRBlockKey = "IPv6CidrBlock"
 	InternetCIDR     = "0.0.0.0/0"
@@ -54,6 +59,26 @@ type VPCResourceSet interface {
 	CreateTemplate(ctx context.Context) (vpcID *gfnt.Value, subnetDetails *SubnetDetails, err error)
 }
 
+// addDHCPOptions associates the custom DHCP options of dns, if any, with the VPC
+func (rs *resourceSet) addDHCPOptions(dns *api.ClusterVPCDNS, vpcID *gfnt.Value) {
+	if !dns.HasCustomDHCPOptions() {
+		return
+	}
+	dhcpOptions := &gfnec2.DHCPOptions{
+		DomainNameServers: gfnt.NewStringSlice(api.AmazonProvidedDNS),
+	}
+	if dns.DomainName != "" {
+		dhcpOptions.DomainName = gfnt.NewString(dns.DomainName)
+	}
+	if len(dns.DomainNameServers) > 0 {
+		dhcpOptions.DomainNameServers = gfnt.NewStringSlice(dns.DomainNameServers...)
+	}
+	rs.newResource(DHCPOptionsAssociationKey, &gfnec2.VPCDHCPOptionsAssociation{
+		DhcpOptionsId: rs.newResource(DHCPOptionsKey, dhcpOptions),
+		VpcId:         vpcID,
+	})
+}
+
 func formatAZ(az string) string {
 	return strings.ToUpper(strings.ReplaceAll(az, "-", ""))
 }
@@ -0,0 +1,76 @@
+package builder
+
+import (
+	"fmt"
+
+	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
+	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
+	gfnlogs "github.com/weaveworks/goformation/v4/cloudformation/logs"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	// FlowLogKey is the name of the flow log of the VPC
+	FlowLogKey               = "FlowLog"
+	flowLogsLogGroupKey      = "FlowLogsLogGroup"
+	flowLogsRoleKey          = "FlowLogsRole"
+	flowLogsServicePrincipal = "vpc-flow-logs.amazonaws.com"
+)
+
+// addResourcesForFlowLogs adds a flow log capturing the traffic of the VPC, along with the log group and the role
+// delivering the records to it for the cloudwatch destination
+func (c *ClusterResourceSet) addResourcesForFlowLogs(vpcID *gfnt.Value) {
+	flowLogs := c.spec.VPC.FlowLogs
+	flowLog := &gfnec2.FlowLog{
+		ResourceId:   vpcID,
+		ResourceType: gfnt.NewString("VPC"),
+		TrafficType:  gfnt.NewString(flowLogs.TrafficType),
+	}
+	if flowLogs.LogFormat != "" {
+		flowLog.LogFormat = gfnt.NewString(flowLogs.LogFormat)
+	}
+
+	switch flowLogs.Destination {
+	case api.FlowLogsDestinationS3:
+		flowLog.LogDestinationType = gfnt.NewString("s3")
+		flowLog.LogDestination = gfnt.NewString(flowLogs.S3BucketARN)
+	default:
+		logGroup := &gfnlogs.LogGroup{
+			LogGroupName: gfnt.NewString(fmt.Sprintf("/aws/eks/%s/vpc-flow-logs", c.spec.Metadata.Name)),
+		}
+		if flowLogs.LogRetentionInDays != 0 {
+			logGroup.RetentionInDays = gfnt.NewInteger(flowLogs.LogRetentionInDays)
+		}
+		c.newResource(flowLogsLogGroupKey, logGroup)
+		logGroupARN := gfnt.MakeFnGetAttString(flowLogsLogGroupKey, "Arn")
+
+		refRole := c.newResource(flowLogsRoleKey, &gfniam.Role{
+			AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+				gfnt.NewString(flowLogsServicePrincipal),
+			),
+		})
+		c.rs.attachAllowPolicy("PolicyFlowLogsDelivery", refRole, []cft.MapOfInterfaces{
+			{
+				"Effect":   effectAllow,
+				"Resource": logGroupARN,
+				"Action": []string{
+					"logs:CreateLogStream",
+					"logs:PutLogEvents",
+					"logs:DescribeLogGroups",
+					"logs:DescribeLogStreams",
+				},
+			},
+		})
+		// the role makes the stack require IAM capabilities even when the service role is given
+		c.rs.withIAM = true
+
+		flowLog.LogDestinationType = gfnt.NewString("cloud-watch-logs")
+		flowLog.LogDestination = logGroupARN
+		flowLog.DeliverLogsPermissionArn = gfnt.MakeFnGetAttString(flowLogsRoleKey, "Arn")
+	}
+
+	c.newResource(FlowLogKey, flowLog)
+}
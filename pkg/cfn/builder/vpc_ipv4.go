@@ -69,6 +69,7 @@ func (v *IPv4VPCResourceSet) addResources() error {
 		EnableDnsSupport:   gfnt.True(),
 		EnableDnsHostnames: gfnt.True(),
 	})
+	v.rs.addDHCPOptions(vpc.DNS, v.vpcID)
 
 	if v.isFullyPrivate() {
 		v.noNAT()
@@ -252,9 +253,11 @@ func (v *IPv4VPCResourceSet) addNATGateways() error {
 
 var (
 	sgProtoTCP           = gfnt.NewString("tcp")
+	sgProtoICMPv6        = gfnt.NewString("58")
 	sgSourceAnywhereIPv4 = gfnt.NewString("0.0.0.0/0")
 	sgSourceAnywhereIPv6 = gfnt.NewString("::/0")
 
+	sgPortAll     = gfnt.NewInteger(-1)
 	sgPortZero    = gfnt.NewInteger(0)
 	sgMinNodePort = gfnt.NewInteger(1025)
 	sgMaxNodePort = gfnt.NewInteger(65535)
@@ -302,6 +302,42 @@ var _ = Describe("VPC Template Builder", func() {
 			})
 		})
 
+		Context("when custom DHCP options are set", func() {
+			BeforeEach(func() {
+				cfg.VPC.DNS = &api.ClusterVPCDNS{
+					DomainName:        "corp.example.com",
+					DomainNameServers: []string{"10.0.0.2", "10.0.0.3"},
+				}
+			})
+
+			It("associates the DHCP options with the VPC", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey(builder.DHCPOptionsKey))
+				Expect(vpcTemplate.Resources[builder.DHCPOptionsKey].Properties.DomainName).To(Equal("corp.example.com"))
+				Expect(vpcTemplate.Resources[builder.DHCPOptionsKey].Properties.DomainNameServers).To(Equal([]string{"10.0.0.2", "10.0.0.3"}))
+
+				Expect(vpcTemplate.Resources).To(HaveKey(builder.DHCPOptionsAssociationKey))
+				Expect(vpcTemplate.Resources[builder.DHCPOptionsAssociationKey].Properties.DhcpOptionsID).To(Equal(makeRef(builder.DHCPOptionsKey)))
+				Expect(vpcTemplate.Resources[builder.DHCPOptionsAssociationKey].Properties.VpcID).To(Equal(makeRef(vpcResourceKey)))
+			})
+
+			It("uses the Amazon DNS server by default", func() {
+				cfg.VPC.DNS.DomainNameServers = nil
+				vpcTemplate = &fakes.FakeTemplate{}
+				rs := builder.NewIPv4VPCResourceSet(builder.NewRS(), cfg, mockEC2)
+				_, _, err := rs.CreateTemplate(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				templateBody, err := rs.RenderJSON()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(json.Unmarshal(templateBody, vpcTemplate)).To(Succeed())
+				Expect(vpcTemplate.Resources[builder.DHCPOptionsKey].Properties.DomainNameServers).To(Equal([]string{"AmazonProvidedDNS"}))
+			})
+		})
+
+		It("does not add DHCP options by default", func() {
+			Expect(vpcTemplate.Resources).NotTo(HaveKey(builder.DHCPOptionsKey))
+			Expect(vpcTemplate.Resources).NotTo(HaveKey(builder.DHCPOptionsAssociationKey))
+		})
+
 		Context("when the vpc is fully private", func() {
 			BeforeEach(func() {
 				cfg.PrivateCluster.Enabled = true
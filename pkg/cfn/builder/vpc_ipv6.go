@@ -38,6 +38,7 @@ func (v *IPv6VPCResourceSet) CreateTemplate(ctx context.Context) (*gfnt.Value, *
 		EnableDnsSupport:   gfnt.True(),
 		EnableDnsHostnames: gfnt.True(),
 	})
+	v.rs.addDHCPOptions(v.clusterConfig.VPC.DNS, vpcResourceRef)
 	v.rs.defineOutput(outputs.ClusterVPC, vpcResourceRef, true, func(val string) error {
 		v.clusterConfig.VPC.ID = val
 		return nil
@@ -14,16 +14,19 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	"github.com/aws/smithy-go"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/progress"
+	"github.com/weaveworks/eksctl/pkg/telemetry"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -72,17 +75,24 @@ type ChangeSet = cloudformation.DescribeChangeSetOutput
 type StackCollection struct {
 	cloudformationAPI awsapi.CloudFormation
 	ec2API            awsapi.EC2
-	eksAPI            eksiface.EKSAPI
+	eksAPI            awsapi.EKS
 	iamAPI            awsapi.IAM
 	cloudTrailAPI     awsapi.CloudTrail
 	asgAPI            awsapi.ASG
 
 	spec            *api.ClusterConfig
 	disableRollback bool
+	// explainFailures looks up the calls denied while creating the resources of failed stacks in CloudTrail
+	explainFailures bool
 	roleARN         string
 	region          string
 	waitTimeout     time.Duration
-	sharedTags      []types.Tag
+	// createTimeout is the maximum time to wait for a stack to be created
+	createTimeout time.Duration
+	// waiterMaxDelay is the maximum delay between the attempts of waiters, zero uses the default of each waiter
+	waiterMaxDelay    time.Duration
+	sharedTags        []types.Tag
+	ensureCredentials func(ctx context.Context, lifetime time.Duration) error
 }
 
 func newTag(key, value string) types.Tag {
@@ -109,9 +119,13 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) S
 		cloudTrailAPI:     provider.CloudTrail(),
 		asgAPI:            provider.ASG(),
 		disableRollback:   provider.CloudFormationDisableRollback(),
+		explainFailures:   provider.CloudFormationExplainFailures(),
 		roleARN:           provider.CloudFormationRoleARN(),
 		region:            provider.Region(),
 		waitTimeout:       provider.WaitTimeout(),
+		createTimeout:     spec.Timeouts.StackCreationTimeout(provider.WaitTimeout()),
+		waiterMaxDelay:    provider.WaiterMaxDelay(),
+		ensureCredentials: provider.EnsureCredentials,
 	}
 }
 
@@ -198,7 +212,10 @@ func (c *StackCollection) createClusterStack(ctx context.Context, stackName stri
 			c.troubleshootStackFailureCause(ctx, stack, string(types.StackStatusCreateComplete))
 		}
 
-		ctx, cancelFunc := context.WithTimeout(context.Background(), c.waitTimeout)
+		_, span := telemetry.StartSpan(ctx, "wait for stack creation", attribute.String("eksctl.stack", *stack.StackName))
+		endWait := metrics.StartStackWait("wait for stack creation")
+		endPhase := progress.StartStackWait("wait for stack creation", *stack.StackName)
+		ctx, cancelFunc := context.WithTimeout(context.Background(), c.createTimeout)
 		defer cancelFunc()
 
 		stack, err := waiter.WaitForStack(ctx, c.cloudformationAPI, *stack.StackId, *stack.StackName, func(attempts int) time.Duration {
@@ -208,6 +225,9 @@ func (c *StackCollection) createClusterStack(ctx context.Context, stackName stri
 			}
 			return 1 * time.Minute
 		})
+		endPhase(err)
+		endWait(err)
+		telemetry.EndSpan(span, err)
 
 		if err != nil {
 			troubleshoot()
@@ -233,7 +253,10 @@ func (c *StackCollection) createStackRequest(ctx context.Context, stackName stri
 		return nil, errors.Wrapf(err, "rendering template for %q stack", *stack.StackName)
 	}
 
-	if err := c.DoCreateStackRequest(ctx, stack, TemplateBody(templateBody), tags, parameters, resourceSet.WithIAM(), resourceSet.WithNamedIAM()); err != nil {
+	ctx, span := telemetry.StartSpan(ctx, "create stack", attribute.String("eksctl.stack", stackName))
+	err = c.DoCreateStackRequest(ctx, stack, TemplateBody(templateBody), tags, parameters, resourceSet.WithIAM(), resourceSet.WithNamedIAM())
+	telemetry.EndSpan(span, err)
+	if err != nil {
 		return nil, err
 	}
 
@@ -315,7 +338,7 @@ func (c *StackCollection) checkASGTagsNumber(ngName, asgName string, propagatedT
 	for ngTagKey := range propagatedTags {
 		for _, asgTag := range asgTags {
 			// decrease the unique tag key count if there is a match
-			if aws.StringValue(asgTag.Key) == ngTagKey {
+			if aws.ToString(asgTag.Key) == ngTagKey {
 				uniqueTagKeyCount--
 				break
 			}
@@ -0,0 +1,34 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+const autoAMIUpdatesStackSuffix = "-auto-ami-updates"
+
+// MakeAutoAMIUpdatesStackName returns the name of the stack that updates the AMIs of the managed nodegroups
+// of a cluster on a schedule
+func MakeAutoAMIUpdatesStackName(clusterName string) string {
+	return fmt.Sprintf("eksctl-%s%s", clusterName, autoAMIUpdatesStackSuffix)
+}
+
+// GetAutoAMIUpdatesStack returns the stack that updates the AMIs of the managed nodegroups on a schedule,
+// or nil if automatic AMI updates are not enabled
+func (c *StackCollection) GetAutoAMIUpdatesStack(ctx context.Context) (*Stack, error) {
+	stack, err := c.DescribeStack(ctx, &Stack{StackName: aws.String(MakeAutoAMIUpdatesStackName(c.spec.Metadata.Name))})
+	if err != nil {
+		if IsStackDoesNotExistError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return stack, nil
+}
+
+func isAutoAMIUpdatesStack(s *Stack) bool {
+	return strings.HasSuffix(*s.StackName, autoAMIUpdatesStackSuffix)
+}
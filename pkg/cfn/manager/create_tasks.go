@@ -106,7 +106,7 @@ func (c *StackCollection) NewManagedNodeGroupTask(ctx context.Context, nodeGroup
 			info:              fmt.Sprintf("create managed nodegroup %q", ng.Name),
 			ctx:               ctx,
 		})
-		if api.IsEnabled(ng.PropagateASGTags) {
+		if api.IsEnabled(ng.PropagateASGTags) || c.spec.AutoScalerTagsEnabled() {
 			// disable parallelisation if any tags propagation is done
 			// since nodegroup must be created to propagate tags to its ASGs
 			taskTree.Parallel = false
@@ -114,6 +114,7 @@ func (c *StackCollection) NewManagedNodeGroupTask(ctx context.Context, nodeGroup
 				stackCollection: c,
 				nodeGroup:       ng,
 				info:            fmt.Sprintf("propagate tags to ASG for managed nodegroup %q", ng.Name),
+				ctx:             ctx,
 			})
 		}
 	}
@@ -6,12 +6,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
@@ -121,11 +121,12 @@ type DeleteWaitCondition struct {
 }
 
 type DeleteUnownedNodegroupTask struct {
+	ctx       context.Context
 	cluster   string
 	nodegroup string
 	wait      *DeleteWaitCondition
 	info      string
-	eksAPI    eksiface.EKSAPI
+	eksAPI    awsapi.EKS
 }
 
 func (d *DeleteUnownedNodegroupTask) Describe() string {
@@ -133,7 +134,7 @@ func (d *DeleteUnownedNodegroupTask) Describe() string {
 }
 
 func (d *DeleteUnownedNodegroupTask) Do() error {
-	out, err := d.eksAPI.DeleteNodegroup(&eks.DeleteNodegroupInput{
+	out, err := d.eksAPI.DeleteNodegroup(d.ctx, &eks.DeleteNodegroupInput{
 		ClusterName:   &d.cluster,
 		NodegroupName: &d.nodegroup,
 	})
@@ -157,15 +158,16 @@ func (d *DeleteUnownedNodegroupTask) Do() error {
 		}
 	}
 
-	if out != nil {
-		logger.Debug("delete nodegroup %q output: %s", d.nodegroup, out.String())
+	if out != nil && out.Nodegroup != nil {
+		logger.Debug("delete nodegroup %q status: %s", d.nodegroup, out.Nodegroup.Status)
 	}
 	return nil
 }
 
-func (c *StackCollection) NewTaskToDeleteUnownedNodeGroup(clusterName, nodegroup string, eksAPI eksiface.EKSAPI, waitCondition *DeleteWaitCondition) tasks.Task {
+func (c *StackCollection) NewTaskToDeleteUnownedNodeGroup(ctx context.Context, clusterName, nodegroup string, eksAPI awsapi.EKS, waitCondition *DeleteWaitCondition) tasks.Task {
 	return tasks.SynchronousTask{
 		SynchronousTaskIface: &DeleteUnownedNodegroupTask{
+			ctx:       ctx,
 			cluster:   clusterName,
 			nodegroup: nodegroup,
 			eksAPI:    eksAPI,
@@ -0,0 +1,173 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/kris-nova/logger"
+)
+
+const (
+	// cloudFormationServiceHost is the source and user agent of the calls CloudFormation makes to create resources
+	cloudFormationServiceHost = "cloudformation.amazonaws.com"
+	// maxExplainFailuresPages limits how many pages of CloudTrail events are looked up, as LookupEvents is rate limited
+	maxExplainFailuresPages = 20
+)
+
+var (
+	accessDeniedPattern   = regexp.MustCompile(`(?i)(AccessDenied|UnauthorizedOperation|not authorized to perform|AuthorizationError)`)
+	deniedActionPattern   = regexp.MustCompile(`not authorized to perform:? ([a-zA-Z0-9-]+:[a-zA-Z0-9]+)`)
+	deniedResourcePattern = regexp.MustCompile(`on resource:? (\S+)`)
+)
+
+// DeniedCall is an AWS API call that was denied while the resources of a stack were created
+type DeniedCall struct {
+	// Action is the IAM action that is missing, e.g. ec2:CreateVpc
+	Action string
+	// Principal is the ARN of the identity that made the call
+	Principal string
+	Resource  string
+}
+
+// cloudTrailRecord holds the fields of a CloudTrail event record that explain a denied call
+type cloudTrailRecord struct {
+	EventSource     string `json:"eventSource"`
+	EventName       string `json:"eventName"`
+	ErrorCode       string `json:"errorCode"`
+	ErrorMessage    string `json:"errorMessage"`
+	SourceIPAddress string `json:"sourceIPAddress"`
+	UserAgent       string `json:"userAgent"`
+	UserIdentity    struct {
+		ARN       string `json:"arn"`
+		InvokedBy string `json:"invokedBy"`
+	} `json:"userIdentity"`
+}
+
+// explainStackCreationFailure explains the denied calls of a stack that failed to be created, if enabled
+func (c *StackCollection) explainStackCreationFailure(ctx context.Context, i *Stack) {
+	if !c.explainFailures {
+		return
+	}
+	events, err := c.DescribeStackEvents(ctx, i)
+	if err != nil {
+		logger.Warning("cannot fetch stack events to explain the failure: %v", err)
+		return
+	}
+	c.explainAccessDenied(ctx, i, events)
+}
+
+// explainAccessDenied logs the IAM actions that were missing for the resources of a stack that failed with an
+// access denied error, looking them up in CloudTrail when --explain-failures is set
+func (c *StackCollection) explainAccessDenied(ctx context.Context, i *Stack, events []types.StackEvent) {
+	var (
+		deniedResources []string
+		since           time.Time
+	)
+	seen := map[string]bool{}
+	for _, e := range events {
+		if e.Timestamp != nil && (since.IsZero() || e.Timestamp.Before(since)) {
+			since = *e.Timestamp
+		}
+		resource := aws.ToString(e.LogicalResourceId)
+		if e.ResourceStatusReason != nil && accessDeniedPattern.MatchString(*e.ResourceStatusReason) && !seen[resource] {
+			seen[resource] = true
+			deniedResources = append(deniedResources, resource)
+		}
+	}
+	if len(deniedResources) == 0 {
+		return
+	}
+
+	if !c.explainFailures {
+		logger.Info("resource(s) %s of stack %q failed because of missing permissions, rerun with --explain-failures to list the denied IAM actions from CloudTrail",
+			strings.Join(deniedResources, ", "), *i.StackName)
+		return
+	}
+
+	logger.Info("looking up the calls denied while creating the resources of stack %q in CloudTrail", *i.StackName)
+	calls, err := c.lookupDeniedCalls(ctx, since.Add(-time.Minute))
+	if err != nil {
+		logger.Warning("cannot look up CloudTrail events to explain the failure: %v", err)
+		return
+	}
+	if len(calls) == 0 {
+		logger.Warning("no denied calls found in CloudTrail, its events can take up to 15 minutes to be delivered")
+		return
+	}
+	logger.Critical("the following IAM actions were denied, grant them to the principals and retry:")
+	for _, call := range calls {
+		msg := fmt.Sprintf("%s for %s", call.Action, call.Principal)
+		if call.Resource != "" {
+			msg = fmt.Sprintf("%s on %s", msg, call.Resource)
+		}
+		logger.Critical("  %s", msg)
+	}
+}
+
+// lookupDeniedCalls returns the calls made by CloudFormation since a time that were denied, sorted by action
+func (c *StackCollection) lookupDeniedCalls(ctx context.Context, since time.Time) ([]DeniedCall, error) {
+	seen := map[DeniedCall]bool{}
+	var calls []DeniedCall
+
+	paginator := cloudtrail.NewLookupEventsPaginator(c.cloudTrailAPI, &cloudtrail.LookupEventsInput{
+		StartTime: aws.Time(since),
+	})
+	for page := 0; paginator.HasMorePages() && page < maxExplainFailuresPages; page++ {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range out.Events {
+			call, ok := parseDeniedCall(aws.ToString(event.CloudTrailEvent))
+			if ok && !seen[call] {
+				seen[call] = true
+				calls = append(calls, call)
+			}
+		}
+	}
+
+	sort.Slice(calls, func(i, j int) bool {
+		if calls[i].Action != calls[j].Action {
+			return calls[i].Action < calls[j].Action
+		}
+		return calls[i].Principal < calls[j].Principal
+	})
+	return calls, nil
+}
+
+// parseDeniedCall returns the denied call of a CloudTrail event record made by CloudFormation, if it was denied
+func parseDeniedCall(event string) (DeniedCall, bool) {
+	var record cloudTrailRecord
+	if err := json.Unmarshal([]byte(event), &record); err != nil {
+		return DeniedCall{}, false
+	}
+	if !accessDeniedPattern.MatchString(record.ErrorCode) {
+		return DeniedCall{}, false
+	}
+	if record.SourceIPAddress != cloudFormationServiceHost && record.UserAgent != cloudFormationServiceHost &&
+		record.UserIdentity.InvokedBy != cloudFormationServiceHost {
+		return DeniedCall{}, false
+	}
+
+	call := DeniedCall{
+		Principal: record.UserIdentity.ARN,
+	}
+	// the error message names the exact action, which can differ from the name of the API call
+	if match := deniedActionPattern.FindStringSubmatch(record.ErrorMessage); match != nil {
+		call.Action = match[1]
+	} else {
+		call.Action = fmt.Sprintf("%s:%s", strings.TrimSuffix(record.EventSource, ".amazonaws.com"), record.EventName)
+	}
+	if match := deniedResourcePattern.FindStringSubmatch(record.ErrorMessage); match != nil {
+		call.Resource = strings.TrimSuffix(match[1], ".")
+	}
+	return call, true
+}
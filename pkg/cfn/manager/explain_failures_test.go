@@ -0,0 +1,96 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Explain failures", func() {
+	DescribeTable("parsing CloudTrail events",
+		func(event string, expected DeniedCall, denied bool) {
+			call, ok := parseDeniedCall(event)
+			Expect(ok).To(Equal(denied))
+			Expect(call).To(Equal(expected))
+		},
+		Entry("call denied to CloudFormation",
+			`{"eventSource": "iam.amazonaws.com", "eventName": "CreateRole", "errorCode": "AccessDenied", "sourceIPAddress": "cloudformation.amazonaws.com",
+			  "errorMessage": "User: arn:aws:sts::123456789012:assumed-role/deployer/session is not authorized to perform: iam:CreateRole on resource: arn:aws:iam::123456789012:role/eksctl-cluster-1-ServiceRole.",
+			  "userIdentity": {"arn": "arn:aws:sts::123456789012:assumed-role/deployer/session", "invokedBy": "cloudformation.amazonaws.com"}}`,
+			DeniedCall{
+				Action:    "iam:CreateRole",
+				Principal: "arn:aws:sts::123456789012:assumed-role/deployer/session",
+				Resource:  "arn:aws:iam::123456789012:role/eksctl-cluster-1-ServiceRole",
+			}, true),
+		Entry("call denied without the action in the message",
+			`{"eventSource": "ec2.amazonaws.com", "eventName": "CreateVpc", "errorCode": "Client.UnauthorizedOperation", "userAgent": "cloudformation.amazonaws.com",
+			  "errorMessage": "You are not authorized to perform this operation.", "userIdentity": {"arn": "arn:aws:iam::123456789012:user/deployer"}}`,
+			DeniedCall{
+				Action:    "ec2:CreateVpc",
+				Principal: "arn:aws:iam::123456789012:user/deployer",
+			}, true),
+		Entry("call denied to another client",
+			`{"eventSource": "ec2.amazonaws.com", "eventName": "CreateVpc", "errorCode": "Client.UnauthorizedOperation", "sourceIPAddress": "10.0.0.1"}`,
+			DeniedCall{}, false),
+		Entry("successful call",
+			`{"eventSource": "ec2.amazonaws.com", "eventName": "CreateVpc", "sourceIPAddress": "cloudformation.amazonaws.com"}`,
+			DeniedCall{}, false),
+		Entry("invalid event", `{`, DeniedCall{}, false),
+	)
+
+	It("looks up the denied calls of resources that failed with access denied errors", func() {
+		p := mockprovider.NewMockProvider()
+		sm := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+		sm.explainFailures = true
+
+		failedAt := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+		p.MockCloudTrail().On("LookupEvents", mock.Anything, &cloudtrail.LookupEventsInput{
+			StartTime: aws.Time(failedAt.Add(-time.Minute)),
+		}, mock.Anything).Return(&cloudtrail.LookupEventsOutput{
+			Events: []cttypes.Event{
+				{CloudTrailEvent: aws.String(`{"eventSource": "iam.amazonaws.com", "eventName": "CreateRole", "errorCode": "AccessDenied", "sourceIPAddress": "cloudformation.amazonaws.com"}`)},
+				{CloudTrailEvent: aws.String(`{"eventSource": "iam.amazonaws.com", "eventName": "CreateRole", "errorCode": "AccessDenied", "sourceIPAddress": "cloudformation.amazonaws.com"}`)},
+			},
+		}, nil)
+
+		sm.explainAccessDenied(context.Background(), &Stack{StackName: aws.String("eksctl-cluster-1-cluster")}, []types.StackEvent{
+			{
+				LogicalResourceId:    aws.String("ServiceRole"),
+				ResourceStatus:       types.ResourceStatusCreateFailed,
+				ResourceStatusReason: aws.String("API: iam:CreateRole User is not authorized to perform: iam:CreateRole"),
+				Timestamp:            aws.Time(failedAt),
+			},
+		})
+		Expect(p.MockCloudTrail().AssertNumberOfCalls(GinkgoT(), "LookupEvents", 1)).To(BeTrue())
+
+		calls, err := sm.lookupDeniedCalls(context.Background(), failedAt.Add(-time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal([]DeniedCall{{Action: "iam:CreateRole"}}))
+	})
+
+	It("doesn't look up CloudTrail events for other failures", func() {
+		p := mockprovider.NewMockProvider()
+		sm := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+		sm.explainFailures = true
+
+		sm.explainAccessDenied(context.Background(), &Stack{StackName: aws.String("eksctl-cluster-1-cluster")}, []types.StackEvent{
+			{
+				LogicalResourceId:    aws.String("VPC"),
+				ResourceStatus:       types.ResourceStatusCreateFailed,
+				ResourceStatusReason: aws.String("The maximum number of VPCs has been reached."),
+			},
+		})
+		p.MockCloudTrail().AssertNotCalled(GinkgoT(), "LookupEvents", mock.Anything, mock.Anything, mock.Anything)
+	})
+})
@@ -9,8 +9,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	typesb "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
@@ -275,6 +275,19 @@ type FakeStackManager struct {
 	fixClusterCompatibilityReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetAutoAMIUpdatesStackStub        func(context.Context) (*types.Stack, error)
+	getAutoAMIUpdatesStackMutex       sync.RWMutex
+	getAutoAMIUpdatesStackArgsForCall []struct {
+		arg1 context.Context
+	}
+	getAutoAMIUpdatesStackReturns struct {
+		result1 *types.Stack
+		result2 error
+	}
+	getAutoAMIUpdatesStackReturnsOnCall map[int]struct {
+		result1 *types.Stack
+		result2 error
+	}
 	GetAutoScalingGroupDesiredCapacityStub        func(context.Context, string) (typesa.AutoScalingGroup, error)
 	getAutoScalingGroupDesiredCapacityMutex       sync.RWMutex
 	getAutoScalingGroupDesiredCapacityArgsForCall []struct {
@@ -418,6 +431,19 @@ type FakeStackManager struct {
 		result1 v1alpha5.NodeGroupType
 		result2 error
 	}
+	GetScheduledDeletionStackStub        func(context.Context) (*types.Stack, error)
+	getScheduledDeletionStackMutex       sync.RWMutex
+	getScheduledDeletionStackArgsForCall []struct {
+		arg1 context.Context
+	}
+	getScheduledDeletionStackReturns struct {
+		result1 *types.Stack
+		result2 error
+	}
+	getScheduledDeletionStackReturnsOnCall map[int]struct {
+		result1 *types.Stack
+		result2 error
+	}
 	GetStackTemplateStub        func(context.Context, string) (string, error)
 	getStackTemplateMutex       sync.RWMutex
 	getStackTemplateArgsForCall []struct {
@@ -603,13 +629,14 @@ type FakeStackManager struct {
 		result1 *tasks.TaskTree
 		result2 error
 	}
-	NewTaskToDeleteUnownedNodeGroupStub        func(string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) tasks.Task
+	NewTaskToDeleteUnownedNodeGroupStub        func(context.Context, string, string, awsapi.EKS, *manager.DeleteWaitCondition) tasks.Task
 	newTaskToDeleteUnownedNodeGroupMutex       sync.RWMutex
 	newTaskToDeleteUnownedNodeGroupArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-		arg3 eksiface.EKSAPI
-		arg4 *manager.DeleteWaitCondition
+		arg3 string
+		arg4 awsapi.EKS
+		arg5 *manager.DeleteWaitCondition
 	}
 	newTaskToDeleteUnownedNodeGroupReturns struct {
 		result1 tasks.Task
@@ -750,6 +777,21 @@ type FakeStackManager struct {
 	refreshFargatePodExecutionRoleARNReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RetainResourcesStub        func(context.Context, *types.Stack, []string) ([]manager.RetainedResource, error)
+	retainResourcesMutex       sync.RWMutex
+	retainResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 *types.Stack
+		arg3 []string
+	}
+	retainResourcesReturns struct {
+		result1 []manager.RetainedResource
+		result2 error
+	}
+	retainResourcesReturnsOnCall map[int]struct {
+		result1 []manager.RetainedResource
+		result2 error
+	}
 	StackStatusIsNotReadyStub        func(*types.Stack) bool
 	stackStatusIsNotReadyMutex       sync.RWMutex
 	stackStatusIsNotReadyArgsForCall []struct {
@@ -2019,6 +2061,70 @@ func (fake *FakeStackManager) FixClusterCompatibilityReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeStackManager) GetAutoAMIUpdatesStack(arg1 context.Context) (*types.Stack, error) {
+	fake.getAutoAMIUpdatesStackMutex.Lock()
+	ret, specificReturn := fake.getAutoAMIUpdatesStackReturnsOnCall[len(fake.getAutoAMIUpdatesStackArgsForCall)]
+	fake.getAutoAMIUpdatesStackArgsForCall = append(fake.getAutoAMIUpdatesStackArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetAutoAMIUpdatesStackStub
+	fakeReturns := fake.getAutoAMIUpdatesStackReturns
+	fake.recordInvocation("GetAutoAMIUpdatesStack", []interface{}{arg1})
+	fake.getAutoAMIUpdatesStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetAutoAMIUpdatesStackCallCount() int {
+	fake.getAutoAMIUpdatesStackMutex.RLock()
+	defer fake.getAutoAMIUpdatesStackMutex.RUnlock()
+	return len(fake.getAutoAMIUpdatesStackArgsForCall)
+}
+
+func (fake *FakeStackManager) GetAutoAMIUpdatesStackCalls(stub func(context.Context) (*types.Stack, error)) {
+	fake.getAutoAMIUpdatesStackMutex.Lock()
+	defer fake.getAutoAMIUpdatesStackMutex.Unlock()
+	fake.GetAutoAMIUpdatesStackStub = stub
+}
+
+func (fake *FakeStackManager) GetAutoAMIUpdatesStackArgsForCall(i int) context.Context {
+	fake.getAutoAMIUpdatesStackMutex.RLock()
+	defer fake.getAutoAMIUpdatesStackMutex.RUnlock()
+	argsForCall := fake.getAutoAMIUpdatesStackArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetAutoAMIUpdatesStackReturns(result1 *types.Stack, result2 error) {
+	fake.getAutoAMIUpdatesStackMutex.Lock()
+	defer fake.getAutoAMIUpdatesStackMutex.Unlock()
+	fake.GetAutoAMIUpdatesStackStub = nil
+	fake.getAutoAMIUpdatesStackReturns = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetAutoAMIUpdatesStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.getAutoAMIUpdatesStackMutex.Lock()
+	defer fake.getAutoAMIUpdatesStackMutex.Unlock()
+	fake.GetAutoAMIUpdatesStackStub = nil
+	if fake.getAutoAMIUpdatesStackReturnsOnCall == nil {
+		fake.getAutoAMIUpdatesStackReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
+			result2 error
+		})
+	}
+	fake.getAutoAMIUpdatesStackReturnsOnCall[i] = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacity(arg1 context.Context, arg2 string) (typesa.AutoScalingGroup, error) {
 	fake.getAutoScalingGroupDesiredCapacityMutex.Lock()
 	ret, specificReturn := fake.getAutoScalingGroupDesiredCapacityReturnsOnCall[len(fake.getAutoScalingGroupDesiredCapacityArgsForCall)]
@@ -2040,6 +2146,8 @@ func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacity(arg1 context.Co
 }
 
 func (fake *FakeStackManager) GetAutoScalingGroupDesiredCapacityCallCount() int {
+	fake.getAutoAMIUpdatesStackMutex.RLock()
+	defer fake.getAutoAMIUpdatesStackMutex.RUnlock()
 	fake.getAutoScalingGroupDesiredCapacityMutex.RLock()
 	defer fake.getAutoScalingGroupDesiredCapacityMutex.RUnlock()
 	return len(fake.getAutoScalingGroupDesiredCapacityArgsForCall)
@@ -2721,6 +2829,70 @@ func (fake *FakeStackManager) GetNodeGroupStackTypeReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) GetScheduledDeletionStack(arg1 context.Context) (*types.Stack, error) {
+	fake.getScheduledDeletionStackMutex.Lock()
+	ret, specificReturn := fake.getScheduledDeletionStackReturnsOnCall[len(fake.getScheduledDeletionStackArgsForCall)]
+	fake.getScheduledDeletionStackArgsForCall = append(fake.getScheduledDeletionStackArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetScheduledDeletionStackStub
+	fakeReturns := fake.getScheduledDeletionStackReturns
+	fake.recordInvocation("GetScheduledDeletionStack", []interface{}{arg1})
+	fake.getScheduledDeletionStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetScheduledDeletionStackCallCount() int {
+	fake.getScheduledDeletionStackMutex.RLock()
+	defer fake.getScheduledDeletionStackMutex.RUnlock()
+	return len(fake.getScheduledDeletionStackArgsForCall)
+}
+
+func (fake *FakeStackManager) GetScheduledDeletionStackCalls(stub func(context.Context) (*types.Stack, error)) {
+	fake.getScheduledDeletionStackMutex.Lock()
+	defer fake.getScheduledDeletionStackMutex.Unlock()
+	fake.GetScheduledDeletionStackStub = stub
+}
+
+func (fake *FakeStackManager) GetScheduledDeletionStackArgsForCall(i int) context.Context {
+	fake.getScheduledDeletionStackMutex.RLock()
+	defer fake.getScheduledDeletionStackMutex.RUnlock()
+	argsForCall := fake.getScheduledDeletionStackArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetScheduledDeletionStackReturns(result1 *types.Stack, result2 error) {
+	fake.getScheduledDeletionStackMutex.Lock()
+	defer fake.getScheduledDeletionStackMutex.Unlock()
+	fake.GetScheduledDeletionStackStub = nil
+	fake.getScheduledDeletionStackReturns = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetScheduledDeletionStackReturnsOnCall(i int, result1 *types.Stack, result2 error) {
+	fake.getScheduledDeletionStackMutex.Lock()
+	defer fake.getScheduledDeletionStackMutex.Unlock()
+	fake.GetScheduledDeletionStackStub = nil
+	if fake.getScheduledDeletionStackReturnsOnCall == nil {
+		fake.getScheduledDeletionStackReturnsOnCall = make(map[int]struct {
+			result1 *types.Stack
+			result2 error
+		})
+	}
+	fake.getScheduledDeletionStackReturnsOnCall[i] = struct {
+		result1 *types.Stack
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) GetStackTemplate(arg1 context.Context, arg2 string) (string, error) {
 	fake.getStackTemplateMutex.Lock()
 	ret, specificReturn := fake.getStackTemplateReturnsOnCall[len(fake.getStackTemplateArgsForCall)]
@@ -3619,21 +3791,22 @@ func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroup(arg1 string, arg2 string, arg3 eksiface.EKSAPI, arg4 *manager.DeleteWaitCondition) tasks.Task {
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroup(arg1 context.Context, arg2 string, arg3 string, arg4 awsapi.EKS, arg5 *manager.DeleteWaitCondition) tasks.Task {
 	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
 	ret, specificReturn := fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)]
 	fake.newTaskToDeleteUnownedNodeGroupArgsForCall = append(fake.newTaskToDeleteUnownedNodeGroupArgsForCall, struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-		arg3 eksiface.EKSAPI
-		arg4 *manager.DeleteWaitCondition
-	}{arg1, arg2, arg3, arg4})
+		arg3 string
+		arg4 awsapi.EKS
+		arg5 *manager.DeleteWaitCondition
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.NewTaskToDeleteUnownedNodeGroupStub
 	fakeReturns := fake.newTaskToDeleteUnownedNodeGroupReturns
-	fake.recordInvocation("NewTaskToDeleteUnownedNodeGroup", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("NewTaskToDeleteUnownedNodeGroup", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1
@@ -3647,17 +3820,17 @@ func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCallCount() int {
 	return len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCalls(stub func(string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) tasks.Task) {
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCalls(stub func(context.Context, string, string, awsapi.EKS, *manager.DeleteWaitCondition) tasks.Task) {
 	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
 	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
 	fake.NewTaskToDeleteUnownedNodeGroupStub = stub
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupArgsForCall(i int) (string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) {
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupArgsForCall(i int) (context.Context, string, string, awsapi.EKS, *manager.DeleteWaitCondition) {
 	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
 	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
 	argsForCall := fake.newTaskToDeleteUnownedNodeGroupArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturns(result1 tasks.Task) {
@@ -4310,6 +4483,77 @@ func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeStackManager) RetainResources(arg1 context.Context, arg2 *types.Stack, arg3 []string) ([]manager.RetainedResource, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.retainResourcesMutex.Lock()
+	ret, specificReturn := fake.retainResourcesReturnsOnCall[len(fake.retainResourcesArgsForCall)]
+	fake.retainResourcesArgsForCall = append(fake.retainResourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *types.Stack
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.RetainResourcesStub
+	fakeReturns := fake.retainResourcesReturns
+	fake.recordInvocation("RetainResources", []interface{}{arg1, arg2, arg3Copy})
+	fake.retainResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) RetainResourcesCallCount() int {
+	fake.retainResourcesMutex.RLock()
+	defer fake.retainResourcesMutex.RUnlock()
+	return len(fake.retainResourcesArgsForCall)
+}
+
+func (fake *FakeStackManager) RetainResourcesCalls(stub func(context.Context, *types.Stack, []string) ([]manager.RetainedResource, error)) {
+	fake.retainResourcesMutex.Lock()
+	defer fake.retainResourcesMutex.Unlock()
+	fake.RetainResourcesStub = stub
+}
+
+func (fake *FakeStackManager) RetainResourcesArgsForCall(i int) (context.Context, *types.Stack, []string) {
+	fake.retainResourcesMutex.RLock()
+	defer fake.retainResourcesMutex.RUnlock()
+	argsForCall := fake.retainResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) RetainResourcesReturns(result1 []manager.RetainedResource, result2 error) {
+	fake.retainResourcesMutex.Lock()
+	defer fake.retainResourcesMutex.Unlock()
+	fake.RetainResourcesStub = nil
+	fake.retainResourcesReturns = struct {
+		result1 []manager.RetainedResource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) RetainResourcesReturnsOnCall(i int, result1 []manager.RetainedResource, result2 error) {
+	fake.retainResourcesMutex.Lock()
+	defer fake.retainResourcesMutex.Unlock()
+	fake.RetainResourcesStub = nil
+	if fake.retainResourcesReturnsOnCall == nil {
+		fake.retainResourcesReturnsOnCall = make(map[int]struct {
+			result1 []manager.RetainedResource
+			result2 error
+		})
+	}
+	fake.retainResourcesReturnsOnCall[i] = struct {
+		result1 []manager.RetainedResource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) StackStatusIsNotReady(arg1 *types.Stack) bool {
 	fake.stackStatusIsNotReadyMutex.Lock()
 	ret, specificReturn := fake.stackStatusIsNotReadyReturnsOnCall[len(fake.stackStatusIsNotReadyArgsForCall)]
@@ -4621,6 +4865,8 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.getNodeGroupNameMutex.RUnlock()
 	fake.getNodeGroupStackTypeMutex.RLock()
 	defer fake.getNodeGroupStackTypeMutex.RUnlock()
+	fake.getScheduledDeletionStackMutex.RLock()
+	defer fake.getScheduledDeletionStackMutex.RUnlock()
 	fake.getStackTemplateMutex.RLock()
 	defer fake.getStackTemplateMutex.RUnlock()
 	fake.getUnmanagedNodeGroupAutoScalingGroupNameMutex.RLock()
@@ -4669,6 +4915,8 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.propagateManagedNodeGroupTagsToASGMutex.RUnlock()
 	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
 	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
+	fake.retainResourcesMutex.RLock()
+	defer fake.retainResourcesMutex.RUnlock()
 	fake.stackStatusIsNotReadyMutex.RLock()
 	defer fake.stackStatusIsNotReadyMutex.RUnlock()
 	fake.stackStatusIsNotTransitionalMutex.RLock()
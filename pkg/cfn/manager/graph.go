@@ -0,0 +1,182 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// StackType is the kind of resource a stack created by eksctl manages
+type StackType string
+
+// Values for StackType
+const (
+	StackTypeCluster           StackType = "cluster"
+	StackTypeNodeGroup         StackType = "nodegroup"
+	StackTypeIAMServiceAccount StackType = "iamserviceaccount"
+	StackTypeAddon             StackType = "addon"
+	StackTypeFargate           StackType = "fargate"
+	StackTypeKarpenter         StackType = "karpenter"
+	StackTypeAutoAMIUpdates    StackType = "auto-ami-updates"
+	StackTypeScheduledDeletion StackType = "scheduled-deletion"
+	StackTypeUnknown           StackType = "unknown"
+)
+
+// StackSummary describes a stack created by eksctl and the stacks it depends on
+type StackSummary struct {
+	Name string
+	Type StackType
+	// Resource is the name of the nodegroup, iamserviceaccount or addon the stack manages
+	Resource  string `json:",omitempty"`
+	Status    types.StackStatus
+	DependsOn []string
+}
+
+// GetStackType returns the type of a stack created by eksctl based on its tags and name, along with
+// the name of the resource it manages
+func GetStackType(s *Stack) (StackType, string) {
+	// the name getters don't use the StackCollection
+	var c *StackCollection
+	if name := GetIAMServiceAccountName(s); name != "" {
+		return StackTypeIAMServiceAccount, name
+	}
+	if name := c.GetIAMAddonName(s); name != "" {
+		return StackTypeAddon, name
+	}
+	if name := c.GetNodeGroupName(s); name != "" {
+		return StackTypeNodeGroup, name
+	}
+	switch {
+	case isFargateStack(s):
+		return StackTypeFargate, ""
+	case isKarpenterStack(s):
+		return StackTypeKarpenter, ""
+	case isAutoAMIUpdatesStack(s):
+		return StackTypeAutoAMIUpdates, ""
+	case isScheduledDeletionStack(s):
+		return StackTypeScheduledDeletion, ""
+	case getClusterName(s) != "":
+		return StackTypeCluster, ""
+	}
+	return StackTypeUnknown, ""
+}
+
+// SummarizeStacks returns the summaries of stacks, sorted by name; a stack depends on the stacks whose outputs
+// it imports and, as they all manage resources of the cluster, on the cluster stack
+func SummarizeStacks(ctx context.Context, cfnAPI awsapi.CloudFormation, stacks []*Stack) ([]StackSummary, error) {
+	var clusterStackName string
+	exporters := map[string]string{}
+	for _, s := range stacks {
+		if stackType, _ := GetStackType(s); stackType == StackTypeCluster {
+			clusterStackName = *s.StackName
+		}
+		for _, o := range s.Outputs {
+			if o.ExportName != nil {
+				exporters[*o.ExportName] = *s.StackName
+			}
+		}
+	}
+
+	dependencies := map[string]map[string]struct{}{}
+	addDependency := func(stackName, dependency string) {
+		if stackName == dependency {
+			return
+		}
+		if dependencies[stackName] == nil {
+			dependencies[stackName] = map[string]struct{}{}
+		}
+		dependencies[stackName][dependency] = struct{}{}
+	}
+
+	exportNames := make([]string, 0, len(exporters))
+	for exportName := range exporters {
+		exportNames = append(exportNames, exportName)
+	}
+	sort.Strings(exportNames)
+	for _, exportName := range exportNames {
+		importers, err := listImports(ctx, cfnAPI, exportName)
+		if err != nil {
+			return nil, err
+		}
+		for _, importer := range importers {
+			addDependency(importer, exporters[exportName])
+		}
+	}
+
+	summaries := make([]StackSummary, 0, len(stacks))
+	for _, s := range stacks {
+		stackType, resource := GetStackType(s)
+		if stackType != StackTypeCluster && clusterStackName != "" {
+			addDependency(*s.StackName, clusterStackName)
+		}
+		dependsOn := []string{}
+		for dependency := range dependencies[*s.StackName] {
+			dependsOn = append(dependsOn, dependency)
+		}
+		sort.Strings(dependsOn)
+		summaries = append(summaries, StackSummary{
+			Name:      *s.StackName,
+			Type:      stackType,
+			Resource:  resource,
+			Status:    s.StackStatus,
+			DependsOn: dependsOn,
+		})
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Name < summaries[j].Name
+	})
+	return summaries, nil
+}
+
+func listImports(ctx context.Context, cfnAPI awsapi.CloudFormation, exportName string) ([]string, error) {
+	var importers []string
+	paginator := cloudformation.NewListImportsPaginator(cfnAPI, &cloudformation.ListImportsInput{
+		ExportName: &exportName,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			// CloudFormation returns a validation error for exports that aren't imported
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && apiErr.ErrorCode() == "ValidationError" && strings.Contains(apiErr.ErrorMessage(), "is not imported by any stack") {
+				return importers, nil
+			}
+			return nil, errors.Wrapf(err, "listing imports of %q", exportName)
+		}
+		importers = append(importers, output.Imports...)
+	}
+	return importers, nil
+}
+
+// WriteStackGraph writes the dependency graph of stacks in the DOT language, with an edge from each stack
+// to the stacks it depends on
+func WriteStackGraph(w io.Writer, clusterName string, summaries []StackSummary) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", clusterName)
+	b.WriteString("  rankdir=BT;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, s := range summaries {
+		label := fmt.Sprintf("%s\\n%s", s.Name, s.Type)
+		if s.Resource != "" {
+			label = fmt.Sprintf("%s\\n%s %s", s.Name, s.Type, s.Resource)
+		}
+		fmt.Fprintf(&b, "  %q [label=\"%s\\n%s\"];\n", s.Name, label, s.Status)
+	}
+	for _, s := range summaries {
+		for _, dependency := range s.DependsOn {
+			fmt.Fprintf(&b, "  %q -> %q;\n", s.Name, dependency)
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,86 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Stack summaries", func() {
+	var (
+		p      *mockprovider.MockProvider
+		stacks []*Stack
+	)
+
+	newStack := func(name string, tags map[string]string, exports ...string) *Stack {
+		s := &Stack{
+			StackName:   aws.String(name),
+			StackStatus: types.StackStatusCreateComplete,
+			Tags:        []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test")}},
+		}
+		for k, v := range tags {
+			s.Tags = append(s.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		for _, export := range exports {
+			s.Outputs = append(s.Outputs, types.Output{OutputKey: aws.String(export), ExportName: aws.String(export)})
+		}
+		return s
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		stacks = []*Stack{
+			newStack("eksctl-test-nodegroup-ng-1", map[string]string{api.NodeGroupNameTag: "ng-1"}),
+			newStack("eksctl-test-cluster", nil, "eksctl-test-cluster::VPC", "eksctl-test-cluster::SharedNodeSecurityGroup"),
+			newStack("eksctl-test-addon-iamserviceaccount-kube-system-aws-node", map[string]string{api.IAMServiceAccountNameTag: "kube-system/aws-node"}),
+			newStack("eksctl-test-addon-vpc-cni", map[string]string{api.AddonNameTag: "vpc-cni"}),
+			newStack("eksctl-test-fargate", nil),
+		}
+		p.MockCloudFormation().On("ListImports", mock.Anything, &cfn.ListImportsInput{ExportName: aws.String("eksctl-test-cluster::VPC")}, mock.Anything).
+			Return(&cfn.ListImportsOutput{Imports: []string{"eksctl-test-nodegroup-ng-1"}}, nil)
+		p.MockCloudFormation().On("ListImports", mock.Anything, &cfn.ListImportsInput{ExportName: aws.String("eksctl-test-cluster::SharedNodeSecurityGroup")}, mock.Anything).
+			Return(nil, &smithy.GenericAPIError{Code: "ValidationError", Message: "Export 'eksctl-test-cluster::SharedNodeSecurityGroup' is not imported by any stack."})
+	})
+
+	It("returns the type, resource and dependencies of the stacks", func() {
+		summaries, err := SummarizeStacks(context.Background(), p.CloudFormation(), stacks)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summaries).To(Equal([]StackSummary{
+			{Name: "eksctl-test-addon-iamserviceaccount-kube-system-aws-node", Type: StackTypeIAMServiceAccount, Resource: "kube-system/aws-node", Status: types.StackStatusCreateComplete, DependsOn: []string{"eksctl-test-cluster"}},
+			{Name: "eksctl-test-addon-vpc-cni", Type: StackTypeAddon, Resource: "vpc-cni", Status: types.StackStatusCreateComplete, DependsOn: []string{"eksctl-test-cluster"}},
+			{Name: "eksctl-test-cluster", Type: StackTypeCluster, Status: types.StackStatusCreateComplete, DependsOn: []string{}},
+			{Name: "eksctl-test-fargate", Type: StackTypeFargate, Status: types.StackStatusCreateComplete, DependsOn: []string{"eksctl-test-cluster"}},
+			{Name: "eksctl-test-nodegroup-ng-1", Type: StackTypeNodeGroup, Resource: "ng-1", Status: types.StackStatusCreateComplete, DependsOn: []string{"eksctl-test-cluster"}},
+		}))
+	})
+
+	It("fails when the imports can't be listed", func() {
+		p = mockprovider.NewMockProvider()
+		p.MockCloudFormation().On("ListImports", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, &smithy.GenericAPIError{Code: "AccessDenied"})
+		_, err := SummarizeStacks(context.Background(), p.CloudFormation(), stacks)
+		Expect(err).To(MatchError(ContainSubstring("listing imports of")))
+	})
+
+	It("writes the dependency graph in DOT", func() {
+		summaries, err := SummarizeStacks(context.Background(), p.CloudFormation(), stacks)
+		Expect(err).NotTo(HaveOccurred())
+
+		var out bytes.Buffer
+		Expect(WriteStackGraph(&out, "test", summaries)).To(Succeed())
+		Expect(out.String()).To(HavePrefix(`digraph "test" {`))
+		Expect(out.String()).To(ContainSubstring(`"eksctl-test-nodegroup-ng-1" [label="eksctl-test-nodegroup-ng-1\nnodegroup ng-1\nCREATE_COMPLETE"];`))
+		Expect(out.String()).To(ContainSubstring(`"eksctl-test-nodegroup-ng-1" -> "eksctl-test-cluster";`))
+		Expect(out.String()).NotTo(ContainSubstring(`"eksctl-test-cluster" ->`))
+	})
+})
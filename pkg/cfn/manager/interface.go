@@ -7,9 +7,9 @@ import (
 
 	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
@@ -59,6 +59,7 @@ type StackManager interface {
 	EnsureMapPublicIPOnLaunchEnabled(ctx context.Context) error
 	FixClusterCompatibility(ctx context.Context) error
 	GetAutoScalingGroupDesiredCapacity(ctx context.Context, name string) (asgtypes.AutoScalingGroup, error)
+	GetAutoAMIUpdatesStack(ctx context.Context) (*Stack, error)
 	GetAutoScalingGroupName(ctx context.Context, s *Stack) (string, error)
 	GetClusterStackIfExists(ctx context.Context) (*Stack, error)
 	GetFargateStack(ctx context.Context) (*Stack, error)
@@ -69,6 +70,7 @@ type StackManager interface {
 	GetManagedNodeGroupTemplate(ctx context.Context, options GetNodegroupOption) (string, error)
 	GetNodeGroupName(s *Stack) string
 	GetNodeGroupStackType(ctx context.Context, options GetNodegroupOption) (v1alpha5.NodeGroupType, error)
+	GetScheduledDeletionStack(ctx context.Context) (*Stack, error)
 	GetStackTemplate(ctx context.Context, stackName string) (string, error)
 	GetUnmanagedNodeGroupAutoScalingGroupName(ctx context.Context, s *Stack) (string, error)
 	HasClusterStackFromList(ctx context.Context, clusterStackNames []string, clusterName string) (bool, error)
@@ -83,7 +85,7 @@ type StackManager interface {
 	NewClusterCompatTask(ctx context.Context) tasks.Task
 	NewManagedNodeGroupTask(ctx context.Context, nodeGroups []*v1alpha5.ManagedNodeGroup, forceAddCNIPolicy bool, importer vpc.Importer) *tasks.TaskTree
 	NewTaskToDeleteAddonIAM(ctx context.Context, wait bool) (*tasks.TaskTree, error)
-	NewTaskToDeleteUnownedNodeGroup(clusterName, nodegroup string, eksAPI eksiface.EKSAPI, waitCondition *DeleteWaitCondition) tasks.Task
+	NewTaskToDeleteUnownedNodeGroup(ctx context.Context, clusterName, nodegroup string, eksAPI awsapi.EKS, waitCondition *DeleteWaitCondition) tasks.Task
 	NewTasksToCreateClusterWithNodeGroups(ctx context.Context, nodeGroups []*v1alpha5.NodeGroup, managedNodeGroups []*v1alpha5.ManagedNodeGroup, postClusterCreationTasks ...tasks.Task) *tasks.TaskTree
 	NewTasksToCreateIAMServiceAccounts(serviceAccounts []*v1alpha5.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *tasks.TaskTree
 	NewTasksToDeleteClusterWithNodeGroups(ctx context.Context, stack *Stack, stacks []NodeGroupStack, deleteOIDCProvider bool, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error)
@@ -93,6 +95,7 @@ type StackManager interface {
 	NewUnmanagedNodeGroupTask(ctx context.Context, nodeGroups []*v1alpha5.NodeGroup, forceAddCNIPolicy bool, importer vpc.Importer) *tasks.TaskTree
 	PropagateManagedNodeGroupTagsToASG(ngName string, ngTags map[string]string, asgNames []string, errCh chan error) error
 	RefreshFargatePodExecutionRoleARN(ctx context.Context) error
+	RetainResources(ctx context.Context, s *Stack, resourceTypes []string) ([]RetainedResource, error)
 	StackStatusIsNotReady(s *Stack) bool
 	StackStatusIsNotTransitional(s *Stack) bool
 	UpdateNodeGroupStack(ctx context.Context, nodeGroupName, template string, wait bool) error
@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
 
 	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/blang/semver"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
@@ -78,13 +78,13 @@ func (c *StackCollection) createManagedNodeGroupTask(ctx context.Context, errorC
 	return c.CreateStack(ctx, name, stack, ng.Tags, nil, errorCh)
 }
 
-func (c *StackCollection) propagateManagedNodeGroupTagsToASGTask(errorCh chan error, ng *api.ManagedNodeGroup) error {
+func (c *StackCollection) propagateManagedNodeGroupTagsToASGTask(ctx context.Context, errorCh chan error, ng *api.ManagedNodeGroup) error {
 	// describe node group to retrieve ASG names
 	input := &eks.DescribeNodegroupInput{
 		ClusterName:   aws.String(c.spec.Metadata.Name),
 		NodegroupName: aws.String(ng.Name),
 	}
-	res, err := c.eksAPI.DescribeNodegroup(input)
+	res, err := c.eksAPI.DescribeNodegroup(ctx, input)
 	if err != nil {
 		return errors.Wrapf(err, "couldn't get managed nodegroup details for nodegroup %q", ng.Name)
 	}
@@ -99,7 +99,31 @@ func (c *StackCollection) propagateManagedNodeGroupTagsToASGTask(errorCh chan er
 			asgNames = append(asgNames, *asg.Name)
 		}
 	}
-	return c.PropagateManagedNodeGroupTagsToASG(ng.Name, ng.Tags, asgNames, errorCh)
+	tags, err := c.managedNodeGroupASGTags(ng)
+	if err != nil {
+		return err
+	}
+	return c.PropagateManagedNodeGroupTagsToASG(ng.Name, tags, asgNames, errorCh)
+}
+
+// managedNodeGroupASGTags returns the tags to apply to the ASGs created by EKS for a managed nodegroup
+func (c *StackCollection) managedNodeGroupASGTags(ng *api.ManagedNodeGroup) (map[string]string, error) {
+	tags := map[string]string{}
+	if api.IsEnabled(ng.PropagateASGTags) {
+		for k, v := range ng.Tags {
+			tags[k] = v
+		}
+	}
+	if c.spec.AutoScalerTagsEnabled() {
+		autoScalerTags, err := builder.ManagedNodeGroupClusterAutoscalerTags(c.spec.Metadata.Name, ng)
+		if err != nil {
+			return nil, errors.Wrapf(err, "generating cluster-autoscaler tags for managed nodegroup %q", ng.Name)
+		}
+		for k, v := range autoScalerTags {
+			tags[k] = v
+		}
+	}
+	return tags, nil
 }
 
 // DescribeNodeGroupStacks calls DescribeStacks and filters out nodegroups
@@ -225,7 +249,7 @@ func (c *StackCollection) getManagedNodeGroupAutoScalingGroupName(ctx context.Co
 		NodegroupName: aws.String(c.GetNodeGroupName(s)),
 	}
 
-	res, err := c.eksAPI.DescribeNodegroup(input)
+	res, err := c.eksAPI.DescribeNodegroup(ctx, input)
 	if err != nil {
 		logger.Warning("couldn't get managed nodegroup details for stack %q", *s.StackName)
 		return "", nil
@@ -235,7 +259,7 @@ func (c *StackCollection) getManagedNodeGroupAutoScalingGroupName(ctx context.Co
 
 	if res.Nodegroup.Resources != nil {
 		for _, v := range res.Nodegroup.Resources.AutoScalingGroups {
-			asgs = append(asgs, aws.StringValue(v.Name))
+			asgs = append(asgs, aws.ToString(v.Name))
 		}
 	}
 	return strings.Join(asgs, ","), nil
@@ -0,0 +1,109 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+const deletionPolicyRetain = "Retain"
+
+// RetainedResource is a resource kept when the stack it belongs to is deleted
+type RetainedResource struct {
+	StackName  string
+	LogicalID  string
+	PhysicalID string
+	Type       string
+}
+
+// RetainResources sets the DeletionPolicy of the resources of a stack that have one of the given types to Retain,
+// so that deleting the stack keeps them, and returns them
+func (c *StackCollection) RetainResources(ctx context.Context, s *Stack, resourceTypes []string) ([]RetainedResource, error) {
+	stackName := aws.ToString(s.StackName)
+	templateBody, err := c.GetStackTemplate(ctx, stackName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting template of stack %q", stackName)
+	}
+
+	var template map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(templateBody)))
+	// numbers are kept as they are instead of being converted to float64
+	decoder.UseNumber()
+	if err := decoder.Decode(&template); err != nil {
+		return nil, errors.Wrapf(err, "parsing template of stack %q", stackName)
+	}
+
+	retainTypes := map[string]bool{}
+	for _, t := range resourceTypes {
+		retainTypes[t] = true
+	}
+
+	resources, _ := template["Resources"].(map[string]interface{})
+	retained := map[string]bool{}
+	changed := false
+	for logicalID, r := range resources {
+		resource, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if resourceType, _ := resource["Type"].(string); !retainTypes[resourceType] {
+			continue
+		}
+		retained[logicalID] = true
+		if resource["DeletionPolicy"] != deletionPolicyRetain {
+			resource["DeletionPolicy"] = deletionPolicyRetain
+			changed = true
+		}
+	}
+	if len(retained) == 0 {
+		return nil, nil
+	}
+
+	if changed {
+		updatedTemplate, err := json.Marshal(template)
+		if err != nil {
+			return nil, errors.Wrapf(err, "serialising template of stack %q", stackName)
+		}
+		parameters := map[string]string{}
+		for _, p := range s.Parameters {
+			parameters[aws.ToString(p.ParameterKey)] = aws.ToString(p.ParameterValue)
+		}
+		if err := c.UpdateStack(ctx, UpdateStackOptions{
+			Stack:         s,
+			ChangeSetName: c.MakeChangeSetName("retain-resources"),
+			Description:   "retaining resources of stack " + stackName,
+			TemplateData:  TemplateBody(updatedTemplate),
+			Parameters:    parameters,
+			Wait:          true,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "retaining resources of stack %q", stackName)
+		}
+	} else {
+		logger.Debug("resources of stack %q are already retained", stackName)
+	}
+
+	output, err := c.cloudformationAPI.DescribeStackResources(ctx, &cfn.DescribeStackResourcesInput{
+		StackName: s.StackName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing resources of stack %q", stackName)
+	}
+	var retainedResources []RetainedResource
+	for _, r := range output.StackResources {
+		if !retained[aws.ToString(r.LogicalResourceId)] {
+			continue
+		}
+		retainedResources = append(retainedResources, RetainedResource{
+			StackName:  stackName,
+			LogicalID:  aws.ToString(r.LogicalResourceId),
+			PhysicalID: aws.ToString(r.PhysicalResourceId),
+			Type:       aws.ToString(r.ResourceType),
+		})
+	}
+	return retainedResources, nil
+}
@@ -0,0 +1,86 @@
+package manager
+
+import (
+	"context"
+
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("RetainResources", func() {
+	const stackName = "eksctl-my-cluster-cluster"
+
+	var (
+		p  *mockprovider.MockProvider
+		sm StackManager
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		sm = NewStackCollection(p, api.NewClusterConfig())
+		p.MockCloudFormation().On("DescribeStackResources", mock.Anything, &cfn.DescribeStackResourcesInput{
+			StackName: aws.String(stackName),
+		}).Return(&cfn.DescribeStackResourcesOutput{
+			StackResources: []types.StackResource{
+				{LogicalResourceId: aws.String("VPC"), PhysicalResourceId: aws.String("vpc-123"), ResourceType: aws.String("AWS::EC2::VPC")},
+				{LogicalResourceId: aws.String("ServiceRole"), PhysicalResourceId: aws.String("role-123"), ResourceType: aws.String("AWS::IAM::Role")},
+			},
+		}, nil)
+	})
+
+	mockTemplate := func(template string) {
+		p.MockCloudFormation().On("GetTemplate", mock.Anything, &cfn.GetTemplateInput{
+			StackName: aws.String(stackName),
+		}).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(template)}, nil)
+	}
+
+	It("sets the DeletionPolicy of the resources with the given types to Retain", func() {
+		mockTemplate(`{"Resources":{"VPC":{"Type":"AWS::EC2::VPC","Properties":{"CidrBlock":"192.168.0.0/16"}},"ServiceRole":{"Type":"AWS::IAM::Role"}}}`)
+		var templateBody string
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.MatchedBy(func(input *cfn.CreateChangeSetInput) bool {
+			templateBody = *input.TemplateBody
+			return true
+		})).Return(nil, nil)
+		p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+			StackName:    aws.String(stackName),
+			StatusReason: aws.String("The submitted information didn't contain changes"),
+		}, nil)
+
+		retained, err := sm.RetainResources(context.Background(), &Stack{
+			StackName:   aws.String(stackName),
+			StackStatus: types.StackStatusCreateComplete,
+		}, []string{"AWS::EC2::VPC"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(templateBody).To(MatchJSON(`{"Resources":{"VPC":{"Type":"AWS::EC2::VPC","DeletionPolicy":"Retain","Properties":{"CidrBlock":"192.168.0.0/16"}},"ServiceRole":{"Type":"AWS::IAM::Role","Properties":{}}}}`))
+		Expect(retained).To(Equal([]RetainedResource{
+			{StackName: stackName, LogicalID: "VPC", PhysicalID: "vpc-123", Type: "AWS::EC2::VPC"},
+		}))
+	})
+
+	It("doesn't update the stack if the resources are already retained", func() {
+		mockTemplate(`{"Resources":{"ServiceRole":{"Type":"AWS::IAM::Role","DeletionPolicy":"Retain"}}}`)
+
+		retained, err := sm.RetainResources(context.Background(), &Stack{StackName: aws.String(stackName)}, []string{"AWS::IAM::Role"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retained).To(Equal([]RetainedResource{
+			{StackName: stackName, LogicalID: "ServiceRole", PhysicalID: "role-123", Type: "AWS::IAM::Role"},
+		}))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+	})
+
+	It("returns nothing if the stack has no resources with the given types", func() {
+		mockTemplate(`{"Resources":{"ServiceRole":{"Type":"AWS::IAM::Role"}}}`)
+
+		retained, err := sm.RetainResources(context.Background(), &Stack{StackName: aws.String(stackName)}, []string{"AWS::EC2::VPC"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retained).To(BeEmpty())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStackResources", mock.Anything, mock.Anything)
+	})
+})
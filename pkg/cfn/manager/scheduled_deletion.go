@@ -0,0 +1,33 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+const scheduledDeletionStackSuffix = "-scheduled-deletion"
+
+// MakeScheduledDeletionStackName returns the name of the stack that deletes a cluster at a later time
+func MakeScheduledDeletionStackName(clusterName string) string {
+	return fmt.Sprintf("eksctl-%s%s", clusterName, scheduledDeletionStackSuffix)
+}
+
+// GetScheduledDeletionStack returns the stack that deletes the cluster at a later time, or nil if no deletion
+// is scheduled
+func (c *StackCollection) GetScheduledDeletionStack(ctx context.Context) (*Stack, error) {
+	stack, err := c.DescribeStack(ctx, &Stack{StackName: aws.String(MakeScheduledDeletionStackName(c.spec.Metadata.Name))})
+	if err != nil {
+		if IsStackDoesNotExistError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return stack, nil
+}
+
+func isScheduledDeletionStack(s *Stack) bool {
+	return strings.HasSuffix(*s.StackName, scheduledDeletionStackSuffix)
+}
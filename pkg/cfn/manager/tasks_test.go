@@ -184,6 +184,39 @@ var _ = Describe("StackCollection Tasks", func() {
 	})
 
 	Describe("ManagedNodeGroupTask", func() {
+		When("nodeGroupDefaults.autoScaler is enabled", func() {
+			BeforeEach(func() {
+				p = mockprovider.NewMockProvider()
+				cfg = newClusterConfig("test-cluster")
+				cfg.NodeGroupDefaults = &api.NodeGroupDefaults{AutoScaler: api.Enabled()}
+				stackManager = NewStackCollection(p, cfg)
+			})
+
+			It("appends a task to tag the ASGs of each managed nodegroup", func() {
+				tasks := stackManager.NewManagedNodeGroupTask(context.Background(), makeManagedNodeGroups("m1", "m2"), false, new(vpcfakes.FakeImporter))
+				Expect(tasks.Describe()).To(Equal(`
+4 sequential tasks: { create managed nodegroup "m1", propagate tags to ASG for managed nodegroup "m1", create managed nodegroup "m2", propagate tags to ASG for managed nodegroup "m2" 
+}
+`))
+			})
+
+			It("merges the cluster-autoscaler tags with propagated nodegroup tags", func() {
+				ng := api.NewManagedNodeGroup()
+				ng.Name = "m1"
+				ng.Tags = map[string]string{"team": "infra"}
+				ng.Labels = map[string]string{"role": "worker"}
+				ng.PropagateASGTags = api.Enabled()
+				tags, err := stackManager.(*StackCollection).managedNodeGroupASGTags(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tags).To(Equal(map[string]string{
+					"team":                                   "infra",
+					"k8s.io/cluster-autoscaler/enabled":      "true",
+					"k8s.io/cluster-autoscaler/test-cluster": "owned",
+					"k8s.io/cluster-autoscaler/node-template/label/role": "worker",
+				}))
+			})
+		})
+
 		When("creating managed nodegroups on a ipv6 cluster", func() {
 			var (
 				p            *mockprovider.MockProvider
@@ -197,7 +230,7 @@ var _ = Describe("StackCollection Tasks", func() {
 				stackManager = NewStackCollection(p, cfg)
 			})
 			It("returns an error", func() {
-				p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(&cloudformation.ListStacksOutput{}, nil)
+				p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStacksOutput{}, nil)
 				ng := api.NewManagedNodeGroup()
 				fakeVPCImporter := new(vpcfakes.FakeImporter)
 				tasks := stackManager.NewManagedNodeGroupTask(context.Background(), []*api.ManagedNodeGroup{ng}, false, fakeVPCImporter)
@@ -207,7 +240,7 @@ var _ = Describe("StackCollection Tasks", func() {
 			})
 			When("finding the stack fails", func() {
 				It("returns the stack error", func() {
-					p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+					p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
 					ng := api.NewManagedNodeGroup()
 					fakeVPCImporter := new(vpcfakes.FakeImporter)
 					tasks := stackManager.NewManagedNodeGroupTask(context.Background(), []*api.ManagedNodeGroup{ng}, false, fakeVPCImporter)
@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/progress"
+	"github.com/weaveworks/eksctl/pkg/telemetry"
 )
 
 func (c *StackCollection) troubleshootStackFailureCause(ctx context.Context, i *Stack, desiredStatus string) {
@@ -48,6 +53,31 @@ func (c *StackCollection) troubleshootStackFailureCause(ctx context.Context, i *
 			logger.Info(msg)
 		}
 	}
+	c.explainAccessDenied(ctx, i, events)
+}
+
+// setWaiterDelays shortens the delays between the attempts of a waiter if the provider sets a maximum delay
+func (c *StackCollection) setWaiterDelays(minDelay, maxDelay *time.Duration) {
+	if c.waiterMaxDelay <= 0 {
+		return
+	}
+	*maxDelay = c.waiterMaxDelay
+	if *minDelay > c.waiterMaxDelay {
+		*minDelay = c.waiterMaxDelay
+	}
+}
+
+// traceWait runs wait in a span named name, so that traces and metrics show how long eksctl waited for the stack,
+// and the progress of the command shows how long it's expected to take
+func traceWait(ctx context.Context, name string, i *Stack, wait func(context.Context) error) error {
+	ctx, span := telemetry.StartSpan(ctx, name, attribute.String("eksctl.stack", *i.StackName))
+	endWait := metrics.StartStackWait(name)
+	endPhase := progress.StartStackWait(name, *i.StackName)
+	err := wait(ctx)
+	endPhase(err)
+	endWait(err)
+	telemetry.EndSpan(span, err)
+	return err
 }
 
 type noChangeError struct {
@@ -61,7 +91,11 @@ func (e *noChangeError) Error() string {
 // DoWaitUntilStackIsCreated blocks until the given stack's
 // creation has completed.
 func (c *StackCollection) DoWaitUntilStackIsCreated(ctx context.Context, i *Stack) error {
+	if err := c.ensureCredentials(ctx, c.createTimeout); err != nil {
+		return err
+	}
 	setCustomRetryer := func(o *cloudformation.StackCreateCompleteWaiterOptions) {
+		c.setWaiterDelays(&o.MinDelay, &o.MaxDelay)
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
@@ -70,15 +104,18 @@ func (c *StackCollection) DoWaitUntilStackIsCreated(ctx context.Context, i *Stac
 	}
 
 	waiter := cloudformation.NewStackCreateCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
-		StackName: i.StackName,
-	}, c.waitTimeout, setCustomRetryer)
+	return traceWait(ctx, "wait for stack creation", i, func(ctx context.Context) error {
+		return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+			StackName: i.StackName,
+		}, c.createTimeout, setCustomRetryer)
+	})
 }
 
 func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack, stack builder.ResourceSetReader, errs chan error) {
 	defer close(errs)
 
 	if err := c.DoWaitUntilStackIsCreated(ctx, i); err != nil {
+		c.explainStackCreationFailure(ctx, i)
 		errs <- err
 		return
 	}
@@ -95,7 +132,11 @@ func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack,
 }
 
 func (c *StackCollection) doWaitUntilStackIsDeleted(ctx context.Context, i *Stack) error {
+	if err := c.ensureCredentials(ctx, c.waitTimeout); err != nil {
+		return err
+	}
 	setCustomRetryer := func(o *cloudformation.StackDeleteCompleteWaiterOptions) {
+		c.setWaiterDelays(&o.MinDelay, &o.MaxDelay)
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
@@ -104,9 +145,11 @@ func (c *StackCollection) doWaitUntilStackIsDeleted(ctx context.Context, i *Stac
 	}
 
 	waiter := cloudformation.NewStackDeleteCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
-		StackName: i.StackName,
-	}, c.waitTimeout, setCustomRetryer)
+	return traceWait(ctx, "wait for stack deletion", i, func(ctx context.Context) error {
+		return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+			StackName: i.StackName,
+		}, c.waitTimeout, setCustomRetryer)
+	})
 }
 
 func (c *StackCollection) waitUntilStackIsDeleted(ctx context.Context, i *Stack, errs chan error) {
@@ -120,7 +163,11 @@ func (c *StackCollection) waitUntilStackIsDeleted(ctx context.Context, i *Stack,
 }
 
 func (c *StackCollection) doWaitUntilStackIsUpdated(ctx context.Context, i *Stack) error {
+	if err := c.ensureCredentials(ctx, c.waitTimeout); err != nil {
+		return err
+	}
 	setCustomRetryer := func(o *cloudformation.StackUpdateCompleteWaiterOptions) {
+		c.setWaiterDelays(&o.MinDelay, &o.MaxDelay)
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
@@ -129,13 +176,16 @@ func (c *StackCollection) doWaitUntilStackIsUpdated(ctx context.Context, i *Stac
 	}
 
 	waiter := cloudformation.NewStackUpdateCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
-		StackName: i.StackName,
-	}, c.waitTimeout, setCustomRetryer)
+	return traceWait(ctx, "wait for stack update", i, func(ctx context.Context) error {
+		return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+			StackName: i.StackName,
+		}, c.waitTimeout, setCustomRetryer)
+	})
 }
 
 func (c *StackCollection) doWaitUntilChangeSetIsCreated(ctx context.Context, i *Stack, changesetName string) error {
 	setCustomRetryer := func(o *cloudformation.ChangeSetCreateCompleteWaiterOptions) {
+		c.setWaiterDelays(&o.MinDelay, &o.MaxDelay)
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeChangeSetInput, out *cloudformation.DescribeChangeSetOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation changeset %q for stack %q", changesetName, *i.StackName)
@@ -148,8 +198,10 @@ func (c *StackCollection) doWaitUntilChangeSetIsCreated(ctx context.Context, i *
 	}
 
 	waiter := cloudformation.NewChangeSetCreateCompleteWaiter(c.cloudformationAPI, setCustomRetryer)
-	return waiter.Wait(ctx, &cloudformation.DescribeChangeSetInput{
-		StackName:     i.StackName,
-		ChangeSetName: &changesetName,
-	}, c.waitTimeout)
+	return traceWait(ctx, "wait for changeset creation", i, func(ctx context.Context) error {
+		return waiter.Wait(ctx, &cloudformation.DescribeChangeSetInput{
+			StackName:     i.StackName,
+			ChangeSetName: &changesetName,
+		}, c.waitTimeout)
+	})
 }
@@ -0,0 +1,239 @@
+// Package client lets other Go programs, e.g. Kubernetes controllers, run eksctl operations in-process
+// instead of shelling out to the eksctl binary.
+//
+// Unlike the rest of the packages in this module, the exported API of this package follows semantic
+// versioning: functions and options fields are only removed or changed in a backwards-incompatible way
+// in a new major version of eksctl. Clusters and nodegroups are described with the ClusterConfig type of
+// the eksctl.io/v1alpha5 API, the same type used for config files.
+//
+// The operations run exactly the same code as the corresponding eksctl commands, which means they also
+// log the same output.
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/create"
+	"github.com/weaveworks/eksctl/pkg/ctl/delete"
+)
+
+// AWSOptions holds the AWS options common to all operations
+type AWSOptions struct {
+	// Profile is the AWS credentials profile to use, defaults to the value of AWS_PROFILE
+	Profile string
+	// Timeout is the maximum waiting time for any long-running operation, defaults to 25 minutes
+	Timeout time.Duration
+}
+
+// ConfigOptions holds the options on how the config is loaded, common to all operations
+type ConfigOptions struct {
+	// BaseDir is the directory the relative file paths in the config are resolved against, like the
+	// directory of a config file, defaults to the working directory
+	BaseDir string
+}
+
+// CreateClusterOptions holds the options for CreateCluster
+type CreateClusterOptions struct {
+	AWSOptions
+	ConfigOptions
+
+	// WithoutNodegroups skips creating the nodegroups in the config
+	WithoutNodegroups bool
+	// KubeconfigPath is the path of the kubeconfig file to write, defaults to ~/.kube/config
+	KubeconfigPath string
+	// SkipKubeconfig disables writing the kubeconfig file
+	SkipKubeconfig bool
+}
+
+// CreateNodegroupOptions holds the options for CreateNodegroup
+type CreateNodegroupOptions struct {
+	AWSOptions
+	ConfigOptions
+
+	// Include is a list of globs, only the nodegroups in the config matching them are created
+	Include []string
+	// Exclude is a list of globs, the nodegroups in the config matching them are not created
+	Exclude []string
+	// SkipOutdatedAddonsCheck allows creating ARM nodegroups when the cluster addons are outdated
+	SkipOutdatedAddonsCheck bool
+}
+
+// CreateAddonsOptions holds the options for CreateAddons
+type CreateAddonsOptions struct {
+	AWSOptions
+	ConfigOptions
+
+	// Force overwrites existing addons
+	Force bool
+	// Wait blocks until the addons are active
+	Wait bool
+}
+
+// DeleteClusterOptions holds the options for DeleteCluster
+type DeleteClusterOptions struct {
+	AWSOptions
+	ConfigOptions
+
+	// Wait blocks until all resources are deleted
+	Wait bool
+	// Force continues the deletion when errors occur
+	Force bool
+}
+
+// CreateCluster creates the cluster described by cfg, along with its nodegroups,
+// like `eksctl create cluster --config-file`
+func CreateCluster(ctx context.Context, cfg *api.ClusterConfig, options CreateClusterOptions) error {
+	args := []string{"cluster"}
+	args = append(args, options.AWSOptions.args()...)
+	if options.WithoutNodegroups {
+		args = append(args, "--without-nodegroup")
+	}
+	if options.KubeconfigPath != "" {
+		args = append(args, "--kubeconfig", options.KubeconfigPath)
+	}
+	if options.SkipKubeconfig {
+		args = append(args, "--write-kubeconfig=false")
+	}
+	return execute(ctx, create.Command, cfg, options.ConfigOptions, args)
+}
+
+// CreateNodegroup creates the nodegroups described by cfg in an existing cluster,
+// like `eksctl create nodegroup --config-file`
+func CreateNodegroup(ctx context.Context, cfg *api.ClusterConfig, options CreateNodegroupOptions) error {
+	args := []string{"nodegroup"}
+	args = append(args, options.AWSOptions.args()...)
+	if len(options.Include) > 0 {
+		args = append(args, "--include", strings.Join(options.Include, ","))
+	}
+	if len(options.Exclude) > 0 {
+		args = append(args, "--exclude", strings.Join(options.Exclude, ","))
+	}
+	if options.SkipOutdatedAddonsCheck {
+		args = append(args, "--skip-outdated-addons-check")
+	}
+	return execute(ctx, create.Command, cfg, options.ConfigOptions, args)
+}
+
+// CreateAddons creates the addons described by cfg in an existing cluster,
+// like `eksctl create addon --config-file`
+func CreateAddons(ctx context.Context, cfg *api.ClusterConfig, options CreateAddonsOptions) error {
+	args := []string{"addon"}
+	args = append(args, options.AWSOptions.args()...)
+	if options.Force {
+		args = append(args, "--force")
+	}
+	if options.Wait {
+		args = append(args, "--wait")
+	}
+	return execute(ctx, create.Command, cfg, options.ConfigOptions, args)
+}
+
+// DeleteCluster deletes the cluster described by cfg and all the resources eksctl created for it,
+// like `eksctl delete cluster --config-file`
+func DeleteCluster(ctx context.Context, cfg *api.ClusterConfig, options DeleteClusterOptions) error {
+	args := []string{"cluster"}
+	args = append(args, options.AWSOptions.args()...)
+	args = append(args, "--wait="+strconv.FormatBool(options.Wait))
+	if options.Force {
+		args = append(args, "--force")
+	}
+	return execute(ctx, delete.Command, cfg, options.ConfigOptions, args)
+}
+
+func (o AWSOptions) args() []string {
+	var args []string
+	if o.Profile != "" {
+		args = append(args, "--profile", o.Profile)
+	}
+	if o.Timeout != 0 {
+		args = append(args, "--timeout", o.Timeout.String())
+	}
+	return args
+}
+
+// execute runs the command with the config written to a temporary config file, so that it is
+// loaded and validated the same way as with the CLI
+func execute(ctx context.Context, newVerbCmd func(*cmdutils.FlagGrouping) *cobra.Command, cfg *api.ClusterConfig, configOptions ConfigOptions, args []string) error {
+	if cfg == nil || cfg.Metadata == nil {
+		return cmdutils.ErrMustBeSet("metadata")
+	}
+
+	configFile, err := writeConfigFile(cfg, configOptions.BaseDir)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(configFile)
+
+	verbCmd := newVerbCmd(cmdutils.NewGrouping())
+	verbCmd.SilenceUsage = true
+	verbCmd.SilenceErrors = true
+	verbCmd.SetArgs(append(args, "--config-file", configFile))
+	return verbCmd.ExecuteContext(ctx)
+}
+
+func writeConfigFile(cfg *api.ClusterConfig, baseDir string) (string, error) {
+	cfgCopy := cfg.DeepCopy()
+	cfgCopy.TypeMeta = api.ClusterConfigTypeMeta()
+	if err := resolveFilePaths(cfgCopy, baseDir); err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(cfgCopy)
+	if err != nil {
+		return "", errors.Wrap(err, "marshalling config")
+	}
+
+	file, err := os.CreateTemp("", "eksctl-client-config-")
+	if err != nil {
+		return "", errors.Wrap(err, "creating config file")
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		os.Remove(file.Name())
+		return "", errors.Wrap(err, "writing config file")
+	}
+	return file.Name(), nil
+}
+
+// resolveFilePaths makes the file paths in cfg that are relative to the directory of the config file
+// absolute, as the config file is written to a temporary directory
+func resolveFilePaths(cfg *api.ClusterConfig, baseDir string) error {
+	baseDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return errors.Wrap(err, "resolving base directory")
+	}
+	resolve := func(path *string) {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(baseDir, *path)
+		}
+	}
+
+	if cfg.NodeGroupDefaults != nil {
+		resolve(&cfg.NodeGroupDefaults.PreBootstrapCommandSnippetsFile)
+	}
+	if cfg.IAM != nil {
+		for _, sa := range cfg.IAM.ServiceAccounts {
+			for i := range sa.AttachPolicyFiles {
+				resolve(&sa.AttachPolicyFiles[i])
+			}
+		}
+	}
+	if cfg.Bootstrap != nil {
+		for _, m := range cfg.Bootstrap.Manifests {
+			resolve(&m.File)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestClient(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
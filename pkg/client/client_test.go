@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/client"
+)
+
+var _ = Describe("Client", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = &api.ClusterConfig{
+			Metadata: &api.ClusterMeta{
+				Name: "test-cluster",
+			},
+		}
+	})
+
+	It("requires the config metadata", func() {
+		err := client.CreateCluster(context.Background(), &api.ClusterConfig{}, client.CreateClusterOptions{})
+		Expect(err).To(MatchError("metadata must be set"))
+	})
+
+	It("loads the config like a config file", func() {
+		err := client.CreateCluster(context.Background(), cfg, client.CreateClusterOptions{})
+		Expect(err).To(MatchError("metadata.region must be set"))
+
+		err = client.CreateNodegroup(context.Background(), cfg, client.CreateNodegroupOptions{})
+		Expect(err).To(MatchError(ContainSubstring("metadata.region must be set")))
+
+		err = client.DeleteCluster(context.Background(), cfg, client.DeleteClusterOptions{})
+		Expect(err).To(MatchError("metadata.region must be set"))
+	})
+
+	Context("with relative file paths", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = os.MkdirTemp("", "client")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(tmpDir, "snippets.yaml"), []byte("proxy: [\"echo proxy\"]\n"), 0600)).To(Succeed())
+
+			cfg.NodeGroupDefaults = &api.NodeGroupDefaults{PreBootstrapCommandSnippetsFile: "snippets.yaml"}
+			cfg.NodeGroups = []*api.NodeGroup{{
+				NodeGroupBase: &api.NodeGroupBase{Name: "ng-1", PreBootstrapCommandRefs: []string{"proxy"}},
+			}}
+		})
+
+		AfterEach(func() {
+			_ = os.RemoveAll(tmpDir)
+		})
+
+		It("resolves them against the base dir", func() {
+			err := client.DeleteCluster(context.Background(), cfg, client.DeleteClusterOptions{
+				ConfigOptions: client.ConfigOptions{BaseDir: tmpDir},
+			})
+			Expect(err).To(MatchError("metadata.region must be set"))
+		})
+
+		It("resolves them against the working directory by default", func() {
+			err := client.DeleteCluster(context.Background(), cfg, client.DeleteClusterOptions{})
+			Expect(err).To(MatchError(ContainSubstring("reading preBootstrapCommand snippets file")))
+		})
+	})
+})
@@ -4,18 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
-	"github.com/aws/aws-sdk-go/aws"
-	awsarn "github.com/aws/aws-sdk-go/aws/arn"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
 
 	"github.com/cenk/backoff"
 	"github.com/kris-nova/logger"
@@ -43,7 +43,7 @@ type EKSConnector struct {
 }
 
 type provider interface {
-	EKS() eksiface.EKSAPI
+	EKS() awsapi.EKS
 	STS() awsapi.STS
 	STSPresigner() api.STSPresigner
 	IAM() awsapi.IAM
@@ -58,7 +58,40 @@ type ManifestList struct {
 	IAMIdentityARN         string
 }
 
-var ValidProviders = eks.ConnectorConfigProvider_Values
+// ValidProviders returns the Kubernetes providers supported by EKS Connector
+func ValidProviders() []string {
+	var providers []string
+	for _, p := range ekstypes.ConnectorConfigProvider("").Values() {
+		providers = append(providers, string(p))
+	}
+	return providers
+}
+
+// RegistrationStatus returns the status of the EKS Connector registration of the cluster, or "-" for EKS clusters.
+// EKS reports registered clusters as pending until EKS Connector connects them, which must happen before the
+// activation expires.
+func RegistrationStatus(cluster *ekstypes.Cluster, now time.Time) string {
+	if cluster.ConnectorConfig == nil {
+		return "-"
+	}
+	switch cluster.Status {
+	case ekstypes.ClusterStatusActive:
+		return "connected"
+	case ekstypes.ClusterStatusPending:
+		expiry := cluster.ConnectorConfig.ActivationExpiry
+		if expiry == nil {
+			return "pending"
+		}
+		if now.After(*expiry) {
+			return "activation expired"
+		}
+		return fmt.Sprintf("pending (activation expires %s)", expiry.Format(time.RFC3339))
+	case "":
+		return "-"
+	default:
+		return strings.ToLower(string(cluster.Status))
+	}
+}
 
 // RegisterCluster registers the specified external cluster with EKS and returns a list of Kubernetes resources
 // for EKS Connector.
@@ -68,13 +101,13 @@ func (c *EKSConnector) RegisterCluster(ctx context.Context, cluster ExternalClus
 		return nil, err
 	}
 
-	_, err := c.Provider.EKS().DescribeCluster(&eks.DescribeClusterInput{
+	_, err := c.Provider.EKS().DescribeCluster(ctx, &eks.DescribeClusterInput{
 		Name: aws.String(cluster.Name),
 	})
 
 	if err != nil {
-		awsErr, ok := err.(awserr.Error)
-		if !ok || awsErr.Code() != eks.ErrCodeResourceNotFoundException {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if !errors.As(err, &notFoundErr) {
 			return nil, errors.New("unexpected error calling DescribeCluster")
 		}
 	} else {
@@ -90,7 +123,7 @@ func (c *EKSConnector) RegisterCluster(ctx context.Context, cluster ExternalClus
 		}
 	}
 
-	registerOutput, err := c.registerCluster(cluster, connectorRoleARN)
+	registerOutput, err := c.registerCluster(ctx, cluster, connectorRoleARN)
 	if err != nil {
 		if cluster.ConnectorRoleARN == "" {
 			if deleteErr := c.deleteRoleByARN(ctx, connectorRoleARN); deleteErr != nil {
@@ -102,7 +135,7 @@ func (c *EKSConnector) RegisterCluster(ctx context.Context, cluster ExternalClus
 	return c.createManifests(ctx, registerOutput.Cluster)
 }
 
-func (c *EKSConnector) createManifests(ctx context.Context, cluster *eks.Cluster) (*ManifestList, error) {
+func (c *EKSConnector) createManifests(ctx context.Context, cluster *ekstypes.Cluster) (*ManifestList, error) {
 	stsOutput, err := c.Provider.STS().GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return nil, err
@@ -141,7 +174,7 @@ func validateProvider(provider string) error {
 	return errors.Errorf("invalid provider %q; must be one of %s", provider, strings.Join(ValidProviders(), ", "))
 }
 
-func (c *EKSConnector) registerCluster(cluster ExternalCluster, connectorRoleARN string) (*eks.RegisterClusterOutput, error) {
+func (c *EKSConnector) registerCluster(ctx context.Context, cluster ExternalCluster, connectorRoleARN string) (*eks.RegisterClusterOutput, error) {
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxElapsedTime = 3 * time.Minute
 
@@ -151,18 +184,18 @@ func (c *EKSConnector) registerCluster(cluster ExternalCluster, connectorRoleARN
 	err := backoff.RetryNotify(func() error {
 		var err error
 
-		registerOutput, err = c.Provider.EKS().RegisterCluster(&eks.RegisterClusterInput{
+		registerOutput, err = c.Provider.EKS().RegisterCluster(ctx, &eks.RegisterClusterInput{
 			Name: aws.String(cluster.Name),
-			ConnectorConfig: &eks.ConnectorConfigRequest{
-				Provider: aws.String(cluster.Provider),
+			ConnectorConfig: &ekstypes.ConnectorConfigRequest{
+				Provider: ekstypes.ConnectorConfigProvider(cluster.Provider),
 				RoleArn:  aws.String(connectorRoleARN),
 				// TODO add tags when they're supported by the API.
 			},
 		})
 
 		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok && awsErr.Code() == eks.ErrCodeInvalidRequestException && strings.HasPrefix(awsErr.Message(), "Not existing role") {
+			var invalidRequestErr *ekstypes.InvalidRequestException
+			if errors.As(err, &invalidRequestErr) && strings.HasPrefix(invalidRequestErr.ErrorMessage(), "Not existing role") {
 				logger.Debug("IAM role could not be found; retrying RegisterCluster")
 				return err
 			}
@@ -175,8 +208,8 @@ func (c *EKSConnector) registerCluster(cluster ExternalCluster, connectorRoleARN
 	})
 
 	if err != nil {
-		awsErr, ok := err.(awserr.Error)
-		if ok && awsErr.Code() == eks.ErrCodeInvalidRequestException && strings.Contains(awsErr.Message(), "AWSServiceRoleForAmazonEKSConnector is not available") {
+		var invalidRequestErr *ekstypes.InvalidRequestException
+		if errors.As(err, &invalidRequestErr) && strings.Contains(invalidRequestErr.ErrorMessage(), "AWSServiceRoleForAmazonEKSConnector is not available") {
 			return nil, errors.Wrap(err, "SLR for EKS Connector does not exist; please run `aws iam create-service-linked-role --aws-service-name eks-connector.amazonaws.com` first")
 		}
 		return nil, err
@@ -185,7 +218,7 @@ func (c *EKSConnector) registerCluster(cluster ExternalCluster, connectorRoleARN
 	return registerOutput, nil
 }
 
-func (c *EKSConnector) parseConnectorTemplate(cluster *eks.Cluster) ManifestFile {
+func (c *EKSConnector) parseConnectorTemplate(cluster *ekstypes.Cluster) ManifestFile {
 	activationCode := base64.StdEncoding.EncodeToString([]byte(*cluster.ConnectorConfig.ActivationCode))
 	manifestFile := c.ManifestTemplate.Connector
 	connectorResources := applyVariables(manifestFile.Data, "%EKS_ACTIVATION_ID%", *cluster.ConnectorConfig.ActivationId)
@@ -211,13 +244,13 @@ func applyVariables(template []byte, field, value string) []byte {
 
 // DeregisterCluster deregisters the cluster and removes associated IAM resources.
 func (c *EKSConnector) DeregisterCluster(ctx context.Context, clusterName string) error {
-	clusterOutput, err := c.Provider.EKS().DeregisterCluster(&eks.DeregisterClusterInput{
+	clusterOutput, err := c.Provider.EKS().DeregisterCluster(ctx, &eks.DeregisterClusterInput{
 		Name: aws.String(clusterName),
 	})
 
 	if err != nil {
-		awsErr, ok := err.(awserr.Error)
-		if ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
 			return errors.Errorf("cluster %q does not exist", clusterName)
 		}
 		return errors.Wrap(err, "unexpected error deregistering cluster")
@@ -344,7 +377,7 @@ func (c *EKSConnector) createConnectorRole(ctx context.Context, cluster External
 	_, err = c.Provider.IAM().PutRolePolicy(ctx, &iam.PutRolePolicyInput{
 		RoleName:   roleName,
 		PolicyName: aws.String(connectorPolicyName),
-		PolicyDocument: aws.String(`{
+		PolicyDocument: aws.String(fmt.Sprintf(`{
 	  "Version": "2012-10-17",
 	  "Statement": [
 	    {
@@ -353,7 +386,7 @@ func (c *EKSConnector) createConnectorRole(ctx context.Context, cluster External
 	      "Action": [
 	        "ssmmessages:CreateControlChannel"
 	      ],
-	      "Resource": "arn:aws:eks:*:*:cluster/*"
+	      "Resource": %q
 	    },
 	    {
 	      "Sid": "ssmDataplaneOperations",
@@ -366,7 +399,7 @@ func (c *EKSConnector) createConnectorRole(ctx context.Context, cluster External
 	      "Resource": "*"
 	    }
 	  ]
-	}`),
+	}`, api.ARN(api.Partition(c.Provider.Region()), "eks", "*", "*", "cluster/*"))),
 	})
 
 	if err != nil {
@@ -7,12 +7,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -67,11 +68,11 @@ var _ = Describe("EKS Connector", func() {
 		mockProvider := mockprovider.NewMockProvider()
 
 		mockDescribeCluster(mockProvider, cc.cluster.Name)
-		mockProvider.MockEKS().On("RegisterCluster", mock.MatchedBy(func(input *eks.RegisterClusterInput) bool {
-			return *input.Name == cc.cluster.Name && *input.ConnectorConfig.Provider == strings.ToUpper(cc.cluster.Provider)
+		mockProvider.MockEKS().On("RegisterCluster", mock.Anything, mock.MatchedBy(func(input *eks.RegisterClusterInput) bool {
+			return *input.Name == cc.cluster.Name && string(input.ConnectorConfig.Provider) == strings.ToUpper(cc.cluster.Provider)
 		})).Return(&eks.RegisterClusterOutput{
-			Cluster: &eks.Cluster{
-				ConnectorConfig: &eks.ConnectorConfigResponse{
+			Cluster: &ekstypes.Cluster{
+				ConnectorConfig: &ekstypes.ConnectorConfigResponse{
 					ActivationId:     aws.String("activation-id-123"),
 					ActivationCode:   aws.String("activation-code-123"),
 					ActivationExpiry: aws.Time(time.Now()),
@@ -162,10 +163,10 @@ var _ = Describe("EKS Connector", func() {
 			mockProvider := mockprovider.NewMockProvider()
 
 			mockDescribeCluster(mockProvider, cluster.Name)
-			mockProvider.MockEKS().On("RegisterCluster", mock.MatchedBy(func(input *eks.RegisterClusterInput) bool {
+			mockProvider.MockEKS().On("RegisterCluster", mock.Anything, mock.MatchedBy(func(input *eks.RegisterClusterInput) bool {
 				return *input.Name == cluster.Name
-			})).Return(nil, &eks.InvalidRequestException{
-				Message_: aws.String("Cluster Management role arn:aws:iam::12345:role/aws-service-role/eks-connector.amazonaws.com/AWSServiceRoleForAmazonEKSConnector is not available"),
+			})).Return(nil, &ekstypes.InvalidRequestException{
+				Message: aws.String("Cluster Management role arn:aws:iam::12345:role/aws-service-role/eks-connector.amazonaws.com/AWSServiceRoleForAmazonEKSConnector is not available"),
 			})
 			mockProvider.MockIAM().On("DeleteRole").Return(&iam.DeleteRoleOutput{}, nil)
 
@@ -186,10 +187,10 @@ var _ = Describe("EKS Connector", func() {
 			mockProvider := mockprovider.NewMockProvider()
 
 			mockDescribeCluster(mockProvider, cluster.Name)
-			mockProvider.MockEKS().On("RegisterCluster", mock.MatchedBy(func(input *eks.RegisterClusterInput) bool {
+			mockProvider.MockEKS().On("RegisterCluster", mock.Anything, mock.MatchedBy(func(input *eks.RegisterClusterInput) bool {
 				return *input.Name == cluster.Name
-			})).Return(nil, &eks.InvalidRequestException{
-				Message_: aws.String("test"),
+			})).Return(nil, &ekstypes.InvalidRequestException{
+				Message: aws.String("test"),
 			})
 
 			mockIAM(mockProvider, cluster.Name)
@@ -209,12 +210,39 @@ var _ = Describe("EKS Connector", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	DescribeTable("Registration status", func(cluster *ekstypes.Cluster, expected string) {
+		Expect(connector.RegistrationStatus(cluster, now)).To(Equal(expected))
+	},
+		Entry("EKS cluster", &ekstypes.Cluster{Status: ekstypes.ClusterStatusActive}, "-"),
+		Entry("connected cluster", &ekstypes.Cluster{
+			Status:          ekstypes.ClusterStatusActive,
+			ConnectorConfig: &ekstypes.ConnectorConfigResponse{},
+		}, "connected"),
+		Entry("pending cluster", &ekstypes.Cluster{
+			Status: ekstypes.ClusterStatusPending,
+			ConnectorConfig: &ekstypes.ConnectorConfigResponse{
+				ActivationExpiry: aws.Time(now.Add(time.Hour)),
+			},
+		}, "pending (activation expires 2022-05-01T13:00:00Z)"),
+		Entry("pending cluster with an expired activation", &ekstypes.Cluster{
+			Status: ekstypes.ClusterStatusPending,
+			ConnectorConfig: &ekstypes.ConnectorConfigResponse{
+				ActivationExpiry: aws.Time(now.Add(-time.Hour)),
+			},
+		}, "activation expired"),
+		Entry("failed cluster", &ekstypes.Cluster{
+			Status:          ekstypes.ClusterStatusFailed,
+			ConnectorConfig: &ekstypes.ConnectorConfigResponse{},
+		}, "failed"),
+	)
 })
 
 func mockDescribeCluster(mockProvider *mockprovider.MockProvider, clusterName string) {
-	mockProvider.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *eks.DescribeClusterInput) bool {
+	mockProvider.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *eks.DescribeClusterInput) bool {
 		return *input.Name == clusterName
-	})).Return(nil, &eks.ResourceNotFoundException{
+	})).Return(nil, &ekstypes.ResourceNotFoundException{
 		ClusterName: aws.String(clusterName),
 	})
 }
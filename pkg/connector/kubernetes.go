@@ -109,31 +109,61 @@ func filenameFromURL(u string) (string, error) {
 
 // WriteResources writes the EKS Connector resources to the current directory.
 func WriteResources(fs afero.Fs, manifestList *ManifestList) error {
-	wd, err := os.Getwd()
+	filenames, err := writeResources(fs, manifestList)
 	if err != nil {
-		return errors.Wrap(err, "error getting current directory")
+		return err
+	}
+
+	warnConsoleAccess(manifestList)
+	logger.Info("run `kubectl apply -f %s` before %s to connect the cluster", strings.Join(filenames, ","), manifestList.Expiry.Format(time.RFC822))
+	return nil
+}
+
+// ResourceApplier creates or replaces the objects of a manifest in a cluster
+type ResourceApplier interface {
+	CreateOrReplace(manifest []byte, plan bool) error
+}
+
+// ApplyResources applies the EKS Connector resources to the external cluster, and writes them to the current
+// directory so that they can be deleted after the cluster is deregistered.
+func ApplyResources(fs afero.Fs, applier ResourceApplier, manifestList *ManifestList) error {
+	if _, err := writeResources(fs, manifestList); err != nil {
+		return err
 	}
 
-	writeFile := func(filename string, data []byte) error {
-		if err := afero.WriteFile(fs, path.Join(wd, filename), data, 0664); err != nil {
-			return err
+	for _, m := range manifestResources(manifestList) {
+		if err := applier.CreateOrReplace(m.Data, false); err != nil {
+			return errors.Wrapf(err, "error applying %s", m.Filename)
 		}
-		logger.Info("wrote file %s to %s", filename, wd)
-		return nil
+	}
+
+	warnConsoleAccess(manifestList)
+	return nil
+}
+
+func writeResources(fs afero.Fs, manifestList *ManifestList) ([]string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting current directory")
 	}
 
 	var filenames []string
-	for _, m := range []ManifestFile{manifestList.ConnectorResources, manifestList.ClusterRoleResources, manifestList.ConsoleAccessResources} {
-		if err := writeFile(m.Filename, m.Data); err != nil {
-			return errors.Wrapf(err, "error writing file %s", m.Filename)
+	for _, m := range manifestResources(manifestList) {
+		if err := afero.WriteFile(fs, path.Join(wd, m.Filename), m.Data, 0664); err != nil {
+			return nil, errors.Wrapf(err, "error writing file %s", m.Filename)
 		}
+		logger.Info("wrote file %s to %s", m.Filename, wd)
 		filenames = append(filenames, m.Filename)
 	}
+	return filenames, nil
+}
 
+func manifestResources(manifestList *ManifestList) []ManifestFile {
+	return []ManifestFile{manifestList.ConnectorResources, manifestList.ClusterRoleResources, manifestList.ConsoleAccessResources}
+}
+
+func warnConsoleAccess(manifestList *ManifestList) {
 	logger.Warning(`note: %q and %q give full EKS Console access to IAM identity %q, edit if required; read %s for more info`,
 		manifestList.ClusterRoleResources.Filename, manifestList.ConsoleAccessResources.Filename, manifestList.IAMIdentityARN,
 		"https://docs.aws.amazon.com/eks/latest/userguide/connector-grant-access.html")
-
-	logger.Info("run `kubectl apply -f %s` before %s to connect the cluster", strings.Join(filenames, ","), manifestList.Expiry.Format(time.RFC822))
-	return nil
 }
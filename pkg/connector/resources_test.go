@@ -1,6 +1,7 @@
 package connector_test
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -11,24 +12,38 @@ import (
 	"github.com/weaveworks/eksctl/pkg/connector"
 )
 
+type fakeApplier struct {
+	manifests [][]byte
+	err       error
+}
+
+func (f *fakeApplier) CreateOrReplace(manifest []byte, plan bool) error {
+	f.manifests = append(f.manifests, manifest)
+	return f.err
+}
+
 var _ = Describe("Writing manifests", func() {
+	newManifestList := func() *connector.ManifestList {
+		return &connector.ManifestList{
+			ConnectorResources: connector.ManifestFile{
+				Data:     []byte("connector"),
+				Filename: "eks-connector.yaml",
+			},
+			ClusterRoleResources: connector.ManifestFile{
+				Data:     []byte("clusterrole"),
+				Filename: "eks-connector-clusterrole.yaml",
+			},
+			ConsoleAccessResources: connector.ManifestFile{
+				Data:     []byte("console-dashboard-full-access-group"),
+				Filename: "eks-connector-console-dashboard-full-access-group.yaml",
+			},
+		}
+	}
+
 	Context("WriteResources", func() {
 		It("should write the manifests for EKS Connector", func() {
 			fs := afero.NewMemMapFs()
-			manifestList := &connector.ManifestList{
-				ConnectorResources: connector.ManifestFile{
-					Data:     []byte("connector"),
-					Filename: "eks-connector.yaml",
-				},
-				ClusterRoleResources: connector.ManifestFile{
-					Data:     []byte("clusterrole"),
-					Filename: "eks-connector-clusterrole.yaml",
-				},
-				ConsoleAccessResources: connector.ManifestFile{
-					Data:     []byte("console-dashboard-full-access-group"),
-					Filename: "eks-connector-console-dashboard-full-access-group.yaml",
-				},
-			}
+			manifestList := newManifestList()
 			err := connector.WriteResources(fs, manifestList)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -55,4 +70,31 @@ var _ = Describe("Writing manifests", func() {
 			}
 		})
 	})
+
+	Context("ApplyResources", func() {
+		It("should apply and write the manifests for EKS Connector", func() {
+			fs := afero.NewMemMapFs()
+			applier := &fakeApplier{}
+			Expect(connector.ApplyResources(fs, applier, newManifestList())).To(Succeed())
+
+			Expect(applier.manifests).To(Equal([][]byte{
+				[]byte("connector"),
+				[]byte("clusterrole"),
+				[]byte("console-dashboard-full-access-group"),
+			}))
+
+			wd, err := os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+			files, err := afero.ReadDir(fs, wd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(3))
+		})
+
+		It("should return an error when applying a manifest fails", func() {
+			applier := &fakeApplier{err: errors.New("forbidden")}
+			err := connector.ApplyResources(afero.NewMemMapFs(), applier, newManifestList())
+			Expect(err).To(MatchError("error applying eks-connector.yaml: forbidden"))
+			Expect(applier.manifests).To(HaveLen(1))
+		})
+	})
 })
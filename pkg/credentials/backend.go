@@ -0,0 +1,32 @@
+package credentials
+
+import (
+	"fmt"
+	"os"
+)
+
+const (
+	// EksctlCacheBackendEnvName defines an environment property to select where cached credentials are stored.
+	EksctlCacheBackendEnvName = "EKSCTL_CREDENTIAL_CACHE_BACKEND"
+
+	// CacheBackendFile stores cached credentials in a file, see EksctlCacheFilenameEnvName.
+	CacheBackendFile = "file"
+	// CacheBackendKeyring stores cached credentials in the OS keychain.
+	CacheBackendKeyring = "keyring"
+	// CacheBackendMemory keeps credentials in memory only, for the duration of a single command.
+	CacheBackendMemory = "memory"
+)
+
+// GetCacheBackend returns the credentials cache backend selected with EksctlCacheBackendEnvName,
+// it defaults to CacheBackendFile.
+func GetCacheBackend() (string, error) {
+	switch backend := os.Getenv(EksctlCacheBackendEnvName); backend {
+	case "":
+		return CacheBackendFile, nil
+	case CacheBackendFile, CacheBackendKeyring, CacheBackendMemory:
+		return backend, nil
+	default:
+		return "", fmt.Errorf("invalid value %q for %s, valid values are %q, %q and %q", backend, EksctlCacheBackendEnvName,
+			CacheBackendFile, CacheBackendKeyring, CacheBackendMemory)
+	}
+}
@@ -0,0 +1,101 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/smithy-go"
+	"github.com/kris-nova/logger"
+)
+
+// ExpiryChecker ensures that credentials remain valid for the duration of long-running operations
+type ExpiryChecker struct {
+	// Provider is the provider of the credentials, it is invalidated to force a refresh if it is an *aws.CredentialsCache
+	Provider aws.CredentialsProvider
+	Clock    Clock
+	// FailFast makes Ensure return an error, rather than log a warning, when the credentials
+	// expire before the operation is expected to complete
+	FailFast bool
+	// Prompt, if set, is called when the credentials cannot be retrieved because the session has expired,
+	// to let the user re-authenticate; it returns false if the user could not be prompted
+	Prompt func(err error) bool
+}
+
+// Ensure validates that the credentials can be retrieved and that they remain valid for at least lifetime,
+// refreshing them if they expire earlier
+func (e *ExpiryChecker) Ensure(ctx context.Context, lifetime time.Duration) error {
+	creds, err := e.retrieve(ctx)
+	if err != nil {
+		return err
+	}
+	deadline := e.Clock.Now().Add(lifetime)
+	if !expiresBefore(creds, deadline) {
+		return nil
+	}
+
+	logger.Debug("refreshing credentials expiring at %s", creds.Expires.Format(time.RFC3339))
+	e.invalidate()
+	if creds, err = e.retrieve(ctx); err != nil {
+		return err
+	}
+	if !expiresBefore(creds, deadline) {
+		return nil
+	}
+
+	msg := fmt.Sprintf("AWS credentials expire at %s, before the operation is expected to complete at %s",
+		creds.Expires.Format(time.RFC3339), deadline.Format(time.RFC3339))
+	if e.FailFast {
+		return fmt.Errorf("%s; re-authenticate to get longer-lived credentials or run without --fail-on-credentials-expiry", msg)
+	}
+	logger.Warning("%s; the operation will fail if they cannot be refreshed then", msg)
+	return nil
+}
+
+func (e *ExpiryChecker) retrieve(ctx context.Context) (aws.Credentials, error) {
+	creds, err := e.Provider.Retrieve(ctx)
+	if err == nil {
+		return creds, nil
+	}
+	if !IsSessionExpiredError(err) || e.Prompt == nil || !e.Prompt(err) {
+		return aws.Credentials{}, fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+	e.invalidate()
+	creds, err = e.Provider.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("retrieving AWS credentials after re-authenticating: %w", err)
+	}
+	return creds, nil
+}
+
+func (e *ExpiryChecker) invalidate() {
+	if cache, ok := e.Provider.(*aws.CredentialsCache); ok {
+		cache.Invalidate()
+	}
+}
+
+func expiresBefore(creds aws.Credentials, deadline time.Time) bool {
+	return creds.CanExpire && creds.Expires.Before(deadline)
+}
+
+// IsSessionExpiredError returns whether err was caused by an expired SSO session
+// or a failing credential process, both of which require the user to re-authenticate
+func IsSessionExpiredError(err error) bool {
+	var (
+		tokenErr   *ssocreds.InvalidTokenError
+		processErr *processcreds.ProviderError
+		apiErr     smithy.APIError
+	)
+	switch {
+	case errors.As(err, &tokenErr), errors.As(err, &processErr):
+		return true
+	case errors.As(err, &apiErr):
+		return apiErr.ErrorCode() == "UnauthorizedException"
+	default:
+		return false
+	}
+}
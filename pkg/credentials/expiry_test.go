@@ -0,0 +1,112 @@
+package credentials_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/credentials"
+	"github.com/weaveworks/eksctl/pkg/credentials/fakes"
+)
+
+var _ = Describe("ExpiryChecker", func() {
+	var (
+		now          time.Time
+		fakeProvider *fakes.FakeProvider
+		checker      *credentials.ExpiryChecker
+		prompted     int
+	)
+
+	expiringIn := func(d time.Duration) aws.Credentials {
+		return aws.Credentials{
+			AccessKeyID:     "key",
+			SecretAccessKey: "secret",
+			CanExpire:       true,
+			Expires:         now.Add(d),
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		fakeClock := &fakes.FakeClock{}
+		fakeClock.NowReturns(now)
+		fakeProvider = &fakes.FakeProvider{}
+		prompted = 0
+		checker = &credentials.ExpiryChecker{
+			Provider: aws.NewCredentialsCache(fakeProvider),
+			Clock:    fakeClock,
+			Prompt: func(_ error) bool {
+				prompted++
+				return true
+			},
+		}
+	})
+
+	It("does not refresh credentials that outlive the operation", func() {
+		fakeProvider.RetrieveReturns(expiringIn(time.Hour), nil)
+
+		Expect(checker.Ensure(context.Background(), 25*time.Minute)).To(Succeed())
+		Expect(fakeProvider.RetrieveCallCount()).To(Equal(1))
+	})
+
+	It("does not refresh credentials that never expire", func() {
+		fakeProvider.RetrieveReturns(aws.Credentials{AccessKeyID: "key", SecretAccessKey: "secret"}, nil)
+
+		Expect(checker.Ensure(context.Background(), 25*time.Minute)).To(Succeed())
+		Expect(fakeProvider.RetrieveCallCount()).To(Equal(1))
+	})
+
+	It("refreshes credentials that expire before the operation completes", func() {
+		fakeProvider.RetrieveReturnsOnCall(0, expiringIn(10*time.Minute), nil)
+		fakeProvider.RetrieveReturnsOnCall(1, expiringIn(time.Hour), nil)
+
+		Expect(checker.Ensure(context.Background(), 25*time.Minute)).To(Succeed())
+		Expect(fakeProvider.RetrieveCallCount()).To(Equal(2))
+	})
+
+	It("only warns when the refreshed credentials still expire too early", func() {
+		fakeProvider.RetrieveReturns(expiringIn(10*time.Minute), nil)
+
+		Expect(checker.Ensure(context.Background(), 25*time.Minute)).To(Succeed())
+		Expect(fakeProvider.RetrieveCallCount()).To(Equal(2))
+	})
+
+	It("fails fast when the refreshed credentials still expire too early", func() {
+		checker.FailFast = true
+		fakeProvider.RetrieveReturns(expiringIn(10*time.Minute), nil)
+
+		err := checker.Ensure(context.Background(), 25*time.Minute)
+		Expect(err).To(MatchError(ContainSubstring("AWS credentials expire at")))
+		Expect(err).To(MatchError(ContainSubstring("before the operation is expected to complete")))
+	})
+
+	It("prompts to re-authenticate when the SSO session has expired", func() {
+		fakeProvider.RetrieveReturnsOnCall(0, aws.Credentials{}, &ssocreds.InvalidTokenError{})
+		fakeProvider.RetrieveReturnsOnCall(1, expiringIn(time.Hour), nil)
+
+		Expect(checker.Ensure(context.Background(), 25*time.Minute)).To(Succeed())
+		Expect(prompted).To(Equal(1))
+	})
+
+	It("returns an error when the user cannot be prompted", func() {
+		checker.Prompt = func(_ error) bool { return false }
+		fakeProvider.RetrieveReturns(aws.Credentials{}, &ssocreds.InvalidTokenError{})
+
+		err := checker.Ensure(context.Background(), 25*time.Minute)
+		Expect(err).To(MatchError(ContainSubstring("the SSO session has expired or is invalid")))
+		Expect(fakeProvider.RetrieveCallCount()).To(Equal(1))
+	})
+
+	It("does not prompt for errors unrelated to the session", func() {
+		fakeProvider.RetrieveReturns(aws.Credentials{}, errors.New("no credentials"))
+
+		err := checker.Ensure(context.Background(), 25*time.Minute)
+		Expect(err).To(MatchError(ContainSubstring("no credentials")))
+		Expect(prompted).To(Equal(0))
+	})
+})
@@ -2,14 +2,11 @@ package credentials
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
-	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/kris-nova/logger"
 	"github.com/spf13/afero"
 	"gopkg.in/yaml.v2"
@@ -58,22 +55,18 @@ type Flock interface {
 
 type FlockFunc func(path string) Flock
 
-type cachedCredential struct {
-	Credential credentials.Value
-	Expiration time.Time
+// credentialValue is a cached AWS credential, its fields are those of the credentials.Value of the AWS SDK v1
+// that the cache file was first written with
+type credentialValue struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	ProviderName    string
 }
 
-// FileCacheProvider is a file based AWS Credentials Provider implementing expiry and retrieve.
-type FileCacheProvider struct {
-	credentials      *credentials.Credentials // the underlying implementation that has the *real* Provider
-	cachedCredential cachedCredential         // the cached credential, if it exists
-	profile          string
-	clock            Clock
-	cacheFilePath    string
-
-	fs       afero.Fs
-	newFlock FlockFunc
-	once     sync.Once
+type cachedCredential struct {
+	Credential credentialValue
+	Expiration time.Time
 }
 
 type cacheFile struct {
@@ -115,31 +108,6 @@ func initializeCache(fs afero.Fs, cacheFilePath string) error {
 	return err
 }
 
-// NewFileCacheProvider creates a new filesystem based AWS credential cache. The cache uses Expiry provided by the
-// AWS Go SDK for providers. It wraps the configured credential provider into a file based cache provider. If the provider
-// does not support caching ( I.e.: it doesn't implement IsExpired ) then this file based caching system is ignored
-// and the default credential provider is used. Caches are per profile.
-func NewFileCacheProvider(profile string, creds *credentials.Credentials, clock Clock, fs afero.Fs, newFlock FlockFunc, cacheFilePath string) (FileCacheProvider, error) {
-	if creds == nil {
-		return FileCacheProvider{}, errors.New("no underlying Credentials object provided")
-	}
-
-	if err := initializeCache(fs, cacheFilePath); err != nil {
-		return FileCacheProvider{}, fmt.Errorf("error initializing credentials cache: %w", err)
-	}
-
-	return FileCacheProvider{
-		profile:       profile,
-		credentials:   creds,
-		clock:         clock,
-		cacheFilePath: cacheFilePath,
-		fs:            fs,
-		newFlock:      newFlock,
-	}, nil
-}
-
-// readCacheFile reads the contents of the credential cache and returns the
-// parsed yaml as a cachedCredential object.
 func readCacheFile(fs afero.Fs, filename string, newFlock FlockFunc) (cacheFile, error) {
 	cache := cacheFile{
 		ProfileMap: make(map[string]cachedCredential),
@@ -190,63 +158,6 @@ func writeCache(fs afero.Fs, filename string, newFlock FlockFunc, cache cacheFil
 	return err
 }
 
-// Retrieve implements the Provider interface, returning the cached credential if is not expired,
-// otherwise fetching the credential from the underlying Provider and caching the results on disk
-// with an expiration time.
-func (f *FileCacheProvider) Retrieve() (credentials.Value, error) {
-	f.once.Do(func() {
-		cacheFile, err := readCacheFile(f.fs, f.cacheFilePath, f.newFlock)
-		if err != nil {
-			logger.Warning("error reading cache file: %v", err)
-			return
-		}
-		f.cachedCredential = cacheFile.Get(f.profile)
-	})
-
-	if !f.cachedCredential.Expiration.Before(f.clock.Now()) {
-		// use the cached credential
-		return f.cachedCredential.Credential, nil
-	}
-	logger.Info("No cached credential available.  Refreshing...")
-	// fetch the credentials from the underlying Provider
-	credential, err := f.credentials.Get()
-	if err != nil {
-		return credential, err
-	}
-	expiration, err := f.credentials.ExpiresAt()
-	if err != nil {
-		// credential doesn't support expiration time, so can't cache, but still return the credential
-		logger.Warning("Unable to cache credential: %v\n", err)
-		return credential, nil
-	}
-	// underlying provider supports Expirer interface, so we can cache
-	f.cachedCredential = cachedCredential{
-		Credential: credential,
-		Expiration: expiration,
-	}
-	// overwrite whatever was there before. we don't care about multiple creds for various clusters.
-	// if user switches to another role and another profile they have to re-authenticate.
-	cache, _ := readCacheFile(f.fs, f.cacheFilePath, f.newFlock)
-	cache.Put(f.profile, f.cachedCredential)
-	if err := writeCache(f.fs, f.cacheFilePath, f.newFlock, cache); err != nil {
-		logger.Warning("Unable to update credential cache %s: %v\n", f.cacheFilePath, err)
-		return credential, err
-	}
-	logger.Info("Updated cached credential\n")
-	return credential, nil
-}
-
-// IsExpired implements the Provider interface, deferring to the cached credential first,
-// but fall back to the underlying Provider if it is expired.
-func (f *FileCacheProvider) IsExpired() bool {
-	return f.cachedCredential.Expiration.Before(f.clock.Now()) && f.credentials.IsExpired()
-}
-
-// ExpiresAt implements the Expirer interface, and gives access to the expiration time of the credential
-func (f *FileCacheProvider) ExpiresAt() time.Time {
-	return f.cachedCredential.Expiration
-}
-
 func parseCacheFile(fs afero.Fs, filename string) (cacheFile, error) {
 	cache := cacheFile{
 		ProfileMap: make(map[string]cachedCredential),
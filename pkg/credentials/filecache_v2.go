@@ -6,7 +6,6 @@ import (
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/kris-nova/logger"
 	"github.com/spf13/afero"
 )
@@ -89,7 +88,7 @@ func (f *FileCacheV2) Retrieve(ctx context.Context) (aws.Credentials, error) {
 		return creds, nil
 	}
 	cache.Put(f.profileName, cachedCredential{
-		Credential: credentials.Value{
+		Credential: credentialValue{
 			AccessKeyID:     creds.AccessKeyID,
 			SecretAccessKey: creds.SecretAccessKey,
 			SessionToken:    creds.SessionToken,
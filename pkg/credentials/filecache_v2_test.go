@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -44,8 +43,15 @@ var _ = Describe("FileCacheV2", func() {
 		expectedCacheFileMissing bool
 	}
 
+	type credentialValue struct {
+		AccessKeyID     string
+		SecretAccessKey string
+		SessionToken    string
+		ProviderName    string
+	}
+
 	type cachedCredential struct {
-		Credential awscredentials.Value
+		Credential credentialValue
 		Expiration time.Time
 	}
 
@@ -122,7 +128,7 @@ var _ = Describe("FileCacheV2", func() {
 				data, err := yaml.Marshal(map[string]map[string]cachedCredential{
 					"profiles": {
 						"test": {
-							Credential: awscredentials.Value{
+							Credential: credentialValue{
 								AccessKeyID:     "k123",
 								SecretAccessKey: "s123",
 								SessionToken:    "t123",
@@ -163,7 +169,7 @@ var _ = Describe("FileCacheV2", func() {
 				data, err := yaml.Marshal(map[string]map[string]cachedCredential{
 					"profiles": {
 						"test": {
-							Credential: awscredentials.Value{
+							Credential: credentialValue{
 								AccessKeyID:     "k123",
 								SecretAccessKey: "s123",
 								SessionToken:    "t123",
@@ -211,7 +217,7 @@ var _ = Describe("FileCacheV2", func() {
 				data, err := yaml.Marshal(map[string]map[string]cachedCredential{
 					"profiles": {
 						"eksctl": {
-							Credential: awscredentials.Value{
+							Credential: credentialValue{
 								AccessKeyID:     "a123",
 								SecretAccessKey: "s123",
 								SessionToken:    "t123",
@@ -270,5 +276,25 @@ var _ = Describe("FileCacheV2", func() {
 			},
 			expectedCacheFileMissing: true,
 		}),
+
+		Entry("a cache file that is not private is refused", fileCacheEntry{
+			createProvider: func() provider {
+				return &fakes.FakeProvider{}
+			},
+			setupCache: func(fs afero.Fs) error {
+				return afero.WriteFile(fs, cacheFilePath, []byte("test:"), 0777)
+			},
+			expectedErr: "is not private",
+		}),
+
+		Entry("a corrupted cache file is refused", fileCacheEntry{
+			createProvider: func() provider {
+				return &fakes.FakeProvider{}
+			},
+			setupCache: func(fs afero.Fs) error {
+				return afero.WriteFile(fs, cacheFilePath, []byte("not valid yaml"), 0600)
+			},
+			expectedErr: "unable to parse file",
+		}),
 	)
 })
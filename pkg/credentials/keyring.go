@@ -0,0 +1,111 @@
+package credentials
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrKeyringItemNotFound is returned by Keyring.Get when no secret is stored for the account.
+var ErrKeyringItemNotFound = errors.New("item not found in keyring")
+
+const keyringService = "eksctl"
+
+// Keyring stores secrets in the OS keychain.
+//counterfeiter:generate -o fakes/fake_keyring.go . Keyring
+type Keyring interface {
+	// Get returns the secret stored for account, or ErrKeyringItemNotFound.
+	Get(account string) (string, error)
+	// Set stores secret for account, replacing any existing secret.
+	Set(account, secret string) error
+}
+
+// NewOSKeyring returns a Keyring backed by the macOS keychain or, on Linux, by the Secret Service
+// through secret-tool. Secrets are never passed as command-line arguments.
+func NewOSKeyring() Keyring {
+	switch runtime.GOOS {
+	case "darwin":
+		return macOSKeychain{}
+	case "linux":
+		return secretServiceKeyring{}
+	default:
+		return unsupportedKeyring{}
+	}
+}
+
+type macOSKeychain struct{}
+
+// itemNotFoundExitCode is the exit code of `security find-generic-password` when there is no matching item.
+const itemNotFoundExitCode = 44
+
+func (macOSKeychain) Get(account string) (string, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", keyringService, "-a", account, "-w").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == itemNotFoundExitCode {
+			return "", ErrKeyringItemNotFound
+		}
+		return "", fmt.Errorf("reading from the macOS keychain: %w", err)
+	}
+	secret, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(out)))
+	if err != nil {
+		return "", fmt.Errorf("decoding secret from the macOS keychain: %w", err)
+	}
+	return string(secret), nil
+}
+
+func (macOSKeychain) Set(account, secret string) error {
+	// the interactive mode reads the command from stdin so that the secret doesn't show up in the process list
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+		quoteKeychainArg(keyringService), quoteKeychainArg(account), base64.StdEncoding.EncodeToString([]byte(secret))))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("writing to the macOS keychain: %w: %s", err, bytes.TrimSpace(out))
+	}
+	return nil
+}
+
+func quoteKeychainArg(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+type secretServiceKeyring struct{}
+
+func (secretServiceKeyring) Get(account string) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("secret-tool", "lookup", "service", keyringService, "account", account)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(out) == 0 && stderr.Len() == 0 {
+			return "", ErrKeyringItemNotFound
+		}
+		return "", fmt.Errorf("reading from the Secret Service: %w: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return string(out), nil
+}
+
+func (secretServiceKeyring) Set(account, secret string) error {
+	cmd := exec.Command("secret-tool", "store", "--label", fmt.Sprintf("eksctl credentials (%s)", account),
+		"service", keyringService, "account", account)
+	cmd.Stdin = strings.NewReader(secret)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("writing to the Secret Service: %w: %s", err, bytes.TrimSpace(out))
+	}
+	return nil
+}
+
+type unsupportedKeyring struct{}
+
+func (unsupportedKeyring) Get(_ string) (string, error) {
+	return "", fmt.Errorf("the %q credentials cache backend is not supported on %s", CacheBackendKeyring, runtime.GOOS)
+}
+
+func (unsupportedKeyring) Set(_, _ string) error {
+	return fmt.Errorf("the %q credentials cache backend is not supported on %s", CacheBackendKeyring, runtime.GOOS)
+}
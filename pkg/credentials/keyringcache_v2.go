@@ -0,0 +1,91 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/kris-nova/logger"
+)
+
+// KeyringCacheV2 is a credentials cache for AWS credentials that can expire, backed by the OS keychain,
+// satisfying the aws.CredentialsProvider interface.
+// It is meant to be wrapped with aws.CredentialsCache. The cache is per profile.
+type KeyringCacheV2 struct {
+	provider    aws.CredentialsProvider
+	profileName string
+	keyring     Keyring
+	clock       Clock
+
+	creds *aws.Credentials
+	mu    sync.Mutex
+}
+
+// NewKeyringCacheV2 returns a *KeyringCacheV2 storing the credentials retrieved from provider in keyring.
+func NewKeyringCacheV2(provider aws.CredentialsProvider, profileName string, keyring Keyring, clock Clock) *KeyringCacheV2 {
+	return &KeyringCacheV2{
+		provider:    provider,
+		profileName: profileName,
+		keyring:     keyring,
+		clock:       clock,
+	}
+}
+
+// Retrieve implements aws.CredentialsProvider.
+func (k *KeyringCacheV2) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.creds == nil {
+		k.creds = k.readKeyring()
+	}
+
+	if k.creds != nil && k.creds.CanExpire && k.creds.Expires.After(k.clock.Now().Round(0)) {
+		return *k.creds, nil
+	}
+
+	creds, err := k.provider.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	k.creds = &creds
+
+	if !creds.CanExpire {
+		return creds, nil
+	}
+
+	data, err := json.Marshal(creds)
+	if err != nil {
+		logger.Warning("failed to encode credentials for the keyring: %v", err)
+		return creds, nil
+	}
+	if err := k.keyring.Set(k.account(), string(data)); err != nil {
+		logger.Warning("failed to update credentials cache: %v", err)
+	}
+	return creds, nil
+}
+
+func (k *KeyringCacheV2) readKeyring() *aws.Credentials {
+	data, err := k.keyring.Get(k.account())
+	if err != nil {
+		if !errors.Is(err, ErrKeyringItemNotFound) {
+			logger.Warning("error reading credentials cache: %v", err)
+		}
+		return nil
+	}
+	var creds aws.Credentials
+	if err := json.Unmarshal([]byte(data), &creds); err != nil {
+		logger.Warning("error parsing cached credentials: %v", err)
+		return nil
+	}
+	return &creds
+}
+
+func (k *KeyringCacheV2) account() string {
+	if k.profileName == "" {
+		return "default"
+	}
+	return k.profileName
+}
@@ -0,0 +1,135 @@
+package credentials_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/credentials"
+	"github.com/weaveworks/eksctl/pkg/credentials/fakes"
+)
+
+var _ = Describe("KeyringCacheV2", func() {
+	var (
+		now          time.Time
+		fakeProvider *fakes.FakeProvider
+		fakeKeyring  *fakes.FakeKeyring
+		cache        *credentials.KeyringCacheV2
+	)
+
+	makeCredentials := func(accessKeyID string, expires time.Time) aws.Credentials {
+		return aws.Credentials{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: "secret",
+			SessionToken:    "token",
+			CanExpire:       true,
+			Expires:         expires,
+		}
+	}
+
+	encode := func(creds aws.Credentials) string {
+		data, err := json.Marshal(creds)
+		Expect(err).NotTo(HaveOccurred())
+		return string(data)
+	}
+
+	BeforeEach(func() {
+		now = time.Now().UTC()
+		fakeClock := &fakes.FakeClock{}
+		fakeClock.NowReturns(now)
+		fakeProvider = &fakes.FakeProvider{}
+		fakeKeyring = &fakes.FakeKeyring{}
+		cache = credentials.NewKeyringCacheV2(fakeProvider, "dev", fakeKeyring, fakeClock)
+	})
+
+	It("returns unexpired credentials from the keyring", func() {
+		cached := makeCredentials("cached", now.Add(time.Hour))
+		fakeKeyring.GetReturns(encode(cached), nil)
+
+		creds, err := cache.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.AccessKeyID).To(Equal("cached"))
+		Expect(fakeKeyring.GetArgsForCall(0)).To(Equal("dev"))
+		Expect(fakeProvider.RetrieveCallCount()).To(BeZero())
+	})
+
+	It("stores credentials retrieved from the provider when the keyring has none", func() {
+		fakeKeyring.GetReturns("", credentials.ErrKeyringItemNotFound)
+		retrieved := makeCredentials("retrieved", now.Add(time.Hour))
+		fakeProvider.RetrieveReturns(retrieved, nil)
+
+		creds, err := cache.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.AccessKeyID).To(Equal("retrieved"))
+		Expect(fakeKeyring.SetCallCount()).To(Equal(1))
+		account, secret := fakeKeyring.SetArgsForCall(0)
+		Expect(account).To(Equal("dev"))
+		Expect(secret).To(MatchJSON(encode(retrieved)))
+	})
+
+	It("refreshes expired credentials", func() {
+		fakeKeyring.GetReturns(encode(makeCredentials("cached", now.Add(-time.Minute))), nil)
+		fakeProvider.RetrieveReturns(makeCredentials("retrieved", now.Add(time.Hour)), nil)
+
+		creds, err := cache.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.AccessKeyID).To(Equal("retrieved"))
+		Expect(fakeKeyring.SetCallCount()).To(Equal(1))
+	})
+
+	It("does not store credentials that never expire", func() {
+		fakeKeyring.GetReturns("", credentials.ErrKeyringItemNotFound)
+		fakeProvider.RetrieveReturns(aws.Credentials{AccessKeyID: "static", SecretAccessKey: "secret"}, nil)
+
+		_, err := cache.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeKeyring.SetCallCount()).To(BeZero())
+	})
+
+	It("still returns credentials when the keyring is unavailable", func() {
+		fakeKeyring.GetReturns("", errors.New("no keyring"))
+		fakeKeyring.SetReturns(errors.New("no keyring"))
+		fakeProvider.RetrieveReturns(makeCredentials("retrieved", now.Add(time.Hour)), nil)
+
+		creds, err := cache.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.AccessKeyID).To(Equal("retrieved"))
+	})
+
+	It("returns provider errors", func() {
+		fakeKeyring.GetReturns("", credentials.ErrKeyringItemNotFound)
+		fakeProvider.RetrieveReturns(aws.Credentials{}, errors.New("access denied"))
+
+		_, err := cache.Retrieve(context.Background())
+		Expect(err).To(MatchError("access denied"))
+		Expect(fakeKeyring.SetCallCount()).To(BeZero())
+	})
+})
+
+var _ = Describe("GetCacheBackend", func() {
+	AfterEach(func() {
+		Expect(os.Unsetenv(credentials.EksctlCacheBackendEnvName)).To(Succeed())
+	})
+
+	It("defaults to the file backend", func() {
+		Expect(os.Unsetenv(credentials.EksctlCacheBackendEnvName)).To(Succeed())
+		Expect(credentials.GetCacheBackend()).To(Equal(credentials.CacheBackendFile))
+	})
+
+	It("returns the selected backend", func() {
+		Expect(os.Setenv(credentials.EksctlCacheBackendEnvName, "keyring")).To(Succeed())
+		Expect(credentials.GetCacheBackend()).To(Equal(credentials.CacheBackendKeyring))
+	})
+
+	It("rejects unknown backends", func() {
+		Expect(os.Setenv(credentials.EksctlCacheBackendEnvName, "vault")).To(Succeed())
+		_, err := credentials.GetCacheBackend()
+		Expect(err).To(MatchError(ContainSubstring(`invalid value "vault" for EKSCTL_CREDENTIAL_CACHE_BACKEND`)))
+	})
+})
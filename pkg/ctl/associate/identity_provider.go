@@ -1,6 +1,7 @@
 package associate
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -19,6 +20,7 @@ func associateIdentityProvider(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("identityprovider", "Associate an identity provider with a cluster", "")
+	cmd.Mutating = true
 
 	var timeout time.Duration
 
@@ -53,13 +55,14 @@ func newAssociateIdentityProviderLoader(cmd *cmdutils.Cmd) cmdutils.ClusterConfi
 }
 
 func doAssociateIdentityProvider(cmd *cmdutils.Cmd, timeout time.Duration) error {
+	ctx := context.TODO()
 	if err := newAssociateIdentityProviderLoader(cmd).Load(); err != nil {
 		return err
 	}
 
 	cfg := cmd.ClusterConfig
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -80,5 +83,5 @@ func doAssociateIdentityProvider(cmd *cmdutils.Cmd, timeout time.Duration) error
 		options.WaitTimeout = &timeout
 	}
 
-	return manager.Associate(options)
+	return manager.Associate(ctx, options)
 }
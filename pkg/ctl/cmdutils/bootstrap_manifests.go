@@ -0,0 +1,60 @@
+package cmdutils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// loadBootstrapManifests reads the manifests referenced by bootstrap.manifests[*].file and bootstrap.manifests[*].url
+// and stores them, along with inline manifests, in Document. Manifests are templated when they are applied, once the
+// resources they can reference exist
+func loadBootstrapManifests(clusterConfig *api.ClusterConfig, configFile string) error {
+	if clusterConfig.Bootstrap == nil {
+		return nil
+	}
+
+	baseDir := ""
+	if configFile != "-" {
+		baseDir = filepath.Dir(configFile)
+	}
+
+	for i, m := range clusterConfig.Bootstrap.Manifests {
+		path := fmt.Sprintf("bootstrap.manifests[%d]", i)
+		switch {
+		case m.File != "":
+			file := m.File
+			if !filepath.IsAbs(file) {
+				file = filepath.Join(baseDir, file)
+			}
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return errors.Wrapf(err, "loading %s.file", path)
+			}
+			m.Document = string(data)
+		case m.URL != "":
+			data, err := fetchManifest(m.URL)
+			if err != nil {
+				return errors.Wrapf(err, "loading %s.url", path)
+			}
+			m.Document = string(data)
+		default:
+			m.Document = m.Content
+		}
+	}
+	return nil
+}
+
+func fetchManifest(url string) ([]byte, error) {
+	body, err := fetchPolicyDocument(url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
@@ -0,0 +1,69 @@
+package cmdutils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("bootstrap manifests", func() {
+	const manifest = "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: {{ .ClusterName }}\n"
+
+	var (
+		cfg    *api.ClusterConfig
+		dir    string
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "bootstrap-manifests")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(dir, "namespace.yaml"), []byte(manifest), 0644)).To(Succeed())
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/namespace.yaml" {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprint(w, manifest)
+		}))
+
+		cfg = api.NewClusterConfig()
+		cfg.Bootstrap = &api.Bootstrap{}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("loads manifests from files relative to the config file, URLs and inline content without templating them", func() {
+		cfg.Bootstrap.Manifests = []*api.BootstrapManifest{
+			{Name: "file", File: "namespace.yaml"},
+			{Name: "url", URL: server.URL + "/namespace.yaml"},
+			{Name: "content", Content: manifest},
+		}
+
+		Expect(loadBootstrapManifests(cfg, filepath.Join(dir, "cluster.yaml"))).To(Succeed())
+		for _, m := range cfg.Bootstrap.Manifests {
+			Expect(m.Document).To(Equal(manifest))
+		}
+	})
+
+	It("returns an error when a manifest cannot be fetched", func() {
+		cfg.Bootstrap.Manifests = []*api.BootstrapManifest{
+			{Name: "url", URL: server.URL + "/missing.yaml"},
+		}
+
+		err := loadBootstrapManifests(cfg, filepath.Join(dir, "cluster.yaml"))
+		Expect(err).To(MatchError(ContainSubstring("loading bootstrap.manifests[0].url")))
+	})
+})
@@ -2,13 +2,19 @@ package cmdutils
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/aws/smithy-go"
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/lock"
 )
 
 var once sync.Once
@@ -29,17 +35,61 @@ type Cmd struct {
 	ClusterConfig  *api.ClusterConfig
 
 	Include, Exclude []string
+
+	// Mutating is set by the commands that change clusters, they hold the lock of the cluster while they run.
+	// Commands that only change clusters with some flags can unset it before calling NewCtl when these flags
+	// aren't set
+	Mutating bool
+	// LockTimeout is how long mutating commands wait for the lock of the cluster
+	LockTimeout time.Duration
+
+	lock *lock.Lock
+	// lockContext is cancelled when the lock of the cluster is lost while the command runs
+	lockContext       context.Context
+	cancelLockContext context.CancelFunc
 }
 
 // NewCtl performs common defaulting and validation and constructs a new
 // instance of eks.ClusterProvider, it may return an error if configuration
 // is invalid or region is not supported
 func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
+	if err := c.SetDefaultsAndValidate(); err != nil {
+		return nil, err
+	}
+
+	ctl, err := eks.New(context.TODO(), &c.ProviderConfig, c.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	//prevent logging multiple times
+	once.Do(func() {
+		logRegionAndVersionInfo(c.ClusterConfig.Metadata)
+	})
+
+	if !ctl.IsSupportedRegion() {
+		return nil, ErrUnsupportedRegion(&c.ProviderConfig)
+	}
+
+	if err := api.ValidatePartitionFeatures(c.ClusterConfig); err != nil {
+		return nil, err
+	}
+
+	if err := c.acquireLock(ctl); err != nil {
+		return nil, err
+	}
+
+	return ctl, nil
+}
+
+// SetDefaultsAndValidate sets the defaults of the ClusterConfig and its nodegroups and validates them, validation
+// errors of the cluster and unmanaged nodegroups are only logged if validation is disabled
+func (c *Cmd) SetDefaultsAndValidate() error {
 	api.SetClusterConfigDefaults(c.ClusterConfig)
 
 	if err := api.ValidateClusterConfig(c.ClusterConfig); err != nil {
 		if c.Validate {
-			return nil, err
+			return err
 		}
 		logger.Warning("ignoring validation error: %s", err.Error())
 	}
@@ -47,7 +97,7 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 	for i, ng := range c.ClusterConfig.NodeGroups {
 		if err := api.ValidateNodeGroup(i, ng); err != nil {
 			if c.Validate {
-				return nil, err
+				return err
 			}
 			logger.Warning("ignoring validation error: %s", err.Error())
 		}
@@ -59,41 +109,101 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 	for i, ng := range c.ClusterConfig.ManagedNodeGroups {
 		api.SetManagedNodeGroupDefaults(ng, c.ClusterConfig.Metadata)
 		if err := api.ValidateManagedNodeGroup(i, ng); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	ctl, err := eks.New(context.TODO(), &c.ProviderConfig, c.ClusterConfig)
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+// acquireLock acquires the lock of the cluster for mutating commands, it's released when the command returns
+func (c *Cmd) acquireLock(ctl *eks.ClusterProvider) error {
+	if !c.Mutating || c.lock != nil || c.ClusterConfig.Metadata.Name == "" {
+		return nil
 	}
 
-	//prevent logging multiple times
-	once.Do(func() {
-		logRegionAndVersionInfo(c.ClusterConfig.Metadata)
-	})
+	l, err := c.LockCluster(ctl, c.ClusterConfig.Metadata.Name)
+	if err != nil || l == nil {
+		return err
+	}
+	c.lock = l
+
+	ctx, cancel := context.WithCancel(c.Context())
+	go func() {
+		select {
+		case <-l.Lost():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	c.lockContext, c.cancelLockContext = ctx, cancel
+	return nil
+}
 
-	if !ctl.IsSupportedRegion() {
-		return nil, ErrUnsupportedRegion(&c.ProviderConfig)
+// LockCluster acquires the lock of a cluster, waiting for it up to --lock-timeout, for mutating commands that change
+// clusters other than the one of the command. It returns a nil lock when the credentials don't allow locking clusters,
+// the caller must release the lock otherwise
+func (c *Cmd) LockCluster(ctl *eks.ClusterProvider, clusterName string) (*lock.Lock, error) {
+	l := lock.New(ctl.Provider.SSM(), clusterName)
+	if err := l.Acquire(c.Context(), c.LockTimeout); err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "AccessDeniedException" {
+			logger.Warning("not locking cluster %q as the SSM parameter %q can't be written, concurrent eksctl invocations may change the cluster: %v",
+				clusterName, lock.ParameterName(clusterName), err)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("locking cluster %q (use `eksctl utils force-unlock` if no other eksctl invocation is running): %w", clusterName, err)
 	}
+	return l, nil
+}
 
-	return ctl, nil
+// releaseLock releases the lock of the cluster, it returns an error if the lock was lost while the command ran
+func (c *Cmd) releaseLock() error {
+	if c.lock == nil {
+		return nil
+	}
+	err := c.lock.Release(context.TODO())
+	c.lock = nil
+	c.cancelLockContext()
+	c.lockContext, c.cancelLockContext = nil, nil
+	if errors.Is(err, lock.ErrLost) {
+		return fmt.Errorf("cluster %q: %w", c.ClusterConfig.Metadata.Name, err)
+	}
+	if err != nil {
+		logger.Warning("failed to release the lock of cluster %q: %v", c.ClusterConfig.Metadata.Name, err)
+	}
+	return nil
 }
 
 // NewProviderForExistingCluster is a wrapper for NewCtl that also validates that the cluster exists and is not a
 // registered/connected cluster.
-func (c *Cmd) NewProviderForExistingCluster() (*eks.ClusterProvider, error) {
+func (c *Cmd) NewProviderForExistingCluster(ctx context.Context) (*eks.ClusterProvider, error) {
 	provider, err := c.NewCtl()
 	if err != nil {
 		return nil, err
 	}
-	if err := provider.RefreshClusterStatus(c.ClusterConfig); err != nil {
+	if err := provider.RefreshClusterStatus(ctx, c.ClusterConfig); err != nil {
 		return nil, err
 	}
 
 	return provider, nil
 }
 
+// Context returns the context the command is executed with, so that callers
+// embedding eksctl can cancel long-running operations. It's cancelled when
+// the lock of the cluster is lost
+func (c *Cmd) Context() context.Context {
+	if c.lockContext != nil {
+		return c.lockContext
+	}
+	if c.CobraCommand != nil {
+		if ctx := c.CobraCommand.Context(); ctx != nil {
+			return ctx
+		}
+	}
+	return context.TODO()
+}
+
 // AddResourceCmd create a registers a new command under the given verb command
 func AddResourceCmd(flagGrouping *FlagGrouping, parentVerbCmd *cobra.Command, newCmd func(*Cmd)) {
 	c := &Cmd{
@@ -108,6 +218,26 @@ func AddResourceCmd(flagGrouping *FlagGrouping, parentVerbCmd *cobra.Command, ne
 	}
 	c.FlagSetGroup = flagGrouping.New(c.CobraCommand)
 	newCmd(c)
+	if c.Mutating {
+		c.FlagSetGroup.InFlagSet("AWS client", func(fs *pflag.FlagSet) {
+			fs.DurationVar(&c.LockTimeout, "lock-timeout", 0, "maximum waiting time for other eksctl invocations changing the cluster to finish")
+		})
+		if runE := c.CobraCommand.RunE; runE != nil {
+			c.CobraCommand.RunE = func(cmd *cobra.Command, args []string) (err error) {
+				defer func() {
+					releaseErr := c.releaseLock()
+					switch {
+					case releaseErr == nil:
+					case err == nil:
+						err = releaseErr
+					default:
+						logger.Critical("%v", releaseErr)
+					}
+				}()
+				return runE(cmd, args)
+			}
+		}
+	}
 	c.FlagSetGroup.AddTo(c.CobraCommand)
 	parentVerbCmd.AddCommand(c.CobraCommand)
 }
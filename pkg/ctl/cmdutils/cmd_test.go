@@ -0,0 +1,73 @@
+package cmdutils
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("AddResourceCmd", func() {
+	DescribeTable("adds --lock-timeout to the commands that change clusters",
+		func(mutating bool) {
+			verbCmd := NewVerbCmd("utils", "", "")
+			AddResourceCmd(NewGrouping(), verbCmd, func(cmd *Cmd) {
+				cmd.SetDescription("command", "", "")
+				cmd.Mutating = mutating
+				cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error { return nil }
+			})
+
+			resourceCmd, _, err := verbCmd.Find([]string{"command"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceCmd.Flags().Lookup("lock-timeout") != nil).To(Equal(mutating))
+		},
+		Entry("mutating", true),
+		Entry("read-only", false),
+	)
+})
+
+var _ = Describe("AddCommonFlagsForAWS", func() {
+	DescribeTable("sets the endpoints mode with --fips",
+		func(args []string, expectedMode string) {
+			cmd := &cobra.Command{}
+			group := NewGrouping().New(cmd)
+			var p api.ProviderConfig
+			AddCommonFlagsForAWS(group, &p, false)
+			group.AddTo(cmd)
+
+			Expect(cmd.ParseFlags(args)).To(Succeed())
+			Expect(p.EndpointsMode).To(Equal(expectedMode))
+		},
+		Entry("without the flag", []string{}, ""),
+		Entry("with the flag", []string{"--fips"}, api.EndpointsModeFIPS),
+		Entry("with the flag disabled", []string{"--fips=false"}, api.EndpointsModeDefault),
+	)
+
+	DescribeTable("sets the endpoints mode with --dual-stack",
+		func(args []string, expectedMode string) {
+			cmd := &cobra.Command{}
+			group := NewGrouping().New(cmd)
+			var p api.ProviderConfig
+			AddCommonFlagsForAWS(group, &p, false)
+			group.AddTo(cmd)
+
+			Expect(cmd.ParseFlags(args)).To(Succeed())
+			Expect(p.EndpointsMode).To(Equal(expectedMode))
+		},
+		Entry("with the flag", []string{"--dual-stack"}, api.EndpointsModeDualStack),
+		Entry("with the flag disabled", []string{"--dual-stack=false"}, api.EndpointsModeDefault),
+		Entry("with --fips disabled", []string{"--fips=false", "--dual-stack"}, api.EndpointsModeDualStack),
+	)
+
+	It("rejects --fips with --dual-stack", func() {
+		cmd := &cobra.Command{}
+		group := NewGrouping().New(cmd)
+		var p api.ProviderConfig
+		AddCommonFlagsForAWS(group, &p, false)
+		group.AddTo(cmd)
+
+		Expect(cmd.ParseFlags([]string{"--fips", "--dual-stack"})).To(MatchError(ContainSubstring("cannot be used with fips endpoints")))
+	})
+})
@@ -3,6 +3,7 @@ package cmdutils
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,8 +13,10 @@ import (
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -104,18 +107,76 @@ func GetNameArg(args []string) string {
 	return ""
 }
 
+// AddPrintTaskPlanFlag adds a flag to print the plan of the tasks of cmd before they run
+func AddPrintTaskPlanFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	printTaskPlan := fs.Bool("print-task-plan", false, "print the tasks to run, which of them run in parallel and which tasks each task waits for")
+	AddPreRun(cmd.CobraCommand, func(_ *cobra.Command, _ []string) {
+		if *printTaskPlan {
+			tasks.PlanWriter = os.Stdout
+		}
+	})
+}
+
 // AddCommonFlagsForAWS adds common flags for api.ProviderConfig
 func AddCommonFlagsForAWS(group *NamedFlagSetGroup, p *api.ProviderConfig, addCfnOptions bool) {
 	group.InFlagSet("AWS client", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&p.Profile, "profile", "p", os.Getenv("AWS_PROFILE"), "AWS credentials profile to use (defaults to value of the AWS_PROFILE environment variable)")
+		fs.IntVar(&p.Retry.MaxAttempts, "aws-max-attempts", 0, "maximum number of attempts for each AWS API call (defaults to value of the AWS_MAX_ATTEMPTS environment variable, or 13)")
+		fs.StringVar(&p.Retry.Mode, "aws-retry-mode", "", fmt.Sprintf("retry mode for AWS API calls, %q or %q which also rate limits throttled calls (defaults to value of the AWS_RETRY_MODE environment variable, or %q)", api.RetryModeStandard, api.RetryModeAdaptive, api.RetryModeStandard))
+		fs.BoolVar(&p.FailOnCredentialsExpiry, "fail-on-credentials-expiry", false, "fail long-running operations before they start if the AWS credentials expire before the operation timeout")
+		fs.StringVar(&p.AuditFile, "audit-file", "", "record the AWS API calls that change resources in this file, one JSON object per line")
+		fs.StringVar(&p.AuditLogGroup, "audit-log-group", "", "also send the records of --audit-file to a log stream in this existing CloudWatch Logs log group")
+		fs.VarPF(endpointsModeFlag{&p.EndpointsMode, api.EndpointsModeFIPS}, "fips", "", "use the FIPS endpoints of AWS services, fails if any of the services eksctl calls doesn't have one in the region").NoOptDefVal = "true"
+		fs.VarPF(endpointsModeFlag{&p.EndpointsMode, api.EndpointsModeDualStack}, "dual-stack", "", "use the dual-stack endpoints of AWS services and check that the API server of the cluster has an IPv6 address, for IPv6-only clients").NoOptDefVal = "true"
+		fs.StringVar(&p.MetadataBundle, "metadata-bundle", "", "look up AMIs, instance types and addon versions in this file exported by 'eksctl utils export-metadata' instead of AWS, for regions where these lookups fail")
+		fs.StringVar(&p.AssumeRoleARN, "assume-role-arn", "", "IAM role to assume with the credentials of the profile or the environment, e.g. the web identity of a CI runner, before calling AWS")
+		fs.StringVar(&p.RoleSessionName, "role-session-name", "", "session name of the role of --assume-role-arn (defaults to eksctl-<timestamp>)")
+		fs.StringToStringVar(&p.SessionTags, "session-tags", nil, "session tags of the roles eksctl assumes, with --assume-role-arn or the profile, e.g. team=platform,env=ci")
+		fs.StringVar(&p.SourceIdentity, "source-identity", os.Getenv(eks.SourceIdentityEnvName), fmt.Sprintf("source identity of the roles eksctl assumes, with --assume-role-arn or the profile, recorded by CloudTrail to attribute the calls made with them (defaults to value of the %s environment variable)", eks.SourceIdentityEnvName))
+		fs.StringVar(&p.STSRegion, "sts-region", "", "region of the STS endpoint credentials are obtained from, for web identity and --assume-role-arn (defaults to --region)")
+		fs.StringVar(&p.DryProvider, "dry-provider", "", fmt.Sprintf("call a local emulator of AWS APIs instead of AWS, e.g. to test config files in CI; only %q is supported, at the endpoint in the %s environment variable (defaults to http://localhost:4566)", api.DryProviderLocalStack, eks.LocalStackEndpointEnvName))
 
 		if addCfnOptions {
 			fs.StringVar(&p.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
 			fs.BoolVar(&p.CloudFormationDisableRollback, "cfn-disable-rollback", false, "for debugging: If a stack fails, do not roll it back. Be careful, this may lead to unintentional resource consumption!")
+			fs.BoolVar(&p.CloudFormationExplainFailures, "explain-failures", false, "if a stack fails because of missing permissions, look up the denied API calls in CloudTrail and list the missing IAM actions")
 		}
 	})
 }
 
+// endpointsModeFlag is a boolean flag that sets the endpoints mode to mode when enabled
+type endpointsModeFlag struct {
+	endpointsMode *string
+	mode          string
+}
+
+func (f endpointsModeFlag) String() string {
+	return strconv.FormatBool(f.endpointsMode != nil && *f.endpointsMode == f.mode)
+}
+
+func (f endpointsModeFlag) Set(value string) error {
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+	current := *f.endpointsMode
+	if !enabled {
+		if current == "" || current == f.mode {
+			*f.endpointsMode = api.EndpointsModeDefault
+		}
+		return nil
+	}
+	if current != "" && current != api.EndpointsModeDefault && current != f.mode {
+		return fmt.Errorf("cannot be used with %s endpoints", current)
+	}
+	*f.endpointsMode = f.mode
+	return nil
+}
+
+func (f endpointsModeFlag) Type() string {
+	return "bool"
+}
+
 // AddTimeoutFlagWithValue configures the timeout flag with the provided value.
 func AddTimeoutFlagWithValue(fs *pflag.FlagSet, p *time.Duration, value time.Duration) {
 	fs.DurationVar(p, "timeout", value, "maximum waiting time for any long-running operation")
@@ -126,6 +187,11 @@ func AddTimeoutFlag(fs *pflag.FlagSet, p *time.Duration) {
 	AddTimeoutFlagWithValue(fs, p, api.DefaultWaitTimeout)
 }
 
+// AddImageRepositoryOverrideFlag adds the --image-repository-override flag
+func AddImageRepositoryOverrideFlag(fs *pflag.FlagSet, cfg *api.ClusterConfig) {
+	fs.StringVar(&cfg.ImageRepositoryOverride, "image-repository-override", "", "registry, optionally followed by a path, that replaces the registry of the images, e.g. mycorp.jfrog.io/eks")
+}
+
 // AddClusterFlag adds a common --cluster flag for cluster name.
 // Use this for commands whose principal resource is *not* a cluster.
 func AddClusterFlag(fs *pflag.FlagSet, meta *api.ClusterMeta) {
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
@@ -47,6 +48,7 @@ var (
 		"version",
 		"cluster",
 		"namepace",
+		"image-repository-override",
 	}
 	defaultFlagsIncompatibleWithoutConfigFile = []string{
 		"only",
@@ -148,6 +150,12 @@ func (l *commonClusterConfigLoader) Load() error {
 		return ErrMustBeSet("metadata.region")
 	}
 	l.ProviderConfig.Region = meta.Region
+	if meta.EndpointsMode != "" {
+		l.ProviderConfig.EndpointsMode = meta.EndpointsMode
+	}
+	if len(meta.ServiceEndpoints) > 0 {
+		l.ProviderConfig.ServiceEndpoints = meta.ServiceEndpoints
+	}
 
 	return l.validateWithConfigFile()
 }
@@ -277,6 +285,14 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 			}
 		}
 
+		if err := loadIAMServiceAccountPolicyDocuments(clusterConfig, l.ClusterConfigFile); err != nil {
+			return err
+		}
+
+		if err := loadBootstrapManifests(clusterConfig, l.ClusterConfigFile); err != nil {
+			return err
+		}
+
 		return validateDryRun()
 	}
 
@@ -522,7 +538,24 @@ func normalizeBaseNodeGroup(np api.NodePool, cmd *cobra.Command) {
 func NewDeleteAndDrainNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *filter.NodeGroupFilter) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
 
+	validateDrainFlags := func() error {
+		if flag := l.CobraCommand.Flag("drain-parallel"); flag != nil && flag.Changed {
+			if val, _ := strconv.Atoi(flag.Value.String()); val < 1 {
+				return fmt.Errorf("--drain-parallel value must be at least 1")
+			}
+		}
+		if flag := l.CobraCommand.Flag("drain-timeout"); flag != nil && flag.Changed {
+			if val, _ := time.ParseDuration(flag.Value.String()); val <= 0 {
+				return fmt.Errorf("--drain-timeout value must be greater than 0")
+			}
+		}
+		return nil
+	}
+
 	l.validateWithConfigFile = func() error {
+		if err := validateDrainFlags(); err != nil {
+			return err
+		}
 		return ngFilter.AppendGlobs(l.Include, l.Exclude, l.ClusterConfig.GetAllNodeGroupNames())
 	}
 
@@ -553,6 +586,10 @@ func NewDeleteAndDrainNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *fil
 			}
 		}
 
+		if err := validateDrainFlags(); err != nil {
+			return err
+		}
+
 		ngFilter.AppendIncludeNames(ng.Name)
 
 		l.Plan = false
@@ -570,6 +607,8 @@ func NewUtilsEnableLoggingLoader(cmd *Cmd) ClusterConfigLoader {
 	l.flagsIncompatibleWithConfigFile.Insert(
 		"enable-types",
 		"disable-types",
+		"log-retention-days",
+		"log-group-kms-key-arn",
 	)
 
 	l.validateWithoutConfigFile = l.validateMetadataWithoutConfigFile
@@ -618,6 +657,56 @@ func NewUtilsEnableEndpointAccessLoader(cmd *Cmd, privateAccess, publicAccess bo
 	return l
 }
 
+// EnableMonitoringOptions holds the flags of 'eksctl enable monitoring'
+type EnableMonitoringOptions struct {
+	AMP                bool
+	AMG                bool
+	GrafanaWorkspaceID string
+}
+
+// NewEnableMonitoringLoader will load config or use flags for 'eksctl enable monitoring'
+func NewEnableMonitoringLoader(cmd *Cmd, options *EnableMonitoringOptions) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"amp",
+		"amg",
+		"grafana-workspace-id",
+	)
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if !options.AMP {
+			return ErrMustBeSet("--amp")
+		}
+		l.ClusterConfig.Observability = &api.Observability{
+			Prometheus: &api.ObservabilityPrometheus{},
+		}
+		if options.AMG {
+			if options.GrafanaWorkspaceID == "" {
+				return ErrMustBeSet("--grafana-workspace-id")
+			}
+			l.ClusterConfig.Observability.Grafana = &api.ObservabilityGrafana{
+				WorkspaceID: options.GrafanaWorkspaceID,
+			}
+		} else if options.GrafanaWorkspaceID != "" {
+			return errors.New("--grafana-workspace-id can only be used with --amg")
+		}
+		return nil
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.Observability == nil || l.ClusterConfig.Observability.Prometheus == nil {
+			return ErrMustBeSet("observability.prometheus")
+		}
+		return api.ValidateObservability(l.ClusterConfig)
+	}
+
+	return l
+}
+
 // NewUtilsAssociateIAMOIDCProviderLoader will load config or use flags for 'eksctl utils associal-iam-oidc-provider'
 func NewUtilsAssociateIAMOIDCProviderLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
@@ -666,6 +755,32 @@ func NewUtilsPublicAccessCIDRsLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewUtilsUpdateAuthenticationModeLoader loads config or uses flags for `eksctl utils update-authentication-mode`
+func NewUtilsUpdateAuthenticationModeLoader(cmd *Cmd, authenticationMode string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("authentication-mode")
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.AccessConfig == nil || l.ClusterConfig.AccessConfig.AuthenticationMode == "" {
+			return errors.New("field accessConfig.authenticationMode is required")
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if authenticationMode == "" {
+			return ErrMustBeSet("--authentication-mode")
+		}
+		l.ClusterConfig.AccessConfig = &api.AccessConfig{AuthenticationMode: authenticationMode}
+		return nil
+	}
+	return l
+}
+
 func parseList(arg string) ([]string, error) {
 	reader := strings.NewReader(arg)
 	csvReader := csv.NewReader(reader)
@@ -680,10 +795,17 @@ func NewCreateIAMServiceAccountLoader(cmd *Cmd, saFilter *filter.IAMServiceAccou
 		"policy-arn",
 	)
 
+	l.flagsIncompatibleWithoutConfigFile.Insert(
+		"prune",
+	)
+
 	l.validateWithConfigFile = func() error {
 		if l.ClusterConfig.IAM == nil || l.ClusterConfig.IAM.ServiceAccounts == nil {
 			return fmt.Errorf("'iam.serviceAccounts' is not defined in %q", l.ClusterConfigFile)
 		}
+		if err := loadIAMServiceAccountPolicyDocuments(l.ClusterConfig, l.ClusterConfigFile); err != nil {
+			return err
+		}
 		return saFilter.AppendGlobs(l.Include, l.Exclude, l.ClusterConfig.IAM.ServiceAccounts)
 	}
 
@@ -758,20 +880,28 @@ func NewGetIAMServiceAccountLoader(cmd *Cmd, options *irsa.GetOptions) ClusterCo
 	return l
 }
 
-// NewDeleteIAMServiceAccountLoader will load config or use flags for 'eksctl delete iamserviceaccount'
-func NewDeleteIAMServiceAccountLoader(cmd *Cmd, sa *api.ClusterIAMServiceAccount, saFilter *filter.IAMServiceAccountFilter) ClusterConfigLoader {
+// NewDeleteIAMServiceAccountLoader will load config or use flags for 'eksctl delete iamserviceaccount'; with
+// onlyMissingFromCluster, every iamserviceaccount of the cluster is considered, so no name is required
+func NewDeleteIAMServiceAccountLoader(cmd *Cmd, sa *api.ClusterIAMServiceAccount, saFilter *filter.IAMServiceAccountFilter, onlyMissingFromCluster bool) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
 
 	l.validateWithConfigFile = func() error {
 		if l.ClusterConfig.IAM == nil || api.IsDisabled(l.ClusterConfig.IAM.WithOIDC) {
 			return fmt.Errorf("'iam.withOIDC' is not enabled in %q", l.ClusterConfigFile)
 		}
+		if onlyMissingFromCluster {
+			if flag := l.CobraCommand.Flag("only-missing"); flag != nil && flag.Changed {
+				return fmt.Errorf("--only-missing and --only-missing-from-cluster %s", IncompatibleFlags)
+			}
+		}
 		return saFilter.AppendGlobs(l.Include, l.Exclude, l.ClusterConfig.IAM.ServiceAccounts)
 	}
 
-	l.flagsIncompatibleWithoutConfigFile.Insert(
-		"approve",
-	)
+	if !onlyMissingFromCluster {
+		l.flagsIncompatibleWithoutConfigFile.Insert(
+			"approve",
+		)
+	}
 
 	l.validateWithoutConfigFile = func() error {
 		sa.AttachPolicyARNs = []string{""} // force to pass general validation
@@ -780,6 +910,13 @@ func NewDeleteIAMServiceAccountLoader(cmd *Cmd, sa *api.ClusterIAMServiceAccount
 			return ErrMustBeSet(ClusterNameFlag(cmd))
 		}
 
+		if onlyMissingFromCluster {
+			if sa.Name != "" || l.NameArg != "" {
+				return fmt.Errorf("--name and --only-missing-from-cluster %s", IncompatibleFlags)
+			}
+			return nil
+		}
+
 		if sa.Name != "" && l.NameArg != "" {
 			return ErrFlagAndArg("--name", sa.Name, l.NameArg)
 		}
@@ -1,6 +1,7 @@
 package cmdutils
 
 import (
+	"os"
 	"path/filepath"
 
 	. "github.com/onsi/ginkgo"
@@ -10,6 +11,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
 )
 
 var _ = Describe("cmdutils configfile", func() {
@@ -23,6 +25,24 @@ var _ = Describe("cmdutils configfile", func() {
 
 	const examplesDir = "../../../examples/"
 
+	var configDir string
+
+	BeforeEach(func() {
+		var err error
+		configDir, err = os.MkdirTemp("", "configfile")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(configDir)).To(Succeed())
+	})
+
+	writeConfigFile := func(b *configbuilder.Builder) string {
+		configFile := filepath.Join(configDir, "cluster.yaml")
+		Expect(b.WriteFile(configFile)).To(Succeed())
+		return configFile
+	}
+
 	Context("load configfiles", func() {
 
 		It("should handle name argument", func() {
@@ -138,7 +158,7 @@ var _ = Describe("cmdutils configfile", func() {
 					l.flagsIncompatibleWithConfigFile.Delete("name")
 
 					err := l.Load()
-					Expect(err).To(MatchError(ContainSubstring("error unmarshaling JSON: while decoding JSON: json: unknown field \"containerRuntime\"")))
+					Expect(err).To(MatchError(ContainSubstring(`line 14, column 5: unknown field "containerRuntime" in managedNodeGroups[0]`)))
 				})
 			})
 		})
@@ -424,10 +444,16 @@ var _ = Describe("cmdutils configfile", func() {
 
 		Describe("should set defaults for cluster endpoint access", func() {
 
-			testClusterEndpointAccessDefaults := func(configFilePath string, expectedPrivAccess, expectedPubAccess bool) {
+			newCluster := func() *configbuilder.Builder {
+				return configbuilder.New("test-cluster-1", api.RegionEUNorth1).
+					WithNodegroup("ng-1", configbuilder.InstanceType("m5.large"), configbuilder.DesiredCapacity(1))
+			}
+			privateSubnets := map[string]string{"eu-north-1a": "subnet-12345", "eu-north-1b": "subnet-67890"}
+
+			testClusterEndpointAccessDefaults := func(b *configbuilder.Builder, expectedPrivAccess, expectedPubAccess bool) {
 				cmd := &Cmd{
 					CobraCommand:      newCmd(),
-					ClusterConfigFile: configFilePath,
+					ClusterConfigFile: writeConfigFile(b),
 					ClusterConfig:     api.NewClusterConfig(),
 					ProviderConfig:    api.ProviderConfig{},
 				}
@@ -445,28 +471,38 @@ var _ = Describe("cmdutils configfile", func() {
 			}
 
 			It("when VPC is imported and no access is defined", func() {
-				testClusterEndpointAccessDefaults("test_data/cluster-with-vpc.yaml", false, true)
+				testClusterEndpointAccessDefaults(newCluster().WithExistingVPC("vpc-12345", privateSubnets), false, true)
 			})
 
 			It("when VPC is created by eksctl and no access is defined", func() {
-				testClusterEndpointAccessDefaults("test_data/cluster-without-vpc.yaml", false, true)
+				testClusterEndpointAccessDefaults(newCluster(), false, true)
 			})
 
 			It("when VPC is created by eksctl and private endpoint is enabled", func() {
-				testClusterEndpointAccessDefaults("test_data/cluster-without-vpc-private-access.yaml", true, true)
+				testClusterEndpointAccessDefaults(newCluster().WithPrivateEndpointAccess(), true, true)
 			})
 
 			It("when VPC is imported and private endpoint is enabled", func() {
-				testClusterEndpointAccessDefaults("test_data/cluster-with-vpc-private-access.yaml", true, true)
+				testClusterEndpointAccessDefaults(newCluster().WithExistingVPC("vpc-12345", privateSubnets).WithPrivateEndpointAccess(), true, true)
 			})
 		})
 	})
 
 	Describe("SetLabelLoader", func() {
+		var configFile string
+
+		BeforeEach(func() {
+			configFile = writeConfigFile(configbuilder.New("test-labels-1", api.RegionUSWest2).
+				WithManagedNodegroup("ng-1", configbuilder.InstanceType("m5.large"), configbuilder.DesiredCapacity(1),
+					configbuilder.Labels(map[string]string{"key": "value"})).
+				WithManagedNodegroup("ng-2", configbuilder.InstanceType("m5.large"), configbuilder.DesiredCapacity(1),
+					configbuilder.Labels(map[string]string{"key2": "value2"})))
+		})
+
 		It("should load the right data", func() {
 			cmd := &Cmd{
 				CobraCommand:      newCmd(),
-				ClusterConfigFile: filepath.Join("test_data", "cluster-with-labels.yaml"),
+				ClusterConfigFile: configFile,
 				ClusterConfig:     api.NewClusterConfig(),
 				ProviderConfig:    api.ProviderConfig{},
 			}
@@ -492,7 +528,7 @@ var _ = Describe("cmdutils configfile", func() {
 			It("should load all nodegroups", func() {
 				cmd := &Cmd{
 					CobraCommand:      newCmd(),
-					ClusterConfigFile: filepath.Join("test_data", "cluster-with-labels.yaml"),
+					ClusterConfigFile: configFile,
 					ClusterConfig:     api.NewClusterConfig(),
 					ProviderConfig:    api.ProviderConfig{},
 				}
@@ -508,7 +544,7 @@ var _ = Describe("cmdutils configfile", func() {
 			It("errors", func() {
 				cmd := &Cmd{
 					CobraCommand:      newCmd(),
-					ClusterConfigFile: filepath.Join("test_data", "cluster-with-labels.yaml"),
+					ClusterConfigFile: configFile,
 					ClusterConfig:     api.NewClusterConfig(),
 					ProviderConfig:    api.ProviderConfig{},
 				}
@@ -19,6 +19,9 @@ type CreateClusterCmdParams struct {
 	WithoutNodeGroup      bool
 	Fargate               bool
 	DryRun                bool
+	NoKubeAccess          bool
+	// RequestQuotaIncreases submits the quota increases the cluster needs instead of only listing them
+	RequestQuotaIncreases bool
 	CreateNGOptions
 	CreateManagedNGOptions
 }
@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
@@ -43,7 +43,7 @@ var _ = Describe("nodegroup filter", func() {
 			filter = NewNodeGroupFilter()
 
 			mockProvider = mockprovider.NewMockProvider()
-			mockProvider.MockEKS().On("ListNodegroups", mock.Anything).Return(&eks.ListNodegroupsOutput{Nodegroups: nil}, nil)
+			mockProvider.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything).Return(&eks.ListNodegroupsOutput{Nodegroups: nil}, nil)
 		})
 
 		It("regression: should only match the ones included in the filter when non existing ngs are present in the config file", func() {
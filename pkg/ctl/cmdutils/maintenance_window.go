@@ -0,0 +1,63 @@
+package cmdutils
+
+import (
+	"context"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// AddMaintenanceWindowFlag adds the --maintenance-window flag
+func AddMaintenanceWindowFlag(fs *pflag.FlagSet, window *string) {
+	fs.StringVar(window, "maintenance-window", "", "Wait for a maintenance window before applying the changes, in the format '[DAYS ]HH:MM-HH:MM[ TIMEZONE]', e.g. 'Sat,Sun 02:00-06:00 Europe/London'")
+}
+
+// ApplyMaintenanceWindowFlag sets the maintenance window of the cluster config from the value of --maintenance-window, if set
+func ApplyMaintenanceWindowFlag(cfg *api.ClusterConfig, window string) error {
+	if window == "" {
+		return nil
+	}
+	maintenanceWindow, err := api.ParseMaintenanceWindow(window)
+	if err != nil {
+		return err
+	}
+	cfg.MaintenanceWindow = maintenanceWindow
+	return nil
+}
+
+// WaitForMaintenanceWindow waits until the maintenance window is open, in plan mode it only logs when
+// the changes would be applied
+func WaitForMaintenanceWindow(ctx context.Context, window *api.MaintenanceWindow, plan bool) error {
+	return waitForMaintenanceWindow(ctx, window, plan, time.Now, time.After)
+}
+
+func waitForMaintenanceWindow(ctx context.Context, window *api.MaintenanceWindow, plan bool, now func() time.Time, after func(time.Duration) <-chan time.Time) error {
+	if window == nil {
+		return nil
+	}
+	current := now()
+	opens, closes, err := window.Next(current)
+	if err != nil {
+		return err
+	}
+	if !opens.After(current) {
+		logger.Info("maintenance window %q is open until %s", window, closes.Format(time.RFC1123))
+		return nil
+	}
+	if plan {
+		logger.Info("(plan) would wait for maintenance window %q to open at %s", window, opens.Format(time.RFC1123))
+		return nil
+	}
+
+	logger.Info("waiting for maintenance window %q to open at %s", window, opens.Format(time.RFC1123))
+	select {
+	case <-after(opens.Sub(current)):
+		logger.Info("maintenance window %q is open until %s", window, closes.Format(time.RFC1123))
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
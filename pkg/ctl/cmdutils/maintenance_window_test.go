@@ -0,0 +1,72 @@
+package cmdutils
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("maintenance window", func() {
+	// Wednesday
+	now := time.Date(2022, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	var waited []time.Duration
+
+	BeforeEach(func() {
+		waited = nil
+	})
+
+	nowFunc := func() time.Time { return now }
+	after := func(d time.Duration) <-chan time.Time {
+		waited = append(waited, d)
+		c := make(chan time.Time, 1)
+		c <- now.Add(d)
+		return c
+	}
+
+	It("sets the maintenance window from the flag", func() {
+		cfg := api.NewClusterConfig()
+		Expect(ApplyMaintenanceWindowFlag(cfg, "")).To(Succeed())
+		Expect(cfg.MaintenanceWindow).To(BeNil())
+
+		Expect(ApplyMaintenanceWindowFlag(cfg, "Sat 02:00-06:00")).To(Succeed())
+		Expect(cfg.MaintenanceWindow).To(Equal(&api.MaintenanceWindow{Days: []string{"Sat"}, Start: "02:00", End: "06:00"}))
+
+		Expect(ApplyMaintenanceWindowFlag(cfg, "02:00")).To(MatchError(ContainSubstring("invalid maintenance window")))
+	})
+
+	It("does not wait without a maintenance window", func() {
+		Expect(waitForMaintenanceWindow(context.Background(), nil, false, nowFunc, after)).To(Succeed())
+		Expect(waited).To(BeEmpty())
+	})
+
+	It("does not wait when the maintenance window is open", func() {
+		window := &api.MaintenanceWindow{Start: "11:00", End: "13:00"}
+		Expect(waitForMaintenanceWindow(context.Background(), window, false, nowFunc, after)).To(Succeed())
+		Expect(waited).To(BeEmpty())
+	})
+
+	It("waits for the maintenance window to open", func() {
+		window := &api.MaintenanceWindow{Start: "14:30", End: "16:00"}
+		Expect(waitForMaintenanceWindow(context.Background(), window, false, nowFunc, after)).To(Succeed())
+		Expect(waited).To(Equal([]time.Duration{150 * time.Minute}))
+	})
+
+	It("does not wait in plan mode", func() {
+		window := &api.MaintenanceWindow{Start: "14:30", End: "16:00"}
+		Expect(waitForMaintenanceWindow(context.Background(), window, true, nowFunc, after)).To(Succeed())
+		Expect(waited).To(BeEmpty())
+	})
+
+	It("stops waiting when the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		never := func(time.Duration) <-chan time.Time { return nil }
+		window := &api.MaintenanceWindow{Start: "14:30", End: "16:00"}
+		Expect(waitForMaintenanceWindow(ctx, window, false, nowFunc, never)).To(MatchError(context.Canceled))
+	})
+})
@@ -3,11 +3,13 @@ package cmdutils
 import (
 	"context"
 
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
@@ -52,10 +54,10 @@ var _ = Describe("PopulateNodegroup", func() {
 			clusterName := "cluster-name"
 			cfg.Metadata.Name = clusterName
 			fakeStackManager.GetNodeGroupStackTypeReturns("", errors.New(""))
-			mockProvider.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+			mockProvider.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
 				ClusterName:   aws.String(clusterName),
 				NodegroupName: aws.String(ngName),
-			}).Return(&awseks.DescribeNodegroupOutput{Nodegroup: &awseks.Nodegroup{}}, nil)
+			}).Return(&awseks.DescribeNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{}}, nil)
 
 			err = PopulateNodegroup(context.TODO(), fakeStackManager, ngName, cfg, mockProvider)
 			Expect(err).NotTo(HaveOccurred())
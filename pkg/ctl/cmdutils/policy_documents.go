@@ -0,0 +1,123 @@
+package cmdutils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// maxPolicyDocumentSize is the maximum size of an inline IAM role policy
+const maxPolicyDocumentSize = 10240
+
+var policyDocumentHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+type policyDocumentTemplateData struct {
+	ClusterName string
+	Region      string
+}
+
+// loadIAMServiceAccountPolicyDocuments reads the policy documents referenced by attachPolicyFiles and
+// attachPolicyURLs of each iamserviceaccount, substitutes the cluster name and region, and stores them
+// in AttachPolicyDocuments
+func loadIAMServiceAccountPolicyDocuments(clusterConfig *api.ClusterConfig, configFile string) error {
+	if clusterConfig.IAM == nil {
+		return nil
+	}
+
+	baseDir := ""
+	if configFile != "-" {
+		baseDir = filepath.Dir(configFile)
+	}
+	data := policyDocumentTemplateData{
+		ClusterName: clusterConfig.Metadata.Name,
+		Region:      clusterConfig.Metadata.Region,
+	}
+
+	for i, sa := range clusterConfig.IAM.ServiceAccounts {
+		path := fmt.Sprintf("iam.serviceAccounts[%d]", i)
+		sa.AttachPolicyDocuments = nil
+
+		for j, file := range sa.AttachPolicyFiles {
+			if !filepath.IsAbs(file) {
+				file = filepath.Join(baseDir, file)
+			}
+			doc, err := loadPolicyDocument(func() (io.ReadCloser, error) {
+				return os.Open(file)
+			}, data)
+			if err != nil {
+				return errors.Wrapf(err, "loading %s.attachPolicyFiles[%d]", path, j)
+			}
+			sa.AttachPolicyDocuments = append(sa.AttachPolicyDocuments, doc)
+		}
+
+		for j, url := range sa.AttachPolicyURLs {
+			doc, err := loadPolicyDocument(func() (io.ReadCloser, error) {
+				return fetchPolicyDocument(url)
+			}, data)
+			if err != nil {
+				return errors.Wrapf(err, "loading %s.attachPolicyURLs[%d]", path, j)
+			}
+			sa.AttachPolicyDocuments = append(sa.AttachPolicyDocuments, doc)
+		}
+	}
+	return nil
+}
+
+func fetchPolicyDocument(url string) (io.ReadCloser, error) {
+	resp, err := policyDocumentHTTPClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %q fetching %q", resp.Status, url)
+	}
+	return resp.Body, nil
+}
+
+func loadPolicyDocument(open func() (io.ReadCloser, error), data policyDocumentTemplateData) (api.InlineDocument, error) {
+	r, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(r, maxPolicyDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxPolicyDocumentSize {
+		return nil, fmt.Errorf("policy document exceeds the maximum size of %d bytes", maxPolicyDocumentSize)
+	}
+
+	tmpl, err := template.New("policy").Option("missingkey=error").Parse(string(raw))
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing policy document template")
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return nil, errors.Wrap(err, "rendering policy document template")
+	}
+	if rendered.Len() > maxPolicyDocumentSize {
+		return nil, fmt.Errorf("policy document exceeds the maximum size of %d bytes", maxPolicyDocumentSize)
+	}
+
+	var doc api.InlineDocument
+	if err := yaml.Unmarshal(rendered.Bytes(), &doc); err != nil {
+		return nil, errors.Wrap(err, "parsing policy document")
+	}
+	if len(doc) == 0 {
+		return nil, errors.New("policy document is empty")
+	}
+	return doc, nil
+}
@@ -0,0 +1,109 @@
+package cmdutils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("iamserviceaccount policy documents", func() {
+	const policyTemplate = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::{{ .ClusterName }}-{{ .Region }}/*"
+    }
+  ]
+}`
+
+	var (
+		cfg      *api.ClusterConfig
+		dir      string
+		server   *httptest.Server
+		sa       *api.ClusterIAMServiceAccount
+		expectS3 = func(doc api.InlineDocument) {
+			statements := doc["Statement"].([]interface{})
+			Expect(statements).To(HaveLen(1))
+			Expect(statements[0].(map[string]interface{})["Resource"]).To(Equal("arn:aws:s3:::test-cluster-us-west-2/*"))
+		}
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "policy-documents")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.MkdirAll(filepath.Join(dir, "policies"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "policies", "s3.json"), []byte(policyTemplate), 0644)).To(Succeed())
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/s3.yaml":
+				fmt.Fprint(w, "Version: '2012-10-17'\nStatement:\n- Effect: Allow\n  Action: s3:GetObject\n  Resource: 'arn:aws:s3:::{{ .ClusterName }}-{{ .Region }}/*'\n")
+			case "/large.json":
+				fmt.Fprint(w, strings.Repeat(" ", maxPolicyDocumentSize+1))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		sa = &api.ClusterIAMServiceAccount{
+			ClusterIAMMeta: api.ClusterIAMMeta{Name: "sa-1", Namespace: "default"},
+		}
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{sa}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("loads policy files relative to the config file and templates them", func() {
+		sa.AttachPolicyFiles = []string{"./policies/s3.json"}
+
+		Expect(loadIAMServiceAccountPolicyDocuments(cfg, filepath.Join(dir, "cluster.yaml"))).To(Succeed())
+		Expect(sa.AttachPolicyDocuments).To(HaveLen(1))
+		expectS3(sa.AttachPolicyDocuments[0])
+	})
+
+	It("loads policy URLs and templates them", func() {
+		sa.AttachPolicyURLs = []string{server.URL + "/s3.yaml"}
+
+		Expect(loadIAMServiceAccountPolicyDocuments(cfg, filepath.Join(dir, "cluster.yaml"))).To(Succeed())
+		Expect(sa.AttachPolicyDocuments).To(HaveLen(1))
+		expectS3(sa.AttachPolicyDocuments[0])
+	})
+
+	It("fails when a policy document is too large", func() {
+		sa.AttachPolicyURLs = []string{server.URL + "/large.json"}
+
+		err := loadIAMServiceAccountPolicyDocuments(cfg, filepath.Join(dir, "cluster.yaml"))
+		Expect(err).To(MatchError(ContainSubstring("loading iam.serviceAccounts[0].attachPolicyURLs[0]: policy document exceeds the maximum size of 10240 bytes")))
+	})
+
+	It("fails when a policy URL cannot be fetched", func() {
+		sa.AttachPolicyURLs = []string{server.URL + "/missing.json"}
+
+		err := loadIAMServiceAccountPolicyDocuments(cfg, filepath.Join(dir, "cluster.yaml"))
+		Expect(err).To(MatchError(ContainSubstring(`unexpected status "404 Not Found"`)))
+	})
+
+	It("fails when a policy file does not exist", func() {
+		sa.AttachPolicyFiles = []string{"./policies/missing.json"}
+
+		err := loadIAMServiceAccountPolicyDocuments(cfg, filepath.Join(dir, "cluster.yaml"))
+		Expect(err).To(MatchError(ContainSubstring("loading iam.serviceAccounts[0].attachPolicyFiles[0]")))
+	})
+})
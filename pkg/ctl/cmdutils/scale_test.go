@@ -2,13 +2,17 @@ package cmdutils
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
 )
 
 type scaleNodeGroupCase struct {
@@ -94,8 +98,7 @@ var _ = Describe("scale node group config file loader", func() {
 
 			DescribeTable("scales a single nodegroup successfully via cli flags",
 				func(params scaleNodeGroupCLICase) {
-					cfg := api.NewClusterConfig()
-					cfg.Metadata.Name = "cluster"
+					cfg := configbuilder.New("cluster", "").Build()
 					cmd := &Cmd{
 						CobraCommand:   newCmd(),
 						ProviderConfig: api.ProviderConfig{},
@@ -178,8 +181,7 @@ var _ = Describe("scale node group config file loader", func() {
 				)
 
 				BeforeEach(func() {
-					config = api.NewClusterConfig()
-					config.Metadata.Name = "test-cluster"
+					config = configbuilder.New("test-cluster", "").Build()
 					ng = config.NewNodeGroup().BaseNodeGroup()
 				})
 
@@ -230,10 +232,27 @@ var _ = Describe("scale node group config file loader", func() {
 
 	When("a nodegroup name is not passed with --name", func() {
 		When("using a config file with nodegroups", func() {
+			var configDir string
+
+			BeforeEach(func() {
+				var err error
+				configDir, err = os.MkdirTemp("", "scale")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(configDir)).To(Succeed())
+			})
+
 			It("finds and scales all nodegroups", func() {
+				configFile := filepath.Join(configDir, "cluster.yaml")
+				Expect(configbuilder.New("test-cluster-1", api.RegionEUNorth1).
+					WithNodegroup("ng-1", configbuilder.Capacity(3, 3, 5)).
+					WithManagedNodegroup("ng-2", configbuilder.Capacity(3, 3, 5)).
+					WriteFile(configFile)).To(Succeed())
 				cmd := &Cmd{
 					CobraCommand:      newCmd(),
-					ClusterConfigFile: "test_data/scale-all-ng-test.yaml",
+					ClusterConfigFile: configFile,
 				}
 
 				err := NewScaleAllNodeGroupLoader(cmd).Load()
@@ -1,6 +1,8 @@
 package cmdutils
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -9,8 +11,8 @@ import (
 
 // KubernetesClientAndConfigFrom returns a Kubernetes client set and REST
 // configuration object for the currently configured cluster.
-func KubernetesClientAndConfigFrom(cmd *Cmd) (*kubernetes.Clientset, *rest.Config, error) {
-	ctl, err := cmd.NewProviderForExistingCluster()
+func KubernetesClientAndConfigFrom(ctx context.Context, cmd *Cmd) (*kubernetes.Clientset, *rest.Config, error) {
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
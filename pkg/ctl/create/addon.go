@@ -1,10 +1,9 @@
 package create
 
 import (
-	"context"
 	"fmt"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/kris-nova/logger"
 
 	"github.com/spf13/cobra"
@@ -22,6 +21,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 		"Create an Addon",
 		"",
 	)
+	cmd.Mutating = true
 
 	var force, wait bool
 	cmd.ClusterConfig.Addons = []*api.Addon{{}}
@@ -44,22 +44,23 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		ctx := cmd.Context()
 		cmd.NameArg = cmdutils.GetNameArg(args)
 		if err := cmdutils.NewCreateOrUpgradeAddonLoader(cmd).Load(); err != nil {
 			return err
 		}
 
-		clusterProvider, err := cmd.NewProviderForExistingCluster()
+		clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 		if err != nil {
 			return err
 		}
 
-		oidc, err := clusterProvider.NewOpenIDConnectManager(cmd.ClusterConfig)
+		oidc, err := clusterProvider.NewOpenIDConnectManager(ctx, cmd.ClusterConfig)
 		if err != nil {
 			return err
 		}
 
-		oidcProviderExists, err := oidc.CheckProviderExists(context.TODO())
+		oidcProviderExists, err := oidc.CheckProviderExists(ctx)
 		if err != nil {
 			return err
 		}
@@ -70,7 +71,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 
 		stackManager := clusterProvider.NewStackManager(cmd.ClusterConfig)
 
-		output, err := clusterProvider.Provider.EKS().DescribeCluster(&awseks.DescribeClusterInput{
+		output, err := clusterProvider.Provider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
 			Name: &cmd.ClusterConfig.Metadata.Name,
 		})
 
@@ -86,7 +87,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 			return err
 		}
 
-		addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, clientSet, cmd.ProviderConfig.WaitTimeout)
+		addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, clientSet, cmd.ClusterConfig.Timeouts.AddonActiveTimeout(cmd.ProviderConfig.WaitTimeout))
 		if err != nil {
 			return err
 		}
@@ -95,7 +96,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 			if force { //force is specified at cmdline level
 				a.Force = true
 			}
-			err := addonManager.Create(context.TODO(), a, wait)
+			err := addonManager.Create(ctx, a, wait)
 			if err != nil {
 				return err
 			}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
 	"github.com/kris-nova/logger"
@@ -15,9 +16,11 @@ import (
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubeclient "k8s.io/client-go/kubernetes"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap"
 	"github.com/weaveworks/eksctl/pkg/actions/flux"
 	karpenteractions "github.com/weaveworks/eksctl/pkg/actions/karpenter"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -26,9 +29,11 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/helm"
 	"github.com/weaveworks/eksctl/pkg/kops"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/quotas"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 	"github.com/weaveworks/eksctl/pkg/utils/kubectl"
 	"github.com/weaveworks/eksctl/pkg/utils/names"
@@ -58,6 +63,7 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 	params := &cmdutils.CreateClusterCmdParams{}
 
 	cmd.SetDescription("cluster", "Create a cluster", "")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
@@ -80,9 +86,12 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 		cmdutils.AddVersionFlag(fs, cfg.Metadata, "")
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPrintTaskPlanFlag(fs, cmd)
 		fs.BoolVarP(&params.InstallWindowsVPCController, "install-vpc-controllers", "", false, "Install VPC controller that's required for Windows workloads")
 		fs.BoolVarP(&params.Fargate, "fargate", "", false, "Create a Fargate profile scheduling pods in the default and kube-system namespaces onto Fargate")
 		fs.BoolVarP(&params.DryRun, "dry-run", "", false, "Dry-run mode that skips cluster creation and outputs a ClusterConfig")
+		fs.BoolVar(&params.RequestQuotaIncreases, "request-quota-increases", false, "Request the Service Quotas increases the cluster needs when its quotas are too low, instead of only listing them")
+		fs.BoolVar(&params.NoKubeAccess, "no-kube-access", false, "Only perform AWS operations, skipping the steps that need access to the Kubernetes API and listing them so they can be run later, e.g. from a bastion")
 
 		_ = fs.MarkDeprecated("install-vpc-controllers", vpcControllerInfoMessage)
 	})
@@ -180,7 +189,15 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		params.KubeconfigPath = kubeconfig.AutoPath(meta.Name)
 	}
 
-	ctx := context.TODO()
+	if params.NoKubeAccess {
+		if err := validateNoKubeAccess(cmd, params); err != nil {
+			return err
+		}
+		params.WriteKubeconfig = false
+		ctl.DisableKubeAccess(cmd.ClusterConfigFile)
+	}
+
+	ctx := cmd.Context()
 
 	if checkSubnetsGivenAsFlags(params) {
 		// undo defaulting and reset it, as it's not set via config file;
@@ -200,7 +217,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	// Check if flux binary exists early in the process, so it doesn't fail at the end when the cluster
 	// has already been created with a missing flux binary error which should have been caught earlier.
 	// Note: we aren't running PreFlight here, we just check for the binary.
-	if cfg.HasGitOpsFluxConfigured() {
+	if cfg.HasGitOpsFluxConfigured() && !params.NoKubeAccess {
 		if _, err := exec.LookPath("flux"); err != nil {
 			return fmt.Errorf("flux binary is required when gitops configuration is set: %w", err)
 		}
@@ -229,12 +246,28 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return cmdutils.PrintDryRunConfig(cfg, os.Stdout)
 	}
 
+	if err := checkQuotas(ctx, ctl, cfg, params.RequestQuotaIncreases); err != nil {
+		return err
+	}
+
 	if err := nodeGroupService.Normalize(ctx, nodePools, cfg.Metadata); err != nil {
 		return err
 	}
 
+	if err := nodeGroupService.ApplyVPCCNIConfig(ctx, nodePools, cfg); err != nil {
+		return err
+	}
+
 	logger.Info("using Kubernetes version %s", meta.Version)
 	logger.Info("creating %s", cfg.LogString())
+	if cfg.Metadata.TTL != "" {
+		cfg.Metadata.SetExpiresAtTag(time.Now())
+		logger.Info("cluster will expire at %s, after which 'eksctl utils reap-expired' deletes it", cfg.Metadata.Tags[api.ExpiresAtTag])
+	}
+	if budget := cfg.Budget; budget != nil {
+		logger.Info("will create a %s cost budget of %g %s for the resources tagged with %s=%s, the tag must be activated as a cost allocation tag in the Billing console",
+			strings.ToLower(budget.TimeUnit), budget.Amount, budget.Unit, budget.CostAllocationTag, budget.CostAllocationTagValue(cfg.Metadata))
+	}
 
 	// TODO dry-run mode should provide a way to render config with all defaults set
 	// we should also make a call to resolve the AMI and write the result, similarly
@@ -269,9 +302,13 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	eks.LogEnabledFeatures(cfg)
 	postClusterCreationTasks := ctl.CreateExtraClusterConfigTasks(ctx, cfg)
 
+	if err := cfg.SetVPCCNIAddonConfiguration(); err != nil {
+		return err
+	}
+
 	var preNodegroupAddons, postNodegroupAddons *tasks.TaskTree
 	if len(cfg.Addons) > 0 {
-		preNodegroupAddons, postNodegroupAddons = addon.CreateAddonTasks(ctx, cfg, ctl, true, cmd.ProviderConfig.WaitTimeout)
+		preNodegroupAddons, postNodegroupAddons = addon.CreateAddonTasks(ctx, cfg, ctl, true, cfg.Timeouts.AddonActiveTimeout(cmd.ProviderConfig.WaitTimeout))
 		postClusterCreationTasks.Append(preNodegroupAddons)
 	}
 
@@ -299,7 +336,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		var kubeconfigContextName string
 
 		if params.WriteKubeconfig {
-			kubectlConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), params.AuthenticatorRoleARN, ctl.Provider.Profile())
+			kubectlConfig := newKubectlConfig(ctl, cfg, params.AuthenticatorRoleARN)
 			kubeconfigContextName = kubectlConfig.CurrentContext
 
 			params.KubeconfigPath, err = kubeconfig.Write(params.KubeconfigPath, *kubectlConfig, params.SetContext)
@@ -310,6 +347,9 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			}
 		} else {
 			params.KubeconfigPath = ""
+			if params.NoKubeAccess {
+				ctl.DeferKubeStep("write kubeconfig", fmt.Sprintf("eksctl utils write-kubeconfig --cluster=%s --region=%s", meta.Name, meta.Region))
+			}
 		}
 
 		ngTasks := ctl.ClusterTasksForNodeGroups(cfg, params.InstallNeuronDevicePlugin, params.InstallNvidiaDevicePlugin)
@@ -325,27 +365,47 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		}
 		logger.Success("all EKS cluster resources for %q have been created", meta.Name)
 
-		// create Kubernetes client
-		clientSet, err := ctl.NewStdClientSet(cfg)
-		if err != nil {
+		var skippedAuthNodeGroups []*api.NodeGroup
+		for _, ng := range cfg.NodeGroups {
+			if cfg.SkipsAWSAuthConfigMap(ng) {
+				skippedAuthNodeGroups = append(skippedAuthNodeGroups, ng)
+			}
+		}
+		if err := authconfigmap.LogNodeGroupsMapRoles(skippedAuthNodeGroups); err != nil {
 			return err
 		}
 
-		for _, ng := range cfg.NodeGroups {
-			// authorise nodes to join
-			if err = authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
+		var clientSet *kubeclient.Clientset
+		if params.NoKubeAccess {
+			deferNodeGroupsAuthorisation(ctl, cfg)
+		} else {
+			// create Kubernetes client
+			clientSet, err = ctl.NewStdClientSet(cfg)
+			if err != nil {
 				return err
 			}
 
-			// wait for nodes to join
-			if err = ctl.WaitForNodes(clientSet, ng); err != nil {
-				return err
+			nodesReadyTimeout := cfg.Timeouts.NodesReadyTimeout(ctl.Provider.WaitTimeout())
+			for _, ng := range cfg.NodeGroups {
+				// the nodes can't join until the auth ConfigMap is updated outside of eksctl
+				if cfg.SkipsAWSAuthConfigMap(ng) {
+					continue
+				}
+				// authorise nodes to join
+				if err = authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
+					return err
+				}
+
+				// wait for nodes to join
+				if err = ctl.WaitForNodes(clientSet, ng, nodesReadyTimeout); err != nil {
+					return err
+				}
 			}
-		}
 
-		for _, ng := range cfg.ManagedNodeGroups {
-			if err := ctl.WaitForNodes(clientSet, ng); err != nil {
-				return err
+			for _, ng := range cfg.ManagedNodeGroups {
+				if err := ctl.WaitForNodes(clientSet, ng, nodesReadyTimeout); err != nil {
+					return err
+				}
 			}
 		}
 		if postNodegroupAddons != nil && postNodegroupAddons.Len() > 0 {
@@ -360,7 +420,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 
 		// After we have the cluster config and all the nodes are done, we install Karpenter if necessary.
 		if cfg.Karpenter != nil {
-			config := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), params.AuthenticatorRoleARN, ctl.Provider.Profile())
+			config := newKubectlConfig(ctl, cfg, params.AuthenticatorRoleARN)
 			kubeConfigBytes, err := runtime.Encode(clientcmdlatest.Codec, config)
 			if err != nil {
 				return errors.Wrap(err, "generating kubeconfig")
@@ -370,8 +430,16 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			}
 		}
 
-		if cfg.HasGitOpsFluxConfigured() {
-			installer, err := flux.New(clientSet, cfg.GitOps)
+		if cfg.HasBootstrap() || cfg.HasCharts() {
+			if err := applyBootstrap(ctx, ctl, cfg, params.AuthenticatorRoleARN); err != nil {
+				return err
+			}
+		}
+
+		if cfg.HasGitOpsFluxConfigured() && params.NoKubeAccess {
+			ctl.DeferKubeStep("install Flux", fmt.Sprintf("eksctl enable flux --config-file=%s", cmd.ClusterConfigFile))
+		} else if cfg.HasGitOpsFluxConfigured() {
+			installer, err := flux.New(clientSet, cfg.GitOps, cfg.Cosign)
 			logger.Info("gitops configuration detected, setting installer to Flux v2")
 			if err != nil {
 				return errors.Wrapf(err, "could not initialise Flux installer")
@@ -385,31 +453,95 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			return nil
 		}
 
-		env, err := ctl.GetCredentialsEnv()
-		if err != nil {
-			return err
-		}
-		if err := kubectl.CheckAllCommands(params.KubeconfigPath, params.SetContext, kubeconfigContextName, env); err != nil {
-			logger.Critical("%s\n", err.Error())
-			logger.Info("cluster should be functional despite missing (or misconfigured) client binaries")
+		if !params.NoKubeAccess {
+			env, err := ctl.GetCredentialsEnv(ctx)
+			if err != nil {
+				return err
+			}
+			if err := kubectl.CheckAllCommands(params.KubeconfigPath, params.SetContext, kubeconfigContextName, env); err != nil {
+				logger.Critical("%s\n", err.Error())
+				logger.Info("cluster should be functional despite missing (or misconfigured) client binaries")
+			}
 		}
 
 		if cfg.PrivateCluster.Enabled {
 			// disable public access
 			logger.Info("disabling public endpoint access for the cluster")
 			cfg.VPC.ClusterEndpoints.PublicAccess = api.Disabled()
-			if err := ctl.UpdateClusterConfigForEndpoints(cfg); err != nil {
+			if err := ctl.UpdateClusterConfigForEndpoints(ctx, cfg); err != nil {
 				return errors.Wrap(err, "error disabling public endpoint access for the cluster")
 			}
 			logger.Info("fully private cluster %q has been created. For subsequent operations, eksctl must be run from within the cluster's VPC, a peered VPC or some other means like AWS Direct Connect", cfg.Metadata.Name)
 		}
 	}
 
+	ctl.LogDeferredKubeSteps(meta)
 	logger.Success("%s is ready", meta.LogString())
 
 	return printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg)
 }
 
+// checkQuotas fails if the Service Quotas of the account are too low for the resources of cfg, after requesting the
+// increases if requestIncreases is set
+func checkQuotas(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig, requestIncreases bool) error {
+	checker := &quotas.Checker{
+		ServiceQuotas: ctl.Provider.ServiceQuotas(),
+		EC2:           ctl.Provider.EC2(),
+		Region:        cfg.Metadata.Region,
+	}
+	shortfalls, err := checker.Check(ctx, cfg)
+	if err != nil {
+		return errors.Wrap(err, "checking service quotas")
+	}
+	if len(shortfalls) == 0 {
+		return nil
+	}
+	if !requestIncreases {
+		return &quotas.ShortfallError{Region: cfg.Metadata.Region, Shortfalls: shortfalls}
+	}
+	if err := checker.RequestIncreases(ctx, shortfalls); err != nil {
+		return err
+	}
+	return fmt.Errorf("requested %d service quota increase(s), rerun the command once they have been approved", len(shortfalls))
+}
+
+func validateNoKubeAccess(cmd *cmdutils.Cmd, params *cmdutils.CreateClusterCmdParams) error {
+	if flag := cmd.CobraCommand.Flag("write-kubeconfig"); flag != nil && flag.Changed && params.WriteKubeconfig {
+		return fmt.Errorf("--no-kube-access and --write-kubeconfig %s", cmdutils.IncompatibleFlags)
+	}
+	if params.InstallWindowsVPCController {
+		return fmt.Errorf("--no-kube-access and --install-vpc-controllers %s", cmdutils.IncompatibleFlags)
+	}
+	if cmd.ClusterConfig.Karpenter != nil {
+		return errors.New("cannot install Karpenter with --no-kube-access, as it needs access to the Kubernetes API")
+	}
+	if cmd.ClusterConfig.HasBootstrap() || cmd.ClusterConfig.HasCharts() {
+		return errors.New("cannot apply bootstrap manifests and Helm charts with --no-kube-access, as it needs access to the Kubernetes API")
+	}
+	return nil
+}
+
+// deferNodeGroupsAuthorisation records the identity mappings that let the nodes of unmanaged nodegroups join the cluster,
+// EKS maps the roles of managed nodegroups itself
+func deferNodeGroupsAuthorisation(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) {
+	for _, ng := range cfg.NodeGroups {
+		if cfg.SkipsAWSAuthConfigMap(ng) {
+			continue
+		}
+		args := []string{
+			"eksctl create iamidentitymapping",
+			fmt.Sprintf("--cluster=%s", cfg.Metadata.Name),
+			fmt.Sprintf("--region=%s", cfg.Metadata.Region),
+			fmt.Sprintf("--arn=%s", ng.IAM.InstanceRoleARN),
+			fmt.Sprintf("--username='%s'", authconfigmap.RoleNodeGroupUsername),
+		}
+		for _, group := range authconfigmap.NodeGroupGroups(ng) {
+			args = append(args, fmt.Sprintf("--group=%s", group))
+		}
+		ctl.DeferKubeStep(fmt.Sprintf("authorise nodes of nodegroup %q to join the cluster", ng.Name), strings.Join(args, " "))
+	}
+}
+
 // installKarpenter prepares the environment for Karpenter, by creating the following resources:
 // - iam roles and profiles
 // - service account
@@ -427,6 +559,40 @@ func installKarpenter(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.Cl
 	return nil
 }
 
+// newKubectlConfig returns a kubeconfig that authenticates with an external authenticator, which calls the
+// dual-stack endpoints of AWS services when eksctl does
+func newKubectlConfig(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, authenticatorRoleARN string) *clientcmdapi.Config {
+	config := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), authenticatorRoleARN, ctl.Provider.Profile())
+	if ctl.IsIPv6Client() {
+		kubeconfig.UseDualStackEndpoints(config)
+	}
+	return config
+}
+
+// applyBootstrap applies the manifests and installs the Helm charts declared in bootstrap and charts, once the
+// cluster, its nodegroups and the IAM roles of its iamserviceaccounts are ready
+func applyBootstrap(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig, authenticatorRoleARN string) error {
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	config := newKubectlConfig(ctl, cfg, authenticatorRoleARN)
+	kubeConfigBytes, err := runtime.Encode(clientcmdlatest.Codec, config)
+	if err != nil {
+		return errors.Wrap(err, "generating kubeconfig")
+	}
+	applier := bootstrap.NewApplier(cfg, rawClient, func(namespace string) (helm.ChartInstaller, error) {
+		return helm.NewInstaller(helm.Options{
+			Namespace:        namespace,
+			RESTClientGetter: kubernetes.NewRESTClientGetter(namespace, string(kubeConfigBytes)),
+		})
+	})
+	if err := applier.Apply(ctx); err != nil {
+		return fmt.Errorf("failed to bootstrap cluster: %w", err)
+	}
+	return nil
+}
+
 func createOrImportVPC(ctx context.Context, cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmdutils.CreateClusterCmdParams, ctl *eks.ClusterProvider) error {
 	customNetworkingNotice := "custom VPC/subnets will be used; if resulting cluster doesn't function as expected, make sure to review the configuration of VPC/subnets"
 
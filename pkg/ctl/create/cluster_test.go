@@ -58,6 +58,7 @@ var _ = Describe("create cluster", func() {
 			Entry("with kubeconfig flag", "--kubeconfig", "~/.kube"),
 			Entry("with authenticator-role-arn flag", "--authenticator-role-arn", "arn::dummy::123/role"),
 			Entry("with auto-kubeconfig flag", "--auto-kubeconfig"),
+			Entry("with no-kube-access flag", "--no-kube-access"),
 			// common node group flags
 			Entry("with node-type flag", "--node-type", "m5.large"),
 			Entry("with nodes flag", "--nodes", "2"),
@@ -20,6 +20,7 @@ func createFargateProfileWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmduti
 		"Create a Fargate profile",
 		"",
 	)
+	cmd.Mutating = true
 	options := configureCreateFargateProfileCmd(cmd)
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
@@ -35,7 +36,8 @@ func createFargateProfile(cmd *cmdutils.Cmd) {
 }
 
 func doCreateFargateProfile(cmd *cmdutils.Cmd) error {
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return errors.Wrap(err, "couldn't create cluster provider from command line options")
 	}
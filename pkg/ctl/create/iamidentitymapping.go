@@ -1,9 +1,10 @@
 package create
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/kris-nova/logger"
 	"github.com/lithammer/dedent"
 	"github.com/pkg/errors"
@@ -38,6 +39,7 @@ func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 			username and groups mapping.
 		`),
 	)
+	cmd.Mutating = true
 
 	var options iamIdentityMappingOptions
 
@@ -64,6 +66,7 @@ func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 }
 
 func doCreateIAMIdentityMapping(cmd *cmdutils.Cmd, options iamIdentityMappingOptions) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -74,7 +77,7 @@ func doCreateIAMIdentityMapping(cmd *cmdutils.Cmd, options iamIdentityMappingOpt
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -20,6 +20,7 @@ func deleteAddonCmd(cmd *cmdutils.Cmd) {
 		"Delete an Addon",
 		"",
 	)
+	cmd.Mutating = true
 
 	cmd.ClusterConfig.Addons = []*api.Addon{{}}
 	var preserve bool
@@ -42,18 +43,19 @@ func deleteAddonCmd(cmd *cmdutils.Cmd) {
 }
 
 func deleteAddon(cmd *cmdutils.Cmd, preserve bool) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewDeleteAddonLoader(cmd).Load(); err != nil {
 		return err
 	}
 
-	clusterProvider, err := cmd.NewProviderForExistingCluster()
+	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
 
 	stackManager := clusterProvider.NewStackManager(cmd.ClusterConfig)
 
-	output, err := clusterProvider.Provider.EKS().DescribeCluster(&awseks.DescribeClusterInput{
+	output, err := clusterProvider.Provider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
 		Name: &cmd.ClusterConfig.Metadata.Name,
 	})
 
@@ -71,7 +73,7 @@ func deleteAddon(cmd *cmdutils.Cmd, preserve bool) error {
 	}
 
 	if preserve {
-		return addonManager.DeleteWithPreserve(cmd.ClusterConfig.Addons[0])
+		return addonManager.DeleteWithPreserve(ctx, cmd.ClusterConfig.Addons[0])
 	}
 
 	return addonManager.Delete(context.TODO(), cmd.ClusterConfig.Addons[0])
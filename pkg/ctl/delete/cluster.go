@@ -1,7 +1,8 @@
 package delete
 
 import (
-	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
@@ -16,26 +17,37 @@ import (
 )
 
 func deleteClusterCmd(cmd *cmdutils.Cmd) {
-	deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int) error {
-		return doDeleteCluster(cmd, force, disableNodegroupEviction, podEvictionWaitPeriod, parallel)
+	deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int, keep cluster.KeepResources, schedule cluster.DeletionSchedule) error {
+		return doDeleteCluster(cmd, force, disableNodegroupEviction, podEvictionWaitPeriod, parallel, keep, schedule)
 	})
 }
 
-func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int) error) {
+func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int, keep cluster.KeepResources, schedule cluster.DeletionSchedule) error) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("cluster", "Delete a cluster", "")
+	cmd.Mutating = true
 
 	var (
 		force                    bool
 		disableNodegroupEviction bool
 		podEvictionWaitPeriod    time.Duration
 		parallel                 int
+		keep                     cluster.KeepResources
+		scheduleAt               string
+		cancelSchedule           bool
 	)
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, force, disableNodegroupEviction, podEvictionWaitPeriod, parallel)
+		schedule, err := makeDeletionSchedule(scheduleAt, cancelSchedule, time.Now())
+		if err != nil {
+			return err
+		}
+		if schedule.IsSet() && keep != (cluster.KeepResources{}) {
+			return errors.New("--keep-vpc, --keep-oidc-provider and --keep-iam-roles cannot be used with --schedule or --cancel-schedule")
+		}
+		return runFunc(cmd, force, disableNodegroupEviction, podEvictionWaitPeriod, parallel, keep, schedule)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -49,23 +61,55 @@ func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd,
 		defaultPodEvictionWaitPeriod, _ := time.ParseDuration("10s")
 		fs.DurationVar(&podEvictionWaitPeriod, "pod-eviction-wait-period", defaultPodEvictionWaitPeriod, "Duration to wait after failing to evict a pod")
 		fs.IntVar(&parallel, "parallel", 1, "Number of nodes to drain in parallel. Max 25")
+		fs.BoolVar(&keep.VPC, "keep-vpc", false, "Keep the VPC, subnets, gateways and route tables eksctl created for the cluster")
+		fs.BoolVar(&keep.OIDCProvider, "keep-oidc-provider", false, "Keep the IAM OIDC provider of the cluster")
+		fs.BoolVar(&keep.IAMRoles, "keep-iam-roles", false, "Keep the IAM roles, policies and instance profiles eksctl created for the cluster")
+		fs.StringVar(&scheduleAt, "schedule", "", "Delete the cluster at a later time instead of now, either a time such as 2006-01-02T15:04:05Z or a duration from now such as 72h")
+		fs.BoolVar(&cancelSchedule, "cancel-schedule", false, "Cancel the deletion scheduled with --schedule")
 
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPrintTaskPlanFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int) error {
+// makeDeletionSchedule parses the time of a scheduled deletion, an RFC 3339 time or a duration from now
+func makeDeletionSchedule(at string, cancel bool, now time.Time) (cluster.DeletionSchedule, error) {
+	schedule := cluster.DeletionSchedule{Cancel: cancel}
+	if at == "" {
+		return schedule, nil
+	}
+	if cancel {
+		return schedule, errors.New("--schedule and --cancel-schedule cannot be used together")
+	}
+	if d, err := time.ParseDuration(at); err == nil {
+		schedule.At = now.Add(d)
+		return schedule, nil
+	}
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return schedule, fmt.Errorf("invalid --schedule %q, expected a time such as 2006-01-02T15:04:05Z or a duration such as 72h", at)
+	}
+	schedule.At = t
+	return schedule, nil
+}
+
+func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int, keep cluster.KeepResources, schedule cluster.DeletionSchedule) error {
+	ctx := cmd.Context()
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
 
+	if schedule.IsSet() {
+		return doScheduleClusterDeletion(cmd, schedule)
+	}
+
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 	printer := printers.NewJSONPrinter()
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		if !force {
 			return err
@@ -84,13 +128,28 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction boo
 		return err
 	}
 
-	ctx := context.TODO()
-	cluster, err := cluster.New(ctx, cfg, ctl)
+	c, err := cluster.New(ctx, cfg, ctl)
 	if err != nil {
 		return err
 	}
 
 	// ProviderConfig.WaitTimeout is not respected by cluster.Delete, which means the operation will never time out.
 	// When this is fixed, a deadline-based Context can be used here.
-	return cluster.Delete(context.TODO(), time.Second*20, podEvictionWaitPeriod, cmd.Wait, force, disableNodegroupEviction, parallel)
+	return c.Delete(ctx, time.Second*20, podEvictionWaitPeriod, cmd.Wait, force, disableNodegroupEviction, parallel, keep)
+}
+
+func doScheduleClusterDeletion(cmd *cmdutils.Cmd, schedule cluster.DeletionSchedule) error {
+	ctx := cmd.Context()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	c, err := cluster.New(ctx, cmd.ClusterConfig, ctl)
+	if err != nil {
+		return err
+	}
+	if schedule.Cancel {
+		return c.CancelScheduledDeletion(ctx, cmd.Wait)
+	}
+	return c.ScheduleDeletion(ctx, schedule.At)
 }
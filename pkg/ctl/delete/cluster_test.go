@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
 
@@ -16,14 +17,16 @@ const (
 
 var _ = Describe("delete cluster", func() {
 	DescribeTable("should be called to delete the cluster",
-		func(forceExpected bool, disableNodegroupEvictionExpected bool, args ...string) {
+		func(forceExpected bool, disableNodegroupEvictionExpected bool, keepExpected cluster.KeepResources, args ...string) {
 			cmd := newMockEmptyCmd(args...)
 			count := 0
 			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-				deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int) error {
+				deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int, keep cluster.KeepResources, schedule cluster.DeletionSchedule) error {
 					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal(clusterName))
 					Expect(force).To(Equal(forceExpected))
 					Expect(disableNodegroupEviction).To(Equal(disableNodegroupEvictionExpected))
+					Expect(keep).To(Equal(keepExpected))
+					Expect(schedule.IsSet()).To(BeFalse())
 					count++
 					return nil
 				})
@@ -32,9 +35,55 @@ var _ = Describe("delete cluster", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(count).To(Equal(1))
 		},
-		Entry("with only valid cluster name", false, false, "cluster", "--name", clusterName),
-		Entry("with valid cluster name and force flag", true, false, "cluster", "--name", clusterName, "--force"),
-		Entry("with valid cluster name and disableNodeGroupEviction flag", false, true, "cluster", "--name", clusterName, "--disable-nodegroup-eviction"),
-		Entry("with valid cluster name, force & disableNodeGroupEviction flags", true, true, "cluster", "--name", clusterName, "--force", "--disable-nodegroup-eviction"),
+		Entry("with only valid cluster name", false, false, cluster.KeepResources{}, "cluster", "--name", clusterName),
+		Entry("with valid cluster name and force flag", true, false, cluster.KeepResources{}, "cluster", "--name", clusterName, "--force"),
+		Entry("with valid cluster name and disableNodeGroupEviction flag", false, true, cluster.KeepResources{}, "cluster", "--name", clusterName, "--disable-nodegroup-eviction"),
+		Entry("with valid cluster name, force & disableNodeGroupEviction flags", true, true, cluster.KeepResources{}, "cluster", "--name", clusterName, "--force", "--disable-nodegroup-eviction"),
+		Entry("with valid cluster name and keep flags", false, false, cluster.KeepResources{VPC: true, OIDCProvider: true, IAMRoles: true}, "cluster", "--name", clusterName, "--keep-vpc", "--keep-oidc-provider", "--keep-iam-roles"),
 	)
+
+	It("schedules the deletion at a time", func() {
+		cmd := newMockEmptyCmd("cluster", "--name", clusterName, "--schedule", "2030-01-02T15:04:05Z")
+		count := 0
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+			deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, _ bool, _ bool, _ time.Duration, _ int, _ cluster.KeepResources, schedule cluster.DeletionSchedule) error {
+				Expect(schedule).To(Equal(cluster.DeletionSchedule{At: time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)}))
+				count++
+				return nil
+			})
+		})
+		_, err := cmd.execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+	})
+
+	DescribeTable("parsing the schedule", func(at string, cancel bool, expected cluster.DeletionSchedule, expectedErr string) {
+		now := time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+		schedule, err := makeDeletionSchedule(at, cancel, now)
+		if expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schedule).To(Equal(expected))
+	},
+		Entry("no schedule", "", false, cluster.DeletionSchedule{}, ""),
+		Entry("a duration from now", "72h", false, cluster.DeletionSchedule{At: time.Date(2022, 5, 4, 10, 0, 0, 0, time.UTC)}, ""),
+		Entry("a time with an offset", "2022-05-02T09:30:00+02:00", false, cluster.DeletionSchedule{At: time.Date(2022, 5, 2, 9, 30, 0, 0, time.FixedZone("", 2*60*60))}, ""),
+		Entry("cancelling", "", true, cluster.DeletionSchedule{Cancel: true}, ""),
+		Entry("an invalid time", "tomorrow", false, cluster.DeletionSchedule{}, `invalid --schedule "tomorrow"`),
+		Entry("scheduling and cancelling", "72h", true, cluster.DeletionSchedule{}, "--schedule and --cancel-schedule cannot be used together"),
+	)
+
+	It("rejects keeping resources of a scheduled deletion", func() {
+		cmd := newMockEmptyCmd("cluster", "--name", clusterName, "--schedule", "72h", "--keep-vpc")
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+			deleteClusterWithRunFunc(cmd, func(*cmdutils.Cmd, bool, bool, time.Duration, int, cluster.KeepResources, cluster.DeletionSchedule) error {
+				Fail("the cluster must not be deleted")
+				return nil
+			})
+		})
+		_, err := cmd.execute()
+		Expect(err).To(MatchError(ContainSubstring("cannot be used with --schedule or --cancel-schedule")))
+	})
 })
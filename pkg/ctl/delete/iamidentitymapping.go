@@ -1,6 +1,7 @@
 package delete
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
@@ -23,6 +24,7 @@ func deleteIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 	)
 
 	cmd.SetDescription("iamidentitymapping", "Delete a IAM identity mapping", "")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		return doDeleteIAMIdentityMapping(cmd, arn, account, all)
@@ -42,13 +44,14 @@ func deleteIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 }
 
 func doDeleteIAMIdentityMapping(cmd *cmdutils.Cmd, arn, account string, all bool) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
 
 	cfg := cmd.ClusterConfig
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
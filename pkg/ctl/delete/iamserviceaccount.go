@@ -16,12 +16,12 @@ import (
 )
 
 func deleteIAMServiceAccountCmd(cmd *cmdutils.Cmd) {
-	deleteIAMServiceAccountCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing bool) error {
-		return doDeleteIAMServiceAccount(cmd, serviceAccount, onlyMissing)
+	deleteIAMServiceAccountCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing, onlyMissingFromCluster bool) error {
+		return doDeleteIAMServiceAccount(cmd, serviceAccount, onlyMissing, onlyMissingFromCluster)
 	})
 }
 
-func deleteIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing bool) error) {
+func deleteIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing, onlyMissingFromCluster bool) error) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
@@ -30,13 +30,14 @@ func deleteIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 	cfg.IAM.WithOIDC = api.Enabled()
 	cfg.IAM.ServiceAccounts = append(cfg.IAM.ServiceAccounts, serviceAccount)
 
-	var onlyMissing bool
+	var onlyMissing, onlyMissingFromCluster bool
 
 	cmd.SetDescription("iamserviceaccount", "Delete an IAM service account", "")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, serviceAccount, onlyMissing)
+		return runFunc(cmd, serviceAccount, onlyMissing, onlyMissingFromCluster)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -47,6 +48,7 @@ func deleteIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 
 		cmdutils.AddIAMServiceAccountFilterFlags(fs, &cmd.Include, &cmd.Exclude)
 		fs.BoolVar(&onlyMissing, "only-missing", false, "Only delete iamserviceaccounts that are not defined in the given config file")
+		fs.BoolVar(&onlyMissingFromCluster, "only-missing-from-cluster", false, "Only delete iamserviceaccounts whose Kubernetes service account no longer exists in the cluster")
 		cmdutils.AddApproveFlag(fs, cmd)
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
@@ -54,15 +56,17 @@ func deleteIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPrintTaskPlanFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing bool) error {
+func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing, onlyMissingFromCluster bool) error {
+	ctx := context.TODO()
 	saFilter := filter.NewIAMServiceAccountFilter()
 
-	if err := cmdutils.NewDeleteIAMServiceAccountLoader(cmd, serviceAccount, saFilter).Load(); err != nil {
+	if err := cmdutils.NewDeleteIAMServiceAccountLoader(cmd, serviceAccount, saFilter, onlyMissingFromCluster).Load(); err != nil {
 		return err
 	}
 
@@ -70,7 +74,7 @@ func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAM
 
 	printer := printers.NewJSONPrinter()
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -84,7 +88,7 @@ func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAM
 		return err
 	}
 
-	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
 	if err != nil {
 		return err
 	}
@@ -100,6 +104,11 @@ func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAM
 
 	stackManager := ctl.NewStackManager(cfg)
 
+	if onlyMissingFromCluster {
+		irsaManager := irsa.New(cfg.Metadata.Name, stackManager, oidc, clientSet)
+		return irsaManager.PruneMissingFromCluster(ctx, saFilter.Match, cmd.Plan, cmd.Wait)
+	}
+
 	if cmd.ClusterConfigFile != "" {
 		logger.Info("comparing %d iamserviceaccounts defined in the given config (%q) against remote state", len(cfg.IAM.ServiceAccounts), cmd.ClusterConfigFile)
 		if err := saFilter.SetDeleteFilter(context.TODO(), stackManager, onlyMissing, cfg); err != nil {
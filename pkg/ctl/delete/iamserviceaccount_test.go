@@ -21,10 +21,11 @@ var _ = Describe("delete iamserviceaccount", func() {
 			cmd := newMockEmptyCmd(commandArgs...)
 			count := 0
 			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-				deleteIAMServiceAccountCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing bool) error {
+				deleteIAMServiceAccountCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing, onlyMissingFromCluster bool) error {
 					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("clusterName"))
 					Expect(cmd.ClusterConfig.IAM.ServiceAccounts[0].Name).To(Equal("serviceAccountName"))
 					Expect(onlyMissing).To(Equal(strings.Contains(strings.Join(commandArgs, " "), "only-missing")))
+					Expect(onlyMissingFromCluster).To(BeFalse())
 					count++
 					return nil
 				})
@@ -39,6 +40,22 @@ var _ = Describe("delete iamserviceaccount", func() {
 		Entry("with approve flag", "--cluster", "clusterName", "--name", "serviceAccountName", "--approve"),
 	)
 
+	It("passes --only-missing-from-cluster to the run func", func() {
+		cmd := newMockEmptyCmd("iamserviceaccount", "--cluster", "clusterName", "--only-missing-from-cluster")
+		count := 0
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+			deleteIAMServiceAccountCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, onlyMissing, onlyMissingFromCluster bool) error {
+				Expect(onlyMissing).To(BeFalse())
+				Expect(onlyMissingFromCluster).To(BeTrue())
+				count++
+				return nil
+			})
+		})
+		_, err := cmd.execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+	})
+
 	DescribeTable("invalid flags or arguments",
 		func(c invalidParamsCase) {
 			commandArgs := append([]string{"iamserviceaccount"}, c.args...)
@@ -18,12 +18,12 @@ import (
 )
 
 func deleteNodeGroupCmd(cmd *cmdutils.Cmd) {
-	deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int) error {
-		return doDeleteNodeGroup(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, podEvictionWaitPeriod, disableEviction, parallel)
+	deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, drainTimeout time.Duration, drainParallel int, continueOnPDBBlock, detachOnly bool) error {
+		return doDeleteNodeGroup(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, podEvictionWaitPeriod, disableEviction, parallel, drainTimeout, drainParallel, continueOnPDBBlock, detachOnly)
 	})
 }
 
-func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int) error) {
+func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, drainTimeout time.Duration, drainParallel int, continueOnPDBBlock, detachOnly bool) error) {
 	cfg := api.NewClusterConfig()
 	ng := api.NewNodeGroup()
 	cmd.ClusterConfig = cfg
@@ -36,13 +36,18 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 		podEvictionWaitPeriod time.Duration
 		disableEviction       bool
 		parallel              int
+		drainTimeout          time.Duration
+		drainParallel         int
+		continueOnPDBBlock    bool
+		detachOnly            bool
 	)
 
 	cmd.SetDescription("nodegroup", "Delete a nodegroup", "", "ng")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, podEvictionWaitPeriod, disableEviction, parallel)
+		return runFunc(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, podEvictionWaitPeriod, disableEviction, parallel, drainTimeout, drainParallel, continueOnPDBBlock, detachOnly)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -62,16 +67,22 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 		defaultDisableEviction := false
 		fs.BoolVar(&disableEviction, "disable-eviction", defaultDisableEviction, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
 		fs.IntVar(&parallel, "parallel", 1, "Number of nodes to drain in parallel. Max 25")
+		fs.DurationVar(&drainTimeout, "drain-timeout", 0, "Maximum time to wait for each nodegroup to be drained, defaults to the value of --timeout")
+		fs.IntVar(&drainParallel, "drain-parallel", 1, "Number of nodegroups to drain in parallel")
+		fs.BoolVar(&continueOnPDBBlock, "continue-on-pdb-block", false, "Continue with the deletion of nodegroups whose drain times out because pod evictions are blocked by a PodDisruptionBudget")
+		fs.BoolVar(&detachOnly, "detach-only", false, "Detach the instances of the nodegroups from their Auto Scaling groups and leave them running, tagged with "+api.DetachedFromNodeGroupTag+", instead of terminating them. Nodes are not drained")
 
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPrintTaskPlanFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int) error {
+func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, drainTimeout time.Duration, drainParallel int, continueOnPDBBlock, detachOnly bool) error {
+	ctx := context.TODO()
 	ngFilter := filter.NewNodeGroupFilter()
 
 	if err := cmdutils.NewDeleteAndDrainNodeGroupLoader(cmd, ng, ngFilter).Load(); err != nil {
@@ -80,7 +91,7 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 
 	cfg := cmd.ClusterConfig
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -95,7 +106,6 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 	}
 
 	stackManager := ctl.NewStackManager(cfg)
-	ctx := context.TODO()
 
 	if cmd.ClusterConfigFile != "" {
 		logger.Info("comparing %d nodegroups defined in the given config (%q) against remote state", len(cfg.NodeGroups), cmd.ClusterConfigFile)
@@ -129,6 +139,10 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 	allNodeGroups := cmdutils.ToKubeNodeGroups(cfg)
 
 	nodeGroupManager := nodegroup.New(cfg, ctl, clientSet)
+	if detachOnly {
+		// the nodes are left as they are, so that their state can be captured
+		deleteNodeGroupDrain = false
+	}
 	if deleteNodeGroupDrain {
 		cmdutils.LogIntendedAction(cmd.Plan, "drain %d nodegroup(s) in cluster %q", len(allNodeGroups), cfg.Metadata.Name)
 
@@ -139,6 +153,9 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 			PodEvictionWaitPeriod: podEvictionWaitPeriod,
 			DisableEviction:       disableEviction,
 			Parallel:              parallel,
+			DrainTimeout:          drainTimeout,
+			NodeGroupParallel:     drainParallel,
+			ContinueOnPDBBlock:    continueOnPDBBlock,
 		}
 		err := nodeGroupManager.Drain(drainInput)
 		if err != nil {
@@ -147,6 +164,13 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 		}
 	}
 
+	if detachOnly {
+		cmdutils.LogIntendedAction(cmd.Plan, "detach the instances of %d nodegroup(s) in cluster %q", len(allNodeGroups), cfg.Metadata.Name)
+		if err := nodeGroupManager.DetachInstances(ctx, allNodeGroups, cmd.Plan); err != nil {
+			return err
+		}
+	}
+
 	cmdutils.LogIntendedAction(cmd.Plan, "delete %d nodegroups from cluster %q", len(allNodeGroups), cfg.Metadata.Name)
 
 	err = nodeGroupManager.Delete(context.TODO(), cfg.NodeGroups, cfg.ManagedNodeGroups, cmd.Wait, cmd.Plan)
@@ -158,6 +182,10 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 		cmdutils.LogIntendedAction(cmd.Plan, "delete %d nodegroups from auth ConfigMap in cluster %q", len(cfg.NodeGroups), cfg.Metadata.Name)
 		if !cmd.Plan {
 			for _, ng := range cfg.NodeGroups {
+				if cfg.SkipsAWSAuthConfigMap(ng) {
+					logger.Info("auth ConfigMap not updated for nodegroup %q, remove the mapRoles entry of its instance role outside of eksctl", ng.Name)
+					continue
+				}
 				if ng.IAM != nil && ng.IAM.InstanceRoleARN != "" {
 					if err := authconfigmap.RemoveNodeGroup(clientSet, ng); err != nil {
 						logger.Warning(err.Error())
@@ -22,7 +22,7 @@ var _ = Describe("delete", func() {
 			cmd := newMockEmptyCmd(args...)
 			count := 0
 			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-				deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int) error {
+				deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, drainTimeout time.Duration, drainParallel int, continueOnPDBBlock, detachOnly bool) error {
 					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("clusterName"))
 					Expect(ng.Name).To(Equal("ng"))
 					count++
@@ -35,6 +35,8 @@ var _ = Describe("delete", func() {
 		},
 		Entry("with valid details", "nodegroup", "--cluster", "clusterName", "--name", "ng"),
 		Entry("with deprecated flag --only", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--only", "ng"),
+		Entry("with drain flags", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--drain-timeout", "5m", "--drain-parallel", "3", "--continue-on-pdb-block"),
+		Entry("with --detach-only", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--detach-only"),
 	)
 
 	DescribeTable("invalid flags or arguments",
@@ -61,5 +63,13 @@ var _ = Describe("delete", func() {
 			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--parallel", "26"},
 			error: fmt.Errorf("Error: --parallel value must be of range 1-25"),
 		}),
+		Entry("setting --drain-parallel below 1", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--drain-parallel", "0"},
+			error: fmt.Errorf("Error: --drain-parallel value must be at least 1"),
+		}),
+		Entry("setting --drain-timeout to 0", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--drain-timeout", "0s"},
+			error: fmt.Errorf("Error: --drain-timeout value must be greater than 0"),
+		}),
 	)
 })
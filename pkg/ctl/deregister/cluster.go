@@ -16,6 +16,7 @@ import (
 
 func deregisterClusterCmd(cmd *cmdutils.Cmd) {
 	cmd.SetDescription("cluster", "Deregister a non-EKS Kubernetes cluster", "")
+	cmd.Mutating = true
 
 	var clusterName string
 
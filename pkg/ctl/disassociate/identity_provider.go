@@ -1,6 +1,7 @@
 package disassociate
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -24,6 +25,7 @@ func disassociateIdentityProvider(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("identityprovider", "Disassociate an identity provider from a cluster", "")
+	cmd.Mutating = true
 
 	var cliProvidedIDP cliProvidedIDP
 	var timeout time.Duration
@@ -67,13 +69,14 @@ func newDisassociateIdentityProviderLoader(cmd *cmdutils.Cmd, cliProvidedIDP cli
 }
 
 func doDisassociateIdentityProvider(cmd *cmdutils.Cmd, cliProvidedIDP cliProvidedIDP, timeout time.Duration) error {
+	ctx := context.TODO()
 	if err := newDisassociateIdentityProviderLoader(cmd, cliProvidedIDP).Load(); err != nil {
 		return err
 	}
 
 	cfg := cmd.ClusterConfig
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -96,7 +99,7 @@ func doDisassociateIdentityProvider(cmd *cmdutils.Cmd, cliProvidedIDP cliProvide
 		options.WaitTimeout = &timeout
 	}
 
-	return manager.Disassociate(options)
+	return manager.Disassociate(ctx, options)
 }
 
 func cliToProviders(cfg *api.ClusterConfig, cliProvidedIDP cliProvidedIDP) []identityproviders.DisassociateIdentityProvider {
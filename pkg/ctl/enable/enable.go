@@ -11,5 +11,6 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	verbCmd := cmdutils.NewVerbCmd("enable", "Enable features in a cluster", "")
 
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableFlux2)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableMonitoring)
 	return verbCmd
 }
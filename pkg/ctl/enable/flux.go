@@ -1,6 +1,7 @@
 package enable
 
 import (
+	"context"
 	"os"
 
 	"github.com/kris-nova/logger"
@@ -25,6 +26,7 @@ func configureAndRun(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
 		"Set up GitOps Toolkit - deploys FluxV2 and creates Git repo to store manifests",
 		"",
 	)
+	cmd.Mutating = true
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
@@ -46,11 +48,12 @@ func configureAndRun(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
 }
 
 func flux2Install(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
 	logger.Info("eksctl version %s", version.GetVersion())
 	logger.Info("will install Flux v2 components on cluster %s", cmd.ClusterConfig.Metadata.Name)
 
 	if kubeconfAndContextNotSet(cmd.ClusterConfig.GitOps.Flux.Flags) {
-		ctl, err := cmd.NewProviderForExistingCluster()
+		ctl, err := cmd.NewProviderForExistingCluster(ctx)
 		if err != nil {
 			return err
 		}
@@ -75,12 +78,12 @@ func flux2Install(cmd *cmdutils.Cmd) error {
 		cmd.ClusterConfig.GitOps.Flux.Flags["kubeconfig"] = kubeCfgPath.Name()
 	}
 
-	k8sClientSet, _, err := cmdutils.KubernetesClientAndConfigFrom(cmd)
+	k8sClientSet, _, err := cmdutils.KubernetesClientAndConfigFrom(ctx, cmd)
 	if err != nil {
 		return err
 	}
 
-	installer, err := flux.New(k8sClientSet, cmd.ClusterConfig.GitOps)
+	installer, err := flux.New(k8sClientSet, cmd.ClusterConfig.GitOps, cmd.ClusterConfig.Cosign)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,83 @@
+package enable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+
+	"github.com/weaveworks/eksctl/pkg/actions/monitoring"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+)
+
+func enableMonitoring(cmd *cmdutils.Cmd) {
+	enableMonitoringWithRunFunc(cmd, doEnableMonitoring)
+}
+
+func enableMonitoringWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+	cmd.SetDescription(
+		"monitoring",
+		"Write the metrics of a cluster to Amazon Managed Service for Prometheus, and query them with Amazon Managed Grafana",
+		"Creates a Prometheus workspace, installs a Prometheus server that writes to it with an IAM role for its service account, and gives a Grafana workspace access to it",
+	)
+	cmd.Mutating = true
+
+	options := &cmdutils.EnableMonitoringOptions{}
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewEnableMonitoringLoader(cmd, options).Load(); err != nil {
+			return err
+		}
+		return runFunc(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.BoolVar(&options.AMP, "amp", false, "write the metrics of the cluster to an Amazon Managed Service for Prometheus workspace")
+		fs.BoolVar(&options.AMG, "amg", false, "give an Amazon Managed Grafana workspace access to the Prometheus workspace")
+		fs.StringVar(&options.GrafanaWorkspaceID, "grafana-workspace-id", "", "ID of the Amazon Managed Grafana workspace, required with --amg")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doEnableMonitoring(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
+	cfg := cmd.ClusterConfig
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	kubeConfigBytes, err := runtime.Encode(clientcmdlatest.Codec, kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), "", ctl.Provider.Profile()))
+	if err != nil {
+		return fmt.Errorf("generating kubeconfig: %w", err)
+	}
+
+	logger.Info("will set up monitoring of cluster %q", cfg.Metadata.Name)
+	enabler, err := monitoring.NewEnabler(ctx, cfg, ctl, ctl.NewStackManager(cfg), clientSet, kubernetes.NewRESTClientGetter(monitoring.DefaultNamespace, string(kubeConfigBytes)))
+	if err != nil {
+		return err
+	}
+	return enabler.Enable(ctx)
+}
@@ -0,0 +1,98 @@
+package enable
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
+)
+
+var _ = Describe("enable monitoring", func() {
+	mockEnableMonitoringCmd := func(args ...string) *ctltest.MockCmd {
+		return ctltest.NewMockCmd(enableMonitoringWithRunFunc, "enable", append([]string{"monitoring"}, args...)...)
+	}
+
+	It("sets up Prometheus and Grafana from flags", func() {
+		cmd := mockEnableMonitoringCmd("--cluster", "cluster-1", "--amp", "--amg", "--grafana-workspace-id", "g-0123456789")
+		_, err := cmd.Execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmd.Cmd.ClusterConfig.Observability).To(Equal(&api.Observability{
+			Prometheus: &api.ObservabilityPrometheus{},
+			Grafana:    &api.ObservabilityGrafana{WorkspaceID: "g-0123456789"},
+		}))
+	})
+
+	DescribeTable("invalid flags",
+		func(expectedErr string, args ...string) {
+			_, err := mockEnableMonitoringCmd(args...).Execute()
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("missing cluster", "--cluster must be set", "--amp"),
+		Entry("missing --amp", "--amp must be set", "--cluster", "cluster-1"),
+		Entry("--amg without a workspace", "--grafana-workspace-id must be set", "--cluster", "cluster-1", "--amp", "--amg"),
+		Entry("workspace without --amg", "--grafana-workspace-id can only be used with --amg", "--cluster", "cluster-1", "--amp", "--grafana-workspace-id", "g-0123456789"),
+	)
+
+	When("--config-file is provided", func() {
+		var (
+			configFile string
+			cfg        *api.ClusterConfig
+			cmd        *ctltest.MockCmd
+			err        error
+			args       []string
+		)
+
+		BeforeEach(func() {
+			cfg = &api.ClusterConfig{
+				TypeMeta: api.ClusterConfigTypeMeta(),
+				Metadata: &api.ClusterMeta{
+					Name:   "cluster-1",
+					Region: "us-west-2",
+				},
+				Observability: &api.Observability{
+					Prometheus: &api.ObservabilityPrometheus{WorkspaceID: "ws-0123456789"},
+				},
+			}
+			args = nil
+		})
+
+		JustBeforeEach(func() {
+			configFile = ctltest.CreateConfigFile(cfg)
+			cmd = mockEnableMonitoringCmd(append([]string{"-f", configFile}, args...)...)
+			_, err = cmd.Execute()
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(configFile)).To(Succeed())
+		})
+
+		It("uses the observability section", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd.Cmd.ClusterConfig.Observability.Prometheus.WorkspaceID).To(Equal("ws-0123456789"))
+		})
+
+		When("observability.prometheus is not set", func() {
+			BeforeEach(func() {
+				cfg.Observability = nil
+			})
+
+			It("fails", func() {
+				Expect(err).To(MatchError("observability.prometheus must be set"))
+			})
+		})
+
+		When("--amp is set", func() {
+			BeforeEach(func() {
+				args = []string{"--amp"}
+			})
+
+			It("fails", func() {
+				Expect(err).To(MatchError(ContainSubstring("cannot use --amp when --config-file/-f is set")))
+			})
+		})
+	})
+})
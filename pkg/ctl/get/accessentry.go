@@ -0,0 +1,114 @@
+package get
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/accessentries"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getAccessEntriesCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+	params := &getCmdParams{}
+
+	cmd.SetDescription(
+		"accessentries",
+		"Get access entries and the access policies associated with them",
+		"Lists the IAM principals granted access to the cluster by access entries, with their Kubernetes username "+
+			"and groups and the access policies associated with them",
+		"accessentry",
+	)
+
+	var principalARN string
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetAccessEntries(cmd, params, principalARN)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+
+		fs.StringVar(&principalARN, "principal-arn", "", "ARN of the IAM principal whose access entry to get")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doGetAccessEntries(cmd *cmdutils.Cmd, params *getCmdParams, principalARN string) error {
+	ctx := context.TODO()
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	if params.output != printers.TableType {
+		//log warnings and errors to stderr
+		logger.Writer = os.Stderr
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	getter := accessentries.NewGetter(cmd.ClusterConfig.Metadata.Name, ctl.Provider.EKS())
+	summaries, err := getter.Get(ctx, principalARN)
+	if err != nil {
+		return err
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == printers.TableType {
+		addAccessEntryTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("access entries", summaries, os.Stdout)
+}
+
+func addAccessEntryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("PRINCIPAL ARN", func(s accessentries.Summary) string {
+		return s.PrincipalARN
+	})
+	printer.AddColumn("TYPE", func(s accessentries.Summary) string {
+		return s.Type
+	})
+	printer.AddColumn("USERNAME", func(s accessentries.Summary) string {
+		return s.Username
+	})
+	printer.AddColumn("GROUPS", func(s accessentries.Summary) string {
+		return strings.Join(s.KubernetesGroups, ",")
+	})
+	printer.AddColumn("ACCESS POLICIES", func(s accessentries.Summary) string {
+		return formatAccessPolicies(s.AccessPolicies)
+	})
+}
+
+// formatAccessPolicies shows an access policy by name, followed by the namespaces it is limited to
+func formatAccessPolicies(policies []accessentries.AccessPolicy) string {
+	var formatted []string
+	for _, p := range policies {
+		name := p.PolicyARN[strings.LastIndex(p.PolicyARN, "/")+1:]
+		if len(p.AccessScope.Namespaces) > 0 {
+			name = fmt.Sprintf("%s(%s)", name, strings.Join(p.AccessScope.Namespaces, ","))
+		}
+		formatted = append(formatted, name)
+	}
+	return strings.Join(formatted, ", ")
+}
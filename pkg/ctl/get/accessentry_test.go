@@ -0,0 +1,39 @@
+package get
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/accessentries"
+)
+
+var _ = Describe("get", func() {
+	Describe("accessentries", func() {
+		It("missing required flag --cluster", func() {
+			cmd := newMockCmd("accessentries")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --cluster must be set"))
+		})
+
+		It("invalid flag --dummy", func() {
+			cmd := newMockCmd("accessentries", "--invalid", "dummy")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: unknown flag: --invalid"))
+		})
+
+		It("formats access policies with the namespaces they are limited to", func() {
+			Expect(formatAccessPolicies([]accessentries.AccessPolicy{
+				{
+					PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy",
+					AccessScope: accessentries.AccessScopeSummary{Type: "cluster"},
+				},
+				{
+					PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy",
+					AccessScope: accessentries.AccessScopeSummary{Type: "namespace", Namespaces: []string{"dev", "test"}},
+				},
+			})).To(Equal("AmazonEKSClusterAdminPolicy, AmazonEKSViewPolicy(dev,test)"))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package get
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/accesspolicy"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getAccessPoliciesCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	params := &getCmdParams{}
+
+	cmd.SetDescription(
+		"accesspolicies",
+		"Get the access policies managed by EKS",
+		"Lists the access policies managed by EKS, along with their ARNs and the access scopes they can be associated with, "+
+			"for use in the accessEntries of a config file",
+		"accesspolicy",
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetAccessPolicies(cmd, params)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVarP(&cmd.ProviderConfig.Region, "region", "r", "", "AWS region whose partition the policy ARNs are listed for")
+		fs.StringVarP(&params.output, "output", "o", "table", "specifies the output format (valid option: table, json, yaml)")
+	})
+}
+
+func doGetAccessPolicies(cmd *cmdutils.Cmd, params *getCmdParams) error {
+	if params.output != printers.TableType {
+		//log warnings and errors to stdout
+		logger.Writer = os.Stderr
+	}
+
+	partition := api.Partition(cmd.ProviderConfig.Region)
+	policies := accesspolicy.List(partition)
+	if cmd.NameArg != "" {
+		policy, ok := accesspolicy.Find(partition, cmd.NameArg)
+		if !ok {
+			return fmt.Errorf("no access policy named %q is managed by EKS", cmd.NameArg)
+		}
+		policies = []accesspolicy.Policy{policy}
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == printers.TableType {
+		addAccessPolicyTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("access policies", policies, os.Stdout)
+}
+
+func addAccessPolicyTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(p accesspolicy.Policy) string {
+		return p.Name
+	})
+	printer.AddColumn("ARN", func(p accesspolicy.Policy) string {
+		return p.ARN
+	})
+	printer.AddColumn("SCOPES", func(p accesspolicy.Policy) string {
+		return strings.Join(p.Scopes, ",")
+	})
+	printer.AddColumn("DESCRIPTION", func(p accesspolicy.Policy) string {
+		return p.Description
+	})
+}
@@ -0,0 +1,29 @@
+package get
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("get", func() {
+	Describe("accesspolicies", func() {
+		It("lists the access policies", func() {
+			cmd := newMockCmd("accesspolicies", "--output", "json")
+			_, err := cmd.execute()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("fails for unknown access policies", func() {
+			cmd := newMockCmd("accesspolicies", "AmazonEKSUnknownPolicy")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`Error: no access policy named "AmazonEKSUnknownPolicy" is managed by EKS`))
+		})
+
+		It("fails for invalid output formats", func() {
+			cmd := newMockCmd("accesspolicies", "--output", "csv")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
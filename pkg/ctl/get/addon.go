@@ -1,10 +1,12 @@
 package get
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/kris-nova/logger"
 
 	"github.com/spf13/cobra"
@@ -15,9 +17,14 @@ import (
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
+// wideOutput prints the addon table with additional columns detailing the addons' issues, pod identity
+// associations and configuration values
+const wideOutput printers.Type = "wide"
+
 func getAddonCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = api.NewClusterConfig()
 	params := &getCmdParams{}
+	var recommended bool
 
 	cmd.SetDescription(
 		"addon",
@@ -29,6 +36,7 @@ func getAddonCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig.Addons = []*api.Addon{{}}
 	cmd.FlagSetGroup.InFlagSet("Addon", func(fs *pflag.FlagSet) {
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Name, "name", "", "Addon name")
+		fs.BoolVar(&recommended, "recommended", false, "List the addons with a default version for the cluster's Kubernetes version that aren't installed")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -36,30 +44,40 @@ func getAddonCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		fs.Lookup("output").Usage = "specifies the output format (valid option: table, wide, json, yaml)"
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return getAddon(cmd, params)
+		if recommended && cmd.ClusterConfig.Addons[0].Name != "" {
+			return fmt.Errorf("--recommended and --name cannot be used together")
+		}
+		return getAddon(cmd, params, recommended)
 	}
 }
 
-func getAddon(cmd *cmdutils.Cmd, params *getCmdParams) error {
+func getAddon(cmd *cmdutils.Cmd, params *getCmdParams, recommended bool) error {
+	ctx := context.TODO()
+	wide := params.output == wideOutput
+	if wide {
+		params.output = printers.TableType
+	}
+
 	if params.output != printers.TableType {
 		//log warnings and errors to stdout
 		logger.Writer = os.Stderr
 	}
 
-	clusterProvider, err := cmd.NewProviderForExistingCluster()
+	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
 
 	stackManager := clusterProvider.NewStackManager(cmd.ClusterConfig)
 
-	output, err := clusterProvider.Provider.EKS().DescribeCluster(&awseks.DescribeClusterInput{
+	output, err := clusterProvider.Provider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
 		Name: &cmd.ClusterConfig.Metadata.Name,
 	})
 
@@ -76,14 +94,18 @@ func getAddon(cmd *cmdutils.Cmd, params *getCmdParams) error {
 		return err
 	}
 
+	if recommended {
+		return getRecommendedAddons(ctx, addonManager, params.output)
+	}
+
 	var summaries []addon.Summary
 	if cmd.ClusterConfig.Addons[0].Name == "" {
-		summaries, err = addonManager.GetAll()
+		summaries, err = addonManager.GetAll(ctx)
 		if err != nil {
 			return err
 		}
 	} else {
-		summary, err := addonManager.Get(cmd.ClusterConfig.Addons[0])
+		summary, err := addonManager.Get(ctx, cmd.ClusterConfig.Addons[0])
 		summaries = []addon.Summary{summary}
 		if err != nil {
 			return err
@@ -101,6 +123,9 @@ func getAddon(cmd *cmdutils.Cmd, params *getCmdParams) error {
 
 	if params.output == printers.TableType {
 		addAddonSummaryTableColumns(printer.(*printers.TablePrinter))
+		if wide {
+			addAddonIssuesTableColumns(printer.(*printers.TablePrinter))
+		}
 	}
 
 	if err := printer.PrintObjWithKind("addons", summaries, os.Stdout); err != nil {
@@ -110,15 +135,53 @@ func getAddon(cmd *cmdutils.Cmd, params *getCmdParams) error {
 	//if getting a particular addon, print the issue
 	if cmd.ClusterConfig.Addons[0].Name != "" {
 		for _, issue := range summaries[0].Issues {
-			if issue != "" {
-				fmt.Printf("Issue: %s\n", issue)
-			}
+			fmt.Printf("Issue: %s\n", issue)
 		}
 	}
 
 	return nil
 }
 
+func getRecommendedAddons(ctx context.Context, addonManager *addon.Manager, output printers.Type) error {
+	recommendations, err := addonManager.GetRecommended(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(recommendations) > 0 {
+		logger.Info("to install an addon run `eksctl create addon --name <addon-name> --cluster <cluster-name>`")
+	}
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	if output == printers.TableType {
+		addRecommendedAddonTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("addons", recommendations, os.Stdout)
+}
+
+func addRecommendedAddonTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(r addon.Recommendation) string {
+		return r.Name
+	})
+	printer.AddColumn("TYPE", func(r addon.Recommendation) string {
+		return r.Type
+	})
+	printer.AddColumn("DEFAULT VERSION", func(r addon.Recommendation) string {
+		return r.DefaultVersion
+	})
+	printer.AddColumn("LATEST VERSION", func(r addon.Recommendation) string {
+		return r.LatestVersion
+	})
+	printer.AddColumn("IAM ROLE REQUIRED", func(r addon.Recommendation) bool {
+		return r.IAMRoleRequired
+	})
+}
+
 func addAddonSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("NAME", func(s addon.Summary) string {
 		return s.Name
@@ -139,3 +202,26 @@ func addAddonSummaryTableColumns(printer *printers.TablePrinter) {
 		return s.NewerVersion
 	})
 }
+
+func addAddonIssuesTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("ISSUE CODES", func(s addon.Summary) string {
+		var codes []string
+		for _, issue := range s.Issues {
+			codes = append(codes, issue.Code)
+		}
+		return strings.Join(codes, ",")
+	})
+	printer.AddColumn("ISSUE MESSAGES", func(s addon.Summary) string {
+		var messages []string
+		for _, issue := range s.Issues {
+			messages = append(messages, issue.Message)
+		}
+		return strings.Join(messages, "; ")
+	})
+	printer.AddColumn("POD IDENTITY ASSOCIATIONS", func(s addon.Summary) string {
+		return strings.Join(s.PodIdentityAssociations, ",")
+	})
+	printer.AddColumn("CONFIGURATION VALUES HASH", func(s addon.Summary) string {
+		return s.ConfigurationValuesHash
+	})
+}
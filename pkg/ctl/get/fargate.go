@@ -1,6 +1,7 @@
 package get
 
 import (
+	"context"
 	"os"
 
 	"github.com/kris-nova/logger"
@@ -58,12 +59,13 @@ func configureGetFargateProfileCmd(cmd *cmdutils.Cmd) *options {
 }
 
 func doGetFargateProfile(cmd *cmdutils.Cmd, options *options) error {
+	ctx := context.TODO()
 	if options.output != printers.TableType {
 		//log warnings and errors to stderr
 		logger.Writer = os.Stderr
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -80,10 +82,11 @@ func doGetFargateProfile(cmd *cmdutils.Cmd, options *options) error {
 }
 
 func getProfiles(manager *fargate.Client, name string) ([]*api.FargateProfile, error) {
+	ctx := context.TODO()
 	if name == "" {
-		return manager.ReadProfiles()
+		return manager.ReadProfiles(ctx)
 	}
-	profile, err := manager.ReadProfile(name)
+	profile, err := manager.ReadProfile(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -24,6 +24,10 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getLabelsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getFargateProfile)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getAddonCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getStacksCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getAccessPoliciesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getAccessEntriesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getInsightsCmd)
 
 	return verbCmd
 }
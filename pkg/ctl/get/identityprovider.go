@@ -1,6 +1,7 @@
 package get
 
 import (
+	"context"
 	"os"
 
 	"github.com/kris-nova/logger"
@@ -18,7 +19,7 @@ func getIdentityProvider(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 	params := getCmdParams{}
 
-	cmd.SetDescription("identityprovider", "Describe identity providers for cluster authentication and authorization", "")
+	cmd.SetDescription("identityprovider", "Describe identity providers for cluster authentication and authorization", "", "identityproviders")
 
 	var name = ""
 
@@ -33,13 +34,14 @@ func getIdentityProvider(cmd *cmdutils.Cmd) {
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
 
-		fs.StringVar(&name, "name", "", "name of the provider to delete")
+		fs.StringVar(&name, "name", "", "name of the provider to get")
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
 func doGetIdentityProvider(cmd *cmdutils.Cmd, params getCmdParams, name string) error {
+	ctx := context.TODO()
 	builder := cmdutils.NewConfigLoaderBuilder()
 	if err := builder.Build(cmd).Load(); err != nil {
 		return err
@@ -50,7 +52,7 @@ func doGetIdentityProvider(cmd *cmdutils.Cmd, params getCmdParams, name string)
 		logger.Writer = os.Stderr
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -66,6 +68,7 @@ func doGetIdentityProvider(cmd *cmdutils.Cmd, params getCmdParams, name string)
 	)
 
 	summaries, err := manager.Get(
+		ctx,
 		identityproviders.GetIdentityProvidersOptions{Name: name},
 	)
 	if err != nil {
@@ -0,0 +1,103 @@
+package get
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/insights"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getInsightsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+	params := &getCmdParams{}
+
+	cmd.SetDescription(
+		"insights",
+		"Get the insights of a cluster",
+		"Lists the findings EKS reports for a cluster, such as its readiness for the next Kubernetes version, "+
+			"with their severity, the resources they affect and the recommended actions",
+		"insight",
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetInsights(cmd, params)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doGetInsights(cmd *cmdutils.Cmd, params *getCmdParams) error {
+	ctx := context.TODO()
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	if params.output != printers.TableType {
+		//log warnings and errors to stderr
+		logger.Writer = os.Stderr
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	summaries, err := insights.NewGetter(cmd.ClusterConfig.Metadata.Name, ctl.Provider.EKS()).Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == printers.TableType {
+		addInsightTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("insights", summaries, os.Stdout)
+}
+
+func addInsightTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(s insights.Summary) string {
+		return s.Name
+	})
+	printer.AddColumn("CATEGORY", func(s insights.Summary) string {
+		return s.Category
+	})
+	printer.AddColumn("SEVERITY", func(s insights.Summary) string {
+		return s.Severity
+	})
+	printer.AddColumn("AFFECTED RESOURCES", func(s insights.Summary) string {
+		return formatAffectedResources(s.AffectedResources)
+	})
+	printer.AddColumn("RECOMMENDATION", func(s insights.Summary) string {
+		return s.Recommendation
+	})
+}
+
+func formatAffectedResources(resources []insights.Resource) string {
+	var names []string
+	for _, r := range resources {
+		names = append(names, r.Name())
+	}
+	return strings.Join(names, ",")
+}
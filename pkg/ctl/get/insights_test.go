@@ -0,0 +1,33 @@
+package get
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/insights"
+)
+
+var _ = Describe("get", func() {
+	Describe("insights", func() {
+		It("missing required flag --cluster", func() {
+			cmd := newMockCmd("insights")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --cluster must be set"))
+		})
+
+		It("invalid flag --dummy", func() {
+			cmd := newMockCmd("insights", "--invalid", "dummy")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: unknown flag: --invalid"))
+		})
+
+		It("formats affected resources by Kubernetes resource URI or ARN", func() {
+			Expect(formatAffectedResources([]insights.Resource{
+				{KubernetesResourceURI: "/apis/flowcontrol.apiserver.k8s.io/v1beta2/flowschemas", ARN: "ignored"},
+				{ARN: "arn:aws:eks:us-west-2:123456789012:nodegroup/my-cluster/ng/1"},
+			})).To(Equal("/apis/flowcontrol.apiserver.k8s.io/v1beta2/flowschemas,arn:aws:eks:us-west-2:123456789012:nodegroup/my-cluster/ng/1"))
+		})
+	})
+})
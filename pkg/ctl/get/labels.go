@@ -44,12 +44,13 @@ func getLabelsCmd(cmd *cmdutils.Cmd) {
 }
 
 func getLabels(cmd *cmdutils.Cmd, nodeGroupName string) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewGetLabelsLoader(cmd, nodeGroupName).Load(); err != nil {
 		return err
 	}
 	cfg := cmd.ClusterConfig
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
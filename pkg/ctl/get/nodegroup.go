@@ -2,8 +2,11 @@ package get
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kris-nova/logger"
@@ -25,12 +28,17 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	params := &getCmdParams{}
+	var (
+		versionSkew       bool
+		utilization       bool
+		utilizationPeriod time.Duration
+	)
 
 	cmd.SetDescription("nodegroup", "Get nodegroup(s)", "", "ng", "nodegroups")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doGetNodeGroup(cmd, ng, params)
+		return doGetNodeGroup(cmd, ng, params, versionSkew, utilization, utilizationPeriod)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -38,6 +46,10 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		fs.Lookup("output").Usage = "specifies the output format (valid option: table, wide, json, yaml)"
+		fs.BoolVar(&versionSkew, "version-skew", false, fmt.Sprintf("Show how many minor versions nodegroups are behind the control plane, flagging those more than %d behind", nodegroup.MaxMinorVersionSkew))
+		fs.BoolVar(&utilization, "utilization", false, "Show the peak CPU and memory utilization and the on-demand cost of nodegroups, suggesting cheaper instance types for over-provisioned ones (requires Container Insights)")
+		fs.DurationVar(&utilizationPeriod, "utilization-period", nodegroup.DefaultUtilizationPeriod, "Period the utilization of nodegroups is measured over")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 	})
@@ -45,7 +57,17 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams) error {
+func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams, versionSkew, utilization bool, utilizationPeriod time.Duration) error {
+	ctx := context.TODO()
+	wide := params.output == wideOutput
+	if wide {
+		params.output = printers.TableType
+	}
+
+	if versionSkew && utilization {
+		return errors.New("--version-skew and --utilization cannot be used together")
+	}
+
 	if err := cmdutils.NewGetNodegroupLoader(cmd, ng).Load(); err != nil {
 		return err
 	}
@@ -55,7 +77,7 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 		logger.Writer = os.Stderr
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -70,14 +92,15 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 		return err
 	}
 
+	manager := nodegroup.New(cfg, ctl, clientSet)
 	var summaries []*nodegroup.Summary
 	if ng.Name == "" {
-		summaries, err = nodegroup.New(cfg, ctl, clientSet).GetAll(context.Background())
+		summaries, err = manager.GetAll(ctx)
 		if err != nil {
 			return err
 		}
 	} else {
-		summary, err := nodegroup.New(cfg, ctl, clientSet).Get(context.Background(), ng.Name)
+		summary, err := manager.Get(context.Background(), ng.Name)
 		if err != nil {
 			return err
 		}
@@ -99,12 +122,112 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 			}
 			return errors.Errorf("nodegroup with name %v not found", ng.Name)
 		}
+	}
+
+	if versionSkew {
+		skews := nodegroup.GetVersionSkew(summaries, ctl.ControlPlaneVersion())
+		for _, skew := range skews {
+			if skew.Status == nodegroup.VersionSkewUnsupported {
+				logger.Warning("nodegroup %q version %s is not within the supported skew of the control plane version %s", skew.Name, skew.Version, skew.ControlPlaneVersion)
+			}
+		}
+		if params.output == printers.TableType {
+			addVersionSkewTableColumns(printer.(*printers.TablePrinter))
+		}
+		return printer.PrintObjWithKind("nodegroups", skews, os.Stdout)
+	}
+
+	if utilization {
+		utilizations, err := manager.GetUtilization(ctx, summaries, utilizationPeriod)
+		if err != nil {
+			return err
+		}
+		for _, u := range utilizations {
+			if u.OverProvisioned() {
+				logger.Warning("nodegroup %q is over-provisioned, %s instead of %s would save $%.2f a month", u.Name, u.SuggestedInstanceType, u.InstanceType, *u.MonthlySavings)
+			}
+		}
+		if params.output == printers.TableType {
+			addUtilizationTableColumns(printer.(*printers.TablePrinter))
+		}
+		return printer.PrintObjWithKind("nodegroups", utilizations, os.Stdout)
+	}
+
+	if wide || params.output != printers.TableType {
+		if err := manager.AddInstancesSummaries(ctx, summaries); err != nil {
+			return err
+		}
+	}
+
+	if params.output == printers.TableType {
 		addSummaryTableColumns(printer.(*printers.TablePrinter))
+		if wide {
+			addInstancesSummaryTableColumns(printer.(*printers.TablePrinter))
+		}
 	}
 
 	return printer.PrintObjWithKind("nodegroups", summaries, os.Stdout)
 }
 
+func addVersionSkewTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NODEGROUP", func(s nodegroup.VersionSkew) string {
+		return s.Name
+	})
+	printer.AddColumn("VERSION", func(s nodegroup.VersionSkew) string {
+		return s.Version
+	})
+	printer.AddColumn("CONTROL PLANE VERSION", func(s nodegroup.VersionSkew) string {
+		return s.ControlPlaneVersion
+	})
+	printer.AddColumn("MINOR VERSIONS BEHIND", func(s nodegroup.VersionSkew) string {
+		if s.Status == nodegroup.VersionSkewUnknown {
+			return "-"
+		}
+		return strconv.Itoa(s.MinorVersionsBehind)
+	})
+	printer.AddColumn("SKEW", func(s nodegroup.VersionSkew) string {
+		return s.Status
+	})
+}
+
+func addUtilizationTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NODEGROUP", func(u nodegroup.Utilization) string {
+		return u.Name
+	})
+	printer.AddColumn("INSTANCE TYPE", func(u nodegroup.Utilization) string {
+		return u.InstanceType
+	})
+	printer.AddColumn("NODES", func(u nodegroup.Utilization) string {
+		return strconv.Itoa(u.Nodes)
+	})
+	printer.AddColumn("PEAK CPU", func(u nodegroup.Utilization) string {
+		return formatValue("%.0f%%", u.CPUUtilization)
+	})
+	printer.AddColumn("PEAK MEMORY", func(u nodegroup.Utilization) string {
+		return formatValue("%.0f%%", u.MemoryUtilization)
+	})
+	printer.AddColumn("HOURLY PRICE", func(u nodegroup.Utilization) string {
+		return formatValue("$%.4f", u.HourlyPrice)
+	})
+	printer.AddColumn("SUGGESTED INSTANCE TYPE", func(u nodegroup.Utilization) string {
+		if u.SuggestedInstanceType == "" {
+			return "-"
+		}
+		return u.SuggestedInstanceType
+	})
+	printer.AddColumn("MONTHLY SAVINGS", func(u nodegroup.Utilization) string {
+		return formatValue("$%.2f", u.MonthlySavings)
+	})
+}
+
+// formatValue formats v with format, or returns - if it's unknown
+func formatValue(format string, v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprintf(format, *v)
+}
+
 func addSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("CLUSTER", func(s *nodegroup.Summary) string {
 		return s.Cluster
@@ -140,3 +263,38 @@ func addSummaryTableColumns(printer *printers.TablePrinter) {
 		return s.NodeGroupType
 	})
 }
+
+func addInstancesSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("SPOT", func(s *nodegroup.Summary) string {
+		return strconv.Itoa(s.Instances.Spot)
+	})
+	printer.AddColumn("ON-DEMAND", func(s *nodegroup.Summary) string {
+		return strconv.Itoa(s.Instances.OnDemand)
+	})
+	printer.AddColumn("INSTANCE TYPES IN SERVICE", func(s *nodegroup.Summary) string {
+		if len(s.Instances.InstanceTypes) == 0 {
+			return "-"
+		}
+		return strings.Join(s.Instances.InstanceTypes, ",")
+	})
+	printer.AddColumn("AVAILABILITY ZONES", func(s *nodegroup.Summary) string {
+		return formatAvailabilityZones(s.Instances.AvailabilityZones)
+	})
+}
+
+// formatAvailabilityZones returns the number of instances per availability zone, e.g. us-west-2a=2,us-west-2b=1
+func formatAvailabilityZones(zones map[string]int) string {
+	if len(zones) == 0 {
+		return "-"
+	}
+	var names []string
+	for name := range zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var counts []string
+	for _, name := range names {
+		counts = append(counts, fmt.Sprintf("%s=%d", name, zones[name]))
+	}
+	return strings.Join(counts, ",")
+}
@@ -0,0 +1,111 @@
+package get
+
+import (
+	"os"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+type getStacksParams struct {
+	getCmdParams
+	graph bool
+}
+
+func getStacksCmd(cmd *cmdutils.Cmd) {
+	getStacksWithRunFunc(cmd, doGetStacks)
+}
+
+func getStacksWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, params *getStacksParams) error) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	params := &getStacksParams{}
+
+	cmd.SetDescription(
+		"stacks",
+		"Get the CloudFormation stacks of a cluster",
+		"Lists the stacks eksctl created for a cluster, their type, status and the stacks they depend on",
+		"stack",
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		if params.graph && cmd.CobraCommand.Flags().Changed("output") {
+			return errors.New("--graph and --output cannot be used together")
+		}
+		return runFunc(cmd, params)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cmd.ClusterConfig.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		fs.BoolVar(&params.graph, "graph", false, "print the dependency graph of the stacks in the DOT language")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doGetStacks(cmd *cmdutils.Cmd, params *getStacksParams) error {
+	ctx := cmd.Context()
+	if params.graph || params.output != printers.TableType {
+		//log warnings and errors to stderr
+		logger.Writer = os.Stderr
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	stacks, err := ctl.NewStackManager(cmd.ClusterConfig).DescribeStacks(ctx)
+	if err != nil {
+		return err
+	}
+	summaries, err := manager.SummarizeStacks(ctx, ctl.Provider.CloudFormation(), stacks)
+	if err != nil {
+		return err
+	}
+
+	if params.graph {
+		return manager.WriteStackGraph(os.Stdout, cmd.ClusterConfig.Metadata.Name, summaries)
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+	if params.output == printers.TableType {
+		addStackSummaryTableColumns(printer.(*printers.TablePrinter))
+	}
+	return printer.PrintObjWithKind("stacks", summaries, os.Stdout)
+}
+
+func addStackSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(s manager.StackSummary) string {
+		return s.Name
+	})
+	printer.AddColumn("TYPE", func(s manager.StackSummary) string {
+		return string(s.Type)
+	})
+	printer.AddColumn("RESOURCE", func(s manager.StackSummary) string {
+		return s.Resource
+	})
+	printer.AddColumn("STATUS", func(s manager.StackSummary) string {
+		return string(s.Status)
+	})
+	printer.AddColumn("DEPENDS ON", func(s manager.StackSummary) string {
+		return strings.Join(s.DependsOn, ",")
+	})
+}
@@ -0,0 +1,77 @@
+package get
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("get stacks", func() {
+	It("requires the cluster's name", func() {
+		cmd := newMockGetStacksCmd("stacks")
+		_, err := cmd.execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Error: --cluster must be set"))
+	})
+
+	It("accepts the cluster's name as an argument", func() {
+		cmd := newMockGetStacksCmd("stacks", "foo")
+		_, err := cmd.execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmd.cmd.ClusterConfig.Metadata.Name).To(Equal("foo"))
+		Expect(cmd.params.graph).To(BeFalse())
+	})
+
+	It("accepts --graph", func() {
+		cmd := newMockGetStacksCmd("stacks", "--cluster", "foo", "--graph")
+		_, err := cmd.execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmd.params.graph).To(BeTrue())
+	})
+
+	It("rejects --graph with --output", func() {
+		cmd := newMockGetStacksCmd("stacks", "--cluster", "foo", "--graph", "--output", "json")
+		_, err := cmd.execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("--graph and --output cannot be used together"))
+	})
+})
+
+func newMockGetStacksCmd(args ...string) *mockGetStacksCmd {
+	mockCmd := &mockGetStacksCmd{}
+	grouping := cmdutils.NewGrouping()
+	parentCmd := cmdutils.NewVerbCmd("get", "", "")
+	cmdutils.AddResourceCmd(grouping, parentCmd, func(cmd *cmdutils.Cmd) {
+		getStacksWithRunFunc(cmd, func(cmd *cmdutils.Cmd, params *getStacksParams) error {
+			mockCmd.cmd = cmd
+			mockCmd.params = params
+			return nil // no-op, to only test input aggregation & validation.
+		})
+	})
+	parentCmd.SetArgs(args)
+	mockCmd.parentCmd = parentCmd
+	return mockCmd
+}
+
+type mockGetStacksCmd struct {
+	parentCmd *cobra.Command
+	cmd       *cmdutils.Cmd
+	params    *getStacksParams
+}
+
+func (c mockGetStacksCmd) execute() (string, error) {
+	outBuf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	c.parentCmd.SetOut(outBuf)
+	c.parentCmd.SetErr(errBuf)
+	err := c.parentCmd.Execute()
+	if err != nil {
+		err = errors.New(errBuf.String())
+	}
+	return outBuf.String(), err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/afero"
 
@@ -11,19 +12,27 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/connector"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
 )
 
 func registerClusterCmd(cmd *cmdutils.Cmd) {
 	cmd.SetDescription("cluster", "Register a non-EKS Kubernetes cluster", "")
+	cmd.Mutating = true
 
-	var cluster connector.ExternalCluster
+	var (
+		cluster connector.ExternalCluster
+		options applyOptions
+	)
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
-		return registerCluster(cmd, cluster)
+		return registerCluster(cmd, cluster, options)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -38,15 +47,43 @@ func registerClusterCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 	})
 
+	cmd.FlagSetGroup.InFlagSet("Apply", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&options.apply, "apply", false, "apply the EKS Connector resources to the cluster instead of only writing them to the current directory")
+		fs.StringVar(&options.kubeconfigPath, "kubeconfig", "", "path to the kubeconfig of the cluster to apply the resources to (defaults to the default kubeconfig)")
+		fs.StringVar(&options.kubeContext, "context", "", "kubeconfig context of the cluster to apply the resources to (defaults to the current context)")
+	})
+
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
 }
 
-func registerCluster(cmd *cmdutils.Cmd, cluster connector.ExternalCluster) error {
+type applyOptions struct {
+	apply          bool
+	kubeconfigPath string
+	kubeContext    string
+}
+
+func registerCluster(cmd *cmdutils.Cmd, cluster connector.ExternalCluster, options applyOptions) error {
+	if !options.apply && (options.kubeconfigPath != "" || options.kubeContext != "") {
+		return errors.New("--kubeconfig and --context can only be used with --apply")
+	}
+
+	var rawClient *kubernetes.RawClient
+	if options.apply {
+		// connect to the cluster before registering it, so that a wrong kubeconfig fails early
+		var err error
+		if rawClient, err = newRawClient(options); err != nil {
+			return errors.Wrap(err, "error creating Kubernetes client for the cluster")
+		}
+	}
+
 	clusterProvider, err := eks.New(context.TODO(), &cmd.ProviderConfig, nil)
 	if err != nil {
 		return err
 	}
+	if err := api.ValidateFeatureInRegion(api.FeatureEKSConnector, clusterProvider.Provider.Region()); err != nil {
+		return err
+	}
 
 	manifestTemplate, err := connector.GetManifestTemplate()
 	if err != nil {
@@ -64,6 +101,31 @@ func registerCluster(cmd *cmdutils.Cmd, cluster connector.ExternalCluster) error
 
 	logger.Info("registered cluster %q successfully", cluster.Name)
 
+	if rawClient != nil {
+		if err := connector.ApplyResources(afero.NewOsFs(), rawClient, resourceList); err != nil {
+			return errors.Wrapf(err, "error applying EKS Connector resources; apply the files written to the current directory before %s to connect the cluster", resourceList.Expiry.Format(time.RFC822))
+		}
+		logger.Info("applied EKS Connector resources, the cluster will be connected once EKS Connector is running; check its registration with `eksctl get cluster --name %s --region %s`", cluster.Name, clusterProvider.Provider.Region())
+		return nil
+	}
+
 	// TODO consider providing a manifests-dir argument to allow writing EKS Connector resources to a specific directory.
 	return connector.WriteResources(afero.NewOsFs(), resourceList)
 }
+
+func newRawClient(options applyOptions) (*kubernetes.RawClient, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = options.kubeconfigPath
+	restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{
+		CurrentContext: options.kubeContext,
+	}).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	clientSet, err := clientset.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewRawClient(clientSet, restConfig)
+}
@@ -23,6 +23,7 @@ func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("nodegroup", "Scale a nodegroup", "", "ng")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
@@ -85,8 +86,9 @@ func scaleAllNodegroups(cmd *cmdutils.Cmd) error {
 }
 
 func scaleNodegroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error {
+	ctx := context.TODO()
 	cfg := cmd.ClusterConfig
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -24,6 +24,7 @@ func setLabelsCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("labels", "Create or overwrite labels for managed nodegroups", "")
+	cmd.Mutating = true
 
 	var options labelOptions
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
@@ -45,13 +46,14 @@ func setLabelsCmd(cmd *cmdutils.Cmd) {
 }
 
 func setLabels(cmd *cmdutils.Cmd, options labelOptions) error {
+	ctx := context.TODO()
 	// if nodeGroupName is defined, the loader will filter managed nodegroups
 	// for that nodegroup only.
 	if err := cmdutils.NewSetLabelLoader(cmd, options.nodeGroupName, options.labels).Load(); err != nil {
 		return err
 	}
 	cfg := cmd.ClusterConfig
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -65,7 +67,6 @@ func setLabels(cmd *cmdutils.Cmd, options labelOptions) error {
 	}
 
 	manager := label.New(cfg.Metadata.Name, service, ctl.Provider.EKS())
-	ctx := context.TODO()
 	// when there is no config file provided
 	if cmd.ClusterConfigFile == "" {
 		if err := manager.Set(ctx, options.nodeGroupName, options.labels); err != nil {
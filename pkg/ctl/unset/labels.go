@@ -20,6 +20,7 @@ func unsetLabelsCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("labels", "Remove labels from managed nodegroups", "")
+	cmd.Mutating = true
 
 	var (
 		nodeGroupName string
@@ -46,6 +47,7 @@ func unsetLabelsCmd(cmd *cmdutils.Cmd) {
 }
 
 func unsetLabels(cmd *cmdutils.Cmd, nodeGroupName string, removeLabels []string) error {
+	ctx := context.TODO()
 	cfg := cmd.ClusterConfig
 	if cfg.Metadata.Name == "" {
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
@@ -58,7 +60,7 @@ func unsetLabels(cmd *cmdutils.Cmd, nodeGroupName string, removeLabels []string)
 		return cmdutils.ErrUnsupportedNameArg()
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
@@ -22,6 +22,7 @@ func updateAddonCmd(cmd *cmdutils.Cmd) {
 		"Upgrade an Addon",
 		"",
 	)
+	cmd.Mutating = true
 
 	var force, wait bool
 	cmd.ClusterConfig.Addons = []*api.Addon{{}}
@@ -48,15 +49,16 @@ func updateAddonCmd(cmd *cmdutils.Cmd) {
 }
 
 func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewCreateOrUpgradeAddonLoader(cmd).Load(); err != nil {
 		return err
 	}
-	clusterProvider, err := cmd.NewProviderForExistingCluster()
+	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
 
-	oidc, err := clusterProvider.NewOpenIDConnectManager(cmd.ClusterConfig)
+	oidc, err := clusterProvider.NewOpenIDConnectManager(ctx, cmd.ClusterConfig)
 	if err != nil {
 		return err
 	}
@@ -72,7 +74,7 @@ func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
 
 	stackManager := clusterProvider.NewStackManager(cmd.ClusterConfig)
 
-	output, err := clusterProvider.Provider.EKS().DescribeCluster(&awseks.DescribeClusterInput{
+	output, err := clusterProvider.Provider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
 		Name: &cmd.ClusterConfig.Metadata.Name,
 	})
 
@@ -83,7 +85,7 @@ func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
 	logger.Info("Kubernetes version %q in use by cluster %q", *output.Cluster.Version, cmd.ClusterConfig.Metadata.Name)
 	cmd.ClusterConfig.Metadata.Version = *output.Cluster.Version
 
-	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, nil, cmd.ProviderConfig.WaitTimeout)
+	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, nil, cmd.ClusterConfig.Timeouts.AddonActiveTimeout(cmd.ProviderConfig.WaitTimeout))
 
 	if err != nil {
 		return err
@@ -20,6 +20,7 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("cluster", "DEPRECATED: use 'upgrade cluster' instead. Upgrade control plane to the next version. ",
 		"DEPRECATED: use 'upgrade cluster' instead. Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing.")
+	cmd.Mutating = true
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
@@ -49,7 +50,7 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 			return err
 		}
 
-		return upgrade.DoUpgradeCluster(cmd)
+		return upgrade.DoUpgradeCluster(cmd, upgrade.PreflightOptions{})
 	}
 
 }
@@ -1,6 +1,7 @@
 package update
 
 import (
+	"context"
 	"github.com/lithammer/dedent"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -23,6 +24,7 @@ func updateNodeGroupCmd(cmd *cmdutils.Cmd) {
 		Note that this is only available for managed nodegroups. 
 	`),
 	)
+	cmd.Mutating = true
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
@@ -38,11 +40,12 @@ func updateNodeGroupCmd(cmd *cmdutils.Cmd) {
 }
 
 func updateNodegroup(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewUpdateNodegroupLoader(cmd).Load(); err != nil {
 		return err
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -51,5 +54,5 @@ func updateNodegroup(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	return nodegroup.New(cmd.ClusterConfig, ctl, nil).Update()
+	return nodegroup.New(cmd.ClusterConfig, ctl, nil).Update(ctx)
 }
@@ -2,27 +2,45 @@ package upgrade
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
 
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/deprecatedapi"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 // updating from 1.15 to 1.16 has been observed to take longer than the default value of 25 minutes
 // increased to 50 for flex fleet changes
 const upgradeClusterTimeout = 65 * time.Minute
 
+// PreflightOptions are the checks run before upgrading the control plane
+type PreflightOptions struct {
+	// Preflight is whether to look for uses of APIs removed in the target version
+	Preflight bool
+	// Force upgrades even if uses of removed APIs are found
+	Force bool
+}
+
 func upgradeCluster(cmd *cmdutils.Cmd) {
 	upgradeClusterWithRunFunc(cmd, DoUpgradeCluster)
 }
 
-func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, preflight PreflightOptions) error) {
+	var (
+		preflight         PreflightOptions
+		maintenanceWindow string
+	)
 	cfg := api.NewClusterConfig()
 	// Reset version
 	cfg.Metadata.Version = ""
@@ -30,6 +48,7 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 
 	cmd.SetDescription("cluster", "Upgrade control plane to the next version",
 		"Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing.")
+	cmd.Mutating = true
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
@@ -42,6 +61,9 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		// cmdutils.AddVersionFlag(fs, cfg.Metadata, `"next" and "latest" can be used to automatically increment version by one, or force latest`)
 
 		cmdutils.AddApproveFlag(fs, cmd)
+		fs.BoolVar(&preflight.Preflight, "preflight", false, "check for workloads using APIs removed in the target version before upgrading")
+		fs.BoolVar(&preflight.Force, "force", false, "upgrade even if the preflight check finds workloads using removed APIs")
+		cmdutils.AddMaintenanceWindowFlag(fs, &maintenanceWindow)
 
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeClusterTimeout)
 	})
@@ -52,14 +74,21 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 			return err
 		}
-		return runFunc(cmd)
+		if preflight.Force && !preflight.Preflight {
+			return errors.New("--force can only be used with --preflight")
+		}
+		if err := cmdutils.ApplyMaintenanceWindowFlag(cmd.ClusterConfig, maintenanceWindow); err != nil {
+			return err
+		}
+		return runFunc(cmd, preflight)
 	}
 }
 
 // DoUpgradeCluster made public so that it can be shared with update/cluster.go until this is deprecated
 // TODO Once `eksctl update cluster` is officially deprecated this can be made package private again
-func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
-	ctl, err := cmd.NewProviderForExistingCluster()
+func DoUpgradeCluster(cmd *cmdutils.Cmd, preflight PreflightOptions) error {
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -73,11 +102,103 @@ func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
 		logger.Warning("NOTE: cluster VPC (subnets, routing & NAT Gateway) configuration changes are not yet implemented")
 	}
 
-	ctx := context.TODO()
+	if preflight.Preflight {
+		if err := checkRemovedAPIs(ctx, ctl, cfg, preflight.Force); err != nil {
+			return err
+		}
+	}
+
 	c, err := cluster.New(ctx, cfg, ctl)
 	if err != nil {
 		return err
 	}
 
+	if err := cmdutils.WaitForMaintenanceWindow(ctx, cfg.MaintenanceWindow, cmd.Plan); err != nil {
+		return err
+	}
+
 	return c.Upgrade(context.TODO(), cmd.Plan)
 }
+
+// checkRemovedAPIs fails if workloads in the cluster use APIs removed in the version the control plane is
+// upgraded to, unless force is set
+func checkRemovedAPIs(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig, force bool) error {
+	currentVersion := ctl.ControlPlaneVersion()
+	targetVersion, upgradeRequired, err := cluster.TargetVersion(cfg.Metadata, currentVersion)
+	if err != nil {
+		return err
+	}
+	if !upgradeRequired {
+		return nil
+	}
+
+	client, err := ctl.NewClient(cfg)
+	if err != nil {
+		return errors.Wrap(err, "creating Kubernetes client config with embedded token")
+	}
+	clientSet, err := client.NewClientSet()
+	if err != nil {
+		return err
+	}
+	metadataClient, err := client.NewMetadataClient()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("checking for workloads using APIs removed in Kubernetes %s", targetVersion)
+	scanner := &deprecatedapi.Scanner{
+		Metadata: metadataClient,
+		Metrics: func(ctx context.Context) ([]byte, error) {
+			return clientSet.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+		},
+	}
+	findings, err := scanner.Scan(ctx, currentVersion, targetVersion)
+	if err != nil {
+		return errors.Wrap(err, "checking for uses of removed APIs")
+	}
+	if len(findings) == 0 {
+		logger.Info("no uses of APIs removed in Kubernetes %s found", targetVersion)
+		return nil
+	}
+
+	printer := printers.NewTablePrinter().(*printers.TablePrinter)
+	addRemovedAPIColumns(printer)
+	if err := printer.PrintObjWithKind("uses of removed APIs", findings, os.Stdout); err != nil {
+		return err
+	}
+	if force {
+		logger.Warning("found %d uses of APIs removed in Kubernetes %s, upgrading anyway as --force is set", len(findings), targetVersion)
+		return nil
+	}
+	return fmt.Errorf("found %d uses of APIs removed in Kubernetes %s, migrate them to the replacement APIs or use --force to upgrade anyway", len(findings), targetVersion)
+}
+
+func addRemovedAPIColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("API VERSION", func(f deprecatedapi.Finding) string {
+		return f.APIVersion
+	})
+	printer.AddColumn("KIND", func(f deprecatedapi.Finding) string {
+		if f.Kind == "" {
+			return f.Resource
+		}
+		return f.Kind
+	})
+	printer.AddColumn("NAMESPACE", func(f deprecatedapi.Finding) string {
+		return f.Namespace
+	})
+	printer.AddColumn("NAME", func(f deprecatedapi.Finding) string {
+		return f.Name
+	})
+	printer.AddColumn("REMOVED IN", func(f deprecatedapi.Finding) string {
+		return f.RemovedIn
+	})
+	printer.AddColumn("REPLACEMENT", func(f deprecatedapi.Finding) string {
+		if f.Replacement == "" {
+			return "<none>"
+		}
+		return f.Replacement
+	})
+	printer.AddColumn("SOURCE", func(f deprecatedapi.Finding) string {
+		return f.Source
+	})
+}
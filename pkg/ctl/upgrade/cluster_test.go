@@ -8,15 +8,27 @@ import (
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
 )
 
 var _ = Describe("upgrade cluster", func() {
 
+	var preflight PreflightOptions
+
 	newMockUpgradeClusterCmd := func(args ...string) *ctltest.MockCmd {
-		return ctltest.NewMockCmd(upgradeClusterWithRunFunc, "upgrade", args...)
+		return ctltest.NewMockCmd(func(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+			upgradeClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, options PreflightOptions) error {
+				preflight = options
+				return runFunc(cmd)
+			})
+		}, "upgrade", args...)
 	}
 
+	BeforeEach(func() {
+		preflight = PreflightOptions{}
+	})
+
 	Describe("without a config file", func() {
 
 		It("should accept a name argument", func() {
@@ -48,6 +60,19 @@ var _ = Describe("upgrade cluster", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("accepts the --preflight and --force flags", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--preflight", "--force")
+			_, err := cmd.Execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preflight).To(Equal(PreflightOptions{Preflight: true, Force: true}))
+		})
+
+		It("fails if --force is used without --preflight", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--force")
+			_, err := cmd.Execute()
+			Expect(err).To(MatchError("--force can only be used with --preflight"))
+		})
+
 		It("loads all flags correctly", func() {
 			cmd := newMockUpgradeClusterCmd("cluster",
 				"--name", "clus-1",
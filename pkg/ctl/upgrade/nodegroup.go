@@ -2,6 +2,9 @@ package upgrade
 
 import (
 	"context"
+	"errors"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -10,6 +13,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 const upgradeNodegroupTimeout = 45 * time.Minute
@@ -19,20 +23,31 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("nodegroup", "Upgrade nodegroup", "")
+	cmd.Mutating = true
 
-	var options nodegroup.UpgradeOptions
+	var (
+		options                    nodegroup.UpgradeOptions
+		listLaunchTemplateVersions bool
+		maintenanceWindow          string
+	)
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return upgradeNodeGroup(cmd, options)
+		if err := cmdutils.ApplyMaintenanceWindowFlag(cmd.ClusterConfig, maintenanceWindow); err != nil {
+			return err
+		}
+		return upgradeNodeGroup(cmd, options, listLaunchTemplateVersions)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("Nodegroup", func(fs *pflag.FlagSet) {
 		fs.StringVar(&options.NodegroupName, "name", "", "Nodegroup name")
-		fs.StringVar(&options.LaunchTemplateVersion, "launch-template-version", "", "Launch template version")
+		fs.StringVar(&options.LaunchTemplateVersion, "launch-template-version", "", "Launch template version, a version number, $Latest or $Default")
+		fs.BoolVar(&listLaunchTemplateVersions, "list-launch-template-versions", false, "List the versions of the nodegroup's launch template instead of upgrading it")
 		fs.StringVar(&options.KubernetesVersion, "kubernetes-version", "", "Kubernetes version")
 		fs.BoolVar(&options.ForceUpgrade, "force-upgrade", false, "Force the update if the existing node group's pods are unable to be drained due to a pod disruption budget issue")
 		fs.StringVar(&options.ReleaseVersion, "release-version", "", "AMI version of the EKS optimized AMI to use")
 		fs.BoolVar(&options.Wait, "wait", true, "nodegroup upgrade to complete")
+		fs.BoolVar(&options.RollbackOnFailure, "rollback-on-failure", true, "Roll back to the previous launch template version if the upgrade fails, requires --wait")
+		cmdutils.AddMaintenanceWindowFlag(fs, &maintenanceWindow)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -46,7 +61,8 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 }
 
-func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error {
+func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions, listLaunchTemplateVersions bool) error {
+	ctx := context.TODO()
 	cfg := cmd.ClusterConfig
 	if cfg.Metadata.Name == "" {
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
@@ -64,7 +80,11 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error
 		return cmdutils.ErrMustBeSet("name")
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	if listLaunchTemplateVersions && options.LaunchTemplateVersion != "" {
+		return errors.New("--list-launch-template-versions and --launch-template-version cannot be used at the same time")
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -73,10 +93,49 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error
 		return err
 	}
 
+	if !listLaunchTemplateVersions {
+		// the Kubernetes client used to drain nodes is created once the window is open, so that its token is fresh
+		if err := cmdutils.WaitForMaintenanceWindow(ctx, cfg.MaintenanceWindow, false); err != nil {
+			return err
+		}
+	}
+
 	clientSet, err := ctl.NewStdClientSet(cfg)
 	if err != nil {
 		return err
 	}
 
-	return nodegroup.New(cfg, ctl, clientSet).Upgrade(context.TODO(), options)
+	m := nodegroup.New(cfg, ctl, clientSet)
+	if listLaunchTemplateVersions {
+		versions, err := m.ListLaunchTemplateVersions(ctx, options.NodegroupName)
+		if err != nil {
+			return err
+		}
+		printer := printers.NewTablePrinter().(*printers.TablePrinter)
+		addLaunchTemplateVersionColumns(printer)
+		return printer.PrintObjWithKind("launch template versions", versions, os.Stdout)
+	}
+
+	return m.Upgrade(context.TODO(), options)
+}
+
+func addLaunchTemplateVersionColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("VERSION", func(v nodegroup.LaunchTemplateVersion) string {
+		return strconv.FormatInt(v.Version, 10)
+	})
+	printer.AddColumn("DEFAULT", func(v nodegroup.LaunchTemplateVersion) string {
+		return strconv.FormatBool(v.Default)
+	})
+	printer.AddColumn("CURRENT", func(v nodegroup.LaunchTemplateVersion) string {
+		return strconv.FormatBool(v.Current)
+	})
+	printer.AddColumn("CREATED", func(v nodegroup.LaunchTemplateVersion) string {
+		return v.CreateTime.Format(time.RFC3339)
+	})
+	printer.AddColumn("IMAGE ID", func(v nodegroup.LaunchTemplateVersion) string {
+		return v.ImageID
+	})
+	printer.AddColumn("DESCRIPTION", func(v nodegroup.LaunchTemplateVersion) string {
+		return v.Description
+	})
 }
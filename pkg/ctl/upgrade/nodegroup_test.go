@@ -0,0 +1,20 @@
+package upgrade
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("upgrade nodegroup", func() {
+	It("fails without a nodegroup name", func() {
+		cmd := newMockCmd("nodegroup", "--cluster", "my-cluster")
+		_, err := cmd.execute()
+		Expect(err).To(MatchError(ContainSubstring("name must be set")))
+	})
+
+	It("fails when listing launch template versions and upgrading to one at the same time", func() {
+		cmd := newMockCmd("nodegroup", "--cluster", "my-cluster", "--name", "ng", "--list-launch-template-versions", "--launch-template-version", "2")
+		_, err := cmd.execute()
+		Expect(err).To(MatchError(ContainSubstring("--list-launch-template-versions and --launch-template-version cannot be used at the same time")))
+	})
+})
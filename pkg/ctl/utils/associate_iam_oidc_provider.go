@@ -17,6 +17,7 @@ func associateIAMOIDCProviderCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("associate-iam-oidc-provider", "Setup IAM OIDC provider for a cluster to enable IAM roles for pods", "")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
@@ -34,6 +35,7 @@ func associateIAMOIDCProviderCmd(cmd *cmdutils.Cmd) {
 }
 
 func doAssociateIAMOIDCProvider(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewUtilsAssociateIAMOIDCProviderLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -43,7 +45,7 @@ func doAssociateIAMOIDCProvider(cmd *cmdutils.Cmd) error {
 
 	printer := printers.NewJSONPrinter()
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -52,7 +54,7 @@ func doAssociateIAMOIDCProvider(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
 	if err != nil {
 		return err
 	}
@@ -61,7 +63,6 @@ func doAssociateIAMOIDCProvider(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	ctx := context.TODO()
 	providerExists, err := oidc.CheckProviderExists(ctx)
 	if err != nil {
 		return err
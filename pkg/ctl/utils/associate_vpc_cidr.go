@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/vpc"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func associateVPCCIDRCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription(
+		"associate-vpc-cidr",
+		"Associate a secondary CIDR block with the VPC of a cluster",
+		"Adds the CIDR block to the VPC through the cluster stack, optionally with a private subnet per availability zone. "+
+			"Only VPCs created by eksctl are supported",
+	)
+	cmd.Mutating = true
+
+	var (
+		cidr          string
+		createSubnets bool
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doAssociateVPCCIDR(cmd, cidr, createSubnets)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&cidr, "cidr", "", "IPv4 CIDR block to associate with the VPC, e.g. 100.64.0.0/16")
+		fs.BoolVar(&createSubnets, "create-subnets", false, "split the CIDR block into a private subnet per availability zone of the cluster")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doAssociateVPCCIDR(cmd *cmdutils.Cmd, cidr string, createSubnets bool) error {
+	ctx := context.TODO()
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	if cidr == "" {
+		return cmdutils.ErrMustBeSet("--cidr")
+	}
+	ip, block, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fmt.Errorf("invalid --cidr %q: %w", cidr, err)
+	}
+	if !ip.Equal(block.IP) {
+		return fmt.Errorf("invalid --cidr %q, did you mean %s?", cidr, block)
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if ok, err := ctl.CanUpdate(cmd.ClusterConfig); !ok {
+		return err
+	}
+
+	associator := vpc.NewCIDRAssociator(ctl.NewStackManager(cmd.ClusterConfig), ctl.Provider.EC2())
+	if err := associator.Associate(ctx, block, createSubnets, cmd.Plan); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
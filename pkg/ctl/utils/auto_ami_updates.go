@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func enableAutoAMIUpdatesCmd(cmd *cmdutils.Cmd) {
+	enableAutoAMIUpdatesCmdWithRunFunc(cmd, doEnableAutoAMIUpdates)
+}
+
+func enableAutoAMIUpdatesCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options builder.AutoAMIUpdatesOptions) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("enable-auto-ami-updates", "Update the AMIs of managed nodegroups on a schedule",
+		"Creates an EventBridge schedule and a Lambda function that update managed nodegroups to the latest AMI release of their Kubernetes version")
+	cmd.Mutating = true
+
+	options := builder.AutoAMIUpdatesOptions{
+		MaintenanceWindow: builder.DefaultAutoAMIUpdatesMaintenanceWindow,
+	}
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		if err := options.Validate(); err != nil {
+			return err
+		}
+		return runFunc(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVar(&options.Schedule, "schedule", "", "EventBridge Scheduler expression that starts the maintenance window, e.g. 'cron(0 3 ? * SUN *)'")
+		fs.StringVar(&options.TimeZone, "timezone", "", "Time zone of the schedule, e.g. 'Europe/London' (default UTC)")
+		fs.DurationVar(&options.MaintenanceWindow, "maintenance-window", options.MaintenanceWindow, "How long after the scheduled time updates can be started, at most 24h")
+		fs.StringSliceVar(&options.NodeGroups, "nodegroups", nil, "Managed nodegroups to update (default all managed nodegroups of the cluster)")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doEnableAutoAMIUpdates(cmd *cmdutils.Cmd, options builder.AutoAMIUpdatesOptions) error {
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if err := nodegroup.New(cmd.ClusterConfig, ctl, nil).EnableAutoAMIUpdates(ctx, options, cmd.Plan); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
+
+func disableAutoAMIUpdatesCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("disable-auto-ami-updates", "Stop updating the AMIs of managed nodegroups on a schedule",
+		"Deletes the stack created by 'eksctl utils enable-auto-ami-updates', updates already started are not cancelled")
+	cmd.Mutating = true
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		return doDisableAutoAMIUpdates(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of the stack")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doDisableAutoAMIUpdates(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if err := nodegroup.New(cmd.ClusterConfig, ctl, nil).DisableAutoAMIUpdates(ctx, cmd.Plan, cmd.Wait); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
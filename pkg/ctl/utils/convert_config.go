@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func convertConfigCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	cmd.SetDescription("convert-config", "Convert a config file to the latest config API version",
+		"Converts a config file to "+v1alpha6.SchemeGroupVersion.String()+" in place, or prints the converted config if the file is read from stdin")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		return doConvertConfig(cmd.ClusterConfigFile, os.Stdout)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+	})
+}
+
+func doConvertConfig(configFile string, stdout io.Writer) error {
+	if configFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file/-f <file>")
+	}
+	if err := api.Register(); err != nil {
+		return err
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if configFile == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(configFile)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "reading config file %q", configFile)
+	}
+	cfg, err := eks.ParseConfig(data)
+	if err != nil {
+		return errors.Wrapf(err, "loading config file %q", configFile)
+	}
+	converted, err := v1alpha6.ConvertFromV1alpha5(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "converting config file %q", configFile)
+	}
+
+	var out bytes.Buffer
+	if err := printers.NewYAMLPrinter().PrintObj(converted, &out); err != nil {
+		return err
+	}
+	if configFile == "-" {
+		_, err := stdout.Write(out.Bytes())
+		return err
+	}
+
+	info, err := os.Stat(configFile)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(configFile, out.Bytes(), info.Mode().Perm()); err != nil {
+		return errors.Wrapf(err, "writing config file %q", configFile)
+	}
+	logger.Success("converted %q to %s, comments and YAML anchors were not kept", configFile, v1alpha6.SchemeGroupVersion)
+	return nil
+}
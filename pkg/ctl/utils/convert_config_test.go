@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("convert-config", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		Expect(api.Register()).To(Succeed())
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "convert-config")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("converts a v1alpha5 config file to v1alpha6 in place", func() {
+		configFile := filepath.Join(tmpDir, "cluster.yaml")
+		Expect(os.WriteFile(configFile, []byte(`apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: cluster-1
+  region: us-west-2
+timeouts:
+  nodesReady: 30m
+nodeGroups:
+  - name: ng-1
+    instanceType: m5.large
+`), 0600)).To(Succeed())
+		original, err := eks.LoadConfigFromFile(configFile)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(doConvertConfig(configFile, &bytes.Buffer{})).To(Succeed())
+
+		data, err := os.ReadFile(configFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("apiVersion: eksctl.io/v1alpha6"))
+		Expect(string(data)).To(ContainSubstring("selfManagedNodeGroups:"))
+		Expect(string(data)).NotTo(ContainSubstring("nodeGroups:"))
+
+		converted, err := eks.LoadConfigFromFile(configFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(converted.NodeGroups).To(Equal(original.NodeGroups))
+		Expect(converted.Metadata).To(Equal(original.Metadata))
+		Expect(converted.Timeouts.NodesReadyTimeout(0)).To(Equal(original.Timeouts.NodesReadyTimeout(0)))
+	})
+
+	It("requires a config file", func() {
+		Expect(doConvertConfig("", &bytes.Buffer{})).To(MatchError(ContainSubstring("--config-file/-f <file> must be set")))
+	})
+})
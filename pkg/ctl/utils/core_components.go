@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+type coreComponentsOptions struct {
+	report bool
+	update bool
+	output printers.Type
+}
+
+func coreComponentsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options coreComponentsOptions
+
+	cmd.SetDescription("core-components", "Report or update the versions of aws-node, coredns and kube-proxy",
+		"Lists the running versions of aws-node, coredns and kube-proxy against the versions recommended for the Kubernetes version of the cluster, "+
+			"and updates the components that are not managed by EKS addons")
+	cmd.Mutating = true
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doCoreComponents(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&options.report, "report", false, "List the running and recommended versions of the core components")
+		fs.BoolVar(&options.update, "update", false, "Update the core components that are not up-to-date and not managed by EKS addons")
+		fs.StringVarP(&options.output, "output", "o", "table", "specifies the output format of --report (valid option: table, json, yaml)")
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doCoreComponents(cmd *cmdutils.Cmd, options coreComponentsOptions) error {
+	ctx := context.TODO()
+	if options.report == options.update {
+		return errors.New("exactly one of --report and --update must be specified")
+	}
+	if options.report && options.output != printers.TableType {
+		// log warnings and errors to stderr so that the output can be parsed
+		logger.Writer = os.Stderr
+	}
+
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	// only --update changes the cluster, --report doesn't wait for other runs
+	cmd.Mutating = options.update
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	kubernetesVersion, err := rawClient.ServerVersion()
+	if err != nil {
+		return err
+	}
+
+	addonsOutput, err := ctl.Provider.EKS().ListAddons(ctx, &awseks.ListAddonsInput{
+		ClusterName: &meta.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	input := defaultaddons.AddonInput{
+		RawClient:           rawClient,
+		ControlPlaneVersion: kubernetesVersion,
+		Region:              meta.Region,
+		EKSAPI:              ctl.Provider.EKS(),
+	}
+	versions, err := defaultaddons.ReportCoreComponents(input, addonsOutput.Addons)
+	if err != nil {
+		return err
+	}
+
+	if options.report {
+		return printCoreComponents(versions, options.output)
+	}
+
+	updateRequired, err := defaultaddons.UpdateCoreComponents(input, versions, cmd.Plan)
+	if err != nil {
+		return err
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
+
+	return nil
+}
+
+func printCoreComponents(versions []defaultaddons.CoreComponentVersion, output printers.Type) error {
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	if output == printers.TableType {
+		addCoreComponentsTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("core components", versions, os.Stdout)
+}
+
+func addCoreComponentsTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(v defaultaddons.CoreComponentVersion) string {
+		return v.Name
+	})
+	printer.AddColumn("CURRENT", func(v defaultaddons.CoreComponentVersion) string {
+		if !v.Installed() {
+			return "-"
+		}
+		return v.CurrentVersion
+	})
+	printer.AddColumn("RECOMMENDED", func(v defaultaddons.CoreComponentVersion) string {
+		return v.RecommendedVersion
+	})
+	printer.AddColumn("UP-TO-DATE", func(v defaultaddons.CoreComponentVersion) string {
+		return strconv.FormatBool(v.UpToDate())
+	})
+	printer.AddColumn("EKS ADDON", func(v defaultaddons.CoreComponentVersion) string {
+		if v.EKSAddon == "" {
+			return "-"
+		}
+		return v.EKSAddon
+	})
+}
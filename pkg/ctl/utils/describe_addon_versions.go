@@ -1,11 +1,12 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -44,6 +45,7 @@ func describeAddonVersionsCmd(cmd *cmdutils.Cmd) {
 }
 
 func describeAddonVersions(cmd *cmdutils.Cmd, addonName, k8sVersion, clusterName string) error {
+	ctx := context.TODO()
 	clusterProvider, err := cmd.NewCtl()
 	if err != nil {
 		return err
@@ -54,7 +56,7 @@ func describeAddonVersions(cmd *cmdutils.Cmd, addonName, k8sVersion, clusterName
 	if k8sVersion != "" {
 		cmd.ClusterConfig.Metadata.Version = k8sVersion
 	} else if clusterName != "" {
-		output, err := clusterProvider.Provider.EKS().DescribeCluster(&awseks.DescribeClusterInput{
+		output, err := clusterProvider.Provider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
 			Name: &clusterName,
 		})
 
@@ -80,12 +82,12 @@ func describeAddonVersions(cmd *cmdutils.Cmd, addonName, k8sVersion, clusterName
 
 	switch addonName {
 	case "":
-		summary, err = addonManager.DescribeAllVersions()
+		summary, err = addonManager.DescribeAllVersions(ctx)
 		if err != nil {
 			return err
 		}
 	default:
-		summary, err = addonManager.DescribeVersions(&api.Addon{Name: addonName})
+		summary, err = addonManager.DescribeVersions(ctx, &api.Addon{Name: addonName})
 		if err != nil {
 			return err
 		}
@@ -63,6 +63,7 @@ func describeStacksCmd(cmd *cmdutils.Cmd) {
 }
 
 func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail bool, printer printers.OutputPrinter) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -71,7 +72,7 @@ func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail bool, printer pri
 		logger.Writer = os.Stderr
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -90,7 +91,6 @@ func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail bool, printer pri
 	}
 
 	stackManager := ctl.NewStackManager(cfg)
-	ctx := context.TODO()
 	stacks, err := stackManager.DescribeStacks(ctx)
 	if err != nil {
 		return err
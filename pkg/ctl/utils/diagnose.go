@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/diagnose"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+type diagnoseOptions struct {
+	outputFile string
+	since      time.Duration
+}
+
+func diagnoseCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options diagnoseOptions
+
+	cmd.SetDescription("diagnose", "Gather the diagnostics of a cluster into a bundle",
+		"Gathers the control plane status, addons, nodegroups, failed stack events, aws-auth ConfigMap and recent "+
+			"CloudTrail errors of a cluster into a tarball, with AWS account IDs and access key IDs redacted")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doDiagnose(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&options.outputFile, "output-file", "", "file the bundle is written to (default \"eksctl-diagnose-<cluster>-<timestamp>.tar.gz\")")
+		fs.DurationVar(&options.since, "since", 24*time.Hour, "how far back CloudTrail errors are looked up")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doDiagnose(cmd *cmdutils.Cmd, options diagnoseOptions) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+	ctx := cmd.Context()
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	collector := &diagnose.Collector{
+		ClusterName:  cfg.Metadata.Name,
+		Provider:     ctl.Provider,
+		StackManager: ctl.NewStackManager(cfg),
+		Since:        options.since,
+	}
+	// the bundle is most useful when the cluster is broken, so failing to reach it isn't fatal
+	if err := ctl.RefreshClusterStatus(ctx, cfg); err != nil {
+		logger.Warning("unable to reach cluster %q: %v", cfg.Metadata.Name, err)
+	} else if clientSet, err := ctl.NewStdClientSet(cfg); err != nil {
+		logger.Warning("unable to create a Kubernetes client for cluster %q: %v", cfg.Metadata.Name, err)
+	} else {
+		collector.ClientSet = clientSet
+	}
+
+	outputFile := options.outputFile
+	if outputFile == "" {
+		outputFile = fmt.Sprintf("eksctl-diagnose-%s-%s.tar.gz", cfg.Metadata.Name, time.Now().UTC().Format("20060102T150405Z"))
+	}
+	f, err := os.OpenFile(outputFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := collector.WriteBundle(ctx, f); err != nil {
+		return err
+	}
+	logger.Success("wrote the diagnostics of cluster %q to %q, review it before sharing it", cfg.Metadata.Name, outputFile)
+	return nil
+}
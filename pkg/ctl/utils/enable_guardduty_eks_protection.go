@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/guardduty"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func enableGuardDutyEKSProtectionCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("enable-guardduty-eks-protection", "Enable GuardDuty EKS protection for a cluster",
+		"Enables EKS Audit Log Monitoring and EKS Runtime Monitoring in the GuardDuty detector of the account and region, "+
+			"and deploys the GuardDuty agent addon to the cluster with the VPC endpoint it reports to")
+	cmd.Mutating = true
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		return doEnableGuardDutyEKSProtection(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doEnableGuardDutyEKSProtection(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
+	cfg := cmd.ClusterConfig
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	addonManager, err := addon.New(cfg, ctl.Provider.EKS(), ctl.NewStackManager(cfg), false, nil, nil, cfg.Timeouts.AddonActiveTimeout(cmd.ProviderConfig.WaitTimeout))
+	if err != nil {
+		return err
+	}
+	enabler := guardduty.New(cfg, ctl.Status.ClusterInfo.Cluster, ctl.Provider.GuardDuty(), ctl.Provider.EC2(), ctl.Provider.EKS(), addonManager)
+	if err := enabler.Enable(ctx, cmd.Plan); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/networkpolicy"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func enableNetworkPolicyCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("enable-network-policy", "Enable the network policy agent of the VPC CNI",
+		"Sets enableNetworkPolicy in the configuration values of the vpc-cni addon and waits for the aws-node DaemonSet "+
+			"to roll out the network policy agent, so that Kubernetes NetworkPolicy resources are enforced")
+	cmd.Mutating = true
+
+	var defaultDenyNamespaces []string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		return doEnableNetworkPolicy(cmd, defaultDenyNamespaces)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringSliceVar(&defaultDenyNamespaces, "default-deny-namespaces", nil,
+			fmt.Sprintf("Namespaces to apply a NetworkPolicy %q denying all ingress and egress traffic of their pods to", networkpolicy.DefaultDenyPolicyName))
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doEnableNetworkPolicy(cmd *cmdutils.Cmd, defaultDenyNamespaces []string) error {
+	for _, namespace := range defaultDenyNamespaces {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace %q in --default-deny-namespaces: %s", namespace, strings.Join(errs, ", "))
+		}
+	}
+
+	ctx := context.TODO()
+	cfg := cmd.ClusterConfig
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	addonManager, err := addon.New(cfg, ctl.Provider.EKS(), ctl.NewStackManager(cfg), false, nil, clientSet, cfg.Timeouts.AddonActiveTimeout(cmd.ProviderConfig.WaitTimeout))
+	if err != nil {
+		return err
+	}
+	enabler := networkpolicy.New(cfg, ctl.Provider.EKS(), addonManager, clientSet, cmd.ProviderConfig.WaitTimeout)
+	if err := enabler.Enable(ctx, defaultDenyNamespaces, cmd.Plan); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
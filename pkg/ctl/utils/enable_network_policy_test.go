@@ -0,0 +1,20 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("enable-network-policy", func() {
+	DescribeTable("validates the flags",
+		func(expectedErr string, args ...string) {
+			cmd := newMockCmd(append([]string{"enable-network-policy"}, args...)...)
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("without a cluster", "--cluster must be set"),
+		Entry("with an invalid namespace", `invalid namespace "Apps" in --default-deny-namespaces`,
+			"--cluster", "my-cluster", "--default-deny-namespaces", "default,Apps"),
+	)
+})
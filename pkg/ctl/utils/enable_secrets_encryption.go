@@ -21,6 +21,7 @@ func enableSecretsEncryptionWithHandler(cmd *cmdutils.Cmd, handler func(*cmdutil
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("enable-secrets-encryption", "Enable secrets encryption", "Enable secrets encryption on a cluster")
+	cmd.Mutating = true
 
 	var encryptExistingSecrets bool
 
@@ -51,9 +52,10 @@ func enableSecretsEncryptionCmd(cmd *cmdutils.Cmd) {
 }
 
 func doEnableSecretsEncryption(cmd *cmdutils.Cmd, encryptExistingSecrets bool) error {
+	ctx := context.TODO()
 	clusterConfig := cmd.ClusterConfig
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -62,7 +64,7 @@ func doEnableSecretsEncryption(cmd *cmdutils.Cmd, encryptExistingSecrets bool) e
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
 	}
 
-	if err := ctl.RefreshClusterStatus(clusterConfig); err != nil {
+	if err := ctl.RefreshClusterStatus(ctx, clusterConfig); err != nil {
 		return err
 	}
 
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/metadata"
+)
+
+func exportMetadataCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription(
+		"export-metadata",
+		"Export the AMIs, instance types and addon versions of a region for offline use",
+		"Captures the metadata eksctl looks up in AWS, so that it can be passed with --metadata-bundle in environments "+
+			"where these lookups fail or are blocked",
+	)
+
+	var (
+		outputFile         string
+		kubernetesVersions []string
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doExportMetadata(cmd, kubernetesVersions, outputFile)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringSliceVar(&kubernetesVersions, "kubernetes-versions", api.SupportedVersions(), "Kubernetes versions to export the AMIs and addon versions of")
+		fs.StringVarP(&outputFile, "output-file", "o", "-", "path of the file to write the metadata to, '-' for stdout")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doExportMetadata(cmd *cmdutils.Cmd, kubernetesVersions []string, outputFile string) error {
+	ctx := context.TODO()
+	if cmd.ProviderConfig.MetadataBundle != "" {
+		return errors.New("--metadata-bundle cannot be used when exporting metadata")
+	}
+	for _, version := range kubernetesVersions {
+		if !api.IsSupportedVersion(version) {
+			return fmt.Errorf("unsupported Kubernetes version %s, supported versions are %s", version, strings.Join(api.SupportedVersions(), ", "))
+		}
+	}
+
+	if outputFile == "-" {
+		// the metadata is written to stdout
+		logger.Writer = os.Stderr
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	exporter := metadata.NewExporter(ctl.Provider.Region(), ctl.Provider.SSM(), ctl.Provider.EC2(), ctl.Provider.EKS())
+	b, err := exporter.Export(ctx, kubernetesVersions)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if outputFile != "-" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := metadata.Write(w, b); err != nil {
+		return err
+	}
+	if outputFile != "-" {
+		logger.Success("exported %d AMI parameters, %d instance types and the addon versions of Kubernetes %s in region %q to %s",
+			len(b.Parameters), len(b.InstanceTypes), strings.Join(kubernetesVersions, ", "), b.Region, outputFile)
+	}
+	return nil
+}
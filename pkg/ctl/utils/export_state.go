@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/state"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func exportStateCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription(
+		"export-state",
+		"Export the CloudFormation stacks eksctl owns for a cluster",
+		"Captures the templates, parameters, tags and resource IDs of the stacks eksctl owns for a cluster, "+
+			"so that they can be brought back with 'eksctl utils import-state' if they are deleted",
+	)
+
+	var outputFile string
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doExportState(cmd, outputFile)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&outputFile, "output-file", "o", "-", "path of the file to write the state to, '-' for stdout")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doExportState(cmd *cmdutils.Cmd, outputFile string) error {
+	ctx := context.TODO()
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	if outputFile == "-" {
+		// the state is written to stdout
+		logger.Writer = os.Stderr
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	exporter := state.NewExporter(cfg.Metadata.Name, ctl.Provider.Region(), ctl.NewStackManager(cfg), ctl.Provider.CloudFormation())
+	s, err := exporter.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if outputFile != "-" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := state.Write(w, s); err != nil {
+		return err
+	}
+	if outputFile != "-" {
+		logger.Success("exported %d stacks of cluster %q to %s", len(s.Stacks), cfg.Metadata.Name, outputFile)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/lock"
+)
+
+func forceUnlockCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("force-unlock", "Release the lock of a cluster held by another eksctl invocation",
+		"Only use this command when the eksctl invocation holding the lock is no longer running, e.g. it was killed")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doForceUnlock(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doForceUnlock(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	holder, err := lock.ForceUnlock(cmd.Context(), ctl.Provider.SSM(), cmd.ClusterConfig.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	if holder == nil {
+		logger.Info("cluster %q is not locked", cmd.ClusterConfig.Metadata.Name)
+		return nil
+	}
+	logger.Success("released the lock of cluster %q held by %s", cmd.ClusterConfig.Metadata.Name, holder)
+	return nil
+}
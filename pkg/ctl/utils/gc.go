@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/gc"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func gcCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("gc", "Delete the resources of a cluster left behind by deleted stacks",
+		"Finds the security groups, network interfaces, IAM roles, OIDC providers and log groups bearing the ownership tags "+
+			"of a cluster that are no longer managed by any of its stacks, and deletes them")
+	cmd.Mutating = true
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGC(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doGC(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	collector := gc.New(cmd.ClusterConfig.Metadata.Name, ctl.Provider)
+	resources, err := collector.Find(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		logger.Info("no leaked resources found for cluster %q", cmd.ClusterConfig.Metadata.Name)
+		return nil
+	}
+
+	logger.Info("%d leaked resource(s) found for cluster %q", len(resources), cmd.ClusterConfig.Metadata.Name)
+	for _, r := range resources {
+		logger.Info("will delete %s", r)
+	}
+	if cmd.Plan {
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+	return collector.Delete(cmd.Context(), resources)
+}
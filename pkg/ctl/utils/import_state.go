@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/state"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func importStateCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription(
+		"import-state",
+		"Bring back the CloudFormation stacks of a state exported with 'eksctl utils export-state'",
+		"Recreates the stacks of the state that no longer exist. With --adopt, the resources of these stacks "+
+			"that still exist are imported into the recreated stacks instead of being created anew",
+	)
+	cmd.Mutating = true
+
+	var (
+		stateFile string
+		adopt     bool
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doImportState(cmd, stateFile, adopt)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringVar(&stateFile, "state-file", "", "path of the state written by 'eksctl utils export-state'")
+		fs.BoolVar(&adopt, "adopt", false, "import the resources of the stacks that still exist instead of creating new ones")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doImportState(cmd *cmdutils.Cmd, stateFile string, adopt bool) error {
+	ctx := context.TODO()
+	if stateFile == "" {
+		return cmdutils.ErrMustBeSet("--state-file")
+	}
+	f, err := os.Open(stateFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	s, err := state.Read(f)
+	if err != nil {
+		return err
+	}
+
+	// the cluster and region default to those of the state
+	meta := cmd.ClusterConfig.Metadata
+	if cmd.NameArg == "" && meta.Name == "" {
+		meta.Name = s.ClusterName
+	}
+	if cmd.ProviderConfig.Region == "" {
+		cmd.ProviderConfig.Region = s.Region
+	}
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	if meta.Name != s.ClusterName {
+		return fmt.Errorf("state file %s is for cluster %q, not %q", stateFile, s.ClusterName, meta.Name)
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	if region := ctl.Provider.Region(); region != s.Region {
+		return fmt.Errorf("state file %s is for region %q, not %q", stateFile, s.Region, region)
+	}
+
+	importer := state.NewImporter(ctl.NewStackManager(cmd.ClusterConfig), ctl.Provider.CloudFormation(), ctl.Provider.CloudFormationRoleARN(), cmd.ProviderConfig.WaitTimeout)
+	if err := importer.Import(ctx, s, adopt, cmd.Plan); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
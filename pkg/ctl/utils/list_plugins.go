@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/actions/plugin"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func listPluginsCmd(cmd *cmdutils.Cmd) {
+	cmd.SetDescription("list-plugins", "List the eksctl plugins found on PATH", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		return doListPlugins()
+	}
+}
+
+func doListPlugins() error {
+	plugins, err := plugin.List()
+	if err != nil {
+		return err
+	}
+	if len(plugins) == 0 {
+		logger.Info("no plugins found, plugins are executables on PATH named %s<name>", plugin.BinaryPrefix)
+		return nil
+	}
+
+	printer := printers.NewTablePrinter()
+	addListPluginsColumns(printer.(*printers.TablePrinter))
+	return printer.PrintObjWithKind("plugins", plugins, os.Stdout)
+}
+
+func addListPluginsColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(p plugin.Plugin) string {
+		return p.Name
+	})
+	printer.AddColumn("PATH", func(p plugin.Plugin) string {
+		return p.Path
+	})
+}
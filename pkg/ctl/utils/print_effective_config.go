@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func printEffectiveConfigCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var output printers.Type
+
+	cmd.SetDescription("print-effective-config", "Print the ClusterConfig with the defaults eksctl sets",
+		"Loads a config file, expanding its YAML anchors, sets the defaults eksctl sets when creating the cluster and prints the resulting ClusterConfig, without calling AWS")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doPrintEffectiveConfig(cmd, output, os.Stdout)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", "yaml", "specifies the output format (valid option: json, yaml)")
+	})
+}
+
+func doPrintEffectiveConfig(cmd *cmdutils.Cmd, output printers.Type, w io.Writer) error {
+	if cmd.ClusterConfigFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file/-f <file>")
+	}
+	if output == printers.TableType {
+		return errors.New("the ClusterConfig cannot be printed as a table, use --output=yaml or --output=json")
+	}
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+	// keep the output parseable
+	logger.Writer = os.Stderr
+
+	if err := cmdutils.NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, &cmdutils.CreateClusterCmdParams{}).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Version == "" || cfg.Metadata.Version == "auto" {
+		cfg.Metadata.Version = api.DefaultVersion
+	}
+	if cfg.Metadata.Version == "latest" {
+		cfg.Metadata.Version = api.LatestVersion
+	}
+	if err := cmd.SetDefaultsAndValidate(); err != nil {
+		return err
+	}
+	return printer.PrintObj(cfg, w)
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+var _ = Describe("print-effective-config", func() {
+	const config = `apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+
+x-defaults: &defaults
+  instanceType: m5.xlarge
+
+metadata:
+  name: cluster-1
+  region: us-west-2
+
+nodeGroups:
+  - <<: *defaults
+    name: ng-1
+managedNodeGroups:
+  - name: mng-1
+`
+
+	var (
+		cmd    *cmdutils.Cmd
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "print-effective-config")
+		Expect(err).NotTo(HaveOccurred())
+
+		configFile := filepath.Join(tmpDir, "cluster.yaml")
+		Expect(os.WriteFile(configFile, []byte(config), 0600)).To(Succeed())
+		cmd = &cmdutils.Cmd{
+			CobraCommand:      &cobra.Command{},
+			ClusterConfigFile: configFile,
+			ClusterConfig:     api.NewClusterConfig(),
+		}
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("prints the config with the defaults eksctl sets", func() {
+		out := &bytes.Buffer{}
+		Expect(doPrintEffectiveConfig(cmd, printers.YAMLType, out)).To(Succeed())
+
+		cfg := &api.ClusterConfig{}
+		Expect(yaml.UnmarshalStrict(out.Bytes(), cfg)).To(Succeed())
+		Expect(cfg.Metadata.Version).To(Equal(api.DefaultVersion))
+		Expect(cfg.VPC.CIDR.String()).To(Equal("192.168.0.0/16"))
+		Expect(*cfg.VPC.NAT.Gateway).To(Equal(api.ClusterSingleNAT))
+		Expect(cfg.NodeGroups[0].InstanceType).To(Equal("m5.xlarge"))
+		Expect(cfg.NodeGroups[0].AMIFamily).To(Equal(api.DefaultNodeImageFamily))
+		Expect(*cfg.NodeGroups[0].VolumeSize).To(Equal(api.DefaultNodeVolumeSize))
+		Expect(cfg.ManagedNodeGroups[0].InstanceType).To(Equal(api.DefaultNodeType))
+		Expect(*cfg.ManagedNodeGroups[0].ScalingConfig.DesiredCapacity).To(Equal(api.DefaultNodeCount))
+	})
+
+	It("requires a config file", func() {
+		cmd.ClusterConfigFile = ""
+		Expect(doPrintEffectiveConfig(cmd, printers.YAMLType, &bytes.Buffer{})).To(MatchError(ContainSubstring("--config-file/-f <file> must be set")))
+	})
+
+	It("rejects the table output", func() {
+		Expect(doPrintEffectiveConfig(cmd, printers.TableType, &bytes.Buffer{})).To(MatchError(ContainSubstring("cannot be printed as a table")))
+	})
+})
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func reapExpiredCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("reap-expired", "Delete the clusters whose TTL expired",
+		"Finds the clusters created by eksctl in a region whose metadata.ttl expired, and deletes them. Clusters whose stack "+
+			"has termination protection enabled or is being updated, and clusters locked by another eksctl invocation, are skipped")
+	cmd.Mutating = true
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			return cmdutils.ErrUnsupportedNameArg()
+		}
+		return doReapExpired(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of each cluster")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doReapExpired(cmd *cmdutils.Cmd) error {
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	expired, err := cluster.FindExpiredClusters(cmd.Context(), ctl.Provider, time.Now())
+	if err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		logger.Info("no expired clusters found in region %q", ctl.Provider.Region())
+		return nil
+	}
+
+	for _, c := range expired {
+		if c.SkipReason != "" {
+			logger.Warning("skipping cluster %q, which expired at %s, as %s", c.Name, c.ExpiresAt.UTC().Format(time.RFC3339), c.SkipReason)
+			continue
+		}
+		logger.Info("will delete cluster %q, which expired at %s", c.Name, c.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	if cmd.Plan {
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+	return cluster.DeleteExpiredClusters(cmd.Context(), &cmd.ProviderConfig, expired, cmd.Wait, cmd.LockCluster)
+}
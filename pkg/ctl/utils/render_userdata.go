@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+)
+
+func renderUserDataCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var nodeGroupName string
+
+	cmd.SetDescription("render-userdata", "Render the user data of a nodegroup",
+		"Prints the user data eksctl generates for a nodegroup in a config file, decoded, so that it can be inspected before the nodegroup is created")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doRenderUserData(cmd, nodeGroupName, os.Stdout)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&nodeGroupName, "nodegroup", "", "name of the nodegroup")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doRenderUserData(cmd *cmdutils.Cmd, nodeGroupName string, w io.Writer) error {
+	if cmd.ClusterConfigFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file/-f <file>")
+	}
+	if nodeGroupName == "" {
+		return cmdutils.ErrMustBeSet("--nodegroup")
+	}
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+	ctx := cmd.Context()
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	// the endpoint, CA and service CIDR of the cluster are part of the user data of self-managed nodegroups
+	if err := ctl.RefreshClusterStatus(ctx, cfg); err != nil {
+		logger.Warning("unable to get the status of cluster %q, the user data won't include its endpoint and CA: %v", cfg.Metadata.Name, err)
+		cfg.Status = &api.ClusterStatus{}
+	}
+
+	bootstrapper, err := newBootstrapper(cfg, nodeGroupName)
+	if err != nil {
+		return err
+	}
+	userData, err := bootstrapper.UserData()
+	if err != nil {
+		return errors.Wrapf(err, "rendering user data of nodegroup %q", nodeGroupName)
+	}
+	if userData == "" {
+		logger.Info("nodegroup %q has no user data", nodeGroupName)
+		return nil
+	}
+
+	size := nodebootstrap.UserDataSize(userData)
+	if err := nodebootstrap.ValidateUserDataSize(nodeGroupName, userData); err != nil {
+		logger.Warning("%v", err)
+	} else {
+		logger.Info("user data of nodegroup %q is %d of at most %d bytes", nodeGroupName, size, nodebootstrap.MaxUserDataSize)
+	}
+
+	document, err := nodebootstrap.DecodeUserData(userData)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(document)
+	return err
+}
+
+func newBootstrapper(cfg *api.ClusterConfig, nodeGroupName string) (nodebootstrap.Bootstrapper, error) {
+	for _, ng := range cfg.NodeGroups {
+		if ng.Name == nodeGroupName {
+			return nodebootstrap.NewBootstrapper(cfg, ng)
+		}
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		if ng.Name == nodeGroupName {
+			if bootstrapper := nodebootstrap.NewManagedBootstrapper(cfg, ng); bootstrapper != nil {
+				return bootstrapper, nil
+			}
+			return nil, fmt.Errorf("eksctl doesn't generate user data for managed nodegroup %q with AMI family %q", ng.Name, ng.AMIFamily)
+		}
+	}
+	return nil, fmt.Errorf("nodegroup %q not found in the config file", nodeGroupName)
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	sshclient "github.com/weaveworks/eksctl/pkg/ssh/client"
+)
+
+func rotateSSHKeyCmd(cmd *cmdutils.Cmd) {
+	rotateSSHKeyCmdWithRunFunc(cmd, doRotateSSHKey)
+}
+
+func rotateSSHKeyCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, nodeGroupName string, sshConfig *api.NodeGroupSSH) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("rotate-ssh-key", "Replace the SSH key pair of a nodegroup",
+		"Updates the launch template of a nodegroup to use another EC2 key pair, which replaces its nodes")
+	cmd.Mutating = true
+
+	var (
+		nodeGroupName string
+		publicKey     string
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		if nodeGroupName == "" {
+			return cmdutils.ErrMustBeSet("--nodegroup")
+		}
+		if publicKey == "" {
+			return cmdutils.ErrMustBeSet("--ssh-public-key")
+		}
+		sshConfig := &api.NodeGroupSSH{
+			Allow: api.Enabled(),
+		}
+		// key store references are key names, a path can also be the name of a key pair in EC2
+		if sshclient.IsKeyStoreReference(publicKey) {
+			sshConfig.PublicKeyName = &publicKey
+		} else {
+			sshConfig.PublicKeyPath = &publicKey
+		}
+		return runFunc(cmd, nodeGroupName, sshConfig)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVar(&nodeGroupName, "nodegroup", "", "name of the nodegroup")
+		fs.StringVar(&publicKey, "ssh-public-key", "", "SSH public key to use for nodes (import from local path, use existing EC2 key pair, or an ssm: or secretsmanager: reference)")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "replacement of the nodes")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doRotateSSHKey(cmd *cmdutils.Cmd, nodeGroupName string, sshConfig *api.NodeGroupSSH) error {
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if err := nodegroup.New(cmd.ClusterConfig, ctl, nil).RotateSSHKey(ctx, nodeGroupName, sshConfig, cmd.Plan, cmd.Wait); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
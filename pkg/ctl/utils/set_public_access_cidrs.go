@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"context"
+
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -16,6 +19,7 @@ func publicAccessCIDRsCmdWithHandler(cmd *cmdutils.Cmd, handler func(cmd *cmduti
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("set-public-access-cidrs", "Update public access CIDRs", "CIDR blocks that EKS uses to create a security group on the public endpoint")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
@@ -41,10 +45,11 @@ func publicAccessCIDRsCmd(cmd *cmdutils.Cmd) {
 }
 
 func doUpdatePublicAccessCIDRs(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -54,7 +59,17 @@ func doUpdatePublicAccessCIDRs(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	clusterVPCConfig, err := ctl.GetCurrentClusterVPCConfig(cfg)
+	ipv6Cluster := false
+	if networkConfig := ctl.Status.ClusterInfo.Cluster.KubernetesNetworkConfig; networkConfig != nil {
+		ipv6Cluster = networkConfig.IpFamily == ekstypes.IpFamilyIpv6
+	}
+	cidrs, err := api.ValidatePublicAccessCIDRs(cfg.VPC.PublicAccessCIDRs, ipv6Cluster)
+	if err != nil {
+		return err
+	}
+	cfg.VPC.PublicAccessCIDRs = cidrs
+
+	clusterVPCConfig, err := ctl.GetCurrentClusterVPCConfig(ctx, cfg)
 	if err != nil {
 		return err
 	}
@@ -72,7 +87,7 @@ func doUpdatePublicAccessCIDRs(cmd *cmdutils.Cmd) error {
 		meta.Name, meta.Region, cfg.VPC.PublicAccessCIDRs)
 
 	if !cmd.Plan {
-		if err := ctl.UpdatePublicAccessCIDRs(cfg); err != nil {
+		if err := ctl.UpdatePublicAccessCIDRs(ctx, cfg); err != nil {
 			return errors.Wrap(err, "error updating CIDRs for public access")
 		}
 		cmdutils.LogCompletedAction(
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/accessentries"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+func updateAuthenticationModeCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-authentication-mode", "Update the authentication mode of a cluster",
+		"Switches the sources of the IAM principals the cluster authenticates between the aws-auth ConfigMap and access entries")
+	cmd.Mutating = true
+
+	var authenticationMode string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		if err := cmdutils.NewUtilsUpdateAuthenticationModeLoader(cmd, authenticationMode).Load(); err != nil {
+			return err
+		}
+		return doUpdateAuthenticationMode(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&authenticationMode, "authentication-mode", "", fmt.Sprintf("authentication mode to switch to, one of %s", strings.Join(api.AuthenticationModes(), ", ")))
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doUpdateAuthenticationMode(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	return updateAuthenticationMode(ctx, cmd, ctl)
+}
+
+func updateAuthenticationMode(ctx context.Context, cmd *cmdutils.Cmd, ctl *eks.ClusterProvider) error {
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	// clusters that predate access entries don't report an access config and only use the aws-auth ConfigMap
+	currentMode := api.AuthenticationModeConfigMap
+	if accessConfig := ctl.Status.ClusterInfo.Cluster.AccessConfig; accessConfig != nil && accessConfig.AuthenticationMode != "" {
+		currentMode = string(accessConfig.AuthenticationMode)
+	}
+	mode := cfg.AccessConfig.AuthenticationMode
+	logger.Info("current authentication mode: %s", currentMode)
+
+	if mode == currentMode {
+		logger.Success("authentication mode of cluster %q in %q is already %s", meta.Name, meta.Region, mode)
+		return nil
+	}
+	if err := api.ValidateAuthenticationModeUpdate(currentMode, mode); err != nil {
+		return err
+	}
+
+	if mode == api.AuthenticationModeAPI {
+		// without the aws-auth ConfigMap, only access entries grant access to the cluster
+		admins, err := accessentries.NewGetter(meta.Name, ctl.Provider.EKS()).ClusterAdmins(ctx)
+		if err != nil {
+			return errors.Wrap(err, "checking for cluster admin access entries")
+		}
+		if len(admins) == 0 {
+			return fmt.Errorf("no access entry of cluster %q is associated with AmazonEKSClusterAdminPolicy for the whole cluster, "+
+				"create one before switching to %s, as the admins mapped in the aws-auth ConfigMap would lose access", meta.Name, mode)
+		}
+		logger.Info("cluster admins with access entries: %s", strings.Join(admins, ", "))
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "update authentication mode of cluster %q in %q from %s to %s", meta.Name, meta.Region, currentMode, mode)
+	if !cmd.Plan {
+		if err := ctl.UpdateAuthenticationMode(ctx, cfg); err != nil {
+			return errors.Wrap(err, "error updating authentication mode")
+		}
+		cmdutils.LogCompletedAction(false, "authentication mode of cluster %q in %q has been updated to %s", meta.Name, meta.Region, mode)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("update-authentication-mode", func() {
+	const (
+		adminARN  = "arn:aws:iam::123456789012:role/admin"
+		viewerARN = "arn:aws:iam::123456789012:role/viewer"
+	)
+
+	var (
+		p   *mockprovider.MockProvider
+		cmd *cmdutils.Cmd
+		ctl *eks.ClusterProvider
+	)
+
+	mockAccessEntry := func(principalARN string, policies ...ekstypes.AssociatedAccessPolicy) {
+		p.MockEKS().On("DescribeAccessEntry", mock.Anything, &awseks.DescribeAccessEntryInput{
+			ClusterName:  aws.String("my-cluster"),
+			PrincipalArn: aws.String(principalARN),
+		}).Return(&awseks.DescribeAccessEntryOutput{
+			AccessEntry: &ekstypes.AccessEntry{PrincipalArn: aws.String(principalARN)},
+		}, nil)
+		p.MockEKS().On("ListAssociatedAccessPolicies", mock.Anything, &awseks.ListAssociatedAccessPoliciesInput{
+			ClusterName:  aws.String("my-cluster"),
+			PrincipalArn: aws.String(principalARN),
+		}).Return(&awseks.ListAssociatedAccessPoliciesOutput{AssociatedAccessPolicies: policies}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.AccessConfig = &api.AccessConfig{AuthenticationMode: api.AuthenticationModeAPI}
+		cmd = &cmdutils.Cmd{ClusterConfig: cfg}
+		ctl = &eks.ClusterProvider{
+			Provider: p,
+			Status: &eks.ProviderStatus{
+				ClusterInfo: &eks.ClusterInfo{
+					Cluster: &ekstypes.Cluster{
+						Status: ekstypes.ClusterStatusActive,
+						AccessConfig: &ekstypes.AccessConfigResponse{
+							AuthenticationMode: ekstypes.AuthenticationModeApiAndConfigMap,
+						},
+					},
+				},
+			},
+		}
+		p.MockEKS().On("ListAccessEntries", mock.Anything, mock.Anything).Return(&awseks.ListAccessEntriesOutput{
+			AccessEntries: []string{adminARN, viewerARN},
+		}, nil)
+	})
+
+	It("refuses to switch to API when no access entry is a cluster admin", func() {
+		mockAccessEntry(adminARN, ekstypes.AssociatedAccessPolicy{
+			PolicyArn:   aws.String("arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"),
+			AccessScope: &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeNamespace, Namespaces: []string{"default"}},
+		})
+		mockAccessEntry(viewerARN, ekstypes.AssociatedAccessPolicy{
+			PolicyArn:   aws.String("arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"),
+			AccessScope: &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeCluster},
+		})
+
+		err := updateAuthenticationMode(context.Background(), cmd, ctl)
+		Expect(err).To(MatchError(ContainSubstring(`no access entry of cluster "my-cluster" is associated with AmazonEKSClusterAdminPolicy for the whole cluster`)))
+		p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateClusterConfig", mock.Anything, mock.Anything)
+	})
+
+	It("plans the switch to API when an access entry is a cluster admin", func() {
+		mockAccessEntry(adminARN, ekstypes.AssociatedAccessPolicy{
+			PolicyArn:   aws.String("arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"),
+			AccessScope: &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeCluster},
+		})
+		mockAccessEntry(viewerARN)
+		cmd.Plan = true
+
+		Expect(updateAuthenticationMode(context.Background(), cmd, ctl)).To(Succeed())
+		p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateClusterConfig", mock.Anything, mock.Anything)
+	})
+})
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -20,6 +21,7 @@ func enableLoggingCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("update-cluster-logging", "Update cluster logging configuration", "")
+	cmd.Mutating = true
 
 	var typesEnabled []string
 	var typesDisabled []string
@@ -44,15 +46,26 @@ func enableLoggingCmd(cmd *cmdutils.Cmd) {
 
 	})
 
+	cmd.FlagSetGroup.InFlagSet("Log group", func(fs *pflag.FlagSet) {
+		fs.IntVar(&cfg.CloudWatch.ClusterLogging.LogRetentionInDays, "log-retention-days", 0, fmt.Sprintf("Number of days to retain the logs for. Supported values: %v", api.LogRetentionInDaysValues))
+		fs.StringVar(&cfg.CloudWatch.ClusterLogging.LogGroupKMSKeyARN, "log-group-kms-key-arn", "", "ARN of the KMS key to encrypt the log group with, its key policy is updated to allow CloudWatch Logs to use it")
+	})
+
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
 func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDisable []string) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewUtilsEnableLoggingLoader(cmd).Load(); err != nil {
 		return err
 	}
 
-	if !cmd.ClusterConfig.HasClusterCloudWatchLogging() {
+	logging := cmd.ClusterConfig.CloudWatch.ClusterLogging
+	configureLogGroup := logging.LogRetentionInDays != 0 || logging.LogGroupKMSKeyARN != ""
+
+	// the log group settings can be changed without changing the log types
+	onlyLogGroupFlags := configureLogGroup && len(logTypesToEnable) == 0 && len(logTypesToDisable) == 0
+	if !cmd.ClusterConfig.HasClusterCloudWatchLogging() && !onlyLogGroupFlags {
 		if err := validateLoggingFlags(logTypesToEnable, logTypesToDisable); err != nil {
 			return err
 		}
@@ -63,12 +76,12 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 
 	printer := printers.NewJSONPrinter()
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
 
-	currentlyEnabled, _, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+	currentlyEnabled, _, err := ctl.GetCurrentClusterConfigForLogging(ctx, cfg)
 	if err != nil {
 		return err
 	}
@@ -89,6 +102,19 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 		return err
 	}
 
+	// the log group is configured first so that logs of newly enabled types are encrypted from the start
+	if configureLogGroup {
+		if err := api.ValidateCloudWatchLogging(cfg); err != nil {
+			return err
+		}
+		cmdutils.LogIntendedAction(cmd.Plan, "configure CloudWatch log group %q of cluster %q in %q", cfg.ClusterLogGroupName(), meta.Name, meta.Region)
+		if !cmd.Plan {
+			if err := ctl.ConfigureClusterLogGroup(ctx, cfg); err != nil {
+				return err
+			}
+		}
+	}
+
 	if updateRequired {
 		describeTypesToEnable := "no types to enable"
 		if len(willBeEnabled.List()) > 0 {
@@ -104,7 +130,7 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 			meta.Name, meta.Region, describeTypesToEnable, describeTypesToDisable,
 		)
 		if !cmd.Plan {
-			if err := ctl.UpdateClusterConfigForLogging(cfg); err != nil {
+			if err := ctl.UpdateClusterConfigForLogging(ctx, cfg); err != nil {
 				return err
 			}
 		}
@@ -112,7 +138,7 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 		logger.Success("CloudWatch logging for cluster %q in %q is already up-to-date", meta.Name, meta.Region)
 	}
 
-	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
+	cmdutils.LogPlanModeWarning(cmd.Plan && (updateRequired || configureLogGroup))
 
 	return nil
 }
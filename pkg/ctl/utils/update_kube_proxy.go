@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -14,6 +15,7 @@ func updateKubeProxyCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("update-kube-proxy", "Update kube-proxy add-on to ensure image matches Kubernetes control plane version", "")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
@@ -24,6 +26,7 @@ func updateKubeProxyCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddClusterFlagWithDeprecated(fs, cfg.Metadata)
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddImageRepositoryOverrideFlag(fs, cfg)
 		cmdutils.AddApproveFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
@@ -32,6 +35,7 @@ func updateKubeProxyCmd(cmd *cmdutils.Cmd) {
 }
 
 func doUpdateKubeProxy(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -39,7 +43,7 @@ func doUpdateKubeProxy(cmd *cmdutils.Cmd) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -59,10 +63,11 @@ func doUpdateKubeProxy(cmd *cmdutils.Cmd) error {
 	}
 
 	updateRequired, err := defaultaddons.UpdateKubeProxy(defaultaddons.AddonInput{
-		RawClient:           rawClient,
-		ControlPlaneVersion: kubernetesVersion,
-		Region:              meta.Region,
-		EKSAPI:              ctl.Provider.EKS(),
+		RawClient:               rawClient,
+		ControlPlaneVersion:     kubernetesVersion,
+		Region:                  meta.Region,
+		EKSAPI:                  ctl.Provider.EKS(),
+		ImageRepositoryOverride: cfg.ImageRepositoryOverride,
 	}, cmd.Plan)
 	if err != nil {
 		return err
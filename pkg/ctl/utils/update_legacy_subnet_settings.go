@@ -18,6 +18,7 @@ func updateLegacySubnetSettings(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("update-legacy-subnet-settings", "Update the configuration of the cluster's public subnets with MapPublicIpOnLaunch enabled",
 		"MapPublicIpOnLaunch is a new property for subnets that is required for creating new nodegroups in them")
+	cmd.Mutating = true
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
@@ -35,6 +36,7 @@ func updateLegacySubnetSettings(cmd *cmdutils.Cmd) {
 }
 
 func doUpdateLegacySubnetSettings(cmd *cmdutils.Cmd) error {
+	ctx := context.TODO()
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -42,7 +44,7 @@ func doUpdateLegacySubnetSettings(cmd *cmdutils.Cmd) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
-	ctl, err := cmd.NewProviderForExistingCluster()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
 	}
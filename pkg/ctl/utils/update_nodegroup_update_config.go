@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateNodeGroupUpdateConfigCmd(cmd *cmdutils.Cmd) {
+	updateNodeGroupUpdateConfigCmdWithRunFunc(cmd, doUpdateNodeGroupUpdateConfig)
+}
+
+func updateNodeGroupUpdateConfigCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, nodeGroupName string, updateConfig *api.NodeGroupUpdateConfig) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-nodegroup-update-config", "Update how many nodes of a managed nodegroup can be unavailable during updates",
+		"Sets the updateConfig of an existing managed nodegroup, which controls how many nodes are replaced at once by rolling updates")
+	cmd.Mutating = true
+
+	var (
+		nodeGroupName            string
+		maxUnavailable           int
+		maxUnavailablePercentage int
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		if nodeGroupName == "" {
+			return cmdutils.ErrMustBeSet("--nodegroup")
+		}
+		updateConfig := &api.NodeGroupUpdateConfig{}
+		if cmd.CobraCommand.Flags().Changed("max-unavailable") {
+			updateConfig.MaxUnavailable = &maxUnavailable
+		}
+		if cmd.CobraCommand.Flags().Changed("max-unavailable-percentage") {
+			updateConfig.MaxUnavailablePercentage = &maxUnavailablePercentage
+		}
+		if err := updateConfig.Validate(); err != nil {
+			return err
+		}
+		return runFunc(cmd, nodeGroupName, updateConfig)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVar(&nodeGroupName, "nodegroup", "", "name of the managed nodegroup")
+		fs.IntVar(&maxUnavailable, "max-unavailable", 0, "maximum number of nodes that can be unavailable during updates")
+		fs.IntVar(&maxUnavailablePercentage, "max-unavailable-percentage", 0, "maximum percentage of nodes that can be unavailable during updates")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "update of the nodegroup")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doUpdateNodeGroupUpdateConfig(cmd *cmdutils.Cmd, nodeGroupName string, updateConfig *api.NodeGroupUpdateConfig) error {
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if err := nodegroup.New(cmd.ClusterConfig, ctl, nil).SetUpdateConfig(ctx, nodeGroupName, updateConfig, cmd.Plan, cmd.Wait); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
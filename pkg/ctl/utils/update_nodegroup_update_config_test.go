@@ -0,0 +1,23 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("update-nodegroup-update-config", func() {
+	DescribeTable("validates the flags",
+		func(expectedErr string, args ...string) {
+			cmd := newMockCmd(append([]string{"update-nodegroup-update-config", "--cluster", "my-cluster"}, args...)...)
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("without a nodegroup", "--nodegroup must be set", "--max-unavailable", "2"),
+		Entry("without an update config", "maxUnavailable or maxUnavailablePercentage must be defined", "--nodegroup", "ng-1"),
+		Entry("with both flags", "cannot use maxUnavailable=2 and maxUnavailablePercentage=50 at the same time",
+			"--nodegroup", "ng-1", "--max-unavailable", "2", "--max-unavailable-percentage", "50"),
+		Entry("with a percentage over 100", "maxUnavailablePercentage must be between 1 and 100, got 150",
+			"--nodegroup", "ng-1", "--max-unavailable-percentage", "150"),
+	)
+})
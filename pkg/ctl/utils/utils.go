@@ -14,16 +14,36 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateKubeProxyCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateAWSNodeCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateCoreDNSCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, coreComponentsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateLegacySubnetSettings)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableLoggingCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, associateIAMOIDCProviderCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installWindowsVPCController)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateClusterEndpointsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, publicAccessCIDRsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateAuthenticationModeCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableNetworkPolicyCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableSecretsEncryptionCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, schemaCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, nodeGroupHealthCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeAddonVersionsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, forceUnlockCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, gcCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, diagnoseCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, renderUserDataCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, listPluginsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, printEffectiveConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, convertConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableAutoAMIUpdatesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, disableAutoAMIUpdatesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, rotateSSHKeyCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateNodeGroupUpdateConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableGuardDutyEKSProtectionCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, exportStateCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, importStateCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, associateVPCCIDRCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, exportMetadataCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, reapExpiredCmd)
 
 	return verbCmd
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
@@ -156,3 +157,28 @@ func (c mockVerbCmd) execute() (string, error) {
 	}
 	return outBuf.String(), err
 }
+
+var _ = Describe("utils", func() {
+	DescribeTable("only the commands that change clusters take the lock of the cluster",
+		func(command string, mutating bool) {
+			utilsCmd := Command(cmdutils.NewGrouping())
+			c, _, err := utilsCmd.Find([]string{command})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Name()).To(Equal(command))
+			Expect(c.Flags().Lookup("lock-timeout") != nil).To(Equal(mutating))
+		},
+		Entry("update-coredns", "update-coredns", true),
+		Entry("update-authentication-mode", "update-authentication-mode", true),
+		Entry("enable-network-policy", "enable-network-policy", true),
+		Entry("enable-auto-ami-updates", "enable-auto-ami-updates", true),
+		Entry("disable-auto-ami-updates", "disable-auto-ami-updates", true),
+		Entry("gc", "gc", true),
+		Entry("import-state", "import-state", true),
+		Entry("core-components", "core-components", true),
+		Entry("reap-expired", "reap-expired", true),
+		Entry("export-state", "export-state", false),
+		Entry("rotate-ssh-key", "rotate-ssh-key", true),
+		Entry("describe-stacks", "describe-stacks", false),
+		Entry("force-unlock", "force-unlock", false),
+	)
+})
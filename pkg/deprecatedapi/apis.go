@@ -0,0 +1,76 @@
+package deprecatedapi
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RemovedAPI is a version of an API that Kubernetes stopped serving
+type RemovedAPI struct {
+	Resource schema.GroupVersionResource
+	Kind     string
+	// RemovedIn is the first Kubernetes version that doesn't serve the API
+	RemovedIn string
+	// Replacement is the API version to migrate to, it's empty if the API was removed without one
+	Replacement string
+}
+
+// APIVersion returns the apiVersion objects of the API are written with
+func (a RemovedAPI) APIVersion() string {
+	return a.Resource.GroupVersion().String()
+}
+
+func removed(group, version, resource, kind, removedIn, replacement string) RemovedAPI {
+	return RemovedAPI{
+		Resource:    schema.GroupVersionResource{Group: group, Version: version, Resource: resource},
+		Kind:        kind,
+		RemovedIn:   removedIn,
+		Replacement: replacement,
+	}
+}
+
+// RemovedAPIs are the APIs removed from the Kubernetes versions EKS supports, as listed in
+// https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+var RemovedAPIs = []RemovedAPI{
+	removed("admissionregistration.k8s.io", "v1beta1", "mutatingwebhookconfigurations", "MutatingWebhookConfiguration", "1.22", "admissionregistration.k8s.io/v1"),
+	removed("admissionregistration.k8s.io", "v1beta1", "validatingwebhookconfigurations", "ValidatingWebhookConfiguration", "1.22", "admissionregistration.k8s.io/v1"),
+	removed("apiextensions.k8s.io", "v1beta1", "customresourcedefinitions", "CustomResourceDefinition", "1.22", "apiextensions.k8s.io/v1"),
+	removed("apiregistration.k8s.io", "v1beta1", "apiservices", "APIService", "1.22", "apiregistration.k8s.io/v1"),
+	removed("certificates.k8s.io", "v1beta1", "certificatesigningrequests", "CertificateSigningRequest", "1.22", "certificates.k8s.io/v1"),
+	removed("coordination.k8s.io", "v1beta1", "leases", "Lease", "1.22", "coordination.k8s.io/v1"),
+	removed("extensions", "v1beta1", "ingresses", "Ingress", "1.22", "networking.k8s.io/v1"),
+	removed("networking.k8s.io", "v1beta1", "ingresses", "Ingress", "1.22", "networking.k8s.io/v1"),
+	removed("networking.k8s.io", "v1beta1", "ingressclasses", "IngressClass", "1.22", "networking.k8s.io/v1"),
+	removed("rbac.authorization.k8s.io", "v1beta1", "clusterroles", "ClusterRole", "1.22", "rbac.authorization.k8s.io/v1"),
+	removed("rbac.authorization.k8s.io", "v1beta1", "clusterrolebindings", "ClusterRoleBinding", "1.22", "rbac.authorization.k8s.io/v1"),
+	removed("rbac.authorization.k8s.io", "v1beta1", "roles", "Role", "1.22", "rbac.authorization.k8s.io/v1"),
+	removed("rbac.authorization.k8s.io", "v1beta1", "rolebindings", "RoleBinding", "1.22", "rbac.authorization.k8s.io/v1"),
+	removed("scheduling.k8s.io", "v1beta1", "priorityclasses", "PriorityClass", "1.22", "scheduling.k8s.io/v1"),
+	removed("storage.k8s.io", "v1beta1", "csidrivers", "CSIDriver", "1.22", "storage.k8s.io/v1"),
+	removed("storage.k8s.io", "v1beta1", "csinodes", "CSINode", "1.22", "storage.k8s.io/v1"),
+	removed("storage.k8s.io", "v1beta1", "storageclasses", "StorageClass", "1.22", "storage.k8s.io/v1"),
+	removed("storage.k8s.io", "v1beta1", "volumeattachments", "VolumeAttachment", "1.22", "storage.k8s.io/v1"),
+
+	removed("batch", "v1beta1", "cronjobs", "CronJob", "1.25", "batch/v1"),
+	removed("discovery.k8s.io", "v1beta1", "endpointslices", "EndpointSlice", "1.25", "discovery.k8s.io/v1"),
+	removed("events.k8s.io", "v1beta1", "events", "Event", "1.25", "events.k8s.io/v1"),
+	removed("autoscaling", "v2beta1", "horizontalpodautoscalers", "HorizontalPodAutoscaler", "1.25", "autoscaling/v2"),
+	removed("policy", "v1beta1", "poddisruptionbudgets", "PodDisruptionBudget", "1.25", "policy/v1"),
+	removed("policy", "v1beta1", "podsecuritypolicies", "PodSecurityPolicy", "1.25", ""),
+	removed("node.k8s.io", "v1beta1", "runtimeclasses", "RuntimeClass", "1.25", "node.k8s.io/v1"),
+
+	removed("flowcontrol.apiserver.k8s.io", "v1beta1", "flowschemas", "FlowSchema", "1.26", "flowcontrol.apiserver.k8s.io/v1beta2"),
+	removed("flowcontrol.apiserver.k8s.io", "v1beta1", "prioritylevelconfigurations", "PriorityLevelConfiguration", "1.26", "flowcontrol.apiserver.k8s.io/v1beta2"),
+	removed("autoscaling", "v2beta2", "horizontalpodautoscalers", "HorizontalPodAutoscaler", "1.26", "autoscaling/v2"),
+
+	removed("storage.k8s.io", "v1beta1", "csistoragecapacities", "CSIStorageCapacity", "1.27", "storage.k8s.io/v1"),
+}
+
+// findRemovedAPI returns the removed API serving a resource, or nil if it wasn't removed
+func findRemovedAPI(resource schema.GroupVersionResource) *RemovedAPI {
+	for i, api := range RemovedAPIs {
+		if api.Resource == resource {
+			return &RemovedAPIs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package deprecatedapi_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestDeprecatedAPI(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
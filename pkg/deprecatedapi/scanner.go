@@ -0,0 +1,198 @@
+package deprecatedapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"regexp"
+	"sort"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+const (
+	// SourceLastAppliedConfiguration is the source of findings for objects whose last applied configuration
+	// uses a removed API
+	SourceLastAppliedConfiguration = "last-applied-configuration"
+	// SourceAPIServerMetrics is the source of findings for removed APIs the API server reports as requested
+	SourceAPIServerMetrics = "apiserver-metrics"
+
+	lastAppliedConfigurationAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+var (
+	deprecatedAPIsMetric = regexp.MustCompile(`^apiserver_requested_deprecated_apis\{([^}]*)\}`)
+	metricLabel          = regexp.MustCompile(`(\w+)="([^"]*)"`)
+)
+
+// Finding is a use of an API removed in the version a cluster is upgraded to
+type Finding struct {
+	APIVersion string
+	Resource   string
+	Kind       string `json:",omitempty"`
+	Namespace  string `json:",omitempty"`
+	Name       string `json:",omitempty"`
+	RemovedIn  string
+	// Replacement is the API version to migrate to, it's empty if the API was removed without one
+	Replacement string `json:",omitempty"`
+	// Source is how the use of the API was found
+	Source string
+}
+
+// Scanner finds uses of APIs removed between two Kubernetes versions
+type Scanner struct {
+	Metadata metadata.Interface
+	// Metrics returns the metrics of the API server in the Prometheus text format, the metrics aren't
+	// checked if it's nil
+	Metrics func(ctx context.Context) ([]byte, error)
+}
+
+// Scan returns the objects whose last applied configuration uses an API removed after currentVersion
+// up to and including targetVersion, and the removed APIs the API server reports as requested
+func (s *Scanner) Scan(ctx context.Context, currentVersion, targetVersion string) ([]Finding, error) {
+	var findings []Finding
+	for _, api := range RemovedAPIs {
+		removedInRange, err := isRemovedBetween(api, currentVersion, targetVersion)
+		if err != nil {
+			return nil, err
+		}
+		if !removedInRange {
+			continue
+		}
+		objectFindings, err := s.scanObjects(ctx, api)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, objectFindings...)
+	}
+
+	if s.Metrics != nil {
+		metricFindings, err := s.scanMetrics(ctx, currentVersion, targetVersion)
+		if err != nil {
+			// reading the metrics requires permissions not every user of the cluster has
+			logger.Warning("unable to check the API server metrics for requests to removed APIs: %v", err)
+		} else {
+			findings = append(findings, metricFindings...)
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].APIVersion != findings[j].APIVersion {
+			return findings[i].APIVersion < findings[j].APIVersion
+		}
+		return findings[i].Resource < findings[j].Resource
+	})
+	return findings, nil
+}
+
+func isRemovedBetween(api RemovedAPI, currentVersion, targetVersion string) (bool, error) {
+	afterCurrent, err := utils.CompareVersions(api.RemovedIn, currentVersion)
+	if err != nil {
+		return false, errors.Wrapf(err, "comparing versions %q and %q", api.RemovedIn, currentVersion)
+	}
+	upToTarget, err := utils.CompareVersions(api.RemovedIn, targetVersion)
+	if err != nil {
+		return false, errors.Wrapf(err, "comparing versions %q and %q", api.RemovedIn, targetVersion)
+	}
+	return afterCurrent > 0 && upToTarget <= 0, nil
+}
+
+func (s *Scanner) scanObjects(ctx context.Context, api RemovedAPI) ([]Finding, error) {
+	list, err := s.Metadata.Resource(api.Resource).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// the cluster doesn't serve this API
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "listing %s", api.Resource.String())
+	}
+
+	var findings []Finding
+	for _, item := range list.Items {
+		if lastAppliedAPIVersion(item.Annotations) != api.APIVersion() {
+			continue
+		}
+		findings = append(findings, Finding{
+			APIVersion:  api.APIVersion(),
+			Resource:    api.Resource.Resource,
+			Kind:        api.Kind,
+			Namespace:   item.Namespace,
+			Name:        item.Name,
+			RemovedIn:   api.RemovedIn,
+			Replacement: api.Replacement,
+			Source:      SourceLastAppliedConfiguration,
+		})
+	}
+	return findings, nil
+}
+
+func lastAppliedAPIVersion(annotations map[string]string) string {
+	lastApplied, ok := annotations[lastAppliedConfigurationAnnotation]
+	if !ok {
+		return ""
+	}
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal([]byte(lastApplied), &typeMeta); err != nil {
+		return ""
+	}
+	return typeMeta.APIVersion
+}
+
+func (s *Scanner) scanMetrics(ctx context.Context, currentVersion, targetVersion string) ([]Finding, error) {
+	metrics, err := s.Metrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	seen := map[schema.GroupVersionResource]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	for scanner.Scan() {
+		match := deprecatedAPIsMetric.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		labels := map[string]string{}
+		for _, label := range metricLabel.FindAllStringSubmatch(match[1], -1) {
+			labels[label[1]] = label[2]
+		}
+		removedIn := labels["removed_release"]
+		if removedIn == "" {
+			// deprecated but not scheduled for removal
+			continue
+		}
+		resource := schema.GroupVersionResource{Group: labels["group"], Version: labels["version"], Resource: labels["resource"]}
+		if seen[resource] {
+			continue
+		}
+		removedInRange, err := isRemovedBetween(RemovedAPI{RemovedIn: removedIn}, currentVersion, targetVersion)
+		if err != nil {
+			return nil, err
+		}
+		if !removedInRange {
+			continue
+		}
+		seen[resource] = true
+
+		finding := Finding{
+			APIVersion: resource.GroupVersion().String(),
+			Resource:   resource.Resource,
+			RemovedIn:  removedIn,
+			Source:     SourceAPIServerMetrics,
+		}
+		if api := findRemovedAPI(resource); api != nil {
+			finding.Kind = api.Kind
+			finding.Replacement = api.Replacement
+		}
+		findings = append(findings, finding)
+	}
+	return findings, errors.Wrap(scanner.Err(), "reading API server metrics")
+}
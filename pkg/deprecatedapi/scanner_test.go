@@ -0,0 +1,116 @@
+package deprecatedapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/metadata/fake"
+
+	"github.com/weaveworks/eksctl/pkg/deprecatedapi"
+)
+
+const metrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="extensions",removed_release="1.22",resource="ingresses",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="extensions",removed_release="1.22",resource="ingresses",subresource="status",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="batch",removed_release="1.25",resource="cronjobs",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="",resource="flowschemas",subresource="",version="v1beta1"} 1
+apiserver_request_total{code="200",resource="ingresses",verb="LIST"} 4
+`
+
+func newObject(apiVersion, kind, namespace, name, lastAppliedAPIVersion string) *metav1.PartialObjectMetadata {
+	obj := &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: apiVersion, Kind: kind},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	if lastAppliedAPIVersion != "" {
+		obj.Annotations = map[string]string{
+			"kubectl.kubernetes.io/last-applied-configuration": fmt.Sprintf(`{"apiVersion":%q,"kind":%q}`, lastAppliedAPIVersion, kind),
+		}
+	}
+	return obj
+}
+
+var _ = Describe("Scanner", func() {
+	var scanner *deprecatedapi.Scanner
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(metav1.AddMetaToScheme(scheme)).To(Succeed())
+		scanner = &deprecatedapi.Scanner{
+			Metadata: fake.NewSimpleMetadataClient(scheme,
+				newObject("extensions/v1beta1", "Ingress", "default", "legacy", "extensions/v1beta1"),
+				newObject("networking.k8s.io/v1beta1", "Ingress", "default", "migrated", "networking.k8s.io/v1"),
+				newObject("apiextensions.k8s.io/v1beta1", "CustomResourceDefinition", "", "widgets.example.com", ""),
+				newObject("batch/v1beta1", "CronJob", "jobs", "nightly", "batch/v1beta1"),
+			),
+		}
+	})
+
+	It("finds objects applied with APIs removed in the target version", func() {
+		findings, err := scanner.Scan(context.Background(), "1.21", "1.22")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(Equal([]deprecatedapi.Finding{
+			{
+				APIVersion:  "extensions/v1beta1",
+				Resource:    "ingresses",
+				Kind:        "Ingress",
+				Namespace:   "default",
+				Name:        "legacy",
+				RemovedIn:   "1.22",
+				Replacement: "networking.k8s.io/v1",
+				Source:      deprecatedapi.SourceLastAppliedConfiguration,
+			},
+		}))
+	})
+
+	It("includes APIs removed in every version up to the target version", func() {
+		findings, err := scanner.Scan(context.Background(), "1.21", "1.25")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(HaveLen(2))
+		Expect(findings[0].Name).To(Equal("nightly"))
+		Expect(findings[1].Name).To(Equal("legacy"))
+	})
+
+	It("ignores APIs the current version already doesn't serve", func() {
+		findings, err := scanner.Scan(context.Background(), "1.22", "1.23")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("reports the removed APIs the API server metrics show as requested", func() {
+		scanner.Metrics = func(context.Context) ([]byte, error) {
+			return []byte(metrics), nil
+		}
+		findings, err := scanner.Scan(context.Background(), "1.21", "1.22")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(ConsistOf(
+			HaveField("Source", deprecatedapi.SourceLastAppliedConfiguration),
+			deprecatedapi.Finding{
+				APIVersion:  "extensions/v1beta1",
+				Resource:    "ingresses",
+				Kind:        "Ingress",
+				RemovedIn:   "1.22",
+				Replacement: "networking.k8s.io/v1",
+				Source:      deprecatedapi.SourceAPIServerMetrics,
+			},
+		))
+	})
+
+	It("doesn't fail when the API server metrics can't be read", func() {
+		scanner.Metrics = func(context.Context) ([]byte, error) {
+			return nil, errors.New("forbidden")
+		}
+		findings, err := scanner.Scan(context.Background(), "1.21", "1.22")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(HaveLen(1))
+	})
+})
@@ -29,6 +29,10 @@ import (
 // retryDelay is how long is slept before retry after an error occurs during drainage
 const retryDelay = 5 * time.Second
 
+// ErrDrainBlockedByPDB is returned when a drain times out while pod evictions
+// are being rejected by a PodDisruptionBudget
+var ErrDrainBlockedByPDB = errors.New("pod evictions blocked by PodDisruptionBudget")
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 //counterfeiter:generate -o fakes/fake_evictor.go . Evictor
 type Evictor interface {
@@ -124,6 +128,9 @@ func (n *NodeGroupDrainer) Drain() error {
 		case <-ctx.Done():
 			//need to use a different context
 			waitForAllRoutinesToFinish(context.TODO(), sem, parallelLimit)
+			if errors.Is(evictErr, ErrDrainBlockedByPDB) {
+				return fmt.Errorf("timed out (after %s) waiting for nodegroup %q to be drained: %w", n.waitTimeout, n.ng.NameString(), ErrDrainBlockedByPDB)
+			}
 			return fmt.Errorf("timed out (after %s) waiting for nodegroup %q to be drained", n.waitTimeout, n.ng.NameString())
 		default:
 			if evictErr != nil {
@@ -222,9 +229,13 @@ func (n *NodeGroupDrainer) evictPods(ctx context.Context, node string) error {
 	// Loop until context times out.  We want to continually try to remove pods
 	// from the node as their eviction status changes.
 	previousReportTime := time.Now()
+	blockedByPDB := false
 	for {
 		select {
 		case <-ctx.Done():
+			if blockedByPDB {
+				return fmt.Errorf("timed out (after %s) waiting for node %q to be drained: %w", n.waitTimeout, node, ErrDrainBlockedByPDB)
+			}
 			return fmt.Errorf("timed out (after %s) waiting for node %q to be drained", n.waitTimeout, node)
 		default:
 			list, errs := n.evictor.GetPodsForEviction(node)
@@ -246,6 +257,7 @@ func (n *NodeGroupDrainer) evictPods(ctx context.Context, node string) error {
 			}
 			logger.Debug("%d pods to be evicted from %s", pods, node)
 			failedEvictions := false
+			blockedByPDB = false
 			for _, pod := range pods {
 				if err := n.evictor.EvictOrDeletePod(pod); err != nil {
 					if !isEvictionErrorRecoverable(err) {
@@ -253,6 +265,10 @@ func (n *NodeGroupDrainer) evictPods(ctx context.Context, node string) error {
 					}
 					logger.Debug("recoverable pod eviction failure: %q", err)
 					failedEvictions = true
+					// the eviction API responds with 429 when a PodDisruptionBudget would be violated
+					if apierrors.IsTooManyRequests(err) {
+						blockedByPDB = true
+					}
 				}
 			}
 			if failedEvictions {
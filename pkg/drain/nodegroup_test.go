@@ -128,6 +128,39 @@ var _ = Describe("Drain", func() {
 		})
 	})
 
+	When("the nodes never drain because evictions are blocked by a PodDisruptionBudget", func() {
+		BeforeEach(func() {
+			fakeEvictor.GetPodsForEvictionReturns(&evictor.PodDeleteList{
+				Items: []evictor.PodDelete{
+					{
+						Pod: corev1.Pod{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-1",
+							},
+						},
+						Status: evictor.PodDeleteStatus{
+							Delete: true,
+						},
+					},
+				},
+			}, nil)
+
+			fakeEvictor.EvictOrDeletePodReturns(apierrors.NewTooManyRequestsError("Cannot evict pod as it would violate the pod's disruption budget."))
+
+			_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("times out with an error identifying the PodDisruptionBudget block", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*2, time.Second*0, time.Second, time.Millisecond*100, false, false, 1)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			err := nodeGroupDrainer.Drain()
+			Expect(errors.Is(err, drain.ErrDrainBlockedByPDB)).To(BeTrue())
+			Expect(err).To(MatchError("timed out (after 2s) waiting for nodegroup \"node-1\" to be drained: pod evictions blocked by PodDisruptionBudget"))
+		})
+	})
+
 	When("Evictions are not supported", func() {
 		BeforeEach(func() {
 			fakeEvictor.CanUseEvictionsReturns(fmt.Errorf("error1"))
@@ -5,41 +5,42 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
-	"github.com/gofrs/flock"
-
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 
-	awseks "github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
-	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/yaml"
 
 	"github.com/weaveworks/eksctl/pkg/ami"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
+	"github.com/weaveworks/eksctl/pkg/audit"
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/az"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	ekscreds "github.com/weaveworks/eksctl/pkg/credentials"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/metadata"
+	"github.com/weaveworks/eksctl/pkg/metrics"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
@@ -50,6 +51,10 @@ type ClusterProvider struct {
 	Provider api.ClusterProvider
 	// informative fields, i.e. used as outputs
 	Status *ProviderStatus
+
+	kubeAccess kubeAccess
+	// ipv6Client is true when eksctl runs on an IPv6-only host and calls the dual-stack endpoints of AWS services
+	ipv6Client bool
 }
 
 //counterfeiter:generate -o fakes/fake_kube_provider.go . KubeProvider
@@ -59,21 +64,21 @@ type KubeProvider interface {
 	ServerVersion(rawClient *kubernetes.RawClient) (string, error)
 	LoadClusterIntoSpecFromStack(ctx context.Context, spec *api.ClusterConfig, stackManager manager.StackManager) error
 	ValidateClusterForCompatibility(ctx context.Context, cfg *api.ClusterConfig, stackManager manager.StackManager) error
-	UpdateAuthConfigMap(nodeGroups []*api.NodeGroup, clientSet kubernetes.Interface) error
-	WaitForNodes(clientSet kubernetes.Interface, ng KubeNodeGroup) error
+	UpdateAuthConfigMap(nodeGroups []*api.NodeGroup, clientSet kubernetes.Interface, nodesReadyTimeout time.Duration) error
+	WaitForNodes(clientSet kubernetes.Interface, ng KubeNodeGroup, timeout time.Duration) error
 }
 
 // ProviderServices stores the used APIs
 type ProviderServices struct {
 	spec *api.ProviderConfig
 	asg  awsapi.ASG
-	eks  eksiface.EKSAPI
-	cfn  cloudformationiface.CloudFormationAPI
 
 	cloudtrail     awsapi.CloudTrail
 	cloudwatchlogs awsapi.CloudWatchLogs
 	session        *session.Session
 
+	credentialsChecker *ekscreds.ExpiryChecker
+
 	*ServicesV2
 }
 
@@ -85,12 +90,14 @@ func (p ProviderServices) CloudFormationDisableRollback() bool {
 	return p.spec.CloudFormationDisableRollback
 }
 
+// CloudFormationExplainFailures returns whether the IAM actions denied to failed stacks are looked up in CloudTrail
+func (p ProviderServices) CloudFormationExplainFailures() bool {
+	return p.spec.CloudFormationExplainFailures
+}
+
 // ASG returns a representation of the AutoScaling API
 func (p ProviderServices) ASG() awsapi.ASG { return p.asg }
 
-// EKS returns a representation of the EKS API
-func (p ProviderServices) EKS() eksiface.EKSAPI { return p.eks }
-
 // CloudTrail returns a representation of the CloudTrail API
 func (p ProviderServices) CloudTrail() awsapi.CloudTrail { return p.cloudtrail }
 
@@ -108,113 +115,183 @@ func (p ProviderServices) Profile() string { return p.spec.Profile }
 // WaitTimeout returns provider-level duration after which any wait operation has to timeout
 func (p ProviderServices) WaitTimeout() time.Duration { return p.spec.WaitTimeout }
 
-func (p ProviderServices) ConfigProvider() client.ConfigProvider {
-	return p.session
+// WaiterMaxDelay returns the maximum delay between the attempts of waiters, zero uses the default of each waiter
+func (p ProviderServices) WaiterMaxDelay() time.Duration {
+	if p.spec.DryProvider == api.DryProviderLocalStack {
+		return localStackWaiterMaxDelay
+	}
+	return 0
 }
 
+// Session returns the AWS SDK v1 session. Every AWS client of eksctl uses the SDK v2, the session is only
+// kept for the instance selector (github.com/aws/amazon-ec2-instance-selector), whose selector.New takes a
+// v1 session. That is why the v1 module is still a dependency, along with the code building the session:
+// the logging retryer, the v1 endpoint resolver, the v1 credentials provider sharing the v2 credentials,
+// and the audit and metrics handlers that record the calls of the instance selector.
 func (p ProviderServices) Session() *session.Session {
 	return p.session
 }
 
 // ClusterInfo provides information about the cluster.
 type ClusterInfo struct {
-	Cluster *awseks.Cluster
+	Cluster *ekstypes.Cluster
 }
 
 // ProviderStatus stores information about the used IAM role and the resulting session
 type ProviderStatus struct {
 	iamRoleARN   string
-	sessionCreds *credentials.Credentials
+	credentials  awsv2.CredentialsProvider
 	ClusterInfo  *ClusterInfo
 }
 
 // New creates a new setup of the used AWS APIs
 func New(ctx context.Context, spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) (*ClusterProvider, error) {
+	retryConfig, err := ResolveRetryConfig(spec.Retry)
+	if err != nil {
+		return nil, err
+	}
+	spec.Retry = retryConfig
+
 	provider := &ProviderServices{
 		spec: spec,
 	}
 	c := &ClusterProvider{
-		Provider: provider,
+		Provider:   provider,
+		ipv6Client: spec.EndpointsMode == api.EndpointsModeDualStack,
+	}
+	if err := validateDryProvider(spec); err != nil {
+		return nil, err
+	}
+	endpointOverrides, err := ResolveServiceEndpoints(spec)
+	if err != nil {
+		return nil, err
 	}
 	// Create a new session and save credentials for possible
 	// later re-use if overriding sessions due to custom URL
-	s := c.newSession(spec)
-
-	cacheCredentials := os.Getenv(ekscreds.EksctlGlobalEnableCachingEnvName) != ""
-	var (
-		credentialsCacheFilePath string
-		err                      error
-	)
-	if cacheCredentials {
-		if s.Config == nil {
-			return nil, errors.New("expected Session.Config to be non-nil")
+	s := c.newSession(spec, endpointOverrides)
+
+	var cacheBackend string
+	if os.Getenv(ekscreds.EksctlGlobalEnableCachingEnvName) != "" {
+		if cacheBackend, err = ekscreds.GetCacheBackend(); err != nil {
+			return nil, err
 		}
+	}
+	var credentialsCacheFilePath string
+	if cacheBackend == ekscreds.CacheBackendFile {
 		credentialsCacheFilePath, err = ekscreds.GetCacheFilePath()
 		if err != nil {
 			return nil, fmt.Errorf("error getting cache file path: %w", err)
 		}
-		if cachedProvider, err := ekscreds.NewFileCacheProvider(spec.Profile, s.Config.Credentials, &ekscreds.RealClock{}, afero.NewOsFs(), func(path string) ekscreds.Flock {
-			return flock.New(path)
-		}, credentialsCacheFilePath); err == nil {
-			s.Config.Credentials = credentials.NewCredentials(&cachedProvider)
-		} else {
-			logger.Warning("Failed to use cached provider: ", err)
-		}
 	}
 
 	provider.session = s
-	provider.cfn = cloudformation.New(s)
-	provider.eks = awseks.New(s)
 
-	cfg, err := newV2Config(spec, c.Provider.Region(), credentialsCacheFilePath)
+	if err := api.ValidateEndpointsMode(spec.EndpointsMode, c.Provider.Region()); err != nil {
+		return nil, err
+	}
+
+	cfg, err := newV2Config(spec, c.Provider.Region(), endpointOverrides, cacheBackend, credentialsCacheFilePath)
 	if err != nil {
 		return nil, err
 	}
+	// share the credentials, and the roles assumed with MFA, with the clients of the AWS SDK v1
+	s.Config.Credentials = credentials.NewCredentials(&v1CredentialsProvider{provider: cfg.Credentials})
+
+	if spec.AuditFile != "" {
+		auditLogger, err := audit.Open(spec.AuditFile)
+		if err != nil {
+			return nil, err
+		}
+		if spec.AuditLogGroup != "" {
+			auditLogger.SendToCloudWatchLogs(cloudwatchlogs.NewFromConfig(cfg), spec.AuditLogGroup)
+		}
+		auditLogger.AddV1Handlers(&s.Handlers)
+		cfg.APIOptions = append(cfg.APIOptions[:len(cfg.APIOptions):len(cfg.APIOptions)], auditLogger.AddMiddleware)
+	} else if spec.AuditLogGroup != "" {
+		return nil, errors.New("--audit-log-group requires --audit-file")
+	}
+
+	if metrics.Enabled() {
+		metrics.AddV1Handlers(&s.Handlers)
+		cfg.APIOptions = append(cfg.APIOptions[:len(cfg.APIOptions):len(cfg.APIOptions)], metrics.AddMiddleware)
+	}
 
 	provider.ServicesV2 = &ServicesV2{
-		config: cfg,
+		config:      cfg,
+		retryConfig: spec.Retry,
+	}
+	if spec.MetadataBundle != "" {
+		bundle, err := metadata.Load(spec.MetadataBundle, c.Provider.Region())
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("looking up AMIs, instance types and addon versions in metadata bundle %s, exported on %s", spec.MetadataBundle, bundle.GeneratedAt.Format(time.RFC3339))
+		provider.ServicesV2.metadataBundle = bundle
+	}
+	provider.credentialsChecker = &ekscreds.ExpiryChecker{
+		Provider: cfg.Credentials,
+		Clock:    &ekscreds.RealClock{},
+		FailFast: spec.FailOnCredentialsExpiry,
+		Prompt:   promptForReauthentication(spec.Profile),
 	}
 
 	c.Status = &ProviderStatus{
-		sessionCreds: s.Config.Credentials,
+		credentials: cfg.Credentials,
 	}
 
 	provider.asg = autoscaling.NewFromConfig(cfg)
 	provider.cloudwatchlogs = cloudwatchlogs.NewFromConfig(cfg)
 	provider.cloudtrail = cloudtrail.NewFromConfig(cfg)
 
-	// override sessions if any custom endpoints specified
-	if endpoint, ok := os.LookupEnv("AWS_CLOUDFORMATION_ENDPOINT"); ok {
-		logger.Debug("Setting CloudFormation endpoint to %s", endpoint)
-		provider.cfn = cloudformation.New(s, s.Config.Copy().WithEndpoint(endpoint))
-	}
-	if endpoint, ok := os.LookupEnv("AWS_EKS_ENDPOINT"); ok {
-		logger.Debug("Setting EKS endpoint to %s", endpoint)
-		provider.eks = awseks.New(s, s.Config.Copy().WithEndpoint(endpoint))
-	}
-
-	if endpoint, ok := os.LookupEnv("AWS_CLOUDTRAIL_ENDPOINT"); ok {
-		logger.Debug("Setting CloudTrail endpoint to %s", endpoint)
-		provider.cloudtrail = cloudtrail.NewFromConfig(cfg, func(o *cloudtrail.Options) {
-			o.EndpointResolver = cloudtrail.EndpointResolverFromURL(endpoint)
-		})
-	}
-
 	if clusterSpec != nil {
 		clusterSpec.Metadata.Region = c.Provider.Region()
 	}
 
-	return c, c.checkAuth(ctx)
+	if err := c.checkAuth(ctx); err != nil {
+		if region := c.Provider.Region(); api.IsOptInRegion(region) {
+			// requests to an opt-in region that isn't enabled fail with an invalid token error
+			return c, fmt.Errorf("%w; region %q is an opt-in region, make sure it's enabled for the account, see %s", err, region, optInRegionsDocURL)
+		}
+		return c, err
+	}
+	if spec.DryProvider != "" {
+		// LocalStack doesn't know about opt-in regions
+		return c, nil
+	}
+	return c, c.CheckRegionEnabled(ctx)
 }
 
 // ParseConfig parses data into a ClusterConfig
 func ParseConfig(data []byte) (*api.ClusterConfig, error) {
+	original := data
+	data, err := expandYAMLAliases(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, err
+	}
+	var strictObj interface{} = &api.ClusterConfig{}
+	if typeMeta.APIVersion == v1alpha6.SchemeGroupVersion.String() {
+		if err := v1alpha6.Register(); err != nil {
+			return nil, err
+		}
+		strictObj = &v1alpha6.ClusterConfig{}
+	}
+
+	// unknown fields are looked up in the original data, whose line numbers are kept
+	if err := checkUnknownFields(original, strictObj); err != nil {
+		return nil, err
+	}
+
 	// strict mode is not available in runtime.Decode, so we use the parser
 	// directly; we don't store the resulting object, this is just the means
 	// of detecting any unknown keys
 	// NOTE: we must use sigs.k8s.io/yaml, as it behaves differently from
 	// github.com/ghodss/yaml, which didn't handle nested structs well
-	if err := yaml.UnmarshalStrict(data, &api.ClusterConfig{}); err != nil {
+	if err := yaml.UnmarshalStrict(data, strictObj); err != nil {
 		return nil, err
 	}
 
@@ -223,11 +300,15 @@ func ParseConfig(data []byte) (*api.ClusterConfig, error) {
 		return nil, err
 	}
 
-	cfg, ok := obj.(*api.ClusterConfig)
-	if !ok {
+	switch cfg := obj.(type) {
+	case *api.ClusterConfig:
+		return cfg, nil
+	case *v1alpha6.ClusterConfig:
+		// eksctl acts on v1alpha5 ClusterConfigs
+		return v1alpha6.ConvertToV1alpha5(cfg), nil
+	default:
 		return nil, fmt.Errorf("expected to decode object of type %T; got %T", &api.ClusterConfig{}, cfg)
 	}
-	return cfg, nil
 }
 
 // LoadConfigFromFile loads ClusterConfig from configFile
@@ -240,6 +321,16 @@ func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "loading config file %q", configFile)
 	}
+	baseDir := filepath.Dir(configFile)
+	if configFile == "-" {
+		baseDir = "."
+	}
+	if err := RenderBootstrapCommandTemplates(clusterConfig); err != nil {
+		return nil, errors.Wrapf(err, "loading config file %q", configFile)
+	}
+	if err := ExpandPreBootstrapCommandRefs(clusterConfig, baseDir); err != nil {
+		return nil, errors.Wrapf(err, "loading config file %q", configFile)
+	}
 	return clusterConfig, nil
 
 }
@@ -262,8 +353,8 @@ func (c *ClusterProvider) IsSupportedRegion() bool {
 }
 
 // GetCredentialsEnv returns the AWS credentials for env usage
-func (c *ClusterProvider) GetCredentialsEnv() ([]string, error) {
-	creds, err := c.Status.sessionCreds.Get()
+func (c *ClusterProvider) GetCredentialsEnv(ctx context.Context) ([]string, error) {
+	creds, err := c.Status.credentials.Retrieve(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "getting effective credentials")
 	}
@@ -292,6 +383,9 @@ func (c *ClusterProvider) checkAuth(ctx context.Context) error {
 func ResolveAMI(ctx context.Context, provider api.ClusterProvider, version string, np api.NodePool) error {
 	var resolver ami.Resolver
 	ng := np.BaseNodeGroup()
+	if api.IsImageBuilderAMI(ng.AMI) {
+		return resolveImageBuilderAMI(ctx, provider, np)
+	}
 	switch ng.AMI {
 	case api.NodeImageResolverAuto:
 		resolver = ami.NewAutoResolver(provider.EC2())
@@ -318,6 +412,25 @@ func ResolveAMI(ctx context.Context, provider api.ClusterProvider, version strin
 	return nil
 }
 
+// resolveImageBuilderAMI sets the node AMI to the latest image built by an EC2 Image Builder pipeline, and tags
+// the nodegroup with the image build version
+func resolveImageBuilderAMI(ctx context.Context, provider api.ClusterProvider, np api.NodePool) error {
+	ng := np.BaseNodeGroup()
+	pipelineARN := strings.TrimPrefix(ng.AMI, api.NodeImageResolverImageBuilderPrefix)
+	resolver := ami.NewImageBuilderResolver(provider.ImageBuilder(), provider.EC2())
+	image, err := resolver.Resolve(ctx, provider.Region(), pipelineARN, api.SelectInstanceType(np), ng.AMIFamily)
+	if err != nil {
+		return errors.Wrap(err, "unable to determine AMI to use")
+	}
+	logger.Info("resolved AMI %q for nodegroup %q from image build version %q", image.ID, ng.Name, image.BuildVersionARN)
+	ng.AMI = image.ID
+	if ng.Tags == nil {
+		ng.Tags = map[string]string{}
+	}
+	ng.Tags[api.ImageBuildVersionTag] = image.BuildVersionARN
+	return nil
+}
+
 // SetAvailabilityZones sets the given (or chooses) the availability zones
 func SetAvailabilityZones(ctx context.Context, spec *api.ClusterConfig, given []string, ec2API awsapi.EC2, region string) error {
 	if count := len(given); count != 0 {
@@ -347,7 +460,7 @@ func SetAvailabilityZones(ctx context.Context, spec *api.ClusterConfig, given []
 	return nil
 }
 
-func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session {
+func (c *ClusterProvider) newSession(spec *api.ProviderConfig, endpointOverrides map[string]string) *session.Session {
 	// we might want to use bits from kops, although right now it seems like too many things we
 	// don't want yet
 	// https://github.com/kubernetes/kops/blob/master/upup/pkg/fi/cloudup/awsup/aws_cloud.go#L179
@@ -357,7 +470,19 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		config = config.WithRegion(c.Provider.Region()).WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint)
 	}
 
-	config = request.WithRetryer(config, newLoggingRetryer())
+	config = request.WithRetryer(config, newLoggingRetryer(numMaxRetriesV1(spec.Retry, 0)))
+	switch spec.EndpointsMode {
+	case api.EndpointsModeFIPS:
+		config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+	case api.EndpointsModeDualStack:
+		config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+	}
+	if resolver := makeEndpointResolverV1(endpointOverrides); resolver != nil {
+		config.EndpointResolver = resolver
+	}
+	if spec.DryProvider == api.DryProviderLocalStack {
+		config = config.WithCredentials(credentials.NewStaticCredentials(localStackAccessKeyID, localStackSecretAccessKey, ""))
+	}
 	if logger.Level >= api.AWSDebugLevel {
 		config = config.WithLogLevel(aws.LogDebug |
 			aws.LogDebugWithHTTPBody |
@@ -374,7 +499,7 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		Config:                  *config,
 		SharedConfigState:       session.SharedConfigEnable,
 		Profile:                 spec.Profile,
-		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+		AssumeRoleTokenProvider: mfaTokenProvider(spec.Profile),
 	}
 
 	stscreds.DefaultDuration = 30 * time.Minute
@@ -395,7 +520,7 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 			// if session config doesn't have region set, make recursive call forcing default region
 			logger.Debug("no region specified in flags or config, setting to %s", api.DefaultRegion)
 			spec.Region = api.DefaultRegion
-			return c.newSession(spec)
+			return c.newSession(spec, endpointOverrides)
 		}
 	}
 
@@ -52,19 +52,48 @@ var _ = Describe("eksctl API", func() {
 		It("should reject unknown field in a YAML config", func() {
 			_, err := LoadConfigFromFile("testdata/bad-field-1.yaml")
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/bad-field-1.yaml": error unmarshaling JSON: while decoding JSON: json: unknown field "zone"`))
+			Expect(err.Error()).To(Equal(`loading config file "testdata/bad-field-1.yaml": line 7, column 3: unknown field "zone" in metadata`))
 		})
 
 		It("should reject unknown field in a YAML config", func() {
 			_, err := LoadConfigFromFile("testdata/bad-field-2.yaml")
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/bad-field-2.yaml": error unmarshaling JSON: while decoding JSON: json: unknown field "bar"`))
+			Expect(err.Error()).To(Equal(`loading config file "testdata/bad-field-2.yaml": 2 unknown fields:
+line 16, column 10: unknown field "foo" in nodeGroups[0].iam.withAddonPolicies
+line 17, column 10: unknown field "bar" in nodeGroups[0].iam.withAddonPolicies`))
 		})
 
 		It("should reject unknown field in a JSON config", func() {
 			_, err := LoadConfigFromFile("testdata/bad-field-1.json")
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/bad-field-1.json": error unmarshaling JSON: while decoding JSON: json: unknown field "nodes"`))
+			Expect(err.Error()).To(Equal(`loading config file "testdata/bad-field-1.json": line 9, column 51: unknown field "nodes" in nodeGroups[0]`))
+		})
+
+		It("should convert a v1alpha6 config to v1alpha5", func() {
+			cfg, err := LoadConfigFromFile("testdata/v1alpha6.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.APIVersion).To(Equal(api.SchemeGroupVersion.String()))
+			Expect(cfg.NodeGroups).To(HaveLen(1))
+			Expect(cfg.NodeGroups[0].Name).To(Equal("ng-1"))
+			Expect(cfg.ManagedNodeGroups[0].Name).To(Equal("mng-1"))
+			Expect(cfg.Timeouts.StackCreation).To(Equal("40m0s"))
+		})
+
+		It("should reject v1alpha5 fields in a v1alpha6 config", func() {
+			_, err := ParseConfig([]byte("apiVersion: eksctl.io/v1alpha6\nkind: ClusterConfig\nmetadata: {name: cluster-1}\nnodeGroups: [{name: ng-1}]\n"))
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "nodeGroups"`)))
+		})
+
+		It("should suggest the closest field for a misspelled one", func() {
+			_, err := LoadConfigFromFile("testdata/bad-field-3.yaml")
+			Expect(err).To(MatchError(`loading config file "testdata/bad-field-3.yaml": line 10, column 5: unknown field "instanceTypes" in nodeGroups[0], did you mean "instanceType"?`))
+		})
+
+		It("should reject unknown fields of subnets and identity providers", func() {
+			_, err := LoadConfigFromFile("testdata/bad-field-4.yaml")
+			Expect(err).To(MatchError(`loading config file "testdata/bad-field-4.yaml": 2 unknown fields:
+line 12, column 9: unknown field "cdir" in vpc.subnets.private.us-west-2a, did you mean "cidr"?
+line 16, column 5: unknown field "issuerUrl2" in identityProviders[0], did you mean "issuerURL"?`))
 		})
 
 		It("should reject old API version", func() {
@@ -73,6 +102,57 @@ var _ = Describe("eksctl API", func() {
 			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/old-version.json": no kind "ClusterConfig" is registered for version "eksctl.io/v1alpha3" in scheme`))
 		})
 
+		It("should expand preBootstrapCommandRefs", func() {
+			cfg, err := LoadConfigFromFile("testdata/prebootstrap-snippets.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.NodeGroups[0].PreBootstrapCommands).To(Equal([]string{
+				"echo 'HTTPS_PROXY=http://proxy.us-west-2.example.com' >> /etc/environment",
+				"echo 'cluster-1-ng-1' > /etc/node-group",
+				"echo done",
+			}))
+			Expect(cfg.NodeGroups[0].PreBootstrapCommandRefs).To(BeNil())
+			Expect(cfg.ManagedNodeGroups[0].PreBootstrapCommands).To(Equal([]string{
+				"echo 'cluster-1-mng-1' > /etc/node-group",
+			}))
+		})
+
+		It("should render bootstrap command templates", func() {
+			cfg, err := LoadConfigFromFile("testdata/bootstrap-command-templates.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.NodeGroups[0].PreBootstrapCommands).To(Equal([]string{
+				"echo 'cluster-1-ng-1' > /etc/node-group",
+				"echo 'cmVnaW9uPXVzLXdlc3QtMg==' | base64 -d > /etc/region",
+				`echo '{"team":"platform"}' > /etc/labels.json`,
+				"docker inspect --format '{{.Id}}' pause",
+			}))
+			Expect(*cfg.ManagedNodeGroups[0].OverrideBootstrapCommand).To(Equal("#!/bin/bash\n/etc/eks/bootstrap.sh cluster-1 --apiserver-endpoint-region us-west-2\n"))
+			Expect(cfg.NodeGroupDefaults.BootstrapCommandTemplates).To(BeNil())
+		})
+
+		It("should reject undefined preBootstrapCommandRefs", func() {
+			_, err := LoadConfigFromFile("testdata/prebootstrap-snippets-undefined.yaml")
+			Expect(err).To(MatchError(`loading config file "testdata/prebootstrap-snippets-undefined.yaml": nodegroup "mng-1" references undefined preBootstrapCommand snippet "proxy"`))
+		})
+
+		It("should expand YAML anchors, aliases and merge keys", func() {
+			cfg, err := LoadConfigFromFile("testdata/anchors.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.NodeGroups).To(HaveLen(2))
+			Expect(cfg.NodeGroups[0].Name).To(Equal("ng-1"))
+			Expect(cfg.NodeGroups[0].InstanceType).To(Equal("m5.large"))
+			Expect(*cfg.NodeGroups[0].DesiredCapacity).To(Equal(2))
+			Expect(cfg.NodeGroups[0].Labels).To(Equal(map[string]string{"team": "platform"}))
+			Expect(cfg.NodeGroups[1].Name).To(Equal("ng-2"))
+			Expect(cfg.NodeGroups[1].InstanceType).To(Equal("m5.xlarge"))
+			Expect(cfg.ManagedNodeGroups[0].Labels).To(Equal(map[string]string{"team": "platform"}))
+		})
+
+		It("should reject unknown fields merged from an anchor", func() {
+			_, err := LoadConfigFromFile("testdata/anchors-bad-field.yaml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`unknown field "instanceTypes"`))
+		})
+
 		It("should error when cannot read a file", func() {
 			_, err := LoadConfigFromFile("../../examples/nothing.xml")
 			Expect(err).To(HaveOccurred())
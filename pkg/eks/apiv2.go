@@ -2,21 +2,15 @@ package eks
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	middlewarev2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go-v2/config"
+	credentialsv2 "github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
-	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
-	"github.com/aws/aws-sdk-go-v2/service/eks"
-	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
-	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
-	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go/middleware"
 	"github.com/gofrs/flock"
@@ -28,7 +22,10 @@ import (
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
-func newV2Config(pc *api.ProviderConfig, region string, credentialsCacheFilePath string) (aws.Config, error) {
+// mfaSessionTokenDuration is how long session credentials obtained with MFA are valid for
+const mfaSessionTokenDuration = 12 * time.Hour
+
+func newV2Config(pc *api.ProviderConfig, region string, endpointOverrides map[string]string, credentialsCacheBackend, credentialsCacheFilePath string) (aws.Config, error) {
 	var options []func(options *config.LoadOptions) error
 
 	// TODO default region
@@ -42,18 +39,30 @@ func newV2Config(pc *api.ProviderConfig, region string, credentialsCacheFilePath
 	}
 	options = append(options, config.WithClientLogMode(clientLogMode))
 
-	if endpointResolver := makeEndpointResolverFunc(); endpointResolver != nil {
+	switch pc.EndpointsMode {
+	case api.EndpointsModeFIPS:
+		options = append(options, config.WithUseFIPSEndpoint(aws.FIPSEndpointStateEnabled))
+	case api.EndpointsModeDualStack:
+		options = append(options, config.WithUseDualStackEndpoint(aws.DualStackEndpointStateEnabled))
+	}
+
+	if endpointResolver := makeEndpointResolverFunc(endpointOverrides); endpointResolver != nil {
 		options = append(options, config.WithEndpointResolverWithOptions(endpointResolver))
 	}
+	if pc.DryProvider == api.DryProviderLocalStack {
+		options = append(options, config.WithCredentialsProvider(credentialsv2.NewStaticCredentialsProvider(localStackAccessKeyID, localStackSecretAccessKey, "")))
+	}
 
 	cfg, err := config.LoadDefaultConfig(context.TODO(), append(options,
 		config.WithSharedConfigProfile(pc.Profile),
 		config.WithRetryer(func() aws.Retryer {
-			return NewRetryerV2()
+			return newRetryerV2(pc.Retry, maxAttempts(pc.Retry, 0))
 		}),
 		config.WithAssumeRoleCredentialOptions(func(o *stscreds.AssumeRoleOptions) {
-			o.TokenProvider = stscreds.StdinTokenProvider
+			o.TokenProvider = mfaTokenProvider(pc.Profile)
 			o.Duration = 30 * time.Minute
+			o.Tags = makeSessionTags(pc.SessionTags)
+			o.SourceIdentity = sourceIdentity(pc.SourceIdentity)
 		}),
 		config.WithAPIOptions([]func(stack *middleware.Stack) error{
 			middlewarev2.AddUserAgentKeyValue("eksctl", version.String()),
@@ -63,55 +72,59 @@ func newV2Config(pc *api.ProviderConfig, region string, credentialsCacheFilePath
 	if err != nil {
 		return cfg, err
 	}
-	if credentialsCacheFilePath != "" {
+	if pc.DryProvider == "" {
+		if err := useMFASessionToken(&cfg, pc.Profile); err != nil {
+			return cfg, err
+		}
+		if err := useRoleCredentials(&cfg, pc); err != nil {
+			return cfg, err
+		}
+	}
+	switch credentialsCacheBackend {
+	case credentials.CacheBackendFile:
 		// TODO: extract the underlying CredentialsProvider from cfg.Credentials and use it.
-		fileCache, err := credentials.NewFileCacheV2(cfg.Credentials, pc.Profile, afero.NewOsFs(), func(path string) credentials.Flock {
+		fileCache, err := credentials.NewFileCacheV2(cfg.Credentials, credentialsCacheKey(pc), afero.NewOsFs(), func(path string) credentials.Flock {
 			return flock.New(path)
 		}, &credentials.RealClock{}, credentialsCacheFilePath)
 		if err != nil {
 			return cfg, fmt.Errorf("error creating credentials cache: %w", err)
 		}
 		cfg.Credentials = aws.NewCredentialsCache(fileCache)
+	case credentials.CacheBackendKeyring:
+		cfg.Credentials = aws.NewCredentialsCache(credentials.NewKeyringCacheV2(cfg.Credentials, credentialsCacheKey(pc), credentials.NewOSKeyring(), &credentials.RealClock{}))
 	}
+	// with CacheBackendMemory, credentials are only cached by cfg.Credentials for the duration of the command
 	return cfg, nil
 }
 
-func makeEndpointResolverFunc() aws.EndpointResolverWithOptionsFunc {
-	serviceIDEnvMap := map[string]string{
-		cloudformation.ServiceID:         "AWS_CLOUDFORMATION_ENDPOINT",
-		eks.ServiceID:                    "AWS_EKS_ENDPOINT",
-		ec2.ServiceID:                    "AWS_EC2_ENDPOINT",
-		elasticloadbalancing.ServiceID:   "AWS_ELB_ENDPOINT",
-		elasticloadbalancingv2.ServiceID: "AWS_ELBV2_ENDPOINT",
-		sts.ServiceID:                    "AWS_STS_ENDPOINT",
-		iam.ServiceID:                    "AWS_IAM_ENDPOINT",
-		cloudtrail.ServiceID:             "AWS_CLOUDTRAIL_ENDPOINT",
-	}
-
-	hasCustomEndpoint := false
-	for service, envName := range serviceIDEnvMap {
-		if endpoint, ok := os.LookupEnv(envName); ok {
-			logger.Debug(
-				"Setting %s endpoint to %s", service, endpoint)
-			hasCustomEndpoint = true
-		}
+// useMFASessionToken makes cfg use temporary session credentials obtained with MFA if the profile sets mfa_serial
+// without a role_arn; profiles that assume a role, including chained roles, get the MFA token code prompted for by
+// the assume role provider
+func useMFASessionToken(cfg *aws.Config, profile string) error {
+	envConfig, err := config.NewEnvConfig()
+	if err != nil {
+		return err
 	}
-
-	if !hasCustomEndpoint {
+	if envConfig.Credentials.HasKeys() {
+		// static credentials in the environment take precedence over the profile
 		return nil
 	}
-
-	return func(service, region string, options ...interface{}) (aws.Endpoint, error) {
-		if envName, ok := serviceIDEnvMap[service]; ok {
-			if ok {
-				if endpoint, ok := os.LookupEnv(envName); ok {
-					return aws.Endpoint{
-						URL:           endpoint,
-						SigningRegion: region,
-					}, nil
-				}
-			}
+	sharedConfig, err := config.LoadSharedConfigProfile(context.TODO(), profileOrDefault(profile))
+	if err != nil {
+		var notExistErr config.SharedConfigProfileNotExistError
+		if errors.As(err, &notExistErr) {
+			return nil
 		}
-		return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+		return fmt.Errorf("loading shared config profile: %w", err)
+	}
+	if sharedConfig.MFASerial == "" || sharedConfig.RoleARN != "" {
+		return nil
 	}
+	cfg.Credentials = aws.NewCredentialsCache(&mfaSessionTokenProvider{
+		stsAPI:        sts.NewFromConfig(*cfg),
+		serialNumber:  sharedConfig.MFASerial,
+		tokenProvider: mfaTokenProvider(profile),
+		duration:      mfaSessionTokenDuration,
+	})
+	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -20,6 +21,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/credentials"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/telemetry"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 )
 
@@ -33,6 +35,9 @@ type Client struct {
 
 // NewClient creates a new client config by embedding the STS token
 func (c *ClusterProvider) NewClient(spec *api.ClusterConfig) (*Client, error) {
+	if !c.HasKubeAccess() {
+		return nil, ErrNoKubeAccess
+	}
 	config := kubeconfig.NewForUser(spec, c.GetUsername())
 	generator := NewGenerator(c.Provider.STSPresigner(), &credentials.RealClock{})
 	client := &Client{
@@ -64,6 +69,7 @@ func (c *Client) new(spec *api.ClusterConfig) (*Client, error) {
 	c.rawConfig = rawConfig
 	c.rawConfig.QPS = float32(25)
 	c.rawConfig.Burst = int(c.rawConfig.QPS) * 2
+	c.rawConfig.Wrap(telemetry.WrapTransport)
 
 	return c, nil
 }
@@ -87,6 +93,15 @@ func (c *Client) NewClientSet() (*kubernetes.Clientset, error) {
 	return client, nil
 }
 
+// NewMetadataClient creates a new API client that only reads and writes the metadata of objects
+func (c *Client) NewMetadataClient() (metadata.Interface, error) {
+	client, err := metadata.NewForConfig(c.rawConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create metadata API client")
+	}
+	return client, nil
+}
+
 // NewStdClientSet creates a new API client in one go with an embedded STS token, this is most commonly used option
 func (c *ClusterProvider) NewStdClientSet(spec *api.ClusterConfig) (*kubernetes.Clientset, error) {
 	_, clientSet, err := c.newClientSetWithEmbeddedToken(spec)
@@ -127,7 +142,7 @@ func (c *ClusterProvider) ServerVersion(rawClient *kubewrapper.RawClient) (strin
 }
 
 // UpdateAuthConfigMap creates or adds a nodegroup IAM role in the auth ConfigMap for the given nodegroup.
-func (c *ClusterProvider) UpdateAuthConfigMap(nodeGroups []*api.NodeGroup, clientSet kubernetes.Interface) error {
+func (c *ClusterProvider) UpdateAuthConfigMap(nodeGroups []*api.NodeGroup, clientSet kubernetes.Interface, nodesReadyTimeout time.Duration) error {
 	for _, ng := range nodeGroups {
 		// authorise nodes to join
 		if err := authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
@@ -135,7 +150,7 @@ func (c *ClusterProvider) UpdateAuthConfigMap(nodeGroups []*api.NodeGroup, clien
 		}
 
 		// wait for nodes to join
-		if err := c.WaitForNodes(clientSet, ng); err != nil {
+		if err := c.WaitForNodes(clientSet, ng, nodesReadyTimeout); err != nil {
 			return err
 		}
 	}
@@ -143,12 +158,12 @@ func (c *ClusterProvider) UpdateAuthConfigMap(nodeGroups []*api.NodeGroup, clien
 }
 
 // WaitForNodes waits till the nodes are ready
-func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng KubeNodeGroup) error {
+func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng KubeNodeGroup, timeout time.Duration) error {
 	minSize := ng.Size()
 	if minSize == 0 {
 		return nil
 	}
-	timeout := time.After(c.Provider.WaitTimeout())
+	timeoutAfter := time.After(timeout)
 	readyNodes := sets.NewString()
 	watcher, err := clientSet.CoreV1().Nodes().Watch(context.TODO(), ng.ListOptions())
 	if err != nil {
@@ -182,8 +197,8 @@ func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng KubeNo
 					}
 				}
 			}
-		case <-timeout:
-			return fmt.Errorf("timed out (after %s) waiting for at least %d nodes to join the cluster and become ready in %q", c.Provider.WaitTimeout(), minSize, ng.NameString())
+		case <-timeoutAfter:
+			return fmt.Errorf("timed out (after %s) waiting for at least %d nodes to join the cluster and become ready in %q", timeout, minSize, ng.NameString())
 		}
 
 		if counter >= minSize {
@@ -0,0 +1,177 @@
+package eks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// defaultKeyPolicyName is the only policy name KMS supports
+const defaultKeyPolicyName = "default"
+
+// ConfigureClusterLogGroup applies the KMS key and retention of cloudWatch.clusterLogging to the log group of
+// the control plane logs, creating the log group if EKS hasn't yet
+func (c *ClusterProvider) ConfigureClusterLogGroup(ctx context.Context, cfg *api.ClusterConfig) error {
+	return ConfigureClusterLogGroup(ctx, cfg, c.Provider.CloudWatchLogs(), c.Provider.KMS())
+}
+
+// ConfigureClusterLogGroup applies the KMS key and retention of cloudWatch.clusterLogging to the log group of
+// the control plane logs. The key policy is extended to allow the CloudWatch Logs service principal to use the
+// key for this log group, which is required for the association to succeed. cfg.Status must be populated.
+func ConfigureClusterLogGroup(ctx context.Context, cfg *api.ClusterConfig, logsAPI awsapi.CloudWatchLogs, kmsAPI awsapi.KMS) error {
+	if !cfg.HasClusterCloudWatchLogging() {
+		return nil
+	}
+	logging := cfg.CloudWatch.ClusterLogging
+	logGroupName := cfg.ClusterLogGroupName()
+
+	if logging.LogGroupKMSKeyARN != "" {
+		clusterARN, err := arn.Parse(cfg.Status.ARN)
+		if err != nil {
+			return fmt.Errorf("parsing cluster ARN %q: %w", cfg.Status.ARN, err)
+		}
+		logGroupARN := arn.ARN{
+			Partition: clusterARN.Partition,
+			Service:   "logs",
+			Region:    clusterARN.Region,
+			AccountID: clusterARN.AccountID,
+			Resource:  "log-group:" + logGroupName,
+		}.String()
+		if err := ensureLogsKeyPolicy(ctx, kmsAPI, logging.LogGroupKMSKeyARN, logGroupARN, api.ServicePrincipal(clusterARN.Partition, "logs."+clusterARN.Region)); err != nil {
+			return err
+		}
+	}
+
+	if err := ensureLogGroup(ctx, logsAPI, logGroupName, logging.LogGroupKMSKeyARN); err != nil {
+		return err
+	}
+
+	if logRetentionDays := logging.LogRetentionInDays; logRetentionDays != 0 {
+		if _, err := logsAPI.PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    aws.String(logGroupName),
+			RetentionInDays: aws.Int32(int32(logRetentionDays)),
+		}); err != nil {
+			return fmt.Errorf("error updating log retention settings: %w", err)
+		}
+		logger.Info("set log retention to %d days for CloudWatch logging", logRetentionDays)
+	}
+	return nil
+}
+
+func ensureLogGroup(ctx context.Context, logsAPI awsapi.CloudWatchLogs, logGroupName, keyARN string) error {
+	input := &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+	}
+	if keyARN != "" {
+		input.KmsKeyId = aws.String(keyARN)
+	}
+	_, err := logsAPI.CreateLogGroup(ctx, input)
+	if err == nil {
+		logger.Info("created log group %q", logGroupName)
+		return nil
+	}
+	var existsErr *cwltypes.ResourceAlreadyExistsException
+	if !errors.As(err, &existsErr) {
+		return fmt.Errorf("creating log group %q: %w", logGroupName, err)
+	}
+	if keyARN == "" {
+		return nil
+	}
+	if _, err := logsAPI.AssociateKmsKey(ctx, &cloudwatchlogs.AssociateKmsKeyInput{
+		LogGroupName: aws.String(logGroupName),
+		KmsKeyId:     aws.String(keyARN),
+	}); err != nil {
+		return fmt.Errorf("associating KMS key %q with log group %q: %w", keyARN, logGroupName, err)
+	}
+	logger.Info("associated KMS key %q with log group %q, log events ingested before remain encrypted with the previous key", keyARN, logGroupName)
+	return nil
+}
+
+// ensureLogsKeyPolicy adds a statement to the key policy allowing the CloudWatch Logs service principal to
+// use the key, scoped to the log group through the encryption context
+func ensureLogsKeyPolicy(ctx context.Context, kmsAPI awsapi.KMS, keyARN, logGroupARN, logsPrincipal string) error {
+	output, err := kmsAPI.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{
+		KeyId:      aws.String(keyARN),
+		PolicyName: aws.String(defaultKeyPolicyName),
+	})
+	if err != nil {
+		return fmt.Errorf("getting policy of KMS key %q: %w", keyARN, err)
+	}
+
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.Policy)), &policy); err != nil {
+		return fmt.Errorf("parsing policy of KMS key %q: %w", keyARN, err)
+	}
+	var statements []interface{}
+	switch s := policy["Statement"].(type) {
+	case []interface{}:
+		statements = s
+	case map[string]interface{}:
+		statements = []interface{}{s}
+	}
+
+	for _, s := range statements {
+		if allowsLogGroup(s, logGroupARN) {
+			logger.Debug("policy of KMS key %q already allows %s to use it for %s", keyARN, logsPrincipal, logGroupARN)
+			return nil
+		}
+	}
+
+	policy["Statement"] = append(statements, map[string]interface{}{
+		"Effect": "Allow",
+		"Principal": map[string]interface{}{
+			"Service": logsPrincipal,
+		},
+		"Action": []string{
+			"kms:Encrypt*",
+			"kms:Decrypt*",
+			"kms:ReEncrypt*",
+			"kms:GenerateDataKey*",
+			"kms:Describe*",
+		},
+		"Resource": "*",
+		"Condition": map[string]interface{}{
+			"ArnEquals": map[string]interface{}{
+				"kms:EncryptionContext:aws:logs:arn": logGroupARN,
+			},
+		},
+	})
+	updated, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	if _, err := kmsAPI.PutKeyPolicy(ctx, &kms.PutKeyPolicyInput{
+		KeyId:      aws.String(keyARN),
+		PolicyName: aws.String(defaultKeyPolicyName),
+		Policy:     aws.String(string(updated)),
+	}); err != nil {
+		return fmt.Errorf("updating policy of KMS key %q: %w", keyARN, err)
+	}
+	logger.Info("allowed %s to use KMS key %q for %s", logsPrincipal, keyARN, logGroupARN)
+	return nil
+}
+
+// allowsLogGroup reports whether a key policy statement is the one added for the log group
+func allowsLogGroup(s interface{}, logGroupARN string) bool {
+	statement, ok := s.(map[string]interface{})
+	if !ok || statement["Effect"] != "Allow" {
+		return false
+	}
+	condition, ok := statement["Condition"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	arnEquals, ok := condition["ArnEquals"].(map[string]interface{})
+	return ok && arnEquals["kms:EncryptionContext:aws:logs:arn"] == logGroupARN
+}
@@ -0,0 +1,106 @@
+package eks_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+const (
+	logGroupKeyARN = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	logGroupARN    = "arn:aws:logs:us-west-2:123456789012:log-group:/aws/eks/my-cluster/cluster"
+)
+
+var _ = Describe("ConfigureClusterLogGroup", func() {
+	var (
+		cfg       *api.ClusterConfig
+		p         *mockprovider.MockProvider
+		keyPolicy string
+		updated   *kms.PutKeyPolicyInput
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Status = &api.ClusterStatus{ARN: "arn:aws:eks:us-west-2:123456789012:cluster/my-cluster"}
+		cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"api"}
+		p = mockprovider.NewMockProvider()
+		keyPolicy = `{"Version":"2012-10-17","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`
+		updated = nil
+		p.MockKMS().On("GetKeyPolicy", mock.Anything, &kms.GetKeyPolicyInput{
+			KeyId:      aws.String(logGroupKeyARN),
+			PolicyName: aws.String("default"),
+		}).Return(func(context.Context, *kms.GetKeyPolicyInput, ...func(*kms.Options)) *kms.GetKeyPolicyOutput {
+			return &kms.GetKeyPolicyOutput{Policy: aws.String(keyPolicy)}
+		}, nil)
+		p.MockKMS().On("PutKeyPolicy", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			updated = args.Get(1).(*kms.PutKeyPolicyInput)
+		}).Return(&kms.PutKeyPolicyOutput{}, nil)
+	})
+
+	It("creates the log group encrypted with the key and allows CloudWatch Logs to use it", func() {
+		cfg.CloudWatch.ClusterLogging.LogGroupKMSKeyARN = logGroupKeyARN
+		cfg.CloudWatch.ClusterLogging.LogRetentionInDays = 30
+		p.MockCloudWatchLogs().On("CreateLogGroup", mock.Anything, &cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: aws.String("/aws/eks/my-cluster/cluster"),
+			KmsKeyId:     aws.String(logGroupKeyARN),
+		}).Return(&cloudwatchlogs.CreateLogGroupOutput{}, nil)
+		p.MockCloudWatchLogs().On("PutRetentionPolicy", mock.Anything, &cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    aws.String("/aws/eks/my-cluster/cluster"),
+			RetentionInDays: aws.Int32(30),
+		}).Return(&cloudwatchlogs.PutRetentionPolicyOutput{}, nil)
+
+		Expect(eks.ConfigureClusterLogGroup(context.Background(), cfg, p.MockCloudWatchLogs(), p.MockKMS())).To(Succeed())
+		p.MockCloudWatchLogs().AssertExpectations(GinkgoT())
+
+		Expect(updated).NotTo(BeNil())
+		var policy struct {
+			Statement []struct {
+				Principal map[string]string
+				Condition map[string]map[string]string
+			}
+		}
+		Expect(json.Unmarshal([]byte(aws.ToString(updated.Policy)), &policy)).To(Succeed())
+		Expect(policy.Statement).To(HaveLen(2))
+		Expect(policy.Statement[1].Principal).To(HaveKeyWithValue("Service", "logs.us-west-2.amazonaws.com"))
+		Expect(policy.Statement[1].Condition["ArnEquals"]).To(HaveKeyWithValue("kms:EncryptionContext:aws:logs:arn", logGroupARN))
+	})
+
+	It("associates the key with an existing log group without changing a policy that already allows it", func() {
+		cfg.CloudWatch.ClusterLogging.LogGroupKMSKeyARN = logGroupKeyARN
+		keyPolicy = `{"Statement":{"Effect":"Allow","Principal":{"Service":"logs.us-west-2.amazonaws.com"},"Action":"kms:*","Resource":"*","Condition":{"ArnEquals":{"kms:EncryptionContext:aws:logs:arn":"` + logGroupARN + `"}}}}`
+		p.MockCloudWatchLogs().On("CreateLogGroup", mock.Anything, mock.Anything).
+			Return(nil, &cwltypes.ResourceAlreadyExistsException{})
+		p.MockCloudWatchLogs().On("AssociateKmsKey", mock.Anything, &cloudwatchlogs.AssociateKmsKeyInput{
+			LogGroupName: aws.String("/aws/eks/my-cluster/cluster"),
+			KmsKeyId:     aws.String(logGroupKeyARN),
+		}).Return(&cloudwatchlogs.AssociateKmsKeyOutput{}, nil)
+
+		Expect(eks.ConfigureClusterLogGroup(context.Background(), cfg, p.MockCloudWatchLogs(), p.MockKMS())).To(Succeed())
+		p.MockCloudWatchLogs().AssertExpectations(GinkgoT())
+		Expect(updated).To(BeNil())
+	})
+
+	It("only sets the retention of an existing log group without a key", func() {
+		cfg.CloudWatch.ClusterLogging.LogRetentionInDays = 7
+		p.MockCloudWatchLogs().On("CreateLogGroup", mock.Anything, mock.Anything).
+			Return(nil, &cwltypes.ResourceAlreadyExistsException{})
+		p.MockCloudWatchLogs().On("PutRetentionPolicy", mock.Anything, mock.Anything).
+			Return(&cloudwatchlogs.PutRetentionPolicyOutput{}, nil)
+
+		Expect(eks.ConfigureClusterLogGroup(context.Background(), cfg, p.MockCloudWatchLogs(), p.MockKMS())).To(Succeed())
+		p.MockCloudWatchLogs().AssertNotCalled(GinkgoT(), "AssociateKmsKey", mock.Anything, mock.Anything)
+		Expect(updated).To(BeNil())
+	})
+})
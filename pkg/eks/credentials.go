@@ -0,0 +1,145 @@
+package eks
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// EnsureCredentials validates that the credentials remain valid for at least lifetime, refreshing them if they
+// expire earlier; it is meant to be called before long-running operations
+func (p ProviderServices) EnsureCredentials(ctx context.Context, lifetime time.Duration) error {
+	if p.credentialsChecker == nil {
+		return nil
+	}
+	return p.credentialsChecker.Ensure(ctx, lifetime)
+}
+
+// promptForReauthentication returns a function that asks the user to re-authenticate when the
+// credentials of profile cannot be retrieved, it doesn't prompt if stdin is not a terminal
+func promptForReauthentication(profile string) func(err error) bool {
+	return func(err error) bool {
+		if !isInteractive() {
+			return false
+		}
+		loginCmd := "aws sso login"
+		if profile != "" {
+			loginCmd = fmt.Sprintf("%s --profile %s", loginCmd, profile)
+		}
+		logger.Warning("the AWS session has expired: %v", err)
+		fmt.Fprintf(os.Stderr, "re-authenticate (e.g. with `%s`) in another terminal, then press Enter to continue: ", loginCmd)
+		_, readErr := bufio.NewReader(os.Stdin).ReadString('\n')
+		return readErr == nil
+	}
+}
+
+func isInteractive() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// mfaTokenProvider returns a function that asks the user for the MFA token code of profile, it fails
+// instead of waiting for input if stdin is not a terminal
+func mfaTokenProvider(profile string) func() (string, error) {
+	return func() (string, error) {
+		if !isInteractive() {
+			return "", fmt.Errorf("profile %q requires an MFA token code, but stdin is not a terminal; "+
+				"enable the credentials cache (EKSCTL_ENABLE_CREDENTIAL_CACHE=1) and run eksctl interactively first", profileOrDefault(profile))
+		}
+		fmt.Fprintf(os.Stderr, "enter the MFA token code for profile %q: ", profileOrDefault(profile))
+		code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return "", errors.Wrap(err, "reading MFA token code")
+		}
+		return strings.TrimSpace(code), nil
+	}
+}
+
+func profileOrDefault(profile string) string {
+	if profile != "" {
+		return profile
+	}
+	if envProfile := os.Getenv("AWS_PROFILE"); envProfile != "" {
+		return envProfile
+	}
+	return "default"
+}
+
+// stsSessionTokenAPI is the subset of the STS API needed to get MFA session tokens
+type stsSessionTokenAPI interface {
+	GetSessionToken(ctx context.Context, params *sts.GetSessionTokenInput, optFns ...func(*sts.Options)) (*sts.GetSessionTokenOutput, error)
+}
+
+// mfaSessionTokenProvider exchanges the long-term credentials of a profile that sets mfa_serial without
+// assuming a role for temporary session credentials, prompting for the MFA token code
+type mfaSessionTokenProvider struct {
+	stsAPI        stsSessionTokenAPI
+	serialNumber  string
+	tokenProvider func() (string, error)
+	duration      time.Duration
+}
+
+// Retrieve implements aws.CredentialsProvider
+func (p *mfaSessionTokenProvider) Retrieve(ctx context.Context) (awsv2.Credentials, error) {
+	code, err := p.tokenProvider()
+	if err != nil {
+		return awsv2.Credentials{}, err
+	}
+	output, err := p.stsAPI.GetSessionToken(ctx, &sts.GetSessionTokenInput{
+		SerialNumber:    awsv2.String(p.serialNumber),
+		TokenCode:       awsv2.String(code),
+		DurationSeconds: awsv2.Int32(int32(p.duration / time.Second)),
+	})
+	if err != nil {
+		return awsv2.Credentials{}, errors.Wrapf(err, "getting session token with MFA device %q", p.serialNumber)
+	}
+	return awsv2.Credentials{
+		AccessKeyID:     awsv2.ToString(output.Credentials.AccessKeyId),
+		SecretAccessKey: awsv2.ToString(output.Credentials.SecretAccessKey),
+		SessionToken:    awsv2.ToString(output.Credentials.SessionToken),
+		Source:          "MFASessionTokenProvider",
+		CanExpire:       true,
+		Expires:         awsv2.ToTime(output.Credentials.Expiration),
+	}, nil
+}
+
+// v1CredentialsProvider makes the clients of the AWS SDK v1 use the credentials resolved for the AWS SDK v2,
+// so that MFA is prompted for and roles are assumed only once per invocation
+type v1CredentialsProvider struct {
+	provider awsv2.CredentialsProvider
+	current  awsv2.Credentials
+}
+
+// Retrieve implements credentials.Provider
+func (p *v1CredentialsProvider) Retrieve() (credentials.Value, error) {
+	creds, err := p.provider.Retrieve(context.TODO())
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	p.current = creds
+	return credentials.Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		ProviderName:    creds.Source,
+	}, nil
+}
+
+// IsExpired implements credentials.Provider
+func (p *v1CredentialsProvider) IsExpired() bool {
+	return p.current.AccessKeyID == "" || p.current.Expired()
+}
+
+// ExpiresAt implements credentials.Expirer
+func (p *v1CredentialsProvider) ExpiresAt() time.Time {
+	return p.current.Expires
+}
@@ -0,0 +1,98 @@
+package eks_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+type fakeSessionTokenAPI struct {
+	input *sts.GetSessionTokenInput
+	calls int
+}
+
+func (f *fakeSessionTokenAPI) GetSessionToken(_ context.Context, params *sts.GetSessionTokenInput, _ ...func(*sts.Options)) (*sts.GetSessionTokenOutput, error) {
+	f.input = params
+	f.calls++
+	return &sts.GetSessionTokenOutput{
+		Credentials: &ststypes.Credentials{
+			AccessKeyId:     awsv2.String("ASIA-session"),
+			SecretAccessKey: awsv2.String("secret"),
+			SessionToken:    awsv2.String("token"),
+			Expiration:      awsv2.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil
+}
+
+var _ = Describe("MFA credentials", func() {
+	Describe("session token provider", func() {
+		It("gets a session token with the MFA token code", func() {
+			stsAPI := &fakeSessionTokenAPI{}
+			provider := awsv2.NewCredentialsCache(eks.NewMFASessionTokenProvider(stsAPI, "arn:aws:iam::123456789012:mfa/user", func() (string, error) {
+				return "123456", nil
+			}, 12*time.Hour))
+
+			creds, err := provider.Retrieve(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(creds.AccessKeyID).To(Equal("ASIA-session"))
+			Expect(creds.SessionToken).To(Equal("token"))
+			Expect(creds.CanExpire).To(BeTrue())
+			Expect(*stsAPI.input.SerialNumber).To(Equal("arn:aws:iam::123456789012:mfa/user"))
+			Expect(*stsAPI.input.TokenCode).To(Equal("123456"))
+			Expect(*stsAPI.input.DurationSeconds).To(Equal(int32(12 * 60 * 60)))
+
+			By("reusing the session token for the rest of the invocation")
+			_, err = provider.Retrieve(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stsAPI.calls).To(Equal(1))
+		})
+
+		It("fails without calling STS if the token code cannot be read", func() {
+			stsAPI := &fakeSessionTokenAPI{}
+			provider := eks.NewMFASessionTokenProvider(stsAPI, "serial", func() (string, error) {
+				return "", errors.New("stdin is not a terminal")
+			}, time.Hour)
+
+			_, err := provider.Retrieve(context.Background())
+			Expect(err).To(MatchError("stdin is not a terminal"))
+			Expect(stsAPI.calls).To(BeZero())
+		})
+	})
+
+	Describe("AWS SDK v1 credentials", func() {
+		It("shares the credentials resolved for the AWS SDK v2", func() {
+			calls := 0
+			v1Creds := credentials.NewCredentials(eks.NewV1CredentialsProvider(awsv2.NewCredentialsCache(awsv2.CredentialsProviderFunc(func(context.Context) (awsv2.Credentials, error) {
+				calls++
+				return awsv2.Credentials{
+					AccessKeyID:     "ASIA-role",
+					SecretAccessKey: "secret",
+					SessionToken:    "token",
+					Source:          "AssumeRoleProvider",
+					CanExpire:       true,
+					Expires:         time.Now().Add(time.Hour),
+				}, nil
+			}))))
+
+			value, err := v1Creds.Get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value.AccessKeyID).To(Equal("ASIA-role"))
+			Expect(value.SessionToken).To(Equal("token"))
+			Expect(value.ProviderName).To(Equal("AssumeRoleProvider"))
+			Expect(v1Creds.IsExpired()).To(BeFalse())
+
+			_, err = v1Creds.Get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(Equal(1))
+		})
+	})
+})
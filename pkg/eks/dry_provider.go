@@ -0,0 +1,47 @@
+package eks
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// LocalStackEndpointEnvName is the environment variable that sets the endpoint of LocalStack
+	LocalStackEndpointEnvName = "LOCALSTACK_ENDPOINT"
+
+	defaultLocalStackEndpoint = "http://localhost:4566"
+
+	// LocalStack doesn't validate credentials, these are the ones its documentation uses
+	localStackAccessKeyID     = "test"
+	localStackSecretAccessKey = "test"
+
+	// LocalStack completes stacks almost immediately, so waiters don't need to wait as long as with AWS
+	localStackWaiterMaxDelay = 2 * time.Second
+)
+
+func validateDryProvider(spec *api.ProviderConfig) error {
+	switch spec.DryProvider {
+	case "":
+		return nil
+	case api.DryProviderLocalStack:
+		switch spec.EndpointsMode {
+		case api.EndpointsModeFIPS:
+			return fmt.Errorf("FIPS endpoints cannot be used with --dry-provider=%s", spec.DryProvider)
+		case api.EndpointsModeDualStack:
+			return fmt.Errorf("dual-stack endpoints cannot be used with --dry-provider=%s", spec.DryProvider)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid dry provider %q, only %q is supported", spec.DryProvider, api.DryProviderLocalStack)
+	}
+}
+
+func localStackEndpoint() string {
+	if endpoint, ok := os.LookupEnv(LocalStackEndpointEnvName); ok {
+		return endpoint
+	}
+	return defaultLocalStackEndpoint
+}
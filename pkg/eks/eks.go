@@ -7,9 +7,10 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
@@ -23,11 +24,11 @@ import (
 )
 
 // DescribeControlPlane describes the cluster control plane
-func (c *ClusterProvider) DescribeControlPlane(meta *api.ClusterMeta) (*awseks.Cluster, error) {
+func (c *ClusterProvider) DescribeControlPlane(ctx context.Context, meta *api.ClusterMeta) (*ekstypes.Cluster, error) {
 	input := &awseks.DescribeClusterInput{
 		Name: &meta.Name,
 	}
-	output, err := c.Provider.EKS().DescribeCluster(input)
+	output, err := c.Provider.EKS().DescribeCluster(ctx, input)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to describe cluster control plane")
 	}
@@ -39,8 +40,8 @@ func (c *ClusterProvider) DescribeControlPlane(meta *api.ClusterMeta) (*awseks.C
 // so that a Kubernetes client can be constructed; additionally it caches Kubernetes
 // version (use ctl.ControlPlaneVersion to retrieve it) and other properties in
 // c.Status.cachedClusterInfo
-func (c *ClusterProvider) RefreshClusterStatus(spec *api.ClusterConfig) error {
-	cluster, err := c.DescribeControlPlane(spec.Metadata)
+func (c *ClusterProvider) RefreshClusterStatus(ctx context.Context, spec *api.ClusterConfig) error {
+	cluster, err := c.DescribeControlPlane(ctx, spec.Metadata)
 	if err != nil {
 		return err
 	}
@@ -59,8 +60,8 @@ func (c *ClusterProvider) RefreshClusterStatus(spec *api.ClusterConfig) error {
 		Cluster: cluster,
 	}
 
-	switch *cluster.Status {
-	case awseks.ClusterStatusCreating, awseks.ClusterStatusDeleting, awseks.ClusterStatusFailed:
+	switch cluster.Status {
+	case ekstypes.ClusterStatusCreating, ekstypes.ClusterStatusDeleting, ekstypes.ClusterStatusFailed:
 		return nil
 	default:
 		return spec.SetClusterStatus(cluster)
@@ -68,7 +69,7 @@ func (c *ClusterProvider) RefreshClusterStatus(spec *api.ClusterConfig) error {
 }
 
 // isNonEKSCluster returns true if the cluster is external
-func isNonEKSCluster(cluster *awseks.Cluster) bool {
+func isNonEKSCluster(cluster *ekstypes.Cluster) bool {
 	return cluster.ConnectorConfig != nil
 }
 
@@ -91,9 +92,9 @@ func PlatformVersion(platformVersion string) (int, error) {
 }
 
 // RefreshClusterStatusIfStale refreshes the cluster status if enough time has passed since the last refresh
-func (c *ClusterProvider) RefreshClusterStatusIfStale(spec *api.ClusterConfig) error {
+func (c *ClusterProvider) RefreshClusterStatusIfStale(ctx context.Context, spec *api.ClusterConfig) error {
 	if c.Status.ClusterInfo == nil {
-		return c.RefreshClusterStatus(spec)
+		return c.RefreshClusterStatus(ctx, spec)
 	}
 	return nil
 }
@@ -105,8 +106,8 @@ func (c *ClusterProvider) CanOperate(spec *api.ClusterConfig) (bool, error) {
 	if c.Status.ClusterInfo == nil {
 		return false, fmt.Errorf("cluster info not available")
 	}
-	switch status := *c.Status.ClusterInfo.Cluster.Status; status {
-	case awseks.ClusterStatusCreating, awseks.ClusterStatusDeleting, awseks.ClusterStatusFailed:
+	switch status := c.Status.ClusterInfo.Cluster.Status; status {
+	case ekstypes.ClusterStatusCreating, ekstypes.ClusterStatusDeleting, ekstypes.ClusterStatusFailed:
 		return false, fmt.Errorf("cannot perform Kubernetes API operations on cluster %q in %q region due to status %q", spec.Metadata.Name, spec.Metadata.Region, status)
 	default:
 		return true, nil
@@ -114,13 +115,13 @@ func (c *ClusterProvider) CanOperate(spec *api.ClusterConfig) (bool, error) {
 }
 
 // CanOperateWithRefresh returns true when a cluster can be operated, otherwise it returns false along with an error explaining the reason
-func (c *ClusterProvider) CanOperateWithRefresh(spec *api.ClusterConfig) (bool, error) {
-	if err := c.RefreshClusterStatusIfStale(spec); err != nil {
+func (c *ClusterProvider) CanOperateWithRefresh(ctx context.Context, spec *api.ClusterConfig) (bool, error) {
+	if err := c.RefreshClusterStatusIfStale(ctx, spec); err != nil {
 		return false, errors.Wrapf(err, "unable to fetch cluster status to determine operability")
 	}
 
-	switch status := *c.Status.ClusterInfo.Cluster.Status; status {
-	case awseks.ClusterStatusCreating, awseks.ClusterStatusDeleting, awseks.ClusterStatusFailed:
+	switch status := c.Status.ClusterInfo.Cluster.Status; status {
+	case ekstypes.ClusterStatusCreating, ekstypes.ClusterStatusDeleting, ekstypes.ClusterStatusFailed:
 		return false, fmt.Errorf("cannot perform Kubernetes API operations on cluster %q in %q region due to status %q", spec.Metadata.Name, spec.Metadata.Region, status)
 	default:
 		return true, nil
@@ -132,8 +133,8 @@ func (c *ClusterProvider) CanUpdate(spec *api.ClusterConfig) (bool, error) {
 	if c.Status.ClusterInfo == nil {
 		return false, nil
 	}
-	switch status := *c.Status.ClusterInfo.Cluster.Status; status {
-	case awseks.ClusterStatusActive:
+	switch status := c.Status.ClusterInfo.Cluster.Status; status {
+	case ekstypes.ClusterStatusActive:
 		// only active cluster can be upgraded
 		return true, nil
 	default:
@@ -143,16 +144,16 @@ func (c *ClusterProvider) CanUpdate(spec *api.ClusterConfig) (bool, error) {
 
 // ControlPlaneVersion returns cached version (EKS API)
 func (c *ClusterProvider) ControlPlaneVersion() string {
-	if c.Status.ClusterInfo == nil || c.Status.ClusterInfo.Cluster == nil || c.Status.ClusterInfo.Cluster.Version == nil {
+	if c.Status == nil || c.Status.ClusterInfo == nil || c.Status.ClusterInfo.Cluster == nil || c.Status.ClusterInfo.Cluster.Version == nil {
 		return ""
 	}
 	return *c.Status.ClusterInfo.Cluster.Version
 }
 
 // ControlPlaneVPCInfo returns cached version (EKS API)
-func (c *ClusterProvider) ControlPlaneVPCInfo() awseks.VpcConfigResponse {
+func (c *ClusterProvider) ControlPlaneVPCInfo() ekstypes.VpcConfigResponse {
 	if c.Status.ClusterInfo == nil || c.Status.ClusterInfo.Cluster == nil || c.Status.ClusterInfo.Cluster.ResourcesVpcConfig == nil {
-		return awseks.VpcConfigResponse{}
+		return ekstypes.VpcConfigResponse{}
 	}
 	return *c.Status.ClusterInfo.Cluster.ResourcesVpcConfig
 }
@@ -167,8 +168,8 @@ func (u *UnsupportedOIDCError) Error() string {
 }
 
 // NewOpenIDConnectManager returns OpenIDConnectManager
-func (c *ClusterProvider) NewOpenIDConnectManager(spec *api.ClusterConfig) (*iamoidc.OpenIDConnectManager, error) {
-	if _, err := c.CanOperateWithRefresh(spec); err != nil {
+func (c *ClusterProvider) NewOpenIDConnectManager(ctx context.Context, spec *api.ClusterConfig) (*iamoidc.OpenIDConnectManager, error) {
+	if _, err := c.CanOperateWithRefresh(ctx, spec); err != nil {
 		return nil, err
 	}
 
@@ -190,7 +191,7 @@ func (c *ClusterProvider) NewOpenIDConnectManager(spec *api.ClusterConfig) (*iam
 		*c.Status.ClusterInfo.Cluster.Identity.Oidc.Issuer, parsedARN.Partition, sharedTags(c.Status.ClusterInfo.Cluster))
 }
 
-func sharedTags(cluster *awseks.Cluster) map[string]string {
+func sharedTags(cluster *ekstypes.Cluster) map[string]string {
 	return map[string]string{
 		api.ClusterNameTag:   *cluster.Name,
 		api.EksctlVersionTag: version.GetVersion(),
@@ -205,7 +206,7 @@ func (c *ClusterProvider) LoadClusterIntoSpecFromStack(ctx context.Context, spec
 	if err := c.LoadClusterVPC(ctx, spec, stackManager); err != nil {
 		return err
 	}
-	if err := c.RefreshClusterStatus(spec); err != nil {
+	if err := c.RefreshClusterStatus(ctx, spec); err != nil {
 		return err
 	}
 	return c.loadClusterKubernetesNetworkConfig(spec)
@@ -233,25 +234,27 @@ func (c *ClusterProvider) loadClusterKubernetesNetworkConfig(spec *api.ClusterCo
 	knCfg := c.Status.ClusterInfo.Cluster.KubernetesNetworkConfig
 	if knCfg != nil {
 		spec.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
-			ServiceIPv4CIDR: aws.StringValue(knCfg.ServiceIpv4Cidr),
+			IPFamily:        string(knCfg.IpFamily),
+			ServiceIPv4CIDR: aws.ToString(knCfg.ServiceIpv4Cidr),
+			ServiceIPv6CIDR: aws.ToString(knCfg.ServiceIpv6Cidr),
 		}
 	}
 	return nil
 }
 
 // GetCluster display details of an EKS cluster in your account
-func (c *ClusterProvider) GetCluster(ctx context.Context, clusterName string) (*awseks.Cluster, error) {
+func (c *ClusterProvider) GetCluster(ctx context.Context, clusterName string) (*ekstypes.Cluster, error) {
 	input := &awseks.DescribeClusterInput{
 		Name: &clusterName,
 	}
 
-	output, err := c.Provider.EKS().DescribeCluster(input)
+	output, err := c.Provider.EKS().DescribeCluster(ctx, input)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to describe control plane %q", clusterName)
 	}
 	logger.Debug("cluster = %#v", output)
 
-	if *output.Cluster.Status == awseks.ClusterStatusActive {
+	if output.Cluster.Status == ekstypes.ClusterStatusActive {
 		if logger.Level >= 4 {
 			spec := &api.ClusterConfig{Metadata: &api.ClusterMeta{Name: clusterName}}
 			stacks, err := c.NewStackManager(spec).ListStacks(ctx)
@@ -267,7 +270,7 @@ func (c *ClusterProvider) GetCluster(ctx context.Context, clusterName string) (*
 }
 
 // WaitForControlPlane waits till the control plane is ready
-func (c *ClusterProvider) WaitForControlPlane(meta *api.ClusterMeta, clientSet *kubernetes.Clientset) error {
+func (c *ClusterProvider) WaitForControlPlane(meta *api.ClusterMeta, clientSet *kubernetes.Clientset, timeout time.Duration) error {
 	successCount := 0
 	operation := func() (bool, error) {
 		_, err := clientSet.ServerVersion()
@@ -289,9 +292,9 @@ func (c *ClusterProvider) WaitForControlPlane(meta *api.ClusterMeta, clientSet *
 		},
 	}
 
-	if err := w.WaitWithTimeout(c.Provider.WaitTimeout()); err != nil {
+	if err := w.WaitWithTimeout(timeout); err != nil {
 		if err == context.DeadlineExceeded {
-			return errors.Errorf("timed out waiting for control plane %q after %s", meta.Name, c.Provider.WaitTimeout())
+			return errors.Errorf("timed out waiting for control plane %q after %s", meta.Name, timeout)
 		}
 		return err
 	}
@@ -3,10 +3,11 @@ package eks_test
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -29,7 +30,7 @@ var _ = Describe("EKS API wrapper", func() {
 		var (
 			clusterName string
 			err         error
-			cluster     *awseks.Cluster
+			cluster     *ekstypes.Cluster
 		)
 
 		When("the cluster is ready", func() {
@@ -42,10 +43,10 @@ var _ = Describe("EKS API wrapper", func() {
 					Provider: p,
 				}
 
-				p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+				p.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
 					return *input.Name == clusterName
 				})).Return(&awseks.DescribeClusterOutput{
-					Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
+					Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive),
 				}, nil)
 			})
 
@@ -63,7 +64,7 @@ var _ = Describe("EKS API wrapper", func() {
 				})
 
 				It("should return the cluster", func() {
-					Expect(cluster).To(Equal(testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive)))
+					Expect(cluster).To(Equal(testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive)))
 				})
 
 				It("should have called AWS EKS service once", func() {
@@ -107,7 +108,7 @@ var _ = Describe("EKS API wrapper", func() {
 							}
 						}
 						return matches == len(expectedStatusFilter)
-					})).Return(&cfn.ListStacksOutput{}, nil)
+					}), mock.Anything).Return(&cfn.ListStacksOutput{}, nil)
 				})
 
 				JustBeforeEach(func() {
@@ -119,7 +120,7 @@ var _ = Describe("EKS API wrapper", func() {
 				})
 
 				It("should return the cluster", func() {
-					Expect(cluster).To(Equal(testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive)))
+					Expect(cluster).To(Equal(testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive)))
 				})
 
 				It("should have called AWS EKS service once", func() {
@@ -143,10 +144,10 @@ var _ = Describe("EKS API wrapper", func() {
 					Provider: p,
 				}
 
-				p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+				p.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
 					return *input.Name == clusterName
 				})).Return(&awseks.DescribeClusterOutput{
-					Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusDeleting),
+					Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusDeleting),
 				}, nil)
 			})
 
@@ -188,24 +189,24 @@ var _ = Describe("EKS API wrapper", func() {
 			cfg = api.NewClusterConfig()
 
 			describeClusterOutput := &awseks.DescribeClusterOutput{
-				Cluster: testutils.NewFakeCluster("testcluster", awseks.ClusterStatusActive),
+				Cluster: testutils.NewFakeCluster("testcluster", ekstypes.ClusterStatusActive),
 			}
 
 			describeClusterOutput.Cluster.Version = aws.String(api.Version1_21)
 
-			describeClusterOutput.Cluster.Identity = &awseks.Identity{
-				Oidc: &awseks.OIDC{
+			describeClusterOutput.Cluster.Identity = &ekstypes.Identity{
+				Oidc: &ekstypes.OIDC{
 					Issuer: &issuer,
 				},
 			}
 
-			p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
 				return true
 			})).Return(describeClusterOutput, nil)
 		})
 
 		It("should get cluster, cache status and construct OIDC manager", func() {
-			err = ctl.RefreshClusterStatus(cfg)
+			err = ctl.RefreshClusterStatus(context.Background(), cfg)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(cfg.Status.Endpoint).To(Equal("https://localhost/"))
@@ -213,7 +214,7 @@ var _ = Describe("EKS API wrapper", func() {
 
 			Expect(ctl.ControlPlaneVersion()).To(Equal(api.Version1_21))
 
-			_, err := ctl.NewOpenIDConnectManager(cfg)
+			_, err := ctl.NewOpenIDConnectManager(context.Background(), cfg)
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
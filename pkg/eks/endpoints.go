@@ -0,0 +1,134 @@
+package eks
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// serviceEndpoint identifies a service whose endpoint can be overridden
+type serviceEndpoint struct {
+	// serviceID is the ServiceID of the SDK v2 client
+	serviceID string
+	// endpointsID is the ID the SDK v1 resolves the endpoint with, it's empty if eksctl doesn't use an SDK v1 client
+	endpointsID string
+	envName     string
+}
+
+// serviceEndpoints are the services whose endpoints can be overridden, keyed by their name in
+// metadata.serviceEndpoints
+var serviceEndpoints = map[string]serviceEndpoint{
+	"autoscaling":    {serviceID: autoscaling.ServiceID, envName: "AWS_AUTOSCALING_ENDPOINT"},
+	"cloudformation": {serviceID: cloudformation.ServiceID, envName: "AWS_CLOUDFORMATION_ENDPOINT"},
+	"cloudtrail":     {serviceID: cloudtrail.ServiceID, envName: "AWS_CLOUDTRAIL_ENDPOINT"},
+	"ec2":            {serviceID: ec2.ServiceID, endpointsID: endpoints.Ec2ServiceID, envName: "AWS_EC2_ENDPOINT"},
+	"eks":            {serviceID: eks.ServiceID, envName: "AWS_EKS_ENDPOINT"},
+	"elb":            {serviceID: elasticloadbalancing.ServiceID, envName: "AWS_ELB_ENDPOINT"},
+	"elbv2":          {serviceID: elasticloadbalancingv2.ServiceID, envName: "AWS_ELBV2_ENDPOINT"},
+	"iam":            {serviceID: iam.ServiceID, envName: "AWS_IAM_ENDPOINT"},
+	"logs":           {serviceID: cloudwatchlogs.ServiceID, envName: "AWS_LOGS_ENDPOINT"},
+	"secretsmanager": {endpointsID: endpoints.SecretsmanagerServiceID, envName: "AWS_SECRETSMANAGER_ENDPOINT"},
+	"ssm":            {serviceID: ssm.ServiceID, envName: "AWS_SSM_ENDPOINT"},
+	"sts":            {serviceID: sts.ServiceID, envName: "AWS_STS_ENDPOINT"},
+}
+
+// ResolveServiceEndpoints returns the endpoint URLs set for services in the environment, overridden by the
+// ones set in the provider config. With a dry provider, the endpoints of all other services are set to it
+func ResolveServiceEndpoints(spec *api.ProviderConfig) (map[string]string, error) {
+	resolved := map[string]string{}
+	for name, service := range serviceEndpoints {
+		if spec.DryProvider == api.DryProviderLocalStack {
+			resolved[name] = localStackEndpoint()
+		}
+		if endpoint, ok := os.LookupEnv(service.envName); ok {
+			resolved[name] = endpoint
+		}
+	}
+	for name, endpoint := range spec.ServiceEndpoints {
+		if _, ok := serviceEndpoints[name]; !ok {
+			return nil, fmt.Errorf("invalid service %q in serviceEndpoints, valid services are: %s", name, strings.Join(serviceEndpointNames(), ", "))
+		}
+		resolved[name] = endpoint
+	}
+	for name, endpoint := range resolved {
+		if u, err := url.Parse(endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid endpoint %q for service %q, expected an absolute URL", endpoint, name)
+		}
+		logger.Debug("setting %s endpoint to %s", name, endpoint)
+	}
+	return resolved, nil
+}
+
+func serviceEndpointNames() []string {
+	var names []string
+	for name := range serviceEndpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// makeEndpointResolverFunc returns an SDK v2 endpoint resolver for the overridden endpoints, or nil if there aren't any
+func makeEndpointResolverFunc(overrides map[string]string) aws.EndpointResolverWithOptionsFunc {
+	endpointsByServiceID := map[string]string{}
+	for name, endpoint := range overrides {
+		if serviceID := serviceEndpoints[name].serviceID; serviceID != "" {
+			endpointsByServiceID[serviceID] = endpoint
+		}
+	}
+	if len(endpointsByServiceID) == 0 {
+		return nil
+	}
+
+	return func(service, region string, options ...interface{}) (aws.Endpoint, error) {
+		if endpoint, ok := endpointsByServiceID[service]; ok {
+			return aws.Endpoint{
+				URL:           endpoint,
+				SigningRegion: region,
+			}, nil
+		}
+		return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+	}
+}
+
+// makeEndpointResolverV1 returns an SDK v1 endpoint resolver for the overridden endpoints, or nil if there aren't any
+func makeEndpointResolverV1(overrides map[string]string) endpoints.Resolver {
+	endpointsByID := map[string]string{}
+	for name, endpoint := range overrides {
+		if endpointsID := serviceEndpoints[name].endpointsID; endpointsID != "" {
+			endpointsByID[endpointsID] = endpoint
+		}
+	}
+	if len(endpointsByID) == 0 {
+		return nil
+	}
+
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		if endpoint, ok := endpointsByID[service]; ok {
+			return endpoints.ResolvedEndpoint{
+				URL:           endpoint,
+				SigningRegion: region,
+			}, nil
+		}
+		return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+	})
+}
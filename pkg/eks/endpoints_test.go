@@ -0,0 +1,84 @@
+package eks_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("AWS service endpoints", func() {
+	envNames := []string{"AWS_EC2_ENDPOINT", "AWS_EKS_ENDPOINT", eks.LocalStackEndpointEnvName}
+	savedEnv := map[string]string{}
+
+	BeforeEach(func() {
+		for _, name := range envNames {
+			if value, ok := os.LookupEnv(name); ok {
+				savedEnv[name] = value
+			}
+			Expect(os.Unsetenv(name)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		for _, name := range envNames {
+			Expect(os.Unsetenv(name)).To(Succeed())
+			if value, ok := savedEnv[name]; ok {
+				Expect(os.Setenv(name, value)).To(Succeed())
+			}
+		}
+	})
+
+	It("doesn't override any endpoints by default", func() {
+		endpoints, err := eks.ResolveServiceEndpoints(&api.ProviderConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(BeEmpty())
+	})
+
+	It("reads endpoints from the environment and overrides them with the configured ones", func() {
+		Expect(os.Setenv("AWS_EC2_ENDPOINT", "http://localhost:4566")).To(Succeed())
+		Expect(os.Setenv("AWS_EKS_ENDPOINT", "http://localhost:4566")).To(Succeed())
+
+		endpoints, err := eks.ResolveServiceEndpoints(&api.ProviderConfig{
+			ServiceEndpoints: map[string]string{
+				"eks":            "https://vpce-1234.eks.us-west-2.vpce.amazonaws.com",
+				"cloudformation": "https://vpce-5678.cloudformation.us-west-2.vpce.amazonaws.com",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(Equal(map[string]string{
+			"ec2":            "http://localhost:4566",
+			"eks":            "https://vpce-1234.eks.us-west-2.vpce.amazonaws.com",
+			"cloudformation": "https://vpce-5678.cloudformation.us-west-2.vpce.amazonaws.com",
+		}))
+	})
+
+	It("sends the requests of all services to LocalStack with --dry-provider=localstack", func() {
+		Expect(os.Setenv(eks.LocalStackEndpointEnvName, "http://localstack:4566")).To(Succeed())
+		Expect(os.Setenv("AWS_EC2_ENDPOINT", "http://ec2:4566")).To(Succeed())
+
+		endpoints, err := eks.ResolveServiceEndpoints(&api.ProviderConfig{
+			DryProvider:      api.DryProviderLocalStack,
+			ServiceEndpoints: map[string]string{"eks": "http://eks:4566"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(12))
+		Expect(endpoints).To(HaveKeyWithValue("ec2", "http://ec2:4566"))
+		Expect(endpoints).To(HaveKeyWithValue("eks", "http://eks:4566"))
+		Expect(endpoints).To(HaveKeyWithValue("cloudformation", "http://localstack:4566"))
+		Expect(endpoints).To(HaveKeyWithValue("sts", "http://localstack:4566"))
+	})
+
+	It("rejects unknown services", func() {
+		_, err := eks.ResolveServiceEndpoints(&api.ProviderConfig{ServiceEndpoints: map[string]string{"ec3": "https://ec3.example.com"}})
+		Expect(err).To(MatchError(ContainSubstring(`invalid service "ec3" in serviceEndpoints, valid services are: autoscaling, cloudformation, cloudtrail, ec2,`)))
+	})
+
+	It("rejects endpoints that aren't absolute URLs", func() {
+		_, err := eks.ResolveServiceEndpoints(&api.ProviderConfig{ServiceEndpoints: map[string]string{"ec2": "localhost:4566"}})
+		Expect(err).To(MatchError(`invalid endpoint "localhost:4566" for service "ec2", expected an absolute URL`))
+	})
+})
@@ -0,0 +1,27 @@
+package eks
+
+import (
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+func NewMFASessionTokenProvider(stsAPI stsSessionTokenAPI, serialNumber string, tokenProvider func() (string, error), duration time.Duration) awsv2.CredentialsProvider {
+	return &mfaSessionTokenProvider{
+		stsAPI:        stsAPI,
+		serialNumber:  serialNumber,
+		tokenProvider: tokenProvider,
+		duration:      duration,
+	}
+}
+
+func NewV1CredentialsProvider(provider awsv2.CredentialsProvider) credentials.Provider {
+	return &v1CredentialsProvider{provider: provider}
+}
+
+func NewV2Config(pc *api.ProviderConfig, region string, endpointOverrides map[string]string) (awsv2.Config, error) {
+	return newV2Config(pc, region, endpointOverrides, "", "")
+}
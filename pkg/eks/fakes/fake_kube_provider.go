@@ -4,6 +4,7 @@ package fakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
@@ -52,11 +53,12 @@ type FakeKubeProvider struct {
 		result1 string
 		result2 error
 	}
-	UpdateAuthConfigMapStub        func([]*v1alpha5.NodeGroup, kubernetesa.Interface) error
+	UpdateAuthConfigMapStub        func([]*v1alpha5.NodeGroup, kubernetesa.Interface, time.Duration) error
 	updateAuthConfigMapMutex       sync.RWMutex
 	updateAuthConfigMapArgsForCall []struct {
 		arg1 []*v1alpha5.NodeGroup
 		arg2 kubernetesa.Interface
+		arg3 time.Duration
 	}
 	updateAuthConfigMapReturns struct {
 		result1 error
@@ -77,11 +79,12 @@ type FakeKubeProvider struct {
 	validateClusterForCompatibilityReturnsOnCall map[int]struct {
 		result1 error
 	}
-	WaitForNodesStub        func(kubernetesa.Interface, eks.KubeNodeGroup) error
+	WaitForNodesStub        func(kubernetesa.Interface, eks.KubeNodeGroup, time.Duration) error
 	waitForNodesMutex       sync.RWMutex
 	waitForNodesArgsForCall []struct {
 		arg1 kubernetesa.Interface
 		arg2 eks.KubeNodeGroup
+		arg3 time.Duration
 	}
 	waitForNodesReturns struct {
 		result1 error
@@ -284,7 +287,7 @@ func (fake *FakeKubeProvider) ServerVersionReturnsOnCall(i int, result1 string,
 	}{result1, result2}
 }
 
-func (fake *FakeKubeProvider) UpdateAuthConfigMap(arg1 []*v1alpha5.NodeGroup, arg2 kubernetesa.Interface) error {
+func (fake *FakeKubeProvider) UpdateAuthConfigMap(arg1 []*v1alpha5.NodeGroup, arg2 kubernetesa.Interface, arg3 time.Duration) error {
 	var arg1Copy []*v1alpha5.NodeGroup
 	if arg1 != nil {
 		arg1Copy = make([]*v1alpha5.NodeGroup, len(arg1))
@@ -295,13 +298,14 @@ func (fake *FakeKubeProvider) UpdateAuthConfigMap(arg1 []*v1alpha5.NodeGroup, ar
 	fake.updateAuthConfigMapArgsForCall = append(fake.updateAuthConfigMapArgsForCall, struct {
 		arg1 []*v1alpha5.NodeGroup
 		arg2 kubernetesa.Interface
-	}{arg1Copy, arg2})
+		arg3 time.Duration
+	}{arg1Copy, arg2, arg3})
 	stub := fake.UpdateAuthConfigMapStub
 	fakeReturns := fake.updateAuthConfigMapReturns
-	fake.recordInvocation("UpdateAuthConfigMap", []interface{}{arg1Copy, arg2})
+	fake.recordInvocation("UpdateAuthConfigMap", []interface{}{arg1Copy, arg2, arg3})
 	fake.updateAuthConfigMapMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -315,17 +319,17 @@ func (fake *FakeKubeProvider) UpdateAuthConfigMapCallCount() int {
 	return len(fake.updateAuthConfigMapArgsForCall)
 }
 
-func (fake *FakeKubeProvider) UpdateAuthConfigMapCalls(stub func([]*v1alpha5.NodeGroup, kubernetesa.Interface) error) {
+func (fake *FakeKubeProvider) UpdateAuthConfigMapCalls(stub func([]*v1alpha5.NodeGroup, kubernetesa.Interface, time.Duration) error) {
 	fake.updateAuthConfigMapMutex.Lock()
 	defer fake.updateAuthConfigMapMutex.Unlock()
 	fake.UpdateAuthConfigMapStub = stub
 }
 
-func (fake *FakeKubeProvider) UpdateAuthConfigMapArgsForCall(i int) ([]*v1alpha5.NodeGroup, kubernetesa.Interface) {
+func (fake *FakeKubeProvider) UpdateAuthConfigMapArgsForCall(i int) ([]*v1alpha5.NodeGroup, kubernetesa.Interface, time.Duration) {
 	fake.updateAuthConfigMapMutex.RLock()
 	defer fake.updateAuthConfigMapMutex.RUnlock()
 	argsForCall := fake.updateAuthConfigMapArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeKubeProvider) UpdateAuthConfigMapReturns(result1 error) {
@@ -414,19 +418,20 @@ func (fake *FakeKubeProvider) ValidateClusterForCompatibilityReturnsOnCall(i int
 	}{result1}
 }
 
-func (fake *FakeKubeProvider) WaitForNodes(arg1 kubernetesa.Interface, arg2 eks.KubeNodeGroup) error {
+func (fake *FakeKubeProvider) WaitForNodes(arg1 kubernetesa.Interface, arg2 eks.KubeNodeGroup, arg3 time.Duration) error {
 	fake.waitForNodesMutex.Lock()
 	ret, specificReturn := fake.waitForNodesReturnsOnCall[len(fake.waitForNodesArgsForCall)]
 	fake.waitForNodesArgsForCall = append(fake.waitForNodesArgsForCall, struct {
 		arg1 kubernetesa.Interface
 		arg2 eks.KubeNodeGroup
-	}{arg1, arg2})
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
 	stub := fake.WaitForNodesStub
 	fakeReturns := fake.waitForNodesReturns
-	fake.recordInvocation("WaitForNodes", []interface{}{arg1, arg2})
+	fake.recordInvocation("WaitForNodes", []interface{}{arg1, arg2, arg3})
 	fake.waitForNodesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -440,17 +445,17 @@ func (fake *FakeKubeProvider) WaitForNodesCallCount() int {
 	return len(fake.waitForNodesArgsForCall)
 }
 
-func (fake *FakeKubeProvider) WaitForNodesCalls(stub func(kubernetesa.Interface, eks.KubeNodeGroup) error) {
+func (fake *FakeKubeProvider) WaitForNodesCalls(stub func(kubernetesa.Interface, eks.KubeNodeGroup, time.Duration) error) {
 	fake.waitForNodesMutex.Lock()
 	defer fake.waitForNodesMutex.Unlock()
 	fake.WaitForNodesStub = stub
 }
 
-func (fake *FakeKubeProvider) WaitForNodesArgsForCall(i int) (kubernetesa.Interface, eks.KubeNodeGroup) {
+func (fake *FakeKubeProvider) WaitForNodesArgsForCall(i int) (kubernetesa.Interface, eks.KubeNodeGroup, time.Duration) {
 	fake.waitForNodesMutex.RLock()
 	defer fake.waitForNodesMutex.RUnlock()
 	argsForCall := fake.waitForNodesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeKubeProvider) WaitForNodesReturns(result1 error) {
@@ -13,6 +13,19 @@ import (
 )
 
 type FakeNodeGroupInitialiser struct {
+	ApplyVPCCNIConfigStub        func(context.Context, []v1alpha5.NodePool, *v1alpha5.ClusterConfig) error
+	applyVPCCNIConfigMutex       sync.RWMutex
+	applyVPCCNIConfigArgsForCall []struct {
+		arg1 context.Context
+		arg2 []v1alpha5.NodePool
+		arg3 *v1alpha5.ClusterConfig
+	}
+	applyVPCCNIConfigReturns struct {
+		result1 error
+	}
+	applyVPCCNIConfigReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DoAllNodegroupStackTasksStub        func(*tasks.TaskTree, string, string) error
 	doAllNodegroupStackTasksMutex       sync.RWMutex
 	doAllNodegroupStackTasksArgsForCall []struct {
@@ -101,6 +114,72 @@ type FakeNodeGroupInitialiser struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeNodeGroupInitialiser) ApplyVPCCNIConfig(arg1 context.Context, arg2 []v1alpha5.NodePool, arg3 *v1alpha5.ClusterConfig) error {
+	var arg2Copy []v1alpha5.NodePool
+	if arg2 != nil {
+		arg2Copy = make([]v1alpha5.NodePool, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.applyVPCCNIConfigMutex.Lock()
+	ret, specificReturn := fake.applyVPCCNIConfigReturnsOnCall[len(fake.applyVPCCNIConfigArgsForCall)]
+	fake.applyVPCCNIConfigArgsForCall = append(fake.applyVPCCNIConfigArgsForCall, struct {
+		arg1 context.Context
+		arg2 []v1alpha5.NodePool
+		arg3 *v1alpha5.ClusterConfig
+	}{arg1, arg2Copy, arg3})
+	stub := fake.ApplyVPCCNIConfigStub
+	fakeReturns := fake.applyVPCCNIConfigReturns
+	fake.recordInvocation("ApplyVPCCNIConfig", []interface{}{arg1, arg2Copy, arg3})
+	fake.applyVPCCNIConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNodeGroupInitialiser) ApplyVPCCNIConfigCallCount() int {
+	return len(fake.applyVPCCNIConfigArgsForCall)
+}
+
+func (fake *FakeNodeGroupInitialiser) ApplyVPCCNIConfigCalls(stub func(context.Context, []v1alpha5.NodePool, *v1alpha5.ClusterConfig) error) {
+	fake.applyVPCCNIConfigMutex.Lock()
+	defer fake.applyVPCCNIConfigMutex.Unlock()
+	fake.ApplyVPCCNIConfigStub = stub
+}
+
+func (fake *FakeNodeGroupInitialiser) ApplyVPCCNIConfigArgsForCall(i int) (context.Context, []v1alpha5.NodePool, *v1alpha5.ClusterConfig) {
+	fake.applyVPCCNIConfigMutex.RLock()
+	defer fake.applyVPCCNIConfigMutex.RUnlock()
+	argsForCall := fake.applyVPCCNIConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNodeGroupInitialiser) ApplyVPCCNIConfigReturns(result1 error) {
+	fake.applyVPCCNIConfigMutex.Lock()
+	defer fake.applyVPCCNIConfigMutex.Unlock()
+	fake.ApplyVPCCNIConfigStub = nil
+	fake.applyVPCCNIConfigReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNodeGroupInitialiser) ApplyVPCCNIConfigReturnsOnCall(i int, result1 error) {
+	fake.applyVPCCNIConfigMutex.Lock()
+	defer fake.applyVPCCNIConfigMutex.Unlock()
+	fake.ApplyVPCCNIConfigStub = nil
+	if fake.applyVPCCNIConfigReturnsOnCall == nil {
+		fake.applyVPCCNIConfigReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyVPCCNIConfigReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNodeGroupInitialiser) DoAllNodegroupStackTasks(arg1 *tasks.TaskTree, arg2 string, arg3 string) error {
 	fake.doAllNodegroupStackTasksMutex.Lock()
 	ret, specificReturn := fake.doAllNodegroupStackTasksReturnsOnCall[len(fake.doAllNodegroupStackTasksArgsForCall)]
@@ -123,6 +202,8 @@ func (fake *FakeNodeGroupInitialiser) DoAllNodegroupStackTasks(arg1 *tasks.TaskT
 }
 
 func (fake *FakeNodeGroupInitialiser) DoAllNodegroupStackTasksCallCount() int {
+	fake.applyVPCCNIConfigMutex.RLock()
+	defer fake.applyVPCCNIConfigMutex.RUnlock()
 	fake.doAllNodegroupStackTasksMutex.RLock()
 	defer fake.doAllNodegroupStackTasksMutex.RUnlock()
 	return len(fake.doAllNodegroupStackTasksArgsForCall)
@@ -363,6 +444,8 @@ func (fake *FakeNodeGroupInitialiser) Normalize(arg1 context.Context, arg2 []v1a
 func (fake *FakeNodeGroupInitialiser) NormalizeCallCount() int {
 	fake.normalizeMutex.RLock()
 	defer fake.normalizeMutex.RUnlock()
+	fake.applyVPCCNIConfigMutex.RLock()
+	defer fake.applyVPCCNIConfigMutex.RUnlock()
 	return len(fake.normalizeArgsForCall)
 }
 
@@ -375,6 +458,8 @@ func (fake *FakeNodeGroupInitialiser) NormalizeCalls(stub func(context.Context,
 func (fake *FakeNodeGroupInitialiser) NormalizeArgsForCall(i int) (context.Context, []v1alpha5.NodePool, *v1alpha5.ClusterMeta) {
 	fake.normalizeMutex.RLock()
 	defer fake.normalizeMutex.RUnlock()
+	fake.applyVPCCNIConfigMutex.RLock()
+	defer fake.applyVPCCNIConfigMutex.RUnlock()
 	argsForCall := fake.normalizeArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
@@ -541,6 +626,8 @@ func (fake *FakeNodeGroupInitialiser) Invocations() map[string][][]interface{} {
 	defer fake.newAWSSelectorSessionMutex.RUnlock()
 	fake.normalizeMutex.RLock()
 	defer fake.normalizeMutex.RUnlock()
+	fake.applyVPCCNIConfigMutex.RLock()
+	defer fake.applyVPCCNIConfigMutex.RUnlock()
 	fake.validateExistingNodeGroupsForCompatibilityMutex.RLock()
 	defer fake.validateExistingNodeGroupsForCompatibilityMutex.RUnlock()
 	fake.validateLegacySubnetsForNodeGroupsMutex.RLock()
@@ -1,9 +1,10 @@
 package eks
 
 import (
+	"context"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
@@ -19,10 +20,11 @@ import (
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 //counterfeiter:generate -o fakes/fargate_client.go . FargateClient
 type FargateClient interface {
-	CreateProfile(profile *api.FargateProfile, waitForCreation bool) error
+	CreateProfile(ctx context.Context, profile *api.FargateProfile, waitForCreation bool) error
 }
 
 type fargateProfilesTask struct {
+	ctx             context.Context
 	info            string
 	clusterProvider *ClusterProvider
 	spec            *api.ClusterConfig
@@ -33,9 +35,12 @@ func (fpt *fargateProfilesTask) Describe() string { return fpt.info }
 
 func (fpt *fargateProfilesTask) Do(errCh chan error) error {
 	defer close(errCh)
-	if err := DoCreateFargateProfiles(fpt.spec, fpt.manager); err != nil {
+	if err := DoCreateFargateProfiles(fpt.ctx, fpt.spec, fpt.manager); err != nil {
 		return err
 	}
+	if !fpt.clusterProvider.HasKubeAccess() {
+		return nil
+	}
 
 	// Add delay after cluster creation to handle a race condition
 	time.Sleep(30 * time.Second)
@@ -51,7 +56,7 @@ func (fpt *fargateProfilesTask) Do(errCh chan error) error {
 }
 
 // DoCreateFargateProfiles creates fargate profiles as specified in the config
-func DoCreateFargateProfiles(config *api.ClusterConfig, fargateClient FargateClient) error {
+func DoCreateFargateProfiles(ctx context.Context, config *api.ClusterConfig, fargateClient FargateClient) error {
 	clusterName := config.Metadata.Name
 	for _, profile := range config.FargateProfiles {
 		logger.Info("creating Fargate profile %q on EKS cluster %q", profile.Name, clusterName)
@@ -66,8 +71,8 @@ func DoCreateFargateProfiles(config *api.ClusterConfig, fargateClient FargateCli
 		//
 		// In the case that a ResourceInUseException is thrown on a profile which was
 		// created on an earlier call, we do not error but continue to the next one
-		var e *eks.ResourceInUseException
-		err := fargateClient.CreateProfile(profile, true)
+		var e *ekstypes.ResourceInUseException
+		err := fargateClient.CreateProfile(ctx, profile, true)
 		switch {
 		case err == nil:
 			logger.Info("created Fargate profile %q on EKS cluster %q", profile.Name, clusterName)
@@ -0,0 +1,88 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+)
+
+// lookupIPv6 returns the IPv6 addresses of host, it's a variable so that tests don't depend on DNS
+var lookupIPv6 = func(ctx context.Context, host string) ([]net.IP, error) {
+	return net.DefaultResolver.LookupIP(ctx, "ip6", host)
+}
+
+// IsIPv6Client returns true when AWS services are called through their dual-stack endpoints, i.e. when eksctl
+// runs on an IPv6-only host
+func (c *ClusterProvider) IsIPv6Client() bool {
+	return c.ipv6Client
+}
+
+// CheckIPv6Endpoint returns an error if the API server endpoint doesn't resolve to an IPv6 address, in which case
+// it can't be reached from IPv6-only clients
+func CheckIPv6Endpoint(ctx context.Context, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.Wrapf(err, "parsing API server endpoint %q", endpoint)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("API server endpoint %q has no host", endpoint)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if ip.To4() != nil {
+			return fmt.Errorf("API server endpoint %q is an IPv4 address, it cannot be reached from IPv6-only clients", endpoint)
+		}
+		return nil
+	}
+	ips, err := lookupIPv6(ctx, host)
+	if err != nil {
+		return errors.Wrapf(err, "looking up the AAAA records of API server endpoint %q", host)
+	}
+	if len(ips) == 0 {
+		return fmt.Errorf("API server endpoint %q has no AAAA record, it cannot be reached from IPv6-only clients", host)
+	}
+	return nil
+}
+
+// WaitForIPv6Endpoint waits till the API server endpoint of the cluster resolves to an IPv6 address
+func (c *ClusterProvider) WaitForIPv6Endpoint(ctx context.Context, cfg *api.ClusterConfig, timeout time.Duration) error {
+	if cfg.Status == nil || cfg.Status.Endpoint == "" {
+		if err := c.RefreshClusterStatus(ctx, cfg); err != nil {
+			return err
+		}
+	}
+
+	var lastErr error
+	w := waiter.Waiter{
+		Operation: func() (bool, error) {
+			lastErr = CheckIPv6Endpoint(ctx, cfg.Status.Endpoint)
+			if lastErr != nil {
+				logger.Debug("API server endpoint not reachable over IPv6 yet – %s", lastErr.Error())
+				return false, nil
+			}
+			return true, nil
+		},
+		NextDelay: func(_ int) time.Duration {
+			return 20 * time.Second
+		},
+	}
+
+	if err := w.WaitWithTimeout(timeout); err != nil {
+		if err == context.DeadlineExceeded {
+			if lastErr == nil {
+				lastErr = err
+			}
+			return errors.Wrapf(lastErr, "timed out waiting for the API server endpoint of %q to have an IPv6 address after %s", cfg.Metadata.Name, timeout)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package eks_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("CheckIPv6Endpoint", func() {
+	DescribeTable("checks that the API server endpoint is reachable over IPv6", func(endpoint, errMsg string) {
+		err := eks.CheckIPv6Endpoint(context.Background(), endpoint)
+		if errMsg != "" {
+			Expect(err).To(MatchError(ContainSubstring(errMsg)))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+	},
+		Entry("an IPv6 address", "https://[2001:db8::1]", ""),
+		Entry("an IPv6 address with a port", "https://[2001:db8::1]:443", ""),
+		Entry("an IPv4 address", "https://192.0.2.1", `API server endpoint "https://192.0.2.1" is an IPv4 address, it cannot be reached from IPv6-only clients`),
+		Entry("an endpoint without a host", "https://", `API server endpoint "https://" has no host`),
+	)
+})
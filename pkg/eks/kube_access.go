@@ -0,0 +1,80 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// ErrNoKubeAccess is returned when a Kubernetes client is requested while access to the Kubernetes API is disabled
+var ErrNoKubeAccess = errors.New("access to the Kubernetes API of the cluster is disabled (--no-kube-access)")
+
+// DeferredKubeStep is a step that needs access to the Kubernetes API of the cluster and that was skipped
+// because access was disabled, it has to be run later from a host that can reach the API
+type DeferredKubeStep struct {
+	// Description of the step
+	Description string
+	// Command runs the step, it's empty when the step has to be done by hand
+	Command string
+}
+
+type kubeAccess struct {
+	disabled   bool
+	configFile string
+	deferred   []DeferredKubeStep
+}
+
+// DisableKubeAccess makes the tasks of c skip every step that needs access to the Kubernetes API of the cluster,
+// the skipped steps are recorded instead, see DeferredKubeSteps. configFile is the config file the command was
+// given, if any, it's used in the commands of the deferred steps.
+func (c *ClusterProvider) DisableKubeAccess(configFile string) {
+	c.kubeAccess.disabled = true
+	c.kubeAccess.configFile = configFile
+}
+
+// HasKubeAccess returns false when access to the Kubernetes API has been disabled
+func (c *ClusterProvider) HasKubeAccess() bool {
+	return !c.kubeAccess.disabled
+}
+
+// DeferKubeStep records a step that was skipped because access to the Kubernetes API is disabled
+func (c *ClusterProvider) DeferKubeStep(description, command string) {
+	c.kubeAccess.deferred = append(c.kubeAccess.deferred, DeferredKubeStep{
+		Description: description,
+		Command:     command,
+	})
+}
+
+// DeferredKubeSteps returns the steps that were skipped because access to the Kubernetes API is disabled
+func (c *ClusterProvider) DeferredKubeSteps() []DeferredKubeStep {
+	return c.kubeAccess.deferred
+}
+
+// LogDeferredKubeSteps logs the steps that were skipped because access to the Kubernetes API is disabled
+func (c *ClusterProvider) LogDeferredKubeSteps(meta *api.ClusterMeta) {
+	if len(c.kubeAccess.deferred) == 0 {
+		return
+	}
+	logger.Warning("the following steps need access to the Kubernetes API of cluster %q and were skipped, run them from a host that can reach it:", meta.Name)
+	for i, step := range c.kubeAccess.deferred {
+		logger.Warning("%d. %s", i+1, step.Description)
+		if step.Command != "" {
+			logger.Warning("   %s", step.Command)
+		}
+	}
+}
+
+// eksctlCommand returns an eksctl command selecting the cluster of cfg, via the config file when there's one
+func (c *ClusterProvider) eksctlCommand(cfg *api.ClusterConfig, command string, args ...string) string {
+	parts := []string{"eksctl", command}
+	if c.kubeAccess.configFile != "" {
+		parts = append(parts, fmt.Sprintf("--config-file=%s", c.kubeAccess.configFile))
+	} else {
+		parts = append(parts, fmt.Sprintf("--cluster=%s", cfg.Metadata.Name), fmt.Sprintf("--region=%s", cfg.Metadata.Region))
+	}
+	return strings.Join(append(parts, args...), " ")
+}
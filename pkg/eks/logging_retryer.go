@@ -18,39 +18,31 @@ package eks
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/kris-nova/logger"
-
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/kris-nova/logger"
 )
 
-const (
-	maxRetries          = 13
-	cfnMinThrottleDelay = 5 * time.Second
-)
+const maxRetries = 13
 
 // LoggingRetryer adds some logging when we are retrying, so we have some idea what is happening
 // Right now it is very basic - e.g. it only logs when we retry (so doesn't log when we fail due to too many retries)
 // It was copied from k8s.io/kops/upup/pkg/fi/cloudup/awsup/logging_retryer.go; the original version used glog, and
-// didn't export the constructor
+// didn't export the constructor. It is only used by the SDK v1 session, see ProviderServices.Session
 type LoggingRetryer struct {
 	client.DefaultRetryer
-	cfnRetryer client.DefaultRetryer
 }
 
 var _ request.Retryer = &LoggingRetryer{}
 
-func newLoggingRetryer() *LoggingRetryer {
+func newLoggingRetryer(numMaxRetries int) *LoggingRetryer {
 	return &LoggingRetryer{
 		DefaultRetryer: client.DefaultRetryer{
-			NumMaxRetries: maxRetries,
-		},
-		cfnRetryer: client.DefaultRetryer{
-			NumMaxRetries:    maxRetries,
-			MinThrottleDelay: cfnMinThrottleDelay,
+			NumMaxRetries: numMaxRetries,
 		},
 	}
 }
@@ -65,19 +57,23 @@ func (l LoggingRetryer) ShouldRetry(r *request.Request) bool {
 	return isErrorRetryable(r.Error)
 }
 
+func isErrorRetryable(err error) bool {
+	if aerr, ok := err.(awserr.RequestFailure); ok && aerr.Code() == "EC2MetadataError" {
+		switch aerr.StatusCode() {
+		case http.StatusForbidden, http.StatusNotFound, http.StatusMethodNotAllowed:
+			return false
+		}
+	}
+	return true
+}
+
 // RetryRules extends on DefaultRetryer.RetryRules
 func (l LoggingRetryer) RetryRules(r *request.Request) time.Duration {
 	var (
-		duration time.Duration
+		duration = l.DefaultRetryer.RetryRules(r)
 		service  = r.ClientInfo.ServiceName
 	)
 
-	if r.IsErrorThrottle() && service == cloudformation.ServiceName && r.Operation.Name == "DescribeStacks" {
-		duration = l.cfnRetryer.RetryRules(r)
-	} else {
-		duration = l.DefaultRetryer.RetryRules(r)
-	}
-
 	name := "?"
 	if r.Operation != nil {
 		name = r.Operation.Name
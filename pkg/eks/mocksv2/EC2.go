@@ -14,6 +14,36 @@ type EC2 struct {
 	mock.Mock
 }
 
+// AcceptAddressTransfer provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) AcceptAddressTransfer(ctx context.Context, params *ec2.AcceptAddressTransferInput, optFns ...func(*ec2.Options)) (*ec2.AcceptAddressTransferOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.AcceptAddressTransferOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.AcceptAddressTransferInput, ...func(*ec2.Options)) *ec2.AcceptAddressTransferOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.AcceptAddressTransferOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.AcceptAddressTransferInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AcceptReservedInstancesExchangeQuote provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) AcceptReservedInstancesExchangeQuote(ctx context.Context, params *ec2.AcceptReservedInstancesExchangeQuoteInput, optFns ...func(*ec2.Options)) (*ec2.AcceptReservedInstancesExchangeQuoteOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -404,6 +434,36 @@ func (_m *EC2) AssignPrivateIpAddresses(ctx context.Context, params *ec2.AssignP
 	return r0, r1
 }
 
+// AssignPrivateNatGatewayAddress provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) AssignPrivateNatGatewayAddress(ctx context.Context, params *ec2.AssignPrivateNatGatewayAddressInput, optFns ...func(*ec2.Options)) (*ec2.AssignPrivateNatGatewayAddressOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.AssignPrivateNatGatewayAddressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.AssignPrivateNatGatewayAddressInput, ...func(*ec2.Options)) *ec2.AssignPrivateNatGatewayAddressOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.AssignPrivateNatGatewayAddressOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.AssignPrivateNatGatewayAddressInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AssociateAddress provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) AssociateAddress(ctx context.Context, params *ec2.AssociateAddressInput, optFns ...func(*ec2.Options)) (*ec2.AssociateAddressOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -584,6 +644,96 @@ func (_m *EC2) AssociateInstanceEventWindow(ctx context.Context, params *ec2.Ass
 	return r0, r1
 }
 
+// AssociateIpamByoasn provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) AssociateIpamByoasn(ctx context.Context, params *ec2.AssociateIpamByoasnInput, optFns ...func(*ec2.Options)) (*ec2.AssociateIpamByoasnOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.AssociateIpamByoasnOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.AssociateIpamByoasnInput, ...func(*ec2.Options)) *ec2.AssociateIpamByoasnOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.AssociateIpamByoasnOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.AssociateIpamByoasnInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssociateIpamResourceDiscovery provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) AssociateIpamResourceDiscovery(ctx context.Context, params *ec2.AssociateIpamResourceDiscoveryInput, optFns ...func(*ec2.Options)) (*ec2.AssociateIpamResourceDiscoveryOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.AssociateIpamResourceDiscoveryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.AssociateIpamResourceDiscoveryInput, ...func(*ec2.Options)) *ec2.AssociateIpamResourceDiscoveryOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.AssociateIpamResourceDiscoveryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.AssociateIpamResourceDiscoveryInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssociateNatGatewayAddress provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) AssociateNatGatewayAddress(ctx context.Context, params *ec2.AssociateNatGatewayAddressInput, optFns ...func(*ec2.Options)) (*ec2.AssociateNatGatewayAddressOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.AssociateNatGatewayAddressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.AssociateNatGatewayAddressInput, ...func(*ec2.Options)) *ec2.AssociateNatGatewayAddressOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.AssociateNatGatewayAddressOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.AssociateNatGatewayAddressInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AssociateRouteTable provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) AssociateRouteTable(ctx context.Context, params *ec2.AssociateRouteTableInput, optFns ...func(*ec2.Options)) (*ec2.AssociateRouteTableOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -674,6 +824,36 @@ func (_m *EC2) AssociateTransitGatewayMulticastDomain(ctx context.Context, param
 	return r0, r1
 }
 
+// AssociateTransitGatewayPolicyTable provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) AssociateTransitGatewayPolicyTable(ctx context.Context, params *ec2.AssociateTransitGatewayPolicyTableInput, optFns ...func(*ec2.Options)) (*ec2.AssociateTransitGatewayPolicyTableOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.AssociateTransitGatewayPolicyTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.AssociateTransitGatewayPolicyTableInput, ...func(*ec2.Options)) *ec2.AssociateTransitGatewayPolicyTableOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.AssociateTransitGatewayPolicyTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.AssociateTransitGatewayPolicyTableInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AssociateTransitGatewayRouteTable provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) AssociateTransitGatewayRouteTable(ctx context.Context, params *ec2.AssociateTransitGatewayRouteTableInput, optFns ...func(*ec2.Options)) (*ec2.AssociateTransitGatewayRouteTableOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -854,6 +1034,36 @@ func (_m *EC2) AttachNetworkInterface(ctx context.Context, params *ec2.AttachNet
 	return r0, r1
 }
 
+// AttachVerifiedAccessTrustProvider provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) AttachVerifiedAccessTrustProvider(ctx context.Context, params *ec2.AttachVerifiedAccessTrustProviderInput, optFns ...func(*ec2.Options)) (*ec2.AttachVerifiedAccessTrustProviderOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.AttachVerifiedAccessTrustProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.AttachVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) *ec2.AttachVerifiedAccessTrustProviderOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.AttachVerifiedAccessTrustProviderOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.AttachVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AttachVolume provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) AttachVolume(ctx context.Context, params *ec2.AttachVolumeInput, optFns ...func(*ec2.Options)) (*ec2.AttachVolumeOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -1184,6 +1394,36 @@ func (_m *EC2) CancelExportTask(ctx context.Context, params *ec2.CancelExportTas
 	return r0, r1
 }
 
+// CancelImageLaunchPermission provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CancelImageLaunchPermission(ctx context.Context, params *ec2.CancelImageLaunchPermissionInput, optFns ...func(*ec2.Options)) (*ec2.CancelImageLaunchPermissionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CancelImageLaunchPermissionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CancelImageLaunchPermissionInput, ...func(*ec2.Options)) *ec2.CancelImageLaunchPermissionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CancelImageLaunchPermissionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CancelImageLaunchPermissionInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CancelImportTask provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) CancelImportTask(ctx context.Context, params *ec2.CancelImportTaskInput, optFns ...func(*ec2.Options)) (*ec2.CancelImportTaskOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -1574,6 +1814,66 @@ func (_m *EC2) CreateClientVpnRoute(ctx context.Context, params *ec2.CreateClien
 	return r0, r1
 }
 
+// CreateCoipCidr provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateCoipCidr(ctx context.Context, params *ec2.CreateCoipCidrInput, optFns ...func(*ec2.Options)) (*ec2.CreateCoipCidrOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateCoipCidrOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateCoipCidrInput, ...func(*ec2.Options)) *ec2.CreateCoipCidrOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateCoipCidrOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateCoipCidrInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCoipPool provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateCoipPool(ctx context.Context, params *ec2.CreateCoipPoolInput, optFns ...func(*ec2.Options)) (*ec2.CreateCoipPoolOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateCoipPoolOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateCoipPoolInput, ...func(*ec2.Options)) *ec2.CreateCoipPoolOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateCoipPoolOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateCoipPoolInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCustomerGateway provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) CreateCustomerGateway(ctx context.Context, params *ec2.CreateCustomerGatewayInput, optFns ...func(*ec2.Options)) (*ec2.CreateCustomerGatewayOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -1844,8 +2144,8 @@ func (_m *EC2) CreateImage(ctx context.Context, params *ec2.CreateImageInput, op
 	return r0, r1
 }
 
-// CreateInstanceEventWindow provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) CreateInstanceEventWindow(ctx context.Context, params *ec2.CreateInstanceEventWindowInput, optFns ...func(*ec2.Options)) (*ec2.CreateInstanceEventWindowOutput, error) {
+// CreateInstanceConnectEndpoint provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateInstanceConnectEndpoint(ctx context.Context, params *ec2.CreateInstanceConnectEndpointInput, optFns ...func(*ec2.Options)) (*ec2.CreateInstanceConnectEndpointOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -1855,17 +2155,17 @@ func (_m *EC2) CreateInstanceEventWindow(ctx context.Context, params *ec2.Create
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.CreateInstanceEventWindowOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateInstanceEventWindowInput, ...func(*ec2.Options)) *ec2.CreateInstanceEventWindowOutput); ok {
+	var r0 *ec2.CreateInstanceConnectEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateInstanceConnectEndpointInput, ...func(*ec2.Options)) *ec2.CreateInstanceConnectEndpointOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.CreateInstanceEventWindowOutput)
+			r0 = ret.Get(0).(*ec2.CreateInstanceConnectEndpointOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateInstanceEventWindowInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateInstanceConnectEndpointInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -1874,8 +2174,8 @@ func (_m *EC2) CreateInstanceEventWindow(ctx context.Context, params *ec2.Create
 	return r0, r1
 }
 
-// CreateInstanceExportTask provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) CreateInstanceExportTask(ctx context.Context, params *ec2.CreateInstanceExportTaskInput, optFns ...func(*ec2.Options)) (*ec2.CreateInstanceExportTaskOutput, error) {
+// CreateInstanceEventWindow provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateInstanceEventWindow(ctx context.Context, params *ec2.CreateInstanceEventWindowInput, optFns ...func(*ec2.Options)) (*ec2.CreateInstanceEventWindowOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -1885,12 +2185,42 @@ func (_m *EC2) CreateInstanceExportTask(ctx context.Context, params *ec2.CreateI
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.CreateInstanceExportTaskOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateInstanceExportTaskInput, ...func(*ec2.Options)) *ec2.CreateInstanceExportTaskOutput); ok {
+	var r0 *ec2.CreateInstanceEventWindowOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateInstanceEventWindowInput, ...func(*ec2.Options)) *ec2.CreateInstanceEventWindowOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.CreateInstanceExportTaskOutput)
+			r0 = ret.Get(0).(*ec2.CreateInstanceEventWindowOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateInstanceEventWindowInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInstanceExportTask provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateInstanceExportTask(ctx context.Context, params *ec2.CreateInstanceExportTaskInput, optFns ...func(*ec2.Options)) (*ec2.CreateInstanceExportTaskOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateInstanceExportTaskOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateInstanceExportTaskInput, ...func(*ec2.Options)) *ec2.CreateInstanceExportTaskOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateInstanceExportTaskOutput)
 		}
 	}
 
@@ -1994,6 +2324,36 @@ func (_m *EC2) CreateIpamPool(ctx context.Context, params *ec2.CreateIpamPoolInp
 	return r0, r1
 }
 
+// CreateIpamResourceDiscovery provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateIpamResourceDiscovery(ctx context.Context, params *ec2.CreateIpamResourceDiscoveryInput, optFns ...func(*ec2.Options)) (*ec2.CreateIpamResourceDiscoveryOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateIpamResourceDiscoveryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateIpamResourceDiscoveryInput, ...func(*ec2.Options)) *ec2.CreateIpamResourceDiscoveryOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateIpamResourceDiscoveryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateIpamResourceDiscoveryInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateIpamScope provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) CreateIpamScope(ctx context.Context, params *ec2.CreateIpamScopeInput, optFns ...func(*ec2.Options)) (*ec2.CreateIpamScopeOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -2144,6 +2504,66 @@ func (_m *EC2) CreateLocalGatewayRoute(ctx context.Context, params *ec2.CreateLo
 	return r0, r1
 }
 
+// CreateLocalGatewayRouteTable provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateLocalGatewayRouteTable(ctx context.Context, params *ec2.CreateLocalGatewayRouteTableInput, optFns ...func(*ec2.Options)) (*ec2.CreateLocalGatewayRouteTableOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateLocalGatewayRouteTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateLocalGatewayRouteTableInput, ...func(*ec2.Options)) *ec2.CreateLocalGatewayRouteTableOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateLocalGatewayRouteTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateLocalGatewayRouteTableInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation(ctx context.Context, params *ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput, optFns ...func(*ec2.Options)) (*ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput, ...func(*ec2.Options)) *ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateLocalGatewayRouteTableVpcAssociation provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) CreateLocalGatewayRouteTableVpcAssociation(ctx context.Context, params *ec2.CreateLocalGatewayRouteTableVpcAssociationInput, optFns ...func(*ec2.Options)) (*ec2.CreateLocalGatewayRouteTableVpcAssociationOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -3134,6 +3554,36 @@ func (_m *EC2) CreateTransitGatewayPeeringAttachment(ctx context.Context, params
 	return r0, r1
 }
 
+// CreateTransitGatewayPolicyTable provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateTransitGatewayPolicyTable(ctx context.Context, params *ec2.CreateTransitGatewayPolicyTableInput, optFns ...func(*ec2.Options)) (*ec2.CreateTransitGatewayPolicyTableOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateTransitGatewayPolicyTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateTransitGatewayPolicyTableInput, ...func(*ec2.Options)) *ec2.CreateTransitGatewayPolicyTableOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateTransitGatewayPolicyTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateTransitGatewayPolicyTableInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTransitGatewayPrefixListReference provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) CreateTransitGatewayPrefixListReference(ctx context.Context, params *ec2.CreateTransitGatewayPrefixListReferenceInput, optFns ...func(*ec2.Options)) (*ec2.CreateTransitGatewayPrefixListReferenceOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -3224,6 +3674,36 @@ func (_m *EC2) CreateTransitGatewayRouteTable(ctx context.Context, params *ec2.C
 	return r0, r1
 }
 
+// CreateTransitGatewayRouteTableAnnouncement provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateTransitGatewayRouteTableAnnouncement(ctx context.Context, params *ec2.CreateTransitGatewayRouteTableAnnouncementInput, optFns ...func(*ec2.Options)) (*ec2.CreateTransitGatewayRouteTableAnnouncementOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateTransitGatewayRouteTableAnnouncementOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateTransitGatewayRouteTableAnnouncementInput, ...func(*ec2.Options)) *ec2.CreateTransitGatewayRouteTableAnnouncementOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateTransitGatewayRouteTableAnnouncementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateTransitGatewayRouteTableAnnouncementInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTransitGatewayVpcAttachment provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) CreateTransitGatewayVpcAttachment(ctx context.Context, params *ec2.CreateTransitGatewayVpcAttachmentInput, optFns ...func(*ec2.Options)) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -3254,6 +3734,126 @@ func (_m *EC2) CreateTransitGatewayVpcAttachment(ctx context.Context, params *ec
 	return r0, r1
 }
 
+// CreateVerifiedAccessEndpoint provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateVerifiedAccessEndpoint(ctx context.Context, params *ec2.CreateVerifiedAccessEndpointInput, optFns ...func(*ec2.Options)) (*ec2.CreateVerifiedAccessEndpointOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateVerifiedAccessEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateVerifiedAccessEndpointInput, ...func(*ec2.Options)) *ec2.CreateVerifiedAccessEndpointOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateVerifiedAccessEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateVerifiedAccessEndpointInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVerifiedAccessGroup provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateVerifiedAccessGroup(ctx context.Context, params *ec2.CreateVerifiedAccessGroupInput, optFns ...func(*ec2.Options)) (*ec2.CreateVerifiedAccessGroupOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateVerifiedAccessGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateVerifiedAccessGroupInput, ...func(*ec2.Options)) *ec2.CreateVerifiedAccessGroupOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateVerifiedAccessGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateVerifiedAccessGroupInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVerifiedAccessInstance provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateVerifiedAccessInstance(ctx context.Context, params *ec2.CreateVerifiedAccessInstanceInput, optFns ...func(*ec2.Options)) (*ec2.CreateVerifiedAccessInstanceOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateVerifiedAccessInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateVerifiedAccessInstanceInput, ...func(*ec2.Options)) *ec2.CreateVerifiedAccessInstanceOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateVerifiedAccessInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateVerifiedAccessInstanceInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVerifiedAccessTrustProvider provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) CreateVerifiedAccessTrustProvider(ctx context.Context, params *ec2.CreateVerifiedAccessTrustProviderInput, optFns ...func(*ec2.Options)) (*ec2.CreateVerifiedAccessTrustProviderOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.CreateVerifiedAccessTrustProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.CreateVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) *ec2.CreateVerifiedAccessTrustProviderOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.CreateVerifiedAccessTrustProviderOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.CreateVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVolume provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) CreateVolume(ctx context.Context, params *ec2.CreateVolumeInput, optFns ...func(*ec2.Options)) (*ec2.CreateVolumeOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -3614,8 +4214,8 @@ func (_m *EC2) DeleteClientVpnRoute(ctx context.Context, params *ec2.DeleteClien
 	return r0, r1
 }
 
-// DeleteCustomerGateway provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteCustomerGateway(ctx context.Context, params *ec2.DeleteCustomerGatewayInput, optFns ...func(*ec2.Options)) (*ec2.DeleteCustomerGatewayOutput, error) {
+// DeleteCoipCidr provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteCoipCidr(ctx context.Context, params *ec2.DeleteCoipCidrInput, optFns ...func(*ec2.Options)) (*ec2.DeleteCoipCidrOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -3625,17 +4225,17 @@ func (_m *EC2) DeleteCustomerGateway(ctx context.Context, params *ec2.DeleteCust
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteCustomerGatewayOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteCustomerGatewayInput, ...func(*ec2.Options)) *ec2.DeleteCustomerGatewayOutput); ok {
+	var r0 *ec2.DeleteCoipCidrOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteCoipCidrInput, ...func(*ec2.Options)) *ec2.DeleteCoipCidrOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteCustomerGatewayOutput)
+			r0 = ret.Get(0).(*ec2.DeleteCoipCidrOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteCustomerGatewayInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteCoipCidrInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -3644,8 +4244,8 @@ func (_m *EC2) DeleteCustomerGateway(ctx context.Context, params *ec2.DeleteCust
 	return r0, r1
 }
 
-// DeleteDhcpOptions provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteDhcpOptions(ctx context.Context, params *ec2.DeleteDhcpOptionsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteDhcpOptionsOutput, error) {
+// DeleteCoipPool provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteCoipPool(ctx context.Context, params *ec2.DeleteCoipPoolInput, optFns ...func(*ec2.Options)) (*ec2.DeleteCoipPoolOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -3655,8 +4255,68 @@ func (_m *EC2) DeleteDhcpOptions(ctx context.Context, params *ec2.DeleteDhcpOpti
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteDhcpOptionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteDhcpOptionsInput, ...func(*ec2.Options)) *ec2.DeleteDhcpOptionsOutput); ok {
+	var r0 *ec2.DeleteCoipPoolOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteCoipPoolInput, ...func(*ec2.Options)) *ec2.DeleteCoipPoolOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteCoipPoolOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteCoipPoolInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCustomerGateway provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteCustomerGateway(ctx context.Context, params *ec2.DeleteCustomerGatewayInput, optFns ...func(*ec2.Options)) (*ec2.DeleteCustomerGatewayOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteCustomerGatewayOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteCustomerGatewayInput, ...func(*ec2.Options)) *ec2.DeleteCustomerGatewayOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteCustomerGatewayOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteCustomerGatewayInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDhcpOptions provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteDhcpOptions(ctx context.Context, params *ec2.DeleteDhcpOptionsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteDhcpOptionsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteDhcpOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteDhcpOptionsInput, ...func(*ec2.Options)) *ec2.DeleteDhcpOptionsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
@@ -3794,6 +4454,36 @@ func (_m *EC2) DeleteFpgaImage(ctx context.Context, params *ec2.DeleteFpgaImageI
 	return r0, r1
 }
 
+// DeleteInstanceConnectEndpoint provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteInstanceConnectEndpoint(ctx context.Context, params *ec2.DeleteInstanceConnectEndpointInput, optFns ...func(*ec2.Options)) (*ec2.DeleteInstanceConnectEndpointOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteInstanceConnectEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteInstanceConnectEndpointInput, ...func(*ec2.Options)) *ec2.DeleteInstanceConnectEndpointOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteInstanceConnectEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteInstanceConnectEndpointInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteInstanceEventWindow provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DeleteInstanceEventWindow(ctx context.Context, params *ec2.DeleteInstanceEventWindowInput, optFns ...func(*ec2.Options)) (*ec2.DeleteInstanceEventWindowOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -3914,6 +4604,36 @@ func (_m *EC2) DeleteIpamPool(ctx context.Context, params *ec2.DeleteIpamPoolInp
 	return r0, r1
 }
 
+// DeleteIpamResourceDiscovery provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteIpamResourceDiscovery(ctx context.Context, params *ec2.DeleteIpamResourceDiscoveryInput, optFns ...func(*ec2.Options)) (*ec2.DeleteIpamResourceDiscoveryOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteIpamResourceDiscoveryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteIpamResourceDiscoveryInput, ...func(*ec2.Options)) *ec2.DeleteIpamResourceDiscoveryOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteIpamResourceDiscoveryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteIpamResourceDiscoveryInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteIpamScope provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DeleteIpamScope(ctx context.Context, params *ec2.DeleteIpamScopeInput, optFns ...func(*ec2.Options)) (*ec2.DeleteIpamScopeOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -4064,6 +4784,66 @@ func (_m *EC2) DeleteLocalGatewayRoute(ctx context.Context, params *ec2.DeleteLo
 	return r0, r1
 }
 
+// DeleteLocalGatewayRouteTable provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteLocalGatewayRouteTable(ctx context.Context, params *ec2.DeleteLocalGatewayRouteTableInput, optFns ...func(*ec2.Options)) (*ec2.DeleteLocalGatewayRouteTableOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteLocalGatewayRouteTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteLocalGatewayRouteTableInput, ...func(*ec2.Options)) *ec2.DeleteLocalGatewayRouteTableOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteLocalGatewayRouteTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteLocalGatewayRouteTableInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation(ctx context.Context, params *ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput, optFns ...func(*ec2.Options)) (*ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput, ...func(*ec2.Options)) *ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteLocalGatewayRouteTableVpcAssociation provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DeleteLocalGatewayRouteTableVpcAssociation(ctx context.Context, params *ec2.DeleteLocalGatewayRouteTableVpcAssociationInput, optFns ...func(*ec2.Options)) (*ec2.DeleteLocalGatewayRouteTableVpcAssociationOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -4994,6 +5774,36 @@ func (_m *EC2) DeleteTransitGatewayPeeringAttachment(ctx context.Context, params
 	return r0, r1
 }
 
+// DeleteTransitGatewayPolicyTable provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteTransitGatewayPolicyTable(ctx context.Context, params *ec2.DeleteTransitGatewayPolicyTableInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayPolicyTableOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteTransitGatewayPolicyTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteTransitGatewayPolicyTableInput, ...func(*ec2.Options)) *ec2.DeleteTransitGatewayPolicyTableOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteTransitGatewayPolicyTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteTransitGatewayPolicyTableInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteTransitGatewayPrefixListReference provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DeleteTransitGatewayPrefixListReference(ctx context.Context, params *ec2.DeleteTransitGatewayPrefixListReferenceInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayPrefixListReferenceOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -5084,8 +5894,8 @@ func (_m *EC2) DeleteTransitGatewayRouteTable(ctx context.Context, params *ec2.D
 	return r0, r1
 }
 
-// DeleteTransitGatewayVpcAttachment provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteTransitGatewayVpcAttachment(ctx context.Context, params *ec2.DeleteTransitGatewayVpcAttachmentInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
+// DeleteTransitGatewayRouteTableAnnouncement provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteTransitGatewayRouteTableAnnouncement(ctx context.Context, params *ec2.DeleteTransitGatewayRouteTableAnnouncementInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayRouteTableAnnouncementOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5095,17 +5905,17 @@ func (_m *EC2) DeleteTransitGatewayVpcAttachment(ctx context.Context, params *ec
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteTransitGatewayVpcAttachmentOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteTransitGatewayVpcAttachmentInput, ...func(*ec2.Options)) *ec2.DeleteTransitGatewayVpcAttachmentOutput); ok {
+	var r0 *ec2.DeleteTransitGatewayRouteTableAnnouncementOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteTransitGatewayRouteTableAnnouncementInput, ...func(*ec2.Options)) *ec2.DeleteTransitGatewayRouteTableAnnouncementOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteTransitGatewayVpcAttachmentOutput)
+			r0 = ret.Get(0).(*ec2.DeleteTransitGatewayRouteTableAnnouncementOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteTransitGatewayVpcAttachmentInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteTransitGatewayRouteTableAnnouncementInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5114,8 +5924,8 @@ func (_m *EC2) DeleteTransitGatewayVpcAttachment(ctx context.Context, params *ec
 	return r0, r1
 }
 
-// DeleteVolume provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteVolume(ctx context.Context, params *ec2.DeleteVolumeInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVolumeOutput, error) {
+// DeleteTransitGatewayVpcAttachment provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteTransitGatewayVpcAttachment(ctx context.Context, params *ec2.DeleteTransitGatewayVpcAttachmentInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5125,17 +5935,17 @@ func (_m *EC2) DeleteVolume(ctx context.Context, params *ec2.DeleteVolumeInput,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteVolumeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVolumeInput, ...func(*ec2.Options)) *ec2.DeleteVolumeOutput); ok {
+	var r0 *ec2.DeleteTransitGatewayVpcAttachmentOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteTransitGatewayVpcAttachmentInput, ...func(*ec2.Options)) *ec2.DeleteTransitGatewayVpcAttachmentOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteVolumeOutput)
+			r0 = ret.Get(0).(*ec2.DeleteTransitGatewayVpcAttachmentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVolumeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteTransitGatewayVpcAttachmentInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5144,8 +5954,8 @@ func (_m *EC2) DeleteVolume(ctx context.Context, params *ec2.DeleteVolumeInput,
 	return r0, r1
 }
 
-// DeleteVpc provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteVpc(ctx context.Context, params *ec2.DeleteVpcInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcOutput, error) {
+// DeleteVerifiedAccessEndpoint provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVerifiedAccessEndpoint(ctx context.Context, params *ec2.DeleteVerifiedAccessEndpointInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVerifiedAccessEndpointOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5155,17 +5965,17 @@ func (_m *EC2) DeleteVpc(ctx context.Context, params *ec2.DeleteVpcInput, optFns
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteVpcOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcInput, ...func(*ec2.Options)) *ec2.DeleteVpcOutput); ok {
+	var r0 *ec2.DeleteVerifiedAccessEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVerifiedAccessEndpointInput, ...func(*ec2.Options)) *ec2.DeleteVerifiedAccessEndpointOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteVpcOutput)
+			r0 = ret.Get(0).(*ec2.DeleteVerifiedAccessEndpointOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVerifiedAccessEndpointInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5174,8 +5984,8 @@ func (_m *EC2) DeleteVpc(ctx context.Context, params *ec2.DeleteVpcInput, optFns
 	return r0, r1
 }
 
-// DeleteVpcEndpointConnectionNotifications provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteVpcEndpointConnectionNotifications(ctx context.Context, params *ec2.DeleteVpcEndpointConnectionNotificationsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointConnectionNotificationsOutput, error) {
+// DeleteVerifiedAccessGroup provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVerifiedAccessGroup(ctx context.Context, params *ec2.DeleteVerifiedAccessGroupInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVerifiedAccessGroupOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5185,17 +5995,17 @@ func (_m *EC2) DeleteVpcEndpointConnectionNotifications(ctx context.Context, par
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteVpcEndpointConnectionNotificationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcEndpointConnectionNotificationsInput, ...func(*ec2.Options)) *ec2.DeleteVpcEndpointConnectionNotificationsOutput); ok {
+	var r0 *ec2.DeleteVerifiedAccessGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVerifiedAccessGroupInput, ...func(*ec2.Options)) *ec2.DeleteVerifiedAccessGroupOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteVpcEndpointConnectionNotificationsOutput)
+			r0 = ret.Get(0).(*ec2.DeleteVerifiedAccessGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcEndpointConnectionNotificationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVerifiedAccessGroupInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5204,8 +6014,8 @@ func (_m *EC2) DeleteVpcEndpointConnectionNotifications(ctx context.Context, par
 	return r0, r1
 }
 
-// DeleteVpcEndpointServiceConfigurations provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteVpcEndpointServiceConfigurations(ctx context.Context, params *ec2.DeleteVpcEndpointServiceConfigurationsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+// DeleteVerifiedAccessInstance provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVerifiedAccessInstance(ctx context.Context, params *ec2.DeleteVerifiedAccessInstanceInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVerifiedAccessInstanceOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5215,17 +6025,17 @@ func (_m *EC2) DeleteVpcEndpointServiceConfigurations(ctx context.Context, param
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteVpcEndpointServiceConfigurationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcEndpointServiceConfigurationsInput, ...func(*ec2.Options)) *ec2.DeleteVpcEndpointServiceConfigurationsOutput); ok {
+	var r0 *ec2.DeleteVerifiedAccessInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVerifiedAccessInstanceInput, ...func(*ec2.Options)) *ec2.DeleteVerifiedAccessInstanceOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteVpcEndpointServiceConfigurationsOutput)
+			r0 = ret.Get(0).(*ec2.DeleteVerifiedAccessInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcEndpointServiceConfigurationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVerifiedAccessInstanceInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5234,8 +6044,8 @@ func (_m *EC2) DeleteVpcEndpointServiceConfigurations(ctx context.Context, param
 	return r0, r1
 }
 
-// DeleteVpcEndpoints provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteVpcEndpoints(ctx context.Context, params *ec2.DeleteVpcEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointsOutput, error) {
+// DeleteVerifiedAccessTrustProvider provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVerifiedAccessTrustProvider(ctx context.Context, params *ec2.DeleteVerifiedAccessTrustProviderInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVerifiedAccessTrustProviderOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5245,17 +6055,17 @@ func (_m *EC2) DeleteVpcEndpoints(ctx context.Context, params *ec2.DeleteVpcEndp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteVpcEndpointsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcEndpointsInput, ...func(*ec2.Options)) *ec2.DeleteVpcEndpointsOutput); ok {
+	var r0 *ec2.DeleteVerifiedAccessTrustProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) *ec2.DeleteVerifiedAccessTrustProviderOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteVpcEndpointsOutput)
+			r0 = ret.Get(0).(*ec2.DeleteVerifiedAccessTrustProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcEndpointsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5264,8 +6074,8 @@ func (_m *EC2) DeleteVpcEndpoints(ctx context.Context, params *ec2.DeleteVpcEndp
 	return r0, r1
 }
 
-// DeleteVpcPeeringConnection provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteVpcPeeringConnection(ctx context.Context, params *ec2.DeleteVpcPeeringConnectionInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcPeeringConnectionOutput, error) {
+// DeleteVolume provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVolume(ctx context.Context, params *ec2.DeleteVolumeInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVolumeOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5275,17 +6085,17 @@ func (_m *EC2) DeleteVpcPeeringConnection(ctx context.Context, params *ec2.Delet
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteVpcPeeringConnectionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcPeeringConnectionInput, ...func(*ec2.Options)) *ec2.DeleteVpcPeeringConnectionOutput); ok {
+	var r0 *ec2.DeleteVolumeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVolumeInput, ...func(*ec2.Options)) *ec2.DeleteVolumeOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteVpcPeeringConnectionOutput)
+			r0 = ret.Get(0).(*ec2.DeleteVolumeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcPeeringConnectionInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVolumeInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5294,8 +6104,8 @@ func (_m *EC2) DeleteVpcPeeringConnection(ctx context.Context, params *ec2.Delet
 	return r0, r1
 }
 
-// DeleteVpnConnection provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteVpnConnection(ctx context.Context, params *ec2.DeleteVpnConnectionInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionOutput, error) {
+// DeleteVpc provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVpc(ctx context.Context, params *ec2.DeleteVpcInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5305,17 +6115,17 @@ func (_m *EC2) DeleteVpnConnection(ctx context.Context, params *ec2.DeleteVpnCon
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteVpnConnectionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpnConnectionInput, ...func(*ec2.Options)) *ec2.DeleteVpnConnectionOutput); ok {
+	var r0 *ec2.DeleteVpcOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcInput, ...func(*ec2.Options)) *ec2.DeleteVpcOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteVpnConnectionOutput)
+			r0 = ret.Get(0).(*ec2.DeleteVpcOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpnConnectionInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5324,8 +6134,8 @@ func (_m *EC2) DeleteVpnConnection(ctx context.Context, params *ec2.DeleteVpnCon
 	return r0, r1
 }
 
-// DeleteVpnConnectionRoute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DeleteVpnConnectionRoute(ctx context.Context, params *ec2.DeleteVpnConnectionRouteInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionRouteOutput, error) {
+// DeleteVpcEndpointConnectionNotifications provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVpcEndpointConnectionNotifications(ctx context.Context, params *ec2.DeleteVpcEndpointConnectionNotificationsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointConnectionNotificationsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -5335,17 +6145,167 @@ func (_m *EC2) DeleteVpnConnectionRoute(ctx context.Context, params *ec2.DeleteV
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DeleteVpnConnectionRouteOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpnConnectionRouteInput, ...func(*ec2.Options)) *ec2.DeleteVpnConnectionRouteOutput); ok {
+	var r0 *ec2.DeleteVpcEndpointConnectionNotificationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcEndpointConnectionNotificationsInput, ...func(*ec2.Options)) *ec2.DeleteVpcEndpointConnectionNotificationsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DeleteVpnConnectionRouteOutput)
+			r0 = ret.Get(0).(*ec2.DeleteVpcEndpointConnectionNotificationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpnConnectionRouteInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcEndpointConnectionNotificationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVpcEndpointServiceConfigurations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVpcEndpointServiceConfigurations(ctx context.Context, params *ec2.DeleteVpcEndpointServiceConfigurationsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteVpcEndpointServiceConfigurationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcEndpointServiceConfigurationsInput, ...func(*ec2.Options)) *ec2.DeleteVpcEndpointServiceConfigurationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteVpcEndpointServiceConfigurationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcEndpointServiceConfigurationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVpcEndpoints provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVpcEndpoints(ctx context.Context, params *ec2.DeleteVpcEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteVpcEndpointsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcEndpointsInput, ...func(*ec2.Options)) *ec2.DeleteVpcEndpointsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteVpcEndpointsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcEndpointsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVpcPeeringConnection provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVpcPeeringConnection(ctx context.Context, params *ec2.DeleteVpcPeeringConnectionInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcPeeringConnectionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteVpcPeeringConnectionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpcPeeringConnectionInput, ...func(*ec2.Options)) *ec2.DeleteVpcPeeringConnectionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteVpcPeeringConnectionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpcPeeringConnectionInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVpnConnection provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVpnConnection(ctx context.Context, params *ec2.DeleteVpnConnectionInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteVpnConnectionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpnConnectionInput, ...func(*ec2.Options)) *ec2.DeleteVpnConnectionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteVpnConnectionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpnConnectionInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVpnConnectionRoute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeleteVpnConnectionRoute(ctx context.Context, params *ec2.DeleteVpnConnectionRouteInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionRouteOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeleteVpnConnectionRouteOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeleteVpnConnectionRouteInput, ...func(*ec2.Options)) *ec2.DeleteVpnConnectionRouteOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeleteVpnConnectionRouteOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeleteVpnConnectionRouteInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -5414,6 +6374,36 @@ func (_m *EC2) DeprovisionByoipCidr(ctx context.Context, params *ec2.Deprovision
 	return r0, r1
 }
 
+// DeprovisionIpamByoasn provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DeprovisionIpamByoasn(ctx context.Context, params *ec2.DeprovisionIpamByoasnInput, optFns ...func(*ec2.Options)) (*ec2.DeprovisionIpamByoasnOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DeprovisionIpamByoasnOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DeprovisionIpamByoasnInput, ...func(*ec2.Options)) *ec2.DeprovisionIpamByoasnOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DeprovisionIpamByoasnOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DeprovisionIpamByoasnInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeprovisionIpamPoolCidr provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DeprovisionIpamPoolCidr(ctx context.Context, params *ec2.DeprovisionIpamPoolCidrInput, optFns ...func(*ec2.Options)) (*ec2.DeprovisionIpamPoolCidrOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -5624,6 +6614,36 @@ func (_m *EC2) DescribeAccountAttributes(ctx context.Context, params *ec2.Descri
 	return r0, r1
 }
 
+// DescribeAddressTransfers provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeAddressTransfers(ctx context.Context, params *ec2.DescribeAddressTransfersInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressTransfersOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeAddressTransfersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeAddressTransfersInput, ...func(*ec2.Options)) *ec2.DescribeAddressTransfersOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeAddressTransfersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeAddressTransfersInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeAddresses provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -5744,6 +6764,36 @@ func (_m *EC2) DescribeAvailabilityZones(ctx context.Context, params *ec2.Descri
 	return r0, r1
 }
 
+// DescribeAwsNetworkPerformanceMetricSubscriptions provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeAwsNetworkPerformanceMetricSubscriptions(ctx context.Context, params *ec2.DescribeAwsNetworkPerformanceMetricSubscriptionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeAwsNetworkPerformanceMetricSubscriptionsInput, ...func(*ec2.Options)) *ec2.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeAwsNetworkPerformanceMetricSubscriptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeAwsNetworkPerformanceMetricSubscriptionsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeBundleTasks provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeBundleTasks(ctx context.Context, params *ec2.DescribeBundleTasksInput, optFns ...func(*ec2.Options)) (*ec2.DescribeBundleTasksOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -5804,6 +6854,36 @@ func (_m *EC2) DescribeByoipCidrs(ctx context.Context, params *ec2.DescribeByoip
 	return r0, r1
 }
 
+// DescribeCapacityBlockOfferings provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeCapacityBlockOfferings(ctx context.Context, params *ec2.DescribeCapacityBlockOfferingsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeCapacityBlockOfferingsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeCapacityBlockOfferingsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeCapacityBlockOfferingsInput, ...func(*ec2.Options)) *ec2.DescribeCapacityBlockOfferingsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeCapacityBlockOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeCapacityBlockOfferingsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeCapacityReservationFleets provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeCapacityReservationFleets(ctx context.Context, params *ec2.DescribeCapacityReservationFleetsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeCapacityReservationFleetsOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -6884,8 +7964,8 @@ func (_m *EC2) DescribeInstanceAttribute(ctx context.Context, params *ec2.Descri
 	return r0, r1
 }
 
-// DescribeInstanceCreditSpecifications provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DescribeInstanceCreditSpecifications(ctx context.Context, params *ec2.DescribeInstanceCreditSpecificationsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceCreditSpecificationsOutput, error) {
+// DescribeInstanceConnectEndpoints provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeInstanceConnectEndpoints(ctx context.Context, params *ec2.DescribeInstanceConnectEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceConnectEndpointsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -6895,17 +7975,17 @@ func (_m *EC2) DescribeInstanceCreditSpecifications(ctx context.Context, params
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DescribeInstanceCreditSpecificationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeInstanceCreditSpecificationsInput, ...func(*ec2.Options)) *ec2.DescribeInstanceCreditSpecificationsOutput); ok {
+	var r0 *ec2.DescribeInstanceConnectEndpointsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeInstanceConnectEndpointsInput, ...func(*ec2.Options)) *ec2.DescribeInstanceConnectEndpointsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DescribeInstanceCreditSpecificationsOutput)
+			r0 = ret.Get(0).(*ec2.DescribeInstanceConnectEndpointsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeInstanceCreditSpecificationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeInstanceConnectEndpointsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -6914,8 +7994,38 @@ func (_m *EC2) DescribeInstanceCreditSpecifications(ctx context.Context, params
 	return r0, r1
 }
 
-// DescribeInstanceEventNotificationAttributes provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DescribeInstanceEventNotificationAttributes(ctx context.Context, params *ec2.DescribeInstanceEventNotificationAttributesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceEventNotificationAttributesOutput, error) {
+// DescribeInstanceCreditSpecifications provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeInstanceCreditSpecifications(ctx context.Context, params *ec2.DescribeInstanceCreditSpecificationsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceCreditSpecificationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeInstanceCreditSpecificationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeInstanceCreditSpecificationsInput, ...func(*ec2.Options)) *ec2.DescribeInstanceCreditSpecificationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeInstanceCreditSpecificationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeInstanceCreditSpecificationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeInstanceEventNotificationAttributes provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeInstanceEventNotificationAttributes(ctx context.Context, params *ec2.DescribeInstanceEventNotificationAttributesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceEventNotificationAttributesOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -7004,6 +8114,36 @@ func (_m *EC2) DescribeInstanceStatus(ctx context.Context, params *ec2.DescribeI
 	return r0, r1
 }
 
+// DescribeInstanceTopology provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeInstanceTopology(ctx context.Context, params *ec2.DescribeInstanceTopologyInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceTopologyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeInstanceTopologyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeInstanceTopologyInput, ...func(*ec2.Options)) *ec2.DescribeInstanceTopologyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeInstanceTopologyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeInstanceTopologyInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeInstanceTypeOfferings provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeInstanceTypeOfferings(ctx context.Context, params *ec2.DescribeInstanceTypeOfferingsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -7124,6 +8264,36 @@ func (_m *EC2) DescribeInternetGateways(ctx context.Context, params *ec2.Describ
 	return r0, r1
 }
 
+// DescribeIpamByoasn provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeIpamByoasn(ctx context.Context, params *ec2.DescribeIpamByoasnInput, optFns ...func(*ec2.Options)) (*ec2.DescribeIpamByoasnOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeIpamByoasnOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeIpamByoasnInput, ...func(*ec2.Options)) *ec2.DescribeIpamByoasnOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeIpamByoasnOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeIpamByoasnInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeIpamPools provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeIpamPools(ctx context.Context, params *ec2.DescribeIpamPoolsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeIpamPoolsOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -7154,6 +8324,66 @@ func (_m *EC2) DescribeIpamPools(ctx context.Context, params *ec2.DescribeIpamPo
 	return r0, r1
 }
 
+// DescribeIpamResourceDiscoveries provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeIpamResourceDiscoveries(ctx context.Context, params *ec2.DescribeIpamResourceDiscoveriesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeIpamResourceDiscoveriesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeIpamResourceDiscoveriesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeIpamResourceDiscoveriesInput, ...func(*ec2.Options)) *ec2.DescribeIpamResourceDiscoveriesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeIpamResourceDiscoveriesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeIpamResourceDiscoveriesInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeIpamResourceDiscoveryAssociations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeIpamResourceDiscoveryAssociations(ctx context.Context, params *ec2.DescribeIpamResourceDiscoveryAssociationsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeIpamResourceDiscoveryAssociationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeIpamResourceDiscoveryAssociationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeIpamResourceDiscoveryAssociationsInput, ...func(*ec2.Options)) *ec2.DescribeIpamResourceDiscoveryAssociationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeIpamResourceDiscoveryAssociationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeIpamResourceDiscoveryAssociationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeIpamScopes provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeIpamScopes(ctx context.Context, params *ec2.DescribeIpamScopesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeIpamScopesOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -7514,6 +8744,66 @@ func (_m *EC2) DescribeLocalGateways(ctx context.Context, params *ec2.DescribeLo
 	return r0, r1
 }
 
+// DescribeLockedSnapshots provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeLockedSnapshots(ctx context.Context, params *ec2.DescribeLockedSnapshotsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLockedSnapshotsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeLockedSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeLockedSnapshotsInput, ...func(*ec2.Options)) *ec2.DescribeLockedSnapshotsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeLockedSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeLockedSnapshotsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeMacHosts provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeMacHosts(ctx context.Context, params *ec2.DescribeMacHostsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeMacHostsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeMacHostsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeMacHostsInput, ...func(*ec2.Options)) *ec2.DescribeMacHostsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeMacHostsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeMacHostsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeManagedPrefixLists provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeManagedPrefixLists(ctx context.Context, params *ec2.DescribeManagedPrefixListsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -8714,6 +10004,36 @@ func (_m *EC2) DescribeTags(ctx context.Context, params *ec2.DescribeTagsInput,
 	return r0, r1
 }
 
+// DescribeTrafficMirrorFilterRules provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeTrafficMirrorFilterRules(ctx context.Context, params *ec2.DescribeTrafficMirrorFilterRulesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTrafficMirrorFilterRulesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeTrafficMirrorFilterRulesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeTrafficMirrorFilterRulesInput, ...func(*ec2.Options)) *ec2.DescribeTrafficMirrorFilterRulesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeTrafficMirrorFilterRulesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeTrafficMirrorFilterRulesInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeTrafficMirrorFilters provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeTrafficMirrorFilters(ctx context.Context, params *ec2.DescribeTrafficMirrorFiltersInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTrafficMirrorFiltersOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -8954,6 +10274,66 @@ func (_m *EC2) DescribeTransitGatewayPeeringAttachments(ctx context.Context, par
 	return r0, r1
 }
 
+// DescribeTransitGatewayPolicyTables provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeTransitGatewayPolicyTables(ctx context.Context, params *ec2.DescribeTransitGatewayPolicyTablesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayPolicyTablesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeTransitGatewayPolicyTablesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeTransitGatewayPolicyTablesInput, ...func(*ec2.Options)) *ec2.DescribeTransitGatewayPolicyTablesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeTransitGatewayPolicyTablesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeTransitGatewayPolicyTablesInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTransitGatewayRouteTableAnnouncements provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeTransitGatewayRouteTableAnnouncements(ctx context.Context, params *ec2.DescribeTransitGatewayRouteTableAnnouncementsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayRouteTableAnnouncementsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeTransitGatewayRouteTableAnnouncementsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeTransitGatewayRouteTableAnnouncementsInput, ...func(*ec2.Options)) *ec2.DescribeTransitGatewayRouteTableAnnouncementsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeTransitGatewayRouteTableAnnouncementsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeTransitGatewayRouteTableAnnouncementsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeTransitGatewayRouteTables provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DescribeTransitGatewayRouteTables(ctx context.Context, params *ec2.DescribeTransitGatewayRouteTablesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayRouteTablesOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -9074,8 +10454,8 @@ func (_m *EC2) DescribeTrunkInterfaceAssociations(ctx context.Context, params *e
 	return r0, r1
 }
 
-// DescribeVolumeAttribute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DescribeVolumeAttribute(ctx context.Context, params *ec2.DescribeVolumeAttributeInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumeAttributeOutput, error) {
+// DescribeVerifiedAccessEndpoints provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeVerifiedAccessEndpoints(ctx context.Context, params *ec2.DescribeVerifiedAccessEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVerifiedAccessEndpointsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9085,17 +10465,17 @@ func (_m *EC2) DescribeVolumeAttribute(ctx context.Context, params *ec2.Describe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DescribeVolumeAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVolumeAttributeInput, ...func(*ec2.Options)) *ec2.DescribeVolumeAttributeOutput); ok {
+	var r0 *ec2.DescribeVerifiedAccessEndpointsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVerifiedAccessEndpointsInput, ...func(*ec2.Options)) *ec2.DescribeVerifiedAccessEndpointsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DescribeVolumeAttributeOutput)
+			r0 = ret.Get(0).(*ec2.DescribeVerifiedAccessEndpointsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeVolumeAttributeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeVerifiedAccessEndpointsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9104,8 +10484,8 @@ func (_m *EC2) DescribeVolumeAttribute(ctx context.Context, params *ec2.Describe
 	return r0, r1
 }
 
-// DescribeVolumeStatus provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DescribeVolumeStatus(ctx context.Context, params *ec2.DescribeVolumeStatusInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumeStatusOutput, error) {
+// DescribeVerifiedAccessGroups provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeVerifiedAccessGroups(ctx context.Context, params *ec2.DescribeVerifiedAccessGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVerifiedAccessGroupsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9115,8 +10495,158 @@ func (_m *EC2) DescribeVolumeStatus(ctx context.Context, params *ec2.DescribeVol
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DescribeVolumeStatusOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVolumeStatusInput, ...func(*ec2.Options)) *ec2.DescribeVolumeStatusOutput); ok {
+	var r0 *ec2.DescribeVerifiedAccessGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVerifiedAccessGroupsInput, ...func(*ec2.Options)) *ec2.DescribeVerifiedAccessGroupsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeVerifiedAccessGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeVerifiedAccessGroupsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeVerifiedAccessInstanceLoggingConfigurations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeVerifiedAccessInstanceLoggingConfigurations(ctx context.Context, params *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsInput, ...func(*ec2.Options)) *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeVerifiedAccessInstances provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeVerifiedAccessInstances(ctx context.Context, params *ec2.DescribeVerifiedAccessInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVerifiedAccessInstancesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeVerifiedAccessInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVerifiedAccessInstancesInput, ...func(*ec2.Options)) *ec2.DescribeVerifiedAccessInstancesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeVerifiedAccessInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeVerifiedAccessInstancesInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeVerifiedAccessTrustProviders provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeVerifiedAccessTrustProviders(ctx context.Context, params *ec2.DescribeVerifiedAccessTrustProvidersInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVerifiedAccessTrustProvidersOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeVerifiedAccessTrustProvidersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVerifiedAccessTrustProvidersInput, ...func(*ec2.Options)) *ec2.DescribeVerifiedAccessTrustProvidersOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeVerifiedAccessTrustProvidersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeVerifiedAccessTrustProvidersInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeVolumeAttribute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeVolumeAttribute(ctx context.Context, params *ec2.DescribeVolumeAttributeInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumeAttributeOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeVolumeAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVolumeAttributeInput, ...func(*ec2.Options)) *ec2.DescribeVolumeAttributeOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DescribeVolumeAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DescribeVolumeAttributeInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeVolumeStatus provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DescribeVolumeStatus(ctx context.Context, params *ec2.DescribeVolumeStatusInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumeStatusOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DescribeVolumeStatusOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DescribeVolumeStatusInput, ...func(*ec2.Options)) *ec2.DescribeVolumeStatusOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
@@ -9674,6 +11204,36 @@ func (_m *EC2) DetachNetworkInterface(ctx context.Context, params *ec2.DetachNet
 	return r0, r1
 }
 
+// DetachVerifiedAccessTrustProvider provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DetachVerifiedAccessTrustProvider(ctx context.Context, params *ec2.DetachVerifiedAccessTrustProviderInput, optFns ...func(*ec2.Options)) (*ec2.DetachVerifiedAccessTrustProviderOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.DetachVerifiedAccessTrustProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DetachVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) *ec2.DetachVerifiedAccessTrustProviderOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.DetachVerifiedAccessTrustProviderOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DetachVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DetachVolume provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) DetachVolume(ctx context.Context, params *ec2.DetachVolumeInput, optFns ...func(*ec2.Options)) (*ec2.DetachVolumeOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -9734,8 +11294,8 @@ func (_m *EC2) DetachVpnGateway(ctx context.Context, params *ec2.DetachVpnGatewa
 	return r0, r1
 }
 
-// DisableEbsEncryptionByDefault provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableEbsEncryptionByDefault(ctx context.Context, params *ec2.DisableEbsEncryptionByDefaultInput, optFns ...func(*ec2.Options)) (*ec2.DisableEbsEncryptionByDefaultOutput, error) {
+// DisableAddressTransfer provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableAddressTransfer(ctx context.Context, params *ec2.DisableAddressTransferInput, optFns ...func(*ec2.Options)) (*ec2.DisableAddressTransferOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9745,17 +11305,17 @@ func (_m *EC2) DisableEbsEncryptionByDefault(ctx context.Context, params *ec2.Di
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableEbsEncryptionByDefaultOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) *ec2.DisableEbsEncryptionByDefaultOutput); ok {
+	var r0 *ec2.DisableAddressTransferOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableAddressTransferInput, ...func(*ec2.Options)) *ec2.DisableAddressTransferOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableEbsEncryptionByDefaultOutput)
+			r0 = ret.Get(0).(*ec2.DisableAddressTransferOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableAddressTransferInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9764,8 +11324,8 @@ func (_m *EC2) DisableEbsEncryptionByDefault(ctx context.Context, params *ec2.Di
 	return r0, r1
 }
 
-// DisableFastLaunch provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableFastLaunch(ctx context.Context, params *ec2.DisableFastLaunchInput, optFns ...func(*ec2.Options)) (*ec2.DisableFastLaunchOutput, error) {
+// DisableAwsNetworkPerformanceMetricSubscription provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableAwsNetworkPerformanceMetricSubscription(ctx context.Context, params *ec2.DisableAwsNetworkPerformanceMetricSubscriptionInput, optFns ...func(*ec2.Options)) (*ec2.DisableAwsNetworkPerformanceMetricSubscriptionOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9775,17 +11335,17 @@ func (_m *EC2) DisableFastLaunch(ctx context.Context, params *ec2.DisableFastLau
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableFastLaunchOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableFastLaunchInput, ...func(*ec2.Options)) *ec2.DisableFastLaunchOutput); ok {
+	var r0 *ec2.DisableAwsNetworkPerformanceMetricSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableAwsNetworkPerformanceMetricSubscriptionInput, ...func(*ec2.Options)) *ec2.DisableAwsNetworkPerformanceMetricSubscriptionOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableFastLaunchOutput)
+			r0 = ret.Get(0).(*ec2.DisableAwsNetworkPerformanceMetricSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableFastLaunchInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableAwsNetworkPerformanceMetricSubscriptionInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9794,8 +11354,8 @@ func (_m *EC2) DisableFastLaunch(ctx context.Context, params *ec2.DisableFastLau
 	return r0, r1
 }
 
-// DisableFastSnapshotRestores provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableFastSnapshotRestores(ctx context.Context, params *ec2.DisableFastSnapshotRestoresInput, optFns ...func(*ec2.Options)) (*ec2.DisableFastSnapshotRestoresOutput, error) {
+// DisableEbsEncryptionByDefault provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableEbsEncryptionByDefault(ctx context.Context, params *ec2.DisableEbsEncryptionByDefaultInput, optFns ...func(*ec2.Options)) (*ec2.DisableEbsEncryptionByDefaultOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9805,17 +11365,17 @@ func (_m *EC2) DisableFastSnapshotRestores(ctx context.Context, params *ec2.Disa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableFastSnapshotRestoresOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableFastSnapshotRestoresInput, ...func(*ec2.Options)) *ec2.DisableFastSnapshotRestoresOutput); ok {
+	var r0 *ec2.DisableEbsEncryptionByDefaultOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) *ec2.DisableEbsEncryptionByDefaultOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableFastSnapshotRestoresOutput)
+			r0 = ret.Get(0).(*ec2.DisableEbsEncryptionByDefaultOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableFastSnapshotRestoresInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9824,8 +11384,8 @@ func (_m *EC2) DisableFastSnapshotRestores(ctx context.Context, params *ec2.Disa
 	return r0, r1
 }
 
-// DisableImageDeprecation provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableImageDeprecation(ctx context.Context, params *ec2.DisableImageDeprecationInput, optFns ...func(*ec2.Options)) (*ec2.DisableImageDeprecationOutput, error) {
+// DisableFastLaunch provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableFastLaunch(ctx context.Context, params *ec2.DisableFastLaunchInput, optFns ...func(*ec2.Options)) (*ec2.DisableFastLaunchOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9835,17 +11395,17 @@ func (_m *EC2) DisableImageDeprecation(ctx context.Context, params *ec2.DisableI
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableImageDeprecationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableImageDeprecationInput, ...func(*ec2.Options)) *ec2.DisableImageDeprecationOutput); ok {
+	var r0 *ec2.DisableFastLaunchOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableFastLaunchInput, ...func(*ec2.Options)) *ec2.DisableFastLaunchOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableImageDeprecationOutput)
+			r0 = ret.Get(0).(*ec2.DisableFastLaunchOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableImageDeprecationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableFastLaunchInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9854,8 +11414,8 @@ func (_m *EC2) DisableImageDeprecation(ctx context.Context, params *ec2.DisableI
 	return r0, r1
 }
 
-// DisableIpamOrganizationAdminAccount provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableIpamOrganizationAdminAccount(ctx context.Context, params *ec2.DisableIpamOrganizationAdminAccountInput, optFns ...func(*ec2.Options)) (*ec2.DisableIpamOrganizationAdminAccountOutput, error) {
+// DisableFastSnapshotRestores provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableFastSnapshotRestores(ctx context.Context, params *ec2.DisableFastSnapshotRestoresInput, optFns ...func(*ec2.Options)) (*ec2.DisableFastSnapshotRestoresOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9865,17 +11425,17 @@ func (_m *EC2) DisableIpamOrganizationAdminAccount(ctx context.Context, params *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableIpamOrganizationAdminAccountOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableIpamOrganizationAdminAccountInput, ...func(*ec2.Options)) *ec2.DisableIpamOrganizationAdminAccountOutput); ok {
+	var r0 *ec2.DisableFastSnapshotRestoresOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableFastSnapshotRestoresInput, ...func(*ec2.Options)) *ec2.DisableFastSnapshotRestoresOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableIpamOrganizationAdminAccountOutput)
+			r0 = ret.Get(0).(*ec2.DisableFastSnapshotRestoresOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableIpamOrganizationAdminAccountInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableFastSnapshotRestoresInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9884,8 +11444,8 @@ func (_m *EC2) DisableIpamOrganizationAdminAccount(ctx context.Context, params *
 	return r0, r1
 }
 
-// DisableSerialConsoleAccess provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableSerialConsoleAccess(ctx context.Context, params *ec2.DisableSerialConsoleAccessInput, optFns ...func(*ec2.Options)) (*ec2.DisableSerialConsoleAccessOutput, error) {
+// DisableImage provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableImage(ctx context.Context, params *ec2.DisableImageInput, optFns ...func(*ec2.Options)) (*ec2.DisableImageOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9895,17 +11455,17 @@ func (_m *EC2) DisableSerialConsoleAccess(ctx context.Context, params *ec2.Disab
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableSerialConsoleAccessOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableSerialConsoleAccessInput, ...func(*ec2.Options)) *ec2.DisableSerialConsoleAccessOutput); ok {
+	var r0 *ec2.DisableImageOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableImageInput, ...func(*ec2.Options)) *ec2.DisableImageOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableSerialConsoleAccessOutput)
+			r0 = ret.Get(0).(*ec2.DisableImageOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableSerialConsoleAccessInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableImageInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9914,8 +11474,8 @@ func (_m *EC2) DisableSerialConsoleAccess(ctx context.Context, params *ec2.Disab
 	return r0, r1
 }
 
-// DisableTransitGatewayRouteTablePropagation provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableTransitGatewayRouteTablePropagation(ctx context.Context, params *ec2.DisableTransitGatewayRouteTablePropagationInput, optFns ...func(*ec2.Options)) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+// DisableImageBlockPublicAccess provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableImageBlockPublicAccess(ctx context.Context, params *ec2.DisableImageBlockPublicAccessInput, optFns ...func(*ec2.Options)) (*ec2.DisableImageBlockPublicAccessOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9925,17 +11485,17 @@ func (_m *EC2) DisableTransitGatewayRouteTablePropagation(ctx context.Context, p
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableTransitGatewayRouteTablePropagationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableTransitGatewayRouteTablePropagationInput, ...func(*ec2.Options)) *ec2.DisableTransitGatewayRouteTablePropagationOutput); ok {
+	var r0 *ec2.DisableImageBlockPublicAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableImageBlockPublicAccessInput, ...func(*ec2.Options)) *ec2.DisableImageBlockPublicAccessOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableTransitGatewayRouteTablePropagationOutput)
+			r0 = ret.Get(0).(*ec2.DisableImageBlockPublicAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableTransitGatewayRouteTablePropagationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableImageBlockPublicAccessInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9944,8 +11504,8 @@ func (_m *EC2) DisableTransitGatewayRouteTablePropagation(ctx context.Context, p
 	return r0, r1
 }
 
-// DisableVgwRoutePropagation provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableVgwRoutePropagation(ctx context.Context, params *ec2.DisableVgwRoutePropagationInput, optFns ...func(*ec2.Options)) (*ec2.DisableVgwRoutePropagationOutput, error) {
+// DisableImageDeprecation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableImageDeprecation(ctx context.Context, params *ec2.DisableImageDeprecationInput, optFns ...func(*ec2.Options)) (*ec2.DisableImageDeprecationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9955,17 +11515,17 @@ func (_m *EC2) DisableVgwRoutePropagation(ctx context.Context, params *ec2.Disab
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableVgwRoutePropagationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableVgwRoutePropagationInput, ...func(*ec2.Options)) *ec2.DisableVgwRoutePropagationOutput); ok {
+	var r0 *ec2.DisableImageDeprecationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableImageDeprecationInput, ...func(*ec2.Options)) *ec2.DisableImageDeprecationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableVgwRoutePropagationOutput)
+			r0 = ret.Get(0).(*ec2.DisableImageDeprecationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableVgwRoutePropagationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableImageDeprecationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -9974,8 +11534,8 @@ func (_m *EC2) DisableVgwRoutePropagation(ctx context.Context, params *ec2.Disab
 	return r0, r1
 }
 
-// DisableVpcClassicLink provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableVpcClassicLink(ctx context.Context, params *ec2.DisableVpcClassicLinkInput, optFns ...func(*ec2.Options)) (*ec2.DisableVpcClassicLinkOutput, error) {
+// DisableImageDeregistrationProtection provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableImageDeregistrationProtection(ctx context.Context, params *ec2.DisableImageDeregistrationProtectionInput, optFns ...func(*ec2.Options)) (*ec2.DisableImageDeregistrationProtectionOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -9985,17 +11545,17 @@ func (_m *EC2) DisableVpcClassicLink(ctx context.Context, params *ec2.DisableVpc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableVpcClassicLinkOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableVpcClassicLinkInput, ...func(*ec2.Options)) *ec2.DisableVpcClassicLinkOutput); ok {
+	var r0 *ec2.DisableImageDeregistrationProtectionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableImageDeregistrationProtectionInput, ...func(*ec2.Options)) *ec2.DisableImageDeregistrationProtectionOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableVpcClassicLinkOutput)
+			r0 = ret.Get(0).(*ec2.DisableImageDeregistrationProtectionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableVpcClassicLinkInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableImageDeregistrationProtectionInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10004,8 +11564,8 @@ func (_m *EC2) DisableVpcClassicLink(ctx context.Context, params *ec2.DisableVpc
 	return r0, r1
 }
 
-// DisableVpcClassicLinkDnsSupport provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisableVpcClassicLinkDnsSupport(ctx context.Context, params *ec2.DisableVpcClassicLinkDnsSupportInput, optFns ...func(*ec2.Options)) (*ec2.DisableVpcClassicLinkDnsSupportOutput, error) {
+// DisableIpamOrganizationAdminAccount provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableIpamOrganizationAdminAccount(ctx context.Context, params *ec2.DisableIpamOrganizationAdminAccountInput, optFns ...func(*ec2.Options)) (*ec2.DisableIpamOrganizationAdminAccountOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10015,17 +11575,17 @@ func (_m *EC2) DisableVpcClassicLinkDnsSupport(ctx context.Context, params *ec2.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisableVpcClassicLinkDnsSupportOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableVpcClassicLinkDnsSupportInput, ...func(*ec2.Options)) *ec2.DisableVpcClassicLinkDnsSupportOutput); ok {
+	var r0 *ec2.DisableIpamOrganizationAdminAccountOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableIpamOrganizationAdminAccountInput, ...func(*ec2.Options)) *ec2.DisableIpamOrganizationAdminAccountOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisableVpcClassicLinkDnsSupportOutput)
+			r0 = ret.Get(0).(*ec2.DisableIpamOrganizationAdminAccountOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableVpcClassicLinkDnsSupportInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableIpamOrganizationAdminAccountInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10034,8 +11594,8 @@ func (_m *EC2) DisableVpcClassicLinkDnsSupport(ctx context.Context, params *ec2.
 	return r0, r1
 }
 
-// DisassociateAddress provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateAddress(ctx context.Context, params *ec2.DisassociateAddressInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateAddressOutput, error) {
+// DisableSerialConsoleAccess provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableSerialConsoleAccess(ctx context.Context, params *ec2.DisableSerialConsoleAccessInput, optFns ...func(*ec2.Options)) (*ec2.DisableSerialConsoleAccessOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10045,17 +11605,17 @@ func (_m *EC2) DisassociateAddress(ctx context.Context, params *ec2.Disassociate
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateAddressOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateAddressInput, ...func(*ec2.Options)) *ec2.DisassociateAddressOutput); ok {
+	var r0 *ec2.DisableSerialConsoleAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableSerialConsoleAccessInput, ...func(*ec2.Options)) *ec2.DisableSerialConsoleAccessOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateAddressOutput)
+			r0 = ret.Get(0).(*ec2.DisableSerialConsoleAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateAddressInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableSerialConsoleAccessInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10064,8 +11624,8 @@ func (_m *EC2) DisassociateAddress(ctx context.Context, params *ec2.Disassociate
 	return r0, r1
 }
 
-// DisassociateClientVpnTargetNetwork provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateClientVpnTargetNetwork(ctx context.Context, params *ec2.DisassociateClientVpnTargetNetworkInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateClientVpnTargetNetworkOutput, error) {
+// DisableSnapshotBlockPublicAccess provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableSnapshotBlockPublicAccess(ctx context.Context, params *ec2.DisableSnapshotBlockPublicAccessInput, optFns ...func(*ec2.Options)) (*ec2.DisableSnapshotBlockPublicAccessOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10075,17 +11635,17 @@ func (_m *EC2) DisassociateClientVpnTargetNetwork(ctx context.Context, params *e
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateClientVpnTargetNetworkOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateClientVpnTargetNetworkInput, ...func(*ec2.Options)) *ec2.DisassociateClientVpnTargetNetworkOutput); ok {
+	var r0 *ec2.DisableSnapshotBlockPublicAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableSnapshotBlockPublicAccessInput, ...func(*ec2.Options)) *ec2.DisableSnapshotBlockPublicAccessOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateClientVpnTargetNetworkOutput)
+			r0 = ret.Get(0).(*ec2.DisableSnapshotBlockPublicAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateClientVpnTargetNetworkInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableSnapshotBlockPublicAccessInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10094,8 +11654,8 @@ func (_m *EC2) DisassociateClientVpnTargetNetwork(ctx context.Context, params *e
 	return r0, r1
 }
 
-// DisassociateEnclaveCertificateIamRole provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateEnclaveCertificateIamRole(ctx context.Context, params *ec2.DisassociateEnclaveCertificateIamRoleInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateEnclaveCertificateIamRoleOutput, error) {
+// DisableTransitGatewayRouteTablePropagation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableTransitGatewayRouteTablePropagation(ctx context.Context, params *ec2.DisableTransitGatewayRouteTablePropagationInput, optFns ...func(*ec2.Options)) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10105,17 +11665,17 @@ func (_m *EC2) DisassociateEnclaveCertificateIamRole(ctx context.Context, params
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateEnclaveCertificateIamRoleOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateEnclaveCertificateIamRoleInput, ...func(*ec2.Options)) *ec2.DisassociateEnclaveCertificateIamRoleOutput); ok {
+	var r0 *ec2.DisableTransitGatewayRouteTablePropagationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableTransitGatewayRouteTablePropagationInput, ...func(*ec2.Options)) *ec2.DisableTransitGatewayRouteTablePropagationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateEnclaveCertificateIamRoleOutput)
+			r0 = ret.Get(0).(*ec2.DisableTransitGatewayRouteTablePropagationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateEnclaveCertificateIamRoleInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableTransitGatewayRouteTablePropagationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10124,8 +11684,8 @@ func (_m *EC2) DisassociateEnclaveCertificateIamRole(ctx context.Context, params
 	return r0, r1
 }
 
-// DisassociateIamInstanceProfile provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateIamInstanceProfile(ctx context.Context, params *ec2.DisassociateIamInstanceProfileInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateIamInstanceProfileOutput, error) {
+// DisableVgwRoutePropagation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableVgwRoutePropagation(ctx context.Context, params *ec2.DisableVgwRoutePropagationInput, optFns ...func(*ec2.Options)) (*ec2.DisableVgwRoutePropagationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10135,17 +11695,17 @@ func (_m *EC2) DisassociateIamInstanceProfile(ctx context.Context, params *ec2.D
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateIamInstanceProfileOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateIamInstanceProfileInput, ...func(*ec2.Options)) *ec2.DisassociateIamInstanceProfileOutput); ok {
+	var r0 *ec2.DisableVgwRoutePropagationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableVgwRoutePropagationInput, ...func(*ec2.Options)) *ec2.DisableVgwRoutePropagationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateIamInstanceProfileOutput)
+			r0 = ret.Get(0).(*ec2.DisableVgwRoutePropagationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateIamInstanceProfileInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableVgwRoutePropagationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10154,8 +11714,8 @@ func (_m *EC2) DisassociateIamInstanceProfile(ctx context.Context, params *ec2.D
 	return r0, r1
 }
 
-// DisassociateInstanceEventWindow provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateInstanceEventWindow(ctx context.Context, params *ec2.DisassociateInstanceEventWindowInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateInstanceEventWindowOutput, error) {
+// DisableVpcClassicLink provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableVpcClassicLink(ctx context.Context, params *ec2.DisableVpcClassicLinkInput, optFns ...func(*ec2.Options)) (*ec2.DisableVpcClassicLinkOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10165,17 +11725,17 @@ func (_m *EC2) DisassociateInstanceEventWindow(ctx context.Context, params *ec2.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateInstanceEventWindowOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateInstanceEventWindowInput, ...func(*ec2.Options)) *ec2.DisassociateInstanceEventWindowOutput); ok {
+	var r0 *ec2.DisableVpcClassicLinkOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableVpcClassicLinkInput, ...func(*ec2.Options)) *ec2.DisableVpcClassicLinkOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateInstanceEventWindowOutput)
+			r0 = ret.Get(0).(*ec2.DisableVpcClassicLinkOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateInstanceEventWindowInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableVpcClassicLinkInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10184,8 +11744,8 @@ func (_m *EC2) DisassociateInstanceEventWindow(ctx context.Context, params *ec2.
 	return r0, r1
 }
 
-// DisassociateRouteTable provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateRouteTable(ctx context.Context, params *ec2.DisassociateRouteTableInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateRouteTableOutput, error) {
+// DisableVpcClassicLinkDnsSupport provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisableVpcClassicLinkDnsSupport(ctx context.Context, params *ec2.DisableVpcClassicLinkDnsSupportInput, optFns ...func(*ec2.Options)) (*ec2.DisableVpcClassicLinkDnsSupportOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10195,17 +11755,17 @@ func (_m *EC2) DisassociateRouteTable(ctx context.Context, params *ec2.Disassoci
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateRouteTableOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateRouteTableInput, ...func(*ec2.Options)) *ec2.DisassociateRouteTableOutput); ok {
+	var r0 *ec2.DisableVpcClassicLinkDnsSupportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisableVpcClassicLinkDnsSupportInput, ...func(*ec2.Options)) *ec2.DisableVpcClassicLinkDnsSupportOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateRouteTableOutput)
+			r0 = ret.Get(0).(*ec2.DisableVpcClassicLinkDnsSupportOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateRouteTableInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisableVpcClassicLinkDnsSupportInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10214,8 +11774,8 @@ func (_m *EC2) DisassociateRouteTable(ctx context.Context, params *ec2.Disassoci
 	return r0, r1
 }
 
-// DisassociateSubnetCidrBlock provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateSubnetCidrBlock(ctx context.Context, params *ec2.DisassociateSubnetCidrBlockInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateSubnetCidrBlockOutput, error) {
+// DisassociateAddress provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateAddress(ctx context.Context, params *ec2.DisassociateAddressInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateAddressOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10225,17 +11785,17 @@ func (_m *EC2) DisassociateSubnetCidrBlock(ctx context.Context, params *ec2.Disa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateSubnetCidrBlockOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateSubnetCidrBlockInput, ...func(*ec2.Options)) *ec2.DisassociateSubnetCidrBlockOutput); ok {
+	var r0 *ec2.DisassociateAddressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateAddressInput, ...func(*ec2.Options)) *ec2.DisassociateAddressOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateSubnetCidrBlockOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateAddressOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateSubnetCidrBlockInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateAddressInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10244,8 +11804,8 @@ func (_m *EC2) DisassociateSubnetCidrBlock(ctx context.Context, params *ec2.Disa
 	return r0, r1
 }
 
-// DisassociateTransitGatewayMulticastDomain provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateTransitGatewayMulticastDomain(ctx context.Context, params *ec2.DisassociateTransitGatewayMulticastDomainInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayMulticastDomainOutput, error) {
+// DisassociateClientVpnTargetNetwork provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateClientVpnTargetNetwork(ctx context.Context, params *ec2.DisassociateClientVpnTargetNetworkInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateClientVpnTargetNetworkOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10255,17 +11815,17 @@ func (_m *EC2) DisassociateTransitGatewayMulticastDomain(ctx context.Context, pa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateTransitGatewayMulticastDomainOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateTransitGatewayMulticastDomainInput, ...func(*ec2.Options)) *ec2.DisassociateTransitGatewayMulticastDomainOutput); ok {
+	var r0 *ec2.DisassociateClientVpnTargetNetworkOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateClientVpnTargetNetworkInput, ...func(*ec2.Options)) *ec2.DisassociateClientVpnTargetNetworkOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateTransitGatewayMulticastDomainOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateClientVpnTargetNetworkOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateTransitGatewayMulticastDomainInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateClientVpnTargetNetworkInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10274,8 +11834,8 @@ func (_m *EC2) DisassociateTransitGatewayMulticastDomain(ctx context.Context, pa
 	return r0, r1
 }
 
-// DisassociateTransitGatewayRouteTable provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateTransitGatewayRouteTable(ctx context.Context, params *ec2.DisassociateTransitGatewayRouteTableInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayRouteTableOutput, error) {
+// DisassociateEnclaveCertificateIamRole provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateEnclaveCertificateIamRole(ctx context.Context, params *ec2.DisassociateEnclaveCertificateIamRoleInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateEnclaveCertificateIamRoleOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10285,17 +11845,17 @@ func (_m *EC2) DisassociateTransitGatewayRouteTable(ctx context.Context, params
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateTransitGatewayRouteTableOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateTransitGatewayRouteTableInput, ...func(*ec2.Options)) *ec2.DisassociateTransitGatewayRouteTableOutput); ok {
+	var r0 *ec2.DisassociateEnclaveCertificateIamRoleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateEnclaveCertificateIamRoleInput, ...func(*ec2.Options)) *ec2.DisassociateEnclaveCertificateIamRoleOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateTransitGatewayRouteTableOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateEnclaveCertificateIamRoleOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateTransitGatewayRouteTableInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateEnclaveCertificateIamRoleInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10304,8 +11864,8 @@ func (_m *EC2) DisassociateTransitGatewayRouteTable(ctx context.Context, params
 	return r0, r1
 }
 
-// DisassociateTrunkInterface provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateTrunkInterface(ctx context.Context, params *ec2.DisassociateTrunkInterfaceInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateTrunkInterfaceOutput, error) {
+// DisassociateIamInstanceProfile provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateIamInstanceProfile(ctx context.Context, params *ec2.DisassociateIamInstanceProfileInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateIamInstanceProfileOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10315,17 +11875,17 @@ func (_m *EC2) DisassociateTrunkInterface(ctx context.Context, params *ec2.Disas
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateTrunkInterfaceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateTrunkInterfaceInput, ...func(*ec2.Options)) *ec2.DisassociateTrunkInterfaceOutput); ok {
+	var r0 *ec2.DisassociateIamInstanceProfileOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateIamInstanceProfileInput, ...func(*ec2.Options)) *ec2.DisassociateIamInstanceProfileOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateTrunkInterfaceOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateIamInstanceProfileOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateTrunkInterfaceInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateIamInstanceProfileInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10334,8 +11894,8 @@ func (_m *EC2) DisassociateTrunkInterface(ctx context.Context, params *ec2.Disas
 	return r0, r1
 }
 
-// DisassociateVpcCidrBlock provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) DisassociateVpcCidrBlock(ctx context.Context, params *ec2.DisassociateVpcCidrBlockInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateVpcCidrBlockOutput, error) {
+// DisassociateInstanceEventWindow provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateInstanceEventWindow(ctx context.Context, params *ec2.DisassociateInstanceEventWindowInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateInstanceEventWindowOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10345,17 +11905,17 @@ func (_m *EC2) DisassociateVpcCidrBlock(ctx context.Context, params *ec2.Disasso
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.DisassociateVpcCidrBlockOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateVpcCidrBlockInput, ...func(*ec2.Options)) *ec2.DisassociateVpcCidrBlockOutput); ok {
+	var r0 *ec2.DisassociateInstanceEventWindowOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateInstanceEventWindowInput, ...func(*ec2.Options)) *ec2.DisassociateInstanceEventWindowOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.DisassociateVpcCidrBlockOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateInstanceEventWindowOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateVpcCidrBlockInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateInstanceEventWindowInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10364,8 +11924,8 @@ func (_m *EC2) DisassociateVpcCidrBlock(ctx context.Context, params *ec2.Disasso
 	return r0, r1
 }
 
-// EnableEbsEncryptionByDefault provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableEbsEncryptionByDefault(ctx context.Context, params *ec2.EnableEbsEncryptionByDefaultInput, optFns ...func(*ec2.Options)) (*ec2.EnableEbsEncryptionByDefaultOutput, error) {
+// DisassociateIpamByoasn provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateIpamByoasn(ctx context.Context, params *ec2.DisassociateIpamByoasnInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateIpamByoasnOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10375,17 +11935,17 @@ func (_m *EC2) EnableEbsEncryptionByDefault(ctx context.Context, params *ec2.Ena
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableEbsEncryptionByDefaultOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) *ec2.EnableEbsEncryptionByDefaultOutput); ok {
+	var r0 *ec2.DisassociateIpamByoasnOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateIpamByoasnInput, ...func(*ec2.Options)) *ec2.DisassociateIpamByoasnOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableEbsEncryptionByDefaultOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateIpamByoasnOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateIpamByoasnInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10394,8 +11954,8 @@ func (_m *EC2) EnableEbsEncryptionByDefault(ctx context.Context, params *ec2.Ena
 	return r0, r1
 }
 
-// EnableFastLaunch provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableFastLaunch(ctx context.Context, params *ec2.EnableFastLaunchInput, optFns ...func(*ec2.Options)) (*ec2.EnableFastLaunchOutput, error) {
+// DisassociateIpamResourceDiscovery provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateIpamResourceDiscovery(ctx context.Context, params *ec2.DisassociateIpamResourceDiscoveryInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateIpamResourceDiscoveryOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10405,17 +11965,17 @@ func (_m *EC2) EnableFastLaunch(ctx context.Context, params *ec2.EnableFastLaunc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableFastLaunchOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableFastLaunchInput, ...func(*ec2.Options)) *ec2.EnableFastLaunchOutput); ok {
+	var r0 *ec2.DisassociateIpamResourceDiscoveryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateIpamResourceDiscoveryInput, ...func(*ec2.Options)) *ec2.DisassociateIpamResourceDiscoveryOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableFastLaunchOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateIpamResourceDiscoveryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableFastLaunchInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateIpamResourceDiscoveryInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10424,8 +11984,8 @@ func (_m *EC2) EnableFastLaunch(ctx context.Context, params *ec2.EnableFastLaunc
 	return r0, r1
 }
 
-// EnableFastSnapshotRestores provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableFastSnapshotRestores(ctx context.Context, params *ec2.EnableFastSnapshotRestoresInput, optFns ...func(*ec2.Options)) (*ec2.EnableFastSnapshotRestoresOutput, error) {
+// DisassociateNatGatewayAddress provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateNatGatewayAddress(ctx context.Context, params *ec2.DisassociateNatGatewayAddressInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateNatGatewayAddressOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10435,17 +11995,17 @@ func (_m *EC2) EnableFastSnapshotRestores(ctx context.Context, params *ec2.Enabl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableFastSnapshotRestoresOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableFastSnapshotRestoresInput, ...func(*ec2.Options)) *ec2.EnableFastSnapshotRestoresOutput); ok {
+	var r0 *ec2.DisassociateNatGatewayAddressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateNatGatewayAddressInput, ...func(*ec2.Options)) *ec2.DisassociateNatGatewayAddressOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableFastSnapshotRestoresOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateNatGatewayAddressOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableFastSnapshotRestoresInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateNatGatewayAddressInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10454,8 +12014,8 @@ func (_m *EC2) EnableFastSnapshotRestores(ctx context.Context, params *ec2.Enabl
 	return r0, r1
 }
 
-// EnableImageDeprecation provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableImageDeprecation(ctx context.Context, params *ec2.EnableImageDeprecationInput, optFns ...func(*ec2.Options)) (*ec2.EnableImageDeprecationOutput, error) {
+// DisassociateRouteTable provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateRouteTable(ctx context.Context, params *ec2.DisassociateRouteTableInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateRouteTableOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10465,17 +12025,17 @@ func (_m *EC2) EnableImageDeprecation(ctx context.Context, params *ec2.EnableIma
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableImageDeprecationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableImageDeprecationInput, ...func(*ec2.Options)) *ec2.EnableImageDeprecationOutput); ok {
+	var r0 *ec2.DisassociateRouteTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateRouteTableInput, ...func(*ec2.Options)) *ec2.DisassociateRouteTableOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableImageDeprecationOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateRouteTableOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableImageDeprecationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateRouteTableInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10484,8 +12044,8 @@ func (_m *EC2) EnableImageDeprecation(ctx context.Context, params *ec2.EnableIma
 	return r0, r1
 }
 
-// EnableIpamOrganizationAdminAccount provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableIpamOrganizationAdminAccount(ctx context.Context, params *ec2.EnableIpamOrganizationAdminAccountInput, optFns ...func(*ec2.Options)) (*ec2.EnableIpamOrganizationAdminAccountOutput, error) {
+// DisassociateSubnetCidrBlock provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateSubnetCidrBlock(ctx context.Context, params *ec2.DisassociateSubnetCidrBlockInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateSubnetCidrBlockOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10495,17 +12055,17 @@ func (_m *EC2) EnableIpamOrganizationAdminAccount(ctx context.Context, params *e
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableIpamOrganizationAdminAccountOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableIpamOrganizationAdminAccountInput, ...func(*ec2.Options)) *ec2.EnableIpamOrganizationAdminAccountOutput); ok {
+	var r0 *ec2.DisassociateSubnetCidrBlockOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateSubnetCidrBlockInput, ...func(*ec2.Options)) *ec2.DisassociateSubnetCidrBlockOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableIpamOrganizationAdminAccountOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateSubnetCidrBlockOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableIpamOrganizationAdminAccountInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateSubnetCidrBlockInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10514,8 +12074,8 @@ func (_m *EC2) EnableIpamOrganizationAdminAccount(ctx context.Context, params *e
 	return r0, r1
 }
 
-// EnableSerialConsoleAccess provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableSerialConsoleAccess(ctx context.Context, params *ec2.EnableSerialConsoleAccessInput, optFns ...func(*ec2.Options)) (*ec2.EnableSerialConsoleAccessOutput, error) {
+// DisassociateTransitGatewayMulticastDomain provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateTransitGatewayMulticastDomain(ctx context.Context, params *ec2.DisassociateTransitGatewayMulticastDomainInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayMulticastDomainOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10525,17 +12085,17 @@ func (_m *EC2) EnableSerialConsoleAccess(ctx context.Context, params *ec2.Enable
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableSerialConsoleAccessOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableSerialConsoleAccessInput, ...func(*ec2.Options)) *ec2.EnableSerialConsoleAccessOutput); ok {
+	var r0 *ec2.DisassociateTransitGatewayMulticastDomainOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateTransitGatewayMulticastDomainInput, ...func(*ec2.Options)) *ec2.DisassociateTransitGatewayMulticastDomainOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableSerialConsoleAccessOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateTransitGatewayMulticastDomainOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableSerialConsoleAccessInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateTransitGatewayMulticastDomainInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10544,8 +12104,8 @@ func (_m *EC2) EnableSerialConsoleAccess(ctx context.Context, params *ec2.Enable
 	return r0, r1
 }
 
-// EnableTransitGatewayRouteTablePropagation provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableTransitGatewayRouteTablePropagation(ctx context.Context, params *ec2.EnableTransitGatewayRouteTablePropagationInput, optFns ...func(*ec2.Options)) (*ec2.EnableTransitGatewayRouteTablePropagationOutput, error) {
+// DisassociateTransitGatewayPolicyTable provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateTransitGatewayPolicyTable(ctx context.Context, params *ec2.DisassociateTransitGatewayPolicyTableInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayPolicyTableOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10555,17 +12115,17 @@ func (_m *EC2) EnableTransitGatewayRouteTablePropagation(ctx context.Context, pa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableTransitGatewayRouteTablePropagationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableTransitGatewayRouteTablePropagationInput, ...func(*ec2.Options)) *ec2.EnableTransitGatewayRouteTablePropagationOutput); ok {
+	var r0 *ec2.DisassociateTransitGatewayPolicyTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateTransitGatewayPolicyTableInput, ...func(*ec2.Options)) *ec2.DisassociateTransitGatewayPolicyTableOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableTransitGatewayRouteTablePropagationOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateTransitGatewayPolicyTableOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableTransitGatewayRouteTablePropagationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateTransitGatewayPolicyTableInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10574,8 +12134,8 @@ func (_m *EC2) EnableTransitGatewayRouteTablePropagation(ctx context.Context, pa
 	return r0, r1
 }
 
-// EnableVgwRoutePropagation provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableVgwRoutePropagation(ctx context.Context, params *ec2.EnableVgwRoutePropagationInput, optFns ...func(*ec2.Options)) (*ec2.EnableVgwRoutePropagationOutput, error) {
+// DisassociateTransitGatewayRouteTable provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateTransitGatewayRouteTable(ctx context.Context, params *ec2.DisassociateTransitGatewayRouteTableInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayRouteTableOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10585,17 +12145,17 @@ func (_m *EC2) EnableVgwRoutePropagation(ctx context.Context, params *ec2.Enable
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableVgwRoutePropagationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableVgwRoutePropagationInput, ...func(*ec2.Options)) *ec2.EnableVgwRoutePropagationOutput); ok {
+	var r0 *ec2.DisassociateTransitGatewayRouteTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateTransitGatewayRouteTableInput, ...func(*ec2.Options)) *ec2.DisassociateTransitGatewayRouteTableOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableVgwRoutePropagationOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateTransitGatewayRouteTableOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableVgwRoutePropagationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateTransitGatewayRouteTableInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10604,8 +12164,8 @@ func (_m *EC2) EnableVgwRoutePropagation(ctx context.Context, params *ec2.Enable
 	return r0, r1
 }
 
-// EnableVolumeIO provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableVolumeIO(ctx context.Context, params *ec2.EnableVolumeIOInput, optFns ...func(*ec2.Options)) (*ec2.EnableVolumeIOOutput, error) {
+// DisassociateTrunkInterface provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateTrunkInterface(ctx context.Context, params *ec2.DisassociateTrunkInterfaceInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateTrunkInterfaceOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10615,17 +12175,17 @@ func (_m *EC2) EnableVolumeIO(ctx context.Context, params *ec2.EnableVolumeIOInp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableVolumeIOOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableVolumeIOInput, ...func(*ec2.Options)) *ec2.EnableVolumeIOOutput); ok {
+	var r0 *ec2.DisassociateTrunkInterfaceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateTrunkInterfaceInput, ...func(*ec2.Options)) *ec2.DisassociateTrunkInterfaceOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableVolumeIOOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateTrunkInterfaceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableVolumeIOInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateTrunkInterfaceInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10634,8 +12194,8 @@ func (_m *EC2) EnableVolumeIO(ctx context.Context, params *ec2.EnableVolumeIOInp
 	return r0, r1
 }
 
-// EnableVpcClassicLink provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableVpcClassicLink(ctx context.Context, params *ec2.EnableVpcClassicLinkInput, optFns ...func(*ec2.Options)) (*ec2.EnableVpcClassicLinkOutput, error) {
+// DisassociateVpcCidrBlock provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) DisassociateVpcCidrBlock(ctx context.Context, params *ec2.DisassociateVpcCidrBlockInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateVpcCidrBlockOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10645,17 +12205,17 @@ func (_m *EC2) EnableVpcClassicLink(ctx context.Context, params *ec2.EnableVpcCl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableVpcClassicLinkOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableVpcClassicLinkInput, ...func(*ec2.Options)) *ec2.EnableVpcClassicLinkOutput); ok {
+	var r0 *ec2.DisassociateVpcCidrBlockOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.DisassociateVpcCidrBlockInput, ...func(*ec2.Options)) *ec2.DisassociateVpcCidrBlockOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableVpcClassicLinkOutput)
+			r0 = ret.Get(0).(*ec2.DisassociateVpcCidrBlockOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableVpcClassicLinkInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.DisassociateVpcCidrBlockInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10664,8 +12224,8 @@ func (_m *EC2) EnableVpcClassicLink(ctx context.Context, params *ec2.EnableVpcCl
 	return r0, r1
 }
 
-// EnableVpcClassicLinkDnsSupport provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) EnableVpcClassicLinkDnsSupport(ctx context.Context, params *ec2.EnableVpcClassicLinkDnsSupportInput, optFns ...func(*ec2.Options)) (*ec2.EnableVpcClassicLinkDnsSupportOutput, error) {
+// EnableAddressTransfer provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableAddressTransfer(ctx context.Context, params *ec2.EnableAddressTransferInput, optFns ...func(*ec2.Options)) (*ec2.EnableAddressTransferOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10675,17 +12235,17 @@ func (_m *EC2) EnableVpcClassicLinkDnsSupport(ctx context.Context, params *ec2.E
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.EnableVpcClassicLinkDnsSupportOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableVpcClassicLinkDnsSupportInput, ...func(*ec2.Options)) *ec2.EnableVpcClassicLinkDnsSupportOutput); ok {
+	var r0 *ec2.EnableAddressTransferOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableAddressTransferInput, ...func(*ec2.Options)) *ec2.EnableAddressTransferOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.EnableVpcClassicLinkDnsSupportOutput)
+			r0 = ret.Get(0).(*ec2.EnableAddressTransferOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableVpcClassicLinkDnsSupportInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableAddressTransferInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10694,8 +12254,8 @@ func (_m *EC2) EnableVpcClassicLinkDnsSupport(ctx context.Context, params *ec2.E
 	return r0, r1
 }
 
-// ExportClientVpnClientCertificateRevocationList provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ExportClientVpnClientCertificateRevocationList(ctx context.Context, params *ec2.ExportClientVpnClientCertificateRevocationListInput, optFns ...func(*ec2.Options)) (*ec2.ExportClientVpnClientCertificateRevocationListOutput, error) {
+// EnableAwsNetworkPerformanceMetricSubscription provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableAwsNetworkPerformanceMetricSubscription(ctx context.Context, params *ec2.EnableAwsNetworkPerformanceMetricSubscriptionInput, optFns ...func(*ec2.Options)) (*ec2.EnableAwsNetworkPerformanceMetricSubscriptionOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10705,17 +12265,17 @@ func (_m *EC2) ExportClientVpnClientCertificateRevocationList(ctx context.Contex
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ExportClientVpnClientCertificateRevocationListOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ExportClientVpnClientCertificateRevocationListInput, ...func(*ec2.Options)) *ec2.ExportClientVpnClientCertificateRevocationListOutput); ok {
+	var r0 *ec2.EnableAwsNetworkPerformanceMetricSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableAwsNetworkPerformanceMetricSubscriptionInput, ...func(*ec2.Options)) *ec2.EnableAwsNetworkPerformanceMetricSubscriptionOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ExportClientVpnClientCertificateRevocationListOutput)
+			r0 = ret.Get(0).(*ec2.EnableAwsNetworkPerformanceMetricSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ExportClientVpnClientCertificateRevocationListInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableAwsNetworkPerformanceMetricSubscriptionInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10724,8 +12284,8 @@ func (_m *EC2) ExportClientVpnClientCertificateRevocationList(ctx context.Contex
 	return r0, r1
 }
 
-// ExportClientVpnClientConfiguration provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ExportClientVpnClientConfiguration(ctx context.Context, params *ec2.ExportClientVpnClientConfigurationInput, optFns ...func(*ec2.Options)) (*ec2.ExportClientVpnClientConfigurationOutput, error) {
+// EnableEbsEncryptionByDefault provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableEbsEncryptionByDefault(ctx context.Context, params *ec2.EnableEbsEncryptionByDefaultInput, optFns ...func(*ec2.Options)) (*ec2.EnableEbsEncryptionByDefaultOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10735,17 +12295,17 @@ func (_m *EC2) ExportClientVpnClientConfiguration(ctx context.Context, params *e
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ExportClientVpnClientConfigurationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ExportClientVpnClientConfigurationInput, ...func(*ec2.Options)) *ec2.ExportClientVpnClientConfigurationOutput); ok {
+	var r0 *ec2.EnableEbsEncryptionByDefaultOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) *ec2.EnableEbsEncryptionByDefaultOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ExportClientVpnClientConfigurationOutput)
+			r0 = ret.Get(0).(*ec2.EnableEbsEncryptionByDefaultOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ExportClientVpnClientConfigurationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10754,8 +12314,8 @@ func (_m *EC2) ExportClientVpnClientConfiguration(ctx context.Context, params *e
 	return r0, r1
 }
 
-// ExportImage provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ExportImage(ctx context.Context, params *ec2.ExportImageInput, optFns ...func(*ec2.Options)) (*ec2.ExportImageOutput, error) {
+// EnableFastLaunch provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableFastLaunch(ctx context.Context, params *ec2.EnableFastLaunchInput, optFns ...func(*ec2.Options)) (*ec2.EnableFastLaunchOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10765,17 +12325,17 @@ func (_m *EC2) ExportImage(ctx context.Context, params *ec2.ExportImageInput, op
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ExportImageOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ExportImageInput, ...func(*ec2.Options)) *ec2.ExportImageOutput); ok {
+	var r0 *ec2.EnableFastLaunchOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableFastLaunchInput, ...func(*ec2.Options)) *ec2.EnableFastLaunchOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ExportImageOutput)
+			r0 = ret.Get(0).(*ec2.EnableFastLaunchOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ExportImageInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableFastLaunchInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10784,8 +12344,8 @@ func (_m *EC2) ExportImage(ctx context.Context, params *ec2.ExportImageInput, op
 	return r0, r1
 }
 
-// ExportTransitGatewayRoutes provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ExportTransitGatewayRoutes(ctx context.Context, params *ec2.ExportTransitGatewayRoutesInput, optFns ...func(*ec2.Options)) (*ec2.ExportTransitGatewayRoutesOutput, error) {
+// EnableFastSnapshotRestores provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableFastSnapshotRestores(ctx context.Context, params *ec2.EnableFastSnapshotRestoresInput, optFns ...func(*ec2.Options)) (*ec2.EnableFastSnapshotRestoresOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10795,17 +12355,17 @@ func (_m *EC2) ExportTransitGatewayRoutes(ctx context.Context, params *ec2.Expor
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ExportTransitGatewayRoutesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ExportTransitGatewayRoutesInput, ...func(*ec2.Options)) *ec2.ExportTransitGatewayRoutesOutput); ok {
+	var r0 *ec2.EnableFastSnapshotRestoresOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableFastSnapshotRestoresInput, ...func(*ec2.Options)) *ec2.EnableFastSnapshotRestoresOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ExportTransitGatewayRoutesOutput)
+			r0 = ret.Get(0).(*ec2.EnableFastSnapshotRestoresOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ExportTransitGatewayRoutesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableFastSnapshotRestoresInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10814,8 +12374,8 @@ func (_m *EC2) ExportTransitGatewayRoutes(ctx context.Context, params *ec2.Expor
 	return r0, r1
 }
 
-// GetAssociatedEnclaveCertificateIamRoles provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetAssociatedEnclaveCertificateIamRoles(ctx context.Context, params *ec2.GetAssociatedEnclaveCertificateIamRolesInput, optFns ...func(*ec2.Options)) (*ec2.GetAssociatedEnclaveCertificateIamRolesOutput, error) {
+// EnableImage provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableImage(ctx context.Context, params *ec2.EnableImageInput, optFns ...func(*ec2.Options)) (*ec2.EnableImageOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10825,17 +12385,17 @@ func (_m *EC2) GetAssociatedEnclaveCertificateIamRoles(ctx context.Context, para
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetAssociatedEnclaveCertificateIamRolesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetAssociatedEnclaveCertificateIamRolesInput, ...func(*ec2.Options)) *ec2.GetAssociatedEnclaveCertificateIamRolesOutput); ok {
+	var r0 *ec2.EnableImageOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableImageInput, ...func(*ec2.Options)) *ec2.EnableImageOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetAssociatedEnclaveCertificateIamRolesOutput)
+			r0 = ret.Get(0).(*ec2.EnableImageOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetAssociatedEnclaveCertificateIamRolesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableImageInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10844,8 +12404,8 @@ func (_m *EC2) GetAssociatedEnclaveCertificateIamRoles(ctx context.Context, para
 	return r0, r1
 }
 
-// GetAssociatedIpv6PoolCidrs provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetAssociatedIpv6PoolCidrs(ctx context.Context, params *ec2.GetAssociatedIpv6PoolCidrsInput, optFns ...func(*ec2.Options)) (*ec2.GetAssociatedIpv6PoolCidrsOutput, error) {
+// EnableImageBlockPublicAccess provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableImageBlockPublicAccess(ctx context.Context, params *ec2.EnableImageBlockPublicAccessInput, optFns ...func(*ec2.Options)) (*ec2.EnableImageBlockPublicAccessOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10855,17 +12415,17 @@ func (_m *EC2) GetAssociatedIpv6PoolCidrs(ctx context.Context, params *ec2.GetAs
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetAssociatedIpv6PoolCidrsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetAssociatedIpv6PoolCidrsInput, ...func(*ec2.Options)) *ec2.GetAssociatedIpv6PoolCidrsOutput); ok {
+	var r0 *ec2.EnableImageBlockPublicAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableImageBlockPublicAccessInput, ...func(*ec2.Options)) *ec2.EnableImageBlockPublicAccessOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetAssociatedIpv6PoolCidrsOutput)
+			r0 = ret.Get(0).(*ec2.EnableImageBlockPublicAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetAssociatedIpv6PoolCidrsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableImageBlockPublicAccessInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10874,8 +12434,8 @@ func (_m *EC2) GetAssociatedIpv6PoolCidrs(ctx context.Context, params *ec2.GetAs
 	return r0, r1
 }
 
-// GetCapacityReservationUsage provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetCapacityReservationUsage(ctx context.Context, params *ec2.GetCapacityReservationUsageInput, optFns ...func(*ec2.Options)) (*ec2.GetCapacityReservationUsageOutput, error) {
+// EnableImageDeprecation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableImageDeprecation(ctx context.Context, params *ec2.EnableImageDeprecationInput, optFns ...func(*ec2.Options)) (*ec2.EnableImageDeprecationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10885,17 +12445,17 @@ func (_m *EC2) GetCapacityReservationUsage(ctx context.Context, params *ec2.GetC
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetCapacityReservationUsageOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetCapacityReservationUsageInput, ...func(*ec2.Options)) *ec2.GetCapacityReservationUsageOutput); ok {
+	var r0 *ec2.EnableImageDeprecationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableImageDeprecationInput, ...func(*ec2.Options)) *ec2.EnableImageDeprecationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetCapacityReservationUsageOutput)
+			r0 = ret.Get(0).(*ec2.EnableImageDeprecationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetCapacityReservationUsageInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableImageDeprecationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10904,8 +12464,8 @@ func (_m *EC2) GetCapacityReservationUsage(ctx context.Context, params *ec2.GetC
 	return r0, r1
 }
 
-// GetCoipPoolUsage provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetCoipPoolUsage(ctx context.Context, params *ec2.GetCoipPoolUsageInput, optFns ...func(*ec2.Options)) (*ec2.GetCoipPoolUsageOutput, error) {
+// EnableImageDeregistrationProtection provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableImageDeregistrationProtection(ctx context.Context, params *ec2.EnableImageDeregistrationProtectionInput, optFns ...func(*ec2.Options)) (*ec2.EnableImageDeregistrationProtectionOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10915,17 +12475,17 @@ func (_m *EC2) GetCoipPoolUsage(ctx context.Context, params *ec2.GetCoipPoolUsag
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetCoipPoolUsageOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetCoipPoolUsageInput, ...func(*ec2.Options)) *ec2.GetCoipPoolUsageOutput); ok {
+	var r0 *ec2.EnableImageDeregistrationProtectionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableImageDeregistrationProtectionInput, ...func(*ec2.Options)) *ec2.EnableImageDeregistrationProtectionOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetCoipPoolUsageOutput)
+			r0 = ret.Get(0).(*ec2.EnableImageDeregistrationProtectionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetCoipPoolUsageInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableImageDeregistrationProtectionInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10934,8 +12494,8 @@ func (_m *EC2) GetCoipPoolUsage(ctx context.Context, params *ec2.GetCoipPoolUsag
 	return r0, r1
 }
 
-// GetConsoleOutput provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetConsoleOutput(ctx context.Context, params *ec2.GetConsoleOutputInput, optFns ...func(*ec2.Options)) (*ec2.GetConsoleOutputOutput, error) {
+// EnableIpamOrganizationAdminAccount provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableIpamOrganizationAdminAccount(ctx context.Context, params *ec2.EnableIpamOrganizationAdminAccountInput, optFns ...func(*ec2.Options)) (*ec2.EnableIpamOrganizationAdminAccountOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10945,17 +12505,17 @@ func (_m *EC2) GetConsoleOutput(ctx context.Context, params *ec2.GetConsoleOutpu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetConsoleOutputOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetConsoleOutputInput, ...func(*ec2.Options)) *ec2.GetConsoleOutputOutput); ok {
+	var r0 *ec2.EnableIpamOrganizationAdminAccountOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableIpamOrganizationAdminAccountInput, ...func(*ec2.Options)) *ec2.EnableIpamOrganizationAdminAccountOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetConsoleOutputOutput)
+			r0 = ret.Get(0).(*ec2.EnableIpamOrganizationAdminAccountOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetConsoleOutputInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableIpamOrganizationAdminAccountInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10964,8 +12524,8 @@ func (_m *EC2) GetConsoleOutput(ctx context.Context, params *ec2.GetConsoleOutpu
 	return r0, r1
 }
 
-// GetConsoleScreenshot provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetConsoleScreenshot(ctx context.Context, params *ec2.GetConsoleScreenshotInput, optFns ...func(*ec2.Options)) (*ec2.GetConsoleScreenshotOutput, error) {
+// EnableReachabilityAnalyzerOrganizationSharing provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableReachabilityAnalyzerOrganizationSharing(ctx context.Context, params *ec2.EnableReachabilityAnalyzerOrganizationSharingInput, optFns ...func(*ec2.Options)) (*ec2.EnableReachabilityAnalyzerOrganizationSharingOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -10975,17 +12535,17 @@ func (_m *EC2) GetConsoleScreenshot(ctx context.Context, params *ec2.GetConsoleS
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetConsoleScreenshotOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetConsoleScreenshotInput, ...func(*ec2.Options)) *ec2.GetConsoleScreenshotOutput); ok {
+	var r0 *ec2.EnableReachabilityAnalyzerOrganizationSharingOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableReachabilityAnalyzerOrganizationSharingInput, ...func(*ec2.Options)) *ec2.EnableReachabilityAnalyzerOrganizationSharingOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetConsoleScreenshotOutput)
+			r0 = ret.Get(0).(*ec2.EnableReachabilityAnalyzerOrganizationSharingOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetConsoleScreenshotInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableReachabilityAnalyzerOrganizationSharingInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -10994,8 +12554,8 @@ func (_m *EC2) GetConsoleScreenshot(ctx context.Context, params *ec2.GetConsoleS
 	return r0, r1
 }
 
-// GetDefaultCreditSpecification provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetDefaultCreditSpecification(ctx context.Context, params *ec2.GetDefaultCreditSpecificationInput, optFns ...func(*ec2.Options)) (*ec2.GetDefaultCreditSpecificationOutput, error) {
+// EnableSerialConsoleAccess provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableSerialConsoleAccess(ctx context.Context, params *ec2.EnableSerialConsoleAccessInput, optFns ...func(*ec2.Options)) (*ec2.EnableSerialConsoleAccessOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11005,17 +12565,17 @@ func (_m *EC2) GetDefaultCreditSpecification(ctx context.Context, params *ec2.Ge
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetDefaultCreditSpecificationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetDefaultCreditSpecificationInput, ...func(*ec2.Options)) *ec2.GetDefaultCreditSpecificationOutput); ok {
+	var r0 *ec2.EnableSerialConsoleAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableSerialConsoleAccessInput, ...func(*ec2.Options)) *ec2.EnableSerialConsoleAccessOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetDefaultCreditSpecificationOutput)
+			r0 = ret.Get(0).(*ec2.EnableSerialConsoleAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetDefaultCreditSpecificationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableSerialConsoleAccessInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11024,8 +12584,8 @@ func (_m *EC2) GetDefaultCreditSpecification(ctx context.Context, params *ec2.Ge
 	return r0, r1
 }
 
-// GetEbsDefaultKmsKeyId provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetEbsDefaultKmsKeyId(ctx context.Context, params *ec2.GetEbsDefaultKmsKeyIdInput, optFns ...func(*ec2.Options)) (*ec2.GetEbsDefaultKmsKeyIdOutput, error) {
+// EnableSnapshotBlockPublicAccess provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableSnapshotBlockPublicAccess(ctx context.Context, params *ec2.EnableSnapshotBlockPublicAccessInput, optFns ...func(*ec2.Options)) (*ec2.EnableSnapshotBlockPublicAccessOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11035,17 +12595,17 @@ func (_m *EC2) GetEbsDefaultKmsKeyId(ctx context.Context, params *ec2.GetEbsDefa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetEbsDefaultKmsKeyIdOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetEbsDefaultKmsKeyIdInput, ...func(*ec2.Options)) *ec2.GetEbsDefaultKmsKeyIdOutput); ok {
+	var r0 *ec2.EnableSnapshotBlockPublicAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableSnapshotBlockPublicAccessInput, ...func(*ec2.Options)) *ec2.EnableSnapshotBlockPublicAccessOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetEbsDefaultKmsKeyIdOutput)
+			r0 = ret.Get(0).(*ec2.EnableSnapshotBlockPublicAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetEbsDefaultKmsKeyIdInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableSnapshotBlockPublicAccessInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11054,8 +12614,8 @@ func (_m *EC2) GetEbsDefaultKmsKeyId(ctx context.Context, params *ec2.GetEbsDefa
 	return r0, r1
 }
 
-// GetEbsEncryptionByDefault provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetEbsEncryptionByDefault(ctx context.Context, params *ec2.GetEbsEncryptionByDefaultInput, optFns ...func(*ec2.Options)) (*ec2.GetEbsEncryptionByDefaultOutput, error) {
+// EnableTransitGatewayRouteTablePropagation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableTransitGatewayRouteTablePropagation(ctx context.Context, params *ec2.EnableTransitGatewayRouteTablePropagationInput, optFns ...func(*ec2.Options)) (*ec2.EnableTransitGatewayRouteTablePropagationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11065,17 +12625,17 @@ func (_m *EC2) GetEbsEncryptionByDefault(ctx context.Context, params *ec2.GetEbs
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetEbsEncryptionByDefaultOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetEbsEncryptionByDefaultInput, ...func(*ec2.Options)) *ec2.GetEbsEncryptionByDefaultOutput); ok {
+	var r0 *ec2.EnableTransitGatewayRouteTablePropagationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableTransitGatewayRouteTablePropagationInput, ...func(*ec2.Options)) *ec2.EnableTransitGatewayRouteTablePropagationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetEbsEncryptionByDefaultOutput)
+			r0 = ret.Get(0).(*ec2.EnableTransitGatewayRouteTablePropagationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetEbsEncryptionByDefaultInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableTransitGatewayRouteTablePropagationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11084,8 +12644,8 @@ func (_m *EC2) GetEbsEncryptionByDefault(ctx context.Context, params *ec2.GetEbs
 	return r0, r1
 }
 
-// GetFlowLogsIntegrationTemplate provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetFlowLogsIntegrationTemplate(ctx context.Context, params *ec2.GetFlowLogsIntegrationTemplateInput, optFns ...func(*ec2.Options)) (*ec2.GetFlowLogsIntegrationTemplateOutput, error) {
+// EnableVgwRoutePropagation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableVgwRoutePropagation(ctx context.Context, params *ec2.EnableVgwRoutePropagationInput, optFns ...func(*ec2.Options)) (*ec2.EnableVgwRoutePropagationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11095,17 +12655,17 @@ func (_m *EC2) GetFlowLogsIntegrationTemplate(ctx context.Context, params *ec2.G
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetFlowLogsIntegrationTemplateOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetFlowLogsIntegrationTemplateInput, ...func(*ec2.Options)) *ec2.GetFlowLogsIntegrationTemplateOutput); ok {
+	var r0 *ec2.EnableVgwRoutePropagationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableVgwRoutePropagationInput, ...func(*ec2.Options)) *ec2.EnableVgwRoutePropagationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetFlowLogsIntegrationTemplateOutput)
+			r0 = ret.Get(0).(*ec2.EnableVgwRoutePropagationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetFlowLogsIntegrationTemplateInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableVgwRoutePropagationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11114,8 +12674,8 @@ func (_m *EC2) GetFlowLogsIntegrationTemplate(ctx context.Context, params *ec2.G
 	return r0, r1
 }
 
-// GetGroupsForCapacityReservation provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetGroupsForCapacityReservation(ctx context.Context, params *ec2.GetGroupsForCapacityReservationInput, optFns ...func(*ec2.Options)) (*ec2.GetGroupsForCapacityReservationOutput, error) {
+// EnableVolumeIO provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableVolumeIO(ctx context.Context, params *ec2.EnableVolumeIOInput, optFns ...func(*ec2.Options)) (*ec2.EnableVolumeIOOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11125,17 +12685,17 @@ func (_m *EC2) GetGroupsForCapacityReservation(ctx context.Context, params *ec2.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetGroupsForCapacityReservationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetGroupsForCapacityReservationInput, ...func(*ec2.Options)) *ec2.GetGroupsForCapacityReservationOutput); ok {
+	var r0 *ec2.EnableVolumeIOOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableVolumeIOInput, ...func(*ec2.Options)) *ec2.EnableVolumeIOOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetGroupsForCapacityReservationOutput)
+			r0 = ret.Get(0).(*ec2.EnableVolumeIOOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetGroupsForCapacityReservationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableVolumeIOInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11144,8 +12704,8 @@ func (_m *EC2) GetGroupsForCapacityReservation(ctx context.Context, params *ec2.
 	return r0, r1
 }
 
-// GetHostReservationPurchasePreview provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetHostReservationPurchasePreview(ctx context.Context, params *ec2.GetHostReservationPurchasePreviewInput, optFns ...func(*ec2.Options)) (*ec2.GetHostReservationPurchasePreviewOutput, error) {
+// EnableVpcClassicLink provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableVpcClassicLink(ctx context.Context, params *ec2.EnableVpcClassicLinkInput, optFns ...func(*ec2.Options)) (*ec2.EnableVpcClassicLinkOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11155,17 +12715,17 @@ func (_m *EC2) GetHostReservationPurchasePreview(ctx context.Context, params *ec
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetHostReservationPurchasePreviewOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetHostReservationPurchasePreviewInput, ...func(*ec2.Options)) *ec2.GetHostReservationPurchasePreviewOutput); ok {
+	var r0 *ec2.EnableVpcClassicLinkOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableVpcClassicLinkInput, ...func(*ec2.Options)) *ec2.EnableVpcClassicLinkOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetHostReservationPurchasePreviewOutput)
+			r0 = ret.Get(0).(*ec2.EnableVpcClassicLinkOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetHostReservationPurchasePreviewInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableVpcClassicLinkInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11174,8 +12734,8 @@ func (_m *EC2) GetHostReservationPurchasePreview(ctx context.Context, params *ec
 	return r0, r1
 }
 
-// GetInstanceTypesFromInstanceRequirements provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetInstanceTypesFromInstanceRequirements(ctx context.Context, params *ec2.GetInstanceTypesFromInstanceRequirementsInput, optFns ...func(*ec2.Options)) (*ec2.GetInstanceTypesFromInstanceRequirementsOutput, error) {
+// EnableVpcClassicLinkDnsSupport provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) EnableVpcClassicLinkDnsSupport(ctx context.Context, params *ec2.EnableVpcClassicLinkDnsSupportInput, optFns ...func(*ec2.Options)) (*ec2.EnableVpcClassicLinkDnsSupportOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11185,17 +12745,17 @@ func (_m *EC2) GetInstanceTypesFromInstanceRequirements(ctx context.Context, par
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetInstanceTypesFromInstanceRequirementsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetInstanceTypesFromInstanceRequirementsInput, ...func(*ec2.Options)) *ec2.GetInstanceTypesFromInstanceRequirementsOutput); ok {
+	var r0 *ec2.EnableVpcClassicLinkDnsSupportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.EnableVpcClassicLinkDnsSupportInput, ...func(*ec2.Options)) *ec2.EnableVpcClassicLinkDnsSupportOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetInstanceTypesFromInstanceRequirementsOutput)
+			r0 = ret.Get(0).(*ec2.EnableVpcClassicLinkDnsSupportOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetInstanceTypesFromInstanceRequirementsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.EnableVpcClassicLinkDnsSupportInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11204,8 +12764,8 @@ func (_m *EC2) GetInstanceTypesFromInstanceRequirements(ctx context.Context, par
 	return r0, r1
 }
 
-// GetIpamAddressHistory provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetIpamAddressHistory(ctx context.Context, params *ec2.GetIpamAddressHistoryInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamAddressHistoryOutput, error) {
+// ExportClientVpnClientCertificateRevocationList provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ExportClientVpnClientCertificateRevocationList(ctx context.Context, params *ec2.ExportClientVpnClientCertificateRevocationListInput, optFns ...func(*ec2.Options)) (*ec2.ExportClientVpnClientCertificateRevocationListOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11215,17 +12775,17 @@ func (_m *EC2) GetIpamAddressHistory(ctx context.Context, params *ec2.GetIpamAdd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetIpamAddressHistoryOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamAddressHistoryInput, ...func(*ec2.Options)) *ec2.GetIpamAddressHistoryOutput); ok {
+	var r0 *ec2.ExportClientVpnClientCertificateRevocationListOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ExportClientVpnClientCertificateRevocationListInput, ...func(*ec2.Options)) *ec2.ExportClientVpnClientCertificateRevocationListOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetIpamAddressHistoryOutput)
+			r0 = ret.Get(0).(*ec2.ExportClientVpnClientCertificateRevocationListOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamAddressHistoryInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ExportClientVpnClientCertificateRevocationListInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11234,8 +12794,8 @@ func (_m *EC2) GetIpamAddressHistory(ctx context.Context, params *ec2.GetIpamAdd
 	return r0, r1
 }
 
-// GetIpamPoolAllocations provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetIpamPoolAllocations(ctx context.Context, params *ec2.GetIpamPoolAllocationsInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamPoolAllocationsOutput, error) {
+// ExportClientVpnClientConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ExportClientVpnClientConfiguration(ctx context.Context, params *ec2.ExportClientVpnClientConfigurationInput, optFns ...func(*ec2.Options)) (*ec2.ExportClientVpnClientConfigurationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11245,17 +12805,17 @@ func (_m *EC2) GetIpamPoolAllocations(ctx context.Context, params *ec2.GetIpamPo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetIpamPoolAllocationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamPoolAllocationsInput, ...func(*ec2.Options)) *ec2.GetIpamPoolAllocationsOutput); ok {
+	var r0 *ec2.ExportClientVpnClientConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ExportClientVpnClientConfigurationInput, ...func(*ec2.Options)) *ec2.ExportClientVpnClientConfigurationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetIpamPoolAllocationsOutput)
+			r0 = ret.Get(0).(*ec2.ExportClientVpnClientConfigurationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamPoolAllocationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ExportClientVpnClientConfigurationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11264,8 +12824,8 @@ func (_m *EC2) GetIpamPoolAllocations(ctx context.Context, params *ec2.GetIpamPo
 	return r0, r1
 }
 
-// GetIpamPoolCidrs provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetIpamPoolCidrs(ctx context.Context, params *ec2.GetIpamPoolCidrsInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error) {
+// ExportImage provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ExportImage(ctx context.Context, params *ec2.ExportImageInput, optFns ...func(*ec2.Options)) (*ec2.ExportImageOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11275,17 +12835,17 @@ func (_m *EC2) GetIpamPoolCidrs(ctx context.Context, params *ec2.GetIpamPoolCidr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetIpamPoolCidrsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamPoolCidrsInput, ...func(*ec2.Options)) *ec2.GetIpamPoolCidrsOutput); ok {
+	var r0 *ec2.ExportImageOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ExportImageInput, ...func(*ec2.Options)) *ec2.ExportImageOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetIpamPoolCidrsOutput)
+			r0 = ret.Get(0).(*ec2.ExportImageOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamPoolCidrsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ExportImageInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11294,8 +12854,8 @@ func (_m *EC2) GetIpamPoolCidrs(ctx context.Context, params *ec2.GetIpamPoolCidr
 	return r0, r1
 }
 
-// GetIpamResourceCidrs provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetIpamResourceCidrs(ctx context.Context, params *ec2.GetIpamResourceCidrsInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamResourceCidrsOutput, error) {
+// ExportTransitGatewayRoutes provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ExportTransitGatewayRoutes(ctx context.Context, params *ec2.ExportTransitGatewayRoutesInput, optFns ...func(*ec2.Options)) (*ec2.ExportTransitGatewayRoutesOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11305,17 +12865,17 @@ func (_m *EC2) GetIpamResourceCidrs(ctx context.Context, params *ec2.GetIpamReso
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetIpamResourceCidrsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamResourceCidrsInput, ...func(*ec2.Options)) *ec2.GetIpamResourceCidrsOutput); ok {
+	var r0 *ec2.ExportTransitGatewayRoutesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ExportTransitGatewayRoutesInput, ...func(*ec2.Options)) *ec2.ExportTransitGatewayRoutesOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetIpamResourceCidrsOutput)
+			r0 = ret.Get(0).(*ec2.ExportTransitGatewayRoutesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamResourceCidrsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ExportTransitGatewayRoutesInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11324,8 +12884,8 @@ func (_m *EC2) GetIpamResourceCidrs(ctx context.Context, params *ec2.GetIpamReso
 	return r0, r1
 }
 
-// GetLaunchTemplateData provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetLaunchTemplateData(ctx context.Context, params *ec2.GetLaunchTemplateDataInput, optFns ...func(*ec2.Options)) (*ec2.GetLaunchTemplateDataOutput, error) {
+// GetAssociatedEnclaveCertificateIamRoles provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetAssociatedEnclaveCertificateIamRoles(ctx context.Context, params *ec2.GetAssociatedEnclaveCertificateIamRolesInput, optFns ...func(*ec2.Options)) (*ec2.GetAssociatedEnclaveCertificateIamRolesOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11335,17 +12895,17 @@ func (_m *EC2) GetLaunchTemplateData(ctx context.Context, params *ec2.GetLaunchT
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetLaunchTemplateDataOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetLaunchTemplateDataInput, ...func(*ec2.Options)) *ec2.GetLaunchTemplateDataOutput); ok {
+	var r0 *ec2.GetAssociatedEnclaveCertificateIamRolesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetAssociatedEnclaveCertificateIamRolesInput, ...func(*ec2.Options)) *ec2.GetAssociatedEnclaveCertificateIamRolesOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetLaunchTemplateDataOutput)
+			r0 = ret.Get(0).(*ec2.GetAssociatedEnclaveCertificateIamRolesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetLaunchTemplateDataInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetAssociatedEnclaveCertificateIamRolesInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11354,8 +12914,8 @@ func (_m *EC2) GetLaunchTemplateData(ctx context.Context, params *ec2.GetLaunchT
 	return r0, r1
 }
 
-// GetManagedPrefixListAssociations provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetManagedPrefixListAssociations(ctx context.Context, params *ec2.GetManagedPrefixListAssociationsInput, optFns ...func(*ec2.Options)) (*ec2.GetManagedPrefixListAssociationsOutput, error) {
+// GetAssociatedIpv6PoolCidrs provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetAssociatedIpv6PoolCidrs(ctx context.Context, params *ec2.GetAssociatedIpv6PoolCidrsInput, optFns ...func(*ec2.Options)) (*ec2.GetAssociatedIpv6PoolCidrsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11365,17 +12925,17 @@ func (_m *EC2) GetManagedPrefixListAssociations(ctx context.Context, params *ec2
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetManagedPrefixListAssociationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetManagedPrefixListAssociationsInput, ...func(*ec2.Options)) *ec2.GetManagedPrefixListAssociationsOutput); ok {
+	var r0 *ec2.GetAssociatedIpv6PoolCidrsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetAssociatedIpv6PoolCidrsInput, ...func(*ec2.Options)) *ec2.GetAssociatedIpv6PoolCidrsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetManagedPrefixListAssociationsOutput)
+			r0 = ret.Get(0).(*ec2.GetAssociatedIpv6PoolCidrsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetManagedPrefixListAssociationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetAssociatedIpv6PoolCidrsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11384,8 +12944,8 @@ func (_m *EC2) GetManagedPrefixListAssociations(ctx context.Context, params *ec2
 	return r0, r1
 }
 
-// GetManagedPrefixListEntries provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetManagedPrefixListEntries(ctx context.Context, params *ec2.GetManagedPrefixListEntriesInput, optFns ...func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error) {
+// GetAwsNetworkPerformanceData provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetAwsNetworkPerformanceData(ctx context.Context, params *ec2.GetAwsNetworkPerformanceDataInput, optFns ...func(*ec2.Options)) (*ec2.GetAwsNetworkPerformanceDataOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11395,17 +12955,17 @@ func (_m *EC2) GetManagedPrefixListEntries(ctx context.Context, params *ec2.GetM
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetManagedPrefixListEntriesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetManagedPrefixListEntriesInput, ...func(*ec2.Options)) *ec2.GetManagedPrefixListEntriesOutput); ok {
+	var r0 *ec2.GetAwsNetworkPerformanceDataOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetAwsNetworkPerformanceDataInput, ...func(*ec2.Options)) *ec2.GetAwsNetworkPerformanceDataOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetManagedPrefixListEntriesOutput)
+			r0 = ret.Get(0).(*ec2.GetAwsNetworkPerformanceDataOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetManagedPrefixListEntriesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetAwsNetworkPerformanceDataInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11414,8 +12974,8 @@ func (_m *EC2) GetManagedPrefixListEntries(ctx context.Context, params *ec2.GetM
 	return r0, r1
 }
 
-// GetNetworkInsightsAccessScopeAnalysisFindings provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetNetworkInsightsAccessScopeAnalysisFindings(ctx context.Context, params *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput, optFns ...func(*ec2.Options)) (*ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput, error) {
+// GetCapacityReservationUsage provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetCapacityReservationUsage(ctx context.Context, params *ec2.GetCapacityReservationUsageInput, optFns ...func(*ec2.Options)) (*ec2.GetCapacityReservationUsageOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11425,17 +12985,17 @@ func (_m *EC2) GetNetworkInsightsAccessScopeAnalysisFindings(ctx context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput, ...func(*ec2.Options)) *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput); ok {
+	var r0 *ec2.GetCapacityReservationUsageOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetCapacityReservationUsageInput, ...func(*ec2.Options)) *ec2.GetCapacityReservationUsageOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput)
+			r0 = ret.Get(0).(*ec2.GetCapacityReservationUsageOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetCapacityReservationUsageInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11444,8 +13004,8 @@ func (_m *EC2) GetNetworkInsightsAccessScopeAnalysisFindings(ctx context.Context
 	return r0, r1
 }
 
-// GetNetworkInsightsAccessScopeContent provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetNetworkInsightsAccessScopeContent(ctx context.Context, params *ec2.GetNetworkInsightsAccessScopeContentInput, optFns ...func(*ec2.Options)) (*ec2.GetNetworkInsightsAccessScopeContentOutput, error) {
+// GetCoipPoolUsage provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetCoipPoolUsage(ctx context.Context, params *ec2.GetCoipPoolUsageInput, optFns ...func(*ec2.Options)) (*ec2.GetCoipPoolUsageOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11455,17 +13015,17 @@ func (_m *EC2) GetNetworkInsightsAccessScopeContent(ctx context.Context, params
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetNetworkInsightsAccessScopeContentOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetNetworkInsightsAccessScopeContentInput, ...func(*ec2.Options)) *ec2.GetNetworkInsightsAccessScopeContentOutput); ok {
+	var r0 *ec2.GetCoipPoolUsageOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetCoipPoolUsageInput, ...func(*ec2.Options)) *ec2.GetCoipPoolUsageOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetNetworkInsightsAccessScopeContentOutput)
+			r0 = ret.Get(0).(*ec2.GetCoipPoolUsageOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetNetworkInsightsAccessScopeContentInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetCoipPoolUsageInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11474,8 +13034,8 @@ func (_m *EC2) GetNetworkInsightsAccessScopeContent(ctx context.Context, params
 	return r0, r1
 }
 
-// GetPasswordData provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetPasswordData(ctx context.Context, params *ec2.GetPasswordDataInput, optFns ...func(*ec2.Options)) (*ec2.GetPasswordDataOutput, error) {
+// GetConsoleOutput provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetConsoleOutput(ctx context.Context, params *ec2.GetConsoleOutputInput, optFns ...func(*ec2.Options)) (*ec2.GetConsoleOutputOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11485,17 +13045,17 @@ func (_m *EC2) GetPasswordData(ctx context.Context, params *ec2.GetPasswordDataI
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetPasswordDataOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetPasswordDataInput, ...func(*ec2.Options)) *ec2.GetPasswordDataOutput); ok {
+	var r0 *ec2.GetConsoleOutputOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetConsoleOutputInput, ...func(*ec2.Options)) *ec2.GetConsoleOutputOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetPasswordDataOutput)
+			r0 = ret.Get(0).(*ec2.GetConsoleOutputOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetPasswordDataInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetConsoleOutputInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11504,8 +13064,8 @@ func (_m *EC2) GetPasswordData(ctx context.Context, params *ec2.GetPasswordDataI
 	return r0, r1
 }
 
-// GetReservedInstancesExchangeQuote provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetReservedInstancesExchangeQuote(ctx context.Context, params *ec2.GetReservedInstancesExchangeQuoteInput, optFns ...func(*ec2.Options)) (*ec2.GetReservedInstancesExchangeQuoteOutput, error) {
+// GetConsoleScreenshot provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetConsoleScreenshot(ctx context.Context, params *ec2.GetConsoleScreenshotInput, optFns ...func(*ec2.Options)) (*ec2.GetConsoleScreenshotOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11515,17 +13075,17 @@ func (_m *EC2) GetReservedInstancesExchangeQuote(ctx context.Context, params *ec
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetReservedInstancesExchangeQuoteOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetReservedInstancesExchangeQuoteInput, ...func(*ec2.Options)) *ec2.GetReservedInstancesExchangeQuoteOutput); ok {
+	var r0 *ec2.GetConsoleScreenshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetConsoleScreenshotInput, ...func(*ec2.Options)) *ec2.GetConsoleScreenshotOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetReservedInstancesExchangeQuoteOutput)
+			r0 = ret.Get(0).(*ec2.GetConsoleScreenshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetReservedInstancesExchangeQuoteInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetConsoleScreenshotInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11534,8 +13094,8 @@ func (_m *EC2) GetReservedInstancesExchangeQuote(ctx context.Context, params *ec
 	return r0, r1
 }
 
-// GetSerialConsoleAccessStatus provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetSerialConsoleAccessStatus(ctx context.Context, params *ec2.GetSerialConsoleAccessStatusInput, optFns ...func(*ec2.Options)) (*ec2.GetSerialConsoleAccessStatusOutput, error) {
+// GetDefaultCreditSpecification provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetDefaultCreditSpecification(ctx context.Context, params *ec2.GetDefaultCreditSpecificationInput, optFns ...func(*ec2.Options)) (*ec2.GetDefaultCreditSpecificationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11545,17 +13105,17 @@ func (_m *EC2) GetSerialConsoleAccessStatus(ctx context.Context, params *ec2.Get
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetSerialConsoleAccessStatusOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetSerialConsoleAccessStatusInput, ...func(*ec2.Options)) *ec2.GetSerialConsoleAccessStatusOutput); ok {
+	var r0 *ec2.GetDefaultCreditSpecificationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetDefaultCreditSpecificationInput, ...func(*ec2.Options)) *ec2.GetDefaultCreditSpecificationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetSerialConsoleAccessStatusOutput)
+			r0 = ret.Get(0).(*ec2.GetDefaultCreditSpecificationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetSerialConsoleAccessStatusInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetDefaultCreditSpecificationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11564,8 +13124,8 @@ func (_m *EC2) GetSerialConsoleAccessStatus(ctx context.Context, params *ec2.Get
 	return r0, r1
 }
 
-// GetSpotPlacementScores provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetSpotPlacementScores(ctx context.Context, params *ec2.GetSpotPlacementScoresInput, optFns ...func(*ec2.Options)) (*ec2.GetSpotPlacementScoresOutput, error) {
+// GetEbsDefaultKmsKeyId provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetEbsDefaultKmsKeyId(ctx context.Context, params *ec2.GetEbsDefaultKmsKeyIdInput, optFns ...func(*ec2.Options)) (*ec2.GetEbsDefaultKmsKeyIdOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11575,17 +13135,17 @@ func (_m *EC2) GetSpotPlacementScores(ctx context.Context, params *ec2.GetSpotPl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetSpotPlacementScoresOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetSpotPlacementScoresInput, ...func(*ec2.Options)) *ec2.GetSpotPlacementScoresOutput); ok {
+	var r0 *ec2.GetEbsDefaultKmsKeyIdOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetEbsDefaultKmsKeyIdInput, ...func(*ec2.Options)) *ec2.GetEbsDefaultKmsKeyIdOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetSpotPlacementScoresOutput)
+			r0 = ret.Get(0).(*ec2.GetEbsDefaultKmsKeyIdOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetSpotPlacementScoresInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetEbsDefaultKmsKeyIdInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11594,8 +13154,8 @@ func (_m *EC2) GetSpotPlacementScores(ctx context.Context, params *ec2.GetSpotPl
 	return r0, r1
 }
 
-// GetSubnetCidrReservations provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetSubnetCidrReservations(ctx context.Context, params *ec2.GetSubnetCidrReservationsInput, optFns ...func(*ec2.Options)) (*ec2.GetSubnetCidrReservationsOutput, error) {
+// GetEbsEncryptionByDefault provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetEbsEncryptionByDefault(ctx context.Context, params *ec2.GetEbsEncryptionByDefaultInput, optFns ...func(*ec2.Options)) (*ec2.GetEbsEncryptionByDefaultOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11605,17 +13165,17 @@ func (_m *EC2) GetSubnetCidrReservations(ctx context.Context, params *ec2.GetSub
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetSubnetCidrReservationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetSubnetCidrReservationsInput, ...func(*ec2.Options)) *ec2.GetSubnetCidrReservationsOutput); ok {
+	var r0 *ec2.GetEbsEncryptionByDefaultOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetEbsEncryptionByDefaultInput, ...func(*ec2.Options)) *ec2.GetEbsEncryptionByDefaultOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetSubnetCidrReservationsOutput)
+			r0 = ret.Get(0).(*ec2.GetEbsEncryptionByDefaultOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetSubnetCidrReservationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetEbsEncryptionByDefaultInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11624,8 +13184,8 @@ func (_m *EC2) GetSubnetCidrReservations(ctx context.Context, params *ec2.GetSub
 	return r0, r1
 }
 
-// GetTransitGatewayAttachmentPropagations provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetTransitGatewayAttachmentPropagations(ctx context.Context, params *ec2.GetTransitGatewayAttachmentPropagationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayAttachmentPropagationsOutput, error) {
+// GetFlowLogsIntegrationTemplate provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetFlowLogsIntegrationTemplate(ctx context.Context, params *ec2.GetFlowLogsIntegrationTemplateInput, optFns ...func(*ec2.Options)) (*ec2.GetFlowLogsIntegrationTemplateOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11635,17 +13195,17 @@ func (_m *EC2) GetTransitGatewayAttachmentPropagations(ctx context.Context, para
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetTransitGatewayAttachmentPropagationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayAttachmentPropagationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayAttachmentPropagationsOutput); ok {
+	var r0 *ec2.GetFlowLogsIntegrationTemplateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetFlowLogsIntegrationTemplateInput, ...func(*ec2.Options)) *ec2.GetFlowLogsIntegrationTemplateOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetTransitGatewayAttachmentPropagationsOutput)
+			r0 = ret.Get(0).(*ec2.GetFlowLogsIntegrationTemplateOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayAttachmentPropagationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetFlowLogsIntegrationTemplateInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11654,8 +13214,8 @@ func (_m *EC2) GetTransitGatewayAttachmentPropagations(ctx context.Context, para
 	return r0, r1
 }
 
-// GetTransitGatewayMulticastDomainAssociations provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetTransitGatewayMulticastDomainAssociations(ctx context.Context, params *ec2.GetTransitGatewayMulticastDomainAssociationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayMulticastDomainAssociationsOutput, error) {
+// GetGroupsForCapacityReservation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetGroupsForCapacityReservation(ctx context.Context, params *ec2.GetGroupsForCapacityReservationInput, optFns ...func(*ec2.Options)) (*ec2.GetGroupsForCapacityReservationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11665,17 +13225,17 @@ func (_m *EC2) GetTransitGatewayMulticastDomainAssociations(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetTransitGatewayMulticastDomainAssociationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayMulticastDomainAssociationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayMulticastDomainAssociationsOutput); ok {
+	var r0 *ec2.GetGroupsForCapacityReservationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetGroupsForCapacityReservationInput, ...func(*ec2.Options)) *ec2.GetGroupsForCapacityReservationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetTransitGatewayMulticastDomainAssociationsOutput)
+			r0 = ret.Get(0).(*ec2.GetGroupsForCapacityReservationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayMulticastDomainAssociationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetGroupsForCapacityReservationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11684,8 +13244,8 @@ func (_m *EC2) GetTransitGatewayMulticastDomainAssociations(ctx context.Context,
 	return r0, r1
 }
 
-// GetTransitGatewayPrefixListReferences provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetTransitGatewayPrefixListReferences(ctx context.Context, params *ec2.GetTransitGatewayPrefixListReferencesInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayPrefixListReferencesOutput, error) {
+// GetHostReservationPurchasePreview provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetHostReservationPurchasePreview(ctx context.Context, params *ec2.GetHostReservationPurchasePreviewInput, optFns ...func(*ec2.Options)) (*ec2.GetHostReservationPurchasePreviewOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11695,17 +13255,17 @@ func (_m *EC2) GetTransitGatewayPrefixListReferences(ctx context.Context, params
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetTransitGatewayPrefixListReferencesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayPrefixListReferencesInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayPrefixListReferencesOutput); ok {
+	var r0 *ec2.GetHostReservationPurchasePreviewOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetHostReservationPurchasePreviewInput, ...func(*ec2.Options)) *ec2.GetHostReservationPurchasePreviewOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetTransitGatewayPrefixListReferencesOutput)
+			r0 = ret.Get(0).(*ec2.GetHostReservationPurchasePreviewOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayPrefixListReferencesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetHostReservationPurchasePreviewInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11714,8 +13274,8 @@ func (_m *EC2) GetTransitGatewayPrefixListReferences(ctx context.Context, params
 	return r0, r1
 }
 
-// GetTransitGatewayRouteTableAssociations provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetTransitGatewayRouteTableAssociations(ctx context.Context, params *ec2.GetTransitGatewayRouteTableAssociationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+// GetImageBlockPublicAccessState provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetImageBlockPublicAccessState(ctx context.Context, params *ec2.GetImageBlockPublicAccessStateInput, optFns ...func(*ec2.Options)) (*ec2.GetImageBlockPublicAccessStateOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11725,17 +13285,17 @@ func (_m *EC2) GetTransitGatewayRouteTableAssociations(ctx context.Context, para
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetTransitGatewayRouteTableAssociationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayRouteTableAssociationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayRouteTableAssociationsOutput); ok {
+	var r0 *ec2.GetImageBlockPublicAccessStateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetImageBlockPublicAccessStateInput, ...func(*ec2.Options)) *ec2.GetImageBlockPublicAccessStateOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetTransitGatewayRouteTableAssociationsOutput)
+			r0 = ret.Get(0).(*ec2.GetImageBlockPublicAccessStateOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayRouteTableAssociationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetImageBlockPublicAccessStateInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11744,8 +13304,8 @@ func (_m *EC2) GetTransitGatewayRouteTableAssociations(ctx context.Context, para
 	return r0, r1
 }
 
-// GetTransitGatewayRouteTablePropagations provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetTransitGatewayRouteTablePropagations(ctx context.Context, params *ec2.GetTransitGatewayRouteTablePropagationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+// GetInstanceMetadataDefaults provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetInstanceMetadataDefaults(ctx context.Context, params *ec2.GetInstanceMetadataDefaultsInput, optFns ...func(*ec2.Options)) (*ec2.GetInstanceMetadataDefaultsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11755,17 +13315,17 @@ func (_m *EC2) GetTransitGatewayRouteTablePropagations(ctx context.Context, para
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetTransitGatewayRouteTablePropagationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayRouteTablePropagationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayRouteTablePropagationsOutput); ok {
+	var r0 *ec2.GetInstanceMetadataDefaultsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetInstanceMetadataDefaultsInput, ...func(*ec2.Options)) *ec2.GetInstanceMetadataDefaultsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetTransitGatewayRouteTablePropagationsOutput)
+			r0 = ret.Get(0).(*ec2.GetInstanceMetadataDefaultsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayRouteTablePropagationsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetInstanceMetadataDefaultsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11774,8 +13334,8 @@ func (_m *EC2) GetTransitGatewayRouteTablePropagations(ctx context.Context, para
 	return r0, r1
 }
 
-// GetVpnConnectionDeviceSampleConfiguration provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetVpnConnectionDeviceSampleConfiguration(ctx context.Context, params *ec2.GetVpnConnectionDeviceSampleConfigurationInput, optFns ...func(*ec2.Options)) (*ec2.GetVpnConnectionDeviceSampleConfigurationOutput, error) {
+// GetInstanceTpmEkPub provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetInstanceTpmEkPub(ctx context.Context, params *ec2.GetInstanceTpmEkPubInput, optFns ...func(*ec2.Options)) (*ec2.GetInstanceTpmEkPubOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11785,17 +13345,17 @@ func (_m *EC2) GetVpnConnectionDeviceSampleConfiguration(ctx context.Context, pa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetVpnConnectionDeviceSampleConfigurationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetVpnConnectionDeviceSampleConfigurationInput, ...func(*ec2.Options)) *ec2.GetVpnConnectionDeviceSampleConfigurationOutput); ok {
+	var r0 *ec2.GetInstanceTpmEkPubOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetInstanceTpmEkPubInput, ...func(*ec2.Options)) *ec2.GetInstanceTpmEkPubOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetVpnConnectionDeviceSampleConfigurationOutput)
+			r0 = ret.Get(0).(*ec2.GetInstanceTpmEkPubOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetVpnConnectionDeviceSampleConfigurationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetInstanceTpmEkPubInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11804,8 +13364,8 @@ func (_m *EC2) GetVpnConnectionDeviceSampleConfiguration(ctx context.Context, pa
 	return r0, r1
 }
 
-// GetVpnConnectionDeviceTypes provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) GetVpnConnectionDeviceTypes(ctx context.Context, params *ec2.GetVpnConnectionDeviceTypesInput, optFns ...func(*ec2.Options)) (*ec2.GetVpnConnectionDeviceTypesOutput, error) {
+// GetInstanceTypesFromInstanceRequirements provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetInstanceTypesFromInstanceRequirements(ctx context.Context, params *ec2.GetInstanceTypesFromInstanceRequirementsInput, optFns ...func(*ec2.Options)) (*ec2.GetInstanceTypesFromInstanceRequirementsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11815,17 +13375,17 @@ func (_m *EC2) GetVpnConnectionDeviceTypes(ctx context.Context, params *ec2.GetV
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.GetVpnConnectionDeviceTypesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetVpnConnectionDeviceTypesInput, ...func(*ec2.Options)) *ec2.GetVpnConnectionDeviceTypesOutput); ok {
+	var r0 *ec2.GetInstanceTypesFromInstanceRequirementsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetInstanceTypesFromInstanceRequirementsInput, ...func(*ec2.Options)) *ec2.GetInstanceTypesFromInstanceRequirementsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.GetVpnConnectionDeviceTypesOutput)
+			r0 = ret.Get(0).(*ec2.GetInstanceTypesFromInstanceRequirementsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetVpnConnectionDeviceTypesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetInstanceTypesFromInstanceRequirementsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11834,8 +13394,8 @@ func (_m *EC2) GetVpnConnectionDeviceTypes(ctx context.Context, params *ec2.GetV
 	return r0, r1
 }
 
-// ImportClientVpnClientCertificateRevocationList provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ImportClientVpnClientCertificateRevocationList(ctx context.Context, params *ec2.ImportClientVpnClientCertificateRevocationListInput, optFns ...func(*ec2.Options)) (*ec2.ImportClientVpnClientCertificateRevocationListOutput, error) {
+// GetInstanceUefiData provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetInstanceUefiData(ctx context.Context, params *ec2.GetInstanceUefiDataInput, optFns ...func(*ec2.Options)) (*ec2.GetInstanceUefiDataOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11845,17 +13405,17 @@ func (_m *EC2) ImportClientVpnClientCertificateRevocationList(ctx context.Contex
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ImportClientVpnClientCertificateRevocationListOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportClientVpnClientCertificateRevocationListInput, ...func(*ec2.Options)) *ec2.ImportClientVpnClientCertificateRevocationListOutput); ok {
+	var r0 *ec2.GetInstanceUefiDataOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetInstanceUefiDataInput, ...func(*ec2.Options)) *ec2.GetInstanceUefiDataOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ImportClientVpnClientCertificateRevocationListOutput)
+			r0 = ret.Get(0).(*ec2.GetInstanceUefiDataOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportClientVpnClientCertificateRevocationListInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetInstanceUefiDataInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11864,8 +13424,8 @@ func (_m *EC2) ImportClientVpnClientCertificateRevocationList(ctx context.Contex
 	return r0, r1
 }
 
-// ImportImage provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ImportImage(ctx context.Context, params *ec2.ImportImageInput, optFns ...func(*ec2.Options)) (*ec2.ImportImageOutput, error) {
+// GetIpamAddressHistory provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetIpamAddressHistory(ctx context.Context, params *ec2.GetIpamAddressHistoryInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamAddressHistoryOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11875,17 +13435,17 @@ func (_m *EC2) ImportImage(ctx context.Context, params *ec2.ImportImageInput, op
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ImportImageOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportImageInput, ...func(*ec2.Options)) *ec2.ImportImageOutput); ok {
+	var r0 *ec2.GetIpamAddressHistoryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamAddressHistoryInput, ...func(*ec2.Options)) *ec2.GetIpamAddressHistoryOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ImportImageOutput)
+			r0 = ret.Get(0).(*ec2.GetIpamAddressHistoryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportImageInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamAddressHistoryInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11894,8 +13454,8 @@ func (_m *EC2) ImportImage(ctx context.Context, params *ec2.ImportImageInput, op
 	return r0, r1
 }
 
-// ImportInstance provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ImportInstance(ctx context.Context, params *ec2.ImportInstanceInput, optFns ...func(*ec2.Options)) (*ec2.ImportInstanceOutput, error) {
+// GetIpamDiscoveredAccounts provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetIpamDiscoveredAccounts(ctx context.Context, params *ec2.GetIpamDiscoveredAccountsInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamDiscoveredAccountsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11905,17 +13465,17 @@ func (_m *EC2) ImportInstance(ctx context.Context, params *ec2.ImportInstanceInp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ImportInstanceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportInstanceInput, ...func(*ec2.Options)) *ec2.ImportInstanceOutput); ok {
+	var r0 *ec2.GetIpamDiscoveredAccountsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamDiscoveredAccountsInput, ...func(*ec2.Options)) *ec2.GetIpamDiscoveredAccountsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ImportInstanceOutput)
+			r0 = ret.Get(0).(*ec2.GetIpamDiscoveredAccountsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportInstanceInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamDiscoveredAccountsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11924,8 +13484,8 @@ func (_m *EC2) ImportInstance(ctx context.Context, params *ec2.ImportInstanceInp
 	return r0, r1
 }
 
-// ImportKeyPair provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ImportKeyPair(ctx context.Context, params *ec2.ImportKeyPairInput, optFns ...func(*ec2.Options)) (*ec2.ImportKeyPairOutput, error) {
+// GetIpamDiscoveredPublicAddresses provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetIpamDiscoveredPublicAddresses(ctx context.Context, params *ec2.GetIpamDiscoveredPublicAddressesInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamDiscoveredPublicAddressesOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11935,17 +13495,17 @@ func (_m *EC2) ImportKeyPair(ctx context.Context, params *ec2.ImportKeyPairInput
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ImportKeyPairOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportKeyPairInput, ...func(*ec2.Options)) *ec2.ImportKeyPairOutput); ok {
+	var r0 *ec2.GetIpamDiscoveredPublicAddressesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamDiscoveredPublicAddressesInput, ...func(*ec2.Options)) *ec2.GetIpamDiscoveredPublicAddressesOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ImportKeyPairOutput)
+			r0 = ret.Get(0).(*ec2.GetIpamDiscoveredPublicAddressesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportKeyPairInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamDiscoveredPublicAddressesInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11954,8 +13514,8 @@ func (_m *EC2) ImportKeyPair(ctx context.Context, params *ec2.ImportKeyPairInput
 	return r0, r1
 }
 
-// ImportSnapshot provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ImportSnapshot(ctx context.Context, params *ec2.ImportSnapshotInput, optFns ...func(*ec2.Options)) (*ec2.ImportSnapshotOutput, error) {
+// GetIpamDiscoveredResourceCidrs provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetIpamDiscoveredResourceCidrs(ctx context.Context, params *ec2.GetIpamDiscoveredResourceCidrsInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamDiscoveredResourceCidrsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11965,17 +13525,17 @@ func (_m *EC2) ImportSnapshot(ctx context.Context, params *ec2.ImportSnapshotInp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ImportSnapshotOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportSnapshotInput, ...func(*ec2.Options)) *ec2.ImportSnapshotOutput); ok {
+	var r0 *ec2.GetIpamDiscoveredResourceCidrsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamDiscoveredResourceCidrsInput, ...func(*ec2.Options)) *ec2.GetIpamDiscoveredResourceCidrsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ImportSnapshotOutput)
+			r0 = ret.Get(0).(*ec2.GetIpamDiscoveredResourceCidrsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportSnapshotInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamDiscoveredResourceCidrsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -11984,8 +13544,8 @@ func (_m *EC2) ImportSnapshot(ctx context.Context, params *ec2.ImportSnapshotInp
 	return r0, r1
 }
 
-// ImportVolume provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ImportVolume(ctx context.Context, params *ec2.ImportVolumeInput, optFns ...func(*ec2.Options)) (*ec2.ImportVolumeOutput, error) {
+// GetIpamPoolAllocations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetIpamPoolAllocations(ctx context.Context, params *ec2.GetIpamPoolAllocationsInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamPoolAllocationsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -11995,17 +13555,17 @@ func (_m *EC2) ImportVolume(ctx context.Context, params *ec2.ImportVolumeInput,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ImportVolumeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportVolumeInput, ...func(*ec2.Options)) *ec2.ImportVolumeOutput); ok {
+	var r0 *ec2.GetIpamPoolAllocationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamPoolAllocationsInput, ...func(*ec2.Options)) *ec2.GetIpamPoolAllocationsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ImportVolumeOutput)
+			r0 = ret.Get(0).(*ec2.GetIpamPoolAllocationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportVolumeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamPoolAllocationsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12014,8 +13574,8 @@ func (_m *EC2) ImportVolume(ctx context.Context, params *ec2.ImportVolumeInput,
 	return r0, r1
 }
 
-// ListImagesInRecycleBin provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ListImagesInRecycleBin(ctx context.Context, params *ec2.ListImagesInRecycleBinInput, optFns ...func(*ec2.Options)) (*ec2.ListImagesInRecycleBinOutput, error) {
+// GetIpamPoolCidrs provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetIpamPoolCidrs(ctx context.Context, params *ec2.GetIpamPoolCidrsInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12025,17 +13585,17 @@ func (_m *EC2) ListImagesInRecycleBin(ctx context.Context, params *ec2.ListImage
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ListImagesInRecycleBinOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ListImagesInRecycleBinInput, ...func(*ec2.Options)) *ec2.ListImagesInRecycleBinOutput); ok {
+	var r0 *ec2.GetIpamPoolCidrsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamPoolCidrsInput, ...func(*ec2.Options)) *ec2.GetIpamPoolCidrsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ListImagesInRecycleBinOutput)
+			r0 = ret.Get(0).(*ec2.GetIpamPoolCidrsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ListImagesInRecycleBinInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamPoolCidrsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12044,8 +13604,8 @@ func (_m *EC2) ListImagesInRecycleBin(ctx context.Context, params *ec2.ListImage
 	return r0, r1
 }
 
-// ListSnapshotsInRecycleBin provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ListSnapshotsInRecycleBin(ctx context.Context, params *ec2.ListSnapshotsInRecycleBinInput, optFns ...func(*ec2.Options)) (*ec2.ListSnapshotsInRecycleBinOutput, error) {
+// GetIpamResourceCidrs provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetIpamResourceCidrs(ctx context.Context, params *ec2.GetIpamResourceCidrsInput, optFns ...func(*ec2.Options)) (*ec2.GetIpamResourceCidrsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12055,17 +13615,17 @@ func (_m *EC2) ListSnapshotsInRecycleBin(ctx context.Context, params *ec2.ListSn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ListSnapshotsInRecycleBinOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ListSnapshotsInRecycleBinInput, ...func(*ec2.Options)) *ec2.ListSnapshotsInRecycleBinOutput); ok {
+	var r0 *ec2.GetIpamResourceCidrsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetIpamResourceCidrsInput, ...func(*ec2.Options)) *ec2.GetIpamResourceCidrsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ListSnapshotsInRecycleBinOutput)
+			r0 = ret.Get(0).(*ec2.GetIpamResourceCidrsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ListSnapshotsInRecycleBinInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetIpamResourceCidrsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12074,8 +13634,8 @@ func (_m *EC2) ListSnapshotsInRecycleBin(ctx context.Context, params *ec2.ListSn
 	return r0, r1
 }
 
-// ModifyAddressAttribute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyAddressAttribute(ctx context.Context, params *ec2.ModifyAddressAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyAddressAttributeOutput, error) {
+// GetLaunchTemplateData provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetLaunchTemplateData(ctx context.Context, params *ec2.GetLaunchTemplateDataInput, optFns ...func(*ec2.Options)) (*ec2.GetLaunchTemplateDataOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12085,17 +13645,17 @@ func (_m *EC2) ModifyAddressAttribute(ctx context.Context, params *ec2.ModifyAdd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyAddressAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyAddressAttributeInput, ...func(*ec2.Options)) *ec2.ModifyAddressAttributeOutput); ok {
+	var r0 *ec2.GetLaunchTemplateDataOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetLaunchTemplateDataInput, ...func(*ec2.Options)) *ec2.GetLaunchTemplateDataOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyAddressAttributeOutput)
+			r0 = ret.Get(0).(*ec2.GetLaunchTemplateDataOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyAddressAttributeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetLaunchTemplateDataInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12104,8 +13664,8 @@ func (_m *EC2) ModifyAddressAttribute(ctx context.Context, params *ec2.ModifyAdd
 	return r0, r1
 }
 
-// ModifyAvailabilityZoneGroup provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyAvailabilityZoneGroup(ctx context.Context, params *ec2.ModifyAvailabilityZoneGroupInput, optFns ...func(*ec2.Options)) (*ec2.ModifyAvailabilityZoneGroupOutput, error) {
+// GetManagedPrefixListAssociations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetManagedPrefixListAssociations(ctx context.Context, params *ec2.GetManagedPrefixListAssociationsInput, optFns ...func(*ec2.Options)) (*ec2.GetManagedPrefixListAssociationsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12115,17 +13675,17 @@ func (_m *EC2) ModifyAvailabilityZoneGroup(ctx context.Context, params *ec2.Modi
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyAvailabilityZoneGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyAvailabilityZoneGroupInput, ...func(*ec2.Options)) *ec2.ModifyAvailabilityZoneGroupOutput); ok {
+	var r0 *ec2.GetManagedPrefixListAssociationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetManagedPrefixListAssociationsInput, ...func(*ec2.Options)) *ec2.GetManagedPrefixListAssociationsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyAvailabilityZoneGroupOutput)
+			r0 = ret.Get(0).(*ec2.GetManagedPrefixListAssociationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyAvailabilityZoneGroupInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetManagedPrefixListAssociationsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12134,8 +13694,8 @@ func (_m *EC2) ModifyAvailabilityZoneGroup(ctx context.Context, params *ec2.Modi
 	return r0, r1
 }
 
-// ModifyCapacityReservation provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyCapacityReservation(ctx context.Context, params *ec2.ModifyCapacityReservationInput, optFns ...func(*ec2.Options)) (*ec2.ModifyCapacityReservationOutput, error) {
+// GetManagedPrefixListEntries provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetManagedPrefixListEntries(ctx context.Context, params *ec2.GetManagedPrefixListEntriesInput, optFns ...func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12145,17 +13705,17 @@ func (_m *EC2) ModifyCapacityReservation(ctx context.Context, params *ec2.Modify
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyCapacityReservationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyCapacityReservationInput, ...func(*ec2.Options)) *ec2.ModifyCapacityReservationOutput); ok {
+	var r0 *ec2.GetManagedPrefixListEntriesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetManagedPrefixListEntriesInput, ...func(*ec2.Options)) *ec2.GetManagedPrefixListEntriesOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyCapacityReservationOutput)
+			r0 = ret.Get(0).(*ec2.GetManagedPrefixListEntriesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyCapacityReservationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetManagedPrefixListEntriesInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12164,8 +13724,8 @@ func (_m *EC2) ModifyCapacityReservation(ctx context.Context, params *ec2.Modify
 	return r0, r1
 }
 
-// ModifyCapacityReservationFleet provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyCapacityReservationFleet(ctx context.Context, params *ec2.ModifyCapacityReservationFleetInput, optFns ...func(*ec2.Options)) (*ec2.ModifyCapacityReservationFleetOutput, error) {
+// GetNetworkInsightsAccessScopeAnalysisFindings provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetNetworkInsightsAccessScopeAnalysisFindings(ctx context.Context, params *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput, optFns ...func(*ec2.Options)) (*ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12175,17 +13735,17 @@ func (_m *EC2) ModifyCapacityReservationFleet(ctx context.Context, params *ec2.M
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyCapacityReservationFleetOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyCapacityReservationFleetInput, ...func(*ec2.Options)) *ec2.ModifyCapacityReservationFleetOutput); ok {
+	var r0 *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput, ...func(*ec2.Options)) *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyCapacityReservationFleetOutput)
+			r0 = ret.Get(0).(*ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyCapacityReservationFleetInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12194,8 +13754,8 @@ func (_m *EC2) ModifyCapacityReservationFleet(ctx context.Context, params *ec2.M
 	return r0, r1
 }
 
-// ModifyClientVpnEndpoint provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyClientVpnEndpoint(ctx context.Context, params *ec2.ModifyClientVpnEndpointInput, optFns ...func(*ec2.Options)) (*ec2.ModifyClientVpnEndpointOutput, error) {
+// GetNetworkInsightsAccessScopeContent provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetNetworkInsightsAccessScopeContent(ctx context.Context, params *ec2.GetNetworkInsightsAccessScopeContentInput, optFns ...func(*ec2.Options)) (*ec2.GetNetworkInsightsAccessScopeContentOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12205,17 +13765,17 @@ func (_m *EC2) ModifyClientVpnEndpoint(ctx context.Context, params *ec2.ModifyCl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyClientVpnEndpointOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyClientVpnEndpointInput, ...func(*ec2.Options)) *ec2.ModifyClientVpnEndpointOutput); ok {
+	var r0 *ec2.GetNetworkInsightsAccessScopeContentOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetNetworkInsightsAccessScopeContentInput, ...func(*ec2.Options)) *ec2.GetNetworkInsightsAccessScopeContentOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyClientVpnEndpointOutput)
+			r0 = ret.Get(0).(*ec2.GetNetworkInsightsAccessScopeContentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyClientVpnEndpointInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetNetworkInsightsAccessScopeContentInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12224,8 +13784,8 @@ func (_m *EC2) ModifyClientVpnEndpoint(ctx context.Context, params *ec2.ModifyCl
 	return r0, r1
 }
 
-// ModifyDefaultCreditSpecification provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyDefaultCreditSpecification(ctx context.Context, params *ec2.ModifyDefaultCreditSpecificationInput, optFns ...func(*ec2.Options)) (*ec2.ModifyDefaultCreditSpecificationOutput, error) {
+// GetPasswordData provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetPasswordData(ctx context.Context, params *ec2.GetPasswordDataInput, optFns ...func(*ec2.Options)) (*ec2.GetPasswordDataOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12235,17 +13795,17 @@ func (_m *EC2) ModifyDefaultCreditSpecification(ctx context.Context, params *ec2
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyDefaultCreditSpecificationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyDefaultCreditSpecificationInput, ...func(*ec2.Options)) *ec2.ModifyDefaultCreditSpecificationOutput); ok {
+	var r0 *ec2.GetPasswordDataOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetPasswordDataInput, ...func(*ec2.Options)) *ec2.GetPasswordDataOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyDefaultCreditSpecificationOutput)
+			r0 = ret.Get(0).(*ec2.GetPasswordDataOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyDefaultCreditSpecificationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetPasswordDataInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12254,8 +13814,8 @@ func (_m *EC2) ModifyDefaultCreditSpecification(ctx context.Context, params *ec2
 	return r0, r1
 }
 
-// ModifyEbsDefaultKmsKeyId provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyEbsDefaultKmsKeyId(ctx context.Context, params *ec2.ModifyEbsDefaultKmsKeyIdInput, optFns ...func(*ec2.Options)) (*ec2.ModifyEbsDefaultKmsKeyIdOutput, error) {
+// GetReservedInstancesExchangeQuote provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetReservedInstancesExchangeQuote(ctx context.Context, params *ec2.GetReservedInstancesExchangeQuoteInput, optFns ...func(*ec2.Options)) (*ec2.GetReservedInstancesExchangeQuoteOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12265,17 +13825,1007 @@ func (_m *EC2) ModifyEbsDefaultKmsKeyId(ctx context.Context, params *ec2.ModifyE
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyEbsDefaultKmsKeyIdOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyEbsDefaultKmsKeyIdInput, ...func(*ec2.Options)) *ec2.ModifyEbsDefaultKmsKeyIdOutput); ok {
+	var r0 *ec2.GetReservedInstancesExchangeQuoteOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetReservedInstancesExchangeQuoteInput, ...func(*ec2.Options)) *ec2.GetReservedInstancesExchangeQuoteOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyEbsDefaultKmsKeyIdOutput)
+			r0 = ret.Get(0).(*ec2.GetReservedInstancesExchangeQuoteOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyEbsDefaultKmsKeyIdInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetReservedInstancesExchangeQuoteInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSecurityGroupsForVpc provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetSecurityGroupsForVpc(ctx context.Context, params *ec2.GetSecurityGroupsForVpcInput, optFns ...func(*ec2.Options)) (*ec2.GetSecurityGroupsForVpcOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetSecurityGroupsForVpcOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetSecurityGroupsForVpcInput, ...func(*ec2.Options)) *ec2.GetSecurityGroupsForVpcOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetSecurityGroupsForVpcOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetSecurityGroupsForVpcInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSerialConsoleAccessStatus provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetSerialConsoleAccessStatus(ctx context.Context, params *ec2.GetSerialConsoleAccessStatusInput, optFns ...func(*ec2.Options)) (*ec2.GetSerialConsoleAccessStatusOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetSerialConsoleAccessStatusOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetSerialConsoleAccessStatusInput, ...func(*ec2.Options)) *ec2.GetSerialConsoleAccessStatusOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetSerialConsoleAccessStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetSerialConsoleAccessStatusInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSnapshotBlockPublicAccessState provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetSnapshotBlockPublicAccessState(ctx context.Context, params *ec2.GetSnapshotBlockPublicAccessStateInput, optFns ...func(*ec2.Options)) (*ec2.GetSnapshotBlockPublicAccessStateOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetSnapshotBlockPublicAccessStateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetSnapshotBlockPublicAccessStateInput, ...func(*ec2.Options)) *ec2.GetSnapshotBlockPublicAccessStateOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetSnapshotBlockPublicAccessStateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetSnapshotBlockPublicAccessStateInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSpotPlacementScores provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetSpotPlacementScores(ctx context.Context, params *ec2.GetSpotPlacementScoresInput, optFns ...func(*ec2.Options)) (*ec2.GetSpotPlacementScoresOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetSpotPlacementScoresOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetSpotPlacementScoresInput, ...func(*ec2.Options)) *ec2.GetSpotPlacementScoresOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetSpotPlacementScoresOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetSpotPlacementScoresInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubnetCidrReservations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetSubnetCidrReservations(ctx context.Context, params *ec2.GetSubnetCidrReservationsInput, optFns ...func(*ec2.Options)) (*ec2.GetSubnetCidrReservationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetSubnetCidrReservationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetSubnetCidrReservationsInput, ...func(*ec2.Options)) *ec2.GetSubnetCidrReservationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetSubnetCidrReservationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetSubnetCidrReservationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransitGatewayAttachmentPropagations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetTransitGatewayAttachmentPropagations(ctx context.Context, params *ec2.GetTransitGatewayAttachmentPropagationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayAttachmentPropagationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetTransitGatewayAttachmentPropagationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayAttachmentPropagationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayAttachmentPropagationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetTransitGatewayAttachmentPropagationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayAttachmentPropagationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransitGatewayMulticastDomainAssociations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetTransitGatewayMulticastDomainAssociations(ctx context.Context, params *ec2.GetTransitGatewayMulticastDomainAssociationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayMulticastDomainAssociationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetTransitGatewayMulticastDomainAssociationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayMulticastDomainAssociationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayMulticastDomainAssociationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetTransitGatewayMulticastDomainAssociationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayMulticastDomainAssociationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransitGatewayPolicyTableAssociations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetTransitGatewayPolicyTableAssociations(ctx context.Context, params *ec2.GetTransitGatewayPolicyTableAssociationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayPolicyTableAssociationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetTransitGatewayPolicyTableAssociationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayPolicyTableAssociationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayPolicyTableAssociationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetTransitGatewayPolicyTableAssociationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayPolicyTableAssociationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransitGatewayPolicyTableEntries provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetTransitGatewayPolicyTableEntries(ctx context.Context, params *ec2.GetTransitGatewayPolicyTableEntriesInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayPolicyTableEntriesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetTransitGatewayPolicyTableEntriesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayPolicyTableEntriesInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayPolicyTableEntriesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetTransitGatewayPolicyTableEntriesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayPolicyTableEntriesInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransitGatewayPrefixListReferences provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetTransitGatewayPrefixListReferences(ctx context.Context, params *ec2.GetTransitGatewayPrefixListReferencesInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayPrefixListReferencesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetTransitGatewayPrefixListReferencesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayPrefixListReferencesInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayPrefixListReferencesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetTransitGatewayPrefixListReferencesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayPrefixListReferencesInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransitGatewayRouteTableAssociations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetTransitGatewayRouteTableAssociations(ctx context.Context, params *ec2.GetTransitGatewayRouteTableAssociationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetTransitGatewayRouteTableAssociationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayRouteTableAssociationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayRouteTableAssociationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetTransitGatewayRouteTableAssociationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayRouteTableAssociationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransitGatewayRouteTablePropagations provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetTransitGatewayRouteTablePropagations(ctx context.Context, params *ec2.GetTransitGatewayRouteTablePropagationsInput, optFns ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetTransitGatewayRouteTablePropagationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetTransitGatewayRouteTablePropagationsInput, ...func(*ec2.Options)) *ec2.GetTransitGatewayRouteTablePropagationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetTransitGatewayRouteTablePropagationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetTransitGatewayRouteTablePropagationsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVerifiedAccessEndpointPolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetVerifiedAccessEndpointPolicy(ctx context.Context, params *ec2.GetVerifiedAccessEndpointPolicyInput, optFns ...func(*ec2.Options)) (*ec2.GetVerifiedAccessEndpointPolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetVerifiedAccessEndpointPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetVerifiedAccessEndpointPolicyInput, ...func(*ec2.Options)) *ec2.GetVerifiedAccessEndpointPolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetVerifiedAccessEndpointPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetVerifiedAccessEndpointPolicyInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVerifiedAccessGroupPolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetVerifiedAccessGroupPolicy(ctx context.Context, params *ec2.GetVerifiedAccessGroupPolicyInput, optFns ...func(*ec2.Options)) (*ec2.GetVerifiedAccessGroupPolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetVerifiedAccessGroupPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetVerifiedAccessGroupPolicyInput, ...func(*ec2.Options)) *ec2.GetVerifiedAccessGroupPolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetVerifiedAccessGroupPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetVerifiedAccessGroupPolicyInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVpnConnectionDeviceSampleConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetVpnConnectionDeviceSampleConfiguration(ctx context.Context, params *ec2.GetVpnConnectionDeviceSampleConfigurationInput, optFns ...func(*ec2.Options)) (*ec2.GetVpnConnectionDeviceSampleConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetVpnConnectionDeviceSampleConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetVpnConnectionDeviceSampleConfigurationInput, ...func(*ec2.Options)) *ec2.GetVpnConnectionDeviceSampleConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetVpnConnectionDeviceSampleConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetVpnConnectionDeviceSampleConfigurationInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVpnConnectionDeviceTypes provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetVpnConnectionDeviceTypes(ctx context.Context, params *ec2.GetVpnConnectionDeviceTypesInput, optFns ...func(*ec2.Options)) (*ec2.GetVpnConnectionDeviceTypesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetVpnConnectionDeviceTypesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetVpnConnectionDeviceTypesInput, ...func(*ec2.Options)) *ec2.GetVpnConnectionDeviceTypesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetVpnConnectionDeviceTypesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetVpnConnectionDeviceTypesInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVpnTunnelReplacementStatus provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) GetVpnTunnelReplacementStatus(ctx context.Context, params *ec2.GetVpnTunnelReplacementStatusInput, optFns ...func(*ec2.Options)) (*ec2.GetVpnTunnelReplacementStatusOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.GetVpnTunnelReplacementStatusOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.GetVpnTunnelReplacementStatusInput, ...func(*ec2.Options)) *ec2.GetVpnTunnelReplacementStatusOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.GetVpnTunnelReplacementStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.GetVpnTunnelReplacementStatusInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportClientVpnClientCertificateRevocationList provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ImportClientVpnClientCertificateRevocationList(ctx context.Context, params *ec2.ImportClientVpnClientCertificateRevocationListInput, optFns ...func(*ec2.Options)) (*ec2.ImportClientVpnClientCertificateRevocationListOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ImportClientVpnClientCertificateRevocationListOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportClientVpnClientCertificateRevocationListInput, ...func(*ec2.Options)) *ec2.ImportClientVpnClientCertificateRevocationListOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ImportClientVpnClientCertificateRevocationListOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportClientVpnClientCertificateRevocationListInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportImage provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ImportImage(ctx context.Context, params *ec2.ImportImageInput, optFns ...func(*ec2.Options)) (*ec2.ImportImageOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ImportImageOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportImageInput, ...func(*ec2.Options)) *ec2.ImportImageOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ImportImageOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportImageInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportInstance provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ImportInstance(ctx context.Context, params *ec2.ImportInstanceInput, optFns ...func(*ec2.Options)) (*ec2.ImportInstanceOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ImportInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportInstanceInput, ...func(*ec2.Options)) *ec2.ImportInstanceOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ImportInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportInstanceInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportKeyPair provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ImportKeyPair(ctx context.Context, params *ec2.ImportKeyPairInput, optFns ...func(*ec2.Options)) (*ec2.ImportKeyPairOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ImportKeyPairOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportKeyPairInput, ...func(*ec2.Options)) *ec2.ImportKeyPairOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ImportKeyPairOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportKeyPairInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportSnapshot provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ImportSnapshot(ctx context.Context, params *ec2.ImportSnapshotInput, optFns ...func(*ec2.Options)) (*ec2.ImportSnapshotOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ImportSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportSnapshotInput, ...func(*ec2.Options)) *ec2.ImportSnapshotOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ImportSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportSnapshotInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportVolume provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ImportVolume(ctx context.Context, params *ec2.ImportVolumeInput, optFns ...func(*ec2.Options)) (*ec2.ImportVolumeOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ImportVolumeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ImportVolumeInput, ...func(*ec2.Options)) *ec2.ImportVolumeOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ImportVolumeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ImportVolumeInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImagesInRecycleBin provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ListImagesInRecycleBin(ctx context.Context, params *ec2.ListImagesInRecycleBinInput, optFns ...func(*ec2.Options)) (*ec2.ListImagesInRecycleBinOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ListImagesInRecycleBinOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ListImagesInRecycleBinInput, ...func(*ec2.Options)) *ec2.ListImagesInRecycleBinOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ListImagesInRecycleBinOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ListImagesInRecycleBinInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSnapshotsInRecycleBin provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ListSnapshotsInRecycleBin(ctx context.Context, params *ec2.ListSnapshotsInRecycleBinInput, optFns ...func(*ec2.Options)) (*ec2.ListSnapshotsInRecycleBinOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ListSnapshotsInRecycleBinOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ListSnapshotsInRecycleBinInput, ...func(*ec2.Options)) *ec2.ListSnapshotsInRecycleBinOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ListSnapshotsInRecycleBinOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ListSnapshotsInRecycleBinInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockSnapshot provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) LockSnapshot(ctx context.Context, params *ec2.LockSnapshotInput, optFns ...func(*ec2.Options)) (*ec2.LockSnapshotOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.LockSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.LockSnapshotInput, ...func(*ec2.Options)) *ec2.LockSnapshotOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.LockSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.LockSnapshotInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyAddressAttribute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyAddressAttribute(ctx context.Context, params *ec2.ModifyAddressAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyAddressAttributeOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyAddressAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyAddressAttributeInput, ...func(*ec2.Options)) *ec2.ModifyAddressAttributeOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyAddressAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyAddressAttributeInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyAvailabilityZoneGroup provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyAvailabilityZoneGroup(ctx context.Context, params *ec2.ModifyAvailabilityZoneGroupInput, optFns ...func(*ec2.Options)) (*ec2.ModifyAvailabilityZoneGroupOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyAvailabilityZoneGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyAvailabilityZoneGroupInput, ...func(*ec2.Options)) *ec2.ModifyAvailabilityZoneGroupOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyAvailabilityZoneGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyAvailabilityZoneGroupInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCapacityReservation provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyCapacityReservation(ctx context.Context, params *ec2.ModifyCapacityReservationInput, optFns ...func(*ec2.Options)) (*ec2.ModifyCapacityReservationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyCapacityReservationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyCapacityReservationInput, ...func(*ec2.Options)) *ec2.ModifyCapacityReservationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyCapacityReservationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyCapacityReservationInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCapacityReservationFleet provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyCapacityReservationFleet(ctx context.Context, params *ec2.ModifyCapacityReservationFleetInput, optFns ...func(*ec2.Options)) (*ec2.ModifyCapacityReservationFleetOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyCapacityReservationFleetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyCapacityReservationFleetInput, ...func(*ec2.Options)) *ec2.ModifyCapacityReservationFleetOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyCapacityReservationFleetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyCapacityReservationFleetInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClientVpnEndpoint provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyClientVpnEndpoint(ctx context.Context, params *ec2.ModifyClientVpnEndpointInput, optFns ...func(*ec2.Options)) (*ec2.ModifyClientVpnEndpointOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyClientVpnEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyClientVpnEndpointInput, ...func(*ec2.Options)) *ec2.ModifyClientVpnEndpointOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyClientVpnEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyClientVpnEndpointInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDefaultCreditSpecification provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyDefaultCreditSpecification(ctx context.Context, params *ec2.ModifyDefaultCreditSpecificationInput, optFns ...func(*ec2.Options)) (*ec2.ModifyDefaultCreditSpecificationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyDefaultCreditSpecificationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyDefaultCreditSpecificationInput, ...func(*ec2.Options)) *ec2.ModifyDefaultCreditSpecificationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyDefaultCreditSpecificationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyDefaultCreditSpecificationInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyEbsDefaultKmsKeyId provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyEbsDefaultKmsKeyId(ctx context.Context, params *ec2.ModifyEbsDefaultKmsKeyIdInput, optFns ...func(*ec2.Options)) (*ec2.ModifyEbsDefaultKmsKeyIdOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyEbsDefaultKmsKeyIdOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyEbsDefaultKmsKeyIdInput, ...func(*ec2.Options)) *ec2.ModifyEbsDefaultKmsKeyIdOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyEbsDefaultKmsKeyIdOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyEbsDefaultKmsKeyIdInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12295,17 +14845,287 @@ func (_m *EC2) ModifyFleet(ctx context.Context, params *ec2.ModifyFleetInput, op
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyFleetOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyFleetInput, ...func(*ec2.Options)) *ec2.ModifyFleetOutput); ok {
+	var r0 *ec2.ModifyFleetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyFleetInput, ...func(*ec2.Options)) *ec2.ModifyFleetOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyFleetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyFleetInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyFpgaImageAttribute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyFpgaImageAttribute(ctx context.Context, params *ec2.ModifyFpgaImageAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyFpgaImageAttributeOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyFpgaImageAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyFpgaImageAttributeInput, ...func(*ec2.Options)) *ec2.ModifyFpgaImageAttributeOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyFpgaImageAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyFpgaImageAttributeInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyHosts provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyHosts(ctx context.Context, params *ec2.ModifyHostsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyHostsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyHostsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyHostsInput, ...func(*ec2.Options)) *ec2.ModifyHostsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyHostsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyHostsInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyIdFormat provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyIdFormat(ctx context.Context, params *ec2.ModifyIdFormatInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIdFormatOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyIdFormatOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIdFormatInput, ...func(*ec2.Options)) *ec2.ModifyIdFormatOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyIdFormatOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIdFormatInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyIdentityIdFormat provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyIdentityIdFormat(ctx context.Context, params *ec2.ModifyIdentityIdFormatInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIdentityIdFormatOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyIdentityIdFormatOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIdentityIdFormatInput, ...func(*ec2.Options)) *ec2.ModifyIdentityIdFormatOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyIdentityIdFormatOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIdentityIdFormatInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyImageAttribute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyImageAttribute(ctx context.Context, params *ec2.ModifyImageAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyImageAttributeOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyImageAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyImageAttributeInput, ...func(*ec2.Options)) *ec2.ModifyImageAttributeOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyImageAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyImageAttributeInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyInstanceAttribute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstanceAttribute(ctx context.Context, params *ec2.ModifyInstanceAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyInstanceAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) *ec2.ModifyInstanceAttributeOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyInstanceAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyInstanceCapacityReservationAttributes provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstanceCapacityReservationAttributes(ctx context.Context, params *ec2.ModifyInstanceCapacityReservationAttributesInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceCapacityReservationAttributesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyInstanceCapacityReservationAttributesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceCapacityReservationAttributesInput, ...func(*ec2.Options)) *ec2.ModifyInstanceCapacityReservationAttributesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyInstanceCapacityReservationAttributesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceCapacityReservationAttributesInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyInstanceCreditSpecification provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstanceCreditSpecification(ctx context.Context, params *ec2.ModifyInstanceCreditSpecificationInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceCreditSpecificationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyInstanceCreditSpecificationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceCreditSpecificationInput, ...func(*ec2.Options)) *ec2.ModifyInstanceCreditSpecificationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifyInstanceCreditSpecificationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceCreditSpecificationInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyInstanceEventStartTime provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstanceEventStartTime(ctx context.Context, params *ec2.ModifyInstanceEventStartTimeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceEventStartTimeOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifyInstanceEventStartTimeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceEventStartTimeInput, ...func(*ec2.Options)) *ec2.ModifyInstanceEventStartTimeOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyFleetOutput)
+			r0 = ret.Get(0).(*ec2.ModifyInstanceEventStartTimeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyFleetInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceEventStartTimeInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12314,8 +15134,8 @@ func (_m *EC2) ModifyFleet(ctx context.Context, params *ec2.ModifyFleetInput, op
 	return r0, r1
 }
 
-// ModifyFpgaImageAttribute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyFpgaImageAttribute(ctx context.Context, params *ec2.ModifyFpgaImageAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyFpgaImageAttributeOutput, error) {
+// ModifyInstanceEventWindow provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstanceEventWindow(ctx context.Context, params *ec2.ModifyInstanceEventWindowInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceEventWindowOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12325,17 +15145,17 @@ func (_m *EC2) ModifyFpgaImageAttribute(ctx context.Context, params *ec2.ModifyF
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyFpgaImageAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyFpgaImageAttributeInput, ...func(*ec2.Options)) *ec2.ModifyFpgaImageAttributeOutput); ok {
+	var r0 *ec2.ModifyInstanceEventWindowOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceEventWindowInput, ...func(*ec2.Options)) *ec2.ModifyInstanceEventWindowOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyFpgaImageAttributeOutput)
+			r0 = ret.Get(0).(*ec2.ModifyInstanceEventWindowOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyFpgaImageAttributeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceEventWindowInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12344,8 +15164,8 @@ func (_m *EC2) ModifyFpgaImageAttribute(ctx context.Context, params *ec2.ModifyF
 	return r0, r1
 }
 
-// ModifyHosts provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyHosts(ctx context.Context, params *ec2.ModifyHostsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyHostsOutput, error) {
+// ModifyInstanceMaintenanceOptions provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstanceMaintenanceOptions(ctx context.Context, params *ec2.ModifyInstanceMaintenanceOptionsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceMaintenanceOptionsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12355,17 +15175,17 @@ func (_m *EC2) ModifyHosts(ctx context.Context, params *ec2.ModifyHostsInput, op
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyHostsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyHostsInput, ...func(*ec2.Options)) *ec2.ModifyHostsOutput); ok {
+	var r0 *ec2.ModifyInstanceMaintenanceOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceMaintenanceOptionsInput, ...func(*ec2.Options)) *ec2.ModifyInstanceMaintenanceOptionsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyHostsOutput)
+			r0 = ret.Get(0).(*ec2.ModifyInstanceMaintenanceOptionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyHostsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceMaintenanceOptionsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12374,8 +15194,8 @@ func (_m *EC2) ModifyHosts(ctx context.Context, params *ec2.ModifyHostsInput, op
 	return r0, r1
 }
 
-// ModifyIdFormat provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyIdFormat(ctx context.Context, params *ec2.ModifyIdFormatInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIdFormatOutput, error) {
+// ModifyInstanceMetadataDefaults provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstanceMetadataDefaults(ctx context.Context, params *ec2.ModifyInstanceMetadataDefaultsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceMetadataDefaultsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12385,17 +15205,17 @@ func (_m *EC2) ModifyIdFormat(ctx context.Context, params *ec2.ModifyIdFormatInp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyIdFormatOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIdFormatInput, ...func(*ec2.Options)) *ec2.ModifyIdFormatOutput); ok {
+	var r0 *ec2.ModifyInstanceMetadataDefaultsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceMetadataDefaultsInput, ...func(*ec2.Options)) *ec2.ModifyInstanceMetadataDefaultsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyIdFormatOutput)
+			r0 = ret.Get(0).(*ec2.ModifyInstanceMetadataDefaultsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIdFormatInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceMetadataDefaultsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12404,8 +15224,8 @@ func (_m *EC2) ModifyIdFormat(ctx context.Context, params *ec2.ModifyIdFormatInp
 	return r0, r1
 }
 
-// ModifyIdentityIdFormat provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyIdentityIdFormat(ctx context.Context, params *ec2.ModifyIdentityIdFormatInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIdentityIdFormatOutput, error) {
+// ModifyInstanceMetadataOptions provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstanceMetadataOptions(ctx context.Context, params *ec2.ModifyInstanceMetadataOptionsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceMetadataOptionsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12415,17 +15235,17 @@ func (_m *EC2) ModifyIdentityIdFormat(ctx context.Context, params *ec2.ModifyIde
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyIdentityIdFormatOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIdentityIdFormatInput, ...func(*ec2.Options)) *ec2.ModifyIdentityIdFormatOutput); ok {
+	var r0 *ec2.ModifyInstanceMetadataOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceMetadataOptionsInput, ...func(*ec2.Options)) *ec2.ModifyInstanceMetadataOptionsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyIdentityIdFormatOutput)
+			r0 = ret.Get(0).(*ec2.ModifyInstanceMetadataOptionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIdentityIdFormatInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceMetadataOptionsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12434,8 +15254,8 @@ func (_m *EC2) ModifyIdentityIdFormat(ctx context.Context, params *ec2.ModifyIde
 	return r0, r1
 }
 
-// ModifyImageAttribute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyImageAttribute(ctx context.Context, params *ec2.ModifyImageAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyImageAttributeOutput, error) {
+// ModifyInstancePlacement provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyInstancePlacement(ctx context.Context, params *ec2.ModifyInstancePlacementInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstancePlacementOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12445,17 +15265,17 @@ func (_m *EC2) ModifyImageAttribute(ctx context.Context, params *ec2.ModifyImage
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyImageAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyImageAttributeInput, ...func(*ec2.Options)) *ec2.ModifyImageAttributeOutput); ok {
+	var r0 *ec2.ModifyInstancePlacementOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstancePlacementInput, ...func(*ec2.Options)) *ec2.ModifyInstancePlacementOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyImageAttributeOutput)
+			r0 = ret.Get(0).(*ec2.ModifyInstancePlacementOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyImageAttributeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstancePlacementInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12464,8 +15284,8 @@ func (_m *EC2) ModifyImageAttribute(ctx context.Context, params *ec2.ModifyImage
 	return r0, r1
 }
 
-// ModifyInstanceAttribute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyInstanceAttribute(ctx context.Context, params *ec2.ModifyInstanceAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error) {
+// ModifyIpam provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyIpam(ctx context.Context, params *ec2.ModifyIpamInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12475,17 +15295,17 @@ func (_m *EC2) ModifyInstanceAttribute(ctx context.Context, params *ec2.ModifyIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyInstanceAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) *ec2.ModifyInstanceAttributeOutput); ok {
+	var r0 *ec2.ModifyIpamOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamInput, ...func(*ec2.Options)) *ec2.ModifyIpamOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyInstanceAttributeOutput)
+			r0 = ret.Get(0).(*ec2.ModifyIpamOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12494,8 +15314,8 @@ func (_m *EC2) ModifyInstanceAttribute(ctx context.Context, params *ec2.ModifyIn
 	return r0, r1
 }
 
-// ModifyInstanceCapacityReservationAttributes provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyInstanceCapacityReservationAttributes(ctx context.Context, params *ec2.ModifyInstanceCapacityReservationAttributesInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceCapacityReservationAttributesOutput, error) {
+// ModifyIpamPool provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyIpamPool(ctx context.Context, params *ec2.ModifyIpamPoolInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12505,17 +15325,17 @@ func (_m *EC2) ModifyInstanceCapacityReservationAttributes(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyInstanceCapacityReservationAttributesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceCapacityReservationAttributesInput, ...func(*ec2.Options)) *ec2.ModifyInstanceCapacityReservationAttributesOutput); ok {
+	var r0 *ec2.ModifyIpamPoolOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamPoolInput, ...func(*ec2.Options)) *ec2.ModifyIpamPoolOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyInstanceCapacityReservationAttributesOutput)
+			r0 = ret.Get(0).(*ec2.ModifyIpamPoolOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceCapacityReservationAttributesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamPoolInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12524,8 +15344,8 @@ func (_m *EC2) ModifyInstanceCapacityReservationAttributes(ctx context.Context,
 	return r0, r1
 }
 
-// ModifyInstanceCreditSpecification provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyInstanceCreditSpecification(ctx context.Context, params *ec2.ModifyInstanceCreditSpecificationInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceCreditSpecificationOutput, error) {
+// ModifyIpamResourceCidr provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyIpamResourceCidr(ctx context.Context, params *ec2.ModifyIpamResourceCidrInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamResourceCidrOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12535,17 +15355,17 @@ func (_m *EC2) ModifyInstanceCreditSpecification(ctx context.Context, params *ec
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyInstanceCreditSpecificationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceCreditSpecificationInput, ...func(*ec2.Options)) *ec2.ModifyInstanceCreditSpecificationOutput); ok {
+	var r0 *ec2.ModifyIpamResourceCidrOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamResourceCidrInput, ...func(*ec2.Options)) *ec2.ModifyIpamResourceCidrOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyInstanceCreditSpecificationOutput)
+			r0 = ret.Get(0).(*ec2.ModifyIpamResourceCidrOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceCreditSpecificationInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamResourceCidrInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12554,8 +15374,8 @@ func (_m *EC2) ModifyInstanceCreditSpecification(ctx context.Context, params *ec
 	return r0, r1
 }
 
-// ModifyInstanceEventStartTime provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyInstanceEventStartTime(ctx context.Context, params *ec2.ModifyInstanceEventStartTimeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceEventStartTimeOutput, error) {
+// ModifyIpamResourceDiscovery provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyIpamResourceDiscovery(ctx context.Context, params *ec2.ModifyIpamResourceDiscoveryInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamResourceDiscoveryOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12565,17 +15385,17 @@ func (_m *EC2) ModifyInstanceEventStartTime(ctx context.Context, params *ec2.Mod
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyInstanceEventStartTimeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceEventStartTimeInput, ...func(*ec2.Options)) *ec2.ModifyInstanceEventStartTimeOutput); ok {
+	var r0 *ec2.ModifyIpamResourceDiscoveryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamResourceDiscoveryInput, ...func(*ec2.Options)) *ec2.ModifyIpamResourceDiscoveryOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyInstanceEventStartTimeOutput)
+			r0 = ret.Get(0).(*ec2.ModifyIpamResourceDiscoveryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceEventStartTimeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamResourceDiscoveryInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12584,8 +15404,8 @@ func (_m *EC2) ModifyInstanceEventStartTime(ctx context.Context, params *ec2.Mod
 	return r0, r1
 }
 
-// ModifyInstanceEventWindow provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyInstanceEventWindow(ctx context.Context, params *ec2.ModifyInstanceEventWindowInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceEventWindowOutput, error) {
+// ModifyIpamScope provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyIpamScope(ctx context.Context, params *ec2.ModifyIpamScopeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamScopeOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12595,17 +15415,17 @@ func (_m *EC2) ModifyInstanceEventWindow(ctx context.Context, params *ec2.Modify
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyInstanceEventWindowOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceEventWindowInput, ...func(*ec2.Options)) *ec2.ModifyInstanceEventWindowOutput); ok {
+	var r0 *ec2.ModifyIpamScopeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamScopeInput, ...func(*ec2.Options)) *ec2.ModifyIpamScopeOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyInstanceEventWindowOutput)
+			r0 = ret.Get(0).(*ec2.ModifyIpamScopeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceEventWindowInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamScopeInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12614,8 +15434,8 @@ func (_m *EC2) ModifyInstanceEventWindow(ctx context.Context, params *ec2.Modify
 	return r0, r1
 }
 
-// ModifyInstanceMaintenanceOptions provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyInstanceMaintenanceOptions(ctx context.Context, params *ec2.ModifyInstanceMaintenanceOptionsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceMaintenanceOptionsOutput, error) {
+// ModifyLaunchTemplate provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyLaunchTemplate(ctx context.Context, params *ec2.ModifyLaunchTemplateInput, optFns ...func(*ec2.Options)) (*ec2.ModifyLaunchTemplateOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12625,17 +15445,17 @@ func (_m *EC2) ModifyInstanceMaintenanceOptions(ctx context.Context, params *ec2
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyInstanceMaintenanceOptionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceMaintenanceOptionsInput, ...func(*ec2.Options)) *ec2.ModifyInstanceMaintenanceOptionsOutput); ok {
+	var r0 *ec2.ModifyLaunchTemplateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyLaunchTemplateInput, ...func(*ec2.Options)) *ec2.ModifyLaunchTemplateOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyInstanceMaintenanceOptionsOutput)
+			r0 = ret.Get(0).(*ec2.ModifyLaunchTemplateOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceMaintenanceOptionsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyLaunchTemplateInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12644,8 +15464,8 @@ func (_m *EC2) ModifyInstanceMaintenanceOptions(ctx context.Context, params *ec2
 	return r0, r1
 }
 
-// ModifyInstanceMetadataOptions provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyInstanceMetadataOptions(ctx context.Context, params *ec2.ModifyInstanceMetadataOptionsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceMetadataOptionsOutput, error) {
+// ModifyLocalGatewayRoute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyLocalGatewayRoute(ctx context.Context, params *ec2.ModifyLocalGatewayRouteInput, optFns ...func(*ec2.Options)) (*ec2.ModifyLocalGatewayRouteOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12655,17 +15475,17 @@ func (_m *EC2) ModifyInstanceMetadataOptions(ctx context.Context, params *ec2.Mo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyInstanceMetadataOptionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstanceMetadataOptionsInput, ...func(*ec2.Options)) *ec2.ModifyInstanceMetadataOptionsOutput); ok {
+	var r0 *ec2.ModifyLocalGatewayRouteOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyLocalGatewayRouteInput, ...func(*ec2.Options)) *ec2.ModifyLocalGatewayRouteOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyInstanceMetadataOptionsOutput)
+			r0 = ret.Get(0).(*ec2.ModifyLocalGatewayRouteOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstanceMetadataOptionsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyLocalGatewayRouteInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12674,8 +15494,8 @@ func (_m *EC2) ModifyInstanceMetadataOptions(ctx context.Context, params *ec2.Mo
 	return r0, r1
 }
 
-// ModifyInstancePlacement provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyInstancePlacement(ctx context.Context, params *ec2.ModifyInstancePlacementInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstancePlacementOutput, error) {
+// ModifyManagedPrefixList provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyManagedPrefixList(ctx context.Context, params *ec2.ModifyManagedPrefixListInput, optFns ...func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12685,17 +15505,17 @@ func (_m *EC2) ModifyInstancePlacement(ctx context.Context, params *ec2.ModifyIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyInstancePlacementOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyInstancePlacementInput, ...func(*ec2.Options)) *ec2.ModifyInstancePlacementOutput); ok {
+	var r0 *ec2.ModifyManagedPrefixListOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyManagedPrefixListInput, ...func(*ec2.Options)) *ec2.ModifyManagedPrefixListOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyInstancePlacementOutput)
+			r0 = ret.Get(0).(*ec2.ModifyManagedPrefixListOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyInstancePlacementInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyManagedPrefixListInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12704,8 +15524,8 @@ func (_m *EC2) ModifyInstancePlacement(ctx context.Context, params *ec2.ModifyIn
 	return r0, r1
 }
 
-// ModifyIpam provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyIpam(ctx context.Context, params *ec2.ModifyIpamInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamOutput, error) {
+// ModifyNetworkInterfaceAttribute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyNetworkInterfaceAttribute(ctx context.Context, params *ec2.ModifyNetworkInterfaceAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12715,17 +15535,17 @@ func (_m *EC2) ModifyIpam(ctx context.Context, params *ec2.ModifyIpamInput, optF
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyIpamOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamInput, ...func(*ec2.Options)) *ec2.ModifyIpamOutput); ok {
+	var r0 *ec2.ModifyNetworkInterfaceAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyNetworkInterfaceAttributeInput, ...func(*ec2.Options)) *ec2.ModifyNetworkInterfaceAttributeOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyIpamOutput)
+			r0 = ret.Get(0).(*ec2.ModifyNetworkInterfaceAttributeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyNetworkInterfaceAttributeInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12734,8 +15554,8 @@ func (_m *EC2) ModifyIpam(ctx context.Context, params *ec2.ModifyIpamInput, optF
 	return r0, r1
 }
 
-// ModifyIpamPool provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyIpamPool(ctx context.Context, params *ec2.ModifyIpamPoolInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error) {
+// ModifyPrivateDnsNameOptions provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyPrivateDnsNameOptions(ctx context.Context, params *ec2.ModifyPrivateDnsNameOptionsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyPrivateDnsNameOptionsOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12745,17 +15565,17 @@ func (_m *EC2) ModifyIpamPool(ctx context.Context, params *ec2.ModifyIpamPoolInp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyIpamPoolOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamPoolInput, ...func(*ec2.Options)) *ec2.ModifyIpamPoolOutput); ok {
+	var r0 *ec2.ModifyPrivateDnsNameOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyPrivateDnsNameOptionsInput, ...func(*ec2.Options)) *ec2.ModifyPrivateDnsNameOptionsOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyIpamPoolOutput)
+			r0 = ret.Get(0).(*ec2.ModifyPrivateDnsNameOptionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamPoolInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyPrivateDnsNameOptionsInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12764,8 +15584,8 @@ func (_m *EC2) ModifyIpamPool(ctx context.Context, params *ec2.ModifyIpamPoolInp
 	return r0, r1
 }
 
-// ModifyIpamResourceCidr provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyIpamResourceCidr(ctx context.Context, params *ec2.ModifyIpamResourceCidrInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamResourceCidrOutput, error) {
+// ModifyReservedInstances provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyReservedInstances(ctx context.Context, params *ec2.ModifyReservedInstancesInput, optFns ...func(*ec2.Options)) (*ec2.ModifyReservedInstancesOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12775,17 +15595,17 @@ func (_m *EC2) ModifyIpamResourceCidr(ctx context.Context, params *ec2.ModifyIpa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyIpamResourceCidrOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamResourceCidrInput, ...func(*ec2.Options)) *ec2.ModifyIpamResourceCidrOutput); ok {
+	var r0 *ec2.ModifyReservedInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyReservedInstancesInput, ...func(*ec2.Options)) *ec2.ModifyReservedInstancesOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyIpamResourceCidrOutput)
+			r0 = ret.Get(0).(*ec2.ModifyReservedInstancesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamResourceCidrInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyReservedInstancesInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12794,8 +15614,8 @@ func (_m *EC2) ModifyIpamResourceCidr(ctx context.Context, params *ec2.ModifyIpa
 	return r0, r1
 }
 
-// ModifyIpamScope provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyIpamScope(ctx context.Context, params *ec2.ModifyIpamScopeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyIpamScopeOutput, error) {
+// ModifySecurityGroupRules provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifySecurityGroupRules(ctx context.Context, params *ec2.ModifySecurityGroupRulesInput, optFns ...func(*ec2.Options)) (*ec2.ModifySecurityGroupRulesOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12805,17 +15625,17 @@ func (_m *EC2) ModifyIpamScope(ctx context.Context, params *ec2.ModifyIpamScopeI
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyIpamScopeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyIpamScopeInput, ...func(*ec2.Options)) *ec2.ModifyIpamScopeOutput); ok {
+	var r0 *ec2.ModifySecurityGroupRulesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySecurityGroupRulesInput, ...func(*ec2.Options)) *ec2.ModifySecurityGroupRulesOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyIpamScopeOutput)
+			r0 = ret.Get(0).(*ec2.ModifySecurityGroupRulesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyIpamScopeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySecurityGroupRulesInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12824,8 +15644,8 @@ func (_m *EC2) ModifyIpamScope(ctx context.Context, params *ec2.ModifyIpamScopeI
 	return r0, r1
 }
 
-// ModifyLaunchTemplate provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyLaunchTemplate(ctx context.Context, params *ec2.ModifyLaunchTemplateInput, optFns ...func(*ec2.Options)) (*ec2.ModifyLaunchTemplateOutput, error) {
+// ModifySnapshotAttribute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifySnapshotAttribute(ctx context.Context, params *ec2.ModifySnapshotAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifySnapshotAttributeOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12835,17 +15655,17 @@ func (_m *EC2) ModifyLaunchTemplate(ctx context.Context, params *ec2.ModifyLaunc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyLaunchTemplateOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyLaunchTemplateInput, ...func(*ec2.Options)) *ec2.ModifyLaunchTemplateOutput); ok {
+	var r0 *ec2.ModifySnapshotAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySnapshotAttributeInput, ...func(*ec2.Options)) *ec2.ModifySnapshotAttributeOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyLaunchTemplateOutput)
+			r0 = ret.Get(0).(*ec2.ModifySnapshotAttributeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyLaunchTemplateInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySnapshotAttributeInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12854,8 +15674,8 @@ func (_m *EC2) ModifyLaunchTemplate(ctx context.Context, params *ec2.ModifyLaunc
 	return r0, r1
 }
 
-// ModifyManagedPrefixList provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyManagedPrefixList(ctx context.Context, params *ec2.ModifyManagedPrefixListInput, optFns ...func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error) {
+// ModifySnapshotTier provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifySnapshotTier(ctx context.Context, params *ec2.ModifySnapshotTierInput, optFns ...func(*ec2.Options)) (*ec2.ModifySnapshotTierOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12865,17 +15685,47 @@ func (_m *EC2) ModifyManagedPrefixList(ctx context.Context, params *ec2.ModifyMa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyManagedPrefixListOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyManagedPrefixListInput, ...func(*ec2.Options)) *ec2.ModifyManagedPrefixListOutput); ok {
+	var r0 *ec2.ModifySnapshotTierOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySnapshotTierInput, ...func(*ec2.Options)) *ec2.ModifySnapshotTierOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ModifySnapshotTierOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySnapshotTierInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifySpotFleetRequest provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifySpotFleetRequest(ctx context.Context, params *ec2.ModifySpotFleetRequestInput, optFns ...func(*ec2.Options)) (*ec2.ModifySpotFleetRequestOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ModifySpotFleetRequestOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySpotFleetRequestInput, ...func(*ec2.Options)) *ec2.ModifySpotFleetRequestOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyManagedPrefixListOutput)
+			r0 = ret.Get(0).(*ec2.ModifySpotFleetRequestOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyManagedPrefixListInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySpotFleetRequestInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12884,8 +15734,8 @@ func (_m *EC2) ModifyManagedPrefixList(ctx context.Context, params *ec2.ModifyMa
 	return r0, r1
 }
 
-// ModifyNetworkInterfaceAttribute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyNetworkInterfaceAttribute(ctx context.Context, params *ec2.ModifyNetworkInterfaceAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error) {
+// ModifySubnetAttribute provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifySubnetAttribute(ctx context.Context, params *ec2.ModifySubnetAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifySubnetAttributeOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12895,17 +15745,17 @@ func (_m *EC2) ModifyNetworkInterfaceAttribute(ctx context.Context, params *ec2.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyNetworkInterfaceAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyNetworkInterfaceAttributeInput, ...func(*ec2.Options)) *ec2.ModifyNetworkInterfaceAttributeOutput); ok {
+	var r0 *ec2.ModifySubnetAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySubnetAttributeInput, ...func(*ec2.Options)) *ec2.ModifySubnetAttributeOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyNetworkInterfaceAttributeOutput)
+			r0 = ret.Get(0).(*ec2.ModifySubnetAttributeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyNetworkInterfaceAttributeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySubnetAttributeInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12914,8 +15764,8 @@ func (_m *EC2) ModifyNetworkInterfaceAttribute(ctx context.Context, params *ec2.
 	return r0, r1
 }
 
-// ModifyPrivateDnsNameOptions provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyPrivateDnsNameOptions(ctx context.Context, params *ec2.ModifyPrivateDnsNameOptionsInput, optFns ...func(*ec2.Options)) (*ec2.ModifyPrivateDnsNameOptionsOutput, error) {
+// ModifyTrafficMirrorFilterNetworkServices provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyTrafficMirrorFilterNetworkServices(ctx context.Context, params *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterNetworkServicesOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12925,17 +15775,17 @@ func (_m *EC2) ModifyPrivateDnsNameOptions(ctx context.Context, params *ec2.Modi
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyPrivateDnsNameOptionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyPrivateDnsNameOptionsInput, ...func(*ec2.Options)) *ec2.ModifyPrivateDnsNameOptionsOutput); ok {
+	var r0 *ec2.ModifyTrafficMirrorFilterNetworkServicesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, ...func(*ec2.Options)) *ec2.ModifyTrafficMirrorFilterNetworkServicesOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyPrivateDnsNameOptionsOutput)
+			r0 = ret.Get(0).(*ec2.ModifyTrafficMirrorFilterNetworkServicesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyPrivateDnsNameOptionsInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12944,8 +15794,8 @@ func (_m *EC2) ModifyPrivateDnsNameOptions(ctx context.Context, params *ec2.Modi
 	return r0, r1
 }
 
-// ModifyReservedInstances provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyReservedInstances(ctx context.Context, params *ec2.ModifyReservedInstancesInput, optFns ...func(*ec2.Options)) (*ec2.ModifyReservedInstancesOutput, error) {
+// ModifyTrafficMirrorFilterRule provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyTrafficMirrorFilterRule(ctx context.Context, params *ec2.ModifyTrafficMirrorFilterRuleInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterRuleOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12955,17 +15805,17 @@ func (_m *EC2) ModifyReservedInstances(ctx context.Context, params *ec2.ModifyRe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyReservedInstancesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyReservedInstancesInput, ...func(*ec2.Options)) *ec2.ModifyReservedInstancesOutput); ok {
+	var r0 *ec2.ModifyTrafficMirrorFilterRuleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTrafficMirrorFilterRuleInput, ...func(*ec2.Options)) *ec2.ModifyTrafficMirrorFilterRuleOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyReservedInstancesOutput)
+			r0 = ret.Get(0).(*ec2.ModifyTrafficMirrorFilterRuleOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyReservedInstancesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTrafficMirrorFilterRuleInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -12974,8 +15824,8 @@ func (_m *EC2) ModifyReservedInstances(ctx context.Context, params *ec2.ModifyRe
 	return r0, r1
 }
 
-// ModifySecurityGroupRules provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifySecurityGroupRules(ctx context.Context, params *ec2.ModifySecurityGroupRulesInput, optFns ...func(*ec2.Options)) (*ec2.ModifySecurityGroupRulesOutput, error) {
+// ModifyTrafficMirrorSession provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyTrafficMirrorSession(ctx context.Context, params *ec2.ModifyTrafficMirrorSessionInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorSessionOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -12985,17 +15835,17 @@ func (_m *EC2) ModifySecurityGroupRules(ctx context.Context, params *ec2.ModifyS
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifySecurityGroupRulesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySecurityGroupRulesInput, ...func(*ec2.Options)) *ec2.ModifySecurityGroupRulesOutput); ok {
+	var r0 *ec2.ModifyTrafficMirrorSessionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTrafficMirrorSessionInput, ...func(*ec2.Options)) *ec2.ModifyTrafficMirrorSessionOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifySecurityGroupRulesOutput)
+			r0 = ret.Get(0).(*ec2.ModifyTrafficMirrorSessionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySecurityGroupRulesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTrafficMirrorSessionInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13004,8 +15854,8 @@ func (_m *EC2) ModifySecurityGroupRules(ctx context.Context, params *ec2.ModifyS
 	return r0, r1
 }
 
-// ModifySnapshotAttribute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifySnapshotAttribute(ctx context.Context, params *ec2.ModifySnapshotAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifySnapshotAttributeOutput, error) {
+// ModifyTransitGateway provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyTransitGateway(ctx context.Context, params *ec2.ModifyTransitGatewayInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTransitGatewayOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13015,17 +15865,17 @@ func (_m *EC2) ModifySnapshotAttribute(ctx context.Context, params *ec2.ModifySn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifySnapshotAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySnapshotAttributeInput, ...func(*ec2.Options)) *ec2.ModifySnapshotAttributeOutput); ok {
+	var r0 *ec2.ModifyTransitGatewayOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTransitGatewayInput, ...func(*ec2.Options)) *ec2.ModifyTransitGatewayOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifySnapshotAttributeOutput)
+			r0 = ret.Get(0).(*ec2.ModifyTransitGatewayOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySnapshotAttributeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTransitGatewayInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13034,8 +15884,8 @@ func (_m *EC2) ModifySnapshotAttribute(ctx context.Context, params *ec2.ModifySn
 	return r0, r1
 }
 
-// ModifySnapshotTier provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifySnapshotTier(ctx context.Context, params *ec2.ModifySnapshotTierInput, optFns ...func(*ec2.Options)) (*ec2.ModifySnapshotTierOutput, error) {
+// ModifyTransitGatewayPrefixListReference provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyTransitGatewayPrefixListReference(ctx context.Context, params *ec2.ModifyTransitGatewayPrefixListReferenceInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTransitGatewayPrefixListReferenceOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13045,17 +15895,17 @@ func (_m *EC2) ModifySnapshotTier(ctx context.Context, params *ec2.ModifySnapsho
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifySnapshotTierOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySnapshotTierInput, ...func(*ec2.Options)) *ec2.ModifySnapshotTierOutput); ok {
+	var r0 *ec2.ModifyTransitGatewayPrefixListReferenceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTransitGatewayPrefixListReferenceInput, ...func(*ec2.Options)) *ec2.ModifyTransitGatewayPrefixListReferenceOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifySnapshotTierOutput)
+			r0 = ret.Get(0).(*ec2.ModifyTransitGatewayPrefixListReferenceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySnapshotTierInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTransitGatewayPrefixListReferenceInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13064,8 +15914,8 @@ func (_m *EC2) ModifySnapshotTier(ctx context.Context, params *ec2.ModifySnapsho
 	return r0, r1
 }
 
-// ModifySpotFleetRequest provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifySpotFleetRequest(ctx context.Context, params *ec2.ModifySpotFleetRequestInput, optFns ...func(*ec2.Options)) (*ec2.ModifySpotFleetRequestOutput, error) {
+// ModifyTransitGatewayVpcAttachment provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyTransitGatewayVpcAttachment(ctx context.Context, params *ec2.ModifyTransitGatewayVpcAttachmentInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTransitGatewayVpcAttachmentOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13075,17 +15925,17 @@ func (_m *EC2) ModifySpotFleetRequest(ctx context.Context, params *ec2.ModifySpo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifySpotFleetRequestOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySpotFleetRequestInput, ...func(*ec2.Options)) *ec2.ModifySpotFleetRequestOutput); ok {
+	var r0 *ec2.ModifyTransitGatewayVpcAttachmentOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTransitGatewayVpcAttachmentInput, ...func(*ec2.Options)) *ec2.ModifyTransitGatewayVpcAttachmentOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifySpotFleetRequestOutput)
+			r0 = ret.Get(0).(*ec2.ModifyTransitGatewayVpcAttachmentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySpotFleetRequestInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTransitGatewayVpcAttachmentInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13094,8 +15944,8 @@ func (_m *EC2) ModifySpotFleetRequest(ctx context.Context, params *ec2.ModifySpo
 	return r0, r1
 }
 
-// ModifySubnetAttribute provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifySubnetAttribute(ctx context.Context, params *ec2.ModifySubnetAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifySubnetAttributeOutput, error) {
+// ModifyVerifiedAccessEndpoint provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyVerifiedAccessEndpoint(ctx context.Context, params *ec2.ModifyVerifiedAccessEndpointInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVerifiedAccessEndpointOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13105,17 +15955,17 @@ func (_m *EC2) ModifySubnetAttribute(ctx context.Context, params *ec2.ModifySubn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifySubnetAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifySubnetAttributeInput, ...func(*ec2.Options)) *ec2.ModifySubnetAttributeOutput); ok {
+	var r0 *ec2.ModifyVerifiedAccessEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyVerifiedAccessEndpointInput, ...func(*ec2.Options)) *ec2.ModifyVerifiedAccessEndpointOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifySubnetAttributeOutput)
+			r0 = ret.Get(0).(*ec2.ModifyVerifiedAccessEndpointOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifySubnetAttributeInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyVerifiedAccessEndpointInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13124,8 +15974,8 @@ func (_m *EC2) ModifySubnetAttribute(ctx context.Context, params *ec2.ModifySubn
 	return r0, r1
 }
 
-// ModifyTrafficMirrorFilterNetworkServices provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyTrafficMirrorFilterNetworkServices(ctx context.Context, params *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterNetworkServicesOutput, error) {
+// ModifyVerifiedAccessEndpointPolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyVerifiedAccessEndpointPolicy(ctx context.Context, params *ec2.ModifyVerifiedAccessEndpointPolicyInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVerifiedAccessEndpointPolicyOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13135,17 +15985,17 @@ func (_m *EC2) ModifyTrafficMirrorFilterNetworkServices(ctx context.Context, par
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyTrafficMirrorFilterNetworkServicesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, ...func(*ec2.Options)) *ec2.ModifyTrafficMirrorFilterNetworkServicesOutput); ok {
+	var r0 *ec2.ModifyVerifiedAccessEndpointPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyVerifiedAccessEndpointPolicyInput, ...func(*ec2.Options)) *ec2.ModifyVerifiedAccessEndpointPolicyOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyTrafficMirrorFilterNetworkServicesOutput)
+			r0 = ret.Get(0).(*ec2.ModifyVerifiedAccessEndpointPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyVerifiedAccessEndpointPolicyInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13154,8 +16004,8 @@ func (_m *EC2) ModifyTrafficMirrorFilterNetworkServices(ctx context.Context, par
 	return r0, r1
 }
 
-// ModifyTrafficMirrorFilterRule provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyTrafficMirrorFilterRule(ctx context.Context, params *ec2.ModifyTrafficMirrorFilterRuleInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterRuleOutput, error) {
+// ModifyVerifiedAccessGroup provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyVerifiedAccessGroup(ctx context.Context, params *ec2.ModifyVerifiedAccessGroupInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVerifiedAccessGroupOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13165,17 +16015,17 @@ func (_m *EC2) ModifyTrafficMirrorFilterRule(ctx context.Context, params *ec2.Mo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyTrafficMirrorFilterRuleOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTrafficMirrorFilterRuleInput, ...func(*ec2.Options)) *ec2.ModifyTrafficMirrorFilterRuleOutput); ok {
+	var r0 *ec2.ModifyVerifiedAccessGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyVerifiedAccessGroupInput, ...func(*ec2.Options)) *ec2.ModifyVerifiedAccessGroupOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyTrafficMirrorFilterRuleOutput)
+			r0 = ret.Get(0).(*ec2.ModifyVerifiedAccessGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTrafficMirrorFilterRuleInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyVerifiedAccessGroupInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13184,8 +16034,8 @@ func (_m *EC2) ModifyTrafficMirrorFilterRule(ctx context.Context, params *ec2.Mo
 	return r0, r1
 }
 
-// ModifyTrafficMirrorSession provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyTrafficMirrorSession(ctx context.Context, params *ec2.ModifyTrafficMirrorSessionInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorSessionOutput, error) {
+// ModifyVerifiedAccessGroupPolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyVerifiedAccessGroupPolicy(ctx context.Context, params *ec2.ModifyVerifiedAccessGroupPolicyInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVerifiedAccessGroupPolicyOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13195,17 +16045,17 @@ func (_m *EC2) ModifyTrafficMirrorSession(ctx context.Context, params *ec2.Modif
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyTrafficMirrorSessionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTrafficMirrorSessionInput, ...func(*ec2.Options)) *ec2.ModifyTrafficMirrorSessionOutput); ok {
+	var r0 *ec2.ModifyVerifiedAccessGroupPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyVerifiedAccessGroupPolicyInput, ...func(*ec2.Options)) *ec2.ModifyVerifiedAccessGroupPolicyOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyTrafficMirrorSessionOutput)
+			r0 = ret.Get(0).(*ec2.ModifyVerifiedAccessGroupPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTrafficMirrorSessionInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyVerifiedAccessGroupPolicyInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13214,8 +16064,8 @@ func (_m *EC2) ModifyTrafficMirrorSession(ctx context.Context, params *ec2.Modif
 	return r0, r1
 }
 
-// ModifyTransitGateway provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyTransitGateway(ctx context.Context, params *ec2.ModifyTransitGatewayInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTransitGatewayOutput, error) {
+// ModifyVerifiedAccessInstance provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyVerifiedAccessInstance(ctx context.Context, params *ec2.ModifyVerifiedAccessInstanceInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVerifiedAccessInstanceOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13225,17 +16075,17 @@ func (_m *EC2) ModifyTransitGateway(ctx context.Context, params *ec2.ModifyTrans
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyTransitGatewayOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTransitGatewayInput, ...func(*ec2.Options)) *ec2.ModifyTransitGatewayOutput); ok {
+	var r0 *ec2.ModifyVerifiedAccessInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyVerifiedAccessInstanceInput, ...func(*ec2.Options)) *ec2.ModifyVerifiedAccessInstanceOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyTransitGatewayOutput)
+			r0 = ret.Get(0).(*ec2.ModifyVerifiedAccessInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTransitGatewayInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyVerifiedAccessInstanceInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13244,8 +16094,8 @@ func (_m *EC2) ModifyTransitGateway(ctx context.Context, params *ec2.ModifyTrans
 	return r0, r1
 }
 
-// ModifyTransitGatewayPrefixListReference provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyTransitGatewayPrefixListReference(ctx context.Context, params *ec2.ModifyTransitGatewayPrefixListReferenceInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTransitGatewayPrefixListReferenceOutput, error) {
+// ModifyVerifiedAccessInstanceLoggingConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyVerifiedAccessInstanceLoggingConfiguration(ctx context.Context, params *ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVerifiedAccessInstanceLoggingConfigurationOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13255,17 +16105,17 @@ func (_m *EC2) ModifyTransitGatewayPrefixListReference(ctx context.Context, para
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyTransitGatewayPrefixListReferenceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTransitGatewayPrefixListReferenceInput, ...func(*ec2.Options)) *ec2.ModifyTransitGatewayPrefixListReferenceOutput); ok {
+	var r0 *ec2.ModifyVerifiedAccessInstanceLoggingConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput, ...func(*ec2.Options)) *ec2.ModifyVerifiedAccessInstanceLoggingConfigurationOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyTransitGatewayPrefixListReferenceOutput)
+			r0 = ret.Get(0).(*ec2.ModifyVerifiedAccessInstanceLoggingConfigurationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTransitGatewayPrefixListReferenceInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13274,8 +16124,8 @@ func (_m *EC2) ModifyTransitGatewayPrefixListReference(ctx context.Context, para
 	return r0, r1
 }
 
-// ModifyTransitGatewayVpcAttachment provides a mock function with given fields: ctx, params, optFns
-func (_m *EC2) ModifyTransitGatewayVpcAttachment(ctx context.Context, params *ec2.ModifyTransitGatewayVpcAttachmentInput, optFns ...func(*ec2.Options)) (*ec2.ModifyTransitGatewayVpcAttachmentOutput, error) {
+// ModifyVerifiedAccessTrustProvider provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ModifyVerifiedAccessTrustProvider(ctx context.Context, params *ec2.ModifyVerifiedAccessTrustProviderInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVerifiedAccessTrustProviderOutput, error) {
 	_va := make([]interface{}, len(optFns))
 	for _i := range optFns {
 		_va[_i] = optFns[_i]
@@ -13285,17 +16135,17 @@ func (_m *EC2) ModifyTransitGatewayVpcAttachment(ctx context.Context, params *ec
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *ec2.ModifyTransitGatewayVpcAttachmentOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyTransitGatewayVpcAttachmentInput, ...func(*ec2.Options)) *ec2.ModifyTransitGatewayVpcAttachmentOutput); ok {
+	var r0 *ec2.ModifyVerifiedAccessTrustProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ModifyVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) *ec2.ModifyVerifiedAccessTrustProviderOutput); ok {
 		r0 = rf(ctx, params, optFns...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ec2.ModifyTransitGatewayVpcAttachmentOutput)
+			r0 = ret.Get(0).(*ec2.ModifyVerifiedAccessTrustProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyTransitGatewayVpcAttachmentInput, ...func(*ec2.Options)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ModifyVerifiedAccessTrustProviderInput, ...func(*ec2.Options)) error); ok {
 		r1 = rf(ctx, params, optFns...)
 	} else {
 		r1 = ret.Error(1)
@@ -13814,6 +16664,20 @@ func (_m *EC2) MoveByoipCidrToIpam(ctx context.Context, params *ec2.MoveByoipCid
 	return r0, r1
 }
 
+// Options provides a mock function with given fields:
+func (_m *EC2) Options() ec2.Options {
+	ret := _m.Called()
+
+	var r0 ec2.Options
+	if rf, ok := ret.Get(0).(func() ec2.Options); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(ec2.Options)
+	}
+
+	return r0
+}
+
 // ProvisionByoipCidr provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) ProvisionByoipCidr(ctx context.Context, params *ec2.ProvisionByoipCidrInput, optFns ...func(*ec2.Options)) (*ec2.ProvisionByoipCidrOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -13844,6 +16708,36 @@ func (_m *EC2) ProvisionByoipCidr(ctx context.Context, params *ec2.ProvisionByoi
 	return r0, r1
 }
 
+// ProvisionIpamByoasn provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ProvisionIpamByoasn(ctx context.Context, params *ec2.ProvisionIpamByoasnInput, optFns ...func(*ec2.Options)) (*ec2.ProvisionIpamByoasnOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ProvisionIpamByoasnOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ProvisionIpamByoasnInput, ...func(*ec2.Options)) *ec2.ProvisionIpamByoasnOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ProvisionIpamByoasnOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ProvisionIpamByoasnInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ProvisionIpamPoolCidr provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) ProvisionIpamPoolCidr(ctx context.Context, params *ec2.ProvisionIpamPoolCidrInput, optFns ...func(*ec2.Options)) (*ec2.ProvisionIpamPoolCidrOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -13904,6 +16798,36 @@ func (_m *EC2) ProvisionPublicIpv4PoolCidr(ctx context.Context, params *ec2.Prov
 	return r0, r1
 }
 
+// PurchaseCapacityBlock provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) PurchaseCapacityBlock(ctx context.Context, params *ec2.PurchaseCapacityBlockInput, optFns ...func(*ec2.Options)) (*ec2.PurchaseCapacityBlockOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.PurchaseCapacityBlockOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.PurchaseCapacityBlockInput, ...func(*ec2.Options)) *ec2.PurchaseCapacityBlockOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.PurchaseCapacityBlockOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.PurchaseCapacityBlockInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PurchaseHostReservation provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) PurchaseHostReservation(ctx context.Context, params *ec2.PurchaseHostReservationInput, optFns ...func(*ec2.Options)) (*ec2.PurchaseHostReservationOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -14564,6 +17488,36 @@ func (_m *EC2) ReplaceTransitGatewayRoute(ctx context.Context, params *ec2.Repla
 	return r0, r1
 }
 
+// ReplaceVpnTunnel provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) ReplaceVpnTunnel(ctx context.Context, params *ec2.ReplaceVpnTunnelInput, optFns ...func(*ec2.Options)) (*ec2.ReplaceVpnTunnelOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.ReplaceVpnTunnelOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.ReplaceVpnTunnelInput, ...func(*ec2.Options)) *ec2.ReplaceVpnTunnelOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.ReplaceVpnTunnelOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.ReplaceVpnTunnelInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReportInstanceStatus provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) ReportInstanceStatus(ctx context.Context, params *ec2.ReportInstanceStatusInput, optFns ...func(*ec2.Options)) (*ec2.ReportInstanceStatusOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -15554,6 +18508,66 @@ func (_m *EC2) UnassignPrivateIpAddresses(ctx context.Context, params *ec2.Unass
 	return r0, r1
 }
 
+// UnassignPrivateNatGatewayAddress provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) UnassignPrivateNatGatewayAddress(ctx context.Context, params *ec2.UnassignPrivateNatGatewayAddressInput, optFns ...func(*ec2.Options)) (*ec2.UnassignPrivateNatGatewayAddressOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.UnassignPrivateNatGatewayAddressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.UnassignPrivateNatGatewayAddressInput, ...func(*ec2.Options)) *ec2.UnassignPrivateNatGatewayAddressOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.UnassignPrivateNatGatewayAddressOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.UnassignPrivateNatGatewayAddressInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnlockSnapshot provides a mock function with given fields: ctx, params, optFns
+func (_m *EC2) UnlockSnapshot(ctx context.Context, params *ec2.UnlockSnapshotInput, optFns ...func(*ec2.Options)) (*ec2.UnlockSnapshotOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ec2.UnlockSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *ec2.UnlockSnapshotInput, ...func(*ec2.Options)) *ec2.UnlockSnapshotOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ec2.UnlockSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ec2.UnlockSnapshotInput, ...func(*ec2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnmonitorInstances provides a mock function with given fields: ctx, params, optFns
 func (_m *EC2) UnmonitorInstances(ctx context.Context, params *ec2.UnmonitorInstancesInput, optFns ...func(*ec2.Options)) (*ec2.UnmonitorInstancesOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -734,6 +734,20 @@ func (_m *ELB) ModifyLoadBalancerAttributes(ctx context.Context, params *elastic
 	return r0, r1
 }
 
+// Options provides a mock function with given fields:
+func (_m *ELB) Options() elasticloadbalancing.Options {
+	ret := _m.Called()
+
+	var r0 elasticloadbalancing.Options
+	if rf, ok := ret.Get(0).(func() elasticloadbalancing.Options); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(elasticloadbalancing.Options)
+	}
+
+	return r0
+}
+
 // RegisterInstancesWithLoadBalancer provides a mock function with given fields: ctx, params, optFns
 func (_m *ELB) RegisterInstancesWithLoadBalancer(ctx context.Context, params *elasticloadbalancing.RegisterInstancesWithLoadBalancerInput, optFns ...func(*elasticloadbalancing.Options)) (*elasticloadbalancing.RegisterInstancesWithLoadBalancerOutput, error) {
 	_va := make([]interface{}, len(optFns))
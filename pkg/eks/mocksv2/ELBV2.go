@@ -74,6 +74,36 @@ func (_m *ELBV2) AddTags(ctx context.Context, params *elasticloadbalancingv2.Add
 	return r0, r1
 }
 
+// AddTrustStoreRevocations provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) AddTrustStoreRevocations(ctx context.Context, params *elasticloadbalancingv2.AddTrustStoreRevocationsInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.AddTrustStoreRevocationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.AddTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.AddTrustStoreRevocationsInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.AddTrustStoreRevocationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.AddTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.AddTrustStoreRevocationsInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateListener provides a mock function with given fields: ctx, params, optFns
 func (_m *ELBV2) CreateListener(ctx context.Context, params *elasticloadbalancingv2.CreateListenerInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.CreateListenerOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -194,6 +224,36 @@ func (_m *ELBV2) CreateTargetGroup(ctx context.Context, params *elasticloadbalan
 	return r0, r1
 }
 
+// CreateTrustStore provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) CreateTrustStore(ctx context.Context, params *elasticloadbalancingv2.CreateTrustStoreInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.CreateTrustStoreOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.CreateTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.CreateTrustStoreInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.CreateTrustStoreOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.CreateTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.CreateTrustStoreInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteListener provides a mock function with given fields: ctx, params, optFns
 func (_m *ELBV2) DeleteListener(ctx context.Context, params *elasticloadbalancingv2.DeleteListenerInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DeleteListenerOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -314,6 +374,36 @@ func (_m *ELBV2) DeleteTargetGroup(ctx context.Context, params *elasticloadbalan
 	return r0, r1
 }
 
+// DeleteTrustStore provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) DeleteTrustStore(ctx context.Context, params *elasticloadbalancingv2.DeleteTrustStoreInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DeleteTrustStoreOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.DeleteTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.DeleteTrustStoreInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.DeleteTrustStoreOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.DeleteTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.DeleteTrustStoreInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeregisterTargets provides a mock function with given fields: ctx, params, optFns
 func (_m *ELBV2) DeregisterTargets(ctx context.Context, params *elasticloadbalancingv2.DeregisterTargetsInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DeregisterTargetsOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -674,6 +764,156 @@ func (_m *ELBV2) DescribeTargetHealth(ctx context.Context, params *elasticloadba
 	return r0, r1
 }
 
+// DescribeTrustStoreAssociations provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) DescribeTrustStoreAssociations(ctx context.Context, params *elasticloadbalancingv2.DescribeTrustStoreAssociationsInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTrustStoreAssociationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.DescribeTrustStoreAssociationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.DescribeTrustStoreAssociationsInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.DescribeTrustStoreAssociationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.DescribeTrustStoreAssociationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.DescribeTrustStoreAssociationsInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStoreRevocations provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) DescribeTrustStoreRevocations(ctx context.Context, params *elasticloadbalancingv2.DescribeTrustStoreRevocationsInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTrustStoreRevocationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.DescribeTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.DescribeTrustStoreRevocationsInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.DescribeTrustStoreRevocationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.DescribeTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.DescribeTrustStoreRevocationsInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStores provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) DescribeTrustStores(ctx context.Context, params *elasticloadbalancingv2.DescribeTrustStoresInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTrustStoresOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.DescribeTrustStoresOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.DescribeTrustStoresInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.DescribeTrustStoresOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.DescribeTrustStoresOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.DescribeTrustStoresInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrustStoreCaCertificatesBundle provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) GetTrustStoreCaCertificatesBundle(ctx context.Context, params *elasticloadbalancingv2.GetTrustStoreCaCertificatesBundleInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.GetTrustStoreCaCertificatesBundleOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.GetTrustStoreCaCertificatesBundleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.GetTrustStoreCaCertificatesBundleInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.GetTrustStoreCaCertificatesBundleOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.GetTrustStoreCaCertificatesBundleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.GetTrustStoreCaCertificatesBundleInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrustStoreRevocationContent provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) GetTrustStoreRevocationContent(ctx context.Context, params *elasticloadbalancingv2.GetTrustStoreRevocationContentInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.GetTrustStoreRevocationContentOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.GetTrustStoreRevocationContentOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.GetTrustStoreRevocationContentInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.GetTrustStoreRevocationContentOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.GetTrustStoreRevocationContentOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.GetTrustStoreRevocationContentInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ModifyListener provides a mock function with given fields: ctx, params, optFns
 func (_m *ELBV2) ModifyListener(ctx context.Context, params *elasticloadbalancingv2.ModifyListenerInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.ModifyListenerOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -824,6 +1064,50 @@ func (_m *ELBV2) ModifyTargetGroupAttributes(ctx context.Context, params *elasti
 	return r0, r1
 }
 
+// ModifyTrustStore provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) ModifyTrustStore(ctx context.Context, params *elasticloadbalancingv2.ModifyTrustStoreInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.ModifyTrustStoreOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.ModifyTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.ModifyTrustStoreInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.ModifyTrustStoreOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.ModifyTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.ModifyTrustStoreInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Options provides a mock function with given fields:
+func (_m *ELBV2) Options() elasticloadbalancingv2.Options {
+	ret := _m.Called()
+
+	var r0 elasticloadbalancingv2.Options
+	if rf, ok := ret.Get(0).(func() elasticloadbalancingv2.Options); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(elasticloadbalancingv2.Options)
+	}
+
+	return r0
+}
+
 // RegisterTargets provides a mock function with given fields: ctx, params, optFns
 func (_m *ELBV2) RegisterTargets(ctx context.Context, params *elasticloadbalancingv2.RegisterTargetsInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.RegisterTargetsOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -914,6 +1198,36 @@ func (_m *ELBV2) RemoveTags(ctx context.Context, params *elasticloadbalancingv2.
 	return r0, r1
 }
 
+// RemoveTrustStoreRevocations provides a mock function with given fields: ctx, params, optFns
+func (_m *ELBV2) RemoveTrustStoreRevocations(ctx context.Context, params *elasticloadbalancingv2.RemoveTrustStoreRevocationsInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.RemoveTrustStoreRevocationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elasticloadbalancingv2.RemoveTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticloadbalancingv2.RemoveTrustStoreRevocationsInput, ...func(*elasticloadbalancingv2.Options)) *elasticloadbalancingv2.RemoveTrustStoreRevocationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elasticloadbalancingv2.RemoveTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elasticloadbalancingv2.RemoveTrustStoreRevocationsInput, ...func(*elasticloadbalancingv2.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetIpAddressType provides a mock function with given fields: ctx, params, optFns
 func (_m *ELBV2) SetIpAddressType(ctx context.Context, params *elasticloadbalancingv2.SetIpAddressTypeInput, optFns ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.SetIpAddressTypeOutput, error) {
 	_va := make([]interface{}, len(optFns))
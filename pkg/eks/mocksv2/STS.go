@@ -253,3 +253,17 @@ func (_m *STS) GetSessionToken(ctx context.Context, params *sts.GetSessionTokenI
 
 	return r0, r1
 }
+
+// Options provides a mock function with given fields:
+func (_m *STS) Options() sts.Options {
+	ret := _m.Called()
+
+	var r0 sts.Options
+	if rf, ok := ret.Get(0).(func() sts.Options); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(sts.Options)
+	}
+
+	return r0
+}
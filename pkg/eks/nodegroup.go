@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
-	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
@@ -90,8 +90,9 @@ func LogWindowsCompatibility(nodeGroups []KubeNodeGroup, clusterMeta *api.Cluste
 	}
 }
 
-//go:generate "${GOBIN}/mockery" --name=KubeNodeGroup --output=mocks/
 // KubeNodeGroup defines a set of Kubernetes Nodes
+//
+//go:generate "${GOBIN}/mockery" --name=KubeNodeGroup --output=mocks/
 type KubeNodeGroup interface {
 	// NameString returns the name
 	NameString() string
@@ -212,3 +213,49 @@ func newSuspendProcesses(c *ClusterProvider, spec *api.ClusterConfig, nodegroup
 		},
 	}
 }
+
+type enableMetricsCollection struct {
+	asg             awsapi.ASG
+	ctx             context.Context
+	nodegroup       *api.NodeGroupBase
+	stackCollection manager.StackManager
+}
+
+func (t *enableMetricsCollection) Describe() string {
+	return fmt.Sprintf("enable ASG metrics collection for nodegroup %s", t.nodegroup.Name)
+}
+
+func (t *enableMetricsCollection) Do() error {
+	ngStack, err := t.stackCollection.DescribeNodeGroupStack(t.ctx, t.nodegroup.Name)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't describe nodegroup stack for nodegroup %s", t.nodegroup.Name)
+	}
+	asgName, err := t.stackCollection.GetAutoScalingGroupName(t.ctx, ngStack)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't get autoscalinggroup name nodegroup %s", t.nodegroup.Name)
+	}
+	for _, m := range t.nodegroup.ASGMetricsCollection {
+		if _, err := t.asg.EnableMetricsCollection(t.ctx, &autoscaling.EnableMetricsCollectionInput{
+			AutoScalingGroupName: aws.String(asgName),
+			Granularity:          aws.String(m.Granularity),
+			Metrics:              m.Metrics,
+		}); err != nil {
+			return errors.Wrapf(err, "couldn't enable metrics collection for nodegroup %s", t.nodegroup.Name)
+		}
+	}
+	logger.Info("enabled ASG metrics collection for %s", t.nodegroup.Name)
+	return nil
+}
+
+// newEnableMetricsCollection returns a task that enables the collection of group metrics
+// on the AutoScalingGroup of a managed nodegroup, unmanaged nodegroups enable it in their stack
+func newEnableMetricsCollection(c *ClusterProvider, spec *api.ClusterConfig, nodegroup *api.NodeGroupBase) tasks.Task {
+	return tasks.SynchronousTask{
+		SynchronousTaskIface: &enableMetricsCollection{
+			ctx:             context.Background(),
+			asg:             c.Provider.ASG(),
+			stackCollection: c.NewStackManager(spec),
+			nodegroup:       nodegroup,
+		},
+	}
+}
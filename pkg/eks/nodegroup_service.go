@@ -8,7 +8,7 @@ import (
 
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/bytequantity"
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
@@ -17,6 +17,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/ssh"
+	sshclient "github.com/weaveworks/eksctl/pkg/ssh/client"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
@@ -27,17 +28,20 @@ const maxInstanceTypes = 40
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 
-//counterfeiter:generate -o fakes/fake_instance_selector.go . InstanceSelector
 // InstanceSelector selects a set of instance types matching the specified instance selector criteria
+//
+//counterfeiter:generate -o fakes/fake_instance_selector.go . InstanceSelector
 type InstanceSelector interface {
 	// Filter returns a set of instance types matching the specified instance selector filters
 	Filter(selector.Filters) ([]string, error)
 }
 
-//counterfeiter:generate -o fakes/fake_nodegroup_initialiser.go . NodeGroupInitialiser
 // NodeGroupInitialiser is an interface that provides helpers for nodegroup creation.
+//
+//counterfeiter:generate -o fakes/fake_nodegroup_initialiser.go . NodeGroupInitialiser
 type NodeGroupInitialiser interface {
 	Normalize(ctx context.Context, nodePools []api.NodePool, clusterMeta *api.ClusterMeta) error
+	ApplyVPCCNIConfig(ctx context.Context, nodePools []api.NodePool, clusterConfig *api.ClusterConfig) error
 	ExpandInstanceSelectorOptions(nodePools []api.NodePool, clusterAZs []string) error
 	NewAWSSelectorSession(provider api.ClusterProvider)
 	ValidateLegacySubnetsForNodeGroups(ctx context.Context, spec *api.ClusterConfig, provider api.ClusterProvider) error
@@ -73,7 +77,7 @@ func (m *NodeGroupService) Normalize(ctx context.Context, nodePools []api.NodePo
 		switch ng := np.(type) {
 		case *api.ManagedNodeGroup:
 			hasNativeAMIFamilySupport := ng.AMIFamily == api.NodeImageFamilyAmazonLinux2 || ng.AMIFamily == api.NodeImageFamilyBottlerocket
-			if !hasNativeAMIFamilySupport && !api.IsAMI(ng.AMI) {
+			if (!hasNativeAMIFamilySupport && !api.IsAMI(ng.AMI)) || api.IsImageBuilderAMI(ng.AMI) {
 				if err := ResolveAMI(ctx, m.Provider, clusterMeta.Version, np); err != nil {
 					return err
 				}
@@ -100,7 +104,7 @@ func (m *NodeGroupService) Normalize(ctx context.Context, nodePools []api.NodePo
 		// fingerprint, so if unique keys are provided, each will get
 		// loaded and used as intended and there is no need to have
 		// nodegroup name in the key name
-		publicKeyName, err := ssh.LoadKey(ctx, ng.SSH, clusterMeta.Name, ng.Name, m.Provider.EC2())
+		publicKeyName, err := ssh.LoadKey(ctx, ng.SSH, clusterMeta.Name, ng.Name, m.Provider.EC2(), m.sshKeyStores())
 		if err != nil {
 			return err
 		}
@@ -111,6 +115,13 @@ func (m *NodeGroupService) Normalize(ctx context.Context, nodePools []api.NodePo
 	return nil
 }
 
+func (m *NodeGroupService) sshKeyStores() sshclient.KeyStores {
+	return sshclient.KeyStores{
+		SSM:            m.Provider.SSM(),
+		SecretsManager: m.Provider.SecretsManager(),
+	}
+}
+
 // ExpandInstanceSelectorOptions sets instance types to instances matched by the instance selector criteria
 func (m *NodeGroupService) ExpandInstanceSelectorOptions(nodePools []api.NodePool, clusterAZs []string) error {
 	instanceTypesMatch := func(a, b []string) bool {
@@ -0,0 +1,164 @@
+package eks
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// preBootstrapCommandData is the data preBootstrapCommand snippets are rendered with
+type preBootstrapCommandData struct {
+	Cluster   *api.ClusterMeta
+	NodeGroup *api.NodeGroupBase
+}
+
+// RenderBootstrapCommandTemplates renders the preBootstrapCommands and overrideBootstrapCommand of each nodegroup
+// as templates, with the same data and functions as snippets, if nodeGroupDefaults.bootstrapCommandTemplates is
+// enabled. It must be called before ExpandPreBootstrapCommandRefs so that snippets aren't rendered twice
+func RenderBootstrapCommandTemplates(cfg *api.ClusterConfig) error {
+	if cfg.NodeGroupDefaults == nil || !api.IsEnabled(cfg.NodeGroupDefaults.BootstrapCommandTemplates) {
+		return nil
+	}
+	for _, ng := range cfg.AllNodeGroups() {
+		data := preBootstrapCommandData{
+			Cluster:   cfg.Metadata,
+			NodeGroup: ng,
+		}
+		for i, command := range ng.PreBootstrapCommands {
+			rendered, err := renderPreBootstrapCommand(fmt.Sprintf("preBootstrapCommands[%d]", i), command, data)
+			if err != nil {
+				return errors.Wrapf(err, "rendering preBootstrapCommands[%d] of nodegroup %q", i, ng.Name)
+			}
+			ng.PreBootstrapCommands[i] = rendered
+		}
+		if ng.OverrideBootstrapCommand != nil {
+			rendered, err := renderPreBootstrapCommand("overrideBootstrapCommand", *ng.OverrideBootstrapCommand, data)
+			if err != nil {
+				return errors.Wrapf(err, "rendering overrideBootstrapCommand of nodegroup %q", ng.Name)
+			}
+			ng.OverrideBootstrapCommand = &rendered
+		}
+	}
+	// templates are disabled so that the config isn't rendered twice if it's written back
+	cfg.NodeGroupDefaults.BootstrapCommandTemplates = nil
+	return nil
+}
+
+// ExpandPreBootstrapCommandRefs prepends the commands of the snippets referenced by each nodegroup's
+// preBootstrapCommandRefs to its preBootstrapCommands; a relative snippets file is resolved against baseDir
+func ExpandPreBootstrapCommandRefs(cfg *api.ClusterConfig, baseDir string) error {
+	var nodeGroups []*api.NodeGroupBase
+	for _, ng := range cfg.AllNodeGroups() {
+		if len(ng.PreBootstrapCommandRefs) > 0 {
+			nodeGroups = append(nodeGroups, ng)
+		}
+	}
+	if len(nodeGroups) == 0 {
+		return nil
+	}
+
+	snippets, err := loadPreBootstrapCommandSnippets(cfg.NodeGroupDefaults, baseDir)
+	if err != nil {
+		return err
+	}
+
+	for _, ng := range nodeGroups {
+		var commands []string
+		for _, ref := range ng.PreBootstrapCommandRefs {
+			snippet, ok := snippets[ref]
+			if !ok {
+				return fmt.Errorf("nodegroup %q references undefined preBootstrapCommand snippet %q", ng.Name, ref)
+			}
+			for _, command := range snippet {
+				rendered, err := renderPreBootstrapCommand(ref, command, preBootstrapCommandData{
+					Cluster:   cfg.Metadata,
+					NodeGroup: ng,
+				})
+				if err != nil {
+					return errors.Wrapf(err, "rendering preBootstrapCommand snippet %q for nodegroup %q", ref, ng.Name)
+				}
+				commands = append(commands, rendered)
+			}
+		}
+		ng.PreBootstrapCommands = append(commands, ng.PreBootstrapCommands...)
+		// the refs are cleared so that the config isn't expanded twice if it's written back
+		ng.PreBootstrapCommandRefs = nil
+	}
+	return nil
+}
+
+func loadPreBootstrapCommandSnippets(defaults *api.NodeGroupDefaults, baseDir string) (map[string][]string, error) {
+	snippets := map[string][]string{}
+	if defaults == nil {
+		return snippets, nil
+	}
+	for name, commands := range defaults.PreBootstrapCommandSnippets {
+		snippets[name] = commands
+	}
+	if defaults.PreBootstrapCommandSnippetsFile == "" {
+		return snippets, nil
+	}
+
+	path := defaults.PreBootstrapCommandSnippetsFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading preBootstrapCommand snippets file")
+	}
+	var fileSnippets map[string][]string
+	if err := yaml.UnmarshalStrict(data, &fileSnippets); err != nil {
+		return nil, errors.Wrapf(err, "parsing preBootstrapCommand snippets file %q", path)
+	}
+	for name, commands := range fileSnippets {
+		if _, ok := snippets[name]; ok {
+			return nil, fmt.Errorf("preBootstrapCommand snippet %q is defined both in nodeGroupDefaults and in %q", name, path)
+		}
+		snippets[name] = commands
+	}
+	return snippets, nil
+}
+
+// bootstrapCommandFuncs are the functions available to bootstrap command templates
+func bootstrapCommandFuncs(meta *api.ClusterMeta) template.FuncMap {
+	return template.FuncMap{
+		"clusterName": func() string {
+			return meta.Name
+		},
+		"region": func() string {
+			return meta.Region
+		},
+		"b64": func(s string) string {
+			return base64.StdEncoding.EncodeToString([]byte(s))
+		},
+		"tojson": func(v interface{}) (string, error) {
+			data, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+			return string(data), nil
+		},
+	}
+}
+
+func renderPreBootstrapCommand(name, command string, data preBootstrapCommandData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(bootstrapCommandFuncs(data.Cluster)).Parse(command)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
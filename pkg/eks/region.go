@@ -0,0 +1,37 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const optInRegionsDocURL = "https://docs.aws.amazon.com/general/latest/gr/rande-manage.html"
+
+// CheckRegionEnabled returns an error if the region is an opt-in region that isn't enabled for the account
+func (c *ClusterProvider) CheckRegionEnabled(ctx context.Context) error {
+	region := c.Provider.Region()
+	if !api.IsOptInRegion(region) {
+		return nil
+	}
+	output, err := c.Provider.EC2().DescribeRegions(ctx, &ec2.DescribeRegionsInput{
+		AllRegions:  aws.Bool(true),
+		RegionNames: []string{region},
+	})
+	if err != nil {
+		// the check is best effort, as the credentials may not allow describing regions
+		logger.Debug("unable to check whether region %q is enabled: %v", region, err)
+		return nil
+	}
+	for _, r := range output.Regions {
+		if aws.ToString(r.RegionName) == region && aws.ToString(r.OptInStatus) == "not-opted-in" {
+			return fmt.Errorf("region %q is an opt-in region that isn't enabled for the account, enable it before using it, see %s", region, optInRegionsDocURL)
+		}
+	}
+	return nil
+}
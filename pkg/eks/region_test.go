@@ -0,0 +1,62 @@
+package eks_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("CheckRegionEnabled", func() {
+	var (
+		p   *mockprovider.MockProvider
+		ctl *ClusterProvider
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{Provider: p}
+	})
+
+	mockOptInStatus := func(status string) {
+		p.MockEC2().On("DescribeRegions", mock.Anything, &ec2.DescribeRegionsInput{
+			AllRegions:  aws.Bool(true),
+			RegionNames: []string{api.RegionAFSouth1},
+		}).Return(&ec2.DescribeRegionsOutput{
+			Regions: []ec2types.Region{{RegionName: aws.String(api.RegionAFSouth1), OptInStatus: aws.String(status)}},
+		}, nil)
+	}
+
+	It("doesn't check regions that are enabled by default", func() {
+		p.SetRegion(api.RegionUSWest2)
+		Expect(ctl.CheckRegionEnabled(context.Background())).To(Succeed())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeRegions", mock.Anything, mock.Anything)
+	})
+
+	It("succeeds if the opt-in region is enabled", func() {
+		p.SetRegion(api.RegionAFSouth1)
+		mockOptInStatus("opted-in")
+		Expect(ctl.CheckRegionEnabled(context.Background())).To(Succeed())
+	})
+
+	It("fails if the opt-in region isn't enabled", func() {
+		p.SetRegion(api.RegionAFSouth1)
+		mockOptInStatus("not-opted-in")
+		Expect(ctl.CheckRegionEnabled(context.Background())).To(MatchError(ContainSubstring(`region "af-south-1" is an opt-in region that isn't enabled for the account`)))
+	})
+
+	It("doesn't fail if the regions can't be described", func() {
+		p.SetRegion(api.RegionAFSouth1)
+		p.MockEC2().On("DescribeRegions", mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+		Expect(ctl.CheckRegionEnabled(context.Background())).To(Succeed())
+	})
+})
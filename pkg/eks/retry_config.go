@@ -0,0 +1,98 @@
+package eks
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Environment variables used for the retry settings that aren't set explicitly
+const (
+	MaxAttemptsEnvName               = "AWS_MAX_ATTEMPTS"
+	RetryModeEnvName                 = "AWS_RETRY_MODE"
+	EC2MaxAttemptsEnvName            = "EKSCTL_EC2_MAX_ATTEMPTS"
+	CloudFormationMaxAttemptsEnvName = "EKSCTL_CLOUDFORMATION_MAX_ATTEMPTS"
+	EKSMaxAttemptsEnvName            = "EKSCTL_EKS_MAX_ATTEMPTS"
+)
+
+// ResolveRetryConfig fills the unset fields of rc from the environment and validates the result
+func ResolveRetryConfig(rc api.RetryConfig) (api.RetryConfig, error) {
+	for _, attempts := range []struct {
+		value   *int
+		envName string
+	}{
+		{&rc.MaxAttempts, MaxAttemptsEnvName},
+		{&rc.EC2MaxAttempts, EC2MaxAttemptsEnvName},
+		{&rc.CloudFormationMaxAttempts, CloudFormationMaxAttemptsEnvName},
+		{&rc.EKSMaxAttempts, EKSMaxAttemptsEnvName},
+	} {
+		if *attempts.value != 0 {
+			if *attempts.value < 0 {
+				return rc, fmt.Errorf("maximum number of attempts must be positive; got %d", *attempts.value)
+			}
+			continue
+		}
+		envValue, ok := os.LookupEnv(attempts.envName)
+		if !ok || envValue == "" {
+			continue
+		}
+		value, err := strconv.Atoi(envValue)
+		if err != nil || value < 1 {
+			return rc, fmt.Errorf("invalid value %q for %s: must be a positive integer", envValue, attempts.envName)
+		}
+		*attempts.value = value
+	}
+
+	if rc.Mode == "" {
+		rc.Mode = os.Getenv(RetryModeEnvName)
+	}
+	switch rc.Mode {
+	case "":
+		rc.Mode = api.RetryModeStandard
+	case api.RetryModeStandard, api.RetryModeAdaptive:
+	default:
+		return rc, fmt.Errorf("invalid retry mode %q: must be one of %q or %q", rc.Mode, api.RetryModeStandard, api.RetryModeAdaptive)
+	}
+	return rc, nil
+}
+
+// maxAttempts returns serviceMaxAttempts if it is set, otherwise the global setting or the default
+func maxAttempts(rc api.RetryConfig, serviceMaxAttempts int) int {
+	switch {
+	case serviceMaxAttempts > 0:
+		return serviceMaxAttempts
+	case rc.MaxAttempts > 0:
+		return rc.MaxAttempts
+	default:
+		return maxRetries
+	}
+}
+
+// numMaxRetriesV1 returns the number of retries for SDK v1 clients, which don't count the first attempt
+func numMaxRetriesV1(rc api.RetryConfig, serviceMaxAttempts int) int {
+	if serviceMaxAttempts > 0 || rc.MaxAttempts > 0 {
+		return maxAttempts(rc, serviceMaxAttempts) - 1
+	}
+	return maxRetries
+}
+
+// newRetryerV2 returns a retryer for SDK v2 clients that makes up to maxAttempts attempts using the mode in rc
+func newRetryerV2(rc api.RetryConfig, maxAttempts int) *RetryerV2 {
+	standardOptions := func(o *retry.StandardOptions) {
+		o.MaxAttempts = maxAttempts
+	}
+	if rc.Mode == api.RetryModeAdaptive {
+		return &RetryerV2{
+			Retryer: retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = []func(*retry.StandardOptions){standardOptions}
+			}),
+		}
+	}
+	return &RetryerV2{
+		Retryer: retry.AddWithMaxAttempts(retry.NewStandard(standardOptions), maxAttempts),
+	}
+}
@@ -0,0 +1,79 @@
+package eks_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("AWS API retry configuration", func() {
+	envNames := []string{
+		eks.MaxAttemptsEnvName,
+		eks.RetryModeEnvName,
+		eks.EC2MaxAttemptsEnvName,
+		eks.CloudFormationMaxAttemptsEnvName,
+		eks.EKSMaxAttemptsEnvName,
+	}
+	savedEnv := map[string]string{}
+
+	BeforeEach(func() {
+		for _, name := range envNames {
+			if value, ok := os.LookupEnv(name); ok {
+				savedEnv[name] = value
+			}
+			Expect(os.Unsetenv(name)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		for _, name := range envNames {
+			Expect(os.Unsetenv(name)).To(Succeed())
+			if value, ok := savedEnv[name]; ok {
+				Expect(os.Setenv(name, value)).To(Succeed())
+			}
+		}
+	})
+
+	It("defaults to the standard retry mode", func() {
+		rc, err := eks.ResolveRetryConfig(api.RetryConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rc).To(Equal(api.RetryConfig{Mode: api.RetryModeStandard}))
+	})
+
+	It("reads unset values from the environment", func() {
+		Expect(os.Setenv(eks.MaxAttemptsEnvName, "20")).To(Succeed())
+		Expect(os.Setenv(eks.RetryModeEnvName, "adaptive")).To(Succeed())
+		Expect(os.Setenv(eks.EC2MaxAttemptsEnvName, "30")).To(Succeed())
+		Expect(os.Setenv(eks.EKSMaxAttemptsEnvName, "5")).To(Succeed())
+
+		rc, err := eks.ResolveRetryConfig(api.RetryConfig{EKSMaxAttempts: 8})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rc).To(Equal(api.RetryConfig{
+			MaxAttempts:    20,
+			Mode:           api.RetryModeAdaptive,
+			EC2MaxAttempts: 30,
+			EKSMaxAttempts: 8,
+		}))
+	})
+
+	It("fails on an invalid number of attempts in the environment", func() {
+		Expect(os.Setenv(eks.CloudFormationMaxAttemptsEnvName, "many")).To(Succeed())
+
+		_, err := eks.ResolveRetryConfig(api.RetryConfig{})
+		Expect(err).To(MatchError(`invalid value "many" for EKSCTL_CLOUDFORMATION_MAX_ATTEMPTS: must be a positive integer`))
+	})
+
+	It("fails on a negative number of attempts", func() {
+		_, err := eks.ResolveRetryConfig(api.RetryConfig{MaxAttempts: -1})
+		Expect(err).To(MatchError("maximum number of attempts must be positive; got -1"))
+	})
+
+	It("fails on an unknown retry mode", func() {
+		_, err := eks.ResolveRetryConfig(api.RetryConfig{Mode: "legacy"})
+		Expect(err).To(MatchError(`invalid retry mode "legacy": must be one of "standard" or "adaptive"`))
+	})
+})
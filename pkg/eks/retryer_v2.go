@@ -1,31 +1,31 @@
 package eks
 
 import (
-	"net/http"
+	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-
-	"github.com/aws/aws-sdk-go-v2/aws/retry"
-
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
-// RetryerV2 implements aws.Retryer
+// RetryerV2 implements aws.RetryerV2
 type RetryerV2 struct {
 	aws.Retryer
 }
 
 // NewRetryerV2 returns a new *RetryerV2
 func NewRetryerV2() *RetryerV2 {
-	standard := retry.AddWithMaxAttempts(retry.NewStandard(func(o *retry.StandardOptions) {
-		o.MaxAttempts = maxRetries
-	}), maxRetries)
+	return newRetryerV2(api.RetryConfig{}, maxRetries)
+}
 
-	return &RetryerV2{
-		Retryer: standard,
+// GetAttemptToken implements aws.RetryerV2, it lets the adaptive retry mode rate limit attempts
+func (r *RetryerV2) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if retryer, ok := r.Retryer.(aws.RetryerV2); ok {
+		return retryer.GetAttemptToken(ctx)
 	}
+	return r.Retryer.GetInitialToken(), nil
 }
 
 // IsErrorRetryable implements aws.Retryer
@@ -35,15 +35,5 @@ func (r *RetryerV2) IsErrorRetryable(err error) bool {
 	}
 
 	var oe *smithy.OperationError
-	return errors.As(err, &oe) && oe.Err != nil && isErrorRetryable(oe.Err)
-}
-
-func isErrorRetryable(err error) bool {
-	if aerr, ok := err.(awserr.RequestFailure); ok && aerr.Code() == "EC2MetadataError" {
-		switch aerr.StatusCode() {
-		case http.StatusForbidden, http.StatusNotFound, http.StatusMethodNotAllowed:
-			return false
-		}
-	}
-	return true
+	return errors.As(err, &oe) && oe.Err != nil
 }
@@ -0,0 +1,170 @@
+package eks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Environment variables the AWS SDKs, EKS (IRSA) and CI runners such as CodeBuild and GitHub Actions configure web
+// identity credentials with
+const (
+	webIdentityTokenFileEnvName = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	webIdentityRoleARNEnvName   = "AWS_ROLE_ARN"
+	roleSessionNameEnvName      = "AWS_ROLE_SESSION_NAME"
+)
+
+// SourceIdentityEnvName is the environment variable that sets the source identity of the roles eksctl assumes
+const SourceIdentityEnvName = "EKSCTL_SOURCE_IDENTITY"
+
+// maxSessionTags is the maximum number of session tags STS accepts
+const maxSessionTags = 50
+
+// sourceIdentityPattern matches the source identities STS accepts
+var sourceIdentityPattern = regexp.MustCompile(`^[\w+=,.@-]{2,64}$`)
+
+// useRoleCredentials resolves web identity credentials from the environment and assumes pc.AssumeRoleARN with the
+// resulting credentials, calling STS in the region pinned with pc.STSRegion.
+// The SDK resolves web identity credentials itself, but eksctl does it to pin the STS region and so that roles
+// are chained on top of them rather than the profile
+func useRoleCredentials(cfg *aws.Config, pc *api.ProviderConfig) error {
+	if err := validateRoleCredentials(pc); err != nil {
+		return err
+	}
+
+	// the client calls STS with the credentials of cfg at the time it's created
+	newSTSClient := func() *sts.Client {
+		return sts.NewFromConfig(*cfg, func(o *sts.Options) {
+			if pc.STSRegion != "" {
+				o.Region = pc.STSRegion
+			}
+		})
+	}
+
+	if provider, ok := webIdentityProvider(newSTSClient(), pc.Profile); ok {
+		cfg.Credentials = aws.NewCredentialsCache(provider)
+	}
+
+	if pc.AssumeRoleARN == "" {
+		return nil
+	}
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(newSTSClient(), pc.AssumeRoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = roleSessionName(pc.RoleSessionName)
+		o.Tags = makeSessionTags(pc.SessionTags)
+		o.SourceIdentity = sourceIdentity(pc.SourceIdentity)
+	}))
+	return nil
+}
+
+func validateRoleCredentials(pc *api.ProviderConfig) error {
+	if pc.AssumeRoleARN == "" {
+		if pc.RoleSessionName != "" {
+			return errors.New("--role-session-name requires --assume-role-arn")
+		}
+		if (len(pc.SessionTags) > 0 || pc.SourceIdentity != "") && !profileAssumesRole(pc.Profile) {
+			return errors.New("--session-tags and --source-identity require a role to be assumed, with --assume-role-arn or a profile that sets role_arn")
+		}
+	}
+	if len(pc.SessionTags) > maxSessionTags {
+		return fmt.Errorf("at most %d session tags can be set, got %d", maxSessionTags, len(pc.SessionTags))
+	}
+	if pc.SourceIdentity != "" && !sourceIdentityPattern.MatchString(pc.SourceIdentity) {
+		return fmt.Errorf("invalid source identity %q, it must be 2 to 64 letters, digits or any of _+=,.@-", pc.SourceIdentity)
+	}
+	return nil
+}
+
+// profileAssumesRole reports whether the credentials of profile are obtained with AssumeRole, which sets session
+// tags and the source identity; web identity roles get them from the claims of the token instead
+func profileAssumesRole(profile string) bool {
+	envConfig, err := config.NewEnvConfig()
+	if err != nil || envConfig.Credentials.HasKeys() {
+		return false
+	}
+	sharedConfig, err := config.LoadSharedConfigProfile(context.TODO(), profileOrDefault(profile), func(o *config.LoadSharedConfigOptions) {
+		if envConfig.SharedConfigFile != "" {
+			o.ConfigFiles = []string{envConfig.SharedConfigFile}
+		}
+		if envConfig.SharedCredentialsFile != "" {
+			o.CredentialsFiles = []string{envConfig.SharedCredentialsFile}
+		}
+	})
+	if err != nil {
+		return false
+	}
+	return sharedConfig.RoleARN != "" && sharedConfig.WebIdentityTokenFile == ""
+}
+
+// webIdentityProvider returns a provider of the web identity credentials configured in the environment, unless
+// static credentials are set in the environment or a profile is selected, which take precedence
+func webIdentityProvider(stsAPI stscreds.AssumeRoleWithWebIdentityAPIClient, profile string) (aws.CredentialsProvider, bool) {
+	tokenFile, roleARN := os.Getenv(webIdentityTokenFileEnvName), os.Getenv(webIdentityRoleARNEnvName)
+	if tokenFile == "" || roleARN == "" || profile != "" {
+		return nil, false
+	}
+	if envConfig, err := config.NewEnvConfig(); err == nil && envConfig.Credentials.HasKeys() {
+		return nil, false
+	}
+	return stscreds.NewWebIdentityRoleProvider(stsAPI, roleARN, stscreds.IdentityTokenFile(tokenFile), func(o *stscreds.WebIdentityRoleOptions) {
+		o.RoleSessionName = roleSessionName(os.Getenv(roleSessionNameEnvName))
+	}), true
+}
+
+// roleSessionName returns name, or a name identifying eksctl if it's empty
+func roleSessionName(name string) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("eksctl-%d", time.Now().UnixNano())
+}
+
+// sourceIdentity returns the source identity to assume roles with, or nil if it's empty
+func sourceIdentity(identity string) *string {
+	if identity == "" {
+		return nil
+	}
+	return aws.String(identity)
+}
+
+// makeSessionTags returns the session tags of tags, sorted by key so that requests are deterministic
+func makeSessionTags(tags map[string]string) []ststypes.Tag {
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sessionTags []ststypes.Tag
+	for _, k := range keys {
+		sessionTags = append(sessionTags, ststypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+	return sessionTags
+}
+
+// credentialsCacheKey returns the key credentials are cached under, credentials of assumed roles are cached apart
+// from the ones of the profile they're assumed with, and credentials of a source identity apart from the others
+// so that calls are never attributed to the wrong operator
+func credentialsCacheKey(pc *api.ProviderConfig) string {
+	key := pc.Profile
+	if pc.AssumeRoleARN != "" {
+		key += "@" + pc.AssumeRoleARN
+	}
+	if pc.SourceIdentity != "" {
+		key += "#" + pc.SourceIdentity
+	}
+	return key
+}
@@ -6,22 +6,36 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/amp"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/grafana"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/metadata"
 )
 
+// pricingRegion is a region the Price List API is available in
+const pricingRegion = "us-east-1"
+
 // ServicesV2 implements api.ServicesV2.
 // The SDK clients are initialized lazily and guarded by a mutex.
 type ServicesV2 struct {
-	config aws.Config
+	config      aws.Config
+	retryConfig api.RetryConfig
 
 	// mu guards initialization of SDK clients.
 	// All service methods should ensure that their initialization is guarded by mu.
@@ -34,6 +48,19 @@ type ServicesV2 struct {
 	ssm                    *ssm.Client
 	iam                    *iam.Client
 	ec2                    *ec2.Client
+	eks                    *eks.Client
+	servicequotas          *servicequotas.Client
+	imagebuilder           *imagebuilder.Client
+	amp                    *amp.Client
+	grafana                *grafana.Client
+	guardduty              *guardduty.Client
+	kms                    *kms.Client
+	pricing                *pricing.Client
+	cloudwatch             *cloudwatch.Client
+	secretsmanager         *secretsmanager.Client
+
+	// metadataBundle answers the metadata lookups of the SSM, EC2 and EKS clients when set
+	metadataBundle *metadata.Bundle
 }
 
 // STS implements the AWS STS service.
@@ -74,13 +101,9 @@ func (s *ServicesV2) CloudFormation() awsapi.CloudFormation {
 		s.cloudformation = cloudformation.NewFromConfig(s.config, func(o *cloudformation.Options) {
 			// Use adaptive mode for retrying CloudFormation requests to mimic
 			// the logic used for AWS SDK v1.
-			o.Retryer = retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
-				o.StandardOptions = []func(*retry.StandardOptions){
-					func(so *retry.StandardOptions) {
-						so.MaxAttempts = maxRetries
-					},
-				}
-			})
+			retryConfig := s.retryConfig
+			retryConfig.Mode = api.RetryModeAdaptive
+			o.Retryer = newRetryerV2(retryConfig, maxAttempts(retryConfig, retryConfig.CloudFormationMaxAttempts))
 		})
 	}
 	return s.cloudformation
@@ -113,6 +136,9 @@ func (s *ServicesV2) SSM() awsapi.SSM {
 	if s.ssm == nil {
 		s.ssm = ssm.NewFromConfig(s.config)
 	}
+	if s.metadataBundle != nil {
+		return metadata.WrapSSM(s.ssm, s.metadataBundle)
+	}
 	return s.ssm
 }
 
@@ -131,7 +157,120 @@ func (s *ServicesV2) EC2() awsapi.EC2 {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.ec2 == nil {
-		s.ec2 = ec2.NewFromConfig(s.config)
+		s.ec2 = ec2.NewFromConfig(s.config, func(o *ec2.Options) {
+			o.Retryer = newRetryerV2(s.retryConfig, maxAttempts(s.retryConfig, s.retryConfig.EC2MaxAttempts))
+		})
+	}
+	if s.metadataBundle != nil {
+		return metadata.WrapEC2(s.ec2, s.metadataBundle)
 	}
 	return s.ec2
 }
+
+// EKS implements the AWS EKS service.
+func (s *ServicesV2) EKS() awsapi.EKS {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.eks == nil {
+		s.eks = eks.NewFromConfig(s.config, func(o *eks.Options) {
+			o.Retryer = newRetryerV2(s.retryConfig, maxAttempts(s.retryConfig, s.retryConfig.EKSMaxAttempts))
+		})
+	}
+	if s.metadataBundle != nil {
+		return metadata.WrapEKS(s.eks, s.metadataBundle)
+	}
+	return s.eks
+}
+
+// ServiceQuotas implements the AWS Service Quotas service.
+func (s *ServicesV2) ServiceQuotas() awsapi.ServiceQuotas {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.servicequotas == nil {
+		s.servicequotas = servicequotas.NewFromConfig(s.config)
+	}
+	return s.servicequotas
+}
+
+// ImageBuilder implements the AWS EC2 Image Builder service.
+func (s *ServicesV2) ImageBuilder() awsapi.ImageBuilder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.imagebuilder == nil {
+		s.imagebuilder = imagebuilder.NewFromConfig(s.config)
+	}
+	return s.imagebuilder
+}
+
+// AMP implements the AWS Managed Service for Prometheus service.
+func (s *ServicesV2) AMP() awsapi.AMP {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.amp == nil {
+		s.amp = amp.NewFromConfig(s.config)
+	}
+	return s.amp
+}
+
+// Grafana implements the AWS Managed Grafana service.
+func (s *ServicesV2) Grafana() awsapi.Grafana {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.grafana == nil {
+		s.grafana = grafana.NewFromConfig(s.config)
+	}
+	return s.grafana
+}
+
+// GuardDuty implements the AWS GuardDuty service.
+func (s *ServicesV2) GuardDuty() awsapi.GuardDuty {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.guardduty == nil {
+		s.guardduty = guardduty.NewFromConfig(s.config)
+	}
+	return s.guardduty
+}
+
+// KMS implements the AWS KMS service.
+func (s *ServicesV2) KMS() awsapi.KMS {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.kms == nil {
+		s.kms = kms.NewFromConfig(s.config)
+	}
+	return s.kms
+}
+
+// Pricing implements the AWS Price List service.
+func (s *ServicesV2) Pricing() awsapi.Pricing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pricing == nil {
+		s.pricing = pricing.NewFromConfig(s.config, func(o *pricing.Options) {
+			// the Price List API is only available in a few regions, and returns the prices of all the regions
+			o.Region = pricingRegion
+		})
+	}
+	return s.pricing
+}
+
+// CloudWatch implements the AWS CloudWatch service.
+func (s *ServicesV2) CloudWatch() awsapi.CloudWatch {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cloudwatch == nil {
+		s.cloudwatch = cloudwatch.NewFromConfig(s.config)
+	}
+	return s.cloudwatch
+}
+
+// SecretsManager implements the AWS Secrets Manager service.
+func (s *ServicesV2) SecretsManager() awsapi.SecretsManager {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.secretsmanager == nil {
+		s.secretsmanager = secretsmanager.NewFromConfig(s.config)
+	}
+	return s.secretsmanager
+}
@@ -6,9 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
-	"github.com/aws/aws-sdk-go/aws"
-
+	"github.com/weaveworks/eksctl/pkg/actions/accessentries"
 	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
 	"github.com/weaveworks/eksctl/pkg/windows"
 
@@ -24,6 +22,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/addons"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/fargate/coredns"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
@@ -90,7 +89,7 @@ func (v *VPCControllerTask) Do(errCh chan error) error {
 	if err != nil {
 		return err
 	}
-	oidc, err := v.ClusterProvider.NewOpenIDConnectManager(v.ClusterConfig)
+	oidc, err := v.ClusterProvider.NewOpenIDConnectManager(v.Context, v.ClusterConfig)
 	if err != nil {
 		return err
 	}
@@ -105,7 +104,7 @@ func (v *VPCControllerTask) Do(errCh chan error) error {
 	irsa := addons.NewIRSAHelper(oidc, stackCollection, irsaManager, v.ClusterConfig.Metadata.Name)
 
 	// TODO PlanMode doesn't work as intended
-	vpcController := addons.NewVPCController(rawClient, irsa, v.ClusterConfig.Status, v.ClusterProvider.Provider.Region(), v.PlanMode)
+	vpcController := addons.NewVPCController(rawClient, irsa, v.ClusterConfig.Status, v.ClusterProvider.Provider.Region(), v.ClusterConfig.ImageRepositoryOverride, v.PlanMode)
 	if err := vpcController.Deploy(v.Context); err != nil {
 		return errors.Wrap(err, "error installing VPC controller")
 	}
@@ -128,7 +127,7 @@ func (n *devicePluginTask) Do(errCh chan error) error {
 	if err != nil {
 		return err
 	}
-	devicePlugin := n.mkPlugin(rawClient, n.clusterProvider.Provider.Region(), false)
+	devicePlugin := n.mkPlugin(rawClient, n.clusterProvider.Provider.Region(), false, n.spec.ImageRepositoryOverride)
 	if err := devicePlugin.Deploy(); err != nil {
 		return errors.Wrap(err, "error installing device plugin")
 	}
@@ -226,36 +225,51 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg
 		IsSubTask: true,
 	}
 
+	if cfg.AccessConfig != nil && len(cfg.AccessConfig.ClusterAdminARNs) > 0 {
+		// the admins must be able to access the cluster before eksctl waits for its API server
+		creator := accessentries.NewClusterAdminCreator(cfg.Metadata.Name, api.Partition(cfg.Metadata.Region), c.Provider.EKS())
+		for _, principalARN := range cfg.AccessConfig.ClusterAdminARNs {
+			principalARN := principalARN
+			newTasks.Append(&tasks.GenericTask{
+				Description: fmt.Sprintf("create cluster admin access entry for %q", principalARN),
+				Doer: func() error {
+					return creator.Create(ctx, principalARN)
+				},
+			})
+		}
+	}
+
 	newTasks.Append(&tasks.GenericTask{
 		Description: "wait for control plane to become ready",
 		Doer: func() error {
+			if !c.HasKubeAccess() {
+				// the cluster is active once its stack is complete, only the API server readiness can't be checked
+				return c.RefreshClusterStatus(ctx, cfg)
+			}
 			clientSet, err := c.NewStdClientSet(cfg)
 			if err != nil {
 				return errors.Wrap(err, "error creating Clientset")
 			}
-			if err := c.WaitForControlPlane(cfg.Metadata, clientSet); err != nil {
+			timeout := cfg.Timeouts.ControlPlaneReadyTimeout(c.Provider.WaitTimeout())
+			if c.ipv6Client {
+				if err := c.WaitForIPv6Endpoint(ctx, cfg, timeout); err != nil {
+					return err
+				}
+			}
+			if err := c.WaitForControlPlane(cfg.Metadata, clientSet, timeout); err != nil {
 				return err
 			}
-			return c.RefreshClusterStatus(cfg)
+			return c.RefreshClusterStatus(ctx, cfg)
 		},
 	})
 
 	if cfg.HasClusterCloudWatchLogging() {
-		if logRetentionDays := cfg.CloudWatch.ClusterLogging.LogRetentionInDays; logRetentionDays != 0 {
+		if logging := cfg.CloudWatch.ClusterLogging; logging.LogRetentionInDays != 0 || logging.LogGroupKMSKeyARN != "" {
 			newTasks.Append(&clusterConfigTask{
-				info: "update CloudWatch log retention",
+				info: "configure CloudWatch log group",
 				spec: cfg,
 				call: func(clusterConfig *api.ClusterConfig) error {
-					_, err := c.Provider.CloudWatchLogs().PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
-						// The format for log group name is documented here: https://docs.aws.amazon.com/eks/latest/userguide/control-plane-logs.html
-						LogGroupName:    aws.String(fmt.Sprintf("/aws/eks/%s/cluster", cfg.Metadata.Name)),
-						RetentionInDays: aws.Int32(int32(logRetentionDays)),
-					})
-					if err != nil {
-						return errors.Wrap(err, "error updating log retention settings")
-					}
-					logger.Info("set log retention to %d days for CloudWatch logging", logRetentionDays)
-					return nil
+					return c.ConfigureClusterLogGroup(ctx, clusterConfig)
 				},
 			})
 		}
@@ -264,11 +278,15 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg
 	if cfg.IsFargateEnabled() {
 		manager := fargate.NewFromProvider(cfg.Metadata.Name, c.Provider, c.NewStackManager(cfg))
 		newTasks.Append(&fargateProfilesTask{
+			ctx:             ctx,
 			info:            "create fargate profiles",
 			spec:            cfg,
 			clusterProvider: c,
 			manager:         &manager,
 		})
+		if !c.HasKubeAccess() && coredns.IsSchedulableOnFargate(cfg.FargateProfiles) {
+			c.DeferKubeStep("schedule CoreDNS onto Fargate", coredns.ScheduleOnFargateCommand)
+		}
 	}
 
 	if api.IsEnabled(cfg.IAM.WithOIDC) {
@@ -276,10 +294,12 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg
 	}
 
 	if len(cfg.IdentityProviders) > 0 {
-		newTasks.Append(identityproviders.NewAssociateProvidersTask(*cfg.Metadata, cfg.IdentityProviders, c.Provider.EKS()))
+		newTasks.Append(identityproviders.NewAssociateProvidersTask(ctx, *cfg.Metadata, cfg.IdentityProviders, c.Provider.EKS()))
 	}
 
-	if cfg.HasWindowsNodeGroup() {
+	if cfg.HasWindowsNodeGroup() && !c.HasKubeAccess() {
+		c.DeferKubeStep("enable Windows IP address management", windows.EnableIPAMCommand)
+	} else if cfg.HasWindowsNodeGroup() {
 		newTasks.Append(&WindowsIPAMTask{
 			Info: "enable Windows IP address management",
 			ClientsetFunc: func() (kubernetes.Interface, error) {
@@ -354,6 +374,11 @@ func (c *ClusterProvider) ClusterTasksForNodeGroups(cfg *api.ClusterConfig, inst
 				ng.GetAMIFamily() == api.NodeImageFamilyAmazonLinux2)
 		efaEnabled = efaEnabled || api.IsEnabled(ng.EFAEnabled)
 	}
+	if !c.HasKubeAccess() {
+		c.deferDevicePlugins(installNeuronDevicePluginParam && clusterRequiresNeuronDevicePlugin,
+			installNvidiaDevicePluginParam && clusterRequiresNvidiaDevicePlugin, efaEnabled)
+		clusterRequiresNeuronDevicePlugin, clusterRequiresNvidiaDevicePlugin, efaEnabled = false, false, false
+	}
 	if clusterRequiresNeuronDevicePlugin {
 		if installNeuronDevicePluginParam {
 			tasks.Append(newNeuronDevicePluginTask(c, cfg))
@@ -383,6 +408,11 @@ func (c *ClusterProvider) ClusterTasksForNodeGroups(cfg *api.ClusterConfig, inst
 			tasks.Append(newSuspendProcesses(c, cfg, ng))
 		}
 	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		if len(ng.ASGMetricsCollection) > 0 {
+			tasks.Append(newEnableMetricsCollection(c, cfg, ng.NodeGroupBase))
+		}
+	}
 
 	if efaEnabled {
 		tasks.Append(newEFADevicePluginTask(c, cfg))
@@ -391,6 +421,18 @@ func (c *ClusterProvider) ClusterTasksForNodeGroups(cfg *api.ClusterConfig, inst
 	return tasks
 }
 
+func (c *ClusterProvider) deferDevicePlugins(neuron, nvidia, efa bool) {
+	if neuron {
+		c.DeferKubeStep("install the AWS Neuron Kubernetes device plugin, see https://awsdocs-neuron.readthedocs-hosted.com/en/latest/neuron-deploy/tutorials/tutorial-k8s.html#tutorial-k8s-env-setup-for-neuron", "")
+	}
+	if nvidia {
+		c.DeferKubeStep("install the NVIDIA Kubernetes device plugin, see https://github.com/NVIDIA/k8s-device-plugin", "")
+	}
+	if efa {
+		c.DeferKubeStep("install the EFA Kubernetes device plugin, see https://github.com/aws-samples/aws-efa-eks", "")
+	}
+}
+
 func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(ctx context.Context, cfg *api.ClusterConfig, tasks *tasks.TaskTree) {
 	// we don't have all the information to construct full iamoidc.OpenIDConnectManager now,
 	// instead we just create a reference that gets updated when first task runs, and gets
@@ -402,7 +444,7 @@ func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(ctx context.Con
 		info: "associate IAM OIDC provider",
 		spec: cfg,
 		call: func(cfg *api.ClusterConfig) error {
-			oidc, err := c.NewOpenIDConnectManager(cfg)
+			oidc, err := c.NewOpenIDConnectManager(ctx, cfg)
 			if err != nil {
 				return err
 			}
@@ -423,13 +465,20 @@ func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(ctx context.Con
 	// as this is non-CloudFormation context, we need to construct a new stackManager,
 	// given a clientSet getter and OpenIDConnectManager reference we can build out
 	// the list of tasks for each of the service accounts that need to be created
+	serviceAccounts := api.IAMServiceAccountsWithImplicitServiceAccounts(cfg)
+	if !c.HasKubeAccess() {
+		serviceAccounts = c.deferIAMServiceAccounts(cfg, serviceAccounts)
+	}
 	newTasks := c.NewStackManager(cfg).NewTasksToCreateIAMServiceAccounts(
-		api.IAMServiceAccountsWithImplicitServiceAccounts(cfg),
+		serviceAccounts,
 		oidcPlaceholder,
 		clientSet,
 	)
 	newTasks.IsSubTask = true
 	tasks.Append(newTasks)
+	if !c.HasKubeAccess() {
+		return
+	}
 	tasks.Append(&restartDaemonsetTask{
 		namespace:       "kube-system",
 		name:            "aws-node",
@@ -437,3 +486,41 @@ func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(ctx context.Con
 		spec:            cfg,
 	})
 }
+
+// deferIAMServiceAccounts defers the creation of the service accounts that need a Kubernetes
+// service account, and returns the ones that only need an IAM role
+func (c *ClusterProvider) deferIAMServiceAccounts(cfg *api.ClusterConfig, serviceAccounts []*api.ClusterIAMServiceAccount) []*api.ClusterIAMServiceAccount {
+	var (
+		roleOnly   []*api.ClusterIAMServiceAccount
+		configured []string
+	)
+	for _, sa := range serviceAccounts {
+		switch {
+		case api.IsEnabled(sa.RoleOnly):
+			roleOnly = append(roleOnly, sa)
+		case sa.Name == api.AWSNodeMeta.Name && sa.Namespace == api.AWSNodeMeta.Namespace && !hasServiceAccount(cfg.IAM.ServiceAccounts, sa):
+			c.DeferKubeStep("create IAM role for service account kube-system/aws-node",
+				fmt.Sprintf("eksctl create iamserviceaccount --cluster=%s --region=%s --namespace=%s --name=%s --attach-policy-arn=%s --override-existing-serviceaccounts --approve",
+					cfg.Metadata.Name, cfg.Metadata.Region, sa.Namespace, sa.Name, strings.Join(sa.AttachPolicyARNs, ",")))
+		default:
+			configured = append(configured, sa.NameString())
+		}
+	}
+	if len(configured) > 0 {
+		c.DeferKubeStep(fmt.Sprintf("create IAM service accounts %s", strings.Join(configured, ", ")),
+			c.eksctlCommand(cfg, "create iamserviceaccount", "--include="+strings.Join(configured, ","), "--override-existing-serviceaccounts", "--approve"))
+	}
+	if len(roleOnly) < len(serviceAccounts) {
+		c.DeferKubeStep(`restart daemonset "kube-system/aws-node"`, "kubectl rollout restart daemonset/aws-node --namespace=kube-system")
+	}
+	return roleOnly
+}
+
+func hasServiceAccount(serviceAccounts []*api.ClusterIAMServiceAccount, sa *api.ClusterIAMServiceAccount) bool {
+	for _, s := range serviceAccounts {
+		if s == sa {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package eks_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ClusterTasksForNodeGroups", func() {
+	var (
+		ctl *ClusterProvider
+		cfg *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		ctl = &ClusterProvider{
+			Provider: mockprovider.NewMockProvider(),
+			Status:   &ProviderStatus{},
+		}
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster"
+	})
+
+	It("enables ASG metrics collection for managed nodegroups", func() {
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng"
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+		mng.ASGMetricsCollection = []api.MetricsCollection{{Granularity: "1Minute"}}
+
+		tasks := ctl.ClusterTasksForNodeGroups(cfg, false, false)
+		Expect(tasks.Describe()).To(ContainSubstring("enable ASG metrics collection for nodegroup mng"))
+	})
+
+	It("leaves ASG metrics collection of unmanaged nodegroups to their stack", func() {
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng"
+		ng.ASGMetricsCollection = []api.MetricsCollection{{Granularity: "1Minute"}}
+
+		tasks := ctl.ClusterTasksForNodeGroups(cfg, false, false)
+		Expect(tasks.Describe()).NotTo(ContainSubstring("enable ASG metrics collection"))
+	})
+})
+
+var _ = Describe("tasks without access to the Kubernetes API", func() {
+	var (
+		ctl *ClusterProvider
+		cfg *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		ctl = &ClusterProvider{
+			Provider: mockprovider.NewMockProvider(),
+			Status:   &ProviderStatus{},
+		}
+		ctl.DisableKubeAccess("cluster.yaml")
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster"
+		cfg.Metadata.Region = "us-west-2"
+	})
+
+	deferredDescriptions := func() []string {
+		var descriptions []string
+		for _, step := range ctl.DeferredKubeSteps() {
+			descriptions = append(descriptions, step.Description)
+		}
+		return descriptions
+	}
+
+	It("refuses to create Kubernetes clients", func() {
+		_, err := ctl.NewStdClientSet(cfg)
+		Expect(err).To(MatchError(ContainSubstring(ErrNoKubeAccess.Error())))
+	})
+
+	It("defers device plugins", func() {
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng"
+		ng.EFAEnabled = api.Enabled()
+
+		tasks := ctl.ClusterTasksForNodeGroups(cfg, true, true)
+		Expect(tasks.Len()).To(BeZero())
+		Expect(deferredDescriptions()).To(ConsistOf(ContainSubstring("install the EFA Kubernetes device plugin")))
+	})
+
+	It("creates only the IAM roles of role-only service accounts and defers the others", func() {
+		cfg.IAM.WithOIDC = api.Enabled()
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{
+			{ClusterIAMMeta: api.ClusterIAMMeta{Name: "s3-reader", Namespace: "default"}, RoleOnly: api.Enabled()},
+			{ClusterIAMMeta: api.ClusterIAMMeta{Name: "cluster-autoscaler", Namespace: "kube-system"}},
+		}
+		ng := cfg.NewNodeGroup()
+		ng.Name = "windows"
+		ng.AMIFamily = api.NodeImageFamilyWindowsServer2019FullContainer
+
+		tasks := ctl.CreateExtraClusterConfigTasks(context.Background(), cfg)
+		Expect(tasks.Describe()).To(ContainSubstring(`create IAM role for serviceaccount "default/s3-reader"`))
+		Expect(tasks.Describe()).NotTo(ContainSubstring("cluster-autoscaler"))
+		Expect(tasks.Describe()).NotTo(ContainSubstring("restart daemonset"))
+		Expect(tasks.Describe()).NotTo(ContainSubstring("Windows"))
+
+		Expect(ctl.DeferredKubeSteps()).To(ConsistOf(
+			DeferredKubeStep{
+				Description: "create IAM role for service account kube-system/aws-node",
+				Command:     "eksctl create iamserviceaccount --cluster=cluster --region=us-west-2 --namespace=kube-system --name=aws-node --attach-policy-arn=arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy --override-existing-serviceaccounts --approve",
+			},
+			DeferredKubeStep{
+				Description: "create IAM service accounts kube-system/cluster-autoscaler",
+				Command:     "eksctl create iamserviceaccount --config-file=cluster.yaml --include=kube-system/cluster-autoscaler --override-existing-serviceaccounts --approve",
+			},
+			DeferredKubeStep{
+				Description: `restart daemonset "kube-system/aws-node"`,
+				Command:     "kubectl rollout restart daemonset/aws-node --namespace=kube-system",
+			},
+			HaveField("Description", "enable Windows IP address management"),
+		))
+	})
+})
@@ -0,0 +1,273 @@
+package eks
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// mergeKey is the YAML key that merges the fields of an anchored mapping into another mapping
+const mergeKey = "<<"
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+	// unmarshalerShapes are the shapes of the fields of types that implement json.Unmarshaler, so that their
+	// fields are checked too
+	unmarshalerShapes = map[reflect.Type]reflect.Type{
+		reflect.TypeOf(api.AZSubnetMapping{}): reflect.TypeOf(map[string]api.AZSubnetSpec{}),
+		reflect.TypeOf(api.IdentityProvider{}): reflect.TypeOf(struct {
+			api.OIDCIdentityProvider
+			Type string `json:"type"`
+		}{}),
+	}
+)
+
+// UnknownField is a field of a config file that doesn't exist in the ClusterConfig
+type UnknownField struct {
+	// Name is the name of the field
+	Name string
+	// Parent is the path of the object the field was found in, e.g. nodeGroups[0].iam
+	Parent string
+	// Line and Column are the position of the field in the config file
+	Line, Column int
+	// Suggestion is the name of a known field close to Name, if any
+	Suggestion string
+}
+
+func (f UnknownField) String() string {
+	msg := fmt.Sprintf("line %d, column %d: unknown field %q", f.Line, f.Column, f.Name)
+	if f.Parent != "" {
+		msg = fmt.Sprintf("%s in %s", msg, f.Parent)
+	}
+	if f.Suggestion != "" {
+		msg = fmt.Sprintf("%s, did you mean %q?", msg, f.Suggestion)
+	}
+	return msg
+}
+
+// UnknownFieldsError is returned when a config file has fields that don't exist in the ClusterConfig
+type UnknownFieldsError struct {
+	Fields []UnknownField
+}
+
+func (e *UnknownFieldsError) Error() string {
+	if len(e.Fields) == 1 {
+		return e.Fields[0].String()
+	}
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.String()
+	}
+	return fmt.Sprintf("%d unknown fields:\n%s", len(e.Fields), strings.Join(msgs, "\n"))
+}
+
+// checkUnknownFields returns an UnknownFieldsError with the position of the fields of data that don't exist in obj;
+// unlike the strict decoding of sigs.k8s.io/yaml, it reports every unknown field with its line and column, and
+// checks the fields of types that implement json.Unmarshaler. Other errors are left to the decoder.
+func checkUnknownFields(data []byte, obj interface{}) error {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &doc); err != nil || doc.Kind != yamlv3.DocumentNode || len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+	if root.Kind == yamlv3.MappingNode {
+		// extension fields hold the anchors shared by other parts of the config file
+		root = withoutExtensionFields(root)
+	}
+	var unknownFields []UnknownField
+	checkNodeFields(root, reflect.TypeOf(obj), "", &unknownFields)
+	if len(unknownFields) == 0 {
+		return nil
+	}
+	return &UnknownFieldsError{Fields: unknownFields}
+}
+
+func withoutExtensionFields(node *yamlv3.Node) *yamlv3.Node {
+	filtered := *node
+	filtered.Content = nil
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if !strings.HasPrefix(node.Content[i].Value, extensionFieldPrefix) {
+			filtered.Content = append(filtered.Content, node.Content[i], node.Content[i+1])
+		}
+	}
+	return &filtered
+}
+
+func checkNodeFields(node *yamlv3.Node, t reflect.Type, path string, unknownFields *[]UnknownField) {
+	if node.Kind == yamlv3.AliasNode {
+		node = node.Alias
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if shape, ok := unmarshalerShapes[t]; ok {
+		t = shape
+	} else if t.Implements(jsonUnmarshalerType) || reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if node.Kind != yamlv3.MappingNode {
+			return
+		}
+		fields := jsonFields(t)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == mergeKey {
+				for _, merged := range mergedNodes(value) {
+					checkNodeFields(merged, t, path, unknownFields)
+				}
+				continue
+			}
+			field, ok := fields[strings.ToLower(key.Value)]
+			if !ok {
+				*unknownFields = append(*unknownFields, UnknownField{
+					Name:       key.Value,
+					Parent:     path,
+					Line:       key.Line,
+					Column:     key.Column,
+					Suggestion: suggestField(key.Value, fields),
+				})
+				continue
+			}
+			checkNodeFields(value, field.Type, joinPath(path, field.Name), unknownFields)
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yamlv3.SequenceNode {
+			return
+		}
+		for i, item := range node.Content {
+			checkNodeFields(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i), unknownFields)
+		}
+	case reflect.Map:
+		if node.Kind != yamlv3.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			checkNodeFields(node.Content[i+1], t.Elem(), joinPath(path, node.Content[i].Value), unknownFields)
+		}
+	}
+}
+
+func mergedNodes(value *yamlv3.Node) []*yamlv3.Node {
+	if value.Kind == yamlv3.SequenceNode {
+		return value.Content
+	}
+	return []*yamlv3.Node{value}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+type jsonField struct {
+	Name string
+	Type reflect.Type
+}
+
+// jsonFields returns the fields of a struct keyed by their lowercase JSON name, as encoding/json matches them
+// case-insensitively
+func jsonFields(t reflect.Type) map[string]jsonField {
+	fields := map[string]jsonField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		fieldType := f.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if f.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for key, embedded := range jsonFields(fieldType) {
+				if _, ok := fields[key]; !ok {
+					fields[key] = embedded
+				}
+			}
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = jsonField{Name: name, Type: f.Type}
+	}
+	return fields
+}
+
+// suggestField returns the known field closest to name, if it's close enough to be a typo
+func suggestField(name string, fields map[string]jsonField) string {
+	var names []string
+	for _, f := range fields {
+		names = append(names, f.Name)
+	}
+	// iterate in a stable order so that ties are always broken the same way
+	sort.Strings(names)
+
+	suggestion, bestDistance := "", -1
+	for _, candidate := range names {
+		distance := editDistance(strings.ToLower(name), strings.ToLower(candidate))
+		if bestDistance == -1 || distance < bestDistance {
+			suggestion, bestDistance = candidate, distance
+		}
+	}
+	maxDistance := len(name) / 3
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+	if bestDistance == -1 || bestDistance > maxDistance {
+		return ""
+	}
+	return suggestion
+}
+
+// editDistance returns the number of insertions, deletions, substitutions and transpositions of adjacent
+// characters needed to turn a into b
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
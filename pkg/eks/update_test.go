@@ -1,8 +1,11 @@
 package eks_test
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -22,7 +25,7 @@ var _ = Describe("EKS API wrapper", func() {
 			cfg *api.ClusterConfig
 			err error
 
-			sentClusterLogging []*awseks.LogSetup
+			sentClusterLogging []ekstypes.LogSetup
 		)
 
 		BeforeEach(func() {
@@ -35,34 +38,34 @@ var _ = Describe("EKS API wrapper", func() {
 			cfg = api.NewClusterConfig()
 
 			updateClusterConfigOutput := &awseks.UpdateClusterConfigOutput{
-				Update: &awseks.Update{
+				Update: &ekstypes.Update{
 					Id:   aws.String("u123"),
-					Type: aws.String(awseks.UpdateTypeLoggingUpdate),
+					Type: ekstypes.UpdateTypeLoggingUpdate,
 				},
 			}
 
 			describeClusterOutput := &awseks.DescribeClusterOutput{
-				Cluster: testutils.NewFakeCluster("testcluster", awseks.ClusterStatusActive),
+				Cluster: testutils.NewFakeCluster("testcluster", ekstypes.ClusterStatusActive),
 			}
 
-			describeClusterOutput.Cluster.Logging = &awseks.Logging{
-				ClusterLogging: []*awseks.LogSetup{
+			describeClusterOutput.Cluster.Logging = &ekstypes.Logging{
+				ClusterLogging: []ekstypes.LogSetup{
 					{
 						Enabled: api.Enabled(),
-						Types:   aws.StringSlice([]string{"api", "audit"}),
+						Types:   []ekstypes.LogType{ekstypes.LogTypeApi, ekstypes.LogTypeAudit},
 					},
 					{
 						Enabled: api.Disabled(),
-						Types:   aws.StringSlice([]string{"controllerManager", "authenticator", "scheduler"}),
+						Types:   []ekstypes.LogType{ekstypes.LogTypeControllerManager, ekstypes.LogTypeAuthenticator, ekstypes.LogTypeScheduler},
 					},
 				},
 			}
 
-			p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
 				return true
 			})).Return(describeClusterOutput, nil)
 
-			p.MockEKS().On("UpdateClusterConfig", mock.MatchedBy(func(input *awseks.UpdateClusterConfigInput) bool {
+			p.MockEKS().On("UpdateClusterConfig", mock.Anything, mock.MatchedBy(func(input *awseks.UpdateClusterConfigInput) bool {
 				Expect(input.Logging).NotTo(BeNil())
 
 				Expect(input.Logging.ClusterLogging[0].Enabled).NotTo(BeNil())
@@ -76,24 +79,21 @@ var _ = Describe("EKS API wrapper", func() {
 				return true
 			})).Return(updateClusterConfigOutput, nil)
 
-			describeUpdateInput := &awseks.DescribeUpdateInput{}
-
 			describeUpdateOutput := &awseks.DescribeUpdateOutput{
-				Update: &awseks.Update{
+				Update: &ekstypes.Update{
 					Id:     aws.String("u123"),
-					Type:   aws.String(awseks.UpdateTypeLoggingUpdate),
-					Status: aws.String(awseks.UpdateStatusSuccessful),
+					Type:   ekstypes.UpdateTypeLoggingUpdate,
+					Status: ekstypes.UpdateStatusSuccessful,
 				},
 			}
 
-			p.MockEKS().On("DescribeUpdateRequest", mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
-				*describeUpdateInput = *input
-				return true
-			})).Return(p.Client.MockRequestForGivenOutput(describeUpdateInput, describeUpdateOutput), describeUpdateOutput)
+			p.MockEKS().On("DescribeUpdate", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
+				return *input.UpdateId == "u123"
+			})).Return(describeUpdateOutput, nil)
 		})
 
 		It("should get current config", func() {
-			enabled, disabled, err := ctl.GetCurrentClusterConfigForLogging(cfg)
+			enabled, disabled, err := ctl.GetCurrentClusterConfigForLogging(context.Background(), cfg)
 			Expect(err).NotTo(HaveOccurred())
 
 			enabled.HasAll("api", "audit")
@@ -109,13 +109,13 @@ var _ = Describe("EKS API wrapper", func() {
 
 			Expect(cfg.CloudWatch.ClusterLogging.EnableTypes).To(BeEmpty())
 
-			err = ctl.UpdateClusterConfigForLogging(cfg)
+			err = ctl.UpdateClusterConfigForLogging(context.Background(), cfg)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(sentClusterLogging[0].Types).To(BeEmpty())
 
 			Expect(sentClusterLogging[1].Types).NotTo(BeEmpty())
-			Expect(sentClusterLogging[1].Types).To(Equal(aws.StringSlice(api.SupportedCloudWatchClusterLogTypes())))
+			Expect(sentClusterLogging[1].Types).To(Equal(logTypes(api.SupportedCloudWatchClusterLogTypes())))
 		})
 
 		It("should expand `['*']` to all", func() {
@@ -127,11 +127,11 @@ var _ = Describe("EKS API wrapper", func() {
 
 			Expect(cfg.CloudWatch.ClusterLogging.EnableTypes).To(Equal(api.SupportedCloudWatchClusterLogTypes()))
 
-			err = ctl.UpdateClusterConfigForLogging(cfg)
+			err = ctl.UpdateClusterConfigForLogging(context.Background(), cfg)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(sentClusterLogging[0].Types).NotTo(BeEmpty())
-			Expect(sentClusterLogging[0].Types).To(Equal(aws.StringSlice(cfg.CloudWatch.ClusterLogging.EnableTypes)))
+			Expect(sentClusterLogging[0].Types).To(Equal(logTypes(cfg.CloudWatch.ClusterLogging.EnableTypes)))
 
 			Expect(sentClusterLogging[1].Types).To(BeEmpty())
 		})
@@ -145,14 +145,22 @@ var _ = Describe("EKS API wrapper", func() {
 
 			Expect(cfg.CloudWatch.ClusterLogging.EnableTypes).To(Equal([]string{"authenticator", "controllerManager"}))
 
-			err = ctl.UpdateClusterConfigForLogging(cfg)
+			err = ctl.UpdateClusterConfigForLogging(context.Background(), cfg)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(sentClusterLogging[0].Types).NotTo(BeEmpty())
-			Expect(sentClusterLogging[0].Types).To(Equal(aws.StringSlice(cfg.CloudWatch.ClusterLogging.EnableTypes)))
+			Expect(sentClusterLogging[0].Types).To(Equal(logTypes(cfg.CloudWatch.ClusterLogging.EnableTypes)))
 
 			Expect(sentClusterLogging[1].Types).NotTo(BeEmpty())
-			Expect(sentClusterLogging[1].Types).To(Equal(aws.StringSlice([]string{"api", "audit", "scheduler"})))
+			Expect(sentClusterLogging[1].Types).To(Equal([]ekstypes.LogType{ekstypes.LogTypeApi, ekstypes.LogTypeAudit, ekstypes.LogTypeScheduler}))
 		})
 	})
 })
+
+func logTypes(types []string) []ekstypes.LogType {
+	var out []ekstypes.LogType
+	for _, t := range types {
+		out = append(out, ekstypes.LogType(t))
+	}
+	return out
+}
@@ -0,0 +1,148 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// ipv4PrefixSize is the number of IP addresses in each prefix assigned by the VPC CNI
+	ipv4PrefixSize = 16
+	// the maximum number of pods recommended by EKS for instances with less than
+	// largeInstanceVCPUs vCPUs and for larger instances respectively
+	maxPodsSmallInstance = 110
+	maxPodsLargeInstance = 250
+	largeInstanceVCPUs   = 30
+)
+
+// ApplyVPCCNIConfig updates nodegroups according to the VPC CNI configuration. With prefix delegation, nodegroups that
+// do not set `maxPodsPerNode` use the maximum number of pods their instance types support. With security groups for pods,
+// nodegroups whose instance types support trunk network interfaces are labelled with api.SecurityGroupsForPodsLabel
+func (m *NodeGroupService) ApplyVPCCNIConfig(ctx context.Context, nodePools []api.NodePool, clusterConfig *api.ClusterConfig) error {
+	prefixDelegation, podENI := clusterConfig.HasPrefixDelegation(), clusterConfig.HasPodENI()
+	if !prefixDelegation && !podENI {
+		return nil
+	}
+
+	for _, np := range nodePools {
+		ng := np.BaseNodeGroup()
+		setMaxPods := prefixDelegation && ng.MaxPodsPerNode == 0
+		if api.IsWindowsImage(ng.AMIFamily) || (!setMaxPods && !podENI) {
+			continue
+		}
+
+		var instanceTypes []string
+		switch ng := np.(type) {
+		case *api.NodeGroup:
+			instanceTypes = ng.InstanceTypeList()
+		case *api.ManagedNodeGroup:
+			instanceTypes = ng.InstanceTypeList()
+		}
+		if len(instanceTypes) == 0 {
+			continue
+		}
+
+		instanceTypeInfos, err := m.describeInstanceTypes(ctx, instanceTypes)
+		if err != nil {
+			return errors.Wrapf(err, "applying VPC CNI configuration to nodegroup %q", ng.Name)
+		}
+
+		if setMaxPods {
+			if maxPods := maxPodsWithPrefixDelegation(instanceTypeInfos); maxPods > 0 {
+				logger.Info("nodegroup %q will use maxPodsPerNode=%d as prefix delegation is enabled", ng.Name, maxPods)
+				ng.MaxPodsPerNode = maxPods
+			} else {
+				logger.Warning("instance types %v of nodegroup %q do not all support prefix delegation, maxPodsPerNode will not be changed", instanceTypes, ng.Name)
+			}
+		}
+
+		if podENI {
+			if supportsTrunkENI(instanceTypeInfos) {
+				if ng.Labels == nil {
+					ng.Labels = map[string]string{}
+				}
+				ng.Labels[api.SecurityGroupsForPodsLabel] = "true"
+			} else {
+				logger.Warning("instance types %v of nodegroup %q do not all support security groups for pods, pods with security groups will not be scheduled on it", instanceTypes, ng.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *NodeGroupService) describeInstanceTypes(ctx context.Context, instanceTypes []string) ([]ec2types.InstanceTypeInfo, error) {
+	var instanceTypeList []ec2types.InstanceType
+	for _, it := range instanceTypes {
+		instanceTypeList = append(instanceTypeList, ec2types.InstanceType(it))
+	}
+	output, err := m.Provider.EC2().DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: instanceTypeList,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't retrieve instance type description for %v", instanceTypes)
+	}
+
+	described := map[string]bool{}
+	for _, it := range output.InstanceTypes {
+		described[string(it.InstanceType)] = true
+	}
+	var missing []string
+	for _, it := range instanceTypes {
+		if !described[it] {
+			missing = append(missing, it)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("instance types %v were not found in region %q", missing, m.Provider.Region())
+	}
+	return output.InstanceTypes, nil
+}
+
+// maxPodsWithPrefixDelegation returns the lowest maximum number of pods of instanceTypes, or 0 if
+// an instance type does not support prefix delegation
+func maxPodsWithPrefixDelegation(instanceTypes []ec2types.InstanceTypeInfo) int {
+	maxPods := 0
+	for _, it := range instanceTypes {
+		// prefixes can only be assigned to Nitro instances
+		if it.Hypervisor != ec2types.InstanceTypeHypervisorNitro || it.NetworkInfo == nil || it.VCpuInfo == nil {
+			return 0
+		}
+		enis := int(aws.ToInt32(it.NetworkInfo.MaximumNetworkInterfaces))
+		ipsPerENI := int(aws.ToInt32(it.NetworkInfo.Ipv4AddressesPerInterface))
+		// the primary IP address of each ENI is not available to pods, and pods using the host network
+		// (aws-node and kube-proxy) do not consume an IP address
+		instanceMaxPods := enis*(ipsPerENI-1)*ipv4PrefixSize + 2
+
+		limit := maxPodsSmallInstance
+		if int(aws.ToInt32(it.VCpuInfo.DefaultVCpus)) >= largeInstanceVCPUs {
+			limit = maxPodsLargeInstance
+		}
+		if instanceMaxPods > limit {
+			instanceMaxPods = limit
+		}
+		if maxPods == 0 || instanceMaxPods < maxPods {
+			maxPods = instanceMaxPods
+		}
+	}
+	return maxPods
+}
+
+// supportsTrunkENI returns whether all instanceTypes support the trunk network interface used by
+// security groups for pods, which is available on Nitro instances except for the burstable t family
+func supportsTrunkENI(instanceTypes []ec2types.InstanceTypeInfo) bool {
+	for _, it := range instanceTypes {
+		if it.Hypervisor != ec2types.InstanceTypeHypervisorNitro || strings.HasPrefix(string(it.InstanceType), "t") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,125 @@
+package eks_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("VPC CNI configuration", func() {
+	var (
+		provider         *mockprovider.MockProvider
+		nodeGroupService *eks.NodeGroupService
+		cfg              *api.ClusterConfig
+		ng               *api.NodeGroup
+		mng              *api.ManagedNodeGroup
+	)
+
+	instanceTypeInfo := func(instanceType string, hypervisor ec2types.InstanceTypeHypervisor, vCPUs, enis, ipsPerENI int32) ec2types.InstanceTypeInfo {
+		return ec2types.InstanceTypeInfo{
+			InstanceType: ec2types.InstanceType(instanceType),
+			Hypervisor:   hypervisor,
+			VCpuInfo:     &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(vCPUs)},
+			NetworkInfo: &ec2types.NetworkInfo{
+				MaximumNetworkInterfaces:  aws.Int32(enis),
+				Ipv4AddressesPerInterface: aws.Int32(ipsPerENI),
+			},
+		}
+	}
+
+	mockDescribeInstanceTypes := func(infos ...ec2types.InstanceTypeInfo) {
+		provider.MockEC2().On("DescribeInstanceTypes", mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: infos,
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+		nodeGroupService = eks.NewNodeGroupService(provider, nil)
+		cfg = api.NewClusterConfig()
+		cfg.VPCCNI = &api.VPCCNI{PrefixDelegation: true}
+		ng = api.NewNodeGroup()
+		ng.Name = "ng"
+		ng.InstanceType = "m5.large"
+		mng = api.NewManagedNodeGroup()
+		mng.Name = "mng"
+		mng.InstanceType = "m5.24xlarge"
+	})
+
+	It("sets maxPodsPerNode to the number of pods supported by the instance type", func() {
+		mockDescribeInstanceTypes(instanceTypeInfo("m5.large", ec2types.InstanceTypeHypervisorNitro, 2, 3, 10))
+
+		Expect(nodeGroupService.ApplyVPCCNIConfig(context.Background(), []api.NodePool{ng}, cfg)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(Equal(110))
+	})
+
+	It("uses the higher limit for instances with at least 30 vCPUs", func() {
+		mockDescribeInstanceTypes(instanceTypeInfo("m5.24xlarge", ec2types.InstanceTypeHypervisorNitro, 96, 15, 50))
+
+		Expect(nodeGroupService.ApplyVPCCNIConfig(context.Background(), []api.NodePool{mng}, cfg)).To(Succeed())
+		Expect(mng.MaxPodsPerNode).To(Equal(250))
+	})
+
+	It("uses the lowest number of pods across instance types", func() {
+		mockDescribeInstanceTypes(
+			instanceTypeInfo("t3.nano", ec2types.InstanceTypeHypervisorNitro, 2, 2, 2),
+			instanceTypeInfo("m5.large", ec2types.InstanceTypeHypervisorNitro, 2, 3, 10),
+		)
+
+		Expect(nodeGroupService.ApplyVPCCNIConfig(context.Background(), []api.NodePool{ng}, cfg)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(Equal(34))
+	})
+
+	It("does not change maxPodsPerNode for instance types that do not support prefixes", func() {
+		ng.InstanceType = "m4.large"
+		mockDescribeInstanceTypes(instanceTypeInfo("m4.large", ec2types.InstanceTypeHypervisorXen, 2, 2, 10))
+
+		Expect(nodeGroupService.ApplyVPCCNIConfig(context.Background(), []api.NodePool{ng}, cfg)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(BeZero())
+	})
+
+	It("returns an error naming instance types that were not found", func() {
+		mockDescribeInstanceTypes()
+
+		err := nodeGroupService.ApplyVPCCNIConfig(context.Background(), []api.NodePool{ng}, cfg)
+		Expect(err).To(MatchError(ContainSubstring("instance types [m5.large] were not found")))
+		Expect(ng.MaxPodsPerNode).To(BeZero())
+	})
+
+	It("does not change maxPodsPerNode when it is set or prefix delegation is disabled", func() {
+		ng.MaxPodsPerNode = 20
+		Expect(nodeGroupService.ApplyVPCCNIConfig(context.Background(), []api.NodePool{ng, mng}, api.NewClusterConfig())).To(Succeed())
+		Expect(nodeGroupService.ApplyVPCCNIConfig(context.Background(), []api.NodePool{ng}, cfg)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(Equal(20))
+		Expect(mng.MaxPodsPerNode).To(BeZero())
+		provider.MockEC2().AssertNotCalled(GinkgoT(), "DescribeInstanceTypes", mock.Anything, mock.Anything)
+	})
+
+	It("labels nodegroups that support security groups for pods", func() {
+		cfg.VPCCNI = &api.VPCCNI{PodENI: true}
+		ng.Labels = nil
+		ng.InstanceType = "t3.large"
+		provider.MockEC2().On("DescribeInstanceTypes", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeInstanceTypesInput) bool {
+			return input.InstanceTypes[0] == "t3.large"
+		})).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []ec2types.InstanceTypeInfo{instanceTypeInfo("t3.large", ec2types.InstanceTypeHypervisorNitro, 2, 3, 12)},
+		}, nil)
+		provider.MockEC2().On("DescribeInstanceTypes", mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []ec2types.InstanceTypeInfo{instanceTypeInfo("m5.24xlarge", ec2types.InstanceTypeHypervisorNitro, 96, 15, 50)},
+		}, nil)
+
+		Expect(nodeGroupService.ApplyVPCCNIConfig(context.Background(), []api.NodePool{ng, mng}, cfg)).To(Succeed())
+		Expect(ng.Labels).NotTo(HaveKey(api.SecurityGroupsForPodsLabel))
+		Expect(mng.Labels).To(HaveKeyWithValue(api.SecurityGroupsForPodsLabel, "true"))
+		Expect(mng.MaxPodsPerNode).To(BeZero())
+	})
+})
@@ -0,0 +1,96 @@
+package eks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// extensionFieldPrefix is the prefix of top-level fields that are ignored so that they can hold the anchors
+// shared by other parts of a config file, e.g. `x-nodegroup-defaults: &defaults`
+const extensionFieldPrefix = "x-"
+
+// expandYAMLAliases resolves the anchors, aliases and merge keys (`<<: *anchor`) of a config file and drops its
+// extension fields, so that the strict decoding of the ClusterConfig only sees plain values; data without anchors
+// or extension fields is returned as is, to keep the line numbers of decoding errors
+func expandYAMLAliases(data []byte) ([]byte, error) {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &doc); err != nil {
+		// leave reporting syntax errors to the decoder
+		return data, nil
+	}
+	if doc.Kind != yamlv3.DocumentNode || len(doc.Content) == 0 {
+		return data, nil
+	}
+	root := doc.Content[0]
+	hasExtensionFields := removeExtensionFields(root)
+	if !hasExtensionFields && !usesAnchors(root) {
+		return data, nil
+	}
+
+	var value interface{}
+	if err := root.Decode(&value); err != nil {
+		return nil, errors.Wrap(err, "expanding YAML anchors")
+	}
+	expanded, err := json.Marshal(stringifyKeys(value))
+	if err != nil {
+		return nil, errors.Wrap(err, "expanding YAML anchors")
+	}
+	return expanded, nil
+}
+
+func removeExtensionFields(root *yamlv3.Node) bool {
+	if root.Kind != yamlv3.MappingNode {
+		return false
+	}
+	removed := false
+	var content []*yamlv3.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if strings.HasPrefix(root.Content[i].Value, extensionFieldPrefix) {
+			removed = true
+			continue
+		}
+		content = append(content, root.Content[i], root.Content[i+1])
+	}
+	root.Content = content
+	return removed
+}
+
+func usesAnchors(node *yamlv3.Node) bool {
+	if node.Anchor != "" || node.Kind == yamlv3.AliasNode {
+		return true
+	}
+	for _, child := range node.Content {
+		if usesAnchors(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// stringifyKeys converts the maps with non-string keys, e.g. numeric labels, that JSON cannot encode
+func stringifyKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = stringifyKeys(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = stringifyKeys(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = stringifyKeys(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
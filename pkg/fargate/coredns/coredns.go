@@ -27,6 +27,11 @@ const (
 	// scheduling.
 	ComputeTypeAnnotationKey = "eks.amazonaws.com/compute-type"
 	computeTypeFargate       = "fargate"
+
+	// ScheduleOnFargateCommand is the kubectl command that makes CoreDNS
+	// schedulable onto Fargate, like ScheduleOnFargate does.
+	ScheduleOnFargateCommand = "kubectl patch deployment/" + Name + " --namespace=" + Namespace +
+		` --type=merge --patch='{"spec":{"template":{"metadata":{"annotations":{"` + ComputeTypeAnnotationKey + `":"` + computeTypeFargate + `"}}}}}'`
 )
 
 // IsSchedulableOnFargate analyzes the provided profiles to determine whether
@@ -1,10 +1,12 @@
 package fargate
 
 import (
+	"context"
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -48,18 +50,18 @@ func (o CreateOptions) ToFargateProfile() *api.FargateProfile {
 }
 
 // CreateProfile creates the provided Fargate profile.
-func (c *Client) CreateProfile(profile *api.FargateProfile, waitForCreation bool) error {
+func (c *Client) CreateProfile(ctx context.Context, profile *api.FargateProfile, waitForCreation bool) error {
 	if profile == nil {
 		return errors.New("invalid Fargate profile: nil")
 	}
 	logger.Debug("Fargate profile: create request input: %#v", profile)
-	out, err := c.api.CreateFargateProfile(createRequest(c.clusterName, profile))
+	out, err := c.api.CreateFargateProfile(ctx, createRequest(c.clusterName, profile))
 	logger.Debug("Fargate profile: create request: received: %#v", out)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create Fargate profile %q", profile.Name)
 	}
 	if waitForCreation {
-		return c.waitForCreation(profile.Name)
+		return c.waitForCreation(ctx, profile.Name)
 	}
 	return nil
 }
@@ -68,20 +70,20 @@ func createRequest(clusterName string, profile *api.FargateProfile) *eks.CreateF
 	request := &eks.CreateFargateProfileInput{
 		ClusterName:         &clusterName,
 		FargateProfileName:  &profile.Name,
-		Selectors:           toSelectorPointers(profile.Selectors),
+		Selectors:           toEKSSelectors(profile.Selectors),
 		PodExecutionRoleArn: strings.NilIfEmpty(profile.PodExecutionRoleARN),
-		Subnets:             strings.NilPointersArrayIfEmpty(strings.ToPointersArray(profile.Subnets)),
-		Tags:                strings.NilPointersMapIfEmpty(strings.ToPointersMap(profile.Tags)),
+		Subnets:             nilIfEmpty(profile.Subnets),
+		Tags:                nilMapIfEmpty(profile.Tags),
 	}
 	logger.Debug("Fargate profile: create request: sending: %#v", request)
 	return request
 }
 
-func (c *Client) waitForCreation(name string) error {
+func (c *Client) waitForCreation(ctx context.Context, name string) error {
 	// Clone this client's policy to ensure this method is re-entrant/thread-safe:
 	retryPolicy := c.retryPolicy.Clone()
 	for !retryPolicy.Done() {
-		out, err := c.api.DescribeFargateProfile(describeRequest(c.clusterName, name))
+		out, err := c.api.DescribeFargateProfile(ctx, describeRequest(c.clusterName, name))
 		if err != nil {
 			return errors.Wrapf(err, "failed while waiting for Fargate profile %q's creation", name)
 		}
@@ -97,17 +99,30 @@ func (c *Client) waitForCreation(name string) error {
 func created(out *eks.DescribeFargateProfileOutput) bool {
 	return out != nil &&
 		out.FargateProfile != nil &&
-		out.FargateProfile.Status != nil &&
-		*out.FargateProfile.Status == eks.FargateProfileStatusActive
+		out.FargateProfile.Status == ekstypes.FargateProfileStatusActive
 }
 
-func toSelectorPointers(in []api.FargateProfileSelector) []*eks.FargateProfileSelector {
-	out := make([]*eks.FargateProfileSelector, len(in))
+func toEKSSelectors(in []api.FargateProfileSelector) []ekstypes.FargateProfileSelector {
+	out := make([]ekstypes.FargateProfileSelector, len(in))
 	for i, selector := range in {
-		out[i] = &eks.FargateProfileSelector{
+		out[i] = ekstypes.FargateProfileSelector{
 			Namespace: strings.Pointer(selector.Namespace),
-			Labels:    strings.NilPointersMapIfEmpty(strings.ToPointersMap(selector.Labels)),
+			Labels:    nilMapIfEmpty(selector.Labels),
 		}
 	}
 	return out
 }
+
+func nilIfEmpty(in []string) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	return in
+}
+
+func nilMapIfEmpty(in map[string]string) map[string]string {
+	if len(in) == 0 {
+		return nil
+	}
+	return in
+}
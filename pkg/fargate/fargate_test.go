@@ -5,17 +5,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
-	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
 	"github.com/weaveworks/eksctl/pkg/fargate"
 	"github.com/weaveworks/eksctl/pkg/utils/retry"
 	"github.com/weaveworks/eksctl/pkg/utils/strings"
@@ -30,9 +32,9 @@ var _ = Describe("fargate", func() {
 		var neverCalledStackManager *manager.StackCollection
 		Describe("CreateProfile", func() {
 			It("fails fast if the provided profile is nil", func() {
-				client := fargate.NewWithRetryPolicy(clusterName, &mocks.EKSAPI{}, &retryPolicy, neverCalledStackManager)
+				client := fargate.NewWithRetryPolicy(clusterName, &mocksv2.EKS{}, &retryPolicy, neverCalledStackManager)
 				waitForCreation := false
-				err := client.CreateProfile(nil, waitForCreation)
+				err := client.CreateProfile(context.TODO(), nil, waitForCreation)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("invalid Fargate profile: nil"))
 			})
@@ -40,21 +42,21 @@ var _ = Describe("fargate", func() {
 			It("creates the provided profile without tag", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForCreateFargateProfileWithoutTag(), &retryPolicy, neverCalledStackManager)
 				waitForCreation := false
-				err := client.CreateProfile(createProfileWithoutTag(), waitForCreation)
+				err := client.CreateProfile(context.TODO(), createProfileWithoutTag(), waitForCreation)
 				Expect(err).To(Not(HaveOccurred()))
 			})
 
 			It("creates the provided profile", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForCreateFargateProfile(), &retryPolicy, neverCalledStackManager)
 				waitForCreation := false
-				err := client.CreateProfile(testFargateProfile(), waitForCreation)
+				err := client.CreateProfile(context.TODO(), testFargateProfile(), waitForCreation)
 				Expect(err).To(Not(HaveOccurred()))
 			})
 
 			It("fails by wrapping the root error with some additional context for clarity", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForFailureOnCreateFargateProfile(), &retryPolicy, neverCalledStackManager)
 				waitForCreation := false
-				err := client.CreateProfile(testFargateProfile(), waitForCreation)
+				err := client.CreateProfile(context.TODO(), testFargateProfile(), waitForCreation)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("failed to create Fargate profile \"default\": the Internet broke down"))
 			})
@@ -67,7 +69,7 @@ var _ = Describe("fargate", func() {
 				numRetriesAfterCreation := 3 // < MaxRetries
 				client := fargate.NewWithRetryPolicy(clusterName, mockForCreateFargateProfileWithWait(numRetriesAfterCreation), retryPolicy, nil)
 				waitForCreation := true
-				err := client.CreateProfile(testFargateProfile(), waitForCreation)
+				err := client.CreateProfile(context.TODO(), testFargateProfile(), waitForCreation)
 				Expect(err).To(Not(HaveOccurred()))
 			})
 
@@ -79,7 +81,7 @@ var _ = Describe("fargate", func() {
 				numRetriesAfterCreation := 5 // == MaxRetries, i.e. we will time out.
 				client := fargate.NewWithRetryPolicy(clusterName, mockForCreateFargateProfileWithWait(numRetriesAfterCreation), retryPolicy, nil)
 				waitForCreation := true
-				err := client.CreateProfile(testFargateProfile(), waitForCreation)
+				err := client.CreateProfile(context.TODO(), testFargateProfile(), waitForCreation)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("timed out while waiting for Fargate profile \"default\"'s creation"))
 			})
@@ -88,7 +90,7 @@ var _ = Describe("fargate", func() {
 		Describe("ReadProfiles", func() {
 			It("returns all Fargate profiles", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForReadProfiles(), &retryPolicy, neverCalledStackManager)
-				out, err := client.ReadProfiles()
+				out, err := client.ReadProfiles(context.TODO())
 				Expect(err).To(Not(HaveOccurred()))
 				Expect(out).To(Not(BeNil()))
 				Expect(out).To(HaveLen(2))
@@ -98,7 +100,7 @@ var _ = Describe("fargate", func() {
 
 			It("returns an empty array if no Fargate profile exists", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForEmptyReadProfiles(), &retryPolicy, neverCalledStackManager)
-				out, err := client.ReadProfiles()
+				out, err := client.ReadProfiles(context.TODO())
 				Expect(err).To(Not(HaveOccurred()))
 				Expect(out).To(Not(BeNil()))
 				Expect(out).To(HaveLen(0))
@@ -106,7 +108,7 @@ var _ = Describe("fargate", func() {
 
 			It("fails by wrapping the root error with some additional context for clarity", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForFailureOnReadProfiles(), &retryPolicy, neverCalledStackManager)
-				out, err := client.ReadProfiles()
+				out, err := client.ReadProfiles(context.TODO())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("failed to get Fargate profile(s) for cluster \"non-existing-test-cluster\": the Internet broke down"))
 				Expect(out).To(BeNil())
@@ -116,14 +118,14 @@ var _ = Describe("fargate", func() {
 		Describe("ReadProfile", func() {
 			It("returns the Fargate profile matching the provided name, if any", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForReadProfile(), &retryPolicy, neverCalledStackManager)
-				out, err := client.ReadProfile(testGreen)
+				out, err := client.ReadProfile(context.TODO(), testGreen)
 				Expect(err).To(Not(HaveOccurred()))
 				Expect(out).To(Equal(apiFargateProfile(testGreen)))
 			})
 
 			It("returns a 'not found' error if no Fargate profile matched the provided name", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForEmptyReadProfile(), &retryPolicy, neverCalledStackManager)
-				out, err := client.ReadProfile(testRed)
+				out, err := client.ReadProfile(context.TODO(), testRed)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("failed to get Fargate profile \"test-red\": ResourceNotFoundException: No Fargate Profile found with name: test-red"))
 				Expect(out).To(BeNil())
@@ -132,7 +134,7 @@ var _ = Describe("fargate", func() {
 
 		Describe("DeleteProfile", func() {
 			It("fails fast if the provided profile name is empty", func() {
-				client := fargate.NewWithRetryPolicy(clusterName, &mocks.EKSAPI{}, &retryPolicy, neverCalledStackManager)
+				client := fargate.NewWithRetryPolicy(clusterName, &mocksv2.EKS{}, &retryPolicy, neverCalledStackManager)
 				waitForDeletion := false
 				err := client.DeleteProfile(context.TODO(), "", waitForDeletion)
 				Expect(err).To(HaveOccurred())
@@ -202,20 +204,20 @@ var _ = Describe("fargate", func() {
 	})
 })
 
-func mockForCreateFargateProfile() *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
+func mockForCreateFargateProfile() *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
 	mockCreateFargateProfile(&mockClient)
 	return &mockClient
 }
 
-func mockForCreateFargateProfileWithoutTag() *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
+func mockForCreateFargateProfileWithoutTag() *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
 	mockCreateFargateProfileWithoutTag(&mockClient)
 	return &mockClient
 }
 
-func mockForCreateFargateProfileWithWait(numRetries int) *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
+func mockForCreateFargateProfileWithWait(numRetries int) *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
 	mockCreateFargateProfile(&mockClient)
 	// Simulate a couple calls to AWS' API before the profile actually gets created:
 	for i := 0; i < numRetries; i++ {
@@ -225,19 +227,19 @@ func mockForCreateFargateProfileWithWait(numRetries int) *mocks.EKSAPI {
 	return &mockClient
 }
 
-func mockCreateFargateProfile(mockClient *mocks.EKSAPI) {
-	mockClient.Mock.On("CreateFargateProfile", testCreateFargateProfileInput()).
+func mockCreateFargateProfile(mockClient *mocksv2.EKS) {
+	mockClient.Mock.On("CreateFargateProfile", mock.Anything, testCreateFargateProfileInput()).
 		Return(&eks.CreateFargateProfileOutput{}, nil)
 }
 
-func mockCreateFargateProfileWithoutTag(mockClient *mocks.EKSAPI) {
-	mockClient.Mock.On("CreateFargateProfile", createEksProfileWithoutTag()).
+func mockCreateFargateProfileWithoutTag(mockClient *mocksv2.EKS) {
+	mockClient.Mock.On("CreateFargateProfile", mock.Anything, createEksProfileWithoutTag()).
 		Return(&eks.CreateFargateProfileOutput{}, nil)
 }
 
-func mockForFailureOnCreateFargateProfile() *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
-	mockClient.Mock.On("CreateFargateProfile", testCreateFargateProfileInput()).
+func mockForFailureOnCreateFargateProfile() *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
+	mockClient.Mock.On("CreateFargateProfile", mock.Anything, testCreateFargateProfileInput()).
 		Return(nil, errors.New("the Internet broke down"))
 	return &mockClient
 }
@@ -280,17 +282,17 @@ func testCreateFargateProfileInput() *eks.CreateFargateProfileInput {
 	return &eks.CreateFargateProfileInput{
 		ClusterName:        strings.Pointer(clusterName),
 		FargateProfileName: strings.Pointer("default"),
-		Selectors: []*eks.FargateProfileSelector{
+		Selectors: []ekstypes.FargateProfileSelector{
 			{
 				Namespace: strings.Pointer("kube-system"),
-				Labels: map[string]*string{
-					"app": strings.Pointer("my-app"),
-					"env": strings.Pointer("test"),
+				Labels: map[string]string{
+					"app": "my-app",
+					"env": "test",
 				},
 			},
 		},
-		Tags: map[string]*string{
-			"env": strings.Pointer("test"),
+		Tags: map[string]string{
+			"env": "test",
 		},
 	}
 }
@@ -299,12 +301,12 @@ func createEksProfileWithoutTag() *eks.CreateFargateProfileInput {
 	return &eks.CreateFargateProfileInput{
 		ClusterName:        strings.Pointer(clusterName),
 		FargateProfileName: strings.Pointer("default"),
-		Selectors: []*eks.FargateProfileSelector{
+		Selectors: []ekstypes.FargateProfileSelector{
 			{
 				Namespace: strings.Pointer("kube-system"),
-				Labels: map[string]*string{
-					"app": strings.Pointer("my-app"),
-					"env": strings.Pointer("test"),
+				Labels: map[string]string{
+					"app": "my-app",
+					"env": "test",
 				},
 			},
 		},
@@ -317,34 +319,30 @@ const (
 	testRed   = "test-red"
 )
 
-func mockForReadProfiles() *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
+func mockForReadProfiles() *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
 	mockListFargateProfiles(&mockClient, testBlue, testGreen)
 	mockDescribeFargateProfile(&mockClient, testBlue, "ACTIVE")
 	mockDescribeFargateProfile(&mockClient, testGreen, "ACTIVE")
 	return &mockClient
 }
 
-func mockListFargateProfiles(mockClient *mocks.EKSAPI, names ...string) {
-	profileNames := make([]*string, len(names))
-	for i, name := range names {
-		profileNames[i] = strings.Pointer(name)
-	}
-	mockClient.Mock.On("ListFargateProfiles", &eks.ListFargateProfilesInput{
+func mockListFargateProfiles(mockClient *mocksv2.EKS, names ...string) {
+	mockClient.Mock.On("ListFargateProfiles", mock.Anything, &eks.ListFargateProfilesInput{
 		ClusterName: strings.Pointer(clusterName),
 	}).Once().Return(&eks.ListFargateProfilesOutput{
-		FargateProfileNames: profileNames,
+		FargateProfileNames: names,
 	}, nil)
 }
 
-func mockForReadProfile() *mocks.EKSAPI {
-	mockClient := &mocks.EKSAPI{}
+func mockForReadProfile() *mocksv2.EKS {
+	mockClient := &mocksv2.EKS{}
 	mockDescribeFargateProfile(mockClient, testGreen, "ACTIVE")
 	return mockClient
 }
 
-func mockDescribeFargateProfile(mockClient *mocks.EKSAPI, name, status string) {
-	mockClient.Mock.On("DescribeFargateProfile", &eks.DescribeFargateProfileInput{
+func mockDescribeFargateProfile(mockClient *mocksv2.EKS, name, status string) {
+	mockClient.Mock.On("DescribeFargateProfile", mock.Anything, &eks.DescribeFargateProfileInput{
 		ClusterName:        strings.Pointer(clusterName),
 		FargateProfileName: strings.Pointer(name),
 	}).Once().Return(&eks.DescribeFargateProfileOutput{
@@ -352,16 +350,16 @@ func mockDescribeFargateProfile(mockClient *mocks.EKSAPI, name, status string) {
 	}, nil)
 }
 
-func eksFargateProfile(name, status string) *eks.FargateProfile {
-	return &eks.FargateProfile{
+func eksFargateProfile(name, status string) *ekstypes.FargateProfile {
+	return &ekstypes.FargateProfile{
 		ClusterName:        strings.Pointer(clusterName),
 		FargateProfileName: strings.Pointer(name),
-		Selectors: []*eks.FargateProfileSelector{
+		Selectors: []ekstypes.FargateProfileSelector{
 			{
 				Namespace: strings.Pointer(name),
 			},
 		},
-		Status: strings.Pointer(status),
+		Status: ekstypes.FargateProfileStatus(status),
 	}
 }
 
@@ -380,45 +378,45 @@ func apiFargateProfile(name string) *api.FargateProfile {
 	}
 }
 
-func mockForEmptyReadProfiles() *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
+func mockForEmptyReadProfiles() *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
 	mockListFargateProfiles(&mockClient)
 	return &mockClient
 }
 
-func mockForEmptyReadProfile() *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
-	mockClient.Mock.On("DescribeFargateProfile", &eks.DescribeFargateProfileInput{
+func mockForEmptyReadProfile() *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
+	mockClient.Mock.On("DescribeFargateProfile", mock.Anything, &eks.DescribeFargateProfileInput{
 		ClusterName:        strings.Pointer(clusterName),
 		FargateProfileName: strings.Pointer(testRed),
 	}).Return(nil, fmt.Errorf("ResourceNotFoundException: No Fargate Profile found with name: %s", testRed))
 	return &mockClient
 }
 
-func mockForFailureOnReadProfiles() *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
-	mockClient.Mock.On("ListFargateProfiles", &eks.ListFargateProfilesInput{
+func mockForFailureOnReadProfiles() *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
+	mockClient.Mock.On("ListFargateProfiles", mock.Anything, &eks.ListFargateProfilesInput{
 		ClusterName: strings.Pointer(clusterName),
 	}).Return(nil, errors.New("the Internet broke down"))
 	return &mockClient
 }
 
-func mockForDeleteFargateProfile(name string) *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
+func mockForDeleteFargateProfile(name string) *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
 	mockDeleteFargateProfile(&mockClient, name)
 	mockListFargateProfiles(&mockClient, "default")
 	return &mockClient
 }
 
-func mockForDeleteFargateProfileWithoutAnyRemaining(name string) *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
+func mockForDeleteFargateProfileWithoutAnyRemaining(name string) *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
 	mockDeleteFargateProfile(&mockClient, name)
 	mockListFargateProfiles(&mockClient)
 	return &mockClient
 }
 
-func mockForDeleteFargateProfileWithWait(name string, numRetries int) *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
+func mockForDeleteFargateProfileWithWait(name string, numRetries int) *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
 	mockDeleteFargateProfile(&mockClient, name)
 	// Simulate a couple calls to AWS' API before the profile actually gets deleted:
 	for i := 0; i < numRetries; i++ {
@@ -429,21 +427,21 @@ func mockForDeleteFargateProfileWithWait(name string, numRetries int) *mocks.EKS
 	return &mockClient
 }
 
-func mockDeleteFargateProfile(mockClient *mocks.EKSAPI, name string) {
-	mockClient.Mock.On("DeleteFargateProfile", &eks.DeleteFargateProfileInput{
+func mockDeleteFargateProfile(mockClient *mocksv2.EKS, name string) {
+	mockClient.Mock.On("DeleteFargateProfile", mock.Anything, &eks.DeleteFargateProfileInput{
 		ClusterName:        strings.Pointer(clusterName),
 		FargateProfileName: &name,
 	}).Return(&eks.DeleteFargateProfileOutput{
-		FargateProfile: &eks.FargateProfile{
+		FargateProfile: &ekstypes.FargateProfile{
 			FargateProfileName: &name,
-			Status:             strings.Pointer("DELETING"),
+			Status:             ekstypes.FargateProfileStatusDeleting,
 		},
 	}, nil)
 }
 
-func mockForFailureOnDeleteFargateProfile(name string) *mocks.EKSAPI {
-	mockClient := mocks.EKSAPI{}
-	mockClient.Mock.On("DeleteFargateProfile", &eks.DeleteFargateProfileInput{
+func mockForFailureOnDeleteFargateProfile(name string) *mocksv2.EKS {
+	mockClient := mocksv2.EKS{}
+	mockClient.Mock.On("DeleteFargateProfile", mock.Anything, &eks.DeleteFargateProfileInput{
 		ClusterName:        strings.Pointer(clusterName),
 		FargateProfileName: &name,
 	}).Return(nil, errors.New("the Internet broke down"))
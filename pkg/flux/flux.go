@@ -10,6 +10,7 @@ import (
 	"github.com/kris-nova/logger"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/provenance"
 )
 
 const (
@@ -19,22 +20,30 @@ const (
 
 type Client struct {
 	executor executor.Executor
+	verifier *provenance.Verifier
 	opts     *api.Flux
 }
 
-func NewClient(opts *api.Flux) (*Client, error) {
+// NewClient creates a Client, the flux binary is verified against opts.BinaryVerification with the key of cosign
+func NewClient(opts *api.Flux, cosign *api.Cosign) (*Client, error) {
 	return &Client{
 		executor: executor.NewShellExecutor(executor.EnvVars{}),
+		verifier: provenance.NewVerifier(cosign),
 		opts:     opts,
 	}, nil
 }
 
 func (c *Client) PreFlight() error {
-	if _, err := exec.LookPath(fluxBin); err != nil {
+	binPath, err := exec.LookPath(fluxBin)
+	if err != nil {
 		logger.Warning(err.Error())
 		return errors.New("flux not found, required")
 	}
 
+	if err := c.verifier.Verify("the flux binary", binPath, c.opts.BinaryVerification); err != nil {
+		return err
+	}
+
 	if err := c.checkFluxVersion(); err != nil {
 		return err
 	}
@@ -28,7 +28,7 @@ var _ = Describe("Flux", func() {
 
 		fakeExecutor = new(fakes.FakeExecutor)
 		var err error
-		fluxClient, err = flux.NewClient(opts)
+		fluxClient, err = flux.NewClient(opts, nil)
 		Expect(err).NotTo(HaveOccurred())
 		fluxClient.SetExecutor(fakeExecutor)
 		fakeExecutor.ExecWithOutReturns([]byte("flux version 0.13.3\n"), nil)
@@ -79,6 +79,21 @@ var _ = Describe("Flux", func() {
 			})
 		})
 
+		When("the digest of the flux binary is pinned", func() {
+			It("runs a binary with a matching digest", func() {
+				// the fake binary is empty
+				opts.BinaryVerification = &api.ArtifactVerification{Digest: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}
+				Expect(fluxClient.PreFlight()).To(Succeed())
+				Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+			})
+
+			It("rejects a binary with another digest", func() {
+				opts.BinaryVerification = &api.ArtifactVerification{Digest: "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"}
+				Expect(fluxClient.PreFlight()).To(MatchError(ContainSubstring("the digest of the flux binary is sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")))
+				Expect(fakeExecutor.ExecCallCount()).To(BeZero())
+			})
+		})
+
 		When("the flux binary is not found on the path", func() {
 			BeforeEach(func() {
 				Expect(os.Unsetenv("PATH")).To(Succeed())
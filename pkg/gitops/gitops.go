@@ -21,7 +21,7 @@ type FluxInstaller interface {
 
 // Setup sets up gitops in a repository for a cluster.
 func Setup(kubeconfigPath string, k8sRestConfig *rest.Config, k8sClientSet kubeclient.Interface, cfg *api.ClusterConfig, timeout time.Duration) error {
-	installer, err := flux.New(k8sClientSet, cfg.GitOps)
+	installer, err := flux.New(k8sClientSet, cfg.GitOps, cfg.Cosign)
 	logger.Info("gitops configuration detected, setting installer to Flux v2")
 	if err != nil {
 		return errors.Wrapf(err, "could not initialise Flux installer")
@@ -5,10 +5,10 @@ import (
 	"context"
 	"sync"
 
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/helm"
 )
 
-type FakeHelmInstaller struct {
+type FakeChartInstaller struct {
 	AddRepoStub        func(string, string) error
 	addRepoMutex       sync.RWMutex
 	addRepoArgsForCall []struct {
@@ -21,11 +21,11 @@ type FakeHelmInstaller struct {
 	addRepoReturnsOnCall map[int]struct {
 		result1 error
 	}
-	InstallChartStub        func(context.Context, providers.InstallChartOpts) error
+	InstallChartStub        func(context.Context, helm.InstallChartOpts) error
 	installChartMutex       sync.RWMutex
 	installChartArgsForCall []struct {
 		arg1 context.Context
-		arg2 providers.InstallChartOpts
+		arg2 helm.InstallChartOpts
 	}
 	installChartReturns struct {
 		result1 error
@@ -37,7 +37,7 @@ type FakeHelmInstaller struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeHelmInstaller) AddRepo(arg1 string, arg2 string) error {
+func (fake *FakeChartInstaller) AddRepo(arg1 string, arg2 string) error {
 	fake.addRepoMutex.Lock()
 	ret, specificReturn := fake.addRepoReturnsOnCall[len(fake.addRepoArgsForCall)]
 	fake.addRepoArgsForCall = append(fake.addRepoArgsForCall, struct {
@@ -57,26 +57,26 @@ func (fake *FakeHelmInstaller) AddRepo(arg1 string, arg2 string) error {
 	return fakeReturns.result1
 }
 
-func (fake *FakeHelmInstaller) AddRepoCallCount() int {
+func (fake *FakeChartInstaller) AddRepoCallCount() int {
 	fake.addRepoMutex.RLock()
 	defer fake.addRepoMutex.RUnlock()
 	return len(fake.addRepoArgsForCall)
 }
 
-func (fake *FakeHelmInstaller) AddRepoCalls(stub func(string, string) error) {
+func (fake *FakeChartInstaller) AddRepoCalls(stub func(string, string) error) {
 	fake.addRepoMutex.Lock()
 	defer fake.addRepoMutex.Unlock()
 	fake.AddRepoStub = stub
 }
 
-func (fake *FakeHelmInstaller) AddRepoArgsForCall(i int) (string, string) {
+func (fake *FakeChartInstaller) AddRepoArgsForCall(i int) (string, string) {
 	fake.addRepoMutex.RLock()
 	defer fake.addRepoMutex.RUnlock()
 	argsForCall := fake.addRepoArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeHelmInstaller) AddRepoReturns(result1 error) {
+func (fake *FakeChartInstaller) AddRepoReturns(result1 error) {
 	fake.addRepoMutex.Lock()
 	defer fake.addRepoMutex.Unlock()
 	fake.AddRepoStub = nil
@@ -85,7 +85,7 @@ func (fake *FakeHelmInstaller) AddRepoReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeHelmInstaller) AddRepoReturnsOnCall(i int, result1 error) {
+func (fake *FakeChartInstaller) AddRepoReturnsOnCall(i int, result1 error) {
 	fake.addRepoMutex.Lock()
 	defer fake.addRepoMutex.Unlock()
 	fake.AddRepoStub = nil
@@ -99,12 +99,12 @@ func (fake *FakeHelmInstaller) AddRepoReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeHelmInstaller) InstallChart(arg1 context.Context, arg2 providers.InstallChartOpts) error {
+func (fake *FakeChartInstaller) InstallChart(arg1 context.Context, arg2 helm.InstallChartOpts) error {
 	fake.installChartMutex.Lock()
 	ret, specificReturn := fake.installChartReturnsOnCall[len(fake.installChartArgsForCall)]
 	fake.installChartArgsForCall = append(fake.installChartArgsForCall, struct {
 		arg1 context.Context
-		arg2 providers.InstallChartOpts
+		arg2 helm.InstallChartOpts
 	}{arg1, arg2})
 	stub := fake.InstallChartStub
 	fakeReturns := fake.installChartReturns
@@ -119,26 +119,26 @@ func (fake *FakeHelmInstaller) InstallChart(arg1 context.Context, arg2 providers
 	return fakeReturns.result1
 }
 
-func (fake *FakeHelmInstaller) InstallChartCallCount() int {
+func (fake *FakeChartInstaller) InstallChartCallCount() int {
 	fake.installChartMutex.RLock()
 	defer fake.installChartMutex.RUnlock()
 	return len(fake.installChartArgsForCall)
 }
 
-func (fake *FakeHelmInstaller) InstallChartCalls(stub func(context.Context, providers.InstallChartOpts) error) {
+func (fake *FakeChartInstaller) InstallChartCalls(stub func(context.Context, helm.InstallChartOpts) error) {
 	fake.installChartMutex.Lock()
 	defer fake.installChartMutex.Unlock()
 	fake.InstallChartStub = stub
 }
 
-func (fake *FakeHelmInstaller) InstallChartArgsForCall(i int) (context.Context, providers.InstallChartOpts) {
+func (fake *FakeChartInstaller) InstallChartArgsForCall(i int) (context.Context, helm.InstallChartOpts) {
 	fake.installChartMutex.RLock()
 	defer fake.installChartMutex.RUnlock()
 	argsForCall := fake.installChartArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeHelmInstaller) InstallChartReturns(result1 error) {
+func (fake *FakeChartInstaller) InstallChartReturns(result1 error) {
 	fake.installChartMutex.Lock()
 	defer fake.installChartMutex.Unlock()
 	fake.InstallChartStub = nil
@@ -147,7 +147,7 @@ func (fake *FakeHelmInstaller) InstallChartReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeHelmInstaller) InstallChartReturnsOnCall(i int, result1 error) {
+func (fake *FakeChartInstaller) InstallChartReturnsOnCall(i int, result1 error) {
 	fake.installChartMutex.Lock()
 	defer fake.installChartMutex.Unlock()
 	fake.InstallChartStub = nil
@@ -161,7 +161,7 @@ func (fake *FakeHelmInstaller) InstallChartReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeHelmInstaller) Invocations() map[string][][]interface{} {
+func (fake *FakeChartInstaller) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addRepoMutex.RLock()
@@ -175,7 +175,7 @@ func (fake *FakeHelmInstaller) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
-func (fake *FakeHelmInstaller) recordInvocation(key string, args []interface{}) {
+func (fake *FakeChartInstaller) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
 	if fake.invocations == nil {
@@ -187,4 +187,4 @@ func (fake *FakeHelmInstaller) recordInvocation(key string, args []interface{})
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ providers.HelmInstaller = new(FakeHelmInstaller)
+var _ helm.ChartInstaller = new(FakeChartInstaller)
@@ -5,7 +5,7 @@ import (
 	"bytes"
 	"sync"
 
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/helm"
 	"helm.sh/helm/v3/pkg/getter"
 )
 
@@ -117,4 +117,4 @@ func (fake *FakeURLGetter) recordInvocation(key string, args []interface{}) {
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ providers.URLGetter = new(FakeURLGetter)
+var _ helm.URLGetter = new(FakeURLGetter)
@@ -16,8 +16,6 @@ import (
 	"helm.sh/helm/v3/pkg/getter"
 	"helm.sh/helm/v3/pkg/repo"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
-
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
 )
 
 // Options defines options for the Helm Installer.
@@ -47,7 +45,7 @@ func NewInstaller(opts Options) (*Installer, error) {
 	}, nil
 }
 
-var _ providers.HelmInstaller = &Installer{}
+var _ ChartInstaller = &Installer{}
 
 // AddRepo adds a repository to helm repositories.
 func (i *Installer) AddRepo(repoURL, release string) error {
@@ -84,7 +82,7 @@ func (i *Installer) AddRepo(repoURL, release string) error {
 
 // InstallChart takes a repo's name and a chart name and installs it. If namespace is not empty
 // it will install into that namespace and create the namespace. Version is required.
-func (i *Installer) InstallChart(ctx context.Context, opts providers.InstallChartOpts) error {
+func (i *Installer) InstallChart(ctx context.Context, opts InstallChartOpts) error {
 	client := action.NewInstall(i.ActionConfig)
 	client.Wait = true
 	client.Namespace = opts.Namespace
@@ -97,6 +95,11 @@ func (i *Installer) InstallChart(ctx context.Context, opts providers.InstallChar
 	if err != nil {
 		return fmt.Errorf("failed to locate chart: %w", err)
 	}
+	if opts.Verify != nil {
+		if err := opts.Verify(chartPath); err != nil {
+			return fmt.Errorf("failed to verify chart: %w", err)
+		}
+	}
 
 	// possibly deal with chart dependencies, but for now, maybe we don't care.
 	ch, err := loader.Load(chartPath)
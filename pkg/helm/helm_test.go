@@ -1,4 +1,4 @@
-package helm
+package helm_test
 
 import (
 	"bytes"
@@ -22,8 +22,8 @@ import (
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
 
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers/fakes"
+	"github.com/weaveworks/eksctl/pkg/helm"
+	"github.com/weaveworks/eksctl/pkg/helm/fakes"
 )
 
 var _ = Describe("HelmInstaller", func() {
@@ -35,7 +35,7 @@ var _ = Describe("HelmInstaller", func() {
 			getters            getter.Providers
 			tmp                string
 			err                error
-			installerUnderTest *Installer
+			installerUnderTest *helm.Installer
 		)
 
 		BeforeEach(func() {
@@ -49,7 +49,7 @@ var _ = Describe("HelmInstaller", func() {
 				},
 			}
 			getters = append(getters, provider)
-			installerUnderTest = &Installer{
+			installerUnderTest = &helm.Installer{
 				Getters: getters,
 				Settings: &cli.EnvSettings{
 					RegistryConfig:   filepath.Join(tmp, "registry.json"),
@@ -95,7 +95,7 @@ var _ = Describe("HelmInstaller", func() {
 		})
 		When("there is no provider for the given scheme", func() {
 			It("errors", func() {
-				installer := Installer{
+				installer := helm.Installer{
 					Getters: nil,
 					Settings: &cli.EnvSettings{
 						RegistryConfig:   filepath.Join(tmp, "registry.json"),
@@ -116,7 +116,7 @@ var _ = Describe("HelmInstaller", func() {
 			getters            getter.Providers
 			tmp                string
 			err                error
-			installerUnderTest *Installer
+			installerUnderTest *helm.Installer
 			values             map[string]interface{}
 			actionConfig       *action.Configuration
 			fakeKubeClient     *fakes.PrintingKubeClient
@@ -141,7 +141,7 @@ var _ = Describe("HelmInstaller", func() {
 				Capabilities: chartutil.DefaultCapabilities,
 				Log:          func(format string, v ...interface{}) {},
 			}
-			installerUnderTest = &Installer{
+			installerUnderTest = &helm.Installer{
 				Getters: getters,
 				Settings: &cli.EnvSettings{
 					RepositoryCache:  tmp,
@@ -164,7 +164,7 @@ var _ = Describe("HelmInstaller", func() {
 			Expect(os.WriteFile(filepath.Join(tmp, "repositories.yaml"), []byte(expectedRepositoryYaml), 0644)).To(Succeed())
 			Expect(copy.Copy(filepath.Join("testdata", "karpenter-0.4.3.tgz"), filepath.Join(tmp, "karpenter-0.4.3.tgz"))).To(Succeed())
 			Expect(copy.Copy(filepath.Join("testdata", "karpenter-index.yaml"), filepath.Join(tmp, "karpenter-index.yaml"))).To(Succeed())
-			Expect(installerUnderTest.InstallChart(context.Background(), providers.InstallChartOpts{
+			Expect(installerUnderTest.InstallChart(context.Background(), helm.InstallChartOpts{
 				ChartName:       "karpenter/karpenter",
 				CreateNamespace: true,
 				Namespace:       "karpenter",
@@ -181,7 +181,7 @@ var _ = Describe("HelmInstaller", func() {
 				Expect(os.WriteFile(filepath.Join(tmp, "repositories.yaml"), []byte(expectedRepositoryYaml), 0644)).To(Succeed())
 				Expect(copy.Copy(filepath.Join("testdata", "karpenter-0.4.3.tgz"), filepath.Join(tmp, "karpenter-0.4.3.tgz"))).To(Succeed())
 				Expect(copy.Copy(filepath.Join("testdata", "karpenter-index.yaml"), filepath.Join(tmp, "karpenter-index.yaml"))).To(Succeed())
-				Expect(installerUnderTest.InstallChart(context.Background(), providers.InstallChartOpts{
+				Expect(installerUnderTest.InstallChart(context.Background(), helm.InstallChartOpts{
 					ChartName:       "karpenter/karpenter",
 					CreateNamespace: false,
 					Namespace:       "karpenter",
@@ -198,7 +198,7 @@ var _ = Describe("HelmInstaller", func() {
 		})
 		When("locate chart is unable to find the requested chart", func() {
 			It("errors", func() {
-				err := installerUnderTest.InstallChart(context.Background(), providers.InstallChartOpts{
+				err := installerUnderTest.InstallChart(context.Background(), helm.InstallChartOpts{
 					ChartName:       "karpenter/karpenter",
 					CreateNamespace: true,
 					Namespace:       "karpenter",
@@ -214,7 +214,7 @@ var _ = Describe("HelmInstaller", func() {
 				Expect(os.WriteFile(filepath.Join(tmp, "repositories.yaml"), []byte(expectedRepositoryYaml), 0644)).To(Succeed())
 				Expect(copy.Copy(filepath.Join("testdata", "karpenter-0.4.3.tgz"), filepath.Join(tmp, "karpenter-0.4.3.tgz"))).To(Succeed())
 				Expect(copy.Copy(filepath.Join("testdata", "karpenter-index.yaml"), filepath.Join(tmp, "karpenter-index.yaml"))).To(Succeed())
-				err := installerUnderTest.InstallChart(context.Background(), providers.InstallChartOpts{
+				err := installerUnderTest.InstallChart(context.Background(), helm.InstallChartOpts{
 					ChartName:       "karpenter/karpenter",
 					CreateNamespace: true,
 					Namespace:       "karpenter",
@@ -228,7 +228,7 @@ var _ = Describe("HelmInstaller", func() {
 		When("repository is invalid", func() {
 			It("errors", func() {
 				Expect(os.WriteFile(filepath.Join(tmp, "repositories.yaml"), []byte("invalid\n"), 0644)).To(Succeed())
-				err := installerUnderTest.InstallChart(context.Background(), providers.InstallChartOpts{
+				err := installerUnderTest.InstallChart(context.Background(), helm.InstallChartOpts{
 					ChartName:       "karpenter/karpenter",
 					CreateNamespace: true,
 					Namespace:       "karpenter",
@@ -250,7 +250,7 @@ var _ = Describe("HelmInstaller", func() {
 				}
 				actionConfig.KubeClient = fakeKube
 				installerUnderTest.ActionConfig = actionConfig
-				err := installerUnderTest.InstallChart(context.Background(), providers.InstallChartOpts{
+				err := installerUnderTest.InstallChart(context.Background(), helm.InstallChartOpts{
 					ChartName:       "karpenter/karpenter",
 					CreateNamespace: true,
 					Namespace:       "karpenter",
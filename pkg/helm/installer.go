@@ -1,4 +1,4 @@
-package providers
+package helm
 
 import (
 	"bytes"
@@ -23,12 +23,14 @@ type InstallChartOpts struct {
 	ReleaseName     string
 	Values          map[string]interface{}
 	Version         string
+	// Verify verifies the chart archive once it's downloaded, the chart isn't installed if it fails
+	Verify func(chartPath string) error
 }
 
-// HelmInstaller deals with setting up Helm related resources.
+// ChartInstaller deals with setting up Helm related resources.
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
-//counterfeiter:generate -o fakes/fake_helm_installer.go . HelmInstaller
-type HelmInstaller interface {
+//counterfeiter:generate -o fakes/fake_chart_installer.go . ChartInstaller
+type ChartInstaller interface {
 	// AddRepo adds a repository to helm repositories.
 	AddRepo(repoURL string, release string) error
 	// InstallChart takes a releaseName's name and a chart name and installs it. If namespace is not empty
@@ -13,9 +13,10 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
 )
 
@@ -68,9 +69,7 @@ func NewOpenIDConnectManager(iamapi awsapi.IAM, accountID, issuer, partition str
 // if it was unable to call IAM API
 func (m *OpenIDConnectManager) CheckProviderExists(ctx context.Context) (bool, error) {
 	input := &iam.GetOpenIDConnectProviderInput{
-		OpenIDConnectProviderArn: aws.String(
-			fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", m.partition, m.accountID, m.hostnameAndPath()),
-		),
+		OpenIDConnectProviderArn: aws.String(api.OIDCProviderARN(m.partition, m.accountID, m.hostnameAndPath())),
 	}
 	_, err := m.iam.GetOpenIDConnectProvider(ctx, input)
 	if err != nil {
@@ -7,7 +7,8 @@ import (
 	"github.com/kris-nova/logger"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/helm"
+	"github.com/weaveworks/eksctl/pkg/provenance"
 )
 
 const (
@@ -19,19 +20,26 @@ const (
 	aws                      = "aws"
 	clusterEndpoint          = "clusterEndpoint"
 	clusterName              = "clusterName"
+	controller               = "controller"
 	create                   = "create"
 	defaultInstanceProfile   = "defaultInstanceProfile"
 	helmChartName            = "karpenter/karpenter"
 	helmRepo                 = "https://charts.karpenter.sh"
+	image                    = "image"
 	releaseName              = "karpenter"
 	serviceAccount           = "serviceAccount"
 	serviceAccountAnnotation = "annotations"
 	serviceAccountName       = "name"
+	webhook                  = "webhook"
+
+	// imageRepository is the repository the images of Karpenter are published under, it's kept when the registry is
+	// overridden
+	imageRepository = "karpenter"
 )
 
 // Options contains values which Karpenter uses to configure the installation.
 type Options struct {
-	HelmInstaller providers.HelmInstaller
+	HelmInstaller helm.ChartInstaller
 	Namespace     string
 	ClusterConfig *api.ClusterConfig
 }
@@ -77,15 +85,25 @@ func (k *Installer) Install(ctx context.Context, serviceAccountRoleARN string, i
 		},
 		serviceAccount: serviceAccountMap,
 	}
+	if repository := k.ClusterConfig.ImageRepositoryOverride; repository != "" {
+		// the chart defaults to the images of public.ecr.aws
+		values[controller] = map[string]interface{}{
+			image: fmt.Sprintf("%s/%s/controller:v%s", repository, imageRepository, k.ClusterConfig.Karpenter.Version),
+		}
+		values[webhook] = map[string]interface{}{
+			image: fmt.Sprintf("%s/%s/webhook:v%s", repository, imageRepository, k.ClusterConfig.Karpenter.Version),
+		}
+	}
 
 	logger.Debug("the following values will be applied to the install: %+v", values)
-	if err := k.HelmInstaller.InstallChart(ctx, providers.InstallChartOpts{
+	if err := k.HelmInstaller.InstallChart(ctx, helm.InstallChartOpts{
 		ChartName:       helmChartName,
 		CreateNamespace: true,
 		Namespace:       DefaultNamespace,
 		ReleaseName:     releaseName,
 		Values:          values,
 		Version:         k.ClusterConfig.Karpenter.Version,
+		Verify:          provenance.NewVerifier(k.ClusterConfig.Cosign).VerifyFunc("the Karpenter chart", k.ClusterConfig.Karpenter.ChartVerification),
 	}); err != nil {
 		return fmt.Errorf("failed to install Karpenter chart: %w", err)
 	}
@@ -8,8 +8,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
-	"github.com/weaveworks/eksctl/pkg/karpenter/providers/fakes"
+	"github.com/weaveworks/eksctl/pkg/helm"
+	"github.com/weaveworks/eksctl/pkg/helm/fakes"
 )
 
 var _ = Describe("Install", func() {
@@ -17,7 +17,7 @@ var _ = Describe("Install", func() {
 	Context("Install", func() {
 
 		var (
-			fakeHelmInstaller  *fakes.FakeHelmInstaller
+			fakeHelmInstaller  *fakes.FakeChartInstaller
 			installerUnderTest *Installer
 			cfg                *api.ClusterConfig
 		)
@@ -33,7 +33,7 @@ var _ = Describe("Install", func() {
 			cfg.Status = &api.ClusterStatus{
 				Endpoint: "https://endpoint.com",
 			}
-			fakeHelmInstaller = &fakes.FakeHelmInstaller{}
+			fakeHelmInstaller = &fakes.FakeChartInstaller{}
 			installerUnderTest = &Installer{
 				Options: Options{
 					HelmInstaller: fakeHelmInstaller,
@@ -60,7 +60,7 @@ var _ = Describe("Install", func() {
 					defaultInstanceProfile: "role/profile",
 				},
 			}
-			Expect(args).To(Equal(providers.InstallChartOpts{
+			Expect(args).To(Equal(helm.InstallChartOpts{
 				ChartName:       "karpenter/karpenter",
 				CreateNamespace: true,
 				Namespace:       "karpenter",
@@ -114,5 +114,29 @@ var _ = Describe("Install", func() {
 				Expect(opts.Values).To(Equal(values))
 			})
 		})
+
+		When("the chart is pinned", func() {
+			It("verifies the chart before it's installed", func() {
+				cfg.Karpenter.ChartVerification = &api.ArtifactVerification{Digest: "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"}
+				Expect(installerUnderTest.Install(context.Background(), "role/account", "role/profile")).To(Succeed())
+				_, opts := fakeHelmInstaller.InstallChartArgsForCall(0)
+				Expect(opts.Verify).NotTo(BeNil())
+				Expect(opts.Verify("testdata/does-not-exist.tgz")).To(MatchError(ContainSubstring("computing the digest of the Karpenter chart")))
+			})
+		})
+
+		When("the image repository is overridden", func() {
+			It("sets the images of the controller and webhook", func() {
+				cfg.ImageRepositoryOverride = "mycorp.jfrog.io/eks"
+				Expect(installerUnderTest.Install(context.Background(), "role/account", "role/profile")).To(Succeed())
+				_, opts := fakeHelmInstaller.InstallChartArgsForCall(0)
+				Expect(opts.Values).To(HaveKeyWithValue(controller, map[string]interface{}{
+					image: "mycorp.jfrog.io/eks/karpenter/controller:v0.4.3",
+				}))
+				Expect(opts.Values).To(HaveKeyWithValue(webhook, map[string]interface{}{
+					image: "mycorp.jfrog.io/eks/karpenter/webhook:v0.4.3",
+				}))
+			})
+		})
 	})
 })
@@ -0,0 +1,384 @@
+// Package lock implements an advisory lock that prevents concurrent eksctl invocations from
+// changing the same cluster. The lock is an SSM parameter, which can be created atomically and
+// doesn't require any infrastructure other than what eksctl already uses. It is preferred to a
+// DynamoDB table, which would have to be created in each account before eksctl could use it, and
+// to a lease in the tags of the cluster, since tags can't be set conditionally, so two invocations
+// could both take the lease, and don't exist before the cluster is created.
+package lock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/google/uuid"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+const (
+	// DefaultLeaseDuration is how long a lock is held after it was last renewed, so that
+	// the lock of an invocation that was killed eventually expires
+	DefaultLeaseDuration = 5 * time.Minute
+	// DefaultPollInterval is how often a held lock is checked while waiting for it
+	DefaultPollInterval = 10 * time.Second
+)
+
+// ParameterName returns the name of the SSM parameter holding the lock of clusterName
+func ParameterName(clusterName string) string {
+	return "/eksctl/locks/" + clusterName
+}
+
+// ClaimParameterName returns the name of the SSM parameter claiming the lock of clusterName held by holderID.
+// SSM parameters can't be changed conditionally, so the lock is only renewed, released or taken over by the
+// invocation that created this parameter, which can be created by only one invocation
+func ClaimParameterName(clusterName, holderID string) string {
+	return "/eksctl/lock-claims/" + clusterName + "/" + holderID
+}
+
+// ErrLost is returned by Release when the lock was taken over, or couldn't be renewed before it expired, while
+// it was held
+var ErrLost = errors.New("the lock was lost while it was held, other eksctl invocations may have changed the cluster")
+
+// Holder describes the invocation holding a lock
+type Holder struct {
+	ID         string    `json:"id"`
+	Host       string    `json:"host"`
+	Command    string    `json:"command"`
+	AcquiredAt time.Time `json:"acquiredAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+func (h Holder) String() string {
+	return fmt.Sprintf("%q on host %q since %s", h.Command, h.Host, h.AcquiredAt.Format(time.RFC3339))
+}
+
+// Lock is the lock of a cluster
+type Lock struct {
+	ssm           awsapi.SSM
+	clusterName   string
+	parameterName string
+	holder        Holder
+
+	// LeaseDuration is how long the lock is held without being renewed; it is renewed every third of it
+	LeaseDuration time.Duration
+	// PollInterval is how often the lock is checked while waiting for it
+	PollInterval time.Duration
+
+	mu       sync.Mutex
+	acquired bool
+	lost     chan struct{}
+	stop     chan struct{}
+	stopped  chan struct{}
+}
+
+// New returns the Lock of clusterName
+func New(ssmAPI awsapi.SSM, clusterName string) *Lock {
+	host, _ := os.Hostname()
+	return &Lock{
+		ssm:           ssmAPI,
+		clusterName:   clusterName,
+		parameterName: ParameterName(clusterName),
+		holder: Holder{
+			ID:      uuid.NewString(),
+			Host:    host,
+			Command: strings.Join(os.Args, " "),
+		},
+		LeaseDuration: DefaultLeaseDuration,
+		PollInterval:  DefaultPollInterval,
+	}
+}
+
+// HeldError is returned when a lock is held by another invocation
+type HeldError struct {
+	Holder Holder
+}
+
+func (e *HeldError) Error() string {
+	return fmt.Sprintf("lock is held by %s", e.Holder)
+}
+
+// Acquire acquires the lock, waiting up to timeout for it to be released, and renews it until
+// it is released
+func (l *Lock) Acquire(ctx context.Context, timeout time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.acquired {
+		return nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := l.tryAcquire(ctx)
+		if err == nil {
+			break
+		}
+		var heldErr *HeldError
+		if !errors.As(err, &heldErr) {
+			return err
+		}
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf("timed out waiting for the lock %q: %w", l.parameterName, err)
+		}
+		logger.Info("waiting for the lock %q held by %s", l.parameterName, heldErr.Holder)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(l.PollInterval):
+		}
+	}
+
+	l.acquired = true
+	l.lost = make(chan struct{})
+	l.stop = make(chan struct{})
+	l.stopped = make(chan struct{})
+	go l.renew()
+	return nil
+}
+
+func (l *Lock) tryAcquire(ctx context.Context) error {
+	err := l.put(ctx, false)
+	var alreadyExists *ssmtypes.ParameterAlreadyExists
+	if !errors.As(err, &alreadyExists) {
+		return err
+	}
+
+	holder, err := l.currentHolder(ctx)
+	if err != nil {
+		return err
+	}
+	if holder == nil {
+		// released in the meantime
+		return l.tryAcquire(ctx)
+	}
+	if time.Now().Before(holder.ExpiresAt) {
+		return &HeldError{Holder: *holder}
+	}
+
+	claimed, err := l.claim(ctx, holder.ID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		// another invocation is taking it over, or its holder is renewing it
+		return &HeldError{Holder: *holder}
+	}
+	defer l.unclaim(holder.ID)
+
+	current, err := l.currentHolder(ctx)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return l.tryAcquire(ctx)
+	}
+	if current.ID != holder.ID || !current.ExpiresAt.Equal(holder.ExpiresAt) {
+		// renewed or taken over before it was claimed
+		return &HeldError{Holder: *current}
+	}
+
+	logger.Warning("taking over the expired lock %q held by %s", l.parameterName, holder)
+	return l.put(ctx, true)
+}
+
+// claim creates the claim on the lock held by holderID, it returns false if another invocation claimed it
+func (l *Lock) claim(ctx context.Context, holderID string) (bool, error) {
+	_, err := l.ssm.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:        aws.String(ClaimParameterName(l.clusterName, holderID)),
+		Value:       aws.String(l.holder.ID),
+		Type:        ssmtypes.ParameterTypeString,
+		Description: aws.String("eksctl lock claim"),
+		Overwrite:   aws.Bool(false),
+	})
+	var alreadyExists *ssmtypes.ParameterAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("claiming lock %q: %w", l.parameterName, err)
+	}
+	return true, nil
+}
+
+func (l *Lock) unclaim(holderID string) {
+	// the claim must be deleted even if the operation holding it was cancelled
+	if err := deleteParameter(context.TODO(), l.ssm, ClaimParameterName(l.clusterName, holderID)); err != nil {
+		logger.Warning("failed to delete the claim on the lock %q, run `eksctl utils force-unlock` if it can't be acquired: %v", l.parameterName, err)
+	}
+}
+
+func (l *Lock) put(ctx context.Context, overwrite bool) error {
+	now := time.Now().UTC()
+	holder := l.holder
+	if holder.AcquiredAt.IsZero() || !overwrite {
+		holder.AcquiredAt = now
+	}
+	holder.ExpiresAt = now.Add(l.LeaseDuration)
+	value, err := json.Marshal(holder)
+	if err != nil {
+		return err
+	}
+	if _, err := l.ssm.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:        aws.String(l.parameterName),
+		Value:       aws.String(string(value)),
+		Type:        ssmtypes.ParameterTypeString,
+		Description: aws.String("eksctl lock"),
+		Overwrite:   aws.Bool(overwrite),
+	}); err != nil {
+		return err
+	}
+	l.holder = holder
+	return nil
+}
+
+func (l *Lock) delete(ctx context.Context) error {
+	return deleteParameter(ctx, l.ssm, l.parameterName)
+}
+
+func deleteParameter(ctx context.Context, ssmAPI awsapi.SSM, name string) error {
+	_, err := ssmAPI.DeleteParameter(ctx, &ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	var notFound *ssmtypes.ParameterNotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
+
+func (l *Lock) renew() {
+	defer close(l.stopped)
+	ticker := time.NewTicker(l.LeaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			l.mu.Lock()
+			// renewals must not be cancelled with the operation holding the lock
+			err := l.renewOnce(context.TODO())
+			if err != nil && !errors.Is(err, ErrLost) && !time.Now().Before(l.holder.ExpiresAt) {
+				err = fmt.Errorf("%w: %v", ErrLost, err)
+			}
+			if errors.Is(err, ErrLost) {
+				close(l.lost)
+			}
+			l.mu.Unlock()
+
+			switch {
+			case errors.Is(err, ErrLost):
+				logger.Critical("lost the lock %q: %v", l.parameterName, err)
+				return
+			case err != nil:
+				logger.Warning("failed to renew the lock %q: %v", l.parameterName, err)
+			}
+		}
+	}
+}
+
+// renewOnce extends the lease of the lock, it returns ErrLost if the lock was taken over
+func (l *Lock) renewOnce(ctx context.Context) error {
+	if err := l.checkHeld(ctx); err != nil {
+		return err
+	}
+	defer l.unclaim(l.holder.ID)
+	return l.put(ctx, true)
+}
+
+// checkHeld claims the lock and checks that it's still held by this invocation, so that it can't be taken
+// over until it is unclaimed
+func (l *Lock) checkHeld(ctx context.Context) error {
+	claimed, err := l.claim(ctx, l.holder.ID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return fmt.Errorf("%w: another invocation is taking it over", ErrLost)
+	}
+	holder, err := l.currentHolder(ctx)
+	if err == nil && (holder == nil || holder.ID != l.holder.ID) {
+		err = fmt.Errorf("%w: it was released or taken over", ErrLost)
+	}
+	if err != nil {
+		l.unclaim(l.holder.ID)
+		return err
+	}
+	return nil
+}
+
+// Lost returns a channel that is closed when the lock is lost while it's held. The operation holding the lock
+// should stop then, as other invocations may change the cluster
+func (l *Lock) Lost() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lost
+}
+
+// Release releases the lock if it was acquired and is still held by this invocation. It returns ErrLost if the
+// lock was lost while it was held
+func (l *Lock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	if !l.acquired {
+		l.mu.Unlock()
+		return nil
+	}
+	l.acquired = false
+	close(l.stop)
+	l.mu.Unlock()
+	<-l.stopped
+
+	select {
+	case <-l.lost:
+		return ErrLost
+	default:
+	}
+
+	if err := l.checkHeld(ctx); err != nil {
+		return err
+	}
+	defer l.unclaim(l.holder.ID)
+	return l.delete(ctx)
+}
+
+// ForceUnlock releases the lock of clusterName regardless of its holder, returning the holder
+// or nil if the lock wasn't held
+func ForceUnlock(ctx context.Context, ssmAPI awsapi.SSM, clusterName string) (*Holder, error) {
+	l := &Lock{ssm: ssmAPI, clusterName: clusterName, parameterName: ParameterName(clusterName)}
+	holder, err := l.currentHolder(ctx)
+	if err != nil || holder == nil {
+		return nil, err
+	}
+	if err := l.delete(ctx); err != nil {
+		return nil, err
+	}
+	// the claim of an invocation killed while it held it would prevent the lock from being taken over
+	return holder, deleteParameter(ctx, ssmAPI, ClaimParameterName(clusterName, holder.ID))
+}
+
+func (l *Lock) currentHolder(ctx context.Context) (*Holder, error) {
+	output, err := l.ssm.GetParameter(ctx, &ssm.GetParameterInput{
+		Name: aws.String(l.parameterName),
+	})
+	if err != nil {
+		var notFound *ssmtypes.ParameterNotFound
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading lock %q: %w", l.parameterName, err)
+	}
+	holder := &Holder{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.Parameter.Value)), holder); err != nil {
+		return nil, fmt.Errorf("invalid lock %q: %w", l.parameterName, err)
+	}
+	return holder, nil
+}
@@ -0,0 +1,11 @@
+package lock_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestLock(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
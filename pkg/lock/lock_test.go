@@ -0,0 +1,259 @@
+package lock_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/lock"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+// parameterStore backs the mocked SSM API with a map of parameters
+type parameterStore struct {
+	mu         sync.Mutex
+	parameters map[string]string
+}
+
+func (s *parameterStore) mock(p *mockprovider.MockProvider) {
+	p.MockSSM().On("PutParameter", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, input *ssm.PutParameterInput, _ ...func(*ssm.Options)) *ssm.PutParameterOutput {
+			return &ssm.PutParameterOutput{}
+		},
+		func(_ context.Context, input *ssm.PutParameterInput, _ ...func(*ssm.Options)) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if _, ok := s.parameters[*input.Name]; ok && !aws.ToBool(input.Overwrite) {
+				return &ssmtypes.ParameterAlreadyExists{}
+			}
+			s.parameters[*input.Name] = *input.Value
+			return nil
+		},
+	)
+	p.MockSSM().On("GetParameter", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, input *ssm.GetParameterInput, _ ...func(*ssm.Options)) *ssm.GetParameterOutput {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return &ssm.GetParameterOutput{Parameter: &ssmtypes.Parameter{Value: aws.String(s.parameters[*input.Name])}}
+		},
+		func(_ context.Context, input *ssm.GetParameterInput, _ ...func(*ssm.Options)) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if _, ok := s.parameters[*input.Name]; !ok {
+				return &ssmtypes.ParameterNotFound{}
+			}
+			return nil
+		},
+	)
+	p.MockSSM().On("DeleteParameter", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, input *ssm.DeleteParameterInput, _ ...func(*ssm.Options)) *ssm.DeleteParameterOutput {
+			return &ssm.DeleteParameterOutput{}
+		},
+		func(_ context.Context, input *ssm.DeleteParameterInput, _ ...func(*ssm.Options)) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if _, ok := s.parameters[*input.Name]; !ok {
+				return &ssmtypes.ParameterNotFound{}
+			}
+			delete(s.parameters, *input.Name)
+			return nil
+		},
+	)
+}
+
+func (s *parameterStore) holder(name string) *lock.Holder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.parameters[name]
+	if !ok {
+		return nil
+	}
+	holder := &lock.Holder{}
+	Expect(json.Unmarshal([]byte(value), holder)).To(Succeed())
+	return holder
+}
+
+func (s *parameterStore) exists(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.parameters[name]
+	return ok
+}
+
+func (s *parameterStore) claim(name, holderID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.parameters[name] = holderID
+}
+
+func (s *parameterStore) set(name string, holder lock.Holder) {
+	value, err := json.Marshal(holder)
+	Expect(err).NotTo(HaveOccurred())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.parameters[name] = string(value)
+}
+
+var _ = Describe("Lock", func() {
+	const clusterName = "test-cluster"
+
+	var (
+		p             *mockprovider.MockProvider
+		store         *parameterStore
+		parameterName string
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		store = &parameterStore{parameters: map[string]string{}}
+		store.mock(p)
+		parameterName = lock.ParameterName(clusterName)
+	})
+
+	newLock := func() *lock.Lock {
+		l := lock.New(p.SSM(), clusterName)
+		l.PollInterval = 10 * time.Millisecond
+		return l
+	}
+
+	It("acquires a free lock and deletes it on release", func() {
+		l := newLock()
+		Expect(l.Acquire(context.Background(), 0)).To(Succeed())
+
+		holder := store.holder(parameterName)
+		Expect(holder).NotTo(BeNil())
+		Expect(holder.ExpiresAt).To(BeTemporally("~", time.Now().Add(lock.DefaultLeaseDuration), time.Minute))
+
+		Expect(l.Release(context.Background())).To(Succeed())
+		Expect(store.holder(parameterName)).To(BeNil())
+	})
+
+	It("fails when the lock is held and the timeout is reached", func() {
+		Expect(newLock().Acquire(context.Background(), 0)).To(Succeed())
+
+		err := newLock().Acquire(context.Background(), 50*time.Millisecond)
+		var heldErr *lock.HeldError
+		Expect(errors.As(err, &heldErr)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("timed out waiting for the lock"))
+	})
+
+	It("waits for the lock to be released", func() {
+		holding := newLock()
+		Expect(holding.Acquire(context.Background(), 0)).To(Succeed())
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(50 * time.Millisecond)
+			Expect(holding.Release(context.Background())).To(Succeed())
+		}()
+
+		Expect(newLock().Acquire(context.Background(), time.Minute)).To(Succeed())
+	})
+
+	It("takes over an expired lock", func() {
+		store.set(parameterName, lock.Holder{
+			ID:         "killed",
+			AcquiredAt: time.Now().Add(-time.Hour),
+			ExpiresAt:  time.Now().Add(-time.Minute),
+		})
+
+		Expect(newLock().Acquire(context.Background(), 0)).To(Succeed())
+		Expect(store.holder(parameterName).ID).NotTo(Equal("killed"))
+	})
+
+	It("doesn't take over an expired lock claimed by another invocation", func() {
+		store.set(parameterName, lock.Holder{
+			ID:         "killed",
+			AcquiredAt: time.Now().Add(-time.Hour),
+			ExpiresAt:  time.Now().Add(-time.Minute),
+		})
+		store.claim(lock.ClaimParameterName(clusterName, "killed"), "other")
+
+		err := newLock().Acquire(context.Background(), 0)
+		var heldErr *lock.HeldError
+		Expect(errors.As(err, &heldErr)).To(BeTrue())
+		Expect(heldErr.Holder.ID).To(Equal("killed"))
+		Expect(store.holder(parameterName).ID).To(Equal("killed"))
+	})
+
+	It("deletes its claim after taking over an expired lock", func() {
+		store.set(parameterName, lock.Holder{
+			ID:         "killed",
+			AcquiredAt: time.Now().Add(-time.Hour),
+			ExpiresAt:  time.Now().Add(-time.Minute),
+		})
+
+		Expect(newLock().Acquire(context.Background(), 0)).To(Succeed())
+		Expect(store.exists(lock.ClaimParameterName(clusterName, "killed"))).To(BeFalse())
+	})
+
+	It("doesn't delete a lock taken over by another invocation on release", func() {
+		l := newLock()
+		Expect(l.Acquire(context.Background(), 0)).To(Succeed())
+		store.set(parameterName, lock.Holder{ID: "other", ExpiresAt: time.Now().Add(time.Hour)})
+
+		Expect(errors.Is(l.Release(context.Background()), lock.ErrLost)).To(BeTrue())
+		Expect(store.holder(parameterName).ID).To(Equal("other"))
+	})
+
+	It("doesn't delete a lock being taken over by another invocation on release", func() {
+		l := newLock()
+		Expect(l.Acquire(context.Background(), 0)).To(Succeed())
+		id := store.holder(parameterName).ID
+		store.claim(lock.ClaimParameterName(clusterName, id), "other")
+
+		Expect(errors.Is(l.Release(context.Background()), lock.ErrLost)).To(BeTrue())
+		Expect(store.holder(parameterName).ID).To(Equal(id))
+	})
+
+	It("signals that the lock was lost when it's taken over while it's held", func() {
+		l := newLock()
+		l.LeaseDuration = 300 * time.Millisecond
+		Expect(l.Acquire(context.Background(), 0)).To(Succeed())
+		store.set(parameterName, lock.Holder{ID: "other", ExpiresAt: time.Now().Add(time.Hour)})
+
+		Eventually(l.Lost()).Should(BeClosed())
+		Expect(l.Release(context.Background())).To(MatchError(lock.ErrLost))
+		Expect(store.holder(parameterName).ID).To(Equal("other"))
+	})
+
+	It("renews the lock while it's held", func() {
+		l := newLock()
+		l.LeaseDuration = 300 * time.Millisecond
+		Expect(l.Acquire(context.Background(), 0)).To(Succeed())
+		defer func() {
+			Expect(l.Release(context.Background())).To(Succeed())
+		}()
+
+		Consistently(func() bool {
+			return time.Now().Before(store.holder(parameterName).ExpiresAt)
+		}, time.Second, 50*time.Millisecond).Should(BeTrue())
+	})
+
+	Describe("ForceUnlock", func() {
+		It("deletes the lock and its claim and returns its holder", func() {
+			store.set(parameterName, lock.Holder{ID: "other", Host: "host", ExpiresAt: time.Now().Add(time.Hour)})
+			store.claim(lock.ClaimParameterName(clusterName, "other"), "killed")
+
+			holder, err := lock.ForceUnlock(context.Background(), p.SSM(), clusterName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(holder.Host).To(Equal("host"))
+			Expect(store.holder(parameterName)).To(BeNil())
+			Expect(store.exists(lock.ClaimParameterName(clusterName, "other"))).To(BeFalse())
+		})
+
+		It("returns nil when the cluster isn't locked", func() {
+			holder, err := lock.ForceUnlock(context.Background(), p.SSM(), clusterName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(holder).To(BeNil())
+		})
+	})
+})
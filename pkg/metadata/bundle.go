@@ -0,0 +1,66 @@
+// Package metadata captures the AWS metadata eksctl looks up to resolve AMIs, instance types and addon versions, so
+// that eksctl can run in isolated regions where these lookups fail or are blocked
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// Version is the version of the bundle format
+const Version = 1
+
+// Bundle is the metadata of a region, exported in a connected environment
+type Bundle struct {
+	Version            int       `json:"version"`
+	Region             string    `json:"region"`
+	GeneratedAt        time.Time `json:"generatedAt"`
+	KubernetesVersions []string  `json:"kubernetesVersions"`
+	// Parameters are the public SSM parameters AMIs and release versions are resolved with, keyed by name
+	Parameters    map[string]string           `json:"parameters"`
+	InstanceTypes []ec2types.InstanceTypeInfo `json:"instanceTypes"`
+	// AddonVersions are the addons available for each Kubernetes version
+	AddonVersions map[string][]ekstypes.AddonInfo `json:"addonVersions"`
+}
+
+// Write writes the bundle as indented JSON
+func Write(w io.Writer, b *Bundle) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(b)
+}
+
+// Read reads a bundle written by Write
+func Read(r io.Reader) (*Bundle, error) {
+	var b Bundle
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return nil, fmt.Errorf("parsing metadata bundle: %w", err)
+	}
+	if b.Version != Version {
+		return nil, fmt.Errorf("unsupported metadata bundle version %d, expected %d", b.Version, Version)
+	}
+	return &b, nil
+}
+
+// Load reads the bundle at path and checks that it was exported for region
+func Load(path, region string) (*Bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening metadata bundle: %w", err)
+	}
+	defer f.Close()
+	b, err := Read(f)
+	if err != nil {
+		return nil, err
+	}
+	if b.Region != region {
+		return nil, fmt.Errorf("metadata bundle %s was exported for region %q, not %q", path, b.Region, region)
+	}
+	return b, nil
+}
@@ -0,0 +1,117 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// windowsParametersPath holds the parameters of all Windows AMIs, of which only the EKS optimized ones are exported
+const windowsParametersPath = "/aws/service/ami-windows-latest"
+
+// Exporter exports the metadata of a region
+type Exporter struct {
+	region string
+	ssmAPI awsapi.SSM
+	ec2API awsapi.EC2
+	eksAPI awsapi.EKS
+}
+
+// NewExporter creates an Exporter
+func NewExporter(region string, ssmAPI awsapi.SSM, ec2API awsapi.EC2, eksAPI awsapi.EKS) *Exporter {
+	return &Exporter{
+		region: region,
+		ssmAPI: ssmAPI,
+		ec2API: ec2API,
+		eksAPI: eksAPI,
+	}
+}
+
+// Export exports the AMIs and addon versions of kubernetesVersions, along with all instance types of the region
+func (e *Exporter) Export(ctx context.Context, kubernetesVersions []string) (*Bundle, error) {
+	b := &Bundle{
+		Version:            Version,
+		Region:             e.region,
+		GeneratedAt:        time.Now().UTC(),
+		KubernetesVersions: kubernetesVersions,
+		Parameters:         map[string]string{},
+		AddonVersions:      map[string][]ekstypes.AddonInfo{},
+	}
+
+	for _, version := range kubernetesVersions {
+		logger.Info("exporting AMIs and addon versions of Kubernetes %s", version)
+		for _, path := range []string{
+			fmt.Sprintf("/aws/service/eks/optimized-ami/%s", version),
+			fmt.Sprintf("/aws/service/bottlerocket/aws-k8s-%s", version),
+			fmt.Sprintf("/aws/service/bottlerocket/aws-k8s-%s-nvidia", version),
+		} {
+			if err := e.exportParameters(ctx, b, path, func(name string) bool {
+				// the paths also hold every past release, of which none is resolved by eksctl
+				return strings.Contains(name, "/recommended/") || strings.Contains(name, "/latest/")
+			}); err != nil {
+				return nil, err
+			}
+		}
+		if err := e.exportParameters(ctx, b, windowsParametersPath, func(name string) bool {
+			return strings.Contains(name, "EKS_Optimized-"+version+"/")
+		}); err != nil {
+			return nil, err
+		}
+
+		paginator := eks.NewDescribeAddonVersionsPaginator(e.eksAPI, &eks.DescribeAddonVersionsInput{
+			KubernetesVersion: aws.String(version),
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("describing addon versions of Kubernetes %s: %w", version, err)
+			}
+			b.AddonVersions[version] = append(b.AddonVersions[version], output.Addons...)
+		}
+	}
+
+	logger.Info("exporting instance types")
+	paginator := ec2.NewDescribeInstanceTypesPaginator(e.ec2API, &ec2.DescribeInstanceTypesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing instance types: %w", err)
+		}
+		b.InstanceTypes = append(b.InstanceTypes, output.InstanceTypes...)
+	}
+	return b, nil
+}
+
+// exportParameters exports the image_id and release_version parameters under path that match include
+func (e *Exporter) exportParameters(ctx context.Context, b *Bundle, path string, include func(string) bool) error {
+	paginator := ssm.NewGetParametersByPathPaginator(e.ssmAPI, &ssm.GetParametersByPathInput{
+		Path:      aws.String(path),
+		Recursive: aws.Bool(true),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("getting SSM parameters under %s: %w", path, err)
+		}
+		for _, p := range output.Parameters {
+			name := aws.ToString(p.Name)
+			if !strings.HasSuffix(name, "/image_id") && !strings.HasSuffix(name, "/release_version") {
+				continue
+			}
+			if include(name) {
+				b.Parameters[name] = aws.ToString(p.Value)
+			}
+		}
+	}
+	return nil
+}
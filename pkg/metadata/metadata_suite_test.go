@@ -0,0 +1,11 @@
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestMetadata(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,179 @@
+package metadata_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/ami"
+	"github.com/weaveworks/eksctl/pkg/metadata"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+const amiParameter = "/aws/service/eks/optimized-ami/1.22/amazon-linux-2/recommended/image_id"
+
+var _ = Describe("Metadata bundle", func() {
+	var (
+		p      *mockprovider.MockProvider
+		bundle *metadata.Bundle
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "metadata")
+		Expect(err).NotTo(HaveOccurred())
+
+		p = mockprovider.NewMockProvider()
+		bundle = &metadata.Bundle{
+			Version:            metadata.Version,
+			Region:             "us-west-2",
+			KubernetesVersions: []string{"1.22"},
+			Parameters: map[string]string{
+				amiParameter: "ami-123",
+			},
+			InstanceTypes: []ec2types.InstanceTypeInfo{
+				{InstanceType: "m5.large", VCpuInfo: &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(2)}},
+				{InstanceType: "m5.xlarge", VCpuInfo: &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(4)}},
+				{InstanceType: "c5.large", VCpuInfo: &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(2)}},
+			},
+			AddonVersions: map[string][]ekstypes.AddonInfo{
+				"1.22": {
+					{AddonName: aws.String("vpc-cni"), AddonVersions: []ekstypes.AddonVersionInfo{{AddonVersion: aws.String("v1.10.1-eksbuild.1")}}},
+					{AddonName: aws.String("kube-proxy"), AddonVersions: []ekstypes.AddonVersionInfo{{AddonVersion: aws.String("v1.22.6-eksbuild.1")}}},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("round-trips through a file", func() {
+		var buf bytes.Buffer
+		Expect(metadata.Write(&buf, bundle)).To(Succeed())
+		path := filepath.Join(tmpDir, "bundle.json")
+		Expect(os.WriteFile(path, buf.Bytes(), 0600)).To(Succeed())
+
+		loaded, err := metadata.Load(path, "us-west-2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.Parameters).To(Equal(bundle.Parameters))
+		Expect(loaded.InstanceTypes).To(HaveLen(3))
+		Expect(*loaded.AddonVersions["1.22"][1].AddonVersions[0].AddonVersion).To(Equal("v1.22.6-eksbuild.1"))
+
+		_, err = metadata.Load(path, "eu-west-1")
+		Expect(err).To(MatchError(ContainSubstring(`was exported for region "us-west-2", not "eu-west-1"`)))
+	})
+
+	Context("offline APIs", func() {
+		It("resolves AMIs from the bundle", func() {
+			resolver := ami.NewSSMResolver(metadata.WrapSSM(p.SSM(), bundle))
+			id, err := resolver.Resolve(context.Background(), "us-west-2", "1.22", "m5.large", "AmazonLinux2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal("ami-123"))
+			p.MockSSM().AssertNotCalled(GinkgoT(), "GetParameter", mock.Anything, mock.Anything)
+
+			_, err = resolver.Resolve(context.Background(), "us-west-2", "1.21", "m5.large", "AmazonLinux2")
+			Expect(err).To(MatchError(ContainSubstring("/aws/service/eks/optimized-ami/1.21/amazon-linux-2/recommended/image_id is not in the metadata bundle")))
+		})
+
+		It("gets other SSM parameters from SSM", func() {
+			input := &ssm.GetParameterInput{Name: aws.String("/eksctl/lock/cluster")}
+			p.MockSSM().On("GetParameter", mock.Anything, input).Return(&ssm.GetParameterOutput{
+				Parameter: &ssmtypes.Parameter{Value: aws.String("lock")},
+			}, nil)
+			output, err := metadata.WrapSSM(p.SSM(), bundle).GetParameter(context.Background(), input)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*output.Parameter.Value).To(Equal("lock"))
+		})
+
+		It("describes instance types from the bundle", func() {
+			ec2API := metadata.WrapEC2(p.EC2(), bundle)
+			output, err := ec2API.DescribeInstanceTypes(context.Background(), &ec2.DescribeInstanceTypesInput{
+				InstanceTypes: []ec2types.InstanceType{"m5.xlarge"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.InstanceTypes).To(HaveLen(1))
+			Expect(*output.InstanceTypes[0].VCpuInfo.DefaultVCpus).To(Equal(int32(4)))
+
+			output, err = ec2API.DescribeInstanceTypes(context.Background(), &ec2.DescribeInstanceTypesInput{
+				Filters: []ec2types.Filter{{Name: aws.String("instance-type"), Values: []string{"m5.*"}}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.InstanceTypes).To(HaveLen(2))
+
+			_, err = ec2API.DescribeInstanceTypes(context.Background(), &ec2.DescribeInstanceTypesInput{
+				InstanceTypes: []ec2types.InstanceType{"p4d.24xlarge"},
+			})
+			Expect(err).To(MatchError("instance type p4d.24xlarge is not in the metadata bundle"))
+		})
+
+		It("describes addon versions from the bundle", func() {
+			eksAPI := metadata.WrapEKS(p.EKS(), bundle)
+			output, err := eksAPI.DescribeAddonVersions(context.Background(), &eks.DescribeAddonVersionsInput{
+				KubernetesVersion: aws.String("1.22"),
+				AddonName:         aws.String("kube-proxy"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Addons).To(HaveLen(1))
+			Expect(*output.Addons[0].AddonName).To(Equal("kube-proxy"))
+
+			_, err = eksAPI.DescribeAddonVersions(context.Background(), &eks.DescribeAddonVersionsInput{
+				KubernetesVersion: aws.String("1.23"),
+			})
+			Expect(err).To(MatchError("the addon versions of Kubernetes 1.23 are not in the metadata bundle"))
+		})
+	})
+
+	It("exports the recommended AMIs, instance types and addon versions", func() {
+		p.MockSSM().On("GetParametersByPath", mock.Anything, mock.MatchedBy(func(input *ssm.GetParametersByPathInput) bool {
+			return *input.Path == "/aws/service/eks/optimized-ami/1.22"
+		}), mock.Anything).Return(&ssm.GetParametersByPathOutput{
+			Parameters: []ssmtypes.Parameter{
+				{Name: aws.String(amiParameter), Value: aws.String("ami-123")},
+				{Name: aws.String("/aws/service/eks/optimized-ami/1.22/amazon-linux-2/recommended/image_name"), Value: aws.String("amazon-eks-node")},
+				{Name: aws.String("/aws/service/eks/optimized-ami/1.22/amazon-linux-2/amazon-eks-node-1.22-v20220101/image_id"), Value: aws.String("ami-old")},
+			},
+		}, nil)
+		p.MockSSM().On("GetParametersByPath", mock.Anything, mock.MatchedBy(func(input *ssm.GetParametersByPathInput) bool {
+			return *input.Path == "/aws/service/ami-windows-latest"
+		}), mock.Anything).Return(&ssm.GetParametersByPathOutput{
+			Parameters: []ssmtypes.Parameter{
+				{Name: aws.String("/aws/service/ami-windows-latest/Windows_Server-2019-English-Core-EKS_Optimized-1.22/image_id"), Value: aws.String("ami-win")},
+				{Name: aws.String("/aws/service/ami-windows-latest/Windows_Server-2019-English-Core-EKS_Optimized-1.21/image_id"), Value: aws.String("ami-win-old")},
+				{Name: aws.String("/aws/service/ami-windows-latest/Windows_Server-2019-English-Full-Base/image_id"), Value: aws.String("ami-base")},
+			},
+		}, nil)
+		p.MockSSM().On("GetParametersByPath", mock.Anything, mock.Anything, mock.Anything).Return(&ssm.GetParametersByPathOutput{}, nil)
+		p.MockEKS().On("DescribeAddonVersions", mock.Anything, &eks.DescribeAddonVersionsInput{
+			KubernetesVersion: aws.String("1.22"),
+		}, mock.Anything).Return(&eks.DescribeAddonVersionsOutput{Addons: bundle.AddonVersions["1.22"]}, nil)
+		p.MockEC2().On("DescribeInstanceTypes", mock.Anything, &ec2.DescribeInstanceTypesInput{}, mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: bundle.InstanceTypes,
+		}, nil)
+
+		exported, err := metadata.NewExporter("us-west-2", p.SSM(), p.EC2(), p.EKS()).Export(context.Background(), []string{"1.22"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exported.Version).To(Equal(metadata.Version))
+		Expect(exported.Region).To(Equal("us-west-2"))
+		Expect(exported.Parameters).To(Equal(map[string]string{
+			amiParameter: "ami-123",
+			"/aws/service/ami-windows-latest/Windows_Server-2019-English-Core-EKS_Optimized-1.22/image_id": "ami-win",
+		}))
+		Expect(exported.InstanceTypes).To(HaveLen(3))
+		Expect(exported.AddonVersions["1.22"]).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,146 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// publicParametersPrefix prefixes the public SSM parameters published by AWS, other parameters, e.g. the locks of
+// eksctl, are still read from SSM
+const publicParametersPrefix = "/aws/service/"
+
+// WrapSSM returns an SSM API that gets public parameters from b
+func WrapSSM(ssmAPI awsapi.SSM, b *Bundle) awsapi.SSM {
+	return &offlineSSM{SSM: ssmAPI, bundle: b}
+}
+
+// WrapEC2 returns an EC2 API that describes instance types from b
+func WrapEC2(ec2API awsapi.EC2, b *Bundle) awsapi.EC2 {
+	return &offlineEC2{EC2: ec2API, bundle: b}
+}
+
+// WrapEKS returns an EKS API that describes addon versions from b
+func WrapEKS(eksAPI awsapi.EKS, b *Bundle) awsapi.EKS {
+	return &offlineEKS{EKS: eksAPI, bundle: b}
+}
+
+type offlineSSM struct {
+	awsapi.SSM
+	bundle *Bundle
+}
+
+func (s *offlineSSM) GetParameter(ctx context.Context, params *ssm.GetParameterInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	name := aws.ToString(params.Name)
+	if !strings.HasPrefix(name, publicParametersPrefix) {
+		return s.SSM.GetParameter(ctx, params, optFns...)
+	}
+	value, ok := s.bundle.Parameters[name]
+	if !ok {
+		return nil, fmt.Errorf("SSM parameter %s is not in the metadata bundle, export it again with the Kubernetes version of the cluster", name)
+	}
+	return &ssm.GetParameterOutput{
+		Parameter: &ssmtypes.Parameter{
+			Name:  aws.String(name),
+			Type:  ssmtypes.ParameterTypeString,
+			Value: aws.String(value),
+		},
+	}, nil
+}
+
+type offlineEC2 struct {
+	awsapi.EC2
+	bundle *Bundle
+}
+
+func (e *offlineEC2) DescribeInstanceTypes(_ context.Context, params *ec2.DescribeInstanceTypesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstanceTypesOutput, error) {
+	// the instance-type filter is the only one eksctl uses
+	var patterns []string
+	for _, f := range params.Filters {
+		if aws.ToString(f.Name) != "instance-type" {
+			return nil, fmt.Errorf("filter %q is not supported when describing instance types from the metadata bundle", aws.ToString(f.Name))
+		}
+		patterns = append(patterns, f.Values...)
+	}
+
+	matches := func(instanceType ec2types.InstanceType) bool {
+		if len(params.InstanceTypes) > 0 && !containsInstanceType(params.InstanceTypes, instanceType) {
+			return false
+		}
+		if len(params.Filters) == 0 {
+			return true
+		}
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, string(instanceType)); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	output := &ec2.DescribeInstanceTypesOutput{}
+	for _, it := range e.bundle.InstanceTypes {
+		if matches(it.InstanceType) {
+			output.InstanceTypes = append(output.InstanceTypes, it)
+		}
+	}
+	for _, instanceType := range params.InstanceTypes {
+		if !containsInstanceTypeInfo(output.InstanceTypes, instanceType) {
+			return nil, fmt.Errorf("instance type %s is not in the metadata bundle", instanceType)
+		}
+	}
+	return output, nil
+}
+
+func containsInstanceType(instanceTypes []ec2types.InstanceType, instanceType ec2types.InstanceType) bool {
+	for _, it := range instanceTypes {
+		if it == instanceType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInstanceTypeInfo(infos []ec2types.InstanceTypeInfo, instanceType ec2types.InstanceType) bool {
+	for _, info := range infos {
+		if info.InstanceType == instanceType {
+			return true
+		}
+	}
+	return false
+}
+
+type offlineEKS struct {
+	awsapi.EKS
+	bundle *Bundle
+}
+
+func (e *offlineEKS) DescribeAddonVersions(_ context.Context, params *eks.DescribeAddonVersionsInput, _ ...func(*eks.Options)) (*eks.DescribeAddonVersionsOutput, error) {
+	version := aws.ToString(params.KubernetesVersion)
+	if version == "" {
+		return nil, errors.New("a Kubernetes version is required to describe addon versions from the metadata bundle")
+	}
+	addons, ok := e.bundle.AddonVersions[version]
+	if !ok {
+		return nil, fmt.Errorf("the addon versions of Kubernetes %s are not in the metadata bundle", version)
+	}
+
+	output := &eks.DescribeAddonVersionsOutput{}
+	for _, addon := range addons {
+		if params.AddonName == nil || aws.ToString(addon.AddonName) == *params.AddonName {
+			output.Addons = append(output.Addons, addon)
+		}
+	}
+	return output, nil
+}
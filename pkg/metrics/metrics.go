@@ -0,0 +1,161 @@
+// Package metrics exposes Prometheus metrics of long-running eksctl operations, such as the
+// CloudFormation stacks eksctl is waiting for and the AWS API calls it makes, so that jobs
+// provisioning many clusters can be profiled. Metrics are only served when Serve is called,
+// i.e. when `--metrics-listen` is set.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/kris-nova/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "eksctl"
+
+// Values of the result label
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+	enabled  int32
+
+	stacksInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "cloudformation_stacks_in_flight",
+		Help:      "Number of CloudFormation stacks eksctl is waiting for.",
+	})
+	waiterDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "waiter_duration_seconds",
+		Help:      "Time eksctl waited for CloudFormation stacks to reach the desired state.",
+		Buckets:   []float64{5, 15, 30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600},
+	}, []string{"waiter", "result"})
+	awsAPICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "aws_api_calls_total",
+		Help:      "Number of AWS API calls, counted once however many times they were retried.",
+	}, []string{"service", "operation", "result"})
+	awsAPIThrottles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "aws_api_throttles_total",
+		Help:      "Number of attempts of AWS API calls that were throttled.",
+	}, []string{"service", "operation"})
+)
+
+func init() {
+	registry.MustRegister(
+		stacksInFlight,
+		waiterDuration,
+		awsAPICalls,
+		awsAPIThrottles,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Serve serves the metrics at http://addr/metrics in the background, the returned func stops the server
+func Serve(addr string) (func(), error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listening for metrics requests: %w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Warning("failed to serve metrics: %v", err)
+		}
+	}()
+	atomic.StoreInt32(&enabled, 1)
+	logger.Info("serving metrics at http://%s/metrics", listener.Addr())
+	return func() {
+		atomic.StoreInt32(&enabled, 0)
+		if err := server.Close(); err != nil {
+			logger.Debug("failed to stop the metrics server: %v", err)
+		}
+	}, nil
+}
+
+// Handler returns the handler serving the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Enabled reports whether the metrics are served
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// StartStackWait records that eksctl started waiting for a stack, the returned func records the end of the wait
+func StartStackWait(waiter string) func(err error) {
+	stacksInFlight.Inc()
+	start := time.Now()
+	return func(err error) {
+		stacksInFlight.Dec()
+		waiterDuration.WithLabelValues(waiter, result(err)).Observe(time.Since(start).Seconds())
+	}
+}
+
+// AddV1Handlers counts the calls made with the AWS SDK v1 handlers
+func AddV1Handlers(handlers *request.Handlers) {
+	handlers.AfterRetry.PushBackNamed(request.NamedHandler{
+		Name: "eksctlMetrics",
+		Fn: func(r *request.Request) {
+			if r.IsErrorThrottle() {
+				awsAPIThrottles.WithLabelValues(r.ClientInfo.ServiceID, r.Operation.Name).Inc()
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "eksctlMetrics",
+		Fn: func(r *request.Request) {
+			awsAPICalls.WithLabelValues(r.ClientInfo.ServiceID, r.Operation.Name, result(r.Error)).Inc()
+		},
+	})
+}
+
+// AddMiddleware counts the calls made with AWS SDK v2 clients, it's meant to be added to aws.Config.APIOptions
+func AddMiddleware(stack *middleware.Stack) error {
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("eksctlMetrics", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		awsAPICalls.WithLabelValues(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), result(err)).Inc()
+		return out, metadata, err
+	}), middleware.After); err != nil {
+		return err
+	}
+	// finalize middlewares added after the retry middleware run for every attempt
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("eksctlMetricsThrottles", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleFinalize(ctx, in)
+		if err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool() {
+			awsAPIThrottles.WithLabelValues(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)).Inc()
+		}
+		return out, metadata, err
+	}), middleware.After)
+}
+
+func result(err error) string {
+	if err != nil {
+		return ResultFailure
+	}
+	return ResultSuccess
+}
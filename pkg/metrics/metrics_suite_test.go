@@ -0,0 +1,11 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestMetrics(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
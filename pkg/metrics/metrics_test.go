@@ -0,0 +1,117 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/smithy-go/middleware"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/metrics"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+var _ = Describe("Metrics", func() {
+	var (
+		httpClient *http.Client
+		throttled  bool
+	)
+
+	BeforeEach(func() {
+		throttled = false
+		httpClient = &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			if throttled {
+				return &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{"X-Amzn-Errortype": []string{"ThrottlingException"}},
+					Body:       io.NopCloser(strings.NewReader(`{"message": "Rate exceeded"}`)),
+					Request:    r,
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+				Request:    r,
+			}, nil
+		})}
+	})
+
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		metrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		return recorder.Body.String()
+	}
+
+	It("counts the calls and throttles of SDK v2 clients", func() {
+		client := eks.NewFromConfig(aws.Config{
+			Region:      "us-west-2",
+			Credentials: aws.AnonymousCredentials{},
+			HTTPClient:  httpClient,
+			Retryer: func() aws.Retryer {
+				return retry.NewStandard(func(o *retry.StandardOptions) {
+					o.MaxAttempts = 2
+					o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+				})
+			},
+			APIOptions: []func(*middleware.Stack) error{metrics.AddMiddleware},
+		})
+		_, err := client.DescribeCluster(context.Background(), &eks.DescribeClusterInput{Name: aws.String("dev")})
+		Expect(err).NotTo(HaveOccurred())
+		throttled = true
+		_, err = client.DeleteCluster(context.Background(), &eks.DeleteClusterInput{Name: aws.String("dev")})
+		Expect(err).To(HaveOccurred())
+
+		output := scrape()
+		Expect(output).To(ContainSubstring(`eksctl_aws_api_calls_total{operation="DescribeCluster",result="success",service="EKS"} 1`))
+		Expect(output).To(ContainSubstring(`eksctl_aws_api_calls_total{operation="DeleteCluster",result="failure",service="EKS"} 1`))
+		Expect(output).To(ContainSubstring(`eksctl_aws_api_throttles_total{operation="DeleteCluster",service="EKS"} 2`))
+	})
+
+	It("counts the calls and throttles of SDK v1 clients", func() {
+		sess := session.Must(session.NewSession(&awsv1.Config{
+			Region:      awsv1.String("us-west-2"),
+			Credentials: credentialsv1.AnonymousCredentials,
+			Retryer: client.DefaultRetryer{
+				NumMaxRetries:    1,
+				MinThrottleDelay: time.Millisecond,
+				MaxThrottleDelay: time.Millisecond,
+			},
+		}))
+		metrics.AddV1Handlers(&sess.Handlers)
+		throttled = true
+		_, err := eksv1.New(sess, &awsv1.Config{HTTPClient: httpClient}).ListClusters(&eksv1.ListClustersInput{})
+		Expect(err).To(HaveOccurred())
+
+		output := scrape()
+		Expect(output).To(ContainSubstring(`eksctl_aws_api_calls_total{operation="ListClusters",result="failure",service="EKS"} 1`))
+		Expect(output).To(ContainSubstring(`eksctl_aws_api_throttles_total{operation="ListClusters",service="EKS"} 2`))
+	})
+
+	It("records the stacks in flight and the duration of waits", func() {
+		endWait := metrics.StartStackWait("wait for stack deletion")
+		Expect(scrape()).To(ContainSubstring("eksctl_cloudformation_stacks_in_flight 1"))
+
+		endWait(errors.New("stack deletion failed"))
+		output := scrape()
+		Expect(output).To(ContainSubstring("eksctl_cloudformation_stacks_in_flight 0"))
+		Expect(output).To(ContainSubstring(`eksctl_waiter_duration_seconds_count{result="failure",waiter="wait for stack deletion"} 1`))
+	})
+})
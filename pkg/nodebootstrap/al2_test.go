@@ -174,6 +174,30 @@ NODE_TAINTS=
 CLUSTER_DNS=172.16.0.10
 CONTAINER_RUNTIME=`,
 		}),
+
+		Entry("IPv6 cluster", bootScriptEntry{
+			clusterConfig: func() *api.ClusterConfig {
+				clusterConfig := api.NewClusterConfig()
+				clusterConfig.Metadata.Name = "ipv6"
+				clusterConfig.Status = &api.ClusterStatus{
+					KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+						IPFamily:        "ipv6",
+						ServiceIPv6CIDR: "fd30:1c53:5f8a::/108",
+					},
+				}
+				return clusterConfig
+			}(),
+			ng: api.NewNodeGroup(),
+			expectedUserData: `CLUSTER_NAME=ipv6
+API_SERVER_URL=
+B64_CLUSTER_CA=
+NODE_LABELS=
+NODE_TAINTS=
+CLUSTER_DNS=fd30:1c53:5f8a::a
+CONTAINER_RUNTIME=
+IP_FAMILY=ipv6
+SERVICE_IPV6_CIDR=fd30:1c53:5f8a::/108`,
+		}),
 	)
 
 	Context("standard userdata", func() {
@@ -307,6 +331,93 @@ CONTAINER_RUNTIME=`, "\n")))
 		})
 	})
 
+	When("proxy and extra CA certificates are set", func() {
+		BeforeEach(func() {
+			ng.Proxy = &api.NodeGroupProxy{
+				HTTPSProxy: "http://proxy.corp:3128",
+				NoProxy:    []string{".corp.example.com"},
+			}
+			ng.ExtraCACerts = []string{"-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"}
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("adds the proxy environment, the certificates and the proxy script before the boot script", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/etc/eksctl/proxy.env"))
+			Expect(strings.Split(cloudCfg.WriteFiles[2].Content, "\n")).To(ConsistOf(
+				"HTTPS_PROXY=http://proxy.corp:3128",
+				"https_proxy=http://proxy.corp:3128",
+				"NO_PROXY=localhost,127.0.0.1,169.254.169.254,.internal,.svc,.cluster.local,192.168.0.0/16,.corp.example.com",
+				"no_proxy=localhost,127.0.0.1,169.254.169.254,.internal,.svc,.cluster.local,192.168.0.0/16,.corp.example.com",
+			))
+			Expect(cloudCfg.WriteFiles[3].Path).To(Equal("/etc/eksctl/extra-ca-certs.pem"))
+			Expect(cloudCfg.WriteFiles[3].Content).To(Equal("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"))
+			Expect(cloudCfg.WriteFiles[4].Path).To(Equal("/var/lib/cloud/scripts/eksctl/proxy.linux.sh"))
+			Expect(cloudCfg.WriteFiles[5].Path).To(Equal("/var/lib/cloud/scripts/eksctl/bootstrap.al2.sh"))
+			Expect(cloudCfg.Commands[0]).To(ContainElement("/var/lib/cloud/scripts/eksctl/proxy.linux.sh"))
+		})
+	})
+
+	When("authorized keys are set", func() {
+		BeforeEach(func() {
+			ng.SSH.Allow = api.Enabled()
+			ng.SSH.AuthorizedKeys = []string{"ssh-ed25519 AAAA user1", "ssh-rsa BBBB user2\n"}
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("writes the keys and adds the authorized keys script before the boot script", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/etc/eksctl/authorized_keys"))
+			Expect(cloudCfg.WriteFiles[2].Content).To(Equal("ssh-ed25519 AAAA user1\nssh-rsa BBBB user2\n"))
+			Expect(cloudCfg.WriteFiles[3].Path).To(Equal("/var/lib/cloud/scripts/eksctl/authorized-keys.linux.sh"))
+			Expect(cloudCfg.WriteFiles[4].Path).To(Equal("/var/lib/cloud/scripts/eksctl/bootstrap.al2.sh"))
+		})
+	})
+
+	When("elasticIPs are allocated", func() {
+		BeforeEach(func() {
+			ng.Name = "something-awesome-ng"
+			ng.ElasticIPs = &api.NodeGroupElasticIPs{Allocate: aws.Bool(true)}
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("finds the Elastic IPs by the tags of the nodegroup and associates one before the boot script", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/etc/eksctl/elastic-ips.env"))
+			Expect(strings.Split(cloudCfg.WriteFiles[2].Content, "\n")).To(ConsistOf(
+				"CLUSTER_NAME=something-awesome",
+				"NODEGROUP_NAME=something-awesome-ng",
+			))
+			Expect(cloudCfg.WriteFiles[3].Path).To(Equal("/var/lib/cloud/scripts/eksctl/elastic-ips.linux.sh"))
+			Expect(cloudCfg.WriteFiles[4].Path).To(Equal("/var/lib/cloud/scripts/eksctl/bootstrap.al2.sh"))
+		})
+	})
+
+	When("elasticIPs are existing allocations", func() {
+		BeforeEach(func() {
+			ng.ElasticIPs = &api.NodeGroupElasticIPs{AllocationIDs: []string{"eipalloc-1", "eipalloc-2"}}
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("lists the allocation IDs", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/etc/eksctl/elastic-ips.env"))
+			Expect(cloudCfg.WriteFiles[2].Content).To(Equal("ALLOCATION_IDS=eipalloc-1,eipalloc-2"))
+		})
+	})
+
 	When("OverrideBootstrapCommand is set", func() {
 		var (
 			err      error
@@ -5,6 +5,10 @@ import (
 	_ "embed"
 )
 
+//AuthorizedKeysLinuxSh holds the authorized-keys.linux.sh contents
+//go:embed scripts/authorized-keys.linux.sh
+var AuthorizedKeysLinuxSh string
+
 //BootstrapAl2Sh holds the bootstrap.al2.sh contents
 //go:embed scripts/bootstrap.al2.sh
 var BootstrapAl2Sh string
@@ -25,10 +29,18 @@ var EfaAl2Sh string
 //go:embed scripts/efa.managed.boothook
 var EfaManagedBoothook string
 
+//ElasticIPsLinuxSh holds the elastic-ips.linux.sh contents
+//go:embed scripts/elastic-ips.linux.sh
+var ElasticIPsLinuxSh string
+
 //InstallSsmAl2Sh holds the install-ssm.al2.sh contents
 //go:embed scripts/install-ssm.al2.sh
 var InstallSsmAl2Sh string
 
+//ProxyLinuxSh holds the proxy.linux.sh contents
+//go:embed scripts/proxy.linux.sh
+var ProxyLinuxSh string
+
 //KubeletYaml holds the kubelet.yaml contents
 //go:embed scripts/kubelet.yaml
 var KubeletYaml string
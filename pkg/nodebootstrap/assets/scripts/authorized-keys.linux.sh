@@ -0,0 +1,26 @@
+#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+AUTHORIZED_KEYS_FILE='/etc/eksctl/authorized_keys'
+
+for user in ec2-user ubuntu; do
+  if ! id "${user}" >/dev/null 2>&1; then
+    continue
+  fi
+  home=$(getent passwd "${user}" | cut -d: -f6)
+  mkdir -p "${home}/.ssh"
+  touch "${home}/.ssh/authorized_keys"
+  # the key pair of the instance is already in authorized_keys, only append the keys that aren't
+  while IFS= read -r key; do
+    if [[ -n "${key}" ]] && ! grep -qxF "${key}" "${home}/.ssh/authorized_keys"; then
+      echo "${key}" >> "${home}/.ssh/authorized_keys"
+    fi
+  done < "${AUTHORIZED_KEYS_FILE}"
+  chown -R "${user}:" "${home}/.ssh"
+  chmod 700 "${home}/.ssh"
+  chmod 600 "${home}/.ssh/authorized_keys"
+  echo "eksctl: added authorized keys for ${user}"
+done
@@ -6,13 +6,17 @@ set -o nounset
 
 source /var/lib/cloud/scripts/eksctl/bootstrap.helper.sh
 
+BOOTSTRAP_ARGS=()
+[[ "${IP_FAMILY}" == "ipv6" ]] && BOOTSTRAP_ARGS+=(--ip-family ipv6 --service-ipv6-cidr "${SERVICE_IPV6_CIDR}")
+
 echo "eksctl: running /etc/eks/bootstrap"
 /etc/eks/bootstrap.sh "${CLUSTER_NAME}" \
   --apiserver-endpoint "${API_SERVER_URL}" \
   --b64-cluster-ca "${B64_CLUSTER_CA}" \
   --dns-cluster-ip "${CLUSTER_DNS}" \
   --kubelet-extra-args "${KUBELET_EXTRA_ARGS}" \
-  --container-runtime "${CONTAINER_RUNTIME}"
+  --container-runtime "${CONTAINER_RUNTIME}" \
+  ${BOOTSTRAP_ARGS[@]+"${BOOTSTRAP_ARGS[@]}"}
 
 echo "eksctl: merging user options into kubelet-config.json"
 trap 'rm -f ${TMP_KUBE_CONF}' EXIT
@@ -19,12 +19,16 @@ INSTANCE_LIFECYCLE="$(get_metadata instance-life-cycle)"
 CLUSTER_DNS="${CLUSTER_DNS:-}"
 NODE_TAINTS="${NODE_TAINTS:-}"
 MAX_PODS="${MAX_PODS:-}"
+IP_FAMILY="${IP_FAMILY:-ipv4}"
+SERVICE_IPV6_CIDR="${SERVICE_IPV6_CIDR:-}"
 NODE_LABELS="${NODE_LABELS},node-lifecycle=${INSTANCE_LIFECYCLE},alpha.eksctl.io/instance-id=${INSTANCE_ID}"
 
 KUBELET_ARGS=("--node-labels=${NODE_LABELS}")
 [[ -n "${NODE_TAINTS}" ]] && KUBELET_ARGS+=("--register-with-taints=${NODE_TAINTS}")
 # --max-pods as a CLI argument is deprecated, this is a workaround until we deprecate support for maxPodsPerNode
 [[ -n "${MAX_PODS}" ]] && KUBELET_ARGS+=("--max-pods=${MAX_PODS}")
+# kubelet picks the first IPv4 address by default, which does not exist on IPv6-only subnets
+[[ "${IP_FAMILY}" == "ipv6" ]] && KUBELET_ARGS+=("--node-ip=$(get_metadata ipv6)")
 KUBELET_EXTRA_ARGS="${KUBELET_ARGS[@]}"
 
 CLUSTER_NAME="${CLUSTER_NAME}"
@@ -0,0 +1,41 @@
+#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+ELASTIC_IPS_ENV_FILE='/etc/eksctl/elastic-ips.env'
+
+source "${ELASTIC_IPS_ENV_FILE}"
+
+IMDS_TOKEN=$(curl -sS -X PUT 'http://169.254.169.254/latest/api/token' -H 'X-aws-ec2-metadata-token-ttl-seconds: 300')
+imds() {
+  curl -sS --fail -H "X-aws-ec2-metadata-token: ${IMDS_TOKEN}" "http://169.254.169.254/latest/meta-data/$1"
+}
+
+INSTANCE_ID=$(imds instance-id)
+AWS_DEFAULT_REGION=$(imds placement/region)
+export AWS_DEFAULT_REGION
+
+if [[ -n "${ALLOCATION_IDS:-}" ]]; then
+  FILTERS=("Name=allocation-id,Values=${ALLOCATION_IDS}")
+else
+  FILTERS=("Name=tag:alpha.eksctl.io/cluster-name,Values=${CLUSTER_NAME}" "Name=tag:alpha.eksctl.io/nodegroup-name,Values=${NODEGROUP_NAME}")
+fi
+
+# nodes booting at the same time may pick the same Elastic IP, reassociation is disallowed
+# so only one of them gets it and the others retry with another free Elastic IP
+for attempt in $(seq 1 10); do
+  allocation_id=$(aws ec2 describe-addresses --filters "${FILTERS[@]}" \
+    --query 'Addresses[?AssociationId==null].AllocationId' --output text | tr '\t' '\n' | shuf -n 1)
+  if [[ -z "${allocation_id}" ]]; then
+    echo "eksctl: no free Elastic IP for ${INSTANCE_ID} (attempt ${attempt})"
+  elif aws ec2 associate-address --instance-id "${INSTANCE_ID}" --allocation-id "${allocation_id}" --no-allow-reassociation >/dev/null; then
+    echo "eksctl: associated Elastic IP ${allocation_id} with ${INSTANCE_ID}"
+    exit 0
+  fi
+  sleep $((RANDOM % 10 + 5))
+done
+
+echo "eksctl: failed to associate an Elastic IP with ${INSTANCE_ID}" >&2
+exit 1
@@ -0,0 +1,36 @@
+#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+PROXY_ENV_FILE='/etc/eksctl/proxy.env'
+EXTRA_CA_CERTS_FILE='/etc/eksctl/extra-ca-certs.pem'
+
+if [[ -f "${EXTRA_CA_CERTS_FILE}" ]]; then
+  echo "eksctl: adding extra CA certificates to the trust store"
+  if command -v update-ca-trust >/dev/null; then
+    cp "${EXTRA_CA_CERTS_FILE}" /etc/pki/ca-trust/source/anchors/eksctl-extra-ca-certs.pem
+    update-ca-trust extract
+  else
+    cp "${EXTRA_CA_CERTS_FILE}" /usr/local/share/ca-certificates/eksctl-extra-ca-certs.crt
+    update-ca-certificates
+  fi
+fi
+
+if [[ -f "${PROXY_ENV_FILE}" ]]; then
+  echo "eksctl: configuring HTTP proxy for the container runtime and kubelet"
+  for unit in containerd docker kubelet snap.kubelet-eks.daemon; do
+    mkdir -p "/etc/systemd/system/${unit}.service.d"
+    cat > "/etc/systemd/system/${unit}.service.d/http-proxy.conf" <<EOS
+[Service]
+EnvironmentFile=${PROXY_ENV_FILE}
+EOS
+  done
+  systemctl daemon-reload
+  for unit in containerd docker; do
+    if systemctl is-active --quiet "${unit}"; then
+      systemctl restart "${unit}"
+    fi
+  done
+fi
@@ -0,0 +1,43 @@
+package nodebootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/assets"
+)
+
+const (
+	authorizedKeysFile        = "authorized_keys"
+	linuxAuthorizedKeysScript = "authorized-keys.linux.sh"
+)
+
+func hasAuthorizedKeys(ng *api.NodeGroupBase) bool {
+	return ng.SSH != nil && api.IsEnabled(ng.SSH.Allow) && len(ng.SSH.AuthorizedKeys) > 0
+}
+
+// makeAuthorizedKeysFile returns the file read by the authorized-keys.linux.sh script
+func makeAuthorizedKeysFile(ng *api.NodeGroupBase) cloudconfig.File {
+	var keys []string
+	for _, key := range ng.SSH.AuthorizedKeys {
+		keys = append(keys, strings.TrimSpace(key))
+	}
+	return cloudconfig.File{
+		Path:    configDir + authorizedKeysFile,
+		Content: strings.Join(keys, "\n") + "\n",
+	}
+}
+
+// makeAuthorizedKeysShellScript returns a script that writes the authorized keys and adds them, for managed
+// nodegroups whose user data is a MIME multipart message
+func makeAuthorizedKeysShellScript(ng *api.NodeGroupBase) string {
+	var sb strings.Builder
+	sb.WriteString("#!/bin/bash\n")
+	fmt.Fprintf(&sb, "mkdir -p %s\n", configDir)
+	f := makeAuthorizedKeysFile(ng)
+	fmt.Fprintf(&sb, "cat > %s <<'EKSCTL_EOF'\n%s\nEKSCTL_EOF\n", f.Path, strings.TrimSuffix(f.Content, "\n"))
+	sb.WriteString(strings.TrimPrefix(assets.AuthorizedKeysLinuxSh, "#!/bin/bash\n"))
+	return sb.String()
+}
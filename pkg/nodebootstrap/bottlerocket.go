@@ -29,7 +29,7 @@ func (b *Bottlerocket) UserData() (string, error) {
 	// Update settings based on NodeGroup configuration. Values set here are not
 	// allowed to be set by the user - the values are owned by the NodeGroup and
 	// expressly written into settings.
-	if err := setDerivedBottlerocketSettings(b.np); err != nil {
+	if err := setDerivedBottlerocketSettings(b.clusterConfig, b.np); err != nil {
 		return "", err
 	}
 
@@ -53,7 +53,7 @@ func (b *Bottlerocket) UserData() (string, error) {
 	return base64.StdEncoding.EncodeToString([]byte(data)), nil
 }
 
-func setDerivedBottlerocketSettings(np api.NodePool) error {
+func setDerivedBottlerocketSettings(clusterConfig *api.ClusterConfig, np api.NodePool) error {
 	kubernetesSettings, err := extractKubernetesSettings(np)
 	if err != nil {
 		return err
@@ -75,6 +75,10 @@ func setDerivedBottlerocketSettings(np api.NodePool) error {
 			kubernetesSettings["cluster-dns-ip"] = ng.ClusterDNS
 		}
 	}
+
+	if hasProxyConfig(ng) {
+		setBottlerocketProxySettings(clusterConfig, ng)
+	}
 	return nil
 }
 
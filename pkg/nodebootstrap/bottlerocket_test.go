@@ -228,6 +228,29 @@ var _ = Describe("Bottlerocket", func() {
 			})
 		})
 
+		When("proxy and extra CA certificates are set", func() {
+			It("adds network and pki settings to the userdata", func() {
+				ng.Proxy = &api.NodeGroupProxy{
+					HTTPProxy: "http://proxy.corp:3128",
+				}
+				ng.ExtraCACerts = []string{"-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"}
+
+				bootstrapper := newBootstrapper(clusterConfig, ng)
+				userdata, err := bootstrapper.UserData()
+				Expect(err).NotTo(HaveOccurred())
+
+				tree, parseErr := userdataTOML(userdata)
+				Expect(parseErr).NotTo(HaveOccurred())
+
+				Expect(tree.GetPath(strings.Split("settings.network.https-proxy", "."))).To(Equal("http://proxy.corp:3128"))
+				Expect(tree.GetPath(strings.Split("settings.network.no-proxy", "."))).To(ContainElements("169.254.169.254", "192.168.0.0/16"))
+				Expect(tree.GetPath([]string{"settings", "pki", "eksctl-extra-ca-0", "trusted"})).To(BeTrue())
+				Expect(tree.GetPath([]string{"settings", "pki", "eksctl-extra-ca-0", "data"})).To(Equal(
+					base64.StdEncoding.EncodeToString([]byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----")),
+				))
+			})
+		})
+
 		When("maxPods", func() {
 			It("adds MaxPodsPerNode to userdata when set", func() {
 				ng.MaxPodsPerNode = 32
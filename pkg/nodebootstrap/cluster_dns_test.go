@@ -43,6 +43,26 @@ var _ = DescribeTable("Cluster DNS", func(c clusterDNSEntry) {
 		expectedClusterDNS: "172.16.0.10",
 	}),
 
+	Entry("IPv6 cluster", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				IPFamily:        "ipv6",
+				ServiceIPv6CIDR: "fd30:1c53:5f8a::/108",
+			},
+		},
+		expectedClusterDNS: "fd30:1c53:5f8a::a",
+	}),
+
+	Entry("invalid ServiceIPv6CIDR", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				IPFamily:        api.IPV6Family,
+				ServiceIPv6CIDR: "fd30:1c53:5f8a::/4000",
+			},
+		},
+		expectedErr: "unexpected error parsing kubernetesNetworkConfig.serviceIPv6CIDR",
+	}),
+
 	Entry("empty ServiceIPv4CIDR", clusterDNSEntry{
 		clusterStatus:      &api.ClusterStatus{},
 		expectedClusterDNS: "",
@@ -0,0 +1,29 @@
+package nodebootstrap
+
+import (
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+const (
+	elasticIPsEnvFile     = "elastic-ips.env"
+	linuxElasticIPsScript = "elastic-ips.linux.sh"
+)
+
+// makeElasticIPsEnv returns the file read by the elastic-ips.linux.sh script. Elastic IPs allocated by eksctl
+// are found by the cluster and nodegroup name tags of the nodegroup stack
+func makeElasticIPsEnv(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) cloudconfig.File {
+	variables := map[string]string{}
+	if len(ng.ElasticIPs.AllocationIDs) > 0 {
+		variables["ALLOCATION_IDS"] = strings.Join(ng.ElasticIPs.AllocationIDs, ",")
+	} else {
+		variables["CLUSTER_NAME"] = clusterConfig.Metadata.Name
+		variables["NODEGROUP_NAME"] = ng.Name
+	}
+	return cloudconfig.File{
+		Path:    configDir + elasticIPsEnvFile,
+		Content: makeKeyValues(variables, "\n"),
+	}
+}
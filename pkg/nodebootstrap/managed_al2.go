@@ -16,15 +16,17 @@ import (
 
 // ManagedAL2 is a bootstrapper for managed Amazon Linux 2 nodegroups
 type ManagedAL2 struct {
-	ng *api.ManagedNodeGroup
+	clusterConfig *api.ClusterConfig
+	ng            *api.ManagedNodeGroup
 	// UserDataMimeBoundary sets the MIME boundary for user data
 	UserDataMimeBoundary string
 }
 
 // NewManagedAL2Bootstrapper creates a new ManagedAL2 bootstrapper
-func NewManagedAL2Bootstrapper(ng *api.ManagedNodeGroup) *ManagedAL2 {
+func NewManagedAL2Bootstrapper(clusterConfig *api.ClusterConfig, ng *api.ManagedNodeGroup) *ManagedAL2 {
 	return &ManagedAL2{
-		ng: ng,
+		clusterConfig: clusterConfig,
+		ng:            ng,
 	}
 }
 
@@ -33,7 +35,7 @@ func (m *ManagedAL2) UserData() (string, error) {
 	ng := m.ng
 
 	if strings.HasPrefix(ng.AMI, "ami-") {
-		return makeCustomAMIUserData(ng.NodeGroupBase, m.UserDataMimeBoundary)
+		return makeCustomAMIUserData(m.clusterConfig, ng.NodeGroupBase, m.UserDataMimeBoundary)
 	}
 
 	var (
@@ -46,6 +48,14 @@ func (m *ManagedAL2) UserData() (string, error) {
 		scripts = append(scripts, ng.PreBootstrapCommands...)
 	}
 
+	if hasProxyConfig(ng.NodeGroupBase) {
+		scripts = append(scripts, makeProxyShellScript(m.clusterConfig, ng.NodeGroupBase))
+	}
+
+	if hasAuthorizedKeys(ng.NodeGroupBase) {
+		scripts = append(scripts, makeAuthorizedKeysShellScript(ng.NodeGroupBase))
+	}
+
 	if ng.OverrideBootstrapCommand != nil {
 		scripts = append(scripts, *ng.OverrideBootstrapCommand)
 	} else if ng.MaxPodsPerNode != 0 {
@@ -67,7 +77,7 @@ func (m *ManagedAL2) UserData() (string, error) {
 	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
 }
 
-func makeCustomAMIUserData(ng *api.NodeGroupBase, mimeBoundary string) (string, error) {
+func makeCustomAMIUserData(clusterConfig *api.ClusterConfig, ng *api.NodeGroupBase, mimeBoundary string) (string, error) {
 	var (
 		buf     bytes.Buffer
 		scripts []string
@@ -77,6 +87,14 @@ func makeCustomAMIUserData(ng *api.NodeGroupBase, mimeBoundary string) (string,
 		scripts = append(scripts, ng.PreBootstrapCommands...)
 	}
 
+	if hasProxyConfig(ng) {
+		scripts = append(scripts, makeProxyShellScript(clusterConfig, ng))
+	}
+
+	if hasAuthorizedKeys(ng) {
+		scripts = append(scripts, makeAuthorizedKeysShellScript(ng))
+	}
+
 	if ng.OverrideBootstrapCommand != nil {
 		scripts = append(scripts, *ng.OverrideBootstrapCommand)
 	}
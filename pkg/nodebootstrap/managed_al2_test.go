@@ -20,7 +20,7 @@ type managedEntry struct {
 
 var _ = DescribeTable("Managed AL2", func(e managedEntry) {
 	api.SetManagedNodeGroupDefaults(e.ng, &api.ClusterMeta{Name: "cluster"})
-	bootstrapper := nodebootstrap.NewManagedAL2Bootstrapper(e.ng)
+	bootstrapper := nodebootstrap.NewManagedAL2Bootstrapper(api.NewClusterConfig(), e.ng)
 	bootstrapper.UserDataMimeBoundary = "//"
 
 	userData, err := bootstrapper.UserData()
@@ -69,6 +69,10 @@ func (b *ManagedBottlerocket) setDerivedSettings() error {
 		kubernetesSettings["max-pods"] = b.ng.MaxPodsPerNode
 	}
 
+	if hasProxyConfig(b.ng.NodeGroupBase) {
+		setBottlerocketProxySettings(b.clusterConfig, b.ng.NodeGroupBase)
+	}
+
 	return nil
 }
 
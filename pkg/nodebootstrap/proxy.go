@@ -0,0 +1,185 @@
+package nodebootstrap
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/assets"
+)
+
+const (
+	proxyEnvFile      = "proxy.env"
+	extraCACertsFile  = "extra-ca-certs.pem"
+	linuxProxyScript  = "proxy.linux.sh"
+	windowsCACertsDir = `$env:ProgramData\eksctl\certs`
+)
+
+// defaultNoProxy holds addresses that must always bypass the proxy for nodes to bootstrap
+var defaultNoProxy = []string{
+	"localhost",
+	"127.0.0.1",
+	"169.254.169.254",
+	".internal",
+	".svc",
+	".cluster.local",
+}
+
+func hasProxyConfig(ng *api.NodeGroupBase) bool {
+	return ng.Proxy != nil || len(ng.ExtraCACerts) > 0
+}
+
+// noProxyList returns the user-supplied noProxy entries along with the addresses
+// required for nodes to reach IMDS, the VPC and the cluster endpoint directly
+func noProxyList(clusterConfig *api.ClusterConfig, proxy *api.NodeGroupProxy) []string {
+	entries := append([]string{}, defaultNoProxy...)
+
+	if clusterConfig.VPC != nil && clusterConfig.VPC.CIDR != nil {
+		entries = append(entries, clusterConfig.VPC.CIDR.String())
+	}
+
+	if clusterConfig.Status != nil {
+		if networkConfig := clusterConfig.Status.KubernetesNetworkConfig; networkConfig != nil && networkConfig.ServiceIPv4CIDR != "" {
+			entries = append(entries, networkConfig.ServiceIPv4CIDR)
+		}
+		if endpoint, err := url.Parse(clusterConfig.Status.Endpoint); err == nil && endpoint.Hostname() != "" {
+			entries = append(entries, endpoint.Hostname())
+		}
+	}
+
+	entries = append(entries, proxy.NoProxy...)
+
+	seen := map[string]struct{}{}
+	var noProxy []string
+	for _, e := range entries {
+		if _, ok := seen[e]; ok {
+			continue
+		}
+		seen[e] = struct{}{}
+		noProxy = append(noProxy, e)
+	}
+	return noProxy
+}
+
+// makeProxyEnv returns the environment variables consumed by the container runtime and kubelet.
+// Both upper and lower case variants are set as tools disagree on which one takes precedence
+func makeProxyEnv(clusterConfig *api.ClusterConfig, proxy *api.NodeGroupProxy) string {
+	var lines []string
+	addVar := func(name, value string) {
+		if value == "" {
+			return
+		}
+		lines = append(lines,
+			fmt.Sprintf("%s=%s", strings.ToUpper(name), value),
+			fmt.Sprintf("%s=%s", strings.ToLower(name), value),
+		)
+	}
+	addVar("HTTP_PROXY", proxy.HTTPProxy)
+	addVar("HTTPS_PROXY", proxy.HTTPSProxy)
+	addVar("NO_PROXY", strings.Join(noProxyList(clusterConfig, proxy), ","))
+	return strings.Join(lines, "\n")
+}
+
+func makeExtraCACerts(certs []string) string {
+	var trimmed []string
+	for _, c := range certs {
+		trimmed = append(trimmed, strings.TrimSpace(c))
+	}
+	return strings.Join(trimmed, "\n") + "\n"
+}
+
+// makeProxyFiles returns the files read by the proxy.linux.sh script
+func makeProxyFiles(clusterConfig *api.ClusterConfig, ng *api.NodeGroupBase) []cloudconfig.File {
+	var files []cloudconfig.File
+	if ng.Proxy != nil {
+		files = append(files, cloudconfig.File{
+			Path:    configDir + proxyEnvFile,
+			Content: makeProxyEnv(clusterConfig, ng.Proxy),
+		})
+	}
+	if len(ng.ExtraCACerts) > 0 {
+		files = append(files, cloudconfig.File{
+			Path:    configDir + extraCACertsFile,
+			Content: makeExtraCACerts(ng.ExtraCACerts),
+		})
+	}
+	return files
+}
+
+// makeProxyShellScript returns a self-contained shell script that writes the proxy
+// configuration and CA certificates before running proxy.linux.sh. It is used for
+// nodegroups whose user data is a MIME multi-part document instead of a cloud-config
+func makeProxyShellScript(clusterConfig *api.ClusterConfig, ng *api.NodeGroupBase) string {
+	var sb strings.Builder
+	sb.WriteString("#!/bin/bash\n")
+	fmt.Fprintf(&sb, "mkdir -p %s\n", configDir)
+	for _, f := range makeProxyFiles(clusterConfig, ng) {
+		fmt.Fprintf(&sb, "cat > %s <<'EKSCTL_EOF'\n%s\nEKSCTL_EOF\n", f.Path, strings.TrimSuffix(f.Content, "\n"))
+	}
+	sb.WriteString(strings.TrimPrefix(assets.ProxyLinuxSh, "#!/bin/bash\n"))
+	return sb.String()
+}
+
+// setBottlerocketProxySettings sets the network and PKI settings for Bottlerocket nodes
+func setBottlerocketProxySettings(clusterConfig *api.ClusterConfig, ng *api.NodeGroupBase) {
+	settings := *ng.Bottlerocket.Settings
+	if ng.Proxy != nil {
+		networkSettings, ok := settings["network"].(map[string]interface{})
+		if !ok {
+			networkSettings = map[string]interface{}{}
+			settings["network"] = networkSettings
+		}
+		// Bottlerocket uses a single proxy for both HTTP and HTTPS traffic
+		proxyURL := ng.Proxy.HTTPSProxy
+		if proxyURL == "" {
+			proxyURL = ng.Proxy.HTTPProxy
+		}
+		networkSettings["https-proxy"] = proxyURL
+		networkSettings["no-proxy"] = noProxyList(clusterConfig, ng.Proxy)
+	}
+
+	if len(ng.ExtraCACerts) > 0 {
+		pkiSettings, ok := settings["pki"].(map[string]interface{})
+		if !ok {
+			pkiSettings = map[string]interface{}{}
+			settings["pki"] = pkiSettings
+		}
+		for i, cert := range ng.ExtraCACerts {
+			pkiSettings[fmt.Sprintf("eksctl-extra-ca-%d", i)] = map[string]interface{}{
+				"data":    base64.StdEncoding.EncodeToString([]byte(strings.TrimSpace(cert))),
+				"trusted": true,
+			}
+		}
+	}
+}
+
+// makeWindowsProxyCommands returns PowerShell commands that set the machine-wide proxy
+// environment and import CA certificates into the local machine root store
+func makeWindowsProxyCommands(clusterConfig *api.ClusterConfig, ng *api.NodeGroupBase) []string {
+	var commands []string
+	if ng.Proxy != nil {
+		setEnv := func(name, value string) {
+			if value != "" {
+				commands = append(commands, fmt.Sprintf("[Environment]::SetEnvironmentVariable(%q, %q, [EnvironmentVariableTarget]::Machine)", name, value))
+			}
+		}
+		setEnv("HTTP_PROXY", ng.Proxy.HTTPProxy)
+		setEnv("HTTPS_PROXY", ng.Proxy.HTTPSProxy)
+		setEnv("NO_PROXY", strings.Join(noProxyList(clusterConfig, ng.Proxy), ","))
+	}
+
+	if len(ng.ExtraCACerts) > 0 {
+		commands = append(commands, fmt.Sprintf(`New-Item -ItemType Directory -Force -Path "%s" | Out-Null`, windowsCACertsDir))
+		for i, cert := range ng.ExtraCACerts {
+			certPath := fmt.Sprintf(`%s\eksctl-extra-ca-%d.crt`, windowsCACertsDir, i)
+			commands = append(commands,
+				fmt.Sprintf("@'\n%s\n'@ | Set-Content -Path \"%s\"", strings.TrimSpace(cert), certPath),
+				fmt.Sprintf(`Import-Certificate -FilePath "%s" -CertStoreLocation Cert:\LocalMachine\Root | Out-Null`, certPath),
+			)
+		}
+	}
+	return commands
+}
@@ -0,0 +1,50 @@
+package nodebootstrap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// MaxUserDataSize is the maximum size of user data accepted by EC2, before it's base64-encoded
+const MaxUserDataSize = 16 * 1024
+
+// UserDataSize returns the size of base64-encoded userData as counted by EC2
+func UserDataSize(userData string) int {
+	decoded, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil {
+		return len(userData)
+	}
+	return len(decoded)
+}
+
+// ValidateUserDataSize checks that the base64-encoded userData of nodegroup ngName can be launched by EC2
+func ValidateUserDataSize(ngName, userData string) error {
+	if size := UserDataSize(userData); size > MaxUserDataSize {
+		return fmt.Errorf("user data of nodegroup %q is %d bytes, which exceeds the maximum of %d bytes accepted by EC2; "+
+			"reduce the size of preBootstrapCommands, overrideBootstrapCommand or kubeletExtraConfig", ngName, size, MaxUserDataSize)
+	}
+	return nil
+}
+
+// DecodeUserData returns the document in base64-encoded userData, decompressing it if it's gzipped
+func DecodeUserData(userData string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding user data")
+	}
+	// gzip streams start with the magic bytes 0x1f 0x8b
+	if !bytes.HasPrefix(decoded, []byte{0x1f, 0x8b}) {
+		return decoded, nil
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(decoded))
+	if err != nil {
+		return nil, errors.Wrap(err, "decompressing user data")
+	}
+	defer gr.Close()
+	return io.ReadAll(gr)
+}
@@ -0,0 +1,43 @@
+package nodebootstrap_test
+
+import (
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+)
+
+var _ = Describe("User data size", func() {
+	It("accepts user data of at most 16KB", func() {
+		userData := base64.StdEncoding.EncodeToString(make([]byte, nodebootstrap.MaxUserDataSize))
+		Expect(nodebootstrap.ValidateUserDataSize("ng", userData)).To(Succeed())
+	})
+
+	It("rejects user data larger than 16KB before it's base64-encoded", func() {
+		userData := base64.StdEncoding.EncodeToString(make([]byte, nodebootstrap.MaxUserDataSize+1))
+		Expect(nodebootstrap.ValidateUserDataSize("ng", userData)).To(MatchError(ContainSubstring(`user data of nodegroup "ng" is 16385 bytes`)))
+	})
+
+	It("decodes gzipped user data", func() {
+		config := cloudconfig.New()
+		config.AddShellCommand("echo hello")
+		userData, err := config.Encode()
+		Expect(err).NotTo(HaveOccurred())
+
+		document, err := nodebootstrap.DecodeUserData(userData)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(document)).To(HavePrefix("#cloud-config"))
+		Expect(string(document)).To(ContainSubstring("echo hello"))
+	})
+
+	It("decodes user data that isn't gzipped", func() {
+		script := strings.Join([]string{"#!/bin/bash", "echo hello"}, "\n")
+		document, err := nodebootstrap.DecodeUserData(base64.StdEncoding.EncodeToString([]byte(script)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(document)).To(Equal(script))
+	})
+})
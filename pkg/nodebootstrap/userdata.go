@@ -63,7 +63,7 @@ func NewBootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) (Boots
 func NewManagedBootstrapper(clusterConfig *api.ClusterConfig, ng *api.ManagedNodeGroup) Bootstrapper {
 	switch ng.AMIFamily {
 	case api.NodeImageFamilyAmazonLinux2:
-		return NewManagedAL2Bootstrapper(ng)
+		return NewManagedAL2Bootstrapper(clusterConfig, ng)
 	case api.NodeImageFamilyBottlerocket:
 		return NewManagedBottlerocketBootstrapper(clusterConfig, ng)
 	case api.NodeImageFamilyUbuntu1804, api.NodeImageFamilyUbuntu2004:
@@ -79,6 +79,17 @@ func GetClusterDNS(clusterConfig *api.ClusterConfig) (string, error) {
 		return "", nil
 	}
 
+	if networkConfig.IPv6Enabled() {
+		// the DNS service is always assigned the 10th address of the service CIDR, e.g. fd00:1234::a
+		ip, _, err := net.ParseCIDR(networkConfig.ServiceIPv6CIDR)
+		if err != nil {
+			return "", errors.Wrapf(err, "unexpected error parsing kubernetesNetworkConfig.serviceIPv6CIDR: %q", networkConfig.ServiceIPv6CIDR)
+		}
+		ip = ip.To16()
+		ip[net.IPv6len-1] = 10
+		return ip.String(), nil
+	}
+
 	ip, _, err := net.ParseCIDR(networkConfig.ServiceIPv4CIDR)
 	if err != nil {
 		return "", errors.Wrapf(err, "unexpected error parsing kubernetesNetworkConfig.serviceIPv4CIDR: %q", networkConfig.ServiceIPv4CIDR)
@@ -119,6 +130,23 @@ func linuxConfig(clusterConfig *api.ClusterConfig, bootScriptName, bootScriptCon
 	envFile := makeBootstrapEnv(clusterConfig, np)
 	files = append(files, envFile)
 
+	if unmanaged, ok := np.(*api.NodeGroup); ok && unmanaged.ElasticIPs != nil {
+		files = append(files, makeElasticIPsEnv(clusterConfig, unmanaged))
+		// nodes join the cluster once their public IPv4 address is the Elastic IP
+		scripts = append([]script{{name: linuxElasticIPsScript, contents: assets.ElasticIPsLinuxSh}}, scripts...)
+	}
+
+	if hasAuthorizedKeys(ng) {
+		files = append(files, makeAuthorizedKeysFile(ng))
+		scripts = append([]script{{name: linuxAuthorizedKeysScript, contents: assets.AuthorizedKeysLinuxSh}}, scripts...)
+	}
+
+	if hasProxyConfig(ng) {
+		files = append(files, makeProxyFiles(clusterConfig, ng)...)
+		// the proxy and trust store must be configured before the boot script pulls any images
+		scripts = append([]script{{name: linuxProxyScript, contents: assets.ProxyLinuxSh}}, scripts...)
+	}
+
 	if err := addFilesAndScripts(config, files, scripts); err != nil {
 		return "", err
 	}
@@ -173,6 +201,11 @@ func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool) cloudco
 		variables["CONTAINER_RUNTIME"] = unmanaged.GetContainerRuntime()
 	}
 
+	if networkConfig := clusterConfig.Status.KubernetesNetworkConfig; networkConfig != nil && networkConfig.IPv6Enabled() {
+		variables["IP_FAMILY"] = "ipv6"
+		variables["SERVICE_IPV6_CIDR"] = networkConfig.ServiceIPv6CIDR
+	}
+
 	return cloudconfig.File{
 		Path:    configDir + envFile,
 		Content: makeKeyValues(variables, "\n"),
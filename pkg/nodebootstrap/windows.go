@@ -36,6 +36,7 @@ func (b *Windows) UserData() (string, error) {
 [string]$EKSBootstrapScriptFile = "$env:ProgramFiles\Amazon\EKS\Start-EKSBootstrap.ps1"`,
 	}
 
+	bootstrapCommands = append(bootstrapCommands, makeWindowsProxyCommands(b.clusterConfig, b.ng.NodeGroupBase)...)
 	bootstrapCommands = append(bootstrapCommands, b.ng.PreBootstrapCommands...)
 	eksBootstrapCommand := fmt.Sprintf("& $EKSBootstrapScriptFile %s 3>&1 4>&1 5>&1 6>&1", b.makeBootstrapParams())
 	bootstrapCommands = append(bootstrapCommands,
@@ -146,6 +146,26 @@ start /wait msiexec.exe /qb /i "amazon-cloudwatch-agent.msi"
 [string]$EKSBootstrapScriptFile = "$env:ProgramFiles\Amazon\EKS\Start-EKSBootstrap.ps1"
 & $EKSBootstrapScriptFile -EKSClusterName "windohs" -APIServerEndpoint "https://test.com" -Base64ClusterCA "dGVzdA==" -ContainerRuntime "containerd" -KubeletExtraArgs "--node-labels= --register-with-taints=" 3>&1 4>&1 5>&1 6>&1
 </powershell>
+`,
+		}),
+
+		Entry("with proxy", windowsEntry{
+			updateNodeGroup: func(ng *api.NodeGroup) {
+				ng.Proxy = &api.NodeGroupProxy{
+					HTTPProxy:  "http://proxy.corp:3128",
+					HTTPSProxy: "http://proxy.corp:3128",
+					NoProxy:    []string{".corp"},
+				}
+			},
+
+			expectedUserData: `
+<powershell>
+[string]$EKSBootstrapScriptFile = "$env:ProgramFiles\Amazon\EKS\Start-EKSBootstrap.ps1"
+[Environment]::SetEnvironmentVariable("HTTP_PROXY", "http://proxy.corp:3128", [EnvironmentVariableTarget]::Machine)
+[Environment]::SetEnvironmentVariable("HTTPS_PROXY", "http://proxy.corp:3128", [EnvironmentVariableTarget]::Machine)
+[Environment]::SetEnvironmentVariable("NO_PROXY", "localhost,127.0.0.1,169.254.169.254,.internal,.svc,.cluster.local,192.168.0.0/16,test.com,.corp", [EnvironmentVariableTarget]::Machine)
+& $EKSBootstrapScriptFile -EKSClusterName "windohs" -APIServerEndpoint "https://test.com" -Base64ClusterCA "dGVzdA==" -ContainerRuntime "docker" -KubeletExtraArgs "--node-labels= --register-with-taints=" 3>&1 4>&1 5>&1 6>&1
+</powershell>
 `,
 		}),
 	)
@@ -0,0 +1,73 @@
+package operator
+
+import (
+	"context"
+	"errors"
+
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/client"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+// Actions are the operations the operator performs on EKS clusters
+//counterfeiter:generate -o fakes/fake_actions.go . Actions
+type Actions interface {
+	ClusterExists(ctx context.Context, cfg *api.ClusterConfig) (bool, error)
+	CreateCluster(ctx context.Context, cfg *api.ClusterConfig) error
+	CreateNodegroups(ctx context.Context, cfg *api.ClusterConfig) error
+	DeleteCluster(ctx context.Context, cfg *api.ClusterConfig) error
+}
+
+// NewActions returns Actions that run the same code as the eksctl commands
+func NewActions(awsOptions client.AWSOptions) Actions {
+	return &clientActions{awsOptions: awsOptions}
+}
+
+type clientActions struct {
+	awsOptions client.AWSOptions
+}
+
+func (a *clientActions) ClusterExists(ctx context.Context, cfg *api.ClusterConfig) (bool, error) {
+	cfg = cfg.DeepCopy()
+	ctl, err := eks.New(ctx, &api.ProviderConfig{
+		Region:      cfg.Metadata.Region,
+		Profile:     a.awsOptions.Profile,
+		WaitTimeout: api.DefaultWaitTimeout,
+	}, cfg)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := ctl.GetCluster(ctx, cfg.Metadata.Name); err != nil {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *clientActions) CreateCluster(ctx context.Context, cfg *api.ClusterConfig) error {
+	return client.CreateCluster(ctx, cfg, client.CreateClusterOptions{
+		AWSOptions:     a.awsOptions,
+		SkipKubeconfig: true,
+	})
+}
+
+func (a *clientActions) CreateNodegroups(ctx context.Context, cfg *api.ClusterConfig) error {
+	return client.CreateNodegroup(ctx, cfg, client.CreateNodegroupOptions{
+		AWSOptions: a.awsOptions,
+	})
+}
+
+func (a *clientActions) DeleteCluster(ctx context.Context, cfg *api.ClusterConfig) error {
+	return client.DeleteCluster(ctx, cfg, client.DeleteClusterOptions{
+		AWSOptions: a.awsOptions,
+		Wait:       true,
+	})
+}
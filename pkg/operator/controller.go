@@ -0,0 +1,150 @@
+// Package operator implements a Kubernetes controller that creates and deletes EKS clusters
+// described by ClusterConfig objects in a management cluster.
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	// go:embed to work
+	_ "embed"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed assets/crd.yaml
+var crdYAML []byte
+
+const resyncPeriod = 10 * time.Minute
+
+// InstallCRD creates the ClusterConfig CustomResourceDefinition if it doesn't exist
+func InstallCRD(ctx context.Context, restConfig *rest.Config) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.UnmarshalStrict(crdYAML, crd); err != nil {
+		return errors.Wrap(err, "parsing CustomResourceDefinition")
+	}
+
+	clientSet, err := apiextensionsclient.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	_, err = clientSet.ApiextensionsV1().CustomResourceDefinitions().Create(ctx, crd, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "creating CustomResourceDefinition %q", crd.Name)
+	}
+	return nil
+}
+
+// Controller watches ClusterConfig objects and reconciles them one at a time,
+// as operations on EKS clusters can take a long time and share the eksctl logger
+type Controller struct {
+	reconciler *Reconciler
+	informer   cache.SharedIndexInformer
+	queue      workqueue.RateLimitingInterface
+}
+
+// NewController returns a Controller watching the ClusterConfig objects in namespace, or in all
+// namespaces if namespace is empty
+func NewController(restConfig *rest.Config, namespace string, actions Actions) (*Controller, error) {
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	c := &Controller{
+		reconciler: &Reconciler{
+			Client:   dynamicClient.Resource(ClusterConfigResource),
+			Actions:  actions,
+			Recorder: broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "eksctl-operator"}),
+		},
+		informer: dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, resyncPeriod, namespace, nil).
+			ForResource(ClusterConfigResource).Informer(),
+		queue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+
+	enqueue := func(obj interface{}) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			logger.Warning("ignoring object: %v", err)
+			return
+		}
+		c.queue.Add(key)
+	}
+	c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    enqueue,
+		UpdateFunc: func(_, obj interface{}) { enqueue(obj) },
+	})
+	return c, nil
+}
+
+// Run reconciles ClusterConfig objects until ctx is cancelled
+func (c *Controller) Run(ctx context.Context) error {
+	defer c.queue.ShutDown()
+
+	go c.informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
+		return errors.New("timed out waiting for the ClusterConfig cache to sync")
+	}
+	logger.Info("watching ClusterConfig objects")
+
+	go func() {
+		<-ctx.Done()
+		c.queue.ShutDown()
+	}()
+	for c.processNextItem(ctx) {
+	}
+	return nil
+}
+
+func (c *Controller) processNextItem(ctx context.Context) bool {
+	key, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.reconcile(ctx, key.(string)); err != nil {
+		logger.Warning("failed to reconcile ClusterConfig %s: %v", key, err)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *Controller) reconcile(ctx context.Context, key string) error {
+	item, exists, err := c.informer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	obj, ok := item.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unexpected object of type %T", item)
+	}
+	return c.reconciler.Reconcile(ctx, obj)
+}
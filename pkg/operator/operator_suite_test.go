@@ -0,0 +1,11 @@
+package operator_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestOperator(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,240 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/record"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// ClusterConfigResource is the resource of ClusterConfig objects
+var ClusterConfigResource = schema.GroupVersionResource{
+	Group:    api.SchemeGroupVersion.Group,
+	Version:  api.SchemeGroupVersion.Version,
+	Resource: "clusterconfigs",
+}
+
+// Finalizer makes sure the EKS cluster is deleted before its ClusterConfig
+const Finalizer = "eksctl.io/cluster"
+
+// ConditionReady is the condition reporting whether the EKS cluster matches the ClusterConfig
+const ConditionReady = "Ready"
+
+// Reasons of the Ready condition, also used for events
+const (
+	ReasonCreating      = "Creating"
+	ReasonUpdating      = "Updating"
+	ReasonReconciled    = "Reconciled"
+	ReasonFailed        = "ReconcileFailed"
+	ReasonInvalidConfig = "InvalidConfig"
+	ReasonDeleting      = "Deleting"
+	ReasonDeleteFailed  = "DeleteFailed"
+)
+
+// Status is the status of a ClusterConfig object
+type Status struct {
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Reconciler reconciles EKS clusters with ClusterConfig objects
+type Reconciler struct {
+	Client   dynamic.NamespaceableResourceInterface
+	Actions  Actions
+	Recorder record.EventRecorder
+}
+
+// Reconcile creates the EKS cluster and nodegroups of obj, or deletes the cluster
+// when obj is being deleted
+func (r *Reconciler) Reconcile(ctx context.Context, obj *unstructured.Unstructured) error {
+	obj = obj.DeepCopy()
+	status, err := statusOf(obj)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := ClusterConfigFromObject(obj)
+	if err != nil {
+		r.Recorder.Event(obj, corev1.EventTypeWarning, ReasonInvalidConfig, err.Error())
+		return r.setReady(ctx, obj, status, metav1.ConditionFalse, ReasonInvalidConfig, err.Error())
+	}
+
+	if obj.GetDeletionTimestamp() != nil {
+		return r.reconcileDelete(ctx, obj, status, cfg)
+	}
+
+	if !hasFinalizer(obj) {
+		obj.SetFinalizers(append(obj.GetFinalizers(), Finalizer))
+		_, err := r.Client.Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{})
+		return err
+	}
+
+	if status.ObservedGeneration == obj.GetGeneration() && meta.IsStatusConditionTrue(status.Conditions, ConditionReady) {
+		return nil
+	}
+
+	exists, err := r.Actions.ClusterExists(ctx, cfg)
+	if err != nil {
+		return r.fail(ctx, obj, status, ReasonFailed, err)
+	}
+
+	if exists {
+		if err := r.progress(ctx, obj, status, ReasonUpdating, fmt.Sprintf("creating missing nodegroups of EKS cluster %q", cfg.Metadata.Name)); err != nil {
+			return err
+		}
+		err = r.Actions.CreateNodegroups(ctx, cfg)
+	} else {
+		if err := r.progress(ctx, obj, status, ReasonCreating, fmt.Sprintf("creating EKS cluster %q", cfg.Metadata.Name)); err != nil {
+			return err
+		}
+		err = r.Actions.CreateCluster(ctx, cfg)
+	}
+	if err != nil {
+		return r.fail(ctx, obj, status, ReasonFailed, err)
+	}
+
+	message := fmt.Sprintf("EKS cluster %q is up to date", cfg.Metadata.Name)
+	r.Recorder.Event(obj, corev1.EventTypeNormal, ReasonReconciled, message)
+	status.ObservedGeneration = obj.GetGeneration()
+	return r.setReady(ctx, obj, status, metav1.ConditionTrue, ReasonReconciled, message)
+}
+
+func (r *Reconciler) reconcileDelete(ctx context.Context, obj *unstructured.Unstructured, status *Status, cfg *api.ClusterConfig) error {
+	if !hasFinalizer(obj) {
+		return nil
+	}
+
+	if err := r.progress(ctx, obj, status, ReasonDeleting, fmt.Sprintf("deleting EKS cluster %q", cfg.Metadata.Name)); err != nil {
+		return err
+	}
+
+	exists, err := r.Actions.ClusterExists(ctx, cfg)
+	if err != nil {
+		return r.fail(ctx, obj, status, ReasonDeleteFailed, err)
+	}
+	if exists {
+		if err := r.Actions.DeleteCluster(ctx, cfg); err != nil {
+			return r.fail(ctx, obj, status, ReasonDeleteFailed, err)
+		}
+	}
+
+	var finalizers []string
+	for _, f := range obj.GetFinalizers() {
+		if f != Finalizer {
+			finalizers = append(finalizers, f)
+		}
+	}
+	obj.SetFinalizers(finalizers)
+	_, err = r.Client.Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *Reconciler) progress(ctx context.Context, obj *unstructured.Unstructured, status *Status, reason, message string) error {
+	logger.Info("%s/%s: %s", obj.GetNamespace(), obj.GetName(), message)
+	r.Recorder.Event(obj, corev1.EventTypeNormal, reason, message)
+	return r.setReady(ctx, obj, status, metav1.ConditionFalse, reason, message)
+}
+
+// fail records err and returns it, so that the object is reconciled again with a backoff
+func (r *Reconciler) fail(ctx context.Context, obj *unstructured.Unstructured, status *Status, reason string, err error) error {
+	r.Recorder.Event(obj, corev1.EventTypeWarning, reason, err.Error())
+	if statusErr := r.setReady(ctx, obj, status, metav1.ConditionFalse, reason, err.Error()); statusErr != nil {
+		logger.Warning("failed to update status of %s/%s: %v", obj.GetNamespace(), obj.GetName(), statusErr)
+	}
+	return err
+}
+
+func (r *Reconciler) setReady(ctx context.Context, obj *unstructured.Unstructured, status *Status, conditionStatus metav1.ConditionStatus, reason, message string) error {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               ConditionReady,
+		Status:             conditionStatus,
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+	statusObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+	if err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedMap(obj.Object, statusObj, "status"); err != nil {
+		return err
+	}
+
+	updated, err := r.Client.Namespace(obj.GetNamespace()).UpdateStatus(ctx, obj, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrap(err, "updating status")
+	}
+	// keep the latest resourceVersion for the following updates
+	obj.SetResourceVersion(updated.GetResourceVersion())
+	return nil
+}
+
+// ClusterConfigFromObject returns the ClusterConfig in the spec of obj, validated like a config file;
+// metadata.name defaults to the name of obj
+func ClusterConfigFromObject(obj *unstructured.Unstructured) (*api.ClusterConfig, error) {
+	if err := api.Register(); err != nil {
+		return nil, err
+	}
+
+	spec, found, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("spec must be set")
+	}
+	if name, _, _ := unstructured.NestedString(spec, "metadata", "name"); name == "" {
+		if err := unstructured.SetNestedField(spec, obj.GetName(), "metadata", "name"); err != nil {
+			return nil, err
+		}
+	}
+	spec["apiVersion"] = api.SchemeGroupVersion.String()
+	spec["kind"] = api.ClusterConfigKind
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := eks.ParseConfig(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid spec")
+	}
+	if cfg.Metadata.Region == "" {
+		return nil, errors.New("spec.metadata.region must be set")
+	}
+	return cfg, nil
+}
+
+func statusOf(obj *unstructured.Unstructured) (*Status, error) {
+	status := &Status{}
+	statusObj, found, err := unstructured.NestedMap(obj.Object, "status")
+	if err != nil || !found {
+		return status, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(statusObj, status); err != nil {
+		return nil, errors.Wrap(err, "invalid status")
+	}
+	return status, nil
+}
+
+func hasFinalizer(obj *unstructured.Unstructured) bool {
+	for _, f := range obj.GetFinalizers() {
+		if f == Finalizer {
+			return true
+		}
+	}
+	return false
+}
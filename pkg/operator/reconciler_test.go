@@ -0,0 +1,163 @@
+package operator_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/weaveworks/eksctl/pkg/operator"
+	"github.com/weaveworks/eksctl/pkg/operator/fakes"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		obj          *unstructured.Unstructured
+		fakeActions  *fakes.FakeActions
+		fakeRecorder *record.FakeRecorder
+		reconciler   *operator.Reconciler
+	)
+
+	newObject := func(finalizers ...string) *unstructured.Unstructured {
+		o := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "eksctl.io/v1alpha5",
+			"kind":       "ClusterConfig",
+			"spec": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"region": "us-west-2",
+				},
+				"managedNodeGroups": []interface{}{
+					map[string]interface{}{"name": "ng-1"},
+				},
+			},
+		}}
+		o.SetName("dev")
+		o.SetNamespace("clusters")
+		o.SetGeneration(1)
+		o.SetFinalizers(finalizers)
+		return o
+	}
+
+	setup := func(o *unstructured.Unstructured) {
+		obj = o
+		client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			operator.ClusterConfigResource: "ClusterConfigList",
+		}, obj)
+		fakeActions = &fakes.FakeActions{}
+		fakeRecorder = record.NewFakeRecorder(10)
+		reconciler = &operator.Reconciler{
+			Client:   client.Resource(operator.ClusterConfigResource),
+			Actions:  fakeActions,
+			Recorder: fakeRecorder,
+		}
+	}
+
+	get := func() *unstructured.Unstructured {
+		o, err := reconciler.Client.Namespace("clusters").Get(context.Background(), "dev", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return o
+	}
+
+	readyCondition := func(o *unstructured.Unstructured) *metav1.Condition {
+		status := &operator.Status{}
+		statusObj, _, err := unstructured.NestedMap(o.Object, "status")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(statusObj, status)).To(Succeed())
+		return meta.FindStatusCondition(status.Conditions, operator.ConditionReady)
+	}
+
+	It("adds the finalizer first", func() {
+		setup(newObject())
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(Succeed())
+		Expect(get().GetFinalizers()).To(ConsistOf(operator.Finalizer))
+		Expect(fakeActions.Invocations()).To(BeEmpty())
+	})
+
+	It("creates the cluster when it doesn't exist", func() {
+		setup(newObject(operator.Finalizer))
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(Succeed())
+
+		Expect(fakeActions.CreateClusterCallCount()).To(Equal(1))
+		_, cfg := fakeActions.CreateClusterArgsForCall(0)
+		Expect(cfg.Metadata.Name).To(Equal("dev"))
+		Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+
+		condition := readyCondition(get())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(operator.ReasonReconciled))
+		Expect(fakeRecorder.Events).To(Receive(ContainSubstring(operator.ReasonCreating)))
+		Expect(fakeRecorder.Events).To(Receive(ContainSubstring(operator.ReasonReconciled)))
+	})
+
+	It("creates the missing nodegroups of existing clusters", func() {
+		setup(newObject(operator.Finalizer))
+		fakeActions.ClusterExistsReturns(true, nil)
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(Succeed())
+
+		Expect(fakeActions.CreateClusterCallCount()).To(BeZero())
+		Expect(fakeActions.CreateNodegroupsCallCount()).To(Equal(1))
+	})
+
+	It("does nothing when the generation was already reconciled", func() {
+		setup(newObject(operator.Finalizer))
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(Succeed())
+		Expect(reconciler.Reconcile(context.Background(), get())).To(Succeed())
+		Expect(fakeActions.ClusterExistsCallCount()).To(Equal(1))
+	})
+
+	It("reports failures in the status and returns them", func() {
+		setup(newObject(operator.Finalizer))
+		fakeActions.CreateClusterReturns(errors.New("quota exceeded"))
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(MatchError("quota exceeded"))
+
+		condition := readyCondition(get())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(operator.ReasonFailed))
+		Expect(condition.Message).To(Equal("quota exceeded"))
+	})
+
+	It("reports invalid configs", func() {
+		o := newObject(operator.Finalizer)
+		Expect(unstructured.SetNestedField(o.Object, "", "spec", "metadata", "region")).To(Succeed())
+		setup(o)
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(Succeed())
+
+		Expect(readyCondition(get()).Reason).To(Equal(operator.ReasonInvalidConfig))
+		Expect(fakeActions.Invocations()).To(BeEmpty())
+	})
+
+	It("deletes the cluster before removing the finalizer", func() {
+		o := newObject(operator.Finalizer)
+		now := metav1.Now()
+		o.SetDeletionTimestamp(&now)
+		setup(o)
+		fakeActions.ClusterExistsReturns(true, nil)
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(Succeed())
+
+		Expect(fakeActions.DeleteClusterCallCount()).To(Equal(1))
+		Expect(get().GetFinalizers()).To(BeEmpty())
+	})
+
+	It("keeps the finalizer when deleting the cluster fails", func() {
+		o := newObject(operator.Finalizer)
+		now := metav1.Now()
+		o.SetDeletionTimestamp(&now)
+		setup(o)
+		fakeActions.ClusterExistsReturns(true, nil)
+		fakeActions.DeleteClusterReturns(errors.New("stack deletion failed"))
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(HaveOccurred())
+
+		o = get()
+		Expect(o.GetFinalizers()).To(ConsistOf(operator.Finalizer))
+		Expect(readyCondition(o).Reason).To(Equal(operator.ReasonDeleteFailed))
+	})
+})
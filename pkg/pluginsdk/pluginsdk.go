@@ -0,0 +1,119 @@
+// Package pluginsdk helps writing eksctl plugins, executables named `eksctl-<name>`
+// that eksctl runs for `eksctl <name>`. Plugins that use it take the same
+// --config-file, --cluster, --region, --profile and --timeout flags as eksctl
+// commands, and get AWS clients that are set up like eksctl's.
+package pluginsdk
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/plugin"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// EksctlVersion returns the version of eksctl that runs the plugin, or an
+// empty string when the plugin wasn't run by eksctl
+func EksctlVersion() string {
+	return os.Getenv(plugin.VersionEnvName)
+}
+
+// EksctlBinary returns the path of the eksctl binary that runs the plugin,
+// or an empty string when the plugin wasn't run by eksctl
+func EksctlBinary() string {
+	return os.Getenv(plugin.BinaryEnvName)
+}
+
+// Options holds the cluster and AWS client settings of a plugin
+type Options struct {
+	ConfigFile     string
+	ClusterConfig  *api.ClusterConfig
+	ProviderConfig api.ProviderConfig
+}
+
+// NewOptions returns Options with the same defaults as eksctl commands
+func NewOptions() *Options {
+	return &Options{
+		ClusterConfig: api.NewClusterConfig(),
+		ProviderConfig: api.ProviderConfig{
+			WaitTimeout: api.DefaultWaitTimeout,
+		},
+	}
+}
+
+// AddFlags adds the flags of eksctl commands that select a cluster and the AWS credentials to fs
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	cmdutils.AddConfigFileFlag(fs, &o.ConfigFile)
+	cmdutils.AddClusterFlag(fs, o.ClusterConfig.Metadata)
+	cmdutils.AddRegionFlag(fs, &o.ProviderConfig)
+	fs.StringVarP(&o.ProviderConfig.Profile, "profile", "p", os.Getenv("AWS_PROFILE"), "AWS credentials profile to use (defaults to value of the AWS_PROFILE environment variable)")
+	cmdutils.AddTimeoutFlag(fs, &o.ProviderConfig.WaitTimeout)
+}
+
+// LoadClusterConfig returns the ClusterConfig from --config-file, or one
+// with the name and region given by --cluster and --region
+func (o *Options) LoadClusterConfig() (*api.ClusterConfig, error) {
+	if err := api.Register(); err != nil {
+		return nil, err
+	}
+
+	if o.ConfigFile == "" {
+		if o.ClusterConfig.Metadata.Name == "" {
+			return nil, cmdutils.ErrMustBeSet("--cluster")
+		}
+		o.ClusterConfig.Metadata.Region = o.ProviderConfig.Region
+		return o.ClusterConfig, nil
+	}
+
+	if o.ClusterConfig.Metadata.Name != "" {
+		return nil, cmdutils.ErrCannotUseWithConfigFile("--cluster")
+	}
+	if o.ProviderConfig.Region != "" {
+		return nil, cmdutils.ErrCannotUseWithConfigFile("--region")
+	}
+
+	clusterConfig, err := eks.LoadConfigFromFile(o.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	meta := clusterConfig.Metadata
+	if meta == nil {
+		return nil, cmdutils.ErrMustBeSet("metadata")
+	}
+	if meta.Name == "" {
+		return nil, cmdutils.ErrMustBeSet("metadata.name")
+	}
+	if meta.Region == "" {
+		return nil, cmdutils.ErrMustBeSet("metadata.region")
+	}
+	o.ProviderConfig.Region = meta.Region
+	if meta.EndpointsMode != "" {
+		o.ProviderConfig.EndpointsMode = meta.EndpointsMode
+	}
+	if len(meta.ServiceEndpoints) > 0 {
+		o.ProviderConfig.ServiceEndpoints = meta.ServiceEndpoints
+	}
+
+	o.ClusterConfig = clusterConfig
+	return clusterConfig, nil
+}
+
+// NewClusterProvider returns the AWS clients for the ClusterConfig returned by
+// LoadClusterConfig, with the credentials, retries and endpoints eksctl uses
+func (o *Options) NewClusterProvider(ctx context.Context) (*eks.ClusterProvider, error) {
+	return eks.New(ctx, &o.ProviderConfig, o.ClusterConfig)
+}
+
+// NewClientSet returns a Kubernetes client for the cluster, authenticated
+// with the AWS credentials of ctl
+func (o *Options) NewClientSet(ctx context.Context, ctl *eks.ClusterProvider) (*kubernetes.Clientset, error) {
+	if err := ctl.RefreshClusterStatus(ctx, o.ClusterConfig); err != nil {
+		return nil, err
+	}
+	return ctl.NewStdClientSet(o.ClusterConfig)
+}
@@ -0,0 +1,11 @@
+package pluginsdk_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestPluginSDK(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,85 @@
+package pluginsdk_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/pluginsdk"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *pluginsdk.Options
+		fs      *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		options = pluginsdk.NewOptions()
+		fs = pflag.NewFlagSet("plugin", pflag.ContinueOnError)
+		options.AddFlags(fs)
+	})
+
+	It("uses the cluster and region flags without a config file", func() {
+		Expect(fs.Parse([]string{"--cluster", "test", "--region", "us-west-2"})).To(Succeed())
+
+		clusterConfig, err := options.LoadClusterConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterConfig.Metadata.Name).To(Equal("test"))
+		Expect(clusterConfig.Metadata.Region).To(Equal("us-west-2"))
+	})
+
+	It("requires a cluster name", func() {
+		Expect(fs.Parse([]string{})).To(Succeed())
+
+		_, err := options.LoadClusterConfig()
+		Expect(err).To(MatchError("--cluster must be set"))
+	})
+
+	When("a config file is given", func() {
+		var (
+			dir        string
+			configFile string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "pluginsdk")
+			Expect(err).NotTo(HaveOccurred())
+
+			configFile = filepath.Join(dir, "cluster.yaml")
+			Expect(os.WriteFile(configFile, []byte(`apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: test
+  region: eu-west-1
+  endpointsMode: fips
+`), 0644)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		It("loads the ClusterConfig and the provider settings from it", func() {
+			Expect(fs.Parse([]string{"-f", configFile, "--profile", "dev"})).To(Succeed())
+
+			clusterConfig, err := options.LoadClusterConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterConfig.Metadata.Name).To(Equal("test"))
+			Expect(options.ProviderConfig.Region).To(Equal("eu-west-1"))
+			Expect(options.ProviderConfig.EndpointsMode).To(Equal("fips"))
+			Expect(options.ProviderConfig.Profile).To(Equal("dev"))
+		})
+
+		It("rejects --cluster", func() {
+			Expect(fs.Parse([]string{"-f", configFile, "--cluster", "other"})).To(Succeed())
+
+			_, err := options.LoadClusterConfig()
+			Expect(err).To(MatchError("cannot use --cluster when --config-file/-f is set"))
+		})
+	})
+})
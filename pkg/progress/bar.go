@@ -0,0 +1,101 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// barWidth is the number of characters of the bar itself
+const barWidth = 20
+
+// clearLine moves the cursor to the start of the line and clears it
+const clearLine = "\r\033[K"
+
+// bar draws the progress of a tracker on the last line of a terminal
+type bar struct {
+	mu      sync.Mutex
+	out     io.Writer
+	tracker *Tracker
+	drawn   bool
+}
+
+// barWriter writes the logs above the bar
+type barWriter struct {
+	bar *bar
+}
+
+func (w barWriter) Write(p []byte) (int, error) {
+	w.bar.mu.Lock()
+	defer w.bar.mu.Unlock()
+	w.bar.clear()
+	n, err := w.bar.out.Write(p)
+	w.bar.draw()
+	return n, err
+}
+
+// EnableBar is like the package function, the bar is redrawn every interval
+func (t *Tracker) EnableBar(w io.Writer, interval time.Duration) io.Writer {
+	b := &bar{out: w, tracker: t}
+	t.mu.Lock()
+	t.bar = b
+	t.mu.Unlock()
+	// the bar is redrawn for as long as eksctl runs, so that the progress of the phases is updated
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			b.redraw()
+		}
+	}()
+	return barWriter{bar: b}
+}
+
+// redraw redraws the bar if it's enabled
+func (t *Tracker) redraw() {
+	t.mu.Lock()
+	b := t.bar
+	t.mu.Unlock()
+	if b != nil {
+		b.redraw()
+	}
+}
+
+func (b *bar) redraw() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clear()
+	b.draw()
+}
+
+func (b *bar) clear() {
+	if b.drawn {
+		fmt.Fprint(b.out, clearLine)
+		b.drawn = false
+	}
+}
+
+func (b *bar) draw() {
+	if line := b.tracker.Line(); line != "" {
+		fmt.Fprint(b.out, line)
+		b.drawn = true
+	}
+}
+
+// Line returns the progress bar, the overall progress followed by the phase in progress and the tasks that are
+// done, or an empty string when no phase is in progress
+func (t *Tracker) Line() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.runs) == 0 {
+		return ""
+	}
+	overall, phases := t.progress()
+	filled := int(overall * barWidth)
+	line := fmt.Sprintf("[%s%s] %3d%% %s", strings.Repeat("=", filled), strings.Repeat(" ", barWidth-filled), int(overall*100), t.phaseSummary(phases))
+	if t.totalTasks > 0 {
+		line += fmt.Sprintf(", %d of %d tasks done", t.doneTasks, t.totalTasks)
+	}
+	return line
+}
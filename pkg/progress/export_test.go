@@ -0,0 +1,8 @@
+package progress
+
+import "time"
+
+// SetNow sets the clock of the tracker
+func (t *Tracker) SetNow(now func() time.Time) {
+	t.now = now
+}
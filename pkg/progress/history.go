@@ -0,0 +1,73 @@
+package progress
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	// maxTimings is the number of timings kept for each phase, older ones are dropped
+	maxTimings = 20
+	// minTimings is the number of timings a phase needs for them to be used instead of its default estimate
+	minTimings = 3
+)
+
+// timings are the durations in seconds of the last runs of each phase, oldest first
+type timings map[string][]float64
+
+func loadTimings(path string) (timings, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return timings{}, nil
+		}
+		return nil, err
+	}
+	t := timings{}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t timings) save(path string) error {
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+func (t timings) add(phase string, elapsed time.Duration) {
+	durations := append(t[phase], elapsed.Seconds())
+	if len(durations) > maxTimings {
+		durations = durations[len(durations)-maxTimings:]
+	}
+	t[phase] = durations
+}
+
+// estimate returns the range of the recorded timings of phase, without the fastest and slowest tenth of them
+func (t timings) estimate(phase string) (Estimate, bool) {
+	if len(t[phase]) < minTimings {
+		return Estimate{}, false
+	}
+	durations := make([]float64, len(t[phase]))
+	copy(durations, t[phase])
+	sort.Float64s(durations)
+	outliers := len(durations) / 10
+	return Estimate{
+		Min: seconds(durations[outliers]),
+		Max: seconds(durations[len(durations)-1-outliers]),
+	}, true
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
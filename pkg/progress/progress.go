@@ -0,0 +1,311 @@
+// Package progress estimates how long the CloudFormation stacks eksctl waits for take, from the timings of
+// previous runs, and shows the progress of the running command: the estimate of each stack when eksctl starts
+// waiting for it, and a progress bar of the stacks and tasks when the logs are written to a terminal
+package progress
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+)
+
+// Operations on stacks that have estimates
+const (
+	OperationCreate = "create"
+	OperationDelete = "delete"
+	OperationUpdate = "update"
+)
+
+// Phase is a kind of stack operation whose duration is estimated, e.g. creating the control plane
+type Phase struct {
+	Operation string
+	Resource  string
+}
+
+func (p Phase) String() string {
+	return p.Operation + " " + p.Resource
+}
+
+// Describe returns the phase as a step in progress, e.g. "creating control plane"
+func (p Phase) Describe() string {
+	return strings.TrimSuffix(p.Operation, "e") + "ing " + p.Resource
+}
+
+// Estimate is the range of durations of a phase
+type Estimate struct {
+	Min, Max time.Duration
+}
+
+func (e Estimate) String() string {
+	minMinutes, maxMinutes := int(e.Min.Minutes()), int(math.Ceil(e.Max.Minutes()))
+	switch {
+	case maxMinutes <= 1:
+		return "~1 min"
+	case minMinutes == maxMinutes:
+		return fmt.Sprintf("~%d min", maxMinutes)
+	default:
+		return fmt.Sprintf("~%d–%d min", minMinutes, maxMinutes)
+	}
+}
+
+// defaultEstimates are the typical durations of phases, used until enough timings are recorded
+var defaultEstimates = map[string]Estimate{
+	"create control plane":       {9 * time.Minute, 12 * time.Minute},
+	"update control plane":       {1 * time.Minute, 3 * time.Minute},
+	"delete control plane":       {5 * time.Minute, 10 * time.Minute},
+	"create nodegroup":           {3 * time.Minute, 6 * time.Minute},
+	"update nodegroup":           {1 * time.Minute, 5 * time.Minute},
+	"delete nodegroup":           {3 * time.Minute, 6 * time.Minute},
+	"create IAM service account": {30 * time.Second, 1 * time.Minute},
+	"update IAM service account": {30 * time.Second, 1 * time.Minute},
+	"delete IAM service account": {30 * time.Second, 1 * time.Minute},
+	"create stack":               {1 * time.Minute, 5 * time.Minute},
+	"update stack":               {1 * time.Minute, 5 * time.Minute},
+	"delete stack":               {1 * time.Minute, 5 * time.Minute},
+}
+
+// ClassifyStackWait returns the phase of waiting for stackName with the waiter named waiter, e.g.
+// "wait for stack creation", it returns false for waits that aren't estimated, such as changesets
+func ClassifyStackWait(waiter, stackName string) (Phase, bool) {
+	var phase Phase
+	switch waiter {
+	case "wait for stack creation":
+		phase.Operation = OperationCreate
+	case "wait for stack update":
+		phase.Operation = OperationUpdate
+	case "wait for stack deletion":
+		phase.Operation = OperationDelete
+	default:
+		return phase, false
+	}
+	switch {
+	case strings.HasSuffix(stackName, "-cluster"):
+		phase.Resource = "control plane"
+	case strings.Contains(stackName, "-addon-iamserviceaccount-"):
+		phase.Resource = "IAM service account"
+	case strings.Contains(stackName, "-nodegroup-"):
+		phase.Resource = "nodegroup"
+	default:
+		phase.Resource = "stack"
+	}
+	return phase, true
+}
+
+// run is a phase in progress
+type run struct {
+	phase     Phase
+	stackName string
+	start     time.Time
+	estimate  Estimate
+}
+
+// Tracker estimates phases and tracks the ones in progress
+type Tracker struct {
+	mu  sync.Mutex
+	now func() time.Time
+
+	historyPath string
+	history     timings
+
+	runs       []*run
+	totalTasks int
+	doneTasks  int
+
+	bar *bar
+}
+
+// NewTracker creates a tracker that records timings in historyPath, no timings are recorded if it's empty
+func NewTracker(historyPath string) *Tracker {
+	return &Tracker{
+		now:         time.Now,
+		historyPath: historyPath,
+	}
+}
+
+var defaultTracker = NewTracker(defaultHistoryPath())
+
+func defaultHistoryPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".eksctl", "timings.json")
+}
+
+// StartStackWait logs the estimate of waiting for a stack, see ClassifyStackWait, the returned func records the
+// end of the wait and, if it succeeded, its duration
+func StartStackWait(waiter, stackName string) func(err error) {
+	return defaultTracker.StartStackWait(waiter, stackName)
+}
+
+// StartTasks adds n tasks to the steps of the command
+func StartTasks(n int) {
+	defaultTracker.StartTasks(n)
+}
+
+// TaskDone records that a task of the command completed
+func TaskDone() {
+	defaultTracker.TaskDone()
+}
+
+// EnableBar shows a progress bar at the bottom of the terminal w while stacks are in progress, the logs must be
+// written to the returned writer so that the bar is redrawn below them
+func EnableBar(w io.Writer) io.Writer {
+	return defaultTracker.EnableBar(w, time.Second)
+}
+
+// StartStackWait is like the package function
+func (t *Tracker) StartStackWait(waiter, stackName string) func(err error) {
+	phase, ok := ClassifyStackWait(waiter, stackName)
+	if !ok {
+		return func(error) {}
+	}
+	estimate := t.Estimate(phase)
+	logger.Info("%s (stack %q) %s", phase.Describe(), stackName, estimate)
+
+	t.mu.Lock()
+	r := &run{phase: phase, stackName: stackName, start: t.now(), estimate: estimate}
+	t.runs = append(t.runs, r)
+	t.mu.Unlock()
+	t.redraw()
+
+	return func(err error) {
+		t.mu.Lock()
+		for i, other := range t.runs {
+			if other == r {
+				t.runs = append(t.runs[:i], t.runs[i+1:]...)
+				break
+			}
+		}
+		elapsed := t.now().Sub(r.start)
+		t.mu.Unlock()
+		t.redraw()
+		if err == nil {
+			t.record(phase, elapsed)
+		}
+	}
+}
+
+// StartTasks is like the package function
+func (t *Tracker) StartTasks(n int) {
+	t.mu.Lock()
+	t.totalTasks += n
+	t.mu.Unlock()
+}
+
+// TaskDone is like the package function
+func (t *Tracker) TaskDone() {
+	t.mu.Lock()
+	t.doneTasks++
+	t.mu.Unlock()
+	t.redraw()
+}
+
+// Estimate returns the estimate of phase from the recorded timings, or its default estimate if there are
+// too few of them
+func (t *Tracker) Estimate(phase Phase) Estimate {
+	t.mu.Lock()
+	err := t.loadHistory()
+	estimate, ok := t.history.estimate(phase.String())
+	t.mu.Unlock()
+	if err != nil {
+		logger.Debug("ignoring the timings of previous runs: %v", err)
+	}
+	if ok {
+		return estimate
+	}
+	return defaultEstimates[phase.String()]
+}
+
+// Progress returns the overall progress of the command, from 0 to 1, and the progress of each phase in
+// progress. A phase is never complete before it ends, however long it takes
+func (t *Tracker) Progress() (float64, []float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.progress()
+}
+
+func (t *Tracker) progress() (float64, []float64) {
+	var (
+		phases []float64
+		sum    float64
+	)
+	now := t.now()
+	for _, r := range t.runs {
+		p := math.Min(float64(now.Sub(r.start))/float64(r.estimate.Max), 0.99)
+		phases = append(phases, p)
+		sum += p
+	}
+	var overall float64
+	switch {
+	case t.totalTasks > 0:
+		overall = (float64(t.doneTasks) + sum) / float64(t.totalTasks)
+		if t.doneTasks < t.totalTasks {
+			overall = math.Min(overall, 0.99)
+		}
+	case len(phases) > 0:
+		overall = sum / float64(len(phases))
+	}
+	return math.Min(overall, 1), phases
+}
+
+func (t *Tracker) record(phase Phase, elapsed time.Duration) {
+	if t.historyPath == "" {
+		return
+	}
+	// nothing is logged while t.mu is held, as logging redraws the progress bar
+	t.mu.Lock()
+	_ = t.loadHistory()
+	t.history.add(phase.String(), elapsed)
+	err := t.history.save(t.historyPath)
+	t.mu.Unlock()
+	if err != nil {
+		logger.Debug("failed to record the timings of %s: %v", phase, err)
+	}
+}
+
+// loadHistory loads the recorded timings the first time it's called
+func (t *Tracker) loadHistory() error {
+	if t.history != nil {
+		return nil
+	}
+	t.history = timings{}
+	if t.historyPath == "" {
+		return nil
+	}
+	history, err := loadTimings(t.historyPath)
+	if err != nil {
+		return err
+	}
+	t.history = history
+	return nil
+}
+
+// phaseSummary describes the phases in progress for the progress bar, the phase that started first is shown
+// as it's the one expected to end first
+func (t *Tracker) phaseSummary(phases []float64) string {
+	first := 0
+	for i, r := range t.runs {
+		if r.start.Before(t.runs[first].start) {
+			first = i
+		}
+	}
+	r := t.runs[first]
+	summary := fmt.Sprintf("%s %d%% (%s of %s)", r.phase.Describe(), int(phases[first]*100), formatElapsed(t.now().Sub(r.start)), r.estimate)
+	if len(t.runs) > 1 {
+		summary += fmt.Sprintf(" and %d more", len(t.runs)-1)
+	}
+	return summary
+}
+
+func formatElapsed(d time.Duration) string {
+	d = d.Round(time.Second)
+	return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+}
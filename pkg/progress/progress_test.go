@@ -0,0 +1,123 @@
+package progress_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/progress"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestProgress(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
+
+var _ = Describe("Progress", func() {
+	DescribeTable("classifying stack waits", func(waiter, stackName, phase string, ok bool) {
+		p, classified := progress.ClassifyStackWait(waiter, stackName)
+		Expect(classified).To(Equal(ok))
+		if ok {
+			Expect(p.String()).To(Equal(phase))
+		}
+	},
+		Entry("control plane", "wait for stack creation", "eksctl-test-cluster", "create control plane", true),
+		Entry("nodegroup", "wait for stack deletion", "eksctl-test-nodegroup-ng-1", "delete nodegroup", true),
+		Entry("IAM service account", "wait for stack update", "eksctl-test-addon-iamserviceaccount-kube-system-sa", "update IAM service account", true),
+		Entry("other stacks", "wait for stack creation", "eksctl-test-addon-vpc-cni", "create stack", true),
+		Entry("changesets", "wait for changeset creation", "eksctl-test-cluster", "", false),
+	)
+
+	DescribeTable("formatting estimates", func(estimate progress.Estimate, expected string) {
+		Expect(estimate.String()).To(Equal(expected))
+	},
+		Entry("under a minute", progress.Estimate{Min: 30 * time.Second, Max: time.Minute}, "~1 min"),
+		Entry("same minute", progress.Estimate{Min: 4 * time.Minute, Max: 4 * time.Minute}, "~4 min"),
+		Entry("range", progress.Estimate{Min: 9 * time.Minute, Max: 12 * time.Minute}, "~9–12 min"),
+	)
+
+	var (
+		tracker     *progress.Tracker
+		dir         string
+		historyPath string
+		now         time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "progress")
+		Expect(err).NotTo(HaveOccurred())
+		historyPath = filepath.Join(dir, ".eksctl", "timings.json")
+		now = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		tracker = progress.NewTracker(historyPath)
+		tracker.SetNow(func() time.Time { return now })
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	controlPlane := progress.Phase{Operation: progress.OperationCreate, Resource: "control plane"}
+
+	It("uses the default estimates until enough timings are recorded", func() {
+		Expect(tracker.Estimate(controlPlane)).To(Equal(progress.Estimate{Min: 9 * time.Minute, Max: 12 * time.Minute}))
+
+		for _, d := range []time.Duration{14 * time.Minute, 15 * time.Minute, 16 * time.Minute} {
+			endPhase := tracker.StartStackWait("wait for stack creation", "eksctl-test-cluster")
+			now = now.Add(d)
+			endPhase(nil)
+		}
+		// failed waits aren't recorded
+		endPhase := tracker.StartStackWait("wait for stack creation", "eksctl-test-cluster")
+		now = now.Add(time.Minute)
+		endPhase(errors.New("failed"))
+
+		Expect(historyPath).To(BeARegularFile())
+		Expect(progress.NewTracker(historyPath).Estimate(controlPlane)).To(Equal(progress.Estimate{Min: 14 * time.Minute, Max: 16 * time.Minute}))
+	})
+
+	It("ignores unreadable timings", func() {
+		Expect(os.MkdirAll(filepath.Dir(historyPath), 0755)).To(Succeed())
+		Expect(os.WriteFile(historyPath, []byte("{"), 0600)).To(Succeed())
+		Expect(tracker.Estimate(controlPlane)).To(Equal(progress.Estimate{Min: 9 * time.Minute, Max: 12 * time.Minute}))
+	})
+
+	It("reports the progress of phases and tasks", func() {
+		Expect(tracker.Line()).To(BeEmpty())
+
+		tracker.StartTasks(4)
+		tracker.TaskDone()
+		endPhase := tracker.StartStackWait("wait for stack creation", "eksctl-test-cluster")
+		tracker.StartStackWait("wait for stack creation", "eksctl-test-nodegroup-ng-1")
+		now = now.Add(6 * time.Minute)
+
+		overall, phases := tracker.Progress()
+		Expect(phases).To(Equal([]float64{0.5, 0.99}))
+		Expect(overall).To(BeNumerically("~", (1+0.5+0.99)/4))
+		Expect(tracker.Line()).To(Equal("[============        ]  62% creating control plane 50% (6m00s of ~9–12 min) and 1 more, 1 of 4 tasks done"))
+
+		endPhase(nil)
+		Expect(tracker.Line()).To(HaveSuffix("creating nodegroup 99% (6m00s of ~3–6 min), 1 of 4 tasks done"))
+	})
+
+	It("redraws the bar below the logs", func() {
+		out := &bytes.Buffer{}
+		w := tracker.EnableBar(out, time.Hour)
+		_, err := w.Write([]byte("log 1\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(Equal("log 1\n"))
+
+		tracker.StartStackWait("wait for stack creation", "eksctl-test-cluster")
+		out.Reset()
+		_, err = w.Write([]byte("log 2\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(Equal("\r\033[Klog 2\n[                    ]   0% creating control plane 0% (0m00s of ~9–12 min)"))
+	})
+})
@@ -0,0 +1,7 @@
+package provenance
+
+import "github.com/weaveworks/eksctl/pkg/executor"
+
+func (v *Verifier) SetExecutor(executor executor.Executor) {
+	v.executor = executor
+}
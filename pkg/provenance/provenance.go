@@ -0,0 +1,89 @@
+// Package provenance verifies the artifacts eksctl deploys, such as Helm chart archives and the flux binary,
+// against the digests and cosign signatures pinned in the ClusterConfig
+package provenance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor"
+)
+
+const cosignBin = "cosign"
+
+// Verifier verifies artifacts, signatures are verified by shelling out to cosign
+type Verifier struct {
+	executor executor.Executor
+	cosign   *api.Cosign
+}
+
+// NewVerifier creates a Verifier that verifies signatures with the key of cosign, which may be nil if no
+// signatures are verified
+func NewVerifier(cosign *api.Cosign) *Verifier {
+	return &Verifier{
+		executor: executor.NewShellExecutor(executor.EnvVars{}),
+		cosign:   cosign,
+	}
+}
+
+// Verify checks the digest and signature of the artifact at path, described by name, against verification.
+// It's a no-op when verification is nil
+func (v *Verifier) Verify(name, path string, verification *api.ArtifactVerification) error {
+	if verification == nil {
+		return nil
+	}
+	if verification.Digest != "" {
+		digest, err := fileDigest(path)
+		if err != nil {
+			return fmt.Errorf("computing the digest of %s: %w", name, err)
+		}
+		if digest != verification.Digest {
+			return fmt.Errorf("the digest of %s is %s, expected %s", name, digest, verification.Digest)
+		}
+		logger.Debug("digest of %s matches %s", name, digest)
+	}
+	if verification.Signature != "" {
+		if v.cosign == nil {
+			return fmt.Errorf("cosign.key must be set to verify the signature of %s", name)
+		}
+		if _, err := exec.LookPath(cosignBin); err != nil {
+			return fmt.Errorf("cosign not found, required to verify the signature of %s", name)
+		}
+		if err := v.executor.Exec(cosignBin, "verify-blob", "--key", v.cosign.Key, "--signature", verification.Signature, path); err != nil {
+			return fmt.Errorf("verifying the signature of %s: %w", name, err)
+		}
+	}
+	logger.Info("verified %s", name)
+	return nil
+}
+
+// VerifyFunc returns a function that verifies the artifact at a path against verification, or nil if verification
+// is nil, e.g. for verifying charts once they are downloaded
+func (v *Verifier) VerifyFunc(name string, verification *api.ArtifactVerification) func(path string) error {
+	if verification == nil {
+		return nil
+	}
+	return func(path string) error {
+		return v.Verify(name, path, verification)
+	}
+}
+
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,11 @@
+package provenance_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestProvenance(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
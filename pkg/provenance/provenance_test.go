@@ -0,0 +1,98 @@
+package provenance_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor/fakes"
+	"github.com/weaveworks/eksctl/pkg/provenance"
+)
+
+// digestOfFoo is the SHA-256 digest of "foo"
+const digestOfFoo = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+var _ = Describe("Verifier", func() {
+	var (
+		fakeExecutor *fakes.FakeExecutor
+		verifier     *provenance.Verifier
+		cosign       *api.Cosign
+		dir          string
+		artifact     string
+		path         string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "provenance")
+		Expect(err).NotTo(HaveOccurred())
+		artifact = filepath.Join(dir, "chart.tgz")
+		Expect(os.WriteFile(artifact, []byte("foo"), 0644)).To(Succeed())
+
+		// a fake cosign binary, so that it's found on PATH
+		path = os.Getenv("PATH")
+		Expect(os.WriteFile(filepath.Join(dir, "cosign"), nil, 0755)).To(Succeed())
+		Expect(os.Setenv("PATH", dir)).To(Succeed())
+
+		fakeExecutor = new(fakes.FakeExecutor)
+		cosign = &api.Cosign{Key: "awskms:///alias/cosign"}
+	})
+
+	JustBeforeEach(func() {
+		verifier = provenance.NewVerifier(cosign)
+		verifier.SetExecutor(fakeExecutor)
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("PATH", path)).To(Succeed())
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("does nothing without a verification", func() {
+		Expect(verifier.Verify("chart", artifact, nil)).To(Succeed())
+		Expect(verifier.VerifyFunc("chart", nil)).To(BeNil())
+		Expect(fakeExecutor.ExecCallCount()).To(BeZero())
+	})
+
+	It("accepts a matching digest", func() {
+		Expect(verifier.Verify("chart", artifact, &api.ArtifactVerification{Digest: digestOfFoo})).To(Succeed())
+		Expect(fakeExecutor.ExecCallCount()).To(BeZero())
+	})
+
+	It("rejects a digest that doesn't match", func() {
+		err := verifier.Verify("chart", artifact, &api.ArtifactVerification{
+			Digest: "sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9",
+		})
+		Expect(err).To(MatchError("the digest of chart is " + digestOfFoo + ", expected sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9"))
+	})
+
+	It("verifies the signature with cosign", func() {
+		verify := verifier.VerifyFunc("chart", &api.ArtifactVerification{Signature: "https://example.com/chart.tgz.sig"})
+		Expect(verify(artifact)).To(Succeed())
+		Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+		command, args := fakeExecutor.ExecArgsForCall(0)
+		Expect(command).To(Equal("cosign"))
+		Expect(args).To(Equal([]string{"verify-blob", "--key", "awskms:///alias/cosign", "--signature", "https://example.com/chart.tgz.sig", artifact}))
+	})
+
+	It("rejects an invalid signature", func() {
+		fakeExecutor.ExecReturns(errors.New("invalid signature"))
+		err := verifier.Verify("chart", artifact, &api.ArtifactVerification{Signature: "chart.tgz.sig"})
+		Expect(err).To(MatchError("verifying the signature of chart: invalid signature"))
+	})
+
+	When("cosign isn't configured", func() {
+		BeforeEach(func() {
+			cosign = nil
+		})
+
+		It("errors for signatures", func() {
+			err := verifier.Verify("chart", artifact, &api.ArtifactVerification{Signature: "chart.tgz.sig"})
+			Expect(err).To(MatchError("cosign.key must be set to verify the signature of chart"))
+		})
+	})
+})
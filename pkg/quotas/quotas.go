@@ -0,0 +1,450 @@
+// Package quotas checks that the Service Quotas of an account leave room for the resources a cluster config needs,
+// before any of them are created
+package quotas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Quota identifies a Service Quota
+type Quota struct {
+	ServiceCode string
+	QuotaCode   string
+	// Name is used when the quota can't be described
+	Name string
+}
+
+// Quotas checked before a cluster is created
+var (
+	VPCsPerRegion = Quota{ServiceCode: "vpc", QuotaCode: "L-F678F1CE", Name: "VPCs per Region"}
+	ElasticIPs    = Quota{ServiceCode: "ec2", QuotaCode: "L-0263D0A3", Name: "EC2-VPC Elastic IPs"}
+	// RulesPerSecurityGroup applies to inbound and outbound rules separately
+	RulesPerSecurityGroup = Quota{ServiceCode: "vpc", QuotaCode: "L-0EA8095F", Name: "Inbound or outbound rules per security group"}
+)
+
+// instanceFamilyQuota holds the vCPU quotas of the On-Demand and Spot instances of an instance family
+type instanceFamilyQuota struct {
+	onDemand Quota
+	spot     Quota
+}
+
+var (
+	standardInstancesQuota = instanceFamilyQuota{
+		onDemand: Quota{ServiceCode: "ec2", QuotaCode: "L-1216C47A", Name: "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances"},
+		spot:     Quota{ServiceCode: "ec2", QuotaCode: "L-34B43A08", Name: "All Standard (A, C, D, H, I, M, R, T, Z) Spot Instance Requests"},
+	}
+	fInstancesQuota = instanceFamilyQuota{
+		onDemand: Quota{ServiceCode: "ec2", QuotaCode: "L-74FC7D96", Name: "Running On-Demand F instances"},
+		spot:     Quota{ServiceCode: "ec2", QuotaCode: "L-88CF9481", Name: "All F Spot Instance Requests"},
+	}
+	gInstancesQuota = instanceFamilyQuota{
+		onDemand: Quota{ServiceCode: "ec2", QuotaCode: "L-DB2E81BA", Name: "Running On-Demand G and VT instances"},
+		spot:     Quota{ServiceCode: "ec2", QuotaCode: "L-3819A6DF", Name: "All G and VT Spot Instance Requests"},
+	}
+	infInstancesQuota = instanceFamilyQuota{
+		onDemand: Quota{ServiceCode: "ec2", QuotaCode: "L-1945791B", Name: "Running On-Demand Inf instances"},
+		spot:     Quota{ServiceCode: "ec2", QuotaCode: "L-B5D1601B", Name: "All Inf Spot Instance Requests"},
+	}
+	pInstancesQuota = instanceFamilyQuota{
+		onDemand: Quota{ServiceCode: "ec2", QuotaCode: "L-417A185B", Name: "Running On-Demand P instances"},
+		spot:     Quota{ServiceCode: "ec2", QuotaCode: "L-7212CCBC", Name: "All P Spot Instance Requests"},
+	}
+	xInstancesQuota = instanceFamilyQuota{
+		onDemand: Quota{ServiceCode: "ec2", QuotaCode: "L-7295265B", Name: "Running On-Demand X instances"},
+		spot:     Quota{ServiceCode: "ec2", QuotaCode: "L-E3A00192", Name: "All X Spot Instance Requests"},
+	}
+)
+
+// instanceFamilyQuotas maps instance families to their vCPU quotas
+var instanceFamilyQuotas = map[string]instanceFamilyQuota{
+	"a":   standardInstancesQuota,
+	"c":   standardInstancesQuota,
+	"d":   standardInstancesQuota,
+	"h":   standardInstancesQuota,
+	"i":   standardInstancesQuota,
+	"m":   standardInstancesQuota,
+	"r":   standardInstancesQuota,
+	"t":   standardInstancesQuota,
+	"z":   standardInstancesQuota,
+	"f":   fInstancesQuota,
+	"g":   gInstancesQuota,
+	"vt":  gInstancesQuota,
+	"inf": infInstancesQuota,
+	"p":   pInstancesQuota,
+	"x":   xInstancesQuota,
+}
+
+// Shortfall is a quota that is too low for the resources a cluster config needs
+type Shortfall struct {
+	Quota
+	// Value is the current value of the quota
+	Value float64
+	// InUse is the amount of the quota already used in the region
+	InUse float64
+	// Required is the amount of the quota the cluster config needs
+	Required float64
+}
+
+// DesiredValue returns the smallest quota value that leaves room for the resources
+func (s Shortfall) DesiredValue() float64 {
+	return s.InUse + s.Required
+}
+
+// RequestCommand returns the AWS CLI command that requests the quota increase
+func (s Shortfall) RequestCommand(region string) string {
+	return fmt.Sprintf("aws service-quotas request-service-quota-increase --service-code %s --quota-code %s --desired-value %v --region %s",
+		s.ServiceCode, s.QuotaCode, s.DesiredValue(), region)
+}
+
+// ShortfallError is returned when quotas are too low to create a cluster
+type ShortfallError struct {
+	Region     string
+	Shortfalls []Shortfall
+}
+
+func (e *ShortfallError) Error() string {
+	var lines []string
+	for _, s := range e.Shortfalls {
+		lines = append(lines, fmt.Sprintf("- %q (%s/%s) is %v, %v in use and %v required, increase it with:\n    %s",
+			s.Name, s.ServiceCode, s.QuotaCode, s.Value, s.InUse, s.Required, s.RequestCommand(e.Region)))
+	}
+	return fmt.Sprintf("insufficient service quotas, request the following increases or rerun with --request-quota-increases:\n%s", strings.Join(lines, "\n"))
+}
+
+// Checker checks Service Quotas against the resources cluster configs need
+type Checker struct {
+	ServiceQuotas awsapi.ServiceQuotas
+	EC2           awsapi.EC2
+	Region        string
+}
+
+// Check returns the quotas that are too low for the resources of cfg that would be created. Quotas that can't be
+// described, e.g. because of missing permissions, are logged and skipped
+func (c *Checker) Check(ctx context.Context, cfg *api.ClusterConfig) ([]Shortfall, error) {
+	var shortfalls []Shortfall
+	check := func(quota Quota, required float64, inUse func() (float64, error)) error {
+		if required == 0 {
+			return nil
+		}
+		value, name, ok := c.quotaValue(ctx, quota)
+		if !ok {
+			return nil
+		}
+		used, err := inUse()
+		if err != nil {
+			return err
+		}
+		if used+required > value {
+			quota.Name = name
+			shortfalls = append(shortfalls, Shortfall{Quota: quota, Value: value, InUse: used, Required: required})
+		}
+		return nil
+	}
+
+	if cfg.VPC.ID == "" {
+		if err := check(VPCsPerRegion, 1, c.countVPCs(ctx)); err != nil {
+			return nil, err
+		}
+		if err := check(ElasticIPs, float64(requiredElasticIPs(cfg)), c.countElasticIPs(ctx)); err != nil {
+			return nil, err
+		}
+	}
+
+	// rules are only added to new security groups, so none of the quota is in use
+	if err := check(RulesPerSecurityGroup, float64(requiredRulesPerSecurityGroup(cfg)), func() (float64, error) { return 0, nil }); err != nil {
+		return nil, err
+	}
+
+	required, err := c.requiredVCPUs(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(required) > 0 {
+		inUse, err := c.vCPUsInUse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, quota := range sortedQuotas(required) {
+			if err := check(quota, required[quota], func() (float64, error) { return inUse[quota], nil }); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return shortfalls, nil
+}
+
+// RequestIncreases requests the quota increases that make up for shortfalls
+func (c *Checker) RequestIncreases(ctx context.Context, shortfalls []Shortfall) error {
+	for _, s := range shortfalls {
+		_, err := c.ServiceQuotas.RequestServiceQuotaIncrease(ctx, &servicequotas.RequestServiceQuotaIncreaseInput{
+			ServiceCode:  aws.String(s.ServiceCode),
+			QuotaCode:    aws.String(s.QuotaCode),
+			DesiredValue: aws.Float64(s.DesiredValue()),
+		})
+		if err != nil {
+			var alreadyExists *servicequotastypes.ResourceAlreadyExistsException
+			if errors.As(err, &alreadyExists) {
+				logger.Info("an increase of %q is already pending", s.Name)
+				continue
+			}
+			return fmt.Errorf("requesting an increase of %q to %v: %w", s.Name, s.DesiredValue(), err)
+		}
+		logger.Info("requested an increase of %q to %v", s.Name, s.DesiredValue())
+	}
+	return nil
+}
+
+func (c *Checker) quotaValue(ctx context.Context, quota Quota) (float64, string, bool) {
+	var (
+		serviceQuota *servicequotastypes.ServiceQuota
+		noSuchQuota  *servicequotastypes.NoSuchResourceException
+	)
+	output, err := c.ServiceQuotas.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(quota.ServiceCode),
+		QuotaCode:   aws.String(quota.QuotaCode),
+	})
+	if err == nil {
+		serviceQuota = output.Quota
+	} else if errors.As(err, &noSuchQuota) {
+		// quotas that were never increased only have their default value
+		defaultOutput, defaultErr := c.ServiceQuotas.GetAWSDefaultServiceQuota(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+			ServiceCode: aws.String(quota.ServiceCode),
+			QuotaCode:   aws.String(quota.QuotaCode),
+		})
+		if defaultErr == nil {
+			serviceQuota = defaultOutput.Quota
+		}
+		err = defaultErr
+	}
+	if err != nil || serviceQuota == nil || serviceQuota.Value == nil {
+		logger.Warning("unable to check quota %q (%s/%s), skipping: %v", quota.Name, quota.ServiceCode, quota.QuotaCode, err)
+		return 0, "", false
+	}
+
+	name := quota.Name
+	if serviceQuota.QuotaName != nil {
+		name = *serviceQuota.QuotaName
+	}
+	return *serviceQuota.Value, name, true
+}
+
+func (c *Checker) countVPCs(ctx context.Context) func() (float64, error) {
+	return func() (float64, error) {
+		count := 0
+		paginator := ec2.NewDescribeVpcsPaginator(c.EC2, &ec2.DescribeVpcsInput{})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return 0, fmt.Errorf("describing VPCs: %w", err)
+			}
+			count += len(output.Vpcs)
+		}
+		return float64(count), nil
+	}
+}
+
+func (c *Checker) countElasticIPs(ctx context.Context) func() (float64, error) {
+	return func() (float64, error) {
+		output, err := c.EC2.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+			Filters: []ec2types.Filter{
+				{Name: aws.String("domain"), Values: []string{"vpc"}},
+			},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("describing Elastic IPs: %w", err)
+		}
+		return float64(len(output.Addresses)), nil
+	}
+}
+
+// requiredVCPUs returns the vCPUs the nodegroups of cfg need, by quota
+func (c *Checker) requiredVCPUs(ctx context.Context, cfg *api.ClusterConfig) (map[Quota]float64, error) {
+	type nodes struct {
+		instanceType string
+		count        int
+		spot         bool
+	}
+	var allNodes []nodes
+	addNodes := func(ng *api.NodeGroupBase, instanceTypes []string, spot bool) {
+		count := ng.GetDesiredCapacity()
+		if count == 0 {
+			count = ng.Size()
+		}
+		// the vCPUs of the first instance type are assumed for all the nodes
+		if count > 0 && len(instanceTypes) > 0 && instanceTypes[0] != "" {
+			allNodes = append(allNodes, nodes{instanceType: instanceTypes[0], count: count, spot: spot})
+		}
+	}
+	for _, ng := range cfg.NodeGroups {
+		spot := ng.InstancesDistribution != nil && ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity != nil &&
+			*ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity == 0 &&
+			(ng.InstancesDistribution.OnDemandBaseCapacity == nil || *ng.InstancesDistribution.OnDemandBaseCapacity == 0)
+		addNodes(ng.NodeGroupBase, ng.InstanceTypeList(), spot)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		addNodes(ng.NodeGroupBase, ng.InstanceTypeList(), ng.Spot)
+	}
+	if len(allNodes) == 0 {
+		return nil, nil
+	}
+
+	var instanceTypes []ec2types.InstanceType
+	seen := map[string]bool{}
+	for _, n := range allNodes {
+		if !seen[n.instanceType] {
+			seen[n.instanceType] = true
+			instanceTypes = append(instanceTypes, ec2types.InstanceType(n.instanceType))
+		}
+	}
+	vCPUs := map[string]int32{}
+	paginator := ec2.NewDescribeInstanceTypesPaginator(c.EC2, &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: instanceTypes,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing instance types: %w", err)
+		}
+		for _, it := range output.InstanceTypes {
+			if it.VCpuInfo != nil {
+				vCPUs[string(it.InstanceType)] = aws.ToInt32(it.VCpuInfo.DefaultVCpus)
+			}
+		}
+	}
+
+	required := map[Quota]float64{}
+	for _, n := range allNodes {
+		quota, ok := instanceQuota(n.instanceType, n.spot)
+		if !ok {
+			logger.Debug("no vCPU quota known for instance type %q, skipping", n.instanceType)
+			continue
+		}
+		required[quota] += float64(int(vCPUs[n.instanceType]) * n.count)
+	}
+	return required, nil
+}
+
+// vCPUsInUse returns the vCPUs of the running instances in the region, by quota
+func (c *Checker) vCPUsInUse(ctx context.Context) (map[Quota]float64, error) {
+	inUse := map[Quota]float64{}
+	paginator := ec2.NewDescribeInstancesPaginator(c.EC2, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("instance-state-name"), Values: []string{"pending", "running"}},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing instances: %w", err)
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				quota, ok := instanceQuota(string(instance.InstanceType), instance.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot)
+				if !ok || instance.CpuOptions == nil {
+					continue
+				}
+				inUse[quota] += float64(aws.ToInt32(instance.CpuOptions.CoreCount) * aws.ToInt32(instance.CpuOptions.ThreadsPerCore))
+			}
+		}
+	}
+	return inUse, nil
+}
+
+// instanceQuota returns the vCPU quota that applies to instances of instanceType
+func instanceQuota(instanceType string, spot bool) (Quota, bool) {
+	// the family is made of the letters before the generation, e.g. g for g4dn or inf for inf1
+	family := strings.SplitN(instanceType, ".", 2)[0]
+	if end := strings.IndexFunc(family, func(r rune) bool { return r < 'a' || r > 'z' }); end >= 0 {
+		family = family[:end]
+	}
+	quotas, ok := instanceFamilyQuotas[family]
+	if !ok {
+		return Quota{}, false
+	}
+	if spot {
+		return quotas.spot, true
+	}
+	return quotas.onDemand, true
+}
+
+// requiredElasticIPs returns the number of Elastic IPs the NAT gateways of a new VPC need
+func requiredElasticIPs(cfg *api.ClusterConfig) int {
+	if cfg.VPC.NAT == nil || cfg.VPC.NAT.Gateway == nil {
+		return 0
+	}
+	switch *cfg.VPC.NAT.Gateway {
+	case api.ClusterSingleNAT:
+		return 1
+	case api.ClusterHighlyAvailableNAT:
+		return len(cfg.AvailabilityZones)
+	default:
+		return 0
+	}
+}
+
+// requiredRulesPerSecurityGroup returns the largest number of inbound rules eksctl adds to a security group it creates
+func requiredRulesPerSecurityGroup(cfg *api.ClusterConfig) int {
+	var required int
+	if cfg.VPC.SecurityGroup == "" {
+		// the control plane security group allows HTTPS from extra CIDRs and from the local security group of each
+		// unmanaged nodegroup
+		controlPlaneRules := len(cfg.VPC.ExtraCIDRs) + len(cfg.VPC.ExtraIPv6CIDRs)
+		for _, ng := range cfg.NodeGroups {
+			if ng.SecurityGroups == nil || api.IsEnabled(ng.SecurityGroups.WithLocal) {
+				controlPlaneRules++
+			}
+		}
+		required = controlPlaneRules
+	}
+	if cfg.VPC.SharedNodeSecurityGroup == "" {
+		if sharedNodeRules := 2 + len(cfg.VPC.OpenWebhookPorts); sharedNodeRules > required {
+			required = sharedNodeRules
+		}
+	}
+	for _, ng := range cfg.NodeGroups {
+		if ng.SecurityGroups != nil && !api.IsEnabled(ng.SecurityGroups.WithLocal) {
+			continue
+		}
+		nodeRules := 2
+		if ng.SSH != nil && api.IsEnabled(ng.SSH.Allow) {
+			if len(ng.SSH.SourceSecurityGroupIDs) > 0 {
+				nodeRules += len(ng.SSH.SourceSecurityGroupIDs)
+			} else {
+				nodeRules += 2
+			}
+		}
+		if cfg.IPv6Enabled() {
+			nodeRules += 2
+		}
+		if nodeRules > required {
+			required = nodeRules
+		}
+	}
+	return required
+}
+
+func sortedQuotas(quotas map[Quota]float64) []Quota {
+	var sorted []Quota
+	for quota := range quotas {
+		sorted = append(sorted, quota)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].QuotaCode < sorted[j].QuotaCode
+	})
+	return sorted
+}
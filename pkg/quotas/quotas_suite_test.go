@@ -0,0 +1,11 @@
+package quotas_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestQuotas(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
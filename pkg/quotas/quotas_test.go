@@ -0,0 +1,167 @@
+package quotas_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/quotas"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Quotas", func() {
+	var (
+		p        *mockprovider.MockProvider
+		applied  map[string]float64
+		defaults map[string]float64
+		checker  *quotas.Checker
+		cfg      *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		applied = map[string]float64{
+			quotas.VPCsPerRegion.QuotaCode: 5,
+			"L-1216C47A":                   32,
+		}
+		defaults = map[string]float64{
+			quotas.ElasticIPs.QuotaCode:            5,
+			quotas.RulesPerSecurityGroup.QuotaCode: 60,
+		}
+		p.MockServiceQuotas().On("GetServiceQuota", mock.Anything, mock.Anything).Return(func(_ context.Context, input *servicequotas.GetServiceQuotaInput, _ ...func(*servicequotas.Options)) *servicequotas.GetServiceQuotaOutput {
+			value, ok := applied[*input.QuotaCode]
+			if !ok {
+				return nil
+			}
+			return &servicequotas.GetServiceQuotaOutput{Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(value)}}
+		}, func(_ context.Context, input *servicequotas.GetServiceQuotaInput, _ ...func(*servicequotas.Options)) error {
+			if _, ok := applied[*input.QuotaCode]; !ok {
+				return &servicequotastypes.NoSuchResourceException{Message: aws.String("not applied")}
+			}
+			return nil
+		})
+		p.MockServiceQuotas().On("GetAWSDefaultServiceQuota", mock.Anything, mock.Anything).Return(func(_ context.Context, input *servicequotas.GetAWSDefaultServiceQuotaInput, _ ...func(*servicequotas.Options)) *servicequotas.GetAWSDefaultServiceQuotaOutput {
+			value, ok := defaults[*input.QuotaCode]
+			if !ok {
+				return nil
+			}
+			return &servicequotas.GetAWSDefaultServiceQuotaOutput{Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(value)}}
+		}, func(_ context.Context, input *servicequotas.GetAWSDefaultServiceQuotaInput, _ ...func(*servicequotas.Options)) error {
+			if _, ok := defaults[*input.QuotaCode]; !ok {
+				return errors.New("access denied")
+			}
+			return nil
+		})
+		checker = &quotas.Checker{ServiceQuotas: p.ServiceQuotas(), EC2: p.EC2(), Region: "us-west-2"}
+
+		cfg = api.NewClusterConfig()
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b", "us-west-2c"}
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.2xlarge"
+		ng.DesiredCapacity = aws.Int(4)
+
+		p.MockEC2().On("DescribeVpcs", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []ec2types.Vpc{{}, {}, {}, {}, {}},
+		}, nil)
+		p.MockEC2().On("DescribeAddresses", mock.Anything, mock.Anything).Return(&ec2.DescribeAddressesOutput{
+			Addresses: []ec2types.Address{{}, {}},
+		}, nil)
+		p.MockEC2().On("DescribeInstanceTypes", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []ec2types.InstanceTypeInfo{
+				{InstanceType: "m5.2xlarge", VCpuInfo: &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(8)}},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeInstances", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []ec2types.Reservation{
+				{
+					Instances: []ec2types.Instance{
+						{InstanceType: "c5.xlarge", CpuOptions: &ec2types.CpuOptions{CoreCount: aws.Int32(2), ThreadsPerCore: aws.Int32(2)}},
+						{InstanceType: "g4dn.xlarge", CpuOptions: &ec2types.CpuOptions{CoreCount: aws.Int32(2), ThreadsPerCore: aws.Int32(2)}},
+					},
+				},
+			},
+		}, nil)
+	})
+
+	It("reports the quotas that are too low", func() {
+		*cfg.VPC.NAT.Gateway = api.ClusterHighlyAvailableNAT
+
+		shortfalls, err := checker.Check(context.Background(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shortfalls).To(ConsistOf(
+			quotas.Shortfall{Quota: quotas.VPCsPerRegion, Value: 5, InUse: 5, Required: 1},
+			// the G instance in use doesn't count against the standard instances quota
+			quotas.Shortfall{
+				Quota:    quotas.Quota{ServiceCode: "ec2", QuotaCode: "L-1216C47A", Name: "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances"},
+				Value:    32,
+				InUse:    4,
+				Required: 32,
+			},
+		))
+
+		err = &quotas.ShortfallError{Region: "us-west-2", Shortfalls: shortfalls}
+		Expect(err.Error()).To(ContainSubstring("aws service-quotas request-service-quota-increase --service-code ec2 --quota-code L-1216C47A --desired-value 36 --region us-west-2"))
+	})
+
+	It("doesn't check the VPC quotas when an existing VPC is used", func() {
+		cfg.VPC.ID = "vpc-1"
+		cfg.NodeGroups[0].DesiredCapacity = aws.Int(2)
+
+		shortfalls, err := checker.Check(context.Background(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shortfalls).To(BeEmpty())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeVpcs", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	It("skips quotas that can't be described", func() {
+		cfg.VPC.ID = "vpc-1"
+		delete(applied, "L-1216C47A")
+
+		shortfalls, err := checker.Check(context.Background(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shortfalls).To(BeEmpty())
+	})
+
+	It("uses the Spot quotas for Spot nodegroups", func() {
+		cfg.VPC.ID = "vpc-1"
+		cfg.NodeGroups = nil
+		mng := api.NewManagedNodeGroup()
+		mng.InstanceTypes = []string{"m5.2xlarge"}
+		mng.Spot = true
+		mng.DesiredCapacity = aws.Int(2)
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+		defaults["L-34B43A08"] = 8
+
+		shortfalls, err := checker.Check(context.Background(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shortfalls).To(HaveLen(1))
+		Expect(shortfalls[0].QuotaCode).To(Equal("L-34B43A08"))
+		Expect(shortfalls[0].Required).To(Equal(float64(16)))
+	})
+
+	It("requests the quota increases", func() {
+		p.MockServiceQuotas().On("RequestServiceQuotaIncrease", mock.Anything, &servicequotas.RequestServiceQuotaIncreaseInput{
+			ServiceCode:  aws.String("vpc"),
+			QuotaCode:    aws.String("L-F678F1CE"),
+			DesiredValue: aws.Float64(6),
+		}).Return(&servicequotas.RequestServiceQuotaIncreaseOutput{}, nil).Once()
+		p.MockServiceQuotas().On("RequestServiceQuotaIncrease", mock.Anything, mock.Anything).Return(nil, &servicequotastypes.ResourceAlreadyExistsException{}).Once()
+
+		err := checker.RequestIncreases(context.Background(), []quotas.Shortfall{
+			{Quota: quotas.VPCsPerRegion, Value: 5, InUse: 5, Required: 1},
+			{Quota: quotas.ElasticIPs, Value: 5, InUse: 2, Required: 6},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		p.MockServiceQuotas().AssertNumberOfCalls(GinkgoT(), "RequestServiceQuotaIncrease", 2)
+	})
+})
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/client"
+)
+
+// Operation is an operation that can be run as a job
+type Operation string
+
+// Supported operations
+const (
+	OperationCreateCluster   Operation = "create-cluster"
+	OperationCreateNodegroup Operation = "create-nodegroup"
+	OperationCreateAddons    Operation = "create-addons"
+	OperationDeleteCluster   Operation = "delete-cluster"
+)
+
+// JobStatus is the status of a job
+type JobStatus string
+
+// Job statuses
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// JobRequest is the body of a request to start a job
+type JobRequest struct {
+	Operation Operation          `json:"operation"`
+	Config    *api.ClusterConfig `json:"config"`
+	Options   JobOptions         `json:"options"`
+}
+
+// JobOptions holds the options of all operations, options that don't apply to the
+// requested operation are ignored
+type JobOptions struct {
+	Profile string `json:"profile,omitempty"`
+	// Timeout is a duration such as "40m"
+	Timeout string `json:"timeout,omitempty"`
+
+	WithoutNodegroups       bool     `json:"withoutNodegroups,omitempty"`
+	KubeconfigPath          string   `json:"kubeconfigPath,omitempty"`
+	SkipKubeconfig          bool     `json:"skipKubeconfig,omitempty"`
+	Include                 []string `json:"include,omitempty"`
+	Exclude                 []string `json:"exclude,omitempty"`
+	SkipOutdatedAddonsCheck bool     `json:"skipOutdatedAddonsCheck,omitempty"`
+	Force                   bool     `json:"force,omitempty"`
+	Wait                    bool     `json:"wait,omitempty"`
+}
+
+// Job is an operation run asynchronously by the server
+type Job struct {
+	ID        string    `json:"id"`
+	Operation Operation `json:"operation"`
+	Cluster   string    `json:"cluster"`
+	Status    JobStatus `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	// Log holds the lines logged by the operation so far
+	Log []string `json:"log"`
+
+	CreatedAt  time.Time  `json:"createdAt"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// OperationFunc runs an operation
+type OperationFunc func(ctx context.Context, cfg *api.ClusterConfig, options JobOptions) error
+
+// DefaultOperations returns the operations backed by the client package
+func DefaultOperations() map[Operation]OperationFunc {
+	return map[Operation]OperationFunc{
+		OperationCreateCluster: func(ctx context.Context, cfg *api.ClusterConfig, options JobOptions) error {
+			awsOptions, err := options.awsOptions()
+			if err != nil {
+				return err
+			}
+			return client.CreateCluster(ctx, cfg, client.CreateClusterOptions{
+				AWSOptions:        awsOptions,
+				WithoutNodegroups: options.WithoutNodegroups,
+				KubeconfigPath:    options.KubeconfigPath,
+				SkipKubeconfig:    options.SkipKubeconfig,
+			})
+		},
+		OperationCreateNodegroup: func(ctx context.Context, cfg *api.ClusterConfig, options JobOptions) error {
+			awsOptions, err := options.awsOptions()
+			if err != nil {
+				return err
+			}
+			return client.CreateNodegroup(ctx, cfg, client.CreateNodegroupOptions{
+				AWSOptions:              awsOptions,
+				Include:                 options.Include,
+				Exclude:                 options.Exclude,
+				SkipOutdatedAddonsCheck: options.SkipOutdatedAddonsCheck,
+			})
+		},
+		OperationCreateAddons: func(ctx context.Context, cfg *api.ClusterConfig, options JobOptions) error {
+			awsOptions, err := options.awsOptions()
+			if err != nil {
+				return err
+			}
+			return client.CreateAddons(ctx, cfg, client.CreateAddonsOptions{
+				AWSOptions: awsOptions,
+				Force:      options.Force,
+				Wait:       options.Wait,
+			})
+		},
+		OperationDeleteCluster: func(ctx context.Context, cfg *api.ClusterConfig, options JobOptions) error {
+			awsOptions, err := options.awsOptions()
+			if err != nil {
+				return err
+			}
+			return client.DeleteCluster(ctx, cfg, client.DeleteClusterOptions{
+				AWSOptions: awsOptions,
+				Wait:       options.Wait,
+				Force:      options.Force,
+			})
+		},
+	}
+}
+
+func (o JobOptions) awsOptions() (client.AWSOptions, error) {
+	awsOptions := client.AWSOptions{
+		Profile: o.Profile,
+	}
+	if o.Timeout != "" {
+		timeout, err := time.ParseDuration(o.Timeout)
+		if err != nil {
+			return client.AWSOptions{}, errors.Wrap(err, "invalid timeout")
+		}
+		awsOptions.Timeout = timeout
+	}
+	return awsOptions, nil
+}
+
+func (r JobRequest) validate(operations map[Operation]OperationFunc) error {
+	if _, ok := operations[r.Operation]; !ok {
+		return fmt.Errorf("unsupported operation %q", r.Operation)
+	}
+	if r.Config == nil || r.Config.Metadata == nil || r.Config.Metadata.Name == "" {
+		return errors.New("config.metadata.name must be set")
+	}
+	_, err := r.Options.awsOptions()
+	return err
+}
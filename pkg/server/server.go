@@ -0,0 +1,230 @@
+// Package server exposes eksctl operations over a local HTTP API. Operations run as jobs,
+// one at a time, and their status and logs can be polled while they run.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kris-nova/logger"
+)
+
+const jobsPath = "/v1/jobs"
+
+// Server runs the jobs requested over HTTP
+type Server struct {
+	// Operations are the operations jobs can run
+	Operations map[Operation]OperationFunc
+
+	mu    sync.Mutex
+	jobs  map[string]*Job
+	order []string
+	queue chan queuedJob
+	logs  *logRouter
+}
+
+type queuedJob struct {
+	job     *Job
+	request JobRequest
+}
+
+// New returns a Server running the default operations
+func New() *Server {
+	return &Server{
+		Operations: DefaultOperations(),
+		jobs:       map[string]*Job{},
+		queue:      make(chan queuedJob, 100),
+		logs:       &logRouter{},
+	}
+}
+
+// Run runs the queued jobs until ctx is cancelled, cancelling the running job. The eksctl logger
+// is global, so it is pointed at the Server once for the lifetime of Run, which sends the lines
+// to the writer of the running job
+func (s *Server) Run(ctx context.Context) {
+	s.logs.out = logger.Writer
+	logger.Writer = s.logs
+	defer func() {
+		logger.Writer = s.logs.out
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case queued := <-s.queue:
+			s.runJob(ctx, queued)
+		}
+	}
+}
+
+// runJob runs a single job; jobs run one at a time as the lines logged by eksctl
+// can't be told apart otherwise
+func (s *Server) runJob(ctx context.Context, queued queuedJob) {
+	s.update(queued.job.ID, func(job *Job) {
+		now := time.Now()
+		job.Status = JobStatusRunning
+		job.StartedAt = &now
+	})
+
+	s.logs.setJobWriter(&jobLogWriter{server: s, id: queued.job.ID})
+	err := s.Operations[queued.request.Operation](ctx, queued.request.Config, queued.request.Options)
+	s.logs.setJobWriter(nil)
+
+	s.update(queued.job.ID, func(job *Job) {
+		now := time.Now()
+		job.FinishedAt = &now
+		if err != nil {
+			job.Status = JobStatusFailed
+			job.Error = err.Error()
+			return
+		}
+		job.Status = JobStatusSucceeded
+	})
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == jobsPath && r.Method == http.MethodPost:
+		s.createJob(w, r)
+	case r.URL.Path == jobsPath && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.listJobs())
+	case strings.HasPrefix(r.URL.Path, jobsPath+"/") && r.Method == http.MethodGet:
+		job, ok := s.getJob(strings.TrimPrefix(r.URL.Path, jobsPath+"/"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "job not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, job)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) createJob(w http.ResponseWriter, r *http.Request) {
+	var request JobRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+	if err := request.validate(s.Operations); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	job := &Job{
+		ID:        uuid.NewString(),
+		Operation: request.Operation,
+		Cluster:   request.Config.Metadata.Name,
+		Status:    JobStatusPending,
+		Log:       []string{},
+		CreatedAt: time.Now(),
+	}
+
+	s.mu.Lock()
+	select {
+	case s.queue <- queuedJob{job: job, request: request}:
+	default:
+		s.mu.Unlock()
+		writeError(w, http.StatusServiceUnavailable, "too many queued jobs")
+		return
+	}
+	s.jobs[job.ID] = job
+	s.order = append(s.order, job.ID)
+	jobCopy := *job
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusAccepted, jobCopy)
+}
+
+func (s *Server) listJobs() []Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := make([]Job, 0, len(s.order))
+	for _, id := range s.order {
+		jobs = append(jobs, s.copyJob(s.jobs[id]))
+	}
+	return jobs
+}
+
+func (s *Server) getJob(id string) (Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+	return s.copyJob(job), true
+}
+
+func (s *Server) copyJob(job *Job) Job {
+	jobCopy := *job
+	jobCopy.Log = append([]string{}, job.Log...)
+	return jobCopy
+}
+
+func (s *Server) update(id string, updateFn func(job *Job)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	updateFn(s.jobs[id])
+}
+
+// logRouter is the logger.Writer of a running Server, it writes to the original writer and
+// to the writer of the running job
+type logRouter struct {
+	out io.Writer
+
+	mu        sync.Mutex
+	jobWriter io.Writer
+}
+
+func (r *logRouter) setJobWriter(w io.Writer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobWriter = w
+}
+
+func (r *logRouter) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	jobWriter := r.jobWriter
+	r.mu.Unlock()
+	if jobWriter != nil {
+		_, _ = jobWriter.Write(p)
+	}
+	return r.out.Write(p)
+}
+
+// jobLogWriter appends the lines written to it to the log of a job
+type jobLogWriter struct {
+	server *Server
+	id     string
+}
+
+func (w *jobLogWriter) Write(p []byte) (int, error) {
+	lines := strings.Split(strings.TrimRight(string(p), "\n"), "\n")
+	w.server.update(w.id, func(job *Job) {
+		job.Log = append(job.Log, lines...)
+	})
+	return len(p), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Warning("failed to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
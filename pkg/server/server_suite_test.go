@@ -0,0 +1,11 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestServer(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
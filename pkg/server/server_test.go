@@ -0,0 +1,134 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/server"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		s          *server.Server
+		httpServer *httptest.Server
+		cancel     context.CancelFunc
+		stopped    chan struct{}
+		received   chan server.JobOptions
+	)
+
+	BeforeEach(func() {
+		received = make(chan server.JobOptions, 1)
+		s = server.New()
+		s.Operations = map[server.Operation]server.OperationFunc{
+			server.OperationCreateCluster: func(_ context.Context, cfg *api.ClusterConfig, options server.JobOptions) error {
+				logger.Info("creating cluster %q", cfg.Metadata.Name)
+				received <- options
+				return nil
+			},
+			server.OperationDeleteCluster: func(_ context.Context, _ *api.ClusterConfig, _ server.JobOptions) error {
+				return errors.New("cluster not found")
+			},
+		}
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		stopped = make(chan struct{})
+		go func() {
+			defer close(stopped)
+			s.Run(ctx)
+		}()
+		httpServer = httptest.NewServer(s)
+	})
+
+	AfterEach(func() {
+		httpServer.Close()
+		cancel()
+		// Run restores the logger when it returns
+		<-stopped
+	})
+
+	submit := func(body string) (*http.Response, server.Job) {
+		resp, err := http.Post(httpServer.URL+"/v1/jobs", "application/json", strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		var job server.Job
+		Expect(json.NewDecoder(resp.Body).Decode(&job)).To(Succeed())
+		return resp, job
+	}
+
+	getJob := func(id string) server.Job {
+		resp, err := http.Get(httpServer.URL + "/v1/jobs/" + id)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		var job server.Job
+		Expect(json.NewDecoder(resp.Body).Decode(&job)).To(Succeed())
+		return job
+	}
+
+	It("runs jobs asynchronously and reports their progress", func() {
+		resp, job := submit(`{"operation": "create-cluster", "config": {"metadata": {"name": "dev", "region": "us-west-2"}}, "options": {"timeout": "40m"}}`)
+		Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+		Expect(job.Cluster).To(Equal("dev"))
+
+		Eventually(func() server.JobStatus {
+			return getJob(job.ID).Status
+		}).Should(Equal(server.JobStatusSucceeded))
+		Expect(<-received).To(Equal(server.JobOptions{Timeout: "40m"}))
+
+		job = getJob(job.ID)
+		Expect(job.Log).To(ContainElement(ContainSubstring(`creating cluster "dev"`)))
+		Expect(job.StartedAt).NotTo(BeNil())
+		Expect(job.FinishedAt).NotTo(BeNil())
+	})
+
+	It("reports failed jobs", func() {
+		_, job := submit(`{"operation": "delete-cluster", "config": {"metadata": {"name": "dev"}}}`)
+
+		Eventually(func() server.JobStatus {
+			return getJob(job.ID).Status
+		}).Should(Equal(server.JobStatusFailed))
+		Expect(getJob(job.ID).Error).To(Equal("cluster not found"))
+	})
+
+	It("lists jobs", func() {
+		_, job := submit(`{"operation": "delete-cluster", "config": {"metadata": {"name": "dev"}}}`)
+
+		resp, err := http.Get(httpServer.URL + "/v1/jobs")
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		var jobs []server.Job
+		Expect(json.NewDecoder(resp.Body).Decode(&jobs)).To(Succeed())
+		Expect(jobs).To(HaveLen(1))
+		Expect(jobs[0].ID).To(Equal(job.ID))
+	})
+
+	DescribeTable("rejects invalid requests", func(body string) {
+		resp, err := http.Post(httpServer.URL+"/v1/jobs", "application/json", strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	},
+		Entry("unsupported operation", `{"operation": "upgrade-cluster", "config": {"metadata": {"name": "dev"}}}`),
+		Entry("missing cluster name", `{"operation": "create-cluster", "config": {"metadata": {}}}`),
+		Entry("invalid timeout", `{"operation": "create-cluster", "config": {"metadata": {"name": "dev"}}, "options": {"timeout": "soon"}}`),
+		Entry("unknown fields", `{"operation": "create-cluster", "config": {"metadata": {"name": "dev"}}, "dryRun": true}`),
+	)
+
+	It("returns 404 for unknown jobs", func() {
+		resp, err := http.Get(httpServer.URL + "/v1/jobs/unknown")
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+const (
+	ssmKeyPrefix            = "ssm:"
+	secretsManagerKeyPrefix = "secretsmanager:"
+)
+
+// KeyStores holds the APIs of the services SSH public keys can be read from
+type KeyStores struct {
+	SSM            awsapi.SSM
+	SecretsManager awsapi.SecretsManager
+}
+
+// IsKeyStoreReference returns whether keyRef refers to an SSM parameter ("ssm:<name>")
+// or a Secrets Manager secret ("secretsmanager:<name or ARN>")
+func IsKeyStoreReference(keyRef string) bool {
+	return strings.HasPrefix(keyRef, ssmKeyPrefix) || strings.HasPrefix(keyRef, secretsManagerKeyPrefix)
+}
+
+// LoadKeyFromStore reads the SSH public key from the SSM parameter or Secrets Manager secret referenced by keyRef
+// and imports it in EC2 if it doesn't exist. Returns the name of the key
+func LoadKeyFromStore(ctx context.Context, keyRef, clusterName, ngName string, ec2API awsapi.EC2, stores KeyStores) (string, error) {
+	content, err := readKeyFromStore(ctx, keyRef, stores)
+	if err != nil {
+		return "", errors.Wrapf(err, "reading SSH public key from %q", keyRef)
+	}
+	if content == "" {
+		return "", fmt.Errorf("SSH public key %q is empty", keyRef)
+	}
+
+	fingerprint, err := fingerprint(keyRef, []byte(content))
+	if err != nil {
+		return "", err
+	}
+
+	keyName := getKeyName(clusterName, ngName, fingerprint)
+	logger.Info("using SSH public key from %q as %q", keyRef, keyName)
+
+	if err := importKey(ctx, keyName, fingerprint, &content, ec2API); err != nil {
+		return "", err
+	}
+	return keyName, nil
+}
+
+func readKeyFromStore(ctx context.Context, keyRef string, stores KeyStores) (string, error) {
+	switch {
+	case strings.HasPrefix(keyRef, ssmKeyPrefix):
+		output, err := stores.SSM.GetParameter(ctx, &ssm.GetParameterInput{
+			Name:           aws.String(strings.TrimPrefix(keyRef, ssmKeyPrefix)),
+			WithDecryption: aws.Bool(true),
+		})
+		if err != nil {
+			return "", err
+		}
+		if output.Parameter == nil || output.Parameter.Value == nil {
+			return "", nil
+		}
+		return strings.TrimSpace(*output.Parameter.Value), nil
+
+	case strings.HasPrefix(keyRef, secretsManagerKeyPrefix):
+		output, err := stores.SecretsManager.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+			SecretId: aws.String(strings.TrimPrefix(keyRef, secretsManagerKeyPrefix)),
+		})
+		if err != nil {
+			return "", err
+		}
+		if output.SecretString != nil {
+			return strings.TrimSpace(*output.SecretString), nil
+		}
+		return strings.TrimSpace(string(output.SecretBinary)), nil
+
+	default:
+		return "", fmt.Errorf("unsupported SSH public key reference %q", keyRef)
+	}
+}
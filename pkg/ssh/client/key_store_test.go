@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("ssh public key from a key store", func() {
+	var (
+		clusterName    = "sshtestcluster"
+		ngName         = "ng1"
+		rsaKey         = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDcSoNjWaJaw+MYBz43lgm12ZGdP+zRs9o0sXAGbiQua6e3JSkAiH4p9YZHmWxCTjckbiEdXN5qcs5OC5KUxYBvnEgor7jEydcKe1ZJXqsm/8CrtnJMTNcO9QVFnXfjvpkNjgNYj+8w9PcFRr0JDgDhRb52JvPWoqywv/Om9s1hpUov0gxDIl6CLLHSk0lmXZEhtVMMJmo0Tu/NlHqdky2DxFgHyNjBcMNpiBd8bs3dA5xf36dY+qgcXBV23i1SCgbqn9xcw1Q0IrHuQ4/QB+PJ5haxUx0bnOTahxSZ+tlEz9EiLwlM8VtKo3ND/giBvGaXuIK2iGDL0kSCRjueM5/3 user@example\n"
+		keyName        = "eksctl-sshtestcluster-nodegroup-ng1-f5:d9:01:88:1e:fb:40:fb:e1:ca:69:fe:2e:31:03:6c"
+		rsaFingerprint = "f5:d9:01:88:1e:fb:40:fb:e1:ca:69:fe:2e:31:03:6c"
+		mockEC2        *mocksv2.EC2
+		mockSSM        *mocksv2.SSM
+		mockSecrets    *mocksv2.SecretsManager
+		keyStores      KeyStores
+	)
+
+	BeforeEach(func() {
+		mockEC2 = &mocksv2.EC2{}
+		mockSSM = &mocksv2.SSM{}
+		mockSecrets = &mocksv2.SecretsManager{}
+		mockSecrets.On("GetSecretValue", mock.Anything, &secretsmanager.GetSecretValueInput{
+			SecretId: aws.String("ssh/public-key"),
+		}).Return(&secretsmanager.GetSecretValueOutput{SecretString: aws.String(rsaKey)}, nil)
+		mockSecrets.On("GetSecretValue", mock.Anything, mock.Anything).Return(nil, errors.New("secret not found"))
+		keyStores = KeyStores{
+			SSM:            mockSSM,
+			SecretsManager: mockSecrets,
+		}
+	})
+
+	It("recognises key store references", func() {
+		Expect(IsKeyStoreReference("ssm:/ssh/public-key")).To(BeTrue())
+		Expect(IsKeyStoreReference("secretsmanager:ssh/public-key")).To(BeTrue())
+		Expect(IsKeyStoreReference("my-ec2-key")).To(BeFalse())
+	})
+
+	It("imports a key read from an SSM parameter", func() {
+		mockSSM.On("GetParameter", mock.Anything, mock.MatchedBy(func(input *ssm.GetParameterInput) bool {
+			return *input.Name == "/ssh/public-key" && *input.WithDecryption
+		})).Return(&ssm.GetParameterOutput{
+			Parameter: &ssmtypes.Parameter{Value: aws.String(rsaKey)},
+		}, nil)
+		mockDescribeKeyPairs(mockEC2, make(map[string]string))
+		mockImportKeyPair(mockEC2, keyName, rsaFingerprint, strings.TrimSpace(rsaKey))
+
+		name, err := LoadKeyFromStore(context.Background(), "ssm:/ssh/public-key", clusterName, ngName, mockEC2, keyStores)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal(keyName))
+		mockEC2.AssertNumberOfCalls(GinkgoT(), "ImportKeyPair", 1)
+	})
+
+	It("imports a key read from a Secrets Manager secret", func() {
+		mockDescribeKeyPairs(mockEC2, make(map[string]string))
+		mockImportKeyPair(mockEC2, keyName, rsaFingerprint, strings.TrimSpace(rsaKey))
+
+		name, err := LoadKeyFromStore(context.Background(), "secretsmanager:ssh/public-key", clusterName, ngName, mockEC2, keyStores)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal(keyName))
+		mockSSM.AssertNotCalled(GinkgoT(), "GetParameter", mock.Anything, mock.Anything)
+	})
+
+	It("returns an error when the secret cannot be read", func() {
+		_, err := LoadKeyFromStore(context.Background(), "secretsmanager:missing", clusterName, ngName, mockEC2, keyStores)
+		Expect(err).To(MatchError(`reading SSH public key from "secretsmanager:missing": secret not found`))
+		mockEC2.AssertNotCalled(GinkgoT(), "ImportKeyPair", mock.Anything, mock.Anything)
+	})
+})
@@ -13,9 +13,10 @@ import (
 
 // LoadKey loads the SSH public key specified in NodeGroupSSH and returns it. The key should be specified
 // in only one way: by name (for a key existing in EC2), by path (for a key in a local file)
-// or by its contents (in the config-file). It also assumes that if ssh is enabled (SSH.Allow
-// == true) then one key was specified
-func LoadKey(ctx context.Context, sshConfig *api.NodeGroupSSH, clusterName, nodeGroupName string, ec2API awsapi.EC2) (string, error) {
+// or by its contents (in the config-file). A key name can also reference an SSM parameter or a Secrets Manager
+// secret holding the key, which is then imported in EC2. No key is loaded if SSH access only uses the
+// authorized keys set in user data
+func LoadKey(ctx context.Context, sshConfig *api.NodeGroupSSH, clusterName, nodeGroupName string, ec2API awsapi.EC2, keyStores client.KeyStores) (string, error) {
 	if sshConfig.Allow == nil || !*sshConfig.Allow {
 		return "", nil
 	}
@@ -30,6 +31,14 @@ func LoadKey(ctx context.Context, sshConfig *api.NodeGroupSSH, clusterName, node
 		}
 		return keyName, nil
 
+	// Load key from SSM or Secrets Manager
+	case sshConfig.PublicKeyName != nil && client.IsKeyStoreReference(*sshConfig.PublicKeyName):
+		keyName, err := client.LoadKeyFromStore(ctx, *sshConfig.PublicKeyName, clusterName, nodeGroupName, ec2API, keyStores)
+		if err != nil {
+			return "", err
+		}
+		return keyName, nil
+
 	// Use key by name in EC2
 	case sshConfig.PublicKeyName != nil && *sshConfig.PublicKeyName != "":
 		if err := client.CheckKeyExistsInEC2(ctx, ec2API, *sshConfig.PublicKeyName); err != nil {
@@ -38,6 +47,10 @@ func LoadKey(ctx context.Context, sshConfig *api.NodeGroupSSH, clusterName, node
 		logger.Info("using EC2 key pair %q", *sshConfig.PublicKeyName)
 		return *sshConfig.PublicKeyName, nil
 
+	// Only authorized keys in user data
+	case sshConfig.PublicKeyPath == nil:
+		return "", nil
+
 	// Local ssh key file
 	case file.Exists(*sshConfig.PublicKeyPath):
 		keyName, err := client.LoadKeyFromFile(ctx, *sshConfig.PublicKeyPath, clusterName, nodeGroupName, ec2API)
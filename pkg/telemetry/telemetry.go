@@ -0,0 +1,155 @@
+// Package telemetry traces eksctl commands with OpenTelemetry, so that it's visible where
+// long-running operations such as creating a cluster spend their time. Spans are exported
+// via OTLP over HTTP when EKSCTL_OTEL_EXPORTER is set, and are no-ops otherwise.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+const (
+	// ExporterEnvName enables the export of traces when set, either to the URL of an
+	// OTLP/HTTP endpoint, e.g. `http://localhost:4318`, or to `otlp` to configure the
+	// exporter with the standard OTEL_EXPORTER_OTLP_* environment variables
+	ExporterEnvName = "EKSCTL_OTEL_EXPORTER"
+
+	tracerName      = "github.com/weaveworks/eksctl"
+	shutdownTimeout = 10 * time.Second
+)
+
+var (
+	mu   sync.RWMutex
+	root = context.Background()
+)
+
+// Setup starts the root span of command and returns a context holding it, along with
+// a func that ends the span and flushes all spans to the exporter. When ExporterEnvName
+// isn't set, or the exporter can't be configured, spans aren't recorded.
+func Setup(ctx context.Context, command string) (context.Context, func()) {
+	endpoint := os.Getenv(ExporterEnvName)
+	if endpoint == "" {
+		return ctx, func() {}
+	}
+
+	exporter, err := otlptracehttp.New(ctx, exporterOptions(endpoint)...)
+	if err != nil {
+		logger.Warning("not exporting traces as the OTLP exporter can't be configured: %v", err)
+		return ctx, func() {}
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String("eksctl"),
+			semconv.ServiceVersionKey.String(version.GetVersion()),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	ctx, span := StartSpan(ctx, command)
+	setRoot(ctx)
+	return ctx, func() {
+		span.End()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := provider.Shutdown(shutdownCtx); err != nil {
+			logger.Warning("failed to export traces: %v", err)
+		}
+	}
+}
+
+func exporterOptions(endpoint string) []otlptracehttp.Option {
+	if !strings.Contains(endpoint, "://") {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		logger.Warning("ignoring invalid %s %q: %v", ExporterEnvName, endpoint, err)
+		return nil
+	}
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(u.Host)}
+	if u.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if u.Path != "" && u.Path != "/" {
+		opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+	}
+	return opts
+}
+
+func setRoot(ctx context.Context) {
+	mu.Lock()
+	defer mu.Unlock()
+	root = ctx
+}
+
+// RootContext returns the context holding the root span of the command, it's the parent
+// of spans started by code that isn't given the context of the command, e.g. tasks
+func RootContext() context.Context {
+	mu.RLock()
+	defer mu.RUnlock()
+	return root
+}
+
+// StartSpan starts a span named name as a child of the span in ctx
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends span, marking it as failed when err is non-nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// WrapTransport returns a RoundTripper that records a span for every request made
+// with rt, requests whose context has no span are recorded under the root span
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &transport{next: rt}
+}
+
+type transport struct {
+	next http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		ctx = trace.ContextWithSpan(ctx, trace.SpanFromContext(RootContext()))
+	}
+	ctx, span := StartSpan(ctx, fmt.Sprintf("%s %s", req.Method, req.URL.Path),
+		semconv.HTTPMethodKey.String(req.Method),
+		semconv.HTTPURLKey.String(req.URL.String()),
+	)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err == nil {
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+		if resp.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+	EndSpan(span, err)
+	return resp, err
+}
@@ -0,0 +1,11 @@
+package telemetry_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestTelemetry(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
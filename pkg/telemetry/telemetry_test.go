@@ -0,0 +1,108 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/weaveworks/eksctl/pkg/telemetry"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("Telemetry", func() {
+	var (
+		recorder         *tracetest.SpanRecorder
+		originalProvider trace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		originalProvider = otel.GetTracerProvider()
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(originalProvider)
+	})
+
+	spanNamed := func(name string) sdktrace.ReadOnlySpan {
+		for _, span := range recorder.Ended() {
+			if span.Name() == name {
+				return span
+			}
+		}
+		Fail("no span named " + name)
+		return nil
+	}
+
+	It("doesn't record the command when the exporter isn't set", func() {
+		Expect(os.Unsetenv(telemetry.ExporterEnvName)).To(Succeed())
+		ctx, end := telemetry.Setup(context.Background(), "eksctl create cluster")
+		end()
+		Expect(trace.SpanFromContext(ctx).SpanContext().IsValid()).To(BeFalse())
+	})
+
+	It("marks failed spans", func() {
+		_, span := telemetry.StartSpan(context.Background(), "create stack")
+		telemetry.EndSpan(span, errors.New("stack failed"))
+
+		Expect(spanNamed("create stack").Status().Code).To(Equal(codes.Error))
+		Expect(spanNamed("create stack").Status().Description).To(Equal("stack failed"))
+	})
+
+	It("records a span for each task, nesting the tasks of sub-trees", func() {
+		subTree := &tasks.TaskTree{Parallel: true, IsSubTask: true}
+		subTree.Append(
+			&tasks.GenericTask{Description: "create nodegroup ng-1", Doer: func() error { return nil }},
+			&tasks.GenericTask{Description: "create nodegroup ng-2", Doer: func() error { return nil }},
+		)
+		taskTree := &tasks.TaskTree{}
+		taskTree.Append(
+			&tasks.GenericTask{Description: "create cluster control plane", Doer: func() error { return nil }},
+			subTree,
+			&tasks.GenericTask{Description: "install device plugin", Doer: func() error { return errors.New("failed") }},
+		)
+		Expect(taskTree.DoAllSync()).To(HaveLen(1))
+
+		controlPlane := spanNamed("create cluster control plane")
+		nodeGroups := spanNamed("2 parallel sub-tasks")
+		Expect(controlPlane.Parent().IsValid()).To(BeFalse())
+		Expect(nodeGroups.Parent().IsValid()).To(BeFalse())
+
+		ng1 := spanNamed("create nodegroup ng-1")
+		ng2 := spanNamed("create nodegroup ng-2")
+		Expect(ng1.Parent().SpanID()).To(Equal(nodeGroups.SpanContext().SpanID()))
+		Expect(ng2.Parent().SpanID()).To(Equal(nodeGroups.SpanContext().SpanID()))
+		Expect(ng1.Status().Code).NotTo(Equal(codes.Error))
+		Expect(spanNamed("install device plugin").Status().Code).To(Equal(codes.Error))
+	})
+
+	It("records a span for each request of a wrapped transport", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		ctx, parent := telemetry.StartSpan(context.Background(), "restart daemonset")
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/namespaces/kube-system/pods", nil)
+		Expect(err).NotTo(HaveOccurred())
+		resp, err := telemetry.WrapTransport(http.DefaultTransport).RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		parent.End()
+
+		span := spanNamed("GET /api/v1/namespaces/kube-system/pods")
+		Expect(span.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(span.Status().Code).To(Equal(codes.Error))
+	})
+})
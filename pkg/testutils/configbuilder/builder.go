@@ -0,0 +1,215 @@
+// Package configbuilder builds ClusterConfigs for tests, instead of writing them as YAML or struct literals
+package configbuilder
+
+import (
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Builder builds a ClusterConfig, each method adds to it and returns the builder so that calls can be chained
+type Builder struct {
+	cfg *api.ClusterConfig
+}
+
+// New starts building the config of a cluster in a region, with the default Kubernetes version
+func New(name, region string) *Builder {
+	cfg := api.NewClusterConfig()
+	cfg.Metadata.Name = name
+	cfg.Metadata.Region = region
+	return &Builder{cfg: cfg}
+}
+
+// WithVersion sets the Kubernetes version of the cluster
+func (b *Builder) WithVersion(version string) *Builder {
+	b.cfg.Metadata.Version = version
+	return b
+}
+
+// WithTags adds tags to the AWS resources of the cluster
+func (b *Builder) WithTags(tags map[string]string) *Builder {
+	if b.cfg.Metadata.Tags == nil {
+		b.cfg.Metadata.Tags = map[string]string{}
+	}
+	for k, v := range tags {
+		b.cfg.Metadata.Tags[k] = v
+	}
+	return b
+}
+
+// WithEndpoint sets the endpoint and certificate authority data of the cluster, as eksctl does for a cluster that
+// exists
+func (b *Builder) WithEndpoint(endpoint string, certificateAuthorityData []byte) *Builder {
+	b.cfg.Status = &api.ClusterStatus{
+		Endpoint:                 endpoint,
+		CertificateAuthorityData: certificateAuthorityData,
+	}
+	return b
+}
+
+// WithExistingVPC uses an existing VPC for the cluster, privateSubnets maps availability zones to the IDs of the
+// private subnets in them
+func (b *Builder) WithExistingVPC(id string, privateSubnets map[string]string) *Builder {
+	b.cfg.VPC.ID = id
+	b.cfg.VPC.Subnets = &api.ClusterSubnets{Private: api.AZSubnetMapping{}}
+	for az, subnetID := range privateSubnets {
+		b.cfg.VPC.Subnets.Private[az] = api.AZSubnetSpec{ID: subnetID}
+	}
+	return b
+}
+
+// WithPrivateEndpointAccess enables the private endpoint of the cluster, leaving the public endpoint unset
+func (b *Builder) WithPrivateEndpointAccess() *Builder {
+	b.cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{PrivateAccess: api.Enabled()}
+	return b
+}
+
+// WithNodegroup adds an unmanaged nodegroup, the options are applied to it in order
+func (b *Builder) WithNodegroup(name string, options ...NodegroupOption) *Builder {
+	ng := b.cfg.NewNodeGroup()
+	ng.Name = name
+	for _, o := range options {
+		o(ng.NodeGroupBase)
+	}
+	return b
+}
+
+// WithManagedNodegroup adds a managed nodegroup, the options are applied to it in order
+func (b *Builder) WithManagedNodegroup(name string, options ...NodegroupOption) *Builder {
+	ng := api.NewManagedNodeGroup()
+	ng.Name = name
+	for _, o := range options {
+		o(ng.NodeGroupBase)
+	}
+	b.cfg.ManagedNodeGroups = append(b.cfg.ManagedNodeGroups, ng)
+	return b
+}
+
+// WithFargateProfile adds a Fargate profile selecting the pods of the namespaces
+func (b *Builder) WithFargateProfile(name string, namespaces ...string) *Builder {
+	fp := &api.FargateProfile{Name: name}
+	for _, ns := range namespaces {
+		fp.Selectors = append(fp.Selectors, api.FargateProfileSelector{Namespace: ns})
+	}
+	b.cfg.FargateProfiles = append(b.cfg.FargateProfiles, fp)
+	return b
+}
+
+// WithIRSA enables IAM roles for service accounts by creating an OIDC provider for the cluster
+func (b *Builder) WithIRSA() *Builder {
+	b.cfg.IAM.WithOIDC = api.Enabled()
+	return b
+}
+
+// WithServiceAccount adds an IAM service account with the policies attached, it enables IAM roles for service
+// accounts
+func (b *Builder) WithServiceAccount(namespace, name string, policyARNs ...string) *Builder {
+	b.WithIRSA()
+	b.cfg.IAM.ServiceAccounts = append(b.cfg.IAM.ServiceAccounts, &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		AttachPolicyARNs: policyARNs,
+	})
+	return b
+}
+
+// WithAddon adds an EKS addon, an empty version uses the default version of the addon
+func (b *Builder) WithAddon(name, version string) *Builder {
+	b.cfg.Addons = append(b.cfg.Addons, &api.Addon{
+		Name:    name,
+		Version: version,
+	})
+	return b
+}
+
+// Build returns the config as written, as if it had been loaded from a config file, the defaults eksctl sets
+// when loading it aren't set
+func (b *Builder) Build() *api.ClusterConfig {
+	return b.cfg.DeepCopy()
+}
+
+// WriteFile writes the config as written to a config file at path, for the tests of commands taking a config file
+func (b *Builder) WriteFile(path string) error {
+	data, err := yaml.Marshal(b.Build())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// BuildWithDefaults returns the config with the defaults eksctl sets when loading it
+func (b *Builder) BuildWithDefaults() *api.ClusterConfig {
+	cfg := b.Build()
+	api.SetClusterConfigDefaults(cfg)
+	for _, ng := range cfg.NodeGroups {
+		api.SetNodeGroupDefaults(ng, cfg.Metadata)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		api.SetManagedNodeGroupDefaults(ng, cfg.Metadata)
+	}
+	return cfg
+}
+
+// NodegroupOption sets a field common to unmanaged and managed nodegroups, fields specific to either can be set on
+// the built config
+type NodegroupOption func(*api.NodeGroupBase)
+
+// InstanceType sets the instance type of a nodegroup
+func InstanceType(instanceType string) NodegroupOption {
+	return func(ng *api.NodeGroupBase) {
+		ng.InstanceType = instanceType
+	}
+}
+
+// Capacity sets the minimum, desired and maximum number of nodes of a nodegroup
+func Capacity(min, desired, max int) NodegroupOption {
+	return func(ng *api.NodeGroupBase) {
+		ng.MinSize = &min
+		ng.DesiredCapacity = &desired
+		ng.MaxSize = &max
+	}
+}
+
+// MinSize sets the minimum number of nodes of a nodegroup
+func MinSize(min int) NodegroupOption {
+	return func(ng *api.NodeGroupBase) {
+		ng.MinSize = &min
+	}
+}
+
+// DesiredCapacity sets the desired number of nodes of a nodegroup
+func DesiredCapacity(desired int) NodegroupOption {
+	return func(ng *api.NodeGroupBase) {
+		ng.DesiredCapacity = &desired
+	}
+}
+
+// MaxSize sets the maximum number of nodes of a nodegroup
+func MaxSize(max int) NodegroupOption {
+	return func(ng *api.NodeGroupBase) {
+		ng.MaxSize = &max
+	}
+}
+
+// PrivateNetworking places the nodes of a nodegroup in private subnets only
+func PrivateNetworking() NodegroupOption {
+	return func(ng *api.NodeGroupBase) {
+		ng.PrivateNetworking = true
+	}
+}
+
+// Labels adds Kubernetes labels to the nodes of a nodegroup
+func Labels(labels map[string]string) NodegroupOption {
+	return func(ng *api.NodeGroupBase) {
+		if ng.Labels == nil {
+			ng.Labels = map[string]string{}
+		}
+		for k, v := range labels {
+			ng.Labels[k] = v
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package configbuilder_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/configbuilder"
+)
+
+var _ = Describe("Builder", func() {
+	It("builds a valid config", func() {
+		cfg := configbuilder.New("test-cluster", api.RegionUSWest2).
+			WithVersion(api.Version1_21).
+			WithNodegroup("ng-1", configbuilder.InstanceType("m5.large"), configbuilder.Capacity(1, 2, 3)).
+			WithManagedNodegroup("mng-1", configbuilder.PrivateNetworking(), configbuilder.Labels(map[string]string{"role": "workers"})).
+			WithFargateProfile("fp-default", "default", "kube-system").
+			WithServiceAccount("kube-system", "cluster-autoscaler", "arn:aws:iam::aws:policy/AutoScalingFullAccess").
+			WithAddon("vpc-cni", "").
+			BuildWithDefaults()
+
+		Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		for i, ng := range cfg.NodeGroups {
+			Expect(api.ValidateNodeGroup(i, ng)).To(Succeed())
+		}
+		for i, ng := range cfg.ManagedNodeGroups {
+			Expect(api.ValidateManagedNodeGroup(i, ng)).To(Succeed())
+		}
+
+		Expect(cfg.Metadata.Name).To(Equal("test-cluster"))
+		Expect(cfg.Metadata.Version).To(Equal(api.Version1_21))
+		Expect(cfg.NodeGroups).To(HaveLen(1))
+		Expect(cfg.NodeGroups[0].InstanceType).To(Equal("m5.large"))
+		Expect(*cfg.NodeGroups[0].DesiredCapacity).To(Equal(2))
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+		Expect(cfg.ManagedNodeGroups[0].PrivateNetworking).To(BeTrue())
+		Expect(cfg.ManagedNodeGroups[0].Labels).To(HaveKeyWithValue("role", "workers"))
+		Expect(cfg.FargateProfiles[0].Selectors).To(HaveLen(2))
+		Expect(api.IsEnabled(cfg.IAM.WithOIDC)).To(BeTrue())
+		Expect(cfg.IAM.ServiceAccounts[0].AttachPolicyARNs).To(ConsistOf("arn:aws:iam::aws:policy/AutoScalingFullAccess"))
+		Expect(cfg.Addons[0].Name).To(Equal("vpc-cni"))
+	})
+
+	It("returns a copy of the config", func() {
+		b := configbuilder.New("test-cluster", api.RegionUSWest2).WithManagedNodegroup("mng-1")
+		cfg := b.Build()
+		cfg.ManagedNodeGroups[0].Name = "changed"
+
+		Expect(b.Build().ManagedNodeGroups[0].Name).To(Equal("mng-1"))
+	})
+
+	It("writes a config file that eksctl loads", func() {
+		Expect(api.Register()).To(Succeed())
+		dir, err := os.MkdirTemp("", "configbuilder")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		configFile := filepath.Join(dir, "cluster.yaml")
+
+		Expect(configbuilder.New("test-cluster", api.RegionUSWest2).
+			WithExistingVPC("vpc-12345", map[string]string{"us-west-2a": "subnet-12345"}).
+			WithPrivateEndpointAccess().
+			WithNodegroup("ng-1", configbuilder.MinSize(1), configbuilder.MaxSize(3)).
+			WithManagedNodegroup("mng-1", configbuilder.DesiredCapacity(2)).
+			WriteFile(configFile)).To(Succeed())
+
+		cfg, err := eks.LoadConfigFromFile(configFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Metadata.Name).To(Equal("test-cluster"))
+		Expect(cfg.VPC.ID).To(Equal("vpc-12345"))
+		Expect(cfg.VPC.Subnets.Private["us-west-2a"].ID).To(Equal("subnet-12345"))
+		Expect(api.IsEnabled(cfg.VPC.ClusterEndpoints.PrivateAccess)).To(BeTrue())
+		Expect(cfg.VPC.ClusterEndpoints.PublicAccess).To(BeNil())
+		Expect(*cfg.NodeGroups[0].MinSize).To(Equal(1))
+		Expect(*cfg.NodeGroups[0].MaxSize).To(Equal(3))
+		Expect(cfg.NodeGroups[0].DesiredCapacity).To(BeNil())
+		Expect(*cfg.ManagedNodeGroups[0].DesiredCapacity).To(Equal(2))
+	})
+})
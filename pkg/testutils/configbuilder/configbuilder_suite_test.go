@@ -0,0 +1,11 @@
+package configbuilder_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestConfigBuilder(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
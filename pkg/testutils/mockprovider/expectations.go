@@ -0,0 +1,68 @@
+package mockprovider
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/stretchr/testify/mock"
+)
+
+// The Expect* helpers set up the expectations most tests need on the mocked APIs, they return the call so that
+// it can be further restricted, e.g. with Once()
+
+// ExpectDescribeCluster expects the cluster to be described and returns it
+func (m *MockProvider) ExpectDescribeCluster(cluster *ekstypes.Cluster) *mock.Call {
+	return m.MockEKS().On("DescribeCluster", mock.Anything, mock.MatchedBy(func(input *eks.DescribeClusterInput) bool {
+		return aws.ToString(input.Name) == aws.ToString(cluster.Name)
+	})).Return(&eks.DescribeClusterOutput{Cluster: cluster}, nil)
+}
+
+// ExpectDescribeNodegroup expects the managed nodegroup to be described and returns it
+func (m *MockProvider) ExpectDescribeNodegroup(nodegroup *ekstypes.Nodegroup) *mock.Call {
+	return m.MockEKS().On("DescribeNodegroup", mock.Anything, mock.MatchedBy(func(input *eks.DescribeNodegroupInput) bool {
+		return aws.ToString(input.ClusterName) == aws.ToString(nodegroup.ClusterName) &&
+			aws.ToString(input.NodegroupName) == aws.ToString(nodegroup.NodegroupName)
+	})).Return(&eks.DescribeNodegroupOutput{Nodegroup: nodegroup}, nil)
+}
+
+// ExpectDescribeStack expects the stack to be described by name and returns it
+func (m *MockProvider) ExpectDescribeStack(stack cfntypes.Stack) *mock.Call {
+	return m.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.MatchedBy(func(input *cloudformation.DescribeStacksInput) bool {
+		return aws.ToString(input.StackName) == aws.ToString(stack.StackName)
+	})).Return(&cloudformation.DescribeStacksOutput{Stacks: []cfntypes.Stack{stack}}, nil)
+}
+
+// ExpectListStacks expects the stacks to be listed with a paginator and returns a single page of summaries
+func (m *MockProvider) ExpectListStacks(summaries ...cfntypes.StackSummary) *mock.Call {
+	return m.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).
+		Return(&cloudformation.ListStacksOutput{StackSummaries: summaries}, nil)
+}
+
+// ExpectDescribeAvailabilityZones expects the availability zones to be described and returns the zones, all
+// available in the region of the provider
+func (m *MockProvider) ExpectDescribeAvailabilityZones(zones ...string) *mock.Call {
+	var availabilityZones []ec2types.AvailabilityZone
+	for _, zone := range zones {
+		availabilityZones = append(availabilityZones, ec2types.AvailabilityZone{
+			GroupName:  aws.String(m.Region()),
+			RegionName: aws.String(m.Region()),
+			ZoneName:   aws.String(zone),
+			State:      ec2types.AvailabilityZoneStateAvailable,
+		})
+	}
+	return m.MockEC2().On("DescribeAvailabilityZones", mock.Anything, mock.Anything).
+		Return(&ec2.DescribeAvailabilityZonesOutput{AvailabilityZones: availabilityZones}, nil)
+}
+
+// ExpectGetCallerIdentity expects the caller identity to be requested and returns the ARN and account ID
+func (m *MockProvider) ExpectGetCallerIdentity(arn, accountID string) *mock.Call {
+	return m.MockSTS().On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{
+		Arn:     aws.String(arn),
+		Account: aws.String(accountID),
+	}, nil)
+}
@@ -1,19 +1,14 @@
 package mockprovider
 
 import (
+	"context"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/client"
-	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/awstesting"
-	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5/fakes"
 	"github.com/weaveworks/eksctl/pkg/awsapi"
-	"github.com/weaveworks/eksctl/pkg/eks/mocks"
 	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
 )
 
@@ -26,60 +21,59 @@ var ProviderConfig = &api.ProviderConfig{
 
 var _ api.ClusterProvider = &MockProvider{}
 
-type MockAWSClient struct {
-	*client.Client
-}
-
-type MockInput struct{}
-type MockOutput struct {
-	States []*MockState
-}
-type MockState struct {
-	State *string
-}
-
 // MockProvider stores the mocked APIs
 type MockProvider struct {
-	Client *MockAWSClient
-
 	region         string
 	cfnRoleARN     string
 	asg            *mocksv2.ASG
-	eks            *mocks.EKSAPI
+	eks            *mocksv2.EKS
 	cloudtrail     *mocksv2.CloudTrail
 	cloudwatchlogs *mocksv2.CloudWatchLogs
-	configProvider *mocks.ConfigProvider
-
-	cfn              *mocksv2.CloudFormation
-	sts              *mocksv2.STS
-	stsPresigner     api.STSPresigner
-	cloudformationV2 *mocksv2.CloudFormation
-	elb              *mocksv2.ELB
-	elbV2            *mocksv2.ELBV2
-	ssm              *mocksv2.SSM
-	iam              *mocksv2.IAM
-	ec2              *mocksv2.EC2
+
+	cfn            *mocksv2.CloudFormation
+	sts            *mocksv2.STS
+	stsPresigner   api.STSPresigner
+	elb            *mocksv2.ELB
+	elbV2          *mocksv2.ELBV2
+	ssm            *mocksv2.SSM
+	iam            *mocksv2.IAM
+	ec2            *mocksv2.EC2
+	servicequotas  *mocksv2.ServiceQuotas
+	imagebuilder   *mocksv2.ImageBuilder
+	amp            *mocksv2.AMP
+	grafana        *mocksv2.Grafana
+	guardduty      *mocksv2.GuardDuty
+	kms            *mocksv2.KMS
+	pricing        *mocksv2.Pricing
+	cloudwatch     *mocksv2.CloudWatch
+	secretsmanager *mocksv2.SecretsManager
 }
 
 // NewMockProvider returns a new MockProvider
 func NewMockProvider() *MockProvider {
 	return &MockProvider{
-		Client: NewMockAWSClient(),
-
 		asg:            &mocksv2.ASG{},
-		eks:            &mocks.EKSAPI{},
+		eks:            &mocksv2.EKS{},
 		cloudtrail:     &mocksv2.CloudTrail{},
 		cloudwatchlogs: &mocksv2.CloudWatchLogs{},
-		configProvider: &mocks.ConfigProvider{},
-
-		sts:          &mocksv2.STS{},
-		stsPresigner: &fakes.FakeSTSPresigner{},
-		cfn:          &mocksv2.CloudFormation{},
-		elb:          &mocksv2.ELB{},
-		elbV2:        &mocksv2.ELBV2{},
-		ssm:          &mocksv2.SSM{},
-		iam:          &mocksv2.IAM{},
-		ec2:          &mocksv2.EC2{},
+
+		sts:            &mocksv2.STS{},
+		stsPresigner:   &fakes.FakeSTSPresigner{},
+		cfn:            &mocksv2.CloudFormation{},
+		elb:            &mocksv2.ELB{},
+		elbV2:          &mocksv2.ELBV2{},
+		ssm:            &mocksv2.SSM{},
+		iam:            &mocksv2.IAM{},
+		ec2:            &mocksv2.EC2{},
+		servicequotas:  &mocksv2.ServiceQuotas{},
+		imagebuilder:   &mocksv2.ImageBuilder{},
+		amp:            &mocksv2.AMP{},
+		grafana:        &mocksv2.Grafana{},
+		guardduty:      &mocksv2.GuardDuty{},
+		kms:            &mocksv2.KMS{},
+		pricing:        &mocksv2.Pricing{},
+		cloudwatch:     &mocksv2.CloudWatch{},
+		secretsmanager: &mocksv2.SecretsManager{},
 	}
 }
 
@@ -138,6 +132,11 @@ func (m MockProvider) CloudFormationDisableRollback() bool {
 	return false
 }
 
+// CloudFormationExplainFailures returns whether the IAM actions denied to failed stacks are looked up in CloudTrail
+func (m MockProvider) CloudFormationExplainFailures() bool {
+	return false
+}
+
 // ASG returns a representation of the ASG API
 func (m MockProvider) ASG() awsapi.ASG { return m.asg }
 
@@ -145,10 +144,10 @@ func (m MockProvider) ASG() awsapi.ASG { return m.asg }
 func (m MockProvider) MockASG() *mocksv2.ASG { return m.ASG().(*mocksv2.ASG) }
 
 // EKS returns a representation of the EKS API
-func (m MockProvider) EKS() eksiface.EKSAPI { return m.eks }
+func (m MockProvider) EKS() awsapi.EKS { return m.eks }
 
 // MockEKS returns a mocked EKS API
-func (m MockProvider) MockEKS() *mocks.EKSAPI { return m.EKS().(*mocks.EKSAPI) }
+func (m MockProvider) MockEKS() *mocksv2.EKS { return m.eks }
 
 // EC2 returns a representation of the EC2 API
 func (m MockProvider) EC2() awsapi.EC2 { return m.ec2 }
@@ -184,6 +183,60 @@ func (m MockProvider) MockCloudWatchLogs() *mocksv2.CloudWatchLogs {
 	return m.CloudWatchLogs().(*mocksv2.CloudWatchLogs)
 }
 
+// ServiceQuotas returns a representation of the ServiceQuotas API
+func (m MockProvider) ServiceQuotas() awsapi.ServiceQuotas { return m.servicequotas }
+
+// MockServiceQuotas returns a mocked ServiceQuotas API
+func (m MockProvider) MockServiceQuotas() *mocksv2.ServiceQuotas { return m.servicequotas }
+
+// ImageBuilder returns a representation of the ImageBuilder API
+func (m MockProvider) ImageBuilder() awsapi.ImageBuilder { return m.imagebuilder }
+
+// MockImageBuilder returns a mocked ImageBuilder API
+func (m MockProvider) MockImageBuilder() *mocksv2.ImageBuilder { return m.imagebuilder }
+
+// AMP returns a representation of the AMP API
+func (m MockProvider) AMP() awsapi.AMP { return m.amp }
+
+// MockAMP returns a mocked AMP API
+func (m MockProvider) MockAMP() *mocksv2.AMP { return m.amp }
+
+// Grafana returns a representation of the Grafana API
+func (m MockProvider) Grafana() awsapi.Grafana { return m.grafana }
+
+// MockGrafana returns a mocked Grafana API
+func (m MockProvider) MockGrafana() *mocksv2.Grafana { return m.grafana }
+
+// GuardDuty returns a representation of the GuardDuty API
+func (m MockProvider) GuardDuty() awsapi.GuardDuty { return m.guardduty }
+
+// MockGuardDuty returns a mocked GuardDuty API
+func (m MockProvider) MockGuardDuty() *mocksv2.GuardDuty { return m.guardduty }
+
+// KMS returns a representation of the KMS API
+func (m MockProvider) KMS() awsapi.KMS { return m.kms }
+
+// MockKMS returns a mocked KMS API
+func (m MockProvider) MockKMS() *mocksv2.KMS { return m.kms }
+
+// Pricing returns a representation of the Pricing API
+func (m MockProvider) Pricing() awsapi.Pricing { return m.pricing }
+
+// MockPricing returns a mocked Pricing API
+func (m MockProvider) MockPricing() *mocksv2.Pricing { return m.pricing }
+
+// CloudWatch returns a representation of the CloudWatch API
+func (m MockProvider) CloudWatch() awsapi.CloudWatch { return m.cloudwatch }
+
+// MockCloudWatch returns a mocked CloudWatch API
+func (m MockProvider) MockCloudWatch() *mocksv2.CloudWatch { return m.cloudwatch }
+
+// SecretsManager returns a representation of the SecretsManager API
+func (m MockProvider) SecretsManager() awsapi.SecretsManager { return m.secretsmanager }
+
+// MockSecretsManager returns a mocked SecretsManager API
+func (m MockProvider) MockSecretsManager() *mocksv2.SecretsManager { return m.secretsmanager }
+
 // Profile returns current profile setting
 func (m MockProvider) Profile() string { return ProviderConfig.Profile }
 
@@ -203,64 +256,12 @@ func (m *MockProvider) SetRegion(r string) {
 // WaitTimeout returns current timeout setting
 func (m MockProvider) WaitTimeout() time.Duration { return ProviderConfig.WaitTimeout }
 
-// ConfigProvider returns a representation of the ConfigProvider
-func (m MockProvider) ConfigProvider() client.ConfigProvider {
-	return m.configProvider
-}
+// WaiterMaxDelay returns the default of each waiter
+func (m MockProvider) WaiterMaxDelay() time.Duration { return 0 }
 
-// MockConfigProvider returns a mocked ConfigProvider
-func (m MockProvider) MockConfigProvider() client.ConfigProvider {
-	return m.configProvider
-}
+// EnsureCredentials always succeeds
+func (m MockProvider) EnsureCredentials(_ context.Context, _ time.Duration) error { return nil }
 
 func (m MockProvider) Session() *session.Session {
 	panic("not implemented")
 }
-
-func NewMockAWSClient() *MockAWSClient {
-	m := &MockAWSClient{
-		Client: awstesting.NewClient(&aws.Config{
-			Region: &ProviderConfig.Region,
-		}),
-	}
-
-	m.Handlers.Send.Clear()
-	m.Handlers.Unmarshal.Clear()
-	m.Handlers.UnmarshalMeta.Clear()
-	m.Handlers.ValidateResponse.Clear()
-
-	return m
-}
-
-func (m *MockAWSClient) MockRequestForMockOutput(input *MockInput) (*request.Request, *MockOutput) {
-	op := &request.Operation{
-		Name:       "Mock",
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
-	}
-
-	if input == nil {
-		input = &MockInput{}
-	}
-
-	output := &MockOutput{}
-	req := m.NewRequest(op, input, output)
-	req.Data = output
-	return req, output
-}
-
-func (m *MockAWSClient) MockRequestForGivenOutput(input, output interface{}) *request.Request {
-	op := &request.Operation{
-		Name:       "Mock",
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
-	}
-
-	if input == nil {
-		input = &MockInput{}
-	}
-
-	req := m.NewRequest(op, input, output)
-	req.Data = output
-	return req
-}
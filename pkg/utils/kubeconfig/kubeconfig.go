@@ -207,6 +207,20 @@ func AppendAuthenticator(config *clientcmdapi.Config, clusterMeta *api.ClusterMe
 	}
 }
 
+// UseDualStackEndpoints makes the authenticators of config call the dual-stack endpoints of AWS services, so that
+// tokens can be generated from IPv6-only hosts
+func UseDualStackEndpoints(config *clientcmdapi.Config) {
+	for _, authInfo := range config.AuthInfos {
+		if authInfo.Exec == nil {
+			continue
+		}
+		authInfo.Exec.Env = append(authInfo.Exec.Env, clientcmdapi.ExecEnvVar{
+			Name:  "AWS_USE_DUALSTACK_ENDPOINT",
+			Value: "true",
+		})
+	}
+}
+
 // AWSAuthenticatorVersionFormat is the format in which aws-iam-authenticator displays version information:
 // {"Version":"0.5.5","Commit":"85e50980d9d916ae95882176c18f14ae145f916f"}
 type AWSAuthenticatorVersionFormat struct {
@@ -414,4 +414,18 @@ var _ = Describe("Kubeconfig", func() {
 			Expect(config.AuthInfos["test"].Exec.APIVersion).To(Equal("client.authentication.k8s.io/v1alpha1"))
 		})
 	})
+	Context("UseDualStackEndpoints", func() {
+		It("makes the authenticator call the dual-stack endpoints of AWS services", func() {
+			config := &clientcmdapi.Config{
+				AuthInfos:      map[string]*clientcmdapi.AuthInfo{},
+				CurrentContext: "test",
+			}
+			kubeconfig.AppendAuthenticator(config, &eksctlapi.ClusterMeta{Region: "us-west-2", Name: "name"}, kubeconfig.AWSEKSAuthenticator, "", "")
+			kubeconfig.UseDualStackEndpoints(config)
+			Expect(config.AuthInfos["test"].Exec.Env).To(ContainElement(clientcmdapi.ExecEnvVar{
+				Name:  "AWS_USE_DUALSTACK_ENDPOINT",
+				Value: "true",
+			}))
+		})
+	})
 })
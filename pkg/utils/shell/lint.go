@@ -0,0 +1,702 @@
+// Package shell lints the shell scripts that nodes run, such as preBootstrapCommands, for the syntax errors
+// that would otherwise only surface in the cloud-init logs of a node that failed to join the cluster
+package shell
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Issue is a problem found in a script, at a line and column counted from 1
+type Issue struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("line %d, column %d: %s", i.Line, i.Column, i.Message)
+}
+
+// LintError is the error returned for a script with issues
+type LintError struct {
+	Issues []Issue
+}
+
+func (e *LintError) Error() string {
+	issues := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		issues[i] = issue.String()
+	}
+	return strings.Join(issues, "; ")
+}
+
+// Lint checks that the quotes, substitutions, here-documents, compound commands and test brackets of script are
+// closed, and that it doesn't have Windows line endings. It returns a *LintError listing the issues in the order
+// they appear in the script, or nil
+func Lint(script string) error {
+	l := &linter{src: []rune(script), line: 1, col: 1}
+	l.carriageReturns()
+	l.list(0)
+	if len(l.issues) == 0 {
+		return nil
+	}
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		a, b := l.issues[i], l.issues[j]
+		return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+	})
+	return &LintError{Issues: l.issues}
+}
+
+// assignment matches the variable assignments that may precede a command
+var assignment = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\[[^]]*\])?\+?=`)
+
+type position struct {
+	line, col int
+}
+
+// block is a compound command that's waiting to be closed
+type block struct {
+	keyword string
+	position
+	state blockState
+}
+
+type blockState int
+
+const (
+	// stateCondition is the condition of if, elif, while and until, or the words of for
+	stateCondition blockState = iota
+	// stateBody is the body of if, elif, else, loops, groups and case items
+	stateBody
+	// stateCaseWord is the word case matches
+	stateCaseWord
+	// stateCasePattern is the position of the patterns of case items
+	stateCasePattern
+)
+
+// closers are the keywords that close each compound command
+var closers = map[string]string{
+	"if":    "fi",
+	"for":   "done",
+	"while": "done",
+	"until": "done",
+	"case":  "esac",
+	"{":     "}",
+}
+
+type heredoc struct {
+	delimiter string
+	stripTabs bool
+	position
+}
+
+type linter struct {
+	src       []rune
+	pos       int
+	line, col int
+	issues    []Issue
+	heredocs  []heredoc
+}
+
+func (l *linter) report(p position, format string, args ...interface{}) {
+	l.issues = append(l.issues, Issue{Line: p.line, Column: p.col, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) eof() bool {
+	return l.pos >= len(l.src)
+}
+
+func (l *linter) peek() rune {
+	if l.eof() {
+		return 0
+	}
+	return l.src[l.pos]
+}
+
+func (l *linter) peekAt(offset int) rune {
+	if l.pos+offset >= len(l.src) {
+		return 0
+	}
+	return l.src[l.pos+offset]
+}
+
+func (l *linter) position() position {
+	return position{line: l.line, col: l.col}
+}
+
+func (l *linter) next() rune {
+	c := l.src[l.pos]
+	l.pos++
+	if c == '\n' {
+		l.line++
+		l.col = 1
+	} else {
+		l.col++
+	}
+	return c
+}
+
+// carriageReturns reports the first carriage return, scripts with Windows line endings fail on every line
+func (l *linter) carriageReturns() {
+	line, col := 1, 1
+	for _, c := range l.src {
+		switch c {
+		case '\r':
+			l.report(position{line, col}, "literal carriage return, convert the script to Unix line endings")
+			return
+		case '\n':
+			line++
+			col = 1
+		default:
+			col++
+		}
+	}
+}
+
+// skipBlanks skips spaces, tabs and line continuations
+func (l *linter) skipBlanks() {
+	for !l.eof() {
+		switch c := l.peek(); {
+		case c == ' ' || c == '\t' || c == '\r':
+			l.next()
+		case c == '\\' && l.peekAt(1) == '\n':
+			l.next()
+			l.next()
+		default:
+			return
+		}
+	}
+}
+
+// command is the simple command being read
+type command struct {
+	first, last string
+	position
+	// inDoubleBracket is set until the ]] of a [[ command
+	inDoubleBracket bool
+}
+
+// list reads commands until the unmatched closing paren, or the end of the script if closing is 0, and reports
+// the compound commands that aren't closed. It returns false if the script ends before closing
+func (l *linter) list(closing rune) bool {
+	var (
+		blocks            []*block
+		cmd               command
+		cmdStart          = true
+		afterFunctionWord bool
+	)
+	top := func() *block {
+		if len(blocks) == 0 {
+			return nil
+		}
+		return blocks[len(blocks)-1]
+	}
+	endCommand := func() {
+		switch {
+		case cmd.first == "[" && cmd.last != "]":
+			l.report(cmd.position, "[ is not closed with a separate ], it must be separated by spaces, e.g. [ -f file ]")
+		case cmd.first == "[[" && cmd.last != "]]":
+			l.report(cmd.position, "[[ is not closed with a separate ]], it must be separated by spaces, e.g. [[ -f file ]]")
+		}
+		cmd = command{}
+		cmdStart = true
+	}
+	closeBlocks := func() {
+		for i := len(blocks) - 1; i >= 0; i-- {
+			l.report(blocks[i].position, "%s is never closed with %s", blocks[i].keyword, closers[blocks[i].keyword])
+		}
+	}
+
+	for {
+		l.skipBlanks()
+		if l.eof() {
+			l.readHeredocs()
+			endCommand()
+			closeBlocks()
+			return closing == 0
+		}
+		p := l.position()
+		c := l.peek()
+
+		if cmd.inDoubleBracket && c != '\n' && c != '#' {
+			// && || < > ( ) are operators of the expression inside [[ ]]
+			if strings.ContainsRune("&|<>()", c) {
+				l.next()
+				continue
+			}
+		}
+
+		switch {
+		case c == '#':
+			for !l.eof() && l.peek() != '\n' {
+				l.next()
+			}
+
+		case c == '\n':
+			l.next()
+			l.readHeredocs()
+			endCommand()
+
+		case c == ';':
+			l.next()
+			endCommand()
+			if next := l.peek(); next == ';' || next == '&' {
+				l.next()
+				if l.peek() == '&' {
+					l.next()
+				}
+				if b := top(); b != nil && b.keyword == "case" && b.state == stateBody {
+					b.state = stateCasePattern
+				} else {
+					l.report(p, "case item terminator outside of a case")
+				}
+			}
+
+		case c == '&' || c == '|':
+			l.next()
+			if next := l.peek(); next == c || (c == '|' && next == '&') {
+				l.next()
+			}
+			if b := top(); b != nil && b.keyword == "case" && b.state == stateCasePattern {
+				// alternative patterns of a case item
+				continue
+			}
+			endCommand()
+
+		case c == '(':
+			l.next()
+			if b := top(); b != nil && b.keyword == "case" && b.state == stateCasePattern {
+				// optional opening paren of a pattern
+				continue
+			}
+			if b := top(); l.peek() == '(' && (cmdStart || b != nil && b.keyword == "for" && b.state == stateCondition) {
+				// arithmetic command, or the arithmetic for loop, for ((...))
+				l.next()
+				if !l.arithmetic() {
+					l.report(p, "(( is never closed with ))")
+				}
+				cmdStart = false
+				continue
+			}
+			l.skipBlanks()
+			switch {
+			case l.peek() == ')':
+				// function definition, name() or function name()
+				l.next()
+				endCommand()
+			case cmdStart:
+				if !l.list(')') {
+					l.report(p, "( is never closed with )")
+				}
+				cmdStart = false
+			default:
+				l.report(p, "unexpected (")
+			}
+
+		case c == ')':
+			if b := top(); b != nil && b.keyword == "case" && b.state == stateCasePattern {
+				l.next()
+				b.state = stateBody
+				cmdStart = true
+				continue
+			}
+			if closing == ')' {
+				l.next()
+				endCommand()
+				closeBlocks()
+				return true
+			}
+			l.next()
+			l.report(p, "unexpected ), there's no ( or $( to close")
+
+		case c == '<' || c == '>':
+			l.redirection()
+			cmdStart = false
+
+		default:
+			word := l.word()
+			if word == "" {
+				// a character that can't start a word
+				l.next()
+				continue
+			}
+			if b := top(); b != nil && b.keyword == "case" && b.state != stateBody {
+				switch {
+				case b.state == stateCaseWord:
+					b.state = stateCondition
+				case b.state == stateCondition && word == "in":
+					b.state = stateCasePattern
+				case b.state == stateCasePattern && word == "esac":
+					blocks = blocks[:len(blocks)-1]
+					cmdStart = false
+				}
+				continue
+			}
+			if afterFunctionWord {
+				afterFunctionWord = false
+				cmdStart = true
+				continue
+			}
+			if !cmdStart {
+				if cmd.first != "" {
+					cmd.last = word
+				}
+				if cmd.inDoubleBracket && word == "]]" {
+					cmd.inDoubleBracket = false
+				}
+				continue
+			}
+			if assignment.MatchString(word) {
+				continue
+			}
+			if closed, ok := l.keyword(word, p, &blocks); ok {
+				cmdStart = !closed
+				if word == "case" || word == "for" {
+					cmdStart = false
+				}
+				if word == "function" {
+					afterFunctionWord = true
+					cmdStart = false
+				}
+				continue
+			}
+			if len(word) > 1 && word[0] == '[' && word != "[[" && strings.ContainsAny(word[1:2], `-$"'!`) {
+				l.report(p, "missing space after [, e.g. [ -f file ]")
+			}
+			cmd = command{first: word, last: word, position: p, inDoubleBracket: word == "[["}
+			cmdStart = false
+		}
+	}
+}
+
+// keyword updates blocks for word if it's a reserved word. closed is true if it closes a compound command,
+// after which the next word isn't a command
+func (l *linter) keyword(word string, p position, blocks *[]*block) (closed, ok bool) {
+	var top *block
+	if len(*blocks) > 0 {
+		top = (*blocks)[len(*blocks)-1]
+	}
+	push := func(state blockState) {
+		*blocks = append(*blocks, &block{keyword: word, position: p, state: state})
+	}
+	expect := func(keywords ...string) bool {
+		if top != nil {
+			for _, k := range keywords {
+				if top.keyword == k {
+					return true
+				}
+			}
+		}
+		if top == nil {
+			l.report(p, "%s without a matching %s", word, strings.Join(keywords, " or "))
+		} else {
+			l.report(p, "%s inside %s opened at line %d, expected %s", word, top.keyword, top.line, closers[top.keyword])
+		}
+		return false
+	}
+
+	switch word {
+	case "if", "while", "until", "for":
+		push(stateCondition)
+	case "case":
+		push(stateCaseWord)
+	case "{":
+		push(stateBody)
+	case "then":
+		if expect("if") {
+			top.state = stateBody
+		}
+	case "elif":
+		if expect("if") {
+			top.state = stateCondition
+		}
+	case "else":
+		if expect("if") {
+			top.state = stateBody
+		}
+	case "do":
+		if expect("for", "while", "until") {
+			top.state = stateBody
+		}
+	case "fi", "done", "esac", "}":
+		var openers []string
+		for opener, closer := range closers {
+			if closer == word {
+				openers = append(openers, opener)
+			}
+		}
+		sort.Strings(openers)
+		if expect(openers...) {
+			*blocks = (*blocks)[:len(*blocks)-1]
+		}
+		return true, true
+	case "!", "function", "time":
+	default:
+		return false, false
+	}
+	return false, true
+}
+
+// word reads a word and returns it as written, including its quotes
+func (l *linter) word() string {
+	start := l.pos
+	for !l.eof() {
+		p := l.position()
+		switch c := l.peek(); c {
+		case '(':
+			if l.pos == start || l.src[l.pos-1] != '=' {
+				return string(l.src[start:l.pos])
+			}
+			l.next()
+			if !l.array() {
+				l.report(p, "unterminated array (")
+			}
+		case ' ', '\t', '\r', '\n', ';', '&', '|', '<', '>', ')':
+			return string(l.src[start:l.pos])
+		case '\\':
+			l.next()
+			if !l.eof() {
+				l.next()
+			}
+		case '\'':
+			l.next()
+			if !l.skipTo('\'') {
+				l.report(p, "unterminated single-quoted string")
+			}
+		case '"':
+			l.next()
+			l.doubleQuoted(p)
+		case '`':
+			l.next()
+			l.backquoted(p)
+		case '$':
+			l.dollar(false)
+		default:
+			l.next()
+		}
+	}
+	return string(l.src[start:l.pos])
+}
+
+// array reads the elements of an array assignment, name=(...), it returns false if the script ends before its )
+func (l *linter) array() bool {
+	for {
+		for !l.eof() && strings.ContainsRune(" \t\r\n", l.peek()) {
+			l.next()
+		}
+		switch l.peek() {
+		case 0:
+			return false
+		case ')':
+			l.next()
+			return true
+		case '#':
+			for !l.eof() && l.peek() != '\n' {
+				l.next()
+			}
+		default:
+			if l.word() == "" {
+				l.next()
+			}
+		}
+	}
+}
+
+// skipTo skips past the next c, it returns false if the script ends first
+func (l *linter) skipTo(c rune) bool {
+	for !l.eof() {
+		if l.next() == c {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *linter) doubleQuoted(open position) {
+	for !l.eof() {
+		switch l.peek() {
+		case '"':
+			l.next()
+			return
+		case '\\':
+			l.next()
+			if !l.eof() {
+				l.next()
+			}
+		case '`':
+			p := l.position()
+			l.next()
+			l.backquoted(p)
+		case '$':
+			l.dollar(true)
+		default:
+			l.next()
+		}
+	}
+	l.report(open, "unterminated double-quoted string")
+}
+
+func (l *linter) backquoted(open position) {
+	for !l.eof() {
+		switch l.next() {
+		case '\\':
+			if !l.eof() {
+				l.next()
+			}
+		case '`':
+			return
+		}
+	}
+	l.report(open, "unterminated backquoted command substitution")
+}
+
+// dollar reads a $ expansion, $” strings are only quoted outside of double quotes
+func (l *linter) dollar(inDoubleQuotes bool) {
+	open := l.position()
+	l.next()
+	switch l.peek() {
+	case '\'':
+		if inDoubleQuotes {
+			return
+		}
+		l.next()
+		for !l.eof() {
+			switch l.next() {
+			case '\\':
+				if !l.eof() {
+					l.next()
+				}
+			case '\'':
+				return
+			}
+		}
+		l.report(open, "unterminated single-quoted string $'")
+	case '(':
+		l.next()
+		if l.peek() == '(' {
+			l.next()
+			if !l.arithmetic() {
+				l.report(open, "unterminated arithmetic expansion $((")
+			}
+			return
+		}
+		if !l.list(')') {
+			l.report(open, "unterminated command substitution $(")
+		}
+	case '{':
+		l.next()
+		for !l.eof() {
+			switch l.peek() {
+			case '}':
+				l.next()
+				return
+			case '\\':
+				l.next()
+				if !l.eof() {
+					l.next()
+				}
+			case '\'':
+				p := l.position()
+				l.next()
+				if !l.skipTo('\'') {
+					l.report(p, "unterminated single-quoted string")
+				}
+			case '"':
+				p := l.position()
+				l.next()
+				l.doubleQuoted(p)
+			case '$':
+				l.dollar(false)
+			default:
+				l.next()
+			}
+		}
+		l.report(open, "unterminated parameter expansion ${")
+	}
+}
+
+// arithmetic skips past the )) of an arithmetic expansion, it returns false if the script ends first
+func (l *linter) arithmetic() bool {
+	depth := 0
+	for !l.eof() {
+		switch l.next() {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			} else if l.peek() == ')' {
+				l.next()
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// redirection reads a redirection operator, and the delimiter of a here-document
+func (l *linter) redirection() {
+	p := l.position()
+	c := l.next()
+	if c == '<' && l.peek() == '<' {
+		l.next()
+		if l.peek() == '<' {
+			// here-string
+			l.next()
+			return
+		}
+		stripTabs := false
+		if l.peek() == '-' {
+			l.next()
+			stripTabs = true
+		}
+		l.skipBlanks()
+		delimiter := strings.NewReplacer(`'`, "", `"`, "", `\`, "").Replace(l.word())
+		if delimiter == "" {
+			l.report(p, "missing here-document delimiter after <<")
+			return
+		}
+		l.heredocs = append(l.heredocs, heredoc{delimiter: delimiter, stripTabs: stripTabs, position: p})
+		return
+	}
+	if l.peek() == '(' {
+		// process substitution
+		l.next()
+		if !l.list(')') {
+			l.report(p, "unterminated process substitution %c(", c)
+		}
+		return
+	}
+	for !l.eof() && strings.ContainsRune("<>&|", l.peek()) {
+		l.next()
+	}
+}
+
+// readHeredocs skips the bodies of the here-documents started on the line that just ended
+func (l *linter) readHeredocs() {
+	for _, h := range l.heredocs {
+		closed := false
+		for !l.eof() && !closed {
+			start := l.pos
+			for !l.eof() && l.peek() != '\n' {
+				l.next()
+			}
+			line := string(l.src[start:l.pos])
+			if !l.eof() {
+				l.next()
+			}
+			line = strings.TrimSuffix(line, "\r")
+			if h.stripTabs {
+				line = strings.TrimLeft(line, "\t")
+			}
+			closed = line == h.delimiter
+		}
+		if !closed {
+			l.report(h.position, "here-document is never closed with %s", h.delimiter)
+		}
+	}
+	l.heredocs = nil
+}
@@ -0,0 +1,88 @@
+package shell_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/utils/shell"
+)
+
+func TestUtilsShell(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
+
+var _ = Describe("Lint", func() {
+	DescribeTable("valid scripts", func(script string) {
+		Expect(shell.Lint(script)).To(Succeed())
+	},
+		Entry("simple commands", "yum install -y jq && echo done | tee /tmp/log; sleep 1 &"),
+		Entry("quotes and escapes", `echo 'it''s' "a \"quoted\" $HOME" \'ok \
+  --continued $'it\'s'`),
+		Entry("substitutions", "echo $(hostname) `date` ${HOME:-/root} $((1 + (2 * 3))) \"$(echo \"$(id -u)\")\""),
+		Entry("compound commands", `if [ -f /etc/eks/bootstrap.sh ]; then
+  echo yes
+elif [[ -n "$X" && "$Y" == a* ]]; then
+  echo maybe
+else
+  { echo no; }
+fi
+for i in 1 2 3; do echo $i; done
+for ((i = 0; i < 3; i++)); do echo $i; done
+while true; do break; done
+until false; do break; done
+(( n = 1 << 2 ))
+(cd /tmp && ls)`),
+		Entry("case", `case "$(uname -m)" in
+  x86_64|amd64) arch=amd64 ;;
+  (aarch64) arch=arm64
+    ;;
+  *) if true; then echo; fi
+esac`),
+		Entry("functions and arrays", `f() { echo f; }
+function g { echo g; }
+function h() {
+  local args=(a "b c" [k]=v)
+  declare -A m=(["g3"]=x)
+}`),
+		Entry("here-documents", `cat <<EOF > /etc/motd
+if ( unbalanced '
+EOF
+cat <<-'END' | sh
+	echo "$HOME"
+	END
+grep x <<< "here string"
+diff <(ls a) <(ls b)`),
+		Entry("comments", "# it's a comment with 'quotes' and (parens\necho a#b"),
+	)
+
+	DescribeTable("invalid scripts", func(script string, issues ...string) {
+		err := shell.Lint(script)
+		Expect(err).To(HaveOccurred())
+		var messages []string
+		for _, issue := range err.(*shell.LintError).Issues {
+			messages = append(messages, issue.String())
+		}
+		Expect(messages).To(Equal(issues))
+	},
+		Entry("unterminated quotes", "echo ok\necho it's", "line 2, column 8: unterminated single-quoted string"),
+		Entry("unterminated double quotes", "echo \"$(hostname)\n", "line 1, column 6: unterminated double-quoted string"),
+		Entry("unterminated command substitution", "x=$(hostname\necho $x", "line 1, column 3: unterminated command substitution $("),
+		Entry("unterminated parameter expansion", "echo ${HOME", "line 1, column 6: unterminated parameter expansion ${"),
+		Entry("if without fi", "echo a\nif true; then\n  echo b\n", "line 2, column 1: if is never closed with fi"),
+		Entry("wrong closer", "while true; do\n  echo\nfi", "line 1, column 1: while is never closed with done", "line 3, column 1: fi inside while opened at line 1, expected done"),
+		Entry("closer without opener", "echo\ndone", "line 2, column 1: done without a matching for or until or while"),
+		Entry("then without if", "then echo", "line 1, column 1: then without a matching if"),
+		Entry("case without esac", "case $x in\n  a) echo ;;\n", "line 1, column 1: case is never closed with esac"),
+		Entry("missing space after [", "if [-f /etc/x ]; then echo; fi", "line 1, column 4: missing space after [, e.g. [ -f file ]"),
+		Entry("missing space before ]", "[ -f /etc/x] && echo", "line 1, column 1: [ is not closed with a separate ], it must be separated by spaces, e.g. [ -f file ]"),
+		Entry("unclosed [[", "[[ -n $x && echo", "line 1, column 1: [[ is not closed with a separate ]], it must be separated by spaces, e.g. [[ -f file ]]"),
+		Entry("unclosed here-document", "cat <<EOF\nhello\nEOF \n", "line 1, column 5: here-document is never closed with EOF"),
+		Entry("unexpected paren", "echo )", "line 1, column 6: unexpected ), there's no ( or $( to close"),
+		Entry("carriage returns", "echo a\r\necho b\r\n", "line 1, column 7: literal carriage return, convert the script to Unix line endings"),
+		Entry("several issues in order", "if true; then\n  echo \"unterminated\nfi", "line 1, column 1: if is never closed with fi", "line 2, column 8: unterminated double-quoted string"),
+	)
+})
@@ -1,13 +1,23 @@
 package tasks
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 
 	"github.com/kris-nova/logger"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/weaveworks/eksctl/pkg/progress"
+	"github.com/weaveworks/eksctl/pkg/telemetry"
 )
 
+// PlanWriter receives the plan of every task tree before it's executed when set,
+// see TaskTree.Plan
+var PlanWriter io.Writer
+
 // Task is a common interface for the stack manager tasks
 type Task interface {
 	Describe() string
@@ -47,6 +57,10 @@ type TaskTree struct {
 	Parallel  bool
 	PlanMode  bool
 	IsSubTask bool
+
+	// ctx holds the span of the task that runs the tree when it's a sub-tree,
+	// the spans of its tasks are children of that span
+	ctx context.Context
 }
 
 // Append new tasks to the set
@@ -131,22 +145,121 @@ func (t *TaskTree) Describe() string {
 	return msg + "\n"
 }
 
+// Plan returns the tasks of the tree as an indented list, which shows which tasks run in
+// parallel, which tasks each task waits for, and how many tasks can run at the same time
+func (t *TaskTree) Plan() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "task plan: %s\n", t.planSummary())
+	t.writePlan(&b, "", 1)
+	return b.String()
+}
+
+func (t *TaskTree) planSummary() string {
+	mode := "sequential"
+	if t.Parallel {
+		mode = "parallel"
+	}
+	noun := "tasks"
+	if t.Len() == 1 {
+		noun = "task"
+	}
+	summary := fmt.Sprintf("%d %s %s, up to %d running at the same time", t.Len(), mode, noun, t.maxParallelism())
+	if t.PlanMode {
+		summary += " (plan mode, no task will run)"
+	}
+	return summary
+}
+
+func (t *TaskTree) writePlan(w io.Writer, prefix string, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for i, task := range t.Tasks {
+		id := fmt.Sprintf("%s%d", prefix, i+1)
+		var after string
+		if !t.Parallel && i > 0 {
+			after = fmt.Sprintf(" (after %s%d)", prefix, i)
+		}
+		if tree, ok := task.(*TaskTree); ok {
+			fmt.Fprintf(w, "%s%s. %s%s\n", indent, id, tree.planSummary(), after)
+			tree.writePlan(w, id+".", depth+1)
+			continue
+		}
+		fmt.Fprintf(w, "%s%s. %s%s\n", indent, id, strings.Join(strings.Fields(task.Describe()), " "), after)
+	}
+}
+
+// maxParallelism returns the maximum number of tasks of the tree that can run at the same time
+func (t *TaskTree) maxParallelism() int {
+	max := 0
+	for _, task := range t.Tasks {
+		n := 1
+		if tree, ok := task.(*TaskTree); ok {
+			n = tree.maxParallelism()
+		}
+		if t.Parallel {
+			max += n
+		} else if n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+// printPlan writes the plan to PlanWriter when the tree is executed by a command rather than by
+// the tree it's a sub-tree of, as the plan of the parent tree already contains it
+func (t *TaskTree) printPlan() {
+	if PlanWriter == nil || t.ctx != nil {
+		return
+	}
+	fmt.Fprint(PlanWriter, t.Plan())
+}
+
+// startProgress adds the tasks of the tree to the progress of the command when it's executed by a command,
+// the tasks of sub-trees are counted by the tree they're part of
+func (t *TaskTree) startProgress() {
+	if t.ctx != nil {
+		return
+	}
+	progress.StartTasks(t.countTasks())
+}
+
+// countTasks returns the number of tasks of the tree that aren't trees themselves
+func (t *TaskTree) countTasks() int {
+	n := 0
+	for _, task := range t.Tasks {
+		if tree, ok := task.(*TaskTree); ok {
+			n += tree.countTasks()
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+func (t *TaskTree) context() context.Context {
+	if t.ctx != nil {
+		return t.ctx
+	}
+	return telemetry.RootContext()
+}
+
 // Do will run through the set in the background, it may return an error immediately,
 // or eventually write to the errs channel; it will close the channel once all tasks
 // are completed
 func (t *TaskTree) Do(allErrs chan error) error {
+	t.printPlan()
 	if t.Len() == 0 || t.PlanMode {
 		logger.Debug("no actual tasks")
 		close(allErrs)
 		return nil
 	}
+	t.startProgress()
 
 	errs := make(chan error)
 
 	if t.Parallel {
-		go doParallelTasks(errs, t.Tasks)
+		go doParallelTasks(t.context(), errs, t.Tasks)
 	} else {
-		go doSequentialTasks(errs, t.Tasks)
+		go doSequentialTasks(t.context(), errs, t.Tasks)
 	}
 
 	go func() {
@@ -162,17 +275,19 @@ func (t *TaskTree) Do(allErrs chan error) error {
 // DoAllSync will run through the set in the foregrounds and return all the errors
 // in a slice
 func (t *TaskTree) DoAllSync() []error {
+	t.printPlan()
 	if t.Len() == 0 || t.PlanMode {
 		logger.Debug("no actual tasks")
 		return nil
 	}
+	t.startProgress()
 
 	errs := make(chan error)
 
 	if t.Parallel {
-		go doParallelTasks(errs, t.Tasks)
+		go doParallelTasks(t.context(), errs, t.Tasks)
 	} else {
-		go doSequentialTasks(errs, t.Tasks)
+		go doSequentialTasks(t.context(), errs, t.Tasks)
 	}
 
 	allErrs := []error{}
@@ -182,29 +297,50 @@ func (t *TaskTree) DoAllSync() []error {
 	return allErrs
 }
 
-func doSingleTask(allErrs chan error, task Task) bool {
+func doSingleTask(ctx context.Context, allErrs chan error, task Task) bool {
 	desc := task.Describe()
 	logger.Debug("started task: %s", desc)
+
+	spanName := strings.TrimSpace(desc)
+	tree, isTree := task.(*TaskTree)
+	if isTree {
+		// the description of a tree lists all of its tasks, which is too long for a span name
+		spanName = fmt.Sprintf("%d sequential sub-tasks", tree.Len())
+		if tree.Parallel {
+			spanName = fmt.Sprintf("%d parallel sub-tasks", tree.Len())
+		}
+	}
+	ctx, span := telemetry.StartSpan(ctx, spanName, attribute.String("eksctl.task", strings.TrimSpace(desc)))
+	if isTree {
+		tree.ctx = ctx
+	}
+
 	errs := make(chan error)
 	if err := task.Do(errs); err != nil {
+		telemetry.EndSpan(span, err)
 		allErrs <- err
 		return false
 	}
 	if err := <-errs; err != nil {
+		telemetry.EndSpan(span, err)
 		allErrs <- err
 		return false
 	}
+	telemetry.EndSpan(span, nil)
+	if !isTree {
+		progress.TaskDone()
+	}
 	logger.Debug("completed task: %s", desc)
 	return true
 }
 
-func doParallelTasks(allErrs chan error, tasks []Task) {
+func doParallelTasks(ctx context.Context, allErrs chan error, tasks []Task) {
 	wg := &sync.WaitGroup{}
 	wg.Add(len(tasks))
 	for t := range tasks {
 		go func(t int) {
 			defer wg.Done()
-			if ok := doSingleTask(allErrs, tasks[t]); !ok {
+			if ok := doSingleTask(ctx, allErrs, tasks[t]); !ok {
 				logger.Debug("failed task: %s (will continue until other parallel tasks are completed)", tasks[t].Describe())
 			}
 		}(t)
@@ -214,9 +350,9 @@ func doParallelTasks(allErrs chan error, tasks []Task) {
 	close(allErrs)
 }
 
-func doSequentialTasks(allErrs chan error, tasks []Task) {
+func doSequentialTasks(ctx context.Context, allErrs chan error, tasks []Task) {
 	for t := range tasks {
-		if ok := doSingleTask(allErrs, tasks[t]); !ok {
+		if ok := doSingleTask(ctx, allErrs, tasks[t]); !ok {
 			logger.Debug("failed task: %s (will not run other sequential tasks)", tasks[t].Describe())
 			break
 		}
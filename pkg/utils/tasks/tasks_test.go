@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -622,4 +623,56 @@ var _ = Describe("TaskTree", func() {
 			}
 		})
 	})
+
+	Context("Plan", func() {
+		newTask := func(description string) Task {
+			return &GenericTask{Description: description, Doer: func() error { return nil }}
+		}
+
+		It("shows the order, dependencies and parallelism of the tasks", func() {
+			nodeGroups := &TaskTree{Parallel: true, IsSubTask: true}
+			nodeGroups.Append(newTask("create nodegroup \"ng-1\""), newTask("create nodegroup \"ng-2\""))
+			addons := &TaskTree{IsSubTask: true}
+			addons.Append(newTask("create addon vpc-cni"), newTask("restart aws-node\n"))
+			postCreation := &TaskTree{Parallel: true, IsSubTask: true}
+			postCreation.Append(nodeGroups, addons)
+
+			tasks := &TaskTree{}
+			tasks.Append(newTask("create cluster control plane \"c\""), postCreation)
+
+			Expect(tasks.Plan()).To(Equal(`task plan: 2 sequential tasks, up to 3 running at the same time
+  1. create cluster control plane "c"
+  2. 2 parallel tasks, up to 3 running at the same time (after 1)
+    2.1. 2 parallel tasks, up to 2 running at the same time
+      2.1.1. create nodegroup "ng-1"
+      2.1.2. create nodegroup "ng-2"
+    2.2. 2 sequential tasks, up to 1 running at the same time
+      2.2.1. create addon vpc-cni
+      2.2.2. restart aws-node (after 2.2.1)
+`))
+		})
+
+		It("is printed before the tasks run", func() {
+			defer func() { PlanWriter = nil }()
+			var out strings.Builder
+			PlanWriter = &out
+
+			subTree := &TaskTree{IsSubTask: true}
+			subTree.Append(newTask("t1.1"))
+			tasks := &TaskTree{PlanMode: true}
+			tasks.Append(subTree)
+
+			Expect(tasks.DoAllSync()).To(BeEmpty())
+			Expect(out.String()).To(Equal(`task plan: 1 sequential task, up to 1 running at the same time (plan mode, no task will run)
+  1. 1 sequential task, up to 1 running at the same time
+    1.1. t1.1
+`))
+
+			out.Reset()
+			tasks.PlanMode = false
+			Expect(tasks.DoAllSync()).To(BeEmpty())
+			// the sub-tree is part of the plan of the tree
+			Expect(strings.Count(out.String(), "task plan")).To(Equal(1))
+		})
+	})
 })
@@ -2,91 +2,53 @@ package waiters
 
 import (
 	"context"
-	"fmt"
 	"math/rand"
-	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/telemetry"
 )
 
-// Wait for something with a name to reach status that is expressed by acceptors using newRequest
-// until we hit waitTimeout, on unexpected status troubleshoot will be called with the desired
-// status as an argument, so that it can find what might have gone wrong
-func Wait(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error {
-	desiredStatus := fmt.Sprintf("%v", acceptors[0].Expected)
-	name = strings.Join([]string{"wait", name, desiredStatus}, "_")
+// ConditionFunc reports whether the desired state has been reached, a non-nil error stops the wait
+type ConditionFunc func(ctx context.Context) (bool, error)
+
+// Wait polls condition until it reports that the desired state has been reached, it returns an error
+// or waitTimeout elapses; msg is logged before every attempt
+func Wait(ctx context.Context, msg string, condition ConditionFunc, waitTimeout time.Duration) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, msg)
+	defer func() { telemetry.EndSpan(span, err) }()
 
-	ctx, cancel := context.WithTimeout(context.Background(), waitTimeout)
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
 	defer cancel()
+
 	startTime := time.Now()
-	w := makeWaiter(ctx, name, msg, acceptors, newRequest)
+	nextDelay := makeWaiterDelay()
 	logger.Debug("start %s", msg)
-	if waitErr := w.WaitWithContext(ctx); waitErr != nil {
-		if troubleshoot != nil {
-			if wrappedErr := troubleshoot(desiredStatus); wrappedErr != nil {
-				return wrappedErr
-			}
+	for attempt := 0; ; attempt++ {
+		logger.Info(msg)
+		done, err := condition(ctx)
+		if err != nil {
+			return errors.Wrap(err, msg)
+		}
+		if done {
+			logger.Debug("done after %s of %s", time.Since(startTime), msg)
+			return nil
 		}
-		return errors.Wrap(waitErr, msg)
-	}
-	logger.Debug("done after %s of %s", time.Since(startTime), msg)
-	return nil
-}
-
-func makeWaiter(ctx context.Context, name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request) request.Waiter {
-	return request.Waiter{
-		Name:        name,
-		MaxAttempts: 1024, // we use context deadline instead
-		Delay:       makeWaiterDelay(),
-		Acceptors:   acceptors,
-		NewRequest: func(_ []request.Option) (*request.Request, error) {
-			logger.Info(msg)
-			req := newRequest()
-			req.SetContext(ctx)
-			return req, nil
-		},
-	}
-}
-
-// MakeAcceptors constructs a slice of request acceptors
-func MakeAcceptors(statusPath string, successStatus interface{}, failureStates []string, extraAcceptors ...request.WaiterAcceptor) []request.WaiterAcceptor {
-	acceptors := []request.WaiterAcceptor{makeStatusAcceptor(successStatus, statusPath)}
-	acceptors[0].State = request.SuccessWaiterState
-
-	for _, s := range failureStates {
-		acceptors = append(acceptors, makeStatusAcceptor(s, statusPath))
-	}
-
-	acceptors = append(acceptors, extraAcceptors...)
-
-	return acceptors
-}
-
-func makeStatusAcceptor(status interface{}, statusPath string) request.WaiterAcceptor {
-	return request.WaiterAcceptor{
-		Matcher:  request.PathAllWaiterMatch,
-		Argument: statusPath,
-		Expected: status,
-		State:    request.FailureWaiterState,
-	}
-}
 
-// MakeAcceptors constructs a slice of request acceptors for error codes
-func MakeErrorCodeAcceptors(errorCode string) []request.WaiterAcceptor {
-	return []request.WaiterAcceptor{
-		{
-			Matcher:  request.ErrorWaiterMatch,
-			Expected: errorCode,
-			State:    request.SuccessWaiterState,
-		},
+		timer := time.NewTimer(nextDelay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrap(ctx.Err(), msg)
+		case <-timer.C:
+		}
 	}
 }
 
 // makeWaiterDelay returns delay ranging between 15s and 20s
-func makeWaiterDelay() request.WaiterDelay {
+func makeWaiterDelay() func(attempt int) time.Duration {
 	const (
 		base        = 15 * time.Second
 		offsetSteps = 200
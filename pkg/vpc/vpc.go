@@ -5,13 +5,14 @@ import (
 	"encoding/binary"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -213,7 +214,7 @@ func UseFromClusterStack(ctx context.Context, provider api.ClusterProvider, stac
 
 	// Cluster Endpoint Access isn't part of the EKS CloudFormation Cluster stack at this point
 	// Retrieve the current configuration via the SDK
-	if err := UseEndpointAccessFromCluster(provider, spec); err != nil {
+	if err := UseEndpointAccessFromCluster(ctx, provider, spec); err != nil {
 		return err
 	}
 
@@ -492,19 +493,19 @@ func ImportSubnetsFromSpec(ctx context.Context, provider api.ClusterProvider, sp
 
 //UseEndpointAccessFromCluster retrieves the Cluster's endpoint access configuration via the SDK
 // as the CloudFormation Stack doesn't support that configuration currently
-func UseEndpointAccessFromCluster(provider api.ClusterProvider, spec *api.ClusterConfig) error {
+func UseEndpointAccessFromCluster(ctx context.Context, provider api.ClusterProvider, spec *api.ClusterConfig) error {
 	input := &awseks.DescribeClusterInput{
 		Name: &spec.Metadata.Name,
 	}
-	output, err := provider.EKS().DescribeCluster(input)
+	output, err := provider.EKS().DescribeCluster(ctx, input)
 	if err != nil {
 		return err
 	}
 	if spec.VPC.ClusterEndpoints == nil {
 		spec.VPC.ClusterEndpoints = &api.ClusterEndpoints{}
 	}
-	spec.VPC.ClusterEndpoints.PublicAccess = output.Cluster.ResourcesVpcConfig.EndpointPublicAccess
-	spec.VPC.ClusterEndpoints.PrivateAccess = output.Cluster.ResourcesVpcConfig.EndpointPrivateAccess
+	spec.VPC.ClusterEndpoints.PublicAccess = &output.Cluster.ResourcesVpcConfig.EndpointPublicAccess
+	spec.VPC.ClusterEndpoints.PrivateAccess = &output.Cluster.ResourcesVpcConfig.EndpointPrivateAccess
 	return nil
 }
 
@@ -557,6 +558,47 @@ func getSubnetByID(ctx context.Context, ec2API awsapi.EC2, id string) (ec2types.
 	return output.Subnets[0], nil
 }
 
+// SelectNodeGroupSubnetsByTags returns the IDs of the subnets of vpcID that have all tags of subnetSelector
+func SelectNodeGroupSubnetsByTags(ctx context.Context, subnetSelector map[string]string, ec2API awsapi.EC2, vpcID string) ([]string, error) {
+	var keys []string
+	for key := range subnetSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	filters := []ec2types.Filter{
+		{
+			Name:   aws.String("vpc-id"),
+			Values: []string{vpcID},
+		},
+	}
+	for _, key := range keys {
+		filters = append(filters, ec2types.Filter{
+			Name:   aws.String("tag:" + key),
+			Values: []string{subnetSelector[key]},
+		})
+	}
+
+	var subnetIDs []string
+	paginator := ec2.NewDescribeSubnetsPaginator(ec2API, &ec2.DescribeSubnetsInput{
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "describing subnets")
+		}
+		for _, subnet := range output.Subnets {
+			subnetIDs = append(subnetIDs, *subnet.SubnetId)
+		}
+	}
+	if len(subnetIDs) == 0 {
+		return nil, fmt.Errorf("no subnets in vpc %q match subnetSelector %v", vpcID, subnetSelector)
+	}
+	sort.Strings(subnetIDs)
+	return subnetIDs, nil
+}
+
 func SelectNodeGroupSubnets(ctx context.Context, nodegroupAZs, nodegroupSubnets []string, subnets api.AZSubnetMapping, ec2API awsapi.EC2, vpcID string) ([]string, error) {
 	// We have validated that either azs are provided or subnets are provided
 	numNodeGroupsAZs := len(nodegroupAZs)
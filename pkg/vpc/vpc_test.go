@@ -10,7 +10,8 @@ import (
 	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -81,11 +82,12 @@ type selectSubnetsCase struct {
 	expectIDs        []string
 }
 
-func newFakeClusterWithEndpoints(private, public bool, name string) *eks.Cluster {
-	cluster := NewFakeCluster(name, eks.ClusterStatusActive)
-	vpcCfgReq := eks.VpcConfigResponse{}
-	vpcCfgReq.SetEndpointPrivateAccess(private).SetEndpointPublicAccess(public)
-	cluster.SetResourcesVpcConfig(&vpcCfgReq)
+func newFakeClusterWithEndpoints(private, public bool, name string) *ekstypes.Cluster {
+	cluster := NewFakeCluster(name, ekstypes.ClusterStatusActive)
+	cluster.ResourcesVpcConfig = &ekstypes.VpcConfigResponse{
+		EndpointPrivateAccess: private,
+		EndpointPublicAccess:  public,
+	}
 	return cluster
 }
 
@@ -221,11 +223,11 @@ var _ = Describe("VPC", func() {
 		func(clusterCase useFromClusterCase) {
 			p := mockprovider.NewMockProvider()
 			cluster := newFakeClusterWithEndpoints(true, true, "dummy cluster")
-			mockResultFn := func(_ *eks.DescribeClusterInput) *eks.DescribeClusterOutput {
+			mockResultFn := func(_ context.Context, _ *eks.DescribeClusterInput, _ ...func(*eks.Options)) *eks.DescribeClusterOutput {
 				return &eks.DescribeClusterOutput{Cluster: cluster}
 			}
 
-			p.MockEKS().On("DescribeCluster", MatchedBy(func(input *eks.DescribeClusterInput) bool {
+			p.MockEKS().On("DescribeCluster", Anything, MatchedBy(func(input *eks.DescribeClusterInput) bool {
 				return input != nil
 			})).Return(mockResultFn, nil)
 
@@ -427,15 +429,15 @@ var _ = Describe("VPC", func() {
 			p := mockprovider.NewMockProvider()
 			p.MockEKS()
 			cluster := newFakeClusterWithEndpoints(e.private, e.public, e.clusterName)
-			mockResultFn := func(_ *eks.DescribeClusterInput) *eks.DescribeClusterOutput {
+			mockResultFn := func(_ context.Context, _ *eks.DescribeClusterInput, _ ...func(*eks.Options)) *eks.DescribeClusterOutput {
 				return &eks.DescribeClusterOutput{Cluster: cluster}
 			}
 
-			p.MockEKS().On("DescribeCluster", MatchedBy(func(input *eks.DescribeClusterInput) bool {
+			p.MockEKS().On("DescribeCluster", Anything, MatchedBy(func(input *eks.DescribeClusterInput) bool {
 				return input != nil
 			})).Return(mockResultFn, e.error)
 
-			err := UseEndpointAccessFromCluster(p, e.cfg)
+			err := UseEndpointAccessFromCluster(context.Background(), p, e.cfg)
 			if e.error != nil {
 				Expect(err).To(MatchError(e.error.Error()))
 			} else {
@@ -470,7 +472,7 @@ var _ = Describe("VPC", func() {
 			clusterName: "notFoundCluster",
 			private:     false,
 			public:      false,
-			error:       errors.New(eks.ErrCodeResourceNotFoundException),
+			error:       &ekstypes.ResourceNotFoundException{Message: aws.String("cluster not found")},
 		}),
 		Entry("Nil Cluster endpoint from config", endpointAccessCase{
 			cfg: &api.ClusterConfig{
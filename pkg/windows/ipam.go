@@ -19,6 +19,10 @@ const (
 	vpcCNIName       = "amazon-vpc-cni"
 	vpcCNINamespace  = metav1.NamespaceSystem
 	windowsIPAMField = "enable-windows-ipam"
+
+	// EnableIPAMCommand is the kubectl command that enables Windows IPAM like IPAM.Enable does
+	EnableIPAMCommand = "kubectl create configmap " + vpcCNIName + " --namespace=" + vpcCNINamespace +
+		" --from-literal=" + windowsIPAMField + "=true --dry-run=client --output=yaml | kubectl apply --filename=-"
 )
 
 // IPAM enables Windows IPAM in the VPC CNI ConfigMap.